@@ -40,6 +40,9 @@ import (
 	"github.com/mafredri/cdp/protocol/media"
 	"github.com/mafredri/cdp/protocol/memory"
 	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/noderuntime"
+	"github.com/mafredri/cdp/protocol/nodetracing"
+	"github.com/mafredri/cdp/protocol/nodeworker"
 	"github.com/mafredri/cdp/protocol/overlay"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/protocol/performance"
@@ -3485,6 +3488,117 @@ type Network interface {
 	ReportingAPIEndpointsChangedForOrigin(context.Context) (network.ReportingAPIEndpointsChangedForOriginClient, error)
 }
 
+// The NodeRuntime domain. Support for inspecting node process state.
+//
+// Note: This domain is experimental.
+type NodeRuntime interface {
+	// Command Enable
+	//
+	// Enable the NodeRuntime events except by
+	// `NodeRuntime.waitingForDisconnect`.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disable NodeRuntime events
+	Disable(context.Context) error
+
+	// Command NotifyWhenWaitingForDisconnect
+	//
+	// Enable the `NodeRuntime.waitingForDisconnect`.
+	NotifyWhenWaitingForDisconnect(context.Context, *noderuntime.NotifyWhenWaitingForDisconnectArgs) error
+
+	// Event WaitingForDisconnect
+	//
+	// This event is fired instead of `Runtime.executionContextDestroyed`
+	// when enabled. It is fired when the Node process finished all code
+	// execution and is waiting for all frontends to disconnect.
+	WaitingForDisconnect(context.Context) (noderuntime.WaitingForDisconnectClient, error)
+
+	// Event WaitingForDebugger
+	//
+	// This event is fired when the runtime is waiting for the debugger.
+	// For example, when inspector.waitingForDebugger is called
+	WaitingForDebugger(context.Context) (noderuntime.WaitingForDebuggerClient, error)
+}
+
+// The NodeTracing domain.
+//
+// Note: This domain is experimental.
+type NodeTracing interface {
+	// Command GetCategories
+	//
+	// Gets supported tracing categories.
+	GetCategories(context.Context) (*nodetracing.GetCategoriesReply, error)
+
+	// Command Start
+	//
+	// Start trace events collection.
+	Start(context.Context, *nodetracing.StartArgs) error
+
+	// Command Stop
+	//
+	// Stop trace events collection. Remaining collected events will be
+	// sent as a sequence of dataCollected events followed by
+	// tracingComplete event.
+	Stop(context.Context) error
+
+	// Event DataCollected
+	//
+	// Contains an bucket of collected trace events.
+	DataCollected(context.Context) (nodetracing.DataCollectedClient, error)
+
+	// Event TracingComplete
+	//
+	// Signals that tracing is stopped and there is no trace buffers
+	// pending flush, all data were delivered via dataCollected events.
+	TracingComplete(context.Context) (nodetracing.TracingCompleteClient, error)
+}
+
+// The NodeWorker domain. Support for sending messages to Node worker
+// Inspector instances.
+//
+// Note: This domain is experimental.
+type NodeWorker interface {
+	// Command SendMessageToWorker
+	//
+	// Sends protocol message over session with given id.
+	SendMessageToWorker(context.Context, *nodeworker.SendMessageToWorkerArgs) error
+
+	// Command Enable
+	//
+	// Instructs the inspector to attach to running workers. Will also
+	// attach to new workers as they start
+	Enable(context.Context, *nodeworker.EnableArgs) error
+
+	// Command Disable
+	//
+	// Detaches from all running workers and disables attaching to new
+	// workers as they are started.
+	Disable(context.Context) error
+
+	// Command Detach
+	//
+	// Detached from the worker with given sessionId.
+	Detach(context.Context, *nodeworker.DetachArgs) error
+
+	// Event AttachedToWorker
+	//
+	// Issued when attached to a worker.
+	AttachedToWorker(context.Context) (nodeworker.AttachedToWorkerClient, error)
+
+	// Event DetachedFromWorker
+	//
+	// Issued when detached from the worker.
+	DetachedFromWorker(context.Context) (nodeworker.DetachedFromWorkerClient, error)
+
+	// Event ReceivedMessageFromWorker
+	//
+	// Notifies about a new protocol message received from the session
+	// (session ID is provided in attachedToWorker notification).
+	ReceivedMessageFromWorker(context.Context) (nodeworker.ReceivedMessageFromWorkerClient, error)
+}
+
 // The Overlay domain. This domain provides various functionality related to
 // drawing atop the inspected page.
 //
@@ -116,6 +116,8 @@ type Client struct {
 	Tracing              Tracing
 	WebAudio             WebAudio
 	WebAuthn             WebAuthn
+
+	conn *rpcc.Conn
 }
 
 // NewClient returns a new Client that uses conn
@@ -175,5 +177,13 @@ func NewClient(conn *rpcc.Conn) *Client {
 		Tracing:              tracing.NewClient(conn),
 		WebAudio:             webaudio.NewClient(conn),
 		WebAuthn:             webauthn.NewClient(conn),
+
+		conn: conn,
 	}
 }
+
+// Conn returns the rpcc connection used by the Client. Conn returns nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn {
+	return c.conn
+}
@@ -0,0 +1,105 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package cdp
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/css"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// The Edge extensions of the CSS domain.
+type EdgeCSS interface {
+	// Event LayoutEditorChange
+	LayoutEditorChange(context.Context) (css.LayoutEditorChangeClient, error)
+}
+
+// The Edge extensions of the DOM domain.
+type EdgeDOM interface {
+	// Event InspectNodeRequested
+	//
+	// Fired when the node should be inspected. This happens after call to
+	// setInspectMode.
+	InspectNodeRequested(context.Context) (dom.InspectNodeRequestedClient, error)
+}
+
+// The Edge extensions of the Debugger domain.
+type EdgeDebugger interface {
+	// Command CanSetScriptSource
+	//
+	// Always returns true.
+	CanSetScriptSource(context.Context) (*debugger.CanSetScriptSourceReply, error)
+
+	// Event GlobalObjectCleared
+	//
+	// Called when global has been cleared and debugger client should
+	// reset its state. Happens upon navigation or reload.
+	GlobalObjectCleared(context.Context) (debugger.GlobalObjectClearedClient, error)
+
+	// Event AsyncOperationCompleted
+	//
+	// Fired when an async operation is completed (while in a debugger
+	// stepping session).
+	AsyncOperationCompleted(context.Context) (debugger.AsyncOperationCompletedClient, error)
+}
+
+// The Edge extensions of the Page domain.
+type EdgePage interface {
+	// Command CanScreencast
+	//
+	// Tells whether screencast is supported.
+	CanScreencast(context.Context) (*page.CanScreencastReply, error)
+
+	// Command SetShowViewportSizeOnResize
+	//
+	// Paints viewport size upon main frame resize.
+	SetShowViewportSizeOnResize(context.Context, *page.SetShowViewportSizeOnResizeArgs) error
+}
+
+// The Edge extensions of the Runtime domain.
+type EdgeRuntime interface {
+	// Command Run
+	//
+	// Tells inspected instance(worker or page) that it can run in case it
+	// was started paused.
+	Run(context.Context) error
+}
+
+// EdgeClient provides the domains, commands and events that are added
+// by the Edge protocol, it is used alongside Client.
+type EdgeClient struct {
+	CSS      EdgeCSS
+	DOM      EdgeDOM
+	Debugger EdgeDebugger
+	Page     EdgePage
+	Runtime  EdgeRuntime
+
+	conn *rpcc.Conn
+}
+
+// NewEdgeClient returns a new EdgeClient that uses conn
+// for communication with the debugging target.
+func NewEdgeClient(conn *rpcc.Conn) *EdgeClient {
+	return &EdgeClient{
+		CSS:      css.NewClient(conn),
+		DOM:      dom.NewClient(conn),
+		Debugger: debugger.NewClient(conn),
+		Page:     page.NewClient(conn),
+		Runtime:  runtime.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the EdgeClient. Conn
+// returns nil if the EdgeClient was not created by NewEdgeClient.
+func (c *EdgeClient) Conn() *rpcc.Conn {
+	return c.conn
+}
@@ -0,0 +1,440 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cdp
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/console"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/heapprofiler"
+	"github.com/mafredri/cdp/protocol/io"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/noderuntime"
+	"github.com/mafredri/cdp/protocol/nodetracing"
+	"github.com/mafredri/cdp/protocol/nodeworker"
+	"github.com/mafredri/cdp/protocol/profiler"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/schema"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// NodeClient represents a client for the Node.js inspector protocol, it
+// can be used to invoke methods or listen to events in the domains
+// supported by Node.js. Domains that Node.js only partially supports
+// (e.g. Network) have Node specific interfaces with the commands and
+// events of the Node.js protocol. The NodeClient consumes a rpcc
+// connection, used to invoke the methods.
+type NodeClient struct {
+	Console      Console
+	Debugger     NodeDebugger
+	HeapProfiler HeapProfiler
+	IO           NodeIO
+	Network      NodeNetwork
+	NodeRuntime  NodeRuntime
+	NodeTracing  NodeTracing
+	NodeWorker   NodeWorker
+	Profiler     Profiler
+	Runtime      Runtime
+	Schema       Schema
+	Target       NodeTarget
+
+	conn *rpcc.Conn
+}
+
+// NewNodeClient returns a new NodeClient that uses conn
+// for communication with the Node.js process.
+func NewNodeClient(conn *rpcc.Conn) *NodeClient {
+	return &NodeClient{
+		Console:      console.NewClient(conn),
+		Debugger:     debugger.NewClient(conn),
+		HeapProfiler: heapprofiler.NewClient(conn),
+		IO:           io.NewClient(conn),
+		Network:      network.NewClient(conn),
+		NodeRuntime:  noderuntime.NewClient(conn),
+		NodeTracing:  nodetracing.NewClient(conn),
+		NodeWorker:   nodeworker.NewClient(conn),
+		Profiler:     profiler.NewClient(conn),
+		Runtime:      runtime.NewClient(conn),
+		Schema:       schema.NewClient(conn),
+		Target:       target.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the NodeClient. Conn returns
+// nil if the NodeClient was not created by NewNodeClient.
+func (c *NodeClient) Conn() *rpcc.Conn {
+	return c.conn
+}
+
+// NodeDebugger is the subset of the Debugger domain supported by Node.js.
+// Debugger domain exposes JavaScript debugging capabilities. It allows setting
+// and removing breakpoints, stepping through execution, exploring stack
+// traces, etc.
+type NodeDebugger interface {
+	// Command ContinueToLocation
+	//
+	// Continues execution until specific location is reached.
+	ContinueToLocation(context.Context, *debugger.ContinueToLocationArgs) error
+
+	// Command Disable
+	//
+	// Disables debugger for given page.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables debugger for the given page. Clients should not assume that
+	// the debugging has been enabled until the result for this command is
+	// received.
+	Enable(context.Context, *debugger.EnableArgs) (*debugger.EnableReply, error)
+
+	// Command EvaluateOnCallFrame
+	//
+	// Evaluates expression on a given call frame.
+	EvaluateOnCallFrame(context.Context, *debugger.EvaluateOnCallFrameArgs) (*debugger.EvaluateOnCallFrameReply, error)
+
+	// Command GetPossibleBreakpoints
+	//
+	// Returns possible locations for breakpoint. scriptId in start and
+	// end range locations should be the same.
+	GetPossibleBreakpoints(context.Context, *debugger.GetPossibleBreakpointsArgs) (*debugger.GetPossibleBreakpointsReply, error)
+
+	// Command GetScriptSource
+	//
+	// Returns source for the script with given id.
+	GetScriptSource(context.Context, *debugger.GetScriptSourceArgs) (*debugger.GetScriptSourceReply, error)
+
+	// Command DisassembleWASMModule
+	//
+	// Note: This command is experimental.
+	DisassembleWASMModule(context.Context, *debugger.DisassembleWASMModuleArgs) (*debugger.DisassembleWASMModuleReply, error)
+
+	// Command NextWASMDisassemblyChunk
+	//
+	// Disassemble the next chunk of lines for the module corresponding to
+	// the stream. If disassembly is complete, this API will invalidate the
+	// streamId and return an empty chunk. Any subsequent calls for the now
+	// invalid stream will return errors.
+	//
+	// Note: This command is experimental.
+	NextWASMDisassemblyChunk(context.Context, *debugger.NextWASMDisassemblyChunkArgs) (*debugger.NextWASMDisassemblyChunkReply, error)
+
+	// Command GetWASMBytecode
+	//
+	// Deprecated: This command is deprecated. Use getScriptSource
+	// instead.
+	GetWASMBytecode(context.Context, *debugger.GetWASMBytecodeArgs) (*debugger.GetWASMBytecodeReply, error)
+
+	// Command GetStackTrace
+	//
+	// Returns stack trace with given `stackTraceId`.
+	//
+	// Note: This command is experimental.
+	GetStackTrace(context.Context, *debugger.GetStackTraceArgs) (*debugger.GetStackTraceReply, error)
+
+	// Command Pause
+	//
+	// Stops on the next JavaScript statement.
+	Pause(context.Context) error
+
+	// Command PauseOnAsyncCall
+	//
+	// Deprecated: This command is deprecated.
+	//
+	// Note: This command is experimental.
+	PauseOnAsyncCall(context.Context, *debugger.PauseOnAsyncCallArgs) error
+
+	// Command RemoveBreakpoint
+	//
+	// Removes JavaScript breakpoint.
+	RemoveBreakpoint(context.Context, *debugger.RemoveBreakpointArgs) error
+
+	// Command RestartFrame
+	//
+	// Restarts particular call frame from the beginning. The old,
+	// deprecated behavior of `restartFrame` is to stay paused and allow
+	// further CDP commands after a restart was scheduled. This can cause
+	// problems with restarting, so we now continue execution immediately
+	// after it has been scheduled until we reach the beginning of the
+	// restarted frame.
+	//
+	// To stay back-wards compatible, `restartFrame` now expects a `mode`
+	// parameter to be present. If the `mode` parameter is missing,
+	// `restartFrame` errors out.
+	//
+	// The various return values are deprecated and `callFrames` is always
+	// empty. Use the call frames from the `Debugger#paused` events
+	// instead, that fires once V8 pauses at the beginning of the restarted
+	// function.
+	RestartFrame(context.Context, *debugger.RestartFrameArgs) (*debugger.RestartFrameReply, error)
+
+	// Command Resume
+	//
+	// Resumes JavaScript execution.
+	Resume(context.Context, *debugger.ResumeArgs) error
+
+	// Command SearchInContent
+	//
+	// Searches for given string in script content.
+	SearchInContent(context.Context, *debugger.SearchInContentArgs) (*debugger.SearchInContentReply, error)
+
+	// Command SetAsyncCallStackDepth
+	//
+	// Enables or disables async call stacks tracking.
+	SetAsyncCallStackDepth(context.Context, *debugger.SetAsyncCallStackDepthArgs) error
+
+	// Command SetBlackboxPatterns
+	//
+	// Replace previous blackbox patterns with passed ones. Forces backend
+	// to skip stepping/pausing in scripts with url matching one of the
+	// patterns. VM will try to leave blackboxed script by performing 'step
+	// in' several times, finally resorting to 'step out' if unsuccessful.
+	//
+	// Note: This command is experimental.
+	SetBlackboxPatterns(context.Context, *debugger.SetBlackboxPatternsArgs) error
+
+	// Command SetBlackboxedRanges
+	//
+	// Makes backend skip steps in the script in blackboxed ranges. VM
+	// will try leave blacklisted scripts by performing 'step in' several
+	// times, finally resorting to 'step out' if unsuccessful. Positions
+	// array contains positions where blackbox state is changed. First
+	// interval isn't blackboxed. Array should be sorted.
+	//
+	// Note: This command is experimental.
+	SetBlackboxedRanges(context.Context, *debugger.SetBlackboxedRangesArgs) error
+
+	// Command SetBreakpoint
+	//
+	// Sets JavaScript breakpoint at a given location.
+	SetBreakpoint(context.Context, *debugger.SetBreakpointArgs) (*debugger.SetBreakpointReply, error)
+
+	// Command SetInstrumentationBreakpoint
+	//
+	// Sets instrumentation breakpoint.
+	SetInstrumentationBreakpoint(context.Context, *debugger.SetInstrumentationBreakpointArgs) (*debugger.SetInstrumentationBreakpointReply, error)
+
+	// Command SetBreakpointByURL
+	//
+	// Sets JavaScript breakpoint at given location specified either by
+	// URL or URL regex. Once this command is issued, all existing parsed
+	// scripts will have breakpoints resolved and returned in `locations`
+	// property. Further matching script parsing will result in subsequent
+	// `breakpointResolved` events issued. This logical breakpoint will
+	// survive page reloads.
+	SetBreakpointByURL(context.Context, *debugger.SetBreakpointByURLArgs) (*debugger.SetBreakpointByURLReply, error)
+
+	// Command SetBreakpointOnFunctionCall
+	//
+	// Sets JavaScript breakpoint before each call to the given function.
+	// If another function was created from the same source as a given one,
+	// calling it will also trigger the breakpoint.
+	//
+	// Note: This command is experimental.
+	SetBreakpointOnFunctionCall(context.Context, *debugger.SetBreakpointOnFunctionCallArgs) (*debugger.SetBreakpointOnFunctionCallReply, error)
+
+	// Command SetBreakpointsActive
+	//
+	// Activates / deactivates all breakpoints on the page.
+	SetBreakpointsActive(context.Context, *debugger.SetBreakpointsActiveArgs) error
+
+	// Command SetPauseOnExceptions
+	//
+	// Defines pause on exceptions state. Can be set to stop on all
+	// exceptions, uncaught exceptions, or caught exceptions, no
+	// exceptions. Initial pause on exceptions state is `none`.
+	SetPauseOnExceptions(context.Context, *debugger.SetPauseOnExceptionsArgs) error
+
+	// Command SetReturnValue
+	//
+	// Changes return value in top frame. Available only at return break
+	// position.
+	//
+	// Note: This command is experimental.
+	SetReturnValue(context.Context, *debugger.SetReturnValueArgs) error
+
+	// Command SetScriptSource
+	//
+	// Edits JavaScript source live.
+	//
+	// In general, functions that are currently on the stack can not be
+	// edited with a single exception: If the edited function is the
+	// top-most stack frame and that is the only activation of that
+	// function on the stack. In this case the live edit will be successful
+	// and a `Debugger.restartFrame` for the top-most function is
+	// automatically triggered.
+	SetScriptSource(context.Context, *debugger.SetScriptSourceArgs) (*debugger.SetScriptSourceReply, error)
+
+	// Command SetSkipAllPauses
+	//
+	// Makes page not interrupt on any pauses (breakpoint, exception, dom
+	// exception etc).
+	SetSkipAllPauses(context.Context, *debugger.SetSkipAllPausesArgs) error
+
+	// Command SetVariableValue
+	//
+	// Changes value of variable in a callframe. Object-based scopes are
+	// not supported and must be mutated manually.
+	SetVariableValue(context.Context, *debugger.SetVariableValueArgs) error
+
+	// Command StepInto
+	//
+	// Steps into the function call.
+	StepInto(context.Context, *debugger.StepIntoArgs) error
+
+	// Command StepOut
+	//
+	// Steps out of the function call.
+	StepOut(context.Context) error
+
+	// Command StepOver
+	//
+	// Steps over the statement.
+	StepOver(context.Context, *debugger.StepOverArgs) error
+
+	// Event BreakpointResolved
+	//
+	// Deprecated: Fired when breakpoint is resolved to an actual script
+	// and location. Deprecated in favor of `resolvedBreakpoints` in the
+	// `scriptParsed` event.
+	BreakpointResolved(context.Context) (debugger.BreakpointResolvedClient, error)
+
+	// Event Paused
+	//
+	// Fired when the virtual machine stopped on breakpoint or exception
+	// or any other stop criteria.
+	Paused(context.Context) (debugger.PausedClient, error)
+
+	// Event Resumed
+	//
+	// Fired when the virtual machine resumed execution.
+	Resumed(context.Context) (debugger.ResumedClient, error)
+
+	// Event ScriptFailedToParse
+	//
+	// Fired when virtual machine fails to parse the script.
+	ScriptFailedToParse(context.Context) (debugger.ScriptFailedToParseClient, error)
+
+	// Event ScriptParsed
+	//
+	// Fired when virtual machine parses script. This event is also fired
+	// for all known and uncollected scripts upon enabling debugger.
+	ScriptParsed(context.Context) (debugger.ScriptParsedClient, error)
+}
+
+// NodeIO is the subset of the IO domain supported by Node.js. Input/Output
+// operations for streams produced by DevTools.
+type NodeIO interface {
+	// Command Close
+	//
+	// Close the stream, discard any temporary backing storage.
+	Close(context.Context, *io.CloseArgs) error
+
+	// Command Read
+	//
+	// Read a chunk of the stream
+	Read(context.Context, *io.ReadArgs) (*io.ReadReply, error)
+}
+
+// NodeNetwork is the subset of the Network domain supported by Node.js.
+// Network domain allows tracking network activities of the page. It exposes
+// information about http, file, data and other requests and responses, their
+// headers, bodies, timing, etc.
+type NodeNetwork interface {
+	// Command Disable
+	//
+	// Disables network tracking, prevents network events from being sent
+	// to the client.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables network tracking, network events will now be delivered to
+	// the client.
+	Enable(context.Context, *network.EnableArgs) error
+
+	// Command GetResponseBody
+	//
+	// Returns content served for the given request.
+	GetResponseBody(context.Context, *network.GetResponseBodyArgs) (*network.GetResponseBodyReply, error)
+
+	// Command GetRequestPostData
+	//
+	// Returns post data sent with the request. Returns an error when no
+	// data was sent with the request.
+	GetRequestPostData(context.Context, *network.GetRequestPostDataArgs) (*network.GetRequestPostDataReply, error)
+
+	// Command StreamResourceContent
+	//
+	// Enables streaming of the response for the given requestId. If
+	// enabled, the dataReceived event contains the data that was received
+	// during streaming.
+	//
+	// Note: This command is experimental.
+	StreamResourceContent(context.Context, *network.StreamResourceContentArgs) (*network.StreamResourceContentReply, error)
+
+	// Command LoadNetworkResource
+	//
+	// Fetches the resource and returns the content.
+	//
+	// Note: This command is experimental.
+	LoadNetworkResource(context.Context, *network.LoadNetworkResourceArgs) (*network.LoadNetworkResourceReply, error)
+
+	// Event DataReceived
+	//
+	// Fired when data chunk was received over the network.
+	DataReceived(context.Context) (network.DataReceivedClient, error)
+
+	// Event LoadingFailed
+	//
+	// Fired when HTTP request has failed to load.
+	LoadingFailed(context.Context) (network.LoadingFailedClient, error)
+
+	// Event LoadingFinished
+	//
+	// Fired when HTTP request has finished loading.
+	LoadingFinished(context.Context) (network.LoadingFinishedClient, error)
+
+	// Event RequestWillBeSent
+	//
+	// Fired when page is about to send HTTP request.
+	RequestWillBeSent(context.Context) (network.RequestWillBeSentClient, error)
+
+	// Event ResponseReceived
+	//
+	// Fired when HTTP response is available.
+	ResponseReceived(context.Context) (network.ResponseReceivedClient, error)
+}
+
+// NodeTarget is the subset of the Target domain supported by Node.js.
+// Supports additional targets discovery and allows to attach to them.
+type NodeTarget interface {
+	// Command SetAutoAttach
+	//
+	// Controls whether to automatically attach to new targets which are
+	// considered to be directly related to this one (for example, iframes
+	// or workers). When turned on, attaches to all existing related
+	// targets as well. When turned off, automatically detaches from all
+	// currently attached targets. This also clears all targets added by
+	// `autoAttachRelated` from the list of targets to watch for creation
+	// of related targets. You might want to call this recursively for
+	// auto-attached targets to attach to all available targets.
+	SetAutoAttach(context.Context, *target.SetAutoAttachArgs) error
+
+	// Event AttachedToTarget
+	//
+	// Issued when attached to target because of auto-attach or
+	// `attachToTarget` command.
+	//
+	// Note: This event is experimental.
+	AttachedToTarget(context.Context) (target.AttachedToTargetClient, error)
+
+	// Event TargetCreated
+	//
+	// Issued when a possible inspection target is created.
+	TargetCreated(context.Context) (target.CreatedClient, error)
+}
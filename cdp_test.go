@@ -18,6 +18,8 @@ import (
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/protocol/runtime"
 	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/versions/chrome140"
+	runtime140 "github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
 )
 
 var TestSockSrv string
@@ -111,6 +113,38 @@ func TestBrowser_RemoteDebuggingProtocol(t *testing.T) {
 	}
 }
 
+func TestBrowser_ProtocolVersion(t *testing.T) {
+	if !*testBrowser {
+		t.SkipNow()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	devt := devtool.New(fmt.Sprintf("http://localhost:%d", *remoteDebuggingPort))
+	pt, err := devt.Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devt.Close(ctx, pt)
+
+	conn, err := rpcc.DialContext(ctx, pt.WebSocketDebuggerURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// The versioned bindings share rpcc with package cdp.
+	c := chrome140.NewClient(conn)
+	eval, err := c.Runtime.Evaluate(ctx, runtime140.NewEvaluateArgs("1 + 1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(eval.Result.Value); got != "2" {
+		t.Errorf("Evaluate(1 + 1): got %s, want 2", got)
+	}
+}
+
 var (
 	testBrowser         = flag.Bool("browser", false, "Run browser tests")
 	remoteDebuggingPort = flag.Int("rdp", 9222, "Remote debugging port (for browser tests)")
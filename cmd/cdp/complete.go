@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mafredri/cdp/registry"
+)
+
+// splitArgs splits a line into arguments like a shell: quotes (” and
+// "") and backslash escapes group words. JSON objects ({...}) are kept
+// as one argument.
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	inArg := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		case c == '{' && !inArg:
+			end, err := objectEnd(line[i:])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, line[i:i+end])
+			i += end - 1
+		case c == '\'':
+			end := strings.IndexByte(line[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated quote (')")
+			}
+			cur.WriteString(line[i+1 : i+1+end])
+			i += end + 1
+			inArg = true
+		case c == '"':
+			i++
+			for ; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' && i+1 < len(line) {
+					i++
+				}
+				cur.WriteByte(line[i])
+			}
+			if i == len(line) {
+				return nil, errors.New("unterminated quote (\")")
+			}
+			inArg = true
+		case c == '\\' && i+1 < len(line):
+			i++
+			cur.WriteByte(line[i])
+			inArg = true
+		default:
+			cur.WriteByte(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args, nil
+}
+
+// objectEnd returns the length of the JSON object at the start of s.
+func objectEnd(s string) (int, error) {
+	depth := 0
+	inString := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			depth--
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+	}
+	return 0, errors.New("unterminated JSON object")
+}
+
+// complete returns the completions for the last word of line: REPL
+// commands, methods (Domain.method), parameters (--param), events
+// (.events) and targets (.attach).
+func (r *repl) complete(line string) (start int, candidates []string) {
+	start = strings.LastIndexAny(line, " \t") + 1
+	word := line[start:]
+	prev := strings.Fields(line[:start])
+
+	var words []string
+	switch {
+	case len(prev) == 0 && strings.HasPrefix(word, "."):
+		for _, c := range replCommands {
+			words = append(words, c.name)
+		}
+	case len(prev) == 0:
+		words = completeNames(word, methodNames())
+	case prev[0] == ".events":
+		words = completeNames(word, append(eventNames(), "off"))
+	case prev[0] == ".attach" && len(prev) == 1:
+		for _, t := range r.targets {
+			words = append(words, string(t.TargetID))
+		}
+	case strings.HasPrefix(word, "-") || word == "":
+		domain, method, ok := strings.Cut(prev[0], ".")
+		rest := prev[1:]
+		if !ok && len(rest) > 0 {
+			method, rest = rest[0], rest[1:]
+		}
+		d, _ := lookupDomain(domain)
+		m, ok := lookupMethod(d, method)
+		if !ok {
+			return 0, nil
+		}
+		used := make(map[string]bool)
+		for _, a := range rest {
+			used[strings.TrimLeft(a, "-")] = true
+		}
+		for _, p := range params(m.Args) {
+			if !used[p.name] {
+				words = append(words, "--"+p.name)
+			}
+		}
+	}
+	return start, matchPrefix(words, word)
+}
+
+// completeNames returns the domains ("Domain.") matching word, or the
+// names within the domain once it is complete, so that Tab completes
+// one level at a time.
+func completeNames(word string, names []string) []string {
+	if !strings.Contains(word, ".") {
+		seen := make(map[string]bool)
+		var words []string
+		for _, name := range names {
+			d, _, ok := strings.Cut(name, ".")
+			if ok {
+				d += "."
+			}
+			if !seen[d] {
+				seen[d] = true
+				words = append(words, d)
+			}
+		}
+		return words
+	}
+	return names
+}
+
+// matchPrefix returns the words starting with prefix, ignoring case.
+func matchPrefix(words []string, prefix string) []string {
+	var matched []string
+	for _, w := range words {
+		if len(w) >= len(prefix) && strings.EqualFold(w[:len(prefix)], prefix) {
+			matched = append(matched, w)
+		}
+	}
+	return matched
+}
+
+func methodNames() []string {
+	var names []string
+	for _, m := range registry.Methods() {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func eventNames() []string {
+	var names []string
+	for _, e := range registry.Events() {
+		names = append(names, e.Name)
+	}
+	return names
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/target"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    []string
+		wantErr string
+	}{
+		{name: "Empty", line: "  "},
+		{name: "Words", line: " Page.navigate\t--url  x ", want: []string{"Page.navigate", "--url", "x"}},
+		{name: "SingleQuote", line: `--expression 'a "b" c'`, want: []string{"--expression", `a "b" c`}},
+		{name: "DoubleQuote", line: `--expression "a \"b\" 'c'"`, want: []string{"--expression", `a "b" 'c'`}},
+		{name: "QuoteInWord", line: `--url=a' 'b`, want: []string{"--url=a b"}},
+		{name: "Backslash", line: `a\ b`, want: []string{"a b"}},
+		{name: "Object", line: `m {"a": {"b": "} "}} x`, want: []string{"m", `{"a": {"b": "} "}}`, "x"}},
+		{name: "ObjectArray", line: `{"a": [1, {"b": 2}]}`, want: []string{`{"a": [1, {"b": 2}]}`}},
+		{name: "BraceInWord", line: `--expression a{b`, want: []string{"--expression", "a{b"}},
+		{name: "UnterminatedSingle", line: `'a`, wantErr: "unterminated quote (')"},
+		{name: "UnterminatedDouble", line: `"a`, wantErr: `unterminated quote (")`},
+		{name: "UnterminatedObject", line: `{"a": 1`, wantErr: "unterminated JSON object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitArgs(tt.line)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("splitArgs() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	r := &repl{targets: []target.Info{{TargetID: "A1"}, {TargetID: "B2"}}}
+	tests := []struct {
+		name      string
+		line      string
+		wantStart int
+		want      []string
+	}{
+		{name: "Commands", line: ".re", want: []string{".record", ".replay"}},
+		{name: "Domain", line: "pag", want: []string{"Page."}},
+		{name: "Method", line: "Page.navigateTo", want: []string{"Page.navigateToHistoryEntry"}},
+		{name: "MethodIgnoreCase", line: "page.NAVIGATETO", want: []string{"Page.navigateToHistoryEntry"}},
+		{name: "Params", line: "Page.navigate --url x --", wantStart: 22, want: []string{"--referrer", "--transitionType", "--frameId", "--referrerPolicy"}},
+		{name: "ParamPrefix", line: "Page.navigate --tr", wantStart: 14, want: []string{"--transitionType"}},
+		{name: "ParamsSplitMethod", line: "Page navigateToHistoryEntry ", wantStart: 28, want: []string{"--entryId"}},
+		{name: "UnknownMethod", line: "Page.nope --"},
+		{name: "Events", line: ".events Page.frameNav", wantStart: 8, want: []string{"Page.frameNavigated"}},
+		{name: "EventsOff", line: ".events of", wantStart: 8, want: []string{"off"}},
+		{name: "Attach", line: ".attach b", wantStart: 8, want: []string{"B2"}},
+		{name: "AttachOnce", line: ".attach A1 "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, got := r.complete(tt.line)
+			if start != tt.wantStart {
+				t.Errorf("complete() start = %d, want %d", start, tt.wantStart)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("complete() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
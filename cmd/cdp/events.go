@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/mafredri/cdp/registry"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// eventMsg is an event notification as printed by tail.
+type eventMsg struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// matchEvents returns the events matching any of the patterns (see
+// path.Match), e.g. "Network.*" or "Page.loadEventFired".
+func matchEvents(patterns []string) ([]*registry.Event, error) {
+	var matched []*registry.Event
+	for _, e := range registry.Events() {
+		for _, p := range patterns {
+			ok, err := path.Match(p, e.Name)
+			if err != nil {
+				return nil, fmt.Errorf("bad pattern %q: %v", p, err)
+			}
+			if ok {
+				matched = append(matched, e)
+				break
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no events matching %s", strings.Join(patterns, " "))
+	}
+	return matched, nil
+}
+
+// enableDomains calls <Domain>.enable for the domains of events, when
+// the domain has one.
+func enableDomains(ctx context.Context, conn *rpcc.Conn, events []*registry.Event) error {
+	seen := make(map[string]bool)
+	for _, e := range events {
+		if seen[e.Domain] {
+			continue
+		}
+		seen[e.Domain] = true
+		m, ok := registry.LookupMethod(e.Domain + ".enable")
+		if !ok {
+			continue
+		}
+		args, err := m.DecodeArgs(nil)
+		if err != nil {
+			return err
+		}
+		if err = rpcc.Invoke(ctx, m.Name, args, nil, conn); err != nil {
+			return fmt.Errorf("%s: %v", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// subscribe creates a stream for each of the events on conn. The
+// notifications are received on msgs and the first stream error on errc,
+// until ctx is done.
+func subscribe(ctx context.Context, conn *rpcc.Conn, events []*registry.Event) (msgs <-chan eventMsg, errc <-chan error, err error) {
+	var streams []rpcc.Stream
+	for _, e := range events {
+		s, err := rpcc.NewStream(ctx, e.Name, conn)
+		if err != nil {
+			for _, s := range streams {
+				s.Close()
+			}
+			return nil, nil, err
+		}
+		streams = append(streams, s)
+	}
+
+	msgc := make(chan eventMsg)
+	errs := make(chan error, len(streams))
+	for i, s := range streams {
+		go func(name string, s rpcc.Stream) {
+			defer s.Close()
+			for {
+				var params json.RawMessage
+				if err := s.RecvMsg(&params); err != nil {
+					errs <- err
+					return
+				}
+				select {
+				case msgc <- eventMsg{Method: name, Params: params}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(events[i].Name, s)
+	}
+	return msgc, errs, nil
+}
+
+// tail prints the events matching the patterns in args as JSON lines,
+// until interrupted or the count (-n) is reached.
+func (c *cli) tail(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("events tail", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	n := fs.Int("n", 0, "Exit after `count` events (default unlimited)")
+	enable := fs.Bool("enable", true, "Enable the domains of the events (e.g. Network.enable)")
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprintln(c.stderr, "Usage: cdp events tail [-n count] [-enable=false] <event pattern>...")
+		return errUsage
+	}
+	events, err := matchEvents(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, c.timeout)
+	defer dialCancel()
+	conn, err := c.dial(dialCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The streams are created before enabling the domains so that no
+	// events are missed.
+	msgs, errc, err := subscribe(ctx, conn, events)
+	if err != nil {
+		return err
+	}
+
+	if *enable {
+		if err = enableDomains(dialCtx, conn, events); err != nil {
+			return err
+		}
+	}
+
+	enc := json.NewEncoder(c.stdout)
+	for count := 0; *n == 0 || count < *n; count++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errc:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		case m := <-msgs:
+			if err := enc.Encode(m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mafredri/cdp/registry"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// param is a parameter (struct field) of the method arguments.
+type param struct {
+	name     string // Protocol name, e.g. "transitionType".
+	typ      reflect.Type
+	optional bool
+}
+
+// params returns the parameters of the arguments type t.
+func params(t reflect.Type) []param {
+	if t == nil {
+		return nil
+	}
+	var ps []param
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		typ := f.Type
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		ps = append(ps, param{name: name, typ: typ, optional: opts == "omitempty"})
+	}
+	return ps
+}
+
+// matchParam returns the parameter matching the flag name, ignoring case
+// and dashes (e.g. --transition-type).
+func matchParam(ps []param, name string) (param, bool) {
+	name = strings.ReplaceAll(name, "-", "")
+	for _, p := range ps {
+		if strings.EqualFold(p.name, name) {
+			return p, true
+		}
+	}
+	return param{}, false
+}
+
+var bytesType = reflect.TypeOf([]byte(nil))
+
+// paramValue returns the JSON encoding of the flag value s for p.
+// Strings (and base64 encoded byte arrays) are passed as is, other
+// values as JSON.
+func paramValue(p param, s string) (json.RawMessage, error) {
+	if p.typ.Kind() == reflect.String || p.typ == bytesType {
+		return json.Marshal(s)
+	}
+	if !json.Valid([]byte(s)) {
+		return nil, fmt.Errorf("--%s: invalid JSON value for %v: %s", p.name, p.typ, s)
+	}
+	return json.RawMessage(s), nil
+}
+
+// parseArgs returns the JSON encoded arguments (params) for the
+// command-line arguments, flags (--param value) and JSON objects.
+func parseArgs(m *registry.Method, args []string) (json.RawMessage, error) {
+	ps := params(m.Args)
+	values := make(map[string]json.RawMessage)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if strings.HasPrefix(arg, "{") {
+			var obj map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(arg), &obj); err != nil {
+				return nil, fmt.Errorf("invalid JSON object %s: %v", arg, err)
+			}
+			for k, v := range obj {
+				values[k] = v
+			}
+			continue
+		}
+		if !strings.HasPrefix(arg, "-") {
+			return nil, fmt.Errorf("unexpected argument %q, want --param value", arg)
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		p, ok := matchParam(ps, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter --%s for %s", name, m.Name)
+		}
+		if !hasValue {
+			switch {
+			case p.typ.Kind() == reflect.Bool && (i+1 == len(args) || (args[i+1] != "true" && args[i+1] != "false")):
+				value = "true" // E.g. --returnByValue.
+			case i+1 < len(args):
+				i++
+				value = args[i]
+			default:
+				return nil, fmt.Errorf("--%s: missing value", p.name)
+			}
+		}
+		v, err := paramValue(p, value)
+		if err != nil {
+			return nil, err
+		}
+		values[p.name] = v
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(values)
+}
+
+// decodeArgs returns the arguments for m (e.g. *page.NavigateArgs) from
+// the command-line arguments, nil if m has no arguments.
+func decodeArgs(m *registry.Method, args []string) (interface{}, error) {
+	data, err := parseArgs(m, args)
+	if err != nil {
+		return nil, err
+	}
+	if m.NewArgs == nil && data != nil {
+		return nil, fmt.Errorf("%s takes no parameters", m.Name)
+	}
+	// Decoding verifies the types, the arguments are validated by the
+	// connection (rpcc.WithValidation) before sending.
+	return m.DecodeArgs(data)
+}
+
+// invoke calls the method m with the command-line arguments and prints
+// the reply.
+func (c *cli) invoke(ctx context.Context, m *registry.Method, args []string) error {
+	for _, a := range args {
+		if a == "-h" || a == "-help" || a == "--help" {
+			c.methodHelp(m)
+			return nil
+		}
+	}
+	v, err := decodeArgs(m, args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var reply json.RawMessage
+	if err = rpcc.Invoke(ctx, m.Name, v, &reply, conn); err != nil {
+		return err
+	}
+	if len(reply) == 0 {
+		reply = json.RawMessage("{}")
+	}
+	return c.print(reply)
+}
+
+// domainHelp prints the methods and events in domain.
+func (c *cli) domainHelp(domain string) {
+	fmt.Fprintf(c.stdout, "Methods (cdp %s <method>):\n", strings.ToLower(domain))
+	for _, m := range registry.Methods() {
+		if m.Domain == domain {
+			fmt.Fprintf(c.stdout, "  %-40s %s\n", strings.TrimPrefix(m.Name, domain+"."), summary(m.Description, m.Experimental, m.Deprecated))
+		}
+	}
+	fmt.Fprintf(c.stdout, "\nEvents (cdp events tail %s.<event>):\n", domain)
+	for _, e := range registry.Events() {
+		if e.Domain == domain {
+			fmt.Fprintf(c.stdout, "  %-40s %s\n", strings.TrimPrefix(e.Name, domain+"."), summary(e.Description, e.Experimental, e.Deprecated))
+		}
+	}
+}
+
+// methodHelp prints the description and parameters of m.
+func (c *cli) methodHelp(m *registry.Method) {
+	fmt.Fprintf(c.stdout, "%s%s\n", m.Name, flags(m.Experimental, m.Deprecated))
+	if m.Description != "" {
+		fmt.Fprintf(c.stdout, "\n%s\n", m.Description)
+	}
+	ps := params(m.Args)
+	if len(ps) == 0 {
+		return
+	}
+	fmt.Fprintln(c.stdout, "\nParameters:")
+	for _, p := range ps {
+		opt := ""
+		if p.optional {
+			opt = " (optional)"
+		}
+		fmt.Fprintf(c.stdout, "  --%-30s %v%s\n", p.name, p.typ, opt)
+	}
+}
+
+// summary returns the first line of the description, and the flags.
+func summary(desc string, experimental, deprecated bool) string {
+	desc, _, _ = strings.Cut(desc, "\n")
+	return desc + flags(experimental, deprecated)
+}
+
+func flags(experimental, deprecated bool) string {
+	var s string
+	if experimental {
+		s += " [experimental]"
+	}
+	if deprecated {
+		s += " [deprecated]"
+	}
+	return s
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mafredri/cdp/registry"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "NoArgs",
+			method: "Runtime.evaluate",
+		},
+		{
+			name:   "FlagValue",
+			method: "Runtime.evaluate",
+			args:   []string{"--expression", "1+1"},
+			want:   `{"expression":"1+1"}`,
+		},
+		{
+			name:   "FlagEquals",
+			method: "Runtime.evaluate",
+			args:   []string{"--expression=a=b"},
+			want:   `{"expression":"a=b"}`,
+		},
+		{
+			name:   "BoolFlag",
+			method: "Runtime.evaluate",
+			args:   []string{"--returnByValue", "--expression", "1"},
+			want:   `{"expression":"1","returnByValue":true}`,
+		},
+		{
+			name:   "BoolFlagValue",
+			method: "Runtime.evaluate",
+			args:   []string{"--returnByValue", "false"},
+			want:   `{"returnByValue":false}`,
+		},
+		{
+			name:   "NumberAndDashes",
+			method: "Runtime.evaluate",
+			args:   []string{"--context-id", "3"},
+			want:   `{"contextId":3}`,
+		},
+		{
+			name:   "IgnoreCase",
+			method: "Runtime.evaluate",
+			args:   []string{"-EXPRESSION", "x"},
+			want:   `{"expression":"x"}`,
+		},
+		{
+			name:   "JSONObject",
+			method: "Runtime.evaluate",
+			args:   []string{`{"expression":"1","silent":true}`, "--expression", "2"},
+			want:   `{"expression":"2","silent":true}`,
+		},
+		{
+			name:    "InvalidJSONValue",
+			method:  "Runtime.evaluate",
+			args:    []string{"--contextId", "x"},
+			wantErr: "--contextId: invalid JSON value for runtime.ExecutionContextID: x",
+		},
+		{
+			name:    "InvalidJSONObject",
+			method:  "Runtime.evaluate",
+			args:    []string{`{"expression"}`},
+			wantErr: `invalid JSON object {"expression"}: invalid character '}' after object key`,
+		},
+		{
+			name:    "UnknownParam",
+			method:  "Runtime.evaluate",
+			args:    []string{"--nope", "1"},
+			wantErr: "unknown parameter --nope for Runtime.evaluate",
+		},
+		{
+			name:    "MissingValue",
+			method:  "Runtime.evaluate",
+			args:    []string{"--expression"},
+			wantErr: "--expression: missing value",
+		},
+		{
+			name:    "Positional",
+			method:  "Runtime.evaluate",
+			args:    []string{"1+1"},
+			wantErr: `unexpected argument "1+1", want --param value`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := registry.LookupMethod(tt.method)
+			if !ok {
+				t.Fatalf("LookupMethod(%q): not found", tt.method)
+			}
+			got, err := parseArgs(m, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parseArgs() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("parseArgs() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeArgs(t *testing.T) {
+	m, _ := registry.LookupMethod("Runtime.enable")
+	want := "Runtime.enable takes no parameters"
+	if _, err := decodeArgs(m, []string{`{"x":1}`}); err == nil || err.Error() != want {
+		t.Errorf("decodeArgs() error = %v, want %s", err, want)
+	}
+	if v, err := decodeArgs(m, nil); err != nil || v != nil {
+		t.Errorf("decodeArgs() = %v, %v, want nil, nil", v, err)
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// errInterrupt is returned by readLine on Ctrl-C.
+var errInterrupt = errors.New("interrupt")
+
+// completeFunc returns the candidates for completing the word ending
+// at the end of line, and where the word starts.
+type completeFunc func(line string) (start int, candidates []string)
+
+// lineReader reads lines from the terminal with history and completion.
+// When the input is not a terminal (e.g. a pipe) lines are read as is.
+type lineReader struct {
+	in       *os.File
+	r        *bufio.Reader
+	out      io.Writer
+	complete completeFunc
+	history  []string
+
+	mu      sync.Mutex // Protects the fields below and writes to out.
+	prompt  string
+	line    []rune
+	pos     int  // Cursor position in line.
+	editing bool // A line is being edited, see printAbove.
+}
+
+func newLineReader(in *os.File, out io.Writer, complete completeFunc) *lineReader {
+	return &lineReader{in: in, r: bufio.NewReader(in), out: out, complete: complete}
+}
+
+// readLine reads a line, the terminal is in raw mode only while reading
+// so that interrupts are delivered (as signals) while commands run.
+func (lr *lineReader) readLine(prompt string) (string, error) {
+	restore, err := makeRaw(lr.in)
+	if err != nil {
+		// Not a terminal (or not supported), no prompt for pipes.
+		if fi, err := lr.in.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+			fmt.Fprint(lr.out, prompt)
+		}
+		line, err := lr.r.ReadString('\n')
+		if err == io.EOF && line != "" {
+			err = nil
+		}
+		return strings.TrimRight(line, "\r\n"), err
+	}
+	defer restore()
+	return lr.edit(prompt)
+}
+
+// edit reads and edits a line, key by key, the terminal must be in raw
+// mode.
+func (lr *lineReader) edit(prompt string) (string, error) {
+	lr.mu.Lock()
+	lr.prompt, lr.line, lr.pos, lr.editing = prompt, nil, 0, true
+	lr.redraw()
+	lr.mu.Unlock()
+	defer func() {
+		lr.mu.Lock()
+		lr.editing = false
+		lr.mu.Unlock()
+	}()
+
+	hist := len(lr.history) // Index in history, len is the new line.
+	for {
+		c, _, err := lr.r.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		lr.mu.Lock()
+		switch c {
+		case '\r', '\n':
+			line := string(lr.line)
+			fmt.Fprint(lr.out, "\r\n")
+			lr.mu.Unlock()
+			if strings.TrimSpace(line) != "" && (len(lr.history) == 0 || lr.history[len(lr.history)-1] != line) {
+				lr.history = append(lr.history, line)
+			}
+			return line, nil
+		case 3: // Ctrl-C.
+			fmt.Fprint(lr.out, "^C\r\n")
+			lr.mu.Unlock()
+			return "", errInterrupt
+		case 4: // Ctrl-D.
+			if len(lr.line) == 0 {
+				fmt.Fprint(lr.out, "\r\n")
+				lr.mu.Unlock()
+				return "", io.EOF
+			}
+		case 127, 8: // Backspace.
+			if lr.pos > 0 {
+				lr.line = append(lr.line[:lr.pos-1], lr.line[lr.pos:]...)
+				lr.pos--
+			}
+		case 1: // Ctrl-A.
+			lr.pos = 0
+		case 5: // Ctrl-E.
+			lr.pos = len(lr.line)
+		case 21: // Ctrl-U.
+			lr.line, lr.pos = lr.line[lr.pos:], 0
+		case '\t':
+			lr.tab()
+		case 27: // Escape sequence, e.g. arrow keys.
+			lr.mu.Unlock()
+			seq := lr.readEscape()
+			lr.mu.Lock()
+			switch seq {
+			case "[A": // Up.
+				if hist > 0 {
+					hist--
+					lr.line = []rune(lr.history[hist])
+					lr.pos = len(lr.line)
+				}
+			case "[B": // Down.
+				if hist < len(lr.history) {
+					hist++
+					lr.line = nil
+					if hist < len(lr.history) {
+						lr.line = []rune(lr.history[hist])
+					}
+					lr.pos = len(lr.line)
+				}
+			case "[C": // Right.
+				if lr.pos < len(lr.line) {
+					lr.pos++
+				}
+			case "[D": // Left.
+				if lr.pos > 0 {
+					lr.pos--
+				}
+			}
+		default:
+			if unicode.IsPrint(c) {
+				lr.insert(string(c))
+			}
+		}
+		lr.redraw()
+		lr.mu.Unlock()
+	}
+}
+
+// readEscape reads the rest of an escape sequence (CSI), e.g. "[A".
+func (lr *lineReader) readEscape() string {
+	var seq []rune
+	for {
+		c, _, err := lr.r.ReadRune()
+		if err != nil {
+			return string(seq)
+		}
+		seq = append(seq, c)
+		if len(seq) > 1 && (unicode.IsLetter(c) || c == '~') {
+			return string(seq)
+		}
+		if len(seq) == 1 && c != '[' && c != 'O' {
+			return string(seq)
+		}
+	}
+}
+
+func (lr *lineReader) insert(s string) {
+	r := []rune(s)
+	line := make([]rune, 0, len(lr.line)+len(r))
+	line = append(line, lr.line[:lr.pos]...)
+	line = append(line, r...)
+	lr.line = append(line, lr.line[lr.pos:]...)
+	lr.pos += len(r)
+}
+
+// tab completes the word before the cursor, the candidates are listed
+// when there is no common prefix to insert.
+func (lr *lineReader) tab() {
+	if lr.complete == nil {
+		return
+	}
+	before := string(lr.line[:lr.pos])
+	start, cands := lr.complete(before)
+	if len(cands) == 0 {
+		return
+	}
+	word := before[start:]
+	prefix := commonPrefix(cands)
+	if len(cands) == 1 && !strings.HasSuffix(prefix, ".") {
+		prefix += " "
+	}
+	if utf8.RuneCountInString(prefix) > utf8.RuneCountInString(word) {
+		// Replace the word, the candidates may differ in case.
+		n := utf8.RuneCountInString(word)
+		lr.line = append(lr.line[:lr.pos-n], lr.line[lr.pos:]...)
+		lr.pos -= n
+		lr.insert(prefix)
+		return
+	}
+	fmt.Fprint(lr.out, "\r\n")
+	fmt.Fprint(lr.out, strings.Join(columns(cands, 80), "\r\n"))
+	fmt.Fprint(lr.out, "\r\n")
+}
+
+// redraw writes the prompt and the line, and moves the cursor into
+// position.
+func (lr *lineReader) redraw() {
+	fmt.Fprintf(lr.out, "\r\x1b[K%s%s", lr.prompt, string(lr.line))
+	if n := len(lr.line) - lr.pos; n > 0 {
+		fmt.Fprintf(lr.out, "\x1b[%dD", n)
+	}
+}
+
+// printAbove writes s (e.g. an event) above the line being edited.
+func (lr *lineReader) printAbove(s string) {
+	lr.mu.Lock()
+	defer lr.mu.Unlock()
+	if !lr.editing {
+		fmt.Fprint(lr.out, s)
+		return
+	}
+	// The terminal is in raw mode, newlines do not return the cursor.
+	fmt.Fprintf(lr.out, "\r\x1b[K%s", strings.ReplaceAll(s, "\n", "\r\n"))
+	lr.redraw()
+}
+
+// commonPrefix returns the longest common prefix of the candidates,
+// ignoring case, in the case of the first candidate.
+func commonPrefix(cands []string) string {
+	prefix := []rune(cands[0])
+	for _, c := range cands[1:] {
+		r := []rune(c)
+		n := 0
+		for n < len(prefix) && n < len(r) && unicode.ToLower(prefix[n]) == unicode.ToLower(r[n]) {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return string(prefix)
+}
+
+// columns formats the words in columns that fit in width.
+func columns(words []string, width int) []string {
+	w := 0
+	for _, s := range words {
+		if len(s) > w {
+			w = len(s)
+		}
+	}
+	w += 2
+	n := width / w
+	if n < 1 {
+		n = 1
+	}
+	var lines []string
+	for i := 0; i < len(words); i += n {
+		var b strings.Builder
+		for j := i; j < i+n && j < len(words); j++ {
+			fmt.Fprintf(&b, "%-*s", w, words[j])
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	return lines
+}
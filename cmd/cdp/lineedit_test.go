@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLineReaderEdit(t *testing.T) {
+	complete := func(line string) (int, []string) {
+		start := strings.LastIndexByte(line, ' ') + 1
+		return start, matchPrefix([]string{"Page.", "Page.enable", "Page.reload", "Runtime."}, line[start:])
+	}
+	tests := []struct {
+		name    string
+		history []string
+		keys    string
+		want    string
+		wantErr error
+	}{
+		{name: "Enter", keys: "abc\r", want: "abc"},
+		{name: "Backspace", keys: "abd\x7fc\r", want: "abc"},
+		{name: "BackspaceAtStart", keys: "\x01\x7fabc\r", want: "abc"},
+		{name: "Home", keys: "bc\x01a\r", want: "abc"},
+		{name: "End", keys: "ac\x01\x05d\r", want: "acd"},
+		{name: "KillLine", keys: "xyabc\x1b[D\x1b[D\x1b[D\x15\r", want: "abc"},
+		{name: "Left", keys: "ac\x1b[Db\r", want: "abc"},
+		{name: "Right", keys: "ac\x01\x1b[C\x1b[Cd\r", want: "acd"},
+		{name: "Unicode", keys: "åäx\x7fö\r", want: "åäö"},
+		{name: "IgnoreControl", keys: "a\x02\x1b[3~b\r", want: "ab"},
+		{name: "HistoryUp", history: []string{"one", "two"}, keys: "\x1b[A\x1b[A\r", want: "one"},
+		{name: "HistoryDown", history: []string{"one", "two"}, keys: "x\x1b[A\x1b[A\x1b[B\x1b[B\r", want: ""},
+		{name: "HistoryEdit", history: []string{"one"}, keys: "\x1b[A!\r", want: "one!"},
+		{name: "TabDomain", keys: "pa\t\r", want: "Page."},
+		{name: "TabUnique", keys: "Page.rel\t--\r", want: "Page.reload --"},
+		{name: "TabAmbiguous", keys: "Page.\t\r", want: "Page."},
+		{name: "TabMidLine", keys: " x\x01r\t\r", want: "Runtime. x"},
+		{name: "Interrupt", keys: "abc\x03", wantErr: errInterrupt},
+		{name: "EOF", keys: "\x04", wantErr: io.EOF},
+		{name: "EOFNotEmpty", keys: "a\x04b\r", want: "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := &lineReader{
+				r:        bufio.NewReader(strings.NewReader(tt.keys)),
+				out:      io.Discard,
+				complete: complete,
+				history:  tt.history,
+			}
+			got, err := lr.edit("> ")
+			if err != tt.wantErr {
+				t.Fatalf("edit() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("edit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineReaderHistory(t *testing.T) {
+	lr := &lineReader{
+		r:   bufio.NewReader(strings.NewReader("a\r\ra\rb\r")),
+		out: io.Discard,
+	}
+	for i := 0; i < 4; i++ {
+		if _, err := lr.edit("> "); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Empty lines and repeats are not added.
+	if want := []string{"a", "b"}; !reflect.DeepEqual(lr.history, want) {
+		t.Errorf("history = %q, want %q", lr.history, want)
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		cands []string
+		want  string
+	}{
+		{[]string{"Page.enable"}, "Page.enable"},
+		{[]string{"Page.enable", "Page.reload"}, "Page."},
+		{[]string{"page.", "Page."}, "page."},
+		{[]string{"abc", "xyz"}, ""},
+		{[]string{"åäö", "åäx"}, "åä"},
+	}
+	for _, tt := range tests {
+		if got := commonPrefix(tt.cands); got != tt.want {
+			t.Errorf("commonPrefix(%q) = %q, want %q", tt.cands, got, tt.want)
+		}
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		words []string
+		width int
+		want  []string
+	}{
+		{[]string{"a", "bb", "c"}, 80, []string{"a   bb  c"}},
+		{[]string{"a", "bb", "c"}, 8, []string{"a   bb", "c"}},
+		{[]string{"long"}, 2, []string{"long"}},
+		{nil, 80, nil},
+	}
+	for _, tt := range tests {
+		if got := columns(tt.words, tt.width); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("columns(%q, %d) = %q, want %q", tt.words, tt.width, got, tt.want)
+		}
+	}
+}
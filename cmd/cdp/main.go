@@ -0,0 +1,282 @@
+// The cdp command invokes the methods (commands) and listens to the
+// events of the Chrome DevTools Protocol from the command line. The
+// domains, methods, events and their arguments are those of the bindings
+// (see package registry), the output is JSON.
+//
+// Usage:
+//
+//	cdp [flags] <domain> <method> [--param value]... ['{"param": value}']
+//	cdp [flags] <domain>
+//	cdp [flags] events tail [-n count] [-enable=false] <event pattern>...
+//	cdp [flags] repl [-record file] [-replay file]
+//	cdp [flags] proxy [-listen address] [-record file] [-filter pattern]...
+//	cdp [flags] targets list
+//	cdp [flags] version
+//
+// For example:
+//
+//	cdp page navigate --url https://www.example.com
+//	cdp runtime evaluate --expression 1+1 --returnByValue
+//	cdp events tail 'Network.*' Page.loadEventFired
+//
+// Parameters are set by their protocol name (e.g. --transitionType, or
+// --transition-type), strings are passed as is and other values as JSON
+// (e.g. --clip '{"x": 0, "y": 0, "width": 10, "height": 10, "scale": 1}').
+// Byte arrays are passed as base64 strings.
+//
+// The target is the first page, or the first target matching -target (by
+// ID, URL or title). With -browser the browser target is used, e.g. for
+// the Target and Browser domains.
+//
+// The repl command starts an interactive session with completion (Tab)
+// of methods, parameters and events. Events are printed in the background
+// (.events) and targets are switched via sessions (.targets, .attach).
+// Sessions are recorded (-record, .record) to transcripts, JSON lines of
+// calls, replies and events, that are replayed by invoking the calls
+// (-replay, .replay). See .help for the commands.
+//
+// The proxy command shows what another client (e.g. Puppeteer or the
+// DevTools frontend) sends to the browser: the client connects to the
+// proxy (-listen) instead of the -url endpoint. The websocket URLs in the
+// /json responses are rewritten to the proxy, the messages are forwarded
+// and logged (stderr) or recorded to a transcript (-record) for replay.
+// Only the methods and events matching -filter (e.g. 'Network.*') are
+// logged and recorded.
+//
+// The exit status is 1 on error, 2 on usage error.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/registry"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+const usage = `Usage:
+  cdp [flags] <domain> <method> [--param value]... ['{"param": value}']
+  cdp [flags] <domain>
+  cdp [flags] events tail [-n count] [-enable=false] <event pattern>...
+  cdp [flags] repl [-record file] [-replay file]
+  cdp [flags] proxy [-listen address] [-record file] [-filter pattern]...
+  cdp [flags] targets list
+  cdp [flags] version
+
+Flags:
+`
+
+// errUsage is returned for invalid command-line usage, the usage has
+// already been printed.
+var errUsage = errors.New("usage error")
+
+// cli holds the global flags and the output.
+type cli struct {
+	url     string
+	target  string
+	browser bool
+	timeout time.Duration
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func main() {
+	c := &cli{stdout: os.Stdout, stderr: os.Stderr}
+
+	fs := flag.NewFlagSet("cdp", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+		fmt.Fprintf(fs.Output(), "\nDomains:\n  %s\n", strings.Join(domains(), " "))
+	}
+	fs.StringVar(&c.url, "url", "http://127.0.0.1:9222", "DevTools endpoint")
+	fs.StringVar(&c.target, "target", "", "Target ID, or part of the URL or title (default first page)")
+	fs.BoolVar(&c.browser, "browser", false, "Use the browser target")
+	fs.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout for commands")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	if fs.Arg(0) != "repl" { // The REPL handles interrupts per command.
+		var stop context.CancelFunc
+		ctx, stop = signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+	}
+
+	err := c.run(ctx, fs.Args())
+	switch {
+	case err == nil:
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case errors.Is(err, flag.ErrHelp):
+	default:
+		fmt.Fprintln(c.stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func (c *cli) run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usage[:strings.Index(usage, "\nFlags:")])
+		fmt.Fprintf(c.stderr, "\nDomains:\n  %s\n", strings.Join(domains(), " "))
+		return errUsage
+	}
+
+	switch args[0] {
+	case "events":
+		if len(args) < 2 || args[1] != "tail" {
+			fmt.Fprintln(c.stderr, "Usage: cdp events tail [-n count] [-enable=false] <event pattern>...")
+			return errUsage
+		}
+		return c.tail(ctx, args[2:])
+	case "repl":
+		return c.repl(ctx, args[1:])
+	case "proxy":
+		return c.proxy(ctx, args[1:])
+	case "targets":
+		if len(args) != 2 || args[1] != "list" {
+			fmt.Fprintln(c.stderr, "Usage: cdp targets list")
+			return errUsage
+		}
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+		targets, err := devtool.New(c.url).List(ctx)
+		if err != nil {
+			return err
+		}
+		return c.print(targets)
+	case "version":
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+		v, err := devtool.New(c.url).Version(ctx)
+		if err != nil {
+			return err
+		}
+		return c.print(v)
+	}
+
+	domain, ok := lookupDomain(args[0])
+	if !ok {
+		fmt.Fprintf(c.stderr, "Unknown domain %q, available domains:\n  %s\n", args[0], strings.Join(domains(), " "))
+		return errUsage
+	}
+	if len(args) == 1 {
+		c.domainHelp(domain)
+		return nil
+	}
+	m, ok := lookupMethod(domain, args[1])
+	if !ok {
+		fmt.Fprintf(c.stderr, "Unknown method %q in domain %s.\n\n", args[1], domain)
+		c.domainHelp(domain)
+		return errUsage
+	}
+	return c.invoke(ctx, m, args[2:])
+}
+
+// dial connects to the selected target.
+func (c *cli) dial(ctx context.Context) (*rpcc.Conn, error) {
+	devt := devtool.New(c.url)
+
+	var wsURL string
+	if c.browser {
+		v, err := devt.Version(ctx)
+		if err != nil {
+			return nil, err
+		}
+		wsURL = v.WebSocketDebuggerURL
+	} else {
+		t, err := c.findTarget(ctx, devt)
+		if err != nil {
+			return nil, err
+		}
+		wsURL = t.WebSocketDebuggerURL
+	}
+	if wsURL == "" {
+		return nil, errors.New("target has no websocket URL, is a debugger already attached?")
+	}
+	return rpcc.DialContext(ctx, wsURL, rpcc.WithValidation())
+}
+
+// findTarget returns the target matching c.target or the first page
+// (or the first target, e.g. in Node.js).
+func (c *cli) findTarget(ctx context.Context, devt *devtool.DevTools) (*devtool.Target, error) {
+	list, err := devt.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range list {
+		if c.target == "" && t.Type == devtool.Page {
+			return t, nil
+		}
+		if c.target != "" && (t.ID == c.target || strings.Contains(t.URL, c.target) || strings.Contains(t.Title, c.target)) {
+			return t, nil
+		}
+	}
+	if c.target == "" && len(list) > 0 {
+		return list[0], nil
+	}
+	if c.target != "" {
+		return nil, fmt.Errorf("no target matching %q", c.target)
+	}
+	return nil, errors.New("no targets")
+}
+
+// print writes v as indented JSON.
+func (c *cli) print(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.stdout, "%s\n", b)
+	return err
+}
+
+// domains returns the domain names in the registry.
+func domains() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, m := range registry.Methods() {
+		if !seen[m.Domain] {
+			seen[m.Domain] = true
+			names = append(names, m.Domain)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookupDomain returns the domain name matching name, ignoring case.
+func lookupDomain(name string) (string, bool) {
+	for _, d := range domains() {
+		if strings.EqualFold(d, name) {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+// lookupMethod returns the method in domain matching name, ignoring
+// case.
+func lookupMethod(domain, name string) (*registry.Method, bool) {
+	if m, ok := registry.LookupMethod(domain + "." + name); ok {
+		return m, true
+	}
+	for _, m := range registry.Methods() {
+		if m.Domain == domain && strings.EqualFold(m.Name, domain+"."+name) {
+			return m, true
+		}
+	}
+	return nil, false
+}
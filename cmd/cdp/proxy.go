@@ -0,0 +1,443 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// proxy forwards the DevTools HTTP endpoints and websocket connections
+// to the browser (upstream) and logs and records the messages.
+type proxy struct {
+	upstream *url.URL
+	filter   []string // Method patterns, see path.Match.
+	log      io.Writer
+	trunc    int
+	rec      *transcript
+
+	mu         sync.Mutex
+	nconn      int
+	recorded   bool              // An entry has been recorded.
+	lastTarget string            // Of the last recorded entry.
+	sessions   map[string]string // Session ID to target ID.
+	targetURL  map[string]string // Target ID to URL.
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// proxy runs the proxy until ctx is done.
+func (c *cli) proxy(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("proxy", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	listen := fs.String("listen", "127.0.0.1:9223", "Listen `address`")
+	record := fs.String("record", "", "Record the messages to a transcript `file`")
+	quiet := fs.Bool("quiet", false, "Do not log the messages")
+	trunc := fs.Int("trunc", 512, "Truncate logged messages to `n` bytes, 0 for no limit")
+	var filter stringsFlag
+	fs.Var(&filter, "filter", "Only log and record the methods and events matching `pattern` (e.g. 'Network.*'), repeatable")
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintln(c.stderr, "Usage: cdp proxy [-listen address] [-record file] [-filter pattern]... [-quiet] [-trunc n]")
+		return errUsage
+	}
+	for _, p := range filter {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("bad pattern %q: %v", p, err)
+		}
+	}
+
+	upstream, err := url.Parse(c.url)
+	if err != nil {
+		return err
+	}
+	p := &proxy{
+		upstream:  upstream,
+		filter:    filter,
+		log:       c.stderr,
+		trunc:     *trunc,
+		sessions:  make(map[string]string),
+		targetURL: make(map[string]string),
+	}
+	if *quiet {
+		p.log = nil
+	}
+	if *record != "" {
+		if p.rec, err = createTranscript(*record); err != nil {
+			return err
+		}
+		defer p.rec.Close()
+	}
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: p}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+	fmt.Fprintf(c.stderr, "Proxying %s on http://%s\n", c.url, l.Addr())
+	if err = srv.Serve(l); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// stringsFlag is a repeatable string flag.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string     { return strings.Join(*f, ",") }
+func (f *stringsFlag) Set(s string) error { *f = append(*f, s); return nil }
+
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		p.serveWebSocket(w, r)
+		return
+	}
+	p.serveHTTP(w, r)
+}
+
+// serveHTTP forwards the request, e.g. /json/list, and rewrites the
+// websocket URLs in the response to point to the proxy.
+func (p *proxy) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	u := *p.upstream
+	u.Path, u.RawQuery = r.URL.Path, r.URL.RawQuery
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, u.String(), r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	var v interface{}
+	if json.Unmarshal(body, &v) == nil {
+		p.rewrite(v, r.Host)
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false) // Keep & in the URLs.
+		enc.SetIndent("", "   ")
+		if enc.Encode(v) == nil {
+			body = b.Bytes()
+		}
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(body)
+}
+
+// rewrite replaces the websocket URLs (webSocketDebuggerUrl and the ws
+// parameter of the devtoolsFrontendUrls) in v with the proxy host and notes
+// the target URLs.
+func (p *proxy) rewrite(v interface{}, host string) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, vv := range v {
+			p.rewrite(vv, host)
+		}
+	case map[string]interface{}:
+		ws, _ := v["webSocketDebuggerUrl"].(string)
+		if ws == "" {
+			return
+		}
+		u, err := url.Parse(ws)
+		if err != nil {
+			return
+		}
+		orig := u.Host
+		u.Host = host
+		v["webSocketDebuggerUrl"] = u.String()
+		for k, f := range v {
+			// E.g. devtoolsFrontendUrl and devtoolsFrontendUrlCompat.
+			if f, ok := f.(string); ok && strings.HasPrefix(k, "devtoolsFrontendUrl") {
+				v[k] = strings.Replace(f, "ws="+orig, "ws="+host, 1)
+			}
+		}
+
+		id, _ := v["id"].(string)
+		targetURL, _ := v["url"].(string)
+		if id != "" {
+			p.mu.Lock()
+			p.targetURL[id] = targetURL
+			p.mu.Unlock()
+		}
+	}
+}
+
+// serveWebSocket connects to the same path upstream and forwards the
+// messages in both directions.
+func (p *proxy) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	u := *p.upstream
+	u.Scheme = "ws"
+	if p.upstream.Scheme == "https" {
+		u.Scheme = "wss"
+	}
+	u.Path, u.RawQuery = r.URL.Path, r.URL.RawQuery
+	up, _, err := websocket.DefaultDialer.DialContext(r.Context(), u.String(), nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer up.Close()
+	down, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer down.Close()
+
+	p.mu.Lock()
+	p.nconn++
+	pc := &proxyConn{p: p, id: p.nconn, pending: make(map[pendingKey]pendingCall)}
+	p.mu.Unlock()
+	// E.g. /devtools/page/<target ID>, the browser has no target ID.
+	if dir, id := path.Split(r.URL.Path); !strings.HasSuffix(dir, "/browser/") {
+		pc.target = id
+	}
+	p.logf("[%d] connected %s", pc.id, r.URL.Path)
+
+	errc := make(chan error, 2)
+	go func() { errc <- pc.forward(up, down, true) }()
+	go func() { errc <- pc.forward(down, up, false) }()
+	err = <-errc
+	p.logf("[%d] disconnected: %v", pc.id, err)
+}
+
+// proxyConn is a proxied websocket connection.
+type proxyConn struct {
+	p      *proxy
+	id     int
+	target string // Target ID, empty for the browser.
+
+	mu      sync.Mutex
+	pending map[pendingKey]pendingCall
+}
+
+type pendingKey struct {
+	session string
+	id      int64
+}
+
+type pendingCall struct {
+	method string
+	params json.RawMessage
+}
+
+// message is a protocol message: a request, response or event.
+type message struct {
+	ID        int64               `json:"id,omitempty"`
+	SessionID string              `json:"sessionId,omitempty"`
+	Method    string              `json:"method,omitempty"`
+	Params    json.RawMessage     `json:"params,omitempty"`
+	Result    json.RawMessage     `json:"result,omitempty"`
+	Error     *rpcc.ResponseError `json:"error,omitempty"`
+}
+
+// forward copies messages from src to dst until an error occurs, the
+// messages are logged and recorded. Requests are noted before they are
+// forwarded so that the response can be matched.
+func (pc *proxyConn) forward(dst, src *websocket.Conn, request bool) error {
+	for {
+		typ, data, err := src.ReadMessage()
+		if err != nil {
+			dst.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return err
+		}
+		var m message
+		switch {
+		case typ != websocket.TextMessage || json.Unmarshal(data, &m) != nil:
+			pc.p.logf("[%d] unknown message: %s", pc.id, pc.p.truncate(data))
+		case request:
+			pc.request(m, data)
+		default:
+			pc.response(m, data)
+		}
+		if err = dst.WriteMessage(typ, data); err != nil {
+			return err
+		}
+	}
+}
+
+func (pc *proxyConn) request(m message, data []byte) {
+	pc.mu.Lock()
+	pc.pending[pendingKey{m.SessionID, m.ID}] = pendingCall{method: m.Method, params: m.Params}
+	pc.mu.Unlock()
+	if pc.p.match(m.Method) {
+		pc.p.logf("[%d] -> %s", pc.id, pc.p.truncate(data))
+	}
+}
+
+func (pc *proxyConn) response(m message, data []byte) {
+	p := pc.p
+	if m.Method != "" {
+		// Event.
+		p.trackSession(m.Method, m.Params, nil)
+		if !p.match(m.Method) {
+			return
+		}
+		p.logf("[%d] <- %s", pc.id, p.truncate(data))
+		p.record(entry{Type: entryEvent, Target: pc.targetID(m.SessionID), Method: m.Method, Params: m.Params})
+		return
+	}
+
+	key := pendingKey{m.SessionID, m.ID}
+	pc.mu.Lock()
+	call, ok := pc.pending[key]
+	delete(pc.pending, key)
+	pc.mu.Unlock()
+	if !ok {
+		p.logf("[%d] <- (unknown request) %s", pc.id, p.truncate(data))
+		return
+	}
+	p.trackSession(call.method, call.params, m.Result)
+	if !p.match(call.method) {
+		return
+	}
+	p.logf("[%d] <- %s", pc.id, p.truncate(data))
+
+	e := entry{Type: entryCall, Target: pc.targetID(m.SessionID), Method: call.method, Params: call.params, Result: m.Result}
+	if m.Error != nil {
+		e.Error, e.Result = m.Error.Error(), nil
+	}
+	p.record(e)
+}
+
+// targetID returns the target of the messages for the session (flat
+// sessions) or the connection.
+func (pc *proxyConn) targetID(sessionID string) string {
+	if sessionID == "" {
+		return pc.target
+	}
+	pc.p.mu.Lock()
+	defer pc.p.mu.Unlock()
+	return pc.p.sessions[sessionID]
+}
+
+// trackSession notes the target of sessions attached via
+// Target.attachToTarget or Target.attachedToTarget (auto-attach).
+func (p *proxy) trackSession(method string, params, result json.RawMessage) {
+	var sessionID, targetID, targetURL string
+	switch method {
+	case "Target.attachToTarget":
+		var args struct {
+			TargetID string `json:"targetId"`
+		}
+		var reply struct {
+			SessionID string `json:"sessionId"`
+		}
+		if json.Unmarshal(params, &args) != nil || json.Unmarshal(result, &reply) != nil {
+			return
+		}
+		sessionID, targetID = reply.SessionID, args.TargetID
+	case "Target.attachedToTarget":
+		var ev struct {
+			SessionID  string `json:"sessionId"`
+			TargetInfo struct {
+				TargetID string `json:"targetId"`
+				URL      string `json:"url"`
+			} `json:"targetInfo"`
+		}
+		if json.Unmarshal(params, &ev) != nil {
+			return
+		}
+		sessionID, targetID, targetURL = ev.SessionID, ev.TargetInfo.TargetID, ev.TargetInfo.URL
+	default:
+		return
+	}
+	if sessionID == "" {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sessions[sessionID] = targetID
+	if targetURL != "" {
+		p.targetURL[targetID] = targetURL
+	}
+}
+
+// match reports whether method matches the filter.
+func (p *proxy) match(method string) bool {
+	if len(p.filter) == 0 {
+		return true
+	}
+	for _, pattern := range p.filter {
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// record writes e to the transcript, preceded by a target entry when the
+// target changes so that replay switches targets.
+func (p *proxy) record(e entry) {
+	if p.rec == nil {
+		return
+	}
+	// Hold the lock across the target entry and e so that entries
+	// from other connections are not recorded in between.
+	p.mu.Lock()
+	var err error
+	if !p.recorded || e.Target != p.lastTarget {
+		p.recorded, p.lastTarget = true, e.Target
+		err = p.rec.write(entry{Type: entryTarget, Target: e.Target, URL: p.targetURL[e.Target]})
+	}
+	if err == nil {
+		err = p.rec.write(e)
+	}
+	p.mu.Unlock()
+
+	if err != nil {
+		p.logf("record: %v", err)
+	}
+}
+
+func (p *proxy) logf(format string, args ...interface{}) {
+	if p.log == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintf(p.log, "%s "+format+"\n", append([]interface{}{time.Now().Format("15:04:05.000")}, args...)...)
+}
+
+// truncate returns data shortened to the -trunc limit.
+func (p *proxy) truncate(data []byte) []byte {
+	data = bytes.TrimSpace(data)
+	if p.trunc <= 0 || len(data) <= p.trunc {
+		return data
+	}
+	return append(data[:p.trunc:p.trunc], fmt.Sprintf("... (%d bytes)", len(data))...)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func newTestProxy(filter ...string) *proxy {
+	return &proxy{
+		filter:    filter,
+		sessions:  make(map[string]string),
+		targetURL: make(map[string]string),
+	}
+}
+
+func TestProxyRewrite(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		want          string
+		wantTargetURL map[string]string
+	}{
+		{
+			name: "Version",
+			in:   `{"Browser":"Chrome","webSocketDebuggerUrl":"ws://127.0.0.1:9222/devtools/browser/B"}`,
+			want: `{"Browser":"Chrome","webSocketDebuggerUrl":"ws://localhost:9223/devtools/browser/B"}`,
+		},
+		{
+			name: "List",
+			in: `[{"id":"T1","url":"https://example.com/?a=b","webSocketDebuggerUrl":"ws://127.0.0.1:9222/devtools/page/T1",` +
+				`"devtoolsFrontendUrl":"/devtools/inspector.html?ws=127.0.0.1:9222/devtools/page/T1",` +
+				`"devtoolsFrontendUrlCompat":"/devtools/inspector.html?ws=127.0.0.1:9222/devtools/page/T1"},` +
+				`{"id":"T2","url":"about:blank"}]`,
+			want: `[{"devtoolsFrontendUrl":"/devtools/inspector.html?ws=localhost:9223/devtools/page/T1",` +
+				`"devtoolsFrontendUrlCompat":"/devtools/inspector.html?ws=localhost:9223/devtools/page/T1",` +
+				`"id":"T1","url":"https://example.com/?a=b","webSocketDebuggerUrl":"ws://localhost:9223/devtools/page/T1"},` +
+				`{"id":"T2","url":"about:blank"}]`,
+			wantTargetURL: map[string]string{"T1": "https://example.com/?a=b"},
+		},
+		{
+			name: "NotTarget",
+			in:   `{"Protocol-Version":"1.3"}`,
+			want: `{"Protocol-Version":"1.3"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProxy()
+			var v interface{}
+			if err := json.Unmarshal([]byte(tt.in), &v); err != nil {
+				t.Fatal(err)
+			}
+			p.rewrite(v, "localhost:9223")
+			got, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("rewrite() = %s, want %s", got, tt.want)
+			}
+			if tt.wantTargetURL == nil {
+				tt.wantTargetURL = map[string]string{}
+			}
+			if !reflect.DeepEqual(p.targetURL, tt.wantTargetURL) {
+				t.Errorf("targetURL = %v, want %v", p.targetURL, tt.wantTargetURL)
+			}
+		})
+	}
+}
+
+func TestProxyTrackSession(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		params        string
+		result        string
+		wantSessions  map[string]string
+		wantTargetURL map[string]string
+	}{
+		{
+			name:         "AttachToTarget",
+			method:       "Target.attachToTarget",
+			params:       `{"targetId":"T1","flatten":true}`,
+			result:       `{"sessionId":"S1"}`,
+			wantSessions: map[string]string{"S1": "T1"},
+		},
+		{
+			name:          "AttachedToTarget",
+			method:        "Target.attachedToTarget",
+			params:        `{"sessionId":"S2","targetInfo":{"targetId":"T2","url":"about:blank"},"waitingForDebugger":false}`,
+			wantSessions:  map[string]string{"S2": "T2"},
+			wantTargetURL: map[string]string{"T2": "about:blank"},
+		},
+		{
+			name:   "AttachToTargetError",
+			method: "Target.attachToTarget",
+			params: `{"targetId":"T1"}`,
+		},
+		{
+			name:   "InvalidParams",
+			method: "Target.attachedToTarget",
+			params: `[]`,
+		},
+		{
+			name:   "OtherMethod",
+			method: "Target.detachFromTarget",
+			params: `{"sessionId":"S1"}`,
+			result: `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProxy()
+			var result json.RawMessage
+			if tt.result != "" {
+				result = json.RawMessage(tt.result)
+			}
+			p.trackSession(tt.method, json.RawMessage(tt.params), result)
+			for _, m := range []*map[string]string{&tt.wantSessions, &tt.wantTargetURL} {
+				if *m == nil {
+					*m = map[string]string{}
+				}
+			}
+			if !reflect.DeepEqual(p.sessions, tt.wantSessions) {
+				t.Errorf("sessions = %v, want %v", p.sessions, tt.wantSessions)
+			}
+			if !reflect.DeepEqual(p.targetURL, tt.wantTargetURL) {
+				t.Errorf("targetURL = %v, want %v", p.targetURL, tt.wantTargetURL)
+			}
+		})
+	}
+}
+
+func TestProxyMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter []string
+		method string
+		want   bool
+	}{
+		{name: "NoFilter", method: "Page.navigate", want: true},
+		{name: "Domain", filter: []string{"Network.*"}, method: "Network.enable", want: true},
+		{name: "OtherDomain", filter: []string{"Network.*"}, method: "Page.enable"},
+		{name: "Exact", filter: []string{"Page.navigate"}, method: "Page.navigate", want: true},
+		{name: "Any", filter: []string{"Network.*", "*.enable"}, method: "Page.enable", want: true},
+		{name: "Prefix", filter: []string{"Page.frame*"}, method: "Page.frameNavigated", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProxy(tt.filter...)
+			if got := p.match(tt.method); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProxyRecord checks that messages for flat sessions are recorded
+// for the target the session is attached to.
+func TestProxyRecord(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "transcript.jsonl")
+	p := newTestProxy("Target.*", "Page.*")
+	var err error
+	if p.rec, err = createTranscript(name); err != nil {
+		t.Fatal(err)
+	}
+	pc := &proxyConn{p: p, id: 1, pending: make(map[pendingKey]pendingCall)}
+
+	for _, m := range []struct {
+		request bool
+		data    string
+	}{
+		{true, `{"id":1,"method":"Target.attachToTarget","params":{"targetId":"T1","flatten":true}}`},
+		{false, `{"id":1,"result":{"sessionId":"S1"}}`},
+		{true, `{"id":2,"sessionId":"S1","method":"Page.navigate","params":{"url":"about:blank"}}`},
+		{true, `{"id":2,"method":"Runtime.evaluate","params":{"expression":"1"}}`}, // Filtered.
+		{false, `{"id":2,"result":{"result":{"type":"number"}}}`},
+		{false, `{"method":"Target.attachedToTarget","params":{"sessionId":"S2","targetInfo":{"targetId":"T2","url":"about:blank#2"}}}`},
+		{false, `{"id":2,"sessionId":"S1","error":{"code":-32000,"message":"Cannot navigate"}}`},
+		{false, `{"method":"Page.loadEventFired","sessionId":"S2","params":{"timestamp":1}}`},
+		{false, `{"id":3,"result":{}}`}, // Unknown request.
+	} {
+		var msg message
+		if err = json.Unmarshal([]byte(m.data), &msg); err != nil {
+			t.Fatal(err)
+		}
+		if m.request {
+			pc.request(msg, []byte(m.data))
+		} else {
+			pc.response(msg, []byte(m.data))
+		}
+	}
+	if err = p.rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := readTranscript(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type summary struct{ Type, Target, URL, Method, Error string }
+	var got []summary
+	for _, e := range entries {
+		got = append(got, summary{e.Type, e.Target, e.URL, e.Method, e.Error})
+	}
+	want := []summary{
+		{Type: entryTarget},
+		{Type: entryCall, Method: "Target.attachToTarget"},
+		{Type: entryEvent, Method: "Target.attachedToTarget"},
+		{Type: entryTarget, Target: "T1"},
+		{Type: entryCall, Target: "T1", Method: "Page.navigate", Error: "rpc error: Cannot navigate (code = -32000)"},
+		{Type: entryTarget, Target: "T2", URL: "about:blank#2"},
+		{Type: entryEvent, Target: "T2", Method: "Page.loadEventFired"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("transcript:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestProxyTruncate(t *testing.T) {
+	p := &proxy{trunc: 4}
+	if got, want := string(p.truncate([]byte(" abcdef\n"))), "abcd... (6 bytes)"; got != want {
+		t.Errorf("truncate() = %q, want %q", got, want)
+	}
+	if got, want := string(p.truncate([]byte("abc"))), "abc"; got != want {
+		t.Errorf("truncate() = %q, want %q", got, want)
+	}
+}
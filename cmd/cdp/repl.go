@@ -0,0 +1,565 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/registry"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+// replCommands are the REPL commands, in addition to method calls.
+var replCommands = []struct{ name, args, help string }{
+	{".help", "", "Show this help"},
+	{".targets", "", "List the targets"},
+	{".attach", "<target>", "Switch to the target (ID, or part of the URL or title)"},
+	{".browser", "", "Switch to the browser target"},
+	{".events", "[off | <event pattern>...]", "Print matching events in the background, or stop"},
+	{".record", "<file> | off", "Record the session to a transcript"},
+	{".replay", "<file>", "Replay the calls in a transcript"},
+	{".quit", "", "Exit (or Ctrl-D)"},
+}
+
+// repl is an interactive session. Targets are switched via sessions on
+// the browser connection, when the endpoint has one (not e.g. Node.js).
+type repl struct {
+	c  *cli
+	lr *lineReader
+
+	browser  *rpcc.Conn
+	sm       *session.Manager
+	sessions map[target.ID]*rpcc.Conn
+
+	conn    *rpcc.Conn    // Current connection.
+	target  *target.Info  // Current target, nil for the browser.
+	targets []target.Info // Last listed, for completion.
+
+	patterns   []string // Event patterns, see .events.
+	stopEvents func()
+
+	recMu sync.Mutex // Protects rec, events are recorded in the background.
+	rec   *transcript
+}
+
+// repl runs the interactive session.
+func (c *cli) repl(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("repl", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	record := fs.String("record", "", "Record the session to a transcript `file`")
+	replay := fs.String("replay", "", "Replay the calls in a transcript `file` before reading commands")
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintln(c.stderr, "Usage: cdp repl [-record file] [-replay file]")
+		return errUsage
+	}
+
+	r := &repl{c: c, sessions: make(map[target.ID]*rpcc.Conn), stopEvents: func() {}}
+	r.lr = newLineReader(os.Stdin, c.stdout, r.complete)
+	defer r.close()
+
+	if *record != "" {
+		var err error
+		if r.rec, err = createTranscript(*record); err != nil {
+			return err
+		}
+	}
+	if err := r.connect(ctx); err != nil {
+		return err
+	}
+	if *replay != "" {
+		if err := r.replay(ctx, *replay); err != nil {
+			return err
+		}
+	}
+
+	for {
+		line, err := r.lr.readLine(r.prompt())
+		if err == errInterrupt {
+			continue
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Interrupts cancel the current command, not the REPL.
+		cmdCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		err = r.exec(cmdCtx, line)
+		stop()
+		if err == errQuit {
+			return nil
+		}
+		if err != nil {
+			r.lr.printAbove(fmt.Sprintf("error: %v\n", err))
+		}
+	}
+}
+
+var errQuit = errors.New("quit")
+
+// connect connects to the browser and attaches to the target selected
+// by the -target and -browser flags.
+func (r *repl) connect(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.c.timeout)
+	defer cancel()
+
+	v, err := devtool.New(r.c.url).Version(ctx)
+	if err != nil || v.WebSocketDebuggerURL == "" {
+		// No browser target, use a single target connection.
+		conn, err := r.c.dial(ctx)
+		if err != nil {
+			return err
+		}
+		r.conn = conn
+		return nil
+	}
+
+	r.browser, err = rpcc.DialContext(ctx, v.WebSocketDebuggerURL, rpcc.WithValidation())
+	if err != nil {
+		return err
+	}
+	r.sm, err = session.NewManager(cdp.NewClient(r.browser), session.WithFlatSessions())
+	if err != nil {
+		return err
+	}
+	r.conn = r.browser
+	if r.c.browser {
+		return nil
+	}
+	return r.attach(ctx, r.c.target)
+}
+
+func (r *repl) close() {
+	r.stopEvents()
+	if r.sm != nil {
+		r.sm.Close()
+	}
+	if r.browser != nil {
+		r.browser.Close()
+	} else if r.conn != nil {
+		r.conn.Close()
+	}
+	r.recMu.Lock()
+	r.rec.Close()
+	r.recMu.Unlock()
+}
+
+// write records e in the current transcript, if any.
+func (r *repl) write(e entry) error {
+	r.recMu.Lock()
+	defer r.recMu.Unlock()
+	return r.rec.write(e)
+}
+
+func (r *repl) prompt() string {
+	switch {
+	case r.target != nil:
+		id := string(r.target.TargetID)
+		if len(id) > 8 {
+			id = id[:8]
+		}
+		return fmt.Sprintf("%s[%s]> ", r.target.Type, id)
+	case r.browser != nil:
+		return "browser> "
+	default:
+		return "cdp> "
+	}
+}
+
+// exec executes a line, a REPL command or a method call.
+func (r *repl) exec(ctx context.Context, line string) error {
+	args, err := splitArgs(line)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	switch args[0] {
+	case ".help":
+		r.help()
+		return nil
+	case ".quit", ".exit":
+		return errQuit
+	case ".targets":
+		return r.listTargets(ctx)
+	case ".attach":
+		if len(args) != 2 {
+			return errors.New("usage: .attach <target>")
+		}
+		return r.attach(ctx, args[1])
+	case ".browser":
+		if r.browser == nil {
+			return errors.New("the endpoint has no browser target")
+		}
+		r.switchTo(r.browser, nil)
+		return nil
+	case ".events":
+		return r.events(ctx, args[1:])
+	case ".record":
+		return r.record(args[1:])
+	case ".replay":
+		if len(args) != 2 {
+			return errors.New("usage: .replay <file>")
+		}
+		return r.replay(ctx, args[1])
+	}
+	if strings.HasPrefix(args[0], ".") {
+		return fmt.Errorf("unknown command %s, see .help", args[0])
+	}
+
+	// Domain.method or domain method.
+	domain, method, ok := strings.Cut(args[0], ".")
+	args = args[1:]
+	if !ok {
+		if len(args) == 0 {
+			if d, ok := lookupDomain(domain); ok {
+				r.c.domainHelp(d)
+				return nil
+			}
+			return fmt.Errorf("unknown domain %s", domain)
+		}
+		method, args = args[0], args[1:]
+	}
+	d, ok := lookupDomain(domain)
+	if !ok {
+		return fmt.Errorf("unknown domain %s", domain)
+	}
+	m, ok := lookupMethod(d, method)
+	if !ok {
+		return fmt.Errorf("unknown method %s.%s", d, method)
+	}
+	for _, a := range args {
+		if a == "-h" || a == "-help" || a == "--help" {
+			r.c.methodHelp(m)
+			return nil
+		}
+	}
+	v, err := decodeArgs(m, args)
+	if err != nil {
+		return err
+	}
+	return r.invoke(ctx, m, v)
+}
+
+// invoke calls m with the arguments v on the current connection, prints
+// and records the reply.
+func (r *repl) invoke(ctx context.Context, m *registry.Method, v interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, r.c.timeout)
+	defer cancel()
+
+	e := entry{Type: entryCall, Target: r.targetID(), Method: m.Name}
+	if v != nil {
+		params, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		e.Params = params
+	}
+
+	var reply json.RawMessage
+	err := rpcc.Invoke(ctx, m.Name, v, &reply, r.conn)
+	if err != nil {
+		e.Error = err.Error()
+	} else {
+		e.Result = reply
+		if len(reply) == 0 {
+			reply = json.RawMessage("{}")
+		}
+		r.lr.printAbove(indent(reply))
+	}
+	if werr := r.write(e); werr != nil && err == nil {
+		err = werr
+	}
+	return err
+}
+
+func (r *repl) targetID() string {
+	if r.target == nil {
+		return ""
+	}
+	return string(r.target.TargetID)
+}
+
+func (r *repl) help() {
+	var b strings.Builder
+	b.WriteString("Call methods as Domain.method [--param value]... ['{\"param\": value}'],\n")
+	b.WriteString("e.g. Page.navigate --url https://www.example.com (Tab completes).\n")
+	b.WriteString("Domain lists the methods and events, Domain.method -h the parameters.\n\n")
+	for _, c := range replCommands {
+		fmt.Fprintf(&b, "  %-36s %s\n", strings.TrimSpace(c.name+" "+c.args), c.help)
+	}
+	r.lr.printAbove(b.String())
+}
+
+// getTargets lists the targets via the browser connection.
+func (r *repl) getTargets(ctx context.Context) ([]target.Info, error) {
+	if r.browser == nil {
+		return nil, errors.New("the endpoint has no browser target")
+	}
+	ctx, cancel := context.WithTimeout(ctx, r.c.timeout)
+	defer cancel()
+	reply, err := cdp.NewClient(r.browser).Target.GetTargets(ctx, target.NewGetTargetsArgs())
+	if err != nil {
+		return nil, err
+	}
+	r.targets = reply.TargetInfos
+	return reply.TargetInfos, nil
+}
+
+func (r *repl) listTargets(ctx context.Context) error {
+	targets, err := r.getTargets(ctx)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, t := range targets {
+		cur := " "
+		if r.target != nil && r.target.TargetID == t.TargetID {
+			cur = "*"
+		}
+		fmt.Fprintf(&b, "%s %s  %-15s %s  %s\n", cur, t.TargetID, t.Type, t.Title, t.URL)
+	}
+	r.lr.printAbove(b.String())
+	return nil
+}
+
+// matchTarget returns the target matching query (see findTarget), or
+// the first page when query is empty.
+func matchTarget(targets []target.Info, query string) (target.Info, bool) {
+	for _, t := range targets {
+		if query == "" && t.Type == "page" {
+			return t, true
+		}
+		if query != "" && (string(t.TargetID) == query || strings.Contains(t.URL, query) || strings.Contains(t.Title, query)) {
+			return t, true
+		}
+	}
+	return target.Info{}, false
+}
+
+// attach switches to the target matching query, the session is reused
+// if still attached.
+func (r *repl) attach(ctx context.Context, query string) error {
+	targets, err := r.getTargets(ctx)
+	if err != nil {
+		return err
+	}
+	t, ok := matchTarget(targets, query)
+	if !ok {
+		if query == "" {
+			return errors.New("no page targets, use .targets and .attach")
+		}
+		return fmt.Errorf("no target matching %q", query)
+	}
+
+	conn, ok := r.sessions[t.TargetID]
+	if ok && conn.Err() != nil {
+		// Detached or closed, e.g. the page navigated cross-process.
+		delete(r.sessions, t.TargetID)
+		ok = false
+	}
+	if !ok {
+		ctx, cancel := context.WithTimeout(ctx, r.c.timeout)
+		defer cancel()
+		if conn, err = r.sm.Dial(ctx, t.TargetID); err != nil {
+			return err
+		}
+		r.sessions[t.TargetID] = conn
+	}
+	r.switchTo(conn, &t)
+	return nil
+}
+
+// switchTo makes conn the current connection, the event subscriptions
+// follow.
+func (r *repl) switchTo(conn *rpcc.Conn, t *target.Info) {
+	r.stopEvents()
+	r.stopEvents = func() {}
+	r.conn, r.target = conn, t
+
+	e := entry{Type: entryTarget}
+	if t != nil {
+		e.Target, e.URL = string(t.TargetID), t.URL
+	}
+	if err := r.write(e); err != nil {
+		r.lr.printAbove(fmt.Sprintf("error: record: %v\n", err))
+	}
+	if len(r.patterns) > 0 {
+		// Keep the subscriptions, ctx is only for enabling the domains.
+		ctx, cancel := context.WithTimeout(context.Background(), r.c.timeout)
+		defer cancel()
+		if err := r.subscribe(ctx, r.patterns); err != nil {
+			r.lr.printAbove(fmt.Sprintf("error: events: %v\n", err))
+		}
+	}
+}
+
+// events handles the .events command.
+func (r *repl) events(ctx context.Context, args []string) error {
+	switch {
+	case len(args) == 0:
+		if len(r.patterns) == 0 {
+			r.lr.printAbove("No event subscriptions, use .events <event pattern>...\n")
+		} else {
+			r.lr.printAbove(strings.Join(r.patterns, " ") + "\n")
+		}
+		return nil
+	case len(args) == 1 && args[0] == "off":
+		r.stopEvents()
+		r.stopEvents = func() {}
+		r.patterns = nil
+		return nil
+	}
+	return r.subscribe(ctx, append(r.patterns, args...))
+}
+
+// subscribe prints the events matching patterns in the background,
+// replacing the current subscriptions.
+func (r *repl) subscribe(ctx context.Context, patterns []string) error {
+	events, err := matchEvents(patterns)
+	if err != nil {
+		return err
+	}
+	r.stopEvents()
+	r.stopEvents = func() {}
+
+	sctx, cancel := context.WithCancel(context.Background())
+	msgs, errc, err := subscribe(sctx, r.conn, events)
+	if err != nil {
+		cancel()
+		return err
+	}
+	r.stopEvents = cancel
+	r.patterns = patterns
+
+	id := r.targetID()
+	go func() {
+		for {
+			select {
+			case <-sctx.Done():
+				return
+			case err := <-errc:
+				if sctx.Err() == nil {
+					r.lr.printAbove(fmt.Sprintf("error: events: %v\n", err))
+				}
+				return
+			case m := <-msgs:
+				r.lr.printAbove(fmt.Sprintf("< %s %s", m.Method, indent(m.Params)))
+				if err := r.write(entry{Type: entryEvent, Target: id, Method: m.Method, Params: m.Params}); err != nil {
+					r.lr.printAbove(fmt.Sprintf("error: record: %v\n", err))
+				}
+			}
+		}
+	}()
+
+	ctx, cancelEnable := context.WithTimeout(ctx, r.c.timeout)
+	defer cancelEnable()
+	return enableDomains(ctx, r.conn, events)
+}
+
+// record handles the .record command.
+func (r *repl) record(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: .record <file> | off")
+	}
+	r.recMu.Lock()
+	defer r.recMu.Unlock()
+	if err := r.rec.Close(); err != nil {
+		return err
+	}
+	r.rec = nil
+	if args[0] == "off" {
+		return nil
+	}
+	rec, err := createTranscript(args[0])
+	if err != nil {
+		return err
+	}
+	r.rec = rec
+	// Start with the current target, for replay.
+	e := entry{Type: entryTarget}
+	if r.target != nil {
+		e.Target, e.URL = string(r.target.TargetID), r.target.URL
+	}
+	return r.rec.write(e)
+}
+
+// replay invokes the calls in the transcript file in order, switching
+// targets as recorded. A recorded target is matched by ID or URL, the
+// current target is kept if neither exists (e.g. in a new browser).
+func (r *repl) replay(ctx context.Context, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	entries, err := readTranscript(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		switch e.Type {
+		case entryTarget:
+			if r.browser == nil {
+				continue
+			}
+			if e.Target == "" {
+				r.switchTo(r.browser, nil)
+				continue
+			}
+			if err := r.attach(ctx, e.Target); err != nil {
+				if e.URL == "" || r.attach(ctx, e.URL) != nil {
+					r.lr.printAbove(fmt.Sprintf("replay: target %s not found, using the current target\n", e.Target))
+				}
+			}
+		case entryCall:
+			m, ok := registry.LookupMethod(e.Method)
+			if !ok {
+				return fmt.Errorf("replay: unknown method %s", e.Method)
+			}
+			v, err := m.DecodeArgs(e.Params)
+			if err != nil {
+				return err
+			}
+			r.lr.printAbove(strings.TrimSpace(fmt.Sprintf("> %s %s", e.Method, e.Params)) + "\n")
+			if err = r.invoke(ctx, m, v); err != nil {
+				r.lr.printAbove(fmt.Sprintf("error: %v\n", err))
+			}
+		}
+	}
+	return nil
+}
+
+// indent returns the indented JSON, ending with a newline.
+func indent(data json.RawMessage) string {
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return string(data) + "\n"
+	}
+	return string(b) + "\n"
+}
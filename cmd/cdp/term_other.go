@@ -0,0 +1,13 @@
+//go:build !unix
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+// makeRaw is not supported, lines are read without editing.
+func makeRaw(f *os.File) (restore func(), err error) {
+	return nil, errors.New("raw mode not supported")
+}
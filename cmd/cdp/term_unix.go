@@ -0,0 +1,30 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// makeRaw puts the terminal f in raw mode (no echo, line buffering or
+// signals) via stty, restore returns it to the previous state. Returns
+// an error if f is not a terminal.
+func makeRaw(f *os.File) (restore func(), err error) {
+	state, err := stty(f, "-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = stty(f, "-icanon", "-echo", "-isig", "min", "1", "time", "0"); err != nil {
+		return nil, err
+	}
+	return func() { stty(f, strings.TrimSpace(state)) }, nil
+}
+
+func stty(f *os.File, args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = f
+	out, err := cmd.Output()
+	return string(out), err
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Transcript entry types.
+const (
+	entryCall   = "call"   // A method call and its reply (result or error).
+	entryEvent  = "event"  // An event notification.
+	entryTarget = "target" // The target changed, e.g. via .attach.
+)
+
+// entry is a line in a transcript (JSON lines). Transcripts record REPL
+// sessions and are replayed by invoking the calls in order.
+type entry struct {
+	Time   time.Time       `json:"time"`
+	Type   string          `json:"type"`
+	Target string          `json:"target,omitempty"` // Target ID, empty for the browser.
+	URL    string          `json:"url,omitempty"`    // Target URL (entryTarget).
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// transcript writes entries to a file. The nil transcript discards them.
+type transcript struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+func createTranscript(name string) (*transcript, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetEscapeHTML(false)
+	return &transcript{f: f, enc: enc}, nil
+}
+
+func (t *transcript) write(e entry) error {
+	if t == nil {
+		return nil
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.enc.Encode(e)
+}
+
+func (t *transcript) Close() error {
+	if t == nil {
+		return nil
+	}
+	return t.f.Close()
+}
+
+// readTranscript reads the entries of a transcript.
+func readTranscript(r io.Reader) ([]entry, error) {
+	var entries []entry
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 64<<20) // Replies can be large, e.g. screenshots.
+	for n := 1; s.Scan(); n++ {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		var e entry
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("transcript line %d: %v", n, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, s.Err()
+}
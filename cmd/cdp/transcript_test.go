@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTranscript(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	tests := []struct {
+		name    string
+		entries []entry
+	}{
+		{name: "Empty"},
+		{
+			name: "Session",
+			entries: []entry{
+				{Time: now, Type: entryTarget, Target: "T1", URL: "https://example.com/?a=1&b=<2>"},
+				{Time: now, Type: entryCall, Target: "T1", Method: "Page.navigate", Params: json.RawMessage(`{"url":"about:blank"}`), Result: json.RawMessage(`{"frameId":"F1"}`)},
+				{Time: now, Type: entryCall, Method: "Page.reload", Error: "rpc error: not found"},
+				{Time: now, Type: entryEvent, Target: "T1", Method: "Page.loadEventFired", Params: json.RawMessage(`{"timestamp":1.5}`)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := filepath.Join(t.TempDir(), "transcript.jsonl")
+			rec, err := createTranscript(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range tt.entries {
+				if err = rec.write(e); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err = rec.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(b), `\u0026`) {
+				t.Errorf("transcript: HTML escaped: %s", b)
+			}
+			got, err := readTranscript(strings.NewReader(string(b)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.entries) {
+				t.Errorf("readTranscript() = %+v, want %+v", got, tt.entries)
+			}
+		})
+	}
+}
+
+func TestTranscriptNil(t *testing.T) {
+	var rec *transcript
+	if err := rec.write(entry{Type: entryCall}); err != nil {
+		t.Errorf("write: %v", err)
+	}
+	if err := rec.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
+
+func TestReadTranscript(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []entry
+		wantErr string
+	}{
+		{
+			name: "SkipEmptyLines",
+			in:   "\n" + `{"time":"0001-01-01T00:00:00Z","type":"call","method":"Page.enable"}` + "\n\n",
+			want: []entry{{Type: entryCall, Method: "Page.enable"}},
+		},
+		{
+			name:    "Invalid",
+			in:      `{"type":"call"}` + "\n" + `{"type":`,
+			wantErr: "transcript line 2: unexpected end of JSON input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readTranscript(strings.NewReader(tt.in))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("readTranscript() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readTranscript() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// The cdpcompat command reports the differences between the bindings
+// (package cdp) and the protocol of a running browser.
+//
+// Usage:
+//
+//	cdpcompat [-url http://127.0.0.1:9222] [-stable]
+//
+// The exit status is 1 if there are issues, 2 on error.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mafredri/cdp/compat"
+	"github.com/mafredri/cdp/devtool"
+)
+
+func main() {
+	var (
+		url     string
+		stable  bool
+		timeout time.Duration
+	)
+	flag.StringVar(&url, "url", "http://127.0.0.1:9222", "DevTools endpoint")
+	flag.BoolVar(&stable, "stable", false, "Only report issues for non-experimental APIs")
+	flag.DurationVar(&timeout, "timeout", 10*time.Second, "Timeout for fetching the protocol")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	devt := devtool.New(url)
+	v, err := devt.Version(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(2)
+	}
+	r, err := compat.Check(ctx, devt)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(2)
+	}
+
+	issues := r.Issues
+	if stable {
+		issues = r.Stable()
+	}
+	for _, i := range issues {
+		fmt.Println(i)
+	}
+	fmt.Printf("%s (protocol %s): %d issues\n", v.Browser, v.Protocol, len(issues))
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+}
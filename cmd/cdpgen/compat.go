@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// writeCompatProtocol writes the merged protocol definitions, without
+// descriptions, for use by the compat package at runtime.
+func writeCompatProtocol(dir string, data ...[]byte) {
+	var protocol proto.Protocol
+	for _, d := range data {
+		var p proto.Protocol
+		err := json.Unmarshal(d, &p)
+		panicErr(err)
+		if protocol.Version.Major == "" {
+			protocol.Version = p.Version
+		}
+		protocol.Domains = append(protocol.Domains, p.Domains...)
+	}
+	sort.Slice(protocol.Domains, func(i, j int) bool {
+		return protocol.Domains[i].Domain < protocol.Domains[j].Domain
+	})
+
+	for i := range protocol.Domains {
+		d := &protocol.Domains[i]
+		d.Description = ""
+		stripDescriptions(d.Types)
+		for ii := range d.Commands {
+			c := &d.Commands[ii]
+			c.Description = ""
+			stripDescriptions(c.Parameters)
+			stripDescriptions(c.Returns)
+		}
+		for ii := range d.Events {
+			e := &d.Events[ii]
+			e.Description = ""
+			stripDescriptions(e.Parameters)
+		}
+	}
+
+	b, err := json.MarshalIndent(protocol, "", "\t")
+	panicErr(err)
+
+	err = mkdir(dir)
+	panicErr(err)
+	fp := filepath.Join(dir, "protocol.json")
+	log.Printf("Writing %s...", fp)
+	err = os.WriteFile(fp, append(b, '\n'), 0o644)
+	panicErr(err)
+}
+
+func stripDescriptions(types []proto.AnyType) {
+	for i := range types {
+		t := &types[i]
+		t.Description = ""
+		stripDescriptions(t.Properties)
+		if t.Items != nil {
+			items := *t.Items
+			items.Description = ""
+			stripDescriptions(items.Properties)
+			t.Items = &items
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+const diffUsage = `Usage: cdpgen diff [-changelog] [-breaking] old.json new.json
+
+Reports the added, removed and changed domains, commands, events, types,
+parameters and enum values between two protocol definitions. Changes that
+break the generated Go API are marked as breaking.
+
+The exit status is 1 if there are breaking changes, 2 on error.
+`
+
+// diffMain runs the diff subcommand and returns the exit status.
+func diffMain(args []string) int {
+	fs := flag.NewFlagSet("cdpgen diff", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), diffUsage)
+		fs.PrintDefaults()
+	}
+	var changelog, breaking bool
+	fs.BoolVar(&changelog, "changelog", false, "Print the changes as markdown, e.g. for release notes")
+	fs.BoolVar(&breaking, "breaking", false, "Only print breaking changes")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	var protos [2]*proto.Protocol
+	for i, name := range fs.Args() {
+		data, err := os.ReadFile(name)
+		if err == nil {
+			protos[i] = new(proto.Protocol)
+			err = json.Unmarshal(data, protos[i])
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			return 2
+		}
+	}
+
+	changes := diffProtocol(protos[0], protos[1])
+	if breaking {
+		var b []schemaChange
+		for _, c := range changes {
+			if c.breaking {
+				b = append(b, c)
+			}
+		}
+		changes = b
+	}
+	if changelog {
+		writeChangelog(os.Stdout, changes)
+	} else {
+		for _, c := range changes {
+			fmt.Println(c)
+		}
+	}
+
+	for _, c := range changes {
+		if c.breaking {
+			return 1
+		}
+	}
+	return 0
+}
+
+type changeKind int
+
+const (
+	changeAdded changeKind = iota + 1
+	changeRemoved
+	changeModified
+)
+
+// schemaChange is a difference between two protocol definitions.
+type schemaChange struct {
+	kind         changeKind
+	item         string // E.g. "command" or "parameter".
+	name         string // E.g. "Page.navigate" or "Page.navigate.url".
+	detail       string // Description of a modification.
+	breaking     bool   // Breaks the generated Go API.
+	experimental bool   // Experimental (or in an experimental domain).
+}
+
+func (c schemaChange) String() string {
+	var s string
+	switch c.kind {
+	case changeAdded:
+		s = "+ "
+	case changeRemoved:
+		s = "- "
+	default:
+		s = "~ "
+	}
+	s += c.item + " " + c.name
+	if c.detail != "" {
+		s += ": " + c.detail
+	}
+	if c.experimental {
+		s += " [experimental]"
+	}
+	if c.breaking {
+		s += " [breaking]"
+	}
+	return s
+}
+
+// writeChangelog writes the changes as markdown, breaking changes are
+// listed separately.
+func writeChangelog(w io.Writer, changes []schemaChange) {
+	sections := []struct {
+		title  string
+		filter func(schemaChange) bool
+	}{
+		{"Breaking changes", func(c schemaChange) bool { return c.breaking }},
+		{"Added", func(c schemaChange) bool { return !c.breaking && c.kind == changeAdded }},
+		{"Removed", func(c schemaChange) bool { return !c.breaking && c.kind == changeRemoved }},
+		{"Changed", func(c schemaChange) bool { return !c.breaking && c.kind == changeModified }},
+	}
+
+	fmt.Fprintln(w, "## Protocol changes")
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "\nNo changes.")
+		return
+	}
+	for _, sec := range sections {
+		var lines []string
+		for _, c := range changes {
+			if !sec.filter(c) {
+				continue
+			}
+			line := "- " + strings.ToUpper(c.item[:1]) + c.item[1:] + " `" + c.name + "`"
+			switch {
+			case c.breaking && c.kind == changeAdded:
+				line += " added"
+			case c.breaking && c.kind == changeRemoved:
+				line += " removed"
+			}
+			if c.detail != "" {
+				if c.kind == changeModified {
+					line += ": " + c.detail
+				} else {
+					line += " (" + c.detail + ")"
+				}
+			}
+			if c.experimental {
+				line += " (experimental)"
+			}
+			lines = append(lines, line+".")
+		}
+		if len(lines) > 0 {
+			fmt.Fprintf(w, "\n### %s\n\n%s\n", sec.title, strings.Join(lines, "\n"))
+		}
+	}
+}
+
+// protocolDiff holds the state of a comparison.
+type protocolDiff struct {
+	old, new *proto.Protocol
+	changes  []schemaChange
+}
+
+// diffProtocol returns the changes from old to new, sorted by name.
+func diffProtocol(old, new *proto.Protocol) []schemaChange {
+	pd := &protocolDiff{old: old, new: new}
+	for _, od := range old.Domains {
+		nd, ok := new.Domain(od.Domain)
+		if !ok {
+			pd.add(schemaChange{kind: changeRemoved, item: "domain", name: od.Domain, breaking: true, experimental: od.Experimental})
+			continue
+		}
+		pd.diffDomain(od, nd)
+	}
+	for _, nd := range new.Domains {
+		if _, ok := old.Domain(nd.Domain); !ok {
+			pd.add(schemaChange{kind: changeAdded, item: "domain", name: nd.Domain, experimental: nd.Experimental})
+		}
+	}
+	sort.SliceStable(pd.changes, func(i, j int) bool {
+		return pd.changes[i].name < pd.changes[j].name
+	})
+	return pd.changes
+}
+
+func (pd *protocolDiff) add(c schemaChange) {
+	pd.changes = append(pd.changes, c)
+}
+
+// diffFlags reports changes to the experimental and deprecated status.
+func (pd *protocolDiff) diffFlags(item, name string, exp bool, oldExp, newExp, oldDep, newDep bool) {
+	if oldExp != newExp {
+		detail := "no longer experimental"
+		if newExp {
+			detail = "now experimental"
+		}
+		pd.add(schemaChange{kind: changeModified, item: item, name: name, detail: detail, experimental: exp})
+	}
+	if oldDep != newDep {
+		detail := "no longer deprecated"
+		if newDep {
+			detail = "now deprecated"
+		}
+		pd.add(schemaChange{kind: changeModified, item: item, name: name, detail: detail, experimental: exp})
+	}
+}
+
+func (pd *protocolDiff) diffDomain(od, nd proto.Domain) {
+	exp := od.Experimental || nd.Experimental
+	pd.diffFlags("domain", od.Domain, exp, od.Experimental, nd.Experimental, od.Deprecated, nd.Deprecated)
+
+	for _, oc := range od.Commands {
+		if oc.Redirect != "" {
+			continue // Not part of the bindings.
+		}
+		name := od.Domain + "." + oc.NameName
+		nc, ok := nd.Command(oc.NameName)
+		if !ok || nc.Redirect != "" {
+			pd.add(schemaChange{kind: changeRemoved, item: "command", name: name, breaking: true, experimental: exp || oc.Experimental})
+			continue
+		}
+		cexp := exp || oc.Experimental || nc.Experimental
+		pd.diffFlags("command", name, cexp, oc.Experimental, nc.Experimental, oc.Deprecated, nc.Deprecated)
+		pd.diffFields("parameter", name, cexp, true, nd, oc.Parameters, nc.Parameters)
+		pd.diffFields("return value", name, cexp, false, nd, oc.Returns, nc.Returns)
+	}
+	for _, nc := range nd.Commands {
+		if oc, ok := od.Command(nc.NameName); nc.Redirect == "" && (!ok || oc.Redirect != "") {
+			pd.add(schemaChange{kind: changeAdded, item: "command", name: nd.Domain + "." + nc.NameName, experimental: exp || nc.Experimental})
+		}
+	}
+
+	for _, oe := range od.Events {
+		name := od.Domain + "." + oe.NameName
+		ne, ok := nd.Event(oe.NameName)
+		if !ok {
+			pd.add(schemaChange{kind: changeRemoved, item: "event", name: name, breaking: true, experimental: exp || oe.Experimental})
+			continue
+		}
+		eexp := exp || oe.Experimental || ne.Experimental
+		pd.diffFlags("event", name, eexp, oe.Experimental, ne.Experimental, oe.Deprecated, ne.Deprecated)
+		pd.diffFields("parameter", name, eexp, false, nd, oe.Parameters, ne.Parameters)
+	}
+	for _, ne := range nd.Events {
+		if _, ok := od.Event(ne.NameName); !ok {
+			pd.add(schemaChange{kind: changeAdded, item: "event", name: nd.Domain + "." + ne.NameName, experimental: exp || ne.Experimental})
+		}
+	}
+
+	for _, ot := range od.Types {
+		name := od.Domain + "." + ot.IDName
+		nt, ok := nd.Type(ot.IDName)
+		if !ok {
+			pd.add(schemaChange{kind: changeRemoved, item: "type", name: name, breaking: true, experimental: exp || ot.Experimental})
+			continue
+		}
+		texp := exp || ot.Experimental || nt.Experimental
+		pd.diffFlags("type", name, texp, ot.Experimental, nt.Experimental, ot.Deprecated, nt.Deprecated)
+		if o, n := ot.TypeName(), nt.TypeName(); o != n {
+			pd.add(schemaChange{kind: changeModified, item: "type", name: name, detail: "type " + o + " changed to " + n, breaking: true, experimental: texp})
+			continue
+		}
+		// Named enums have constants, removing a value removes one.
+		pd.diffEnum("type", name, texp, isEnum(nt), ot.Enum, nt.Enum)
+		pd.diffFields("property", name, texp, false, nd, ot.Properties, nt.Properties)
+	}
+	for _, nt := range nd.Types {
+		if _, ok := od.Type(nt.IDName); !ok {
+			pd.add(schemaChange{kind: changeAdded, item: "type", name: nd.Domain + "." + nt.IDName, experimental: exp || nt.Experimental})
+		}
+	}
+}
+
+// diffFields compares the parameters, return values or properties of
+// parent. For command arguments (args) the required parameters are part
+// of the constructor (e.g. NewNavigateArgs), adding one or changing the
+// optionality breaks the Go API.
+func (pd *protocolDiff) diffFields(item, parent string, exp, args bool, nd proto.Domain, old, new []proto.AnyType) {
+	var removed, added []proto.AnyType
+	for _, o := range old {
+		n, ok := proto.Field(new, o.NameName)
+		if !ok {
+			removed = append(removed, o)
+			continue
+		}
+		name := parent + "." + o.NameName
+		fexp := exp || o.Experimental || n.Experimental
+		pd.diffFlags(item, name, fexp, o.Experimental, n.Experimental, o.Deprecated, n.Deprecated)
+
+		if ot, nt := o.TypeName(), n.TypeName(); ot != nt {
+			pd.add(schemaChange{kind: changeModified, item: item, name: name, detail: "type " + ot + " changed to " + nt, breaking: true, experimental: fexp})
+			continue
+		}
+		if o.Optional != n.Optional {
+			detail := "optional changed to required"
+			if n.Optional {
+				detail = "required changed to optional"
+			}
+			// Optional fields are pointers, unless the Go type is
+			// nillable (e.g. slices).
+			breaking := args || pd.isPointer(nd, n)
+			pd.add(schemaChange{kind: changeModified, item: item, name: name, detail: detail, breaking: breaking, experimental: fexp})
+		}
+		pd.diffEnum(item, name, fexp, false, o.Enum, n.Enum)
+	}
+	for _, n := range new {
+		if _, ok := proto.Field(old, n.NameName); !ok {
+			added = append(added, n)
+		}
+	}
+
+	// A single removed and added field of the same type is most likely
+	// a rename.
+	if len(removed) == 1 && len(added) == 1 && removed[0].TypeName() == added[0].TypeName() {
+		o, n := removed[0], added[0]
+		pd.add(schemaChange{
+			kind:         changeModified,
+			item:         item,
+			name:         parent + "." + o.NameName,
+			detail:       "renamed to " + n.NameName,
+			breaking:     true,
+			experimental: exp || n.Experimental,
+		})
+		return
+	}
+	for _, o := range removed {
+		pd.add(schemaChange{kind: changeRemoved, item: item, name: parent + "." + o.NameName, breaking: true, experimental: exp || o.Experimental})
+	}
+	for _, n := range added {
+		c := schemaChange{kind: changeAdded, item: item, name: parent + "." + n.NameName, experimental: exp || n.Experimental}
+		if args && !n.Optional {
+			c.detail = "required"
+			c.breaking = true
+		}
+		pd.add(c)
+	}
+}
+
+// diffEnum compares enum values, removing a value of a named enum
+// removes the Go constant.
+func (pd *protocolDiff) diffEnum(item, name string, exp, named bool, old, new []proto.Enum) {
+	has := func(enums []proto.Enum, e proto.Enum) bool {
+		for _, v := range enums {
+			if v == e {
+				return true
+			}
+		}
+		return false
+	}
+	for _, o := range old {
+		if !has(new, o) {
+			pd.add(schemaChange{kind: changeModified, item: item, name: name, detail: fmt.Sprintf("enum value %q removed", o), breaking: named, experimental: exp})
+		}
+	}
+	for _, n := range new {
+		if !has(old, n) {
+			pd.add(schemaChange{kind: changeModified, item: item, name: name, detail: fmt.Sprintf("enum value %q added", n), experimental: exp})
+		}
+	}
+}
+
+// isPointer reports if the optional field t (in domain d) is a pointer
+// in the generated Go struct.
+func (pd *protocolDiff) isPointer(d proto.Domain, t proto.AnyType) bool {
+	if t.Ref != "" {
+		ref := t.Ref
+		if !strings.ContainsRune(ref, '.') {
+			ref = d.Domain + "." + ref
+		}
+		i := strings.IndexByte(ref, '.')
+		rd, ok := pd.new.Domain(ref[:i])
+		if !ok {
+			return true
+		}
+		rt, ok := rd.Type(ref[i+1:])
+		if !ok {
+			return true
+		}
+		d, t = rd, rt
+	}
+	return !isNonPointer(strings.ToLower(domainName(d)), d, t)
+}
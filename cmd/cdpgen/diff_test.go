@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+func parseProtocol(t *testing.T, s string) *proto.Protocol {
+	t.Helper()
+	p := new(proto.Protocol)
+	if err := json.Unmarshal([]byte(s), p); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// pageDomain returns a protocol with only the Page domain, defined by
+// the (JSON) fields in s.
+func pageDomain(s string) string {
+	return `{"domains": [{"domain": "Page"` + s + `}]}`
+}
+
+func TestDiffProtocol(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{
+			name: "No changes",
+			old:  pageDomain(`, "commands": [{"name": "reload"}]`),
+			new:  pageDomain(`, "commands": [{"name": "reload"}]`),
+			want: nil,
+		},
+		{
+			name: "Domain added and removed",
+			old:  `{"domains": [{"domain": "Page"}, {"domain": "Storage", "experimental": true}]}`,
+			new:  `{"domains": [{"domain": "Page"}, {"domain": "Fetch"}]}`,
+			want: []string{
+				"+ domain Fetch",
+				"- domain Storage [experimental] [breaking]",
+			},
+		},
+		{
+			name: "Command added and removed",
+			old:  pageDomain(`, "commands": [{"name": "reload"}, {"name": "close"}]`),
+			new:  pageDomain(`, "commands": [{"name": "reload"}, {"name": "crash", "experimental": true}]`),
+			want: []string{
+				"- command Page.close [breaking]",
+				"+ command Page.crash [experimental]",
+			},
+		},
+		{
+			name: "Redirected command is removed",
+			old:  pageDomain(`, "commands": [{"name": "reload"}]`),
+			new:  pageDomain(`, "commands": [{"name": "reload", "redirect": "Runtime"}]`),
+			want: []string{"- command Page.reload [breaking]"},
+		},
+		{
+			name: "Parameter renamed",
+			old:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "url", "type": "string"}]}]`),
+			new:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "link", "type": "string"}]}]`),
+			want: []string{"~ parameter Page.navigate.url: renamed to link [breaking]"},
+		},
+		{
+			name: "Parameter replaced by different type",
+			old:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "url", "type": "string"}]}]`),
+			new:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "link", "type": "integer"}]}]`),
+			want: []string{
+				"+ parameter Page.navigate.link: required [breaking]",
+				"- parameter Page.navigate.url [breaking]",
+			},
+		},
+		{
+			name: "Optional parameter added",
+			old:  pageDomain(`, "commands": [{"name": "navigate"}]`),
+			new:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "referrer", "type": "string", "optional": true}]}]`),
+			want: []string{"+ parameter Page.navigate.referrer"},
+		},
+		{
+			name: "Parameter type changed",
+			old:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "url", "type": "string"}]}]`),
+			new:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "url", "type": "array", "items": {"type": "string"}}]}]`),
+			want: []string{"~ parameter Page.navigate.url: type string changed to array of string [breaking]"},
+		},
+		{
+			name: "Parameter optionality changed",
+			old:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "url", "type": "string"}]}]`),
+			new:  pageDomain(`, "commands": [{"name": "navigate", "parameters": [{"name": "url", "type": "string", "optional": true}]}]`),
+			want: []string{"~ parameter Page.navigate.url: required changed to optional [breaking]"},
+		},
+		{
+			name: "Return value optionality changed",
+			old: pageDomain(`, "commands": [{"name": "getFrames", "returns": [
+				{"name": "ids", "type": "array", "items": {"type": "string"}},
+				{"name": "name", "type": "string"}
+			]}]`),
+			new: pageDomain(`, "commands": [{"name": "getFrames", "returns": [
+				{"name": "ids", "type": "array", "items": {"type": "string"}, "optional": true},
+				{"name": "name", "type": "string", "optional": true}
+			]}]`),
+			want: []string{
+				"~ return value Page.getFrames.ids: required changed to optional",
+				"~ return value Page.getFrames.name: required changed to optional [breaking]",
+			},
+		},
+		{
+			name: "Enum values",
+			old:  pageDomain(`, "types": [{"id": "State", "type": "string", "enum": ["a", "b"]}]`),
+			new:  pageDomain(`, "types": [{"id": "State", "type": "string", "enum": ["a", "c"]}]`),
+			want: []string{
+				`~ type Page.State: enum value "b" removed [breaking]`,
+				`~ type Page.State: enum value "c" added`,
+			},
+		},
+		{
+			name: "Type changed",
+			old:  pageDomain(`, "types": [{"id": "FrameId", "type": "string"}]`),
+			new:  pageDomain(`, "types": [{"id": "FrameId", "type": "integer"}]`),
+			want: []string{"~ type Page.FrameId: type string changed to integer [breaking]"},
+		},
+		{
+			name: "Flags",
+			old:  pageDomain(`, "events": [{"name": "loadEventFired", "experimental": true}]`),
+			new:  pageDomain(`, "events": [{"name": "loadEventFired", "deprecated": true}]`),
+			want: []string{
+				"~ event Page.loadEventFired: no longer experimental [experimental]",
+				"~ event Page.loadEventFired: now deprecated [experimental]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range diffProtocol(parseProtocol(t, tt.old), parseProtocol(t, tt.new)) {
+				got = append(got, c.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffProtocol:\ngot  %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteChangelog(t *testing.T) {
+	old := parseProtocol(t, pageDomain(`, "commands": [
+		{"name": "navigate", "parameters": [{"name": "url", "type": "string"}]},
+		{"name": "close"}
+	], "types": [{"id": "State", "type": "string", "enum": ["a"]}]`))
+	new := parseProtocol(t, pageDomain(`, "commands": [
+		{"name": "navigate", "parameters": [{"name": "url", "type": "string"}, {"name": "referrer", "type": "string"}]},
+		{"name": "crash", "experimental": true}
+	], "types": [{"id": "State", "type": "string", "enum": ["a", "b"]}]`))
+
+	var buf bytes.Buffer
+	writeChangelog(&buf, diffProtocol(old, new))
+	want := "## Protocol changes\n" +
+		"\n### Breaking changes\n\n" +
+		"- Command `Page.close` removed.\n" +
+		"- Parameter `Page.navigate.referrer` added (required).\n" +
+		"\n### Added\n\n" +
+		"- Command `Page.crash` (experimental).\n" +
+		"\n### Changed\n\n" +
+		"- Type `Page.State`: enum value \"b\" added.\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeChangelog:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	buf.Reset()
+	writeChangelog(&buf, nil)
+	if got, want := buf.String(), "## Protocol changes\n\nNo changes.\n"; got != want {
+		t.Errorf("writeChangelog(nil): got %q, want %q", got, want)
+	}
+}
+
+func TestDiffMain(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, s string) string {
+		name = filepath.Join(dir, name)
+		if err := os.WriteFile(name, []byte(s), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+	old := write("old.json", pageDomain(`, "commands": [{"name": "reload"}]`))
+	added := write("added.json", pageDomain(`, "commands": [{"name": "reload"}, {"name": "close"}]`))
+	removed := write("removed.json", pageDomain(``))
+
+	for _, tt := range []struct {
+		args []string
+		want int
+	}{
+		{[]string{old, added}, 0},
+		{[]string{old, removed}, 1},
+		{[]string{"-breaking", old, added}, 0},
+		{[]string{"-changelog", old, removed}, 1},
+		{[]string{old, filepath.Join(dir, "missing.json")}, 2},
+		{[]string{old}, 2},
+	} {
+		if got := diffMain(tt.args); got != tt.want {
+			t.Errorf("diffMain(%q): got exit status %d, want %d", tt.args, got, tt.want)
+		}
+	}
+}
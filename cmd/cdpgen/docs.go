@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// docsAliases maps the circular types (package internal) to the domain
+// types they alias.
+var docsAliases = map[string]string{
+	"internal.PageFrameID":           "page.FrameID",
+	"internal.NetworkTimeSinceEpoch": "network.TimeSinceEpoch",
+	"internal.BrowserContextID":      "browser.ContextID",
+}
+
+// docs renders the markdown reference of the protocol (-docs).
+type docs struct {
+	pkg     string // Go package, e.g. github.com/mafredri/cdp.
+	domains []proto.Domain
+	buf     bytes.Buffer
+}
+
+// writeDocs writes the markdown reference of the domains to dir: an
+// index (README.md) and a file per domain with the commands, events and
+// types, cross-linked to each other and to the Go identifiers.
+func writeDocs(dir, pkg string, domains []proto.Domain) {
+	err := os.MkdirAll(dir, 0o755)
+	panicErr(err)
+
+	doc := &docs{pkg: pkg, domains: domains}
+	doc.Index()
+	doc.writeFile(filepath.Join(dir, "README.md"))
+	for _, d := range domains {
+		doc.Domain(d)
+		doc.writeFile(filepath.Join(dir, docsFile(d.Domain)))
+	}
+}
+
+func (doc *docs) Printf(format string, args ...interface{}) {
+	fmt.Fprintf(&doc.buf, format, args...)
+}
+
+func (doc *docs) writeFile(name string) {
+	log.Printf("Writing %s...", name)
+	err := os.WriteFile(name, doc.buf.Bytes(), 0o644)
+	panicErr(err)
+	doc.buf.Reset()
+}
+
+// Index writes the list of domains.
+func (doc *docs) Index() {
+	doc.Printf("# Chrome DevTools Protocol reference\n\n")
+	doc.Printf("The domains of the protocol and their Go bindings (package [`cdp`](%s)). Generated by cdpgen, do not edit.\n\n", doc.godoc("", ""))
+	doc.Printf("| Domain | Go package | Description |\n|---|---|---|\n")
+	for _, d := range doc.domains {
+		pkgName := strings.ToLower(domainName(d))
+		doc.Printf("| [%s](%s)%s | [`%s`](%s) | %s |\n",
+			d.Domain, docsFile(d.Domain), badges(d.Experimental, d.Deprecated, false),
+			pkgName, doc.godoc(pkgName, ""), cell(firstSentence(d.Description)))
+	}
+}
+
+// Domain writes the reference of d.
+func (doc *docs) Domain(d proto.Domain) {
+	pkgName := strings.ToLower(domainName(d))
+	doc.Printf("# %s%s\n\n", d.Domain, badges(d.Experimental, d.Deprecated, false))
+	if d.Description != "" {
+		doc.Printf("%s\n\n", paragraph(d.Description))
+	}
+	doc.Printf("Go: [`cdp.%[1]s`](%[2]s) (`Client.%[1]s`), package [`%[3]s`](%[4]s).\n\n",
+		domainName(d), doc.godoc("", domainName(d)), pkgName, doc.godoc(pkgName, ""))
+	if len(d.Dependencies) > 0 {
+		var deps []string
+		for _, dep := range d.Dependencies {
+			deps = append(deps, fmt.Sprintf("[%s](%s)", dep, docsFile(dep)))
+		}
+		doc.Printf("Depends on: %s.\n\n", strings.Join(deps, ", "))
+	}
+	doc.Printf("[Index](README.md)")
+	for _, s := range []struct {
+		name string
+		n    int
+	}{{"Commands", len(d.Commands)}, {"Events", len(d.Events)}, {"Types", len(d.Types)}} {
+		if s.n > 0 {
+			doc.Printf(" · [%s](#%s)", s.name, strings.ToLower(s.name))
+		}
+	}
+	doc.Printf("\n")
+
+	if len(d.Commands) > 0 {
+		doc.Printf("\n## Commands\n")
+		for _, c := range d.Commands {
+			if c.Redirect != "" {
+				continue
+			}
+			doc.Command(d, c)
+		}
+	}
+	if len(d.Events) > 0 {
+		doc.Printf("\n## Events\n")
+		for _, e := range d.Events {
+			doc.Event(d, e)
+		}
+	}
+	if len(d.Types) > 0 {
+		doc.Printf("\n## Types\n")
+		for _, t := range d.Types {
+			doc.Type(d, t)
+		}
+	}
+}
+
+// Command writes the reference of c with the Go method and the
+// constructor of the arguments.
+func (doc *docs) Command(d proto.Domain, c proto.Command) {
+	pkgName := strings.ToLower(domainName(d))
+	doc.Printf("\n### %s.%s\n\n", d.Domain, c.NameName)
+	if b := badges(c.Experimental, c.Deprecated, false); b != "" {
+		doc.Printf("%s\n\n", strings.TrimSpace(b))
+	}
+	if c.Description != "" {
+		doc.Printf("%s\n\n", paragraph(c.Description))
+	}
+
+	args, reply := "", "error"
+	if len(c.Parameters) > 0 {
+		args = ", *" + pkgName + "." + argsName(c, d)
+	}
+	if len(c.Returns) > 0 {
+		reply = fmt.Sprintf("(*%s.%s, error)", pkgName, replyName(c, d))
+	}
+	doc.Printf("Go: [`%s.%s`](%s)\n\n```go\n", domainName(d), commandName(c), doc.godoc("", domainName(d)+"."+commandName(c)))
+	doc.Printf("%s(context.Context%s) %s\n", commandName(c), args, reply)
+	if len(c.Parameters) > 0 {
+		doc.Printf("\n// package %s\n", pkgName)
+		doc.Printf("func New%[1]s(%[2]s) *%[1]s\n", argsName(c, d), argsSignature(c, pkgName, d))
+		if argOptions && hasOptional(c.Parameters) {
+			sig := "opts ..." + commandName(c) + "Option"
+			if s := argsSignature(c, pkgName, d); s != "" {
+				sig = s + ", " + sig
+			}
+			doc.Printf("func New%[1]sWith(%[2]s) *%[1]s\n", argsName(c, d), sig)
+		}
+	}
+	doc.Printf("```\n")
+
+	if len(c.Parameters) > 0 {
+		doc.Printf("\nParameters ([`%s.%s`](%s)):\n\n", pkgName, argsName(c, d), doc.godoc(pkgName, argsName(c, d)))
+		doc.Properties(d, c.Parameters)
+	}
+	if len(c.Returns) > 0 {
+		doc.Printf("\nReturns ([`%s.%s`](%s)):\n\n", pkgName, replyName(c, d), doc.godoc(pkgName, replyName(c, d)))
+		doc.Properties(d, c.Returns)
+	}
+}
+
+// Event writes the reference of e with the Go method for the event
+// client.
+func (doc *docs) Event(d proto.Domain, e proto.Event) {
+	pkgName := strings.ToLower(domainName(d))
+	doc.Printf("\n### %s.%s\n\n", d.Domain, e.NameName)
+	if b := badges(e.Experimental, e.Deprecated, false); b != "" {
+		doc.Printf("%s\n\n", strings.TrimSpace(b))
+	}
+	if e.Description != "" {
+		doc.Printf("%s\n\n", paragraph(e.Description))
+	}
+	doc.Printf("Go: [`%s.%s`](%s)\n\n```go\n", domainName(d), eventName(e), doc.godoc("", domainName(d)+"."+eventName(e)))
+	doc.Printf("%s(context.Context) (%s.%sClient, error)\n```\n", eventName(e), pkgName, eventTypeName(e, d))
+	if len(e.Parameters) > 0 {
+		doc.Printf("\nParameters ([`%s.%s`](%s)):\n\n", pkgName, eventReplyName(e, d), doc.godoc(pkgName, eventReplyName(e, d)))
+		doc.Properties(d, e.Parameters)
+	}
+}
+
+// Type writes the reference of t: the Go type, and the properties or
+// the enum values.
+func (doc *docs) Type(d proto.Domain, t proto.AnyType) {
+	pkgName := strings.ToLower(domainName(d))
+	name := typeName(t, d)
+	doc.Printf("\n### %s.%s\n\n", d.Domain, t.IDName)
+	if b := badges(t.Experimental, t.Deprecated, false); b != "" {
+		doc.Printf("%s\n\n", strings.TrimSpace(b))
+	}
+	if t.Description != "" {
+		doc.Printf("%s\n\n", paragraph(t.Description))
+	}
+
+	typ := goTypeOf(t, pkgName, d)
+	switch {
+	case typ == "struct":
+		typ = "struct{ ... }"
+	case typ == "enum":
+		typ = "string"
+	case typ == "RawMessage":
+		typ = "[]byte"
+	case strings.HasPrefix(typ, "= "):
+		// Alias of a circular type (package internal).
+	default:
+		typ = doc.qualify(pkgName, typ)
+	}
+	doc.Printf("Go: [`%s.%s`](%s)\n\n```go\ntype %s %s\n```\n", pkgName, name, doc.godoc(pkgName, name), name, typ)
+
+	switch {
+	case isEnum(t):
+		doc.Printf("\n| Value | Go constant |\n|---|---|\n")
+		for _, e := range t.Enum {
+			doc.Printf("| `%q` | `%s.%s%s` |\n", e, pkgName, name, enumName(e))
+		}
+	case len(t.Properties) > 0:
+		doc.Printf("\nProperties:\n\n")
+		doc.Properties(d, t.Properties)
+	}
+}
+
+// Properties writes a table of the parameters, return values or
+// properties.
+func (doc *docs) Properties(d proto.Domain, props []proto.AnyType) {
+	doc.Printf("| Name | Go field | Type | Description |\n|---|---|---|---|\n")
+	for _, p := range props {
+		desc := cell(p.Description)
+		if isLocalEnum(p) {
+			var values []string
+			for _, e := range p.Enum {
+				values = append(values, fmt.Sprintf("`%q`", e))
+			}
+			desc = strings.TrimSpace(desc + " Values: " + strings.Join(values, ", ") + ".")
+		}
+		doc.Printf("| `%s`%s | `%s` | %s | %s |\n",
+			p.NameName, badges(p.Experimental, p.Deprecated, p.Optional),
+			fieldName(p, d), doc.typeLink(d, p), desc)
+	}
+}
+
+// typeLink returns the Go type of p, linked to the referenced protocol
+// type.
+func (doc *docs) typeLink(d proto.Domain, p proto.AnyType) string {
+	pkgName := strings.ToLower(domainName(d))
+	typ := fmt.Sprintf("`%s`", doc.qualify(pkgName, goTypeOf(p, pkgName, d)))
+
+	ref := p.Ref
+	if p.Items != nil {
+		ref = p.Items.Ref
+	}
+	if ref == "" {
+		return typ
+	}
+	domain, name, ok := strings.Cut(ref, ".")
+	if !ok {
+		domain, name = d.Domain, ref
+	}
+	link := "#" + docsAnchor(domain+"."+name)
+	if domain != d.Domain {
+		link = docsFile(domain) + link
+	}
+	return fmt.Sprintf("[%s](%s)", typ, link)
+}
+
+// qualify returns the Go type typ, as written in package pkgName,
+// qualified by the package (e.g. FrameID becomes page.FrameID).
+func (doc *docs) qualify(pkgName, typ string) string {
+	prefix := ""
+	for strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "*") {
+		n := 1
+		if typ[0] == '[' {
+			n = 2
+		}
+		prefix, typ = prefix+typ[:n], typ[n:]
+	}
+	if alias, ok := docsAliases[typ]; ok {
+		typ = alias
+	}
+	if !strings.Contains(typ, ".") && typ != "" && typ[0] >= 'A' && typ[0] <= 'Z' {
+		typ = pkgName + "." + typ
+	}
+	return prefix + typ
+}
+
+// godoc returns the pkg.go.dev URL of the identifier ident (optional) in
+// the domain package pkgName, or package cdp if pkgName is empty.
+func (doc *docs) godoc(pkgName, ident string) string {
+	u := "https://pkg.go.dev/" + doc.pkg
+	if pkgName != "" {
+		u += "/protocol/" + pkgName
+	}
+	if ident != "" {
+		u += "#" + ident
+	}
+	return u
+}
+
+func hasOptional(params []proto.AnyType) bool {
+	for _, p := range params {
+		if p.Optional {
+			return true
+		}
+	}
+	return false
+}
+
+// docsFile returns the file name for the domain.
+func docsFile(domain string) string {
+	return strings.ToLower(domain) + ".md"
+}
+
+// docsAnchor returns the anchor of a heading (as generated by GitHub),
+// e.g. "Page.FrameId" becomes "pageframeid".
+func docsAnchor(heading string) string {
+	return strings.ToLower(strings.ReplaceAll(heading, ".", ""))
+}
+
+// badges returns the badges for the flags, e.g. " `experimental`".
+func badges(experimental, deprecated, optional bool) string {
+	var s string
+	if optional {
+		s += " <sub>optional</sub>"
+	}
+	if experimental {
+		s += " `experimental`"
+	}
+	if deprecated {
+		s += " `deprecated`"
+	}
+	return s
+}
+
+// paragraph returns the description as markdown text.
+func paragraph(desc string) string {
+	return strings.TrimSpace(desc)
+}
+
+// cell returns the description for a table cell, on a single line.
+func cell(desc string) string {
+	desc = strings.Join(strings.Fields(desc), " ")
+	return strings.ReplaceAll(desc, "|", `\|`)
+}
+
+// firstSentence returns the first sentence of the description.
+func firstSentence(desc string) string {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if i := strings.Index(desc, ". "); i >= 0 {
+		return desc[:i+1]
+	}
+	return desc
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update .golden files")
+
+const docsProtocol = `{"domains": [
+	{"domain": "Page", "description": "Actions and events related to the inspected page.", "dependencies": ["Network"], "commands": [
+		{"name": "navigate", "description": "Navigates current page to the given URL.", "parameters": [
+			{"name": "url", "type": "string", "description": "URL to navigate the page to."},
+			{"name": "referrer", "type": "string", "optional": true, "description": "Referrer URL."},
+			{"name": "transitionType", "type": "string", "optional": true, "experimental": true, "enum": ["link", "typed"]}
+		], "returns": [
+			{"name": "frameId", "$ref": "FrameId", "description": "Frame id that has navigated."},
+			{"name": "loaderId", "$ref": "Network.LoaderId", "optional": true}
+		]},
+		{"name": "crash", "experimental": true, "description": "Crashes renderer on the IO thread."},
+		{"name": "clearDeviceMetricsOverride", "redirect": "Emulation"}
+	], "events": [
+		{"name": "frameNavigated", "deprecated": true, "description": "Fired once navigation of the frame has completed.", "parameters": [
+			{"name": "frames", "type": "array", "items": {"$ref": "Frame"}}
+		]}
+	], "types": [
+		{"id": "FrameId", "type": "string", "description": "Unique frame identifier."},
+		{"id": "Frame", "type": "object", "description": "Information about the Frame on the page.", "properties": [
+			{"name": "id", "$ref": "FrameId"},
+			{"name": "url", "type": "string", "description": "Frame document's URL | without fragment."}
+		]},
+		{"id": "TransitionType", "type": "string", "experimental": true, "deprecated": true, "enum": ["link", "typed"]}
+	]},
+	{"domain": "Network", "experimental": true, "description": "Network domain allows tracking network activities of the page. It exposes information about http requests.", "types": [
+		{"id": "LoaderId", "type": "string", "description": "Unique loader identifier."}
+	]}
+]}`
+
+func TestWriteDocs(t *testing.T) {
+	defer func(v bool) { argOptions = v }(argOptions)
+	argOptions = true
+
+	p := parseProtocol(t, docsProtocol)
+	prepareDomains("github.com/mafredri/cdp", "github.com/mafredri/cdp", p.Domains)
+
+	dir := t.TempDir()
+	writeDocs(dir, "github.com/mafredri/cdp", p.Domains)
+
+	for _, name := range []string{"README.md", "page.md", "network.md"} {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := filepath.Join("testdata", "docs", name)
+		if *update {
+			if err = os.WriteFile(out, got, 0o666); err != nil {
+				t.Error(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: output does not match golden file %s:\n%s", name, out, got)
+		}
+	}
+
+	readme, err := os.ReadFile(filepath.Join(dir, "README.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := os.ReadFile(filepath.Join(dir, "page.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		doc  []byte
+		want string
+	}{
+		{"domain", readme, "| [Page](page.md) | [`page`](https://pkg.go.dev/github.com/mafredri/cdp/protocol/page) | Actions and events related to the inspected page. |"},
+		{"experimental domain", readme, "| [Network](network.md) `experimental` |"},
+		{"first sentence", readme, "| Network domain allows tracking network activities of the page. |"},
+		{"dependency", page, "Depends on: [Network](network.md)."},
+		{"experimental command", page, "### Page.crash\n\n`experimental`\n"},
+		{"deprecated event", page, "### Page.frameNavigated\n\n`deprecated`\n"},
+		{"experimental and deprecated type", page, "### Page.TransitionType\n\n`experimental` `deprecated`\n"},
+		{"optional experimental parameter", page, "| `transitionType` <sub>optional</sub> `experimental` |"},
+		{"local enum", page, "Values: `\"link\"`, `\"typed\"`."},
+		{"link to type", page, "[`page.FrameID`](#pageframeid)"},
+		{"link to array type", page, "[`[]page.Frame`](#pageframe)"},
+		{"link to other domain", page, "[`network.LoaderID`](network.md#networkloaderid)"},
+		{"godoc link", page, "Go: [`Page.Navigate`](https://pkg.go.dev/github.com/mafredri/cdp#Page.Navigate)"},
+		{"options constructor", page, "func NewNavigateArgsWith(url string, opts ...NavigateOption) *NavigateArgs"},
+		{"enum constant", page, "| `\"typed\"` | `page.TransitionTypeTyped` |"},
+		{"escaped cell", page, "Frame document's URL \\| without fragment."},
+	} {
+		if !bytes.Contains(tt.doc, []byte(tt.want)) {
+			t.Errorf("%s: missing %q", tt.name, tt.want)
+		}
+	}
+	if strings.Contains(string(page), "clearDeviceMetricsOverride") {
+		t.Error("redirected command: got Page.clearDeviceMetricsOverride, want omitted")
+	}
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// extraProto is a vendor protocol definition (e.g. Edge), given via
+// -extra-proto tag=path.
+type extraProto struct {
+	tag  string // Build tag for the generated files, e.g. "edge".
+	path string
+}
+
+// extraProtoFlag implements flag.Value, the flag can be repeated.
+type extraProtoFlag []extraProto
+
+var validTag = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+func (f *extraProtoFlag) String() string {
+	var s []string
+	for _, e := range *f {
+		s = append(s, e.tag+"="+e.path)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f *extraProtoFlag) Set(v string) error {
+	i := strings.IndexByte(v, '=')
+	if i == -1 {
+		return errors.New("want tag=path, e.g. edge=./protodef/edge.json")
+	}
+	e := extraProto{tag: v[:i], path: v[i+1:]}
+	if !validTag.MatchString(e.tag) {
+		return fmt.Errorf("invalid tag %q, must be lowercase alphanumeric", e.tag)
+	}
+	for _, e2 := range *f {
+		if e2.tag == e.tag {
+			return fmt.Errorf("duplicate tag %q", e.tag)
+		}
+	}
+	*f = append(*f, e)
+	return nil
+}
+
+// extendDomains returns the domains, commands, events and types in ext
+// that are missing from base. Domains that do not exist in base are
+// returned in full and reported in added.
+func extendDomains(base, ext []proto.Domain) (domains []proto.Domain, added map[string]bool) {
+	baseProto := proto.Protocol{Domains: base}
+	added = make(map[string]bool)
+	for _, d := range ext {
+		bd, ok := baseProto.Domain(d.Domain)
+		if !ok {
+			domains = append(domains, d)
+			added[d.Domain] = true
+			continue
+		}
+
+		x := proto.Domain{
+			Domain:       d.Domain,
+			Experimental: d.Experimental,
+			Deprecated:   d.Deprecated,
+		}
+		for _, t := range d.Types {
+			if _, ok := bd.Type(t.IDName); !ok {
+				x.Types = append(x.Types, t)
+			}
+		}
+		for _, c := range d.Commands {
+			if c.Redirect == "" && !baseProto.HasCommand(d.Domain+"."+c.NameName) {
+				x.Commands = append(x.Commands, c)
+			}
+		}
+		for _, e := range d.Events {
+			if !baseProto.HasEvent(d.Domain + "." + e.NameName) {
+				x.Events = append(x.Events, e)
+			}
+		}
+		if len(x.Types)+len(x.Commands)+len(x.Events) > 0 {
+			domains = append(domains, x)
+		}
+	}
+	domains = pruneUnresolved(base, domains)
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].Domain < domains[j].Domain
+	})
+	return domains, added
+}
+
+// pruneUnresolved removes the types, commands and events that cannot be
+// generated because they reference types that do not exist (e.g. types
+// removed from the base protocol) or use inline object definitions.
+func pruneUnresolved(base, domains []proto.Domain) []proto.Domain {
+	known := make(map[string]bool)
+	for _, ds := range [][]proto.Domain{base, domains} {
+		for _, d := range ds {
+			for _, t := range d.Types {
+				known[d.Domain+"."+t.IDName] = true
+			}
+		}
+	}
+	resolved := func(d proto.Domain, types ...proto.AnyType) bool {
+		for _, t := range types {
+			if !typeResolved(known, d, t) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Removing a type can make other types unresolved, repeat until
+	// nothing changes.
+	for changed := true; changed; {
+		changed = false
+		for _, d := range domains {
+			for _, t := range d.Types {
+				key := d.Domain + "." + t.IDName
+				if !known[key] {
+					continue
+				}
+				if !resolved(d, t.Properties...) || (t.Items != nil && !resolved(d, *t.Items)) {
+					log.Printf("Skipping type %s, unresolved reference...", key)
+					delete(known, key)
+					changed = true
+				}
+			}
+		}
+	}
+
+	var pruned []proto.Domain
+	for _, d := range domains {
+		x := d
+		x.Types, x.Commands, x.Events = nil, nil, nil
+		for _, t := range d.Types {
+			if known[d.Domain+"."+t.IDName] {
+				x.Types = append(x.Types, t)
+			}
+		}
+		for _, c := range d.Commands {
+			if resolved(d, c.Parameters...) && resolved(d, c.Returns...) {
+				x.Commands = append(x.Commands, c)
+			} else {
+				log.Printf("Skipping command %s.%s, unresolved reference...", d.Domain, c.NameName)
+			}
+		}
+		for _, e := range d.Events {
+			if resolved(d, e.Parameters...) {
+				x.Events = append(x.Events, e)
+			} else {
+				log.Printf("Skipping event %s.%s, unresolved reference...", d.Domain, e.NameName)
+			}
+		}
+		if len(x.Types)+len(x.Commands)+len(x.Events) > 0 {
+			pruned = append(pruned, x)
+		}
+	}
+	return pruned
+}
+
+// typeResolved reports if the (property) type t can be generated.
+func typeResolved(known map[string]bool, d proto.Domain, t proto.AnyType) bool {
+	switch {
+	case t.Ref != "":
+		ref := t.Ref
+		if !strings.ContainsRune(ref, '.') {
+			ref = d.Domain + "." + ref
+		}
+		return known[ref]
+	case t.Type == "object" && len(t.Properties) > 0:
+		return false // Inline objects are not supported.
+	case t.Items != nil:
+		return typeResolved(known, d, *t.Items)
+	}
+	return true
+}
+
+// writeExtraProtocol generates the vendor extensions in e as files
+// constrained by the build tag e.tag. Extended domains get additional
+// files (e.g. protocol/page/edge_command.go), new domains a new package.
+// The cdp.<Tag>Client provides access to the extensions.
+func writeExtraProtocol(dest, pkg string, imports []string, base []proto.Domain, e extraProto) {
+	data, err := os.ReadFile(e.path)
+	panicErr(err)
+
+	var p proto.Protocol
+	err = json.Unmarshal(data, &p)
+	panicErr(err)
+
+	domains, added := extendDomains(base, p.Domains)
+	if len(domains) == 0 {
+		log.Printf("No extensions in %s, skipping...", e.path)
+		return
+	}
+
+	indexJSONTypes(domains)
+
+	protoDest := filepath.Join(dest, "protocol")
+	for _, d := range domains {
+		if added[d.Domain] {
+			imports = append(imports, filepath.Join(pkg, "protocol", strings.ToLower(domainName(d))))
+		}
+		for _, t := range d.Types {
+			if isNonPointer(d.Domain, d, t) {
+				nam := typeName(t, d)
+				nonPtrMap[nam] = true
+				nonPtrMap[d.Domain+"."+nam] = true
+			}
+		}
+	}
+
+	prefix := strings.ToUpper(e.tag[:1]) + e.tag[1:]
+
+	cdp := Generator{pkg: "cdp", dir: dest, imports: imports, buildTag: e.tag}
+	cdp.PackageHeader("")
+	for _, d := range domains {
+		name := prefix + domainName(d)
+		comment := fmt.Sprintf("The %s domain (%s). ", domainName(d), prefix)
+		if !added[d.Domain] {
+			comment = fmt.Sprintf("The %s extensions of the %s domain.", prefix, domainName(d))
+		}
+		cdp.domainInterface(d, name, comment)
+	}
+	cdp.ExtraClient(prefix, domains)
+	cdp.writeFile("cdp_" + e.tag + ".go")
+
+	g := Generator{imports: imports, buildTag: e.tag}
+	for _, d := range domains {
+		dLower := strings.ToLower(d.Domain)
+		g.dir = filepath.Join(protoDest, dLower)
+		g.pkg = dLower
+		err := mkdir(g.path())
+		panicErr(err)
+
+		filePrefix := e.tag + "_"
+		if added[d.Domain] {
+			// The package documentation is not constrained by the
+			// build tag, otherwise the package would be empty.
+			doc := Generator{pkg: dLower, dir: g.dir}
+			doc.hasContent = true
+			comment := fmt.Sprintf("Package %s implements the %s domain (%s). ", dLower, domainName(d), e.tag)
+			doc.PackageHeader(fmt.Sprintf("// %s%s\n//\n// Build with the %q tag to use this package.", comment, domainDesc(d, 0, len(comment)), e.tag))
+			doc.writeFile("doc.go")
+
+			g.PackageHeader("")
+			g.DomainDefinition(d)
+		} else {
+			g.PackageHeader("")
+			g.DomainMethods(d)
+		}
+		g.writeFile(filePrefix + "domain.go")
+
+		g.DomainFiles(d, filePrefix)
+	}
+
+	writeRegistry(dest, imports, e.tag, e.tag+"_domains.go", domains)
+}
+
+// ExtraClient creates the cdp.<Prefix>Client type for vendor extensions.
+func (g *Generator) ExtraClient(prefix string, domains []proto.Domain) {
+	g.hasContent = true
+	var fields, newFields Generator
+	for _, d := range domains {
+		fields.Printf("\t%s %s%s\n", domainName(d), prefix, domainName(d))
+		newFields.Printf("\t\t%s: %s.NewClient(conn),\n", domainName(d), strings.ToLower(domainName(d)))
+	}
+	g.Printf(`
+// %[1]sClient provides the domains, commands and events that are added
+// by the %[1]s protocol, it is used alongside Client.
+type %[1]sClient struct {
+	%[2]s
+	conn *rpcc.Conn
+}
+
+// New%[1]sClient returns a new %[1]sClient that uses conn
+// for communication with the debugging target.
+func New%[1]sClient(conn *rpcc.Conn) *%[1]sClient {
+	return &%[1]sClient{
+		%[3]s
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the %[1]sClient. Conn
+// returns nil if the %[1]sClient was not created by New%[1]sClient.
+func (c *%[1]sClient) Conn() *rpcc.Conn {
+	return c.conn
+}
+`, prefix, fields.buf.Bytes(), newFields.buf.Bytes())
+}
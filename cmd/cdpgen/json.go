@@ -0,0 +1,383 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// jsonTag is the build tag that enables the generated MarshalJSON and
+// UnmarshalJSON methods (using EncodeJSON and DecodeJSON).
+const jsonTag = "cdpjson"
+
+// jsonKind describes how a protocol type is encoded.
+type jsonKind int
+
+const (
+	jsonBool jsonKind = iota
+	jsonInt
+	jsonFloat
+	jsonString
+	jsonBase64    // []byte.
+	jsonAny       // json.RawMessage.
+	jsonTime      // float64 implementing json.Marshaler.
+	jsonRaw       // []byte implementing json.Marshaler.
+	jsonStruct    // Generated EncodeJSON and DecodeJSON.
+	jsonArray     // Slice of items.
+	jsonUnmarshal // Generated EncodeJSON, hand-written UnmarshalJSON.
+)
+
+type jsonRef struct {
+	d proto.Domain
+	t proto.AnyType
+}
+
+// jsonTypes indexes the protocol types by "Domain.ID".
+var jsonTypes = make(map[string]jsonRef)
+
+// customUnmarshal are the struct types with hand-written UnmarshalJSON
+// methods, DecodeJSON is not generated for them.
+var customUnmarshal = map[string]bool{
+	"Network.CookiePartitionKey": true,
+}
+
+func indexJSONTypes(domains []proto.Domain) {
+	for _, d := range domains {
+		for _, t := range d.Types {
+			jsonTypes[d.Domain+"."+t.IDName] = jsonRef{d: d, t: t}
+		}
+	}
+}
+
+// jsonKindOf returns the kind of t in domain d, references are resolved.
+// For jsonArray, items is the item type (references qualified so that
+// they can be used in d).
+func jsonKindOf(pkg string, d proto.Domain, t proto.AnyType) (kind jsonKind, items proto.AnyType) {
+	if t.Ref != "" {
+		ref := t.Ref
+		if !strings.ContainsRune(ref, '.') {
+			ref = d.Domain + "." + ref
+		}
+		r, ok := jsonTypes[ref]
+		if !ok {
+			panic("cdpgen: unknown reference: " + ref)
+		}
+		kind, items = jsonKindOf(strings.ToLower(domainName(r.d)), r.d, r.t)
+		switch {
+		case kind == jsonStruct && customUnmarshal[ref]:
+			kind = jsonUnmarshal
+		case kind == jsonArray && items.Ref != "" && !strings.ContainsRune(items.Ref, '.') && r.d.Domain != d.Domain:
+			items.Ref = r.d.Domain + "." + items.Ref
+		}
+		return kind, items
+	}
+
+	switch typ := goTypeOf(t, pkg, d); typ {
+	case "bool":
+		return jsonBool, items
+	case "int":
+		return jsonInt, items
+	case "float64":
+		return jsonFloat, items
+	case "string", "enum", "= internal.PageFrameID", "= internal.BrowserContextID":
+		return jsonString, items
+	case "[]byte":
+		return jsonBase64, items
+	case "json.RawMessage":
+		return jsonAny, items
+	case "time.Time", "= internal.NetworkTimeSinceEpoch":
+		return jsonTime, items
+	case "RawMessage":
+		return jsonRaw, items
+	case "struct":
+		return jsonStruct, items
+	default:
+		if strings.HasPrefix(typ, "[]") && t.Items != nil {
+			return jsonArray, *t.Items
+		}
+		panic("cdpgen: unhandled JSON type: " + typ)
+	}
+}
+
+// jsonField is a struct field and its protocol type.
+type jsonField struct {
+	name   string // Go name.
+	goType string
+	prop   proto.AnyType
+}
+
+func (g *Generator) jsonFields(d proto.Domain, name string, props []proto.AnyType, renameOptional bool) []jsonField {
+	var fields []jsonField
+	for _, prop := range props {
+		exportedName := fieldName(prop, d)
+		if renameOptional && prop.Optional {
+			exportedName = OptionalPropPrefix + exportedName
+		}
+		fields = append(fields, jsonField{
+			name:   exportedName,
+			goType: g.fieldType(d, name, prop, true),
+			prop:   prop,
+		})
+	}
+	return fields
+}
+
+// DomainJSON writes the EncodeJSON and DecodeJSON methods for the types,
+// arguments and replies in the domain.
+func (g *Generator) DomainJSON(d proto.Domain) {
+	g.eachJSONStruct(d, func(name string, fields []jsonField, decode bool) {
+		g.hasContent = true
+		g.jsonEncodeStruct(d, name, fields)
+		if decode {
+			g.jsonDecodeStruct(d, name, fields)
+		}
+	})
+}
+
+// DomainJSONMarshal writes the MarshalJSON and UnmarshalJSON methods
+// for the types, arguments and replies in the domain.
+func (g *Generator) DomainJSONMarshal(d proto.Domain) {
+	g.eachJSONStruct(d, func(name string, _ []jsonField, decode bool) {
+		g.hasContent = true
+		g.Printf(`
+// MarshalJSON implements json.Marshaler.
+func (v %[1]s) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+`, name)
+		if decode {
+			g.Printf(`
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *%[1]s) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+`, name)
+		}
+	})
+}
+
+func (g *Generator) eachJSONStruct(d proto.Domain, fn func(name string, fields []jsonField, decode bool)) {
+	for _, t := range d.Types {
+		if goTypeOf(t, g.pkg, d) != "struct" {
+			continue
+		}
+		name := typeName(t, d)
+		fn(name, g.jsonFields(d, name, t.Properties, false), !customUnmarshal[d.Domain+"."+t.IDName])
+	}
+	for _, c := range d.Commands {
+		if c.Redirect != "" {
+			continue
+		}
+		if len(c.Parameters) > 0 {
+			name := argsName(c, d)
+			fn(name, g.jsonFields(d, name, c.Parameters, true), true)
+		}
+		if len(c.Returns) > 0 {
+			name := replyName(c, d)
+			fn(name, g.jsonFields(d, name, c.Returns, false), true)
+		}
+	}
+	for _, e := range d.Events {
+		name := eventReplyName(e, d)
+		fn(name, g.jsonFields(d, name, e.Parameters, false), true)
+	}
+}
+
+func (g *Generator) jsonEncodeStruct(d proto.Domain, name string, fields []jsonField) {
+	g.Printf(`
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *%s) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+`, name)
+	for _, f := range fields {
+		expr := "v." + f.name
+		kind, _ := jsonKindOf(g.pkg, d, f.prop)
+		ptr := strings.HasPrefix(f.goType, "*")
+
+		cond := g.jsonSetCond(d, f, expr)
+		if cond != "" {
+			g.Printf("if %s {\n", cond)
+		}
+		g.Printf("w.Key(%q)\n", f.prop.NameName)
+		switch {
+		case ptr && !f.prop.Optional:
+			// Recursive type.
+			g.Printf("if %s == nil {\nw.Null()\n} else {\n", expr)
+			g.jsonEncode(d, f.prop, "*"+expr, 0, false)
+			g.Printf("}\n")
+		case ptr:
+			g.jsonEncode(d, f.prop, "*"+expr, 0, false)
+		default:
+			g.jsonEncode(d, f.prop, expr, 0, kind == jsonArray && cond != "")
+		}
+		if cond != "" {
+			g.Printf("}\n")
+		}
+	}
+	g.Printf(`	w.ObjectEnd()
+}
+`)
+}
+
+// jsonSetCond returns the condition for expr (field f) being encoded,
+// i.e. not omitted because of omitempty. Returns an empty string if the
+// field is always encoded.
+func (g *Generator) jsonSetCond(d proto.Domain, f jsonField, expr string) string {
+	kind, _ := jsonKindOf(g.pkg, d, f.prop)
+	switch {
+	case strings.HasPrefix(f.goType, "*"):
+		return expr + " != nil"
+	case !f.prop.Optional || kind == jsonStruct || kind == jsonUnmarshal:
+		return "" // Always encoded (encoding/json never omits structs).
+	case kind == jsonArray || kind == jsonBase64 || kind == jsonAny || kind == jsonRaw:
+		return "len(" + expr + ") > 0"
+	case kind == jsonString:
+		return expr + ` != ""`
+	case kind == jsonBool:
+		return expr
+	default:
+		return expr + " != 0"
+	}
+}
+
+// jsonEncode writes the statements for encoding expr (of type t), nil
+// checks are omitted for arrays if notNil is true.
+func (g *Generator) jsonEncode(d proto.Domain, t proto.AnyType, expr string, depth int, notNil bool) {
+	kind, items := jsonKindOf(g.pkg, d, t)
+	typ := jsonGoType(g.pkg, d, t)
+	switch kind {
+	case jsonBool:
+		g.Printf("w.Bool(%s)\n", jsonConv("bool", typ, expr))
+	case jsonInt:
+		g.Printf("w.Int(%s)\n", jsonConv("int", typ, expr))
+	case jsonFloat:
+		g.Printf("w.Float(%s)\n", jsonConv("float64", typ, expr))
+	case jsonString:
+		g.Printf("w.String(%s)\n", jsonConv("string", typ, expr))
+	case jsonBase64:
+		g.Printf("w.Base64(%s)\n", jsonConv("[]byte", typ, expr))
+	case jsonAny:
+		g.Printf("w.Raw(%s)\n", expr)
+	case jsonTime, jsonRaw:
+		g.Printf("w.Marshal(%s)\n", expr)
+	case jsonStruct, jsonUnmarshal:
+		g.Printf("%s.EncodeJSON(w)\n", strings.TrimPrefix(expr, "*"))
+	case jsonArray:
+		if !notNil {
+			g.Printf("if %s == nil {\nw.Null()\n} else {\n", expr)
+		}
+		i := fmt.Sprintf("i%d", depth)
+		g.Printf("w.ArrayStart()\nfor %s := range %s {\n", i, expr)
+		if strings.HasPrefix(expr, "*") {
+			expr = "(" + expr + ")"
+		}
+		g.jsonEncode(d, items, expr+"["+i+"]", depth+1, false)
+		g.Printf("}\nw.ArrayEnd()\n")
+		if !notNil {
+			g.Printf("}\n")
+		}
+	}
+}
+
+// jsonConv returns expr of type typ converted to base, if needed.
+func jsonConv(base, typ, expr string) string {
+	if typ == base {
+		return expr
+	}
+	return base + "(" + expr + ")"
+}
+
+func (g *Generator) jsonDecodeStruct(d proto.Domain, name string, fields []jsonField) {
+	g.Printf(`
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *%s) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+`, name)
+	for _, f := range fields {
+		expr := "v." + f.name
+		g.Printf("case %q:\n", f.prop.NameName)
+		if strings.HasPrefix(f.goType, "*") {
+			g.Printf("if r.Null() {\n%s = nil\n} else {\n", expr)
+			g.jsonDecodeVar(d, f.prop, "p", 0)
+			g.Printf("%s = &p\n}\n", expr)
+		} else {
+			g.jsonDecode(d, f.prop, expr, 0)
+		}
+	}
+	g.Printf(`		default:
+			r.Skip()
+		}
+	}
+}
+`)
+}
+
+// jsonDecode writes the statements for decoding into expr (of type t).
+func (g *Generator) jsonDecode(d proto.Domain, t proto.AnyType, expr string, depth int) {
+	if x := g.jsonDecodeExpr(d, t); x != "" {
+		g.Printf("%s = %s\n", expr, x)
+		return
+	}
+	kind, items := jsonKindOf(g.pkg, d, t)
+	switch kind {
+	case jsonTime, jsonRaw, jsonUnmarshal:
+		g.Printf("r.Decode(&%s)\n", expr)
+	case jsonStruct:
+		g.Printf("%s.DecodeJSON(r)\n", expr)
+	case jsonArray:
+		// Initial capacity like encoding/json.
+		g.Printf("if r.ArrayStart() {\n%s = make(%s, 0, 4)\nfor r.ArrayNext() {\n", expr, jsonGoType(g.pkg, d, t))
+		e := fmt.Sprintf("e%d", depth)
+		g.jsonDecodeVar(d, items, e, depth+1)
+		g.Printf("%[1]s = append(%[1]s, %[2]s)\n}\n} else {\n%[1]s = nil\n}\n", expr, e)
+	}
+}
+
+// jsonDecodeVar writes the statements for declaring and decoding the
+// variable name (of type t).
+func (g *Generator) jsonDecodeVar(d proto.Domain, t proto.AnyType, name string, depth int) {
+	if x := g.jsonDecodeExpr(d, t); x != "" {
+		g.Printf("%s := %s\n", name, x)
+		return
+	}
+	g.Printf("var %s %s\n", name, jsonGoType(g.pkg, d, t))
+	g.jsonDecode(d, t, name, depth)
+}
+
+// jsonDecodeExpr returns the expression for decoding a value of type t,
+// or an empty string if t cannot be decoded by an expression.
+func (g *Generator) jsonDecodeExpr(d proto.Domain, t proto.AnyType) string {
+	kind, _ := jsonKindOf(g.pkg, d, t)
+	typ := jsonGoType(g.pkg, d, t)
+	switch kind {
+	case jsonBool:
+		return jsonConv(typ, "bool", "r.Bool()")
+	case jsonInt:
+		return jsonConv(typ, "int", "r.Int()")
+	case jsonFloat:
+		return jsonConv(typ, "float64", "r.Float()")
+	case jsonString:
+		return jsonConv(typ, "string", "r.String()")
+	case jsonBase64:
+		return jsonConv(typ, "[]byte", "r.Base64()")
+	case jsonAny:
+		return "r.Raw()"
+	}
+	return ""
+}
+
+// jsonGoType returns the Go type of t for use in conversions and
+// declarations.
+func jsonGoType(pkg string, d proto.Domain, t proto.AnyType) string {
+	typ := strings.TrimPrefix(goTypeOf(t, pkg, d), "= ")
+	if typ == "enum" {
+		// Local enums are strings.
+		typ = "string"
+	}
+	return typ
+}
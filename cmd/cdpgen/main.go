@@ -1,4 +1,7 @@
 // The cdpgen command generates the package cdp from the provided protocol definitions.
+//
+// The diff subcommand (cdpgen diff old.json new.json) reports the
+// changes between two protocol definitions.
 package main
 
 import (
@@ -15,7 +18,7 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/mafredri/cdp/cmd/cdpgen/proto"
+	"github.com/mafredri/cdp/proto"
 )
 
 // Global constants.
@@ -41,23 +44,36 @@ func mkdir(name string) error {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		os.Exit(diffMain(os.Args[2:]))
+	}
+
 	var (
 		dest             string
 		pkg              string
 		browserProtoJSON string
 		jsProtoFileJSON  string
+		nodeProtoJSON    string
+		extraProtos      extraProtoFlag
+		versionsDir      string
+		docsDir          string
 	)
 	flag.StringVar(&dest, "dest", "", "Destination for generated cdp package")
 	flag.StringVar(&pkg, "pkg", "github.com/mafredri/cdp", "Name of package")
 	flag.StringVar(&browserProtoJSON, "browser-proto", "./protodef/browser_protocol.json", "Path to browser protocol")
 	flag.StringVar(&jsProtoFileJSON, "js-proto", "./protodef/js_protocol.json", "Path to JS protocol")
+	flag.StringVar(&nodeProtoJSON, "node-proto", "", "Path to Node.js protocol (optional), generates the NodeClient")
+	flag.Var(&extraProtos, "extra-proto", "Vendor protocol extensions as tag=path, generated behind the build tag (repeatable)")
+	flag.BoolVar(&argOptions, "arg-options", false, "Generate functional options for command arguments (e.g. page.NewNavigateArgsWith)")
+	flag.StringVar(&versionsDir, "versions", "", "Directory with protocol versions (optional), each subdirectory <name> is generated in versions/<name>")
+	flag.StringVar(&docsDir, "docs", "", "Destination for the markdown reference of the protocol (optional), only the reference is generated if dest is not set")
 	flag.Parse()
 
-	if dest == "" {
-		fmt.Fprintln(os.Stderr, "error: dest must be set")
+	if dest == "" && docsDir == "" {
+		fmt.Fprintln(os.Stderr, "error: dest (or docs) must be set")
 		os.Exit(1)
 	}
-	if !filepath.IsAbs(dest) {
+	if dest != "" && !filepath.IsAbs(dest) {
 		wd, err := os.Getwd()
 		if err != nil {
 			panic(err)
@@ -79,23 +95,102 @@ func main() {
 	panicErr(err)
 
 	protocol.Domains = append(protocol.Domains, jsProtocol.Domains...)
+
+	// The Node.js protocol shares the V8 domains with the JS protocol,
+	// only the Node specific domains (e.g. NodeWorker) are added.
+	var nodeDomains []proto.Domain
+	var nodeOnlyDomains []string
+	if nodeProtoJSON != "" {
+		var nodeProtocol proto.Protocol
+		nodeProtocolData, err := os.ReadFile(nodeProtoJSON)
+		panicErr(err)
+
+		err = json.Unmarshal(nodeProtocolData, &nodeProtocol)
+		panicErr(err)
+
+		for _, nd := range nodeProtocol.Domains {
+			nodeDomains = append(nodeDomains, nd)
+			if !hasDomain(protocol.Domains, nd.Domain) {
+				protocol.Domains = append(protocol.Domains, nd)
+				nodeOnlyDomains = append(nodeOnlyDomains, nd.Domain)
+			}
+		}
+	}
 	sort.Slice(protocol.Domains, func(i, j int) bool {
 		return protocol.Domains[i].Domain < protocol.Domains[j].Domain
 	})
 
-	protoDest := filepath.Join(dest, "protocol")
-	protoImport := filepath.Join(pkg, "protocol")
+	imports := prepareDomains(pkg, pkg, protocol.Domains)
+
+	if docsDir != "" {
+		writeDocs(docsDir, pkg, protocol.Domains)
+		if dest == "" {
+			return
+		}
+	}
+
+	// Snapshot of the protocol for runtime compatibility checks.
+	writeCompatProtocol(filepath.Join(dest, "compat"), protocolData, jsProtocolData)
+
+	// Package cdp and cdp/stable, the latter omits experimental and
+	// deprecated APIs.
+	writeCdpPackage(dest, "cdp", imports, protocol.Domains, nodeDomains, nodeOnlyDomains)
+	writeCdpPackage(filepath.Join(dest, "stable"), "stable", append(imports, pkg), stableDomains(protocol.Domains), nodeDomains, nodeOnlyDomains)
+
+	// Package cdp/protocol and the domain packages.
+	writeProtocolPackages(filepath.Join(dest, "protocol"), imports, protocol.Domains)
+
+	// Package cdp/registry.
+	writeRegistry(dest, imports, "", "domains.go", protocol.Domains)
+
+	// Vendor extensions, constrained by build tags.
+	for _, e := range extraProtos {
+		writeExtraProtocol(dest, pkg, imports, protocol.Domains, e)
+	}
+
+	// Bindings for other protocol versions, generated last since they
+	// reset the type information of the protocol above.
+	for _, v := range findVersions(versionsDir) {
+		writeVersion(dest, pkg, v)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	goimports := exec.CommandContext(ctx, "goimports", "-w", "-v", dest)
+	out, err := goimports.CombinedOutput()
+	if err != nil {
+		log.Printf("goimports failed: %s", out)
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	goinstall := exec.CommandContext(ctx, "go", "install", filepath.Join(dest, "..."))
+	out, err = goinstall.CombinedOutput()
+	if err != nil {
+		log.Printf("install failed: %s", out)
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+// prepareDomains records the non-pointer types of the domains and
+// returns the imports for the generated packages. The domain packages
+// are rooted at treePkg (e.g. github.com/mafredri/cdp), rpcc and jsonx
+// at pkg.
+func prepareDomains(pkg, treePkg string, domains []proto.Domain) []string {
+	protoImport := treePkg + "/protocol"
 	imports := []string{
 		pkg + "/rpcc",
-		pkg + "/protocol/internal",
-		pkg + "/protocol",
+		protoImport + "/internal",
+		protoImport,
 	}
-	for i, d := range protocol.Domains {
-		dLower := strings.ToLower(d.Name())
+	for i, d := range domains {
+		dLower := strings.ToLower(domainName(d))
 		imports = append(imports, filepath.Join(protoImport, dLower))
 
 		for ii, t := range d.Types {
-			nam := t.Name(d)
+			nam := typeName(t, d)
 			if isNonPointer(d.Domain, d, t) {
 				nonPtrMap[nam] = true
 				nonPtrMap[d.Domain+"."+nam] = true
@@ -112,7 +207,7 @@ func main() {
 			}
 			d.Types[ii] = t
 		}
-		protocol.Domains[i] = d
+		domains[i] = d
 	}
 	nonPtrMap["Timestamp"] = true
 	nonPtrMap["protocol.Timestamp"] = true
@@ -123,27 +218,28 @@ func main() {
 	nonPtrMap["MonotonicTime"] = true
 	nonPtrMap["network.MonotonicTime"] = true
 
-	var cdp, g Generator
-	cdp.imports = imports
-	g.imports = imports
+	indexJSONTypes(domains)
+	return append(imports, pkg+"/internal/jsonx")
+}
 
-	// Define the cdp Client.
-	cdp.pkg = "cdp"
-	cdp.dir = dest
-	cdp.PackageHeader("")
-	cdp.CdpClient(protocol.Domains)
-	cdp.writeFile("cdp_client.go")
+// writeProtocolPackages writes the domain packages, and the circular
+// types to the internal package, in protoDest.
+func writeProtocolPackages(protoDest string, imports []string, domains []proto.Domain) {
+	var g Generator
+	g.imports = imports
 
 	// Package cdp/protocol.
 	g.pkg = "protocol"
 	g.dir = protoDest
-	err = mkdir(g.path())
+	err := mkdir(g.path())
 	panicErr(err)
 
 	// Package cdp/protocol/internal.
 	g.pkg = "internal"
 	g.dir = filepath.Join(protoDest, "internal")
-	for _, d := range protocol.Domains {
+	err = mkdir(g.path())
+	panicErr(err)
+	for _, d := range domains {
 		// Write circular types to internal package.
 		for _, it := range []struct {
 			domain string
@@ -153,91 +249,109 @@ func main() {
 			{domain: "browser", typ: "ContextID"},
 			{domain: "network", typ: "TimeSinceEpoch"},
 		} {
-			domName := d.Name()
+			domName := domainName(d)
 			if strings.ToLower(domName) != it.domain {
 				continue
 			}
-			d.Domain = "internal"
 			g.PackageHeader("")
 			for _, t := range d.Types {
-				idName := t.Name(d)
+				// The name in the domain, e.g. Browser.BrowserContextID
+				// is browser.ContextID.
+				idName := typeName(t, d)
 				if idName != it.typ {
 					continue
 				}
-				t.IDName = domName + t.IDName
+				t.IDName = domName + idName
 				t.Description = fmt.Sprintf("%s\n\nThis type cannot be used directly. Use %s.%s instead.", t.Description, it.domain, idName)
-				g.DomainType(d, t)
+				g.DomainType(proto.Domain{Domain: "internal"}, t)
 			}
 			g.writeFile(fmt.Sprintf("%s.go", it.domain))
 		}
 	}
 
 	// Generate the protocol definitions.
-	for _, d := range protocol.Domains {
-		cdp.PackageHeader("")
-		cdp.DomainInterface(d)
-
+	for _, d := range domains {
 		dLower := strings.ToLower(d.Domain)
 		g.dir = filepath.Join(protoDest, dLower)
 		g.pkg = dLower
 		err := mkdir(g.path())
 		panicErr(err)
 
-		comment := fmt.Sprintf("Package %s implements the %s domain. ", dLower, d.Name())
-		g.PackageHeader(fmt.Sprintf("// %s%s", comment, d.Desc(0, len(comment))))
+		comment := fmt.Sprintf("Package %s implements the %s domain. ", dLower, domainName(d))
+		g.PackageHeader(fmt.Sprintf("// %s%s", comment, domainDesc(d, 0, len(comment))))
 		g.DomainDefinition(d)
 		g.writeFile("domain.go")
 
-		if len(d.Types) > 0 {
-			g.PackageHeader("")
-			for _, t := range d.Types {
-				g.DomainType(d, t)
-			}
-			g.writeFile("types.go")
-		}
+		g.DomainFiles(d, "")
+	}
+}
 
-		if len(d.Commands) > 0 {
-			g.PackageHeader("")
-			for _, c := range d.Commands {
-				if c.Redirect != "" {
-					continue
-				}
-				g.DomainCmd(d, c)
-			}
-			g.writeFile("command.go")
-		}
+// writeCdpPackage writes the Client and domain interfaces for package
+// pkg (cdp, stable or a protocol version) in dir.
+func writeCdpPackage(dir, pkg string, imports []string, domains, nodeDomains []proto.Domain, nodeOnlyDomains []string) {
+	cdp := Generator{pkg: pkg, dir: dir, imports: imports}
+	err := mkdir(cdp.path())
+	panicErr(err)
 
-		if len(d.Events) > 0 {
-			g.PackageHeader("")
-			for _, e := range d.Events {
-				g.DomainEvent(d, e)
-			}
-			g.writeFile("event.go")
-		}
+	prefix := ""
+	if pkg == "cdp" {
+		prefix = "cdp_"
 	}
+	// The stable clients are a subset of the cdp clients.
+	from := pkg == "stable"
 
-	cdp.writeFile(cdp.pkg + ".go")
-
-	g.dir = dest
+	// Define the cdp Client.
+	clientDomains := excludeDomains(domains, nodeOnlyDomains)
+	cdp.PackageHeader("")
+	cdp.CdpClient(clientDomains)
+	if from {
+		cdp.FromClient("Client", clientDomains)
+	}
+	cdp.writeFile(prefix + "client.go")
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	if len(nodeDomains) > 0 {
+		var names []string
+		for _, nd := range nodeDomains {
+			names = append(names, nd.Domain)
+		}
+		nodeClientDomains := selectDomains(domains, names)
+		partial := nodePartialDomains(nodeClientDomains, nodeDomains)
+		cdp.PackageHeader("")
+		cdp.NodeClient(nodeClientDomains, partial)
+		if from {
+			cdp.FromClient("NodeClient", nodeClientDomains)
+		}
+		for _, d := range partial {
+			name := nodeInterfaceName(domains, d)
+			cdp.domainInterface(d, name, fmt.Sprintf("%s is the subset of the %s domain supported by Node.js. ", name, domainName(d)))
+		}
+		cdp.writeFile(prefix + "node_client.go")
+	}
 
-	goimports := exec.CommandContext(ctx, "goimports", "-w", "-v", g.path())
-	out, err := goimports.CombinedOutput()
-	if err != nil {
-		log.Printf("goimports failed: %s", out)
-		log.Println(err)
-		os.Exit(1)
+	cdp.PackageHeader("")
+	for _, d := range domains {
+		cdp.DomainInterface(d)
 	}
+	cdp.writeFile(pkg + ".go")
+}
 
-	goinstall := exec.CommandContext(ctx, "go", "install", filepath.Join(dest, "..."))
-	out, err = goinstall.CombinedOutput()
-	if err != nil {
-		log.Printf("install failed: %s", out)
-		log.Println(err)
-		os.Exit(1)
+// FromClient creates the From<Client> function, converting from the
+// cdp client type (e.g. cdp.Client) to the (restricted) client type.
+func (g *Generator) FromClient(client string, domains []proto.Domain) {
+	g.hasContent = true
+	var fields Generator
+	for _, d := range domains {
+		fields.Printf("\t\t%s: c.%s,\n", domainName(d), domainName(d))
 	}
+	g.Printf(`
+// From%[1]s returns a %[1]s that uses the domains of c.
+func From%[1]s(c *cdp.%[1]s) *%[1]s {
+	return &%[1]s{
+		%[2]s
+		conn: c.Conn(),
+	}
+}
+`, client, fields.buf.Bytes())
 }
 
 // Generator holds the state of the analysis. Primarily used to buffer
@@ -250,6 +364,7 @@ type Generator struct {
 	testbuf    bytes.Buffer // Accumulated test output.
 	hasContent bool
 	hasHeader  bool
+	buildTag   string // Build constraint for the generated files, if any.
 }
 
 func (g *Generator) path() string {
@@ -316,13 +431,132 @@ func (g *Generator) format() []byte {
 	return src
 }
 
+func hasDomain(domains []proto.Domain, name string) bool {
+	for _, d := range domains {
+		if d.Domain == name {
+			return true
+		}
+	}
+	return false
+}
+
+// selectDomains returns the domains with the given names.
+func selectDomains(domains []proto.Domain, names []string) []proto.Domain {
+	var sel []proto.Domain
+	for _, d := range domains {
+		for _, n := range names {
+			if d.Domain == n {
+				sel = append(sel, d)
+				break
+			}
+		}
+	}
+	return sel
+}
+
+// excludeDomains returns the domains without the given names.
+func excludeDomains(domains []proto.Domain, names []string) []proto.Domain {
+	var sel []proto.Domain
+	for _, d := range domains {
+		if !hasDomain(selectDomains(domains, names), d.Domain) {
+			sel = append(sel, d)
+		}
+	}
+	return sel
+}
+
+// nodePartialDomains returns the domains (of the bindings) that Node.js
+// only partially supports, restricted to the commands and events in the
+// Node.js protocol (nodeDomains).
+func nodePartialDomains(domains, nodeDomains []proto.Domain) []proto.Domain {
+	var partial []proto.Domain
+	for _, d := range domains {
+		var nd proto.Domain
+		for _, x := range nodeDomains {
+			if x.Domain == d.Domain {
+				nd = x
+			}
+		}
+		sub := d
+		sub.Commands, sub.Events = nil, nil
+		for _, c := range d.Commands {
+			if _, ok := nd.Command(c.NameName); ok || c.Redirect != "" {
+				sub.Commands = append(sub.Commands, c)
+			}
+		}
+		for _, e := range d.Events {
+			if _, ok := nd.Event(e.NameName); ok {
+				sub.Events = append(sub.Events, e)
+			}
+		}
+		if len(sub.Commands) < len(d.Commands) || len(sub.Events) < len(d.Events) {
+			partial = append(partial, sub)
+		}
+	}
+	return partial
+}
+
+// nodeInterfaceName returns the name of the interface for the partially
+// supported domain d, e.g. NodeNetwork.
+func nodeInterfaceName(domains []proto.Domain, d proto.Domain) string {
+	name := "Node" + domainName(d)
+	for _, x := range domains {
+		if domainName(x) == name {
+			log.Panicf("node: interface %s for the partial %s domain conflicts with the %s domain", name, d.Domain, x.Domain)
+		}
+	}
+	return name
+}
+
+// NodeClient creates the cdp.NodeClient type, the domains in partial use
+// the Node specific interfaces (see nodePartialDomains).
+func (g *Generator) NodeClient(domains, partial []proto.Domain) {
+	g.hasContent = true
+	var fields, newFields Generator
+	for _, d := range domains {
+		typ := domainName(d)
+		if hasDomain(partial, d.Domain) {
+			typ = nodeInterfaceName(domains, d)
+		}
+		fields.Printf("\t%s %s\n", domainName(d), typ)
+		newFields.Printf("\t\t%s: %s.NewClient(conn),\n", domainName(d), strings.ToLower(domainName(d)))
+	}
+	g.Printf(`
+// NodeClient represents a client for the Node.js inspector protocol, it
+// can be used to invoke methods or listen to events in the domains
+// supported by Node.js. Domains that Node.js only partially supports
+// (e.g. Network) have Node specific interfaces with the commands and
+// events of the Node.js protocol. The NodeClient consumes a rpcc
+// connection, used to invoke the methods.
+type NodeClient struct {
+	%s
+	conn *rpcc.Conn
+}
+
+// NewNodeClient returns a new NodeClient that uses conn
+// for communication with the Node.js process.
+func NewNodeClient(conn *rpcc.Conn) *NodeClient {
+	return &NodeClient{
+		%s
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the NodeClient. Conn returns
+// nil if the NodeClient was not created by NewNodeClient.
+func (c *NodeClient) Conn() *rpcc.Conn {
+	return c.conn
+}
+`, fields.buf.Bytes(), newFields.buf.Bytes())
+}
+
 // CdpClient creates the cdp.Client type.
 func (g *Generator) CdpClient(domains []proto.Domain) {
 	g.hasContent = true
 	var fields, newFields Generator
 	for _, d := range domains {
-		fields.Printf("\t%s %s\n", d.Name(), d.Type())
-		newFields.Printf("\t\t%s: %s.NewClient(conn),\n", d.Name(), strings.ToLower(d.Name()))
+		fields.Printf("\t%s %s\n", domainName(d), domainName(d))
+		newFields.Printf("\t\t%s: %s.NewClient(conn),\n", domainName(d), strings.ToLower(domainName(d)))
 	}
 	g.Printf(`
 // Client represents a Chrome DevTools Protocol client that can be used to
@@ -330,6 +564,7 @@ func (g *Generator) CdpClient(domains []proto.Domain) {
 // a rpcc connection, used to invoke the methods.
 type Client struct {
 	%s
+	conn *rpcc.Conn
 }
 
 // NewClient returns a new Client that uses conn
@@ -337,8 +572,15 @@ type Client struct {
 func NewClient(conn *rpcc.Conn) *Client {
 	return &Client{
 		%s
+		conn: conn,
 	}
 }
+
+// Conn returns the rpcc connection used by the Client. Conn returns nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn {
+	return c.conn
+}
 `, fields.buf.Bytes(), newFields.buf.Bytes())
 }
 
@@ -348,9 +590,11 @@ func (g *Generator) PackageHeader(comment string) {
 		return
 	}
 	g.hasHeader = true
-	g.Printf(`// Code generated by cdpgen. DO NOT EDIT.
-
-%s
+	g.Printf("// Code generated by cdpgen. DO NOT EDIT.\n\n")
+	if g.buildTag != "" {
+		g.Printf("//go:build %s\n\n", g.buildTag)
+	}
+	g.Printf(`%s
 package %s
 
 import (
@@ -365,10 +609,13 @@ import (
 
 // DomainInterface defines the domain interface.
 func (g *Generator) DomainInterface(d proto.Domain) {
+	g.domainInterface(d, domainName(d), "The "+domainName(d)+" domain. ")
+}
+
+func (g *Generator) domainInterface(d proto.Domain, name, comment string) {
 	g.hasContent = true
 
-	comment := "The " + d.Name() + " domain. "
-	desc := d.Desc(0, len(comment))
+	desc := domainDesc(d, 0, len(comment))
 	if d.Deprecated {
 		desc = "\n//\n// Deprecated: " + desc
 	}
@@ -377,7 +624,7 @@ func (g *Generator) DomainInterface(d proto.Domain) {
 	}
 	g.Printf(`
 // %[1]s%[2]s
-type %[3]s interface{`, comment, desc, d.Name())
+type %[3]s interface{`, comment, desc, name)
 	for _, c := range d.Commands {
 		if c.Redirect != "" {
 			continue
@@ -385,14 +632,14 @@ type %[3]s interface{`, comment, desc, d.Name())
 		request := ""
 		reply := "error"
 		if len(c.Parameters) > 0 {
-			request = ", *" + strings.ToLower(d.Name()) + "." + c.ArgsName(d)
+			request = ", *" + strings.ToLower(domainName(d)) + "." + argsName(c, d)
 		}
 		if len(c.Returns) > 0 {
-			reply = fmt.Sprintf("(*%s.%s, error)", strings.ToLower(d.Name()), c.ReplyName(d))
+			reply = fmt.Sprintf("(*%s.%s, error)", strings.ToLower(domainName(d)), replyName(c, d))
 		}
-		desc := c.Desc(true, 8, 0)
+		desc := commandDesc(c, true, 8, 0)
 		if c.Deprecated {
-			desc = strings.Replace(c.Desc(true, 8, 12), "Deprecated, ", "", 1)
+			desc = strings.Replace(commandDesc(c, true, 8, 12), "Deprecated, ", "", 1)
 			desc = strings.Replace(desc, "Deprecated. ", "", 1)
 			if len(desc) < 1 {
 				desc = "This command is deprecated."
@@ -405,13 +652,13 @@ type %[3]s interface{`, comment, desc, d.Name())
 		if c.Experimental {
 			desc += "\n\t//\n\t// Note: This command is experimental."
 		}
-		g.Printf("\n\t// Command %s%s\n\t%s(context.Context%s) %s\n", c.Name(), desc, c.Name(), request, reply)
+		g.Printf("\n\t// Command %s%s\n\t%s(context.Context%s) %s\n", commandName(c), desc, commandName(c), request, reply)
 	}
 	for _, e := range d.Events {
-		eventClient := fmt.Sprintf("%sClient", e.EventName(d))
-		desc := e.Desc(true, 8, 0)
+		eventClient := fmt.Sprintf("%sClient", eventTypeName(e, d))
+		desc := eventDesc(e, true, 8, 0)
 		if e.Deprecated {
-			desc = strings.Replace(e.Desc(true, 8, 12), "Deprecated, ", "", 1)
+			desc = strings.Replace(eventDesc(e, true, 8, 12), "Deprecated, ", "", 1)
 			desc = "Deprecated: " + strings.ToUpper(desc[0:1]) + desc[1:]
 		}
 		if desc != "" {
@@ -420,16 +667,70 @@ type %[3]s interface{`, comment, desc, d.Name())
 		if e.Experimental {
 			desc += "\n//\n// Note: This event is experimental."
 		}
-		g.Printf("\n\t// Event %s%s\n\t%s(context.Context) (%s.%s, error)\n", e.Name(), desc, e.Name(), strings.ToLower(d.Name()), eventClient)
+		g.Printf("\n\t// Event %s%s\n\t%s(context.Context) (%s.%s, error)\n", eventName(e), desc, eventName(e), strings.ToLower(domainName(d)), eventClient)
 	}
 	g.Printf("}\n")
 }
 
+// DomainFiles writes the types, commands and events of the domain,
+// the file names are prefixed by prefix.
+func (g *Generator) DomainFiles(d proto.Domain, prefix string) {
+	if len(d.Types) > 0 {
+		g.PackageHeader("")
+		for _, t := range d.Types {
+			g.DomainType(d, t)
+		}
+		g.writeFile(prefix + "types.go")
+	}
+
+	if len(d.Commands) > 0 {
+		g.PackageHeader("")
+		for _, c := range d.Commands {
+			if c.Redirect != "" {
+				continue
+			}
+			g.DomainCmd(d, c)
+		}
+		g.writeFile(prefix + "command.go")
+
+		if argOptions {
+			g.PackageHeader("")
+			g.DomainArgOptions(d)
+			g.writeFile(prefix + "options.go")
+		}
+	}
+
+	if len(d.Events) > 0 {
+		g.PackageHeader("")
+		for _, e := range d.Events {
+			g.DomainEvent(d, e)
+		}
+		g.writeFile(prefix + "event.go")
+	}
+
+	if len(d.Types)+len(d.Commands)+len(d.Events) > 0 {
+		g.PackageHeader("")
+		g.DomainJSON(d)
+		g.writeFile(prefix + "json.go")
+
+		// The MarshalJSON and UnmarshalJSON methods are opt-in.
+		tag := g.buildTag
+		g.buildTag = jsonTag
+		if tag != "" {
+			g.buildTag = tag + " && " + jsonTag
+		}
+		g.PackageHeader("")
+		g.DomainJSONMarshal(d)
+		g.writeFile(prefix + "marshal.go")
+		g.buildTag = tag
+	}
+}
+
 // DomainDefinition defines the entire domain.
 func (g *Generator) DomainDefinition(d proto.Domain) {
 	g.hasContent = true
 
-	comment := fmt.Sprintf("domainClient is a client for the %s domain. ", d.Name())
+	comment := fmt.Sprintf("domainClient is a client for the %s domain. ", domainName(d))
 	g.Printf(`
 // %[1]s%[3]s
 type domainClient struct{ conn *rpcc.Conn }
@@ -438,7 +739,15 @@ type domainClient struct{ conn *rpcc.Conn }
 func NewClient(conn *rpcc.Conn) *domainClient {
 	return &domainClient{conn: conn}
 }
-`, comment, d.Name(), d.Desc(0, len(comment)))
+`, comment, domainName(d), domainDesc(d, 0, len(comment)))
+
+	g.DomainMethods(d)
+}
+
+// DomainMethods defines the commands and events of the domain as
+// methods on the domainClient.
+func (g *Generator) DomainMethods(d proto.Domain) {
+	g.hasContent = true
 
 	for _, c := range d.Commands {
 		if c.Redirect != "" {
@@ -447,19 +756,19 @@ func NewClient(conn *rpcc.Conn) *domainClient {
 		request := ""
 		invokeReply := "nil"
 		if len(c.Parameters) > 0 {
-			request = ", args *" + c.ArgsName(d)
+			request = ", args *" + argsName(c, d)
 		}
 		reply := "(err error)"
 		if len(c.Returns) > 0 {
-			reply = fmt.Sprintf("(reply *%s, err error)", c.ReplyName(d))
+			reply = fmt.Sprintf("(reply *%s, err error)", replyName(c, d))
 		}
-		comment := fmt.Sprintf("%[1]s invokes the %[2]s method. ", c.Name(), d.Name())
+		comment := fmt.Sprintf("%[1]s invokes the %[2]s method. ", commandName(c), domainName(d))
 		g.Printf(`
 // %[2]s%[5]s
-func (d *domainClient) %[1]s(ctx context.Context%[3]s) %[4]s {`, c.Name(), comment, request, reply, c.Desc(true, 0, len(comment)))
+func (d *domainClient) %[1]s(ctx context.Context%[3]s) %[4]s {`, commandName(c), comment, request, reply, commandDesc(c, true, 0, len(comment)))
 		if len(c.Returns) > 0 {
 			g.Printf(`
-	reply = new(%s)`, c.ReplyName(d))
+	reply = new(%s)`, replyName(c, d))
 			invokeReply = "reply"
 		}
 		if len(c.Parameters) > 0 {
@@ -474,7 +783,7 @@ func (d *domainClient) %[1]s(ctx context.Context%[3]s) %[4]s {`, c.Name(), comme
 	}
 	return
 }
-`, d.Domain+"."+c.NameName, invokeReply, d.Name(), c.Name())
+`, d.Domain+"."+c.NameName, invokeReply, domainName(d), commandName(c))
 		} else {
 			g.Printf(`
 	err = rpcc.Invoke(ctx, %q, nil, %s, d.conn)
@@ -483,7 +792,7 @@ func (d *domainClient) %[1]s(ctx context.Context%[3]s) %[4]s {`, c.Name(), comme
 	}
 	return
 }
-`, d.Domain+"."+c.NameName, invokeReply, d.Name(), c.Name())
+`, d.Domain+"."+c.NameName, invokeReply, domainName(d), commandName(c))
 		}
 
 		// Generate method tests.
@@ -494,7 +803,7 @@ func Test%[1]s_%[2]s(t *testing.T) {
 
 	dom := New%[1]s(conn)
 	var err error
-`, d.Name(), c.Name())
+`, domainName(d), commandName(c))
 		assign := "err"
 		if len(c.Returns) > 0 {
 			assign = "_, err"
@@ -507,10 +816,10 @@ func Test%[1]s_%[2]s(t *testing.T) {
 		t.Error(err)
 	}
 	// Test args.
-	%[1]s = dom.%[2]s(nil, &%[3]s{})`, assign, c.Name(), c.ArgsName(d))
+	%[1]s = dom.%[2]s(nil, &%[3]s{})`, assign, commandName(c), argsName(c, d))
 		} else {
 			g.TestPrintf(`
-	%[1]s = dom.%[2]s(nil)`, assign, c.Name())
+	%[1]s = dom.%[2]s(nil)`, assign, commandName(c))
 		}
 		g.TestPrintf(`
 	if err != nil {
@@ -521,10 +830,10 @@ func Test%[1]s_%[2]s(t *testing.T) {
 	codec.respErr = errors.New("bad request")`)
 		if len(c.Parameters) > 0 {
 			g.TestPrintf(`
-	%[1]s = dom.%[2]s(nil, &%[3]s{})`, assign, c.Name(), c.ArgsName(d))
+	%[1]s = dom.%[2]s(nil, &%[3]s{})`, assign, commandName(c), argsName(c, d))
 		} else {
 			g.TestPrintf(`
-	%[1]s = dom.%[2]s(nil)`, assign, c.Name())
+	%[1]s = dom.%[2]s(nil)`, assign, commandName(c))
 		}
 		g.TestPrintf(`
 	if err == nil || err.(*internal.OpError).Err.(*rpcc.ResponseError).Message != codec.respErr.Error() {
@@ -536,7 +845,7 @@ func Test%[1]s_%[2]s(t *testing.T) {
 
 	}
 	for _, e := range d.Events {
-		eventClient := fmt.Sprintf("%sClient", e.EventName(d))
+		eventClient := fmt.Sprintf("%sClient", eventTypeName(e, d))
 		eventClientImpl := strings.ToLower(string(eventClient[0])) + eventClient[1:]
 
 		// Implement event on domain.
@@ -548,7 +857,7 @@ func (d *domainClient) %s(ctx context.Context) (%s, error) {
 	}
 	return &%s{Stream: s}, nil
 }
-`, e.Name(), eventClient, d.Domain+"."+e.NameName, eventClientImpl)
+`, eventName(e), eventClient, d.Domain+"."+e.NameName, eventClientImpl)
 
 		g.Printf(`
 type %[4]s struct { rpcc.Stream }
@@ -563,7 +872,7 @@ func (c *%[4]s) Recv() (*%[3]s, error) {
 	}
 	return event, nil
 }
-`, eventClient, "", e.ReplyName(d), eventClientImpl, d.Name(), e.Name())
+`, eventClient, "", eventReplyName(e, d), eventClientImpl, domainName(d), eventName(e))
 
 		// Generate event tests.
 		g.TestPrintf(`
@@ -598,7 +907,7 @@ func Test%[1]s_%[2]s(t *testing.T) {
 	if err == nil {
 		t.Errorf("Open stream: got nil, want error")
 	}
-`, d.Name(), e.Name(), e.EventName(d))
+`, domainName(d), eventName(e), eventTypeName(e, d))
 		g.TestPrintf(`
 }
 `)
@@ -610,18 +919,18 @@ func (g *Generator) DomainType(d proto.Domain, t proto.AnyType) {
 	g.hasContent = true
 
 	var comment string
-	desc := t.Desc(0, len(t.Name(d))+1)
+	desc := typeDesc(t, 0, len(typeName(t, d))+1)
 	if t.Deprecated {
-		desc = "\n//\n// Deprecated: " + t.Desc(0, 12)
+		desc = "\n//\n// Deprecated: " + typeDesc(t, 0, 12)
 	}
 	if t.Experimental {
 		desc = desc + "\n//\n// Note: This type is experimental."
 	}
 	g.Printf(`
 // %[1]s %[2]s
-%[3]stype %[1]s `, t.Name(d), desc, comment)
+%[3]stype %[1]s `, typeName(t, d), desc, comment)
 
-	typ := t.GoType(g.pkg, d)
+	typ := goTypeOf(t, g.pkg, d)
 	switch typ {
 	case "struct":
 		g.domainTypeStruct(d, t)
@@ -640,40 +949,29 @@ func (g *Generator) DomainType(d proto.Domain, t proto.AnyType) {
 func (g *Generator) printStructProperties(d proto.Domain, name string, props []proto.AnyType, ptrOptional, renameOptional bool) {
 	for _, prop := range props {
 		jsontag := prop.NameName
-		ptype := prop.GoType(g.pkg, d)
-
-		// Make all optional properties into pointers, unless they are slices.
 		if prop.Optional {
-			isNonPtr := nonPtrMap[ptype]
-			if ptrOptional && !isNonPtr && !isNonPointer(g.pkg, d, prop) {
-				ptype = "*" + ptype
-			}
 			jsontag += ",omitempty"
 		}
+		ptype := g.fieldType(d, name, prop, ptrOptional)
 
-		// Avoid recursive type definitions.
-		if ptype == name {
-			ptype = "*" + ptype
-		}
-
-		exportedName := prop.ExportedName(d)
+		exportedName := fieldName(prop, d)
 		if renameOptional && prop.Optional {
 			exportedName = OptionalPropPrefix + exportedName
 		}
 
 		var preDesc, postDesc string
 
-		desc := prop.Desc(8, len(exportedName)+1)
+		desc := typeDesc(prop, 8, len(exportedName)+1)
 		var deprecated, localEnum, experimental string
 		if prop.Deprecated {
-			desc = prop.Desc(8, 12)
+			desc = typeDesc(prop, 8, 12)
 			if desc == "" {
 				desc = "This property should not be used."
 			}
 			deprecated = "//\n// Deprecated: " + desc + "\n"
 			desc = "is deprecated."
 		}
-		if prop.IsLocalEnum() {
+		if isLocalEnum(prop) {
 			var enums []string
 			for _, e := range prop.Enum {
 				enums = append(enums, fmt.Sprintf("%q", e))
@@ -696,19 +994,38 @@ func (g *Generator) printStructProperties(d proto.Domain, name string, props []p
 	}
 }
 
+// fieldType returns the Go type of the struct field for prop.
+func (g *Generator) fieldType(d proto.Domain, name string, prop proto.AnyType, ptrOptional bool) string {
+	ptype := goTypeOf(prop, g.pkg, d)
+
+	// Make all optional properties into pointers, unless they are slices.
+	if prop.Optional {
+		isNonPtr := nonPtrMap[ptype]
+		if ptrOptional && !isNonPtr && !isNonPointer(g.pkg, d, prop) {
+			ptype = "*" + ptype
+		}
+	}
+
+	// Avoid recursive type definitions.
+	if ptype == name {
+		ptype = "*" + ptype
+	}
+	return ptype
+}
+
 func enforceSingleLine(s string) string {
 	return strings.Replace(s, "\n//", "", -1)
 }
 
 func (g *Generator) domainTypeStruct(d proto.Domain, t proto.AnyType) {
 	g.Printf("struct{\n")
-	g.printStructProperties(d, t.Name(d), t.Properties, true, false)
+	g.printStructProperties(d, typeName(t, d), t.Properties, true, false)
 	g.Printf("}")
 }
 
 func (g *Generator) domainTypeTime(d proto.Domain, t proto.AnyType) {
 	var div int
-	if d.Name() == "Runtime" {
+	if domainName(d) == "Runtime" {
 		// Runtime domain denotes timestamps in milliseconds.
 		div = 1000
 	} else {
@@ -755,7 +1072,7 @@ func (t *%[1]s) UnmarshalJSON(data []byte) error {
 
 var _ json.Marshaler = (*%[1]s)(nil)
 var _ json.Unmarshaler = (*%[1]s)(nil)
-`, t.Name(d), g.pkg, div)
+`, typeName(t, d), g.pkg, div)
 
 	g.TestPrintf(`
 func Test%[1]s_Marshal(t *testing.T) {
@@ -788,7 +1105,7 @@ func Test%[1]s_Marshal(t *testing.T) {
 		t.Errorf("Unmarshal() got %%v, want no error", err)
 	}
 }
-`, t.Name(d))
+`, typeName(t, d))
 }
 
 func (g *Generator) domainTypeRawMessage(d proto.Domain, t proto.AnyType) {
@@ -813,7 +1130,7 @@ func (%[3]s *%[1]s) UnmarshalJSON(data []byte) error {
 
 var _ json.Marshaler = (*%[1]s)(nil)
 var _ json.Unmarshaler = (*%[1]s)(nil)
-`, t.Name(d), g.pkg, t.Recvr(d))
+`, typeName(t, d), g.pkg, recvr(t, d))
 
 	g.TestPrintf(`
 func Test%[1]s_Marshal(t *testing.T) {
@@ -850,7 +1167,7 @@ func Test%[1]s_Marshal(t *testing.T) {
 		t.Errorf("Unmarshal() got %%s, want %%s", b, v)
 	}
 }
-`, t.Name(d))
+`, typeName(t, d))
 }
 
 func (g *Generator) domainTypeEnum(d proto.Domain, t proto.AnyType) {
@@ -863,11 +1180,11 @@ func (g *Generator) domainTypeEnum(d proto.Domain, t proto.AnyType) {
 	// *string when encoding to JSON (omitempty).
 	for _, e := range t.Enum {
 		if e == "" {
-			panic("enum " + t.Name(d) + " has unexpected empty enum value")
+			panic("enum " + typeName(t, d) + " has unexpected empty enum value")
 		}
 	}
 
-	name := strings.Title(t.Name(d))
+	name := strings.Title(typeName(t, d))
 	if realEnum {
 		g.Printf("int\n\n")
 
@@ -877,7 +1194,7 @@ const (
 	%sNotSet %s = iota`
 		g.Printf(format, name, name, name)
 		for _, e := range t.Enum {
-			g.Printf("\n\t%s%s", name, e.Name())
+			g.Printf("\n\t%s%s", name, enumName(e))
 		}
 		g.Printf(`
 )
@@ -939,7 +1256,7 @@ const (`, name)
 		g.Printf(format, name, "NotSet", name, "")
 
 		for _, e := range t.Enum {
-			g.Printf(format, name, e.Name(), name, e)
+			g.Printf(format, name, enumName(e), name, e)
 		}
 		g.Printf(`
 )
@@ -963,7 +1280,7 @@ func (e %[1]s) Valid() bool {
 func (e %[1]s) String() string {
 	return string(e)
 }
-`, t.Name(d), strings.Join(enumValues, ", "))
+`, typeName(t, d), strings.Join(enumValues, ", "))
 	}
 }
 
@@ -982,7 +1299,7 @@ func (c CmdType) String() string {
 const (`)
 	for _, d := range doms {
 		for _, c := range d.Commands {
-			g.Printf("\n\t%s CmdType = %q", c.CmdName(d, true), d.Domain+"."+c.NameName)
+			g.Printf("\n\t%s CmdType = %q", cmdName(c, d, true), d.Domain+"."+c.NameName)
 		}
 	}
 	g.Printf("\n)\n")
@@ -1004,8 +1321,8 @@ func (g *Generator) domainCmdArgs(d proto.Domain, c proto.Command) {
 	g.Printf(`
 // %[1]s represents the arguments for %[2]s in the %[3]s domain.
 type %[1]s struct {
-`, c.ArgsName(d), c.Name(), d.Name())
-	g.printStructProperties(d, c.ArgsName(d), c.Parameters, true, true)
+`, argsName(c, d), commandName(c), domainName(d))
+	g.printStructProperties(d, argsName(c, d), c.Parameters, true, true)
 	g.Printf("}\n\n")
 
 	newfmt := `
@@ -1016,13 +1333,13 @@ func New%[1]s(%[2]s) *%[1]s {
 	return args
 }
 `
-	sig := c.ArgsSignature(g.pkg, d)
-	g.Printf(newfmt, c.ArgsName(d), sig, c.ArgsAssign("args", d), c.ArgsName(d))
+	sig := argsSignature(c, g.pkg, d)
+	g.Printf(newfmt, argsName(c, d), sig, argsAssign(c, "args", d), argsName(c, d))
 
 	// Test the new arguments.
 	testInit := ""
-	if c.ArgsSignature(g.pkg, d) != "" {
-		testInit = fmt.Sprintf("func() (%s) { return }()", c.ArgsSignature(g.pkg, d))
+	if argsSignature(c, g.pkg, d) != "" {
+		testInit = fmt.Sprintf("func() (%s) { return }()", argsSignature(c, g.pkg, d))
 	}
 	g.TestPrintf(`
 func TestNew%[1]s(t *testing.T) {
@@ -1031,31 +1348,31 @@ func TestNew%[1]s(t *testing.T) {
 		t.Errorf("New%[1]s returned nil args")
 	}
 }
-`, c.ArgsName(d), testInit)
+`, argsName(c, d), testInit)
 
 	for _, arg := range c.Parameters {
 		if !arg.Optional {
 			continue
 		}
-		typ := arg.GoType(g.pkg, d)
+		typ := goTypeOf(arg, g.pkg, d)
 		isNonPtr := nonPtrMap[typ]
 		ptr := "&"
 		if isNonPtr || isNonPointer(g.pkg, d, arg) {
 			ptr = ""
 		}
-		name := arg.Name(d)
+		name := typeName(arg, d)
 		if name == "range" || name == "type" {
 			name = name[0 : len(name)-1]
 		}
-		comment := fmt.Sprintf("Set%[1]s sets the %[1]s optional argument. ", arg.ExportedName(d))
-		desc := arg.Desc(8, len(comment))
+		comment := fmt.Sprintf("Set%[1]s sets the %[1]s optional argument. ", fieldName(arg, d))
+		desc := typeDesc(arg, 8, len(comment))
 		if arg.Deprecated {
 			if desc == "" {
 				desc = "This property should not be used."
 			}
 			desc = "\n//\n// Deprecated: " + desc
 		}
-		if arg.IsLocalEnum() {
+		if isLocalEnum(arg) {
 			var enums []string
 			for _, e := range arg.Enum {
 				enums = append(enums, fmt.Sprintf("%q", e))
@@ -1071,19 +1388,21 @@ func (a *%[2]s) Set%[1]s(%[3]s %%[1]s) *%[2]s {
 	a.%[4]s%[1]s = %[6]s%[3]s
 	return a
 }
-`, arg.ExportedName(d), c.ArgsName(d), name, OptionalPropPrefix, desc, ptr, comment)
+`, fieldName(arg, d), argsName(c, d), name, OptionalPropPrefix, desc, ptr, comment)
 
-		argType := arg.GoType(g.pkg, d)
+		argType := goTypeOf(arg, g.pkg, d)
 		g.Printf(setMethodFmt, argType)
 	}
+
+	g.domainCmdArgsValidate(d, c)
 }
 
 func (g *Generator) domainCmdReply(d proto.Domain, c proto.Command) {
 	g.Printf(`
 // %[1]s represents the return values for %[2]s in the %[3]s domain.
 type %[1]s struct {
-`, c.ReplyName(d), c.Name(), d.Name())
-	g.printStructProperties(d, c.ReplyName(d), c.Returns, true, false)
+`, replyName(c, d), commandName(c), domainName(d))
+	g.printStructProperties(d, replyName(c, d), c.Returns, true, false)
 	g.Printf("}\n\n")
 }
 
@@ -1102,7 +1421,7 @@ func (e EventType) String() string {
 const (`)
 	for _, d := range doms {
 		for _, e := range d.Events {
-			g.Printf("\n\t%s EventType = %q", e.EventName(d), d.Domain+"."+e.NameName)
+			g.Printf("\n\t%s EventType = %q", eventTypeName(e, d), d.Domain+"."+e.NameName)
 		}
 	}
 	g.Printf("\n)\n")
@@ -1117,8 +1436,8 @@ func (g *Generator) DomainEvent(d proto.Domain, e proto.Event) {
 }
 
 func (g *Generator) domainEventClient(d proto.Domain, e proto.Event) {
-	eventClient := fmt.Sprintf("%sClient", e.EventName(d))
-	comment := fmt.Sprintf("%[1]s is a client for %[2]s events. ", eventClient, e.Name())
+	eventClient := fmt.Sprintf("%sClient", eventTypeName(e, d))
+	comment := fmt.Sprintf("%[1]s is a client for %[2]s events. ", eventClient, eventName(e))
 	g.Printf(`
 // %[2]s%[4]s
 type %[1]s interface {
@@ -1127,15 +1446,15 @@ type %[1]s interface {
 	Recv() (*%[3]s, error)
 	rpcc.Stream
 }
-`, eventClient, comment, e.ReplyName(d), e.Desc(true, 0, len(comment)))
+`, eventClient, comment, eventReplyName(e, d), eventDesc(e, true, 0, len(comment)))
 }
 
 func (g *Generator) domainEventReply(d proto.Domain, e proto.Event) {
 	g.Printf(`
 // %[1]s is the reply for %[2]s events.
 type %[1]s struct {
-`, e.ReplyName(d), e.Name())
-	g.printStructProperties(d, e.ReplyName(d), e.Parameters, true, false)
+`, eventReplyName(e, d), eventName(e))
+	g.printStructProperties(d, eventReplyName(e, d), e.Parameters, true, false)
 	g.Printf("}\n")
 }
 
@@ -1148,9 +1467,9 @@ func quotedImports(imports []string) string {
 }
 
 func isNonPointer(pkg string, d proto.Domain, t proto.AnyType) bool {
-	typ := t.GoType(pkg, d)
+	typ := goTypeOf(t, pkg, d)
 	switch {
-	case t.IsEnum():
+	case isEnum(t):
 	case strings.HasPrefix(typ, "[]"):
 	case strings.HasPrefix(typ, "map["):
 	case typ == "time.Time":
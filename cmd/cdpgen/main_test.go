@@ -0,0 +1,41 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNodePartialDomains(t *testing.T) {
+	bindings := parseProtocol(t, `{"domains": [
+		{"domain": "Runtime", "commands": [{"name": "evaluate"}], "events": [{"name": "consoleAPICalled"}]},
+		{"domain": "Network", "commands": [{"name": "enable"}, {"name": "setCookie"}, {"name": "clearCache", "redirect": "Fetch"}], "events": [{"name": "requestWillBeSent"}, {"name": "webSocketCreated"}]},
+		{"domain": "NodeWorker", "commands": [{"name": "enable"}]}
+	]}`)
+	node := parseProtocol(t, `{"domains": [
+		{"domain": "Runtime", "commands": [{"name": "evaluate"}, {"name": "setAsyncCallStackDepth"}], "events": [{"name": "consoleAPICalled"}]},
+		{"domain": "Network", "commands": [{"name": "enable"}], "events": [{"name": "requestWillBeSent"}]},
+		{"domain": "NodeWorker", "commands": [{"name": "enable"}]}
+	]}`)
+
+	partial := nodePartialDomains(bindings.Domains, node.Domains)
+	if len(partial) != 1 || partial[0].Domain != "Network" {
+		t.Fatalf("nodePartialDomains: got %v, want only Network", partial)
+	}
+	var commands, events []string
+	for _, c := range partial[0].Commands {
+		commands = append(commands, c.NameName)
+	}
+	for _, e := range partial[0].Events {
+		events = append(events, e.NameName)
+	}
+	if want := []string{"enable", "clearCache"}; !reflect.DeepEqual(commands, want) {
+		t.Errorf("Network commands: got %v, want %v", commands, want)
+	}
+	if want := []string{"requestWillBeSent"}; !reflect.DeepEqual(events, want) {
+		t.Errorf("Network events: got %v, want %v", events, want)
+	}
+
+	if got := nodeInterfaceName(bindings.Domains, partial[0]); got != "NodeNetwork" {
+		t.Errorf("nodeInterfaceName: got %q, want NodeNetwork", got)
+	}
+}
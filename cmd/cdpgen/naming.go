@@ -0,0 +1,410 @@
+package main
+
+// Naming and type helpers for generating Go code from the protocol
+// definitions (proto).
+
+import (
+	"log"
+	"strings"
+
+	"github.com/client9/misspell"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/mafredri/cdp/cmd/cdpgen/lint"
+	"github.com/mafredri/cdp/proto"
+)
+
+var (
+	misspellReplacer = misspell.New()
+	titleCase        = cases.Title(language.AmericanEnglish, cases.NoLower).String
+)
+
+func init() {
+	misspellReplacer.AddRuleList(misspell.DictAmerican)
+	misspellReplacer.Compile()
+}
+
+// domainName returns the Go name of the domain.
+func domainName(d proto.Domain) string {
+	return lint.Name(titleCase(d.Domain))
+}
+
+// domainDesc returns the cleaned domain description.
+func domainDesc(d proto.Domain, indent, startOffset int) string {
+	return cleanDescription(d.Description, indent, startOffset)
+}
+
+// commandName returns the linted command name.
+func commandName(c proto.Command) string {
+	return lint.Name(titleCase(c.NameName))
+}
+
+// commandDesc returns the cleaned command description.
+func commandDesc(c proto.Command, lineEndComment bool, indent, startOffset int) string {
+	if lineEndComment {
+		return cleanDescription(c.Description, indent, startOffset)
+	}
+	return lowerFirst(cleanDescription(c.Description, indent, startOffset))
+}
+
+// cmdName returns the full name of a command.
+func cmdName(c proto.Command, d proto.Domain, export bool) string {
+	name := domainName(d)
+	if !export {
+		name = strings.ToLower(domainName(d))
+	}
+	return name + commandName(c) + ""
+}
+
+// argsName returns the name of command arguments.
+func argsName(c proto.Command, d proto.Domain) string {
+	return commandName(c) + "Args"
+}
+
+// replyName returns the name of the command reply.
+func replyName(c proto.Command, d proto.Domain) string {
+	return commandName(c) + "Reply"
+}
+
+// argsSignature returns the signature (for use as function parameters).
+func argsSignature(c proto.Command, pkg string, d proto.Domain) string {
+	var args []string
+	for _, arg := range filterTypes(isOptional(false), c.Parameters...) {
+		name := typeName(arg, d)
+		if name == "range" || name == "type" {
+			name = name[0 : len(name)-1]
+		}
+		name += " "
+		args = append(args, name+goTypeOf(arg, pkg, d))
+	}
+	return strings.Join(args, ", ")
+}
+
+// argsInit returns the code for initializing arguments.
+func argsInit(c proto.Command, d proto.Domain) string {
+	var args []string
+	for _, arg := range filterTypes(isOptional(false), c.Parameters...) {
+		name := typeName(arg, d)
+		if name == "range" || name == "type" {
+			name = name[0 : len(name)-1]
+		}
+		args = append(args, fieldName(arg, d)+": "+name+",")
+	}
+	return strings.Join(args, "\n")
+}
+
+// argsAssign returns the argument assignment for args.
+func argsAssign(c proto.Command, receiver string, d proto.Domain) string {
+	var args []string
+	for _, arg := range filterTypes(isOptional(false), c.Parameters...) {
+		name := typeName(arg, d)
+		if name == "range" || name == "type" {
+			name = name[0 : len(name)-1]
+		}
+		args = append(args, receiver+"."+fieldName(arg, d)+" = "+name)
+	}
+	return strings.Join(args, "\n")
+}
+
+// replySignature returns the reply signature. Not used.
+func replySignature(c proto.Command, d proto.Domain) string {
+	var args []string
+	for _, arg := range c.Returns {
+		name := typeName(arg, d)
+		if name == "range" || name == "type" {
+			name = name[0 : len(name)-1]
+		}
+
+		typ := goTypeOf(arg, "cdp", d)
+		if arg.Optional && !strings.HasPrefix(typ, "[]") {
+			typ = "*" + typ
+		}
+		args = append(args, name+" "+typ)
+	}
+	return strings.Join(args, ", ")
+}
+
+// replyAssign assigns the parameters of the reply. Not used.
+func replyAssign(c proto.Command, receiver string, d proto.Domain) string {
+	var args []string
+	for _, arg := range c.Returns {
+		name := typeName(arg, d)
+		if name == "range" || name == "type" {
+			name = name[0 : len(name)-1]
+		}
+		args = append(args, name+" = "+receiver+"."+fieldName(arg, d))
+	}
+	return strings.Join(args, "\n")
+}
+
+// eventName returns the name of the event.
+func eventName(e proto.Event) string {
+	return lint.Name(titleCase(e.NameName))
+}
+
+// eventDesc returns the cleaned description.
+func eventDesc(e proto.Event, lineEndComment bool, indent, startOffset int) string {
+	if lineEndComment {
+		return cleanDescription(e.Description, indent, startOffset)
+	}
+	return lowerFirst(cleanDescription(e.Description, indent, startOffset))
+}
+
+// eventTypeName returns the name of the event as a go type.
+func eventTypeName(e proto.Event, d proto.Domain) string {
+	return nameInDomain(d, eventName(e), "")
+}
+
+// eventReplyName returns the name of the event reply struct.
+func eventReplyName(e proto.Event, d proto.Domain) string {
+	return eventTypeName(e, d) + "Reply"
+}
+
+// enumName returns the Go-ified name for the enum.
+func enumName(e proto.Enum) string {
+	switch e {
+	case "-Infinity", "-0":
+		return strings.Replace(string(e), "-", "Negative", 1)
+	}
+	s := strings.Replace(string(e), "-", " ", -1)
+	s = titleCase(s)
+	return lint.Name(strings.Replace(s, " ", "", -1))
+}
+
+// typeDesc returns the cleaned description.
+func typeDesc(at proto.AnyType, indent, startOffset int) string {
+	return cleanDescription(at.Description, indent, startOffset)
+}
+
+// fieldName returns an exported name.
+func fieldName(at proto.AnyType, d proto.Domain) string {
+	if at.IDName != "" {
+		return typeName(at, d)
+	}
+	return lint.Name(titleCase(at.NameName))
+}
+
+// typeName returns a Go-ified name for the AnyType.
+func typeName(at proto.AnyType, d proto.Domain) string {
+	if at.IDName != "" {
+		return nameInDomain(d, at.IDName, "")
+	}
+
+	return lint.Name(at.NameName)
+}
+
+// recvr returns the receiver for the type.
+func recvr(at proto.AnyType, d proto.Domain) string {
+	return strings.ToLower(typeName(at, d)[0:1])
+}
+
+func nameInDomain(d proto.Domain, name, _ string) string {
+	name = lint.Name(titleCase(name))
+	if name != domainName(d) && strings.Index(name, domainName(d)) == 0 {
+		name = strings.Replace(name, domainName(d), "", 1)
+	}
+	name = strings.TrimPrefix(name, "ing") // preload.Preloading -> ing -> ""
+	return name
+}
+
+func typeKind(at proto.AnyType, pkg string) string {
+	if at.Ref != "" {
+		return "reference"
+	}
+	if (at.IDName == "Timestamp" || at.IDName == "NetworkTimeSinceEpoch" || (pkg != "network" && at.IDName == "TimeSinceEpoch") || at.IDName == "MonotonicTime") && at.Type == "number" {
+		return "time"
+	}
+	if isEnum(at) {
+		return "enum"
+	}
+	if at.Type == "string" && strings.HasPrefix(at.Description, "Base64-encoded") {
+		return "base64"
+	}
+	if at.Type == "string" && strings.Contains(at.Description, "Encoded as a base64 string when passed over JSON") {
+		return "base64"
+	}
+	if at.Type == "object" && len(at.Properties) == 0 {
+		if at.IDName != "" {
+			return "raw"
+		}
+		return "any"
+	}
+	return at.Type
+}
+
+// goTypeOf returns the Go representation for a protocol type.
+func goTypeOf(at proto.AnyType, pkg string, d proto.Domain) string {
+	// Special case for circular types.
+	if pkg == "page" && typeName(at, d) == "FrameID" {
+		return "= internal.PageFrameID"
+	}
+	if pkg == "network" && typeName(at, d) == "TimeSinceEpoch" {
+		return "= internal.NetworkTimeSinceEpoch"
+	}
+	if pkg == "browser" && typeName(at, d) == "ContextID" {
+		return "= internal.BrowserContextID"
+	}
+
+	switch typeKind(at, pkg) {
+	case "any":
+		return "json.RawMessage"
+	case "boolean":
+		return "bool"
+	case "string":
+		return "string"
+	case "number":
+		return "float64"
+	case "integer":
+		return "int"
+	case "object":
+		return "struct"
+	case "array":
+		return "[]" + goTypeOf(*at.Items, pkg, d)
+	case "reference":
+		return refType(at, pkg, d)
+	case "time":
+		return "time.Time"
+	case "enum":
+		// Enums are handled specially.
+		return "enum"
+	case "base64":
+		// By using a []byte here, Base64-encoded images are
+		// automatically decoded by json.Unmarshal.
+		return "[]byte"
+	case "raw":
+		// Special []byte, without bas64 decoding.
+		return "RawMessage"
+	default:
+		log.Panicf("unhandled type: %#v", at)
+	}
+
+	panic("unreachable")
+}
+
+func refType(at proto.AnyType, pkg string, d proto.Domain) string {
+	var prefix string
+	if strings.ContainsRune(at.Ref, '.') {
+		s := strings.Split(at.Ref, ".")
+		prefix = strings.ToLower(s[0]) + "."
+		s[0] = lint.Name(titleCase(s[0]))
+		s[1] = lint.Name(titleCase(s[1]))
+
+		// Remove stutter, e.g. SecuritySecurityState.
+		if strings.Index(s[1], s[0]) == 0 || s[1] == s[0] {
+			s[1] = strings.Replace(s[1], s[0], "", 1)
+		}
+
+		// Fix types that reference their own domain.
+		if s[0] == domainName(d) {
+			prefix = ""
+			at.Ref = s[1]
+		}
+		name := prefix + titleCase(lint.Name(s[1]))
+
+		// Special cases for circular types.
+		switch {
+		case (pkg == "network" || pkg == "dom") && name == "page.FrameID":
+			return "internal.PageFrameID"
+		case pkg == "target" && name == "browser.ContextID":
+			return "internal.BrowserContextID"
+		case pkg == "security" && name == "network.TimeSinceEpoch":
+			return "internal.NetworkTimeSinceEpoch"
+		}
+		return name
+	}
+	return prefix + nameInDomain(d, at.Ref, "")
+}
+
+// isEnum returns true if type is an enum.
+func isEnum(at proto.AnyType) bool {
+	return at.IDName != "" && len(at.Enum) > 0
+}
+
+// isLocalEnum returns true if type is enumerated without an exported type.
+func isLocalEnum(at proto.AnyType) bool {
+	return at.IDName == "" && len(at.Enum) > 0
+}
+
+func lowerFirst(d string) string {
+	desc := strings.Split(d, " ")
+	if desc[0] != strings.ToUpper(desc[0]) {
+		desc[0] = strings.ToLower(desc[0])
+	}
+	return strings.Join(desc, " ")
+}
+
+// Account 3 for comment prefix (// ).
+const maxCommentLineLen = 80 - 3
+
+func cleanDescription(d string, indent, startOffset int) string {
+	replace := []struct {
+		old string
+		new string
+	}{
+		{"<code>", ""},
+		{"</code>", ""},
+		// <p> is only used by DOM description.
+		{"<p>", "\n\n"},
+		{"</p>", ""},
+		{"&lt;", "<"},
+		{"&gt;", ">"},
+		// Fix typo...
+		{"&gt ", "> "},
+	}
+
+	for _, r := range replace {
+		d = strings.Replace(d, r.old, r.new, -1)
+	}
+
+	d, _ = misspellReplacer.Replace(d)
+
+	p := strings.Split(d, "\n\n")
+	for i, s := range p {
+		ss := strings.Fields(s)
+
+		var split []string
+		n := startOffset
+		startOffset = 0 // Zero after first use.
+		s = ""
+		for _, sss := range ss {
+			n += len(sss) + 1
+			if n < maxCommentLineLen-indent {
+				split = append(split, sss)
+				continue
+			}
+			n = len(sss)
+			s += strings.Join(split, " ") + "\n"
+			split = nil
+			split = append(split, sss)
+		}
+
+		if len(split) > 0 {
+			s += strings.Join(split, " ") + "\n"
+		}
+
+		s = strings.TrimSuffix(s, "\n")
+
+		p[i] = strings.Replace(s, "\n", "\n// ", -1)
+	}
+
+	return strings.Join(p, "\n//\n// ")
+}
+
+type filterFunc func(at proto.AnyType) bool
+
+func filterTypes(f filterFunc, at ...proto.AnyType) []proto.AnyType {
+	var ret []proto.AnyType
+	for _, a := range at {
+		if f(a) {
+			ret = append(ret, a)
+		}
+	}
+	return ret
+}
+
+func isOptional(o bool) filterFunc {
+	return func(at proto.AnyType) bool {
+		return at.Optional == o
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// argOptions enables the functional options for command arguments
+// (-arg-options), see DomainArgOptions.
+var argOptions bool
+
+// DomainArgOptions writes the functional options for the commands with
+// optional arguments, an alternative to the chained setters:
+//
+//	page.NewNavigateArgsWith(url, page.NavigateWithReferrer(ref))
+//
+// The options call the setters, the encoding is the same.
+func (g *Generator) DomainArgOptions(d proto.Domain) {
+	for _, c := range d.Commands {
+		if c.Redirect != "" {
+			continue
+		}
+		var required []string
+		var optional []proto.AnyType
+		for _, p := range c.Parameters {
+			if p.Optional {
+				optional = append(optional, p)
+				continue
+			}
+			name := typeName(p, d)
+			if name == "range" || name == "type" {
+				name = name[0 : len(name)-1]
+			}
+			required = append(required, name)
+		}
+		if len(optional) == 0 {
+			continue
+		}
+		g.hasContent = true
+
+		args := argsName(c, d)
+		option := commandName(c) + "Option"
+		sig := "opts ..." + option
+		if s := argsSignature(c, g.pkg, d); s != "" {
+			sig = s + ", " + sig
+		}
+		g.Printf(`
+// %[1]s sets an optional argument of %[2]s.
+type %[1]s func(*%[2]s)
+
+// New%[2]sWith initializes %[2]s with the required arguments
+// and applies the options, nil options are ignored.
+func New%[2]sWith(%[3]s) *%[2]s {
+	args := New%[2]s(%[4]s)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+`, option, args, sig, strings.Join(required, ", "))
+
+		for _, p := range optional {
+			var notes string
+			if p.Deprecated {
+				notes += "\n//\n// Deprecated: This argument should not be used."
+			}
+			if p.Experimental {
+				notes += "\n//\n// Note: This argument is experimental."
+			}
+			g.Printf(`
+// %[1]sWith%[2]s sets the %[2]s optional argument, see
+// %[3]s.Set%[2]s.%[5]s
+func %[1]sWith%[2]s(v %[4]s) %[6]s {
+	return func(a *%[3]s) { a.Set%[2]s(v) }
+}
+`, commandName(c), fieldName(p, d), args, goTypeOf(p, g.pkg, d), notes, option)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// writeRegistry writes the method and event metadata for the domains to
+// the cdp/registry package (as file).
+func writeRegistry(dest string, imports []string, buildTag, file string, domains []proto.Domain) {
+	g := Generator{
+		pkg:      "registry",
+		dir:      filepath.Join(dest, "registry"),
+		imports:  append(append([]string(nil), imports...), "reflect"),
+		buildTag: buildTag,
+	}
+	err := mkdir(g.path())
+	panicErr(err)
+
+	g.PackageHeader("")
+	g.Registry(domains)
+	g.writeFile(file)
+}
+
+// Registry appends the methods and events of the domains to the
+// registry in init.
+func (g *Generator) Registry(domains []proto.Domain) {
+	var methods, events strings.Builder
+	for _, d := range domains {
+		pkg := strings.ToLower(domainName(d))
+		for _, c := range d.Commands {
+			if c.Redirect != "" {
+				continue
+			}
+			fmt.Fprintf(&methods, "{\n%s", registryCommon(d, d.Domain+"."+c.NameName, c.Description, c.Experimental, c.Deprecated))
+			if len(c.Parameters) > 0 {
+				name := pkg + "." + argsName(c, d)
+				fmt.Fprintf(&methods, "Args: reflect.TypeOf(%[1]s{}),\nNewArgs: func() interface{} { return new(%[1]s) },\n", name)
+			}
+			if len(c.Returns) > 0 {
+				name := pkg + "." + replyName(c, d)
+				fmt.Fprintf(&methods, "Reply: reflect.TypeOf(%[1]s{}),\nNewReply: func() interface{} { return new(%[1]s) },\n", name)
+			}
+			methods.WriteString("},\n")
+		}
+		for _, e := range d.Events {
+			name := pkg + "." + eventReplyName(e, d)
+			fmt.Fprintf(&events, "{\n%s", registryCommon(d, d.Domain+"."+e.NameName, e.Description, e.Experimental, e.Deprecated))
+			fmt.Fprintf(&events, "Reply: reflect.TypeOf(%[1]s{}),\nNewReply: func() interface{} { return new(%[1]s) },\n},\n", name)
+		}
+	}
+	if methods.Len()+events.Len() == 0 {
+		return
+	}
+	g.hasContent = true
+
+	g.Printf("\nfunc init() {\n")
+	if methods.Len() > 0 {
+		g.Printf("methods = append(methods, []*Method{\n%s}...)\n", methods.String())
+	}
+	if events.Len() > 0 {
+		g.Printf("events = append(events, []*Event{\n%s}...)\n", events.String())
+	}
+	g.Printf("}\n")
+}
+
+// registryCommon returns the fields shared by Method and Event, the
+// experimental and deprecated status is inherited from the domain.
+func registryCommon(d proto.Domain, name, desc string, experimental, deprecated bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name: %q,\nDomain: %q,\n", name, d.Domain)
+	if desc != "" {
+		fmt.Fprintf(&b, "Description: %q,\n", desc)
+	}
+	if experimental || d.Experimental {
+		b.WriteString("Experimental: true,\n")
+	}
+	if deprecated || d.Deprecated {
+		b.WriteString("Deprecated: true,\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,28 @@
+package main
+
+import "github.com/mafredri/cdp/proto"
+
+// stableDomains returns the domains without experimental and deprecated
+// domains, commands and events.
+func stableDomains(domains []proto.Domain) []proto.Domain {
+	var stable []proto.Domain
+	for _, d := range domains {
+		if d.Experimental || d.Deprecated {
+			continue
+		}
+		x := d
+		x.Commands, x.Events = nil, nil
+		for _, c := range d.Commands {
+			if !c.Experimental && !c.Deprecated {
+				x.Commands = append(x.Commands, c)
+			}
+		}
+		for _, e := range d.Events {
+			if !e.Experimental && !e.Deprecated {
+				x.Events = append(x.Events, e)
+			}
+		}
+		stable = append(stable, x)
+	}
+	return stable
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+// The protocol does not describe relations between parameters other
+// than in their descriptions, these patterns match the ones in use.
+var (
+	// E.g. "This is mutually exclusive with `uniqueContextId`".
+	reMutuallyExclusive = regexp.MustCompile("(?i)mutually\\s+exclusive\\s+with\\s+`(\\w+)`")
+	// E.g. "At least and at most one of securityOrigin, storageKey,
+	// or storageBucket must be specified."
+	reExactlyOne = regexp.MustCompile(`At\s+least\s+and\s+at\s+most\s+one\s+of\s+([\w\s,]+?)\s+must\s+be\s+specified`)
+	// E.g. "Either `url` or `urlRegex` must be specified." Exactly one
+	// must be set, see eitherExtra for parameters not listed.
+	reEither = regexp.MustCompile("Either\\s+`(\\w+)`\\s+or\\s+`(\\w+)`\\s+must\\s+be\\s+specified")
+)
+
+// eitherExtra lists the parameters that are accepted in place of the
+// ones named by reEither but missing from the description, by command.
+var eitherExtra = map[string][]string{
+	// V8 also accepts scriptHash, see
+	// V8DebuggerAgentImpl::setBreakpointByUrl.
+	"Debugger.setBreakpointByUrl": {"scriptHash"},
+}
+
+// argsGroup is a set of parameters of which at most one (or exactly
+// one) may be set.
+type argsGroup struct {
+	names   []string
+	exactly bool
+}
+
+// argsGroups returns the groups of related parameters in c.
+func argsGroups(d proto.Domain, c proto.Command) []argsGroup {
+	params := make(map[string]bool)
+	for _, p := range c.Parameters {
+		params[p.NameName] = p.Optional
+	}
+
+	var groups []argsGroup
+	seen := make(map[string]bool)
+	add := func(exactly bool, names ...string) {
+		for _, n := range names {
+			if !params[n] {
+				return // Unknown or required parameter.
+			}
+		}
+		key := append([]string(nil), names...)
+		sort.Strings(key)
+		if len(names) < 2 || seen[strings.Join(key, ",")] {
+			return
+		}
+		seen[strings.Join(key, ",")] = true
+		groups = append(groups, argsGroup{names: names, exactly: exactly})
+	}
+
+	for _, p := range c.Parameters {
+		for _, m := range reMutuallyExclusive.FindAllStringSubmatch(p.Description, -1) {
+			add(false, p.NameName, m[1])
+		}
+		for _, m := range reExactlyOne.FindAllStringSubmatch(p.Description, -1) {
+			var names []string
+			for _, n := range strings.FieldsFunc(m[1], func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+				if n != "or" {
+					names = append(names, n)
+				}
+			}
+			add(true, names...)
+		}
+		for _, m := range reEither.FindAllStringSubmatch(p.Description, -1) {
+			add(true, append([]string{m[1], m[2]}, eitherExtra[d.Domain+"."+c.NameName]...)...)
+		}
+	}
+	return groups
+}
+
+// domainCmdArgsValidate writes the Validate method for the command
+// arguments. Required arguments that would be encoded as null, invalid
+// enum values and related parameters (see argsGroups) are checked.
+func (g *Generator) domainCmdArgsValidate(d proto.Domain, c proto.Command) {
+	name := argsName(c, d)
+	prefix := fmt.Sprintf("%s.%s: ", g.pkg, name)
+	fields := g.jsonFields(d, name, c.Parameters, true)
+
+	g.Printf(`
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *%s) Validate() error {
+`, name)
+	for _, f := range fields {
+		expr := "a." + f.name
+		kind, _ := jsonKindOf(g.pkg, d, f.prop)
+		ptr := strings.HasPrefix(f.goType, "*")
+
+		if !f.prop.Optional {
+			switch {
+			case ptr, kind == jsonArray, kind == jsonBase64, kind == jsonAny, kind == jsonRaw:
+				g.Printf("if %s == nil {\nreturn errors.New(%q)\n}\n", expr, prefix+f.name+" is required")
+			}
+		}
+
+		var check Generator
+		check.pkg = g.pkg
+		if ptr {
+			check.validateEnum(d, f.prop, "*"+expr, prefix, f.name, "", 0)
+		} else {
+			check.validateEnum(d, f.prop, expr, prefix, f.name, "", 0)
+		}
+		if check.buf.Len() == 0 {
+			continue
+		}
+		if cond := g.jsonSetCond(d, f, expr); cond != "" {
+			g.Printf("if %s {\n%s}\n", cond, check.buf.Bytes())
+		} else {
+			g.Printf("%s", check.buf.Bytes())
+		}
+	}
+
+	declared := false
+	for _, grp := range argsGroups(d, c) {
+		var names, conds []string
+		for _, n := range grp.names {
+			for _, f := range fields {
+				if f.prop.NameName == n {
+					names = append(names, f.name)
+					conds = append(conds, g.jsonSetCond(d, f, "a."+f.name))
+				}
+			}
+		}
+		if grp.exactly {
+			if declared {
+				g.Printf("n = 0\n")
+			} else {
+				g.Printf("n := 0\n")
+				declared = true
+			}
+			for _, cond := range conds {
+				g.Printf("if %s {\nn++\n}\n", cond)
+			}
+			msg := "exactly one of " + strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1] + " must be set"
+			g.Printf("if n != 1 {\nreturn errors.New(%q)\n}\n", prefix+msg)
+		} else {
+			msg := strings.Join(names, " and ") + " are mutually exclusive"
+			g.Printf("if %s {\nreturn errors.New(%q)\n}\n", strings.Join(conds, " && "), prefix+msg)
+		}
+	}
+	g.Printf("return nil\n}\n")
+}
+
+// validateEnum writes the enum check for expr (of type t), nested arrays
+// are checked element by element. The error describes the value via
+// field and index (the index expression of the element, if any).
+func (g *Generator) validateEnum(d proto.Domain, t proto.AnyType, expr, prefix, field, index string, depth int) {
+	errExpr := func(v string) string {
+		if index == "" {
+			return fmt.Sprintf("%q + strconv.Quote(%s)", prefix+field+": invalid value ", v)
+		}
+		return fmt.Sprintf(`%q + %s + "]: invalid value " + strconv.Quote(%s)`, prefix+field, index, v)
+	}
+
+	kind, items := jsonKindOf(g.pkg, d, t)
+	switch {
+	case isLocalEnum(t):
+		var enums []string
+		for _, e := range t.Enum {
+			enums = append(enums, fmt.Sprintf("%q", e))
+		}
+		g.Printf("switch %s {\ncase %s:\ndefault:\nreturn errors.New(%s)\n}\n", expr, strings.Join(enums, ", "), errExpr(expr))
+	case t.Ref != "" && kind == jsonString && isEnumRef(d, t):
+		g.Printf("if !%s.Valid() {\nreturn errors.New(%s)\n}\n", strings.TrimPrefix(expr, "*"), errExpr("string("+expr+")"))
+	case kind == jsonArray:
+		var check Generator
+		check.pkg = g.pkg
+		i := fmt.Sprintf("i%d", depth)
+		idx := `"[" + strconv.Itoa(` + i + `)`
+		if index != "" {
+			idx = index + ` + "][" + strconv.Itoa(` + i + `)`
+		}
+		if strings.HasPrefix(expr, "*") {
+			expr = "(" + expr + ")"
+		}
+		check.validateEnum(d, items, expr+"["+i+"]", prefix, field, idx, depth+1)
+		if check.buf.Len() > 0 {
+			g.Printf("for %s := range %s {\n%s}\n", i, expr, check.buf.Bytes())
+		}
+	}
+}
+
+// isEnumRef reports if the reference t resolves to an enum type.
+func isEnumRef(d proto.Domain, t proto.AnyType) bool {
+	ref := t.Ref
+	if !strings.ContainsRune(ref, '.') {
+		ref = d.Domain + "." + ref
+	}
+	r, ok := jsonTypes[ref]
+	return ok && isEnum(r.t)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+type versionProto struct {
+	name string // Package name, e.g. "chrome140".
+	dir  string // Contains browser_protocol.json and js_protocol.json.
+}
+
+// findVersions returns the protocol versions in dir, one per
+// subdirectory (e.g. dir/chrome140). A missing dir has no versions.
+func findVersions(dir string) []versionProto {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	panicErr(err)
+
+	var versions []versionProto
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if !validTag.MatchString(e.Name()) {
+			log.Printf("Invalid version name %q, must be lowercase alphanumeric, skipping...", e.Name())
+			continue
+		}
+		versions = append(versions, versionProto{name: e.Name(), dir: filepath.Join(dir, e.Name())})
+	}
+	return versions
+}
+
+// writeVersion generates the bindings for the protocol version v in
+// versions/<name>: the Client (package <name>) and the domain packages
+// (versions/<name>/protocol/...). The packages are independent of the
+// cdp and protocol packages, rpcc is shared.
+func writeVersion(dest, pkg string, v versionProto) {
+	var protocol proto.Protocol
+	for _, name := range []string{"browser_protocol.json", "js_protocol.json"} {
+		data, err := os.ReadFile(filepath.Join(v.dir, name))
+		panicErr(err)
+
+		var p proto.Protocol
+		err = json.Unmarshal(data, &p)
+		panicErr(err)
+		protocol.Domains = append(protocol.Domains, p.Domains...)
+	}
+	sort.Slice(protocol.Domains, func(i, j int) bool {
+		return protocol.Domains[i].Domain < protocol.Domains[j].Domain
+	})
+
+	// The type information is global, start over for this version. The
+	// hand-written UnmarshalJSON methods only exist in package cdp.
+	nonPtrMap = make(map[string]bool)
+	jsonTypes = make(map[string]jsonRef)
+	customUnmarshal = make(map[string]bool)
+
+	vdest := filepath.Join(dest, "versions", v.name)
+	imports := prepareDomains(pkg, pkg+"/versions/"+v.name, protocol.Domains)
+
+	doc := Generator{pkg: v.name, dir: vdest}
+	err := os.MkdirAll(doc.path(), 0o755)
+	panicErr(err)
+	doc.hasContent = true
+	doc.PackageHeader(fmt.Sprintf(`// Package %[1]s provides the bindings for the %[1]s protocol version,
+// e.g. for browsers that no longer support methods removed from the
+// current protocol. It is used like package cdp:
+//
+//	c := %[1]s.NewClient(conn) // conn created via rpcc.Dial.
+//
+// The domain packages are in %[2]s/versions/%[1]s/protocol.`, v.name, pkg))
+	doc.writeFile("doc.go")
+
+	writeCdpPackage(vdest, v.name, imports, protocol.Domains, nil, nil)
+	writeProtocolPackages(filepath.Join(vdest, "protocol"), imports, protocol.Domains)
+
+	// The internal package of cdp/protocol cannot be imported, OpError
+	// is copied from it.
+	src, err := os.ReadFile(filepath.Join(dest, "protocol", "internal", "error.go"))
+	panicErr(err)
+	g := Generator{pkg: "internal", dir: filepath.Join(vdest, "protocol", "internal")}
+	g.hasContent = true
+	g.Printf("// Code generated by cdpgen from protocol/internal/error.go. DO NOT EDIT.\n\n%s", src)
+	g.writeFile("error.go")
+}
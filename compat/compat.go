@@ -0,0 +1,290 @@
+/*
+Package compat checks the compatibility of the bindings (package cdp)
+with a connected browser. The bindings are generated from a fixed snapshot
+of the protocol definitions, older (or newer) browsers may lack domains,
+commands or parameters, or use different types.
+
+Check the browser at startup:
+
+	report, err := compat.Check(ctx, devtool.New("http://127.0.0.1:9222"))
+	if err != nil {
+		// Handle error.
+	}
+	if !report.Supported("Page.captureScreenshot") {
+		// Fallback...
+	}
+	for _, issue := range report.Issues {
+		log.Println(issue)
+	}
+
+The cdpcompat command prints the report for a DevTools endpoint.
+*/
+package compat
+
+import (
+	"context"
+	_ "embed" // For protocol.json.
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/proto"
+)
+
+// protocolJSON is the protocol used to generate the bindings, written
+// by cdpgen.
+//
+//go:embed protocol.json
+var protocolJSON []byte
+
+var (
+	bindingsOnce sync.Once
+	bindings     *proto.Protocol
+)
+
+// Bindings returns the protocol definitions (without descriptions) the
+// bindings were generated from. The returned value must not be modified.
+func Bindings() *proto.Protocol {
+	bindingsOnce.Do(func() {
+		bindings = new(proto.Protocol)
+		if err := json.Unmarshal(protocolJSON, bindings); err != nil {
+			panic("compat: invalid protocol.json: " + err.Error())
+		}
+	})
+	return bindings
+}
+
+// IssueKind is the kind of compatibility Issue.
+type IssueKind int
+
+// IssueKind enums.
+const (
+	MissingDomain IssueKind = iota + 1
+	MissingCommand
+	MissingEvent
+	MissingType
+	MissingParameter // Command or event parameter.
+	MissingReturn    // Command return value.
+	MissingProperty  // Type property.
+	TypeMismatch
+)
+
+func (k IssueKind) String() string {
+	switch k {
+	case MissingDomain:
+		return "MissingDomain"
+	case MissingCommand:
+		return "MissingCommand"
+	case MissingEvent:
+		return "MissingEvent"
+	case MissingType:
+		return "MissingType"
+	case MissingParameter:
+		return "MissingParameter"
+	case MissingReturn:
+		return "MissingReturn"
+	case MissingProperty:
+		return "MissingProperty"
+	case TypeMismatch:
+		return "TypeMismatch"
+	default:
+		return "IssueKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Issue represents a difference between the bindings and the browser.
+type Issue struct {
+	Kind   IssueKind
+	Domain string // E.g. "Page".
+	Name   string // Command, event or type, if any, e.g. "navigate".
+	Field  string // Parameter, return value or property, if any.
+
+	// Want and Got are the types in the bindings and the browser,
+	// respectively, for TypeMismatch.
+	Want string
+	Got  string
+
+	// Experimental is true when the item is experimental (or part of
+	// an experimental domain) in the bindings.
+	Experimental bool
+}
+
+func (i Issue) String() string {
+	s := i.Kind.String() + ": " + i.Domain
+	if i.Name != "" {
+		s += "." + i.Name
+	}
+	if i.Field != "" {
+		s += " (" + i.Field + ")"
+	}
+	if i.Kind == TypeMismatch {
+		s += ": want " + i.Want + ", got " + i.Got
+	}
+	if i.Experimental {
+		s += " [experimental]"
+	}
+	return s
+}
+
+// Report is the result of a compatibility check.
+type Report struct {
+	Issues []Issue // Sorted by domain and name.
+
+	missing map[string]bool // Missing domains, commands and events.
+}
+
+// OK returns true if no issues were found.
+func (r *Report) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// Supported returns false if the domain, command or event (e.g.
+// "Page" or "Page.navigate") is missing in the browser. The parameters
+// may still differ, see Issues.
+func (r *Report) Supported(method string) bool {
+	if r.missing[method] {
+		return false
+	}
+	for i := 0; i < len(method); i++ {
+		if method[i] == '.' {
+			return !r.missing[method[:i]]
+		}
+	}
+	return true
+}
+
+// Stable returns the issues that are not experimental.
+func (r *Report) Stable() []Issue {
+	var issues []Issue
+	for _, i := range r.Issues {
+		if !i.Experimental {
+			issues = append(issues, i)
+		}
+	}
+	return issues
+}
+
+// Check compares the bindings with the protocol served by the DevTools
+// endpoint (see devtool.Protocol).
+func Check(ctx context.Context, devt *devtool.DevTools) (*Report, error) {
+	remote, err := devt.Protocol(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "compat: Check")
+	}
+	return Compare(Bindings(), remote), nil
+}
+
+// CheckDomains compares the domains of the bindings with the domains
+// supported by the target (via Schema.getDomains). Only MissingDomain
+// issues are reported, use Check for a full report.
+func CheckDomains(ctx context.Context, c *cdp.Client) (*Report, error) {
+	reply, err := c.Schema.GetDomains(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "compat: CheckDomains")
+	}
+	remote := new(proto.Protocol)
+	for _, d := range reply.Domains {
+		remote.Domains = append(remote.Domains, proto.Domain{Domain: d.Name})
+	}
+
+	r := &Report{missing: make(map[string]bool)}
+	for _, d := range Bindings().Domains {
+		if _, ok := remote.Domain(d.Domain); !ok {
+			r.add(Issue{Kind: MissingDomain, Domain: d.Domain, Experimental: d.Experimental})
+		}
+	}
+	r.sort()
+	return r, nil
+}
+
+// Compare reports the differences between the protocols want (e.g.
+// Bindings) and got (e.g. from the browser). Items that only exist in
+// got are not reported.
+func Compare(want, got *proto.Protocol) *Report {
+	r := &Report{missing: make(map[string]bool)}
+	for _, wd := range want.Domains {
+		gd, ok := got.Domain(wd.Domain)
+		if !ok {
+			r.add(Issue{Kind: MissingDomain, Domain: wd.Domain, Experimental: wd.Experimental})
+			continue
+		}
+		r.compareDomain(wd, gd)
+	}
+	r.sort()
+	return r
+}
+
+func (r *Report) add(i Issue) {
+	r.Issues = append(r.Issues, i)
+	switch i.Kind {
+	case MissingDomain:
+		r.missing[i.Domain] = true
+	case MissingCommand, MissingEvent:
+		r.missing[i.Domain+"."+i.Name] = true
+	}
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Issues, func(i, j int) bool {
+		a, b := r.Issues[i], r.Issues[j]
+		if a.Domain != b.Domain {
+			return a.Domain < b.Domain
+		}
+		return a.Name < b.Name
+	})
+}
+
+func (r *Report) compareDomain(want, got proto.Domain) {
+	for _, wc := range want.Commands {
+		if wc.Redirect != "" {
+			continue // Not part of the bindings.
+		}
+		exp := want.Experimental || wc.Experimental
+		gc, ok := got.Command(wc.NameName)
+		if !ok {
+			r.add(Issue{Kind: MissingCommand, Domain: want.Domain, Name: wc.NameName, Experimental: exp})
+			continue
+		}
+		r.compareFields(MissingParameter, want.Domain, wc.NameName, exp, wc.Parameters, gc.Parameters)
+		r.compareFields(MissingReturn, want.Domain, wc.NameName, exp, wc.Returns, gc.Returns)
+	}
+	for _, we := range want.Events {
+		exp := want.Experimental || we.Experimental
+		ge, ok := got.Event(we.NameName)
+		if !ok {
+			r.add(Issue{Kind: MissingEvent, Domain: want.Domain, Name: we.NameName, Experimental: exp})
+			continue
+		}
+		r.compareFields(MissingParameter, want.Domain, we.NameName, exp, we.Parameters, ge.Parameters)
+	}
+	for _, wt := range want.Types {
+		exp := want.Experimental || wt.Experimental
+		gt, ok := got.Type(wt.IDName)
+		if !ok {
+			r.add(Issue{Kind: MissingType, Domain: want.Domain, Name: wt.IDName, Experimental: exp})
+			continue
+		}
+		if w, g := wt.TypeName(), gt.TypeName(); w != g {
+			r.add(Issue{Kind: TypeMismatch, Domain: want.Domain, Name: wt.IDName, Want: w, Got: g, Experimental: exp})
+			continue
+		}
+		r.compareFields(MissingProperty, want.Domain, wt.IDName, exp, wt.Properties, gt.Properties)
+	}
+}
+
+func (r *Report) compareFields(kind IssueKind, domain, name string, exp bool, want, got []proto.AnyType) {
+	for _, w := range want {
+		g, ok := proto.Field(got, w.NameName)
+		if !ok {
+			r.add(Issue{Kind: kind, Domain: domain, Name: name, Field: w.NameName, Experimental: exp || w.Experimental})
+			continue
+		}
+		if wt, gt := w.TypeName(), g.TypeName(); wt != gt {
+			r.add(Issue{Kind: TypeMismatch, Domain: domain, Name: name, Field: w.NameName, Want: wt, Got: gt, Experimental: exp || w.Experimental})
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package compat
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mafredri/cdp/proto"
+)
+
+func TestBindings(t *testing.T) {
+	p := Bindings()
+	for _, method := range []string{"Page.navigate", "Target.attachToTarget", "Runtime.evaluate"} {
+		if !p.HasCommand(method) {
+			t.Errorf("Bindings: missing %s", method)
+		}
+	}
+	if r := Compare(p, p); !r.OK() {
+		t.Errorf("Compare(Bindings, Bindings): got issues %v", r.Issues)
+	}
+}
+
+func parseProtocol(t *testing.T, s string) *proto.Protocol {
+	t.Helper()
+	p := new(proto.Protocol)
+	if err := json.Unmarshal([]byte(s), p); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestCompare(t *testing.T) {
+	want := parseProtocol(t, `{"domains": [
+		{"domain": "Page", "commands": [
+			{"name": "navigate", "parameters": [
+				{"name": "url", "type": "string"},
+				{"name": "referrer", "type": "string", "optional": true, "experimental": true}
+			], "returns": [{"name": "frameId", "$ref": "FrameId"}]},
+			{"name": "reload"}
+		], "events": [
+			{"name": "loadEventFired", "parameters": [{"name": "timestamp", "type": "number"}]}
+		], "types": [
+			{"id": "FrameId", "type": "string"}
+		]},
+		{"domain": "Storage", "experimental": true}
+	]}`)
+	got := parseProtocol(t, `{"domains": [
+		{"domain": "Page", "commands": [
+			{"name": "navigate", "parameters": [
+				{"name": "url", "type": "integer"}
+			], "returns": [{"name": "frameId", "$ref": "FrameId"}]},
+			{"name": "newCommand"}
+		], "events": [
+			{"name": "loadEventFired", "parameters": [{"name": "timestamp", "type": "number"}]}
+		], "types": [
+			{"id": "FrameId", "type": "string"}
+		]}
+	]}`)
+
+	r := Compare(want, got)
+	wantIssues := []Issue{
+		{Kind: TypeMismatch, Domain: "Page", Name: "navigate", Field: "url", Want: "string", Got: "integer"},
+		{Kind: MissingParameter, Domain: "Page", Name: "navigate", Field: "referrer", Experimental: true},
+		{Kind: MissingCommand, Domain: "Page", Name: "reload"},
+		{Kind: MissingDomain, Domain: "Storage", Experimental: true},
+	}
+	if !reflect.DeepEqual(r.Issues, wantIssues) {
+		t.Errorf("Issues:\ngot  %v\nwant %v", r.Issues, wantIssues)
+	}
+	if len(r.Stable()) != 2 {
+		t.Errorf("Stable: got %v, want 2 issues", r.Stable())
+	}
+
+	for _, tt := range []struct {
+		method string
+		want   bool
+	}{
+		{"Page.navigate", true},
+		{"Page.reload", false},
+		{"Page.loadEventFired", true},
+		{"Storage", false},
+		{"Storage.getUsageAndQuota", false},
+	} {
+		if got := r.Supported(tt.method); got != tt.want {
+			t.Errorf("Supported(%q): got %v, want %v", tt.method, got, tt.want)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/proto"
 )
 
 // DevToolsOption represents a function that sets a DevTools option.
@@ -68,6 +69,9 @@ type Target struct {
 	Type                 Type   `json:"type"`
 	URL                  string `json:"url"`
 	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+
+	// Present in Node.js.
+	DevToolsFrontendURLCompat string `json:"devtoolsFrontendUrlCompat,omitempty"`
 }
 
 // Create a new Target, usually a page with about:blank as URL.
@@ -228,6 +232,34 @@ func (d *DevTools) Version(ctx context.Context) (*Version, error) {
 	return v, json.NewDecoder(resp.Body).Decode(&v)
 }
 
+// Protocol returns the protocol definition (schema) served by the
+// DevTools endpoint, it can be used to check if a command or event is
+// supported by the browser:
+//
+//	p, err := devt.Protocol(ctx)
+//	// ...
+//	if p.HasCommand("Page.captureScreenshot") {
+//		// ...
+//	}
+func (d *DevTools) Protocol(ctx context.Context) (*proto.Protocol, error) {
+	resp, err := d.httpPut(ctx, "/json/protocol")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if ok, err := d.handleNodeUnsupportedMethod(ctx, resp, "Protocol"); ok {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseError("Protocol", resp.Body)
+	}
+
+	p := new(proto.Protocol)
+	return p, json.NewDecoder(resp.Body).Decode(p)
+}
+
 func (d *DevTools) httpPut(ctx context.Context, path string) (*http.Response, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -325,6 +357,9 @@ func (d *DevTools) resolveHost(ctx context.Context) error {
 		}
 	}
 	if newURL == "" {
+		// Try again on the next request, the endpoint may not
+		// be ready yet (see WaitReady).
+		d.lookup = false
 		return fmt.Errorf("could not find a working endpoint for %q, tried: %v", origHost, tried)
 	}
 	d.url = newURL
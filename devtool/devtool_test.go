@@ -143,6 +143,44 @@ func TestDevTools(t *testing.T) {
 	}
 }
 
+func TestDevTools_Protocol(t *testing.T) {
+	th := newTestHandler(t)
+	srv := httptest.NewServer(th)
+	defer srv.Close()
+
+	devt := New(srv.URL)
+	th.hostnameLookup = true
+	th.status = http.StatusOK
+	th.body = read(t, filepath.Join("testdata", "protocol.json"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p, err := devt.Protocol(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Version.Major != "1" || p.Version.Minor != "3" {
+		t.Errorf("got version %v, want 1.3", p.Version)
+	}
+	for _, tt := range []struct {
+		method string
+		ok     bool
+	}{
+		{"Page.navigate", true},
+		{"Page.reload", false},
+		{"Network.enable", false},
+		{"navigate", false},
+	} {
+		if ok := p.HasCommand(tt.method); ok != tt.ok {
+			t.Errorf("HasCommand(%q): got %v, want %v", tt.method, ok, tt.ok)
+		}
+	}
+	if !p.HasEvent("Page.loadEventFired") || p.HasEvent("Page.navigate") {
+		t.Error("HasEvent: Page.loadEventFired should be the only event")
+	}
+}
+
 func TestDevTools_Error(t *testing.T) {
 	th := newTestHandler(t)
 	srv := httptest.NewServer(th)
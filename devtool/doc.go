@@ -13,6 +13,23 @@ Create a new DevTools instance that interacts with the given URL:
 
 	devt := devtool.New("http://127.0.0.1:9222")
 
+For Node.js, the URL can be derived from the --inspect flag or from the
+"Debugger listening on ws://..." line printed by the process:
+
+	u, err := devtool.NodeInspectURL("--inspect=0.0.0.0:9230")
+	// ...
+	devt := devtool.New(u)
+	node, err := devt.Get(ctx, devtool.Node)
+
+Wait for a newly started browser to be ready:
+
+	v, err := devt.WaitReady(ctx)
+
+Or, for a browser started with --remote-debugging-port=0, discover the
+endpoint via the DevToolsActivePort file in its user data directory:
+
+	devt, v, err := devtool.WaitActivePort(ctx, userDataDir)
+
 Get the active page or create a new one:
 
 	devt := devtool.New("http://127.0.0.1:9222")
@@ -36,5 +53,24 @@ Set request timeouts via contexts:
 		// Handle error.
 	}
 	// ...
+
+Watch the targets of the endpoint, changes are received via
+Target.setDiscoverTargets on the browser websocket or by polling the
+"/json/list" endpoint (e.g. for Node.js):
+
+	w, err := devt.Watch(ctx, devtool.WithWatchTypes(devtool.Page))
+	if err != nil {
+		// Handle error.
+	}
+	defer w.Close()
+
+	for c := range w.Changes() {
+		switch c.Type {
+		case devtool.TargetCreated:
+			// ...
+		case devtool.TargetDestroyed:
+			// ...
+		}
+	}
 */
 package devtool
@@ -0,0 +1,96 @@
+package devtool
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mafredri/cdp/internal/errors"
+)
+
+// NodeInspectPort is the default port of the Node.js inspector.
+const NodeInspectPort = 9229
+
+// nodeListening is the prefix of the line printed by Node.js (on
+// stderr) when the inspector is activated.
+const nodeListening = "Debugger listening on "
+
+// NodeInspectURL returns the URL of the DevTools endpoint (for New) of
+// a Node.js process started with --inspect. The value of the flag is
+// given as [host:]port, host or port. An empty value (or the flag
+// itself) results in the Node.js defaults, 127.0.0.1:9229.
+//
+//	u, err := devtool.NodeInspectURL("--inspect-brk=0.0.0.0:9230")
+//	// u == "http://127.0.0.1:9230"
+//
+// Unspecified hosts (e.g. 0.0.0.0) are replaced by the loopback address.
+func NodeInspectURL(inspect string) (string, error) {
+	v := inspect
+	if strings.HasPrefix(v, "--inspect") {
+		i := strings.IndexByte(v, '=')
+		if i == -1 {
+			v = ""
+		} else {
+			v = v[i+1:]
+		}
+	}
+
+	host, port := "127.0.0.1", strconv.Itoa(NodeInspectPort)
+	switch {
+	case v == "":
+	case isPort(v):
+		port = v
+	case strings.HasSuffix(v, "]") || !strings.Contains(v, ":"):
+		host = strings.Trim(v, "[]")
+	default:
+		h, p, err := net.SplitHostPort(v)
+		if err != nil || !isPort(p) {
+			return "", errors.New("devtool: NodeInspectURL: invalid host and port: " + strconv.Quote(inspect))
+		}
+		host, port = h, p
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+		if ip.To4() == nil {
+			host = "::1"
+		}
+	}
+
+	return "http://" + net.JoinHostPort(host, port), nil
+}
+
+// ParseNodeListening parses the websocket URL from the line printed by
+// Node.js when the inspector is activated, e.g.:
+//
+//	Debugger listening on ws://127.0.0.1:9229/4b9a6f3c-6b5e-4b4e-9c34-1f7d2e1b8a1d
+//
+// The websocket URL can be used with rpcc.Dial directly. The second
+// return value is false if the line does not contain the URL.
+func ParseNodeListening(line string) (wsURL string, ok bool) {
+	i := strings.Index(line, nodeListening)
+	if i == -1 {
+		return "", false
+	}
+	wsURL = strings.TrimSpace(line[i+len(nodeListening):])
+	u, err := url.Parse(wsURL)
+	if err != nil || u.Scheme != "ws" || u.Host == "" {
+		return "", false
+	}
+	return wsURL, true
+}
+
+// NodeURL returns the URL of the DevTools endpoint (for New) given the
+// websocket URL of a Node.js process, see ParseNodeListening.
+func NodeURL(wsURL string) (string, error) {
+	u, err := url.Parse(wsURL)
+	if err != nil || u.Scheme != "ws" || u.Host == "" {
+		return "", errors.New("devtool: NodeURL: invalid websocket URL: " + strconv.Quote(wsURL))
+	}
+	return "http://" + u.Host, nil
+}
+
+func isPort(s string) bool {
+	p, err := strconv.Atoi(s)
+	return err == nil && p >= 0 && p <= 65535
+}
@@ -0,0 +1,113 @@
+package devtool
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNodeInspectURL(t *testing.T) {
+	tests := []struct {
+		inspect string
+		want    string
+		wantErr bool
+	}{
+		{"", "http://127.0.0.1:9229", false},
+		{"--inspect", "http://127.0.0.1:9229", false},
+		{"--inspect-brk=9230", "http://127.0.0.1:9230", false},
+		{"9230", "http://127.0.0.1:9230", false},
+		{"localhost", "http://localhost:9229", false},
+		{"localhost:9230", "http://localhost:9230", false},
+		{"0.0.0.0:9230", "http://127.0.0.1:9230", false},
+		{"[::]:9230", "http://[::1]:9230", false},
+		{"[::1]", "http://[::1]:9229", false},
+		{"--inspect=192.168.0.10:9231", "http://192.168.0.10:9231", false},
+		{"localhost:port", "", true},
+		{"localhost:70000", "", true},
+	}
+	for _, tt := range tests {
+		got, err := NodeInspectURL(tt.inspect)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NodeInspectURL(%q): got err %v, want err %v", tt.inspect, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NodeInspectURL(%q): got %q, want %q", tt.inspect, got, tt.want)
+		}
+	}
+}
+
+func TestParseNodeListening(t *testing.T) {
+	ws := "ws://127.0.0.1:9229/4b9a6f3c-6b5e-4b4e-9c34-1f7d2e1b8a1d"
+	tests := []struct {
+		line   string
+		want   string
+		wantOK bool
+	}{
+		{"Debugger listening on " + ws, ws, true},
+		{"Debugger listening on " + ws + "\r\n", ws, true},
+		{"For help, see: https://nodejs.org/en/docs/inspector", "", false},
+		{"Debugger listening on http://127.0.0.1:9229", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseNodeListening(tt.line)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ParseNodeListening(%q): got %q, %v; want %q, %v", tt.line, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	u, err := NodeURL(ws)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "http://127.0.0.1:9229"; u != want {
+		t.Errorf("NodeURL: got %q, want %q", u, want)
+	}
+	if _, err = NodeURL("127.0.0.1:9229"); err == nil {
+		t.Error("NodeURL: want error for invalid websocket URL")
+	}
+}
+
+func TestDevTools_Node(t *testing.T) {
+	list := read(t, filepath.Join("testdata", "node_list.json"))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Node.js only supports GET requests.
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/json/version":
+			w.Write([]byte(`{"Browser": "node.js/v20.11.0", "Protocol-Version": "1.1"}`))
+		case "/json/list", "/json":
+			w.Write(list)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Unknown method"))
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	devt := New(srv.URL)
+	target, err := devt.Get(ctx, Node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "ws://127.0.0.1:9229/4b9a6f3c-6b5e-4b4e-9c34-1f7d2e1b8a1d"; target.WebSocketDebuggerURL != want {
+		t.Errorf("Get: WebSocketDebuggerURL: got %q, want %q", target.WebSocketDebuggerURL, want)
+	}
+	if !strings.HasPrefix(target.DevToolsFrontendURLCompat, "devtools://devtools/bundled/inspector.html") {
+		t.Errorf("Get: DevToolsFrontendURLCompat: got %q", target.DevToolsFrontendURLCompat)
+	}
+
+	_, err = devt.Create(ctx)
+	if err == nil || !strings.Contains(err.Error(), "not supported by Node.js") {
+		t.Errorf("Create: got %v, want not supported by Node.js error", err)
+	}
+}
@@ -0,0 +1,142 @@
+package devtool
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mafredri/cdp/internal/errors"
+)
+
+// Backoff used when waiting for the DevTools endpoint.
+const (
+	minWaitDelay = 10 * time.Millisecond
+	maxWaitDelay = 500 * time.Millisecond
+)
+
+// ActivePortFile is the name of the file written to the user data
+// directory by Chrome when started with --remote-debugging-port=0.
+const ActivePortFile = "DevToolsActivePort"
+
+// ActivePort represents the contents of a DevToolsActivePort file.
+type ActivePort struct {
+	Port int    // Port of the DevTools endpoint.
+	Path string // Path of the browser websocket, e.g. "/devtools/browser/<id>".
+}
+
+// URL returns the URL of the DevTools endpoint (for New).
+func (p *ActivePort) URL() string {
+	return "http://127.0.0.1:" + strconv.Itoa(p.Port)
+}
+
+// WebSocketURL returns the URL of the browser websocket.
+func (p *ActivePort) WebSocketURL() string {
+	return "ws://127.0.0.1:" + strconv.Itoa(p.Port) + p.Path
+}
+
+// ReadActivePort reads the DevToolsActivePort file in userDataDir.
+func ReadActivePort(userDataDir string) (*ActivePort, error) {
+	b, err := os.ReadFile(filepath.Join(userDataDir, ActivePortFile))
+	if err != nil {
+		return nil, err
+	}
+	return parseActivePort(b)
+}
+
+func parseActivePort(b []byte) (*ActivePort, error) {
+	s := bufio.NewScanner(bytes.NewReader(b))
+	var lines []string
+	for s.Scan() {
+		lines = append(lines, strings.TrimSpace(s.Text()))
+	}
+	if len(lines) < 2 {
+		return nil, errors.New("devtool: " + ActivePortFile + ": want port and path, got: " + strconv.Quote(string(b)))
+	}
+	port, err := strconv.Atoi(lines[0])
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, errors.New("devtool: " + ActivePortFile + ": invalid port: " + strconv.Quote(lines[0]))
+	}
+	if !strings.HasPrefix(lines[1], "/devtools/browser/") {
+		return nil, errors.New("devtool: " + ActivePortFile + ": invalid path: " + strconv.Quote(lines[1]))
+	}
+	return &ActivePort{Port: port, Path: lines[1]}, nil
+}
+
+// WaitReady waits until the DevTools endpoint responds, e.g. right
+// after starting the browser. Version is retried with backoff until it
+// succeeds or ctx is done, in which case the last error is returned.
+func (d *DevTools) WaitReady(ctx context.Context) (*Version, error) {
+	var v *Version
+	err := retry(ctx, func() (err error) {
+		v, err = d.Version(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "devtool: WaitReady")
+	}
+	return v, nil
+}
+
+// WaitActivePort waits for the browser using userDataDir to write its
+// DevToolsActivePort file and for the DevTools endpoint to respond.
+// The endpoint must report the browser websocket path from the file,
+// a stale file (e.g. from a previous browser) is retried until it is
+// replaced or ctx is done.
+func WaitActivePort(ctx context.Context, userDataDir string, opts ...DevToolsOption) (*DevTools, *Version, error) {
+	var (
+		d *DevTools
+		v *Version
+	)
+	err := retry(ctx, func() error {
+		p, err := ReadActivePort(userDataDir)
+		if err != nil {
+			return err
+		}
+		d = New(p.URL(), opts...)
+		v, err = d.Version(ctx)
+		if err != nil {
+			return err
+		}
+
+		u, err := url.Parse(v.WebSocketDebuggerURL)
+		if err != nil {
+			return err
+		}
+		if u.Path != p.Path {
+			return errors.New("devtool: browser websocket " + v.WebSocketDebuggerURL + " does not match " + ActivePortFile + " path " + p.Path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "devtool: WaitActivePort")
+	}
+	return d, v, nil
+}
+
+// retry calls fn with backoff until it succeeds or ctx is done.
+func retry(ctx context.Context, fn func() error) error {
+	delay := minWaitDelay
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return errors.Merge(err, ctx.Err())
+		case <-t.C:
+		}
+		if delay *= 2; delay > maxWaitDelay {
+			delay = maxWaitDelay
+		}
+	}
+}
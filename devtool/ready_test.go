@@ -0,0 +1,119 @@
+package devtool
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseActivePort(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    ActivePort
+		wantErr bool
+	}{
+		{"OK", "9222\n/devtools/browser/74ffefaa\n", ActivePort{9222, "/devtools/browser/74ffefaa"}, false},
+		{"Windows newline", "9222\r\n/devtools/browser/74ffefaa\r\n", ActivePort{9222, "/devtools/browser/74ffefaa"}, false},
+		{"Empty", "", ActivePort{}, true},
+		{"Only port", "9222\n", ActivePort{}, true},
+		{"Invalid port", "0\n/devtools/browser/74ffefaa\n", ActivePort{}, true},
+		{"Invalid path", "9222\n/json\n", ActivePort{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseActivePort([]byte(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("got %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+// readyHandler responds with version after failing the first requests.
+func readyHandler(fail int32, version *Version) http.Handler {
+	var n int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&n, 1) <= fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(version)
+	})
+}
+
+func TestDevTools_WaitReady(t *testing.T) {
+	srv := httptest.NewServer(readyHandler(3, &Version{Browser: "Chrome/120.0.0.0"}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	v, err := New(srv.URL).WaitReady(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Browser != "Chrome/120.0.0.0" {
+		t.Errorf("got browser %q, want Chrome/120.0.0.0", v.Browser)
+	}
+
+	srv.Close()
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err = New(srv.URL).WaitReady(ctx); err == nil {
+		t.Error("WaitReady: want error for closed endpoint, got nil")
+	}
+}
+
+func TestWaitActivePort(t *testing.T) {
+	const path = "/devtools/browser/74ffefaa"
+
+	version := &Version{Browser: "Chrome/120.0.0.0"}
+	srv := httptest.NewServer(readyHandler(0, version))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version.WebSocketDebuggerURL = "ws://" + u.Host + path
+
+	dir := t.TempDir()
+	write := func(path string) {
+		err := os.WriteFile(filepath.Join(dir, ActivePortFile), []byte(u.Port()+"\n"+path+"\n"), 0o644)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	// A stale file does not match the running browser.
+	write("/devtools/browser/stale")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, _, err = WaitActivePort(ctx, dir); err == nil {
+		t.Error("WaitActivePort: want error for stale file, got nil")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	time.AfterFunc(20*time.Millisecond, func() { write(path) })
+	devt, v, err := WaitActivePort(ctx, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.WebSocketDebuggerURL != version.WebSocketDebuggerURL {
+		t.Errorf("got websocket URL %q, want %q", v.WebSocketDebuggerURL, version.WebSocketDebuggerURL)
+	}
+	if devt.url != "http://127.0.0.1:"+u.Port() {
+		t.Errorf("got DevTools URL %q", devt.url)
+	}
+}
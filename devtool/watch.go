@@ -0,0 +1,434 @@
+package devtool
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// WatchOption represents an option passed to Watch.
+type WatchOption func(*watchOptions)
+
+type watchOptions struct {
+	types        []Type
+	matchURL     func(string) bool
+	pollInterval time.Duration
+}
+
+// WithWatchTypes returns a WatchOption that only watches targets of
+// the provided types.
+func WithWatchTypes(types ...Type) WatchOption {
+	return func(o *watchOptions) {
+		o.types = types
+	}
+}
+
+// WithWatchURL returns a WatchOption that only watches targets with an
+// URL for which match returns true. A target whose URL stops matching
+// is reported as destroyed.
+func WithWatchURL(match func(url string) bool) WatchOption {
+	return func(o *watchOptions) {
+		o.matchURL = match
+	}
+}
+
+// WithPollInterval returns a WatchOption that sets the interval for
+// polling "/json/list" when the endpoint has no browser websocket (e.g.
+// Node.js). The default is 1 second.
+func WithPollInterval(d time.Duration) WatchOption {
+	return func(o *watchOptions) {
+		o.pollInterval = d
+	}
+}
+
+// ChangeType is the type of a target Change.
+type ChangeType int
+
+// ChangeType enums.
+const (
+	TargetCreated ChangeType = iota + 1
+	TargetChanged
+	TargetDestroyed
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case TargetCreated:
+		return "TargetCreated"
+	case TargetChanged:
+		return "TargetChanged"
+	case TargetDestroyed:
+		return "TargetDestroyed"
+	default:
+		return "ChangeType(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// Change represents a change to a watched target. For TargetDestroyed,
+// Target is the last known state of the target.
+type Change struct {
+	Type   ChangeType
+	Target *Target
+}
+
+// Watcher keeps track of the targets of a DevTools endpoint.
+type Watcher struct {
+	d      *DevTools
+	opts   watchOptions
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	closed chan struct{} // Closed by Close.
+	once   sync.Once
+	conn   *rpcc.Conn // Nil when polling.
+	wsURL  *url.URL   // Browser websocket, used for target URLs.
+
+	in  chan Change
+	out chan Change
+
+	mu      sync.Mutex // Protects following.
+	targets map[string]*Target
+	err     error
+}
+
+// Watch returns a Watcher that keeps a live map of targets. When the
+// endpoint has a browser websocket (see Version), Target.setDiscoverTargets
+// is used to receive changes as they happen, otherwise "/json/list" is
+// polled.
+//
+// The initial targets are available via Targets when Watch returns and
+// are also sent as TargetCreated changes.
+//
+// Targets from the browser websocket have no Description or
+// DevToolsFrontendURL, and WebSocketDebuggerURL is only set for page-like
+// targets (page, background_page, iframe and webview), like in
+// "/json/list".
+func (d *DevTools) Watch(ctx context.Context, opts ...WatchOption) (*Watcher, error) {
+	w := &Watcher{
+		d: d,
+		opts: watchOptions{
+			pollInterval: time.Second,
+		},
+		done:    make(chan struct{}),
+		closed:  make(chan struct{}),
+		in:      make(chan Change),
+		out:     make(chan Change),
+		targets: make(map[string]*Target),
+	}
+	for _, o := range opts {
+		o(&w.opts)
+	}
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+	go w.queue()
+
+	v, err := d.Version(ctx)
+	if err != nil {
+		w.stop(err)
+		w.Close()
+		return nil, err
+	}
+
+	var run func() error
+	if v.WebSocketDebuggerURL != "" {
+		run, err = w.startBrowser(ctx, v.WebSocketDebuggerURL)
+	} else {
+		run, err = w.startPolling(ctx)
+	}
+	if err != nil {
+		w.stop(err)
+		w.Close()
+		return nil, err
+	}
+
+	go func() {
+		w.stop(run())
+	}()
+	return w, nil
+}
+
+// Targets returns the current targets, ordered by ID.
+func (w *Watcher) Targets() []*Target {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	list := make([]*Target, 0, len(w.targets))
+	for _, t := range w.targets {
+		tt := *t
+		list = append(list, &tt)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// Changes returns a channel that receives target changes. Changes are
+// queued, a slow reader does not block the Watcher. The channel is
+// closed when the Watcher stops, see Err.
+func (w *Watcher) Changes() <-chan Change {
+	return w.out
+}
+
+// Done is closed when the Watcher stops.
+func (w *Watcher) Done() <-chan struct{} {
+	return w.done
+}
+
+// Err returns the reason the Watcher stopped, e.g. the browser
+// connection was lost. Err returns nil while the Watcher is running
+// and after Close.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Close stops the Watcher, pending changes are discarded.
+func (w *Watcher) Close() error {
+	w.once.Do(func() { close(w.closed) })
+	w.cancel()
+	<-w.done
+	return nil
+}
+
+// stop records err as the reason the Watcher stopped.
+func (w *Watcher) stop(err error) {
+	w.mu.Lock()
+	if w.ctx.Err() == nil {
+		w.err = err
+	}
+	w.mu.Unlock()
+
+	w.cancel()
+	if w.conn != nil {
+		w.conn.Close()
+	}
+	close(w.in)
+	close(w.done)
+}
+
+// queue forwards changes from in to out until in is closed and all
+// changes have been delivered, or until Close.
+func (w *Watcher) queue() {
+	defer close(w.out)
+
+	in := w.in
+	var queue []Change
+	for in != nil || len(queue) > 0 {
+		var out chan Change
+		var next Change
+		if len(queue) > 0 {
+			out = w.out
+			next = queue[0]
+		}
+		select {
+		case c, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+			queue = append(queue, c)
+		case out <- next:
+			queue[0] = Change{}
+			queue = queue[1:]
+		case <-w.closed:
+			return
+		}
+	}
+}
+
+func (w *Watcher) match(t *Target) bool {
+	if len(w.opts.types) > 0 {
+		ok := false
+		for _, typ := range w.opts.types {
+			if t.Type == typ {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return w.opts.matchURL == nil || w.opts.matchURL(t.URL)
+}
+
+// update sets the target with id to t, or removes it when t is nil,
+// and sends the resulting change, if any.
+func (w *Watcher) update(id string, t *Target) {
+	if t != nil && !w.match(t) {
+		t = nil
+	}
+
+	w.mu.Lock()
+	old := w.targets[id]
+	var c Change
+	switch {
+	case old == nil && t == nil:
+	case old == nil:
+		w.targets[id] = t
+		c = Change{Type: TargetCreated, Target: t}
+	case t == nil:
+		delete(w.targets, id)
+		c = Change{Type: TargetDestroyed, Target: old}
+	case *old != *t:
+		w.targets[id] = t
+		c = Change{Type: TargetChanged, Target: t}
+	}
+	w.mu.Unlock()
+
+	if c.Type != 0 {
+		tt := *c.Target
+		c.Target = &tt
+		select {
+		case w.in <- c:
+		case <-w.closed:
+		}
+	}
+}
+
+// startBrowser subscribes to target events on the browser websocket.
+func (w *Watcher) startBrowser(ctx context.Context, wsURL string) (run func() error, err error) {
+	w.wsURL, err = url.Parse(wsURL)
+	if err != nil {
+		return nil, err
+	}
+	w.conn, err = rpcc.DialContext(ctx, wsURL)
+	if err != nil {
+		return nil, err
+	}
+	c := cdp.NewClient(w.conn)
+
+	// Streams are closed with the connection.
+	created, err := c.Target.TargetCreated(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	changed, err := c.Target.TargetInfoChanged(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	destroyed, err := c.Target.TargetDestroyed(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = cdp.Sync(created, changed, destroyed); err != nil {
+		return nil, err
+	}
+
+	err = c.Target.SetDiscoverTargets(ctx, target.NewSetDiscoverTargetsArgs(true))
+	if err != nil {
+		return nil, errors.Wrapf(err, "devtool: Watch: set discover targets failed")
+	}
+	// The initial targets are also sent as targetCreated events,
+	// they will be reported as unchanged.
+	list, err := c.Target.GetTargets(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "devtool: Watch: get targets failed")
+	}
+	for _, info := range list.TargetInfos {
+		w.update(string(info.TargetID), w.fromInfo(info))
+	}
+
+	return func() error {
+		for {
+			select {
+			case <-w.ctx.Done():
+				return nil
+			case <-created.Ready():
+				ev, err := created.Recv()
+				if err != nil {
+					return err
+				}
+				w.update(string(ev.TargetInfo.TargetID), w.fromInfo(ev.TargetInfo))
+			case <-changed.Ready():
+				ev, err := changed.Recv()
+				if err != nil {
+					return err
+				}
+				w.update(string(ev.TargetInfo.TargetID), w.fromInfo(ev.TargetInfo))
+			case <-destroyed.Ready():
+				ev, err := destroyed.Recv()
+				if err != nil {
+					return err
+				}
+				w.update(string(ev.TargetID), nil)
+			}
+		}
+	}, nil
+}
+
+// fromInfo converts target info to a Target, the websocket URL of
+// page-like targets is derived from the browser websocket URL.
+func (w *Watcher) fromInfo(info target.Info) *Target {
+	t := &Target{
+		ID:    string(info.TargetID),
+		Title: info.Title,
+		Type:  Type(info.Type),
+		URL:   info.URL,
+	}
+	switch t.Type {
+	case Page, BackgroundPage, "iframe", "webview":
+		u := *w.wsURL
+		u.Path = "/devtools/page/" + t.ID
+		t.WebSocketDebuggerURL = u.String()
+	}
+	return t
+}
+
+// startPolling polls "/json/list" for endpoints without a browser
+// websocket.
+func (w *Watcher) startPolling(ctx context.Context) (run func() error, err error) {
+	if err = w.poll(ctx); err != nil {
+		return nil, err
+	}
+	return func() error {
+		t := time.NewTicker(w.opts.pollInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-w.ctx.Done():
+				return nil
+			case <-t.C:
+				if err := w.poll(w.ctx); err != nil {
+					if w.ctx.Err() != nil {
+						return nil
+					}
+					return err
+				}
+			}
+		}
+	}, nil
+}
+
+func (w *Watcher) poll(ctx context.Context) error {
+	list, err := w.d.List(ctx)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(list))
+	for _, t := range list {
+		seen[t.ID] = true
+		w.update(t.ID, t)
+	}
+
+	w.mu.Lock()
+	var gone []string
+	for id := range w.targets {
+		if !seen[id] {
+			gone = append(gone, id)
+		}
+	}
+	w.mu.Unlock()
+	sort.Strings(gone)
+	for _, id := range gone {
+		w.update(id, nil)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package devtool
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// watchEndpoint is a fake DevTools endpoint, the browser websocket is
+// only available when browser is true.
+type watchEndpoint struct {
+	t       *testing.T
+	browser bool
+	srv     *httptest.Server
+	events  chan string // Raw notifications sent on the browser websocket.
+
+	mu     sync.Mutex
+	list   []*Target
+	wsConn *websocket.Conn
+}
+
+func newWatchEndpoint(t *testing.T, browser bool, list ...*Target) *watchEndpoint {
+	e := &watchEndpoint{t: t, browser: browser, list: list, events: make(chan string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", e.version)
+	mux.HandleFunc("/json/list", e.targets)
+	mux.HandleFunc("/devtools/browser", e.websocket)
+	e.srv = httptest.NewServer(mux)
+	return e
+}
+
+func (e *watchEndpoint) setList(list ...*Target) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = list
+}
+
+func (e *watchEndpoint) version(w http.ResponseWriter, r *http.Request) {
+	v := Version{Browser: "node.js/v20.0.0"}
+	if e.browser {
+		v.Browser = "Chrome/120.0.0.0"
+		v.WebSocketDebuggerURL = "ws" + strings.TrimPrefix(e.srv.URL, "http") + "/devtools/browser"
+	}
+	json.NewEncoder(w).Encode(v)
+}
+
+func (e *watchEndpoint) targets(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	json.NewEncoder(w).Encode(e.list)
+}
+
+func (e *watchEndpoint) websocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		e.t.Error(err)
+		return
+	}
+	defer conn.Close()
+	e.mu.Lock()
+	e.wsConn = conn
+	e.mu.Unlock()
+
+	var mu sync.Mutex // Protects conn writes.
+	go func() {
+		for ev := range e.events {
+			mu.Lock()
+			conn.WriteMessage(websocket.TextMessage, []byte(ev))
+			mu.Unlock()
+		}
+	}()
+
+	for {
+		var req struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+		result := `{}`
+		if req.Method == "Target.getTargets" {
+			e.mu.Lock()
+			var infos []string
+			for _, t := range e.list {
+				infos = append(infos, `{"targetId":"`+t.ID+`","type":"`+string(t.Type)+`","title":"`+t.Title+`","url":"`+t.URL+`","attached":false,"canAccessOpener":false}`)
+			}
+			e.mu.Unlock()
+			result = `{"targetInfos":[` + strings.Join(infos, ",") + `]}`
+		}
+		mu.Lock()
+		conn.WriteJSON(map[string]interface{}{"id": req.ID, "result": json.RawMessage(result)})
+		mu.Unlock()
+	}
+}
+
+func (e *watchEndpoint) Close() {
+	close(e.events)
+	e.mu.Lock()
+	if e.wsConn != nil {
+		e.wsConn.Close() // Hijacked, not closed by srv.
+	}
+	e.mu.Unlock()
+	e.srv.Close()
+}
+
+func nextChange(t *testing.T, w *Watcher) Change {
+	t.Helper()
+	select {
+	case c := <-w.Changes():
+		return c
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for change")
+		return Change{}
+	}
+}
+
+func TestWatch_Polling(t *testing.T) {
+	page1 := &Target{ID: "1", Type: Page, URL: "about:blank"}
+	worker := &Target{ID: "2", Type: ServiceWorker, URL: "https://example.com/sw.js"}
+	e := newWatchEndpoint(t, false, page1, worker)
+	defer e.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	w, err := New(e.srv.URL).Watch(ctx,
+		WithWatchTypes(Page),
+		WithPollInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	if got := w.Targets(); len(got) != 1 || *got[0] != *page1 {
+		t.Errorf("Targets: got %v, want [%v]", got, page1)
+	}
+	if c := nextChange(t, w); c.Type != TargetCreated || c.Target.ID != "1" {
+		t.Errorf("got change %v %v, want TargetCreated 1", c.Type, c.Target)
+	}
+
+	page1Nav := &Target{ID: "1", Type: Page, URL: "https://example.com"}
+	page3 := &Target{ID: "3", Type: Page, URL: "about:blank"}
+	e.setList(page1Nav, worker, page3)
+	for _, want := range []Change{
+		{TargetChanged, page1Nav},
+		{TargetCreated, page3},
+	} {
+		if c := nextChange(t, w); c.Type != want.Type || *c.Target != *want.Target {
+			t.Errorf("got change %v %v, want %v %v", c.Type, c.Target, want.Type, want.Target)
+		}
+	}
+
+	e.setList(worker)
+	for _, want := range []Change{
+		{TargetDestroyed, page1Nav},
+		{TargetDestroyed, page3},
+	} {
+		if c := nextChange(t, w); c.Type != want.Type || *c.Target != *want.Target {
+			t.Errorf("got change %v %v, want %v %v", c.Type, c.Target, want.Type, want.Target)
+		}
+	}
+
+	w.Close()
+	if _, ok := <-w.Changes(); ok {
+		t.Error("Changes: channel was not closed")
+	}
+	if err := w.Err(); err != nil {
+		t.Errorf("Err: got %v, want nil after Close", err)
+	}
+}
+
+func TestWatch_Browser(t *testing.T) {
+	e := newWatchEndpoint(t, true, &Target{ID: "1", Type: Page, URL: "about:blank"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	w, err := New(e.srv.URL).Watch(ctx,
+		WithWatchURL(func(url string) bool { return strings.HasPrefix(url, "about:") }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	got := w.Targets()
+	if len(got) != 1 || got[0].ID != "1" || !strings.HasSuffix(got[0].WebSocketDebuggerURL, "/devtools/page/1") {
+		t.Errorf("Targets: got %v, want page 1", got)
+	}
+	nextChange(t, w) // Initial target.
+
+	// The initial targets are also sent as targetCreated.
+	e.events <- `{"method":"Target.targetCreated","params":{"targetInfo":{"targetId":"1","type":"page","title":"","url":"about:blank"}}}`
+	e.events <- `{"method":"Target.targetCreated","params":{"targetInfo":{"targetId":"2","type":"page","title":"","url":"about:blank"}}}`
+	if c := nextChange(t, w); c.Type != TargetCreated || c.Target.ID != "2" {
+		t.Errorf("got change %v %v, want TargetCreated 2", c.Type, c.Target)
+	}
+
+	// Only page-like targets have a websocket URL.
+	e.events <- `{"method":"Target.targetCreated","params":{"targetInfo":{"targetId":"3","type":"service_worker","title":"","url":"about:blank"}}}`
+	if c := nextChange(t, w); c.Type != TargetCreated || c.Target.ID != "3" || c.Target.WebSocketDebuggerURL != "" {
+		t.Errorf("got change %v %v, want TargetCreated 3 without websocket URL", c.Type, c.Target)
+	}
+	e.events <- `{"method":"Target.targetDestroyed","params":{"targetId":"3"}}`
+	if c := nextChange(t, w); c.Type != TargetDestroyed || c.Target.ID != "3" {
+		t.Errorf("got change %v %v, want TargetDestroyed 3", c.Type, c.Target)
+	}
+
+	// No longer matches the URL filter.
+	e.events <- `{"method":"Target.targetInfoChanged","params":{"targetInfo":{"targetId":"2","type":"page","title":"","url":"https://example.com"}}}`
+	if c := nextChange(t, w); c.Type != TargetDestroyed || c.Target.ID != "2" {
+		t.Errorf("got change %v %v, want TargetDestroyed 2", c.Type, c.Target)
+	}
+
+	e.events <- `{"method":"Target.targetDestroyed","params":{"targetId":"1"}}`
+	if c := nextChange(t, w); c.Type != TargetDestroyed || c.Target.ID != "1" {
+		t.Errorf("got change %v %v, want TargetDestroyed 1", c.Type, c.Target)
+	}
+	if got := w.Targets(); len(got) != 0 {
+		t.Errorf("Targets: got %v, want none", got)
+	}
+
+	// Losing the browser connection stops the Watcher.
+	e.Close()
+	<-w.Done()
+	if err := w.Err(); err == nil {
+		t.Error("Err: got nil, want error")
+	}
+}
@@ -41,6 +41,12 @@ Methods can be invoked from the Client:
 	}
 	// ...
 
+Optional arguments are set with the chained setters or, alternatively,
+with functional options (see package opt for optional values):
+
+	args := page.NewNavigateArgs(url).SetReferrer(ref)
+	args = page.NewNavigateArgsWith(url, page.NavigateWithReferrer(ref))
+
 # Domain events
 
 Event clients are used to handle events sent over the protocol. A client
@@ -138,12 +144,105 @@ single websocket connection.
 
 	newPageClient := cdp.NewClient(newPageConn)
 	// ...
+
+# Node.js
+
+The NodeClient supports the domains of the Node.js inspector protocol
+(e.g. NodeTracing and NodeWorker), for a process started with --inspect.
+Domains that Node.js only partially supports have Node specific
+interfaces (e.g. NodeNetwork):
+
+	u, err := devtool.NodeInspectURL("--inspect=9229")
+	if err != nil {
+		// Handle error.
+	}
+	pt, err := devtool.New(u).Get(ctx, devtool.Node)
+	if err != nil {
+		// Handle error.
+	}
+	conn, err := rpcc.DialContext(ctx, pt.WebSocketDebuggerURL)
+	if err != nil {
+		// Handle error.
+	}
+	defer conn.Close()
+
+	c := cdp.NewNodeClient(conn)
+	err = c.Profiler.Enable(ctx)
+	// ...
+
+# Stable API
+
+Experimental and deprecated APIs can change or be removed in new browser
+versions. Package stable provides a Client without them, using one
+results in a compile error:
+
+	c := stable.NewClient(conn)
+
+# Validation
+
+The arguments of each command have a generated Validate method that
+checks required arguments, enum values and mutually exclusive options.
+Invalid arguments are otherwise reported by the browser as "Invalid
+parameters". Use rpcc.WithValidation to validate all arguments before
+they are sent:
+
+	conn, err := rpcc.DialContext(ctx, pt.WebSocketDebuggerURL, rpcc.WithValidation())
+	if err != nil {
+		// Handle error.
+	}
+	c := cdp.NewClient(conn)
+
+	err = c.Runtime.AddBinding(ctx, runtime.NewAddBindingArgs("fn").
+		SetExecutionContextID(1).
+		SetExecutionContextName("main"))
+	var argsErr *rpcc.ArgsError
+	if errors.As(err, &argsErr) {
+		// ExecutionContextID and ExecutionContextName are mutually exclusive.
+	}
+
+# JSON encoding
+
+All argument, reply and type structs have generated (reflection-free)
+EncodeJSON and DecodeJSON methods. Build with the cdpjson tag to use them
+for MarshalJSON and UnmarshalJSON, reducing the time spent encoding
+commands and decoding events:
+
+	go build -tags cdpjson
+
+Unlike encoding/json, object keys are matched case-sensitively when
+decoding.
+
+# Protocol versions
+
+The bindings track the latest protocol. Bindings for older protocol
+versions, e.g. for browsers that no longer support commands since
+removed, are generated from historical protocol definitions into
+versions/<name> (see cmd/cdpgen). Each version has its own Client and
+domain packages and shares rpcc, the version is selected when creating
+the Client (package github.com/mafredri/cdp/versions/chrome140):
+
+	v, err := devtool.New(url).Version(ctx)
+	// ...
+	if strings.HasPrefix(v.Browser, "Chrome/140.") {
+		c := chrome140.NewClient(conn)
+		// ...
+	}
+
+# Registry
+
+The registry package describes all methods and events by name, e.g. for
+decoding recorded traffic into the types of the protocol packages:
+
+	m, ok := registry.LookupMethod("Page.navigate")
+	if ok {
+		args, err := m.DecodeArgs(params) // *page.NavigateArgs
+	}
 */
 package cdp
 
 // Generate protcol definition using cdpgen.
 //go:generate go install ./cmd/cdpgen
-//go:generate cdpgen -dest . -browser-proto ./cmd/cdpgen/protodef/browser_protocol.json -js-proto ./cmd/cdpgen/protodef/js_protocol.json
+//go:generate cdpgen -dest . -browser-proto ./cmd/cdpgen/protodef/browser_protocol.json -js-proto ./cmd/cdpgen/protodef/js_protocol.json -node-proto ./cmd/cdpgen/protodef/node.json -extra-proto edge=./cmd/cdpgen/protodef/edge.json -versions ./cmd/cdpgen/protodef/versions -arg-options
 
 // Update code samples in README.
 //go:generate embedmd -w README.md
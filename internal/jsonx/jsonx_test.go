@@ -0,0 +1,258 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testStrings = []string{
+	"",
+	"hello",
+	`quote " and backslash \`,
+	"<script>&amp;</script>",
+	"control \x00\x01\x1f\b\f\n\r\t",
+	"unicode åäö 世界 😀",
+	"separators    ",
+	"invalid \xff utf-8 \xed\xa0\x80",
+	"\x7f del",
+}
+
+func TestWriter_String(t *testing.T) {
+	for _, s := range testStrings {
+		var w Writer
+		w.String(s)
+		want, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := w.Bytes()
+		if !utf8.ValidString(s) {
+			// Invalid UTF-8 is written as \ufffd, newer versions of
+			// encoding/json write the replacement character as is,
+			// compare the decoded values.
+			var ws, gs string
+			if err := json.Unmarshal(want, &ws); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(got, &gs); err != nil {
+				t.Fatal(err)
+			}
+			want, got = []byte(ws), []byte(gs)
+		}
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("String(%q) output differs from encoding/json (-want +got):\n%s", s, diff)
+		}
+	}
+}
+
+func TestWriter_Float(t *testing.T) {
+	for _, f := range []float64{
+		0, 1, -1, 0.1, 1.5e-7, 1e-6, 123456789.123, 1e20, 1e21, -1e21,
+		1.7976931348623157e308, 5e-324, math.Copysign(0, -1),
+	} {
+		var w Writer
+		w.Float(f)
+		want, err := json.Marshal(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(string(want), string(w.Bytes())); diff != "" {
+			t.Errorf("Float(%v) output differs from encoding/json (-want +got):\n%s", f, diff)
+		}
+	}
+
+	var w Writer
+	w.Float(math.NaN())
+	if w.Err() == nil {
+		t.Error("Float(NaN): want error, got nil")
+	}
+}
+
+func TestWriter_Separators(t *testing.T) {
+	var w Writer
+	w.ObjectStart()
+	w.Key("a")
+	w.ArrayStart()
+	w.Int(1)
+	w.Null()
+	w.ObjectStart()
+	w.ObjectEnd()
+	w.ArrayStart()
+	w.ArrayEnd()
+	w.Base64([]byte("cdp"))
+	w.Raw([]byte(`{"b":true}`))
+	w.ArrayEnd()
+	w.Key("c")
+	w.Bool(false)
+	w.ObjectEnd()
+
+	want := `{"a":[1,null,{},[],"Y2Rw",{"b":true}],"c":false}`
+	if diff := cmp.Diff(want, string(w.Bytes())); diff != "" {
+		t.Errorf("output differs (-want +got):\n%s", diff)
+	}
+}
+
+func TestReader_String(t *testing.T) {
+	inputs := []string{
+		`"\/å😀\ud800A\uDFFF"`,
+		`"\"\\\b\f\n\r\t"`,
+		"\"raw \xff invalid\"",
+	}
+	for _, s := range testStrings {
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs = append(inputs, string(b))
+	}
+
+	for _, in := range inputs {
+		var want string
+		if err := json.Unmarshal([]byte(in), &want); err != nil {
+			t.Fatal(err)
+		}
+		r := NewReader([]byte(in))
+		got := r.String()
+		if r.Err() != nil {
+			t.Errorf("String(%s): %v", in, r.Err())
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("String(%s) differs from encoding/json (-want +got):\n%s", in, diff)
+		}
+	}
+}
+
+type testObject struct {
+	Name   string   `json:"name"`
+	Count  int      `json:"count"`
+	Ratio  float64  `json:"ratio"`
+	OK     bool     `json:"ok"`
+	Data   []byte   `json:"data"`
+	Values []string `json:"values"`
+	Nested *testObject
+}
+
+func (v *testObject) DecodeJSON(r *Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "count":
+			v.Count = r.Int()
+		case "ratio":
+			v.Ratio = r.Float()
+		case "ok":
+			v.OK = r.Bool()
+		case "data":
+			v.Data = r.Base64()
+		case "values":
+			if r.ArrayStart() {
+				v.Values = make([]string, 0)
+				for r.ArrayNext() {
+					v.Values = append(v.Values, r.String())
+				}
+			} else {
+				v.Values = nil
+			}
+		case "Nested":
+			if r.Null() {
+				v.Nested = nil
+			} else {
+				var p testObject
+				p.DecodeJSON(r)
+				v.Nested = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "Empty", in: `{}`},
+		{name: "Null", in: `null`},
+		{name: "Values", in: `{"name":"ab","count":-42,"ratio":1.5e3,"ok":true,"data":"Y2Rw","values":["x","y"]}`},
+		{name: "Whitespace", in: " { \"name\" : \"a\" ,\n\t\"values\" : [ ] , \"count\" : 1 } "},
+		{name: "NullValues", in: `{"name":null,"count":null,"ok":null,"data":null,"values":null,"Nested":null}`},
+		{name: "Nested", in: `{"Nested":{"name":"b","Nested":{"count":2}}}`},
+		{name: "Unknown", in: `{"unknown":{"a":[1,{"b":"]}"}],"c":null},"name":"a","other":-1.5e-3}`},
+		{name: "EscapedKey", in: `{"n\u0061me":"a"}`},
+		{name: "TypeMismatch", in: `{"name":1,"count":"a","values":{"a":"b"},"ok":"true"}`, wantErr: true},
+		{name: "NotInteger", in: `{"count":1.5}`, wantErr: true},
+		{name: "InvalidBase64", in: `{"data":"!"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got testObject
+			err := Unmarshal([]byte(tt.in), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var want testObject
+			if err := json.Unmarshal([]byte(tt.in), &want); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Unmarshal() differs from encoding/json (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type rawObject struct {
+	Raw json.RawMessage
+	Dec *testObject
+}
+
+func (v *rawObject) DecodeJSON(r *Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "Raw":
+			v.Raw = r.Raw()
+		case "Dec":
+			var p testObject
+			r.Decode(unmarshaler{&p})
+			v.Dec = &p
+		default:
+			r.Skip()
+		}
+	}
+}
+
+type unmarshaler struct{ v Decoder }
+
+func (u unmarshaler) UnmarshalJSON(data []byte) error { return Unmarshal(data, u.v) }
+
+func TestReader_RawDecode(t *testing.T) {
+	in := `{"Raw": {"a": [1, 2]} , "Dec":{"name":"a"}}`
+	var got rawObject
+	if err := Unmarshal([]byte(in), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := rawObject{
+		Raw: json.RawMessage(`{"a": [1, 2]}`),
+		Dec: &testObject{Name: "a"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unmarshal() (-want +got):\n%s", diff)
+	}
+}
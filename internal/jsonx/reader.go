@@ -0,0 +1,446 @@
+package jsonx
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/mafredri/cdp/internal/errors"
+)
+
+// Reader reads JSON values from a buffer. The input is expected to be
+// valid JSON (encoding/json validates the input before calling
+// UnmarshalJSON), type mismatches are recorded (see Err) and the
+// mismatched value is skipped. An object is read as:
+//
+//	if r.ObjectStart() {
+//		for r.ObjectNext() {
+//			switch string(r.Key()) {
+//			case "name":
+//				v.Name = r.String()
+//			default:
+//				r.Skip()
+//			}
+//		}
+//	}
+type Reader struct {
+	data []byte
+	pos  int
+	key  []byte
+	buf  []byte // Scratch buffer for unescaping keys.
+	err  error
+}
+
+// NewReader returns a Reader for data.
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data}
+}
+
+// Err returns the first error encountered.
+func (r *Reader) Err() error { return r.err }
+
+func (r *Reader) setErr(want string) {
+	if r.err == nil {
+		got := "end of input"
+		if r.pos < len(r.data) {
+			got = strconv.QuoteRune(rune(r.data[r.pos]))
+		}
+		r.err = errors.New("jsonx: want " + want + ", got " + got + " at offset " + strconv.Itoa(r.pos))
+	}
+}
+
+func (r *Reader) skipSpace() {
+	for r.pos < len(r.data) {
+		switch r.data[r.pos] {
+		case ' ', '\t', '\n', '\r':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (r *Reader) peek() byte {
+	r.skipSpace()
+	if r.pos < len(r.data) {
+		return r.data[r.pos]
+	}
+	return 0
+}
+
+// mismatch records an error and skips the value.
+func (r *Reader) mismatch(want string) {
+	r.setErr(want)
+	r.Skip()
+}
+
+// Null consumes null and returns true if the next value is null.
+func (r *Reader) Null() bool {
+	if r.peek() == 'n' {
+		r.pos += len("null")
+		return true
+	}
+	return false
+}
+
+// ObjectStart consumes the start of an object. Returns false if the
+// value is null (or not an object).
+func (r *Reader) ObjectStart() bool {
+	switch r.peek() {
+	case '{':
+		r.pos++
+		return true
+	case 'n':
+		r.pos += len("null")
+	default:
+		r.mismatch("object")
+	}
+	return false
+}
+
+// ObjectNext reads the next key in the object, available via Key, and
+// returns true. Returns false at the end of the object.
+func (r *Reader) ObjectNext() bool {
+	switch r.peek() {
+	case '}':
+		r.pos++
+		return false
+	case ',':
+		r.pos++
+		r.skipSpace()
+	}
+	if r.peek() != '"' {
+		r.setErr("object key")
+		r.pos = len(r.data)
+		return false
+	}
+	s, esc := r.rawString()
+	if esc {
+		r.buf = unquote(r.buf[:0], s)
+		r.key = r.buf
+	} else {
+		r.key = s
+	}
+	if r.peek() != ':' {
+		r.setErr("':'")
+		r.pos = len(r.data)
+		return false
+	}
+	r.pos++
+	return true
+}
+
+// Key returns the current object key, only valid until the next call
+// to ObjectNext.
+func (r *Reader) Key() []byte { return r.key }
+
+// ArrayStart consumes the start of an array. Returns false if the
+// value is null (or not an array).
+func (r *Reader) ArrayStart() bool {
+	switch r.peek() {
+	case '[':
+		r.pos++
+		return true
+	case 'n':
+		r.pos += len("null")
+	default:
+		r.mismatch("array")
+	}
+	return false
+}
+
+// ArrayNext returns true if there is another value in the array.
+func (r *Reader) ArrayNext() bool {
+	switch r.peek() {
+	case ']':
+		r.pos++
+		return false
+	case ',':
+		r.pos++
+	case 0:
+		r.setErr("']'")
+		return false
+	}
+	return true
+}
+
+// Bool reads a boolean, null results in false.
+func (r *Reader) Bool() bool {
+	switch r.peek() {
+	case 't':
+		r.pos += len("true")
+		return true
+	case 'f':
+		r.pos += len("false")
+	case 'n':
+		r.pos += len("null")
+	default:
+		r.mismatch("boolean")
+	}
+	return false
+}
+
+// number returns the next number token.
+func (r *Reader) number() ([]byte, bool) {
+	c := r.peek()
+	if c == 'n' {
+		r.pos += len("null")
+		return nil, false
+	}
+	if c != '-' && (c < '0' || c > '9') {
+		r.mismatch("number")
+		return nil, false
+	}
+	start := r.pos
+	for r.pos < len(r.data) {
+		switch c := r.data[r.pos]; {
+		case c >= '0' && c <= '9', c == '-', c == '+', c == '.', c == 'e', c == 'E':
+			r.pos++
+			continue
+		}
+		break
+	}
+	return r.data[start:r.pos], true
+}
+
+// Int reads an integer, null results in 0.
+func (r *Reader) Int() int {
+	b, ok := r.number()
+	if !ok {
+		return 0
+	}
+	i, err := strconv.ParseInt(string(b), 10, 0)
+	if err != nil {
+		r.pos -= len(b)
+		r.setErr("integer")
+		r.pos += len(b)
+		return 0
+	}
+	return int(i)
+}
+
+// Float reads a number, null results in 0.
+func (r *Reader) Float() float64 {
+	b, ok := r.number()
+	if !ok {
+		return 0
+	}
+	f, err := strconv.ParseFloat(string(b), 64)
+	if err != nil {
+		r.pos -= len(b)
+		r.setErr("number")
+		r.pos += len(b)
+		return 0
+	}
+	return f
+}
+
+// String reads a string, null results in "".
+func (r *Reader) String() string {
+	switch r.peek() {
+	case '"':
+	case 'n':
+		r.pos += len("null")
+		return ""
+	default:
+		r.mismatch("string")
+		return ""
+	}
+	s, esc := r.rawString()
+	if esc {
+		return string(unquote(nil, s))
+	}
+	return string(s)
+}
+
+// Base64 reads a base64 encoded string, null results in nil.
+func (r *Reader) Base64() []byte {
+	switch r.peek() {
+	case '"':
+	case 'n':
+		r.pos += len("null")
+		return nil
+	default:
+		r.mismatch("string")
+		return nil
+	}
+	s, esc := r.rawString()
+	if esc {
+		s = unquote(nil, s)
+	}
+	// Allocate exactly (for valid input) by excluding the padding.
+	n := base64.StdEncoding.DecodedLen(len(s))
+	for i := len(s) - 1; i >= len(s)-2 && i >= 0 && s[i] == '='; i-- {
+		n--
+	}
+	b, err := base64.StdEncoding.AppendDecode(make([]byte, 0, max(n, 0)), s)
+	if err != nil {
+		if r.err == nil {
+			r.err = errors.Wrapf(err, "jsonx: base64")
+		}
+		return nil
+	}
+	return b
+}
+
+// Raw returns a copy of the next value, including null.
+func (r *Reader) Raw() json.RawMessage {
+	r.skipSpace()
+	start := r.pos
+	r.Skip()
+	return append(json.RawMessage(nil), r.data[start:r.pos]...)
+}
+
+// Decode calls u.UnmarshalJSON with the next value, including null.
+func (r *Reader) Decode(u json.Unmarshaler) {
+	r.skipSpace()
+	start := r.pos
+	r.Skip()
+	if err := u.UnmarshalJSON(r.data[start:r.pos]); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+// Skip skips the next value.
+func (r *Reader) Skip() {
+	switch r.peek() {
+	case '{':
+		r.pos++
+		for r.ObjectNext() {
+			r.Skip()
+		}
+	case '[':
+		r.pos++
+		for r.ArrayNext() {
+			r.Skip()
+		}
+	case '"':
+		r.rawString()
+	case 't':
+		r.pos += len("true")
+	case 'f':
+		r.pos += len("false")
+	case 'n':
+		r.pos += len("null")
+	case 0:
+		r.setErr("value")
+	default:
+		for r.pos < len(r.data) {
+			switch r.data[r.pos] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				return
+			}
+			r.pos++
+		}
+	}
+}
+
+// rawString consumes a string and returns its contents (without
+// quotes). The contents must be unquoted if esc is true, i.e. it
+// contains escape sequences or is not valid UTF-8.
+func (r *Reader) rawString() (s []byte, esc bool) {
+	r.pos++ // Opening quote.
+	start := r.pos
+	for {
+		i := bytes.IndexByte(r.data[r.pos:], '"')
+		if i == -1 {
+			r.pos = len(r.data)
+			r.setErr("'\"'")
+			return r.data[start:], esc
+		}
+		r.pos += i + 1
+
+		// The quote is escaped if preceded by an odd number of
+		// backslashes.
+		n := 0
+		for j := r.pos - 2; j >= start && r.data[j] == '\\'; j-- {
+			n++
+		}
+		if n%2 == 0 {
+			break
+		}
+	}
+	s = r.data[start : r.pos-1]
+	esc = bytes.IndexByte(s, '\\') != -1 || !utf8.Valid(s)
+	return s, esc
+}
+
+// unquote appends the unescaped contents of s to b, invalid UTF-8 and
+// surrogates are replaced by utf8.RuneError (like encoding/json).
+func unquote(b, s []byte) []byte {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case '"', '\\', '/', '\'':
+				b = append(b, s[i])
+			case 'b':
+				b = append(b, '\b')
+			case 'f':
+				b = append(b, '\f')
+			case 'n':
+				b = append(b, '\n')
+			case 'r':
+				b = append(b, '\r')
+			case 't':
+				b = append(b, '\t')
+			case 'u':
+				rn := getu4(s[i-1:])
+				if rn < 0 {
+					b = utf8.AppendRune(b, utf8.RuneError)
+					i++
+					continue
+				}
+				i += 5
+				if utf16.IsSurrogate(rn) {
+					rn2 := getu4(s[i:])
+					if dec := utf16.DecodeRune(rn, rn2); dec != utf8.RuneError {
+						i += 6
+						b = utf8.AppendRune(b, dec)
+						continue
+					}
+					rn = utf8.RuneError
+				}
+				b = utf8.AppendRune(b, rn)
+				continue
+			}
+			i++
+		case c < utf8.RuneSelf:
+			b = append(b, c)
+			i++
+		default:
+			rn, size := utf8.DecodeRune(s[i:])
+			b = utf8.AppendRune(b, rn)
+			i += size
+		}
+	}
+	return b
+}
+
+// getu4 decodes \uXXXX from the beginning of s, returning the hex
+// value, or -1.
+func getu4(s []byte) rune {
+	if len(s) < 6 || s[0] != '\\' || s[1] != 'u' {
+		return -1
+	}
+	var r rune
+	for _, c := range s[2:6] {
+		switch {
+		case '0' <= c && c <= '9':
+			c = c - '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return -1
+		}
+		r = r*16 + rune(c)
+	}
+	return r
+}
@@ -0,0 +1,252 @@
+// Package jsonx implements the reflection-free JSON encoding and decoding
+// used by the code generated by cdpgen (EncodeJSON and DecodeJSON).
+//
+// The output of Writer matches encoding/json (including HTML escaping),
+// Reader accepts the same input as encoding/json for the protocol types,
+// except that object keys are matched case-sensitively.
+package jsonx
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/mafredri/cdp/internal/errors"
+)
+
+// Encoder is implemented by types that can encode themselves to JSON.
+type Encoder interface {
+	EncodeJSON(w *Writer)
+}
+
+// Decoder is implemented by types that can decode themselves from JSON.
+type Decoder interface {
+	DecodeJSON(r *Reader)
+}
+
+// writerPool reuses Writer buffers between calls to Marshal, like
+// encoding/json.
+var writerPool = sync.Pool{
+	New: func() interface{} { return new(Writer) },
+}
+
+// Marshal returns the JSON encoding of v.
+func Marshal(v Encoder) ([]byte, error) {
+	w := writerPool.Get().(*Writer)
+	defer func() {
+		*w = Writer{buf: w.buf[:0]}
+		writerPool.Put(w)
+	}()
+
+	v.EncodeJSON(w)
+	if w.err != nil {
+		return nil, w.err
+	}
+	return append([]byte(nil), w.buf...), nil
+}
+
+// Unmarshal decodes the JSON encoded data into v.
+func Unmarshal(data []byte, v Decoder) error {
+	r := Reader{data: data}
+	v.DecodeJSON(&r)
+	return r.Err()
+}
+
+// Writer writes JSON values to a buffer. Separators are written
+// automatically, an object is written as:
+//
+//	w.ObjectStart()
+//	w.Key("name")
+//	w.String("value")
+//	w.ObjectEnd()
+type Writer struct {
+	buf   []byte
+	comma bool // A separator is needed before the next key or value.
+	err   error
+}
+
+// Bytes returns the written bytes.
+func (w *Writer) Bytes() []byte { return w.buf }
+
+// Err returns the first error encountered, e.g. for NaN or when a
+// json.Marshaler fails.
+func (w *Writer) Err() error { return w.err }
+
+func (w *Writer) sep() {
+	if w.comma {
+		w.buf = append(w.buf, ',')
+	}
+	w.comma = true
+}
+
+// ObjectStart writes the start of an object.
+func (w *Writer) ObjectStart() {
+	w.sep()
+	w.buf = append(w.buf, '{')
+	w.comma = false
+}
+
+// ObjectEnd writes the end of an object.
+func (w *Writer) ObjectEnd() {
+	w.buf = append(w.buf, '}')
+	w.comma = true
+}
+
+// ArrayStart writes the start of an array.
+func (w *Writer) ArrayStart() {
+	w.sep()
+	w.buf = append(w.buf, '[')
+	w.comma = false
+}
+
+// ArrayEnd writes the end of an array.
+func (w *Writer) ArrayEnd() {
+	w.buf = append(w.buf, ']')
+	w.comma = true
+}
+
+// Key writes an object key, the key must not require escaping.
+func (w *Writer) Key(k string) {
+	w.sep()
+	w.buf = append(w.buf, '"')
+	w.buf = append(w.buf, k...)
+	w.buf = append(w.buf, '"', ':')
+	w.comma = false
+}
+
+// Null writes null.
+func (w *Writer) Null() {
+	w.sep()
+	w.buf = append(w.buf, "null"...)
+}
+
+// Bool writes a boolean.
+func (w *Writer) Bool(b bool) {
+	w.sep()
+	w.buf = strconv.AppendBool(w.buf, b)
+}
+
+// Int writes an integer.
+func (w *Writer) Int(i int) {
+	w.sep()
+	w.buf = strconv.AppendInt(w.buf, int64(i), 10)
+}
+
+// Float writes a number, formatted like encoding/json.
+func (w *Writer) Float(f float64) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		w.setErr(errors.New("jsonx: unsupported value: " + strconv.FormatFloat(f, 'g', -1, 64)))
+		f = 0
+	}
+	w.sep()
+	fmt := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		fmt = 'e'
+	}
+	w.buf = strconv.AppendFloat(w.buf, f, fmt, -1, 64)
+	if fmt == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(w.buf)
+		if n >= 4 && w.buf[n-4] == 'e' && w.buf[n-3] == '-' && w.buf[n-2] == '0' {
+			w.buf[n-2] = w.buf[n-1]
+			w.buf = w.buf[:n-1]
+		}
+	}
+}
+
+// Base64 writes b as a base64 encoded string, nil is written as null.
+func (w *Writer) Base64(b []byte) {
+	if b == nil {
+		w.Null()
+		return
+	}
+	w.sep()
+	w.buf = append(w.buf, '"')
+	w.buf = base64.StdEncoding.AppendEncode(w.buf, b)
+	w.buf = append(w.buf, '"')
+}
+
+// Raw writes the (valid) JSON in b verbatim, an empty b is written
+// as null.
+func (w *Writer) Raw(b []byte) {
+	if len(b) == 0 {
+		w.Null()
+		return
+	}
+	w.sep()
+	w.buf = append(w.buf, b...)
+}
+
+// Marshal writes the result of m.MarshalJSON.
+func (w *Writer) Marshal(m json.Marshaler) {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		w.setErr(err)
+		b = nil
+	}
+	w.Raw(b)
+}
+
+func (w *Writer) setErr(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+const hex = "0123456789abcdef"
+
+// String writes a string, escaped like encoding/json.
+func (w *Writer) String(s string) {
+	w.sep()
+	w.buf = append(w.buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			w.buf = append(w.buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				w.buf = append(w.buf, '\\', c)
+			case '\b':
+				w.buf = append(w.buf, '\\', 'b')
+			case '\f':
+				w.buf = append(w.buf, '\\', 'f')
+			case '\n':
+				w.buf = append(w.buf, '\\', 'n')
+			case '\r':
+				w.buf = append(w.buf, '\\', 'r')
+			case '\t':
+				w.buf = append(w.buf, '\\', 't')
+			default:
+				w.buf = append(w.buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			w.buf = append(w.buf, s[start:i]...)
+			w.buf = append(w.buf, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			w.buf = append(w.buf, s[start:i]...)
+			w.buf = append(w.buf, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	w.buf = append(w.buf, s[start:]...)
+	w.buf = append(w.buf, '"')
+}
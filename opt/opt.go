@@ -0,0 +1,96 @@
+/*
+Package opt provides optional values for the arguments of the protocol
+commands, an alternative to the pointers used by the optional fields.
+
+An Opt is passed by value and does not require taking an address:
+
+	referrer := opt.None[string]()
+	if ref != "" {
+		referrer = opt.Some(ref)
+	}
+	args := page.NewNavigateArgs(url)
+	args.Referrer = referrer.Ptr() // Nil when not set.
+
+The generated option constructors (e.g. page.NewNavigateArgsWith) are
+combined with Map to set arguments conditionally:
+
+	args := page.NewNavigateArgsWith(url,
+		page.NavigateWithTransitionType(page.TransitionTypeLink),
+		opt.Map(referrer, page.NavigateWithReferrer), // Only if set.
+	)
+
+Values of optional fields (e.g. in replies) are read with FromPtr:
+
+	size := opt.FromPtr(reply.Size).Or(0)
+*/
+package opt
+
+// Opt is an optional value of type T. The zero value is not set.
+type Opt[T any] struct {
+	v   T
+	set bool
+}
+
+// Some returns v as a set Opt.
+func Some[T any](v T) Opt[T] {
+	return Opt[T]{v: v, set: true}
+}
+
+// None returns an Opt that is not set.
+func None[T any]() Opt[T] {
+	return Opt[T]{}
+}
+
+// FromPtr returns the value p points to as a set Opt, or an Opt that is
+// not set if p is nil.
+func FromPtr[T any](p *T) Opt[T] {
+	if p == nil {
+		return Opt[T]{}
+	}
+	return Some(*p)
+}
+
+// IsSet returns true if o has a value.
+func (o Opt[T]) IsSet() bool {
+	return o.set
+}
+
+// Get returns the value and true if o is set.
+func (o Opt[T]) Get() (T, bool) {
+	return o.v, o.set
+}
+
+// Or returns the value of o, or def if o is not set.
+func (o Opt[T]) Or(def T) T {
+	if !o.set {
+		return def
+	}
+	return o.v
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if o is not set.
+// The result is assignable to the pointer fields of optional arguments.
+func (o Opt[T]) Ptr() *T {
+	if !o.set {
+		return nil
+	}
+	v := o.v
+	return &v
+}
+
+// Ptr returns a pointer to v, e.g. for setting optional arguments
+// directly: args.Referrer = opt.Ptr("https://example.com").
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Map returns fn(v) if o is set, otherwise the zero value of O. Used
+// with option constructors (e.g. page.NavigateWithReferrer) the option
+// is nil and ignored when o is not set.
+func Map[T, O any](o Opt[T], fn func(T) O) O {
+	if !o.set {
+		var zero O
+		return zero
+	}
+	return fn(o.v)
+}
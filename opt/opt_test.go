@@ -0,0 +1,50 @@
+package opt
+
+import "testing"
+
+func TestOpt(t *testing.T) {
+	var zero Opt[int]
+	if zero.IsSet() || zero.Ptr() != nil || zero.Or(2) != 2 {
+		t.Errorf("zero value: got %+v, want not set", zero)
+	}
+	if None[int]() != zero {
+		t.Errorf("None() = %+v, want zero value", None[int]())
+	}
+
+	o := Some(0)
+	if v, ok := o.Get(); !ok || v != 0 {
+		t.Errorf("Some(0).Get() = %v, %v, want 0, true", v, ok)
+	}
+	if o.Or(2) != 0 {
+		t.Errorf("Some(0).Or(2) = %v, want 0", o.Or(2))
+	}
+	p := o.Ptr()
+	if p == nil || *p != 0 {
+		t.Fatalf("Some(0).Ptr() = %v, want pointer to 0", p)
+	}
+	*p = 1
+	if o.Or(2) != 0 {
+		t.Error("Ptr() did not return a copy")
+	}
+
+	if FromPtr[int](nil).IsSet() {
+		t.Error("FromPtr(nil) is set")
+	}
+	if v := FromPtr(Ptr(3)).Or(0); v != 3 {
+		t.Errorf("FromPtr(Ptr(3)) = %v, want 3", v)
+	}
+}
+
+func TestMap(t *testing.T) {
+	type option func(*int)
+	set := func(v int) option { return func(p *int) { *p = v } }
+
+	if o := Map(None[int](), set); o != nil {
+		t.Error("Map(None) = non-nil option, want nil")
+	}
+	var got int
+	Map(Some(5), set)(&got)
+	if got != 5 {
+		t.Errorf("Map(Some(5)) set %d, want 5", got)
+	}
+}
@@ -0,0 +1,77 @@
+package pool
+
+import (
+	"context"
+	"io"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+// cdpBrowser is a browser connected via its browser endpoint.
+type cdpBrowser struct {
+	conn *rpcc.Conn
+	m    *session.Manager
+	stop func() error
+}
+
+var _ instance = (*cdpBrowser)(nil)
+
+// dialBrowser launches the browser and connects to it.
+func dialBrowser(ctx context.Context, launch Launcher) (_ instance, err error) {
+	url, stop, err := launch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b := &cdpBrowser{stop: stop}
+	defer func() {
+		if err != nil {
+			err = errors.Merge(err, b.close())
+		}
+	}()
+
+	v, err := devtool.New(url).Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b.conn, err = rpcc.DialContext(ctx, v.WebSocketDebuggerURL)
+	if err != nil {
+		return nil, err
+	}
+	b.m, err = session.NewManager(cdp.NewClient(b.conn))
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *cdpBrowser) newPage(ctx context.Context) (session.SessionInfo, io.Closer, error) {
+	bc, err := session.NewBrowserContext(ctx, b.m)
+	if err != nil {
+		return session.SessionInfo{}, nil, err
+	}
+	page, err := bc.NewPage(ctx, "about:blank")
+	if err != nil {
+		return session.SessionInfo{}, nil, errors.Merge(err, bc.Close())
+	}
+	return page, bc, nil
+}
+
+func (b *cdpBrowser) done() <-chan struct{} { return b.conn.Done() }
+
+func (b *cdpBrowser) close() error {
+	var errs []error
+	if b.m != nil {
+		errs = append(errs, b.m.Close())
+	}
+	if b.conn != nil {
+		errs = append(errs, b.conn.Close())
+	}
+	if b.stop != nil {
+		errs = append(errs, b.stop())
+	}
+	return errors.Merge(errs...)
+}
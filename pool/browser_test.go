@@ -0,0 +1,101 @@
+package pool
+
+import (
+	"context"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+var browserFlag = flag.Bool("browser", false, "Test with browser")
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	os.Exit(m.Run())
+}
+
+func checkBrowser(t *testing.T) {
+	t.Helper()
+	if !*browserFlag {
+		t.Skip("Test requires browser, skipping...")
+	}
+}
+
+func TestDialBrowser(t *testing.T) {
+	checkBrowser(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	inst, err := dialBrowser(ctx, Attach("http://localhost:9222"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, bc, err := inst.newPage(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err := cdp.NewClient(page.Conn).Runtime.Evaluate(ctx, runtime.NewEvaluateArgs("location.href"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(reply.Result.Value); got != `"about:blank"` {
+		t.Errorf("location.href: got %s, want %q", got, "about:blank")
+	}
+
+	// Closing the browser context closes the page session.
+	if err = bc.Close(); err != nil {
+		t.Error(err)
+	}
+	<-page.Conn.Done()
+
+	if err = inst.close(); err != nil {
+		t.Error(err)
+	}
+	select {
+	case <-inst.done():
+	case <-time.After(time.Second):
+		t.Error("done: not closed after close")
+	}
+}
+
+func TestPool_Browser(t *testing.T) {
+	checkBrowser(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	p, err := New(ctx, Attach("http://localhost:9222"), WithBrowsers(1), WithMaxPages(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	var leases []*Lease
+	for i := 0; i < 2; i++ {
+		lease, err := p.Acquire(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, lease)
+	}
+	if leases[0].Page.TargetID == leases[1].Page.TargetID {
+		t.Errorf("got the same page %s twice", leases[0].Page.TargetID)
+	}
+	if s := p.Stats(); s.Healthy != 1 || s.InUse != 2 {
+		t.Errorf("Stats: got %d healthy, %d in use, want 1 and 2", s.Healthy, s.InUse)
+	}
+	for _, lease := range leases {
+		if err = lease.Release(); err != nil {
+			t.Error(err)
+		}
+	}
+	if s := p.Stats(); s.InUse != 0 {
+		t.Errorf("Stats: got %d in use, want 0", s.InUse)
+	}
+}
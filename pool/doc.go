@@ -0,0 +1,33 @@
+/*
+Package pool implements a pool of browsers for running many jobs
+concurrently, each job leases a page in a fresh browser context.
+
+Create a pool of browsers started (or attached to) by a Launcher.
+
+	p, err := pool.New(ctx, pool.Attach("http://127.0.0.1:9222"),
+		pool.WithBrowsers(2),   // Number of browsers.
+		pool.WithMaxPages(4),   // Concurrent pages per browser.
+		pool.WithMaxUses(100))  // Recycle browsers after 100 leases.
+	if err != nil {
+		// Handle error.
+	}
+	defer p.Close()
+
+Acquire a page, Acquire blocks until a page is available or ctx is done.
+
+	lease, err := p.Acquire(ctx)
+	if err != nil {
+		// Handle error.
+	}
+	defer lease.Release() // Disposes of the browser context.
+
+	c := cdp.NewClient(lease.Page.Conn)
+	// ...
+
+The pool health and queue can be monitored via Stats.
+
+	s := p.Stats()
+	log.Printf("%d/%d healthy browsers, %d pages in use, %d waiting",
+		s.Healthy, len(s.Browsers), s.InUse, s.Waiting)
+*/
+package pool
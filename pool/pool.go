@@ -0,0 +1,451 @@
+package pool
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/session"
+)
+
+// Launcher starts, or attaches to, a browser and returns the URL of
+// its DevTools HTTP endpoint, e.g. "http://127.0.0.1:9222". The stop
+// function, if not nil, is called when the browser is recycled or the
+// Pool is closed.
+type Launcher func(ctx context.Context) (url string, stop func() error, err error)
+
+// Attach returns a Launcher for an already running browser. Recycling
+// the browser only re-establishes the connection.
+func Attach(url string) Launcher {
+	return func(context.Context) (string, func() error, error) {
+		return url, nil, nil
+	}
+}
+
+// Option represents an option passed to New.
+type Option func(*options)
+
+type options struct {
+	browsers     int
+	maxPages     int
+	maxUses      int
+	restartDelay time.Duration
+}
+
+// WithBrowsers returns an Option that sets the number of browsers in
+// the Pool. The default is 1.
+func WithBrowsers(n int) Option {
+	return func(o *options) {
+		o.browsers = n
+	}
+}
+
+// WithMaxPages returns an Option that limits the number of pages that
+// are leased concurrently from each browser. The default is 8.
+func WithMaxPages(n int) Option {
+	return func(o *options) {
+		o.maxPages = n
+	}
+}
+
+// WithMaxUses returns an Option that recycles a browser after it has
+// handed out n leases, once all of them have been released. The default
+// (0) never recycles healthy browsers.
+func WithMaxUses(n int) Option {
+	return func(o *options) {
+		o.maxUses = n
+	}
+}
+
+// WithRestartDelay returns an Option that sets the delay between
+// attempts to (re)start a browser that failed to start. The default is
+// 1 second.
+func WithRestartDelay(d time.Duration) Option {
+	return func(o *options) {
+		o.restartDelay = d
+	}
+}
+
+// State represents the state of a browser in the Pool.
+type State int
+
+// State enums.
+const (
+	// Starting means the browser is being (re)started.
+	Starting State = iota + 1
+	// Ready means the browser is handing out leases.
+	Ready
+	// Draining means the browser is waiting for its leases to be
+	// released before it is recycled.
+	Draining
+	// Down means the connection to the browser was lost (e.g. it
+	// crashed), it is restarted once its leases are released.
+	Down
+)
+
+func (s State) String() string {
+	switch s {
+	case Starting:
+		return "Starting"
+	case Ready:
+		return "Ready"
+	case Draining:
+		return "Draining"
+	case Down:
+		return "Down"
+	default:
+		return "State(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// BrowserStats describes the state of a browser in the Pool.
+type BrowserStats struct {
+	ID       int
+	State    State
+	Active   int   // Number of leased pages.
+	Uses     int   // Number of leases since the browser was started.
+	Restarts int   // Number of times the browser was recycled.
+	Err      error // Last error when starting the browser, if any.
+}
+
+// Stats describes the health and queue of the Pool.
+type Stats struct {
+	Browsers []BrowserStats
+	Healthy  int    // Number of browsers that are Ready or Draining.
+	InUse    int    // Number of leased pages.
+	Waiting  int    // Number of Acquire calls waiting for a page.
+	Acquired uint64 // Total number of leases.
+	Recycled uint64 // Total number of browser restarts.
+	Crashed  uint64 // Total number of lost browser connections.
+}
+
+// instance represents a running browser.
+type instance interface {
+	// newPage opens a page in a new browser context, the closer
+	// disposes of the browser context.
+	newPage(ctx context.Context) (session.SessionInfo, io.Closer, error)
+	// done is closed when the connection to the browser is lost.
+	done() <-chan struct{}
+	close() error
+}
+
+type browser struct {
+	id       int
+	inst     instance
+	state    State
+	active   int
+	uses     int
+	restarts int
+	err      error
+}
+
+// Pool hands out page leases from a set of browsers.
+type Pool struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	opts   options
+	launch Launcher
+	dial   func(context.Context, Launcher) (instance, error)
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex // Protects following.
+	browsers []*browser
+	wake     chan struct{} // Closed when leases may be available.
+	waiting  int
+	acquired uint64
+	recycled uint64
+	crashed  uint64
+	closed   bool
+}
+
+// New creates a Pool and starts all browsers using launch. New returns
+// an error if any of the browsers fail to start.
+func New(ctx context.Context, launch Launcher, opts ...Option) (*Pool, error) {
+	return newPool(ctx, launch, dialBrowser, opts...)
+}
+
+func newPool(ctx context.Context, launch Launcher, dial func(context.Context, Launcher) (instance, error), opts ...Option) (*Pool, error) {
+	p := &Pool{
+		opts: options{
+			browsers:     1,
+			maxPages:     8,
+			restartDelay: time.Second,
+		},
+		launch: launch,
+		dial:   dial,
+		wake:   make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(&p.opts)
+	}
+	if p.opts.browsers < 1 || p.opts.maxPages < 1 {
+		return nil, errors.New("pool: the number of browsers and pages must be positive")
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, p.opts.browsers)
+	)
+	for i := 0; i < p.opts.browsers; i++ {
+		b := &browser{id: i, state: Starting}
+		p.browsers = append(p.browsers, b)
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			b.inst, errs[i] = p.dial(ctx, p.launch)
+			if errs[i] != nil {
+				errs[i] = errors.Wrapf(errs[i], "pool: start browser %d failed", i)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if err := errors.Merge(errs...); err != nil {
+		p.Close()
+		return nil, err
+	}
+	for _, b := range p.browsers {
+		b.state = Ready
+		p.monitor(b, b.inst)
+	}
+	return p, nil
+}
+
+// Acquire leases a page in a new browser context. Acquire blocks until
+// a browser has capacity for the page or ctx is done.
+func (p *Pool) Acquire(ctx context.Context) (*Lease, error) {
+	p.mu.Lock()
+	p.waiting++
+	var b *browser
+	for b == nil {
+		if p.closed {
+			p.waiting--
+			p.mu.Unlock()
+			return nil, errors.New("pool: Acquire failed: Pool is closed")
+		}
+		if b = p.pickLocked(); b != nil {
+			break
+		}
+
+		wake := p.wake
+		p.mu.Unlock()
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			p.mu.Lock()
+			p.waiting--
+			p.mu.Unlock()
+			return nil, ctx.Err()
+		}
+		p.mu.Lock()
+	}
+	p.waiting--
+	b.active++
+	b.uses++
+	p.acquired++
+	inst := b.inst
+	p.mu.Unlock()
+
+	page, closer, err := inst.newPage(ctx)
+	if err != nil {
+		// Not a lease, the use was only reserved (the browser is not
+		// restarted while active).
+		p.mu.Lock()
+		b.uses--
+		p.acquired--
+		p.mu.Unlock()
+		p.release(b)
+		return nil, errors.Wrapf(err, "pool: browser %d: new page failed", b.id)
+	}
+	return &Lease{Page: page, Browser: b.id, p: p, b: b, closer: closer}, nil
+}
+
+// pickLocked returns the least busy browser with capacity, if any.
+func (p *Pool) pickLocked() *browser {
+	var pick *browser
+	for _, b := range p.browsers {
+		if b.state != Ready || b.active >= p.opts.maxPages {
+			continue
+		}
+		if p.opts.maxUses > 0 && b.uses >= p.opts.maxUses {
+			continue
+		}
+		if pick == nil || b.active < pick.active {
+			pick = b
+		}
+	}
+	return pick
+}
+
+// broadcastLocked wakes up all waiting Acquire calls.
+func (p *Pool) broadcastLocked() {
+	close(p.wake)
+	p.wake = make(chan struct{})
+}
+
+func (p *Pool) release(b *browser) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b.active--
+	if b.state == Ready && p.opts.maxUses > 0 && b.uses >= p.opts.maxUses {
+		b.state = Draining
+	}
+	p.restartLocked(b)
+	p.broadcastLocked()
+}
+
+// monitor watches the browser connection until the browser is closed.
+func (p *Pool) monitor(b *browser, inst instance) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		select {
+		case <-inst.done():
+		case <-p.ctx.Done():
+			return
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.closed || b.inst != inst {
+			return
+		}
+		p.crashed++
+		b.state = Down
+		p.restartLocked(b)
+		p.broadcastLocked()
+	}()
+}
+
+// restartLocked recycles the browser once all of its leases have been
+// released.
+func (p *Pool) restartLocked(b *browser) {
+	if p.closed || b.active > 0 || (b.state != Draining && b.state != Down) {
+		return
+	}
+	b.state = Starting
+	p.recycled++
+	old := b.inst
+	b.inst = nil
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		if old != nil {
+			old.close()
+		}
+		for {
+			inst, err := p.dial(p.ctx, p.launch)
+			if err == nil {
+				p.mu.Lock()
+				if p.closed {
+					p.mu.Unlock()
+					inst.close()
+					return
+				}
+				b.inst = inst
+				b.state = Ready
+				b.uses = 0
+				b.restarts++
+				b.err = nil
+				p.monitor(b, inst)
+				p.broadcastLocked()
+				p.mu.Unlock()
+				return
+			}
+
+			p.mu.Lock()
+			b.err = err
+			p.mu.Unlock()
+			select {
+			case <-time.After(p.opts.restartDelay):
+			case <-p.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stats returns the current state of the Pool.
+func (p *Pool) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := Stats{
+		Waiting:  p.waiting,
+		Acquired: p.acquired,
+		Recycled: p.recycled,
+		Crashed:  p.crashed,
+	}
+	for _, b := range p.browsers {
+		s.Browsers = append(s.Browsers, BrowserStats{
+			ID:       b.id,
+			State:    b.state,
+			Active:   b.active,
+			Uses:     b.uses,
+			Restarts: b.restarts,
+			Err:      b.err,
+		})
+		s.InUse += b.active
+		if b.state == Ready || b.state == Draining {
+			s.Healthy++
+		}
+	}
+	return s
+}
+
+// Close closes all browsers. Leases that have not been released stop
+// working.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.cancel()
+	p.broadcastLocked()
+	p.mu.Unlock()
+
+	// Wait for restarts, after which b.inst is no longer modified.
+	p.wg.Wait()
+
+	var errs []error
+	for _, b := range p.browsers {
+		if b.inst != nil {
+			if err := b.inst.close(); err != nil {
+				errs = append(errs, errors.Wrapf(err, "pool: close browser %d failed", b.id))
+			}
+		}
+	}
+	return errors.Merge(errs...)
+}
+
+// Lease is a page leased from the Pool.
+type Lease struct {
+	Page    session.SessionInfo // The page session.
+	Browser int                 // ID of the browser (see BrowserStats).
+
+	p      *Pool
+	b      *browser
+	closer io.Closer
+	once   sync.Once
+	err    error
+}
+
+// Release disposes of the browser context of the page and returns the
+// capacity to the Pool. Subsequent calls to Release return the same
+// error.
+func (l *Lease) Release() error {
+	l.once.Do(func() {
+		l.err = l.closer.Close()
+		l.p.release(l.b)
+	})
+	return l.err
+}
@@ -0,0 +1,266 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp/session"
+)
+
+type fakeBrowser struct {
+	mu     sync.Mutex
+	pages  int
+	fail   int // Number of newPage calls that fail.
+	closed bool
+	doneC  chan struct{}
+	once   sync.Once
+}
+
+func (b *fakeBrowser) newPage(ctx context.Context) (session.SessionInfo, io.Closer, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return session.SessionInfo{}, nil, errors.New("browser closed")
+	}
+	if b.fail > 0 {
+		b.fail--
+		return session.SessionInfo{}, nil, errors.New("new page failed")
+	}
+	b.pages++
+	return session.SessionInfo{}, closerFunc(func() error { return nil }), nil
+}
+
+func (b *fakeBrowser) isClosed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closed
+}
+
+func (b *fakeBrowser) done() <-chan struct{} { return b.doneC }
+func (b *fakeBrowser) crash()                { b.once.Do(func() { close(b.doneC) }) }
+func (b *fakeBrowser) close() error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	b.crash()
+	return nil
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+type fakeLauncher struct {
+	mu       sync.Mutex
+	browsers []*fakeBrowser
+}
+
+func (l *fakeLauncher) dial(ctx context.Context, _ Launcher) (instance, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := &fakeBrowser{doneC: make(chan struct{})}
+	l.browsers = append(l.browsers, b)
+	return b, nil
+}
+
+func (l *fakeLauncher) browser(i int) *fakeBrowser {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.browsers[i]
+}
+
+func (l *fakeLauncher) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.browsers)
+}
+
+func waitStats(t *testing.T, p *Pool, ok func(Stats) bool) Stats {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s := p.Stats()
+		if ok(s) {
+			return s
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for stats, got %+v", s)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPool_MaxPages(t *testing.T) {
+	l := &fakeLauncher{}
+	p, err := newPool(context.Background(), nil, l.dial, WithBrowsers(2), WithMaxPages(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var leases []*Lease
+	for i := 0; i < 4; i++ {
+		lease, err := p.Acquire(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, lease)
+	}
+	for _, b := range p.Stats().Browsers {
+		if b.Active != 2 {
+			t.Errorf("browser %d: got %d active pages, want 2", b.ID, b.Active)
+		}
+	}
+
+	// The pool is full, Acquire waits for a release.
+	acquired := make(chan *Lease)
+	go func() {
+		lease, err := p.Acquire(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- lease
+	}()
+	waitStats(t, p, func(s Stats) bool { return s.Waiting == 1 })
+
+	if err = leases[0].Release(); err != nil {
+		t.Error(err)
+	}
+	lease := <-acquired
+	if lease.Browser != leases[0].Browser {
+		t.Errorf("got lease from browser %d, want %d", lease.Browser, leases[0].Browser)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err = p.Acquire(timeoutCtx); err != context.DeadlineExceeded {
+		t.Errorf("Acquire: got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	s := p.Stats()
+	if s.InUse != 4 || s.Waiting != 0 || s.Acquired != 5 || s.Healthy != 2 {
+		t.Errorf("got stats %+v", s)
+	}
+}
+
+func TestPool_RecycleMaxUses(t *testing.T) {
+	l := &fakeLauncher{}
+	p, err := newPool(context.Background(), nil, l.dial, WithMaxUses(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	lease1, err := p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease2, err := p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease1.Release()
+	// Both uses have been handed out, waiting for lease2.
+	if s := p.Stats(); s.Browsers[0].State != Draining {
+		t.Errorf("got state %v, want %v", s.Browsers[0].State, Draining)
+	}
+	lease2.Release()
+
+	waitStats(t, p, func(s Stats) bool { return s.Recycled == 1 && s.Browsers[0].State == Ready })
+	if l.count() != 2 || !l.browser(0).isClosed() {
+		t.Error("browser was not recycled")
+	}
+
+	lease, err := p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lease.Release()
+	if s := p.Stats(); s.Browsers[0].Uses != 1 || s.Browsers[0].Restarts != 1 {
+		t.Errorf("got stats %+v", s.Browsers[0])
+	}
+}
+
+func TestPool_AcquireFailed(t *testing.T) {
+	l := &fakeLauncher{}
+	p, err := newPool(context.Background(), nil, l.dial, WithMaxUses(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	b := l.browser(0)
+	b.mu.Lock()
+	b.fail = 2
+	b.mu.Unlock()
+	for i := 0; i < 2; i++ {
+		if _, err = p.Acquire(ctx); err == nil {
+			t.Fatal("Acquire: want error, got nil")
+		}
+	}
+	// Failed acquires are not leases and do not count against maxUses.
+	if s := p.Stats(); s.Acquired != 0 || s.Browsers[0].Uses != 0 || s.Browsers[0].State != Ready {
+		t.Errorf("got stats %+v, %+v", s, s.Browsers[0])
+	}
+
+	lease, err := p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lease.Release()
+	if s := p.Stats(); s.Recycled != 0 || s.Browsers[0].Uses != 1 {
+		t.Errorf("got stats %+v, %+v", s, s.Browsers[0])
+	}
+}
+
+func TestPool_RestartOnCrash(t *testing.T) {
+	l := &fakeLauncher{}
+	p, err := newPool(context.Background(), nil, l.dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	lease, err := p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.browser(0).crash()
+	waitStats(t, p, func(s Stats) bool { return s.Browsers[0].State == Down })
+	if s := p.Stats(); s.Crashed != 1 || s.Healthy != 0 {
+		t.Errorf("got stats %+v", s)
+	}
+
+	// The browser is restarted once the lease is released.
+	lease.Release()
+	waitStats(t, p, func(s Stats) bool { return s.Browsers[0].State == Ready })
+
+	lease, err = p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease.Release()
+
+	if err = p.Close(); err != nil {
+		t.Error(err)
+	}
+	if _, err = p.Acquire(ctx); err == nil {
+		t.Error("Acquire: want error after Close, got nil")
+	}
+}
@@ -0,0 +1,124 @@
+package proto
+
+import "strings"
+
+// splitMethod splits e.g. "Page.navigate" into "Page" and "navigate".
+func splitMethod(method string) (domain, name string, ok bool) {
+	i := strings.LastIndexByte(method, '.')
+	if i <= 0 || i == len(method)-1 {
+		return "", "", false
+	}
+	return method[:i], method[i+1:], true
+}
+
+// Domain returns the domain with the provided name, e.g. "Page".
+func (p *Protocol) Domain(name string) (Domain, bool) {
+	for _, d := range p.Domains {
+		if d.Domain == name {
+			return d, true
+		}
+	}
+	return Domain{}, false
+}
+
+// Command returns the command for method, e.g. "Page.navigate".
+func (p *Protocol) Command(method string) (Domain, Command, bool) {
+	domain, name, ok := splitMethod(method)
+	if !ok {
+		return Domain{}, Command{}, false
+	}
+	d, ok := p.Domain(domain)
+	if !ok {
+		return Domain{}, Command{}, false
+	}
+	c, ok := d.Command(name)
+	if !ok {
+		return Domain{}, Command{}, false
+	}
+	return d, c, true
+}
+
+// Event returns the event for method, e.g. "Page.loadEventFired".
+func (p *Protocol) Event(method string) (Domain, Event, bool) {
+	domain, name, ok := splitMethod(method)
+	if !ok {
+		return Domain{}, Event{}, false
+	}
+	d, ok := p.Domain(domain)
+	if !ok {
+		return Domain{}, Event{}, false
+	}
+	e, ok := d.Event(name)
+	if !ok {
+		return Domain{}, Event{}, false
+	}
+	return d, e, true
+}
+
+// HasCommand reports whether the protocol defines the command, e.g.
+// "Page.navigate".
+func (p *Protocol) HasCommand(method string) bool {
+	_, _, ok := p.Command(method)
+	return ok
+}
+
+// HasEvent reports whether the protocol defines the event, e.g.
+// "Page.loadEventFired".
+func (p *Protocol) HasEvent(method string) bool {
+	_, _, ok := p.Event(method)
+	return ok
+}
+
+// Command returns the command with the provided name, e.g. "navigate".
+func (d Domain) Command(name string) (Command, bool) {
+	for _, c := range d.Commands {
+		if c.NameName == name {
+			return c, true
+		}
+	}
+	return Command{}, false
+}
+
+// Event returns the event with the provided name, e.g.
+// "loadEventFired".
+func (d Domain) Event(name string) (Event, bool) {
+	for _, e := range d.Events {
+		if e.NameName == name {
+			return e, true
+		}
+	}
+	return Event{}, false
+}
+
+// Type returns the type with the provided id, e.g. "FrameId".
+func (d Domain) Type(id string) (AnyType, bool) {
+	for _, t := range d.Types {
+		if t.IDName == id {
+			return t, true
+		}
+	}
+	return AnyType{}, false
+}
+
+// Field returns the parameter, return value or property with the
+// provided name from fields.
+func Field(fields []AnyType, name string) (AnyType, bool) {
+	for _, f := range fields {
+		if f.NameName == name {
+			return f, true
+		}
+	}
+	return AnyType{}, false
+}
+
+// TypeName returns the type of t, e.g. "string", "Network.Cookie" or
+// "array of Network.Cookie".
+func (t AnyType) TypeName() string {
+	if t.Ref != "" {
+		return t.Ref
+	}
+	if t.Type == "array" && t.Items != nil {
+		return "array of " + t.Items.TypeName()
+	}
+	return t.Type
+}
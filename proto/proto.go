@@ -0,0 +1,70 @@
+// Package proto contains the types of the CDP protocol definitions
+// (JSON), e.g. the schema served by the DevTools endpoint (see
+// devtool.Protocol).
+package proto
+
+// Protocol represents the JSON protocol structure.
+type Protocol struct {
+	Version Version  `json:"version,omitempty"`
+	Domains []Domain `json:"domains,omitempty"`
+}
+
+// Version contains protocol version information.
+type Version struct {
+	Major string `json:"major,omitempty"`
+	Minor string `json:"minor,omitempty"`
+}
+
+// Domain represents a domain, e.g. Page, Network, etc.
+type Domain struct {
+	Domain       string    `json:"domain,omitempty"`
+	Experimental bool      `json:"experimental,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Dependencies []string  `json:"dependencies,omitempty"`
+	Types        []AnyType `json:"types,omitempty"`
+	Commands     []Command `json:"commands,omitempty"`
+	Events       []Event   `json:"events,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
+}
+
+// Command represents a command belonging to a domain, e.g. Network.setCookie.
+type Command struct {
+	NameName     string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Parameters   []AnyType `json:"parameters,omitempty"`
+	Returns      []AnyType `json:"returns,omitempty"`
+	Optional     bool      `json:"optional,omitempty"`
+	Experimental bool      `json:"experimental,omitempty"`
+	Redirect     string    `json:"redirect,omitempty"`
+	Handlers     []string  `json:"handlers,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
+}
+
+// Event represents an subscribeable event.
+type Event struct {
+	NameName     string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Parameters   []AnyType `json:"parameters,omitempty"`
+	Experimental bool      `json:"experimental,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
+}
+
+// Enum represents an enumerable value.
+type Enum string
+
+// AnyType is a catch-all struct for properties, parameters, etc.
+type AnyType struct {
+	IDName       string    `json:"id,omitempty"`
+	NameName     string    `json:"name,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Ref          string    `json:"$ref,omitempty"`
+	Properties   []AnyType `json:"properties,omitempty"`
+	Enum         []Enum    `json:"enum,omitempty"`
+	Items        *AnyType  `json:"items,omitempty"`
+	MinItems     int       `json:"minItems,omitempty"`
+	MaxItems     int       `json:"maxItems,omitempty"`
+	Optional     bool      `json:"optional,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
+	Experimental bool      `json:"experimental,omitempty"`
+}
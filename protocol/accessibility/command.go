@@ -53,6 +53,12 @@ func (a *GetPartialAXTreeArgs) SetFetchRelatives(fetchRelatives bool) *GetPartia
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetPartialAXTreeArgs) Validate() error {
+	return nil
+}
+
 // GetPartialAXTreeReply represents the return values for GetPartialAXTree in the Accessibility domain.
 type GetPartialAXTreeReply struct {
 	Nodes []AXNode `json:"nodes"` // The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and children, if requested.
@@ -87,6 +93,12 @@ func (a *GetFullAXTreeArgs) SetFrameID(frameID page.FrameID) *GetFullAXTreeArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetFullAXTreeArgs) Validate() error {
+	return nil
+}
+
 // GetFullAXTreeReply represents the return values for GetFullAXTree in the Accessibility domain.
 type GetFullAXTreeReply struct {
 	Nodes []AXNode `json:"nodes"` // No description.
@@ -111,6 +123,12 @@ func (a *GetRootAXNodeArgs) SetFrameID(frameID page.FrameID) *GetRootAXNodeArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetRootAXNodeArgs) Validate() error {
+	return nil
+}
+
 // GetRootAXNodeReply represents the return values for GetRootAXNode in the Accessibility domain.
 type GetRootAXNodeReply struct {
 	Node AXNode `json:"node"` // No description.
@@ -151,6 +169,12 @@ func (a *GetAXNodeAndAncestorsArgs) SetObjectID(objectID runtime.RemoteObjectID)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAXNodeAndAncestorsArgs) Validate() error {
+	return nil
+}
+
 // GetAXNodeAndAncestorsReply represents the return values for GetAXNodeAndAncestors in the Accessibility domain.
 type GetAXNodeAndAncestorsReply struct {
 	Nodes []AXNode `json:"nodes"` // No description.
@@ -176,6 +200,12 @@ func (a *GetChildAXNodesArgs) SetFrameID(frameID page.FrameID) *GetChildAXNodesA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetChildAXNodesArgs) Validate() error {
+	return nil
+}
+
 // GetChildAXNodesReply represents the return values for GetChildAXNodes in the Accessibility domain.
 type GetChildAXNodesReply struct {
 	Nodes []AXNode `json:"nodes"` // No description.
@@ -232,6 +262,12 @@ func (a *QueryAXTreeArgs) SetRole(role string) *QueryAXTreeArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *QueryAXTreeArgs) Validate() error {
+	return nil
+}
+
 // QueryAXTreeReply represents the return values for QueryAXTree in the Accessibility domain.
 type QueryAXTreeReply struct {
 	Nodes []AXNode `json:"nodes"` // A list of `Accessibility.AXNode` matching the specified attributes, including nodes that are ignored for accessibility.
@@ -0,0 +1,1014 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package accessibility
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AXValueSource) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.Value != nil {
+		w.Key("value")
+		v.Value.EncodeJSON(w)
+	}
+	if v.Attribute != nil {
+		w.Key("attribute")
+		w.String(*v.Attribute)
+	}
+	if v.AttributeValue != nil {
+		w.Key("attributeValue")
+		v.AttributeValue.EncodeJSON(w)
+	}
+	if v.Superseded != nil {
+		w.Key("superseded")
+		w.Bool(*v.Superseded)
+	}
+	if v.NativeSource != "" {
+		w.Key("nativeSource")
+		w.String(string(v.NativeSource))
+	}
+	if v.NativeSourceValue != nil {
+		w.Key("nativeSourceValue")
+		v.NativeSourceValue.EncodeJSON(w)
+	}
+	if v.Invalid != nil {
+		w.Key("invalid")
+		w.Bool(*v.Invalid)
+	}
+	if v.InvalidReason != nil {
+		w.Key("invalidReason")
+		w.String(*v.InvalidReason)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AXValueSource) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = AXValueSourceType(r.String())
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.Value = &p
+			}
+		case "attribute":
+			if r.Null() {
+				v.Attribute = nil
+			} else {
+				p := r.String()
+				v.Attribute = &p
+			}
+		case "attributeValue":
+			if r.Null() {
+				v.AttributeValue = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.AttributeValue = &p
+			}
+		case "superseded":
+			if r.Null() {
+				v.Superseded = nil
+			} else {
+				p := r.Bool()
+				v.Superseded = &p
+			}
+		case "nativeSource":
+			v.NativeSource = AXValueNativeSourceType(r.String())
+		case "nativeSourceValue":
+			if r.Null() {
+				v.NativeSourceValue = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.NativeSourceValue = &p
+			}
+		case "invalid":
+			if r.Null() {
+				v.Invalid = nil
+			} else {
+				p := r.Bool()
+				v.Invalid = &p
+			}
+		case "invalidReason":
+			if r.Null() {
+				v.InvalidReason = nil
+			} else {
+				p := r.String()
+				v.InvalidReason = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AXRelatedNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backendDOMNodeId")
+	w.Int(int(v.BackendDOMNodeID))
+	if v.IDRef != nil {
+		w.Key("idref")
+		w.String(*v.IDRef)
+	}
+	if v.Text != nil {
+		w.Key("text")
+		w.String(*v.Text)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AXRelatedNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backendDOMNodeId":
+			v.BackendDOMNodeID = dom.BackendNodeID(r.Int())
+		case "idref":
+			if r.Null() {
+				v.IDRef = nil
+			} else {
+				p := r.String()
+				v.IDRef = &p
+			}
+		case "text":
+			if r.Null() {
+				v.Text = nil
+			} else {
+				p := r.String()
+				v.Text = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AXProperty) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(string(v.Name))
+	w.Key("value")
+	v.Value.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AXProperty) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = AXPropertyName(r.String())
+		case "value":
+			v.Value.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AXValue) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	if len(v.Value) > 0 {
+		w.Key("value")
+		w.Raw(v.Value)
+	}
+	if len(v.RelatedNodes) > 0 {
+		w.Key("relatedNodes")
+		w.ArrayStart()
+		for i0 := range v.RelatedNodes {
+			v.RelatedNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Sources) > 0 {
+		w.Key("sources")
+		w.ArrayStart()
+		for i0 := range v.Sources {
+			v.Sources[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AXValue) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = AXValueType(r.String())
+		case "value":
+			v.Value = r.Raw()
+		case "relatedNodes":
+			if r.ArrayStart() {
+				v.RelatedNodes = make([]AXRelatedNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXRelatedNode
+					e0.DecodeJSON(r)
+					v.RelatedNodes = append(v.RelatedNodes, e0)
+				}
+			} else {
+				v.RelatedNodes = nil
+			}
+		case "sources":
+			if r.ArrayStart() {
+				v.Sources = make([]AXValueSource, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXValueSource
+					e0.DecodeJSON(r)
+					v.Sources = append(v.Sources, e0)
+				}
+			} else {
+				v.Sources = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AXNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.String(string(v.NodeID))
+	w.Key("ignored")
+	w.Bool(v.Ignored)
+	if len(v.IgnoredReasons) > 0 {
+		w.Key("ignoredReasons")
+		w.ArrayStart()
+		for i0 := range v.IgnoredReasons {
+			v.IgnoredReasons[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Role != nil {
+		w.Key("role")
+		v.Role.EncodeJSON(w)
+	}
+	if v.ChromeRole != nil {
+		w.Key("chromeRole")
+		v.ChromeRole.EncodeJSON(w)
+	}
+	if v.Name != nil {
+		w.Key("name")
+		v.Name.EncodeJSON(w)
+	}
+	if v.Description != nil {
+		w.Key("description")
+		v.Description.EncodeJSON(w)
+	}
+	if v.Value != nil {
+		w.Key("value")
+		v.Value.EncodeJSON(w)
+	}
+	if len(v.Properties) > 0 {
+		w.Key("properties")
+		w.ArrayStart()
+		for i0 := range v.Properties {
+			v.Properties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ParentID != nil {
+		w.Key("parentId")
+		w.String(string(*v.ParentID))
+	}
+	if len(v.ChildIDs) > 0 {
+		w.Key("childIds")
+		w.ArrayStart()
+		for i0 := range v.ChildIDs {
+			w.String(string(v.ChildIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if v.BackendDOMNodeID != nil {
+		w.Key("backendDOMNodeId")
+		w.Int(int(*v.BackendDOMNodeID))
+	}
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AXNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = AXNodeID(r.String())
+		case "ignored":
+			v.Ignored = r.Bool()
+		case "ignoredReasons":
+			if r.ArrayStart() {
+				v.IgnoredReasons = make([]AXProperty, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXProperty
+					e0.DecodeJSON(r)
+					v.IgnoredReasons = append(v.IgnoredReasons, e0)
+				}
+			} else {
+				v.IgnoredReasons = nil
+			}
+		case "role":
+			if r.Null() {
+				v.Role = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.Role = &p
+			}
+		case "chromeRole":
+			if r.Null() {
+				v.ChromeRole = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.ChromeRole = &p
+			}
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.Name = &p
+			}
+		case "description":
+			if r.Null() {
+				v.Description = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.Description = &p
+			}
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				var p AXValue
+				p.DecodeJSON(r)
+				v.Value = &p
+			}
+		case "properties":
+			if r.ArrayStart() {
+				v.Properties = make([]AXProperty, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXProperty
+					e0.DecodeJSON(r)
+					v.Properties = append(v.Properties, e0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		case "parentId":
+			if r.Null() {
+				v.ParentID = nil
+			} else {
+				p := AXNodeID(r.String())
+				v.ParentID = &p
+			}
+		case "childIds":
+			if r.ArrayStart() {
+				v.ChildIDs = make([]AXNodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := AXNodeID(r.String())
+					v.ChildIDs = append(v.ChildIDs, e0)
+				}
+			} else {
+				v.ChildIDs = nil
+			}
+		case "backendDOMNodeId":
+			if r.Null() {
+				v.BackendDOMNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendDOMNodeID = &p
+			}
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPartialAXTreeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.FetchRelatives != nil {
+		w.Key("fetchRelatives")
+		w.Bool(*v.FetchRelatives)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPartialAXTreeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "fetchRelatives":
+			if r.Null() {
+				v.FetchRelatives = nil
+			} else {
+				p := r.Bool()
+				v.FetchRelatives = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPartialAXTreeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPartialAXTreeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]AXNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFullAXTreeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Depth != nil {
+		w.Key("depth")
+		w.Int(*v.Depth)
+	}
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFullAXTreeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "depth":
+			if r.Null() {
+				v.Depth = nil
+			} else {
+				p := r.Int()
+				v.Depth = &p
+			}
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFullAXTreeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFullAXTreeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]AXNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRootAXNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRootAXNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRootAXNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("node")
+	v.Node.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRootAXNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "node":
+			v.Node.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAXNodeAndAncestorsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAXNodeAndAncestorsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAXNodeAndAncestorsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAXNodeAndAncestorsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]AXNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetChildAXNodesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(string(v.ID))
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetChildAXNodesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = AXNodeID(r.String())
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetChildAXNodesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetChildAXNodesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]AXNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QueryAXTreeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.AccessibleName != nil {
+		w.Key("accessibleName")
+		w.String(*v.AccessibleName)
+	}
+	if v.Role != nil {
+		w.Key("role")
+		w.String(*v.Role)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QueryAXTreeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "accessibleName":
+			if r.Null() {
+				v.AccessibleName = nil
+			} else {
+				p := r.String()
+				v.AccessibleName = &p
+			}
+		case "role":
+			if r.Null() {
+				v.Role = nil
+			} else {
+				p := r.String()
+				v.Role = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QueryAXTreeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QueryAXTreeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]AXNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadCompleteReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("root")
+	v.Root.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadCompleteReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "root":
+			v.Root.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodesUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodesUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]AXNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 AXNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
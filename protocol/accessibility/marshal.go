@@ -0,0 +1,199 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build cdpjson
+
+package accessibility
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v AXValueSource) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AXValueSource) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AXRelatedNode) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AXRelatedNode) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AXProperty) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AXProperty) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AXValue) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AXValue) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AXNode) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AXNode) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetPartialAXTreeArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetPartialAXTreeArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetPartialAXTreeReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetPartialAXTreeReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetFullAXTreeArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetFullAXTreeArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetFullAXTreeReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetFullAXTreeReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetRootAXNodeArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetRootAXNodeArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetRootAXNodeReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetRootAXNodeReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetAXNodeAndAncestorsArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetAXNodeAndAncestorsArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetAXNodeAndAncestorsReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetAXNodeAndAncestorsReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetChildAXNodesArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetChildAXNodesArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetChildAXNodesReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetChildAXNodesReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v QueryAXTreeArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *QueryAXTreeArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v QueryAXTreeReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *QueryAXTreeReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v LoadCompleteReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *LoadCompleteReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v NodesUpdatedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *NodesUpdatedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
@@ -0,0 +1,195 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package accessibility
+
+import (
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// GetPartialAXTreeOption sets an optional argument of GetPartialAXTreeArgs.
+type GetPartialAXTreeOption func(*GetPartialAXTreeArgs)
+
+// NewGetPartialAXTreeArgsWith initializes GetPartialAXTreeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetPartialAXTreeArgsWith(opts ...GetPartialAXTreeOption) *GetPartialAXTreeArgs {
+	args := NewGetPartialAXTreeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetPartialAXTreeWithNodeID sets the NodeID optional argument, see
+// GetPartialAXTreeArgs.SetNodeID.
+func GetPartialAXTreeWithNodeID(v dom.NodeID) GetPartialAXTreeOption {
+	return func(a *GetPartialAXTreeArgs) { a.SetNodeID(v) }
+}
+
+// GetPartialAXTreeWithBackendNodeID sets the BackendNodeID optional argument, see
+// GetPartialAXTreeArgs.SetBackendNodeID.
+func GetPartialAXTreeWithBackendNodeID(v dom.BackendNodeID) GetPartialAXTreeOption {
+	return func(a *GetPartialAXTreeArgs) { a.SetBackendNodeID(v) }
+}
+
+// GetPartialAXTreeWithObjectID sets the ObjectID optional argument, see
+// GetPartialAXTreeArgs.SetObjectID.
+func GetPartialAXTreeWithObjectID(v runtime.RemoteObjectID) GetPartialAXTreeOption {
+	return func(a *GetPartialAXTreeArgs) { a.SetObjectID(v) }
+}
+
+// GetPartialAXTreeWithFetchRelatives sets the FetchRelatives optional argument, see
+// GetPartialAXTreeArgs.SetFetchRelatives.
+func GetPartialAXTreeWithFetchRelatives(v bool) GetPartialAXTreeOption {
+	return func(a *GetPartialAXTreeArgs) { a.SetFetchRelatives(v) }
+}
+
+// GetFullAXTreeOption sets an optional argument of GetFullAXTreeArgs.
+type GetFullAXTreeOption func(*GetFullAXTreeArgs)
+
+// NewGetFullAXTreeArgsWith initializes GetFullAXTreeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetFullAXTreeArgsWith(opts ...GetFullAXTreeOption) *GetFullAXTreeArgs {
+	args := NewGetFullAXTreeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetFullAXTreeWithDepth sets the Depth optional argument, see
+// GetFullAXTreeArgs.SetDepth.
+func GetFullAXTreeWithDepth(v int) GetFullAXTreeOption {
+	return func(a *GetFullAXTreeArgs) { a.SetDepth(v) }
+}
+
+// GetFullAXTreeWithFrameID sets the FrameID optional argument, see
+// GetFullAXTreeArgs.SetFrameID.
+func GetFullAXTreeWithFrameID(v page.FrameID) GetFullAXTreeOption {
+	return func(a *GetFullAXTreeArgs) { a.SetFrameID(v) }
+}
+
+// GetRootAXNodeOption sets an optional argument of GetRootAXNodeArgs.
+type GetRootAXNodeOption func(*GetRootAXNodeArgs)
+
+// NewGetRootAXNodeArgsWith initializes GetRootAXNodeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetRootAXNodeArgsWith(opts ...GetRootAXNodeOption) *GetRootAXNodeArgs {
+	args := NewGetRootAXNodeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetRootAXNodeWithFrameID sets the FrameID optional argument, see
+// GetRootAXNodeArgs.SetFrameID.
+func GetRootAXNodeWithFrameID(v page.FrameID) GetRootAXNodeOption {
+	return func(a *GetRootAXNodeArgs) { a.SetFrameID(v) }
+}
+
+// GetAXNodeAndAncestorsOption sets an optional argument of GetAXNodeAndAncestorsArgs.
+type GetAXNodeAndAncestorsOption func(*GetAXNodeAndAncestorsArgs)
+
+// NewGetAXNodeAndAncestorsArgsWith initializes GetAXNodeAndAncestorsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetAXNodeAndAncestorsArgsWith(opts ...GetAXNodeAndAncestorsOption) *GetAXNodeAndAncestorsArgs {
+	args := NewGetAXNodeAndAncestorsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetAXNodeAndAncestorsWithNodeID sets the NodeID optional argument, see
+// GetAXNodeAndAncestorsArgs.SetNodeID.
+func GetAXNodeAndAncestorsWithNodeID(v dom.NodeID) GetAXNodeAndAncestorsOption {
+	return func(a *GetAXNodeAndAncestorsArgs) { a.SetNodeID(v) }
+}
+
+// GetAXNodeAndAncestorsWithBackendNodeID sets the BackendNodeID optional argument, see
+// GetAXNodeAndAncestorsArgs.SetBackendNodeID.
+func GetAXNodeAndAncestorsWithBackendNodeID(v dom.BackendNodeID) GetAXNodeAndAncestorsOption {
+	return func(a *GetAXNodeAndAncestorsArgs) { a.SetBackendNodeID(v) }
+}
+
+// GetAXNodeAndAncestorsWithObjectID sets the ObjectID optional argument, see
+// GetAXNodeAndAncestorsArgs.SetObjectID.
+func GetAXNodeAndAncestorsWithObjectID(v runtime.RemoteObjectID) GetAXNodeAndAncestorsOption {
+	return func(a *GetAXNodeAndAncestorsArgs) { a.SetObjectID(v) }
+}
+
+// GetChildAXNodesOption sets an optional argument of GetChildAXNodesArgs.
+type GetChildAXNodesOption func(*GetChildAXNodesArgs)
+
+// NewGetChildAXNodesArgsWith initializes GetChildAXNodesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetChildAXNodesArgsWith(id AXNodeID, opts ...GetChildAXNodesOption) *GetChildAXNodesArgs {
+	args := NewGetChildAXNodesArgs(id)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetChildAXNodesWithFrameID sets the FrameID optional argument, see
+// GetChildAXNodesArgs.SetFrameID.
+func GetChildAXNodesWithFrameID(v page.FrameID) GetChildAXNodesOption {
+	return func(a *GetChildAXNodesArgs) { a.SetFrameID(v) }
+}
+
+// QueryAXTreeOption sets an optional argument of QueryAXTreeArgs.
+type QueryAXTreeOption func(*QueryAXTreeArgs)
+
+// NewQueryAXTreeArgsWith initializes QueryAXTreeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewQueryAXTreeArgsWith(opts ...QueryAXTreeOption) *QueryAXTreeArgs {
+	args := NewQueryAXTreeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// QueryAXTreeWithNodeID sets the NodeID optional argument, see
+// QueryAXTreeArgs.SetNodeID.
+func QueryAXTreeWithNodeID(v dom.NodeID) QueryAXTreeOption {
+	return func(a *QueryAXTreeArgs) { a.SetNodeID(v) }
+}
+
+// QueryAXTreeWithBackendNodeID sets the BackendNodeID optional argument, see
+// QueryAXTreeArgs.SetBackendNodeID.
+func QueryAXTreeWithBackendNodeID(v dom.BackendNodeID) QueryAXTreeOption {
+	return func(a *QueryAXTreeArgs) { a.SetBackendNodeID(v) }
+}
+
+// QueryAXTreeWithObjectID sets the ObjectID optional argument, see
+// QueryAXTreeArgs.SetObjectID.
+func QueryAXTreeWithObjectID(v runtime.RemoteObjectID) QueryAXTreeOption {
+	return func(a *QueryAXTreeArgs) { a.SetObjectID(v) }
+}
+
+// QueryAXTreeWithAccessibleName sets the AccessibleName optional argument, see
+// QueryAXTreeArgs.SetAccessibleName.
+func QueryAXTreeWithAccessibleName(v string) QueryAXTreeOption {
+	return func(a *QueryAXTreeArgs) { a.SetAccessibleName(v) }
+}
+
+// QueryAXTreeWithRole sets the Role optional argument, see
+// QueryAXTreeArgs.SetRole.
+func QueryAXTreeWithRole(v string) QueryAXTreeOption {
+	return func(a *QueryAXTreeArgs) { a.SetRole(v) }
+}
@@ -3,6 +3,8 @@
 package animation
 
 import (
+	"errors"
+
 	"github.com/mafredri/cdp/protocol/runtime"
 )
 
@@ -18,6 +20,12 @@ func NewGetCurrentTimeArgs(id string) *GetCurrentTimeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetCurrentTimeArgs) Validate() error {
+	return nil
+}
+
 // GetCurrentTimeReply represents the return values for GetCurrentTime in the Animation domain.
 type GetCurrentTimeReply struct {
 	CurrentTime float64 `json:"currentTime"` // Current time of the page.
@@ -40,6 +48,15 @@ func NewReleaseAnimationsArgs(animations []string) *ReleaseAnimationsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReleaseAnimationsArgs) Validate() error {
+	if a.Animations == nil {
+		return errors.New("animation.ReleaseAnimationsArgs: Animations is required")
+	}
+	return nil
+}
+
 // ResolveAnimationArgs represents the arguments for ResolveAnimation in the Animation domain.
 type ResolveAnimationArgs struct {
 	AnimationID string `json:"animationId"` // Animation id.
@@ -52,6 +69,12 @@ func NewResolveAnimationArgs(animationID string) *ResolveAnimationArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResolveAnimationArgs) Validate() error {
+	return nil
+}
+
 // ResolveAnimationReply represents the return values for ResolveAnimation in the Animation domain.
 type ResolveAnimationReply struct {
 	RemoteObject runtime.RemoteObject `json:"remoteObject"` // Corresponding remote object.
@@ -71,6 +94,15 @@ func NewSeekAnimationsArgs(animations []string, currentTime float64) *SeekAnimat
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SeekAnimationsArgs) Validate() error {
+	if a.Animations == nil {
+		return errors.New("animation.SeekAnimationsArgs: Animations is required")
+	}
+	return nil
+}
+
 // SetPausedArgs represents the arguments for SetPaused in the Animation domain.
 type SetPausedArgs struct {
 	Animations []string `json:"animations"` // Animations to set the pause state of.
@@ -85,6 +117,15 @@ func NewSetPausedArgs(animations []string, paused bool) *SetPausedArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPausedArgs) Validate() error {
+	if a.Animations == nil {
+		return errors.New("animation.SetPausedArgs: Animations is required")
+	}
+	return nil
+}
+
 // SetPlaybackRateArgs represents the arguments for SetPlaybackRate in the Animation domain.
 type SetPlaybackRateArgs struct {
 	PlaybackRate float64 `json:"playbackRate"` // Playback rate for animations on page
@@ -97,6 +138,12 @@ func NewSetPlaybackRateArgs(playbackRate float64) *SetPlaybackRateArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPlaybackRateArgs) Validate() error {
+	return nil
+}
+
 // SetTimingArgs represents the arguments for SetTiming in the Animation domain.
 type SetTimingArgs struct {
 	AnimationID string  `json:"animationId"` // Animation id.
@@ -112,3 +159,9 @@ func NewSetTimingArgs(animationID string, duration float64, delay float64) *SetT
 	args.Delay = delay
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTimingArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build cdpjson
+
+package animation
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v Animation) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Animation) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ViewOrScrollTimeline) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ViewOrScrollTimeline) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Effect) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Effect) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v KeyframesRule) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *KeyframesRule) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v KeyframeStyle) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *KeyframeStyle) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetCurrentTimeArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetCurrentTimeArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetCurrentTimeReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetCurrentTimeReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetPlaybackRateReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetPlaybackRateReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ReleaseAnimationsArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ReleaseAnimationsArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ResolveAnimationArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ResolveAnimationArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ResolveAnimationReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ResolveAnimationReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SeekAnimationsArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SeekAnimationsArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SetPausedArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SetPausedArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SetPlaybackRateArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SetPlaybackRateArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SetTimingArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SetTimingArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CanceledReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CanceledReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CreatedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CreatedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v StartedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *StartedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v UpdatedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *UpdatedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
@@ -3,6 +3,9 @@
 package audits
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/network"
 )
 
@@ -39,6 +42,17 @@ func (a *GetEncodedResponseArgs) SetSizeOnly(sizeOnly bool) *GetEncodedResponseA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetEncodedResponseArgs) Validate() error {
+	switch a.Encoding {
+	case "webp", "jpeg", "png":
+	default:
+		return errors.New("audits.GetEncodedResponseArgs: Encoding: invalid value " + strconv.Quote(a.Encoding))
+	}
+	return nil
+}
+
 // GetEncodedResponseReply represents the return values for GetEncodedResponse in the Audits domain.
 type GetEncodedResponseReply struct {
 	Body         []byte `json:"body,omitempty"` // The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON)
@@ -65,6 +79,12 @@ func (a *CheckContrastArgs) SetReportAAA(reportAAA bool) *CheckContrastArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CheckContrastArgs) Validate() error {
+	return nil
+}
+
 // CheckFormsIssuesReply represents the return values for CheckFormsIssues in the Audits domain.
 type CheckFormsIssuesReply struct {
 	FormIssues []GenericIssueDetails `json:"formIssues"` // No description.
@@ -0,0 +1,2064 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AffectedCookie) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("path")
+	w.String(v.Path)
+	w.Key("domain")
+	w.String(v.Domain)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AffectedCookie) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "path":
+			v.Path = r.String()
+		case "domain":
+			v.Domain = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AffectedRequest) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.RequestID != nil {
+		w.Key("requestId")
+		w.String(string(*v.RequestID))
+	}
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AffectedRequest) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			if r.Null() {
+				v.RequestID = nil
+			} else {
+				p := network.RequestID(r.String())
+				v.RequestID = &p
+			}
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AffectedFrame) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AffectedFrame) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CookieIssueInsight) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.TableEntryURL != nil {
+		w.Key("tableEntryUrl")
+		w.String(*v.TableEntryURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CookieIssueInsight) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = InsightType(r.String())
+		case "tableEntryUrl":
+			if r.Null() {
+				v.TableEntryURL = nil
+			} else {
+				p := r.String()
+				v.TableEntryURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CookieIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Cookie != nil {
+		w.Key("cookie")
+		v.Cookie.EncodeJSON(w)
+	}
+	if v.RawCookieLine != nil {
+		w.Key("rawCookieLine")
+		w.String(*v.RawCookieLine)
+	}
+	w.Key("cookieWarningReasons")
+	if v.CookieWarningReasons == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CookieWarningReasons {
+			w.String(string(v.CookieWarningReasons[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.Key("cookieExclusionReasons")
+	if v.CookieExclusionReasons == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CookieExclusionReasons {
+			w.String(string(v.CookieExclusionReasons[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.Key("operation")
+	w.String(string(v.Operation))
+	if v.SiteForCookies != nil {
+		w.Key("siteForCookies")
+		w.String(*v.SiteForCookies)
+	}
+	if v.CookieURL != nil {
+		w.Key("cookieUrl")
+		w.String(*v.CookieURL)
+	}
+	if v.Request != nil {
+		w.Key("request")
+		v.Request.EncodeJSON(w)
+	}
+	if v.Insight != nil {
+		w.Key("insight")
+		v.Insight.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CookieIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookie":
+			if r.Null() {
+				v.Cookie = nil
+			} else {
+				var p AffectedCookie
+				p.DecodeJSON(r)
+				v.Cookie = &p
+			}
+		case "rawCookieLine":
+			if r.Null() {
+				v.RawCookieLine = nil
+			} else {
+				p := r.String()
+				v.RawCookieLine = &p
+			}
+		case "cookieWarningReasons":
+			if r.ArrayStart() {
+				v.CookieWarningReasons = make([]CookieWarningReason, 0, 4)
+				for r.ArrayNext() {
+					e0 := CookieWarningReason(r.String())
+					v.CookieWarningReasons = append(v.CookieWarningReasons, e0)
+				}
+			} else {
+				v.CookieWarningReasons = nil
+			}
+		case "cookieExclusionReasons":
+			if r.ArrayStart() {
+				v.CookieExclusionReasons = make([]CookieExclusionReason, 0, 4)
+				for r.ArrayNext() {
+					e0 := CookieExclusionReason(r.String())
+					v.CookieExclusionReasons = append(v.CookieExclusionReasons, e0)
+				}
+			} else {
+				v.CookieExclusionReasons = nil
+			}
+		case "operation":
+			v.Operation = CookieOperation(r.String())
+		case "siteForCookies":
+			if r.Null() {
+				v.SiteForCookies = nil
+			} else {
+				p := r.String()
+				v.SiteForCookies = &p
+			}
+		case "cookieUrl":
+			if r.Null() {
+				v.CookieURL = nil
+			} else {
+				p := r.String()
+				v.CookieURL = &p
+			}
+		case "request":
+			if r.Null() {
+				v.Request = nil
+			} else {
+				var p AffectedRequest
+				p.DecodeJSON(r)
+				v.Request = &p
+			}
+		case "insight":
+			if r.Null() {
+				v.Insight = nil
+			} else {
+				var p CookieIssueInsight
+				p.DecodeJSON(r)
+				v.Insight = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MixedContentIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ResourceType != "" {
+		w.Key("resourceType")
+		w.String(string(v.ResourceType))
+	}
+	w.Key("resolutionStatus")
+	w.String(string(v.ResolutionStatus))
+	w.Key("insecureURL")
+	w.String(v.InsecureURL)
+	w.Key("mainResourceURL")
+	w.String(v.MainResourceURL)
+	if v.Request != nil {
+		w.Key("request")
+		v.Request.EncodeJSON(w)
+	}
+	if v.Frame != nil {
+		w.Key("frame")
+		v.Frame.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MixedContentIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "resourceType":
+			v.ResourceType = MixedContentResourceType(r.String())
+		case "resolutionStatus":
+			v.ResolutionStatus = MixedContentResolutionStatus(r.String())
+		case "insecureURL":
+			v.InsecureURL = r.String()
+		case "mainResourceURL":
+			v.MainResourceURL = r.String()
+		case "request":
+			if r.Null() {
+				v.Request = nil
+			} else {
+				var p AffectedRequest
+				p.DecodeJSON(r)
+				v.Request = &p
+			}
+		case "frame":
+			if r.Null() {
+				v.Frame = nil
+			} else {
+				var p AffectedFrame
+				p.DecodeJSON(r)
+				v.Frame = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BlockedByResponseIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	if v.ParentFrame != nil {
+		w.Key("parentFrame")
+		v.ParentFrame.EncodeJSON(w)
+	}
+	if v.BlockedFrame != nil {
+		w.Key("blockedFrame")
+		v.BlockedFrame.EncodeJSON(w)
+	}
+	w.Key("reason")
+	w.String(string(v.Reason))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BlockedByResponseIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "request":
+			v.Request.DecodeJSON(r)
+		case "parentFrame":
+			if r.Null() {
+				v.ParentFrame = nil
+			} else {
+				var p AffectedFrame
+				p.DecodeJSON(r)
+				v.ParentFrame = &p
+			}
+		case "blockedFrame":
+			if r.Null() {
+				v.BlockedFrame = nil
+			} else {
+				var p AffectedFrame
+				p.DecodeJSON(r)
+				v.BlockedFrame = &p
+			}
+		case "reason":
+			v.Reason = BlockedByResponseReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HeavyAdIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("resolution")
+	w.String(string(v.Resolution))
+	w.Key("reason")
+	w.String(string(v.Reason))
+	w.Key("frame")
+	v.Frame.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HeavyAdIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "resolution":
+			v.Resolution = HeavyAdResolutionStatus(r.String())
+		case "reason":
+			v.Reason = HeavyAdReason(r.String())
+		case "frame":
+			v.Frame.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SourceCodeLocation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ScriptID != nil {
+		w.Key("scriptId")
+		w.String(string(*v.ScriptID))
+	}
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SourceCodeLocation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			if r.Null() {
+				v.ScriptID = nil
+			} else {
+				p := runtime.ScriptID(r.String())
+				v.ScriptID = &p
+			}
+		case "url":
+			v.URL = r.String()
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContentSecurityPolicyIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BlockedURL != nil {
+		w.Key("blockedURL")
+		w.String(*v.BlockedURL)
+	}
+	w.Key("violatedDirective")
+	w.String(v.ViolatedDirective)
+	w.Key("isReportOnly")
+	w.Bool(v.IsReportOnly)
+	w.Key("contentSecurityPolicyViolationType")
+	w.String(string(v.ContentSecurityPolicyViolationType))
+	if v.FrameAncestor != nil {
+		w.Key("frameAncestor")
+		v.FrameAncestor.EncodeJSON(w)
+	}
+	if v.SourceCodeLocation != nil {
+		w.Key("sourceCodeLocation")
+		v.SourceCodeLocation.EncodeJSON(w)
+	}
+	if v.ViolatingNodeID != nil {
+		w.Key("violatingNodeId")
+		w.Int(int(*v.ViolatingNodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContentSecurityPolicyIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "blockedURL":
+			if r.Null() {
+				v.BlockedURL = nil
+			} else {
+				p := r.String()
+				v.BlockedURL = &p
+			}
+		case "violatedDirective":
+			v.ViolatedDirective = r.String()
+		case "isReportOnly":
+			v.IsReportOnly = r.Bool()
+		case "contentSecurityPolicyViolationType":
+			v.ContentSecurityPolicyViolationType = ContentSecurityPolicyViolationType(r.String())
+		case "frameAncestor":
+			if r.Null() {
+				v.FrameAncestor = nil
+			} else {
+				var p AffectedFrame
+				p.DecodeJSON(r)
+				v.FrameAncestor = &p
+			}
+		case "sourceCodeLocation":
+			if r.Null() {
+				v.SourceCodeLocation = nil
+			} else {
+				var p SourceCodeLocation
+				p.DecodeJSON(r)
+				v.SourceCodeLocation = &p
+			}
+		case "violatingNodeId":
+			if r.Null() {
+				v.ViolatingNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.ViolatingNodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedArrayBufferIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sourceCodeLocation")
+	v.SourceCodeLocation.EncodeJSON(w)
+	w.Key("isWarning")
+	w.Bool(v.IsWarning)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedArrayBufferIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.DecodeJSON(r)
+		case "isWarning":
+			v.IsWarning = r.Bool()
+		case "type":
+			v.Type = SharedArrayBufferIssueType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LowTextContrastIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("violatingNodeId")
+	w.Int(int(v.ViolatingNodeID))
+	w.Key("violatingNodeSelector")
+	w.String(v.ViolatingNodeSelector)
+	w.Key("contrastRatio")
+	w.Float(v.ContrastRatio)
+	w.Key("thresholdAA")
+	w.Float(v.ThresholdAA)
+	w.Key("thresholdAAA")
+	w.Float(v.ThresholdAAA)
+	w.Key("fontSize")
+	w.String(v.FontSize)
+	w.Key("fontWeight")
+	w.String(v.FontWeight)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LowTextContrastIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "violatingNodeId":
+			v.ViolatingNodeID = dom.BackendNodeID(r.Int())
+		case "violatingNodeSelector":
+			v.ViolatingNodeSelector = r.String()
+		case "contrastRatio":
+			v.ContrastRatio = r.Float()
+		case "thresholdAA":
+			v.ThresholdAA = r.Float()
+		case "thresholdAAA":
+			v.ThresholdAAA = r.Float()
+		case "fontSize":
+			v.FontSize = r.String()
+		case "fontWeight":
+			v.FontWeight = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CORSIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("corsErrorStatus")
+	v.CORSErrorStatus.EncodeJSON(w)
+	w.Key("isWarning")
+	w.Bool(v.IsWarning)
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	if v.Location != nil {
+		w.Key("location")
+		v.Location.EncodeJSON(w)
+	}
+	if v.InitiatorOrigin != nil {
+		w.Key("initiatorOrigin")
+		w.String(*v.InitiatorOrigin)
+	}
+	if v.ResourceIPAddressSpace != nil {
+		w.Key("resourceIPAddressSpace")
+		w.String(string(*v.ResourceIPAddressSpace))
+	}
+	if v.ClientSecurityState != nil {
+		w.Key("clientSecurityState")
+		v.ClientSecurityState.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CORSIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "corsErrorStatus":
+			v.CORSErrorStatus.DecodeJSON(r)
+		case "isWarning":
+			v.IsWarning = r.Bool()
+		case "request":
+			v.Request.DecodeJSON(r)
+		case "location":
+			if r.Null() {
+				v.Location = nil
+			} else {
+				var p SourceCodeLocation
+				p.DecodeJSON(r)
+				v.Location = &p
+			}
+		case "initiatorOrigin":
+			if r.Null() {
+				v.InitiatorOrigin = nil
+			} else {
+				p := r.String()
+				v.InitiatorOrigin = &p
+			}
+		case "resourceIPAddressSpace":
+			if r.Null() {
+				v.ResourceIPAddressSpace = nil
+			} else {
+				p := network.IPAddressSpace(r.String())
+				v.ResourceIPAddressSpace = &p
+			}
+		case "clientSecurityState":
+			if r.Null() {
+				v.ClientSecurityState = nil
+			} else {
+				var p network.ClientSecurityState
+				p.DecodeJSON(r)
+				v.ClientSecurityState = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("violationType")
+	w.String(string(v.ViolationType))
+	if v.Request != nil {
+		w.Key("request")
+		v.Request.EncodeJSON(w)
+	}
+	if v.ViolatingNodeID != nil {
+		w.Key("violatingNodeId")
+		w.Int(int(*v.ViolatingNodeID))
+	}
+	if v.InvalidParameter != nil {
+		w.Key("invalidParameter")
+		w.String(*v.InvalidParameter)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "violationType":
+			v.ViolationType = AttributionReportingIssueType(r.String())
+		case "request":
+			if r.Null() {
+				v.Request = nil
+			} else {
+				var p AffectedRequest
+				p.DecodeJSON(r)
+				v.Request = &p
+			}
+		case "violatingNodeId":
+			if r.Null() {
+				v.ViolatingNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.ViolatingNodeID = &p
+			}
+		case "invalidParameter":
+			if r.Null() {
+				v.InvalidParameter = nil
+			} else {
+				p := r.String()
+				v.InvalidParameter = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QuirksModeIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isLimitedQuirksMode")
+	w.Bool(v.IsLimitedQuirksMode)
+	w.Key("documentNodeId")
+	w.Int(int(v.DocumentNodeID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QuirksModeIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isLimitedQuirksMode":
+			v.IsLimitedQuirksMode = r.Bool()
+		case "documentNodeId":
+			v.DocumentNodeID = dom.BackendNodeID(r.Int())
+		case "url":
+			v.URL = r.String()
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NavigatorUserAgentIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if v.Location != nil {
+		w.Key("location")
+		v.Location.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NavigatorUserAgentIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "location":
+			if r.Null() {
+				v.Location = nil
+			} else {
+				var p SourceCodeLocation
+				p.DecodeJSON(r)
+				v.Location = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedDictionaryIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sharedDictionaryError")
+	w.String(string(v.SharedDictionaryError))
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedDictionaryIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sharedDictionaryError":
+			v.SharedDictionaryError = SharedDictionaryError(r.String())
+		case "request":
+			v.Request.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SRIMessageSignatureIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("error")
+	w.String(string(v.Error))
+	w.Key("signatureBase")
+	w.String(v.SignatureBase)
+	w.Key("integrityAssertions")
+	if v.IntegrityAssertions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.IntegrityAssertions {
+			w.String(v.IntegrityAssertions[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SRIMessageSignatureIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "error":
+			v.Error = SRIMessageSignatureError(r.String())
+		case "signatureBase":
+			v.SignatureBase = r.String()
+		case "integrityAssertions":
+			if r.ArrayStart() {
+				v.IntegrityAssertions = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.IntegrityAssertions = append(v.IntegrityAssertions, e0)
+				}
+			} else {
+				v.IntegrityAssertions = nil
+			}
+		case "request":
+			v.Request.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UnencodedDigestIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("error")
+	w.String(string(v.Error))
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UnencodedDigestIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "error":
+			v.Error = UnencodedDigestError(r.String())
+		case "request":
+			v.Request.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GenericIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("errorType")
+	w.String(string(v.ErrorType))
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	if v.ViolatingNodeID != nil {
+		w.Key("violatingNodeId")
+		w.Int(int(*v.ViolatingNodeID))
+	}
+	if v.ViolatingNodeAttribute != nil {
+		w.Key("violatingNodeAttribute")
+		w.String(*v.ViolatingNodeAttribute)
+	}
+	if v.Request != nil {
+		w.Key("request")
+		v.Request.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GenericIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "errorType":
+			v.ErrorType = GenericIssueErrorType(r.String())
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		case "violatingNodeId":
+			if r.Null() {
+				v.ViolatingNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.ViolatingNodeID = &p
+			}
+		case "violatingNodeAttribute":
+			if r.Null() {
+				v.ViolatingNodeAttribute = nil
+			} else {
+				p := r.String()
+				v.ViolatingNodeAttribute = &p
+			}
+		case "request":
+			if r.Null() {
+				v.Request = nil
+			} else {
+				var p AffectedRequest
+				p.DecodeJSON(r)
+				v.Request = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeprecationIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.AffectedFrame != nil {
+		w.Key("affectedFrame")
+		v.AffectedFrame.EncodeJSON(w)
+	}
+	w.Key("sourceCodeLocation")
+	v.SourceCodeLocation.EncodeJSON(w)
+	w.Key("type")
+	w.String(v.Type)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeprecationIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "affectedFrame":
+			if r.Null() {
+				v.AffectedFrame = nil
+			} else {
+				var p AffectedFrame
+				p.DecodeJSON(r)
+				v.AffectedFrame = &p
+			}
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.DecodeJSON(r)
+		case "type":
+			v.Type = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BounceTrackingIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("trackingSites")
+	if v.TrackingSites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TrackingSites {
+			w.String(v.TrackingSites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BounceTrackingIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "trackingSites":
+			if r.ArrayStart() {
+				v.TrackingSites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.TrackingSites = append(v.TrackingSites, e0)
+				}
+			} else {
+				v.TrackingSites = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CookieDeprecationMetadataIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("allowedSites")
+	if v.AllowedSites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AllowedSites {
+			w.String(v.AllowedSites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("optOutPercentage")
+	w.Float(v.OptOutPercentage)
+	w.Key("isOptOutTopLevel")
+	w.Bool(v.IsOptOutTopLevel)
+	w.Key("operation")
+	w.String(string(v.Operation))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CookieDeprecationMetadataIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "allowedSites":
+			if r.ArrayStart() {
+				v.AllowedSites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.AllowedSites = append(v.AllowedSites, e0)
+				}
+			} else {
+				v.AllowedSites = nil
+			}
+		case "optOutPercentage":
+			v.OptOutPercentage = r.Float()
+		case "isOptOutTopLevel":
+			v.IsOptOutTopLevel = r.Bool()
+		case "operation":
+			v.Operation = CookieOperation(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FederatedAuthRequestIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("federatedAuthRequestIssueReason")
+	w.String(string(v.FederatedAuthRequestIssueReason))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FederatedAuthRequestIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "federatedAuthRequestIssueReason":
+			v.FederatedAuthRequestIssueReason = FederatedAuthRequestIssueReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FederatedAuthUserInfoRequestIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("federatedAuthUserInfoRequestIssueReason")
+	w.String(string(v.FederatedAuthUserInfoRequestIssueReason))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FederatedAuthUserInfoRequestIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "federatedAuthUserInfoRequestIssueReason":
+			v.FederatedAuthUserInfoRequestIssueReason = FederatedAuthUserInfoRequestIssueReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClientHintIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sourceCodeLocation")
+	v.SourceCodeLocation.EncodeJSON(w)
+	w.Key("clientHintIssueReason")
+	w.String(string(v.ClientHintIssueReason))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClientHintIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.DecodeJSON(r)
+		case "clientHintIssueReason":
+			v.ClientHintIssueReason = ClientHintIssueReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FailedRequestInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("failureMessage")
+	w.String(v.FailureMessage)
+	if v.RequestID != nil {
+		w.Key("requestId")
+		w.String(string(*v.RequestID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FailedRequestInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "failureMessage":
+			v.FailureMessage = r.String()
+		case "requestId":
+			if r.Null() {
+				v.RequestID = nil
+			} else {
+				p := network.RequestID(r.String())
+				v.RequestID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PartitioningBlobURLIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("partitioningBlobURLInfo")
+	w.String(string(v.PartitioningBlobURLInfo))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PartitioningBlobURLIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "partitioningBlobURLInfo":
+			v.PartitioningBlobURLInfo = PartitioningBlobURLInfo(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ElementAccessibilityIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("elementAccessibilityIssueReason")
+	w.String(string(v.ElementAccessibilityIssueReason))
+	w.Key("hasDisallowedAttributes")
+	w.Bool(v.HasDisallowedAttributes)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ElementAccessibilityIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.BackendNodeID(r.Int())
+		case "elementAccessibilityIssueReason":
+			v.ElementAccessibilityIssueReason = ElementAccessibilityIssueReason(r.String())
+		case "hasDisallowedAttributes":
+			v.HasDisallowedAttributes = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StylesheetLoadingIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sourceCodeLocation")
+	v.SourceCodeLocation.EncodeJSON(w)
+	w.Key("styleSheetLoadingIssueReason")
+	w.String(string(v.StyleSheetLoadingIssueReason))
+	if v.FailedRequestInfo != nil {
+		w.Key("failedRequestInfo")
+		v.FailedRequestInfo.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StylesheetLoadingIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.DecodeJSON(r)
+		case "styleSheetLoadingIssueReason":
+			v.StyleSheetLoadingIssueReason = StyleSheetLoadingIssueReason(r.String())
+		case "failedRequestInfo":
+			if r.Null() {
+				v.FailedRequestInfo = nil
+			} else {
+				var p FailedRequestInfo
+				p.DecodeJSON(r)
+				v.FailedRequestInfo = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PropertyRuleIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sourceCodeLocation")
+	v.SourceCodeLocation.EncodeJSON(w)
+	w.Key("propertyRuleIssueReason")
+	w.String(string(v.PropertyRuleIssueReason))
+	if v.PropertyValue != nil {
+		w.Key("propertyValue")
+		w.String(*v.PropertyValue)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PropertyRuleIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.DecodeJSON(r)
+		case "propertyRuleIssueReason":
+			v.PropertyRuleIssueReason = PropertyRuleIssueReason(r.String())
+		case "propertyValue":
+			if r.Null() {
+				v.PropertyValue = nil
+			} else {
+				p := r.String()
+				v.PropertyValue = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UserReidentificationIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.Request != nil {
+		w.Key("request")
+		v.Request.EncodeJSON(w)
+	}
+	if v.SourceCodeLocation != nil {
+		w.Key("sourceCodeLocation")
+		v.SourceCodeLocation.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UserReidentificationIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = UserReidentificationIssueType(r.String())
+		case "request":
+			if r.Null() {
+				v.Request = nil
+			} else {
+				var p AffectedRequest
+				p.DecodeJSON(r)
+				v.Request = &p
+			}
+		case "sourceCodeLocation":
+			if r.Null() {
+				v.SourceCodeLocation = nil
+			} else {
+				var p SourceCodeLocation
+				p.DecodeJSON(r)
+				v.SourceCodeLocation = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PermissionElementIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("issueType")
+	w.String(string(v.IssueType))
+	if v.Type != nil {
+		w.Key("type")
+		w.String(*v.Type)
+	}
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.IsWarning != nil {
+		w.Key("isWarning")
+		w.Bool(*v.IsWarning)
+	}
+	if v.PermissionName != nil {
+		w.Key("permissionName")
+		w.String(*v.PermissionName)
+	}
+	if v.OccluderNodeInfo != nil {
+		w.Key("occluderNodeInfo")
+		w.String(*v.OccluderNodeInfo)
+	}
+	if v.OccluderParentNodeInfo != nil {
+		w.Key("occluderParentNodeInfo")
+		w.String(*v.OccluderParentNodeInfo)
+	}
+	if v.DisableReason != nil {
+		w.Key("disableReason")
+		w.String(*v.DisableReason)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PermissionElementIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "issueType":
+			v.IssueType = PermissionElementIssueType(r.String())
+		case "type":
+			if r.Null() {
+				v.Type = nil
+			} else {
+				p := r.String()
+				v.Type = &p
+			}
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "isWarning":
+			if r.Null() {
+				v.IsWarning = nil
+			} else {
+				p := r.Bool()
+				v.IsWarning = &p
+			}
+		case "permissionName":
+			if r.Null() {
+				v.PermissionName = nil
+			} else {
+				p := r.String()
+				v.PermissionName = &p
+			}
+		case "occluderNodeInfo":
+			if r.Null() {
+				v.OccluderNodeInfo = nil
+			} else {
+				p := r.String()
+				v.OccluderNodeInfo = &p
+			}
+		case "occluderParentNodeInfo":
+			if r.Null() {
+				v.OccluderParentNodeInfo = nil
+			} else {
+				p := r.String()
+				v.OccluderParentNodeInfo = &p
+			}
+		case "disableReason":
+			if r.Null() {
+				v.DisableReason = nil
+			} else {
+				p := r.String()
+				v.DisableReason = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InspectorIssueDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.CookieIssueDetails != nil {
+		w.Key("cookieIssueDetails")
+		v.CookieIssueDetails.EncodeJSON(w)
+	}
+	if v.MixedContentIssueDetails != nil {
+		w.Key("mixedContentIssueDetails")
+		v.MixedContentIssueDetails.EncodeJSON(w)
+	}
+	if v.BlockedByResponseIssueDetails != nil {
+		w.Key("blockedByResponseIssueDetails")
+		v.BlockedByResponseIssueDetails.EncodeJSON(w)
+	}
+	if v.HeavyAdIssueDetails != nil {
+		w.Key("heavyAdIssueDetails")
+		v.HeavyAdIssueDetails.EncodeJSON(w)
+	}
+	if v.ContentSecurityPolicyIssueDetails != nil {
+		w.Key("contentSecurityPolicyIssueDetails")
+		v.ContentSecurityPolicyIssueDetails.EncodeJSON(w)
+	}
+	if v.SharedArrayBufferIssueDetails != nil {
+		w.Key("sharedArrayBufferIssueDetails")
+		v.SharedArrayBufferIssueDetails.EncodeJSON(w)
+	}
+	if v.LowTextContrastIssueDetails != nil {
+		w.Key("lowTextContrastIssueDetails")
+		v.LowTextContrastIssueDetails.EncodeJSON(w)
+	}
+	if v.CORSIssueDetails != nil {
+		w.Key("corsIssueDetails")
+		v.CORSIssueDetails.EncodeJSON(w)
+	}
+	if v.AttributionReportingIssueDetails != nil {
+		w.Key("attributionReportingIssueDetails")
+		v.AttributionReportingIssueDetails.EncodeJSON(w)
+	}
+	if v.QuirksModeIssueDetails != nil {
+		w.Key("quirksModeIssueDetails")
+		v.QuirksModeIssueDetails.EncodeJSON(w)
+	}
+	if v.PartitioningBlobURLIssueDetails != nil {
+		w.Key("partitioningBlobURLIssueDetails")
+		v.PartitioningBlobURLIssueDetails.EncodeJSON(w)
+	}
+	if v.NavigatorUserAgentIssueDetails != nil {
+		w.Key("navigatorUserAgentIssueDetails")
+		v.NavigatorUserAgentIssueDetails.EncodeJSON(w)
+	}
+	if v.GenericIssueDetails != nil {
+		w.Key("genericIssueDetails")
+		v.GenericIssueDetails.EncodeJSON(w)
+	}
+	if v.DeprecationIssueDetails != nil {
+		w.Key("deprecationIssueDetails")
+		v.DeprecationIssueDetails.EncodeJSON(w)
+	}
+	if v.ClientHintIssueDetails != nil {
+		w.Key("clientHintIssueDetails")
+		v.ClientHintIssueDetails.EncodeJSON(w)
+	}
+	if v.FederatedAuthRequestIssueDetails != nil {
+		w.Key("federatedAuthRequestIssueDetails")
+		v.FederatedAuthRequestIssueDetails.EncodeJSON(w)
+	}
+	if v.BounceTrackingIssueDetails != nil {
+		w.Key("bounceTrackingIssueDetails")
+		v.BounceTrackingIssueDetails.EncodeJSON(w)
+	}
+	if v.CookieDeprecationMetadataIssueDetails != nil {
+		w.Key("cookieDeprecationMetadataIssueDetails")
+		v.CookieDeprecationMetadataIssueDetails.EncodeJSON(w)
+	}
+	if v.StylesheetLoadingIssueDetails != nil {
+		w.Key("stylesheetLoadingIssueDetails")
+		v.StylesheetLoadingIssueDetails.EncodeJSON(w)
+	}
+	if v.PropertyRuleIssueDetails != nil {
+		w.Key("propertyRuleIssueDetails")
+		v.PropertyRuleIssueDetails.EncodeJSON(w)
+	}
+	if v.FederatedAuthUserInfoRequestIssueDetails != nil {
+		w.Key("federatedAuthUserInfoRequestIssueDetails")
+		v.FederatedAuthUserInfoRequestIssueDetails.EncodeJSON(w)
+	}
+	if v.SharedDictionaryIssueDetails != nil {
+		w.Key("sharedDictionaryIssueDetails")
+		v.SharedDictionaryIssueDetails.EncodeJSON(w)
+	}
+	if v.ElementAccessibilityIssueDetails != nil {
+		w.Key("elementAccessibilityIssueDetails")
+		v.ElementAccessibilityIssueDetails.EncodeJSON(w)
+	}
+	if v.SriMessageSignatureIssueDetails != nil {
+		w.Key("sriMessageSignatureIssueDetails")
+		v.SriMessageSignatureIssueDetails.EncodeJSON(w)
+	}
+	if v.UnencodedDigestIssueDetails != nil {
+		w.Key("unencodedDigestIssueDetails")
+		v.UnencodedDigestIssueDetails.EncodeJSON(w)
+	}
+	if v.UserReidentificationIssueDetails != nil {
+		w.Key("userReidentificationIssueDetails")
+		v.UserReidentificationIssueDetails.EncodeJSON(w)
+	}
+	if v.PermissionElementIssueDetails != nil {
+		w.Key("permissionElementIssueDetails")
+		v.PermissionElementIssueDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InspectorIssueDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookieIssueDetails":
+			if r.Null() {
+				v.CookieIssueDetails = nil
+			} else {
+				var p CookieIssueDetails
+				p.DecodeJSON(r)
+				v.CookieIssueDetails = &p
+			}
+		case "mixedContentIssueDetails":
+			if r.Null() {
+				v.MixedContentIssueDetails = nil
+			} else {
+				var p MixedContentIssueDetails
+				p.DecodeJSON(r)
+				v.MixedContentIssueDetails = &p
+			}
+		case "blockedByResponseIssueDetails":
+			if r.Null() {
+				v.BlockedByResponseIssueDetails = nil
+			} else {
+				var p BlockedByResponseIssueDetails
+				p.DecodeJSON(r)
+				v.BlockedByResponseIssueDetails = &p
+			}
+		case "heavyAdIssueDetails":
+			if r.Null() {
+				v.HeavyAdIssueDetails = nil
+			} else {
+				var p HeavyAdIssueDetails
+				p.DecodeJSON(r)
+				v.HeavyAdIssueDetails = &p
+			}
+		case "contentSecurityPolicyIssueDetails":
+			if r.Null() {
+				v.ContentSecurityPolicyIssueDetails = nil
+			} else {
+				var p ContentSecurityPolicyIssueDetails
+				p.DecodeJSON(r)
+				v.ContentSecurityPolicyIssueDetails = &p
+			}
+		case "sharedArrayBufferIssueDetails":
+			if r.Null() {
+				v.SharedArrayBufferIssueDetails = nil
+			} else {
+				var p SharedArrayBufferIssueDetails
+				p.DecodeJSON(r)
+				v.SharedArrayBufferIssueDetails = &p
+			}
+		case "lowTextContrastIssueDetails":
+			if r.Null() {
+				v.LowTextContrastIssueDetails = nil
+			} else {
+				var p LowTextContrastIssueDetails
+				p.DecodeJSON(r)
+				v.LowTextContrastIssueDetails = &p
+			}
+		case "corsIssueDetails":
+			if r.Null() {
+				v.CORSIssueDetails = nil
+			} else {
+				var p CORSIssueDetails
+				p.DecodeJSON(r)
+				v.CORSIssueDetails = &p
+			}
+		case "attributionReportingIssueDetails":
+			if r.Null() {
+				v.AttributionReportingIssueDetails = nil
+			} else {
+				var p AttributionReportingIssueDetails
+				p.DecodeJSON(r)
+				v.AttributionReportingIssueDetails = &p
+			}
+		case "quirksModeIssueDetails":
+			if r.Null() {
+				v.QuirksModeIssueDetails = nil
+			} else {
+				var p QuirksModeIssueDetails
+				p.DecodeJSON(r)
+				v.QuirksModeIssueDetails = &p
+			}
+		case "partitioningBlobURLIssueDetails":
+			if r.Null() {
+				v.PartitioningBlobURLIssueDetails = nil
+			} else {
+				var p PartitioningBlobURLIssueDetails
+				p.DecodeJSON(r)
+				v.PartitioningBlobURLIssueDetails = &p
+			}
+		case "navigatorUserAgentIssueDetails":
+			if r.Null() {
+				v.NavigatorUserAgentIssueDetails = nil
+			} else {
+				var p NavigatorUserAgentIssueDetails
+				p.DecodeJSON(r)
+				v.NavigatorUserAgentIssueDetails = &p
+			}
+		case "genericIssueDetails":
+			if r.Null() {
+				v.GenericIssueDetails = nil
+			} else {
+				var p GenericIssueDetails
+				p.DecodeJSON(r)
+				v.GenericIssueDetails = &p
+			}
+		case "deprecationIssueDetails":
+			if r.Null() {
+				v.DeprecationIssueDetails = nil
+			} else {
+				var p DeprecationIssueDetails
+				p.DecodeJSON(r)
+				v.DeprecationIssueDetails = &p
+			}
+		case "clientHintIssueDetails":
+			if r.Null() {
+				v.ClientHintIssueDetails = nil
+			} else {
+				var p ClientHintIssueDetails
+				p.DecodeJSON(r)
+				v.ClientHintIssueDetails = &p
+			}
+		case "federatedAuthRequestIssueDetails":
+			if r.Null() {
+				v.FederatedAuthRequestIssueDetails = nil
+			} else {
+				var p FederatedAuthRequestIssueDetails
+				p.DecodeJSON(r)
+				v.FederatedAuthRequestIssueDetails = &p
+			}
+		case "bounceTrackingIssueDetails":
+			if r.Null() {
+				v.BounceTrackingIssueDetails = nil
+			} else {
+				var p BounceTrackingIssueDetails
+				p.DecodeJSON(r)
+				v.BounceTrackingIssueDetails = &p
+			}
+		case "cookieDeprecationMetadataIssueDetails":
+			if r.Null() {
+				v.CookieDeprecationMetadataIssueDetails = nil
+			} else {
+				var p CookieDeprecationMetadataIssueDetails
+				p.DecodeJSON(r)
+				v.CookieDeprecationMetadataIssueDetails = &p
+			}
+		case "stylesheetLoadingIssueDetails":
+			if r.Null() {
+				v.StylesheetLoadingIssueDetails = nil
+			} else {
+				var p StylesheetLoadingIssueDetails
+				p.DecodeJSON(r)
+				v.StylesheetLoadingIssueDetails = &p
+			}
+		case "propertyRuleIssueDetails":
+			if r.Null() {
+				v.PropertyRuleIssueDetails = nil
+			} else {
+				var p PropertyRuleIssueDetails
+				p.DecodeJSON(r)
+				v.PropertyRuleIssueDetails = &p
+			}
+		case "federatedAuthUserInfoRequestIssueDetails":
+			if r.Null() {
+				v.FederatedAuthUserInfoRequestIssueDetails = nil
+			} else {
+				var p FederatedAuthUserInfoRequestIssueDetails
+				p.DecodeJSON(r)
+				v.FederatedAuthUserInfoRequestIssueDetails = &p
+			}
+		case "sharedDictionaryIssueDetails":
+			if r.Null() {
+				v.SharedDictionaryIssueDetails = nil
+			} else {
+				var p SharedDictionaryIssueDetails
+				p.DecodeJSON(r)
+				v.SharedDictionaryIssueDetails = &p
+			}
+		case "elementAccessibilityIssueDetails":
+			if r.Null() {
+				v.ElementAccessibilityIssueDetails = nil
+			} else {
+				var p ElementAccessibilityIssueDetails
+				p.DecodeJSON(r)
+				v.ElementAccessibilityIssueDetails = &p
+			}
+		case "sriMessageSignatureIssueDetails":
+			if r.Null() {
+				v.SriMessageSignatureIssueDetails = nil
+			} else {
+				var p SRIMessageSignatureIssueDetails
+				p.DecodeJSON(r)
+				v.SriMessageSignatureIssueDetails = &p
+			}
+		case "unencodedDigestIssueDetails":
+			if r.Null() {
+				v.UnencodedDigestIssueDetails = nil
+			} else {
+				var p UnencodedDigestIssueDetails
+				p.DecodeJSON(r)
+				v.UnencodedDigestIssueDetails = &p
+			}
+		case "userReidentificationIssueDetails":
+			if r.Null() {
+				v.UserReidentificationIssueDetails = nil
+			} else {
+				var p UserReidentificationIssueDetails
+				p.DecodeJSON(r)
+				v.UserReidentificationIssueDetails = &p
+			}
+		case "permissionElementIssueDetails":
+			if r.Null() {
+				v.PermissionElementIssueDetails = nil
+			} else {
+				var p PermissionElementIssueDetails
+				p.DecodeJSON(r)
+				v.PermissionElementIssueDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InspectorIssue) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("code")
+	w.String(string(v.Code))
+	w.Key("details")
+	v.Details.EncodeJSON(w)
+	if v.IssueID != nil {
+		w.Key("issueId")
+		w.String(string(*v.IssueID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InspectorIssue) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "code":
+			v.Code = InspectorIssueCode(r.String())
+		case "details":
+			v.Details.DecodeJSON(r)
+		case "issueId":
+			if r.Null() {
+				v.IssueID = nil
+			} else {
+				p := IssueID(r.String())
+				v.IssueID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetEncodedResponseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("encoding")
+	w.String(v.Encoding)
+	if v.Quality != nil {
+		w.Key("quality")
+		w.Float(*v.Quality)
+	}
+	if v.SizeOnly != nil {
+		w.Key("sizeOnly")
+		w.Bool(*v.SizeOnly)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetEncodedResponseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = network.RequestID(r.String())
+		case "encoding":
+			v.Encoding = r.String()
+		case "quality":
+			if r.Null() {
+				v.Quality = nil
+			} else {
+				p := r.Float()
+				v.Quality = &p
+			}
+		case "sizeOnly":
+			if r.Null() {
+				v.SizeOnly = nil
+			} else {
+				p := r.Bool()
+				v.SizeOnly = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetEncodedResponseReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.Body) > 0 {
+		w.Key("body")
+		w.Base64(v.Body)
+	}
+	w.Key("originalSize")
+	w.Int(v.OriginalSize)
+	w.Key("encodedSize")
+	w.Int(v.EncodedSize)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetEncodedResponseReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "body":
+			v.Body = r.Base64()
+		case "originalSize":
+			v.OriginalSize = r.Int()
+		case "encodedSize":
+			v.EncodedSize = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CheckContrastArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ReportAAA != nil {
+		w.Key("reportAAA")
+		w.Bool(*v.ReportAAA)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CheckContrastArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "reportAAA":
+			if r.Null() {
+				v.ReportAAA = nil
+			} else {
+				p := r.Bool()
+				v.ReportAAA = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CheckFormsIssuesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("formIssues")
+	if v.FormIssues == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FormIssues {
+			v.FormIssues[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CheckFormsIssuesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "formIssues":
+			if r.ArrayStart() {
+				v.FormIssues = make([]GenericIssueDetails, 0, 4)
+				for r.ArrayNext() {
+					var e0 GenericIssueDetails
+					e0.DecodeJSON(r)
+					v.FormIssues = append(v.FormIssues, e0)
+				}
+			} else {
+				v.FormIssues = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IssueAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("issue")
+	v.Issue.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IssueAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "issue":
+			v.Issue.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,409 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build cdpjson
+
+package audits
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v AffectedCookie) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AffectedCookie) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AffectedRequest) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AffectedRequest) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AffectedFrame) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AffectedFrame) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CookieIssueInsight) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CookieIssueInsight) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CookieIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CookieIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v MixedContentIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *MixedContentIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v BlockedByResponseIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *BlockedByResponseIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v HeavyAdIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *HeavyAdIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SourceCodeLocation) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SourceCodeLocation) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ContentSecurityPolicyIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ContentSecurityPolicyIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SharedArrayBufferIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SharedArrayBufferIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v LowTextContrastIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *LowTextContrastIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CORSIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CORSIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AttributionReportingIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AttributionReportingIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v QuirksModeIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *QuirksModeIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v NavigatorUserAgentIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *NavigatorUserAgentIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SharedDictionaryIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SharedDictionaryIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SRIMessageSignatureIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SRIMessageSignatureIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v UnencodedDigestIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *UnencodedDigestIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GenericIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GenericIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v DeprecationIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *DeprecationIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v BounceTrackingIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *BounceTrackingIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CookieDeprecationMetadataIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CookieDeprecationMetadataIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v FederatedAuthRequestIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *FederatedAuthRequestIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v FederatedAuthUserInfoRequestIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *FederatedAuthUserInfoRequestIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ClientHintIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ClientHintIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v FailedRequestInfo) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *FailedRequestInfo) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v PartitioningBlobURLIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *PartitioningBlobURLIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ElementAccessibilityIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ElementAccessibilityIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v StylesheetLoadingIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *StylesheetLoadingIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v PropertyRuleIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *PropertyRuleIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v UserReidentificationIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *UserReidentificationIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v PermissionElementIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *PermissionElementIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v InspectorIssueDetails) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *InspectorIssueDetails) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v InspectorIssue) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *InspectorIssue) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetEncodedResponseArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetEncodedResponseArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GetEncodedResponseReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GetEncodedResponseReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CheckContrastArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CheckContrastArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CheckFormsIssuesReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CheckFormsIssuesReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v IssueAddedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *IssueAddedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
@@ -0,0 +1,55 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+// GetEncodedResponseOption sets an optional argument of GetEncodedResponseArgs.
+type GetEncodedResponseOption func(*GetEncodedResponseArgs)
+
+// NewGetEncodedResponseArgsWith initializes GetEncodedResponseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetEncodedResponseArgsWith(requestID network.RequestID, encoding string, opts ...GetEncodedResponseOption) *GetEncodedResponseArgs {
+	args := NewGetEncodedResponseArgs(requestID, encoding)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetEncodedResponseWithQuality sets the Quality optional argument, see
+// GetEncodedResponseArgs.SetQuality.
+func GetEncodedResponseWithQuality(v float64) GetEncodedResponseOption {
+	return func(a *GetEncodedResponseArgs) { a.SetQuality(v) }
+}
+
+// GetEncodedResponseWithSizeOnly sets the SizeOnly optional argument, see
+// GetEncodedResponseArgs.SetSizeOnly.
+func GetEncodedResponseWithSizeOnly(v bool) GetEncodedResponseOption {
+	return func(a *GetEncodedResponseArgs) { a.SetSizeOnly(v) }
+}
+
+// CheckContrastOption sets an optional argument of CheckContrastArgs.
+type CheckContrastOption func(*CheckContrastArgs)
+
+// NewCheckContrastArgsWith initializes CheckContrastArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCheckContrastArgsWith(opts ...CheckContrastOption) *CheckContrastArgs {
+	args := NewCheckContrastArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CheckContrastWithReportAAA sets the ReportAAA optional argument, see
+// CheckContrastArgs.SetReportAAA.
+func CheckContrastWithReportAAA(v bool) CheckContrastOption {
+	return func(a *CheckContrastArgs) { a.SetReportAAA(v) }
+}
@@ -3,6 +3,8 @@
 package autofill
 
 import (
+	"errors"
+
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/page"
 )
@@ -44,6 +46,15 @@ func (a *TriggerArgs) SetAddress(address Address) *TriggerArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TriggerArgs) Validate() error {
+	if a.Card != nil && a.Address != nil {
+		return errors.New("autofill.TriggerArgs: Card and Address are mutually exclusive")
+	}
+	return nil
+}
+
 // SetAddressesArgs represents the arguments for SetAddresses in the Autofill domain.
 type SetAddressesArgs struct {
 	Addresses []Address `json:"addresses"` // No description.
@@ -55,3 +66,12 @@ func NewSetAddressesArgs(addresses []Address) *SetAddressesArgs {
 	args.Addresses = addresses
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAddressesArgs) Validate() error {
+	if a.Addresses == nil {
+		return errors.New("autofill.SetAddressesArgs: Addresses is required")
+	}
+	return nil
+}
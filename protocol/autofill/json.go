@@ -0,0 +1,388 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreditCard) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("number")
+	w.String(v.Number)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("expiryMonth")
+	w.String(v.ExpiryMonth)
+	w.Key("expiryYear")
+	w.String(v.ExpiryYear)
+	w.Key("cvc")
+	w.String(v.CVC)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreditCard) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "number":
+			v.Number = r.String()
+		case "name":
+			v.Name = r.String()
+		case "expiryMonth":
+			v.ExpiryMonth = r.String()
+		case "expiryYear":
+			v.ExpiryYear = r.String()
+		case "cvc":
+			v.CVC = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddressField) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddressField) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddressFields) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fields")
+	if v.Fields == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Fields {
+			v.Fields[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddressFields) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fields":
+			if r.ArrayStart() {
+				v.Fields = make([]AddressField, 0, 4)
+				for r.ArrayNext() {
+					var e0 AddressField
+					e0.DecodeJSON(r)
+					v.Fields = append(v.Fields, e0)
+				}
+			} else {
+				v.Fields = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Address) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fields")
+	if v.Fields == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Fields {
+			v.Fields[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Address) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fields":
+			if r.ArrayStart() {
+				v.Fields = make([]AddressField, 0, 4)
+				for r.ArrayNext() {
+					var e0 AddressField
+					e0.DecodeJSON(r)
+					v.Fields = append(v.Fields, e0)
+				}
+			} else {
+				v.Fields = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddressUI) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("addressFields")
+	if v.AddressFields == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AddressFields {
+			v.AddressFields[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddressUI) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "addressFields":
+			if r.ArrayStart() {
+				v.AddressFields = make([]AddressFields, 0, 4)
+				for r.ArrayNext() {
+					var e0 AddressFields
+					e0.DecodeJSON(r)
+					v.AddressFields = append(v.AddressFields, e0)
+				}
+			} else {
+				v.AddressFields = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FilledField) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("htmlType")
+	w.String(v.HTMLType)
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.Key("autofillType")
+	w.String(v.AutofillType)
+	w.Key("fillingStrategy")
+	w.String(string(v.FillingStrategy))
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("fieldId")
+	w.Int(int(v.FieldID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FilledField) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "htmlType":
+			v.HTMLType = r.String()
+		case "id":
+			v.ID = r.String()
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		case "autofillType":
+			v.AutofillType = r.String()
+		case "fillingStrategy":
+			v.FillingStrategy = FillingStrategy(r.String())
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "fieldId":
+			v.FieldID = dom.BackendNodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TriggerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fieldId")
+	w.Int(int(v.FieldID))
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	if v.Card != nil {
+		w.Key("card")
+		v.Card.EncodeJSON(w)
+	}
+	if v.Address != nil {
+		w.Key("address")
+		v.Address.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TriggerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fieldId":
+			v.FieldID = dom.BackendNodeID(r.Int())
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		case "card":
+			if r.Null() {
+				v.Card = nil
+			} else {
+				var p CreditCard
+				p.DecodeJSON(r)
+				v.Card = &p
+			}
+		case "address":
+			if r.Null() {
+				v.Address = nil
+			} else {
+				var p Address
+				p.DecodeJSON(r)
+				v.Address = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAddressesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("addresses")
+	if v.Addresses == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Addresses {
+			v.Addresses[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAddressesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "addresses":
+			if r.ArrayStart() {
+				v.Addresses = make([]Address, 0, 4)
+				for r.ArrayNext() {
+					var e0 Address
+					e0.DecodeJSON(r)
+					v.Addresses = append(v.Addresses, e0)
+				}
+			} else {
+				v.Addresses = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddressFormFilledReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("filledFields")
+	if v.FilledFields == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FilledFields {
+			v.FilledFields[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("addressUi")
+	v.AddressUI.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddressFormFilledReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "filledFields":
+			if r.ArrayStart() {
+				v.FilledFields = make([]FilledField, 0, 4)
+				for r.ArrayNext() {
+					var e0 FilledField
+					e0.DecodeJSON(r)
+					v.FilledFields = append(v.FilledFields, e0)
+				}
+			} else {
+				v.FilledFields = nil
+			}
+		case "addressUi":
+			v.AddressUI.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
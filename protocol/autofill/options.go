@@ -0,0 +1,41 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// TriggerOption sets an optional argument of TriggerArgs.
+type TriggerOption func(*TriggerArgs)
+
+// NewTriggerArgsWith initializes TriggerArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewTriggerArgsWith(fieldID dom.BackendNodeID, opts ...TriggerOption) *TriggerArgs {
+	args := NewTriggerArgs(fieldID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// TriggerWithFrameID sets the FrameID optional argument, see
+// TriggerArgs.SetFrameID.
+func TriggerWithFrameID(v page.FrameID) TriggerOption {
+	return func(a *TriggerArgs) { a.SetFrameID(v) }
+}
+
+// TriggerWithCard sets the Card optional argument, see
+// TriggerArgs.SetCard.
+func TriggerWithCard(v CreditCard) TriggerOption {
+	return func(a *TriggerArgs) { a.SetCard(v) }
+}
+
+// TriggerWithAddress sets the Address optional argument, see
+// TriggerArgs.SetAddress.
+func TriggerWithAddress(v Address) TriggerOption {
+	return func(a *TriggerArgs) { a.SetAddress(v) }
+}
@@ -2,6 +2,11 @@
 
 package backgroundservice
 
+import (
+	"errors"
+	"strconv"
+)
+
 // StartObservingArgs represents the arguments for StartObserving in the BackgroundService domain.
 type StartObservingArgs struct {
 	Service ServiceName `json:"service"` // No description.
@@ -14,6 +19,15 @@ func NewStartObservingArgs(service ServiceName) *StartObservingArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartObservingArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.StartObservingArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
+
 // StopObservingArgs represents the arguments for StopObserving in the BackgroundService domain.
 type StopObservingArgs struct {
 	Service ServiceName `json:"service"` // No description.
@@ -26,6 +40,15 @@ func NewStopObservingArgs(service ServiceName) *StopObservingArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopObservingArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.StopObservingArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
+
 // SetRecordingArgs represents the arguments for SetRecording in the BackgroundService domain.
 type SetRecordingArgs struct {
 	ShouldRecord bool        `json:"shouldRecord"` // No description.
@@ -40,6 +63,15 @@ func NewSetRecordingArgs(shouldRecord bool, service ServiceName) *SetRecordingAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRecordingArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.SetRecordingArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
+
 // ClearEventsArgs represents the arguments for ClearEvents in the BackgroundService domain.
 type ClearEventsArgs struct {
 	Service ServiceName `json:"service"` // No description.
@@ -51,3 +83,12 @@ func NewClearEventsArgs(service ServiceName) *ClearEventsArgs {
 	args.Service = service
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearEventsArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.ClearEventsArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build cdpjson
+
+package backgroundservice
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v EventMetadata) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *EventMetadata) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Event) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Event) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v StartObservingArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *StartObservingArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v StopObservingArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *StopObservingArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SetRecordingArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SetRecordingArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ClearEventsArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ClearEventsArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v RecordingStateChangedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *RecordingStateChangedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v EventReceivedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *EventReceivedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
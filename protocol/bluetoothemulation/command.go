@@ -2,6 +2,11 @@
 
 package bluetoothemulation
 
+import (
+	"errors"
+	"strconv"
+)
+
 // EnableArgs represents the arguments for Enable in the BluetoothEmulation domain.
 type EnableArgs struct {
 	State       CentralState `json:"state"`       // State of the simulated central.
@@ -16,6 +21,15 @@ func NewEnableArgs(state CentralState, leSupported bool) *EnableArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	if !a.State.Valid() {
+		return errors.New("bluetoothemulation.EnableArgs: State: invalid value " + strconv.Quote(string(a.State)))
+	}
+	return nil
+}
+
 // SetSimulatedCentralStateArgs represents the arguments for SetSimulatedCentralState in the BluetoothEmulation domain.
 type SetSimulatedCentralStateArgs struct {
 	State CentralState `json:"state"` // State of the simulated central.
@@ -28,6 +42,15 @@ func NewSetSimulatedCentralStateArgs(state CentralState) *SetSimulatedCentralSta
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSimulatedCentralStateArgs) Validate() error {
+	if !a.State.Valid() {
+		return errors.New("bluetoothemulation.SetSimulatedCentralStateArgs: State: invalid value " + strconv.Quote(string(a.State)))
+	}
+	return nil
+}
+
 // SimulatePreconnectedPeripheralArgs represents the arguments for SimulatePreconnectedPeripheral in the BluetoothEmulation domain.
 type SimulatePreconnectedPeripheralArgs struct {
 	Address           string             `json:"address"`           // No description.
@@ -46,6 +69,18 @@ func NewSimulatePreconnectedPeripheralArgs(address string, name string, manufact
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulatePreconnectedPeripheralArgs) Validate() error {
+	if a.ManufacturerData == nil {
+		return errors.New("bluetoothemulation.SimulatePreconnectedPeripheralArgs: ManufacturerData is required")
+	}
+	if a.KnownServiceUUIDs == nil {
+		return errors.New("bluetoothemulation.SimulatePreconnectedPeripheralArgs: KnownServiceUUIDs is required")
+	}
+	return nil
+}
+
 // SimulateAdvertisementArgs represents the arguments for SimulateAdvertisement in the BluetoothEmulation domain.
 type SimulateAdvertisementArgs struct {
 	Entry ScanEntry `json:"entry"` // No description.
@@ -58,6 +93,12 @@ func NewSimulateAdvertisementArgs(entry ScanEntry) *SimulateAdvertisementArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulateAdvertisementArgs) Validate() error {
+	return nil
+}
+
 // SimulateGATTOperationResponseArgs represents the arguments for SimulateGATTOperationResponse in the BluetoothEmulation domain.
 type SimulateGATTOperationResponseArgs struct {
 	Address string            `json:"address"` // No description.
@@ -74,6 +115,15 @@ func NewSimulateGATTOperationResponseArgs(address string, typ GATTOperationType,
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulateGATTOperationResponseArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("bluetoothemulation.SimulateGATTOperationResponseArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // SimulateCharacteristicOperationResponseArgs represents the arguments for SimulateCharacteristicOperationResponse in the BluetoothEmulation domain.
 type SimulateCharacteristicOperationResponseArgs struct {
 	CharacteristicID string                      `json:"characteristicId"` // No description.
@@ -97,6 +147,15 @@ func (a *SimulateCharacteristicOperationResponseArgs) SetData(data string) *Simu
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulateCharacteristicOperationResponseArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("bluetoothemulation.SimulateCharacteristicOperationResponseArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // SimulateDescriptorOperationResponseArgs represents the arguments for SimulateDescriptorOperationResponse in the BluetoothEmulation domain.
 type SimulateDescriptorOperationResponseArgs struct {
 	DescriptorID string                  `json:"descriptorId"`   // No description.
@@ -120,6 +179,15 @@ func (a *SimulateDescriptorOperationResponseArgs) SetData(data string) *Simulate
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulateDescriptorOperationResponseArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("bluetoothemulation.SimulateDescriptorOperationResponseArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // AddServiceArgs represents the arguments for AddService in the BluetoothEmulation domain.
 type AddServiceArgs struct {
 	Address     string `json:"address"`     // No description.
@@ -134,6 +202,12 @@ func NewAddServiceArgs(address string, serviceUUID string) *AddServiceArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddServiceArgs) Validate() error {
+	return nil
+}
+
 // AddServiceReply represents the return values for AddService in the BluetoothEmulation domain.
 type AddServiceReply struct {
 	ServiceID string `json:"serviceId"` // An identifier that uniquely represents this service.
@@ -151,6 +225,12 @@ func NewRemoveServiceArgs(serviceID string) *RemoveServiceArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveServiceArgs) Validate() error {
+	return nil
+}
+
 // AddCharacteristicArgs represents the arguments for AddCharacteristic in the BluetoothEmulation domain.
 type AddCharacteristicArgs struct {
 	ServiceID          string                   `json:"serviceId"`          // No description.
@@ -167,6 +247,12 @@ func NewAddCharacteristicArgs(serviceID string, characteristicUUID string, prope
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddCharacteristicArgs) Validate() error {
+	return nil
+}
+
 // AddCharacteristicReply represents the return values for AddCharacteristic in the BluetoothEmulation domain.
 type AddCharacteristicReply struct {
 	CharacteristicID string `json:"characteristicId"` // An identifier that uniquely represents this characteristic.
@@ -184,6 +270,12 @@ func NewRemoveCharacteristicArgs(characteristicID string) *RemoveCharacteristicA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveCharacteristicArgs) Validate() error {
+	return nil
+}
+
 // AddDescriptorArgs represents the arguments for AddDescriptor in the BluetoothEmulation domain.
 type AddDescriptorArgs struct {
 	CharacteristicID string `json:"characteristicId"` // No description.
@@ -198,6 +290,12 @@ func NewAddDescriptorArgs(characteristicID string, descriptorUUID string) *AddDe
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddDescriptorArgs) Validate() error {
+	return nil
+}
+
 // AddDescriptorReply represents the return values for AddDescriptor in the BluetoothEmulation domain.
 type AddDescriptorReply struct {
 	DescriptorID string `json:"descriptorId"` // An identifier that uniquely represents this descriptor.
@@ -215,6 +313,12 @@ func NewRemoveDescriptorArgs(descriptorID string) *RemoveDescriptorArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveDescriptorArgs) Validate() error {
+	return nil
+}
+
 // SimulateGATTDisconnectionArgs represents the arguments for SimulateGATTDisconnection in the BluetoothEmulation domain.
 type SimulateGATTDisconnectionArgs struct {
 	Address string `json:"address"` // No description.
@@ -226,3 +330,9 @@ func NewSimulateGATTDisconnectionArgs(address string) *SimulateGATTDisconnection
 	args.Address = address
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulateGATTDisconnectionArgs) Validate() error {
+	return nil
+}
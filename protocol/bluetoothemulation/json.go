@@ -0,0 +1,872 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package bluetoothemulation
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ManufacturerData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	w.Int(v.Key)
+	w.Key("data")
+	w.Base64(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ManufacturerData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key = r.Int()
+		case "data":
+			v.Data = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScanRecord) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	if len(v.UUIDs) > 0 {
+		w.Key("uuids")
+		w.ArrayStart()
+		for i0 := range v.UUIDs {
+			w.String(v.UUIDs[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.Appearance != nil {
+		w.Key("appearance")
+		w.Int(*v.Appearance)
+	}
+	if v.TxPower != nil {
+		w.Key("txPower")
+		w.Int(*v.TxPower)
+	}
+	if len(v.ManufacturerData) > 0 {
+		w.Key("manufacturerData")
+		w.ArrayStart()
+		for i0 := range v.ManufacturerData {
+			v.ManufacturerData[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScanRecord) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "uuids":
+			if r.ArrayStart() {
+				v.UUIDs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.UUIDs = append(v.UUIDs, e0)
+				}
+			} else {
+				v.UUIDs = nil
+			}
+		case "appearance":
+			if r.Null() {
+				v.Appearance = nil
+			} else {
+				p := r.Int()
+				v.Appearance = &p
+			}
+		case "txPower":
+			if r.Null() {
+				v.TxPower = nil
+			} else {
+				p := r.Int()
+				v.TxPower = &p
+			}
+		case "manufacturerData":
+			if r.ArrayStart() {
+				v.ManufacturerData = make([]ManufacturerData, 0, 4)
+				for r.ArrayNext() {
+					var e0 ManufacturerData
+					e0.DecodeJSON(r)
+					v.ManufacturerData = append(v.ManufacturerData, e0)
+				}
+			} else {
+				v.ManufacturerData = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScanEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("deviceAddress")
+	w.String(v.DeviceAddress)
+	w.Key("rssi")
+	w.Int(v.RSSI)
+	w.Key("scanRecord")
+	v.ScanRecord.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScanEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "deviceAddress":
+			v.DeviceAddress = r.String()
+		case "rssi":
+			v.RSSI = r.Int()
+		case "scanRecord":
+			v.ScanRecord.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CharacteristicProperties) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Broadcast != nil {
+		w.Key("broadcast")
+		w.Bool(*v.Broadcast)
+	}
+	if v.Read != nil {
+		w.Key("read")
+		w.Bool(*v.Read)
+	}
+	if v.WriteWithoutResponse != nil {
+		w.Key("writeWithoutResponse")
+		w.Bool(*v.WriteWithoutResponse)
+	}
+	if v.Write != nil {
+		w.Key("write")
+		w.Bool(*v.Write)
+	}
+	if v.Notify != nil {
+		w.Key("notify")
+		w.Bool(*v.Notify)
+	}
+	if v.Indicate != nil {
+		w.Key("indicate")
+		w.Bool(*v.Indicate)
+	}
+	if v.AuthenticatedSignedWrites != nil {
+		w.Key("authenticatedSignedWrites")
+		w.Bool(*v.AuthenticatedSignedWrites)
+	}
+	if v.ExtendedProperties != nil {
+		w.Key("extendedProperties")
+		w.Bool(*v.ExtendedProperties)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CharacteristicProperties) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "broadcast":
+			if r.Null() {
+				v.Broadcast = nil
+			} else {
+				p := r.Bool()
+				v.Broadcast = &p
+			}
+		case "read":
+			if r.Null() {
+				v.Read = nil
+			} else {
+				p := r.Bool()
+				v.Read = &p
+			}
+		case "writeWithoutResponse":
+			if r.Null() {
+				v.WriteWithoutResponse = nil
+			} else {
+				p := r.Bool()
+				v.WriteWithoutResponse = &p
+			}
+		case "write":
+			if r.Null() {
+				v.Write = nil
+			} else {
+				p := r.Bool()
+				v.Write = &p
+			}
+		case "notify":
+			if r.Null() {
+				v.Notify = nil
+			} else {
+				p := r.Bool()
+				v.Notify = &p
+			}
+		case "indicate":
+			if r.Null() {
+				v.Indicate = nil
+			} else {
+				p := r.Bool()
+				v.Indicate = &p
+			}
+		case "authenticatedSignedWrites":
+			if r.Null() {
+				v.AuthenticatedSignedWrites = nil
+			} else {
+				p := r.Bool()
+				v.AuthenticatedSignedWrites = &p
+			}
+		case "extendedProperties":
+			if r.Null() {
+				v.ExtendedProperties = nil
+			} else {
+				p := r.Bool()
+				v.ExtendedProperties = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("state")
+	w.String(string(v.State))
+	w.Key("leSupported")
+	w.Bool(v.LeSupported)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "state":
+			v.State = CentralState(r.String())
+		case "leSupported":
+			v.LeSupported = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSimulatedCentralStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("state")
+	w.String(string(v.State))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSimulatedCentralStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "state":
+			v.State = CentralState(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulatePreconnectedPeripheralArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("address")
+	w.String(v.Address)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("manufacturerData")
+	if v.ManufacturerData == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ManufacturerData {
+			v.ManufacturerData[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("knownServiceUuids")
+	if v.KnownServiceUUIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.KnownServiceUUIDs {
+			w.String(v.KnownServiceUUIDs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulatePreconnectedPeripheralArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "address":
+			v.Address = r.String()
+		case "name":
+			v.Name = r.String()
+		case "manufacturerData":
+			if r.ArrayStart() {
+				v.ManufacturerData = make([]ManufacturerData, 0, 4)
+				for r.ArrayNext() {
+					var e0 ManufacturerData
+					e0.DecodeJSON(r)
+					v.ManufacturerData = append(v.ManufacturerData, e0)
+				}
+			} else {
+				v.ManufacturerData = nil
+			}
+		case "knownServiceUuids":
+			if r.ArrayStart() {
+				v.KnownServiceUUIDs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.KnownServiceUUIDs = append(v.KnownServiceUUIDs, e0)
+				}
+			} else {
+				v.KnownServiceUUIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulateAdvertisementArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("entry")
+	v.Entry.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulateAdvertisementArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "entry":
+			v.Entry.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulateGATTOperationResponseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("address")
+	w.String(v.Address)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("code")
+	w.Int(v.Code)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulateGATTOperationResponseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "address":
+			v.Address = r.String()
+		case "type":
+			v.Type = GATTOperationType(r.String())
+		case "code":
+			v.Code = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulateCharacteristicOperationResponseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("characteristicId")
+	w.String(v.CharacteristicID)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("code")
+	w.Int(v.Code)
+	if v.Data != nil {
+		w.Key("data")
+		w.String(*v.Data)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulateCharacteristicOperationResponseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "characteristicId":
+			v.CharacteristicID = r.String()
+		case "type":
+			v.Type = CharacteristicOperationType(r.String())
+		case "code":
+			v.Code = r.Int()
+		case "data":
+			if r.Null() {
+				v.Data = nil
+			} else {
+				p := r.String()
+				v.Data = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulateDescriptorOperationResponseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("descriptorId")
+	w.String(v.DescriptorID)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("code")
+	w.Int(v.Code)
+	if v.Data != nil {
+		w.Key("data")
+		w.String(*v.Data)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulateDescriptorOperationResponseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "descriptorId":
+			v.DescriptorID = r.String()
+		case "type":
+			v.Type = DescriptorOperationType(r.String())
+		case "code":
+			v.Code = r.Int()
+		case "data":
+			if r.Null() {
+				v.Data = nil
+			} else {
+				p := r.String()
+				v.Data = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddServiceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("address")
+	w.String(v.Address)
+	w.Key("serviceUuid")
+	w.String(v.ServiceUUID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddServiceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "address":
+			v.Address = r.String()
+		case "serviceUuid":
+			v.ServiceUUID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddServiceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("serviceId")
+	w.String(v.ServiceID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddServiceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "serviceId":
+			v.ServiceID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveServiceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("serviceId")
+	w.String(v.ServiceID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveServiceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "serviceId":
+			v.ServiceID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddCharacteristicArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("serviceId")
+	w.String(v.ServiceID)
+	w.Key("characteristicUuid")
+	w.String(v.CharacteristicUUID)
+	w.Key("properties")
+	v.Properties.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddCharacteristicArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "serviceId":
+			v.ServiceID = r.String()
+		case "characteristicUuid":
+			v.CharacteristicUUID = r.String()
+		case "properties":
+			v.Properties.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddCharacteristicReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("characteristicId")
+	w.String(v.CharacteristicID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddCharacteristicReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "characteristicId":
+			v.CharacteristicID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveCharacteristicArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("characteristicId")
+	w.String(v.CharacteristicID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveCharacteristicArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "characteristicId":
+			v.CharacteristicID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddDescriptorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("characteristicId")
+	w.String(v.CharacteristicID)
+	w.Key("descriptorUuid")
+	w.String(v.DescriptorUUID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddDescriptorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "characteristicId":
+			v.CharacteristicID = r.String()
+		case "descriptorUuid":
+			v.DescriptorUUID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddDescriptorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("descriptorId")
+	w.String(v.DescriptorID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddDescriptorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "descriptorId":
+			v.DescriptorID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveDescriptorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("descriptorId")
+	w.String(v.DescriptorID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveDescriptorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "descriptorId":
+			v.DescriptorID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulateGATTDisconnectionArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("address")
+	w.String(v.Address)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulateGATTDisconnectionArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "address":
+			v.Address = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GattOperationReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("address")
+	w.String(v.Address)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GattOperationReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "address":
+			v.Address = r.String()
+		case "type":
+			v.Type = GATTOperationType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CharacteristicOperationReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("characteristicId")
+	w.String(v.CharacteristicID)
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.Data != nil {
+		w.Key("data")
+		w.String(*v.Data)
+	}
+	if v.WriteType != "" {
+		w.Key("writeType")
+		w.String(string(v.WriteType))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CharacteristicOperationReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "characteristicId":
+			v.CharacteristicID = r.String()
+		case "type":
+			v.Type = CharacteristicOperationType(r.String())
+		case "data":
+			if r.Null() {
+				v.Data = nil
+			} else {
+				p := r.String()
+				v.Data = &p
+			}
+		case "writeType":
+			v.WriteType = CharacteristicWriteType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DescriptorOperationReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("descriptorId")
+	w.String(v.DescriptorID)
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.Data != nil {
+		w.Key("data")
+		w.String(*v.Data)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DescriptorOperationReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "descriptorId":
+			v.DescriptorID = r.String()
+		case "type":
+			v.Type = DescriptorOperationType(r.String())
+		case "data":
+			if r.Null() {
+				v.Data = nil
+			} else {
+				p := r.String()
+				v.Data = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
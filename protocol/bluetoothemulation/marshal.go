@@ -0,0 +1,249 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build cdpjson
+
+package bluetoothemulation
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v ManufacturerData) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ManufacturerData) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ScanRecord) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ScanRecord) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ScanEntry) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ScanEntry) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CharacteristicProperties) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CharacteristicProperties) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v EnableArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *EnableArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SetSimulatedCentralStateArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SetSimulatedCentralStateArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SimulatePreconnectedPeripheralArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SimulatePreconnectedPeripheralArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SimulateAdvertisementArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SimulateAdvertisementArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SimulateGATTOperationResponseArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SimulateGATTOperationResponseArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SimulateCharacteristicOperationResponseArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SimulateCharacteristicOperationResponseArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SimulateDescriptorOperationResponseArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SimulateDescriptorOperationResponseArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AddServiceArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AddServiceArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AddServiceReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AddServiceReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v RemoveServiceArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *RemoveServiceArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AddCharacteristicArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AddCharacteristicArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AddCharacteristicReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AddCharacteristicReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v RemoveCharacteristicArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *RemoveCharacteristicArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AddDescriptorArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AddDescriptorArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v AddDescriptorReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *AddDescriptorReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v RemoveDescriptorArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *RemoveDescriptorArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v SimulateGATTDisconnectionArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SimulateGATTDisconnectionArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v GattOperationReceivedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *GattOperationReceivedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v CharacteristicOperationReceivedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *CharacteristicOperationReceivedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v DescriptorOperationReceivedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *DescriptorOperationReceivedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
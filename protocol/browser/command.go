@@ -3,6 +3,9 @@
 package browser
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/target"
 )
 
@@ -47,6 +50,15 @@ func (a *SetPermissionArgs) SetBrowserContextID(browserContextID ContextID) *Set
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPermissionArgs) Validate() error {
+	if !a.Setting.Valid() {
+		return errors.New("browser.SetPermissionArgs: Setting: invalid value " + strconv.Quote(string(a.Setting)))
+	}
+	return nil
+}
+
 // GrantPermissionsArgs represents the arguments for GrantPermissions in the Browser domain.
 type GrantPermissionsArgs struct {
 	Permissions      []PermissionType `json:"permissions"`                // No description.
@@ -76,6 +88,20 @@ func (a *GrantPermissionsArgs) SetBrowserContextID(browserContextID ContextID) *
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GrantPermissionsArgs) Validate() error {
+	if a.Permissions == nil {
+		return errors.New("browser.GrantPermissionsArgs: Permissions is required")
+	}
+	for i0 := range a.Permissions {
+		if !a.Permissions[i0].Valid() {
+			return errors.New("browser.GrantPermissionsArgs: Permissions" + "[" + strconv.Itoa(i0) + "]: invalid value " + strconv.Quote(string(a.Permissions[i0])))
+		}
+	}
+	return nil
+}
+
 // ResetPermissionsArgs represents the arguments for ResetPermissions in the Browser domain.
 type ResetPermissionsArgs struct {
 	BrowserContextID *ContextID `json:"browserContextId,omitempty"` // BrowserContext to reset permissions. When omitted, default browser context is used.
@@ -96,6 +122,12 @@ func (a *ResetPermissionsArgs) SetBrowserContextID(browserContextID ContextID) *
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResetPermissionsArgs) Validate() error {
+	return nil
+}
+
 // SetDownloadBehaviorArgs represents the arguments for SetDownloadBehavior in the Browser domain.
 type SetDownloadBehaviorArgs struct {
 	// Behavior Whether to allow all or deny all download requests, or use
@@ -140,6 +172,17 @@ func (a *SetDownloadBehaviorArgs) SetEventsEnabled(eventsEnabled bool) *SetDownl
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDownloadBehaviorArgs) Validate() error {
+	switch a.Behavior {
+	case "deny", "allow", "allowAndName", "default":
+	default:
+		return errors.New("browser.SetDownloadBehaviorArgs: Behavior: invalid value " + strconv.Quote(a.Behavior))
+	}
+	return nil
+}
+
 // CancelDownloadArgs represents the arguments for CancelDownload in the Browser domain.
 type CancelDownloadArgs struct {
 	GUID             string     `json:"guid"`                       // Global unique identifier of the download.
@@ -161,6 +204,12 @@ func (a *CancelDownloadArgs) SetBrowserContextID(browserContextID ContextID) *Ca
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CancelDownloadArgs) Validate() error {
+	return nil
+}
+
 // GetVersionReply represents the return values for GetVersion in the Browser domain.
 type GetVersionReply struct {
 	ProtocolVersion string `json:"protocolVersion"` // Protocol version.
@@ -203,6 +252,12 @@ func (a *GetHistogramsArgs) SetDelta(delta bool) *GetHistogramsArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetHistogramsArgs) Validate() error {
+	return nil
+}
+
 // GetHistogramsReply represents the return values for GetHistograms in the Browser domain.
 type GetHistogramsReply struct {
 	Histograms []Histogram `json:"histograms"` // Histograms.
@@ -228,6 +283,12 @@ func (a *GetHistogramArgs) SetDelta(delta bool) *GetHistogramArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetHistogramArgs) Validate() error {
+	return nil
+}
+
 // GetHistogramReply represents the return values for GetHistogram in the Browser domain.
 type GetHistogramReply struct {
 	Histogram Histogram `json:"histogram"` // Histogram.
@@ -245,6 +306,12 @@ func NewGetWindowBoundsArgs(windowID WindowID) *GetWindowBoundsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetWindowBoundsArgs) Validate() error {
+	return nil
+}
+
 // GetWindowBoundsReply represents the return values for GetWindowBounds in the Browser domain.
 type GetWindowBoundsReply struct {
 	Bounds Bounds `json:"bounds"` // Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
@@ -270,6 +337,12 @@ func (a *GetWindowForTargetArgs) SetTargetID(targetID target.ID) *GetWindowForTa
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetWindowForTargetArgs) Validate() error {
+	return nil
+}
+
 // GetWindowForTargetReply represents the return values for GetWindowForTarget in the Browser domain.
 type GetWindowForTargetReply struct {
 	WindowID WindowID `json:"windowId"` // Browser window id.
@@ -290,6 +363,12 @@ func NewSetWindowBoundsArgs(windowID WindowID, bounds Bounds) *SetWindowBoundsAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetWindowBoundsArgs) Validate() error {
+	return nil
+}
+
 // SetContentsSizeArgs represents the arguments for SetContentsSize in the Browser domain.
 type SetContentsSizeArgs struct {
 	WindowID WindowID `json:"windowId"`         // Browser window id.
@@ -320,6 +399,12 @@ func (a *SetContentsSizeArgs) SetHeight(height int) *SetContentsSizeArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetContentsSizeArgs) Validate() error {
+	return nil
+}
+
 // SetDockTileArgs represents the arguments for SetDockTile in the Browser domain.
 type SetDockTileArgs struct {
 	BadgeLabel *string `json:"badgeLabel,omitempty"` // No description.
@@ -346,6 +431,12 @@ func (a *SetDockTileArgs) SetImage(image []byte) *SetDockTileArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDockTileArgs) Validate() error {
+	return nil
+}
+
 // ExecuteBrowserCommandArgs represents the arguments for ExecuteBrowserCommand in the Browser domain.
 type ExecuteBrowserCommandArgs struct {
 	CommandID CommandID `json:"commandId"` // No description.
@@ -358,6 +449,15 @@ func NewExecuteBrowserCommandArgs(commandID CommandID) *ExecuteBrowserCommandArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ExecuteBrowserCommandArgs) Validate() error {
+	if !a.CommandID.Valid() {
+		return errors.New("browser.ExecuteBrowserCommandArgs: CommandID: invalid value " + strconv.Quote(string(a.CommandID)))
+	}
+	return nil
+}
+
 // AddPrivacySandboxEnrollmentOverrideArgs represents the arguments for AddPrivacySandboxEnrollmentOverride in the Browser domain.
 type AddPrivacySandboxEnrollmentOverrideArgs struct {
 	URL string `json:"url"` // No description.
@@ -370,6 +470,12 @@ func NewAddPrivacySandboxEnrollmentOverrideArgs(url string) *AddPrivacySandboxEn
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddPrivacySandboxEnrollmentOverrideArgs) Validate() error {
+	return nil
+}
+
 // AddPrivacySandboxCoordinatorKeyConfigArgs represents the arguments for AddPrivacySandboxCoordinatorKeyConfig in the Browser domain.
 type AddPrivacySandboxCoordinatorKeyConfigArgs struct {
 	API               PrivacySandboxAPI `json:"api"`                        // No description.
@@ -394,3 +500,12 @@ func (a *AddPrivacySandboxCoordinatorKeyConfigArgs) SetBrowserContextID(browserC
 	a.BrowserContextID = &browserContextID
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddPrivacySandboxCoordinatorKeyConfigArgs) Validate() error {
+	if !a.API.Valid() {
+		return errors.New("browser.AddPrivacySandboxCoordinatorKeyConfigArgs: API: invalid value " + strconv.Quote(string(a.API)))
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"github.com/mafredri/cdp/protocol/target"
+)
+
+// SetPermissionOption sets an optional argument of SetPermissionArgs.
+type SetPermissionOption func(*SetPermissionArgs)
+
+// NewSetPermissionArgsWith initializes SetPermissionArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetPermissionArgsWith(permission PermissionDescriptor, setting PermissionSetting, opts ...SetPermissionOption) *SetPermissionArgs {
+	args := NewSetPermissionArgs(permission, setting)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetPermissionWithOrigin sets the Origin optional argument, see
+// SetPermissionArgs.SetOrigin.
+func SetPermissionWithOrigin(v string) SetPermissionOption {
+	return func(a *SetPermissionArgs) { a.SetOrigin(v) }
+}
+
+// SetPermissionWithEmbeddedOrigin sets the EmbeddedOrigin optional argument, see
+// SetPermissionArgs.SetEmbeddedOrigin.
+func SetPermissionWithEmbeddedOrigin(v string) SetPermissionOption {
+	return func(a *SetPermissionArgs) { a.SetEmbeddedOrigin(v) }
+}
+
+// SetPermissionWithBrowserContextID sets the BrowserContextID optional argument, see
+// SetPermissionArgs.SetBrowserContextID.
+func SetPermissionWithBrowserContextID(v ContextID) SetPermissionOption {
+	return func(a *SetPermissionArgs) { a.SetBrowserContextID(v) }
+}
+
+// GrantPermissionsOption sets an optional argument of GrantPermissionsArgs.
+type GrantPermissionsOption func(*GrantPermissionsArgs)
+
+// NewGrantPermissionsArgsWith initializes GrantPermissionsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGrantPermissionsArgsWith(permissions []PermissionType, opts ...GrantPermissionsOption) *GrantPermissionsArgs {
+	args := NewGrantPermissionsArgs(permissions)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GrantPermissionsWithOrigin sets the Origin optional argument, see
+// GrantPermissionsArgs.SetOrigin.
+func GrantPermissionsWithOrigin(v string) GrantPermissionsOption {
+	return func(a *GrantPermissionsArgs) { a.SetOrigin(v) }
+}
+
+// GrantPermissionsWithBrowserContextID sets the BrowserContextID optional argument, see
+// GrantPermissionsArgs.SetBrowserContextID.
+func GrantPermissionsWithBrowserContextID(v ContextID) GrantPermissionsOption {
+	return func(a *GrantPermissionsArgs) { a.SetBrowserContextID(v) }
+}
+
+// ResetPermissionsOption sets an optional argument of ResetPermissionsArgs.
+type ResetPermissionsOption func(*ResetPermissionsArgs)
+
+// NewResetPermissionsArgsWith initializes ResetPermissionsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewResetPermissionsArgsWith(opts ...ResetPermissionsOption) *ResetPermissionsArgs {
+	args := NewResetPermissionsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ResetPermissionsWithBrowserContextID sets the BrowserContextID optional argument, see
+// ResetPermissionsArgs.SetBrowserContextID.
+func ResetPermissionsWithBrowserContextID(v ContextID) ResetPermissionsOption {
+	return func(a *ResetPermissionsArgs) { a.SetBrowserContextID(v) }
+}
+
+// SetDownloadBehaviorOption sets an optional argument of SetDownloadBehaviorArgs.
+type SetDownloadBehaviorOption func(*SetDownloadBehaviorArgs)
+
+// NewSetDownloadBehaviorArgsWith initializes SetDownloadBehaviorArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDownloadBehaviorArgsWith(behavior string, opts ...SetDownloadBehaviorOption) *SetDownloadBehaviorArgs {
+	args := NewSetDownloadBehaviorArgs(behavior)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDownloadBehaviorWithBrowserContextID sets the BrowserContextID optional argument, see
+// SetDownloadBehaviorArgs.SetBrowserContextID.
+func SetDownloadBehaviorWithBrowserContextID(v ContextID) SetDownloadBehaviorOption {
+	return func(a *SetDownloadBehaviorArgs) { a.SetBrowserContextID(v) }
+}
+
+// SetDownloadBehaviorWithDownloadPath sets the DownloadPath optional argument, see
+// SetDownloadBehaviorArgs.SetDownloadPath.
+func SetDownloadBehaviorWithDownloadPath(v string) SetDownloadBehaviorOption {
+	return func(a *SetDownloadBehaviorArgs) { a.SetDownloadPath(v) }
+}
+
+// SetDownloadBehaviorWithEventsEnabled sets the EventsEnabled optional argument, see
+// SetDownloadBehaviorArgs.SetEventsEnabled.
+func SetDownloadBehaviorWithEventsEnabled(v bool) SetDownloadBehaviorOption {
+	return func(a *SetDownloadBehaviorArgs) { a.SetEventsEnabled(v) }
+}
+
+// CancelDownloadOption sets an optional argument of CancelDownloadArgs.
+type CancelDownloadOption func(*CancelDownloadArgs)
+
+// NewCancelDownloadArgsWith initializes CancelDownloadArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCancelDownloadArgsWith(guid string, opts ...CancelDownloadOption) *CancelDownloadArgs {
+	args := NewCancelDownloadArgs(guid)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CancelDownloadWithBrowserContextID sets the BrowserContextID optional argument, see
+// CancelDownloadArgs.SetBrowserContextID.
+func CancelDownloadWithBrowserContextID(v ContextID) CancelDownloadOption {
+	return func(a *CancelDownloadArgs) { a.SetBrowserContextID(v) }
+}
+
+// GetHistogramsOption sets an optional argument of GetHistogramsArgs.
+type GetHistogramsOption func(*GetHistogramsArgs)
+
+// NewGetHistogramsArgsWith initializes GetHistogramsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetHistogramsArgsWith(opts ...GetHistogramsOption) *GetHistogramsArgs {
+	args := NewGetHistogramsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetHistogramsWithQuery sets the Query optional argument, see
+// GetHistogramsArgs.SetQuery.
+func GetHistogramsWithQuery(v string) GetHistogramsOption {
+	return func(a *GetHistogramsArgs) { a.SetQuery(v) }
+}
+
+// GetHistogramsWithDelta sets the Delta optional argument, see
+// GetHistogramsArgs.SetDelta.
+func GetHistogramsWithDelta(v bool) GetHistogramsOption {
+	return func(a *GetHistogramsArgs) { a.SetDelta(v) }
+}
+
+// GetHistogramOption sets an optional argument of GetHistogramArgs.
+type GetHistogramOption func(*GetHistogramArgs)
+
+// NewGetHistogramArgsWith initializes GetHistogramArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetHistogramArgsWith(name string, opts ...GetHistogramOption) *GetHistogramArgs {
+	args := NewGetHistogramArgs(name)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetHistogramWithDelta sets the Delta optional argument, see
+// GetHistogramArgs.SetDelta.
+func GetHistogramWithDelta(v bool) GetHistogramOption {
+	return func(a *GetHistogramArgs) { a.SetDelta(v) }
+}
+
+// GetWindowForTargetOption sets an optional argument of GetWindowForTargetArgs.
+type GetWindowForTargetOption func(*GetWindowForTargetArgs)
+
+// NewGetWindowForTargetArgsWith initializes GetWindowForTargetArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetWindowForTargetArgsWith(opts ...GetWindowForTargetOption) *GetWindowForTargetArgs {
+	args := NewGetWindowForTargetArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetWindowForTargetWithTargetID sets the TargetID optional argument, see
+// GetWindowForTargetArgs.SetTargetID.
+func GetWindowForTargetWithTargetID(v target.ID) GetWindowForTargetOption {
+	return func(a *GetWindowForTargetArgs) { a.SetTargetID(v) }
+}
+
+// SetContentsSizeOption sets an optional argument of SetContentsSizeArgs.
+type SetContentsSizeOption func(*SetContentsSizeArgs)
+
+// NewSetContentsSizeArgsWith initializes SetContentsSizeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetContentsSizeArgsWith(windowID WindowID, opts ...SetContentsSizeOption) *SetContentsSizeArgs {
+	args := NewSetContentsSizeArgs(windowID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetContentsSizeWithWidth sets the Width optional argument, see
+// SetContentsSizeArgs.SetWidth.
+func SetContentsSizeWithWidth(v int) SetContentsSizeOption {
+	return func(a *SetContentsSizeArgs) { a.SetWidth(v) }
+}
+
+// SetContentsSizeWithHeight sets the Height optional argument, see
+// SetContentsSizeArgs.SetHeight.
+func SetContentsSizeWithHeight(v int) SetContentsSizeOption {
+	return func(a *SetContentsSizeArgs) { a.SetHeight(v) }
+}
+
+// SetDockTileOption sets an optional argument of SetDockTileArgs.
+type SetDockTileOption func(*SetDockTileArgs)
+
+// NewSetDockTileArgsWith initializes SetDockTileArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDockTileArgsWith(opts ...SetDockTileOption) *SetDockTileArgs {
+	args := NewSetDockTileArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDockTileWithBadgeLabel sets the BadgeLabel optional argument, see
+// SetDockTileArgs.SetBadgeLabel.
+func SetDockTileWithBadgeLabel(v string) SetDockTileOption {
+	return func(a *SetDockTileArgs) { a.SetBadgeLabel(v) }
+}
+
+// SetDockTileWithImage sets the Image optional argument, see
+// SetDockTileArgs.SetImage.
+func SetDockTileWithImage(v []byte) SetDockTileOption {
+	return func(a *SetDockTileArgs) { a.SetImage(v) }
+}
+
+// AddPrivacySandboxCoordinatorKeyConfigOption sets an optional argument of AddPrivacySandboxCoordinatorKeyConfigArgs.
+type AddPrivacySandboxCoordinatorKeyConfigOption func(*AddPrivacySandboxCoordinatorKeyConfigArgs)
+
+// NewAddPrivacySandboxCoordinatorKeyConfigArgsWith initializes AddPrivacySandboxCoordinatorKeyConfigArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAddPrivacySandboxCoordinatorKeyConfigArgsWith(api PrivacySandboxAPI, coordinatorOrigin string, keyConfig string, opts ...AddPrivacySandboxCoordinatorKeyConfigOption) *AddPrivacySandboxCoordinatorKeyConfigArgs {
+	args := NewAddPrivacySandboxCoordinatorKeyConfigArgs(api, coordinatorOrigin, keyConfig)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AddPrivacySandboxCoordinatorKeyConfigWithBrowserContextID sets the BrowserContextID optional argument, see
+// AddPrivacySandboxCoordinatorKeyConfigArgs.SetBrowserContextID.
+func AddPrivacySandboxCoordinatorKeyConfigWithBrowserContextID(v ContextID) AddPrivacySandboxCoordinatorKeyConfigOption {
+	return func(a *AddPrivacySandboxCoordinatorKeyConfigArgs) { a.SetBrowserContextID(v) }
+}
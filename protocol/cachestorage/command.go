@@ -3,6 +3,8 @@
 package cachestorage
 
 import (
+	"errors"
+
 	"github.com/mafredri/cdp/protocol/storage"
 )
 
@@ -18,6 +20,12 @@ func NewDeleteCacheArgs(cacheID CacheID) *DeleteCacheArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteCacheArgs) Validate() error {
+	return nil
+}
+
 // DeleteEntryArgs represents the arguments for DeleteEntry in the CacheStorage domain.
 type DeleteEntryArgs struct {
 	CacheID CacheID `json:"cacheId"` // Id of cache where the entry will be deleted.
@@ -32,6 +40,12 @@ func NewDeleteEntryArgs(cacheID CacheID, request string) *DeleteEntryArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteEntryArgs) Validate() error {
+	return nil
+}
+
 // RequestCacheNamesArgs represents the arguments for RequestCacheNames in the CacheStorage domain.
 type RequestCacheNamesArgs struct {
 	SecurityOrigin *string         `json:"securityOrigin,omitempty"` // At least and at most one of securityOrigin, storageKey, storageBucket must be specified. Security origin.
@@ -67,6 +81,25 @@ func (a *RequestCacheNamesArgs) SetStorageBucket(storageBucket storage.Bucket) *
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestCacheNamesArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("cachestorage.RequestCacheNamesArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // RequestCacheNamesReply represents the return values for RequestCacheNames in the CacheStorage domain.
 type RequestCacheNamesReply struct {
 	Caches []Cache `json:"caches"` // Caches for the security origin.
@@ -88,6 +121,15 @@ func NewRequestCachedResponseArgs(cacheID CacheID, requestURL string, requestHea
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestCachedResponseArgs) Validate() error {
+	if a.RequestHeaders == nil {
+		return errors.New("cachestorage.RequestCachedResponseArgs: RequestHeaders is required")
+	}
+	return nil
+}
+
 // RequestCachedResponseReply represents the return values for RequestCachedResponse in the CacheStorage domain.
 type RequestCachedResponseReply struct {
 	Response CachedResponse `json:"response"` // Response read from the cache.
@@ -129,6 +171,12 @@ func (a *RequestEntriesArgs) SetPathFilter(pathFilter string) *RequestEntriesArg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestEntriesArgs) Validate() error {
+	return nil
+}
+
 // RequestEntriesReply represents the return values for RequestEntries in the CacheStorage domain.
 type RequestEntriesReply struct {
 	CacheDataEntries []DataEntry `json:"cacheDataEntries"` // Array of object store data entries.
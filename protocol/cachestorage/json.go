@@ -0,0 +1,504 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cachestorage
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/storage"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DataEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestURL")
+	w.String(v.RequestURL)
+	w.Key("requestMethod")
+	w.String(v.RequestMethod)
+	w.Key("requestHeaders")
+	if v.RequestHeaders == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.RequestHeaders {
+			v.RequestHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("responseTime")
+	w.Float(v.ResponseTime)
+	w.Key("responseStatus")
+	w.Int(v.ResponseStatus)
+	w.Key("responseStatusText")
+	w.String(v.ResponseStatusText)
+	w.Key("responseType")
+	w.String(string(v.ResponseType))
+	w.Key("responseHeaders")
+	if v.ResponseHeaders == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			v.ResponseHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DataEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestURL":
+			v.RequestURL = r.String()
+		case "requestMethod":
+			v.RequestMethod = r.String()
+		case "requestHeaders":
+			if r.ArrayStart() {
+				v.RequestHeaders = make([]Header, 0, 4)
+				for r.ArrayNext() {
+					var e0 Header
+					e0.DecodeJSON(r)
+					v.RequestHeaders = append(v.RequestHeaders, e0)
+				}
+			} else {
+				v.RequestHeaders = nil
+			}
+		case "responseTime":
+			v.ResponseTime = r.Float()
+		case "responseStatus":
+			v.ResponseStatus = r.Int()
+		case "responseStatusText":
+			v.ResponseStatusText = r.String()
+		case "responseType":
+			v.ResponseType = CachedResponseType(r.String())
+		case "responseHeaders":
+			if r.ArrayStart() {
+				v.ResponseHeaders = make([]Header, 0, 4)
+				for r.ArrayNext() {
+					var e0 Header
+					e0.DecodeJSON(r)
+					v.ResponseHeaders = append(v.ResponseHeaders, e0)
+				}
+			} else {
+				v.ResponseHeaders = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Cache) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheId")
+	w.String(string(v.CacheID))
+	w.Key("securityOrigin")
+	w.String(v.SecurityOrigin)
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("cacheName")
+	w.String(v.CacheName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Cache) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheId":
+			v.CacheID = CacheID(r.String())
+		case "securityOrigin":
+			v.SecurityOrigin = r.String()
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "cacheName":
+			v.CacheName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Header) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Header) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CachedResponse) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("body")
+	w.Base64(v.Body)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CachedResponse) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "body":
+			v.Body = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteCacheArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheId")
+	w.String(string(v.CacheID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteCacheArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheId":
+			v.CacheID = CacheID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteEntryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheId")
+	w.String(string(v.CacheID))
+	w.Key("request")
+	w.String(v.Request)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteEntryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheId":
+			v.CacheID = CacheID(r.String())
+		case "request":
+			v.Request = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestCacheNamesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestCacheNamesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestCacheNamesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("caches")
+	if v.Caches == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Caches {
+			v.Caches[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestCacheNamesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "caches":
+			if r.ArrayStart() {
+				v.Caches = make([]Cache, 0, 4)
+				for r.ArrayNext() {
+					var e0 Cache
+					e0.DecodeJSON(r)
+					v.Caches = append(v.Caches, e0)
+				}
+			} else {
+				v.Caches = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestCachedResponseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheId")
+	w.String(string(v.CacheID))
+	w.Key("requestURL")
+	w.String(v.RequestURL)
+	w.Key("requestHeaders")
+	if v.RequestHeaders == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.RequestHeaders {
+			v.RequestHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestCachedResponseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheId":
+			v.CacheID = CacheID(r.String())
+		case "requestURL":
+			v.RequestURL = r.String()
+		case "requestHeaders":
+			if r.ArrayStart() {
+				v.RequestHeaders = make([]Header, 0, 4)
+				for r.ArrayNext() {
+					var e0 Header
+					e0.DecodeJSON(r)
+					v.RequestHeaders = append(v.RequestHeaders, e0)
+				}
+			} else {
+				v.RequestHeaders = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestCachedResponseReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("response")
+	v.Response.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestCachedResponseReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "response":
+			v.Response.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestEntriesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheId")
+	w.String(string(v.CacheID))
+	if v.SkipCount != nil {
+		w.Key("skipCount")
+		w.Int(*v.SkipCount)
+	}
+	if v.PageSize != nil {
+		w.Key("pageSize")
+		w.Int(*v.PageSize)
+	}
+	if v.PathFilter != nil {
+		w.Key("pathFilter")
+		w.String(*v.PathFilter)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestEntriesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheId":
+			v.CacheID = CacheID(r.String())
+		case "skipCount":
+			if r.Null() {
+				v.SkipCount = nil
+			} else {
+				p := r.Int()
+				v.SkipCount = &p
+			}
+		case "pageSize":
+			if r.Null() {
+				v.PageSize = nil
+			} else {
+				p := r.Int()
+				v.PageSize = &p
+			}
+		case "pathFilter":
+			if r.Null() {
+				v.PathFilter = nil
+			} else {
+				p := r.String()
+				v.PathFilter = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestEntriesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheDataEntries")
+	if v.CacheDataEntries == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CacheDataEntries {
+			v.CacheDataEntries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("returnCount")
+	w.Float(v.ReturnCount)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestEntriesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheDataEntries":
+			if r.ArrayStart() {
+				v.CacheDataEntries = make([]DataEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 DataEntry
+					e0.DecodeJSON(r)
+					v.CacheDataEntries = append(v.CacheDataEntries, e0)
+				}
+			} else {
+				v.CacheDataEntries = nil
+			}
+		case "returnCount":
+			v.ReturnCount = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -20,6 +20,12 @@ func (a *EnableArgs) SetPresentationURL(presentationURL string) *EnableArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // SetSinkToUseArgs represents the arguments for SetSinkToUse in the Cast domain.
 type SetSinkToUseArgs struct {
 	SinkName string `json:"sinkName"` // No description.
@@ -32,6 +38,12 @@ func NewSetSinkToUseArgs(sinkName string) *SetSinkToUseArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSinkToUseArgs) Validate() error {
+	return nil
+}
+
 // StartDesktopMirroringArgs represents the arguments for StartDesktopMirroring in the Cast domain.
 type StartDesktopMirroringArgs struct {
 	SinkName string `json:"sinkName"` // No description.
@@ -44,6 +56,12 @@ func NewStartDesktopMirroringArgs(sinkName string) *StartDesktopMirroringArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartDesktopMirroringArgs) Validate() error {
+	return nil
+}
+
 // StartTabMirroringArgs represents the arguments for StartTabMirroring in the Cast domain.
 type StartTabMirroringArgs struct {
 	SinkName string `json:"sinkName"` // No description.
@@ -56,6 +74,12 @@ func NewStartTabMirroringArgs(sinkName string) *StartTabMirroringArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartTabMirroringArgs) Validate() error {
+	return nil
+}
+
 // StopCastingArgs represents the arguments for StopCasting in the Cast domain.
 type StopCastingArgs struct {
 	SinkName string `json:"sinkName"` // No description.
@@ -67,3 +91,9 @@ func NewStopCastingArgs(sinkName string) *StopCastingArgs {
 	args.SinkName = sinkName
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopCastingArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,230 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cast
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Sink) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("id")
+	w.String(v.ID)
+	if v.Session != nil {
+		w.Key("session")
+		w.String(*v.Session)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Sink) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "id":
+			v.ID = r.String()
+		case "session":
+			if r.Null() {
+				v.Session = nil
+			} else {
+				p := r.String()
+				v.Session = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.PresentationURL != nil {
+		w.Key("presentationUrl")
+		w.String(*v.PresentationURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "presentationUrl":
+			if r.Null() {
+				v.PresentationURL = nil
+			} else {
+				p := r.String()
+				v.PresentationURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSinkToUseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sinkName")
+	w.String(v.SinkName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSinkToUseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sinkName":
+			v.SinkName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartDesktopMirroringArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sinkName")
+	w.String(v.SinkName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartDesktopMirroringArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sinkName":
+			v.SinkName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartTabMirroringArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sinkName")
+	w.String(v.SinkName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartTabMirroringArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sinkName":
+			v.SinkName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopCastingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sinkName")
+	w.String(v.SinkName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopCastingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sinkName":
+			v.SinkName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SinksUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sinks")
+	if v.Sinks == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Sinks {
+			v.Sinks[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SinksUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sinks":
+			if r.ArrayStart() {
+				v.Sinks = make([]Sink, 0, 4)
+				for r.ArrayNext() {
+					var e0 Sink
+					e0.DecodeJSON(r)
+					v.Sinks = append(v.Sinks, e0)
+				}
+			} else {
+				v.Sinks = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IssueUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("issueMessage")
+	w.String(v.IssueMessage)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IssueUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "issueMessage":
+			v.IssueMessage = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -4,6 +4,8 @@ package css
 
 import (
 	"encoding/json"
+	"errors"
+	"strconv"
 
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/page"
@@ -46,6 +48,12 @@ func (a *AddRuleArgs) SetNodeForPropertySyntaxValidation(nodeForPropertySyntaxVa
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddRuleArgs) Validate() error {
+	return nil
+}
+
 // AddRuleReply represents the return values for AddRule in the CSS domain.
 type AddRuleReply struct {
 	Rule Rule `json:"rule"` // The newly created rule.
@@ -63,6 +71,12 @@ func NewCollectClassNamesArgs(styleSheetID dom.StyleSheetID) *CollectClassNamesA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CollectClassNamesArgs) Validate() error {
+	return nil
+}
+
 // CollectClassNamesReply represents the return values for CollectClassNames in the CSS domain.
 type CollectClassNamesReply struct {
 	ClassNames []string `json:"classNames"` // Class name list.
@@ -90,6 +104,12 @@ func (a *CreateStyleSheetArgs) SetForce(force bool) *CreateStyleSheetArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CreateStyleSheetArgs) Validate() error {
+	return nil
+}
+
 // CreateStyleSheetReply represents the return values for CreateStyleSheet in the CSS domain.
 type CreateStyleSheetReply struct {
 	StyleSheetID dom.StyleSheetID `json:"styleSheetId"` // Identifier of the created "via-inspector" stylesheet.
@@ -109,6 +129,15 @@ func NewForcePseudoStateArgs(nodeID dom.NodeID, forcedPseudoClasses []string) *F
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ForcePseudoStateArgs) Validate() error {
+	if a.ForcedPseudoClasses == nil {
+		return errors.New("css.ForcePseudoStateArgs: ForcedPseudoClasses is required")
+	}
+	return nil
+}
+
 // ForceStartingStyleArgs represents the arguments for ForceStartingStyle in the CSS domain.
 type ForceStartingStyleArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // The element id for which to force the starting-style state.
@@ -123,6 +152,12 @@ func NewForceStartingStyleArgs(nodeID dom.NodeID, forced bool) *ForceStartingSty
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ForceStartingStyleArgs) Validate() error {
+	return nil
+}
+
 // GetBackgroundColorsArgs represents the arguments for GetBackgroundColors in the CSS domain.
 type GetBackgroundColorsArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // Id of the node to get background colors for.
@@ -135,6 +170,12 @@ func NewGetBackgroundColorsArgs(nodeID dom.NodeID) *GetBackgroundColorsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetBackgroundColorsArgs) Validate() error {
+	return nil
+}
+
 // GetBackgroundColorsReply represents the return values for GetBackgroundColors in the CSS domain.
 type GetBackgroundColorsReply struct {
 	BackgroundColors   []string `json:"backgroundColors,omitempty"`   // The range of background colors behind this element, if it contains any visible text. If no visible text is present, this will be undefined. In the case of a flat background color, this will consist of simply that color. In the case of a gradient, this will consist of each of the color stops. For anything more complicated, this will be an empty array. Images will be ignored (as if the image had failed to load).
@@ -154,6 +195,12 @@ func NewGetComputedStyleForNodeArgs(nodeID dom.NodeID) *GetComputedStyleForNodeA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetComputedStyleForNodeArgs) Validate() error {
+	return nil
+}
+
 // GetComputedStyleForNodeReply represents the return values for GetComputedStyleForNode in the CSS domain.
 type GetComputedStyleForNodeReply struct {
 	ComputedStyle []ComputedStyleProperty `json:"computedStyle"` // Computed style for the specified DOM node.
@@ -203,6 +250,20 @@ func (a *ResolveValuesArgs) SetPseudoIdentifier(pseudoIdentifier string) *Resolv
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResolveValuesArgs) Validate() error {
+	if a.Values == nil {
+		return errors.New("css.ResolveValuesArgs: Values is required")
+	}
+	if a.PseudoType != nil {
+		if !a.PseudoType.Valid() {
+			return errors.New("css.ResolveValuesArgs: PseudoType: invalid value " + strconv.Quote(string(*a.PseudoType)))
+		}
+	}
+	return nil
+}
+
 // ResolveValuesReply represents the return values for ResolveValues in the CSS domain.
 type ResolveValuesReply struct {
 	Results []string `json:"results"` // No description.
@@ -222,6 +283,12 @@ func NewGetLonghandPropertiesArgs(shorthandName string, value string) *GetLongha
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetLonghandPropertiesArgs) Validate() error {
+	return nil
+}
+
 // GetLonghandPropertiesReply represents the return values for GetLonghandProperties in the CSS domain.
 type GetLonghandPropertiesReply struct {
 	LonghandProperties []Property `json:"longhandProperties"` // No description.
@@ -239,6 +306,12 @@ func NewGetInlineStylesForNodeArgs(nodeID dom.NodeID) *GetInlineStylesForNodeArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetInlineStylesForNodeArgs) Validate() error {
+	return nil
+}
+
 // GetInlineStylesForNodeReply represents the return values for GetInlineStylesForNode in the CSS domain.
 type GetInlineStylesForNodeReply struct {
 	InlineStyle     *Style `json:"inlineStyle,omitempty"`     // Inline style for the specified DOM node.
@@ -257,6 +330,12 @@ func NewGetAnimatedStylesForNodeArgs(nodeID dom.NodeID) *GetAnimatedStylesForNod
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAnimatedStylesForNodeArgs) Validate() error {
+	return nil
+}
+
 // GetAnimatedStylesForNodeReply represents the return values for GetAnimatedStylesForNode in the CSS domain.
 type GetAnimatedStylesForNodeReply struct {
 	AnimationStyles  []AnimationStyle              `json:"animationStyles,omitempty"`  // Styles coming from animations.
@@ -276,6 +355,12 @@ func NewGetMatchedStylesForNodeArgs(nodeID dom.NodeID) *GetMatchedStylesForNodeA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetMatchedStylesForNodeArgs) Validate() error {
+	return nil
+}
+
 // GetMatchedStylesForNodeReply represents the return values for GetMatchedStylesForNode in the CSS domain.
 type GetMatchedStylesForNodeReply struct {
 	InlineStyle                 *Style                          `json:"inlineStyle,omitempty"`                 // Inline style for the specified DOM node.
@@ -324,6 +409,12 @@ func NewGetPlatformFontsForNodeArgs(nodeID dom.NodeID) *GetPlatformFontsForNodeA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetPlatformFontsForNodeArgs) Validate() error {
+	return nil
+}
+
 // GetPlatformFontsForNodeReply represents the return values for GetPlatformFontsForNode in the CSS domain.
 type GetPlatformFontsForNodeReply struct {
 	Fonts []PlatformFontUsage `json:"fonts"` // Usage statistics for every employed platform font.
@@ -341,6 +432,12 @@ func NewGetStyleSheetTextArgs(styleSheetID dom.StyleSheetID) *GetStyleSheetTextA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetStyleSheetTextArgs) Validate() error {
+	return nil
+}
+
 // GetStyleSheetTextReply represents the return values for GetStyleSheetText in the CSS domain.
 type GetStyleSheetTextReply struct {
 	Text string `json:"text"` // The stylesheet text.
@@ -358,6 +455,12 @@ func NewGetLayersForNodeArgs(nodeID dom.NodeID) *GetLayersForNodeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetLayersForNodeArgs) Validate() error {
+	return nil
+}
+
 // GetLayersForNodeReply represents the return values for GetLayersForNode in the CSS domain.
 type GetLayersForNodeReply struct {
 	RootLayer LayerData `json:"rootLayer"` // No description.
@@ -377,6 +480,12 @@ func NewGetLocationForSelectorArgs(styleSheetID dom.StyleSheetID, selectorText s
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetLocationForSelectorArgs) Validate() error {
+	return nil
+}
+
 // GetLocationForSelectorReply represents the return values for GetLocationForSelector in the CSS domain.
 type GetLocationForSelectorReply struct {
 	Ranges []SourceRange `json:"ranges"` // No description.
@@ -400,6 +509,12 @@ func (a *TrackComputedStyleUpdatesForNodeArgs) SetNodeID(nodeID dom.NodeID) *Tra
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TrackComputedStyleUpdatesForNodeArgs) Validate() error {
+	return nil
+}
+
 // TrackComputedStyleUpdatesArgs represents the arguments for TrackComputedStyleUpdates in the CSS domain.
 type TrackComputedStyleUpdatesArgs struct {
 	PropertiesToTrack []ComputedStyleProperty `json:"propertiesToTrack"` // No description.
@@ -412,6 +527,15 @@ func NewTrackComputedStyleUpdatesArgs(propertiesToTrack []ComputedStyleProperty)
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TrackComputedStyleUpdatesArgs) Validate() error {
+	if a.PropertiesToTrack == nil {
+		return errors.New("css.TrackComputedStyleUpdatesArgs: PropertiesToTrack is required")
+	}
+	return nil
+}
+
 // TakeComputedStyleUpdatesReply represents the return values for TakeComputedStyleUpdates in the CSS domain.
 type TakeComputedStyleUpdatesReply struct {
 	NodeIDs []dom.NodeID `json:"nodeIds"` // The list of node Ids that have their tracked computed styles updated.
@@ -433,6 +557,12 @@ func NewSetEffectivePropertyValueForNodeArgs(nodeID dom.NodeID, propertyName str
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEffectivePropertyValueForNodeArgs) Validate() error {
+	return nil
+}
+
 // SetPropertyRulePropertyNameArgs represents the arguments for SetPropertyRulePropertyName in the CSS domain.
 type SetPropertyRulePropertyNameArgs struct {
 	StyleSheetID dom.StyleSheetID `json:"styleSheetId"` // No description.
@@ -449,6 +579,12 @@ func NewSetPropertyRulePropertyNameArgs(styleSheetID dom.StyleSheetID, rang Sour
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPropertyRulePropertyNameArgs) Validate() error {
+	return nil
+}
+
 // SetPropertyRulePropertyNameReply represents the return values for SetPropertyRulePropertyName in the CSS domain.
 type SetPropertyRulePropertyNameReply struct {
 	PropertyName Value `json:"propertyName"` // The resulting key text after modification.
@@ -470,6 +606,12 @@ func NewSetKeyframeKeyArgs(styleSheetID dom.StyleSheetID, rang SourceRange, keyT
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetKeyframeKeyArgs) Validate() error {
+	return nil
+}
+
 // SetKeyframeKeyReply represents the return values for SetKeyframeKey in the CSS domain.
 type SetKeyframeKeyReply struct {
 	KeyText Value `json:"keyText"` // The resulting key text after modification.
@@ -491,6 +633,12 @@ func NewSetMediaTextArgs(styleSheetID dom.StyleSheetID, rang SourceRange, text s
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetMediaTextArgs) Validate() error {
+	return nil
+}
+
 // SetMediaTextReply represents the return values for SetMediaText in the CSS domain.
 type SetMediaTextReply struct {
 	Media Media `json:"media"` // The resulting CSS media rule after modification.
@@ -512,6 +660,12 @@ func NewSetContainerQueryTextArgs(styleSheetID dom.StyleSheetID, rang SourceRang
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetContainerQueryTextArgs) Validate() error {
+	return nil
+}
+
 // SetContainerQueryTextReply represents the return values for SetContainerQueryText in the CSS domain.
 type SetContainerQueryTextReply struct {
 	ContainerQuery ContainerQuery `json:"containerQuery"` // The resulting CSS container query rule after modification.
@@ -533,6 +687,12 @@ func NewSetSupportsTextArgs(styleSheetID dom.StyleSheetID, rang SourceRange, tex
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSupportsTextArgs) Validate() error {
+	return nil
+}
+
 // SetSupportsTextReply represents the return values for SetSupportsText in the CSS domain.
 type SetSupportsTextReply struct {
 	Supports Supports `json:"supports"` // The resulting CSS Supports rule after modification.
@@ -554,6 +714,12 @@ func NewSetScopeTextArgs(styleSheetID dom.StyleSheetID, rang SourceRange, text s
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetScopeTextArgs) Validate() error {
+	return nil
+}
+
 // SetScopeTextReply represents the return values for SetScopeText in the CSS domain.
 type SetScopeTextReply struct {
 	Scope Scope `json:"scope"` // The resulting CSS Scope rule after modification.
@@ -575,6 +741,12 @@ func NewSetRuleSelectorArgs(styleSheetID dom.StyleSheetID, rang SourceRange, sel
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRuleSelectorArgs) Validate() error {
+	return nil
+}
+
 // SetRuleSelectorReply represents the return values for SetRuleSelector in the CSS domain.
 type SetRuleSelectorReply struct {
 	SelectorList SelectorList `json:"selectorList"` // The resulting selector list after modification.
@@ -594,6 +766,12 @@ func NewSetStyleSheetTextArgs(styleSheetID dom.StyleSheetID, text string) *SetSt
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetStyleSheetTextArgs) Validate() error {
+	return nil
+}
+
 // SetStyleSheetTextReply represents the return values for SetStyleSheetText in the CSS domain.
 type SetStyleSheetTextReply struct {
 	SourceMapURL *string `json:"sourceMapURL,omitempty"` // URL of source map associated with script (if any).
@@ -632,6 +810,15 @@ func (a *SetStyleTextsArgs) SetNodeForPropertySyntaxValidation(nodeForPropertySy
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetStyleTextsArgs) Validate() error {
+	if a.Edits == nil {
+		return errors.New("css.SetStyleTextsArgs: Edits is required")
+	}
+	return nil
+}
+
 // SetStyleTextsReply represents the return values for SetStyleTexts in the CSS domain.
 type SetStyleTextsReply struct {
 	Styles []Style `json:"styles"` // The resulting styles after modification.
@@ -659,3 +846,9 @@ func NewSetLocalFontsEnabledArgs(enabled bool) *SetLocalFontsEnabledArgs {
 	args.Enabled = enabled
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetLocalFontsEnabledArgs) Validate() error {
+	return nil
+}
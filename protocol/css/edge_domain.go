@@ -0,0 +1,33 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package css
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func (d *domainClient) LayoutEditorChange(ctx context.Context) (LayoutEditorChangeClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.layoutEditorChange", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &layoutEditorChangeClient{Stream: s}, nil
+}
+
+type layoutEditorChangeClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *layoutEditorChangeClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *layoutEditorChangeClient) Recv() (*LayoutEditorChangeReply, error) {
+	event := new(LayoutEditorChangeReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "CSS", Op: "LayoutEditorChange Recv", Err: err}
+	}
+	return event, nil
+}
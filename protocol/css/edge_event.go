@@ -0,0 +1,23 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package css
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// LayoutEditorChangeClient is a client for LayoutEditorChange events.
+type LayoutEditorChangeClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*LayoutEditorChangeReply, error)
+	rpcc.Stream
+}
+
+// LayoutEditorChangeReply is the reply for LayoutEditorChange events.
+type LayoutEditorChangeReply struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"` // Identifier of the stylesheet where the modification occurred.
+	ChangeRange  SourceRange  `json:"changeRange"`  // Range where the modification occurred.
+}
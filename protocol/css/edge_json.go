@@ -0,0 +1,115 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package css
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PseudoIDMatches) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("pseudoId")
+	w.Int(v.PseudoID)
+	w.Key("matches")
+	if v.Matches == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Matches {
+			v.Matches[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PseudoIDMatches) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "pseudoId":
+			v.PseudoID = r.Int()
+		case "matches":
+			if r.ArrayStart() {
+				v.Matches = make([]RuleMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 RuleMatch
+					e0.DecodeJSON(r)
+					v.Matches = append(v.Matches, e0)
+				}
+			} else {
+				v.Matches = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Selector) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.String(v.Value)
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Selector) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = r.String()
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayoutEditorChangeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("changeRange")
+	v.ChangeRange.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayoutEditorChangeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = StyleSheetID(r.String())
+		case "changeRange":
+			v.ChangeRange.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package css
+
+// StyleSheetID
+type StyleSheetID string
+
+// PseudoIDMatches CSS rule collection for a single pseudo style.
+type PseudoIDMatches struct {
+	PseudoID int         `json:"pseudoId"` // Pseudo style identifier (see enum PseudoId in ComputedStyleConstants.h).
+	Matches  []RuleMatch `json:"matches"`  // Matches of CSS rules applicable to the pseudo style.
+}
+
+// Selector Data for a simple selector (these are delimited by commas in a
+// selector list).
+type Selector struct {
+	Value string       `json:"value"`           // Selector text.
+	Range *SourceRange `json:"range,omitempty"` // Selector range in the underlying resource (if available).
+}
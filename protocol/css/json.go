@@ -0,0 +1,4552 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PseudoElementMatches) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("pseudoType")
+	w.String(string(v.PseudoType))
+	if v.PseudoIdentifier != nil {
+		w.Key("pseudoIdentifier")
+		w.String(*v.PseudoIdentifier)
+	}
+	w.Key("matches")
+	if v.Matches == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Matches {
+			v.Matches[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PseudoElementMatches) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "pseudoType":
+			v.PseudoType = dom.PseudoType(r.String())
+		case "pseudoIdentifier":
+			if r.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				p := r.String()
+				v.PseudoIdentifier = &p
+			}
+		case "matches":
+			if r.ArrayStart() {
+				v.Matches = make([]RuleMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 RuleMatch
+					e0.DecodeJSON(r)
+					v.Matches = append(v.Matches, e0)
+				}
+			} else {
+				v.Matches = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AnimationStyle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AnimationStyle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "style":
+			v.Style.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InheritedStyleEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.InlineStyle != nil {
+		w.Key("inlineStyle")
+		v.InlineStyle.EncodeJSON(w)
+	}
+	w.Key("matchedCSSRules")
+	if v.MatchedCSSRules == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.MatchedCSSRules {
+			v.MatchedCSSRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InheritedStyleEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "inlineStyle":
+			if r.Null() {
+				v.InlineStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.InlineStyle = &p
+			}
+		case "matchedCSSRules":
+			if r.ArrayStart() {
+				v.MatchedCSSRules = make([]RuleMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 RuleMatch
+					e0.DecodeJSON(r)
+					v.MatchedCSSRules = append(v.MatchedCSSRules, e0)
+				}
+			} else {
+				v.MatchedCSSRules = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InheritedAnimatedStyleEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.AnimationStyles) > 0 {
+		w.Key("animationStyles")
+		w.ArrayStart()
+		for i0 := range v.AnimationStyles {
+			v.AnimationStyles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.TransitionsStyle != nil {
+		w.Key("transitionsStyle")
+		v.TransitionsStyle.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InheritedAnimatedStyleEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animationStyles":
+			if r.ArrayStart() {
+				v.AnimationStyles = make([]AnimationStyle, 0, 4)
+				for r.ArrayNext() {
+					var e0 AnimationStyle
+					e0.DecodeJSON(r)
+					v.AnimationStyles = append(v.AnimationStyles, e0)
+				}
+			} else {
+				v.AnimationStyles = nil
+			}
+		case "transitionsStyle":
+			if r.Null() {
+				v.TransitionsStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.TransitionsStyle = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InheritedPseudoElementMatches) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("pseudoElements")
+	if v.PseudoElements == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.PseudoElements {
+			v.PseudoElements[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InheritedPseudoElementMatches) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "pseudoElements":
+			if r.ArrayStart() {
+				v.PseudoElements = make([]PseudoElementMatches, 0, 4)
+				for r.ArrayNext() {
+					var e0 PseudoElementMatches
+					e0.DecodeJSON(r)
+					v.PseudoElements = append(v.PseudoElements, e0)
+				}
+			} else {
+				v.PseudoElements = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RuleMatch) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rule")
+	v.Rule.EncodeJSON(w)
+	w.Key("matchingSelectors")
+	if v.MatchingSelectors == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.MatchingSelectors {
+			w.Int(v.MatchingSelectors[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RuleMatch) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rule":
+			v.Rule.DecodeJSON(r)
+		case "matchingSelectors":
+			if r.ArrayStart() {
+				v.MatchingSelectors = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.MatchingSelectors = append(v.MatchingSelectors, e0)
+				}
+			} else {
+				v.MatchingSelectors = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Value) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.Specificity != nil {
+		w.Key("specificity")
+		v.Specificity.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Value) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "specificity":
+			if r.Null() {
+				v.Specificity = nil
+			} else {
+				var p Specificity
+				p.DecodeJSON(r)
+				v.Specificity = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Specificity) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("a")
+	w.Int(v.A)
+	w.Key("b")
+	w.Int(v.B)
+	w.Key("c")
+	w.Int(v.C)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Specificity) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "a":
+			v.A = r.Int()
+		case "b":
+			v.B = r.Int()
+		case "c":
+			v.C = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SelectorList) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("selectors")
+	if v.Selectors == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Selectors {
+			v.Selectors[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SelectorList) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "selectors":
+			if r.ArrayStart() {
+				v.Selectors = make([]Value, 0, 4)
+				for r.ArrayNext() {
+					var e0 Value
+					e0.DecodeJSON(r)
+					v.Selectors = append(v.Selectors, e0)
+				}
+			} else {
+				v.Selectors = nil
+			}
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StyleSheetHeader) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("sourceURL")
+	w.String(v.SourceURL)
+	if v.SourceMapURL != nil {
+		w.Key("sourceMapURL")
+		w.String(*v.SourceMapURL)
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("title")
+	w.String(v.Title)
+	if v.OwnerNode != nil {
+		w.Key("ownerNode")
+		w.Int(int(*v.OwnerNode))
+	}
+	w.Key("disabled")
+	w.Bool(v.Disabled)
+	if v.HasSourceURL != nil {
+		w.Key("hasSourceURL")
+		w.Bool(*v.HasSourceURL)
+	}
+	w.Key("isInline")
+	w.Bool(v.IsInline)
+	w.Key("isMutable")
+	w.Bool(v.IsMutable)
+	w.Key("isConstructed")
+	w.Bool(v.IsConstructed)
+	w.Key("startLine")
+	w.Float(v.StartLine)
+	w.Key("startColumn")
+	w.Float(v.StartColumn)
+	w.Key("length")
+	w.Float(v.Length)
+	w.Key("endLine")
+	w.Float(v.EndLine)
+	w.Key("endColumn")
+	w.Float(v.EndColumn)
+	if v.LoadingFailed != nil {
+		w.Key("loadingFailed")
+		w.Bool(*v.LoadingFailed)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StyleSheetHeader) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "sourceURL":
+			v.SourceURL = r.String()
+		case "sourceMapURL":
+			if r.Null() {
+				v.SourceMapURL = nil
+			} else {
+				p := r.String()
+				v.SourceMapURL = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "title":
+			v.Title = r.String()
+		case "ownerNode":
+			if r.Null() {
+				v.OwnerNode = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.OwnerNode = &p
+			}
+		case "disabled":
+			v.Disabled = r.Bool()
+		case "hasSourceURL":
+			if r.Null() {
+				v.HasSourceURL = nil
+			} else {
+				p := r.Bool()
+				v.HasSourceURL = &p
+			}
+		case "isInline":
+			v.IsInline = r.Bool()
+		case "isMutable":
+			v.IsMutable = r.Bool()
+		case "isConstructed":
+			v.IsConstructed = r.Bool()
+		case "startLine":
+			v.StartLine = r.Float()
+		case "startColumn":
+			v.StartColumn = r.Float()
+		case "length":
+			v.Length = r.Float()
+		case "endLine":
+			v.EndLine = r.Float()
+		case "endColumn":
+			v.EndColumn = r.Float()
+		case "loadingFailed":
+			if r.Null() {
+				v.LoadingFailed = nil
+			} else {
+				p := r.Bool()
+				v.LoadingFailed = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Rule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("selectorList")
+	v.SelectorList.EncodeJSON(w)
+	if len(v.NestingSelectors) > 0 {
+		w.Key("nestingSelectors")
+		w.ArrayStart()
+		for i0 := range v.NestingSelectors {
+			w.String(v.NestingSelectors[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	if v.OriginTreeScopeNodeID != nil {
+		w.Key("originTreeScopeNodeId")
+		w.Int(int(*v.OriginTreeScopeNodeID))
+	}
+	if len(v.Media) > 0 {
+		w.Key("media")
+		w.ArrayStart()
+		for i0 := range v.Media {
+			v.Media[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.ContainerQueries) > 0 {
+		w.Key("containerQueries")
+		w.ArrayStart()
+		for i0 := range v.ContainerQueries {
+			v.ContainerQueries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Supports) > 0 {
+		w.Key("supports")
+		w.ArrayStart()
+		for i0 := range v.Supports {
+			v.Supports[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Layers) > 0 {
+		w.Key("layers")
+		w.ArrayStart()
+		for i0 := range v.Layers {
+			v.Layers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Scopes) > 0 {
+		w.Key("scopes")
+		w.ArrayStart()
+		for i0 := range v.Scopes {
+			v.Scopes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.RuleTypes) > 0 {
+		w.Key("ruleTypes")
+		w.ArrayStart()
+		for i0 := range v.RuleTypes {
+			w.String(string(v.RuleTypes[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if len(v.StartingStyles) > 0 {
+		w.Key("startingStyles")
+		w.ArrayStart()
+		for i0 := range v.StartingStyles {
+			v.StartingStyles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Rule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "selectorList":
+			v.SelectorList.DecodeJSON(r)
+		case "nestingSelectors":
+			if r.ArrayStart() {
+				v.NestingSelectors = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.NestingSelectors = append(v.NestingSelectors, e0)
+				}
+			} else {
+				v.NestingSelectors = nil
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "style":
+			v.Style.DecodeJSON(r)
+		case "originTreeScopeNodeId":
+			if r.Null() {
+				v.OriginTreeScopeNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.OriginTreeScopeNodeID = &p
+			}
+		case "media":
+			if r.ArrayStart() {
+				v.Media = make([]Media, 0, 4)
+				for r.ArrayNext() {
+					var e0 Media
+					e0.DecodeJSON(r)
+					v.Media = append(v.Media, e0)
+				}
+			} else {
+				v.Media = nil
+			}
+		case "containerQueries":
+			if r.ArrayStart() {
+				v.ContainerQueries = make([]ContainerQuery, 0, 4)
+				for r.ArrayNext() {
+					var e0 ContainerQuery
+					e0.DecodeJSON(r)
+					v.ContainerQueries = append(v.ContainerQueries, e0)
+				}
+			} else {
+				v.ContainerQueries = nil
+			}
+		case "supports":
+			if r.ArrayStart() {
+				v.Supports = make([]Supports, 0, 4)
+				for r.ArrayNext() {
+					var e0 Supports
+					e0.DecodeJSON(r)
+					v.Supports = append(v.Supports, e0)
+				}
+			} else {
+				v.Supports = nil
+			}
+		case "layers":
+			if r.ArrayStart() {
+				v.Layers = make([]Layer, 0, 4)
+				for r.ArrayNext() {
+					var e0 Layer
+					e0.DecodeJSON(r)
+					v.Layers = append(v.Layers, e0)
+				}
+			} else {
+				v.Layers = nil
+			}
+		case "scopes":
+			if r.ArrayStart() {
+				v.Scopes = make([]Scope, 0, 4)
+				for r.ArrayNext() {
+					var e0 Scope
+					e0.DecodeJSON(r)
+					v.Scopes = append(v.Scopes, e0)
+				}
+			} else {
+				v.Scopes = nil
+			}
+		case "ruleTypes":
+			if r.ArrayStart() {
+				v.RuleTypes = make([]RuleType, 0, 4)
+				for r.ArrayNext() {
+					e0 := RuleType(r.String())
+					v.RuleTypes = append(v.RuleTypes, e0)
+				}
+			} else {
+				v.RuleTypes = nil
+			}
+		case "startingStyles":
+			if r.ArrayStart() {
+				v.StartingStyles = make([]StartingStyle, 0, 4)
+				for r.ArrayNext() {
+					var e0 StartingStyle
+					e0.DecodeJSON(r)
+					v.StartingStyles = append(v.StartingStyles, e0)
+				}
+			} else {
+				v.StartingStyles = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RuleUsage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("startOffset")
+	w.Float(v.StartOffset)
+	w.Key("endOffset")
+	w.Float(v.EndOffset)
+	w.Key("used")
+	w.Bool(v.Used)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RuleUsage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "startOffset":
+			v.StartOffset = r.Float()
+		case "endOffset":
+			v.EndOffset = r.Float()
+		case "used":
+			v.Used = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SourceRange) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("startLine")
+	w.Int(v.StartLine)
+	w.Key("startColumn")
+	w.Int(v.StartColumn)
+	w.Key("endLine")
+	w.Int(v.EndLine)
+	w.Key("endColumn")
+	w.Int(v.EndColumn)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SourceRange) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "startLine":
+			v.StartLine = r.Int()
+		case "startColumn":
+			v.StartColumn = r.Int()
+		case "endLine":
+			v.EndLine = r.Int()
+		case "endColumn":
+			v.EndColumn = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ShorthandEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	if v.Important != nil {
+		w.Key("important")
+		w.Bool(*v.Important)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ShorthandEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		case "important":
+			if r.Null() {
+				v.Important = nil
+			} else {
+				p := r.Bool()
+				v.Important = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ComputedStyleProperty) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ComputedStyleProperty) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ComputedStyleExtraFields) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isAppearanceBase")
+	w.Bool(v.IsAppearanceBase)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ComputedStyleExtraFields) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isAppearanceBase":
+			v.IsAppearanceBase = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Style) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("cssProperties")
+	if v.CSSProperties == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CSSProperties {
+			v.CSSProperties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("shorthandEntries")
+	if v.ShorthandEntries == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ShorthandEntries {
+			v.ShorthandEntries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.CSSText != nil {
+		w.Key("cssText")
+		w.String(*v.CSSText)
+	}
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Style) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "cssProperties":
+			if r.ArrayStart() {
+				v.CSSProperties = make([]Property, 0, 4)
+				for r.ArrayNext() {
+					var e0 Property
+					e0.DecodeJSON(r)
+					v.CSSProperties = append(v.CSSProperties, e0)
+				}
+			} else {
+				v.CSSProperties = nil
+			}
+		case "shorthandEntries":
+			if r.ArrayStart() {
+				v.ShorthandEntries = make([]ShorthandEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 ShorthandEntry
+					e0.DecodeJSON(r)
+					v.ShorthandEntries = append(v.ShorthandEntries, e0)
+				}
+			} else {
+				v.ShorthandEntries = nil
+			}
+		case "cssText":
+			if r.Null() {
+				v.CSSText = nil
+			} else {
+				p := r.String()
+				v.CSSText = &p
+			}
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Property) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	if v.Important != nil {
+		w.Key("important")
+		w.Bool(*v.Important)
+	}
+	if v.Implicit != nil {
+		w.Key("implicit")
+		w.Bool(*v.Implicit)
+	}
+	if v.Text != nil {
+		w.Key("text")
+		w.String(*v.Text)
+	}
+	if v.ParsedOk != nil {
+		w.Key("parsedOk")
+		w.Bool(*v.ParsedOk)
+	}
+	if v.Disabled != nil {
+		w.Key("disabled")
+		w.Bool(*v.Disabled)
+	}
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if len(v.LonghandProperties) > 0 {
+		w.Key("longhandProperties")
+		w.ArrayStart()
+		for i0 := range v.LonghandProperties {
+			v.LonghandProperties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Property) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		case "important":
+			if r.Null() {
+				v.Important = nil
+			} else {
+				p := r.Bool()
+				v.Important = &p
+			}
+		case "implicit":
+			if r.Null() {
+				v.Implicit = nil
+			} else {
+				p := r.Bool()
+				v.Implicit = &p
+			}
+		case "text":
+			if r.Null() {
+				v.Text = nil
+			} else {
+				p := r.String()
+				v.Text = &p
+			}
+		case "parsedOk":
+			if r.Null() {
+				v.ParsedOk = nil
+			} else {
+				p := r.Bool()
+				v.ParsedOk = &p
+			}
+		case "disabled":
+			if r.Null() {
+				v.Disabled = nil
+			} else {
+				p := r.Bool()
+				v.Disabled = &p
+			}
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "longhandProperties":
+			if r.ArrayStart() {
+				v.LonghandProperties = make([]Property, 0, 4)
+				for r.ArrayNext() {
+					var e0 Property
+					e0.DecodeJSON(r)
+					v.LonghandProperties = append(v.LonghandProperties, e0)
+				}
+			} else {
+				v.LonghandProperties = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Media) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	w.Key("source")
+	w.String(v.Source)
+	if v.SourceURL != nil {
+		w.Key("sourceURL")
+		w.String(*v.SourceURL)
+	}
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	if len(v.MediaList) > 0 {
+		w.Key("mediaList")
+		w.ArrayStart()
+		for i0 := range v.MediaList {
+			v.MediaList[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Media) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "source":
+			v.Source = r.String()
+		case "sourceURL":
+			if r.Null() {
+				v.SourceURL = nil
+			} else {
+				p := r.String()
+				v.SourceURL = &p
+			}
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "mediaList":
+			if r.ArrayStart() {
+				v.MediaList = make([]MediaQuery, 0, 4)
+				for r.ArrayNext() {
+					var e0 MediaQuery
+					e0.DecodeJSON(r)
+					v.MediaList = append(v.MediaList, e0)
+				}
+			} else {
+				v.MediaList = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MediaQuery) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("expressions")
+	if v.Expressions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Expressions {
+			v.Expressions[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("active")
+	w.Bool(v.Active)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MediaQuery) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "expressions":
+			if r.ArrayStart() {
+				v.Expressions = make([]MediaQueryExpression, 0, 4)
+				for r.ArrayNext() {
+					var e0 MediaQueryExpression
+					e0.DecodeJSON(r)
+					v.Expressions = append(v.Expressions, e0)
+				}
+			} else {
+				v.Expressions = nil
+			}
+		case "active":
+			v.Active = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MediaQueryExpression) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.Float(v.Value)
+	w.Key("unit")
+	w.String(v.Unit)
+	w.Key("feature")
+	w.String(v.Feature)
+	if v.ValueRange != nil {
+		w.Key("valueRange")
+		v.ValueRange.EncodeJSON(w)
+	}
+	if v.ComputedLength != nil {
+		w.Key("computedLength")
+		w.Float(*v.ComputedLength)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MediaQueryExpression) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = r.Float()
+		case "unit":
+			v.Unit = r.String()
+		case "feature":
+			v.Feature = r.String()
+		case "valueRange":
+			if r.Null() {
+				v.ValueRange = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.ValueRange = &p
+			}
+		case "computedLength":
+			if r.Null() {
+				v.ComputedLength = nil
+			} else {
+				p := r.Float()
+				v.ComputedLength = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContainerQuery) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	if v.PhysicalAxes != nil {
+		w.Key("physicalAxes")
+		w.String(string(*v.PhysicalAxes))
+	}
+	if v.LogicalAxes != nil {
+		w.Key("logicalAxes")
+		w.String(string(*v.LogicalAxes))
+	}
+	if v.QueriesScrollState != nil {
+		w.Key("queriesScrollState")
+		w.Bool(*v.QueriesScrollState)
+	}
+	if v.QueriesAnchored != nil {
+		w.Key("queriesAnchored")
+		w.Bool(*v.QueriesAnchored)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContainerQuery) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "physicalAxes":
+			if r.Null() {
+				v.PhysicalAxes = nil
+			} else {
+				p := dom.PhysicalAxes(r.String())
+				v.PhysicalAxes = &p
+			}
+		case "logicalAxes":
+			if r.Null() {
+				v.LogicalAxes = nil
+			} else {
+				p := dom.LogicalAxes(r.String())
+				v.LogicalAxes = &p
+			}
+		case "queriesScrollState":
+			if r.Null() {
+				v.QueriesScrollState = nil
+			} else {
+				p := r.Bool()
+				v.QueriesScrollState = &p
+			}
+		case "queriesAnchored":
+			if r.Null() {
+				v.QueriesAnchored = nil
+			} else {
+				p := r.Bool()
+				v.QueriesAnchored = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Supports) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	w.Key("active")
+	w.Bool(v.Active)
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Supports) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "active":
+			v.Active = r.Bool()
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Scope) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Scope) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Layer) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Layer) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartingStyle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Range != nil {
+		w.Key("range")
+		v.Range.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartingStyle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "range":
+			if r.Null() {
+				v.Range = nil
+			} else {
+				var p SourceRange
+				p.DecodeJSON(r)
+				v.Range = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayerData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if len(v.SubLayers) > 0 {
+		w.Key("subLayers")
+		w.ArrayStart()
+		for i0 := range v.SubLayers {
+			v.SubLayers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("order")
+	w.Float(v.Order)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayerData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "subLayers":
+			if r.ArrayStart() {
+				v.SubLayers = make([]LayerData, 0, 4)
+				for r.ArrayNext() {
+					var e0 LayerData
+					e0.DecodeJSON(r)
+					v.SubLayers = append(v.SubLayers, e0)
+				}
+			} else {
+				v.SubLayers = nil
+			}
+		case "order":
+			v.Order = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlatformFontUsage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("familyName")
+	w.String(v.FamilyName)
+	w.Key("postScriptName")
+	w.String(v.PostScriptName)
+	w.Key("isCustomFont")
+	w.Bool(v.IsCustomFont)
+	w.Key("glyphCount")
+	w.Float(v.GlyphCount)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlatformFontUsage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "familyName":
+			v.FamilyName = r.String()
+		case "postScriptName":
+			v.PostScriptName = r.String()
+		case "isCustomFont":
+			v.IsCustomFont = r.Bool()
+		case "glyphCount":
+			v.GlyphCount = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FontVariationAxis) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("tag")
+	w.String(v.Tag)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("minValue")
+	w.Float(v.MinValue)
+	w.Key("maxValue")
+	w.Float(v.MaxValue)
+	w.Key("defaultValue")
+	w.Float(v.DefaultValue)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FontVariationAxis) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "tag":
+			v.Tag = r.String()
+		case "name":
+			v.Name = r.String()
+		case "minValue":
+			v.MinValue = r.Float()
+		case "maxValue":
+			v.MaxValue = r.Float()
+		case "defaultValue":
+			v.DefaultValue = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FontFace) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fontFamily")
+	w.String(v.FontFamily)
+	w.Key("fontStyle")
+	w.String(v.FontStyle)
+	w.Key("fontVariant")
+	w.String(v.FontVariant)
+	w.Key("fontWeight")
+	w.String(v.FontWeight)
+	w.Key("fontStretch")
+	w.String(v.FontStretch)
+	w.Key("fontDisplay")
+	w.String(v.FontDisplay)
+	w.Key("unicodeRange")
+	w.String(v.UnicodeRange)
+	w.Key("src")
+	w.String(v.Src)
+	w.Key("platformFontFamily")
+	w.String(v.PlatformFontFamily)
+	if len(v.FontVariationAxes) > 0 {
+		w.Key("fontVariationAxes")
+		w.ArrayStart()
+		for i0 := range v.FontVariationAxes {
+			v.FontVariationAxes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FontFace) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fontFamily":
+			v.FontFamily = r.String()
+		case "fontStyle":
+			v.FontStyle = r.String()
+		case "fontVariant":
+			v.FontVariant = r.String()
+		case "fontWeight":
+			v.FontWeight = r.String()
+		case "fontStretch":
+			v.FontStretch = r.String()
+		case "fontDisplay":
+			v.FontDisplay = r.String()
+		case "unicodeRange":
+			v.UnicodeRange = r.String()
+		case "src":
+			v.Src = r.String()
+		case "platformFontFamily":
+			v.PlatformFontFamily = r.String()
+		case "fontVariationAxes":
+			if r.ArrayStart() {
+				v.FontVariationAxes = make([]FontVariationAxis, 0, 4)
+				for r.ArrayNext() {
+					var e0 FontVariationAxis
+					e0.DecodeJSON(r)
+					v.FontVariationAxes = append(v.FontVariationAxes, e0)
+				}
+			} else {
+				v.FontVariationAxes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TryRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TryRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "style":
+			v.Style.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PositionTryRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	v.Name.EncodeJSON(w)
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	w.Key("active")
+	w.Bool(v.Active)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PositionTryRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name.DecodeJSON(r)
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "style":
+			v.Style.DecodeJSON(r)
+		case "active":
+			v.Active = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *KeyframesRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animationName")
+	v.AnimationName.EncodeJSON(w)
+	w.Key("keyframes")
+	if v.Keyframes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Keyframes {
+			v.Keyframes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *KeyframesRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animationName":
+			v.AnimationName.DecodeJSON(r)
+		case "keyframes":
+			if r.ArrayStart() {
+				v.Keyframes = make([]KeyframeRule, 0, 4)
+				for r.ArrayNext() {
+					var e0 KeyframeRule
+					e0.DecodeJSON(r)
+					v.Keyframes = append(v.Keyframes, e0)
+				}
+			} else {
+				v.Keyframes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PropertyRegistration) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("propertyName")
+	w.String(v.PropertyName)
+	if v.InitialValue != nil {
+		w.Key("initialValue")
+		v.InitialValue.EncodeJSON(w)
+	}
+	w.Key("inherits")
+	w.Bool(v.Inherits)
+	w.Key("syntax")
+	w.String(v.Syntax)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PropertyRegistration) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "propertyName":
+			v.PropertyName = r.String()
+		case "initialValue":
+			if r.Null() {
+				v.InitialValue = nil
+			} else {
+				var p Value
+				p.DecodeJSON(r)
+				v.InitialValue = &p
+			}
+		case "inherits":
+			v.Inherits = r.Bool()
+		case "syntax":
+			v.Syntax = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AtRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.Subsection != nil {
+		w.Key("subsection")
+		w.String(*v.Subsection)
+	}
+	if v.Name != nil {
+		w.Key("name")
+		v.Name.EncodeJSON(w)
+	}
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AtRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "subsection":
+			if r.Null() {
+				v.Subsection = nil
+			} else {
+				p := r.String()
+				v.Subsection = &p
+			}
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				var p Value
+				p.DecodeJSON(r)
+				v.Name = &p
+			}
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "style":
+			v.Style.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PropertyRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("propertyName")
+	v.PropertyName.EncodeJSON(w)
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PropertyRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "propertyName":
+			v.PropertyName.DecodeJSON(r)
+		case "style":
+			v.Style.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FunctionParameter) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("type")
+	w.String(v.Type)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FunctionParameter) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "type":
+			v.Type = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FunctionConditionNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Media != nil {
+		w.Key("media")
+		v.Media.EncodeJSON(w)
+	}
+	if v.ContainerQueries != nil {
+		w.Key("containerQueries")
+		v.ContainerQueries.EncodeJSON(w)
+	}
+	if v.Supports != nil {
+		w.Key("supports")
+		v.Supports.EncodeJSON(w)
+	}
+	w.Key("children")
+	if v.Children == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Children {
+			v.Children[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("conditionText")
+	w.String(v.ConditionText)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FunctionConditionNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "media":
+			if r.Null() {
+				v.Media = nil
+			} else {
+				var p Media
+				p.DecodeJSON(r)
+				v.Media = &p
+			}
+		case "containerQueries":
+			if r.Null() {
+				v.ContainerQueries = nil
+			} else {
+				var p ContainerQuery
+				p.DecodeJSON(r)
+				v.ContainerQueries = &p
+			}
+		case "supports":
+			if r.Null() {
+				v.Supports = nil
+			} else {
+				var p Supports
+				p.DecodeJSON(r)
+				v.Supports = &p
+			}
+		case "children":
+			if r.ArrayStart() {
+				v.Children = make([]FunctionNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 FunctionNode
+					e0.DecodeJSON(r)
+					v.Children = append(v.Children, e0)
+				}
+			} else {
+				v.Children = nil
+			}
+		case "conditionText":
+			v.ConditionText = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FunctionNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Condition != nil {
+		w.Key("condition")
+		v.Condition.EncodeJSON(w)
+	}
+	if v.Style != nil {
+		w.Key("style")
+		v.Style.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FunctionNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "condition":
+			if r.Null() {
+				v.Condition = nil
+			} else {
+				var p FunctionConditionNode
+				p.DecodeJSON(r)
+				v.Condition = &p
+			}
+		case "style":
+			if r.Null() {
+				v.Style = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.Style = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FunctionRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	v.Name.EncodeJSON(w)
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("parameters")
+	if v.Parameters == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Parameters {
+			v.Parameters[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("children")
+	if v.Children == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Children {
+			v.Children[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FunctionRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name.DecodeJSON(r)
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "parameters":
+			if r.ArrayStart() {
+				v.Parameters = make([]FunctionParameter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FunctionParameter
+					e0.DecodeJSON(r)
+					v.Parameters = append(v.Parameters, e0)
+				}
+			} else {
+				v.Parameters = nil
+			}
+		case "children":
+			if r.ArrayStart() {
+				v.Children = make([]FunctionNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 FunctionNode
+					e0.DecodeJSON(r)
+					v.Children = append(v.Children, e0)
+				}
+			} else {
+				v.Children = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *KeyframeRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.StyleSheetID != nil {
+		w.Key("styleSheetId")
+		w.String(string(*v.StyleSheetID))
+	}
+	w.Key("origin")
+	w.String(string(v.Origin))
+	w.Key("keyText")
+	v.KeyText.EncodeJSON(w)
+	w.Key("style")
+	v.Style.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *KeyframeRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			if r.Null() {
+				v.StyleSheetID = nil
+			} else {
+				p := dom.StyleSheetID(r.String())
+				v.StyleSheetID = &p
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(r.String())
+		case "keyText":
+			v.KeyText.DecodeJSON(r)
+		case "style":
+			v.Style.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StyleDeclarationEdit) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StyleDeclarationEdit) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddRuleArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("ruleText")
+	w.String(v.RuleText)
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	if v.NodeForPropertySyntaxValidation != nil {
+		w.Key("nodeForPropertySyntaxValidation")
+		w.Int(int(*v.NodeForPropertySyntaxValidation))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddRuleArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "ruleText":
+			v.RuleText = r.String()
+		case "location":
+			v.Location.DecodeJSON(r)
+		case "nodeForPropertySyntaxValidation":
+			if r.Null() {
+				v.NodeForPropertySyntaxValidation = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeForPropertySyntaxValidation = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddRuleReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rule")
+	v.Rule.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddRuleReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rule":
+			v.Rule.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CollectClassNamesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CollectClassNamesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CollectClassNamesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("classNames")
+	if v.ClassNames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ClassNames {
+			w.String(v.ClassNames[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CollectClassNamesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "classNames":
+			if r.ArrayStart() {
+				v.ClassNames = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ClassNames = append(v.ClassNames, e0)
+				}
+			} else {
+				v.ClassNames = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateStyleSheetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	if v.Force != nil {
+		w.Key("force")
+		w.Bool(*v.Force)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateStyleSheetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "force":
+			if r.Null() {
+				v.Force = nil
+			} else {
+				p := r.Bool()
+				v.Force = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateStyleSheetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateStyleSheetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ForcePseudoStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("forcedPseudoClasses")
+	if v.ForcedPseudoClasses == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ForcedPseudoClasses {
+			w.String(v.ForcedPseudoClasses[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ForcePseudoStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "forcedPseudoClasses":
+			if r.ArrayStart() {
+				v.ForcedPseudoClasses = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ForcedPseudoClasses = append(v.ForcedPseudoClasses, e0)
+				}
+			} else {
+				v.ForcedPseudoClasses = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ForceStartingStyleArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("forced")
+	w.Bool(v.Forced)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ForceStartingStyleArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "forced":
+			v.Forced = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBackgroundColorsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBackgroundColorsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBackgroundColorsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.BackgroundColors) > 0 {
+		w.Key("backgroundColors")
+		w.ArrayStart()
+		for i0 := range v.BackgroundColors {
+			w.String(v.BackgroundColors[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.ComputedFontSize != nil {
+		w.Key("computedFontSize")
+		w.String(*v.ComputedFontSize)
+	}
+	if v.ComputedFontWeight != nil {
+		w.Key("computedFontWeight")
+		w.String(*v.ComputedFontWeight)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBackgroundColorsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backgroundColors":
+			if r.ArrayStart() {
+				v.BackgroundColors = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.BackgroundColors = append(v.BackgroundColors, e0)
+				}
+			} else {
+				v.BackgroundColors = nil
+			}
+		case "computedFontSize":
+			if r.Null() {
+				v.ComputedFontSize = nil
+			} else {
+				p := r.String()
+				v.ComputedFontSize = &p
+			}
+		case "computedFontWeight":
+			if r.Null() {
+				v.ComputedFontWeight = nil
+			} else {
+				p := r.String()
+				v.ComputedFontWeight = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetComputedStyleForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetComputedStyleForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetComputedStyleForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("computedStyle")
+	if v.ComputedStyle == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ComputedStyle {
+			v.ComputedStyle[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("extraFields")
+	v.ExtraFields.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetComputedStyleForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "computedStyle":
+			if r.ArrayStart() {
+				v.ComputedStyle = make([]ComputedStyleProperty, 0, 4)
+				for r.ArrayNext() {
+					var e0 ComputedStyleProperty
+					e0.DecodeJSON(r)
+					v.ComputedStyle = append(v.ComputedStyle, e0)
+				}
+			} else {
+				v.ComputedStyle = nil
+			}
+		case "extraFields":
+			v.ExtraFields.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveValuesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("values")
+	if v.Values == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Values {
+			w.String(v.Values[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	if v.PropertyName != nil {
+		w.Key("propertyName")
+		w.String(*v.PropertyName)
+	}
+	if v.PseudoType != nil {
+		w.Key("pseudoType")
+		w.String(string(*v.PseudoType))
+	}
+	if v.PseudoIdentifier != nil {
+		w.Key("pseudoIdentifier")
+		w.String(*v.PseudoIdentifier)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveValuesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "values":
+			if r.ArrayStart() {
+				v.Values = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Values = append(v.Values, e0)
+				}
+			} else {
+				v.Values = nil
+			}
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "propertyName":
+			if r.Null() {
+				v.PropertyName = nil
+			} else {
+				p := r.String()
+				v.PropertyName = &p
+			}
+		case "pseudoType":
+			if r.Null() {
+				v.PseudoType = nil
+			} else {
+				p := dom.PseudoType(r.String())
+				v.PseudoType = &p
+			}
+		case "pseudoIdentifier":
+			if r.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				p := r.String()
+				v.PseudoIdentifier = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveValuesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("results")
+	if v.Results == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Results {
+			w.String(v.Results[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveValuesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "results":
+			if r.ArrayStart() {
+				v.Results = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Results = append(v.Results, e0)
+				}
+			} else {
+				v.Results = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLonghandPropertiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("shorthandName")
+	w.String(v.ShorthandName)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLonghandPropertiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "shorthandName":
+			v.ShorthandName = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLonghandPropertiesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("longhandProperties")
+	if v.LonghandProperties == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.LonghandProperties {
+			v.LonghandProperties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLonghandPropertiesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "longhandProperties":
+			if r.ArrayStart() {
+				v.LonghandProperties = make([]Property, 0, 4)
+				for r.ArrayNext() {
+					var e0 Property
+					e0.DecodeJSON(r)
+					v.LonghandProperties = append(v.LonghandProperties, e0)
+				}
+			} else {
+				v.LonghandProperties = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetInlineStylesForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetInlineStylesForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetInlineStylesForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.InlineStyle != nil {
+		w.Key("inlineStyle")
+		v.InlineStyle.EncodeJSON(w)
+	}
+	if v.AttributesStyle != nil {
+		w.Key("attributesStyle")
+		v.AttributesStyle.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetInlineStylesForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "inlineStyle":
+			if r.Null() {
+				v.InlineStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.InlineStyle = &p
+			}
+		case "attributesStyle":
+			if r.Null() {
+				v.AttributesStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.AttributesStyle = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAnimatedStylesForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAnimatedStylesForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAnimatedStylesForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.AnimationStyles) > 0 {
+		w.Key("animationStyles")
+		w.ArrayStart()
+		for i0 := range v.AnimationStyles {
+			v.AnimationStyles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.TransitionsStyle != nil {
+		w.Key("transitionsStyle")
+		v.TransitionsStyle.EncodeJSON(w)
+	}
+	if len(v.Inherited) > 0 {
+		w.Key("inherited")
+		w.ArrayStart()
+		for i0 := range v.Inherited {
+			v.Inherited[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAnimatedStylesForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animationStyles":
+			if r.ArrayStart() {
+				v.AnimationStyles = make([]AnimationStyle, 0, 4)
+				for r.ArrayNext() {
+					var e0 AnimationStyle
+					e0.DecodeJSON(r)
+					v.AnimationStyles = append(v.AnimationStyles, e0)
+				}
+			} else {
+				v.AnimationStyles = nil
+			}
+		case "transitionsStyle":
+			if r.Null() {
+				v.TransitionsStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.TransitionsStyle = &p
+			}
+		case "inherited":
+			if r.ArrayStart() {
+				v.Inherited = make([]InheritedAnimatedStyleEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 InheritedAnimatedStyleEntry
+					e0.DecodeJSON(r)
+					v.Inherited = append(v.Inherited, e0)
+				}
+			} else {
+				v.Inherited = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetMatchedStylesForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetMatchedStylesForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetMatchedStylesForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.InlineStyle != nil {
+		w.Key("inlineStyle")
+		v.InlineStyle.EncodeJSON(w)
+	}
+	if v.AttributesStyle != nil {
+		w.Key("attributesStyle")
+		v.AttributesStyle.EncodeJSON(w)
+	}
+	if len(v.MatchedCSSRules) > 0 {
+		w.Key("matchedCSSRules")
+		w.ArrayStart()
+		for i0 := range v.MatchedCSSRules {
+			v.MatchedCSSRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.PseudoElements) > 0 {
+		w.Key("pseudoElements")
+		w.ArrayStart()
+		for i0 := range v.PseudoElements {
+			v.PseudoElements[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Inherited) > 0 {
+		w.Key("inherited")
+		w.ArrayStart()
+		for i0 := range v.Inherited {
+			v.Inherited[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.InheritedPseudoElements) > 0 {
+		w.Key("inheritedPseudoElements")
+		w.ArrayStart()
+		for i0 := range v.InheritedPseudoElements {
+			v.InheritedPseudoElements[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.CSSKeyframesRules) > 0 {
+		w.Key("cssKeyframesRules")
+		w.ArrayStart()
+		for i0 := range v.CSSKeyframesRules {
+			v.CSSKeyframesRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.CSSPositionTryRules) > 0 {
+		w.Key("cssPositionTryRules")
+		w.ArrayStart()
+		for i0 := range v.CSSPositionTryRules {
+			v.CSSPositionTryRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ActivePositionFallbackIndex != nil {
+		w.Key("activePositionFallbackIndex")
+		w.Int(*v.ActivePositionFallbackIndex)
+	}
+	if len(v.CSSPropertyRules) > 0 {
+		w.Key("cssPropertyRules")
+		w.ArrayStart()
+		for i0 := range v.CSSPropertyRules {
+			v.CSSPropertyRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.CSSPropertyRegistrations) > 0 {
+		w.Key("cssPropertyRegistrations")
+		w.ArrayStart()
+		for i0 := range v.CSSPropertyRegistrations {
+			v.CSSPropertyRegistrations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.CSSAtRules) > 0 {
+		w.Key("cssAtRules")
+		w.ArrayStart()
+		for i0 := range v.CSSAtRules {
+			v.CSSAtRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ParentLayoutNodeID != nil {
+		w.Key("parentLayoutNodeId")
+		w.Int(int(*v.ParentLayoutNodeID))
+	}
+	if len(v.CSSFunctionRules) > 0 {
+		w.Key("cssFunctionRules")
+		w.ArrayStart()
+		for i0 := range v.CSSFunctionRules {
+			v.CSSFunctionRules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetMatchedStylesForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "inlineStyle":
+			if r.Null() {
+				v.InlineStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.InlineStyle = &p
+			}
+		case "attributesStyle":
+			if r.Null() {
+				v.AttributesStyle = nil
+			} else {
+				var p Style
+				p.DecodeJSON(r)
+				v.AttributesStyle = &p
+			}
+		case "matchedCSSRules":
+			if r.ArrayStart() {
+				v.MatchedCSSRules = make([]RuleMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 RuleMatch
+					e0.DecodeJSON(r)
+					v.MatchedCSSRules = append(v.MatchedCSSRules, e0)
+				}
+			} else {
+				v.MatchedCSSRules = nil
+			}
+		case "pseudoElements":
+			if r.ArrayStart() {
+				v.PseudoElements = make([]PseudoElementMatches, 0, 4)
+				for r.ArrayNext() {
+					var e0 PseudoElementMatches
+					e0.DecodeJSON(r)
+					v.PseudoElements = append(v.PseudoElements, e0)
+				}
+			} else {
+				v.PseudoElements = nil
+			}
+		case "inherited":
+			if r.ArrayStart() {
+				v.Inherited = make([]InheritedStyleEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 InheritedStyleEntry
+					e0.DecodeJSON(r)
+					v.Inherited = append(v.Inherited, e0)
+				}
+			} else {
+				v.Inherited = nil
+			}
+		case "inheritedPseudoElements":
+			if r.ArrayStart() {
+				v.InheritedPseudoElements = make([]InheritedPseudoElementMatches, 0, 4)
+				for r.ArrayNext() {
+					var e0 InheritedPseudoElementMatches
+					e0.DecodeJSON(r)
+					v.InheritedPseudoElements = append(v.InheritedPseudoElements, e0)
+				}
+			} else {
+				v.InheritedPseudoElements = nil
+			}
+		case "cssKeyframesRules":
+			if r.ArrayStart() {
+				v.CSSKeyframesRules = make([]KeyframesRule, 0, 4)
+				for r.ArrayNext() {
+					var e0 KeyframesRule
+					e0.DecodeJSON(r)
+					v.CSSKeyframesRules = append(v.CSSKeyframesRules, e0)
+				}
+			} else {
+				v.CSSKeyframesRules = nil
+			}
+		case "cssPositionTryRules":
+			if r.ArrayStart() {
+				v.CSSPositionTryRules = make([]PositionTryRule, 0, 4)
+				for r.ArrayNext() {
+					var e0 PositionTryRule
+					e0.DecodeJSON(r)
+					v.CSSPositionTryRules = append(v.CSSPositionTryRules, e0)
+				}
+			} else {
+				v.CSSPositionTryRules = nil
+			}
+		case "activePositionFallbackIndex":
+			if r.Null() {
+				v.ActivePositionFallbackIndex = nil
+			} else {
+				p := r.Int()
+				v.ActivePositionFallbackIndex = &p
+			}
+		case "cssPropertyRules":
+			if r.ArrayStart() {
+				v.CSSPropertyRules = make([]PropertyRule, 0, 4)
+				for r.ArrayNext() {
+					var e0 PropertyRule
+					e0.DecodeJSON(r)
+					v.CSSPropertyRules = append(v.CSSPropertyRules, e0)
+				}
+			} else {
+				v.CSSPropertyRules = nil
+			}
+		case "cssPropertyRegistrations":
+			if r.ArrayStart() {
+				v.CSSPropertyRegistrations = make([]PropertyRegistration, 0, 4)
+				for r.ArrayNext() {
+					var e0 PropertyRegistration
+					e0.DecodeJSON(r)
+					v.CSSPropertyRegistrations = append(v.CSSPropertyRegistrations, e0)
+				}
+			} else {
+				v.CSSPropertyRegistrations = nil
+			}
+		case "cssAtRules":
+			if r.ArrayStart() {
+				v.CSSAtRules = make([]AtRule, 0, 4)
+				for r.ArrayNext() {
+					var e0 AtRule
+					e0.DecodeJSON(r)
+					v.CSSAtRules = append(v.CSSAtRules, e0)
+				}
+			} else {
+				v.CSSAtRules = nil
+			}
+		case "parentLayoutNodeId":
+			if r.Null() {
+				v.ParentLayoutNodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.ParentLayoutNodeID = &p
+			}
+		case "cssFunctionRules":
+			if r.ArrayStart() {
+				v.CSSFunctionRules = make([]FunctionRule, 0, 4)
+				for r.ArrayNext() {
+					var e0 FunctionRule
+					e0.DecodeJSON(r)
+					v.CSSFunctionRules = append(v.CSSFunctionRules, e0)
+				}
+			} else {
+				v.CSSFunctionRules = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetEnvironmentVariablesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("environmentVariables")
+	w.Raw(v.EnvironmentVariables)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetEnvironmentVariablesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "environmentVariables":
+			v.EnvironmentVariables = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetMediaQueriesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("medias")
+	if v.Medias == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Medias {
+			v.Medias[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetMediaQueriesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "medias":
+			if r.ArrayStart() {
+				v.Medias = make([]Media, 0, 4)
+				for r.ArrayNext() {
+					var e0 Media
+					e0.DecodeJSON(r)
+					v.Medias = append(v.Medias, e0)
+				}
+			} else {
+				v.Medias = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPlatformFontsForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPlatformFontsForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPlatformFontsForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fonts")
+	if v.Fonts == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Fonts {
+			v.Fonts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPlatformFontsForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fonts":
+			if r.ArrayStart() {
+				v.Fonts = make([]PlatformFontUsage, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlatformFontUsage
+					e0.DecodeJSON(r)
+					v.Fonts = append(v.Fonts, e0)
+				}
+			} else {
+				v.Fonts = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStyleSheetTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStyleSheetTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStyleSheetTextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStyleSheetTextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLayersForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLayersForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLayersForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rootLayer")
+	v.RootLayer.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLayersForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rootLayer":
+			v.RootLayer.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLocationForSelectorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("selectorText")
+	w.String(v.SelectorText)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLocationForSelectorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "selectorText":
+			v.SelectorText = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLocationForSelectorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ranges")
+	if v.Ranges == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Ranges {
+			v.Ranges[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLocationForSelectorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ranges":
+			if r.ArrayStart() {
+				v.Ranges = make([]SourceRange, 0, 4)
+				for r.ArrayNext() {
+					var e0 SourceRange
+					e0.DecodeJSON(r)
+					v.Ranges = append(v.Ranges, e0)
+				}
+			} else {
+				v.Ranges = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrackComputedStyleUpdatesForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrackComputedStyleUpdatesForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrackComputedStyleUpdatesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("propertiesToTrack")
+	if v.PropertiesToTrack == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.PropertiesToTrack {
+			v.PropertiesToTrack[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrackComputedStyleUpdatesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "propertiesToTrack":
+			if r.ArrayStart() {
+				v.PropertiesToTrack = make([]ComputedStyleProperty, 0, 4)
+				for r.ArrayNext() {
+					var e0 ComputedStyleProperty
+					e0.DecodeJSON(r)
+					v.PropertiesToTrack = append(v.PropertiesToTrack, e0)
+				}
+			} else {
+				v.PropertiesToTrack = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeComputedStyleUpdatesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeComputedStyleUpdatesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]dom.NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := dom.NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetEffectivePropertyValueForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("propertyName")
+	w.String(v.PropertyName)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetEffectivePropertyValueForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "propertyName":
+			v.PropertyName = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPropertyRulePropertyNameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("propertyName")
+	w.String(v.PropertyName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPropertyRulePropertyNameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "propertyName":
+			v.PropertyName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPropertyRulePropertyNameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("propertyName")
+	v.PropertyName.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPropertyRulePropertyNameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "propertyName":
+			v.PropertyName.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetKeyframeKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("keyText")
+	w.String(v.KeyText)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetKeyframeKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "keyText":
+			v.KeyText = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetKeyframeKeyReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("keyText")
+	v.KeyText.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetKeyframeKeyReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "keyText":
+			v.KeyText.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetMediaTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetMediaTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetMediaTextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("media")
+	v.Media.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetMediaTextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "media":
+			v.Media.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetContainerQueryTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetContainerQueryTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetContainerQueryTextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("containerQuery")
+	v.ContainerQuery.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetContainerQueryTextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "containerQuery":
+			v.ContainerQuery.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSupportsTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSupportsTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSupportsTextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("supports")
+	v.Supports.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSupportsTextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "supports":
+			v.Supports.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetScopeTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetScopeTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetScopeTextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scope")
+	v.Scope.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetScopeTextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scope":
+			v.Scope.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetRuleSelectorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("range")
+	v.Range.EncodeJSON(w)
+	w.Key("selector")
+	w.String(v.Selector)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetRuleSelectorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "range":
+			v.Range.DecodeJSON(r)
+		case "selector":
+			v.Selector = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetRuleSelectorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("selectorList")
+	v.SelectorList.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetRuleSelectorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "selectorList":
+			v.SelectorList.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetStyleSheetTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetStyleSheetTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetStyleSheetTextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SourceMapURL != nil {
+		w.Key("sourceMapURL")
+		w.String(*v.SourceMapURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetStyleSheetTextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceMapURL":
+			if r.Null() {
+				v.SourceMapURL = nil
+			} else {
+				p := r.String()
+				v.SourceMapURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetStyleTextsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("edits")
+	if v.Edits == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Edits {
+			v.Edits[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.NodeForPropertySyntaxValidation != nil {
+		w.Key("nodeForPropertySyntaxValidation")
+		w.Int(int(*v.NodeForPropertySyntaxValidation))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetStyleTextsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "edits":
+			if r.ArrayStart() {
+				v.Edits = make([]StyleDeclarationEdit, 0, 4)
+				for r.ArrayNext() {
+					var e0 StyleDeclarationEdit
+					e0.DecodeJSON(r)
+					v.Edits = append(v.Edits, e0)
+				}
+			} else {
+				v.Edits = nil
+			}
+		case "nodeForPropertySyntaxValidation":
+			if r.Null() {
+				v.NodeForPropertySyntaxValidation = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeForPropertySyntaxValidation = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetStyleTextsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styles")
+	if v.Styles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Styles {
+			v.Styles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetStyleTextsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styles":
+			if r.ArrayStart() {
+				v.Styles = make([]Style, 0, 4)
+				for r.ArrayNext() {
+					var e0 Style
+					e0.DecodeJSON(r)
+					v.Styles = append(v.Styles, e0)
+				}
+			} else {
+				v.Styles = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopRuleUsageTrackingReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ruleUsage")
+	if v.RuleUsage == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.RuleUsage {
+			v.RuleUsage[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopRuleUsageTrackingReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ruleUsage":
+			if r.ArrayStart() {
+				v.RuleUsage = make([]RuleUsage, 0, 4)
+				for r.ArrayNext() {
+					var e0 RuleUsage
+					e0.DecodeJSON(r)
+					v.RuleUsage = append(v.RuleUsage, e0)
+				}
+			} else {
+				v.RuleUsage = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeCoverageDeltaReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("coverage")
+	if v.Coverage == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Coverage {
+			v.Coverage[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("timestamp")
+	w.Float(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeCoverageDeltaReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "coverage":
+			if r.ArrayStart() {
+				v.Coverage = make([]RuleUsage, 0, 4)
+				for r.ArrayNext() {
+					var e0 RuleUsage
+					e0.DecodeJSON(r)
+					v.Coverage = append(v.Coverage, e0)
+				}
+			} else {
+				v.Coverage = nil
+			}
+		case "timestamp":
+			v.Timestamp = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetLocalFontsEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetLocalFontsEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FontsUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Font != nil {
+		w.Key("font")
+		v.Font.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FontsUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "font":
+			if r.Null() {
+				v.Font = nil
+			} else {
+				var p FontFace
+				p.DecodeJSON(r)
+				v.Font = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MediaQueryResultChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MediaQueryResultChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StyleSheetAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("header")
+	v.Header.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StyleSheetAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "header":
+			v.Header.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StyleSheetChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StyleSheetChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StyleSheetRemovedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("styleSheetId")
+	w.String(string(v.StyleSheetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StyleSheetRemovedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "styleSheetId":
+			v.StyleSheetID = dom.StyleSheetID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ComputedStyleUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ComputedStyleUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -3,6 +3,9 @@
 package debugger
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/runtime"
 )
 
@@ -30,6 +33,19 @@ func (a *ContinueToLocationArgs) SetTargetCallFrames(targetCallFrames string) *C
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ContinueToLocationArgs) Validate() error {
+	if a.TargetCallFrames != nil {
+		switch *a.TargetCallFrames {
+		case "any", "current":
+		default:
+			return errors.New("debugger.ContinueToLocationArgs: TargetCallFrames: invalid value " + strconv.Quote(*a.TargetCallFrames))
+		}
+	}
+	return nil
+}
+
 // EnableArgs represents the arguments for Enable in the Debugger domain.
 type EnableArgs struct {
 	// MaxScriptsCacheSize The maximum size in bytes of collected scripts
@@ -58,6 +74,12 @@ func (a *EnableArgs) SetMaxScriptsCacheSize(maxScriptsCacheSize float64) *Enable
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // EnableReply represents the return values for Enable in the Debugger domain.
 type EnableReply struct {
 	// DebuggerID Unique identifier of the debugger.
@@ -152,6 +174,12 @@ func (a *EvaluateOnCallFrameArgs) SetTimeout(timeout runtime.TimeDelta) *Evaluat
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EvaluateOnCallFrameArgs) Validate() error {
+	return nil
+}
+
 // EvaluateOnCallFrameReply represents the return values for EvaluateOnCallFrame in the Debugger domain.
 type EvaluateOnCallFrameReply struct {
 	Result           runtime.RemoteObject      `json:"result"`                     // Object wrapper for the evaluation result.
@@ -188,6 +216,12 @@ func (a *GetPossibleBreakpointsArgs) SetRestrictToFunction(restrictToFunction bo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetPossibleBreakpointsArgs) Validate() error {
+	return nil
+}
+
 // GetPossibleBreakpointsReply represents the return values for GetPossibleBreakpoints in the Debugger domain.
 type GetPossibleBreakpointsReply struct {
 	Locations []BreakLocation `json:"locations"` // List of the possible breakpoint locations.
@@ -205,6 +239,12 @@ func NewGetScriptSourceArgs(scriptID runtime.ScriptID) *GetScriptSourceArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetScriptSourceArgs) Validate() error {
+	return nil
+}
+
 // GetScriptSourceReply represents the return values for GetScriptSource in the Debugger domain.
 type GetScriptSourceReply struct {
 	ScriptSource string `json:"scriptSource"`       // Script source (empty in case of Wasm bytecode).
@@ -223,6 +263,12 @@ func NewDisassembleWASMModuleArgs(scriptID runtime.ScriptID) *DisassembleWASMMod
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DisassembleWASMModuleArgs) Validate() error {
+	return nil
+}
+
 // DisassembleWASMModuleReply represents the return values for DisassembleWASMModule in the Debugger domain.
 type DisassembleWASMModuleReply struct {
 	StreamID            *string              `json:"streamId,omitempty"`  // For large modules, return a stream from which additional chunks of disassembly can be read successively.
@@ -243,6 +289,12 @@ func NewNextWASMDisassemblyChunkArgs(streamID string) *NextWASMDisassemblyChunkA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *NextWASMDisassemblyChunkArgs) Validate() error {
+	return nil
+}
+
 // NextWASMDisassemblyChunkReply represents the return values for NextWASMDisassemblyChunk in the Debugger domain.
 type NextWASMDisassemblyChunkReply struct {
 	Chunk WASMDisassemblyChunk `json:"chunk"` // The next chunk of disassembly.
@@ -260,6 +312,12 @@ func NewGetWASMBytecodeArgs(scriptID runtime.ScriptID) *GetWASMBytecodeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetWASMBytecodeArgs) Validate() error {
+	return nil
+}
+
 // GetWASMBytecodeReply represents the return values for GetWASMBytecode in the Debugger domain.
 type GetWASMBytecodeReply struct {
 	Bytecode []byte `json:"bytecode"` // Script source. (Encoded as a base64 string when passed over JSON)
@@ -277,6 +335,12 @@ func NewGetStackTraceArgs(stackTraceID runtime.StackTraceID) *GetStackTraceArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetStackTraceArgs) Validate() error {
+	return nil
+}
+
 // GetStackTraceReply represents the return values for GetStackTrace in the Debugger domain.
 type GetStackTraceReply struct {
 	StackTrace runtime.StackTrace `json:"stackTrace"` // No description.
@@ -294,6 +358,12 @@ func NewPauseOnAsyncCallArgs(parentStackTraceID runtime.StackTraceID) *PauseOnAs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *PauseOnAsyncCallArgs) Validate() error {
+	return nil
+}
+
 // RemoveBreakpointArgs represents the arguments for RemoveBreakpoint in the Debugger domain.
 type RemoveBreakpointArgs struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // No description.
@@ -306,6 +376,12 @@ func NewRemoveBreakpointArgs(breakpointID BreakpointID) *RemoveBreakpointArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveBreakpointArgs) Validate() error {
+	return nil
+}
+
 // RestartFrameArgs represents the arguments for RestartFrame in the Debugger domain.
 type RestartFrameArgs struct {
 	CallFrameID CallFrameID `json:"callFrameId"` // Call frame identifier to evaluate on.
@@ -337,6 +413,19 @@ func (a *RestartFrameArgs) SetMode(mode string) *RestartFrameArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RestartFrameArgs) Validate() error {
+	if a.Mode != nil {
+		switch *a.Mode {
+		case "StepInto":
+		default:
+			return errors.New("debugger.RestartFrameArgs: Mode: invalid value " + strconv.Quote(*a.Mode))
+		}
+	}
+	return nil
+}
+
 // RestartFrameReply represents the return values for RestartFrame in the Debugger domain.
 type RestartFrameReply struct {
 	// CallFrames is deprecated.
@@ -377,6 +466,12 @@ func (a *ResumeArgs) SetTerminateOnResume(terminateOnResume bool) *ResumeArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResumeArgs) Validate() error {
+	return nil
+}
+
 // SearchInContentArgs represents the arguments for SearchInContent in the Debugger domain.
 type SearchInContentArgs struct {
 	ScriptID      runtime.ScriptID `json:"scriptId"`                // Id of the script to search in.
@@ -407,6 +502,12 @@ func (a *SearchInContentArgs) SetIsRegex(isRegex bool) *SearchInContentArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SearchInContentArgs) Validate() error {
+	return nil
+}
+
 // SearchInContentReply represents the return values for SearchInContent in the Debugger domain.
 type SearchInContentReply struct {
 	Result []SearchMatch `json:"result"` // List of search matches.
@@ -424,6 +525,12 @@ func NewSetAsyncCallStackDepthArgs(maxDepth int) *SetAsyncCallStackDepthArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAsyncCallStackDepthArgs) Validate() error {
+	return nil
+}
+
 // SetBlackboxExecutionContextsArgs represents the arguments for SetBlackboxExecutionContexts in the Debugger domain.
 type SetBlackboxExecutionContextsArgs struct {
 	UniqueIDs []string `json:"uniqueIds"` // Array of execution context unique ids for the debugger to ignore.
@@ -436,6 +543,15 @@ func NewSetBlackboxExecutionContextsArgs(uniqueIDs []string) *SetBlackboxExecuti
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBlackboxExecutionContextsArgs) Validate() error {
+	if a.UniqueIDs == nil {
+		return errors.New("debugger.SetBlackboxExecutionContextsArgs: UniqueIDs is required")
+	}
+	return nil
+}
+
 // SetBlackboxPatternsArgs represents the arguments for SetBlackboxPatterns in the Debugger domain.
 type SetBlackboxPatternsArgs struct {
 	Patterns      []string `json:"patterns"`                // Array of regexps that will be used to check script url for blackbox state.
@@ -456,6 +572,15 @@ func (a *SetBlackboxPatternsArgs) SetSkipAnonymous(skipAnonymous bool) *SetBlack
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBlackboxPatternsArgs) Validate() error {
+	if a.Patterns == nil {
+		return errors.New("debugger.SetBlackboxPatternsArgs: Patterns is required")
+	}
+	return nil
+}
+
 // SetBlackboxedRangesArgs represents the arguments for SetBlackboxedRanges in the Debugger domain.
 type SetBlackboxedRangesArgs struct {
 	ScriptID  runtime.ScriptID `json:"scriptId"`  // Id of the script.
@@ -470,6 +595,15 @@ func NewSetBlackboxedRangesArgs(scriptID runtime.ScriptID, positions []ScriptPos
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBlackboxedRangesArgs) Validate() error {
+	if a.Positions == nil {
+		return errors.New("debugger.SetBlackboxedRangesArgs: Positions is required")
+	}
+	return nil
+}
+
 // SetBreakpointArgs represents the arguments for SetBreakpoint in the Debugger domain.
 type SetBreakpointArgs struct {
 	Location  Location `json:"location"`            // Location to set breakpoint in.
@@ -491,6 +625,12 @@ func (a *SetBreakpointArgs) SetCondition(condition string) *SetBreakpointArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBreakpointArgs) Validate() error {
+	return nil
+}
+
 // SetBreakpointReply represents the return values for SetBreakpoint in the Debugger domain.
 type SetBreakpointReply struct {
 	BreakpointID   BreakpointID `json:"breakpointId"`   // Id of the created breakpoint for further reference.
@@ -512,6 +652,17 @@ func NewSetInstrumentationBreakpointArgs(instrumentation string) *SetInstrumenta
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInstrumentationBreakpointArgs) Validate() error {
+	switch a.Instrumentation {
+	case "beforeScriptExecution", "beforeScriptWithSourceMapExecution":
+	default:
+		return errors.New("debugger.SetInstrumentationBreakpointArgs: Instrumentation: invalid value " + strconv.Quote(a.Instrumentation))
+	}
+	return nil
+}
+
 // SetInstrumentationBreakpointReply represents the return values for SetInstrumentationBreakpoint in the Debugger domain.
 type SetInstrumentationBreakpointReply struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // Id of the created breakpoint for further reference.
@@ -571,6 +722,25 @@ func (a *SetBreakpointByURLArgs) SetCondition(condition string) *SetBreakpointBy
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBreakpointByURLArgs) Validate() error {
+	n := 0
+	if a.URL != nil {
+		n++
+	}
+	if a.URLRegex != nil {
+		n++
+	}
+	if a.ScriptHash != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("debugger.SetBreakpointByURLArgs: exactly one of URL, URLRegex or ScriptHash must be set")
+	}
+	return nil
+}
+
 // SetBreakpointByURLReply represents the return values for SetBreakpointByURL in the Debugger domain.
 type SetBreakpointByURLReply struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // Id of the created breakpoint for further reference.
@@ -598,6 +768,12 @@ func (a *SetBreakpointOnFunctionCallArgs) SetCondition(condition string) *SetBre
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBreakpointOnFunctionCallArgs) Validate() error {
+	return nil
+}
+
 // SetBreakpointOnFunctionCallReply represents the return values for SetBreakpointOnFunctionCall in the Debugger domain.
 type SetBreakpointOnFunctionCallReply struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // Id of the created breakpoint for further reference.
@@ -615,6 +791,12 @@ func NewSetBreakpointsActiveArgs(active bool) *SetBreakpointsActiveArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBreakpointsActiveArgs) Validate() error {
+	return nil
+}
+
 // SetPauseOnExceptionsArgs represents the arguments for SetPauseOnExceptions in the Debugger domain.
 type SetPauseOnExceptionsArgs struct {
 	// State Pause on exceptions mode.
@@ -630,6 +812,17 @@ func NewSetPauseOnExceptionsArgs(state string) *SetPauseOnExceptionsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPauseOnExceptionsArgs) Validate() error {
+	switch a.State {
+	case "none", "caught", "uncaught", "all":
+	default:
+		return errors.New("debugger.SetPauseOnExceptionsArgs: State: invalid value " + strconv.Quote(a.State))
+	}
+	return nil
+}
+
 // SetReturnValueArgs represents the arguments for SetReturnValue in the Debugger domain.
 type SetReturnValueArgs struct {
 	NewValue runtime.CallArgument `json:"newValue"` // New return value.
@@ -642,6 +835,12 @@ func NewSetReturnValueArgs(newValue runtime.CallArgument) *SetReturnValueArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetReturnValueArgs) Validate() error {
+	return nil
+}
+
 // SetScriptSourceArgs represents the arguments for SetScriptSource in the Debugger domain.
 type SetScriptSourceArgs struct {
 	ScriptID     runtime.ScriptID `json:"scriptId"`         // Id of the script to edit.
@@ -682,6 +881,12 @@ func (a *SetScriptSourceArgs) SetAllowTopFrameEditing(allowTopFrameEditing bool)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetScriptSourceArgs) Validate() error {
+	return nil
+}
+
 // SetScriptSourceReply represents the return values for SetScriptSource in the Debugger domain.
 type SetScriptSourceReply struct {
 	// CallFrames is deprecated.
@@ -725,6 +930,12 @@ func NewSetSkipAllPausesArgs(skip bool) *SetSkipAllPausesArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSkipAllPausesArgs) Validate() error {
+	return nil
+}
+
 // SetVariableValueArgs represents the arguments for SetVariableValue in the Debugger domain.
 type SetVariableValueArgs struct {
 	ScopeNumber  int                  `json:"scopeNumber"`  // 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch' scope types are allowed. Other scopes could be manipulated manually.
@@ -743,6 +954,12 @@ func NewSetVariableValueArgs(scopeNumber int, variableName string, newValue runt
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetVariableValueArgs) Validate() error {
+	return nil
+}
+
 // StepIntoArgs represents the arguments for StepInto in the Debugger domain.
 type StepIntoArgs struct {
 	// BreakOnAsyncCall Debugger will pause on the execution of the first
@@ -783,6 +1000,12 @@ func (a *StepIntoArgs) SetSkipList(skipList []LocationRange) *StepIntoArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StepIntoArgs) Validate() error {
+	return nil
+}
+
 // StepOverArgs represents the arguments for StepOver in the Debugger domain.
 type StepOverArgs struct {
 	// SkipList The skipList specifies location ranges that should be
@@ -807,3 +1030,9 @@ func (a *StepOverArgs) SetSkipList(skipList []LocationRange) *StepOverArgs {
 	a.SkipList = skipList
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StepOverArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,10 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package debugger
+
+// CanSetScriptSourceReply represents the return values for CanSetScriptSource in the Debugger domain.
+type CanSetScriptSourceReply struct {
+	Result bool `json:"result"` // True if setScriptSource is supported.
+}
@@ -0,0 +1,64 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package debugger
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// CanSetScriptSource invokes the Debugger method. Always returns true.
+func (d *domainClient) CanSetScriptSource(ctx context.Context) (reply *CanSetScriptSourceReply, err error) {
+	reply = new(CanSetScriptSourceReply)
+	err = rpcc.Invoke(ctx, "Debugger.canSetScriptSource", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "CanSetScriptSource", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) GlobalObjectCleared(ctx context.Context) (GlobalObjectClearedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.globalObjectCleared", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &globalObjectClearedClient{Stream: s}, nil
+}
+
+type globalObjectClearedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *globalObjectClearedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *globalObjectClearedClient) Recv() (*GlobalObjectClearedReply, error) {
+	event := new(GlobalObjectClearedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "GlobalObjectCleared Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) AsyncOperationCompleted(ctx context.Context) (AsyncOperationCompletedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.asyncOperationCompleted", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &asyncOperationCompletedClient{Stream: s}, nil
+}
+
+type asyncOperationCompletedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *asyncOperationCompletedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *asyncOperationCompletedClient) Recv() (*AsyncOperationCompletedReply, error) {
+	event := new(AsyncOperationCompletedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "AsyncOperationCompleted Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,38 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package debugger
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// GlobalObjectClearedClient is a client for GlobalObjectCleared events.
+// Called when global has been cleared and debugger client should reset its
+// state. Happens upon navigation or reload.
+type GlobalObjectClearedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*GlobalObjectClearedReply, error)
+	rpcc.Stream
+}
+
+// GlobalObjectClearedReply is the reply for GlobalObjectCleared events.
+type GlobalObjectClearedReply struct {
+}
+
+// AsyncOperationCompletedClient is a client for AsyncOperationCompleted events.
+// Fired when an async operation is completed (while in a debugger stepping
+// session).
+type AsyncOperationCompletedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*AsyncOperationCompletedReply, error)
+	rpcc.Stream
+}
+
+// AsyncOperationCompletedReply is the reply for AsyncOperationCompleted events.
+type AsyncOperationCompletedReply struct {
+	ID int `json:"id"` // ID of the async operation that was completed.
+}
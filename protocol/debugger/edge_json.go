@@ -0,0 +1,274 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package debugger
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FunctionDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Location != nil {
+		w.Key("location")
+		v.Location.EncodeJSON(w)
+	}
+	w.Key("functionName")
+	w.String(v.FunctionName)
+	w.Key("isGenerator")
+	w.Bool(v.IsGenerator)
+	if len(v.ScopeChain) > 0 {
+		w.Key("scopeChain")
+		w.ArrayStart()
+		for i0 := range v.ScopeChain {
+			v.ScopeChain[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FunctionDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "location":
+			if r.Null() {
+				v.Location = nil
+			} else {
+				var p Location
+				p.DecodeJSON(r)
+				v.Location = &p
+			}
+		case "functionName":
+			v.FunctionName = r.String()
+		case "isGenerator":
+			v.IsGenerator = r.Bool()
+		case "scopeChain":
+			if r.ArrayStart() {
+				v.ScopeChain = make([]Scope, 0, 4)
+				for r.ArrayNext() {
+					var e0 Scope
+					e0.DecodeJSON(r)
+					v.ScopeChain = append(v.ScopeChain, e0)
+				}
+			} else {
+				v.ScopeChain = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GeneratorObjectDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("function")
+	v.Function.EncodeJSON(w)
+	w.Key("functionName")
+	w.String(v.FunctionName)
+	w.Key("status")
+	w.String(v.Status)
+	if v.Location != nil {
+		w.Key("location")
+		v.Location.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GeneratorObjectDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "function":
+			v.Function.DecodeJSON(r)
+		case "functionName":
+			v.FunctionName = r.String()
+		case "status":
+			v.Status = r.String()
+		case "location":
+			if r.Null() {
+				v.Location = nil
+			} else {
+				var p Location
+				p.DecodeJSON(r)
+				v.Location = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CollectionEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Key != nil {
+		w.Key("key")
+		v.Key.EncodeJSON(w)
+	}
+	w.Key("value")
+	v.Value.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CollectionEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			if r.Null() {
+				v.Key = nil
+			} else {
+				var p runtime.RemoteObject
+				p.DecodeJSON(r)
+				v.Key = &p
+			}
+		case "value":
+			v.Value.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StackTrace) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrames")
+	if v.CallFrames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CallFrames {
+			v.CallFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Description != nil {
+		w.Key("description")
+		w.String(*v.Description)
+	}
+	if v.AsyncStackTrace != nil {
+		w.Key("asyncStackTrace")
+		v.AsyncStackTrace.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StackTrace) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrames":
+			if r.ArrayStart() {
+				v.CallFrames = make([]CallFrame, 0, 4)
+				for r.ArrayNext() {
+					var e0 CallFrame
+					e0.DecodeJSON(r)
+					v.CallFrames = append(v.CallFrames, e0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "description":
+			if r.Null() {
+				v.Description = nil
+			} else {
+				p := r.String()
+				v.Description = &p
+			}
+		case "asyncStackTrace":
+			if r.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				var p StackTrace
+				p.DecodeJSON(r)
+				v.AsyncStackTrace = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanSetScriptSourceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanSetScriptSourceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GlobalObjectClearedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GlobalObjectClearedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AsyncOperationCompletedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.Int(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AsyncOperationCompletedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
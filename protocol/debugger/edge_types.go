@@ -0,0 +1,44 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package debugger
+
+import (
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// ScriptID Unique script identifier.
+type ScriptID string
+
+// FunctionDetails Information about the function.
+type FunctionDetails struct {
+	Location     *Location `json:"location,omitempty"`   // Location of the function, none for native functions.
+	FunctionName string    `json:"functionName"`         // Name of the function.
+	IsGenerator  bool      `json:"isGenerator"`          // Whether this is a generator function.
+	ScopeChain   []Scope   `json:"scopeChain,omitempty"` // Scope chain for this closure.
+}
+
+// GeneratorObjectDetails Information about the generator object.
+type GeneratorObjectDetails struct {
+	Function     runtime.RemoteObject `json:"function"`     // Generator function.
+	FunctionName string               `json:"functionName"` // Name of the generator function.
+	// Status Current generator object status.
+	//
+	// Values: "running", "suspended", "closed".
+	Status   string    `json:"status"`
+	Location *Location `json:"location,omitempty"` // If suspended, location where generator function was suspended (e.g. location of the last 'yield'). Otherwise, location of the generator function.
+}
+
+// CollectionEntry Collection entry.
+type CollectionEntry struct {
+	Key   *runtime.RemoteObject `json:"key,omitempty"` // Entry key of a map-like collection, otherwise not provided.
+	Value runtime.RemoteObject  `json:"value"`         // Entry value.
+}
+
+// StackTrace JavaScript call stack, including async stack traces.
+type StackTrace struct {
+	CallFrames      []CallFrame `json:"callFrames"`                // Call frames of the stack trace.
+	Description     *string     `json:"description,omitempty"`     // String label of this stack trace. For async traces this may be a name of the function that initiated the async call.
+	AsyncStackTrace *StackTrace `json:"asyncStackTrace,omitempty"` // Async stack trace, if any.
+}
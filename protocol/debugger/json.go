@@ -0,0 +1,2586 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Location) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	if v.ColumnNumber != nil {
+		w.Key("columnNumber")
+		w.Int(*v.ColumnNumber)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Location) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			if r.Null() {
+				v.ColumnNumber = nil
+			} else {
+				p := r.Int()
+				v.ColumnNumber = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScriptPosition) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScriptPosition) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LocationRange) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("start")
+	v.Start.EncodeJSON(w)
+	w.Key("end")
+	v.End.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LocationRange) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "start":
+			v.Start.DecodeJSON(r)
+		case "end":
+			v.End.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CallFrame) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrameId")
+	w.String(string(v.CallFrameID))
+	w.Key("functionName")
+	w.String(v.FunctionName)
+	if v.FunctionLocation != nil {
+		w.Key("functionLocation")
+		v.FunctionLocation.EncodeJSON(w)
+	}
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("scopeChain")
+	if v.ScopeChain == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ScopeChain {
+			v.ScopeChain[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("this")
+	v.This.EncodeJSON(w)
+	if v.ReturnValue != nil {
+		w.Key("returnValue")
+		v.ReturnValue.EncodeJSON(w)
+	}
+	if v.CanBeRestarted != nil {
+		w.Key("canBeRestarted")
+		w.Bool(*v.CanBeRestarted)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CallFrame) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrameId":
+			v.CallFrameID = CallFrameID(r.String())
+		case "functionName":
+			v.FunctionName = r.String()
+		case "functionLocation":
+			if r.Null() {
+				v.FunctionLocation = nil
+			} else {
+				var p Location
+				p.DecodeJSON(r)
+				v.FunctionLocation = &p
+			}
+		case "location":
+			v.Location.DecodeJSON(r)
+		case "url":
+			v.URL = r.String()
+		case "scopeChain":
+			if r.ArrayStart() {
+				v.ScopeChain = make([]Scope, 0, 4)
+				for r.ArrayNext() {
+					var e0 Scope
+					e0.DecodeJSON(r)
+					v.ScopeChain = append(v.ScopeChain, e0)
+				}
+			} else {
+				v.ScopeChain = nil
+			}
+		case "this":
+			v.This.DecodeJSON(r)
+		case "returnValue":
+			if r.Null() {
+				v.ReturnValue = nil
+			} else {
+				var p runtime.RemoteObject
+				p.DecodeJSON(r)
+				v.ReturnValue = &p
+			}
+		case "canBeRestarted":
+			if r.Null() {
+				v.CanBeRestarted = nil
+			} else {
+				p := r.Bool()
+				v.CanBeRestarted = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Scope) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("object")
+	v.Object.EncodeJSON(w)
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	if v.StartLocation != nil {
+		w.Key("startLocation")
+		v.StartLocation.EncodeJSON(w)
+	}
+	if v.EndLocation != nil {
+		w.Key("endLocation")
+		v.EndLocation.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Scope) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "object":
+			v.Object.DecodeJSON(r)
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "startLocation":
+			if r.Null() {
+				v.StartLocation = nil
+			} else {
+				var p Location
+				p.DecodeJSON(r)
+				v.StartLocation = &p
+			}
+		case "endLocation":
+			if r.Null() {
+				v.EndLocation = nil
+			} else {
+				var p Location
+				p.DecodeJSON(r)
+				v.EndLocation = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchMatch) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("lineNumber")
+	w.Float(v.LineNumber)
+	w.Key("lineContent")
+	w.String(v.LineContent)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchMatch) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "lineNumber":
+			v.LineNumber = r.Float()
+		case "lineContent":
+			v.LineContent = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BreakLocation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	if v.ColumnNumber != nil {
+		w.Key("columnNumber")
+		w.Int(*v.ColumnNumber)
+	}
+	if v.Type != nil {
+		w.Key("type")
+		w.String(*v.Type)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BreakLocation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			if r.Null() {
+				v.ColumnNumber = nil
+			} else {
+				p := r.Int()
+				v.ColumnNumber = &p
+			}
+		case "type":
+			if r.Null() {
+				v.Type = nil
+			} else {
+				p := r.String()
+				v.Type = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WASMDisassemblyChunk) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("lines")
+	if v.Lines == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Lines {
+			w.String(v.Lines[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("bytecodeOffsets")
+	if v.BytecodeOffsets == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.BytecodeOffsets {
+			w.Int(v.BytecodeOffsets[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WASMDisassemblyChunk) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "lines":
+			if r.ArrayStart() {
+				v.Lines = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Lines = append(v.Lines, e0)
+				}
+			} else {
+				v.Lines = nil
+			}
+		case "bytecodeOffsets":
+			if r.ArrayStart() {
+				v.BytecodeOffsets = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.BytecodeOffsets = append(v.BytecodeOffsets, e0)
+				}
+			} else {
+				v.BytecodeOffsets = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DebugSymbols) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.ExternalURL != nil {
+		w.Key("externalURL")
+		w.String(*v.ExternalURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DebugSymbols) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "externalURL":
+			if r.Null() {
+				v.ExternalURL = nil
+			} else {
+				p := r.String()
+				v.ExternalURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolvedBreakpoint) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolvedBreakpoint) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		case "location":
+			v.Location.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContinueToLocationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	if v.TargetCallFrames != nil {
+		w.Key("targetCallFrames")
+		w.String(*v.TargetCallFrames)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContinueToLocationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "location":
+			v.Location.DecodeJSON(r)
+		case "targetCallFrames":
+			if r.Null() {
+				v.TargetCallFrames = nil
+			} else {
+				p := r.String()
+				v.TargetCallFrames = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.MaxScriptsCacheSize != nil {
+		w.Key("maxScriptsCacheSize")
+		w.Float(*v.MaxScriptsCacheSize)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "maxScriptsCacheSize":
+			if r.Null() {
+				v.MaxScriptsCacheSize = nil
+			} else {
+				p := r.Float()
+				v.MaxScriptsCacheSize = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("debuggerId")
+	w.String(string(v.DebuggerID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "debuggerId":
+			v.DebuggerID = runtime.UniqueDebuggerID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EvaluateOnCallFrameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrameId")
+	w.String(string(v.CallFrameID))
+	w.Key("expression")
+	w.String(v.Expression)
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		w.Key("includeCommandLineAPI")
+		w.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.Silent != nil {
+		w.Key("silent")
+		w.Bool(*v.Silent)
+	}
+	if v.ReturnByValue != nil {
+		w.Key("returnByValue")
+		w.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		w.Key("generatePreview")
+		w.Bool(*v.GeneratePreview)
+	}
+	if v.ThrowOnSideEffect != nil {
+		w.Key("throwOnSideEffect")
+		w.Bool(*v.ThrowOnSideEffect)
+	}
+	if v.Timeout != nil {
+		w.Key("timeout")
+		w.Float(float64(*v.Timeout))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EvaluateOnCallFrameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrameId":
+			v.CallFrameID = CallFrameID(r.String())
+		case "expression":
+			v.Expression = r.String()
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		case "includeCommandLineAPI":
+			if r.Null() {
+				v.IncludeCommandLineAPI = nil
+			} else {
+				p := r.Bool()
+				v.IncludeCommandLineAPI = &p
+			}
+		case "silent":
+			if r.Null() {
+				v.Silent = nil
+			} else {
+				p := r.Bool()
+				v.Silent = &p
+			}
+		case "returnByValue":
+			if r.Null() {
+				v.ReturnByValue = nil
+			} else {
+				p := r.Bool()
+				v.ReturnByValue = &p
+			}
+		case "generatePreview":
+			if r.Null() {
+				v.GeneratePreview = nil
+			} else {
+				p := r.Bool()
+				v.GeneratePreview = &p
+			}
+		case "throwOnSideEffect":
+			if r.Null() {
+				v.ThrowOnSideEffect = nil
+			} else {
+				p := r.Bool()
+				v.ThrowOnSideEffect = &p
+			}
+		case "timeout":
+			if r.Null() {
+				v.Timeout = nil
+			} else {
+				p := runtime.TimeDelta(r.Float())
+				v.Timeout = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EvaluateOnCallFrameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	v.Result.EncodeJSON(w)
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EvaluateOnCallFrameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result.DecodeJSON(r)
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p runtime.ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPossibleBreakpointsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("start")
+	v.Start.EncodeJSON(w)
+	if v.End != nil {
+		w.Key("end")
+		v.End.EncodeJSON(w)
+	}
+	if v.RestrictToFunction != nil {
+		w.Key("restrictToFunction")
+		w.Bool(*v.RestrictToFunction)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPossibleBreakpointsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "start":
+			v.Start.DecodeJSON(r)
+		case "end":
+			if r.Null() {
+				v.End = nil
+			} else {
+				var p Location
+				p.DecodeJSON(r)
+				v.End = &p
+			}
+		case "restrictToFunction":
+			if r.Null() {
+				v.RestrictToFunction = nil
+			} else {
+				p := r.Bool()
+				v.RestrictToFunction = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPossibleBreakpointsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("locations")
+	if v.Locations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Locations {
+			v.Locations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPossibleBreakpointsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "locations":
+			if r.ArrayStart() {
+				v.Locations = make([]BreakLocation, 0, 4)
+				for r.ArrayNext() {
+					var e0 BreakLocation
+					e0.DecodeJSON(r)
+					v.Locations = append(v.Locations, e0)
+				}
+			} else {
+				v.Locations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetScriptSourceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetScriptSourceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetScriptSourceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptSource")
+	w.String(v.ScriptSource)
+	if len(v.Bytecode) > 0 {
+		w.Key("bytecode")
+		w.Base64(v.Bytecode)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetScriptSourceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptSource":
+			v.ScriptSource = r.String()
+		case "bytecode":
+			v.Bytecode = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DisassembleWASMModuleArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DisassembleWASMModuleArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DisassembleWASMModuleReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.StreamID != nil {
+		w.Key("streamId")
+		w.String(*v.StreamID)
+	}
+	w.Key("totalNumberOfLines")
+	w.Int(v.TotalNumberOfLines)
+	w.Key("functionBodyOffsets")
+	if v.FunctionBodyOffsets == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FunctionBodyOffsets {
+			w.Int(v.FunctionBodyOffsets[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("chunk")
+	v.Chunk.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DisassembleWASMModuleReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "streamId":
+			if r.Null() {
+				v.StreamID = nil
+			} else {
+				p := r.String()
+				v.StreamID = &p
+			}
+		case "totalNumberOfLines":
+			v.TotalNumberOfLines = r.Int()
+		case "functionBodyOffsets":
+			if r.ArrayStart() {
+				v.FunctionBodyOffsets = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.FunctionBodyOffsets = append(v.FunctionBodyOffsets, e0)
+				}
+			} else {
+				v.FunctionBodyOffsets = nil
+			}
+		case "chunk":
+			v.Chunk.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NextWASMDisassemblyChunkArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("streamId")
+	w.String(v.StreamID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NextWASMDisassemblyChunkArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "streamId":
+			v.StreamID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NextWASMDisassemblyChunkReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("chunk")
+	v.Chunk.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NextWASMDisassemblyChunkReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "chunk":
+			v.Chunk.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetWASMBytecodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetWASMBytecodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetWASMBytecodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bytecode")
+	w.Base64(v.Bytecode)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetWASMBytecodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bytecode":
+			v.Bytecode = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStackTraceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("stackTraceId")
+	v.StackTraceID.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStackTraceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "stackTraceId":
+			v.StackTraceID.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStackTraceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("stackTrace")
+	v.StackTrace.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStackTraceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "stackTrace":
+			v.StackTrace.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PauseOnAsyncCallArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentStackTraceId")
+	v.ParentStackTraceID.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PauseOnAsyncCallArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentStackTraceId":
+			v.ParentStackTraceID.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RestartFrameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrameId")
+	w.String(string(v.CallFrameID))
+	if v.Mode != nil {
+		w.Key("mode")
+		w.String(*v.Mode)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RestartFrameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrameId":
+			v.CallFrameID = CallFrameID(r.String())
+		case "mode":
+			if r.Null() {
+				v.Mode = nil
+			} else {
+				p := r.String()
+				v.Mode = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RestartFrameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrames")
+	if v.CallFrames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CallFrames {
+			v.CallFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.AsyncStackTrace != nil {
+		w.Key("asyncStackTrace")
+		v.AsyncStackTrace.EncodeJSON(w)
+	}
+	if v.AsyncStackTraceID != nil {
+		w.Key("asyncStackTraceId")
+		v.AsyncStackTraceID.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RestartFrameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrames":
+			if r.ArrayStart() {
+				v.CallFrames = make([]CallFrame, 0, 4)
+				for r.ArrayNext() {
+					var e0 CallFrame
+					e0.DecodeJSON(r)
+					v.CallFrames = append(v.CallFrames, e0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "asyncStackTrace":
+			if r.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.AsyncStackTrace = &p
+			}
+		case "asyncStackTraceId":
+			if r.Null() {
+				v.AsyncStackTraceID = nil
+			} else {
+				var p runtime.StackTraceID
+				p.DecodeJSON(r)
+				v.AsyncStackTraceID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResumeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.TerminateOnResume != nil {
+		w.Key("terminateOnResume")
+		w.Bool(*v.TerminateOnResume)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResumeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "terminateOnResume":
+			if r.Null() {
+				v.TerminateOnResume = nil
+			} else {
+				p := r.Bool()
+				v.TerminateOnResume = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchInContentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("query")
+	w.String(v.Query)
+	if v.CaseSensitive != nil {
+		w.Key("caseSensitive")
+		w.Bool(*v.CaseSensitive)
+	}
+	if v.IsRegex != nil {
+		w.Key("isRegex")
+		w.Bool(*v.IsRegex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchInContentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "query":
+			v.Query = r.String()
+		case "caseSensitive":
+			if r.Null() {
+				v.CaseSensitive = nil
+			} else {
+				p := r.Bool()
+				v.CaseSensitive = &p
+			}
+		case "isRegex":
+			if r.Null() {
+				v.IsRegex = nil
+			} else {
+				p := r.Bool()
+				v.IsRegex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchInContentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchInContentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]SearchMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 SearchMatch
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAsyncCallStackDepthArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("maxDepth")
+	w.Int(v.MaxDepth)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAsyncCallStackDepthArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "maxDepth":
+			v.MaxDepth = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBlackboxExecutionContextsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("uniqueIds")
+	if v.UniqueIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.UniqueIDs {
+			w.String(v.UniqueIDs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBlackboxExecutionContextsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "uniqueIds":
+			if r.ArrayStart() {
+				v.UniqueIDs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.UniqueIDs = append(v.UniqueIDs, e0)
+				}
+			} else {
+				v.UniqueIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBlackboxPatternsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("patterns")
+	if v.Patterns == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Patterns {
+			w.String(v.Patterns[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.SkipAnonymous != nil {
+		w.Key("skipAnonymous")
+		w.Bool(*v.SkipAnonymous)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBlackboxPatternsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "patterns":
+			if r.ArrayStart() {
+				v.Patterns = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Patterns = append(v.Patterns, e0)
+				}
+			} else {
+				v.Patterns = nil
+			}
+		case "skipAnonymous":
+			if r.Null() {
+				v.SkipAnonymous = nil
+			} else {
+				p := r.Bool()
+				v.SkipAnonymous = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBlackboxedRangesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("positions")
+	if v.Positions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Positions {
+			v.Positions[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBlackboxedRangesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "positions":
+			if r.ArrayStart() {
+				v.Positions = make([]ScriptPosition, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScriptPosition
+					e0.DecodeJSON(r)
+					v.Positions = append(v.Positions, e0)
+				}
+			} else {
+				v.Positions = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	if v.Condition != nil {
+		w.Key("condition")
+		w.String(*v.Condition)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "location":
+			v.Location.DecodeJSON(r)
+		case "condition":
+			if r.Null() {
+				v.Condition = nil
+			} else {
+				p := r.String()
+				v.Condition = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.Key("actualLocation")
+	v.ActualLocation.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		case "actualLocation":
+			v.ActualLocation.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInstrumentationBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("instrumentation")
+	w.String(v.Instrumentation)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInstrumentationBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "instrumentation":
+			v.Instrumentation = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInstrumentationBreakpointReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInstrumentationBreakpointReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointByURLArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.URLRegex != nil {
+		w.Key("urlRegex")
+		w.String(*v.URLRegex)
+	}
+	if v.ScriptHash != nil {
+		w.Key("scriptHash")
+		w.String(*v.ScriptHash)
+	}
+	if v.ColumnNumber != nil {
+		w.Key("columnNumber")
+		w.Int(*v.ColumnNumber)
+	}
+	if v.Condition != nil {
+		w.Key("condition")
+		w.String(*v.Condition)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointByURLArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "urlRegex":
+			if r.Null() {
+				v.URLRegex = nil
+			} else {
+				p := r.String()
+				v.URLRegex = &p
+			}
+		case "scriptHash":
+			if r.Null() {
+				v.ScriptHash = nil
+			} else {
+				p := r.String()
+				v.ScriptHash = &p
+			}
+		case "columnNumber":
+			if r.Null() {
+				v.ColumnNumber = nil
+			} else {
+				p := r.Int()
+				v.ColumnNumber = &p
+			}
+		case "condition":
+			if r.Null() {
+				v.Condition = nil
+			} else {
+				p := r.String()
+				v.Condition = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointByURLReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.Key("locations")
+	if v.Locations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Locations {
+			v.Locations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointByURLReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		case "locations":
+			if r.ArrayStart() {
+				v.Locations = make([]Location, 0, 4)
+				for r.ArrayNext() {
+					var e0 Location
+					e0.DecodeJSON(r)
+					v.Locations = append(v.Locations, e0)
+				}
+			} else {
+				v.Locations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointOnFunctionCallArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	if v.Condition != nil {
+		w.Key("condition")
+		w.String(*v.Condition)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointOnFunctionCallArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = runtime.RemoteObjectID(r.String())
+		case "condition":
+			if r.Null() {
+				v.Condition = nil
+			} else {
+				p := r.String()
+				v.Condition = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointOnFunctionCallReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointOnFunctionCallReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakpointsActiveArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("active")
+	w.Bool(v.Active)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakpointsActiveArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "active":
+			v.Active = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPauseOnExceptionsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("state")
+	w.String(v.State)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPauseOnExceptionsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "state":
+			v.State = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetReturnValueArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("newValue")
+	v.NewValue.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetReturnValueArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "newValue":
+			v.NewValue.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetScriptSourceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("scriptSource")
+	w.String(v.ScriptSource)
+	if v.DryRun != nil {
+		w.Key("dryRun")
+		w.Bool(*v.DryRun)
+	}
+	if v.AllowTopFrameEditing != nil {
+		w.Key("allowTopFrameEditing")
+		w.Bool(*v.AllowTopFrameEditing)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetScriptSourceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "scriptSource":
+			v.ScriptSource = r.String()
+		case "dryRun":
+			if r.Null() {
+				v.DryRun = nil
+			} else {
+				p := r.Bool()
+				v.DryRun = &p
+			}
+		case "allowTopFrameEditing":
+			if r.Null() {
+				v.AllowTopFrameEditing = nil
+			} else {
+				p := r.Bool()
+				v.AllowTopFrameEditing = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetScriptSourceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.CallFrames) > 0 {
+		w.Key("callFrames")
+		w.ArrayStart()
+		for i0 := range v.CallFrames {
+			v.CallFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.StackChanged != nil {
+		w.Key("stackChanged")
+		w.Bool(*v.StackChanged)
+	}
+	if v.AsyncStackTrace != nil {
+		w.Key("asyncStackTrace")
+		v.AsyncStackTrace.EncodeJSON(w)
+	}
+	if v.AsyncStackTraceID != nil {
+		w.Key("asyncStackTraceId")
+		v.AsyncStackTraceID.EncodeJSON(w)
+	}
+	w.Key("status")
+	w.String(v.Status)
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetScriptSourceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrames":
+			if r.ArrayStart() {
+				v.CallFrames = make([]CallFrame, 0, 4)
+				for r.ArrayNext() {
+					var e0 CallFrame
+					e0.DecodeJSON(r)
+					v.CallFrames = append(v.CallFrames, e0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "stackChanged":
+			if r.Null() {
+				v.StackChanged = nil
+			} else {
+				p := r.Bool()
+				v.StackChanged = &p
+			}
+		case "asyncStackTrace":
+			if r.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.AsyncStackTrace = &p
+			}
+		case "asyncStackTraceId":
+			if r.Null() {
+				v.AsyncStackTraceID = nil
+			} else {
+				var p runtime.StackTraceID
+				p.DecodeJSON(r)
+				v.AsyncStackTraceID = &p
+			}
+		case "status":
+			v.Status = r.String()
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p runtime.ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSkipAllPausesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("skip")
+	w.Bool(v.Skip)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSkipAllPausesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "skip":
+			v.Skip = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetVariableValueArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scopeNumber")
+	w.Int(v.ScopeNumber)
+	w.Key("variableName")
+	w.String(v.VariableName)
+	w.Key("newValue")
+	v.NewValue.EncodeJSON(w)
+	w.Key("callFrameId")
+	w.String(string(v.CallFrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetVariableValueArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scopeNumber":
+			v.ScopeNumber = r.Int()
+		case "variableName":
+			v.VariableName = r.String()
+		case "newValue":
+			v.NewValue.DecodeJSON(r)
+		case "callFrameId":
+			v.CallFrameID = CallFrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StepIntoArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BreakOnAsyncCall != nil {
+		w.Key("breakOnAsyncCall")
+		w.Bool(*v.BreakOnAsyncCall)
+	}
+	if len(v.SkipList) > 0 {
+		w.Key("skipList")
+		w.ArrayStart()
+		for i0 := range v.SkipList {
+			v.SkipList[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StepIntoArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakOnAsyncCall":
+			if r.Null() {
+				v.BreakOnAsyncCall = nil
+			} else {
+				p := r.Bool()
+				v.BreakOnAsyncCall = &p
+			}
+		case "skipList":
+			if r.ArrayStart() {
+				v.SkipList = make([]LocationRange, 0, 4)
+				for r.ArrayNext() {
+					var e0 LocationRange
+					e0.DecodeJSON(r)
+					v.SkipList = append(v.SkipList, e0)
+				}
+			} else {
+				v.SkipList = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StepOverArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.SkipList) > 0 {
+		w.Key("skipList")
+		w.ArrayStart()
+		for i0 := range v.SkipList {
+			v.SkipList[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StepOverArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "skipList":
+			if r.ArrayStart() {
+				v.SkipList = make([]LocationRange, 0, 4)
+				for r.ArrayNext() {
+					var e0 LocationRange
+					e0.DecodeJSON(r)
+					v.SkipList = append(v.SkipList, e0)
+				}
+			} else {
+				v.SkipList = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BreakpointResolvedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("breakpointId")
+	w.String(string(v.BreakpointID))
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BreakpointResolvedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "breakpointId":
+			v.BreakpointID = BreakpointID(r.String())
+		case "location":
+			v.Location.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PausedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrames")
+	if v.CallFrames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CallFrames {
+			v.CallFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("reason")
+	w.String(v.Reason)
+	if len(v.Data) > 0 {
+		w.Key("data")
+		w.Raw(v.Data)
+	}
+	if len(v.HitBreakpoints) > 0 {
+		w.Key("hitBreakpoints")
+		w.ArrayStart()
+		for i0 := range v.HitBreakpoints {
+			w.String(v.HitBreakpoints[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.AsyncStackTrace != nil {
+		w.Key("asyncStackTrace")
+		v.AsyncStackTrace.EncodeJSON(w)
+	}
+	if v.AsyncStackTraceID != nil {
+		w.Key("asyncStackTraceId")
+		v.AsyncStackTraceID.EncodeJSON(w)
+	}
+	if v.AsyncCallStackTraceID != nil {
+		w.Key("asyncCallStackTraceId")
+		v.AsyncCallStackTraceID.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PausedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrames":
+			if r.ArrayStart() {
+				v.CallFrames = make([]CallFrame, 0, 4)
+				for r.ArrayNext() {
+					var e0 CallFrame
+					e0.DecodeJSON(r)
+					v.CallFrames = append(v.CallFrames, e0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "reason":
+			v.Reason = r.String()
+		case "data":
+			v.Data = r.Raw()
+		case "hitBreakpoints":
+			if r.ArrayStart() {
+				v.HitBreakpoints = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.HitBreakpoints = append(v.HitBreakpoints, e0)
+				}
+			} else {
+				v.HitBreakpoints = nil
+			}
+		case "asyncStackTrace":
+			if r.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.AsyncStackTrace = &p
+			}
+		case "asyncStackTraceId":
+			if r.Null() {
+				v.AsyncStackTraceID = nil
+			} else {
+				var p runtime.StackTraceID
+				p.DecodeJSON(r)
+				v.AsyncStackTraceID = &p
+			}
+		case "asyncCallStackTraceId":
+			if r.Null() {
+				v.AsyncCallStackTraceID = nil
+			} else {
+				var p runtime.StackTraceID
+				p.DecodeJSON(r)
+				v.AsyncCallStackTraceID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResumedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResumedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScriptFailedToParseReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("startLine")
+	w.Int(v.StartLine)
+	w.Key("startColumn")
+	w.Int(v.StartColumn)
+	w.Key("endLine")
+	w.Int(v.EndLine)
+	w.Key("endColumn")
+	w.Int(v.EndColumn)
+	w.Key("executionContextId")
+	w.Int(int(v.ExecutionContextID))
+	w.Key("hash")
+	w.String(v.Hash)
+	w.Key("buildId")
+	w.String(v.BuildID)
+	if len(v.ExecutionContextAuxData) > 0 {
+		w.Key("executionContextAuxData")
+		w.Raw(v.ExecutionContextAuxData)
+	}
+	if v.SourceMapURL != nil {
+		w.Key("sourceMapURL")
+		w.String(*v.SourceMapURL)
+	}
+	if v.HasSourceURL != nil {
+		w.Key("hasSourceURL")
+		w.Bool(*v.HasSourceURL)
+	}
+	if v.IsModule != nil {
+		w.Key("isModule")
+		w.Bool(*v.IsModule)
+	}
+	if v.Length != nil {
+		w.Key("length")
+		w.Int(*v.Length)
+	}
+	if v.StackTrace != nil {
+		w.Key("stackTrace")
+		v.StackTrace.EncodeJSON(w)
+	}
+	if v.CodeOffset != nil {
+		w.Key("codeOffset")
+		w.Int(*v.CodeOffset)
+	}
+	if v.ScriptLanguage != "" {
+		w.Key("scriptLanguage")
+		w.String(string(v.ScriptLanguage))
+	}
+	if v.EmbedderName != nil {
+		w.Key("embedderName")
+		w.String(*v.EmbedderName)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScriptFailedToParseReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "startLine":
+			v.StartLine = r.Int()
+		case "startColumn":
+			v.StartColumn = r.Int()
+		case "endLine":
+			v.EndLine = r.Int()
+		case "endColumn":
+			v.EndColumn = r.Int()
+		case "executionContextId":
+			v.ExecutionContextID = runtime.ExecutionContextID(r.Int())
+		case "hash":
+			v.Hash = r.String()
+		case "buildId":
+			v.BuildID = r.String()
+		case "executionContextAuxData":
+			v.ExecutionContextAuxData = r.Raw()
+		case "sourceMapURL":
+			if r.Null() {
+				v.SourceMapURL = nil
+			} else {
+				p := r.String()
+				v.SourceMapURL = &p
+			}
+		case "hasSourceURL":
+			if r.Null() {
+				v.HasSourceURL = nil
+			} else {
+				p := r.Bool()
+				v.HasSourceURL = &p
+			}
+		case "isModule":
+			if r.Null() {
+				v.IsModule = nil
+			} else {
+				p := r.Bool()
+				v.IsModule = &p
+			}
+		case "length":
+			if r.Null() {
+				v.Length = nil
+			} else {
+				p := r.Int()
+				v.Length = &p
+			}
+		case "stackTrace":
+			if r.Null() {
+				v.StackTrace = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.StackTrace = &p
+			}
+		case "codeOffset":
+			if r.Null() {
+				v.CodeOffset = nil
+			} else {
+				p := r.Int()
+				v.CodeOffset = &p
+			}
+		case "scriptLanguage":
+			v.ScriptLanguage = ScriptLanguage(r.String())
+		case "embedderName":
+			if r.Null() {
+				v.EmbedderName = nil
+			} else {
+				p := r.String()
+				v.EmbedderName = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScriptParsedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("startLine")
+	w.Int(v.StartLine)
+	w.Key("startColumn")
+	w.Int(v.StartColumn)
+	w.Key("endLine")
+	w.Int(v.EndLine)
+	w.Key("endColumn")
+	w.Int(v.EndColumn)
+	w.Key("executionContextId")
+	w.Int(int(v.ExecutionContextID))
+	w.Key("hash")
+	w.String(v.Hash)
+	w.Key("buildId")
+	w.String(v.BuildID)
+	if len(v.ExecutionContextAuxData) > 0 {
+		w.Key("executionContextAuxData")
+		w.Raw(v.ExecutionContextAuxData)
+	}
+	if v.IsLiveEdit != nil {
+		w.Key("isLiveEdit")
+		w.Bool(*v.IsLiveEdit)
+	}
+	if v.SourceMapURL != nil {
+		w.Key("sourceMapURL")
+		w.String(*v.SourceMapURL)
+	}
+	if v.HasSourceURL != nil {
+		w.Key("hasSourceURL")
+		w.Bool(*v.HasSourceURL)
+	}
+	if v.IsModule != nil {
+		w.Key("isModule")
+		w.Bool(*v.IsModule)
+	}
+	if v.Length != nil {
+		w.Key("length")
+		w.Int(*v.Length)
+	}
+	if v.StackTrace != nil {
+		w.Key("stackTrace")
+		v.StackTrace.EncodeJSON(w)
+	}
+	if v.CodeOffset != nil {
+		w.Key("codeOffset")
+		w.Int(*v.CodeOffset)
+	}
+	if v.ScriptLanguage != "" {
+		w.Key("scriptLanguage")
+		w.String(string(v.ScriptLanguage))
+	}
+	if len(v.DebugSymbols) > 0 {
+		w.Key("debugSymbols")
+		w.ArrayStart()
+		for i0 := range v.DebugSymbols {
+			v.DebugSymbols[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.EmbedderName != nil {
+		w.Key("embedderName")
+		w.String(*v.EmbedderName)
+	}
+	if len(v.ResolvedBreakpoints) > 0 {
+		w.Key("resolvedBreakpoints")
+		w.ArrayStart()
+		for i0 := range v.ResolvedBreakpoints {
+			v.ResolvedBreakpoints[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScriptParsedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "startLine":
+			v.StartLine = r.Int()
+		case "startColumn":
+			v.StartColumn = r.Int()
+		case "endLine":
+			v.EndLine = r.Int()
+		case "endColumn":
+			v.EndColumn = r.Int()
+		case "executionContextId":
+			v.ExecutionContextID = runtime.ExecutionContextID(r.Int())
+		case "hash":
+			v.Hash = r.String()
+		case "buildId":
+			v.BuildID = r.String()
+		case "executionContextAuxData":
+			v.ExecutionContextAuxData = r.Raw()
+		case "isLiveEdit":
+			if r.Null() {
+				v.IsLiveEdit = nil
+			} else {
+				p := r.Bool()
+				v.IsLiveEdit = &p
+			}
+		case "sourceMapURL":
+			if r.Null() {
+				v.SourceMapURL = nil
+			} else {
+				p := r.String()
+				v.SourceMapURL = &p
+			}
+		case "hasSourceURL":
+			if r.Null() {
+				v.HasSourceURL = nil
+			} else {
+				p := r.Bool()
+				v.HasSourceURL = &p
+			}
+		case "isModule":
+			if r.Null() {
+				v.IsModule = nil
+			} else {
+				p := r.Bool()
+				v.IsModule = &p
+			}
+		case "length":
+			if r.Null() {
+				v.Length = nil
+			} else {
+				p := r.Int()
+				v.Length = &p
+			}
+		case "stackTrace":
+			if r.Null() {
+				v.StackTrace = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.StackTrace = &p
+			}
+		case "codeOffset":
+			if r.Null() {
+				v.CodeOffset = nil
+			} else {
+				p := r.Int()
+				v.CodeOffset = &p
+			}
+		case "scriptLanguage":
+			v.ScriptLanguage = ScriptLanguage(r.String())
+		case "debugSymbols":
+			if r.ArrayStart() {
+				v.DebugSymbols = make([]DebugSymbols, 0, 4)
+				for r.ArrayNext() {
+					var e0 DebugSymbols
+					e0.DecodeJSON(r)
+					v.DebugSymbols = append(v.DebugSymbols, e0)
+				}
+			} else {
+				v.DebugSymbols = nil
+			}
+		case "embedderName":
+			if r.Null() {
+				v.EmbedderName = nil
+			} else {
+				p := r.String()
+				v.EmbedderName = &p
+			}
+		case "resolvedBreakpoints":
+			if r.ArrayStart() {
+				v.ResolvedBreakpoints = make([]ResolvedBreakpoint, 0, 4)
+				for r.ArrayNext() {
+					var e0 ResolvedBreakpoint
+					e0.DecodeJSON(r)
+					v.ResolvedBreakpoints = append(v.ResolvedBreakpoints, e0)
+				}
+			} else {
+				v.ResolvedBreakpoints = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -16,6 +16,12 @@ func NewSelectPromptArgs(id RequestID, deviceID DeviceID) *SelectPromptArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SelectPromptArgs) Validate() error {
+	return nil
+}
+
 // CancelPromptArgs represents the arguments for CancelPrompt in the DeviceAccess domain.
 type CancelPromptArgs struct {
 	ID RequestID `json:"id"` // No description.
@@ -27,3 +33,9 @@ func NewCancelPromptArgs(id RequestID) *CancelPromptArgs {
 	args.ID = id
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CancelPromptArgs) Validate() error {
+	return nil
+}
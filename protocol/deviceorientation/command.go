@@ -17,3 +17,9 @@ func NewSetDeviceOrientationOverrideArgs(alpha float64, beta float64, gamma floa
 	args.Gamma = gamma
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDeviceOrientationOverrideArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,19 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build cdpjson
+
+package deviceorientation
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v SetDeviceOrientationOverrideArgs) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *SetDeviceOrientationOverrideArgs) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
@@ -3,6 +3,9 @@
 package dom
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
@@ -19,6 +22,12 @@ func NewCollectClassNamesFromSubtreeArgs(nodeID NodeID) *CollectClassNamesFromSu
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CollectClassNamesFromSubtreeArgs) Validate() error {
+	return nil
+}
+
 // CollectClassNamesFromSubtreeReply represents the return values for CollectClassNamesFromSubtree in the DOM domain.
 type CollectClassNamesFromSubtreeReply struct {
 	ClassNames []string `json:"classNames"` // Class name list.
@@ -47,6 +56,12 @@ func (a *CopyToArgs) SetInsertBeforeNodeID(insertBeforeNodeID NodeID) *CopyToArg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CopyToArgs) Validate() error {
+	return nil
+}
+
 // CopyToReply represents the return values for CopyTo in the DOM domain.
 type CopyToReply struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node clone.
@@ -105,6 +120,12 @@ func (a *DescribeNodeArgs) SetPierce(pierce bool) *DescribeNodeArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DescribeNodeArgs) Validate() error {
+	return nil
+}
+
 // DescribeNodeReply represents the return values for DescribeNode in the DOM domain.
 type DescribeNodeReply struct {
 	Node Node `json:"node"` // Node description.
@@ -155,6 +176,12 @@ func (a *ScrollIntoViewIfNeededArgs) SetRect(rect Rect) *ScrollIntoViewIfNeededA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ScrollIntoViewIfNeededArgs) Validate() error {
+	return nil
+}
+
 // DiscardSearchResultsArgs represents the arguments for DiscardSearchResults in the DOM domain.
 type DiscardSearchResultsArgs struct {
 	SearchID string `json:"searchId"` // Unique search session identifier.
@@ -167,6 +194,12 @@ func NewDiscardSearchResultsArgs(searchID string) *DiscardSearchResultsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DiscardSearchResultsArgs) Validate() error {
+	return nil
+}
+
 // EnableArgs represents the arguments for Enable in the DOM domain.
 type EnableArgs struct {
 	// IncludeWhitespace Whether to include whitespaces in the children
@@ -197,6 +230,19 @@ func (a *EnableArgs) SetIncludeWhitespace(includeWhitespace string) *EnableArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	if a.IncludeWhitespace != nil {
+		switch *a.IncludeWhitespace {
+		case "none", "all":
+		default:
+			return errors.New("dom.EnableArgs: IncludeWhitespace: invalid value " + strconv.Quote(*a.IncludeWhitespace))
+		}
+	}
+	return nil
+}
+
 // FocusArgs represents the arguments for Focus in the DOM domain.
 type FocusArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -232,6 +278,12 @@ func (a *FocusArgs) SetObjectID(objectID runtime.RemoteObjectID) *FocusArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *FocusArgs) Validate() error {
+	return nil
+}
+
 // GetAttributesArgs represents the arguments for GetAttributes in the DOM domain.
 type GetAttributesArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to retrieve attributes for.
@@ -244,6 +296,12 @@ func NewGetAttributesArgs(nodeID NodeID) *GetAttributesArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAttributesArgs) Validate() error {
+	return nil
+}
+
 // GetAttributesReply represents the return values for GetAttributes in the DOM domain.
 type GetAttributesReply struct {
 	Attributes []string `json:"attributes"` // An interleaved array of node attribute names and values.
@@ -284,6 +342,12 @@ func (a *GetBoxModelArgs) SetObjectID(objectID runtime.RemoteObjectID) *GetBoxMo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetBoxModelArgs) Validate() error {
+	return nil
+}
+
 // GetBoxModelReply represents the return values for GetBoxModel in the DOM domain.
 type GetBoxModelReply struct {
 	Model BoxModel `json:"model"` // Box model for the node.
@@ -324,6 +388,12 @@ func (a *GetContentQuadsArgs) SetObjectID(objectID runtime.RemoteObjectID) *GetC
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetContentQuadsArgs) Validate() error {
+	return nil
+}
+
 // GetContentQuadsReply represents the return values for GetContentQuads in the DOM domain.
 type GetContentQuadsReply struct {
 	Quads []Quad `json:"quads"` // Quads that describe node layout relative to viewport.
@@ -358,6 +428,12 @@ func (a *GetDocumentArgs) SetPierce(pierce bool) *GetDocumentArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetDocumentArgs) Validate() error {
+	return nil
+}
+
 // GetDocumentReply represents the return values for GetDocument in the DOM domain.
 type GetDocumentReply struct {
 	Root Node `json:"root"` // Resulting node.
@@ -392,6 +468,12 @@ func (a *GetFlattenedDocumentArgs) SetPierce(pierce bool) *GetFlattenedDocumentA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetFlattenedDocumentArgs) Validate() error {
+	return nil
+}
+
 // GetFlattenedDocumentReply represents the return values for GetFlattenedDocument in the DOM domain.
 type GetFlattenedDocumentReply struct {
 	Nodes []Node `json:"nodes"` // Resulting node.
@@ -420,6 +502,15 @@ func (a *GetNodesForSubtreeByStyleArgs) SetPierce(pierce bool) *GetNodesForSubtr
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetNodesForSubtreeByStyleArgs) Validate() error {
+	if a.ComputedStyles == nil {
+		return errors.New("dom.GetNodesForSubtreeByStyleArgs: ComputedStyles is required")
+	}
+	return nil
+}
+
 // GetNodesForSubtreeByStyleReply represents the return values for GetNodesForSubtreeByStyle in the DOM domain.
 type GetNodesForSubtreeByStyleReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Resulting nodes.
@@ -457,6 +548,12 @@ func (a *GetNodeForLocationArgs) SetIgnorePointerEventsNone(ignorePointerEventsN
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetNodeForLocationArgs) Validate() error {
+	return nil
+}
+
 // GetNodeForLocationReply represents the return values for GetNodeForLocation in the DOM domain.
 type GetNodeForLocationReply struct {
 	BackendNodeID BackendNodeID        `json:"backendNodeId"`    // Resulting node.
@@ -513,6 +610,12 @@ func (a *GetOuterHTMLArgs) SetIncludeShadowDOM(includeShadowDOM bool) *GetOuterH
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetOuterHTMLArgs) Validate() error {
+	return nil
+}
+
 // GetOuterHTMLReply represents the return values for GetOuterHTML in the DOM domain.
 type GetOuterHTMLReply struct {
 	OuterHTML string `json:"outerHTML"` // Outer HTML markup.
@@ -530,6 +633,12 @@ func NewGetRelayoutBoundaryArgs(nodeID NodeID) *GetRelayoutBoundaryArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetRelayoutBoundaryArgs) Validate() error {
+	return nil
+}
+
 // GetRelayoutBoundaryReply represents the return values for GetRelayoutBoundary in the DOM domain.
 type GetRelayoutBoundaryReply struct {
 	NodeID NodeID `json:"nodeId"` // Relayout boundary node id for the given node.
@@ -551,6 +660,12 @@ func NewGetSearchResultsArgs(searchID string, fromIndex int, toIndex int) *GetSe
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetSearchResultsArgs) Validate() error {
+	return nil
+}
+
 // GetSearchResultsReply represents the return values for GetSearchResults in the DOM domain.
 type GetSearchResultsReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Ids of the search result nodes.
@@ -579,6 +694,12 @@ func (a *MoveToArgs) SetInsertBeforeNodeID(insertBeforeNodeID NodeID) *MoveToArg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *MoveToArgs) Validate() error {
+	return nil
+}
+
 // MoveToReply represents the return values for MoveTo in the DOM domain.
 type MoveToReply struct {
 	NodeID NodeID `json:"nodeId"` // New id of the moved node.
@@ -604,6 +725,12 @@ func (a *PerformSearchArgs) SetIncludeUserAgentShadowDOM(includeUserAgentShadowD
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *PerformSearchArgs) Validate() error {
+	return nil
+}
+
 // PerformSearchReply represents the return values for PerformSearch in the DOM domain.
 type PerformSearchReply struct {
 	SearchID    string `json:"searchId"`    // Unique search session identifier.
@@ -622,6 +749,12 @@ func NewPushNodeByPathToFrontendArgs(path string) *PushNodeByPathToFrontendArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *PushNodeByPathToFrontendArgs) Validate() error {
+	return nil
+}
+
 // PushNodeByPathToFrontendReply represents the return values for PushNodeByPathToFrontend in the DOM domain.
 type PushNodeByPathToFrontendReply struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node for given path.
@@ -639,6 +772,15 @@ func NewPushNodesByBackendIDsToFrontendArgs(backendNodeIDs []BackendNodeID) *Pus
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *PushNodesByBackendIDsToFrontendArgs) Validate() error {
+	if a.BackendNodeIDs == nil {
+		return errors.New("dom.PushNodesByBackendIDsToFrontendArgs: BackendNodeIDs is required")
+	}
+	return nil
+}
+
 // PushNodesByBackendIDsToFrontendReply represents the return values for PushNodesByBackendIDsToFrontend in the DOM domain.
 type PushNodesByBackendIDsToFrontendReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // The array of ids of pushed nodes that correspond to the backend ids specified in backendNodeIds.
@@ -658,6 +800,12 @@ func NewQuerySelectorArgs(nodeID NodeID, selector string) *QuerySelectorArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *QuerySelectorArgs) Validate() error {
+	return nil
+}
+
 // QuerySelectorReply represents the return values for QuerySelector in the DOM domain.
 type QuerySelectorReply struct {
 	NodeID NodeID `json:"nodeId"` // Query selector result.
@@ -677,6 +825,12 @@ func NewQuerySelectorAllArgs(nodeID NodeID, selector string) *QuerySelectorAllAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *QuerySelectorAllArgs) Validate() error {
+	return nil
+}
+
 // QuerySelectorAllReply represents the return values for QuerySelectorAll in the DOM domain.
 type QuerySelectorAllReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Query selector result.
@@ -704,6 +858,17 @@ func NewGetElementByRelationArgs(nodeID NodeID, relation string) *GetElementByRe
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetElementByRelationArgs) Validate() error {
+	switch a.Relation {
+	case "PopoverTarget", "InterestTarget", "CommandFor":
+	default:
+		return errors.New("dom.GetElementByRelationArgs: Relation: invalid value " + strconv.Quote(a.Relation))
+	}
+	return nil
+}
+
 // GetElementByRelationReply represents the return values for GetElementByRelation in the DOM domain.
 type GetElementByRelationReply struct {
 	NodeID NodeID `json:"nodeId"` // NodeId of the element matching the queried relation.
@@ -723,6 +888,12 @@ func NewRemoveAttributeArgs(nodeID NodeID, name string) *RemoveAttributeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveAttributeArgs) Validate() error {
+	return nil
+}
+
 // RemoveNodeArgs represents the arguments for RemoveNode in the DOM domain.
 type RemoveNodeArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to remove.
@@ -735,6 +906,12 @@ func NewRemoveNodeArgs(nodeID NodeID) *RemoveNodeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveNodeArgs) Validate() error {
+	return nil
+}
+
 // RequestChildNodesArgs represents the arguments for RequestChildNodes in the DOM domain.
 type RequestChildNodesArgs struct {
 	NodeID NodeID `json:"nodeId"`           // Id of the node to get children for.
@@ -765,6 +942,12 @@ func (a *RequestChildNodesArgs) SetPierce(pierce bool) *RequestChildNodesArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestChildNodesArgs) Validate() error {
+	return nil
+}
+
 // RequestNodeArgs represents the arguments for RequestNode in the DOM domain.
 type RequestNodeArgs struct {
 	ObjectID runtime.RemoteObjectID `json:"objectId"` // JavaScript object id to convert into node.
@@ -777,6 +960,12 @@ func NewRequestNodeArgs(objectID runtime.RemoteObjectID) *RequestNodeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestNodeArgs) Validate() error {
+	return nil
+}
+
 // RequestNodeReply represents the return values for RequestNode in the DOM domain.
 type RequestNodeReply struct {
 	NodeID NodeID `json:"nodeId"` // Node id for given object.
@@ -825,6 +1014,12 @@ func (a *ResolveNodeArgs) SetExecutionContextID(executionContextID runtime.Execu
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResolveNodeArgs) Validate() error {
+	return nil
+}
+
 // ResolveNodeReply represents the return values for ResolveNode in the DOM domain.
 type ResolveNodeReply struct {
 	Object runtime.RemoteObject `json:"object"` // JavaScript object wrapper for given node.
@@ -846,6 +1041,12 @@ func NewSetAttributeValueArgs(nodeID NodeID, name string, value string) *SetAttr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAttributeValueArgs) Validate() error {
+	return nil
+}
+
 // SetAttributesAsTextArgs represents the arguments for SetAttributesAsText in the DOM domain.
 type SetAttributesAsTextArgs struct {
 	NodeID NodeID  `json:"nodeId"`         // Id of the element to set attributes for.
@@ -869,6 +1070,12 @@ func (a *SetAttributesAsTextArgs) SetName(name string) *SetAttributesAsTextArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAttributesAsTextArgs) Validate() error {
+	return nil
+}
+
 // SetFileInputFilesArgs represents the arguments for SetFileInputFiles in the DOM domain.
 type SetFileInputFilesArgs struct {
 	Files         []string                `json:"files"`                   // Array of file paths to set.
@@ -905,6 +1112,15 @@ func (a *SetFileInputFilesArgs) SetObjectID(objectID runtime.RemoteObjectID) *Se
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetFileInputFilesArgs) Validate() error {
+	if a.Files == nil {
+		return errors.New("dom.SetFileInputFilesArgs: Files is required")
+	}
+	return nil
+}
+
 // SetNodeStackTracesEnabledArgs represents the arguments for SetNodeStackTracesEnabled in the DOM domain.
 type SetNodeStackTracesEnabledArgs struct {
 	Enable bool `json:"enable"` // Enable or disable.
@@ -917,6 +1133,12 @@ func NewSetNodeStackTracesEnabledArgs(enable bool) *SetNodeStackTracesEnabledArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetNodeStackTracesEnabledArgs) Validate() error {
+	return nil
+}
+
 // GetNodeStackTracesArgs represents the arguments for GetNodeStackTraces in the DOM domain.
 type GetNodeStackTracesArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to get stack traces for.
@@ -929,6 +1151,12 @@ func NewGetNodeStackTracesArgs(nodeID NodeID) *GetNodeStackTracesArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetNodeStackTracesArgs) Validate() error {
+	return nil
+}
+
 // GetNodeStackTracesReply represents the return values for GetNodeStackTraces in the DOM domain.
 type GetNodeStackTracesReply struct {
 	Creation *runtime.StackTrace `json:"creation,omitempty"` // Creation stack trace, if available.
@@ -946,6 +1174,12 @@ func NewGetFileInfoArgs(objectID runtime.RemoteObjectID) *GetFileInfoArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetFileInfoArgs) Validate() error {
+	return nil
+}
+
 // GetFileInfoReply represents the return values for GetFileInfo in the DOM domain.
 type GetFileInfoReply struct {
 	Path string `json:"path"` // No description.
@@ -968,6 +1202,12 @@ func NewSetInspectedNodeArgs(nodeID NodeID) *SetInspectedNodeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInspectedNodeArgs) Validate() error {
+	return nil
+}
+
 // SetNodeNameArgs represents the arguments for SetNodeName in the DOM domain.
 type SetNodeNameArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to set name for.
@@ -982,6 +1222,12 @@ func NewSetNodeNameArgs(nodeID NodeID, name string) *SetNodeNameArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetNodeNameArgs) Validate() error {
+	return nil
+}
+
 // SetNodeNameReply represents the return values for SetNodeName in the DOM domain.
 type SetNodeNameReply struct {
 	NodeID NodeID `json:"nodeId"` // New node's id.
@@ -1001,6 +1247,12 @@ func NewSetNodeValueArgs(nodeID NodeID, value string) *SetNodeValueArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetNodeValueArgs) Validate() error {
+	return nil
+}
+
 // SetOuterHTMLArgs represents the arguments for SetOuterHTML in the DOM domain.
 type SetOuterHTMLArgs struct {
 	NodeID    NodeID `json:"nodeId"`    // Id of the node to set markup for.
@@ -1015,6 +1267,12 @@ func NewSetOuterHTMLArgs(nodeID NodeID, outerHTML string) *SetOuterHTMLArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetOuterHTMLArgs) Validate() error {
+	return nil
+}
+
 // GetFrameOwnerArgs represents the arguments for GetFrameOwner in the DOM domain.
 type GetFrameOwnerArgs struct {
 	FrameID internal.PageFrameID `json:"frameId"` // No description.
@@ -1027,6 +1285,12 @@ func NewGetFrameOwnerArgs(frameID internal.PageFrameID) *GetFrameOwnerArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetFrameOwnerArgs) Validate() error {
+	return nil
+}
+
 // GetFrameOwnerReply represents the return values for GetFrameOwner in the DOM domain.
 type GetFrameOwnerReply struct {
 	BackendNodeID BackendNodeID `json:"backendNodeId"`    // Resulting node.
@@ -1080,6 +1344,22 @@ func (a *GetContainerForNodeArgs) SetQueriesAnchored(queriesAnchored bool) *GetC
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetContainerForNodeArgs) Validate() error {
+	if a.PhysicalAxes != "" {
+		if !a.PhysicalAxes.Valid() {
+			return errors.New("dom.GetContainerForNodeArgs: PhysicalAxes: invalid value " + strconv.Quote(string(a.PhysicalAxes)))
+		}
+	}
+	if a.LogicalAxes != "" {
+		if !a.LogicalAxes.Valid() {
+			return errors.New("dom.GetContainerForNodeArgs: LogicalAxes: invalid value " + strconv.Quote(string(a.LogicalAxes)))
+		}
+	}
+	return nil
+}
+
 // GetContainerForNodeReply represents the return values for GetContainerForNode in the DOM domain.
 type GetContainerForNodeReply struct {
 	NodeID *NodeID `json:"nodeId,omitempty"` // The container node for the given node, or null if not found.
@@ -1097,6 +1377,12 @@ func NewGetQueryingDescendantsForContainerArgs(nodeID NodeID) *GetQueryingDescen
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetQueryingDescendantsForContainerArgs) Validate() error {
+	return nil
+}
+
 // GetQueryingDescendantsForContainerReply represents the return values for GetQueryingDescendantsForContainer in the DOM domain.
 type GetQueryingDescendantsForContainerReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Descendant nodes with container queries against the given container.
@@ -1125,6 +1411,12 @@ func (a *GetAnchorElementArgs) SetAnchorSpecifier(anchorSpecifier string) *GetAn
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAnchorElementArgs) Validate() error {
+	return nil
+}
+
 // GetAnchorElementReply represents the return values for GetAnchorElement in the DOM domain.
 type GetAnchorElementReply struct {
 	NodeID NodeID `json:"nodeId"` // The anchor element of the given anchor query.
@@ -1144,6 +1436,12 @@ func NewForceShowPopoverArgs(nodeID NodeID, enable bool) *ForceShowPopoverArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ForceShowPopoverArgs) Validate() error {
+	return nil
+}
+
 // ForceShowPopoverReply represents the return values for ForceShowPopover in the DOM domain.
 type ForceShowPopoverReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // List of popovers that were closed in order to respect popover stacking order.
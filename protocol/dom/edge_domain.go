@@ -0,0 +1,33 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package dom
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func (d *domainClient) InspectNodeRequested(ctx context.Context) (InspectNodeRequestedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.inspectNodeRequested", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &inspectNodeRequestedClient{Stream: s}, nil
+}
+
+type inspectNodeRequestedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *inspectNodeRequestedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *inspectNodeRequestedClient) Recv() (*InspectNodeRequestedReply, error) {
+	event := new(InspectNodeRequestedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "InspectNodeRequested Recv", Err: err}
+	}
+	return event, nil
+}
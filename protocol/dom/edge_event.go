@@ -0,0 +1,24 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package dom
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// InspectNodeRequestedClient is a client for InspectNodeRequested events.
+// Fired when the node should be inspected. This happens after call to
+// setInspectMode.
+type InspectNodeRequestedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*InspectNodeRequestedReply, error)
+	rpcc.Stream
+}
+
+// InspectNodeRequestedReply is the reply for InspectNodeRequested events.
+type InspectNodeRequestedReply struct {
+	BackendNodeID BackendNodeID `json:"backendNodeId"` // Id of the node to inspect.
+}
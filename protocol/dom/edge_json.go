@@ -0,0 +1,179 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package dom
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ShowInfo != nil {
+		w.Key("showInfo")
+		w.Bool(*v.ShowInfo)
+	}
+	if v.ShowRulers != nil {
+		w.Key("showRulers")
+		w.Bool(*v.ShowRulers)
+	}
+	if v.ShowExtensionLines != nil {
+		w.Key("showExtensionLines")
+		w.Bool(*v.ShowExtensionLines)
+	}
+	if v.DisplayAsMaterial != nil {
+		w.Key("displayAsMaterial")
+		w.Bool(*v.DisplayAsMaterial)
+	}
+	if v.ContentColor != nil {
+		w.Key("contentColor")
+		v.ContentColor.EncodeJSON(w)
+	}
+	if v.PaddingColor != nil {
+		w.Key("paddingColor")
+		v.PaddingColor.EncodeJSON(w)
+	}
+	if v.BorderColor != nil {
+		w.Key("borderColor")
+		v.BorderColor.EncodeJSON(w)
+	}
+	if v.MarginColor != nil {
+		w.Key("marginColor")
+		v.MarginColor.EncodeJSON(w)
+	}
+	if v.EventTargetColor != nil {
+		w.Key("eventTargetColor")
+		v.EventTargetColor.EncodeJSON(w)
+	}
+	if v.ShapeColor != nil {
+		w.Key("shapeColor")
+		v.ShapeColor.EncodeJSON(w)
+	}
+	if v.ShapeMarginColor != nil {
+		w.Key("shapeMarginColor")
+		v.ShapeMarginColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "showInfo":
+			if r.Null() {
+				v.ShowInfo = nil
+			} else {
+				p := r.Bool()
+				v.ShowInfo = &p
+			}
+		case "showRulers":
+			if r.Null() {
+				v.ShowRulers = nil
+			} else {
+				p := r.Bool()
+				v.ShowRulers = &p
+			}
+		case "showExtensionLines":
+			if r.Null() {
+				v.ShowExtensionLines = nil
+			} else {
+				p := r.Bool()
+				v.ShowExtensionLines = &p
+			}
+		case "displayAsMaterial":
+			if r.Null() {
+				v.DisplayAsMaterial = nil
+			} else {
+				p := r.Bool()
+				v.DisplayAsMaterial = &p
+			}
+		case "contentColor":
+			if r.Null() {
+				v.ContentColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.ContentColor = &p
+			}
+		case "paddingColor":
+			if r.Null() {
+				v.PaddingColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.PaddingColor = &p
+			}
+		case "borderColor":
+			if r.Null() {
+				v.BorderColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.BorderColor = &p
+			}
+		case "marginColor":
+			if r.Null() {
+				v.MarginColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.MarginColor = &p
+			}
+		case "eventTargetColor":
+			if r.Null() {
+				v.EventTargetColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.EventTargetColor = &p
+			}
+		case "shapeColor":
+			if r.Null() {
+				v.ShapeColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.ShapeColor = &p
+			}
+		case "shapeMarginColor":
+			if r.Null() {
+				v.ShapeMarginColor = nil
+			} else {
+				var p RGBA
+				p.DecodeJSON(r)
+				v.ShapeMarginColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InspectNodeRequestedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InspectNodeRequestedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backendNodeId":
+			v.BackendNodeID = BackendNodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge && cdpjson
+
+package dom
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// MarshalJSON implements json.Marshaler.
+func (v HighlightConfig) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *HighlightConfig) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v InspectNodeRequestedReply) MarshalJSON() ([]byte, error) {
+	return jsonx.Marshal(&v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *InspectNodeRequestedReply) UnmarshalJSON(data []byte) error {
+	return jsonx.Unmarshal(data, v)
+}
@@ -0,0 +1,45 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package dom
+
+// HighlightConfig Configuration data for the highlighting of page elements.
+type HighlightConfig struct {
+	ShowInfo           *bool `json:"showInfo,omitempty"`           // Whether the node info tooltip should be shown (default: false).
+	ShowRulers         *bool `json:"showRulers,omitempty"`         // Whether the rulers should be shown (default: false).
+	ShowExtensionLines *bool `json:"showExtensionLines,omitempty"` // Whether the extension lines from node to the rulers should be shown (default: false).
+	DisplayAsMaterial  *bool `json:"displayAsMaterial,omitempty"`  // No description.
+	ContentColor       *RGBA `json:"contentColor,omitempty"`       // The content box highlight fill color (default: transparent).
+	PaddingColor       *RGBA `json:"paddingColor,omitempty"`       // The padding highlight fill color (default: transparent).
+	BorderColor        *RGBA `json:"borderColor,omitempty"`        // The border highlight fill color (default: transparent).
+	MarginColor        *RGBA `json:"marginColor,omitempty"`        // The margin highlight fill color (default: transparent).
+	EventTargetColor   *RGBA `json:"eventTargetColor,omitempty"`   // The event target element highlight fill color (default: transparent).
+	ShapeColor         *RGBA `json:"shapeColor,omitempty"`         // The shape outside fill color (default: transparent).
+	ShapeMarginColor   *RGBA `json:"shapeMarginColor,omitempty"`   // The shape margin fill color (default: transparent).
+}
+
+// InspectMode
+type InspectMode string
+
+// InspectMode as enums.
+const (
+	InspectModeNotSet               InspectMode = ""
+	InspectModeSearchForNode        InspectMode = "searchForNode"
+	InspectModeSearchForUAShadowDOM InspectMode = "searchForUAShadowDOM"
+	InspectModeShowLayoutEditor     InspectMode = "showLayoutEditor"
+	InspectModeNone                 InspectMode = "none"
+)
+
+func (e InspectMode) Valid() bool {
+	switch e {
+	case "searchForNode", "searchForUAShadowDOM", "showLayoutEditor", "none":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e InspectMode) String() string {
+	return string(e)
+}
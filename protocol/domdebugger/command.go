@@ -3,6 +3,9 @@
 package domdebugger
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
@@ -38,6 +41,12 @@ func (a *GetEventListenersArgs) SetPierce(pierce bool) *GetEventListenersArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetEventListenersArgs) Validate() error {
+	return nil
+}
+
 // GetEventListenersReply represents the return values for GetEventListeners in the DOMDebugger domain.
 type GetEventListenersReply struct {
 	Listeners []EventListener `json:"listeners"` // Array of relevant listeners.
@@ -57,6 +66,15 @@ func NewRemoveDOMBreakpointArgs(nodeID dom.NodeID, typ DOMBreakpointType) *Remov
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveDOMBreakpointArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("domdebugger.RemoveDOMBreakpointArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // RemoveEventListenerBreakpointArgs represents the arguments for RemoveEventListenerBreakpoint in the DOMDebugger domain.
 type RemoveEventListenerBreakpointArgs struct {
 	EventName string `json:"eventName"` // Event name.
@@ -82,6 +100,12 @@ func (a *RemoveEventListenerBreakpointArgs) SetTargetName(targetName string) *Re
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveEventListenerBreakpointArgs) Validate() error {
+	return nil
+}
+
 // RemoveXHRBreakpointArgs represents the arguments for RemoveXHRBreakpoint in the DOMDebugger domain.
 type RemoveXHRBreakpointArgs struct {
 	URL string `json:"url"` // Resource URL substring.
@@ -94,6 +118,12 @@ func NewRemoveXHRBreakpointArgs(url string) *RemoveXHRBreakpointArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveXHRBreakpointArgs) Validate() error {
+	return nil
+}
+
 // SetBreakOnCSPViolationArgs represents the arguments for SetBreakOnCSPViolation in the DOMDebugger domain.
 type SetBreakOnCSPViolationArgs struct {
 	ViolationTypes []CSPViolationType `json:"violationTypes"` // CSP Violations to stop upon.
@@ -106,6 +136,20 @@ func NewSetBreakOnCSPViolationArgs(violationTypes []CSPViolationType) *SetBreakO
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBreakOnCSPViolationArgs) Validate() error {
+	if a.ViolationTypes == nil {
+		return errors.New("domdebugger.SetBreakOnCSPViolationArgs: ViolationTypes is required")
+	}
+	for i0 := range a.ViolationTypes {
+		if !a.ViolationTypes[i0].Valid() {
+			return errors.New("domdebugger.SetBreakOnCSPViolationArgs: ViolationTypes" + "[" + strconv.Itoa(i0) + "]: invalid value " + strconv.Quote(string(a.ViolationTypes[i0])))
+		}
+	}
+	return nil
+}
+
 // SetDOMBreakpointArgs represents the arguments for SetDOMBreakpoint in the DOMDebugger domain.
 type SetDOMBreakpointArgs struct {
 	NodeID dom.NodeID        `json:"nodeId"` // Identifier of the node to set breakpoint on.
@@ -120,6 +164,15 @@ func NewSetDOMBreakpointArgs(nodeID dom.NodeID, typ DOMBreakpointType) *SetDOMBr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDOMBreakpointArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("domdebugger.SetDOMBreakpointArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // SetEventListenerBreakpointArgs represents the arguments for SetEventListenerBreakpoint in the DOMDebugger domain.
 type SetEventListenerBreakpointArgs struct {
 	EventName string `json:"eventName"` // DOM Event name to stop on (any DOM event will do).
@@ -147,6 +200,12 @@ func (a *SetEventListenerBreakpointArgs) SetTargetName(targetName string) *SetEv
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEventListenerBreakpointArgs) Validate() error {
+	return nil
+}
+
 // SetXHRBreakpointArgs represents the arguments for SetXHRBreakpoint in the DOMDebugger domain.
 type SetXHRBreakpointArgs struct {
 	URL string `json:"url"` // Resource URL substring. All XHRs having this substring in the URL will get stopped upon.
@@ -158,3 +217,9 @@ func NewSetXHRBreakpointArgs(url string) *SetXHRBreakpointArgs {
 	args.URL = url
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetXHRBreakpointArgs) Validate() error {
+	return nil
+}
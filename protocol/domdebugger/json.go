@@ -0,0 +1,383 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package domdebugger
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EventListener) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("useCapture")
+	w.Bool(v.UseCapture)
+	w.Key("passive")
+	w.Bool(v.Passive)
+	w.Key("once")
+	w.Bool(v.Once)
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	if v.Handler != nil {
+		w.Key("handler")
+		v.Handler.EncodeJSON(w)
+	}
+	if v.OriginalHandler != nil {
+		w.Key("originalHandler")
+		v.OriginalHandler.EncodeJSON(w)
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EventListener) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "useCapture":
+			v.UseCapture = r.Bool()
+		case "passive":
+			v.Passive = r.Bool()
+		case "once":
+			v.Once = r.Bool()
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		case "handler":
+			if r.Null() {
+				v.Handler = nil
+			} else {
+				var p runtime.RemoteObject
+				p.DecodeJSON(r)
+				v.Handler = &p
+			}
+		case "originalHandler":
+			if r.Null() {
+				v.OriginalHandler = nil
+			} else {
+				var p runtime.RemoteObject
+				p.DecodeJSON(r)
+				v.OriginalHandler = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetEventListenersArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	if v.Depth != nil {
+		w.Key("depth")
+		w.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		w.Key("pierce")
+		w.Bool(*v.Pierce)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetEventListenersArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = runtime.RemoteObjectID(r.String())
+		case "depth":
+			if r.Null() {
+				v.Depth = nil
+			} else {
+				p := r.Int()
+				v.Depth = &p
+			}
+		case "pierce":
+			if r.Null() {
+				v.Pierce = nil
+			} else {
+				p := r.Bool()
+				v.Pierce = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetEventListenersReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("listeners")
+	if v.Listeners == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Listeners {
+			v.Listeners[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetEventListenersReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "listeners":
+			if r.ArrayStart() {
+				v.Listeners = make([]EventListener, 0, 4)
+				for r.ArrayNext() {
+					var e0 EventListener
+					e0.DecodeJSON(r)
+					v.Listeners = append(v.Listeners, e0)
+				}
+			} else {
+				v.Listeners = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveDOMBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("type")
+	w.String(string(v.Type))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveDOMBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "type":
+			v.Type = DOMBreakpointType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveEventListenerBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventName")
+	w.String(v.EventName)
+	if v.TargetName != nil {
+		w.Key("targetName")
+		w.String(*v.TargetName)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveEventListenerBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventName":
+			v.EventName = r.String()
+		case "targetName":
+			if r.Null() {
+				v.TargetName = nil
+			} else {
+				p := r.String()
+				v.TargetName = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveXHRBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveXHRBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBreakOnCSPViolationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("violationTypes")
+	if v.ViolationTypes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ViolationTypes {
+			w.String(string(v.ViolationTypes[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBreakOnCSPViolationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "violationTypes":
+			if r.ArrayStart() {
+				v.ViolationTypes = make([]CSPViolationType, 0, 4)
+				for r.ArrayNext() {
+					e0 := CSPViolationType(r.String())
+					v.ViolationTypes = append(v.ViolationTypes, e0)
+				}
+			} else {
+				v.ViolationTypes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDOMBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("type")
+	w.String(string(v.Type))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDOMBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "type":
+			v.Type = DOMBreakpointType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetEventListenerBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventName")
+	w.String(v.EventName)
+	if v.TargetName != nil {
+		w.Key("targetName")
+		w.String(*v.TargetName)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetEventListenerBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventName":
+			v.EventName = r.String()
+		case "targetName":
+			if r.Null() {
+				v.TargetName = nil
+			} else {
+				p := r.String()
+				v.TargetName = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetXHRBreakpointArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetXHRBreakpointArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
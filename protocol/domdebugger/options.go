@@ -0,0 +1,80 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package domdebugger
+
+import (
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// GetEventListenersOption sets an optional argument of GetEventListenersArgs.
+type GetEventListenersOption func(*GetEventListenersArgs)
+
+// NewGetEventListenersArgsWith initializes GetEventListenersArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetEventListenersArgsWith(objectID runtime.RemoteObjectID, opts ...GetEventListenersOption) *GetEventListenersArgs {
+	args := NewGetEventListenersArgs(objectID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetEventListenersWithDepth sets the Depth optional argument, see
+// GetEventListenersArgs.SetDepth.
+func GetEventListenersWithDepth(v int) GetEventListenersOption {
+	return func(a *GetEventListenersArgs) { a.SetDepth(v) }
+}
+
+// GetEventListenersWithPierce sets the Pierce optional argument, see
+// GetEventListenersArgs.SetPierce.
+func GetEventListenersWithPierce(v bool) GetEventListenersOption {
+	return func(a *GetEventListenersArgs) { a.SetPierce(v) }
+}
+
+// RemoveEventListenerBreakpointOption sets an optional argument of RemoveEventListenerBreakpointArgs.
+type RemoveEventListenerBreakpointOption func(*RemoveEventListenerBreakpointArgs)
+
+// NewRemoveEventListenerBreakpointArgsWith initializes RemoveEventListenerBreakpointArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRemoveEventListenerBreakpointArgsWith(eventName string, opts ...RemoveEventListenerBreakpointOption) *RemoveEventListenerBreakpointArgs {
+	args := NewRemoveEventListenerBreakpointArgs(eventName)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RemoveEventListenerBreakpointWithTargetName sets the TargetName optional argument, see
+// RemoveEventListenerBreakpointArgs.SetTargetName.
+//
+// Note: This argument is experimental.
+func RemoveEventListenerBreakpointWithTargetName(v string) RemoveEventListenerBreakpointOption {
+	return func(a *RemoveEventListenerBreakpointArgs) { a.SetTargetName(v) }
+}
+
+// SetEventListenerBreakpointOption sets an optional argument of SetEventListenerBreakpointArgs.
+type SetEventListenerBreakpointOption func(*SetEventListenerBreakpointArgs)
+
+// NewSetEventListenerBreakpointArgsWith initializes SetEventListenerBreakpointArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetEventListenerBreakpointArgsWith(eventName string, opts ...SetEventListenerBreakpointOption) *SetEventListenerBreakpointArgs {
+	args := NewSetEventListenerBreakpointArgs(eventName)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetEventListenerBreakpointWithTargetName sets the TargetName optional argument, see
+// SetEventListenerBreakpointArgs.SetTargetName.
+//
+// Note: This argument is experimental.
+func SetEventListenerBreakpointWithTargetName(v string) SetEventListenerBreakpointOption {
+	return func(a *SetEventListenerBreakpointArgs) { a.SetTargetName(v) }
+}
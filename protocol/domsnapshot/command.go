@@ -2,6 +2,8 @@
 
 package domsnapshot
 
+import "errors"
+
 // GetSnapshotArgs represents the arguments for GetSnapshot in the DOMSnapshot domain.
 type GetSnapshotArgs struct {
 	ComputedStyleWhitelist     []string `json:"computedStyleWhitelist"`               // Whitelist of computed styles to return.
@@ -39,6 +41,15 @@ func (a *GetSnapshotArgs) SetIncludeUserAgentShadowTree(includeUserAgentShadowTr
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetSnapshotArgs) Validate() error {
+	if a.ComputedStyleWhitelist == nil {
+		return errors.New("domsnapshot.GetSnapshotArgs: ComputedStyleWhitelist is required")
+	}
+	return nil
+}
+
 // GetSnapshotReply represents the return values for GetSnapshot in the DOMSnapshot domain.
 type GetSnapshotReply struct {
 	DOMNodes        []DOMNode        `json:"domNodes"`        // The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.
@@ -114,6 +125,15 @@ func (a *CaptureSnapshotArgs) SetIncludeTextColorOpacities(includeTextColorOpaci
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CaptureSnapshotArgs) Validate() error {
+	if a.ComputedStyles == nil {
+		return errors.New("domsnapshot.CaptureSnapshotArgs: ComputedStyles is required")
+	}
+	return nil
+}
+
 // CaptureSnapshotReply represents the return values for CaptureSnapshot in the DOMSnapshot domain.
 type CaptureSnapshotReply struct {
 	Documents []DocumentSnapshot `json:"documents"` // The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.
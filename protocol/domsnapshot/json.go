@@ -0,0 +1,1776 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package domsnapshot
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/domdebugger"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DOMNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeType")
+	w.Int(v.NodeType)
+	w.Key("nodeName")
+	w.String(v.NodeName)
+	w.Key("nodeValue")
+	w.String(v.NodeValue)
+	if v.TextValue != nil {
+		w.Key("textValue")
+		w.String(*v.TextValue)
+	}
+	if v.InputValue != nil {
+		w.Key("inputValue")
+		w.String(*v.InputValue)
+	}
+	if v.InputChecked != nil {
+		w.Key("inputChecked")
+		w.Bool(*v.InputChecked)
+	}
+	if v.OptionSelected != nil {
+		w.Key("optionSelected")
+		w.Bool(*v.OptionSelected)
+	}
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	if len(v.ChildNodeIndexes) > 0 {
+		w.Key("childNodeIndexes")
+		w.ArrayStart()
+		for i0 := range v.ChildNodeIndexes {
+			w.Int(v.ChildNodeIndexes[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Attributes) > 0 {
+		w.Key("attributes")
+		w.ArrayStart()
+		for i0 := range v.Attributes {
+			v.Attributes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.PseudoElementIndexes) > 0 {
+		w.Key("pseudoElementIndexes")
+		w.ArrayStart()
+		for i0 := range v.PseudoElementIndexes {
+			w.Int(v.PseudoElementIndexes[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.LayoutNodeIndex != nil {
+		w.Key("layoutNodeIndex")
+		w.Int(*v.LayoutNodeIndex)
+	}
+	if v.DocumentURL != nil {
+		w.Key("documentURL")
+		w.String(*v.DocumentURL)
+	}
+	if v.BaseURL != nil {
+		w.Key("baseURL")
+		w.String(*v.BaseURL)
+	}
+	if v.ContentLanguage != nil {
+		w.Key("contentLanguage")
+		w.String(*v.ContentLanguage)
+	}
+	if v.DocumentEncoding != nil {
+		w.Key("documentEncoding")
+		w.String(*v.DocumentEncoding)
+	}
+	if v.PublicID != nil {
+		w.Key("publicId")
+		w.String(*v.PublicID)
+	}
+	if v.SystemID != nil {
+		w.Key("systemId")
+		w.String(*v.SystemID)
+	}
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	if v.ContentDocumentIndex != nil {
+		w.Key("contentDocumentIndex")
+		w.Int(*v.ContentDocumentIndex)
+	}
+	if v.PseudoType != nil {
+		w.Key("pseudoType")
+		w.String(string(*v.PseudoType))
+	}
+	if v.ShadowRootType != nil {
+		w.Key("shadowRootType")
+		w.String(string(*v.ShadowRootType))
+	}
+	if v.IsClickable != nil {
+		w.Key("isClickable")
+		w.Bool(*v.IsClickable)
+	}
+	if len(v.EventListeners) > 0 {
+		w.Key("eventListeners")
+		w.ArrayStart()
+		for i0 := range v.EventListeners {
+			v.EventListeners[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.CurrentSourceURL != nil {
+		w.Key("currentSourceURL")
+		w.String(*v.CurrentSourceURL)
+	}
+	if v.OriginURL != nil {
+		w.Key("originURL")
+		w.String(*v.OriginURL)
+	}
+	if v.ScrollOffsetX != nil {
+		w.Key("scrollOffsetX")
+		w.Float(*v.ScrollOffsetX)
+	}
+	if v.ScrollOffsetY != nil {
+		w.Key("scrollOffsetY")
+		w.Float(*v.ScrollOffsetY)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DOMNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeType":
+			v.NodeType = r.Int()
+		case "nodeName":
+			v.NodeName = r.String()
+		case "nodeValue":
+			v.NodeValue = r.String()
+		case "textValue":
+			if r.Null() {
+				v.TextValue = nil
+			} else {
+				p := r.String()
+				v.TextValue = &p
+			}
+		case "inputValue":
+			if r.Null() {
+				v.InputValue = nil
+			} else {
+				p := r.String()
+				v.InputValue = &p
+			}
+		case "inputChecked":
+			if r.Null() {
+				v.InputChecked = nil
+			} else {
+				p := r.Bool()
+				v.InputChecked = &p
+			}
+		case "optionSelected":
+			if r.Null() {
+				v.OptionSelected = nil
+			} else {
+				p := r.Bool()
+				v.OptionSelected = &p
+			}
+		case "backendNodeId":
+			v.BackendNodeID = dom.BackendNodeID(r.Int())
+		case "childNodeIndexes":
+			if r.ArrayStart() {
+				v.ChildNodeIndexes = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.ChildNodeIndexes = append(v.ChildNodeIndexes, e0)
+				}
+			} else {
+				v.ChildNodeIndexes = nil
+			}
+		case "attributes":
+			if r.ArrayStart() {
+				v.Attributes = make([]NameValue, 0, 4)
+				for r.ArrayNext() {
+					var e0 NameValue
+					e0.DecodeJSON(r)
+					v.Attributes = append(v.Attributes, e0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		case "pseudoElementIndexes":
+			if r.ArrayStart() {
+				v.PseudoElementIndexes = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.PseudoElementIndexes = append(v.PseudoElementIndexes, e0)
+				}
+			} else {
+				v.PseudoElementIndexes = nil
+			}
+		case "layoutNodeIndex":
+			if r.Null() {
+				v.LayoutNodeIndex = nil
+			} else {
+				p := r.Int()
+				v.LayoutNodeIndex = &p
+			}
+		case "documentURL":
+			if r.Null() {
+				v.DocumentURL = nil
+			} else {
+				p := r.String()
+				v.DocumentURL = &p
+			}
+		case "baseURL":
+			if r.Null() {
+				v.BaseURL = nil
+			} else {
+				p := r.String()
+				v.BaseURL = &p
+			}
+		case "contentLanguage":
+			if r.Null() {
+				v.ContentLanguage = nil
+			} else {
+				p := r.String()
+				v.ContentLanguage = &p
+			}
+		case "documentEncoding":
+			if r.Null() {
+				v.DocumentEncoding = nil
+			} else {
+				p := r.String()
+				v.DocumentEncoding = &p
+			}
+		case "publicId":
+			if r.Null() {
+				v.PublicID = nil
+			} else {
+				p := r.String()
+				v.PublicID = &p
+			}
+		case "systemId":
+			if r.Null() {
+				v.SystemID = nil
+			} else {
+				p := r.String()
+				v.SystemID = &p
+			}
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		case "contentDocumentIndex":
+			if r.Null() {
+				v.ContentDocumentIndex = nil
+			} else {
+				p := r.Int()
+				v.ContentDocumentIndex = &p
+			}
+		case "pseudoType":
+			if r.Null() {
+				v.PseudoType = nil
+			} else {
+				p := dom.PseudoType(r.String())
+				v.PseudoType = &p
+			}
+		case "shadowRootType":
+			if r.Null() {
+				v.ShadowRootType = nil
+			} else {
+				p := dom.ShadowRootType(r.String())
+				v.ShadowRootType = &p
+			}
+		case "isClickable":
+			if r.Null() {
+				v.IsClickable = nil
+			} else {
+				p := r.Bool()
+				v.IsClickable = &p
+			}
+		case "eventListeners":
+			if r.ArrayStart() {
+				v.EventListeners = make([]domdebugger.EventListener, 0, 4)
+				for r.ArrayNext() {
+					var e0 domdebugger.EventListener
+					e0.DecodeJSON(r)
+					v.EventListeners = append(v.EventListeners, e0)
+				}
+			} else {
+				v.EventListeners = nil
+			}
+		case "currentSourceURL":
+			if r.Null() {
+				v.CurrentSourceURL = nil
+			} else {
+				p := r.String()
+				v.CurrentSourceURL = &p
+			}
+		case "originURL":
+			if r.Null() {
+				v.OriginURL = nil
+			} else {
+				p := r.String()
+				v.OriginURL = &p
+			}
+		case "scrollOffsetX":
+			if r.Null() {
+				v.ScrollOffsetX = nil
+			} else {
+				p := r.Float()
+				v.ScrollOffsetX = &p
+			}
+		case "scrollOffsetY":
+			if r.Null() {
+				v.ScrollOffsetY = nil
+			} else {
+				p := r.Float()
+				v.ScrollOffsetY = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InlineTextBox) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("boundingBox")
+	v.BoundingBox.EncodeJSON(w)
+	w.Key("startCharacterIndex")
+	w.Int(v.StartCharacterIndex)
+	w.Key("numCharacters")
+	w.Int(v.NumCharacters)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InlineTextBox) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "boundingBox":
+			v.BoundingBox.DecodeJSON(r)
+		case "startCharacterIndex":
+			v.StartCharacterIndex = r.Int()
+		case "numCharacters":
+			v.NumCharacters = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayoutTreeNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("domNodeIndex")
+	w.Int(v.DOMNodeIndex)
+	w.Key("boundingBox")
+	v.BoundingBox.EncodeJSON(w)
+	if v.LayoutText != nil {
+		w.Key("layoutText")
+		w.String(*v.LayoutText)
+	}
+	if len(v.InlineTextNodes) > 0 {
+		w.Key("inlineTextNodes")
+		w.ArrayStart()
+		for i0 := range v.InlineTextNodes {
+			v.InlineTextNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.StyleIndex != nil {
+		w.Key("styleIndex")
+		w.Int(*v.StyleIndex)
+	}
+	if v.PaintOrder != nil {
+		w.Key("paintOrder")
+		w.Int(*v.PaintOrder)
+	}
+	if v.IsStackingContext != nil {
+		w.Key("isStackingContext")
+		w.Bool(*v.IsStackingContext)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayoutTreeNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "domNodeIndex":
+			v.DOMNodeIndex = r.Int()
+		case "boundingBox":
+			v.BoundingBox.DecodeJSON(r)
+		case "layoutText":
+			if r.Null() {
+				v.LayoutText = nil
+			} else {
+				p := r.String()
+				v.LayoutText = &p
+			}
+		case "inlineTextNodes":
+			if r.ArrayStart() {
+				v.InlineTextNodes = make([]InlineTextBox, 0, 4)
+				for r.ArrayNext() {
+					var e0 InlineTextBox
+					e0.DecodeJSON(r)
+					v.InlineTextNodes = append(v.InlineTextNodes, e0)
+				}
+			} else {
+				v.InlineTextNodes = nil
+			}
+		case "styleIndex":
+			if r.Null() {
+				v.StyleIndex = nil
+			} else {
+				p := r.Int()
+				v.StyleIndex = &p
+			}
+		case "paintOrder":
+			if r.Null() {
+				v.PaintOrder = nil
+			} else {
+				p := r.Int()
+				v.PaintOrder = &p
+			}
+		case "isStackingContext":
+			if r.Null() {
+				v.IsStackingContext = nil
+			} else {
+				p := r.Bool()
+				v.IsStackingContext = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ComputedStyle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("properties")
+	if v.Properties == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Properties {
+			v.Properties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ComputedStyle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "properties":
+			if r.ArrayStart() {
+				v.Properties = make([]NameValue, 0, 4)
+				for r.ArrayNext() {
+					var e0 NameValue
+					e0.DecodeJSON(r)
+					v.Properties = append(v.Properties, e0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NameValue) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NameValue) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RareStringData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("index")
+	if v.Index == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Index {
+			w.Int(v.Index[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("value")
+	if v.Value == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Value {
+			w.Int(int(v.Value[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RareStringData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "index":
+			if r.ArrayStart() {
+				v.Index = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Index = append(v.Index, e0)
+				}
+			} else {
+				v.Index = nil
+			}
+		case "value":
+			if r.ArrayStart() {
+				v.Value = make([]StringIndex, 0, 4)
+				for r.ArrayNext() {
+					e0 := StringIndex(r.Int())
+					v.Value = append(v.Value, e0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RareBooleanData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("index")
+	if v.Index == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Index {
+			w.Int(v.Index[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RareBooleanData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "index":
+			if r.ArrayStart() {
+				v.Index = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Index = append(v.Index, e0)
+				}
+			} else {
+				v.Index = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RareIntegerData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("index")
+	if v.Index == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Index {
+			w.Int(v.Index[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("value")
+	if v.Value == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Value {
+			w.Int(v.Value[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RareIntegerData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "index":
+			if r.ArrayStart() {
+				v.Index = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Index = append(v.Index, e0)
+				}
+			} else {
+				v.Index = nil
+			}
+		case "value":
+			if r.ArrayStart() {
+				v.Value = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Value = append(v.Value, e0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DocumentSnapshot) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("documentURL")
+	w.Int(int(v.DocumentURL))
+	w.Key("title")
+	w.Int(int(v.Title))
+	w.Key("baseURL")
+	w.Int(int(v.BaseURL))
+	w.Key("contentLanguage")
+	w.Int(int(v.ContentLanguage))
+	w.Key("encodingName")
+	w.Int(int(v.EncodingName))
+	w.Key("publicId")
+	w.Int(int(v.PublicID))
+	w.Key("systemId")
+	w.Int(int(v.SystemID))
+	w.Key("frameId")
+	w.Int(int(v.FrameID))
+	w.Key("nodes")
+	v.Nodes.EncodeJSON(w)
+	w.Key("layout")
+	v.Layout.EncodeJSON(w)
+	w.Key("textBoxes")
+	v.TextBoxes.EncodeJSON(w)
+	if v.ScrollOffsetX != nil {
+		w.Key("scrollOffsetX")
+		w.Float(*v.ScrollOffsetX)
+	}
+	if v.ScrollOffsetY != nil {
+		w.Key("scrollOffsetY")
+		w.Float(*v.ScrollOffsetY)
+	}
+	if v.ContentWidth != nil {
+		w.Key("contentWidth")
+		w.Float(*v.ContentWidth)
+	}
+	if v.ContentHeight != nil {
+		w.Key("contentHeight")
+		w.Float(*v.ContentHeight)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DocumentSnapshot) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "documentURL":
+			v.DocumentURL = StringIndex(r.Int())
+		case "title":
+			v.Title = StringIndex(r.Int())
+		case "baseURL":
+			v.BaseURL = StringIndex(r.Int())
+		case "contentLanguage":
+			v.ContentLanguage = StringIndex(r.Int())
+		case "encodingName":
+			v.EncodingName = StringIndex(r.Int())
+		case "publicId":
+			v.PublicID = StringIndex(r.Int())
+		case "systemId":
+			v.SystemID = StringIndex(r.Int())
+		case "frameId":
+			v.FrameID = StringIndex(r.Int())
+		case "nodes":
+			v.Nodes.DecodeJSON(r)
+		case "layout":
+			v.Layout.DecodeJSON(r)
+		case "textBoxes":
+			v.TextBoxes.DecodeJSON(r)
+		case "scrollOffsetX":
+			if r.Null() {
+				v.ScrollOffsetX = nil
+			} else {
+				p := r.Float()
+				v.ScrollOffsetX = &p
+			}
+		case "scrollOffsetY":
+			if r.Null() {
+				v.ScrollOffsetY = nil
+			} else {
+				p := r.Float()
+				v.ScrollOffsetY = &p
+			}
+		case "contentWidth":
+			if r.Null() {
+				v.ContentWidth = nil
+			} else {
+				p := r.Float()
+				v.ContentWidth = &p
+			}
+		case "contentHeight":
+			if r.Null() {
+				v.ContentHeight = nil
+			} else {
+				p := r.Float()
+				v.ContentHeight = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodeTreeSnapshot) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.ParentIndex) > 0 {
+		w.Key("parentIndex")
+		w.ArrayStart()
+		for i0 := range v.ParentIndex {
+			w.Int(v.ParentIndex[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.NodeType) > 0 {
+		w.Key("nodeType")
+		w.ArrayStart()
+		for i0 := range v.NodeType {
+			w.Int(v.NodeType[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.ShadowRootType != nil {
+		w.Key("shadowRootType")
+		v.ShadowRootType.EncodeJSON(w)
+	}
+	if len(v.NodeName) > 0 {
+		w.Key("nodeName")
+		w.ArrayStart()
+		for i0 := range v.NodeName {
+			w.Int(int(v.NodeName[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if len(v.NodeValue) > 0 {
+		w.Key("nodeValue")
+		w.ArrayStart()
+		for i0 := range v.NodeValue {
+			w.Int(int(v.NodeValue[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if len(v.BackendNodeID) > 0 {
+		w.Key("backendNodeId")
+		w.ArrayStart()
+		for i0 := range v.BackendNodeID {
+			w.Int(int(v.BackendNodeID[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Attributes) > 0 {
+		w.Key("attributes")
+		w.ArrayStart()
+		for i0 := range v.Attributes {
+			if v.Attributes[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Attributes[i0] {
+					w.Int(int(v.Attributes[i0][i1]))
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	if v.TextValue != nil {
+		w.Key("textValue")
+		v.TextValue.EncodeJSON(w)
+	}
+	if v.InputValue != nil {
+		w.Key("inputValue")
+		v.InputValue.EncodeJSON(w)
+	}
+	if v.InputChecked != nil {
+		w.Key("inputChecked")
+		v.InputChecked.EncodeJSON(w)
+	}
+	if v.OptionSelected != nil {
+		w.Key("optionSelected")
+		v.OptionSelected.EncodeJSON(w)
+	}
+	if v.ContentDocumentIndex != nil {
+		w.Key("contentDocumentIndex")
+		v.ContentDocumentIndex.EncodeJSON(w)
+	}
+	if v.PseudoType != nil {
+		w.Key("pseudoType")
+		v.PseudoType.EncodeJSON(w)
+	}
+	if v.PseudoIdentifier != nil {
+		w.Key("pseudoIdentifier")
+		v.PseudoIdentifier.EncodeJSON(w)
+	}
+	if v.IsClickable != nil {
+		w.Key("isClickable")
+		v.IsClickable.EncodeJSON(w)
+	}
+	if v.CurrentSourceURL != nil {
+		w.Key("currentSourceURL")
+		v.CurrentSourceURL.EncodeJSON(w)
+	}
+	if v.OriginURL != nil {
+		w.Key("originURL")
+		v.OriginURL.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodeTreeSnapshot) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentIndex":
+			if r.ArrayStart() {
+				v.ParentIndex = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.ParentIndex = append(v.ParentIndex, e0)
+				}
+			} else {
+				v.ParentIndex = nil
+			}
+		case "nodeType":
+			if r.ArrayStart() {
+				v.NodeType = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.NodeType = append(v.NodeType, e0)
+				}
+			} else {
+				v.NodeType = nil
+			}
+		case "shadowRootType":
+			if r.Null() {
+				v.ShadowRootType = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.ShadowRootType = &p
+			}
+		case "nodeName":
+			if r.ArrayStart() {
+				v.NodeName = make([]StringIndex, 0, 4)
+				for r.ArrayNext() {
+					e0 := StringIndex(r.Int())
+					v.NodeName = append(v.NodeName, e0)
+				}
+			} else {
+				v.NodeName = nil
+			}
+		case "nodeValue":
+			if r.ArrayStart() {
+				v.NodeValue = make([]StringIndex, 0, 4)
+				for r.ArrayNext() {
+					e0 := StringIndex(r.Int())
+					v.NodeValue = append(v.NodeValue, e0)
+				}
+			} else {
+				v.NodeValue = nil
+			}
+		case "backendNodeId":
+			if r.ArrayStart() {
+				v.BackendNodeID = make([]dom.BackendNodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := dom.BackendNodeID(r.Int())
+					v.BackendNodeID = append(v.BackendNodeID, e0)
+				}
+			} else {
+				v.BackendNodeID = nil
+			}
+		case "attributes":
+			if r.ArrayStart() {
+				v.Attributes = make([]ArrayOfStrings, 0, 4)
+				for r.ArrayNext() {
+					var e0 ArrayOfStrings
+					if r.ArrayStart() {
+						e0 = make(ArrayOfStrings, 0, 4)
+						for r.ArrayNext() {
+							e1 := StringIndex(r.Int())
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Attributes = append(v.Attributes, e0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		case "textValue":
+			if r.Null() {
+				v.TextValue = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.TextValue = &p
+			}
+		case "inputValue":
+			if r.Null() {
+				v.InputValue = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.InputValue = &p
+			}
+		case "inputChecked":
+			if r.Null() {
+				v.InputChecked = nil
+			} else {
+				var p RareBooleanData
+				p.DecodeJSON(r)
+				v.InputChecked = &p
+			}
+		case "optionSelected":
+			if r.Null() {
+				v.OptionSelected = nil
+			} else {
+				var p RareBooleanData
+				p.DecodeJSON(r)
+				v.OptionSelected = &p
+			}
+		case "contentDocumentIndex":
+			if r.Null() {
+				v.ContentDocumentIndex = nil
+			} else {
+				var p RareIntegerData
+				p.DecodeJSON(r)
+				v.ContentDocumentIndex = &p
+			}
+		case "pseudoType":
+			if r.Null() {
+				v.PseudoType = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.PseudoType = &p
+			}
+		case "pseudoIdentifier":
+			if r.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.PseudoIdentifier = &p
+			}
+		case "isClickable":
+			if r.Null() {
+				v.IsClickable = nil
+			} else {
+				var p RareBooleanData
+				p.DecodeJSON(r)
+				v.IsClickable = &p
+			}
+		case "currentSourceURL":
+			if r.Null() {
+				v.CurrentSourceURL = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.CurrentSourceURL = &p
+			}
+		case "originURL":
+			if r.Null() {
+				v.OriginURL = nil
+			} else {
+				var p RareStringData
+				p.DecodeJSON(r)
+				v.OriginURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayoutTreeSnapshot) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIndex")
+	if v.NodeIndex == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIndex {
+			w.Int(v.NodeIndex[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("styles")
+	if v.Styles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Styles {
+			if v.Styles[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Styles[i0] {
+					w.Int(int(v.Styles[i0][i1]))
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	w.Key("bounds")
+	if v.Bounds == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Bounds {
+			if v.Bounds[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Bounds[i0] {
+					w.Float(v.Bounds[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	w.Key("text")
+	if v.Text == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Text {
+			w.Int(int(v.Text[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.Key("stackingContexts")
+	v.StackingContexts.EncodeJSON(w)
+	if len(v.PaintOrders) > 0 {
+		w.Key("paintOrders")
+		w.ArrayStart()
+		for i0 := range v.PaintOrders {
+			w.Int(v.PaintOrders[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.OffsetRects) > 0 {
+		w.Key("offsetRects")
+		w.ArrayStart()
+		for i0 := range v.OffsetRects {
+			if v.OffsetRects[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.OffsetRects[i0] {
+					w.Float(v.OffsetRects[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	if len(v.ScrollRects) > 0 {
+		w.Key("scrollRects")
+		w.ArrayStart()
+		for i0 := range v.ScrollRects {
+			if v.ScrollRects[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.ScrollRects[i0] {
+					w.Float(v.ScrollRects[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	if len(v.ClientRects) > 0 {
+		w.Key("clientRects")
+		w.ArrayStart()
+		for i0 := range v.ClientRects {
+			if v.ClientRects[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.ClientRects[i0] {
+					w.Float(v.ClientRects[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	if len(v.BlendedBackgroundColors) > 0 {
+		w.Key("blendedBackgroundColors")
+		w.ArrayStart()
+		for i0 := range v.BlendedBackgroundColors {
+			w.Int(int(v.BlendedBackgroundColors[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if len(v.TextColorOpacities) > 0 {
+		w.Key("textColorOpacities")
+		w.ArrayStart()
+		for i0 := range v.TextColorOpacities {
+			w.Float(v.TextColorOpacities[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayoutTreeSnapshot) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIndex":
+			if r.ArrayStart() {
+				v.NodeIndex = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.NodeIndex = append(v.NodeIndex, e0)
+				}
+			} else {
+				v.NodeIndex = nil
+			}
+		case "styles":
+			if r.ArrayStart() {
+				v.Styles = make([]ArrayOfStrings, 0, 4)
+				for r.ArrayNext() {
+					var e0 ArrayOfStrings
+					if r.ArrayStart() {
+						e0 = make(ArrayOfStrings, 0, 4)
+						for r.ArrayNext() {
+							e1 := StringIndex(r.Int())
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Styles = append(v.Styles, e0)
+				}
+			} else {
+				v.Styles = nil
+			}
+		case "bounds":
+			if r.ArrayStart() {
+				v.Bounds = make([]Rectangle, 0, 4)
+				for r.ArrayNext() {
+					var e0 Rectangle
+					if r.ArrayStart() {
+						e0 = make(Rectangle, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Bounds = append(v.Bounds, e0)
+				}
+			} else {
+				v.Bounds = nil
+			}
+		case "text":
+			if r.ArrayStart() {
+				v.Text = make([]StringIndex, 0, 4)
+				for r.ArrayNext() {
+					e0 := StringIndex(r.Int())
+					v.Text = append(v.Text, e0)
+				}
+			} else {
+				v.Text = nil
+			}
+		case "stackingContexts":
+			v.StackingContexts.DecodeJSON(r)
+		case "paintOrders":
+			if r.ArrayStart() {
+				v.PaintOrders = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.PaintOrders = append(v.PaintOrders, e0)
+				}
+			} else {
+				v.PaintOrders = nil
+			}
+		case "offsetRects":
+			if r.ArrayStart() {
+				v.OffsetRects = make([]Rectangle, 0, 4)
+				for r.ArrayNext() {
+					var e0 Rectangle
+					if r.ArrayStart() {
+						e0 = make(Rectangle, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.OffsetRects = append(v.OffsetRects, e0)
+				}
+			} else {
+				v.OffsetRects = nil
+			}
+		case "scrollRects":
+			if r.ArrayStart() {
+				v.ScrollRects = make([]Rectangle, 0, 4)
+				for r.ArrayNext() {
+					var e0 Rectangle
+					if r.ArrayStart() {
+						e0 = make(Rectangle, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.ScrollRects = append(v.ScrollRects, e0)
+				}
+			} else {
+				v.ScrollRects = nil
+			}
+		case "clientRects":
+			if r.ArrayStart() {
+				v.ClientRects = make([]Rectangle, 0, 4)
+				for r.ArrayNext() {
+					var e0 Rectangle
+					if r.ArrayStart() {
+						e0 = make(Rectangle, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.ClientRects = append(v.ClientRects, e0)
+				}
+			} else {
+				v.ClientRects = nil
+			}
+		case "blendedBackgroundColors":
+			if r.ArrayStart() {
+				v.BlendedBackgroundColors = make([]StringIndex, 0, 4)
+				for r.ArrayNext() {
+					e0 := StringIndex(r.Int())
+					v.BlendedBackgroundColors = append(v.BlendedBackgroundColors, e0)
+				}
+			} else {
+				v.BlendedBackgroundColors = nil
+			}
+		case "textColorOpacities":
+			if r.ArrayStart() {
+				v.TextColorOpacities = make([]float64, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.TextColorOpacities = append(v.TextColorOpacities, e0)
+				}
+			} else {
+				v.TextColorOpacities = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TextBoxSnapshot) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("layoutIndex")
+	if v.LayoutIndex == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.LayoutIndex {
+			w.Int(v.LayoutIndex[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("bounds")
+	if v.Bounds == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Bounds {
+			if v.Bounds[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Bounds[i0] {
+					w.Float(v.Bounds[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	w.Key("start")
+	if v.Start == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Start {
+			w.Int(v.Start[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("length")
+	if v.Length == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Length {
+			w.Int(v.Length[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TextBoxSnapshot) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layoutIndex":
+			if r.ArrayStart() {
+				v.LayoutIndex = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.LayoutIndex = append(v.LayoutIndex, e0)
+				}
+			} else {
+				v.LayoutIndex = nil
+			}
+		case "bounds":
+			if r.ArrayStart() {
+				v.Bounds = make([]Rectangle, 0, 4)
+				for r.ArrayNext() {
+					var e0 Rectangle
+					if r.ArrayStart() {
+						e0 = make(Rectangle, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Bounds = append(v.Bounds, e0)
+				}
+			} else {
+				v.Bounds = nil
+			}
+		case "start":
+			if r.ArrayStart() {
+				v.Start = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Start = append(v.Start, e0)
+				}
+			} else {
+				v.Start = nil
+			}
+		case "length":
+			if r.ArrayStart() {
+				v.Length = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Length = append(v.Length, e0)
+				}
+			} else {
+				v.Length = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("computedStyleWhitelist")
+	if v.ComputedStyleWhitelist == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ComputedStyleWhitelist {
+			w.String(v.ComputedStyleWhitelist[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.IncludeEventListeners != nil {
+		w.Key("includeEventListeners")
+		w.Bool(*v.IncludeEventListeners)
+	}
+	if v.IncludePaintOrder != nil {
+		w.Key("includePaintOrder")
+		w.Bool(*v.IncludePaintOrder)
+	}
+	if v.IncludeUserAgentShadowTree != nil {
+		w.Key("includeUserAgentShadowTree")
+		w.Bool(*v.IncludeUserAgentShadowTree)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "computedStyleWhitelist":
+			if r.ArrayStart() {
+				v.ComputedStyleWhitelist = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ComputedStyleWhitelist = append(v.ComputedStyleWhitelist, e0)
+				}
+			} else {
+				v.ComputedStyleWhitelist = nil
+			}
+		case "includeEventListeners":
+			if r.Null() {
+				v.IncludeEventListeners = nil
+			} else {
+				p := r.Bool()
+				v.IncludeEventListeners = &p
+			}
+		case "includePaintOrder":
+			if r.Null() {
+				v.IncludePaintOrder = nil
+			} else {
+				p := r.Bool()
+				v.IncludePaintOrder = &p
+			}
+		case "includeUserAgentShadowTree":
+			if r.Null() {
+				v.IncludeUserAgentShadowTree = nil
+			} else {
+				p := r.Bool()
+				v.IncludeUserAgentShadowTree = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("domNodes")
+	if v.DOMNodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DOMNodes {
+			v.DOMNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("layoutTreeNodes")
+	if v.LayoutTreeNodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.LayoutTreeNodes {
+			v.LayoutTreeNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("computedStyles")
+	if v.ComputedStyles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ComputedStyles {
+			v.ComputedStyles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "domNodes":
+			if r.ArrayStart() {
+				v.DOMNodes = make([]DOMNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 DOMNode
+					e0.DecodeJSON(r)
+					v.DOMNodes = append(v.DOMNodes, e0)
+				}
+			} else {
+				v.DOMNodes = nil
+			}
+		case "layoutTreeNodes":
+			if r.ArrayStart() {
+				v.LayoutTreeNodes = make([]LayoutTreeNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 LayoutTreeNode
+					e0.DecodeJSON(r)
+					v.LayoutTreeNodes = append(v.LayoutTreeNodes, e0)
+				}
+			} else {
+				v.LayoutTreeNodes = nil
+			}
+		case "computedStyles":
+			if r.ArrayStart() {
+				v.ComputedStyles = make([]ComputedStyle, 0, 4)
+				for r.ArrayNext() {
+					var e0 ComputedStyle
+					e0.DecodeJSON(r)
+					v.ComputedStyles = append(v.ComputedStyles, e0)
+				}
+			} else {
+				v.ComputedStyles = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CaptureSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("computedStyles")
+	if v.ComputedStyles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ComputedStyles {
+			w.String(v.ComputedStyles[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.IncludePaintOrder != nil {
+		w.Key("includePaintOrder")
+		w.Bool(*v.IncludePaintOrder)
+	}
+	if v.IncludeDOMRects != nil {
+		w.Key("includeDOMRects")
+		w.Bool(*v.IncludeDOMRects)
+	}
+	if v.IncludeBlendedBackgroundColors != nil {
+		w.Key("includeBlendedBackgroundColors")
+		w.Bool(*v.IncludeBlendedBackgroundColors)
+	}
+	if v.IncludeTextColorOpacities != nil {
+		w.Key("includeTextColorOpacities")
+		w.Bool(*v.IncludeTextColorOpacities)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CaptureSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "computedStyles":
+			if r.ArrayStart() {
+				v.ComputedStyles = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ComputedStyles = append(v.ComputedStyles, e0)
+				}
+			} else {
+				v.ComputedStyles = nil
+			}
+		case "includePaintOrder":
+			if r.Null() {
+				v.IncludePaintOrder = nil
+			} else {
+				p := r.Bool()
+				v.IncludePaintOrder = &p
+			}
+		case "includeDOMRects":
+			if r.Null() {
+				v.IncludeDOMRects = nil
+			} else {
+				p := r.Bool()
+				v.IncludeDOMRects = &p
+			}
+		case "includeBlendedBackgroundColors":
+			if r.Null() {
+				v.IncludeBlendedBackgroundColors = nil
+			} else {
+				p := r.Bool()
+				v.IncludeBlendedBackgroundColors = &p
+			}
+		case "includeTextColorOpacities":
+			if r.Null() {
+				v.IncludeTextColorOpacities = nil
+			} else {
+				p := r.Bool()
+				v.IncludeTextColorOpacities = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CaptureSnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("documents")
+	if v.Documents == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Documents {
+			v.Documents[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("strings")
+	if v.Strings == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Strings {
+			w.String(v.Strings[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CaptureSnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "documents":
+			if r.ArrayStart() {
+				v.Documents = make([]DocumentSnapshot, 0, 4)
+				for r.ArrayNext() {
+					var e0 DocumentSnapshot
+					e0.DecodeJSON(r)
+					v.Documents = append(v.Documents, e0)
+				}
+			} else {
+				v.Documents = nil
+			}
+		case "strings":
+			if r.ArrayStart() {
+				v.Strings = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Strings = append(v.Strings, e0)
+				}
+			} else {
+				v.Strings = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
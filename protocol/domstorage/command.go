@@ -14,6 +14,12 @@ func NewClearArgs(storageID StorageID) *ClearArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearArgs) Validate() error {
+	return nil
+}
+
 // GetDOMStorageItemsArgs represents the arguments for GetDOMStorageItems in the DOMStorage domain.
 type GetDOMStorageItemsArgs struct {
 	StorageID StorageID `json:"storageId"` // No description.
@@ -26,6 +32,12 @@ func NewGetDOMStorageItemsArgs(storageID StorageID) *GetDOMStorageItemsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetDOMStorageItemsArgs) Validate() error {
+	return nil
+}
+
 // GetDOMStorageItemsReply represents the return values for GetDOMStorageItems in the DOMStorage domain.
 type GetDOMStorageItemsReply struct {
 	Entries []Item `json:"entries"` // No description.
@@ -45,6 +57,12 @@ func NewRemoveDOMStorageItemArgs(storageID StorageID, key string) *RemoveDOMStor
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveDOMStorageItemArgs) Validate() error {
+	return nil
+}
+
 // SetDOMStorageItemArgs represents the arguments for SetDOMStorageItem in the DOMStorage domain.
 type SetDOMStorageItemArgs struct {
 	StorageID StorageID `json:"storageId"` // No description.
@@ -60,3 +78,9 @@ func NewSetDOMStorageItemArgs(storageID StorageID, key string, value string) *Se
 	args.Value = value
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDOMStorageItemArgs) Validate() error {
+	return nil
+}
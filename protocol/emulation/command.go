@@ -3,6 +3,9 @@
 package emulation
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/network"
 	"github.com/mafredri/cdp/protocol/page"
@@ -25,6 +28,12 @@ func NewSetFocusEmulationEnabledArgs(enabled bool) *SetFocusEmulationEnabledArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetFocusEmulationEnabledArgs) Validate() error {
+	return nil
+}
+
 // SetAutoDarkModeOverrideArgs represents the arguments for SetAutoDarkModeOverride in the Emulation domain.
 type SetAutoDarkModeOverrideArgs struct {
 	Enabled *bool `json:"enabled,omitempty"` // Whether to enable or disable automatic dark mode. If not specified, any existing override will be cleared.
@@ -45,6 +54,12 @@ func (a *SetAutoDarkModeOverrideArgs) SetEnabled(enabled bool) *SetAutoDarkModeO
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutoDarkModeOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetCPUThrottlingRateArgs represents the arguments for SetCPUThrottlingRate in the Emulation domain.
 type SetCPUThrottlingRateArgs struct {
 	Rate float64 `json:"rate"` // Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
@@ -57,6 +72,12 @@ func NewSetCPUThrottlingRateArgs(rate float64) *SetCPUThrottlingRateArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCPUThrottlingRateArgs) Validate() error {
+	return nil
+}
+
 // SetDefaultBackgroundColorOverrideArgs represents the arguments for SetDefaultBackgroundColorOverride in the Emulation domain.
 type SetDefaultBackgroundColorOverrideArgs struct {
 	Color *dom.RGBA `json:"color,omitempty"` // RGBA of the default background color. If not specified, any existing override will be cleared.
@@ -77,6 +98,12 @@ func (a *SetDefaultBackgroundColorOverrideArgs) SetColor(color dom.RGBA) *SetDef
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDefaultBackgroundColorOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetSafeAreaInsetsOverrideArgs represents the arguments for SetSafeAreaInsetsOverride in the Emulation domain.
 type SetSafeAreaInsetsOverrideArgs struct {
 	Insets SafeAreaInsets `json:"insets"` // No description.
@@ -89,6 +116,12 @@ func NewSetSafeAreaInsetsOverrideArgs(insets SafeAreaInsets) *SetSafeAreaInsetsO
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSafeAreaInsetsOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetDeviceMetricsOverrideArgs represents the arguments for SetDeviceMetricsOverride in the Emulation domain.
 type SetDeviceMetricsOverrideArgs struct {
 	Width             int     `json:"width"`             // Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
@@ -257,6 +290,12 @@ func (a *SetDeviceMetricsOverrideArgs) SetDevicePosture(devicePosture DevicePost
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDeviceMetricsOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetDevicePostureOverrideArgs represents the arguments for SetDevicePostureOverride in the Emulation domain.
 type SetDevicePostureOverrideArgs struct {
 	Posture DevicePosture `json:"posture"` // No description.
@@ -269,6 +308,12 @@ func NewSetDevicePostureOverrideArgs(posture DevicePosture) *SetDevicePostureOve
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDevicePostureOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetDisplayFeaturesOverrideArgs represents the arguments for SetDisplayFeaturesOverride in the Emulation domain.
 type SetDisplayFeaturesOverrideArgs struct {
 	Features []DisplayFeature `json:"features"` // No description.
@@ -281,6 +326,15 @@ func NewSetDisplayFeaturesOverrideArgs(features []DisplayFeature) *SetDisplayFea
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDisplayFeaturesOverrideArgs) Validate() error {
+	if a.Features == nil {
+		return errors.New("emulation.SetDisplayFeaturesOverrideArgs: Features is required")
+	}
+	return nil
+}
+
 // SetScrollbarsHiddenArgs represents the arguments for SetScrollbarsHidden in the Emulation domain.
 type SetScrollbarsHiddenArgs struct {
 	Hidden bool `json:"hidden"` // Whether scrollbars should be always hidden.
@@ -293,6 +347,12 @@ func NewSetScrollbarsHiddenArgs(hidden bool) *SetScrollbarsHiddenArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetScrollbarsHiddenArgs) Validate() error {
+	return nil
+}
+
 // SetDocumentCookieDisabledArgs represents the arguments for SetDocumentCookieDisabled in the Emulation domain.
 type SetDocumentCookieDisabledArgs struct {
 	Disabled bool `json:"disabled"` // Whether document.coookie API should be disabled.
@@ -305,6 +365,12 @@ func NewSetDocumentCookieDisabledArgs(disabled bool) *SetDocumentCookieDisabledA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDocumentCookieDisabledArgs) Validate() error {
+	return nil
+}
+
 // SetEmitTouchEventsForMouseArgs represents the arguments for SetEmitTouchEventsForMouse in the Emulation domain.
 type SetEmitTouchEventsForMouseArgs struct {
 	Enabled bool `json:"enabled"` // Whether touch emulation based on mouse input should be enabled.
@@ -331,6 +397,19 @@ func (a *SetEmitTouchEventsForMouseArgs) SetConfiguration(configuration string)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmitTouchEventsForMouseArgs) Validate() error {
+	if a.Configuration != nil {
+		switch *a.Configuration {
+		case "mobile", "desktop":
+		default:
+			return errors.New("emulation.SetEmitTouchEventsForMouseArgs: Configuration: invalid value " + strconv.Quote(*a.Configuration))
+		}
+	}
+	return nil
+}
+
 // SetEmulatedMediaArgs represents the arguments for SetEmulatedMedia in the Emulation domain.
 type SetEmulatedMediaArgs struct {
 	Media    *string        `json:"media,omitempty"`    // Media type to emulate. Empty string disables the override.
@@ -358,6 +437,12 @@ func (a *SetEmulatedMediaArgs) SetFeatures(features []MediaFeature) *SetEmulated
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmulatedMediaArgs) Validate() error {
+	return nil
+}
+
 // SetEmulatedVisionDeficiencyArgs represents the arguments for SetEmulatedVisionDeficiency in the Emulation domain.
 type SetEmulatedVisionDeficiencyArgs struct {
 	// Type Vision deficiency to emulate. Order: best-effort emulations
@@ -375,6 +460,17 @@ func NewSetEmulatedVisionDeficiencyArgs(typ string) *SetEmulatedVisionDeficiency
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmulatedVisionDeficiencyArgs) Validate() error {
+	switch a.Type {
+	case "none", "blurredVision", "reducedContrast", "achromatopsia", "deuteranopia", "protanopia", "tritanopia":
+	default:
+		return errors.New("emulation.SetEmulatedVisionDeficiencyArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	return nil
+}
+
 // SetEmulatedOSTextScaleArgs represents the arguments for SetEmulatedOSTextScale in the Emulation domain.
 type SetEmulatedOSTextScaleArgs struct {
 	Scale *float64 `json:"scale,omitempty"` // No description.
@@ -393,6 +489,12 @@ func (a *SetEmulatedOSTextScaleArgs) SetScale(scale float64) *SetEmulatedOSTextS
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmulatedOSTextScaleArgs) Validate() error {
+	return nil
+}
+
 // SetGeolocationOverrideArgs represents the arguments for SetGeolocationOverride in the Emulation domain.
 type SetGeolocationOverrideArgs struct {
 	Latitude         *float64 `json:"latitude,omitempty"`         // Mock latitude
@@ -454,6 +556,12 @@ func (a *SetGeolocationOverrideArgs) SetSpeed(speed float64) *SetGeolocationOver
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetGeolocationOverrideArgs) Validate() error {
+	return nil
+}
+
 // GetOverriddenSensorInformationArgs represents the arguments for GetOverriddenSensorInformation in the Emulation domain.
 type GetOverriddenSensorInformationArgs struct {
 	Type SensorType `json:"type"` // No description.
@@ -466,6 +574,15 @@ func NewGetOverriddenSensorInformationArgs(typ SensorType) *GetOverriddenSensorI
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetOverriddenSensorInformationArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("emulation.GetOverriddenSensorInformationArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // GetOverriddenSensorInformationReply represents the return values for GetOverriddenSensorInformation in the Emulation domain.
 type GetOverriddenSensorInformationReply struct {
 	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"` // No description.
@@ -492,6 +609,15 @@ func (a *SetSensorOverrideEnabledArgs) SetMetadata(metadata SensorMetadata) *Set
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSensorOverrideEnabledArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("emulation.SetSensorOverrideEnabledArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // SetSensorOverrideReadingsArgs represents the arguments for SetSensorOverrideReadings in the Emulation domain.
 type SetSensorOverrideReadingsArgs struct {
 	Type    SensorType    `json:"type"`    // No description.
@@ -506,6 +632,15 @@ func NewSetSensorOverrideReadingsArgs(typ SensorType, reading SensorReading) *Se
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSensorOverrideReadingsArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("emulation.SetSensorOverrideReadingsArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
 // SetPressureSourceOverrideEnabledArgs represents the arguments for SetPressureSourceOverrideEnabled in the Emulation domain.
 type SetPressureSourceOverrideEnabledArgs struct {
 	Enabled  bool              `json:"enabled"`            // No description.
@@ -527,6 +662,15 @@ func (a *SetPressureSourceOverrideEnabledArgs) SetMetadata(metadata PressureMeta
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureSourceOverrideEnabledArgs) Validate() error {
+	if !a.Source.Valid() {
+		return errors.New("emulation.SetPressureSourceOverrideEnabledArgs: Source: invalid value " + strconv.Quote(string(a.Source)))
+	}
+	return nil
+}
+
 // SetPressureStateOverrideArgs represents the arguments for SetPressureStateOverride in the Emulation domain.
 type SetPressureStateOverrideArgs struct {
 	Source PressureSource `json:"source"` // No description.
@@ -541,6 +685,18 @@ func NewSetPressureStateOverrideArgs(source PressureSource, state PressureState)
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureStateOverrideArgs) Validate() error {
+	if !a.Source.Valid() {
+		return errors.New("emulation.SetPressureStateOverrideArgs: Source: invalid value " + strconv.Quote(string(a.Source)))
+	}
+	if !a.State.Valid() {
+		return errors.New("emulation.SetPressureStateOverrideArgs: State: invalid value " + strconv.Quote(string(a.State)))
+	}
+	return nil
+}
+
 // SetPressureDataOverrideArgs represents the arguments for SetPressureDataOverride in the Emulation domain.
 type SetPressureDataOverrideArgs struct {
 	Source                  PressureSource `json:"source"`                            // No description.
@@ -562,6 +718,18 @@ func (a *SetPressureDataOverrideArgs) SetOwnContributionEstimate(ownContribution
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureDataOverrideArgs) Validate() error {
+	if !a.Source.Valid() {
+		return errors.New("emulation.SetPressureDataOverrideArgs: Source: invalid value " + strconv.Quote(string(a.Source)))
+	}
+	if !a.State.Valid() {
+		return errors.New("emulation.SetPressureDataOverrideArgs: State: invalid value " + strconv.Quote(string(a.State)))
+	}
+	return nil
+}
+
 // SetIdleOverrideArgs represents the arguments for SetIdleOverride in the Emulation domain.
 type SetIdleOverrideArgs struct {
 	IsUserActive     bool `json:"isUserActive"`     // Mock isUserActive
@@ -576,6 +744,12 @@ func NewSetIdleOverrideArgs(isUserActive bool, isScreenUnlocked bool) *SetIdleOv
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetIdleOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetNavigatorOverridesArgs represents the arguments for SetNavigatorOverrides in the Emulation domain.
 type SetNavigatorOverridesArgs struct {
 	Platform string `json:"platform"` // The platform navigator.platform should return.
@@ -588,6 +762,12 @@ func NewSetNavigatorOverridesArgs(platform string) *SetNavigatorOverridesArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetNavigatorOverridesArgs) Validate() error {
+	return nil
+}
+
 // SetPageScaleFactorArgs represents the arguments for SetPageScaleFactor in the Emulation domain.
 type SetPageScaleFactorArgs struct {
 	PageScaleFactor float64 `json:"pageScaleFactor"` // Page scale factor.
@@ -600,6 +780,12 @@ func NewSetPageScaleFactorArgs(pageScaleFactor float64) *SetPageScaleFactorArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPageScaleFactorArgs) Validate() error {
+	return nil
+}
+
 // SetScriptExecutionDisabledArgs represents the arguments for SetScriptExecutionDisabled in the Emulation domain.
 type SetScriptExecutionDisabledArgs struct {
 	Value bool `json:"value"` // Whether script execution should be disabled in the page.
@@ -612,6 +798,12 @@ func NewSetScriptExecutionDisabledArgs(value bool) *SetScriptExecutionDisabledAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetScriptExecutionDisabledArgs) Validate() error {
+	return nil
+}
+
 // SetTouchEmulationEnabledArgs represents the arguments for SetTouchEmulationEnabled in the Emulation domain.
 type SetTouchEmulationEnabledArgs struct {
 	Enabled        bool `json:"enabled"`                  // Whether the touch event emulation should be enabled.
@@ -632,6 +824,12 @@ func (a *SetTouchEmulationEnabledArgs) SetMaxTouchPoints(maxTouchPoints int) *Se
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTouchEmulationEnabledArgs) Validate() error {
+	return nil
+}
+
 // SetVirtualTimePolicyArgs represents the arguments for SetVirtualTimePolicy in the Emulation domain.
 type SetVirtualTimePolicyArgs struct {
 	Policy                            VirtualTimePolicy      `json:"policy"`                                      // No description.
@@ -671,6 +869,15 @@ func (a *SetVirtualTimePolicyArgs) SetInitialVirtualTime(initialVirtualTime netw
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetVirtualTimePolicyArgs) Validate() error {
+	if !a.Policy.Valid() {
+		return errors.New("emulation.SetVirtualTimePolicyArgs: Policy: invalid value " + strconv.Quote(string(a.Policy)))
+	}
+	return nil
+}
+
 // SetVirtualTimePolicyReply represents the return values for SetVirtualTimePolicy in the Emulation domain.
 type SetVirtualTimePolicyReply struct {
 	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"` // Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
@@ -696,6 +903,12 @@ func (a *SetLocaleOverrideArgs) SetLocale(locale string) *SetLocaleOverrideArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetLocaleOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetTimezoneOverrideArgs represents the arguments for SetTimezoneOverride in the Emulation domain.
 type SetTimezoneOverrideArgs struct {
 	TimezoneID string `json:"timezoneId"` // The timezone identifier. List of supported timezones: https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt If empty, disables the override and restores default host system timezone.
@@ -708,6 +921,12 @@ func NewSetTimezoneOverrideArgs(timezoneID string) *SetTimezoneOverrideArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTimezoneOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetVisibleSizeArgs represents the arguments for SetVisibleSize in the Emulation domain.
 type SetVisibleSizeArgs struct {
 	Width  int `json:"width"`  // Frame width (DIP).
@@ -722,6 +941,12 @@ func NewSetVisibleSizeArgs(width int, height int) *SetVisibleSizeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetVisibleSizeArgs) Validate() error {
+	return nil
+}
+
 // SetDisabledImageTypesArgs represents the arguments for SetDisabledImageTypes in the Emulation domain.
 type SetDisabledImageTypesArgs struct {
 	ImageTypes []DisabledImageType `json:"imageTypes"` // Image types to disable.
@@ -734,6 +959,20 @@ func NewSetDisabledImageTypesArgs(imageTypes []DisabledImageType) *SetDisabledIm
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDisabledImageTypesArgs) Validate() error {
+	if a.ImageTypes == nil {
+		return errors.New("emulation.SetDisabledImageTypesArgs: ImageTypes is required")
+	}
+	for i0 := range a.ImageTypes {
+		if !a.ImageTypes[i0].Valid() {
+			return errors.New("emulation.SetDisabledImageTypesArgs: ImageTypes" + "[" + strconv.Itoa(i0) + "]: invalid value " + strconv.Quote(string(a.ImageTypes[i0])))
+		}
+	}
+	return nil
+}
+
 // SetDataSaverOverrideArgs represents the arguments for SetDataSaverOverride in the Emulation domain.
 type SetDataSaverOverrideArgs struct {
 	DataSaverEnabled *bool `json:"dataSaverEnabled,omitempty"` // Override value. Omitting the parameter disables the override.
@@ -753,6 +992,12 @@ func (a *SetDataSaverOverrideArgs) SetDataSaverEnabled(dataSaverEnabled bool) *S
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDataSaverOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetHardwareConcurrencyOverrideArgs represents the arguments for SetHardwareConcurrencyOverride in the Emulation domain.
 type SetHardwareConcurrencyOverrideArgs struct {
 	HardwareConcurrency int `json:"hardwareConcurrency"` // Hardware concurrency to report
@@ -765,6 +1010,12 @@ func NewSetHardwareConcurrencyOverrideArgs(hardwareConcurrency int) *SetHardware
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetHardwareConcurrencyOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetUserAgentOverrideArgs represents the arguments for SetUserAgentOverride in the Emulation domain.
 type SetUserAgentOverrideArgs struct {
 	UserAgent      string  `json:"userAgent"`                // User agent to use.
@@ -808,6 +1059,12 @@ func (a *SetUserAgentOverrideArgs) SetUserAgentMetadata(userAgentMetadata UserAg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetUserAgentOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetAutomationOverrideArgs represents the arguments for SetAutomationOverride in the Emulation domain.
 type SetAutomationOverrideArgs struct {
 	Enabled bool `json:"enabled"` // Whether the override should be enabled.
@@ -820,6 +1077,12 @@ func NewSetAutomationOverrideArgs(enabled bool) *SetAutomationOverrideArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutomationOverrideArgs) Validate() error {
+	return nil
+}
+
 // SetSmallViewportHeightDifferenceOverrideArgs represents the arguments for SetSmallViewportHeightDifferenceOverride in the Emulation domain.
 type SetSmallViewportHeightDifferenceOverrideArgs struct {
 	Difference int `json:"difference"` // This will cause an element of size 100svh to be `difference` pixels smaller than an element of size 100lvh.
@@ -832,6 +1095,12 @@ func NewSetSmallViewportHeightDifferenceOverrideArgs(difference int) *SetSmallVi
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSmallViewportHeightDifferenceOverrideArgs) Validate() error {
+	return nil
+}
+
 // GetScreenInfosReply represents the return values for GetScreenInfos in the Emulation domain.
 type GetScreenInfosReply struct {
 	ScreenInfos []ScreenInfo `json:"screenInfos"` // No description.
@@ -905,6 +1174,12 @@ func (a *AddScreenArgs) SetIsInternal(isInternal bool) *AddScreenArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddScreenArgs) Validate() error {
+	return nil
+}
+
 // AddScreenReply represents the return values for AddScreen in the Emulation domain.
 type AddScreenReply struct {
 	ScreenInfo ScreenInfo `json:"screenInfo"` // No description.
@@ -921,3 +1196,9 @@ func NewRemoveScreenArgs(screenID ScreenID) *RemoveScreenArgs {
 	args.ScreenID = screenID
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveScreenArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,2296 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SafeAreaInsets) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Top != nil {
+		w.Key("top")
+		w.Int(*v.Top)
+	}
+	if v.TopMax != nil {
+		w.Key("topMax")
+		w.Int(*v.TopMax)
+	}
+	if v.Left != nil {
+		w.Key("left")
+		w.Int(*v.Left)
+	}
+	if v.LeftMax != nil {
+		w.Key("leftMax")
+		w.Int(*v.LeftMax)
+	}
+	if v.Bottom != nil {
+		w.Key("bottom")
+		w.Int(*v.Bottom)
+	}
+	if v.BottomMax != nil {
+		w.Key("bottomMax")
+		w.Int(*v.BottomMax)
+	}
+	if v.Right != nil {
+		w.Key("right")
+		w.Int(*v.Right)
+	}
+	if v.RightMax != nil {
+		w.Key("rightMax")
+		w.Int(*v.RightMax)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SafeAreaInsets) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "top":
+			if r.Null() {
+				v.Top = nil
+			} else {
+				p := r.Int()
+				v.Top = &p
+			}
+		case "topMax":
+			if r.Null() {
+				v.TopMax = nil
+			} else {
+				p := r.Int()
+				v.TopMax = &p
+			}
+		case "left":
+			if r.Null() {
+				v.Left = nil
+			} else {
+				p := r.Int()
+				v.Left = &p
+			}
+		case "leftMax":
+			if r.Null() {
+				v.LeftMax = nil
+			} else {
+				p := r.Int()
+				v.LeftMax = &p
+			}
+		case "bottom":
+			if r.Null() {
+				v.Bottom = nil
+			} else {
+				p := r.Int()
+				v.Bottom = &p
+			}
+		case "bottomMax":
+			if r.Null() {
+				v.BottomMax = nil
+			} else {
+				p := r.Int()
+				v.BottomMax = &p
+			}
+		case "right":
+			if r.Null() {
+				v.Right = nil
+			} else {
+				p := r.Int()
+				v.Right = &p
+			}
+		case "rightMax":
+			if r.Null() {
+				v.RightMax = nil
+			} else {
+				p := r.Int()
+				v.RightMax = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreenOrientation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("angle")
+	w.Int(v.Angle)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreenOrientation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "angle":
+			v.Angle = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DisplayFeature) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("orientation")
+	w.String(v.Orientation)
+	w.Key("offset")
+	w.Int(v.Offset)
+	w.Key("maskLength")
+	w.Int(v.MaskLength)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DisplayFeature) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "orientation":
+			v.Orientation = r.String()
+		case "offset":
+			v.Offset = r.Int()
+		case "maskLength":
+			v.MaskLength = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DevicePosture) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DevicePosture) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MediaFeature) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MediaFeature) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UserAgentBrandVersion) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("brand")
+	w.String(v.Brand)
+	w.Key("version")
+	w.String(v.Version)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UserAgentBrandVersion) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "brand":
+			v.Brand = r.String()
+		case "version":
+			v.Version = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UserAgentMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.Brands) > 0 {
+		w.Key("brands")
+		w.ArrayStart()
+		for i0 := range v.Brands {
+			v.Brands[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.FullVersionList) > 0 {
+		w.Key("fullVersionList")
+		w.ArrayStart()
+		for i0 := range v.FullVersionList {
+			v.FullVersionList[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.FullVersion != nil {
+		w.Key("fullVersion")
+		w.String(*v.FullVersion)
+	}
+	w.Key("platform")
+	w.String(v.Platform)
+	w.Key("platformVersion")
+	w.String(v.PlatformVersion)
+	w.Key("architecture")
+	w.String(v.Architecture)
+	w.Key("model")
+	w.String(v.Model)
+	w.Key("mobile")
+	w.Bool(v.Mobile)
+	if v.Bitness != nil {
+		w.Key("bitness")
+		w.String(*v.Bitness)
+	}
+	if v.Wow64 != nil {
+		w.Key("wow64")
+		w.Bool(*v.Wow64)
+	}
+	if len(v.FormFactors) > 0 {
+		w.Key("formFactors")
+		w.ArrayStart()
+		for i0 := range v.FormFactors {
+			w.String(v.FormFactors[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UserAgentMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "brands":
+			if r.ArrayStart() {
+				v.Brands = make([]UserAgentBrandVersion, 0, 4)
+				for r.ArrayNext() {
+					var e0 UserAgentBrandVersion
+					e0.DecodeJSON(r)
+					v.Brands = append(v.Brands, e0)
+				}
+			} else {
+				v.Brands = nil
+			}
+		case "fullVersionList":
+			if r.ArrayStart() {
+				v.FullVersionList = make([]UserAgentBrandVersion, 0, 4)
+				for r.ArrayNext() {
+					var e0 UserAgentBrandVersion
+					e0.DecodeJSON(r)
+					v.FullVersionList = append(v.FullVersionList, e0)
+				}
+			} else {
+				v.FullVersionList = nil
+			}
+		case "fullVersion":
+			if r.Null() {
+				v.FullVersion = nil
+			} else {
+				p := r.String()
+				v.FullVersion = &p
+			}
+		case "platform":
+			v.Platform = r.String()
+		case "platformVersion":
+			v.PlatformVersion = r.String()
+		case "architecture":
+			v.Architecture = r.String()
+		case "model":
+			v.Model = r.String()
+		case "mobile":
+			v.Mobile = r.Bool()
+		case "bitness":
+			if r.Null() {
+				v.Bitness = nil
+			} else {
+				p := r.String()
+				v.Bitness = &p
+			}
+		case "wow64":
+			if r.Null() {
+				v.Wow64 = nil
+			} else {
+				p := r.Bool()
+				v.Wow64 = &p
+			}
+		case "formFactors":
+			if r.ArrayStart() {
+				v.FormFactors = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.FormFactors = append(v.FormFactors, e0)
+				}
+			} else {
+				v.FormFactors = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SensorMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Available != nil {
+		w.Key("available")
+		w.Bool(*v.Available)
+	}
+	if v.MinimumFrequency != nil {
+		w.Key("minimumFrequency")
+		w.Float(*v.MinimumFrequency)
+	}
+	if v.MaximumFrequency != nil {
+		w.Key("maximumFrequency")
+		w.Float(*v.MaximumFrequency)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SensorMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "available":
+			if r.Null() {
+				v.Available = nil
+			} else {
+				p := r.Bool()
+				v.Available = &p
+			}
+		case "minimumFrequency":
+			if r.Null() {
+				v.MinimumFrequency = nil
+			} else {
+				p := r.Float()
+				v.MinimumFrequency = &p
+			}
+		case "maximumFrequency":
+			if r.Null() {
+				v.MaximumFrequency = nil
+			} else {
+				p := r.Float()
+				v.MaximumFrequency = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SensorReadingSingle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.Float(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SensorReadingSingle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SensorReadingXYZ) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("z")
+	w.Float(v.Z)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SensorReadingXYZ) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "z":
+			v.Z = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SensorReadingQuaternion) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("z")
+	w.Float(v.Z)
+	w.Key("w")
+	w.Float(v.W)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SensorReadingQuaternion) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "z":
+			v.Z = r.Float()
+		case "w":
+			v.W = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SensorReading) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Single != nil {
+		w.Key("single")
+		v.Single.EncodeJSON(w)
+	}
+	if v.Xyz != nil {
+		w.Key("xyz")
+		v.Xyz.EncodeJSON(w)
+	}
+	if v.Quaternion != nil {
+		w.Key("quaternion")
+		v.Quaternion.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SensorReading) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "single":
+			if r.Null() {
+				v.Single = nil
+			} else {
+				var p SensorReadingSingle
+				p.DecodeJSON(r)
+				v.Single = &p
+			}
+		case "xyz":
+			if r.Null() {
+				v.Xyz = nil
+			} else {
+				var p SensorReadingXYZ
+				p.DecodeJSON(r)
+				v.Xyz = &p
+			}
+		case "quaternion":
+			if r.Null() {
+				v.Quaternion = nil
+			} else {
+				var p SensorReadingQuaternion
+				p.DecodeJSON(r)
+				v.Quaternion = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PressureMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Available != nil {
+		w.Key("available")
+		w.Bool(*v.Available)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PressureMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "available":
+			if r.Null() {
+				v.Available = nil
+			} else {
+				p := r.Bool()
+				v.Available = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WorkAreaInsets) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Top != nil {
+		w.Key("top")
+		w.Int(*v.Top)
+	}
+	if v.Left != nil {
+		w.Key("left")
+		w.Int(*v.Left)
+	}
+	if v.Bottom != nil {
+		w.Key("bottom")
+		w.Int(*v.Bottom)
+	}
+	if v.Right != nil {
+		w.Key("right")
+		w.Int(*v.Right)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WorkAreaInsets) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "top":
+			if r.Null() {
+				v.Top = nil
+			} else {
+				p := r.Int()
+				v.Top = &p
+			}
+		case "left":
+			if r.Null() {
+				v.Left = nil
+			} else {
+				p := r.Int()
+				v.Left = &p
+			}
+		case "bottom":
+			if r.Null() {
+				v.Bottom = nil
+			} else {
+				p := r.Int()
+				v.Bottom = &p
+			}
+		case "right":
+			if r.Null() {
+				v.Right = nil
+			} else {
+				p := r.Int()
+				v.Right = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreenInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("left")
+	w.Int(v.Left)
+	w.Key("top")
+	w.Int(v.Top)
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	w.Key("availLeft")
+	w.Int(v.AvailLeft)
+	w.Key("availTop")
+	w.Int(v.AvailTop)
+	w.Key("availWidth")
+	w.Int(v.AvailWidth)
+	w.Key("availHeight")
+	w.Int(v.AvailHeight)
+	w.Key("devicePixelRatio")
+	w.Float(v.DevicePixelRatio)
+	w.Key("orientation")
+	v.Orientation.EncodeJSON(w)
+	w.Key("colorDepth")
+	w.Int(v.ColorDepth)
+	w.Key("isExtended")
+	w.Bool(v.IsExtended)
+	w.Key("isInternal")
+	w.Bool(v.IsInternal)
+	w.Key("isPrimary")
+	w.Bool(v.IsPrimary)
+	w.Key("label")
+	w.String(v.Label)
+	w.Key("id")
+	w.String(string(v.ID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreenInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "left":
+			v.Left = r.Int()
+		case "top":
+			v.Top = r.Int()
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		case "availLeft":
+			v.AvailLeft = r.Int()
+		case "availTop":
+			v.AvailTop = r.Int()
+		case "availWidth":
+			v.AvailWidth = r.Int()
+		case "availHeight":
+			v.AvailHeight = r.Int()
+		case "devicePixelRatio":
+			v.DevicePixelRatio = r.Float()
+		case "orientation":
+			v.Orientation.DecodeJSON(r)
+		case "colorDepth":
+			v.ColorDepth = r.Int()
+		case "isExtended":
+			v.IsExtended = r.Bool()
+		case "isInternal":
+			v.IsInternal = r.Bool()
+		case "isPrimary":
+			v.IsPrimary = r.Bool()
+		case "label":
+			v.Label = r.String()
+		case "id":
+			v.ID = ScreenID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanEmulateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanEmulateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetFocusEmulationEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetFocusEmulationEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAutoDarkModeOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Enabled != nil {
+		w.Key("enabled")
+		w.Bool(*v.Enabled)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAutoDarkModeOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			if r.Null() {
+				v.Enabled = nil
+			} else {
+				p := r.Bool()
+				v.Enabled = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCPUThrottlingRateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rate")
+	w.Float(v.Rate)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCPUThrottlingRateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rate":
+			v.Rate = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDefaultBackgroundColorOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Color != nil {
+		w.Key("color")
+		v.Color.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDefaultBackgroundColorOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "color":
+			if r.Null() {
+				v.Color = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.Color = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSafeAreaInsetsOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("insets")
+	v.Insets.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSafeAreaInsetsOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "insets":
+			v.Insets.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDeviceMetricsOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	w.Key("deviceScaleFactor")
+	w.Float(v.DeviceScaleFactor)
+	w.Key("mobile")
+	w.Bool(v.Mobile)
+	if v.Scale != nil {
+		w.Key("scale")
+		w.Float(*v.Scale)
+	}
+	if v.ScreenWidth != nil {
+		w.Key("screenWidth")
+		w.Int(*v.ScreenWidth)
+	}
+	if v.ScreenHeight != nil {
+		w.Key("screenHeight")
+		w.Int(*v.ScreenHeight)
+	}
+	if v.PositionX != nil {
+		w.Key("positionX")
+		w.Int(*v.PositionX)
+	}
+	if v.PositionY != nil {
+		w.Key("positionY")
+		w.Int(*v.PositionY)
+	}
+	if v.DontSetVisibleSize != nil {
+		w.Key("dontSetVisibleSize")
+		w.Bool(*v.DontSetVisibleSize)
+	}
+	if v.ScreenOrientation != nil {
+		w.Key("screenOrientation")
+		v.ScreenOrientation.EncodeJSON(w)
+	}
+	if v.Viewport != nil {
+		w.Key("viewport")
+		v.Viewport.EncodeJSON(w)
+	}
+	if v.DisplayFeature != nil {
+		w.Key("displayFeature")
+		v.DisplayFeature.EncodeJSON(w)
+	}
+	if v.DevicePosture != nil {
+		w.Key("devicePosture")
+		v.DevicePosture.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDeviceMetricsOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		case "deviceScaleFactor":
+			v.DeviceScaleFactor = r.Float()
+		case "mobile":
+			v.Mobile = r.Bool()
+		case "scale":
+			if r.Null() {
+				v.Scale = nil
+			} else {
+				p := r.Float()
+				v.Scale = &p
+			}
+		case "screenWidth":
+			if r.Null() {
+				v.ScreenWidth = nil
+			} else {
+				p := r.Int()
+				v.ScreenWidth = &p
+			}
+		case "screenHeight":
+			if r.Null() {
+				v.ScreenHeight = nil
+			} else {
+				p := r.Int()
+				v.ScreenHeight = &p
+			}
+		case "positionX":
+			if r.Null() {
+				v.PositionX = nil
+			} else {
+				p := r.Int()
+				v.PositionX = &p
+			}
+		case "positionY":
+			if r.Null() {
+				v.PositionY = nil
+			} else {
+				p := r.Int()
+				v.PositionY = &p
+			}
+		case "dontSetVisibleSize":
+			if r.Null() {
+				v.DontSetVisibleSize = nil
+			} else {
+				p := r.Bool()
+				v.DontSetVisibleSize = &p
+			}
+		case "screenOrientation":
+			if r.Null() {
+				v.ScreenOrientation = nil
+			} else {
+				var p ScreenOrientation
+				p.DecodeJSON(r)
+				v.ScreenOrientation = &p
+			}
+		case "viewport":
+			if r.Null() {
+				v.Viewport = nil
+			} else {
+				var p page.Viewport
+				p.DecodeJSON(r)
+				v.Viewport = &p
+			}
+		case "displayFeature":
+			if r.Null() {
+				v.DisplayFeature = nil
+			} else {
+				var p DisplayFeature
+				p.DecodeJSON(r)
+				v.DisplayFeature = &p
+			}
+		case "devicePosture":
+			if r.Null() {
+				v.DevicePosture = nil
+			} else {
+				var p DevicePosture
+				p.DecodeJSON(r)
+				v.DevicePosture = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDevicePostureOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("posture")
+	v.Posture.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDevicePostureOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "posture":
+			v.Posture.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDisplayFeaturesOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("features")
+	if v.Features == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Features {
+			v.Features[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDisplayFeaturesOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "features":
+			if r.ArrayStart() {
+				v.Features = make([]DisplayFeature, 0, 4)
+				for r.ArrayNext() {
+					var e0 DisplayFeature
+					e0.DecodeJSON(r)
+					v.Features = append(v.Features, e0)
+				}
+			} else {
+				v.Features = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetScrollbarsHiddenArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("hidden")
+	w.Bool(v.Hidden)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetScrollbarsHiddenArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "hidden":
+			v.Hidden = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDocumentCookieDisabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("disabled")
+	w.Bool(v.Disabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDocumentCookieDisabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "disabled":
+			v.Disabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetEmitTouchEventsForMouseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	if v.Configuration != nil {
+		w.Key("configuration")
+		w.String(*v.Configuration)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetEmitTouchEventsForMouseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		case "configuration":
+			if r.Null() {
+				v.Configuration = nil
+			} else {
+				p := r.String()
+				v.Configuration = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetEmulatedMediaArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Media != nil {
+		w.Key("media")
+		w.String(*v.Media)
+	}
+	if len(v.Features) > 0 {
+		w.Key("features")
+		w.ArrayStart()
+		for i0 := range v.Features {
+			v.Features[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetEmulatedMediaArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "media":
+			if r.Null() {
+				v.Media = nil
+			} else {
+				p := r.String()
+				v.Media = &p
+			}
+		case "features":
+			if r.ArrayStart() {
+				v.Features = make([]MediaFeature, 0, 4)
+				for r.ArrayNext() {
+					var e0 MediaFeature
+					e0.DecodeJSON(r)
+					v.Features = append(v.Features, e0)
+				}
+			} else {
+				v.Features = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetEmulatedVisionDeficiencyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetEmulatedVisionDeficiencyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetEmulatedOSTextScaleArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Scale != nil {
+		w.Key("scale")
+		w.Float(*v.Scale)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetEmulatedOSTextScaleArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scale":
+			if r.Null() {
+				v.Scale = nil
+			} else {
+				p := r.Float()
+				v.Scale = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetGeolocationOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Latitude != nil {
+		w.Key("latitude")
+		w.Float(*v.Latitude)
+	}
+	if v.Longitude != nil {
+		w.Key("longitude")
+		w.Float(*v.Longitude)
+	}
+	if v.Accuracy != nil {
+		w.Key("accuracy")
+		w.Float(*v.Accuracy)
+	}
+	if v.Altitude != nil {
+		w.Key("altitude")
+		w.Float(*v.Altitude)
+	}
+	if v.AltitudeAccuracy != nil {
+		w.Key("altitudeAccuracy")
+		w.Float(*v.AltitudeAccuracy)
+	}
+	if v.Heading != nil {
+		w.Key("heading")
+		w.Float(*v.Heading)
+	}
+	if v.Speed != nil {
+		w.Key("speed")
+		w.Float(*v.Speed)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetGeolocationOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "latitude":
+			if r.Null() {
+				v.Latitude = nil
+			} else {
+				p := r.Float()
+				v.Latitude = &p
+			}
+		case "longitude":
+			if r.Null() {
+				v.Longitude = nil
+			} else {
+				p := r.Float()
+				v.Longitude = &p
+			}
+		case "accuracy":
+			if r.Null() {
+				v.Accuracy = nil
+			} else {
+				p := r.Float()
+				v.Accuracy = &p
+			}
+		case "altitude":
+			if r.Null() {
+				v.Altitude = nil
+			} else {
+				p := r.Float()
+				v.Altitude = &p
+			}
+		case "altitudeAccuracy":
+			if r.Null() {
+				v.AltitudeAccuracy = nil
+			} else {
+				p := r.Float()
+				v.AltitudeAccuracy = &p
+			}
+		case "heading":
+			if r.Null() {
+				v.Heading = nil
+			} else {
+				p := r.Float()
+				v.Heading = &p
+			}
+		case "speed":
+			if r.Null() {
+				v.Speed = nil
+			} else {
+				p := r.Float()
+				v.Speed = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOverriddenSensorInformationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOverriddenSensorInformationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = SensorType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOverriddenSensorInformationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestedSamplingFrequency")
+	w.Float(v.RequestedSamplingFrequency)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOverriddenSensorInformationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestedSamplingFrequency":
+			v.RequestedSamplingFrequency = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSensorOverrideEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.Metadata != nil {
+		w.Key("metadata")
+		v.Metadata.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSensorOverrideEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		case "type":
+			v.Type = SensorType(r.String())
+		case "metadata":
+			if r.Null() {
+				v.Metadata = nil
+			} else {
+				var p SensorMetadata
+				p.DecodeJSON(r)
+				v.Metadata = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSensorOverrideReadingsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("reading")
+	v.Reading.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSensorOverrideReadingsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = SensorType(r.String())
+		case "reading":
+			v.Reading.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPressureSourceOverrideEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.Key("source")
+	w.String(string(v.Source))
+	if v.Metadata != nil {
+		w.Key("metadata")
+		v.Metadata.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPressureSourceOverrideEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		case "source":
+			v.Source = PressureSource(r.String())
+		case "metadata":
+			if r.Null() {
+				v.Metadata = nil
+			} else {
+				var p PressureMetadata
+				p.DecodeJSON(r)
+				v.Metadata = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPressureStateOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("source")
+	w.String(string(v.Source))
+	w.Key("state")
+	w.String(string(v.State))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPressureStateOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "source":
+			v.Source = PressureSource(r.String())
+		case "state":
+			v.State = PressureState(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPressureDataOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("source")
+	w.String(string(v.Source))
+	w.Key("state")
+	w.String(string(v.State))
+	if v.OwnContributionEstimate != nil {
+		w.Key("ownContributionEstimate")
+		w.Float(*v.OwnContributionEstimate)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPressureDataOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "source":
+			v.Source = PressureSource(r.String())
+		case "state":
+			v.State = PressureState(r.String())
+		case "ownContributionEstimate":
+			if r.Null() {
+				v.OwnContributionEstimate = nil
+			} else {
+				p := r.Float()
+				v.OwnContributionEstimate = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetIdleOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isUserActive")
+	w.Bool(v.IsUserActive)
+	w.Key("isScreenUnlocked")
+	w.Bool(v.IsScreenUnlocked)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetIdleOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isUserActive":
+			v.IsUserActive = r.Bool()
+		case "isScreenUnlocked":
+			v.IsScreenUnlocked = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetNavigatorOverridesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("platform")
+	w.String(v.Platform)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetNavigatorOverridesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "platform":
+			v.Platform = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPageScaleFactorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("pageScaleFactor")
+	w.Float(v.PageScaleFactor)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPageScaleFactorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "pageScaleFactor":
+			v.PageScaleFactor = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetScriptExecutionDisabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.Bool(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetScriptExecutionDisabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetTouchEmulationEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	if v.MaxTouchPoints != nil {
+		w.Key("maxTouchPoints")
+		w.Int(*v.MaxTouchPoints)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetTouchEmulationEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		case "maxTouchPoints":
+			if r.Null() {
+				v.MaxTouchPoints = nil
+			} else {
+				p := r.Int()
+				v.MaxTouchPoints = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetVirtualTimePolicyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("policy")
+	w.String(string(v.Policy))
+	if v.Budget != nil {
+		w.Key("budget")
+		w.Float(*v.Budget)
+	}
+	if v.MaxVirtualTimeTaskStarvationCount != nil {
+		w.Key("maxVirtualTimeTaskStarvationCount")
+		w.Int(*v.MaxVirtualTimeTaskStarvationCount)
+	}
+	if v.InitialVirtualTime != 0 {
+		w.Key("initialVirtualTime")
+		w.Marshal(v.InitialVirtualTime)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetVirtualTimePolicyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "policy":
+			v.Policy = VirtualTimePolicy(r.String())
+		case "budget":
+			if r.Null() {
+				v.Budget = nil
+			} else {
+				p := r.Float()
+				v.Budget = &p
+			}
+		case "maxVirtualTimeTaskStarvationCount":
+			if r.Null() {
+				v.MaxVirtualTimeTaskStarvationCount = nil
+			} else {
+				p := r.Int()
+				v.MaxVirtualTimeTaskStarvationCount = &p
+			}
+		case "initialVirtualTime":
+			r.Decode(&v.InitialVirtualTime)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetVirtualTimePolicyReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("virtualTimeTicksBase")
+	w.Float(v.VirtualTimeTicksBase)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetVirtualTimePolicyReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "virtualTimeTicksBase":
+			v.VirtualTimeTicksBase = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetLocaleOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Locale != nil {
+		w.Key("locale")
+		w.String(*v.Locale)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetLocaleOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "locale":
+			if r.Null() {
+				v.Locale = nil
+			} else {
+				p := r.String()
+				v.Locale = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetTimezoneOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timezoneId")
+	w.String(v.TimezoneID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetTimezoneOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timezoneId":
+			v.TimezoneID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetVisibleSizeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetVisibleSizeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDisabledImageTypesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("imageTypes")
+	if v.ImageTypes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ImageTypes {
+			w.String(string(v.ImageTypes[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDisabledImageTypesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "imageTypes":
+			if r.ArrayStart() {
+				v.ImageTypes = make([]DisabledImageType, 0, 4)
+				for r.ArrayNext() {
+					e0 := DisabledImageType(r.String())
+					v.ImageTypes = append(v.ImageTypes, e0)
+				}
+			} else {
+				v.ImageTypes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDataSaverOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.DataSaverEnabled != nil {
+		w.Key("dataSaverEnabled")
+		w.Bool(*v.DataSaverEnabled)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDataSaverOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dataSaverEnabled":
+			if r.Null() {
+				v.DataSaverEnabled = nil
+			} else {
+				p := r.Bool()
+				v.DataSaverEnabled = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetHardwareConcurrencyOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("hardwareConcurrency")
+	w.Int(v.HardwareConcurrency)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetHardwareConcurrencyOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "hardwareConcurrency":
+			v.HardwareConcurrency = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetUserAgentOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("userAgent")
+	w.String(v.UserAgent)
+	if v.AcceptLanguage != nil {
+		w.Key("acceptLanguage")
+		w.String(*v.AcceptLanguage)
+	}
+	if v.Platform != nil {
+		w.Key("platform")
+		w.String(*v.Platform)
+	}
+	if v.UserAgentMetadata != nil {
+		w.Key("userAgentMetadata")
+		v.UserAgentMetadata.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetUserAgentOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "userAgent":
+			v.UserAgent = r.String()
+		case "acceptLanguage":
+			if r.Null() {
+				v.AcceptLanguage = nil
+			} else {
+				p := r.String()
+				v.AcceptLanguage = &p
+			}
+		case "platform":
+			if r.Null() {
+				v.Platform = nil
+			} else {
+				p := r.String()
+				v.Platform = &p
+			}
+		case "userAgentMetadata":
+			if r.Null() {
+				v.UserAgentMetadata = nil
+			} else {
+				var p UserAgentMetadata
+				p.DecodeJSON(r)
+				v.UserAgentMetadata = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAutomationOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAutomationOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSmallViewportHeightDifferenceOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("difference")
+	w.Int(v.Difference)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSmallViewportHeightDifferenceOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "difference":
+			v.Difference = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetScreenInfosReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("screenInfos")
+	if v.ScreenInfos == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ScreenInfos {
+			v.ScreenInfos[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetScreenInfosReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "screenInfos":
+			if r.ArrayStart() {
+				v.ScreenInfos = make([]ScreenInfo, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScreenInfo
+					e0.DecodeJSON(r)
+					v.ScreenInfos = append(v.ScreenInfos, e0)
+				}
+			} else {
+				v.ScreenInfos = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddScreenArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("left")
+	w.Int(v.Left)
+	w.Key("top")
+	w.Int(v.Top)
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	if v.WorkAreaInsets != nil {
+		w.Key("workAreaInsets")
+		v.WorkAreaInsets.EncodeJSON(w)
+	}
+	if v.DevicePixelRatio != nil {
+		w.Key("devicePixelRatio")
+		w.Float(*v.DevicePixelRatio)
+	}
+	if v.Rotation != nil {
+		w.Key("rotation")
+		w.Int(*v.Rotation)
+	}
+	if v.ColorDepth != nil {
+		w.Key("colorDepth")
+		w.Int(*v.ColorDepth)
+	}
+	if v.Label != nil {
+		w.Key("label")
+		w.String(*v.Label)
+	}
+	if v.IsInternal != nil {
+		w.Key("isInternal")
+		w.Bool(*v.IsInternal)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddScreenArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "left":
+			v.Left = r.Int()
+		case "top":
+			v.Top = r.Int()
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		case "workAreaInsets":
+			if r.Null() {
+				v.WorkAreaInsets = nil
+			} else {
+				var p WorkAreaInsets
+				p.DecodeJSON(r)
+				v.WorkAreaInsets = &p
+			}
+		case "devicePixelRatio":
+			if r.Null() {
+				v.DevicePixelRatio = nil
+			} else {
+				p := r.Float()
+				v.DevicePixelRatio = &p
+			}
+		case "rotation":
+			if r.Null() {
+				v.Rotation = nil
+			} else {
+				p := r.Int()
+				v.Rotation = &p
+			}
+		case "colorDepth":
+			if r.Null() {
+				v.ColorDepth = nil
+			} else {
+				p := r.Int()
+				v.ColorDepth = &p
+			}
+		case "label":
+			if r.Null() {
+				v.Label = nil
+			} else {
+				p := r.String()
+				v.Label = &p
+			}
+		case "isInternal":
+			if r.Null() {
+				v.IsInternal = nil
+			} else {
+				p := r.Bool()
+				v.IsInternal = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddScreenReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("screenInfo")
+	v.ScreenInfo.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddScreenReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "screenInfo":
+			v.ScreenInfo.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveScreenArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("screenId")
+	w.String(string(v.ScreenID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveScreenArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "screenId":
+			v.ScreenID = ScreenID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VirtualTimeBudgetExpiredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VirtualTimeBudgetExpiredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -14,6 +14,12 @@ func NewSetInstrumentationBreakpointArgs(eventName string) *SetInstrumentationBr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInstrumentationBreakpointArgs) Validate() error {
+	return nil
+}
+
 // RemoveInstrumentationBreakpointArgs represents the arguments for RemoveInstrumentationBreakpoint in the EventBreakpoints domain.
 type RemoveInstrumentationBreakpointArgs struct {
 	EventName string `json:"eventName"` // Instrumentation name to stop on.
@@ -25,3 +31,9 @@ func NewRemoveInstrumentationBreakpointArgs(eventName string) *RemoveInstrumenta
 	args.EventName = eventName
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveInstrumentationBreakpointArgs) Validate() error {
+	return nil
+}
@@ -4,6 +4,8 @@ package extensions
 
 import (
 	"encoding/json"
+	"errors"
+	"strconv"
 )
 
 // LoadUnpackedArgs represents the arguments for LoadUnpacked in the Extensions domain.
@@ -18,6 +20,12 @@ func NewLoadUnpackedArgs(path string) *LoadUnpackedArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *LoadUnpackedArgs) Validate() error {
+	return nil
+}
+
 // LoadUnpackedReply represents the return values for LoadUnpacked in the Extensions domain.
 type LoadUnpackedReply struct {
 	ID string `json:"id"` // Extension id.
@@ -35,6 +43,12 @@ func NewUninstallArgs(id string) *UninstallArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UninstallArgs) Validate() error {
+	return nil
+}
+
 // GetStorageItemsArgs represents the arguments for GetStorageItems in the Extensions domain.
 type GetStorageItemsArgs struct {
 	ID          string      `json:"id"`             // ID of extension.
@@ -56,6 +70,15 @@ func (a *GetStorageItemsArgs) SetKeys(keys []string) *GetStorageItemsArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetStorageItemsArgs) Validate() error {
+	if !a.StorageArea.Valid() {
+		return errors.New("extensions.GetStorageItemsArgs: StorageArea: invalid value " + strconv.Quote(string(a.StorageArea)))
+	}
+	return nil
+}
+
 // GetStorageItemsReply represents the return values for GetStorageItems in the Extensions domain.
 type GetStorageItemsReply struct {
 	Data json.RawMessage `json:"data"` // No description.
@@ -77,6 +100,18 @@ func NewRemoveStorageItemsArgs(id string, storageArea StorageArea, keys []string
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveStorageItemsArgs) Validate() error {
+	if !a.StorageArea.Valid() {
+		return errors.New("extensions.RemoveStorageItemsArgs: StorageArea: invalid value " + strconv.Quote(string(a.StorageArea)))
+	}
+	if a.Keys == nil {
+		return errors.New("extensions.RemoveStorageItemsArgs: Keys is required")
+	}
+	return nil
+}
+
 // ClearStorageItemsArgs represents the arguments for ClearStorageItems in the Extensions domain.
 type ClearStorageItemsArgs struct {
 	ID          string      `json:"id"`          // ID of extension.
@@ -91,6 +126,15 @@ func NewClearStorageItemsArgs(id string, storageArea StorageArea) *ClearStorageI
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearStorageItemsArgs) Validate() error {
+	if !a.StorageArea.Valid() {
+		return errors.New("extensions.ClearStorageItemsArgs: StorageArea: invalid value " + strconv.Quote(string(a.StorageArea)))
+	}
+	return nil
+}
+
 // SetStorageItemsArgs represents the arguments for SetStorageItems in the Extensions domain.
 type SetStorageItemsArgs struct {
 	ID          string          `json:"id"`          // ID of extension.
@@ -106,3 +150,15 @@ func NewSetStorageItemsArgs(id string, storageArea StorageArea, values json.RawM
 	args.Values = values
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetStorageItemsArgs) Validate() error {
+	if !a.StorageArea.Valid() {
+		return errors.New("extensions.SetStorageItemsArgs: StorageArea: invalid value " + strconv.Quote(string(a.StorageArea)))
+	}
+	if a.Values == nil {
+		return errors.New("extensions.SetStorageItemsArgs: Values is required")
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package extensions
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadUnpackedArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("path")
+	w.String(v.Path)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadUnpackedArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "path":
+			v.Path = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadUnpackedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadUnpackedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UninstallArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UninstallArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStorageItemsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("storageArea")
+	w.String(string(v.StorageArea))
+	if len(v.Keys) > 0 {
+		w.Key("keys")
+		w.ArrayStart()
+		for i0 := range v.Keys {
+			w.String(v.Keys[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStorageItemsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "storageArea":
+			v.StorageArea = StorageArea(r.String())
+		case "keys":
+			if r.ArrayStart() {
+				v.Keys = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Keys = append(v.Keys, e0)
+				}
+			} else {
+				v.Keys = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStorageItemsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.Raw(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStorageItemsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveStorageItemsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("storageArea")
+	w.String(string(v.StorageArea))
+	w.Key("keys")
+	if v.Keys == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Keys {
+			w.String(v.Keys[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveStorageItemsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "storageArea":
+			v.StorageArea = StorageArea(r.String())
+		case "keys":
+			if r.ArrayStart() {
+				v.Keys = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Keys = append(v.Keys, e0)
+				}
+			} else {
+				v.Keys = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearStorageItemsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("storageArea")
+	w.String(string(v.StorageArea))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearStorageItemsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "storageArea":
+			v.StorageArea = StorageArea(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetStorageItemsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("storageArea")
+	w.String(string(v.StorageArea))
+	w.Key("values")
+	w.Raw(v.Values)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetStorageItemsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "storageArea":
+			v.StorageArea = StorageArea(r.String())
+		case "values":
+			v.Values = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -2,6 +2,11 @@
 
 package fedcm
 
+import (
+	"errors"
+	"strconv"
+)
+
 // EnableArgs represents the arguments for Enable in the FedCM domain.
 type EnableArgs struct {
 	DisableRejectionDelay *bool `json:"disableRejectionDelay,omitempty"` // Allows callers to disable the promise rejection delay that would normally happen, if this is unimportant to what's being tested. (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
@@ -23,6 +28,12 @@ func (a *EnableArgs) SetDisableRejectionDelay(disableRejectionDelay bool) *Enabl
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // SelectAccountArgs represents the arguments for SelectAccount in the FedCM domain.
 type SelectAccountArgs struct {
 	DialogID     string `json:"dialogId"`     // No description.
@@ -37,6 +48,12 @@ func NewSelectAccountArgs(dialogID string, accountIndex int) *SelectAccountArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SelectAccountArgs) Validate() error {
+	return nil
+}
+
 // ClickDialogButtonArgs represents the arguments for ClickDialogButton in the FedCM domain.
 type ClickDialogButtonArgs struct {
 	DialogID     string       `json:"dialogId"`     // No description.
@@ -51,6 +68,15 @@ func NewClickDialogButtonArgs(dialogID string, dialogButton DialogButton) *Click
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClickDialogButtonArgs) Validate() error {
+	if !a.DialogButton.Valid() {
+		return errors.New("fedcm.ClickDialogButtonArgs: DialogButton: invalid value " + strconv.Quote(string(a.DialogButton)))
+	}
+	return nil
+}
+
 // OpenURLArgs represents the arguments for OpenURL in the FedCM domain.
 type OpenURLArgs struct {
 	DialogID       string         `json:"dialogId"`       // No description.
@@ -67,6 +93,15 @@ func NewOpenURLArgs(dialogID string, accountIndex int, accountURLType AccountURL
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *OpenURLArgs) Validate() error {
+	if !a.AccountURLType.Valid() {
+		return errors.New("fedcm.OpenURLArgs: AccountURLType: invalid value " + strconv.Quote(string(a.AccountURLType)))
+	}
+	return nil
+}
+
 // DismissDialogArgs represents the arguments for DismissDialog in the FedCM domain.
 type DismissDialogArgs struct {
 	DialogID        string `json:"dialogId"`                  // No description.
@@ -85,3 +120,9 @@ func (a *DismissDialogArgs) SetTriggerCooldown(triggerCooldown bool) *DismissDia
 	a.TriggerCooldown = &triggerCooldown
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DismissDialogArgs) Validate() error {
+	return nil
+}
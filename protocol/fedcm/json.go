@@ -0,0 +1,315 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package fedcm
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Account) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("accountId")
+	w.String(v.AccountID)
+	w.Key("email")
+	w.String(v.Email)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("givenName")
+	w.String(v.GivenName)
+	w.Key("pictureUrl")
+	w.String(v.PictureURL)
+	w.Key("idpConfigUrl")
+	w.String(v.IDPConfigURL)
+	w.Key("idpLoginUrl")
+	w.String(v.IDPLoginURL)
+	w.Key("loginState")
+	w.String(string(v.LoginState))
+	if v.TermsOfServiceURL != nil {
+		w.Key("termsOfServiceUrl")
+		w.String(*v.TermsOfServiceURL)
+	}
+	if v.PrivacyPolicyURL != nil {
+		w.Key("privacyPolicyUrl")
+		w.String(*v.PrivacyPolicyURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Account) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "accountId":
+			v.AccountID = r.String()
+		case "email":
+			v.Email = r.String()
+		case "name":
+			v.Name = r.String()
+		case "givenName":
+			v.GivenName = r.String()
+		case "pictureUrl":
+			v.PictureURL = r.String()
+		case "idpConfigUrl":
+			v.IDPConfigURL = r.String()
+		case "idpLoginUrl":
+			v.IDPLoginURL = r.String()
+		case "loginState":
+			v.LoginState = LoginState(r.String())
+		case "termsOfServiceUrl":
+			if r.Null() {
+				v.TermsOfServiceURL = nil
+			} else {
+				p := r.String()
+				v.TermsOfServiceURL = &p
+			}
+		case "privacyPolicyUrl":
+			if r.Null() {
+				v.PrivacyPolicyURL = nil
+			} else {
+				p := r.String()
+				v.PrivacyPolicyURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.DisableRejectionDelay != nil {
+		w.Key("disableRejectionDelay")
+		w.Bool(*v.DisableRejectionDelay)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "disableRejectionDelay":
+			if r.Null() {
+				v.DisableRejectionDelay = nil
+			} else {
+				p := r.Bool()
+				v.DisableRejectionDelay = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SelectAccountArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dialogId")
+	w.String(v.DialogID)
+	w.Key("accountIndex")
+	w.Int(v.AccountIndex)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SelectAccountArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dialogId":
+			v.DialogID = r.String()
+		case "accountIndex":
+			v.AccountIndex = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClickDialogButtonArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dialogId")
+	w.String(v.DialogID)
+	w.Key("dialogButton")
+	w.String(string(v.DialogButton))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClickDialogButtonArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dialogId":
+			v.DialogID = r.String()
+		case "dialogButton":
+			v.DialogButton = DialogButton(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OpenURLArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dialogId")
+	w.String(v.DialogID)
+	w.Key("accountIndex")
+	w.Int(v.AccountIndex)
+	w.Key("accountUrlType")
+	w.String(string(v.AccountURLType))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OpenURLArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dialogId":
+			v.DialogID = r.String()
+		case "accountIndex":
+			v.AccountIndex = r.Int()
+		case "accountUrlType":
+			v.AccountURLType = AccountURLType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DismissDialogArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dialogId")
+	w.String(v.DialogID)
+	if v.TriggerCooldown != nil {
+		w.Key("triggerCooldown")
+		w.Bool(*v.TriggerCooldown)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DismissDialogArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dialogId":
+			v.DialogID = r.String()
+		case "triggerCooldown":
+			if r.Null() {
+				v.TriggerCooldown = nil
+			} else {
+				p := r.Bool()
+				v.TriggerCooldown = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DialogShownReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dialogId")
+	w.String(v.DialogID)
+	w.Key("dialogType")
+	w.String(string(v.DialogType))
+	w.Key("accounts")
+	if v.Accounts == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Accounts {
+			v.Accounts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("title")
+	w.String(v.Title)
+	if v.Subtitle != nil {
+		w.Key("subtitle")
+		w.String(*v.Subtitle)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DialogShownReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dialogId":
+			v.DialogID = r.String()
+		case "dialogType":
+			v.DialogType = DialogType(r.String())
+		case "accounts":
+			if r.ArrayStart() {
+				v.Accounts = make([]Account, 0, 4)
+				for r.ArrayNext() {
+					var e0 Account
+					e0.DecodeJSON(r)
+					v.Accounts = append(v.Accounts, e0)
+				}
+			} else {
+				v.Accounts = nil
+			}
+		case "title":
+			v.Title = r.String()
+		case "subtitle":
+			if r.Null() {
+				v.Subtitle = nil
+			} else {
+				p := r.String()
+				v.Subtitle = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DialogClosedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dialogId")
+	w.String(v.DialogID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DialogClosedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dialogId":
+			v.DialogID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -3,6 +3,9 @@
 package fetch
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/io"
 	"github.com/mafredri/cdp/protocol/network"
 )
@@ -37,6 +40,12 @@ func (a *EnableArgs) SetHandleAuthRequests(handleAuthRequests bool) *EnableArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // FailRequestArgs represents the arguments for FailRequest in the Fetch domain.
 type FailRequestArgs struct {
 	RequestID   RequestID           `json:"requestId"`   // An id the client received in requestPaused event.
@@ -51,6 +60,15 @@ func NewFailRequestArgs(requestID RequestID, errorReason network.ErrorReason) *F
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *FailRequestArgs) Validate() error {
+	if !a.ErrorReason.Valid() {
+		return errors.New("fetch.FailRequestArgs: ErrorReason: invalid value " + strconv.Quote(string(a.ErrorReason)))
+	}
+	return nil
+}
+
 // FulfillRequestArgs represents the arguments for FulfillRequest in the Fetch domain.
 type FulfillRequestArgs struct {
 	RequestID             RequestID     `json:"requestId"`                       // An id the client received in requestPaused event.
@@ -104,6 +122,12 @@ func (a *FulfillRequestArgs) SetResponsePhrase(responsePhrase string) *FulfillRe
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *FulfillRequestArgs) Validate() error {
+	return nil
+}
+
 // ContinueRequestArgs represents the arguments for ContinueRequest in the Fetch domain.
 type ContinueRequestArgs struct {
 	RequestID RequestID     `json:"requestId"`          // An id the client received in requestPaused event.
@@ -165,6 +189,12 @@ func (a *ContinueRequestArgs) SetInterceptResponse(interceptResponse bool) *Cont
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ContinueRequestArgs) Validate() error {
+	return nil
+}
+
 // ContinueWithAuthArgs represents the arguments for ContinueWithAuth in the Fetch domain.
 type ContinueWithAuthArgs struct {
 	RequestID             RequestID             `json:"requestId"`             // An id the client received in authRequired event.
@@ -179,6 +209,12 @@ func NewContinueWithAuthArgs(requestID RequestID, authChallengeResponse AuthChal
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ContinueWithAuthArgs) Validate() error {
+	return nil
+}
+
 // ContinueResponseArgs represents the arguments for ContinueResponse in the Fetch domain.
 type ContinueResponseArgs struct {
 	RequestID             RequestID     `json:"requestId"`                       // An id the client received in requestPaused event.
@@ -227,6 +263,12 @@ func (a *ContinueResponseArgs) SetBinaryResponseHeaders(binaryResponseHeaders []
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ContinueResponseArgs) Validate() error {
+	return nil
+}
+
 // GetResponseBodyArgs represents the arguments for GetResponseBody in the Fetch domain.
 type GetResponseBodyArgs struct {
 	RequestID RequestID `json:"requestId"` // Identifier for the intercepted request to get body for.
@@ -239,6 +281,12 @@ func NewGetResponseBodyArgs(requestID RequestID) *GetResponseBodyArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetResponseBodyArgs) Validate() error {
+	return nil
+}
+
 // GetResponseBodyReply represents the return values for GetResponseBody in the Fetch domain.
 type GetResponseBodyReply struct {
 	Body          string `json:"body"`          // Response body.
@@ -257,6 +305,12 @@ func NewTakeResponseBodyAsStreamArgs(requestID RequestID) *TakeResponseBodyAsStr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TakeResponseBodyAsStreamArgs) Validate() error {
+	return nil
+}
+
 // TakeResponseBodyAsStreamReply represents the return values for TakeResponseBodyAsStream in the Fetch domain.
 type TakeResponseBodyAsStreamReply struct {
 	Stream io.StreamHandle `json:"stream"` // No description.
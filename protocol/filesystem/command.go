@@ -14,6 +14,12 @@ func NewGetDirectoryArgs(bucketFileSystemLocator BucketFileSystemLocator) *GetDi
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetDirectoryArgs) Validate() error {
+	return nil
+}
+
 // GetDirectoryReply represents the return values for GetDirectory in the FileSystem domain.
 type GetDirectoryReply struct {
 	Directory Directory `json:"directory"` // Returns the directory object at the path.
@@ -0,0 +1,207 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package filesystem
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/storage"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *File) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("lastModified")
+	w.Marshal(v.LastModified)
+	w.Key("size")
+	w.Float(v.Size)
+	w.Key("type")
+	w.String(v.Type)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *File) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "lastModified":
+			r.Decode(&v.LastModified)
+		case "size":
+			v.Size = r.Float()
+		case "type":
+			v.Type = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Directory) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("nestedDirectories")
+	if v.NestedDirectories == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NestedDirectories {
+			w.String(v.NestedDirectories[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("nestedFiles")
+	if v.NestedFiles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NestedFiles {
+			v.NestedFiles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Directory) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "nestedDirectories":
+			if r.ArrayStart() {
+				v.NestedDirectories = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.NestedDirectories = append(v.NestedDirectories, e0)
+				}
+			} else {
+				v.NestedDirectories = nil
+			}
+		case "nestedFiles":
+			if r.ArrayStart() {
+				v.NestedFiles = make([]File, 0, 4)
+				for r.ArrayNext() {
+					var e0 File
+					e0.DecodeJSON(r)
+					v.NestedFiles = append(v.NestedFiles, e0)
+				}
+			} else {
+				v.NestedFiles = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BucketFileSystemLocator) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(string(v.StorageKey))
+	if v.BucketName != nil {
+		w.Key("bucketName")
+		w.String(*v.BucketName)
+	}
+	w.Key("pathComponents")
+	if v.PathComponents == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.PathComponents {
+			w.String(v.PathComponents[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BucketFileSystemLocator) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = storage.SerializedStorageKey(r.String())
+		case "bucketName":
+			if r.Null() {
+				v.BucketName = nil
+			} else {
+				p := r.String()
+				v.BucketName = &p
+			}
+		case "pathComponents":
+			if r.ArrayStart() {
+				v.PathComponents = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.PathComponents = append(v.PathComponents, e0)
+				}
+			} else {
+				v.PathComponents = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDirectoryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bucketFileSystemLocator")
+	v.BucketFileSystemLocator.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDirectoryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bucketFileSystemLocator":
+			v.BucketFileSystemLocator.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDirectoryReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("directory")
+	v.Directory.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDirectoryReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "directory":
+			v.Directory.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
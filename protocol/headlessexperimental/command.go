@@ -54,6 +54,12 @@ func (a *BeginFrameArgs) SetScreenshot(screenshot ScreenshotParams) *BeginFrameA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *BeginFrameArgs) Validate() error {
+	return nil
+}
+
 // BeginFrameReply represents the return values for BeginFrame in the HeadlessExperimental domain.
 type BeginFrameReply struct {
 	HasDamage      bool   `json:"hasDamage"`                // Whether the BeginFrame resulted in damage and, thus, a new frame was committed to the display. Reported for diagnostic uses, may be removed in the future.
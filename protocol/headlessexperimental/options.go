@@ -0,0 +1,42 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package headlessexperimental
+
+// BeginFrameOption sets an optional argument of BeginFrameArgs.
+type BeginFrameOption func(*BeginFrameArgs)
+
+// NewBeginFrameArgsWith initializes BeginFrameArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewBeginFrameArgsWith(opts ...BeginFrameOption) *BeginFrameArgs {
+	args := NewBeginFrameArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// BeginFrameWithFrameTimeTicks sets the FrameTimeTicks optional argument, see
+// BeginFrameArgs.SetFrameTimeTicks.
+func BeginFrameWithFrameTimeTicks(v float64) BeginFrameOption {
+	return func(a *BeginFrameArgs) { a.SetFrameTimeTicks(v) }
+}
+
+// BeginFrameWithInterval sets the Interval optional argument, see
+// BeginFrameArgs.SetInterval.
+func BeginFrameWithInterval(v float64) BeginFrameOption {
+	return func(a *BeginFrameArgs) { a.SetInterval(v) }
+}
+
+// BeginFrameWithNoDisplayUpdates sets the NoDisplayUpdates optional argument, see
+// BeginFrameArgs.SetNoDisplayUpdates.
+func BeginFrameWithNoDisplayUpdates(v bool) BeginFrameOption {
+	return func(a *BeginFrameArgs) { a.SetNoDisplayUpdates(v) }
+}
+
+// BeginFrameWithScreenshot sets the Screenshot optional argument, see
+// BeginFrameArgs.SetScreenshot.
+func BeginFrameWithScreenshot(v ScreenshotParams) BeginFrameOption {
+	return func(a *BeginFrameArgs) { a.SetScreenshot(v) }
+}
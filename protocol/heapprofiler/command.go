@@ -18,6 +18,12 @@ func NewAddInspectedHeapObjectArgs(heapObjectID HeapSnapshotObjectID) *AddInspec
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddInspectedHeapObjectArgs) Validate() error {
+	return nil
+}
+
 // GetHeapObjectIDArgs represents the arguments for GetHeapObjectID in the HeapProfiler domain.
 type GetHeapObjectIDArgs struct {
 	ObjectID runtime.RemoteObjectID `json:"objectId"` // Identifier of the object to get heap object id for.
@@ -30,6 +36,12 @@ func NewGetHeapObjectIDArgs(objectID runtime.RemoteObjectID) *GetHeapObjectIDArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetHeapObjectIDArgs) Validate() error {
+	return nil
+}
+
 // GetHeapObjectIDReply represents the return values for GetHeapObjectID in the HeapProfiler domain.
 type GetHeapObjectIDReply struct {
 	HeapSnapshotObjectID HeapSnapshotObjectID `json:"heapSnapshotObjectId"` // Id of the heap snapshot object corresponding to the passed remote object id.
@@ -55,6 +67,12 @@ func (a *GetObjectByHeapObjectIDArgs) SetObjectGroup(objectGroup string) *GetObj
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetObjectByHeapObjectIDArgs) Validate() error {
+	return nil
+}
+
 // GetObjectByHeapObjectIDReply represents the return values for GetObjectByHeapObjectID in the HeapProfiler domain.
 type GetObjectByHeapObjectIDReply struct {
 	Result runtime.RemoteObject `json:"result"` // Evaluation result.
@@ -121,6 +139,12 @@ func (a *StartSamplingArgs) SetIncludeObjectsCollectedByMinorGC(includeObjectsCo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartSamplingArgs) Validate() error {
+	return nil
+}
+
 // StartTrackingHeapObjectsArgs represents the arguments for StartTrackingHeapObjects in the HeapProfiler domain.
 type StartTrackingHeapObjectsArgs struct {
 	TrackAllocations *bool `json:"trackAllocations,omitempty"` // No description.
@@ -139,6 +163,12 @@ func (a *StartTrackingHeapObjectsArgs) SetTrackAllocations(trackAllocations bool
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartTrackingHeapObjectsArgs) Validate() error {
+	return nil
+}
+
 // StopSamplingReply represents the return values for StopSampling in the HeapProfiler domain.
 type StopSamplingReply struct {
 	Profile SamplingHeapProfile `json:"profile"` // Recorded sampling heap profile.
@@ -198,6 +228,12 @@ func (a *StopTrackingHeapObjectsArgs) SetExposeInternals(exposeInternals bool) *
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopTrackingHeapObjectsArgs) Validate() error {
+	return nil
+}
+
 // TakeHeapSnapshotArgs represents the arguments for TakeHeapSnapshot in the HeapProfiler domain.
 type TakeHeapSnapshotArgs struct {
 	ReportProgress *bool `json:"reportProgress,omitempty"` // If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken.
@@ -253,3 +289,9 @@ func (a *TakeHeapSnapshotArgs) SetExposeInternals(exposeInternals bool) *TakeHea
 	a.ExposeInternals = &exposeInternals
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TakeHeapSnapshotArgs) Validate() error {
+	return nil
+}
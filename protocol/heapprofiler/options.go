@@ -0,0 +1,170 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package heapprofiler
+
+// GetObjectByHeapObjectIDOption sets an optional argument of GetObjectByHeapObjectIDArgs.
+type GetObjectByHeapObjectIDOption func(*GetObjectByHeapObjectIDArgs)
+
+// NewGetObjectByHeapObjectIDArgsWith initializes GetObjectByHeapObjectIDArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetObjectByHeapObjectIDArgsWith(objectID HeapSnapshotObjectID, opts ...GetObjectByHeapObjectIDOption) *GetObjectByHeapObjectIDArgs {
+	args := NewGetObjectByHeapObjectIDArgs(objectID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetObjectByHeapObjectIDWithObjectGroup sets the ObjectGroup optional argument, see
+// GetObjectByHeapObjectIDArgs.SetObjectGroup.
+func GetObjectByHeapObjectIDWithObjectGroup(v string) GetObjectByHeapObjectIDOption {
+	return func(a *GetObjectByHeapObjectIDArgs) { a.SetObjectGroup(v) }
+}
+
+// StartSamplingOption sets an optional argument of StartSamplingArgs.
+type StartSamplingOption func(*StartSamplingArgs)
+
+// NewStartSamplingArgsWith initializes StartSamplingArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStartSamplingArgsWith(opts ...StartSamplingOption) *StartSamplingArgs {
+	args := NewStartSamplingArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StartSamplingWithSamplingInterval sets the SamplingInterval optional argument, see
+// StartSamplingArgs.SetSamplingInterval.
+func StartSamplingWithSamplingInterval(v float64) StartSamplingOption {
+	return func(a *StartSamplingArgs) { a.SetSamplingInterval(v) }
+}
+
+// StartSamplingWithStackDepth sets the StackDepth optional argument, see
+// StartSamplingArgs.SetStackDepth.
+func StartSamplingWithStackDepth(v float64) StartSamplingOption {
+	return func(a *StartSamplingArgs) { a.SetStackDepth(v) }
+}
+
+// StartSamplingWithIncludeObjectsCollectedByMajorGC sets the IncludeObjectsCollectedByMajorGC optional argument, see
+// StartSamplingArgs.SetIncludeObjectsCollectedByMajorGC.
+func StartSamplingWithIncludeObjectsCollectedByMajorGC(v bool) StartSamplingOption {
+	return func(a *StartSamplingArgs) { a.SetIncludeObjectsCollectedByMajorGC(v) }
+}
+
+// StartSamplingWithIncludeObjectsCollectedByMinorGC sets the IncludeObjectsCollectedByMinorGC optional argument, see
+// StartSamplingArgs.SetIncludeObjectsCollectedByMinorGC.
+func StartSamplingWithIncludeObjectsCollectedByMinorGC(v bool) StartSamplingOption {
+	return func(a *StartSamplingArgs) { a.SetIncludeObjectsCollectedByMinorGC(v) }
+}
+
+// StartTrackingHeapObjectsOption sets an optional argument of StartTrackingHeapObjectsArgs.
+type StartTrackingHeapObjectsOption func(*StartTrackingHeapObjectsArgs)
+
+// NewStartTrackingHeapObjectsArgsWith initializes StartTrackingHeapObjectsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStartTrackingHeapObjectsArgsWith(opts ...StartTrackingHeapObjectsOption) *StartTrackingHeapObjectsArgs {
+	args := NewStartTrackingHeapObjectsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StartTrackingHeapObjectsWithTrackAllocations sets the TrackAllocations optional argument, see
+// StartTrackingHeapObjectsArgs.SetTrackAllocations.
+func StartTrackingHeapObjectsWithTrackAllocations(v bool) StartTrackingHeapObjectsOption {
+	return func(a *StartTrackingHeapObjectsArgs) { a.SetTrackAllocations(v) }
+}
+
+// StopTrackingHeapObjectsOption sets an optional argument of StopTrackingHeapObjectsArgs.
+type StopTrackingHeapObjectsOption func(*StopTrackingHeapObjectsArgs)
+
+// NewStopTrackingHeapObjectsArgsWith initializes StopTrackingHeapObjectsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStopTrackingHeapObjectsArgsWith(opts ...StopTrackingHeapObjectsOption) *StopTrackingHeapObjectsArgs {
+	args := NewStopTrackingHeapObjectsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StopTrackingHeapObjectsWithReportProgress sets the ReportProgress optional argument, see
+// StopTrackingHeapObjectsArgs.SetReportProgress.
+func StopTrackingHeapObjectsWithReportProgress(v bool) StopTrackingHeapObjectsOption {
+	return func(a *StopTrackingHeapObjectsArgs) { a.SetReportProgress(v) }
+}
+
+// StopTrackingHeapObjectsWithTreatGlobalObjectsAsRoots sets the TreatGlobalObjectsAsRoots optional argument, see
+// StopTrackingHeapObjectsArgs.SetTreatGlobalObjectsAsRoots.
+//
+// Deprecated: This argument should not be used.
+func StopTrackingHeapObjectsWithTreatGlobalObjectsAsRoots(v bool) StopTrackingHeapObjectsOption {
+	return func(a *StopTrackingHeapObjectsArgs) { a.SetTreatGlobalObjectsAsRoots(v) }
+}
+
+// StopTrackingHeapObjectsWithCaptureNumericValue sets the CaptureNumericValue optional argument, see
+// StopTrackingHeapObjectsArgs.SetCaptureNumericValue.
+func StopTrackingHeapObjectsWithCaptureNumericValue(v bool) StopTrackingHeapObjectsOption {
+	return func(a *StopTrackingHeapObjectsArgs) { a.SetCaptureNumericValue(v) }
+}
+
+// StopTrackingHeapObjectsWithExposeInternals sets the ExposeInternals optional argument, see
+// StopTrackingHeapObjectsArgs.SetExposeInternals.
+//
+// Note: This argument is experimental.
+func StopTrackingHeapObjectsWithExposeInternals(v bool) StopTrackingHeapObjectsOption {
+	return func(a *StopTrackingHeapObjectsArgs) { a.SetExposeInternals(v) }
+}
+
+// TakeHeapSnapshotOption sets an optional argument of TakeHeapSnapshotArgs.
+type TakeHeapSnapshotOption func(*TakeHeapSnapshotArgs)
+
+// NewTakeHeapSnapshotArgsWith initializes TakeHeapSnapshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewTakeHeapSnapshotArgsWith(opts ...TakeHeapSnapshotOption) *TakeHeapSnapshotArgs {
+	args := NewTakeHeapSnapshotArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// TakeHeapSnapshotWithReportProgress sets the ReportProgress optional argument, see
+// TakeHeapSnapshotArgs.SetReportProgress.
+func TakeHeapSnapshotWithReportProgress(v bool) TakeHeapSnapshotOption {
+	return func(a *TakeHeapSnapshotArgs) { a.SetReportProgress(v) }
+}
+
+// TakeHeapSnapshotWithTreatGlobalObjectsAsRoots sets the TreatGlobalObjectsAsRoots optional argument, see
+// TakeHeapSnapshotArgs.SetTreatGlobalObjectsAsRoots.
+//
+// Deprecated: This argument should not be used.
+func TakeHeapSnapshotWithTreatGlobalObjectsAsRoots(v bool) TakeHeapSnapshotOption {
+	return func(a *TakeHeapSnapshotArgs) { a.SetTreatGlobalObjectsAsRoots(v) }
+}
+
+// TakeHeapSnapshotWithCaptureNumericValue sets the CaptureNumericValue optional argument, see
+// TakeHeapSnapshotArgs.SetCaptureNumericValue.
+func TakeHeapSnapshotWithCaptureNumericValue(v bool) TakeHeapSnapshotOption {
+	return func(a *TakeHeapSnapshotArgs) { a.SetCaptureNumericValue(v) }
+}
+
+// TakeHeapSnapshotWithExposeInternals sets the ExposeInternals optional argument, see
+// TakeHeapSnapshotArgs.SetExposeInternals.
+//
+// Note: This argument is experimental.
+func TakeHeapSnapshotWithExposeInternals(v bool) TakeHeapSnapshotOption {
+	return func(a *TakeHeapSnapshotArgs) { a.SetExposeInternals(v) }
+}
@@ -3,6 +3,8 @@
 package indexeddb
 
 import (
+	"errors"
+
 	"github.com/mafredri/cdp/protocol/storage"
 )
 
@@ -44,6 +46,25 @@ func (a *ClearObjectStoreArgs) SetStorageBucket(storageBucket storage.Bucket) *C
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearObjectStoreArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.ClearObjectStoreArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // DeleteDatabaseArgs represents the arguments for DeleteDatabase in the IndexedDB domain.
 type DeleteDatabaseArgs struct {
 	SecurityOrigin *string         `json:"securityOrigin,omitempty"` // At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.
@@ -80,6 +101,25 @@ func (a *DeleteDatabaseArgs) SetStorageBucket(storageBucket storage.Bucket) *Del
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteDatabaseArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.DeleteDatabaseArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // DeleteObjectStoreEntriesArgs represents the arguments for DeleteObjectStoreEntries in the IndexedDB domain.
 type DeleteObjectStoreEntriesArgs struct {
 	SecurityOrigin  *string         `json:"securityOrigin,omitempty"` // At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.
@@ -120,6 +160,25 @@ func (a *DeleteObjectStoreEntriesArgs) SetStorageBucket(storageBucket storage.Bu
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteObjectStoreEntriesArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.DeleteObjectStoreEntriesArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // RequestDataArgs represents the arguments for RequestData in the IndexedDB domain.
 type RequestDataArgs struct {
 	SecurityOrigin  *string         `json:"securityOrigin,omitempty"` // At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.
@@ -177,6 +236,25 @@ func (a *RequestDataArgs) SetKeyRange(keyRange KeyRange) *RequestDataArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestDataArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.RequestDataArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // RequestDataReply represents the return values for RequestData in the IndexedDB domain.
 type RequestDataReply struct {
 	ObjectStoreDataEntries []DataEntry `json:"objectStoreDataEntries"` // Array of object store data entries.
@@ -221,6 +299,25 @@ func (a *GetMetadataArgs) SetStorageBucket(storageBucket storage.Bucket) *GetMet
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetMetadataArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.GetMetadataArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // GetMetadataReply represents the return values for GetMetadata in the IndexedDB domain.
 type GetMetadataReply struct {
 	EntriesCount      float64 `json:"entriesCount"`      // the entries count
@@ -263,6 +360,25 @@ func (a *RequestDatabaseArgs) SetStorageBucket(storageBucket storage.Bucket) *Re
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestDatabaseArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.RequestDatabaseArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // RequestDatabaseReply represents the return values for RequestDatabase in the IndexedDB domain.
 type RequestDatabaseReply struct {
 	DatabaseWithObjectStores DatabaseWithObjectStores `json:"databaseWithObjectStores"` // Database with an array of object stores.
@@ -303,6 +419,25 @@ func (a *RequestDatabaseNamesArgs) SetStorageBucket(storageBucket storage.Bucket
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestDatabaseNamesArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("indexeddb.RequestDatabaseNamesArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
 // RequestDatabaseNamesReply represents the return values for RequestDatabaseNames in the IndexedDB domain.
 type RequestDatabaseNamesReply struct {
 	DatabaseNames []string `json:"databaseNames"` // Database names for origin.
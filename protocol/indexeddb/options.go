@@ -0,0 +1,250 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package indexeddb
+
+import (
+	"github.com/mafredri/cdp/protocol/storage"
+)
+
+// ClearObjectStoreOption sets an optional argument of ClearObjectStoreArgs.
+type ClearObjectStoreOption func(*ClearObjectStoreArgs)
+
+// NewClearObjectStoreArgsWith initializes ClearObjectStoreArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewClearObjectStoreArgsWith(databaseName string, objectStoreName string, opts ...ClearObjectStoreOption) *ClearObjectStoreArgs {
+	args := NewClearObjectStoreArgs(databaseName, objectStoreName)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ClearObjectStoreWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// ClearObjectStoreArgs.SetSecurityOrigin.
+func ClearObjectStoreWithSecurityOrigin(v string) ClearObjectStoreOption {
+	return func(a *ClearObjectStoreArgs) { a.SetSecurityOrigin(v) }
+}
+
+// ClearObjectStoreWithStorageKey sets the StorageKey optional argument, see
+// ClearObjectStoreArgs.SetStorageKey.
+func ClearObjectStoreWithStorageKey(v string) ClearObjectStoreOption {
+	return func(a *ClearObjectStoreArgs) { a.SetStorageKey(v) }
+}
+
+// ClearObjectStoreWithStorageBucket sets the StorageBucket optional argument, see
+// ClearObjectStoreArgs.SetStorageBucket.
+func ClearObjectStoreWithStorageBucket(v storage.Bucket) ClearObjectStoreOption {
+	return func(a *ClearObjectStoreArgs) { a.SetStorageBucket(v) }
+}
+
+// DeleteDatabaseOption sets an optional argument of DeleteDatabaseArgs.
+type DeleteDatabaseOption func(*DeleteDatabaseArgs)
+
+// NewDeleteDatabaseArgsWith initializes DeleteDatabaseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDeleteDatabaseArgsWith(databaseName string, opts ...DeleteDatabaseOption) *DeleteDatabaseArgs {
+	args := NewDeleteDatabaseArgs(databaseName)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DeleteDatabaseWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// DeleteDatabaseArgs.SetSecurityOrigin.
+func DeleteDatabaseWithSecurityOrigin(v string) DeleteDatabaseOption {
+	return func(a *DeleteDatabaseArgs) { a.SetSecurityOrigin(v) }
+}
+
+// DeleteDatabaseWithStorageKey sets the StorageKey optional argument, see
+// DeleteDatabaseArgs.SetStorageKey.
+func DeleteDatabaseWithStorageKey(v string) DeleteDatabaseOption {
+	return func(a *DeleteDatabaseArgs) { a.SetStorageKey(v) }
+}
+
+// DeleteDatabaseWithStorageBucket sets the StorageBucket optional argument, see
+// DeleteDatabaseArgs.SetStorageBucket.
+func DeleteDatabaseWithStorageBucket(v storage.Bucket) DeleteDatabaseOption {
+	return func(a *DeleteDatabaseArgs) { a.SetStorageBucket(v) }
+}
+
+// DeleteObjectStoreEntriesOption sets an optional argument of DeleteObjectStoreEntriesArgs.
+type DeleteObjectStoreEntriesOption func(*DeleteObjectStoreEntriesArgs)
+
+// NewDeleteObjectStoreEntriesArgsWith initializes DeleteObjectStoreEntriesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDeleteObjectStoreEntriesArgsWith(databaseName string, objectStoreName string, keyRange KeyRange, opts ...DeleteObjectStoreEntriesOption) *DeleteObjectStoreEntriesArgs {
+	args := NewDeleteObjectStoreEntriesArgs(databaseName, objectStoreName, keyRange)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DeleteObjectStoreEntriesWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// DeleteObjectStoreEntriesArgs.SetSecurityOrigin.
+func DeleteObjectStoreEntriesWithSecurityOrigin(v string) DeleteObjectStoreEntriesOption {
+	return func(a *DeleteObjectStoreEntriesArgs) { a.SetSecurityOrigin(v) }
+}
+
+// DeleteObjectStoreEntriesWithStorageKey sets the StorageKey optional argument, see
+// DeleteObjectStoreEntriesArgs.SetStorageKey.
+func DeleteObjectStoreEntriesWithStorageKey(v string) DeleteObjectStoreEntriesOption {
+	return func(a *DeleteObjectStoreEntriesArgs) { a.SetStorageKey(v) }
+}
+
+// DeleteObjectStoreEntriesWithStorageBucket sets the StorageBucket optional argument, see
+// DeleteObjectStoreEntriesArgs.SetStorageBucket.
+func DeleteObjectStoreEntriesWithStorageBucket(v storage.Bucket) DeleteObjectStoreEntriesOption {
+	return func(a *DeleteObjectStoreEntriesArgs) { a.SetStorageBucket(v) }
+}
+
+// RequestDataOption sets an optional argument of RequestDataArgs.
+type RequestDataOption func(*RequestDataArgs)
+
+// NewRequestDataArgsWith initializes RequestDataArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestDataArgsWith(databaseName string, objectStoreName string, skipCount int, pageSize int, opts ...RequestDataOption) *RequestDataArgs {
+	args := NewRequestDataArgs(databaseName, objectStoreName, skipCount, pageSize)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestDataWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// RequestDataArgs.SetSecurityOrigin.
+func RequestDataWithSecurityOrigin(v string) RequestDataOption {
+	return func(a *RequestDataArgs) { a.SetSecurityOrigin(v) }
+}
+
+// RequestDataWithStorageKey sets the StorageKey optional argument, see
+// RequestDataArgs.SetStorageKey.
+func RequestDataWithStorageKey(v string) RequestDataOption {
+	return func(a *RequestDataArgs) { a.SetStorageKey(v) }
+}
+
+// RequestDataWithStorageBucket sets the StorageBucket optional argument, see
+// RequestDataArgs.SetStorageBucket.
+func RequestDataWithStorageBucket(v storage.Bucket) RequestDataOption {
+	return func(a *RequestDataArgs) { a.SetStorageBucket(v) }
+}
+
+// RequestDataWithIndexName sets the IndexName optional argument, see
+// RequestDataArgs.SetIndexName.
+func RequestDataWithIndexName(v string) RequestDataOption {
+	return func(a *RequestDataArgs) { a.SetIndexName(v) }
+}
+
+// RequestDataWithKeyRange sets the KeyRange optional argument, see
+// RequestDataArgs.SetKeyRange.
+func RequestDataWithKeyRange(v KeyRange) RequestDataOption {
+	return func(a *RequestDataArgs) { a.SetKeyRange(v) }
+}
+
+// GetMetadataOption sets an optional argument of GetMetadataArgs.
+type GetMetadataOption func(*GetMetadataArgs)
+
+// NewGetMetadataArgsWith initializes GetMetadataArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetMetadataArgsWith(databaseName string, objectStoreName string, opts ...GetMetadataOption) *GetMetadataArgs {
+	args := NewGetMetadataArgs(databaseName, objectStoreName)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetMetadataWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// GetMetadataArgs.SetSecurityOrigin.
+func GetMetadataWithSecurityOrigin(v string) GetMetadataOption {
+	return func(a *GetMetadataArgs) { a.SetSecurityOrigin(v) }
+}
+
+// GetMetadataWithStorageKey sets the StorageKey optional argument, see
+// GetMetadataArgs.SetStorageKey.
+func GetMetadataWithStorageKey(v string) GetMetadataOption {
+	return func(a *GetMetadataArgs) { a.SetStorageKey(v) }
+}
+
+// GetMetadataWithStorageBucket sets the StorageBucket optional argument, see
+// GetMetadataArgs.SetStorageBucket.
+func GetMetadataWithStorageBucket(v storage.Bucket) GetMetadataOption {
+	return func(a *GetMetadataArgs) { a.SetStorageBucket(v) }
+}
+
+// RequestDatabaseOption sets an optional argument of RequestDatabaseArgs.
+type RequestDatabaseOption func(*RequestDatabaseArgs)
+
+// NewRequestDatabaseArgsWith initializes RequestDatabaseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestDatabaseArgsWith(databaseName string, opts ...RequestDatabaseOption) *RequestDatabaseArgs {
+	args := NewRequestDatabaseArgs(databaseName)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestDatabaseWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// RequestDatabaseArgs.SetSecurityOrigin.
+func RequestDatabaseWithSecurityOrigin(v string) RequestDatabaseOption {
+	return func(a *RequestDatabaseArgs) { a.SetSecurityOrigin(v) }
+}
+
+// RequestDatabaseWithStorageKey sets the StorageKey optional argument, see
+// RequestDatabaseArgs.SetStorageKey.
+func RequestDatabaseWithStorageKey(v string) RequestDatabaseOption {
+	return func(a *RequestDatabaseArgs) { a.SetStorageKey(v) }
+}
+
+// RequestDatabaseWithStorageBucket sets the StorageBucket optional argument, see
+// RequestDatabaseArgs.SetStorageBucket.
+func RequestDatabaseWithStorageBucket(v storage.Bucket) RequestDatabaseOption {
+	return func(a *RequestDatabaseArgs) { a.SetStorageBucket(v) }
+}
+
+// RequestDatabaseNamesOption sets an optional argument of RequestDatabaseNamesArgs.
+type RequestDatabaseNamesOption func(*RequestDatabaseNamesArgs)
+
+// NewRequestDatabaseNamesArgsWith initializes RequestDatabaseNamesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestDatabaseNamesArgsWith(opts ...RequestDatabaseNamesOption) *RequestDatabaseNamesArgs {
+	args := NewRequestDatabaseNamesArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestDatabaseNamesWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// RequestDatabaseNamesArgs.SetSecurityOrigin.
+func RequestDatabaseNamesWithSecurityOrigin(v string) RequestDatabaseNamesOption {
+	return func(a *RequestDatabaseNamesArgs) { a.SetSecurityOrigin(v) }
+}
+
+// RequestDatabaseNamesWithStorageKey sets the StorageKey optional argument, see
+// RequestDatabaseNamesArgs.SetStorageKey.
+func RequestDatabaseNamesWithStorageKey(v string) RequestDatabaseNamesOption {
+	return func(a *RequestDatabaseNamesArgs) { a.SetStorageKey(v) }
+}
+
+// RequestDatabaseNamesWithStorageBucket sets the StorageBucket optional argument, see
+// RequestDatabaseNamesArgs.SetStorageBucket.
+func RequestDatabaseNamesWithStorageBucket(v storage.Bucket) RequestDatabaseNamesOption {
+	return func(a *RequestDatabaseNamesArgs) { a.SetStorageBucket(v) }
+}
@@ -2,6 +2,11 @@
 
 package input
 
+import (
+	"errors"
+	"strconv"
+)
+
 // DispatchDragEventArgs represents the arguments for DispatchDragEvent in the Input domain.
 type DispatchDragEventArgs struct {
 	// Type Type of the drag event.
@@ -32,6 +37,17 @@ func (a *DispatchDragEventArgs) SetModifiers(modifiers int) *DispatchDragEventAr
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchDragEventArgs) Validate() error {
+	switch a.Type {
+	case "dragEnter", "dragOver", "drop", "dragCancel":
+	default:
+		return errors.New("input.DispatchDragEventArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	return nil
+}
+
 // DispatchKeyEventArgs represents the arguments for DispatchKeyEvent in the Input domain.
 type DispatchKeyEventArgs struct {
 	// Type Type of the key event.
@@ -179,6 +195,17 @@ func (a *DispatchKeyEventArgs) SetCommands(commands []string) *DispatchKeyEventA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchKeyEventArgs) Validate() error {
+	switch a.Type {
+	case "keyDown", "keyUp", "rawKeyDown", "char":
+	default:
+		return errors.New("input.DispatchKeyEventArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	return nil
+}
+
 // InsertTextArgs represents the arguments for InsertText in the Input domain.
 type InsertTextArgs struct {
 	Text string `json:"text"` // The text to insert.
@@ -191,6 +218,12 @@ func NewInsertTextArgs(text string) *InsertTextArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *InsertTextArgs) Validate() error {
+	return nil
+}
+
 // IMESetCompositionArgs represents the arguments for IMESetComposition in the Input domain.
 type IMESetCompositionArgs struct {
 	Text             string `json:"text"`                       // The text to insert
@@ -223,6 +256,12 @@ func (a *IMESetCompositionArgs) SetReplacementEnd(replacementEnd int) *IMESetCom
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *IMESetCompositionArgs) Validate() error {
+	return nil
+}
+
 // DispatchMouseEventArgs represents the arguments for DispatchMouseEvent in the Input domain.
 type DispatchMouseEventArgs struct {
 	// Type Type of the mouse event.
@@ -377,6 +416,29 @@ func (a *DispatchMouseEventArgs) SetPointerType(pointerType string) *DispatchMou
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchMouseEventArgs) Validate() error {
+	switch a.Type {
+	case "mousePressed", "mouseReleased", "mouseMoved", "mouseWheel":
+	default:
+		return errors.New("input.DispatchMouseEventArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	if a.Button != "" {
+		if !a.Button.Valid() {
+			return errors.New("input.DispatchMouseEventArgs: Button: invalid value " + strconv.Quote(string(a.Button)))
+		}
+	}
+	if a.PointerType != nil {
+		switch *a.PointerType {
+		case "mouse", "pen":
+		default:
+			return errors.New("input.DispatchMouseEventArgs: PointerType: invalid value " + strconv.Quote(*a.PointerType))
+		}
+	}
+	return nil
+}
+
 // DispatchTouchEventArgs represents the arguments for DispatchTouchEvent in the Input domain.
 type DispatchTouchEventArgs struct {
 	// Type Type of the touch event. TouchEnd and TouchCancel must not
@@ -413,6 +475,20 @@ func (a *DispatchTouchEventArgs) SetTimestamp(timestamp TimeSinceEpoch) *Dispatc
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchTouchEventArgs) Validate() error {
+	switch a.Type {
+	case "touchStart", "touchEnd", "touchMove", "touchCancel":
+	default:
+		return errors.New("input.DispatchTouchEventArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	if a.TouchPoints == nil {
+		return errors.New("input.DispatchTouchEventArgs: TouchPoints is required")
+	}
+	return nil
+}
+
 // EmulateTouchFromMouseEventArgs represents the arguments for EmulateTouchFromMouseEvent in the Input domain.
 type EmulateTouchFromMouseEventArgs struct {
 	// Type Type of the mouse event.
@@ -475,6 +551,20 @@ func (a *EmulateTouchFromMouseEventArgs) SetClickCount(clickCount int) *EmulateT
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EmulateTouchFromMouseEventArgs) Validate() error {
+	switch a.Type {
+	case "mousePressed", "mouseReleased", "mouseMoved", "mouseWheel":
+	default:
+		return errors.New("input.EmulateTouchFromMouseEventArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	if !a.Button.Valid() {
+		return errors.New("input.EmulateTouchFromMouseEventArgs: Button: invalid value " + strconv.Quote(string(a.Button)))
+	}
+	return nil
+}
+
 // SetIgnoreInputEventsArgs represents the arguments for SetIgnoreInputEvents in the Input domain.
 type SetIgnoreInputEventsArgs struct {
 	Ignore bool `json:"ignore"` // Ignores input events processing when set to true.
@@ -487,6 +577,12 @@ func NewSetIgnoreInputEventsArgs(ignore bool) *SetIgnoreInputEventsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetIgnoreInputEventsArgs) Validate() error {
+	return nil
+}
+
 // SetInterceptDragsArgs represents the arguments for SetInterceptDrags in the Input domain.
 type SetInterceptDragsArgs struct {
 	Enabled bool `json:"enabled"` // No description.
@@ -499,6 +595,12 @@ func NewSetInterceptDragsArgs(enabled bool) *SetInterceptDragsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInterceptDragsArgs) Validate() error {
+	return nil
+}
+
 // SynthesizePinchGestureArgs represents the arguments for SynthesizePinchGesture in the Input domain.
 type SynthesizePinchGestureArgs struct {
 	X                 float64           `json:"x"`                           // X coordinate of the start of the gesture in CSS pixels.
@@ -532,6 +634,17 @@ func (a *SynthesizePinchGestureArgs) SetGestureSourceType(gestureSourceType Gest
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SynthesizePinchGestureArgs) Validate() error {
+	if a.GestureSourceType != "" {
+		if !a.GestureSourceType.Valid() {
+			return errors.New("input.SynthesizePinchGestureArgs: GestureSourceType: invalid value " + strconv.Quote(string(a.GestureSourceType)))
+		}
+	}
+	return nil
+}
+
 // SynthesizeScrollGestureArgs represents the arguments for SynthesizeScrollGesture in the Input domain.
 type SynthesizeScrollGestureArgs struct {
 	X                     float64           `json:"x"`                               // X coordinate of the start of the gesture in CSS pixels.
@@ -630,6 +743,17 @@ func (a *SynthesizeScrollGestureArgs) SetInteractionMarkerName(interactionMarker
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SynthesizeScrollGestureArgs) Validate() error {
+	if a.GestureSourceType != "" {
+		if !a.GestureSourceType.Valid() {
+			return errors.New("input.SynthesizeScrollGestureArgs: GestureSourceType: invalid value " + strconv.Quote(string(a.GestureSourceType)))
+		}
+	}
+	return nil
+}
+
 // SynthesizeTapGestureArgs represents the arguments for SynthesizeTapGesture in the Input domain.
 type SynthesizeTapGestureArgs struct {
 	X                 float64           `json:"x"`                           // X coordinate of the start of the gesture in CSS pixels.
@@ -668,3 +792,14 @@ func (a *SynthesizeTapGestureArgs) SetGestureSourceType(gestureSourceType Gestur
 	a.GestureSourceType = gestureSourceType
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SynthesizeTapGestureArgs) Validate() error {
+	if a.GestureSourceType != "" {
+		if !a.GestureSourceType.Valid() {
+			return errors.New("input.SynthesizeTapGestureArgs: GestureSourceType: invalid value " + strconv.Quote(string(a.GestureSourceType)))
+		}
+	}
+	return nil
+}
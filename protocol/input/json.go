@@ -0,0 +1,1159 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TouchPoint) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	if v.RadiusX != nil {
+		w.Key("radiusX")
+		w.Float(*v.RadiusX)
+	}
+	if v.RadiusY != nil {
+		w.Key("radiusY")
+		w.Float(*v.RadiusY)
+	}
+	if v.RotationAngle != nil {
+		w.Key("rotationAngle")
+		w.Float(*v.RotationAngle)
+	}
+	if v.Force != nil {
+		w.Key("force")
+		w.Float(*v.Force)
+	}
+	if v.TangentialPressure != nil {
+		w.Key("tangentialPressure")
+		w.Float(*v.TangentialPressure)
+	}
+	if v.TiltX != nil {
+		w.Key("tiltX")
+		w.Float(*v.TiltX)
+	}
+	if v.TiltY != nil {
+		w.Key("tiltY")
+		w.Float(*v.TiltY)
+	}
+	if v.Twist != nil {
+		w.Key("twist")
+		w.Int(*v.Twist)
+	}
+	if v.ID != nil {
+		w.Key("id")
+		w.Float(*v.ID)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TouchPoint) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "radiusX":
+			if r.Null() {
+				v.RadiusX = nil
+			} else {
+				p := r.Float()
+				v.RadiusX = &p
+			}
+		case "radiusY":
+			if r.Null() {
+				v.RadiusY = nil
+			} else {
+				p := r.Float()
+				v.RadiusY = &p
+			}
+		case "rotationAngle":
+			if r.Null() {
+				v.RotationAngle = nil
+			} else {
+				p := r.Float()
+				v.RotationAngle = &p
+			}
+		case "force":
+			if r.Null() {
+				v.Force = nil
+			} else {
+				p := r.Float()
+				v.Force = &p
+			}
+		case "tangentialPressure":
+			if r.Null() {
+				v.TangentialPressure = nil
+			} else {
+				p := r.Float()
+				v.TangentialPressure = &p
+			}
+		case "tiltX":
+			if r.Null() {
+				v.TiltX = nil
+			} else {
+				p := r.Float()
+				v.TiltX = &p
+			}
+		case "tiltY":
+			if r.Null() {
+				v.TiltY = nil
+			} else {
+				p := r.Float()
+				v.TiltY = &p
+			}
+		case "twist":
+			if r.Null() {
+				v.Twist = nil
+			} else {
+				p := r.Int()
+				v.Twist = &p
+			}
+		case "id":
+			if r.Null() {
+				v.ID = nil
+			} else {
+				p := r.Float()
+				v.ID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DragDataItem) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("mimeType")
+	w.String(v.MimeType)
+	w.Key("data")
+	w.String(v.Data)
+	if v.Title != nil {
+		w.Key("title")
+		w.String(*v.Title)
+	}
+	if v.BaseURL != nil {
+		w.Key("baseURL")
+		w.String(*v.BaseURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DragDataItem) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "mimeType":
+			v.MimeType = r.String()
+		case "data":
+			v.Data = r.String()
+		case "title":
+			if r.Null() {
+				v.Title = nil
+			} else {
+				p := r.String()
+				v.Title = &p
+			}
+		case "baseURL":
+			if r.Null() {
+				v.BaseURL = nil
+			} else {
+				p := r.String()
+				v.BaseURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DragData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("items")
+	if v.Items == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Items {
+			v.Items[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Files) > 0 {
+		w.Key("files")
+		w.ArrayStart()
+		for i0 := range v.Files {
+			w.String(v.Files[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("dragOperationsMask")
+	w.Int(v.DragOperationsMask)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DragData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "items":
+			if r.ArrayStart() {
+				v.Items = make([]DragDataItem, 0, 4)
+				for r.ArrayNext() {
+					var e0 DragDataItem
+					e0.DecodeJSON(r)
+					v.Items = append(v.Items, e0)
+				}
+			} else {
+				v.Items = nil
+			}
+		case "files":
+			if r.ArrayStart() {
+				v.Files = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Files = append(v.Files, e0)
+				}
+			} else {
+				v.Files = nil
+			}
+		case "dragOperationsMask":
+			v.DragOperationsMask = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DispatchDragEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("data")
+	v.Data.EncodeJSON(w)
+	if v.Modifiers != nil {
+		w.Key("modifiers")
+		w.Int(*v.Modifiers)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DispatchDragEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "data":
+			v.Data.DecodeJSON(r)
+		case "modifiers":
+			if r.Null() {
+				v.Modifiers = nil
+			} else {
+				p := r.Int()
+				v.Modifiers = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DispatchKeyEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.Modifiers != nil {
+		w.Key("modifiers")
+		w.Int(*v.Modifiers)
+	}
+	if v.Timestamp != 0 {
+		w.Key("timestamp")
+		w.Marshal(v.Timestamp)
+	}
+	if v.Text != nil {
+		w.Key("text")
+		w.String(*v.Text)
+	}
+	if v.UnmodifiedText != nil {
+		w.Key("unmodifiedText")
+		w.String(*v.UnmodifiedText)
+	}
+	if v.KeyIdentifier != nil {
+		w.Key("keyIdentifier")
+		w.String(*v.KeyIdentifier)
+	}
+	if v.Code != nil {
+		w.Key("code")
+		w.String(*v.Code)
+	}
+	if v.Key != nil {
+		w.Key("key")
+		w.String(*v.Key)
+	}
+	if v.WindowsVirtualKeyCode != nil {
+		w.Key("windowsVirtualKeyCode")
+		w.Int(*v.WindowsVirtualKeyCode)
+	}
+	if v.NativeVirtualKeyCode != nil {
+		w.Key("nativeVirtualKeyCode")
+		w.Int(*v.NativeVirtualKeyCode)
+	}
+	if v.AutoRepeat != nil {
+		w.Key("autoRepeat")
+		w.Bool(*v.AutoRepeat)
+	}
+	if v.IsKeypad != nil {
+		w.Key("isKeypad")
+		w.Bool(*v.IsKeypad)
+	}
+	if v.IsSystemKey != nil {
+		w.Key("isSystemKey")
+		w.Bool(*v.IsSystemKey)
+	}
+	if v.Location != nil {
+		w.Key("location")
+		w.Int(*v.Location)
+	}
+	if len(v.Commands) > 0 {
+		w.Key("commands")
+		w.ArrayStart()
+		for i0 := range v.Commands {
+			w.String(v.Commands[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DispatchKeyEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "modifiers":
+			if r.Null() {
+				v.Modifiers = nil
+			} else {
+				p := r.Int()
+				v.Modifiers = &p
+			}
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "text":
+			if r.Null() {
+				v.Text = nil
+			} else {
+				p := r.String()
+				v.Text = &p
+			}
+		case "unmodifiedText":
+			if r.Null() {
+				v.UnmodifiedText = nil
+			} else {
+				p := r.String()
+				v.UnmodifiedText = &p
+			}
+		case "keyIdentifier":
+			if r.Null() {
+				v.KeyIdentifier = nil
+			} else {
+				p := r.String()
+				v.KeyIdentifier = &p
+			}
+		case "code":
+			if r.Null() {
+				v.Code = nil
+			} else {
+				p := r.String()
+				v.Code = &p
+			}
+		case "key":
+			if r.Null() {
+				v.Key = nil
+			} else {
+				p := r.String()
+				v.Key = &p
+			}
+		case "windowsVirtualKeyCode":
+			if r.Null() {
+				v.WindowsVirtualKeyCode = nil
+			} else {
+				p := r.Int()
+				v.WindowsVirtualKeyCode = &p
+			}
+		case "nativeVirtualKeyCode":
+			if r.Null() {
+				v.NativeVirtualKeyCode = nil
+			} else {
+				p := r.Int()
+				v.NativeVirtualKeyCode = &p
+			}
+		case "autoRepeat":
+			if r.Null() {
+				v.AutoRepeat = nil
+			} else {
+				p := r.Bool()
+				v.AutoRepeat = &p
+			}
+		case "isKeypad":
+			if r.Null() {
+				v.IsKeypad = nil
+			} else {
+				p := r.Bool()
+				v.IsKeypad = &p
+			}
+		case "isSystemKey":
+			if r.Null() {
+				v.IsSystemKey = nil
+			} else {
+				p := r.Bool()
+				v.IsSystemKey = &p
+			}
+		case "location":
+			if r.Null() {
+				v.Location = nil
+			} else {
+				p := r.Int()
+				v.Location = &p
+			}
+		case "commands":
+			if r.ArrayStart() {
+				v.Commands = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Commands = append(v.Commands, e0)
+				}
+			} else {
+				v.Commands = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InsertTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InsertTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IMESetCompositionArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("text")
+	w.String(v.Text)
+	w.Key("selectionStart")
+	w.Int(v.SelectionStart)
+	w.Key("selectionEnd")
+	w.Int(v.SelectionEnd)
+	if v.ReplacementStart != nil {
+		w.Key("replacementStart")
+		w.Int(*v.ReplacementStart)
+	}
+	if v.ReplacementEnd != nil {
+		w.Key("replacementEnd")
+		w.Int(*v.ReplacementEnd)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IMESetCompositionArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "text":
+			v.Text = r.String()
+		case "selectionStart":
+			v.SelectionStart = r.Int()
+		case "selectionEnd":
+			v.SelectionEnd = r.Int()
+		case "replacementStart":
+			if r.Null() {
+				v.ReplacementStart = nil
+			} else {
+				p := r.Int()
+				v.ReplacementStart = &p
+			}
+		case "replacementEnd":
+			if r.Null() {
+				v.ReplacementEnd = nil
+			} else {
+				p := r.Int()
+				v.ReplacementEnd = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DispatchMouseEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	if v.Modifiers != nil {
+		w.Key("modifiers")
+		w.Int(*v.Modifiers)
+	}
+	if v.Timestamp != 0 {
+		w.Key("timestamp")
+		w.Marshal(v.Timestamp)
+	}
+	if v.Button != "" {
+		w.Key("button")
+		w.String(string(v.Button))
+	}
+	if v.Buttons != nil {
+		w.Key("buttons")
+		w.Int(*v.Buttons)
+	}
+	if v.ClickCount != nil {
+		w.Key("clickCount")
+		w.Int(*v.ClickCount)
+	}
+	if v.Force != nil {
+		w.Key("force")
+		w.Float(*v.Force)
+	}
+	if v.TangentialPressure != nil {
+		w.Key("tangentialPressure")
+		w.Float(*v.TangentialPressure)
+	}
+	if v.TiltX != nil {
+		w.Key("tiltX")
+		w.Float(*v.TiltX)
+	}
+	if v.TiltY != nil {
+		w.Key("tiltY")
+		w.Float(*v.TiltY)
+	}
+	if v.Twist != nil {
+		w.Key("twist")
+		w.Int(*v.Twist)
+	}
+	if v.DeltaX != nil {
+		w.Key("deltaX")
+		w.Float(*v.DeltaX)
+	}
+	if v.DeltaY != nil {
+		w.Key("deltaY")
+		w.Float(*v.DeltaY)
+	}
+	if v.PointerType != nil {
+		w.Key("pointerType")
+		w.String(*v.PointerType)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DispatchMouseEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "modifiers":
+			if r.Null() {
+				v.Modifiers = nil
+			} else {
+				p := r.Int()
+				v.Modifiers = &p
+			}
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "button":
+			v.Button = MouseButton(r.String())
+		case "buttons":
+			if r.Null() {
+				v.Buttons = nil
+			} else {
+				p := r.Int()
+				v.Buttons = &p
+			}
+		case "clickCount":
+			if r.Null() {
+				v.ClickCount = nil
+			} else {
+				p := r.Int()
+				v.ClickCount = &p
+			}
+		case "force":
+			if r.Null() {
+				v.Force = nil
+			} else {
+				p := r.Float()
+				v.Force = &p
+			}
+		case "tangentialPressure":
+			if r.Null() {
+				v.TangentialPressure = nil
+			} else {
+				p := r.Float()
+				v.TangentialPressure = &p
+			}
+		case "tiltX":
+			if r.Null() {
+				v.TiltX = nil
+			} else {
+				p := r.Float()
+				v.TiltX = &p
+			}
+		case "tiltY":
+			if r.Null() {
+				v.TiltY = nil
+			} else {
+				p := r.Float()
+				v.TiltY = &p
+			}
+		case "twist":
+			if r.Null() {
+				v.Twist = nil
+			} else {
+				p := r.Int()
+				v.Twist = &p
+			}
+		case "deltaX":
+			if r.Null() {
+				v.DeltaX = nil
+			} else {
+				p := r.Float()
+				v.DeltaX = &p
+			}
+		case "deltaY":
+			if r.Null() {
+				v.DeltaY = nil
+			} else {
+				p := r.Float()
+				v.DeltaY = &p
+			}
+		case "pointerType":
+			if r.Null() {
+				v.PointerType = nil
+			} else {
+				p := r.String()
+				v.PointerType = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DispatchTouchEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("touchPoints")
+	if v.TouchPoints == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TouchPoints {
+			v.TouchPoints[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Modifiers != nil {
+		w.Key("modifiers")
+		w.Int(*v.Modifiers)
+	}
+	if v.Timestamp != 0 {
+		w.Key("timestamp")
+		w.Marshal(v.Timestamp)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DispatchTouchEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "touchPoints":
+			if r.ArrayStart() {
+				v.TouchPoints = make([]TouchPoint, 0, 4)
+				for r.ArrayNext() {
+					var e0 TouchPoint
+					e0.DecodeJSON(r)
+					v.TouchPoints = append(v.TouchPoints, e0)
+				}
+			} else {
+				v.TouchPoints = nil
+			}
+		case "modifiers":
+			if r.Null() {
+				v.Modifiers = nil
+			} else {
+				p := r.Int()
+				v.Modifiers = &p
+			}
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EmulateTouchFromMouseEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("x")
+	w.Int(v.X)
+	w.Key("y")
+	w.Int(v.Y)
+	w.Key("button")
+	w.String(string(v.Button))
+	if v.Timestamp != 0 {
+		w.Key("timestamp")
+		w.Marshal(v.Timestamp)
+	}
+	if v.DeltaX != nil {
+		w.Key("deltaX")
+		w.Float(*v.DeltaX)
+	}
+	if v.DeltaY != nil {
+		w.Key("deltaY")
+		w.Float(*v.DeltaY)
+	}
+	if v.Modifiers != nil {
+		w.Key("modifiers")
+		w.Int(*v.Modifiers)
+	}
+	if v.ClickCount != nil {
+		w.Key("clickCount")
+		w.Int(*v.ClickCount)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EmulateTouchFromMouseEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "x":
+			v.X = r.Int()
+		case "y":
+			v.Y = r.Int()
+		case "button":
+			v.Button = MouseButton(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "deltaX":
+			if r.Null() {
+				v.DeltaX = nil
+			} else {
+				p := r.Float()
+				v.DeltaX = &p
+			}
+		case "deltaY":
+			if r.Null() {
+				v.DeltaY = nil
+			} else {
+				p := r.Float()
+				v.DeltaY = &p
+			}
+		case "modifiers":
+			if r.Null() {
+				v.Modifiers = nil
+			} else {
+				p := r.Int()
+				v.Modifiers = &p
+			}
+		case "clickCount":
+			if r.Null() {
+				v.ClickCount = nil
+			} else {
+				p := r.Int()
+				v.ClickCount = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetIgnoreInputEventsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ignore")
+	w.Bool(v.Ignore)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetIgnoreInputEventsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ignore":
+			v.Ignore = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInterceptDragsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInterceptDragsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SynthesizePinchGestureArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("scaleFactor")
+	w.Float(v.ScaleFactor)
+	if v.RelativeSpeed != nil {
+		w.Key("relativeSpeed")
+		w.Int(*v.RelativeSpeed)
+	}
+	if v.GestureSourceType != "" {
+		w.Key("gestureSourceType")
+		w.String(string(v.GestureSourceType))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SynthesizePinchGestureArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "scaleFactor":
+			v.ScaleFactor = r.Float()
+		case "relativeSpeed":
+			if r.Null() {
+				v.RelativeSpeed = nil
+			} else {
+				p := r.Int()
+				v.RelativeSpeed = &p
+			}
+		case "gestureSourceType":
+			v.GestureSourceType = GestureSourceType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SynthesizeScrollGestureArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	if v.XDistance != nil {
+		w.Key("xDistance")
+		w.Float(*v.XDistance)
+	}
+	if v.YDistance != nil {
+		w.Key("yDistance")
+		w.Float(*v.YDistance)
+	}
+	if v.XOverscroll != nil {
+		w.Key("xOverscroll")
+		w.Float(*v.XOverscroll)
+	}
+	if v.YOverscroll != nil {
+		w.Key("yOverscroll")
+		w.Float(*v.YOverscroll)
+	}
+	if v.PreventFling != nil {
+		w.Key("preventFling")
+		w.Bool(*v.PreventFling)
+	}
+	if v.Speed != nil {
+		w.Key("speed")
+		w.Int(*v.Speed)
+	}
+	if v.GestureSourceType != "" {
+		w.Key("gestureSourceType")
+		w.String(string(v.GestureSourceType))
+	}
+	if v.RepeatCount != nil {
+		w.Key("repeatCount")
+		w.Int(*v.RepeatCount)
+	}
+	if v.RepeatDelayMs != nil {
+		w.Key("repeatDelayMs")
+		w.Int(*v.RepeatDelayMs)
+	}
+	if v.InteractionMarkerName != nil {
+		w.Key("interactionMarkerName")
+		w.String(*v.InteractionMarkerName)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SynthesizeScrollGestureArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "xDistance":
+			if r.Null() {
+				v.XDistance = nil
+			} else {
+				p := r.Float()
+				v.XDistance = &p
+			}
+		case "yDistance":
+			if r.Null() {
+				v.YDistance = nil
+			} else {
+				p := r.Float()
+				v.YDistance = &p
+			}
+		case "xOverscroll":
+			if r.Null() {
+				v.XOverscroll = nil
+			} else {
+				p := r.Float()
+				v.XOverscroll = &p
+			}
+		case "yOverscroll":
+			if r.Null() {
+				v.YOverscroll = nil
+			} else {
+				p := r.Float()
+				v.YOverscroll = &p
+			}
+		case "preventFling":
+			if r.Null() {
+				v.PreventFling = nil
+			} else {
+				p := r.Bool()
+				v.PreventFling = &p
+			}
+		case "speed":
+			if r.Null() {
+				v.Speed = nil
+			} else {
+				p := r.Int()
+				v.Speed = &p
+			}
+		case "gestureSourceType":
+			v.GestureSourceType = GestureSourceType(r.String())
+		case "repeatCount":
+			if r.Null() {
+				v.RepeatCount = nil
+			} else {
+				p := r.Int()
+				v.RepeatCount = &p
+			}
+		case "repeatDelayMs":
+			if r.Null() {
+				v.RepeatDelayMs = nil
+			} else {
+				p := r.Int()
+				v.RepeatDelayMs = &p
+			}
+		case "interactionMarkerName":
+			if r.Null() {
+				v.InteractionMarkerName = nil
+			} else {
+				p := r.String()
+				v.InteractionMarkerName = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SynthesizeTapGestureArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	if v.Duration != nil {
+		w.Key("duration")
+		w.Int(*v.Duration)
+	}
+	if v.TapCount != nil {
+		w.Key("tapCount")
+		w.Int(*v.TapCount)
+	}
+	if v.GestureSourceType != "" {
+		w.Key("gestureSourceType")
+		w.String(string(v.GestureSourceType))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SynthesizeTapGestureArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "duration":
+			if r.Null() {
+				v.Duration = nil
+			} else {
+				p := r.Int()
+				v.Duration = &p
+			}
+		case "tapCount":
+			if r.Null() {
+				v.TapCount = nil
+			} else {
+				p := r.Int()
+				v.TapCount = &p
+			}
+		case "gestureSourceType":
+			v.GestureSourceType = GestureSourceType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DragInterceptedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	v.Data.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DragInterceptedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
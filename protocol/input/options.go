@@ -0,0 +1,458 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package input
+
+// DispatchDragEventOption sets an optional argument of DispatchDragEventArgs.
+type DispatchDragEventOption func(*DispatchDragEventArgs)
+
+// NewDispatchDragEventArgsWith initializes DispatchDragEventArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDispatchDragEventArgsWith(typ string, x float64, y float64, data DragData, opts ...DispatchDragEventOption) *DispatchDragEventArgs {
+	args := NewDispatchDragEventArgs(typ, x, y, data)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DispatchDragEventWithModifiers sets the Modifiers optional argument, see
+// DispatchDragEventArgs.SetModifiers.
+func DispatchDragEventWithModifiers(v int) DispatchDragEventOption {
+	return func(a *DispatchDragEventArgs) { a.SetModifiers(v) }
+}
+
+// DispatchKeyEventOption sets an optional argument of DispatchKeyEventArgs.
+type DispatchKeyEventOption func(*DispatchKeyEventArgs)
+
+// NewDispatchKeyEventArgsWith initializes DispatchKeyEventArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDispatchKeyEventArgsWith(typ string, opts ...DispatchKeyEventOption) *DispatchKeyEventArgs {
+	args := NewDispatchKeyEventArgs(typ)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DispatchKeyEventWithModifiers sets the Modifiers optional argument, see
+// DispatchKeyEventArgs.SetModifiers.
+func DispatchKeyEventWithModifiers(v int) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetModifiers(v) }
+}
+
+// DispatchKeyEventWithTimestamp sets the Timestamp optional argument, see
+// DispatchKeyEventArgs.SetTimestamp.
+func DispatchKeyEventWithTimestamp(v TimeSinceEpoch) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetTimestamp(v) }
+}
+
+// DispatchKeyEventWithText sets the Text optional argument, see
+// DispatchKeyEventArgs.SetText.
+func DispatchKeyEventWithText(v string) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetText(v) }
+}
+
+// DispatchKeyEventWithUnmodifiedText sets the UnmodifiedText optional argument, see
+// DispatchKeyEventArgs.SetUnmodifiedText.
+func DispatchKeyEventWithUnmodifiedText(v string) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetUnmodifiedText(v) }
+}
+
+// DispatchKeyEventWithKeyIdentifier sets the KeyIdentifier optional argument, see
+// DispatchKeyEventArgs.SetKeyIdentifier.
+func DispatchKeyEventWithKeyIdentifier(v string) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetKeyIdentifier(v) }
+}
+
+// DispatchKeyEventWithCode sets the Code optional argument, see
+// DispatchKeyEventArgs.SetCode.
+func DispatchKeyEventWithCode(v string) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetCode(v) }
+}
+
+// DispatchKeyEventWithKey sets the Key optional argument, see
+// DispatchKeyEventArgs.SetKey.
+func DispatchKeyEventWithKey(v string) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetKey(v) }
+}
+
+// DispatchKeyEventWithWindowsVirtualKeyCode sets the WindowsVirtualKeyCode optional argument, see
+// DispatchKeyEventArgs.SetWindowsVirtualKeyCode.
+func DispatchKeyEventWithWindowsVirtualKeyCode(v int) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetWindowsVirtualKeyCode(v) }
+}
+
+// DispatchKeyEventWithNativeVirtualKeyCode sets the NativeVirtualKeyCode optional argument, see
+// DispatchKeyEventArgs.SetNativeVirtualKeyCode.
+func DispatchKeyEventWithNativeVirtualKeyCode(v int) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetNativeVirtualKeyCode(v) }
+}
+
+// DispatchKeyEventWithAutoRepeat sets the AutoRepeat optional argument, see
+// DispatchKeyEventArgs.SetAutoRepeat.
+func DispatchKeyEventWithAutoRepeat(v bool) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetAutoRepeat(v) }
+}
+
+// DispatchKeyEventWithIsKeypad sets the IsKeypad optional argument, see
+// DispatchKeyEventArgs.SetIsKeypad.
+func DispatchKeyEventWithIsKeypad(v bool) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetIsKeypad(v) }
+}
+
+// DispatchKeyEventWithIsSystemKey sets the IsSystemKey optional argument, see
+// DispatchKeyEventArgs.SetIsSystemKey.
+func DispatchKeyEventWithIsSystemKey(v bool) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetIsSystemKey(v) }
+}
+
+// DispatchKeyEventWithLocation sets the Location optional argument, see
+// DispatchKeyEventArgs.SetLocation.
+func DispatchKeyEventWithLocation(v int) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetLocation(v) }
+}
+
+// DispatchKeyEventWithCommands sets the Commands optional argument, see
+// DispatchKeyEventArgs.SetCommands.
+//
+// Note: This argument is experimental.
+func DispatchKeyEventWithCommands(v []string) DispatchKeyEventOption {
+	return func(a *DispatchKeyEventArgs) { a.SetCommands(v) }
+}
+
+// IMESetCompositionOption sets an optional argument of IMESetCompositionArgs.
+type IMESetCompositionOption func(*IMESetCompositionArgs)
+
+// NewIMESetCompositionArgsWith initializes IMESetCompositionArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewIMESetCompositionArgsWith(text string, selectionStart int, selectionEnd int, opts ...IMESetCompositionOption) *IMESetCompositionArgs {
+	args := NewIMESetCompositionArgs(text, selectionStart, selectionEnd)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// IMESetCompositionWithReplacementStart sets the ReplacementStart optional argument, see
+// IMESetCompositionArgs.SetReplacementStart.
+func IMESetCompositionWithReplacementStart(v int) IMESetCompositionOption {
+	return func(a *IMESetCompositionArgs) { a.SetReplacementStart(v) }
+}
+
+// IMESetCompositionWithReplacementEnd sets the ReplacementEnd optional argument, see
+// IMESetCompositionArgs.SetReplacementEnd.
+func IMESetCompositionWithReplacementEnd(v int) IMESetCompositionOption {
+	return func(a *IMESetCompositionArgs) { a.SetReplacementEnd(v) }
+}
+
+// DispatchMouseEventOption sets an optional argument of DispatchMouseEventArgs.
+type DispatchMouseEventOption func(*DispatchMouseEventArgs)
+
+// NewDispatchMouseEventArgsWith initializes DispatchMouseEventArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDispatchMouseEventArgsWith(typ string, x float64, y float64, opts ...DispatchMouseEventOption) *DispatchMouseEventArgs {
+	args := NewDispatchMouseEventArgs(typ, x, y)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DispatchMouseEventWithModifiers sets the Modifiers optional argument, see
+// DispatchMouseEventArgs.SetModifiers.
+func DispatchMouseEventWithModifiers(v int) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetModifiers(v) }
+}
+
+// DispatchMouseEventWithTimestamp sets the Timestamp optional argument, see
+// DispatchMouseEventArgs.SetTimestamp.
+func DispatchMouseEventWithTimestamp(v TimeSinceEpoch) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetTimestamp(v) }
+}
+
+// DispatchMouseEventWithButton sets the Button optional argument, see
+// DispatchMouseEventArgs.SetButton.
+func DispatchMouseEventWithButton(v MouseButton) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetButton(v) }
+}
+
+// DispatchMouseEventWithButtons sets the Buttons optional argument, see
+// DispatchMouseEventArgs.SetButtons.
+func DispatchMouseEventWithButtons(v int) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetButtons(v) }
+}
+
+// DispatchMouseEventWithClickCount sets the ClickCount optional argument, see
+// DispatchMouseEventArgs.SetClickCount.
+func DispatchMouseEventWithClickCount(v int) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetClickCount(v) }
+}
+
+// DispatchMouseEventWithForce sets the Force optional argument, see
+// DispatchMouseEventArgs.SetForce.
+//
+// Note: This argument is experimental.
+func DispatchMouseEventWithForce(v float64) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetForce(v) }
+}
+
+// DispatchMouseEventWithTangentialPressure sets the TangentialPressure optional argument, see
+// DispatchMouseEventArgs.SetTangentialPressure.
+//
+// Note: This argument is experimental.
+func DispatchMouseEventWithTangentialPressure(v float64) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetTangentialPressure(v) }
+}
+
+// DispatchMouseEventWithTiltX sets the TiltX optional argument, see
+// DispatchMouseEventArgs.SetTiltX.
+func DispatchMouseEventWithTiltX(v float64) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetTiltX(v) }
+}
+
+// DispatchMouseEventWithTiltY sets the TiltY optional argument, see
+// DispatchMouseEventArgs.SetTiltY.
+func DispatchMouseEventWithTiltY(v float64) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetTiltY(v) }
+}
+
+// DispatchMouseEventWithTwist sets the Twist optional argument, see
+// DispatchMouseEventArgs.SetTwist.
+//
+// Note: This argument is experimental.
+func DispatchMouseEventWithTwist(v int) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetTwist(v) }
+}
+
+// DispatchMouseEventWithDeltaX sets the DeltaX optional argument, see
+// DispatchMouseEventArgs.SetDeltaX.
+func DispatchMouseEventWithDeltaX(v float64) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetDeltaX(v) }
+}
+
+// DispatchMouseEventWithDeltaY sets the DeltaY optional argument, see
+// DispatchMouseEventArgs.SetDeltaY.
+func DispatchMouseEventWithDeltaY(v float64) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetDeltaY(v) }
+}
+
+// DispatchMouseEventWithPointerType sets the PointerType optional argument, see
+// DispatchMouseEventArgs.SetPointerType.
+func DispatchMouseEventWithPointerType(v string) DispatchMouseEventOption {
+	return func(a *DispatchMouseEventArgs) { a.SetPointerType(v) }
+}
+
+// DispatchTouchEventOption sets an optional argument of DispatchTouchEventArgs.
+type DispatchTouchEventOption func(*DispatchTouchEventArgs)
+
+// NewDispatchTouchEventArgsWith initializes DispatchTouchEventArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDispatchTouchEventArgsWith(typ string, touchPoints []TouchPoint, opts ...DispatchTouchEventOption) *DispatchTouchEventArgs {
+	args := NewDispatchTouchEventArgs(typ, touchPoints)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DispatchTouchEventWithModifiers sets the Modifiers optional argument, see
+// DispatchTouchEventArgs.SetModifiers.
+func DispatchTouchEventWithModifiers(v int) DispatchTouchEventOption {
+	return func(a *DispatchTouchEventArgs) { a.SetModifiers(v) }
+}
+
+// DispatchTouchEventWithTimestamp sets the Timestamp optional argument, see
+// DispatchTouchEventArgs.SetTimestamp.
+func DispatchTouchEventWithTimestamp(v TimeSinceEpoch) DispatchTouchEventOption {
+	return func(a *DispatchTouchEventArgs) { a.SetTimestamp(v) }
+}
+
+// EmulateTouchFromMouseEventOption sets an optional argument of EmulateTouchFromMouseEventArgs.
+type EmulateTouchFromMouseEventOption func(*EmulateTouchFromMouseEventArgs)
+
+// NewEmulateTouchFromMouseEventArgsWith initializes EmulateTouchFromMouseEventArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEmulateTouchFromMouseEventArgsWith(typ string, x int, y int, button MouseButton, opts ...EmulateTouchFromMouseEventOption) *EmulateTouchFromMouseEventArgs {
+	args := NewEmulateTouchFromMouseEventArgs(typ, x, y, button)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EmulateTouchFromMouseEventWithTimestamp sets the Timestamp optional argument, see
+// EmulateTouchFromMouseEventArgs.SetTimestamp.
+func EmulateTouchFromMouseEventWithTimestamp(v TimeSinceEpoch) EmulateTouchFromMouseEventOption {
+	return func(a *EmulateTouchFromMouseEventArgs) { a.SetTimestamp(v) }
+}
+
+// EmulateTouchFromMouseEventWithDeltaX sets the DeltaX optional argument, see
+// EmulateTouchFromMouseEventArgs.SetDeltaX.
+func EmulateTouchFromMouseEventWithDeltaX(v float64) EmulateTouchFromMouseEventOption {
+	return func(a *EmulateTouchFromMouseEventArgs) { a.SetDeltaX(v) }
+}
+
+// EmulateTouchFromMouseEventWithDeltaY sets the DeltaY optional argument, see
+// EmulateTouchFromMouseEventArgs.SetDeltaY.
+func EmulateTouchFromMouseEventWithDeltaY(v float64) EmulateTouchFromMouseEventOption {
+	return func(a *EmulateTouchFromMouseEventArgs) { a.SetDeltaY(v) }
+}
+
+// EmulateTouchFromMouseEventWithModifiers sets the Modifiers optional argument, see
+// EmulateTouchFromMouseEventArgs.SetModifiers.
+func EmulateTouchFromMouseEventWithModifiers(v int) EmulateTouchFromMouseEventOption {
+	return func(a *EmulateTouchFromMouseEventArgs) { a.SetModifiers(v) }
+}
+
+// EmulateTouchFromMouseEventWithClickCount sets the ClickCount optional argument, see
+// EmulateTouchFromMouseEventArgs.SetClickCount.
+func EmulateTouchFromMouseEventWithClickCount(v int) EmulateTouchFromMouseEventOption {
+	return func(a *EmulateTouchFromMouseEventArgs) { a.SetClickCount(v) }
+}
+
+// SynthesizePinchGestureOption sets an optional argument of SynthesizePinchGestureArgs.
+type SynthesizePinchGestureOption func(*SynthesizePinchGestureArgs)
+
+// NewSynthesizePinchGestureArgsWith initializes SynthesizePinchGestureArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSynthesizePinchGestureArgsWith(x float64, y float64, scaleFactor float64, opts ...SynthesizePinchGestureOption) *SynthesizePinchGestureArgs {
+	args := NewSynthesizePinchGestureArgs(x, y, scaleFactor)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SynthesizePinchGestureWithRelativeSpeed sets the RelativeSpeed optional argument, see
+// SynthesizePinchGestureArgs.SetRelativeSpeed.
+func SynthesizePinchGestureWithRelativeSpeed(v int) SynthesizePinchGestureOption {
+	return func(a *SynthesizePinchGestureArgs) { a.SetRelativeSpeed(v) }
+}
+
+// SynthesizePinchGestureWithGestureSourceType sets the GestureSourceType optional argument, see
+// SynthesizePinchGestureArgs.SetGestureSourceType.
+func SynthesizePinchGestureWithGestureSourceType(v GestureSourceType) SynthesizePinchGestureOption {
+	return func(a *SynthesizePinchGestureArgs) { a.SetGestureSourceType(v) }
+}
+
+// SynthesizeScrollGestureOption sets an optional argument of SynthesizeScrollGestureArgs.
+type SynthesizeScrollGestureOption func(*SynthesizeScrollGestureArgs)
+
+// NewSynthesizeScrollGestureArgsWith initializes SynthesizeScrollGestureArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSynthesizeScrollGestureArgsWith(x float64, y float64, opts ...SynthesizeScrollGestureOption) *SynthesizeScrollGestureArgs {
+	args := NewSynthesizeScrollGestureArgs(x, y)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SynthesizeScrollGestureWithXDistance sets the XDistance optional argument, see
+// SynthesizeScrollGestureArgs.SetXDistance.
+func SynthesizeScrollGestureWithXDistance(v float64) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetXDistance(v) }
+}
+
+// SynthesizeScrollGestureWithYDistance sets the YDistance optional argument, see
+// SynthesizeScrollGestureArgs.SetYDistance.
+func SynthesizeScrollGestureWithYDistance(v float64) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetYDistance(v) }
+}
+
+// SynthesizeScrollGestureWithXOverscroll sets the XOverscroll optional argument, see
+// SynthesizeScrollGestureArgs.SetXOverscroll.
+func SynthesizeScrollGestureWithXOverscroll(v float64) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetXOverscroll(v) }
+}
+
+// SynthesizeScrollGestureWithYOverscroll sets the YOverscroll optional argument, see
+// SynthesizeScrollGestureArgs.SetYOverscroll.
+func SynthesizeScrollGestureWithYOverscroll(v float64) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetYOverscroll(v) }
+}
+
+// SynthesizeScrollGestureWithPreventFling sets the PreventFling optional argument, see
+// SynthesizeScrollGestureArgs.SetPreventFling.
+func SynthesizeScrollGestureWithPreventFling(v bool) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetPreventFling(v) }
+}
+
+// SynthesizeScrollGestureWithSpeed sets the Speed optional argument, see
+// SynthesizeScrollGestureArgs.SetSpeed.
+func SynthesizeScrollGestureWithSpeed(v int) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetSpeed(v) }
+}
+
+// SynthesizeScrollGestureWithGestureSourceType sets the GestureSourceType optional argument, see
+// SynthesizeScrollGestureArgs.SetGestureSourceType.
+func SynthesizeScrollGestureWithGestureSourceType(v GestureSourceType) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetGestureSourceType(v) }
+}
+
+// SynthesizeScrollGestureWithRepeatCount sets the RepeatCount optional argument, see
+// SynthesizeScrollGestureArgs.SetRepeatCount.
+func SynthesizeScrollGestureWithRepeatCount(v int) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetRepeatCount(v) }
+}
+
+// SynthesizeScrollGestureWithRepeatDelayMs sets the RepeatDelayMs optional argument, see
+// SynthesizeScrollGestureArgs.SetRepeatDelayMs.
+func SynthesizeScrollGestureWithRepeatDelayMs(v int) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetRepeatDelayMs(v) }
+}
+
+// SynthesizeScrollGestureWithInteractionMarkerName sets the InteractionMarkerName optional argument, see
+// SynthesizeScrollGestureArgs.SetInteractionMarkerName.
+func SynthesizeScrollGestureWithInteractionMarkerName(v string) SynthesizeScrollGestureOption {
+	return func(a *SynthesizeScrollGestureArgs) { a.SetInteractionMarkerName(v) }
+}
+
+// SynthesizeTapGestureOption sets an optional argument of SynthesizeTapGestureArgs.
+type SynthesizeTapGestureOption func(*SynthesizeTapGestureArgs)
+
+// NewSynthesizeTapGestureArgsWith initializes SynthesizeTapGestureArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSynthesizeTapGestureArgsWith(x float64, y float64, opts ...SynthesizeTapGestureOption) *SynthesizeTapGestureArgs {
+	args := NewSynthesizeTapGestureArgs(x, y)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SynthesizeTapGestureWithDuration sets the Duration optional argument, see
+// SynthesizeTapGestureArgs.SetDuration.
+func SynthesizeTapGestureWithDuration(v int) SynthesizeTapGestureOption {
+	return func(a *SynthesizeTapGestureArgs) { a.SetDuration(v) }
+}
+
+// SynthesizeTapGestureWithTapCount sets the TapCount optional argument, see
+// SynthesizeTapGestureArgs.SetTapCount.
+func SynthesizeTapGestureWithTapCount(v int) SynthesizeTapGestureOption {
+	return func(a *SynthesizeTapGestureArgs) { a.SetTapCount(v) }
+}
+
+// SynthesizeTapGestureWithGestureSourceType sets the GestureSourceType optional argument, see
+// SynthesizeTapGestureArgs.SetGestureSourceType.
+func SynthesizeTapGestureWithGestureSourceType(v GestureSourceType) SynthesizeTapGestureOption {
+	return func(a *SynthesizeTapGestureArgs) { a.SetGestureSourceType(v) }
+}
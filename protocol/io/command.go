@@ -18,6 +18,12 @@ func NewCloseArgs(handle StreamHandle) *CloseArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CloseArgs) Validate() error {
+	return nil
+}
+
 // ReadArgs represents the arguments for Read in the IO domain.
 type ReadArgs struct {
 	Handle StreamHandle `json:"handle"`           // Handle of the stream to read.
@@ -48,6 +54,12 @@ func (a *ReadArgs) SetSize(size int) *ReadArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReadArgs) Validate() error {
+	return nil
+}
+
 // ReadReply represents the return values for Read in the IO domain.
 type ReadReply struct {
 	Base64Encoded *bool  `json:"base64Encoded,omitempty"` // Set if the data is base64-encoded
@@ -67,6 +79,12 @@ func NewResolveBlobArgs(objectID runtime.RemoteObjectID) *ResolveBlobArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResolveBlobArgs) Validate() error {
+	return nil
+}
+
 // ResolveBlobReply represents the return values for ResolveBlob in the IO domain.
 type ResolveBlobReply struct {
 	UUID string `json:"uuid"` // UUID of the specified Blob.
@@ -0,0 +1,160 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package io
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CloseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("handle")
+	w.String(string(v.Handle))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CloseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "handle":
+			v.Handle = StreamHandle(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("handle")
+	w.String(string(v.Handle))
+	if v.Offset != nil {
+		w.Key("offset")
+		w.Int(*v.Offset)
+	}
+	if v.Size != nil {
+		w.Key("size")
+		w.Int(*v.Size)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "handle":
+			v.Handle = StreamHandle(r.String())
+		case "offset":
+			if r.Null() {
+				v.Offset = nil
+			} else {
+				p := r.Int()
+				v.Offset = &p
+			}
+		case "size":
+			if r.Null() {
+				v.Size = nil
+			} else {
+				p := r.Int()
+				v.Size = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReadReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Base64Encoded != nil {
+		w.Key("base64Encoded")
+		w.Bool(*v.Base64Encoded)
+	}
+	w.Key("data")
+	w.String(v.Data)
+	w.Key("eof")
+	w.Bool(v.EOF)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReadReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "base64Encoded":
+			if r.Null() {
+				v.Base64Encoded = nil
+			} else {
+				p := r.Bool()
+				v.Base64Encoded = &p
+			}
+		case "data":
+			v.Data = r.String()
+		case "eof":
+			v.EOF = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveBlobArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveBlobArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = runtime.RemoteObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveBlobReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("uuid")
+	w.String(v.UUID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveBlobReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "uuid":
+			v.UUID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
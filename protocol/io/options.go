@@ -0,0 +1,30 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package io
+
+// ReadOption sets an optional argument of ReadArgs.
+type ReadOption func(*ReadArgs)
+
+// NewReadArgsWith initializes ReadArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewReadArgsWith(handle StreamHandle, opts ...ReadOption) *ReadArgs {
+	args := NewReadArgs(handle)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ReadWithOffset sets the Offset optional argument, see
+// ReadArgs.SetOffset.
+func ReadWithOffset(v int) ReadOption {
+	return func(a *ReadArgs) { a.SetOffset(v) }
+}
+
+// ReadWithSize sets the Size optional argument, see
+// ReadArgs.SetSize.
+func ReadWithSize(v int) ReadOption {
+	return func(a *ReadArgs) { a.SetSize(v) }
+}
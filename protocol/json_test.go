@@ -0,0 +1,240 @@
+// The tests compare jsonx with the reflection based encoding of package
+// json, with the cdpjson tag json.Marshal would use jsonx (MarshalJSON).
+//
+//go:build !cdpjson
+
+package protocol_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+var (
+	decoderType    = reflect.TypeOf((*jsonx.Decoder)(nil)).Elem()
+	marshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// fill sets v to random values, depth limits recursive types.
+func fill(rnd *rand.Rand, v reflect.Value, depth int) {
+	t := v.Type()
+	if reflect.PointerTo(t).Implements(marshalerType) && t.Kind() == reflect.Slice {
+		// E.g. network.Headers, must be valid (compact) JSON.
+		b, _ := json.Marshal(map[string]string{"k" + strconv.Itoa(rnd.Intn(10)): randString(rnd)})
+		v.SetBytes(b)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(rnd.Intn(2) == 0)
+	case reflect.Int:
+		v.SetInt(rnd.Int63n(1<<53) - 1<<52)
+	case reflect.Uint8:
+		v.SetUint(uint64(rnd.Intn(256)))
+	case reflect.Float64:
+		v.SetFloat(rnd.NormFloat64() * math.Pow(10, float64(rnd.Intn(50)-25)))
+	case reflect.String:
+		v.SetString(randString(rnd))
+	case reflect.Ptr:
+		if depth > 3 || rnd.Intn(3) == 0 {
+			return
+		}
+		v.Set(reflect.New(t.Elem()))
+		fill(rnd, v.Elem(), depth+1)
+	case reflect.Slice:
+		if t == rawMessageType {
+			b, _ := json.Marshal([]interface{}{randString(rnd), rnd.Intn(100), nil})
+			v.SetBytes(b)
+			return
+		}
+		if depth > 3 || rnd.Intn(4) == 0 {
+			return // Nil.
+		}
+		n := rnd.Intn(3)
+		v.Set(reflect.MakeSlice(t, n, n))
+		for i := 0; i < n; i++ {
+			fill(rnd, v.Index(i), depth+1)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if depth > 3 && v.Field(i).Kind() != reflect.Ptr {
+				continue
+			}
+			fill(rnd, v.Field(i), depth+1)
+		}
+	default:
+		panic("fill: unhandled type: " + t.String())
+	}
+}
+
+func randString(rnd *rand.Rand) string {
+	const chars = "abcXYZ019 \"\\/<>&\n\t\x01åä世😀 "
+	r := []rune(chars)
+	b := make([]rune, rnd.Intn(8))
+	for i := range b {
+		b[i] = r[rnd.Intn(len(r))]
+	}
+	return string(b)
+}
+
+// TestGeneratedJSON compares the generated (reflection-free) encoding
+// and decoding with encoding/json. When built with the cdpjson tag,
+// encoding/json uses the generated methods and the test is only a
+// round-trip test.
+func TestGeneratedJSON(t *testing.T) {
+	types := protocolTypes()
+	if len(types) < 500 {
+		t.Fatalf("found %d protocol types, want at least 500", len(types))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, typ := range types {
+		for i := 0; i < 10; i++ {
+			v := reflect.New(typ)
+			fill(rnd, v.Elem(), 0)
+
+			want, err := json.Marshal(v.Interface())
+			if err != nil {
+				t.Fatalf("%v: json.Marshal: %v", typ, err)
+			}
+			got, err := jsonx.Marshal(v.Interface().(jsonx.Encoder))
+			if err != nil {
+				t.Fatalf("%v: jsonx.Marshal: %v", typ, err)
+			}
+			if diff := cmp.Diff(string(want), string(got)); diff != "" {
+				t.Fatalf("%v: jsonx.Marshal differs from json.Marshal (-want +got):\n%s", typ, diff)
+			}
+
+			if !reflect.PointerTo(typ).Implements(decoderType) {
+				continue // E.g. network.CookiePartitionKey.
+			}
+			wantV := reflect.New(typ)
+			if err := json.Unmarshal(want, wantV.Interface()); err != nil {
+				t.Fatalf("%v: json.Unmarshal: %v", typ, err)
+			}
+			gotV := reflect.New(typ)
+			if err := jsonx.Unmarshal(want, gotV.Interface().(jsonx.Decoder)); err != nil {
+				t.Fatalf("%v: jsonx.Unmarshal: %v", typ, err)
+			}
+			if !reflect.DeepEqual(wantV.Interface(), gotV.Interface()) {
+				t.Fatalf("%v: jsonx.Unmarshal differs from json.Unmarshal:\nwant: %#v\ngot:  %#v", typ, wantV.Elem(), gotV.Elem())
+			}
+		}
+	}
+
+	if reflect.PointerTo(reflect.TypeOf(network.CookiePartitionKey{})).Implements(decoderType) {
+		t.Error("network.CookiePartitionKey: got DecodeJSON, want hand-written UnmarshalJSON only")
+	}
+}
+
+type benchmarkValue interface {
+	jsonx.Encoder
+	jsonx.Decoder
+}
+
+func benchmarkValues() map[string]func() benchmarkValue {
+	return map[string]func() benchmarkValue{
+		"network.DataReceivedReply": func() benchmarkValue {
+			return &network.DataReceivedReply{
+				RequestID:         "1000.42",
+				Timestamp:         12345.678,
+				DataLength:        4096,
+				EncodedDataLength: 1024,
+			}
+		},
+		"page.ScreencastFrameReply": func() benchmarkValue {
+			data := make([]byte, 64<<10)
+			rand.New(rand.NewSource(1)).Read(data)
+			return &page.ScreencastFrameReply{
+				Data: data,
+				Metadata: page.ScreencastFrameMetadata{
+					OffsetTop:       64,
+					PageScaleFactor: 1,
+					DeviceWidth:     1280,
+					DeviceHeight:    720,
+					ScrollOffsetX:   0,
+					ScrollOffsetY:   512.5,
+				},
+				SessionID: 7,
+			}
+		},
+		"dom.SetChildNodesReply": func() benchmarkValue {
+			nodes := make([]dom.Node, 50)
+			for i := range nodes {
+				nodes[i] = dom.Node{
+					NodeID:        dom.NodeID(i + 2),
+					BackendNodeID: dom.BackendNodeID(i + 100),
+					NodeType:      1,
+					NodeName:      "DIV",
+					LocalName:     "div",
+					Attributes:    []string{"class", "item item-" + strconv.Itoa(i), "data-x", "<&>"},
+				}
+			}
+			return &dom.SetChildNodesReply{ParentID: 1, Nodes: nodes}
+		},
+	}
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	for name, newV := range benchmarkValues() {
+		v := newV()
+		b.Run(name+"/reflect", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := json.Marshal(v); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(name+"/generated", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := jsonx.Marshal(v); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	for name, newV := range benchmarkValues() {
+		v := newV()
+		data, err := jsonx.Marshal(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+		typ := reflect.TypeOf(v).Elem()
+		b.Run(name+"/reflect", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if err := json.Unmarshal(data, reflect.New(typ).Interface()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(name+"/generated", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if err := jsonx.Unmarshal(data, reflect.New(typ).Interface().(jsonx.Decoder)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
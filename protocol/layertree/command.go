@@ -4,6 +4,7 @@ package layertree
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/mafredri/cdp/protocol/dom"
 )
@@ -20,6 +21,12 @@ func NewCompositingReasonsArgs(layerID LayerID) *CompositingReasonsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CompositingReasonsArgs) Validate() error {
+	return nil
+}
+
 // CompositingReasonsReply represents the return values for CompositingReasons in the LayerTree domain.
 type CompositingReasonsReply struct {
 	CompositingReasons   []string `json:"compositingReasons"`   // A list of strings specifying reasons for the given layer to become composited.
@@ -38,6 +45,15 @@ func NewLoadSnapshotArgs(tiles []PictureTile) *LoadSnapshotArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *LoadSnapshotArgs) Validate() error {
+	if a.Tiles == nil {
+		return errors.New("layertree.LoadSnapshotArgs: Tiles is required")
+	}
+	return nil
+}
+
 // LoadSnapshotReply represents the return values for LoadSnapshot in the LayerTree domain.
 type LoadSnapshotReply struct {
 	SnapshotID SnapshotID `json:"snapshotId"` // The id of the snapshot.
@@ -55,6 +71,12 @@ func NewMakeSnapshotArgs(layerID LayerID) *MakeSnapshotArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *MakeSnapshotArgs) Validate() error {
+	return nil
+}
+
 // MakeSnapshotReply represents the return values for MakeSnapshot in the LayerTree domain.
 type MakeSnapshotReply struct {
 	SnapshotID SnapshotID `json:"snapshotId"` // The id of the layer snapshot.
@@ -97,6 +119,12 @@ func (a *ProfileSnapshotArgs) SetClipRect(clipRect dom.Rect) *ProfileSnapshotArg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ProfileSnapshotArgs) Validate() error {
+	return nil
+}
+
 // ProfileSnapshotReply represents the return values for ProfileSnapshot in the LayerTree domain.
 type ProfileSnapshotReply struct {
 	Timings []PaintProfile `json:"timings"` // The array of paint profiles, one per run.
@@ -114,6 +142,12 @@ func NewReleaseSnapshotArgs(snapshotID SnapshotID) *ReleaseSnapshotArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReleaseSnapshotArgs) Validate() error {
+	return nil
+}
+
 // ReplaySnapshotArgs represents the arguments for ReplaySnapshot in the LayerTree domain.
 type ReplaySnapshotArgs struct {
 	SnapshotID SnapshotID `json:"snapshotId"`         // The id of the layer snapshot.
@@ -150,6 +184,12 @@ func (a *ReplaySnapshotArgs) SetScale(scale float64) *ReplaySnapshotArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReplaySnapshotArgs) Validate() error {
+	return nil
+}
+
 // ReplaySnapshotReply represents the return values for ReplaySnapshot in the LayerTree domain.
 type ReplaySnapshotReply struct {
 	DataURL string `json:"dataURL"` // A data: URL for resulting image.
@@ -167,6 +207,12 @@ func NewSnapshotCommandLogArgs(snapshotID SnapshotID) *SnapshotCommandLogArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SnapshotCommandLogArgs) Validate() error {
+	return nil
+}
+
 // SnapshotCommandLogReply represents the return values for SnapshotCommandLog in the LayerTree domain.
 type SnapshotCommandLogReply struct {
 	CommandLog []json.RawMessage `json:"commandLog"` // The array of canvas function calls.
@@ -0,0 +1,73 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package layertree
+
+import (
+	"github.com/mafredri/cdp/protocol/dom"
+)
+
+// ProfileSnapshotOption sets an optional argument of ProfileSnapshotArgs.
+type ProfileSnapshotOption func(*ProfileSnapshotArgs)
+
+// NewProfileSnapshotArgsWith initializes ProfileSnapshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewProfileSnapshotArgsWith(snapshotID SnapshotID, opts ...ProfileSnapshotOption) *ProfileSnapshotArgs {
+	args := NewProfileSnapshotArgs(snapshotID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ProfileSnapshotWithMinRepeatCount sets the MinRepeatCount optional argument, see
+// ProfileSnapshotArgs.SetMinRepeatCount.
+func ProfileSnapshotWithMinRepeatCount(v int) ProfileSnapshotOption {
+	return func(a *ProfileSnapshotArgs) { a.SetMinRepeatCount(v) }
+}
+
+// ProfileSnapshotWithMinDuration sets the MinDuration optional argument, see
+// ProfileSnapshotArgs.SetMinDuration.
+func ProfileSnapshotWithMinDuration(v float64) ProfileSnapshotOption {
+	return func(a *ProfileSnapshotArgs) { a.SetMinDuration(v) }
+}
+
+// ProfileSnapshotWithClipRect sets the ClipRect optional argument, see
+// ProfileSnapshotArgs.SetClipRect.
+func ProfileSnapshotWithClipRect(v dom.Rect) ProfileSnapshotOption {
+	return func(a *ProfileSnapshotArgs) { a.SetClipRect(v) }
+}
+
+// ReplaySnapshotOption sets an optional argument of ReplaySnapshotArgs.
+type ReplaySnapshotOption func(*ReplaySnapshotArgs)
+
+// NewReplaySnapshotArgsWith initializes ReplaySnapshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewReplaySnapshotArgsWith(snapshotID SnapshotID, opts ...ReplaySnapshotOption) *ReplaySnapshotArgs {
+	args := NewReplaySnapshotArgs(snapshotID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ReplaySnapshotWithFromStep sets the FromStep optional argument, see
+// ReplaySnapshotArgs.SetFromStep.
+func ReplaySnapshotWithFromStep(v int) ReplaySnapshotOption {
+	return func(a *ReplaySnapshotArgs) { a.SetFromStep(v) }
+}
+
+// ReplaySnapshotWithToStep sets the ToStep optional argument, see
+// ReplaySnapshotArgs.SetToStep.
+func ReplaySnapshotWithToStep(v int) ReplaySnapshotOption {
+	return func(a *ReplaySnapshotArgs) { a.SetToStep(v) }
+}
+
+// ReplaySnapshotWithScale sets the Scale optional argument, see
+// ReplaySnapshotArgs.SetScale.
+func ReplaySnapshotWithScale(v float64) ReplaySnapshotOption {
+	return func(a *ReplaySnapshotArgs) { a.SetScale(v) }
+}
@@ -2,6 +2,8 @@
 
 package log
 
+import "errors"
+
 // StartViolationsReportArgs represents the arguments for StartViolationsReport in the Log domain.
 type StartViolationsReportArgs struct {
 	Config []ViolationSetting `json:"config"` // Configuration for violations.
@@ -13,3 +15,12 @@ func NewStartViolationsReportArgs(config []ViolationSetting) *StartViolationsRep
 	args.Config = config
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartViolationsReportArgs) Validate() error {
+	if a.Config == nil {
+		return errors.New("log.StartViolationsReportArgs: Config is required")
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package log
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Entry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("source")
+	w.String(v.Source)
+	w.Key("level")
+	w.String(v.Level)
+	w.Key("text")
+	w.String(v.Text)
+	if v.Category != nil {
+		w.Key("category")
+		w.String(*v.Category)
+	}
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.LineNumber != nil {
+		w.Key("lineNumber")
+		w.Int(*v.LineNumber)
+	}
+	if v.StackTrace != nil {
+		w.Key("stackTrace")
+		v.StackTrace.EncodeJSON(w)
+	}
+	if v.NetworkRequestID != nil {
+		w.Key("networkRequestId")
+		w.String(string(*v.NetworkRequestID))
+	}
+	if v.WorkerID != nil {
+		w.Key("workerId")
+		w.String(*v.WorkerID)
+	}
+	if len(v.Args) > 0 {
+		w.Key("args")
+		w.ArrayStart()
+		for i0 := range v.Args {
+			v.Args[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Entry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "source":
+			v.Source = r.String()
+		case "level":
+			v.Level = r.String()
+		case "text":
+			v.Text = r.String()
+		case "category":
+			if r.Null() {
+				v.Category = nil
+			} else {
+				p := r.String()
+				v.Category = &p
+			}
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "lineNumber":
+			if r.Null() {
+				v.LineNumber = nil
+			} else {
+				p := r.Int()
+				v.LineNumber = &p
+			}
+		case "stackTrace":
+			if r.Null() {
+				v.StackTrace = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.StackTrace = &p
+			}
+		case "networkRequestId":
+			if r.Null() {
+				v.NetworkRequestID = nil
+			} else {
+				p := network.RequestID(r.String())
+				v.NetworkRequestID = &p
+			}
+		case "workerId":
+			if r.Null() {
+				v.WorkerID = nil
+			} else {
+				p := r.String()
+				v.WorkerID = &p
+			}
+		case "args":
+			if r.ArrayStart() {
+				v.Args = make([]runtime.RemoteObject, 0, 4)
+				for r.ArrayNext() {
+					var e0 runtime.RemoteObject
+					e0.DecodeJSON(r)
+					v.Args = append(v.Args, e0)
+				}
+			} else {
+				v.Args = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ViolationSetting) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("threshold")
+	w.Float(v.Threshold)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ViolationSetting) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "threshold":
+			v.Threshold = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartViolationsReportArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("config")
+	if v.Config == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Config {
+			v.Config[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartViolationsReportArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "config":
+			if r.ArrayStart() {
+				v.Config = make([]ViolationSetting, 0, 4)
+				for r.ArrayNext() {
+					var e0 ViolationSetting
+					e0.DecodeJSON(r)
+					v.Config = append(v.Config, e0)
+				}
+			} else {
+				v.Config = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EntryAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("entry")
+	v.Entry.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EntryAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "entry":
+			v.Entry.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
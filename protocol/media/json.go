@@ -0,0 +1,422 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package media
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerMessage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("level")
+	w.String(v.Level)
+	w.Key("message")
+	w.String(v.Message)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerMessage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "level":
+			v.Level = r.String()
+		case "message":
+			v.Message = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerProperty) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerProperty) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerEvent) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerEvent) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerErrorSourceLocation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("file")
+	w.String(v.File)
+	w.Key("line")
+	w.Int(v.Line)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerErrorSourceLocation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "file":
+			v.File = r.String()
+		case "line":
+			v.Line = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerError) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("errorType")
+	w.String(v.ErrorType)
+	w.Key("code")
+	w.Int(v.Code)
+	w.Key("stack")
+	if v.Stack == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Stack {
+			v.Stack[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("cause")
+	if v.Cause == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Cause {
+			v.Cause[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("data")
+	w.Raw(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerError) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "errorType":
+			v.ErrorType = r.String()
+		case "code":
+			v.Code = r.Int()
+		case "stack":
+			if r.ArrayStart() {
+				v.Stack = make([]PlayerErrorSourceLocation, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlayerErrorSourceLocation
+					e0.DecodeJSON(r)
+					v.Stack = append(v.Stack, e0)
+				}
+			} else {
+				v.Stack = nil
+			}
+		case "cause":
+			if r.ArrayStart() {
+				v.Cause = make([]PlayerError, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlayerError
+					e0.DecodeJSON(r)
+					v.Cause = append(v.Cause, e0)
+				}
+			} else {
+				v.Cause = nil
+			}
+		case "data":
+			v.Data = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Player) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playerId")
+	w.String(string(v.PlayerID))
+	if v.DOMNodeID != nil {
+		w.Key("domNodeId")
+		w.Int(int(*v.DOMNodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Player) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playerId":
+			v.PlayerID = PlayerID(r.String())
+		case "domNodeId":
+			if r.Null() {
+				v.DOMNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.DOMNodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerPropertiesChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playerId")
+	w.String(string(v.PlayerID))
+	w.Key("properties")
+	if v.Properties == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Properties {
+			v.Properties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerPropertiesChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playerId":
+			v.PlayerID = PlayerID(r.String())
+		case "properties":
+			if r.ArrayStart() {
+				v.Properties = make([]PlayerProperty, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlayerProperty
+					e0.DecodeJSON(r)
+					v.Properties = append(v.Properties, e0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerEventsAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playerId")
+	w.String(string(v.PlayerID))
+	w.Key("events")
+	if v.Events == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Events {
+			v.Events[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerEventsAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playerId":
+			v.PlayerID = PlayerID(r.String())
+		case "events":
+			if r.ArrayStart() {
+				v.Events = make([]PlayerEvent, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlayerEvent
+					e0.DecodeJSON(r)
+					v.Events = append(v.Events, e0)
+				}
+			} else {
+				v.Events = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerMessagesLoggedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playerId")
+	w.String(string(v.PlayerID))
+	w.Key("messages")
+	if v.Messages == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Messages {
+			v.Messages[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerMessagesLoggedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playerId":
+			v.PlayerID = PlayerID(r.String())
+		case "messages":
+			if r.ArrayStart() {
+				v.Messages = make([]PlayerMessage, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlayerMessage
+					e0.DecodeJSON(r)
+					v.Messages = append(v.Messages, e0)
+				}
+			} else {
+				v.Messages = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerErrorsRaisedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playerId")
+	w.String(string(v.PlayerID))
+	w.Key("errors")
+	if v.Errors == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Errors {
+			v.Errors[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerErrorsRaisedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playerId":
+			v.PlayerID = PlayerID(r.String())
+		case "errors":
+			if r.ArrayStart() {
+				v.Errors = make([]PlayerError, 0, 4)
+				for r.ArrayNext() {
+					var e0 PlayerError
+					e0.DecodeJSON(r)
+					v.Errors = append(v.Errors, e0)
+				}
+			} else {
+				v.Errors = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PlayerCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("player")
+	v.Player.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PlayerCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "player":
+			v.Player.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
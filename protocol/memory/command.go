@@ -2,6 +2,11 @@
 
 package memory
 
+import (
+	"errors"
+	"strconv"
+)
+
 // GetDOMCountersReply represents the return values for GetDOMCounters in the Memory domain.
 type GetDOMCountersReply struct {
 	Documents        int `json:"documents"`        // No description.
@@ -26,6 +31,12 @@ func NewSetPressureNotificationsSuppressedArgs(suppressed bool) *SetPressureNoti
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureNotificationsSuppressedArgs) Validate() error {
+	return nil
+}
+
 // SimulatePressureNotificationArgs represents the arguments for SimulatePressureNotification in the Memory domain.
 type SimulatePressureNotificationArgs struct {
 	Level PressureLevel `json:"level"` // Memory pressure level of the notification.
@@ -38,6 +49,15 @@ func NewSimulatePressureNotificationArgs(level PressureLevel) *SimulatePressureN
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SimulatePressureNotificationArgs) Validate() error {
+	if !a.Level.Valid() {
+		return errors.New("memory.SimulatePressureNotificationArgs: Level: invalid value " + strconv.Quote(string(a.Level)))
+	}
+	return nil
+}
+
 // StartSamplingArgs represents the arguments for StartSampling in the Memory domain.
 type StartSamplingArgs struct {
 	SamplingInterval   *int  `json:"samplingInterval,omitempty"`   // Average number of bytes between samples.
@@ -65,6 +85,12 @@ func (a *StartSamplingArgs) SetSuppressRandomness(suppressRandomness bool) *Star
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartSamplingArgs) Validate() error {
+	return nil
+}
+
 // GetAllTimeSamplingProfileReply represents the return values for GetAllTimeSamplingProfile in the Memory domain.
 type GetAllTimeSamplingProfileReply struct {
 	Profile SamplingProfile `json:"profile"` // No description.
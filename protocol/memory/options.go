@@ -0,0 +1,30 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package memory
+
+// StartSamplingOption sets an optional argument of StartSamplingArgs.
+type StartSamplingOption func(*StartSamplingArgs)
+
+// NewStartSamplingArgsWith initializes StartSamplingArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStartSamplingArgsWith(opts ...StartSamplingOption) *StartSamplingArgs {
+	args := NewStartSamplingArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StartSamplingWithSamplingInterval sets the SamplingInterval optional argument, see
+// StartSamplingArgs.SetSamplingInterval.
+func StartSamplingWithSamplingInterval(v int) StartSamplingOption {
+	return func(a *StartSamplingArgs) { a.SetSamplingInterval(v) }
+}
+
+// StartSamplingWithSuppressRandomness sets the SuppressRandomness optional argument, see
+// StartSamplingArgs.SetSuppressRandomness.
+func StartSamplingWithSuppressRandomness(v bool) StartSamplingOption {
+	return func(a *StartSamplingArgs) { a.SetSuppressRandomness(v) }
+}
@@ -3,6 +3,9 @@
 package network
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/debugger"
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/protocol/io"
@@ -20,6 +23,20 @@ func NewSetAcceptedEncodingsArgs(encodings []ContentEncoding) *SetAcceptedEncodi
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAcceptedEncodingsArgs) Validate() error {
+	if a.Encodings == nil {
+		return errors.New("network.SetAcceptedEncodingsArgs: Encodings is required")
+	}
+	for i0 := range a.Encodings {
+		if !a.Encodings[i0].Valid() {
+			return errors.New("network.SetAcceptedEncodingsArgs: Encodings" + "[" + strconv.Itoa(i0) + "]: invalid value " + strconv.Quote(string(a.Encodings[i0])))
+		}
+	}
+	return nil
+}
+
 // CanClearBrowserCacheReply represents the return values for CanClearBrowserCache in the Network domain.
 type CanClearBrowserCacheReply struct {
 	Result bool `json:"result"` // True if browser cache can be cleared.
@@ -112,6 +129,17 @@ func (a *ContinueInterceptedRequestArgs) SetAuthChallengeResponse(authChallengeR
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ContinueInterceptedRequestArgs) Validate() error {
+	if a.ErrorReason != "" {
+		if !a.ErrorReason.Valid() {
+			return errors.New("network.ContinueInterceptedRequestArgs: ErrorReason: invalid value " + strconv.Quote(string(a.ErrorReason)))
+		}
+	}
+	return nil
+}
+
 // DeleteCookiesArgs represents the arguments for DeleteCookies in the Network domain.
 type DeleteCookiesArgs struct {
 	Name   string  `json:"name"`             // Name of the cookies to remove.
@@ -166,6 +194,12 @@ func (a *DeleteCookiesArgs) SetPartitionKey(partitionKey CookiePartitionKey) *De
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteCookiesArgs) Validate() error {
+	return nil
+}
+
 // EmulateNetworkConditionsArgs represents the arguments for EmulateNetworkConditions in the Network domain.
 type EmulateNetworkConditionsArgs struct {
 	Offline            bool           `json:"offline"`                  // True to emulate internet disconnection.
@@ -235,6 +269,17 @@ func (a *EmulateNetworkConditionsArgs) SetPacketReordering(packetReordering bool
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EmulateNetworkConditionsArgs) Validate() error {
+	if a.ConnectionType != "" {
+		if !a.ConnectionType.Valid() {
+			return errors.New("network.EmulateNetworkConditionsArgs: ConnectionType: invalid value " + strconv.Quote(string(a.ConnectionType)))
+		}
+	}
+	return nil
+}
+
 // EmulateNetworkConditionsByRuleArgs represents the arguments for EmulateNetworkConditionsByRule in the Network domain.
 type EmulateNetworkConditionsByRuleArgs struct {
 	Offline                  bool         `json:"offline"`                  // True to emulate internet disconnection.
@@ -249,6 +294,15 @@ func NewEmulateNetworkConditionsByRuleArgs(offline bool, matchedNetworkCondition
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EmulateNetworkConditionsByRuleArgs) Validate() error {
+	if a.MatchedNetworkConditions == nil {
+		return errors.New("network.EmulateNetworkConditionsByRuleArgs: MatchedNetworkConditions is required")
+	}
+	return nil
+}
+
 // EmulateNetworkConditionsByRuleReply represents the return values for EmulateNetworkConditionsByRule in the Network domain.
 type EmulateNetworkConditionsByRuleReply struct {
 	RuleIDs []string `json:"ruleIds"` // An id for each entry in matchedNetworkConditions. The id will be included in the requestWillBeSentExtraInfo for requests affected by a rule.
@@ -280,6 +334,17 @@ func (a *OverrideNetworkStateArgs) SetConnectionType(connectionType ConnectionTy
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *OverrideNetworkStateArgs) Validate() error {
+	if a.ConnectionType != "" {
+		if !a.ConnectionType.Valid() {
+			return errors.New("network.OverrideNetworkStateArgs: ConnectionType: invalid value " + strconv.Quote(string(a.ConnectionType)))
+		}
+	}
+	return nil
+}
+
 // EnableArgs represents the arguments for Enable in the Network domain.
 type EnableArgs struct {
 	// MaxTotalBufferSize Buffer size in bytes to use when preserving
@@ -361,6 +426,12 @@ func (a *EnableArgs) SetEnableDurableMessages(enableDurableMessages bool) *Enabl
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // GetAllCookiesReply represents the return values for GetAllCookies in the Network domain.
 type GetAllCookiesReply struct {
 	Cookies []Cookie `json:"cookies"` // Array of cookie objects.
@@ -378,6 +449,12 @@ func NewGetCertificateArgs(origin string) *GetCertificateArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetCertificateArgs) Validate() error {
+	return nil
+}
+
 // GetCertificateReply represents the return values for GetCertificate in the Network domain.
 type GetCertificateReply struct {
 	TableNames []string `json:"tableNames"` // No description.
@@ -404,6 +481,12 @@ func (a *GetCookiesArgs) SetURLs(urls []string) *GetCookiesArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetCookiesArgs) Validate() error {
+	return nil
+}
+
 // GetCookiesReply represents the return values for GetCookies in the Network domain.
 type GetCookiesReply struct {
 	Cookies []Cookie `json:"cookies"` // Array of cookie objects.
@@ -421,6 +504,12 @@ func NewGetResponseBodyArgs(requestID RequestID) *GetResponseBodyArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetResponseBodyArgs) Validate() error {
+	return nil
+}
+
 // GetResponseBodyReply represents the return values for GetResponseBody in the Network domain.
 type GetResponseBodyReply struct {
 	Body          string `json:"body"`          // Response body.
@@ -439,6 +528,12 @@ func NewGetRequestPostDataArgs(requestID RequestID) *GetRequestPostDataArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetRequestPostDataArgs) Validate() error {
+	return nil
+}
+
 // GetRequestPostDataReply represents the return values for GetRequestPostData in the Network domain.
 type GetRequestPostDataReply struct {
 	PostData string `json:"postData"` // Request body string, omitting files from multipart requests
@@ -456,6 +551,12 @@ func NewGetResponseBodyForInterceptionArgs(interceptionID InterceptionID) *GetRe
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetResponseBodyForInterceptionArgs) Validate() error {
+	return nil
+}
+
 // GetResponseBodyForInterceptionReply represents the return values for GetResponseBodyForInterception in the Network domain.
 type GetResponseBodyForInterceptionReply struct {
 	Body          string `json:"body"`          // Response body.
@@ -474,6 +575,12 @@ func NewTakeResponseBodyForInterceptionAsStreamArgs(interceptionID InterceptionI
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TakeResponseBodyForInterceptionAsStreamArgs) Validate() error {
+	return nil
+}
+
 // TakeResponseBodyForInterceptionAsStreamReply represents the return values for TakeResponseBodyForInterceptionAsStream in the Network domain.
 type TakeResponseBodyForInterceptionAsStreamReply struct {
 	Stream io.StreamHandle `json:"stream"` // No description.
@@ -491,6 +598,12 @@ func NewReplayXHRArgs(requestID RequestID) *ReplayXHRArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReplayXHRArgs) Validate() error {
+	return nil
+}
+
 // SearchInResponseBodyArgs represents the arguments for SearchInResponseBody in the Network domain.
 type SearchInResponseBodyArgs struct {
 	RequestID     RequestID `json:"requestId"`               // Identifier of the network response to search.
@@ -521,6 +634,12 @@ func (a *SearchInResponseBodyArgs) SetIsRegex(isRegex bool) *SearchInResponseBod
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SearchInResponseBodyArgs) Validate() error {
+	return nil
+}
+
 // SearchInResponseBodyReply represents the return values for SearchInResponseBody in the Network domain.
 type SearchInResponseBodyReply struct {
 	Result []debugger.SearchMatch `json:"result"` // List of search matches.
@@ -559,6 +678,12 @@ func (a *SetBlockedURLsArgs) SetURLs(urls []string) *SetBlockedURLsArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBlockedURLsArgs) Validate() error {
+	return nil
+}
+
 // SetBypassServiceWorkerArgs represents the arguments for SetBypassServiceWorker in the Network domain.
 type SetBypassServiceWorkerArgs struct {
 	Bypass bool `json:"bypass"` // Bypass service worker and load from network.
@@ -571,6 +696,12 @@ func NewSetBypassServiceWorkerArgs(bypass bool) *SetBypassServiceWorkerArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBypassServiceWorkerArgs) Validate() error {
+	return nil
+}
+
 // SetCacheDisabledArgs represents the arguments for SetCacheDisabled in the Network domain.
 type SetCacheDisabledArgs struct {
 	CacheDisabled bool `json:"cacheDisabled"` // Cache disabled state.
@@ -583,6 +714,12 @@ func NewSetCacheDisabledArgs(cacheDisabled bool) *SetCacheDisabledArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCacheDisabledArgs) Validate() error {
+	return nil
+}
+
 // SetCookieArgs represents the arguments for SetCookie in the Network domain.
 type SetCookieArgs struct {
 	Name     string         `json:"name"`               // Cookie name.
@@ -726,6 +863,27 @@ func (a *SetCookieArgs) SetPartitionKey(partitionKey CookiePartitionKey) *SetCoo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCookieArgs) Validate() error {
+	if a.SameSite != "" {
+		if !a.SameSite.Valid() {
+			return errors.New("network.SetCookieArgs: SameSite: invalid value " + strconv.Quote(string(a.SameSite)))
+		}
+	}
+	if a.Priority != "" {
+		if !a.Priority.Valid() {
+			return errors.New("network.SetCookieArgs: Priority: invalid value " + strconv.Quote(string(a.Priority)))
+		}
+	}
+	if a.SourceScheme != "" {
+		if !a.SourceScheme.Valid() {
+			return errors.New("network.SetCookieArgs: SourceScheme: invalid value " + strconv.Quote(string(a.SourceScheme)))
+		}
+	}
+	return nil
+}
+
 // SetCookieReply represents the return values for SetCookie in the Network domain.
 type SetCookieReply struct {
 	// Success is deprecated.
@@ -747,6 +905,15 @@ func NewSetCookiesArgs(cookies []CookieParam) *SetCookiesArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCookiesArgs) Validate() error {
+	if a.Cookies == nil {
+		return errors.New("network.SetCookiesArgs: Cookies is required")
+	}
+	return nil
+}
+
 // SetExtraHTTPHeadersArgs represents the arguments for SetExtraHTTPHeaders in the Network domain.
 type SetExtraHTTPHeadersArgs struct {
 	Headers Headers `json:"headers"` // Map with extra HTTP headers.
@@ -759,6 +926,15 @@ func NewSetExtraHTTPHeadersArgs(headers Headers) *SetExtraHTTPHeadersArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetExtraHTTPHeadersArgs) Validate() error {
+	if a.Headers == nil {
+		return errors.New("network.SetExtraHTTPHeadersArgs: Headers is required")
+	}
+	return nil
+}
+
 // SetAttachDebugStackArgs represents the arguments for SetAttachDebugStack in the Network domain.
 type SetAttachDebugStackArgs struct {
 	Enabled bool `json:"enabled"` // Whether to attach a page script stack for debugging purpose.
@@ -771,6 +947,12 @@ func NewSetAttachDebugStackArgs(enabled bool) *SetAttachDebugStackArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAttachDebugStackArgs) Validate() error {
+	return nil
+}
+
 // SetRequestInterceptionArgs represents the arguments for SetRequestInterception in the Network domain.
 type SetRequestInterceptionArgs struct {
 	Patterns []RequestPattern `json:"patterns"` // Requests matching any of these patterns will be forwarded and wait for the corresponding continueInterceptedRequest call.
@@ -783,6 +965,15 @@ func NewSetRequestInterceptionArgs(patterns []RequestPattern) *SetRequestInterce
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRequestInterceptionArgs) Validate() error {
+	if a.Patterns == nil {
+		return errors.New("network.SetRequestInterceptionArgs: Patterns is required")
+	}
+	return nil
+}
+
 // StreamResourceContentArgs represents the arguments for StreamResourceContent in the Network domain.
 type StreamResourceContentArgs struct {
 	RequestID RequestID `json:"requestId"` // Identifier of the request to stream.
@@ -795,6 +986,12 @@ func NewStreamResourceContentArgs(requestID RequestID) *StreamResourceContentArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StreamResourceContentArgs) Validate() error {
+	return nil
+}
+
 // StreamResourceContentReply represents the return values for StreamResourceContent in the Network domain.
 type StreamResourceContentReply struct {
 	BufferedData []byte `json:"bufferedData"` // Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON)
@@ -819,6 +1016,12 @@ func (a *GetSecurityIsolationStatusArgs) SetFrameID(frameID internal.PageFrameID
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetSecurityIsolationStatusArgs) Validate() error {
+	return nil
+}
+
 // GetSecurityIsolationStatusReply represents the return values for GetSecurityIsolationStatus in the Network domain.
 type GetSecurityIsolationStatusReply struct {
 	Status SecurityIsolationStatus `json:"status"` // No description.
@@ -836,6 +1039,12 @@ func NewEnableReportingAPIArgs(enable bool) *EnableReportingAPIArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableReportingAPIArgs) Validate() error {
+	return nil
+}
+
 // LoadNetworkResourceArgs represents the arguments for LoadNetworkResource in the Network domain.
 type LoadNetworkResourceArgs struct {
 	FrameID *internal.PageFrameID      `json:"frameId,omitempty"` // Frame id to get the resource for. Mandatory for frame targets, and should be omitted for worker targets.
@@ -859,6 +1068,12 @@ func (a *LoadNetworkResourceArgs) SetFrameID(frameID internal.PageFrameID) *Load
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *LoadNetworkResourceArgs) Validate() error {
+	return nil
+}
+
 // LoadNetworkResourceReply represents the return values for LoadNetworkResource in the Network domain.
 type LoadNetworkResourceReply struct {
 	Resource LoadNetworkResourcePageResult `json:"resource"` // No description.
@@ -879,3 +1094,9 @@ func NewSetCookieControlsArgs(enableThirdPartyCookieRestriction bool, disableThi
 	args.DisableThirdPartyCookieHeuristics = disableThirdPartyCookieHeuristics
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCookieControlsArgs) Validate() error {
+	return nil
+}
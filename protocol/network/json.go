@@ -0,0 +1,5939 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/protocol/io"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/security"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResourceTiming) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestTime")
+	w.Float(v.RequestTime)
+	w.Key("proxyStart")
+	w.Float(v.ProxyStart)
+	w.Key("proxyEnd")
+	w.Float(v.ProxyEnd)
+	w.Key("dnsStart")
+	w.Float(v.DNSStart)
+	w.Key("dnsEnd")
+	w.Float(v.DNSEnd)
+	w.Key("connectStart")
+	w.Float(v.ConnectStart)
+	w.Key("connectEnd")
+	w.Float(v.ConnectEnd)
+	w.Key("sslStart")
+	w.Float(v.SSLStart)
+	w.Key("sslEnd")
+	w.Float(v.SSLEnd)
+	w.Key("workerStart")
+	w.Float(v.WorkerStart)
+	w.Key("workerReady")
+	w.Float(v.WorkerReady)
+	w.Key("workerFetchStart")
+	w.Float(v.WorkerFetchStart)
+	w.Key("workerRespondWithSettled")
+	w.Float(v.WorkerRespondWithSettled)
+	if v.WorkerRouterEvaluationStart != nil {
+		w.Key("workerRouterEvaluationStart")
+		w.Float(*v.WorkerRouterEvaluationStart)
+	}
+	if v.WorkerCacheLookupStart != nil {
+		w.Key("workerCacheLookupStart")
+		w.Float(*v.WorkerCacheLookupStart)
+	}
+	w.Key("sendStart")
+	w.Float(v.SendStart)
+	w.Key("sendEnd")
+	w.Float(v.SendEnd)
+	w.Key("pushStart")
+	w.Float(v.PushStart)
+	w.Key("pushEnd")
+	w.Float(v.PushEnd)
+	w.Key("receiveHeadersStart")
+	w.Float(v.ReceiveHeadersStart)
+	w.Key("receiveHeadersEnd")
+	w.Float(v.ReceiveHeadersEnd)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResourceTiming) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestTime":
+			v.RequestTime = r.Float()
+		case "proxyStart":
+			v.ProxyStart = r.Float()
+		case "proxyEnd":
+			v.ProxyEnd = r.Float()
+		case "dnsStart":
+			v.DNSStart = r.Float()
+		case "dnsEnd":
+			v.DNSEnd = r.Float()
+		case "connectStart":
+			v.ConnectStart = r.Float()
+		case "connectEnd":
+			v.ConnectEnd = r.Float()
+		case "sslStart":
+			v.SSLStart = r.Float()
+		case "sslEnd":
+			v.SSLEnd = r.Float()
+		case "workerStart":
+			v.WorkerStart = r.Float()
+		case "workerReady":
+			v.WorkerReady = r.Float()
+		case "workerFetchStart":
+			v.WorkerFetchStart = r.Float()
+		case "workerRespondWithSettled":
+			v.WorkerRespondWithSettled = r.Float()
+		case "workerRouterEvaluationStart":
+			if r.Null() {
+				v.WorkerRouterEvaluationStart = nil
+			} else {
+				p := r.Float()
+				v.WorkerRouterEvaluationStart = &p
+			}
+		case "workerCacheLookupStart":
+			if r.Null() {
+				v.WorkerCacheLookupStart = nil
+			} else {
+				p := r.Float()
+				v.WorkerCacheLookupStart = &p
+			}
+		case "sendStart":
+			v.SendStart = r.Float()
+		case "sendEnd":
+			v.SendEnd = r.Float()
+		case "pushStart":
+			v.PushStart = r.Float()
+		case "pushEnd":
+			v.PushEnd = r.Float()
+		case "receiveHeadersStart":
+			v.ReceiveHeadersStart = r.Float()
+		case "receiveHeadersEnd":
+			v.ReceiveHeadersEnd = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PostDataEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Bytes != nil {
+		w.Key("bytes")
+		w.String(*v.Bytes)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PostDataEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bytes":
+			if r.Null() {
+				v.Bytes = nil
+			} else {
+				p := r.String()
+				v.Bytes = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Request) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if v.URLFragment != nil {
+		w.Key("urlFragment")
+		w.String(*v.URLFragment)
+	}
+	w.Key("method")
+	w.String(v.Method)
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	if v.PostData != nil {
+		w.Key("postData")
+		w.String(*v.PostData)
+	}
+	if v.HasPostData != nil {
+		w.Key("hasPostData")
+		w.Bool(*v.HasPostData)
+	}
+	if len(v.PostDataEntries) > 0 {
+		w.Key("postDataEntries")
+		w.ArrayStart()
+		for i0 := range v.PostDataEntries {
+			v.PostDataEntries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.MixedContentType != nil {
+		w.Key("mixedContentType")
+		w.String(string(*v.MixedContentType))
+	}
+	w.Key("initialPriority")
+	w.String(string(v.InitialPriority))
+	w.Key("referrerPolicy")
+	w.String(v.ReferrerPolicy)
+	if v.IsLinkPreload != nil {
+		w.Key("isLinkPreload")
+		w.Bool(*v.IsLinkPreload)
+	}
+	if v.TrustTokenParams != nil {
+		w.Key("trustTokenParams")
+		v.TrustTokenParams.EncodeJSON(w)
+	}
+	if v.IsSameSite != nil {
+		w.Key("isSameSite")
+		w.Bool(*v.IsSameSite)
+	}
+	if v.IsAdRelated != nil {
+		w.Key("isAdRelated")
+		w.Bool(*v.IsAdRelated)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Request) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "urlFragment":
+			if r.Null() {
+				v.URLFragment = nil
+			} else {
+				p := r.String()
+				v.URLFragment = &p
+			}
+		case "method":
+			v.Method = r.String()
+		case "headers":
+			r.Decode(&v.Headers)
+		case "postData":
+			if r.Null() {
+				v.PostData = nil
+			} else {
+				p := r.String()
+				v.PostData = &p
+			}
+		case "hasPostData":
+			if r.Null() {
+				v.HasPostData = nil
+			} else {
+				p := r.Bool()
+				v.HasPostData = &p
+			}
+		case "postDataEntries":
+			if r.ArrayStart() {
+				v.PostDataEntries = make([]PostDataEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 PostDataEntry
+					e0.DecodeJSON(r)
+					v.PostDataEntries = append(v.PostDataEntries, e0)
+				}
+			} else {
+				v.PostDataEntries = nil
+			}
+		case "mixedContentType":
+			if r.Null() {
+				v.MixedContentType = nil
+			} else {
+				p := security.MixedContentType(r.String())
+				v.MixedContentType = &p
+			}
+		case "initialPriority":
+			v.InitialPriority = ResourcePriority(r.String())
+		case "referrerPolicy":
+			v.ReferrerPolicy = r.String()
+		case "isLinkPreload":
+			if r.Null() {
+				v.IsLinkPreload = nil
+			} else {
+				p := r.Bool()
+				v.IsLinkPreload = &p
+			}
+		case "trustTokenParams":
+			if r.Null() {
+				v.TrustTokenParams = nil
+			} else {
+				var p TrustTokenParams
+				p.DecodeJSON(r)
+				v.TrustTokenParams = &p
+			}
+		case "isSameSite":
+			if r.Null() {
+				v.IsSameSite = nil
+			} else {
+				p := r.Bool()
+				v.IsSameSite = &p
+			}
+		case "isAdRelated":
+			if r.Null() {
+				v.IsAdRelated = nil
+			} else {
+				p := r.Bool()
+				v.IsAdRelated = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SignedCertificateTimestamp) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("status")
+	w.String(v.Status)
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("logDescription")
+	w.String(v.LogDescription)
+	w.Key("logId")
+	w.String(v.LogID)
+	w.Key("timestamp")
+	w.Float(v.Timestamp)
+	w.Key("hashAlgorithm")
+	w.String(v.HashAlgorithm)
+	w.Key("signatureAlgorithm")
+	w.String(v.SignatureAlgorithm)
+	w.Key("signatureData")
+	w.String(v.SignatureData)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SignedCertificateTimestamp) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "status":
+			v.Status = r.String()
+		case "origin":
+			v.Origin = r.String()
+		case "logDescription":
+			v.LogDescription = r.String()
+		case "logId":
+			v.LogID = r.String()
+		case "timestamp":
+			v.Timestamp = r.Float()
+		case "hashAlgorithm":
+			v.HashAlgorithm = r.String()
+		case "signatureAlgorithm":
+			v.SignatureAlgorithm = r.String()
+		case "signatureData":
+			v.SignatureData = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SecurityDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("protocol")
+	w.String(v.Protocol)
+	w.Key("keyExchange")
+	w.String(v.KeyExchange)
+	if v.KeyExchangeGroup != nil {
+		w.Key("keyExchangeGroup")
+		w.String(*v.KeyExchangeGroup)
+	}
+	w.Key("cipher")
+	w.String(v.Cipher)
+	if v.MAC != nil {
+		w.Key("mac")
+		w.String(*v.MAC)
+	}
+	w.Key("certificateId")
+	w.Int(int(v.CertificateID))
+	w.Key("subjectName")
+	w.String(v.SubjectName)
+	w.Key("sanList")
+	if v.SanList == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.SanList {
+			w.String(v.SanList[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("issuer")
+	w.String(v.Issuer)
+	w.Key("validFrom")
+	w.Marshal(v.ValidFrom)
+	w.Key("validTo")
+	w.Marshal(v.ValidTo)
+	w.Key("signedCertificateTimestampList")
+	if v.SignedCertificateTimestampList == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.SignedCertificateTimestampList {
+			v.SignedCertificateTimestampList[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("certificateTransparencyCompliance")
+	w.String(string(v.CertificateTransparencyCompliance))
+	if v.ServerSignatureAlgorithm != nil {
+		w.Key("serverSignatureAlgorithm")
+		w.Int(*v.ServerSignatureAlgorithm)
+	}
+	w.Key("encryptedClientHello")
+	w.Bool(v.EncryptedClientHello)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SecurityDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "protocol":
+			v.Protocol = r.String()
+		case "keyExchange":
+			v.KeyExchange = r.String()
+		case "keyExchangeGroup":
+			if r.Null() {
+				v.KeyExchangeGroup = nil
+			} else {
+				p := r.String()
+				v.KeyExchangeGroup = &p
+			}
+		case "cipher":
+			v.Cipher = r.String()
+		case "mac":
+			if r.Null() {
+				v.MAC = nil
+			} else {
+				p := r.String()
+				v.MAC = &p
+			}
+		case "certificateId":
+			v.CertificateID = security.CertificateID(r.Int())
+		case "subjectName":
+			v.SubjectName = r.String()
+		case "sanList":
+			if r.ArrayStart() {
+				v.SanList = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.SanList = append(v.SanList, e0)
+				}
+			} else {
+				v.SanList = nil
+			}
+		case "issuer":
+			v.Issuer = r.String()
+		case "validFrom":
+			r.Decode(&v.ValidFrom)
+		case "validTo":
+			r.Decode(&v.ValidTo)
+		case "signedCertificateTimestampList":
+			if r.ArrayStart() {
+				v.SignedCertificateTimestampList = make([]SignedCertificateTimestamp, 0, 4)
+				for r.ArrayNext() {
+					var e0 SignedCertificateTimestamp
+					e0.DecodeJSON(r)
+					v.SignedCertificateTimestampList = append(v.SignedCertificateTimestampList, e0)
+				}
+			} else {
+				v.SignedCertificateTimestampList = nil
+			}
+		case "certificateTransparencyCompliance":
+			v.CertificateTransparencyCompliance = CertificateTransparencyCompliance(r.String())
+		case "serverSignatureAlgorithm":
+			if r.Null() {
+				v.ServerSignatureAlgorithm = nil
+			} else {
+				p := r.Int()
+				v.ServerSignatureAlgorithm = &p
+			}
+		case "encryptedClientHello":
+			v.EncryptedClientHello = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CORSErrorStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("corsError")
+	w.String(string(v.CORSError))
+	w.Key("failedParameter")
+	w.String(v.FailedParameter)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CORSErrorStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "corsError":
+			v.CORSError = CORSError(r.String())
+		case "failedParameter":
+			v.FailedParameter = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrustTokenParams) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("operation")
+	w.String(string(v.Operation))
+	w.Key("refreshPolicy")
+	w.String(v.RefreshPolicy)
+	if len(v.Issuers) > 0 {
+		w.Key("issuers")
+		w.ArrayStart()
+		for i0 := range v.Issuers {
+			w.String(v.Issuers[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrustTokenParams) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "operation":
+			v.Operation = TrustTokenOperationType(r.String())
+		case "refreshPolicy":
+			v.RefreshPolicy = r.String()
+		case "issuers":
+			if r.ArrayStart() {
+				v.Issuers = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Issuers = append(v.Issuers, e0)
+				}
+			} else {
+				v.Issuers = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ServiceWorkerRouterInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.RuleIDMatched != nil {
+		w.Key("ruleIdMatched")
+		w.Int(*v.RuleIDMatched)
+	}
+	if v.MatchedSourceType != "" {
+		w.Key("matchedSourceType")
+		w.String(string(v.MatchedSourceType))
+	}
+	if v.ActualSourceType != "" {
+		w.Key("actualSourceType")
+		w.String(string(v.ActualSourceType))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ServiceWorkerRouterInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ruleIdMatched":
+			if r.Null() {
+				v.RuleIDMatched = nil
+			} else {
+				p := r.Int()
+				v.RuleIDMatched = &p
+			}
+		case "matchedSourceType":
+			v.MatchedSourceType = ServiceWorkerRouterSource(r.String())
+		case "actualSourceType":
+			v.ActualSourceType = ServiceWorkerRouterSource(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Response) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("status")
+	w.Int(v.Status)
+	w.Key("statusText")
+	w.String(v.StatusText)
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	if v.HeadersText != nil {
+		w.Key("headersText")
+		w.String(*v.HeadersText)
+	}
+	w.Key("mimeType")
+	w.String(v.MimeType)
+	w.Key("charset")
+	w.String(v.Charset)
+	if len(v.RequestHeaders) > 0 {
+		w.Key("requestHeaders")
+		w.Marshal(v.RequestHeaders)
+	}
+	if v.RequestHeadersText != nil {
+		w.Key("requestHeadersText")
+		w.String(*v.RequestHeadersText)
+	}
+	w.Key("connectionReused")
+	w.Bool(v.ConnectionReused)
+	w.Key("connectionId")
+	w.Float(v.ConnectionID)
+	if v.RemoteIPAddress != nil {
+		w.Key("remoteIPAddress")
+		w.String(*v.RemoteIPAddress)
+	}
+	if v.RemotePort != nil {
+		w.Key("remotePort")
+		w.Int(*v.RemotePort)
+	}
+	if v.FromDiskCache != nil {
+		w.Key("fromDiskCache")
+		w.Bool(*v.FromDiskCache)
+	}
+	if v.FromServiceWorker != nil {
+		w.Key("fromServiceWorker")
+		w.Bool(*v.FromServiceWorker)
+	}
+	if v.FromPrefetchCache != nil {
+		w.Key("fromPrefetchCache")
+		w.Bool(*v.FromPrefetchCache)
+	}
+	if v.FromEarlyHints != nil {
+		w.Key("fromEarlyHints")
+		w.Bool(*v.FromEarlyHints)
+	}
+	if v.ServiceWorkerRouterInfo != nil {
+		w.Key("serviceWorkerRouterInfo")
+		v.ServiceWorkerRouterInfo.EncodeJSON(w)
+	}
+	w.Key("encodedDataLength")
+	w.Float(v.EncodedDataLength)
+	if v.Timing != nil {
+		w.Key("timing")
+		v.Timing.EncodeJSON(w)
+	}
+	if v.ServiceWorkerResponseSource != "" {
+		w.Key("serviceWorkerResponseSource")
+		w.String(string(v.ServiceWorkerResponseSource))
+	}
+	if v.ResponseTime != 0 {
+		w.Key("responseTime")
+		w.Marshal(v.ResponseTime)
+	}
+	if v.CacheStorageCacheName != nil {
+		w.Key("cacheStorageCacheName")
+		w.String(*v.CacheStorageCacheName)
+	}
+	if v.Protocol != nil {
+		w.Key("protocol")
+		w.String(*v.Protocol)
+	}
+	if v.AlternateProtocolUsage != "" {
+		w.Key("alternateProtocolUsage")
+		w.String(string(v.AlternateProtocolUsage))
+	}
+	w.Key("securityState")
+	w.String(string(v.SecurityState))
+	if v.SecurityDetails != nil {
+		w.Key("securityDetails")
+		v.SecurityDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Response) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "status":
+			v.Status = r.Int()
+		case "statusText":
+			v.StatusText = r.String()
+		case "headers":
+			r.Decode(&v.Headers)
+		case "headersText":
+			if r.Null() {
+				v.HeadersText = nil
+			} else {
+				p := r.String()
+				v.HeadersText = &p
+			}
+		case "mimeType":
+			v.MimeType = r.String()
+		case "charset":
+			v.Charset = r.String()
+		case "requestHeaders":
+			r.Decode(&v.RequestHeaders)
+		case "requestHeadersText":
+			if r.Null() {
+				v.RequestHeadersText = nil
+			} else {
+				p := r.String()
+				v.RequestHeadersText = &p
+			}
+		case "connectionReused":
+			v.ConnectionReused = r.Bool()
+		case "connectionId":
+			v.ConnectionID = r.Float()
+		case "remoteIPAddress":
+			if r.Null() {
+				v.RemoteIPAddress = nil
+			} else {
+				p := r.String()
+				v.RemoteIPAddress = &p
+			}
+		case "remotePort":
+			if r.Null() {
+				v.RemotePort = nil
+			} else {
+				p := r.Int()
+				v.RemotePort = &p
+			}
+		case "fromDiskCache":
+			if r.Null() {
+				v.FromDiskCache = nil
+			} else {
+				p := r.Bool()
+				v.FromDiskCache = &p
+			}
+		case "fromServiceWorker":
+			if r.Null() {
+				v.FromServiceWorker = nil
+			} else {
+				p := r.Bool()
+				v.FromServiceWorker = &p
+			}
+		case "fromPrefetchCache":
+			if r.Null() {
+				v.FromPrefetchCache = nil
+			} else {
+				p := r.Bool()
+				v.FromPrefetchCache = &p
+			}
+		case "fromEarlyHints":
+			if r.Null() {
+				v.FromEarlyHints = nil
+			} else {
+				p := r.Bool()
+				v.FromEarlyHints = &p
+			}
+		case "serviceWorkerRouterInfo":
+			if r.Null() {
+				v.ServiceWorkerRouterInfo = nil
+			} else {
+				var p ServiceWorkerRouterInfo
+				p.DecodeJSON(r)
+				v.ServiceWorkerRouterInfo = &p
+			}
+		case "encodedDataLength":
+			v.EncodedDataLength = r.Float()
+		case "timing":
+			if r.Null() {
+				v.Timing = nil
+			} else {
+				var p ResourceTiming
+				p.DecodeJSON(r)
+				v.Timing = &p
+			}
+		case "serviceWorkerResponseSource":
+			v.ServiceWorkerResponseSource = ServiceWorkerResponseSource(r.String())
+		case "responseTime":
+			r.Decode(&v.ResponseTime)
+		case "cacheStorageCacheName":
+			if r.Null() {
+				v.CacheStorageCacheName = nil
+			} else {
+				p := r.String()
+				v.CacheStorageCacheName = &p
+			}
+		case "protocol":
+			if r.Null() {
+				v.Protocol = nil
+			} else {
+				p := r.String()
+				v.Protocol = &p
+			}
+		case "alternateProtocolUsage":
+			v.AlternateProtocolUsage = AlternateProtocolUsage(r.String())
+		case "securityState":
+			v.SecurityState = security.State(r.String())
+		case "securityDetails":
+			if r.Null() {
+				v.SecurityDetails = nil
+			} else {
+				var p SecurityDetails
+				p.DecodeJSON(r)
+				v.SecurityDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketRequest) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketRequest) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "headers":
+			r.Decode(&v.Headers)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketResponse) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("status")
+	w.Int(v.Status)
+	w.Key("statusText")
+	w.String(v.StatusText)
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	if v.HeadersText != nil {
+		w.Key("headersText")
+		w.String(*v.HeadersText)
+	}
+	if len(v.RequestHeaders) > 0 {
+		w.Key("requestHeaders")
+		w.Marshal(v.RequestHeaders)
+	}
+	if v.RequestHeadersText != nil {
+		w.Key("requestHeadersText")
+		w.String(*v.RequestHeadersText)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketResponse) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "status":
+			v.Status = r.Int()
+		case "statusText":
+			v.StatusText = r.String()
+		case "headers":
+			r.Decode(&v.Headers)
+		case "headersText":
+			if r.Null() {
+				v.HeadersText = nil
+			} else {
+				p := r.String()
+				v.HeadersText = &p
+			}
+		case "requestHeaders":
+			r.Decode(&v.RequestHeaders)
+		case "requestHeadersText":
+			if r.Null() {
+				v.RequestHeadersText = nil
+			} else {
+				p := r.String()
+				v.RequestHeadersText = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketFrame) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("opcode")
+	w.Float(v.Opcode)
+	w.Key("mask")
+	w.Bool(v.Mask)
+	w.Key("payloadData")
+	w.String(v.PayloadData)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketFrame) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "opcode":
+			v.Opcode = r.Float()
+		case "mask":
+			v.Mask = r.Bool()
+		case "payloadData":
+			v.PayloadData = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CachedResource) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("type")
+	w.String(string(v.Type))
+	if v.Response != nil {
+		w.Key("response")
+		v.Response.EncodeJSON(w)
+	}
+	w.Key("bodySize")
+	w.Float(v.BodySize)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CachedResource) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "type":
+			v.Type = ResourceType(r.String())
+		case "response":
+			if r.Null() {
+				v.Response = nil
+			} else {
+				var p Response
+				p.DecodeJSON(r)
+				v.Response = &p
+			}
+		case "bodySize":
+			v.BodySize = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Initiator) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.Stack != nil {
+		w.Key("stack")
+		v.Stack.EncodeJSON(w)
+	}
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.LineNumber != nil {
+		w.Key("lineNumber")
+		w.Float(*v.LineNumber)
+	}
+	if v.ColumnNumber != nil {
+		w.Key("columnNumber")
+		w.Float(*v.ColumnNumber)
+	}
+	if v.RequestID != nil {
+		w.Key("requestId")
+		w.String(string(*v.RequestID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Initiator) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "stack":
+			if r.Null() {
+				v.Stack = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.Stack = &p
+			}
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "lineNumber":
+			if r.Null() {
+				v.LineNumber = nil
+			} else {
+				p := r.Float()
+				v.LineNumber = &p
+			}
+		case "columnNumber":
+			if r.Null() {
+				v.ColumnNumber = nil
+			} else {
+				p := r.Float()
+				v.ColumnNumber = &p
+			}
+		case "requestId":
+			if r.Null() {
+				v.RequestID = nil
+			} else {
+				p := RequestID(r.String())
+				v.RequestID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CookiePartitionKey) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("topLevelSite")
+	w.String(v.TopLevelSite)
+	w.Key("hasCrossSiteAncestor")
+	w.Bool(v.HasCrossSiteAncestor)
+	w.ObjectEnd()
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Cookie) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.Key("domain")
+	w.String(v.Domain)
+	w.Key("path")
+	w.String(v.Path)
+	w.Key("expires")
+	w.Float(v.Expires)
+	w.Key("size")
+	w.Int(v.Size)
+	w.Key("httpOnly")
+	w.Bool(v.HTTPOnly)
+	w.Key("secure")
+	w.Bool(v.Secure)
+	w.Key("session")
+	w.Bool(v.Session)
+	if v.SameSite != "" {
+		w.Key("sameSite")
+		w.String(string(v.SameSite))
+	}
+	w.Key("priority")
+	w.String(string(v.Priority))
+	w.Key("sameParty")
+	w.Bool(v.SameParty)
+	w.Key("sourceScheme")
+	w.String(string(v.SourceScheme))
+	w.Key("sourcePort")
+	w.Int(v.SourcePort)
+	if v.PartitionKey != nil {
+		w.Key("partitionKey")
+		v.PartitionKey.EncodeJSON(w)
+	}
+	if v.PartitionKeyOpaque != nil {
+		w.Key("partitionKeyOpaque")
+		w.Bool(*v.PartitionKeyOpaque)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Cookie) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		case "domain":
+			v.Domain = r.String()
+		case "path":
+			v.Path = r.String()
+		case "expires":
+			v.Expires = r.Float()
+		case "size":
+			v.Size = r.Int()
+		case "httpOnly":
+			v.HTTPOnly = r.Bool()
+		case "secure":
+			v.Secure = r.Bool()
+		case "session":
+			v.Session = r.Bool()
+		case "sameSite":
+			v.SameSite = CookieSameSite(r.String())
+		case "priority":
+			v.Priority = CookiePriority(r.String())
+		case "sameParty":
+			v.SameParty = r.Bool()
+		case "sourceScheme":
+			v.SourceScheme = CookieSourceScheme(r.String())
+		case "sourcePort":
+			v.SourcePort = r.Int()
+		case "partitionKey":
+			if r.Null() {
+				v.PartitionKey = nil
+			} else {
+				var p CookiePartitionKey
+				r.Decode(&p)
+				v.PartitionKey = &p
+			}
+		case "partitionKeyOpaque":
+			if r.Null() {
+				v.PartitionKeyOpaque = nil
+			} else {
+				p := r.Bool()
+				v.PartitionKeyOpaque = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BlockedSetCookieWithReason) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("blockedReasons")
+	if v.BlockedReasons == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.BlockedReasons {
+			w.String(string(v.BlockedReasons[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.Key("cookieLine")
+	w.String(v.CookieLine)
+	if v.Cookie != nil {
+		w.Key("cookie")
+		v.Cookie.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BlockedSetCookieWithReason) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "blockedReasons":
+			if r.ArrayStart() {
+				v.BlockedReasons = make([]SetCookieBlockedReason, 0, 4)
+				for r.ArrayNext() {
+					e0 := SetCookieBlockedReason(r.String())
+					v.BlockedReasons = append(v.BlockedReasons, e0)
+				}
+			} else {
+				v.BlockedReasons = nil
+			}
+		case "cookieLine":
+			v.CookieLine = r.String()
+		case "cookie":
+			if r.Null() {
+				v.Cookie = nil
+			} else {
+				var p Cookie
+				p.DecodeJSON(r)
+				v.Cookie = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExemptedSetCookieWithReason) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("exemptionReason")
+	w.String(string(v.ExemptionReason))
+	w.Key("cookieLine")
+	w.String(v.CookieLine)
+	w.Key("cookie")
+	v.Cookie.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExemptedSetCookieWithReason) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "exemptionReason":
+			v.ExemptionReason = CookieExemptionReason(r.String())
+		case "cookieLine":
+			v.CookieLine = r.String()
+		case "cookie":
+			v.Cookie.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AssociatedCookie) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cookie")
+	v.Cookie.EncodeJSON(w)
+	w.Key("blockedReasons")
+	if v.BlockedReasons == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.BlockedReasons {
+			w.String(string(v.BlockedReasons[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if v.ExemptionReason != "" {
+		w.Key("exemptionReason")
+		w.String(string(v.ExemptionReason))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AssociatedCookie) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookie":
+			v.Cookie.DecodeJSON(r)
+		case "blockedReasons":
+			if r.ArrayStart() {
+				v.BlockedReasons = make([]CookieBlockedReason, 0, 4)
+				for r.ArrayNext() {
+					e0 := CookieBlockedReason(r.String())
+					v.BlockedReasons = append(v.BlockedReasons, e0)
+				}
+			} else {
+				v.BlockedReasons = nil
+			}
+		case "exemptionReason":
+			v.ExemptionReason = CookieExemptionReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CookieParam) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.Domain != nil {
+		w.Key("domain")
+		w.String(*v.Domain)
+	}
+	if v.Path != nil {
+		w.Key("path")
+		w.String(*v.Path)
+	}
+	if v.Secure != nil {
+		w.Key("secure")
+		w.Bool(*v.Secure)
+	}
+	if v.HTTPOnly != nil {
+		w.Key("httpOnly")
+		w.Bool(*v.HTTPOnly)
+	}
+	if v.SameSite != "" {
+		w.Key("sameSite")
+		w.String(string(v.SameSite))
+	}
+	if v.Expires != 0 {
+		w.Key("expires")
+		w.Marshal(v.Expires)
+	}
+	if v.Priority != "" {
+		w.Key("priority")
+		w.String(string(v.Priority))
+	}
+	if v.SameParty != nil {
+		w.Key("sameParty")
+		w.Bool(*v.SameParty)
+	}
+	if v.SourceScheme != "" {
+		w.Key("sourceScheme")
+		w.String(string(v.SourceScheme))
+	}
+	if v.SourcePort != nil {
+		w.Key("sourcePort")
+		w.Int(*v.SourcePort)
+	}
+	if v.PartitionKey != nil {
+		w.Key("partitionKey")
+		v.PartitionKey.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CookieParam) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "domain":
+			if r.Null() {
+				v.Domain = nil
+			} else {
+				p := r.String()
+				v.Domain = &p
+			}
+		case "path":
+			if r.Null() {
+				v.Path = nil
+			} else {
+				p := r.String()
+				v.Path = &p
+			}
+		case "secure":
+			if r.Null() {
+				v.Secure = nil
+			} else {
+				p := r.Bool()
+				v.Secure = &p
+			}
+		case "httpOnly":
+			if r.Null() {
+				v.HTTPOnly = nil
+			} else {
+				p := r.Bool()
+				v.HTTPOnly = &p
+			}
+		case "sameSite":
+			v.SameSite = CookieSameSite(r.String())
+		case "expires":
+			r.Decode(&v.Expires)
+		case "priority":
+			v.Priority = CookiePriority(r.String())
+		case "sameParty":
+			if r.Null() {
+				v.SameParty = nil
+			} else {
+				p := r.Bool()
+				v.SameParty = &p
+			}
+		case "sourceScheme":
+			v.SourceScheme = CookieSourceScheme(r.String())
+		case "sourcePort":
+			if r.Null() {
+				v.SourcePort = nil
+			} else {
+				p := r.Int()
+				v.SourcePort = &p
+			}
+		case "partitionKey":
+			if r.Null() {
+				v.PartitionKey = nil
+			} else {
+				var p CookiePartitionKey
+				r.Decode(&p)
+				v.PartitionKey = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AuthChallenge) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Source != nil {
+		w.Key("source")
+		w.String(*v.Source)
+	}
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("scheme")
+	w.String(v.Scheme)
+	w.Key("realm")
+	w.String(v.Realm)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AuthChallenge) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "source":
+			if r.Null() {
+				v.Source = nil
+			} else {
+				p := r.String()
+				v.Source = &p
+			}
+		case "origin":
+			v.Origin = r.String()
+		case "scheme":
+			v.Scheme = r.String()
+		case "realm":
+			v.Realm = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AuthChallengeResponse) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("response")
+	w.String(v.Response)
+	if v.Username != nil {
+		w.Key("username")
+		w.String(*v.Username)
+	}
+	if v.Password != nil {
+		w.Key("password")
+		w.String(*v.Password)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AuthChallengeResponse) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "response":
+			v.Response = r.String()
+		case "username":
+			if r.Null() {
+				v.Username = nil
+			} else {
+				p := r.String()
+				v.Username = &p
+			}
+		case "password":
+			if r.Null() {
+				v.Password = nil
+			} else {
+				p := r.String()
+				v.Password = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestPattern) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.URLPattern != nil {
+		w.Key("urlPattern")
+		w.String(*v.URLPattern)
+	}
+	if v.ResourceType != "" {
+		w.Key("resourceType")
+		w.String(string(v.ResourceType))
+	}
+	if v.InterceptionStage != "" {
+		w.Key("interceptionStage")
+		w.String(string(v.InterceptionStage))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestPattern) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "urlPattern":
+			if r.Null() {
+				v.URLPattern = nil
+			} else {
+				p := r.String()
+				v.URLPattern = &p
+			}
+		case "resourceType":
+			v.ResourceType = ResourceType(r.String())
+		case "interceptionStage":
+			v.InterceptionStage = InterceptionStage(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SignedExchangeSignature) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("label")
+	w.String(v.Label)
+	w.Key("signature")
+	w.String(v.Signature)
+	w.Key("integrity")
+	w.String(v.Integrity)
+	if v.CertURL != nil {
+		w.Key("certUrl")
+		w.String(*v.CertURL)
+	}
+	if v.CertSha256 != nil {
+		w.Key("certSha256")
+		w.String(*v.CertSha256)
+	}
+	w.Key("validityUrl")
+	w.String(v.ValidityURL)
+	w.Key("date")
+	w.Int(v.Date)
+	w.Key("expires")
+	w.Int(v.Expires)
+	if len(v.Certificates) > 0 {
+		w.Key("certificates")
+		w.ArrayStart()
+		for i0 := range v.Certificates {
+			w.String(v.Certificates[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SignedExchangeSignature) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "label":
+			v.Label = r.String()
+		case "signature":
+			v.Signature = r.String()
+		case "integrity":
+			v.Integrity = r.String()
+		case "certUrl":
+			if r.Null() {
+				v.CertURL = nil
+			} else {
+				p := r.String()
+				v.CertURL = &p
+			}
+		case "certSha256":
+			if r.Null() {
+				v.CertSha256 = nil
+			} else {
+				p := r.String()
+				v.CertSha256 = &p
+			}
+		case "validityUrl":
+			v.ValidityURL = r.String()
+		case "date":
+			v.Date = r.Int()
+		case "expires":
+			v.Expires = r.Int()
+		case "certificates":
+			if r.ArrayStart() {
+				v.Certificates = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Certificates = append(v.Certificates, e0)
+				}
+			} else {
+				v.Certificates = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SignedExchangeHeader) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestUrl")
+	w.String(v.RequestURL)
+	w.Key("responseCode")
+	w.Int(v.ResponseCode)
+	w.Key("responseHeaders")
+	w.Marshal(v.ResponseHeaders)
+	w.Key("signatures")
+	if v.Signatures == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Signatures {
+			v.Signatures[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("headerIntegrity")
+	w.String(v.HeaderIntegrity)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SignedExchangeHeader) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestUrl":
+			v.RequestURL = r.String()
+		case "responseCode":
+			v.ResponseCode = r.Int()
+		case "responseHeaders":
+			r.Decode(&v.ResponseHeaders)
+		case "signatures":
+			if r.ArrayStart() {
+				v.Signatures = make([]SignedExchangeSignature, 0, 4)
+				for r.ArrayNext() {
+					var e0 SignedExchangeSignature
+					e0.DecodeJSON(r)
+					v.Signatures = append(v.Signatures, e0)
+				}
+			} else {
+				v.Signatures = nil
+			}
+		case "headerIntegrity":
+			v.HeaderIntegrity = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SignedExchangeError) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("message")
+	w.String(v.Message)
+	if v.SignatureIndex != nil {
+		w.Key("signatureIndex")
+		w.Int(*v.SignatureIndex)
+	}
+	if v.ErrorField != "" {
+		w.Key("errorField")
+		w.String(string(v.ErrorField))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SignedExchangeError) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "message":
+			v.Message = r.String()
+		case "signatureIndex":
+			if r.Null() {
+				v.SignatureIndex = nil
+			} else {
+				p := r.Int()
+				v.SignatureIndex = &p
+			}
+		case "errorField":
+			v.ErrorField = SignedExchangeErrorField(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SignedExchangeInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("outerResponse")
+	v.OuterResponse.EncodeJSON(w)
+	w.Key("hasExtraInfo")
+	w.Bool(v.HasExtraInfo)
+	if v.Header != nil {
+		w.Key("header")
+		v.Header.EncodeJSON(w)
+	}
+	if v.SecurityDetails != nil {
+		w.Key("securityDetails")
+		v.SecurityDetails.EncodeJSON(w)
+	}
+	if len(v.Errors) > 0 {
+		w.Key("errors")
+		w.ArrayStart()
+		for i0 := range v.Errors {
+			v.Errors[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SignedExchangeInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "outerResponse":
+			v.OuterResponse.DecodeJSON(r)
+		case "hasExtraInfo":
+			v.HasExtraInfo = r.Bool()
+		case "header":
+			if r.Null() {
+				v.Header = nil
+			} else {
+				var p SignedExchangeHeader
+				p.DecodeJSON(r)
+				v.Header = &p
+			}
+		case "securityDetails":
+			if r.Null() {
+				v.SecurityDetails = nil
+			} else {
+				var p SecurityDetails
+				p.DecodeJSON(r)
+				v.SecurityDetails = &p
+			}
+		case "errors":
+			if r.ArrayStart() {
+				v.Errors = make([]SignedExchangeError, 0, 4)
+				for r.ArrayNext() {
+					var e0 SignedExchangeError
+					e0.DecodeJSON(r)
+					v.Errors = append(v.Errors, e0)
+				}
+			} else {
+				v.Errors = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Conditions) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("urlPattern")
+	w.String(v.URLPattern)
+	w.Key("latency")
+	w.Float(v.Latency)
+	w.Key("downloadThroughput")
+	w.Float(v.DownloadThroughput)
+	w.Key("uploadThroughput")
+	w.Float(v.UploadThroughput)
+	if v.ConnectionType != "" {
+		w.Key("connectionType")
+		w.String(string(v.ConnectionType))
+	}
+	if v.PacketLoss != nil {
+		w.Key("packetLoss")
+		w.Float(*v.PacketLoss)
+	}
+	if v.PacketQueueLength != nil {
+		w.Key("packetQueueLength")
+		w.Int(*v.PacketQueueLength)
+	}
+	if v.PacketReordering != nil {
+		w.Key("packetReordering")
+		w.Bool(*v.PacketReordering)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Conditions) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "urlPattern":
+			v.URLPattern = r.String()
+		case "latency":
+			v.Latency = r.Float()
+		case "downloadThroughput":
+			v.DownloadThroughput = r.Float()
+		case "uploadThroughput":
+			v.UploadThroughput = r.Float()
+		case "connectionType":
+			v.ConnectionType = ConnectionType(r.String())
+		case "packetLoss":
+			if r.Null() {
+				v.PacketLoss = nil
+			} else {
+				p := r.Float()
+				v.PacketLoss = &p
+			}
+		case "packetQueueLength":
+			if r.Null() {
+				v.PacketQueueLength = nil
+			} else {
+				p := r.Int()
+				v.PacketQueueLength = &p
+			}
+		case "packetReordering":
+			if r.Null() {
+				v.PacketReordering = nil
+			} else {
+				p := r.Bool()
+				v.PacketReordering = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BlockPattern) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("urlPattern")
+	w.String(v.URLPattern)
+	w.Key("block")
+	w.Bool(v.Block)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BlockPattern) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "urlPattern":
+			v.URLPattern = r.String()
+		case "block":
+			v.Block = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketOptions) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("noDelay")
+	w.Bool(v.NoDelay)
+	if v.KeepAliveDelay != nil {
+		w.Key("keepAliveDelay")
+		w.Float(*v.KeepAliveDelay)
+	}
+	if v.SendBufferSize != nil {
+		w.Key("sendBufferSize")
+		w.Float(*v.SendBufferSize)
+	}
+	if v.ReceiveBufferSize != nil {
+		w.Key("receiveBufferSize")
+		w.Float(*v.ReceiveBufferSize)
+	}
+	if v.DNSQueryType != "" {
+		w.Key("dnsQueryType")
+		w.String(string(v.DNSQueryType))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketOptions) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "noDelay":
+			v.NoDelay = r.Bool()
+		case "keepAliveDelay":
+			if r.Null() {
+				v.KeepAliveDelay = nil
+			} else {
+				p := r.Float()
+				v.KeepAliveDelay = &p
+			}
+		case "sendBufferSize":
+			if r.Null() {
+				v.SendBufferSize = nil
+			} else {
+				p := r.Float()
+				v.SendBufferSize = &p
+			}
+		case "receiveBufferSize":
+			if r.Null() {
+				v.ReceiveBufferSize = nil
+			} else {
+				p := r.Float()
+				v.ReceiveBufferSize = &p
+			}
+		case "dnsQueryType":
+			v.DNSQueryType = DirectSocketDNSQueryType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketOptions) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.RemoteAddr != nil {
+		w.Key("remoteAddr")
+		w.String(*v.RemoteAddr)
+	}
+	if v.RemotePort != nil {
+		w.Key("remotePort")
+		w.Int(*v.RemotePort)
+	}
+	if v.LocalAddr != nil {
+		w.Key("localAddr")
+		w.String(*v.LocalAddr)
+	}
+	if v.LocalPort != nil {
+		w.Key("localPort")
+		w.Int(*v.LocalPort)
+	}
+	if v.DNSQueryType != "" {
+		w.Key("dnsQueryType")
+		w.String(string(v.DNSQueryType))
+	}
+	if v.SendBufferSize != nil {
+		w.Key("sendBufferSize")
+		w.Float(*v.SendBufferSize)
+	}
+	if v.ReceiveBufferSize != nil {
+		w.Key("receiveBufferSize")
+		w.Float(*v.ReceiveBufferSize)
+	}
+	if v.MulticastLoopback != nil {
+		w.Key("multicastLoopback")
+		w.Bool(*v.MulticastLoopback)
+	}
+	if v.MulticastTimeToLive != nil {
+		w.Key("multicastTimeToLive")
+		w.Int(*v.MulticastTimeToLive)
+	}
+	if v.MulticastAllowAddressSharing != nil {
+		w.Key("multicastAllowAddressSharing")
+		w.Bool(*v.MulticastAllowAddressSharing)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketOptions) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "remoteAddr":
+			if r.Null() {
+				v.RemoteAddr = nil
+			} else {
+				p := r.String()
+				v.RemoteAddr = &p
+			}
+		case "remotePort":
+			if r.Null() {
+				v.RemotePort = nil
+			} else {
+				p := r.Int()
+				v.RemotePort = &p
+			}
+		case "localAddr":
+			if r.Null() {
+				v.LocalAddr = nil
+			} else {
+				p := r.String()
+				v.LocalAddr = &p
+			}
+		case "localPort":
+			if r.Null() {
+				v.LocalPort = nil
+			} else {
+				p := r.Int()
+				v.LocalPort = &p
+			}
+		case "dnsQueryType":
+			v.DNSQueryType = DirectSocketDNSQueryType(r.String())
+		case "sendBufferSize":
+			if r.Null() {
+				v.SendBufferSize = nil
+			} else {
+				p := r.Float()
+				v.SendBufferSize = &p
+			}
+		case "receiveBufferSize":
+			if r.Null() {
+				v.ReceiveBufferSize = nil
+			} else {
+				p := r.Float()
+				v.ReceiveBufferSize = &p
+			}
+		case "multicastLoopback":
+			if r.Null() {
+				v.MulticastLoopback = nil
+			} else {
+				p := r.Bool()
+				v.MulticastLoopback = &p
+			}
+		case "multicastTimeToLive":
+			if r.Null() {
+				v.MulticastTimeToLive = nil
+			} else {
+				p := r.Int()
+				v.MulticastTimeToLive = &p
+			}
+		case "multicastAllowAddressSharing":
+			if r.Null() {
+				v.MulticastAllowAddressSharing = nil
+			} else {
+				p := r.Bool()
+				v.MulticastAllowAddressSharing = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPMessage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.String(v.Data)
+	if v.RemoteAddr != nil {
+		w.Key("remoteAddr")
+		w.String(*v.RemoteAddr)
+	}
+	if v.RemotePort != nil {
+		w.Key("remotePort")
+		w.Int(*v.RemotePort)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPMessage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = r.String()
+		case "remoteAddr":
+			if r.Null() {
+				v.RemoteAddr = nil
+			} else {
+				p := r.String()
+				v.RemoteAddr = &p
+			}
+		case "remotePort":
+			if r.Null() {
+				v.RemotePort = nil
+			} else {
+				p := r.Int()
+				v.RemotePort = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ConnectTiming) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestTime")
+	w.Float(v.RequestTime)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ConnectTiming) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestTime":
+			v.RequestTime = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClientSecurityState) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("initiatorIsSecureContext")
+	w.Bool(v.InitiatorIsSecureContext)
+	w.Key("initiatorIPAddressSpace")
+	w.String(string(v.InitiatorIPAddressSpace))
+	w.Key("privateNetworkRequestPolicy")
+	w.String(string(v.PrivateNetworkRequestPolicy))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClientSecurityState) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "initiatorIsSecureContext":
+			v.InitiatorIsSecureContext = r.Bool()
+		case "initiatorIPAddressSpace":
+			v.InitiatorIPAddressSpace = IPAddressSpace(r.String())
+		case "privateNetworkRequestPolicy":
+			v.PrivateNetworkRequestPolicy = PrivateNetworkRequestPolicy(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CrossOriginOpenerPolicyStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.String(string(v.Value))
+	w.Key("reportOnlyValue")
+	w.String(string(v.ReportOnlyValue))
+	if v.ReportingEndpoint != nil {
+		w.Key("reportingEndpoint")
+		w.String(*v.ReportingEndpoint)
+	}
+	if v.ReportOnlyReportingEndpoint != nil {
+		w.Key("reportOnlyReportingEndpoint")
+		w.String(*v.ReportOnlyReportingEndpoint)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CrossOriginOpenerPolicyStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = CrossOriginOpenerPolicyValue(r.String())
+		case "reportOnlyValue":
+			v.ReportOnlyValue = CrossOriginOpenerPolicyValue(r.String())
+		case "reportingEndpoint":
+			if r.Null() {
+				v.ReportingEndpoint = nil
+			} else {
+				p := r.String()
+				v.ReportingEndpoint = &p
+			}
+		case "reportOnlyReportingEndpoint":
+			if r.Null() {
+				v.ReportOnlyReportingEndpoint = nil
+			} else {
+				p := r.String()
+				v.ReportOnlyReportingEndpoint = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CrossOriginEmbedderPolicyStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.String(string(v.Value))
+	w.Key("reportOnlyValue")
+	w.String(string(v.ReportOnlyValue))
+	if v.ReportingEndpoint != nil {
+		w.Key("reportingEndpoint")
+		w.String(*v.ReportingEndpoint)
+	}
+	if v.ReportOnlyReportingEndpoint != nil {
+		w.Key("reportOnlyReportingEndpoint")
+		w.String(*v.ReportOnlyReportingEndpoint)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CrossOriginEmbedderPolicyStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = CrossOriginEmbedderPolicyValue(r.String())
+		case "reportOnlyValue":
+			v.ReportOnlyValue = CrossOriginEmbedderPolicyValue(r.String())
+		case "reportingEndpoint":
+			if r.Null() {
+				v.ReportingEndpoint = nil
+			} else {
+				p := r.String()
+				v.ReportingEndpoint = &p
+			}
+		case "reportOnlyReportingEndpoint":
+			if r.Null() {
+				v.ReportOnlyReportingEndpoint = nil
+			} else {
+				p := r.String()
+				v.ReportOnlyReportingEndpoint = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContentSecurityPolicyStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("effectiveDirectives")
+	w.String(v.EffectiveDirectives)
+	w.Key("isEnforced")
+	w.Bool(v.IsEnforced)
+	w.Key("source")
+	w.String(string(v.Source))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContentSecurityPolicyStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "effectiveDirectives":
+			v.EffectiveDirectives = r.String()
+		case "isEnforced":
+			v.IsEnforced = r.Bool()
+		case "source":
+			v.Source = ContentSecurityPolicySource(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SecurityIsolationStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.COOP != nil {
+		w.Key("coop")
+		v.COOP.EncodeJSON(w)
+	}
+	if v.COEP != nil {
+		w.Key("coep")
+		v.COEP.EncodeJSON(w)
+	}
+	if len(v.CSP) > 0 {
+		w.Key("csp")
+		w.ArrayStart()
+		for i0 := range v.CSP {
+			v.CSP[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SecurityIsolationStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "coop":
+			if r.Null() {
+				v.COOP = nil
+			} else {
+				var p CrossOriginOpenerPolicyStatus
+				p.DecodeJSON(r)
+				v.COOP = &p
+			}
+		case "coep":
+			if r.Null() {
+				v.COEP = nil
+			} else {
+				var p CrossOriginEmbedderPolicyStatus
+				p.DecodeJSON(r)
+				v.COEP = &p
+			}
+		case "csp":
+			if r.ArrayStart() {
+				v.CSP = make([]ContentSecurityPolicyStatus, 0, 4)
+				for r.ArrayNext() {
+					var e0 ContentSecurityPolicyStatus
+					e0.DecodeJSON(r)
+					v.CSP = append(v.CSP, e0)
+				}
+			} else {
+				v.CSP = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReportingAPIReport) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(string(v.ID))
+	w.Key("initiatorUrl")
+	w.String(v.InitiatorURL)
+	w.Key("destination")
+	w.String(v.Destination)
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("depth")
+	w.Int(v.Depth)
+	w.Key("completedAttempts")
+	w.Int(v.CompletedAttempts)
+	w.Key("body")
+	w.Raw(v.Body)
+	w.Key("status")
+	w.String(string(v.Status))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReportingAPIReport) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = ReportID(r.String())
+		case "initiatorUrl":
+			v.InitiatorURL = r.String()
+		case "destination":
+			v.Destination = r.String()
+		case "type":
+			v.Type = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "depth":
+			v.Depth = r.Int()
+		case "completedAttempts":
+			v.CompletedAttempts = r.Int()
+		case "body":
+			v.Body = r.Raw()
+		case "status":
+			v.Status = ReportStatus(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReportingAPIEndpoint) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("groupName")
+	w.String(v.GroupName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReportingAPIEndpoint) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "groupName":
+			v.GroupName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadNetworkResourcePageResult) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("success")
+	w.Bool(v.Success)
+	if v.NetError != nil {
+		w.Key("netError")
+		w.Float(*v.NetError)
+	}
+	if v.NetErrorName != nil {
+		w.Key("netErrorName")
+		w.String(*v.NetErrorName)
+	}
+	if v.HTTPStatusCode != nil {
+		w.Key("httpStatusCode")
+		w.Float(*v.HTTPStatusCode)
+	}
+	if v.Stream != nil {
+		w.Key("stream")
+		w.String(string(*v.Stream))
+	}
+	if len(v.Headers) > 0 {
+		w.Key("headers")
+		w.Marshal(v.Headers)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadNetworkResourcePageResult) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "success":
+			v.Success = r.Bool()
+		case "netError":
+			if r.Null() {
+				v.NetError = nil
+			} else {
+				p := r.Float()
+				v.NetError = &p
+			}
+		case "netErrorName":
+			if r.Null() {
+				v.NetErrorName = nil
+			} else {
+				p := r.String()
+				v.NetErrorName = &p
+			}
+		case "httpStatusCode":
+			if r.Null() {
+				v.HTTPStatusCode = nil
+			} else {
+				p := r.Float()
+				v.HTTPStatusCode = &p
+			}
+		case "stream":
+			if r.Null() {
+				v.Stream = nil
+			} else {
+				p := io.StreamHandle(r.String())
+				v.Stream = &p
+			}
+		case "headers":
+			r.Decode(&v.Headers)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadNetworkResourceOptions) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("disableCache")
+	w.Bool(v.DisableCache)
+	w.Key("includeCredentials")
+	w.Bool(v.IncludeCredentials)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadNetworkResourceOptions) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "disableCache":
+			v.DisableCache = r.Bool()
+		case "includeCredentials":
+			v.IncludeCredentials = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAcceptedEncodingsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("encodings")
+	if v.Encodings == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Encodings {
+			w.String(string(v.Encodings[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAcceptedEncodingsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "encodings":
+			if r.ArrayStart() {
+				v.Encodings = make([]ContentEncoding, 0, 4)
+				for r.ArrayNext() {
+					e0 := ContentEncoding(r.String())
+					v.Encodings = append(v.Encodings, e0)
+				}
+			} else {
+				v.Encodings = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanClearBrowserCacheReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanClearBrowserCacheReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanClearBrowserCookiesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanClearBrowserCookiesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanEmulateNetworkConditionsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanEmulateNetworkConditionsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContinueInterceptedRequestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("interceptionId")
+	w.String(string(v.InterceptionID))
+	if v.ErrorReason != "" {
+		w.Key("errorReason")
+		w.String(string(v.ErrorReason))
+	}
+	if len(v.RawResponse) > 0 {
+		w.Key("rawResponse")
+		w.Base64(v.RawResponse)
+	}
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.Method != nil {
+		w.Key("method")
+		w.String(*v.Method)
+	}
+	if v.PostData != nil {
+		w.Key("postData")
+		w.String(*v.PostData)
+	}
+	if len(v.Headers) > 0 {
+		w.Key("headers")
+		w.Marshal(v.Headers)
+	}
+	if v.AuthChallengeResponse != nil {
+		w.Key("authChallengeResponse")
+		v.AuthChallengeResponse.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContinueInterceptedRequestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "interceptionId":
+			v.InterceptionID = InterceptionID(r.String())
+		case "errorReason":
+			v.ErrorReason = ErrorReason(r.String())
+		case "rawResponse":
+			v.RawResponse = r.Base64()
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "method":
+			if r.Null() {
+				v.Method = nil
+			} else {
+				p := r.String()
+				v.Method = &p
+			}
+		case "postData":
+			if r.Null() {
+				v.PostData = nil
+			} else {
+				p := r.String()
+				v.PostData = &p
+			}
+		case "headers":
+			r.Decode(&v.Headers)
+		case "authChallengeResponse":
+			if r.Null() {
+				v.AuthChallengeResponse = nil
+			} else {
+				var p AuthChallengeResponse
+				p.DecodeJSON(r)
+				v.AuthChallengeResponse = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteCookiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.Domain != nil {
+		w.Key("domain")
+		w.String(*v.Domain)
+	}
+	if v.Path != nil {
+		w.Key("path")
+		w.String(*v.Path)
+	}
+	if v.PartitionKey != nil {
+		w.Key("partitionKey")
+		v.PartitionKey.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteCookiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "domain":
+			if r.Null() {
+				v.Domain = nil
+			} else {
+				p := r.String()
+				v.Domain = &p
+			}
+		case "path":
+			if r.Null() {
+				v.Path = nil
+			} else {
+				p := r.String()
+				v.Path = &p
+			}
+		case "partitionKey":
+			if r.Null() {
+				v.PartitionKey = nil
+			} else {
+				var p CookiePartitionKey
+				r.Decode(&p)
+				v.PartitionKey = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EmulateNetworkConditionsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("offline")
+	w.Bool(v.Offline)
+	w.Key("latency")
+	w.Float(v.Latency)
+	w.Key("downloadThroughput")
+	w.Float(v.DownloadThroughput)
+	w.Key("uploadThroughput")
+	w.Float(v.UploadThroughput)
+	if v.ConnectionType != "" {
+		w.Key("connectionType")
+		w.String(string(v.ConnectionType))
+	}
+	if v.PacketLoss != nil {
+		w.Key("packetLoss")
+		w.Float(*v.PacketLoss)
+	}
+	if v.PacketQueueLength != nil {
+		w.Key("packetQueueLength")
+		w.Int(*v.PacketQueueLength)
+	}
+	if v.PacketReordering != nil {
+		w.Key("packetReordering")
+		w.Bool(*v.PacketReordering)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EmulateNetworkConditionsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "offline":
+			v.Offline = r.Bool()
+		case "latency":
+			v.Latency = r.Float()
+		case "downloadThroughput":
+			v.DownloadThroughput = r.Float()
+		case "uploadThroughput":
+			v.UploadThroughput = r.Float()
+		case "connectionType":
+			v.ConnectionType = ConnectionType(r.String())
+		case "packetLoss":
+			if r.Null() {
+				v.PacketLoss = nil
+			} else {
+				p := r.Float()
+				v.PacketLoss = &p
+			}
+		case "packetQueueLength":
+			if r.Null() {
+				v.PacketQueueLength = nil
+			} else {
+				p := r.Int()
+				v.PacketQueueLength = &p
+			}
+		case "packetReordering":
+			if r.Null() {
+				v.PacketReordering = nil
+			} else {
+				p := r.Bool()
+				v.PacketReordering = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EmulateNetworkConditionsByRuleArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("offline")
+	w.Bool(v.Offline)
+	w.Key("matchedNetworkConditions")
+	if v.MatchedNetworkConditions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.MatchedNetworkConditions {
+			v.MatchedNetworkConditions[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EmulateNetworkConditionsByRuleArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "offline":
+			v.Offline = r.Bool()
+		case "matchedNetworkConditions":
+			if r.ArrayStart() {
+				v.MatchedNetworkConditions = make([]Conditions, 0, 4)
+				for r.ArrayNext() {
+					var e0 Conditions
+					e0.DecodeJSON(r)
+					v.MatchedNetworkConditions = append(v.MatchedNetworkConditions, e0)
+				}
+			} else {
+				v.MatchedNetworkConditions = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EmulateNetworkConditionsByRuleReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ruleIds")
+	if v.RuleIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.RuleIDs {
+			w.String(v.RuleIDs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EmulateNetworkConditionsByRuleReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ruleIds":
+			if r.ArrayStart() {
+				v.RuleIDs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.RuleIDs = append(v.RuleIDs, e0)
+				}
+			} else {
+				v.RuleIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OverrideNetworkStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("offline")
+	w.Bool(v.Offline)
+	w.Key("latency")
+	w.Float(v.Latency)
+	w.Key("downloadThroughput")
+	w.Float(v.DownloadThroughput)
+	w.Key("uploadThroughput")
+	w.Float(v.UploadThroughput)
+	if v.ConnectionType != "" {
+		w.Key("connectionType")
+		w.String(string(v.ConnectionType))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OverrideNetworkStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "offline":
+			v.Offline = r.Bool()
+		case "latency":
+			v.Latency = r.Float()
+		case "downloadThroughput":
+			v.DownloadThroughput = r.Float()
+		case "uploadThroughput":
+			v.UploadThroughput = r.Float()
+		case "connectionType":
+			v.ConnectionType = ConnectionType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.MaxTotalBufferSize != nil {
+		w.Key("maxTotalBufferSize")
+		w.Int(*v.MaxTotalBufferSize)
+	}
+	if v.MaxResourceBufferSize != nil {
+		w.Key("maxResourceBufferSize")
+		w.Int(*v.MaxResourceBufferSize)
+	}
+	if v.MaxPostDataSize != nil {
+		w.Key("maxPostDataSize")
+		w.Int(*v.MaxPostDataSize)
+	}
+	if v.ReportDirectSocketTraffic != nil {
+		w.Key("reportDirectSocketTraffic")
+		w.Bool(*v.ReportDirectSocketTraffic)
+	}
+	if v.EnableDurableMessages != nil {
+		w.Key("enableDurableMessages")
+		w.Bool(*v.EnableDurableMessages)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "maxTotalBufferSize":
+			if r.Null() {
+				v.MaxTotalBufferSize = nil
+			} else {
+				p := r.Int()
+				v.MaxTotalBufferSize = &p
+			}
+		case "maxResourceBufferSize":
+			if r.Null() {
+				v.MaxResourceBufferSize = nil
+			} else {
+				p := r.Int()
+				v.MaxResourceBufferSize = &p
+			}
+		case "maxPostDataSize":
+			if r.Null() {
+				v.MaxPostDataSize = nil
+			} else {
+				p := r.Int()
+				v.MaxPostDataSize = &p
+			}
+		case "reportDirectSocketTraffic":
+			if r.Null() {
+				v.ReportDirectSocketTraffic = nil
+			} else {
+				p := r.Bool()
+				v.ReportDirectSocketTraffic = &p
+			}
+		case "enableDurableMessages":
+			if r.Null() {
+				v.EnableDurableMessages = nil
+			} else {
+				p := r.Bool()
+				v.EnableDurableMessages = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAllCookiesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cookies")
+	if v.Cookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Cookies {
+			v.Cookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAllCookiesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookies":
+			if r.ArrayStart() {
+				v.Cookies = make([]Cookie, 0, 4)
+				for r.ArrayNext() {
+					var e0 Cookie
+					e0.DecodeJSON(r)
+					v.Cookies = append(v.Cookies, e0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCertificateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCertificateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCertificateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("tableNames")
+	if v.TableNames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TableNames {
+			w.String(v.TableNames[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCertificateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "tableNames":
+			if r.ArrayStart() {
+				v.TableNames = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.TableNames = append(v.TableNames, e0)
+				}
+			} else {
+				v.TableNames = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCookiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.URLs) > 0 {
+		w.Key("urls")
+		w.ArrayStart()
+		for i0 := range v.URLs {
+			w.String(v.URLs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCookiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "urls":
+			if r.ArrayStart() {
+				v.URLs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.URLs = append(v.URLs, e0)
+				}
+			} else {
+				v.URLs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCookiesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cookies")
+	if v.Cookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Cookies {
+			v.Cookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCookiesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookies":
+			if r.ArrayStart() {
+				v.Cookies = make([]Cookie, 0, 4)
+				for r.ArrayNext() {
+					var e0 Cookie
+					e0.DecodeJSON(r)
+					v.Cookies = append(v.Cookies, e0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResponseBodyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResponseBodyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResponseBodyReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("body")
+	w.String(v.Body)
+	w.Key("base64Encoded")
+	w.Bool(v.Base64Encoded)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResponseBodyReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "body":
+			v.Body = r.String()
+		case "base64Encoded":
+			v.Base64Encoded = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRequestPostDataArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRequestPostDataArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRequestPostDataReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("postData")
+	w.String(v.PostData)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRequestPostDataReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "postData":
+			v.PostData = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResponseBodyForInterceptionArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("interceptionId")
+	w.String(string(v.InterceptionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResponseBodyForInterceptionArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "interceptionId":
+			v.InterceptionID = InterceptionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResponseBodyForInterceptionReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("body")
+	w.String(v.Body)
+	w.Key("base64Encoded")
+	w.Bool(v.Base64Encoded)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResponseBodyForInterceptionReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "body":
+			v.Body = r.String()
+		case "base64Encoded":
+			v.Base64Encoded = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeResponseBodyForInterceptionAsStreamArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("interceptionId")
+	w.String(string(v.InterceptionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeResponseBodyForInterceptionAsStreamArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "interceptionId":
+			v.InterceptionID = InterceptionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeResponseBodyForInterceptionAsStreamReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("stream")
+	w.String(string(v.Stream))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeResponseBodyForInterceptionAsStreamReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "stream":
+			v.Stream = io.StreamHandle(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReplayXHRArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReplayXHRArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchInResponseBodyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("query")
+	w.String(v.Query)
+	if v.CaseSensitive != nil {
+		w.Key("caseSensitive")
+		w.Bool(*v.CaseSensitive)
+	}
+	if v.IsRegex != nil {
+		w.Key("isRegex")
+		w.Bool(*v.IsRegex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchInResponseBodyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "query":
+			v.Query = r.String()
+		case "caseSensitive":
+			if r.Null() {
+				v.CaseSensitive = nil
+			} else {
+				p := r.Bool()
+				v.CaseSensitive = &p
+			}
+		case "isRegex":
+			if r.Null() {
+				v.IsRegex = nil
+			} else {
+				p := r.Bool()
+				v.IsRegex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchInResponseBodyReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchInResponseBodyReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]debugger.SearchMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 debugger.SearchMatch
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBlockedURLsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.URLPatterns) > 0 {
+		w.Key("urlPatterns")
+		w.ArrayStart()
+		for i0 := range v.URLPatterns {
+			v.URLPatterns[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.URLs) > 0 {
+		w.Key("urls")
+		w.ArrayStart()
+		for i0 := range v.URLs {
+			w.String(v.URLs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBlockedURLsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "urlPatterns":
+			if r.ArrayStart() {
+				v.URLPatterns = make([]BlockPattern, 0, 4)
+				for r.ArrayNext() {
+					var e0 BlockPattern
+					e0.DecodeJSON(r)
+					v.URLPatterns = append(v.URLPatterns, e0)
+				}
+			} else {
+				v.URLPatterns = nil
+			}
+		case "urls":
+			if r.ArrayStart() {
+				v.URLs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.URLs = append(v.URLs, e0)
+				}
+			} else {
+				v.URLs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBypassServiceWorkerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bypass")
+	w.Bool(v.Bypass)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBypassServiceWorkerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bypass":
+			v.Bypass = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCacheDisabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cacheDisabled")
+	w.Bool(v.CacheDisabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCacheDisabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cacheDisabled":
+			v.CacheDisabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCookieArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.Domain != nil {
+		w.Key("domain")
+		w.String(*v.Domain)
+	}
+	if v.Path != nil {
+		w.Key("path")
+		w.String(*v.Path)
+	}
+	if v.Secure != nil {
+		w.Key("secure")
+		w.Bool(*v.Secure)
+	}
+	if v.HTTPOnly != nil {
+		w.Key("httpOnly")
+		w.Bool(*v.HTTPOnly)
+	}
+	if v.SameSite != "" {
+		w.Key("sameSite")
+		w.String(string(v.SameSite))
+	}
+	if v.Expires != 0 {
+		w.Key("expires")
+		w.Marshal(v.Expires)
+	}
+	if v.Priority != "" {
+		w.Key("priority")
+		w.String(string(v.Priority))
+	}
+	if v.SameParty != nil {
+		w.Key("sameParty")
+		w.Bool(*v.SameParty)
+	}
+	if v.SourceScheme != "" {
+		w.Key("sourceScheme")
+		w.String(string(v.SourceScheme))
+	}
+	if v.SourcePort != nil {
+		w.Key("sourcePort")
+		w.Int(*v.SourcePort)
+	}
+	if v.PartitionKey != nil {
+		w.Key("partitionKey")
+		v.PartitionKey.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCookieArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "domain":
+			if r.Null() {
+				v.Domain = nil
+			} else {
+				p := r.String()
+				v.Domain = &p
+			}
+		case "path":
+			if r.Null() {
+				v.Path = nil
+			} else {
+				p := r.String()
+				v.Path = &p
+			}
+		case "secure":
+			if r.Null() {
+				v.Secure = nil
+			} else {
+				p := r.Bool()
+				v.Secure = &p
+			}
+		case "httpOnly":
+			if r.Null() {
+				v.HTTPOnly = nil
+			} else {
+				p := r.Bool()
+				v.HTTPOnly = &p
+			}
+		case "sameSite":
+			v.SameSite = CookieSameSite(r.String())
+		case "expires":
+			r.Decode(&v.Expires)
+		case "priority":
+			v.Priority = CookiePriority(r.String())
+		case "sameParty":
+			if r.Null() {
+				v.SameParty = nil
+			} else {
+				p := r.Bool()
+				v.SameParty = &p
+			}
+		case "sourceScheme":
+			v.SourceScheme = CookieSourceScheme(r.String())
+		case "sourcePort":
+			if r.Null() {
+				v.SourcePort = nil
+			} else {
+				p := r.Int()
+				v.SourcePort = &p
+			}
+		case "partitionKey":
+			if r.Null() {
+				v.PartitionKey = nil
+			} else {
+				var p CookiePartitionKey
+				r.Decode(&p)
+				v.PartitionKey = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCookieReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("success")
+	w.Bool(v.Success)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCookieReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "success":
+			v.Success = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCookiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cookies")
+	if v.Cookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Cookies {
+			v.Cookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCookiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookies":
+			if r.ArrayStart() {
+				v.Cookies = make([]CookieParam, 0, 4)
+				for r.ArrayNext() {
+					var e0 CookieParam
+					e0.DecodeJSON(r)
+					v.Cookies = append(v.Cookies, e0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetExtraHTTPHeadersArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetExtraHTTPHeadersArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "headers":
+			r.Decode(&v.Headers)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAttachDebugStackArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAttachDebugStackArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetRequestInterceptionArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("patterns")
+	if v.Patterns == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Patterns {
+			v.Patterns[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetRequestInterceptionArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "patterns":
+			if r.ArrayStart() {
+				v.Patterns = make([]RequestPattern, 0, 4)
+				for r.ArrayNext() {
+					var e0 RequestPattern
+					e0.DecodeJSON(r)
+					v.Patterns = append(v.Patterns, e0)
+				}
+			} else {
+				v.Patterns = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StreamResourceContentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StreamResourceContentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StreamResourceContentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bufferedData")
+	w.Base64(v.BufferedData)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StreamResourceContentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bufferedData":
+			v.BufferedData = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSecurityIsolationStatusArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSecurityIsolationStatusArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := internal.PageFrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSecurityIsolationStatusReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("status")
+	v.Status.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSecurityIsolationStatusReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "status":
+			v.Status.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableReportingAPIArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableReportingAPIArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadNetworkResourceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("options")
+	v.Options.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadNetworkResourceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := internal.PageFrameID(r.String())
+				v.FrameID = &p
+			}
+		case "url":
+			v.URL = r.String()
+		case "options":
+			v.Options.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadNetworkResourceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("resource")
+	v.Resource.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadNetworkResourceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "resource":
+			v.Resource.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCookieControlsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enableThirdPartyCookieRestriction")
+	w.Bool(v.EnableThirdPartyCookieRestriction)
+	w.Key("disableThirdPartyCookieMetadata")
+	w.Bool(v.DisableThirdPartyCookieMetadata)
+	w.Key("disableThirdPartyCookieHeuristics")
+	w.Bool(v.DisableThirdPartyCookieHeuristics)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCookieControlsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enableThirdPartyCookieRestriction":
+			v.EnableThirdPartyCookieRestriction = r.Bool()
+		case "disableThirdPartyCookieMetadata":
+			v.DisableThirdPartyCookieMetadata = r.Bool()
+		case "disableThirdPartyCookieHeuristics":
+			v.DisableThirdPartyCookieHeuristics = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DataReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("dataLength")
+	w.Int(v.DataLength)
+	w.Key("encodedDataLength")
+	w.Int(v.EncodedDataLength)
+	if len(v.Data) > 0 {
+		w.Key("data")
+		w.Base64(v.Data)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DataReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "dataLength":
+			v.DataLength = r.Int()
+		case "encodedDataLength":
+			v.EncodedDataLength = r.Int()
+		case "data":
+			v.Data = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EventSourceMessageReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("eventName")
+	w.String(v.EventName)
+	w.Key("eventId")
+	w.String(v.EventID)
+	w.Key("data")
+	w.String(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EventSourceMessageReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "eventName":
+			v.EventName = r.String()
+		case "eventId":
+			v.EventID = r.String()
+		case "data":
+			v.Data = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadingFailedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("errorText")
+	w.String(v.ErrorText)
+	if v.Canceled != nil {
+		w.Key("canceled")
+		w.Bool(*v.Canceled)
+	}
+	if v.BlockedReason != "" {
+		w.Key("blockedReason")
+		w.String(string(v.BlockedReason))
+	}
+	if v.CORSErrorStatus != nil {
+		w.Key("corsErrorStatus")
+		v.CORSErrorStatus.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadingFailedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "type":
+			v.Type = ResourceType(r.String())
+		case "errorText":
+			v.ErrorText = r.String()
+		case "canceled":
+			if r.Null() {
+				v.Canceled = nil
+			} else {
+				p := r.Bool()
+				v.Canceled = &p
+			}
+		case "blockedReason":
+			v.BlockedReason = BlockedReason(r.String())
+		case "corsErrorStatus":
+			if r.Null() {
+				v.CORSErrorStatus = nil
+			} else {
+				var p CORSErrorStatus
+				p.DecodeJSON(r)
+				v.CORSErrorStatus = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadingFinishedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("encodedDataLength")
+	w.Float(v.EncodedDataLength)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadingFinishedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "encodedDataLength":
+			v.EncodedDataLength = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestInterceptedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("interceptionId")
+	w.String(string(v.InterceptionID))
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("resourceType")
+	w.String(string(v.ResourceType))
+	w.Key("isNavigationRequest")
+	w.Bool(v.IsNavigationRequest)
+	if v.IsDownload != nil {
+		w.Key("isDownload")
+		w.Bool(*v.IsDownload)
+	}
+	if v.RedirectURL != nil {
+		w.Key("redirectUrl")
+		w.String(*v.RedirectURL)
+	}
+	if v.AuthChallenge != nil {
+		w.Key("authChallenge")
+		v.AuthChallenge.EncodeJSON(w)
+	}
+	if v.ResponseErrorReason != "" {
+		w.Key("responseErrorReason")
+		w.String(string(v.ResponseErrorReason))
+	}
+	if v.ResponseStatusCode != nil {
+		w.Key("responseStatusCode")
+		w.Int(*v.ResponseStatusCode)
+	}
+	if len(v.ResponseHeaders) > 0 {
+		w.Key("responseHeaders")
+		w.Marshal(v.ResponseHeaders)
+	}
+	if v.RequestID != nil {
+		w.Key("requestId")
+		w.String(string(*v.RequestID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestInterceptedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "interceptionId":
+			v.InterceptionID = InterceptionID(r.String())
+		case "request":
+			v.Request.DecodeJSON(r)
+		case "frameId":
+			v.FrameID = internal.PageFrameID(r.String())
+		case "resourceType":
+			v.ResourceType = ResourceType(r.String())
+		case "isNavigationRequest":
+			v.IsNavigationRequest = r.Bool()
+		case "isDownload":
+			if r.Null() {
+				v.IsDownload = nil
+			} else {
+				p := r.Bool()
+				v.IsDownload = &p
+			}
+		case "redirectUrl":
+			if r.Null() {
+				v.RedirectURL = nil
+			} else {
+				p := r.String()
+				v.RedirectURL = &p
+			}
+		case "authChallenge":
+			if r.Null() {
+				v.AuthChallenge = nil
+			} else {
+				var p AuthChallenge
+				p.DecodeJSON(r)
+				v.AuthChallenge = &p
+			}
+		case "responseErrorReason":
+			v.ResponseErrorReason = ErrorReason(r.String())
+		case "responseStatusCode":
+			if r.Null() {
+				v.ResponseStatusCode = nil
+			} else {
+				p := r.Int()
+				v.ResponseStatusCode = &p
+			}
+		case "responseHeaders":
+			r.Decode(&v.ResponseHeaders)
+		case "requestId":
+			if r.Null() {
+				v.RequestID = nil
+			} else {
+				p := RequestID(r.String())
+				v.RequestID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestServedFromCacheReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestServedFromCacheReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestWillBeSentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("documentURL")
+	w.String(v.DocumentURL)
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("wallTime")
+	w.Marshal(v.WallTime)
+	w.Key("initiator")
+	v.Initiator.EncodeJSON(w)
+	w.Key("redirectHasExtraInfo")
+	w.Bool(v.RedirectHasExtraInfo)
+	if v.RedirectResponse != nil {
+		w.Key("redirectResponse")
+		v.RedirectResponse.EncodeJSON(w)
+	}
+	if v.Type != "" {
+		w.Key("type")
+		w.String(string(v.Type))
+	}
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	if v.HasUserGesture != nil {
+		w.Key("hasUserGesture")
+		w.Bool(*v.HasUserGesture)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestWillBeSentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "loaderId":
+			v.LoaderID = LoaderID(r.String())
+		case "documentURL":
+			v.DocumentURL = r.String()
+		case "request":
+			v.Request.DecodeJSON(r)
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "wallTime":
+			r.Decode(&v.WallTime)
+		case "initiator":
+			v.Initiator.DecodeJSON(r)
+		case "redirectHasExtraInfo":
+			v.RedirectHasExtraInfo = r.Bool()
+		case "redirectResponse":
+			if r.Null() {
+				v.RedirectResponse = nil
+			} else {
+				var p Response
+				p.DecodeJSON(r)
+				v.RedirectResponse = &p
+			}
+		case "type":
+			v.Type = ResourceType(r.String())
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := internal.PageFrameID(r.String())
+				v.FrameID = &p
+			}
+		case "hasUserGesture":
+			if r.Null() {
+				v.HasUserGesture = nil
+			} else {
+				p := r.Bool()
+				v.HasUserGesture = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResourceChangedPriorityReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("newPriority")
+	w.String(string(v.NewPriority))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResourceChangedPriorityReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "newPriority":
+			v.NewPriority = ResourcePriority(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SignedExchangeReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("info")
+	v.Info.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SignedExchangeReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "info":
+			v.Info.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResponseReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("response")
+	v.Response.EncodeJSON(w)
+	w.Key("hasExtraInfo")
+	w.Bool(v.HasExtraInfo)
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResponseReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "loaderId":
+			v.LoaderID = LoaderID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "type":
+			v.Type = ResourceType(r.String())
+		case "response":
+			v.Response.DecodeJSON(r)
+		case "hasExtraInfo":
+			v.HasExtraInfo = r.Bool()
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := internal.PageFrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketClosedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketClosedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("url")
+	w.String(v.URL)
+	if v.Initiator != nil {
+		w.Key("initiator")
+		v.Initiator.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "initiator":
+			if r.Null() {
+				v.Initiator = nil
+			} else {
+				var p Initiator
+				p.DecodeJSON(r)
+				v.Initiator = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketFrameErrorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("errorMessage")
+	w.String(v.ErrorMessage)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketFrameErrorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "errorMessage":
+			v.ErrorMessage = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketFrameReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("response")
+	v.Response.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketFrameReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "response":
+			v.Response.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketFrameSentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("response")
+	v.Response.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketFrameSentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "response":
+			v.Response.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketHandshakeResponseReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("response")
+	v.Response.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketHandshakeResponseReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "response":
+			v.Response.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebSocketWillSendHandshakeRequestReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("wallTime")
+	w.Marshal(v.WallTime)
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebSocketWillSendHandshakeRequestReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "wallTime":
+			r.Decode(&v.WallTime)
+		case "request":
+			v.Request.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebTransportCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("transportId")
+	w.String(string(v.TransportID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.Initiator != nil {
+		w.Key("initiator")
+		v.Initiator.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebTransportCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "transportId":
+			v.TransportID = RequestID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "initiator":
+			if r.Null() {
+				v.Initiator = nil
+			} else {
+				var p Initiator
+				p.DecodeJSON(r)
+				v.Initiator = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebTransportConnectionEstablishedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("transportId")
+	w.String(string(v.TransportID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebTransportConnectionEstablishedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "transportId":
+			v.TransportID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebTransportClosedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("transportId")
+	w.String(string(v.TransportID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebTransportClosedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "transportId":
+			v.TransportID = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("remoteAddr")
+	w.String(v.RemoteAddr)
+	w.Key("remotePort")
+	w.Int(v.RemotePort)
+	w.Key("options")
+	v.Options.EncodeJSON(w)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.Initiator != nil {
+		w.Key("initiator")
+		v.Initiator.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "remoteAddr":
+			v.RemoteAddr = r.String()
+		case "remotePort":
+			v.RemotePort = r.Int()
+		case "options":
+			v.Options.DecodeJSON(r)
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "initiator":
+			if r.Null() {
+				v.Initiator = nil
+			} else {
+				var p Initiator
+				p.DecodeJSON(r)
+				v.Initiator = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketOpenedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("remoteAddr")
+	w.String(v.RemoteAddr)
+	w.Key("remotePort")
+	w.Int(v.RemotePort)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.LocalAddr != nil {
+		w.Key("localAddr")
+		w.String(*v.LocalAddr)
+	}
+	if v.LocalPort != nil {
+		w.Key("localPort")
+		w.Int(*v.LocalPort)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketOpenedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "remoteAddr":
+			v.RemoteAddr = r.String()
+		case "remotePort":
+			v.RemotePort = r.Int()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "localAddr":
+			if r.Null() {
+				v.LocalAddr = nil
+			} else {
+				p := r.String()
+				v.LocalAddr = &p
+			}
+		case "localPort":
+			if r.Null() {
+				v.LocalPort = nil
+			} else {
+				p := r.Int()
+				v.LocalPort = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketAbortedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("errorMessage")
+	w.String(v.ErrorMessage)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketAbortedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "errorMessage":
+			v.ErrorMessage = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketClosedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketClosedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketChunkSentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("data")
+	w.String(v.Data)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketChunkSentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "data":
+			v.Data = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectTCPSocketChunkReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("data")
+	w.String(v.Data)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectTCPSocketChunkReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "data":
+			v.Data = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketJoinedMulticastGroupReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("IPAddress")
+	w.String(v.IPAddress)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketJoinedMulticastGroupReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "IPAddress":
+			v.IPAddress = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketLeftMulticastGroupReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("IPAddress")
+	w.String(v.IPAddress)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketLeftMulticastGroupReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "IPAddress":
+			v.IPAddress = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("options")
+	v.Options.EncodeJSON(w)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.Initiator != nil {
+		w.Key("initiator")
+		v.Initiator.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "options":
+			v.Options.DecodeJSON(r)
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "initiator":
+			if r.Null() {
+				v.Initiator = nil
+			} else {
+				var p Initiator
+				p.DecodeJSON(r)
+				v.Initiator = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketOpenedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("localAddr")
+	w.String(v.LocalAddr)
+	w.Key("localPort")
+	w.Int(v.LocalPort)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.RemoteAddr != nil {
+		w.Key("remoteAddr")
+		w.String(*v.RemoteAddr)
+	}
+	if v.RemotePort != nil {
+		w.Key("remotePort")
+		w.Int(*v.RemotePort)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketOpenedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "localAddr":
+			v.LocalAddr = r.String()
+		case "localPort":
+			v.LocalPort = r.Int()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "remoteAddr":
+			if r.Null() {
+				v.RemoteAddr = nil
+			} else {
+				p := r.String()
+				v.RemoteAddr = &p
+			}
+		case "remotePort":
+			if r.Null() {
+				v.RemotePort = nil
+			} else {
+				p := r.Int()
+				v.RemotePort = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketAbortedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("errorMessage")
+	w.String(v.ErrorMessage)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketAbortedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "errorMessage":
+			v.ErrorMessage = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketClosedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketClosedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketChunkSentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("message")
+	v.Message.EncodeJSON(w)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketChunkSentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "message":
+			v.Message.DecodeJSON(r)
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DirectUDPSocketChunkReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.Key("message")
+	v.Message.EncodeJSON(w)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DirectUDPSocketChunkReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = RequestID(r.String())
+		case "message":
+			v.Message.DecodeJSON(r)
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestWillBeSentExtraInfoReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("associatedCookies")
+	if v.AssociatedCookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AssociatedCookies {
+			v.AssociatedCookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	w.Key("connectTiming")
+	v.ConnectTiming.EncodeJSON(w)
+	if v.ClientSecurityState != nil {
+		w.Key("clientSecurityState")
+		v.ClientSecurityState.EncodeJSON(w)
+	}
+	if v.SiteHasCookieInOtherPartition != nil {
+		w.Key("siteHasCookieInOtherPartition")
+		w.Bool(*v.SiteHasCookieInOtherPartition)
+	}
+	if v.AppliedNetworkConditionsID != nil {
+		w.Key("appliedNetworkConditionsId")
+		w.String(*v.AppliedNetworkConditionsID)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestWillBeSentExtraInfoReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "associatedCookies":
+			if r.ArrayStart() {
+				v.AssociatedCookies = make([]AssociatedCookie, 0, 4)
+				for r.ArrayNext() {
+					var e0 AssociatedCookie
+					e0.DecodeJSON(r)
+					v.AssociatedCookies = append(v.AssociatedCookies, e0)
+				}
+			} else {
+				v.AssociatedCookies = nil
+			}
+		case "headers":
+			r.Decode(&v.Headers)
+		case "connectTiming":
+			v.ConnectTiming.DecodeJSON(r)
+		case "clientSecurityState":
+			if r.Null() {
+				v.ClientSecurityState = nil
+			} else {
+				var p ClientSecurityState
+				p.DecodeJSON(r)
+				v.ClientSecurityState = &p
+			}
+		case "siteHasCookieInOtherPartition":
+			if r.Null() {
+				v.SiteHasCookieInOtherPartition = nil
+			} else {
+				p := r.Bool()
+				v.SiteHasCookieInOtherPartition = &p
+			}
+		case "appliedNetworkConditionsId":
+			if r.Null() {
+				v.AppliedNetworkConditionsID = nil
+			} else {
+				p := r.String()
+				v.AppliedNetworkConditionsID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResponseReceivedExtraInfoReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("blockedCookies")
+	if v.BlockedCookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.BlockedCookies {
+			v.BlockedCookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	w.Key("resourceIPAddressSpace")
+	w.String(string(v.ResourceIPAddressSpace))
+	w.Key("statusCode")
+	w.Int(v.StatusCode)
+	if v.HeadersText != nil {
+		w.Key("headersText")
+		w.String(*v.HeadersText)
+	}
+	if v.CookiePartitionKey != nil {
+		w.Key("cookiePartitionKey")
+		v.CookiePartitionKey.EncodeJSON(w)
+	}
+	if v.CookiePartitionKeyOpaque != nil {
+		w.Key("cookiePartitionKeyOpaque")
+		w.Bool(*v.CookiePartitionKeyOpaque)
+	}
+	if len(v.ExemptedCookies) > 0 {
+		w.Key("exemptedCookies")
+		w.ArrayStart()
+		for i0 := range v.ExemptedCookies {
+			v.ExemptedCookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResponseReceivedExtraInfoReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "blockedCookies":
+			if r.ArrayStart() {
+				v.BlockedCookies = make([]BlockedSetCookieWithReason, 0, 4)
+				for r.ArrayNext() {
+					var e0 BlockedSetCookieWithReason
+					e0.DecodeJSON(r)
+					v.BlockedCookies = append(v.BlockedCookies, e0)
+				}
+			} else {
+				v.BlockedCookies = nil
+			}
+		case "headers":
+			r.Decode(&v.Headers)
+		case "resourceIPAddressSpace":
+			v.ResourceIPAddressSpace = IPAddressSpace(r.String())
+		case "statusCode":
+			v.StatusCode = r.Int()
+		case "headersText":
+			if r.Null() {
+				v.HeadersText = nil
+			} else {
+				p := r.String()
+				v.HeadersText = &p
+			}
+		case "cookiePartitionKey":
+			if r.Null() {
+				v.CookiePartitionKey = nil
+			} else {
+				var p CookiePartitionKey
+				r.Decode(&p)
+				v.CookiePartitionKey = &p
+			}
+		case "cookiePartitionKeyOpaque":
+			if r.Null() {
+				v.CookiePartitionKeyOpaque = nil
+			} else {
+				p := r.Bool()
+				v.CookiePartitionKeyOpaque = &p
+			}
+		case "exemptedCookies":
+			if r.ArrayStart() {
+				v.ExemptedCookies = make([]ExemptedSetCookieWithReason, 0, 4)
+				for r.ArrayNext() {
+					var e0 ExemptedSetCookieWithReason
+					e0.DecodeJSON(r)
+					v.ExemptedCookies = append(v.ExemptedCookies, e0)
+				}
+			} else {
+				v.ExemptedCookies = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResponseReceivedEarlyHintsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("headers")
+	w.Marshal(v.Headers)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResponseReceivedEarlyHintsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "headers":
+			r.Decode(&v.Headers)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrustTokenOperationDoneReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("status")
+	w.String(v.Status)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	if v.TopLevelOrigin != nil {
+		w.Key("topLevelOrigin")
+		w.String(*v.TopLevelOrigin)
+	}
+	if v.IssuerOrigin != nil {
+		w.Key("issuerOrigin")
+		w.String(*v.IssuerOrigin)
+	}
+	if v.IssuedTokenCount != nil {
+		w.Key("issuedTokenCount")
+		w.Int(*v.IssuedTokenCount)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrustTokenOperationDoneReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "status":
+			v.Status = r.String()
+		case "type":
+			v.Type = TrustTokenOperationType(r.String())
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "topLevelOrigin":
+			if r.Null() {
+				v.TopLevelOrigin = nil
+			} else {
+				p := r.String()
+				v.TopLevelOrigin = &p
+			}
+		case "issuerOrigin":
+			if r.Null() {
+				v.IssuerOrigin = nil
+			} else {
+				p := r.String()
+				v.IssuerOrigin = &p
+			}
+		case "issuedTokenCount":
+			if r.Null() {
+				v.IssuedTokenCount = nil
+			} else {
+				p := r.Int()
+				v.IssuedTokenCount = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PolicyUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PolicyUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReportingAPIReportAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("report")
+	v.Report.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReportingAPIReportAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "report":
+			v.Report.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReportingAPIReportUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("report")
+	v.Report.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReportingAPIReportUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "report":
+			v.Report.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReportingAPIEndpointsChangedForOriginReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("endpoints")
+	if v.Endpoints == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Endpoints {
+			v.Endpoints[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReportingAPIEndpointsChangedForOriginReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "endpoints":
+			if r.ArrayStart() {
+				v.Endpoints = make([]ReportingAPIEndpoint, 0, 4)
+				for r.ArrayNext() {
+					var e0 ReportingAPIEndpoint
+					e0.DecodeJSON(r)
+					v.Endpoints = append(v.Endpoints, e0)
+				}
+			} else {
+				v.Endpoints = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
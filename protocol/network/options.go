@@ -0,0 +1,440 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"github.com/mafredri/cdp/protocol/internal"
+)
+
+// ContinueInterceptedRequestOption sets an optional argument of ContinueInterceptedRequestArgs.
+type ContinueInterceptedRequestOption func(*ContinueInterceptedRequestArgs)
+
+// NewContinueInterceptedRequestArgsWith initializes ContinueInterceptedRequestArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewContinueInterceptedRequestArgsWith(interceptionID InterceptionID, opts ...ContinueInterceptedRequestOption) *ContinueInterceptedRequestArgs {
+	args := NewContinueInterceptedRequestArgs(interceptionID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ContinueInterceptedRequestWithErrorReason sets the ErrorReason optional argument, see
+// ContinueInterceptedRequestArgs.SetErrorReason.
+func ContinueInterceptedRequestWithErrorReason(v ErrorReason) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetErrorReason(v) }
+}
+
+// ContinueInterceptedRequestWithRawResponse sets the RawResponse optional argument, see
+// ContinueInterceptedRequestArgs.SetRawResponse.
+func ContinueInterceptedRequestWithRawResponse(v []byte) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetRawResponse(v) }
+}
+
+// ContinueInterceptedRequestWithURL sets the URL optional argument, see
+// ContinueInterceptedRequestArgs.SetURL.
+func ContinueInterceptedRequestWithURL(v string) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetURL(v) }
+}
+
+// ContinueInterceptedRequestWithMethod sets the Method optional argument, see
+// ContinueInterceptedRequestArgs.SetMethod.
+func ContinueInterceptedRequestWithMethod(v string) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetMethod(v) }
+}
+
+// ContinueInterceptedRequestWithPostData sets the PostData optional argument, see
+// ContinueInterceptedRequestArgs.SetPostData.
+func ContinueInterceptedRequestWithPostData(v string) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetPostData(v) }
+}
+
+// ContinueInterceptedRequestWithHeaders sets the Headers optional argument, see
+// ContinueInterceptedRequestArgs.SetHeaders.
+func ContinueInterceptedRequestWithHeaders(v Headers) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetHeaders(v) }
+}
+
+// ContinueInterceptedRequestWithAuthChallengeResponse sets the AuthChallengeResponse optional argument, see
+// ContinueInterceptedRequestArgs.SetAuthChallengeResponse.
+func ContinueInterceptedRequestWithAuthChallengeResponse(v AuthChallengeResponse) ContinueInterceptedRequestOption {
+	return func(a *ContinueInterceptedRequestArgs) { a.SetAuthChallengeResponse(v) }
+}
+
+// DeleteCookiesOption sets an optional argument of DeleteCookiesArgs.
+type DeleteCookiesOption func(*DeleteCookiesArgs)
+
+// NewDeleteCookiesArgsWith initializes DeleteCookiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDeleteCookiesArgsWith(name string, opts ...DeleteCookiesOption) *DeleteCookiesArgs {
+	args := NewDeleteCookiesArgs(name)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DeleteCookiesWithURL sets the URL optional argument, see
+// DeleteCookiesArgs.SetURL.
+func DeleteCookiesWithURL(v string) DeleteCookiesOption {
+	return func(a *DeleteCookiesArgs) { a.SetURL(v) }
+}
+
+// DeleteCookiesWithDomain sets the Domain optional argument, see
+// DeleteCookiesArgs.SetDomain.
+func DeleteCookiesWithDomain(v string) DeleteCookiesOption {
+	return func(a *DeleteCookiesArgs) { a.SetDomain(v) }
+}
+
+// DeleteCookiesWithPath sets the Path optional argument, see
+// DeleteCookiesArgs.SetPath.
+func DeleteCookiesWithPath(v string) DeleteCookiesOption {
+	return func(a *DeleteCookiesArgs) { a.SetPath(v) }
+}
+
+// DeleteCookiesWithPartitionKey sets the PartitionKey optional argument, see
+// DeleteCookiesArgs.SetPartitionKey.
+//
+// Note: This argument is experimental.
+func DeleteCookiesWithPartitionKey(v CookiePartitionKey) DeleteCookiesOption {
+	return func(a *DeleteCookiesArgs) { a.SetPartitionKey(v) }
+}
+
+// EmulateNetworkConditionsOption sets an optional argument of EmulateNetworkConditionsArgs.
+type EmulateNetworkConditionsOption func(*EmulateNetworkConditionsArgs)
+
+// NewEmulateNetworkConditionsArgsWith initializes EmulateNetworkConditionsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEmulateNetworkConditionsArgsWith(offline bool, latency float64, downloadThroughput float64, uploadThroughput float64, opts ...EmulateNetworkConditionsOption) *EmulateNetworkConditionsArgs {
+	args := NewEmulateNetworkConditionsArgs(offline, latency, downloadThroughput, uploadThroughput)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EmulateNetworkConditionsWithConnectionType sets the ConnectionType optional argument, see
+// EmulateNetworkConditionsArgs.SetConnectionType.
+func EmulateNetworkConditionsWithConnectionType(v ConnectionType) EmulateNetworkConditionsOption {
+	return func(a *EmulateNetworkConditionsArgs) { a.SetConnectionType(v) }
+}
+
+// EmulateNetworkConditionsWithPacketLoss sets the PacketLoss optional argument, see
+// EmulateNetworkConditionsArgs.SetPacketLoss.
+//
+// Note: This argument is experimental.
+func EmulateNetworkConditionsWithPacketLoss(v float64) EmulateNetworkConditionsOption {
+	return func(a *EmulateNetworkConditionsArgs) { a.SetPacketLoss(v) }
+}
+
+// EmulateNetworkConditionsWithPacketQueueLength sets the PacketQueueLength optional argument, see
+// EmulateNetworkConditionsArgs.SetPacketQueueLength.
+//
+// Note: This argument is experimental.
+func EmulateNetworkConditionsWithPacketQueueLength(v int) EmulateNetworkConditionsOption {
+	return func(a *EmulateNetworkConditionsArgs) { a.SetPacketQueueLength(v) }
+}
+
+// EmulateNetworkConditionsWithPacketReordering sets the PacketReordering optional argument, see
+// EmulateNetworkConditionsArgs.SetPacketReordering.
+//
+// Note: This argument is experimental.
+func EmulateNetworkConditionsWithPacketReordering(v bool) EmulateNetworkConditionsOption {
+	return func(a *EmulateNetworkConditionsArgs) { a.SetPacketReordering(v) }
+}
+
+// OverrideNetworkStateOption sets an optional argument of OverrideNetworkStateArgs.
+type OverrideNetworkStateOption func(*OverrideNetworkStateArgs)
+
+// NewOverrideNetworkStateArgsWith initializes OverrideNetworkStateArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewOverrideNetworkStateArgsWith(offline bool, latency float64, downloadThroughput float64, uploadThroughput float64, opts ...OverrideNetworkStateOption) *OverrideNetworkStateArgs {
+	args := NewOverrideNetworkStateArgs(offline, latency, downloadThroughput, uploadThroughput)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// OverrideNetworkStateWithConnectionType sets the ConnectionType optional argument, see
+// OverrideNetworkStateArgs.SetConnectionType.
+func OverrideNetworkStateWithConnectionType(v ConnectionType) OverrideNetworkStateOption {
+	return func(a *OverrideNetworkStateArgs) { a.SetConnectionType(v) }
+}
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithMaxTotalBufferSize sets the MaxTotalBufferSize optional argument, see
+// EnableArgs.SetMaxTotalBufferSize.
+//
+// Note: This argument is experimental.
+func EnableWithMaxTotalBufferSize(v int) EnableOption {
+	return func(a *EnableArgs) { a.SetMaxTotalBufferSize(v) }
+}
+
+// EnableWithMaxResourceBufferSize sets the MaxResourceBufferSize optional argument, see
+// EnableArgs.SetMaxResourceBufferSize.
+//
+// Note: This argument is experimental.
+func EnableWithMaxResourceBufferSize(v int) EnableOption {
+	return func(a *EnableArgs) { a.SetMaxResourceBufferSize(v) }
+}
+
+// EnableWithMaxPostDataSize sets the MaxPostDataSize optional argument, see
+// EnableArgs.SetMaxPostDataSize.
+func EnableWithMaxPostDataSize(v int) EnableOption {
+	return func(a *EnableArgs) { a.SetMaxPostDataSize(v) }
+}
+
+// EnableWithReportDirectSocketTraffic sets the ReportDirectSocketTraffic optional argument, see
+// EnableArgs.SetReportDirectSocketTraffic.
+//
+// Note: This argument is experimental.
+func EnableWithReportDirectSocketTraffic(v bool) EnableOption {
+	return func(a *EnableArgs) { a.SetReportDirectSocketTraffic(v) }
+}
+
+// EnableWithEnableDurableMessages sets the EnableDurableMessages optional argument, see
+// EnableArgs.SetEnableDurableMessages.
+//
+// Note: This argument is experimental.
+func EnableWithEnableDurableMessages(v bool) EnableOption {
+	return func(a *EnableArgs) { a.SetEnableDurableMessages(v) }
+}
+
+// GetCookiesOption sets an optional argument of GetCookiesArgs.
+type GetCookiesOption func(*GetCookiesArgs)
+
+// NewGetCookiesArgsWith initializes GetCookiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetCookiesArgsWith(opts ...GetCookiesOption) *GetCookiesArgs {
+	args := NewGetCookiesArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetCookiesWithURLs sets the URLs optional argument, see
+// GetCookiesArgs.SetURLs.
+func GetCookiesWithURLs(v []string) GetCookiesOption {
+	return func(a *GetCookiesArgs) { a.SetURLs(v) }
+}
+
+// SearchInResponseBodyOption sets an optional argument of SearchInResponseBodyArgs.
+type SearchInResponseBodyOption func(*SearchInResponseBodyArgs)
+
+// NewSearchInResponseBodyArgsWith initializes SearchInResponseBodyArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSearchInResponseBodyArgsWith(requestID RequestID, query string, opts ...SearchInResponseBodyOption) *SearchInResponseBodyArgs {
+	args := NewSearchInResponseBodyArgs(requestID, query)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SearchInResponseBodyWithCaseSensitive sets the CaseSensitive optional argument, see
+// SearchInResponseBodyArgs.SetCaseSensitive.
+func SearchInResponseBodyWithCaseSensitive(v bool) SearchInResponseBodyOption {
+	return func(a *SearchInResponseBodyArgs) { a.SetCaseSensitive(v) }
+}
+
+// SearchInResponseBodyWithIsRegex sets the IsRegex optional argument, see
+// SearchInResponseBodyArgs.SetIsRegex.
+func SearchInResponseBodyWithIsRegex(v bool) SearchInResponseBodyOption {
+	return func(a *SearchInResponseBodyArgs) { a.SetIsRegex(v) }
+}
+
+// SetBlockedURLsOption sets an optional argument of SetBlockedURLsArgs.
+type SetBlockedURLsOption func(*SetBlockedURLsArgs)
+
+// NewSetBlockedURLsArgsWith initializes SetBlockedURLsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetBlockedURLsArgsWith(opts ...SetBlockedURLsOption) *SetBlockedURLsArgs {
+	args := NewSetBlockedURLsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetBlockedURLsWithURLPatterns sets the URLPatterns optional argument, see
+// SetBlockedURLsArgs.SetURLPatterns.
+func SetBlockedURLsWithURLPatterns(v []BlockPattern) SetBlockedURLsOption {
+	return func(a *SetBlockedURLsArgs) { a.SetURLPatterns(v) }
+}
+
+// SetBlockedURLsWithURLs sets the URLs optional argument, see
+// SetBlockedURLsArgs.SetURLs.
+//
+// Deprecated: This argument should not be used.
+func SetBlockedURLsWithURLs(v []string) SetBlockedURLsOption {
+	return func(a *SetBlockedURLsArgs) { a.SetURLs(v) }
+}
+
+// SetCookieOption sets an optional argument of SetCookieArgs.
+type SetCookieOption func(*SetCookieArgs)
+
+// NewSetCookieArgsWith initializes SetCookieArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetCookieArgsWith(name string, value string, opts ...SetCookieOption) *SetCookieArgs {
+	args := NewSetCookieArgs(name, value)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetCookieWithURL sets the URL optional argument, see
+// SetCookieArgs.SetURL.
+func SetCookieWithURL(v string) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetURL(v) }
+}
+
+// SetCookieWithDomain sets the Domain optional argument, see
+// SetCookieArgs.SetDomain.
+func SetCookieWithDomain(v string) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetDomain(v) }
+}
+
+// SetCookieWithPath sets the Path optional argument, see
+// SetCookieArgs.SetPath.
+func SetCookieWithPath(v string) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetPath(v) }
+}
+
+// SetCookieWithSecure sets the Secure optional argument, see
+// SetCookieArgs.SetSecure.
+func SetCookieWithSecure(v bool) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetSecure(v) }
+}
+
+// SetCookieWithHTTPOnly sets the HTTPOnly optional argument, see
+// SetCookieArgs.SetHTTPOnly.
+func SetCookieWithHTTPOnly(v bool) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetHTTPOnly(v) }
+}
+
+// SetCookieWithSameSite sets the SameSite optional argument, see
+// SetCookieArgs.SetSameSite.
+func SetCookieWithSameSite(v CookieSameSite) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetSameSite(v) }
+}
+
+// SetCookieWithExpires sets the Expires optional argument, see
+// SetCookieArgs.SetExpires.
+func SetCookieWithExpires(v TimeSinceEpoch) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetExpires(v) }
+}
+
+// SetCookieWithPriority sets the Priority optional argument, see
+// SetCookieArgs.SetPriority.
+//
+// Note: This argument is experimental.
+func SetCookieWithPriority(v CookiePriority) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetPriority(v) }
+}
+
+// SetCookieWithSameParty sets the SameParty optional argument, see
+// SetCookieArgs.SetSameParty.
+//
+// Note: This argument is experimental.
+func SetCookieWithSameParty(v bool) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetSameParty(v) }
+}
+
+// SetCookieWithSourceScheme sets the SourceScheme optional argument, see
+// SetCookieArgs.SetSourceScheme.
+//
+// Note: This argument is experimental.
+func SetCookieWithSourceScheme(v CookieSourceScheme) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetSourceScheme(v) }
+}
+
+// SetCookieWithSourcePort sets the SourcePort optional argument, see
+// SetCookieArgs.SetSourcePort.
+//
+// Note: This argument is experimental.
+func SetCookieWithSourcePort(v int) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetSourcePort(v) }
+}
+
+// SetCookieWithPartitionKey sets the PartitionKey optional argument, see
+// SetCookieArgs.SetPartitionKey.
+//
+// Note: This argument is experimental.
+func SetCookieWithPartitionKey(v CookiePartitionKey) SetCookieOption {
+	return func(a *SetCookieArgs) { a.SetPartitionKey(v) }
+}
+
+// GetSecurityIsolationStatusOption sets an optional argument of GetSecurityIsolationStatusArgs.
+type GetSecurityIsolationStatusOption func(*GetSecurityIsolationStatusArgs)
+
+// NewGetSecurityIsolationStatusArgsWith initializes GetSecurityIsolationStatusArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetSecurityIsolationStatusArgsWith(opts ...GetSecurityIsolationStatusOption) *GetSecurityIsolationStatusArgs {
+	args := NewGetSecurityIsolationStatusArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetSecurityIsolationStatusWithFrameID sets the FrameID optional argument, see
+// GetSecurityIsolationStatusArgs.SetFrameID.
+func GetSecurityIsolationStatusWithFrameID(v internal.PageFrameID) GetSecurityIsolationStatusOption {
+	return func(a *GetSecurityIsolationStatusArgs) { a.SetFrameID(v) }
+}
+
+// LoadNetworkResourceOption sets an optional argument of LoadNetworkResourceArgs.
+type LoadNetworkResourceOption func(*LoadNetworkResourceArgs)
+
+// NewLoadNetworkResourceArgsWith initializes LoadNetworkResourceArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewLoadNetworkResourceArgsWith(url string, options LoadNetworkResourceOptions, opts ...LoadNetworkResourceOption) *LoadNetworkResourceArgs {
+	args := NewLoadNetworkResourceArgs(url, options)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// LoadNetworkResourceWithFrameID sets the FrameID optional argument, see
+// LoadNetworkResourceArgs.SetFrameID.
+func LoadNetworkResourceWithFrameID(v internal.PageFrameID) LoadNetworkResourceOption {
+	return func(a *LoadNetworkResourceArgs) { a.SetFrameID(v) }
+}
@@ -0,0 +1,21 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package noderuntime
+
+// NotifyWhenWaitingForDisconnectArgs represents the arguments for NotifyWhenWaitingForDisconnect in the NodeRuntime domain.
+type NotifyWhenWaitingForDisconnectArgs struct {
+	Enabled bool `json:"enabled"` // No description.
+}
+
+// NewNotifyWhenWaitingForDisconnectArgs initializes NotifyWhenWaitingForDisconnectArgs with the required arguments.
+func NewNotifyWhenWaitingForDisconnectArgs(enabled bool) *NotifyWhenWaitingForDisconnectArgs {
+	args := new(NotifyWhenWaitingForDisconnectArgs)
+	args.Enabled = enabled
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *NotifyWhenWaitingForDisconnectArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,96 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package noderuntime implements the NodeRuntime domain. Support for
+// inspecting node process state.
+package noderuntime
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the NodeRuntime domain. Support for inspecting
+// node process state.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the NodeRuntime domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the NodeRuntime method. Enable the NodeRuntime events except
+// by `NodeRuntime.waitingForDisconnect`.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "NodeRuntime.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeRuntime", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the NodeRuntime method. Disable NodeRuntime events
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "NodeRuntime.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeRuntime", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// NotifyWhenWaitingForDisconnect invokes the NodeRuntime method. Enable the
+// `NodeRuntime.waitingForDisconnect`.
+func (d *domainClient) NotifyWhenWaitingForDisconnect(ctx context.Context, args *NotifyWhenWaitingForDisconnectArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeRuntime.notifyWhenWaitingForDisconnect", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeRuntime.notifyWhenWaitingForDisconnect", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeRuntime", Op: "NotifyWhenWaitingForDisconnect", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) WaitingForDisconnect(ctx context.Context) (WaitingForDisconnectClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeRuntime.waitingForDisconnect", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &waitingForDisconnectClient{Stream: s}, nil
+}
+
+type waitingForDisconnectClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *waitingForDisconnectClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *waitingForDisconnectClient) Recv() (*WaitingForDisconnectReply, error) {
+	event := new(WaitingForDisconnectReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeRuntime", Op: "WaitingForDisconnect Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WaitingForDebugger(ctx context.Context) (WaitingForDebuggerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeRuntime.waitingForDebugger", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &waitingForDebuggerClient{Stream: s}, nil
+}
+
+type waitingForDebuggerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *waitingForDebuggerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *waitingForDebuggerClient) Recv() (*WaitingForDebuggerReply, error) {
+	event := new(WaitingForDebuggerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeRuntime", Op: "WaitingForDebugger Recv", Err: err}
+	}
+	return event, nil
+}
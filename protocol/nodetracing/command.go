@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodetracing
+
+// GetCategoriesReply represents the return values for GetCategories in the NodeTracing domain.
+type GetCategoriesReply struct {
+	Categories []string `json:"categories"` // A list of supported tracing categories.
+}
+
+// StartArgs represents the arguments for Start in the NodeTracing domain.
+type StartArgs struct {
+	TraceConfig TraceConfig `json:"traceConfig"` // No description.
+}
+
+// NewStartArgs initializes StartArgs with the required arguments.
+func NewStartArgs(traceConfig TraceConfig) *StartArgs {
+	args := new(StartArgs)
+	args.TraceConfig = traceConfig
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,96 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package nodetracing implements the NodeTracing domain.
+package nodetracing
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the NodeTracing domain.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the NodeTracing domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// GetCategories invokes the NodeTracing method. Gets supported tracing
+// categories.
+func (d *domainClient) GetCategories(ctx context.Context) (reply *GetCategoriesReply, err error) {
+	reply = new(GetCategoriesReply)
+	err = rpcc.Invoke(ctx, "NodeTracing.getCategories", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeTracing", Op: "GetCategories", Err: err}
+	}
+	return
+}
+
+// Start invokes the NodeTracing method. Start trace events collection.
+func (d *domainClient) Start(ctx context.Context, args *StartArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeTracing.start", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeTracing.start", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeTracing", Op: "Start", Err: err}
+	}
+	return
+}
+
+// Stop invokes the NodeTracing method. Stop trace events collection.
+// Remaining collected events will be sent as a sequence of dataCollected
+// events followed by tracingComplete event.
+func (d *domainClient) Stop(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "NodeTracing.stop", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeTracing", Op: "Stop", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) DataCollected(ctx context.Context) (DataCollectedClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeTracing.dataCollected", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &dataCollectedClient{Stream: s}, nil
+}
+
+type dataCollectedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *dataCollectedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *dataCollectedClient) Recv() (*DataCollectedReply, error) {
+	event := new(DataCollectedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeTracing", Op: "DataCollected Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) TracingComplete(ctx context.Context) (TracingCompleteClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeTracing.tracingComplete", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingCompleteClient{Stream: s}, nil
+}
+
+type tracingCompleteClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *tracingCompleteClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *tracingCompleteClient) Recv() (*TracingCompleteReply, error) {
+	event := new(TracingCompleteReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeTracing", Op: "TracingComplete Recv", Err: err}
+	}
+	return event, nil
+}
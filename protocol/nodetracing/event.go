@@ -0,0 +1,37 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodetracing
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// DataCollectedClient is a client for DataCollected events. Contains an
+// bucket of collected trace events.
+type DataCollectedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DataCollectedReply, error)
+	rpcc.Stream
+}
+
+// DataCollectedReply is the reply for DataCollected events.
+type DataCollectedReply struct {
+	Value []json.RawMessage `json:"value"` // No description.
+}
+
+// TracingCompleteClient is a client for TracingComplete events. Signals that
+// tracing is stopped and there is no trace buffers pending flush, all data
+// were delivered via dataCollected events.
+type TracingCompleteClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*TracingCompleteReply, error)
+	rpcc.Stream
+}
+
+// TracingCompleteReply is the reply for TracingComplete events.
+type TracingCompleteReply struct {
+}
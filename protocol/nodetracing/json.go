@@ -0,0 +1,179 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodetracing
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TraceConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.RecordMode != nil {
+		w.Key("recordMode")
+		w.String(*v.RecordMode)
+	}
+	w.Key("includedCategories")
+	if v.IncludedCategories == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.IncludedCategories {
+			w.String(v.IncludedCategories[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TraceConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "recordMode":
+			if r.Null() {
+				v.RecordMode = nil
+			} else {
+				p := r.String()
+				v.RecordMode = &p
+			}
+		case "includedCategories":
+			if r.ArrayStart() {
+				v.IncludedCategories = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.IncludedCategories = append(v.IncludedCategories, e0)
+				}
+			} else {
+				v.IncludedCategories = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCategoriesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("categories")
+	if v.Categories == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Categories {
+			w.String(v.Categories[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCategoriesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "categories":
+			if r.ArrayStart() {
+				v.Categories = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Categories = append(v.Categories, e0)
+				}
+			} else {
+				v.Categories = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("traceConfig")
+	v.TraceConfig.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "traceConfig":
+			v.TraceConfig.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DataCollectedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	if v.Value == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Value {
+			w.Raw(v.Value[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DataCollectedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			if r.ArrayStart() {
+				v.Value = make([]json.RawMessage, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Raw()
+					v.Value = append(v.Value, e0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TracingCompleteReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TracingCompleteReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
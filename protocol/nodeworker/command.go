@@ -0,0 +1,59 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodeworker
+
+// SendMessageToWorkerArgs represents the arguments for SendMessageToWorker in the NodeWorker domain.
+type SendMessageToWorkerArgs struct {
+	Message   string    `json:"message"`   // No description.
+	SessionID SessionID `json:"sessionId"` // Identifier of the session.
+}
+
+// NewSendMessageToWorkerArgs initializes SendMessageToWorkerArgs with the required arguments.
+func NewSendMessageToWorkerArgs(message string, sessionID SessionID) *SendMessageToWorkerArgs {
+	args := new(SendMessageToWorkerArgs)
+	args.Message = message
+	args.SessionID = sessionID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SendMessageToWorkerArgs) Validate() error {
+	return nil
+}
+
+// EnableArgs represents the arguments for Enable in the NodeWorker domain.
+type EnableArgs struct {
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"` // Whether to new workers should be paused until the frontend sends `Runtime.runIfWaitingForDebugger` message to run them.
+}
+
+// NewEnableArgs initializes EnableArgs with the required arguments.
+func NewEnableArgs(waitForDebuggerOnStart bool) *EnableArgs {
+	args := new(EnableArgs)
+	args.WaitForDebuggerOnStart = waitForDebuggerOnStart
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
+// DetachArgs represents the arguments for Detach in the NodeWorker domain.
+type DetachArgs struct {
+	SessionID SessionID `json:"sessionId"` // No description.
+}
+
+// NewDetachArgs initializes DetachArgs with the required arguments.
+func NewDetachArgs(sessionID SessionID) *DetachArgs {
+	args := new(DetachArgs)
+	args.SessionID = sessionID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DetachArgs) Validate() error {
+	return nil
+}
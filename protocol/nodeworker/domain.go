@@ -0,0 +1,136 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package nodeworker implements the NodeWorker domain. Support for sending
+// messages to Node worker Inspector instances.
+package nodeworker
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the NodeWorker domain. Support for sending
+// messages to Node worker Inspector instances.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the NodeWorker domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// SendMessageToWorker invokes the NodeWorker method. Sends protocol message
+// over session with given id.
+func (d *domainClient) SendMessageToWorker(ctx context.Context, args *SendMessageToWorkerArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeWorker.sendMessageToWorker", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeWorker.sendMessageToWorker", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "SendMessageToWorker", Err: err}
+	}
+	return
+}
+
+// Enable invokes the NodeWorker method. Instructs the inspector to attach to
+// running workers. Will also attach to new workers as they start
+func (d *domainClient) Enable(ctx context.Context, args *EnableArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeWorker.enable", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeWorker.enable", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the NodeWorker method. Detaches from all running workers
+// and disables attaching to new workers as they are started.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "NodeWorker.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// Detach invokes the NodeWorker method. Detached from the worker with given
+// sessionId.
+func (d *domainClient) Detach(ctx context.Context, args *DetachArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeWorker.detach", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeWorker.detach", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "Detach", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) AttachedToWorker(ctx context.Context) (AttachedToWorkerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeWorker.attachedToWorker", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &attachedToWorkerClient{Stream: s}, nil
+}
+
+type attachedToWorkerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *attachedToWorkerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *attachedToWorkerClient) Recv() (*AttachedToWorkerReply, error) {
+	event := new(AttachedToWorkerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeWorker", Op: "AttachedToWorker Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DetachedFromWorker(ctx context.Context) (DetachedFromWorkerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeWorker.detachedFromWorker", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &detachedFromWorkerClient{Stream: s}, nil
+}
+
+type detachedFromWorkerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *detachedFromWorkerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *detachedFromWorkerClient) Recv() (*DetachedFromWorkerReply, error) {
+	event := new(DetachedFromWorkerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeWorker", Op: "DetachedFromWorker Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ReceivedMessageFromWorker(ctx context.Context) (ReceivedMessageFromWorkerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeWorker.receivedMessageFromWorker", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &receivedMessageFromWorkerClient{Stream: s}, nil
+}
+
+type receivedMessageFromWorkerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *receivedMessageFromWorkerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *receivedMessageFromWorkerClient) Recv() (*ReceivedMessageFromWorkerReply, error) {
+	event := new(ReceivedMessageFromWorkerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeWorker", Op: "ReceivedMessageFromWorker Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodeworker
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WorkerInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("workerId")
+	w.String(string(v.WorkerID))
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("title")
+	w.String(v.Title)
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WorkerInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "workerId":
+			v.WorkerID = WorkerID(r.String())
+		case "type":
+			v.Type = r.String()
+		case "title":
+			v.Title = r.String()
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SendMessageToWorkerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("message")
+	w.String(v.Message)
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SendMessageToWorkerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "message":
+			v.Message = r.String()
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("waitForDebuggerOnStart")
+	w.Bool(v.WaitForDebuggerOnStart)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "waitForDebuggerOnStart":
+			v.WaitForDebuggerOnStart = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DetachArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DetachArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttachedToWorkerReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.Key("workerInfo")
+	v.WorkerInfo.EncodeJSON(w)
+	w.Key("waitingForDebugger")
+	w.Bool(v.WaitingForDebugger)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttachedToWorkerReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		case "workerInfo":
+			v.WorkerInfo.DecodeJSON(r)
+		case "waitingForDebugger":
+			v.WaitingForDebugger = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DetachedFromWorkerReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DetachedFromWorkerReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReceivedMessageFromWorkerReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.Key("message")
+	w.String(v.Message)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReceivedMessageFromWorkerReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		case "message":
+			v.Message = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodeworker
+
+// WorkerID
+type WorkerID string
+
+// SessionID Unique identifier of attached debugging session.
+type SessionID string
+
+// WorkerInfo
+type WorkerInfo struct {
+	WorkerID WorkerID `json:"workerId"` // No description.
+	Type     string   `json:"type"`     // No description.
+	Title    string   `json:"title"`    // No description.
+	URL      string   `json:"url"`      // No description.
+}
@@ -0,0 +1,63 @@
+package protocol_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp/opt"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+func TestArgOptions(t *testing.T) {
+	referrer := opt.None[string]()
+	tests := []struct {
+		name string
+		want interface{}
+		got  interface{}
+	}{
+		{
+			name: "Navigate",
+			want: page.NewNavigateArgs("about:blank").SetReferrer("a").SetTransitionType(page.TransitionTypeLink),
+			got: page.NewNavigateArgsWith("about:blank",
+				page.NavigateWithReferrer("a"),
+				page.NavigateWithTransitionType(page.TransitionTypeLink),
+			),
+		},
+		{
+			name: "NavigateNotSet",
+			want: page.NewNavigateArgs("about:blank"),
+			got:  page.NewNavigateArgsWith("about:blank", opt.Map(referrer, page.NavigateWithReferrer)),
+		},
+		{
+			name: "NoRequired",
+			want: page.NewCaptureScreenshotArgs().SetFormat("png").SetQuality(50),
+			got:  page.NewCaptureScreenshotArgsWith(page.CaptureScreenshotWithFormat("png"), page.CaptureScreenshotWithQuality(50)),
+		},
+		{
+			name: "Array",
+			want: runtime.NewCallFunctionOnArgs("f").SetArguments([]runtime.CallArgument{{}}),
+			got:  runtime.NewCallFunctionOnArgsWith("f", runtime.CallFunctionOnWithArguments([]runtime.CallArgument{{}})),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.got); diff != "" {
+				t.Errorf("options differ from setters (-want +got):\n%s", diff)
+			}
+			want, err := json.Marshal(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(tt.got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(want) != string(got) {
+				t.Errorf("json.Marshal() = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ package overlay
 
 import (
 	"encoding/json"
+	"errors"
+	"strconv"
 
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/page"
@@ -54,6 +56,17 @@ func (a *GetHighlightObjectForTestArgs) SetShowAccessibilityInfo(showAccessibili
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetHighlightObjectForTestArgs) Validate() error {
+	if a.ColorFormat != "" {
+		if !a.ColorFormat.Valid() {
+			return errors.New("overlay.GetHighlightObjectForTestArgs: ColorFormat: invalid value " + strconv.Quote(string(a.ColorFormat)))
+		}
+	}
+	return nil
+}
+
 // GetHighlightObjectForTestReply represents the return values for GetHighlightObjectForTest in the Overlay domain.
 type GetHighlightObjectForTestReply struct {
 	Highlight json.RawMessage `json:"highlight"` // Highlight data for the node.
@@ -71,6 +84,15 @@ func NewGetGridHighlightObjectsForTestArgs(nodeIDs []dom.NodeID) *GetGridHighlig
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetGridHighlightObjectsForTestArgs) Validate() error {
+	if a.NodeIDs == nil {
+		return errors.New("overlay.GetGridHighlightObjectsForTestArgs: NodeIDs is required")
+	}
+	return nil
+}
+
 // GetGridHighlightObjectsForTestReply represents the return values for GetGridHighlightObjectsForTest in the Overlay domain.
 type GetGridHighlightObjectsForTestReply struct {
 	Highlights json.RawMessage `json:"highlights"` // Grid Highlight data for the node ids provided.
@@ -88,6 +110,12 @@ func NewGetSourceOrderHighlightObjectForTestArgs(nodeID dom.NodeID) *GetSourceOr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetSourceOrderHighlightObjectForTestArgs) Validate() error {
+	return nil
+}
+
 // GetSourceOrderHighlightObjectForTestReply represents the return values for GetSourceOrderHighlightObjectForTest in the Overlay domain.
 type GetSourceOrderHighlightObjectForTestReply struct {
 	Highlight json.RawMessage `json:"highlight"` // Source order highlight data for the node id provided.
@@ -121,6 +149,12 @@ func (a *HighlightFrameArgs) SetContentOutlineColor(contentOutlineColor dom.RGBA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HighlightFrameArgs) Validate() error {
+	return nil
+}
+
 // HighlightNodeArgs represents the arguments for HighlightNode in the Overlay domain.
 type HighlightNodeArgs struct {
 	HighlightConfig HighlightConfig         `json:"highlightConfig"`         // A descriptor for the highlight appearance.
@@ -165,6 +199,12 @@ func (a *HighlightNodeArgs) SetSelector(selector string) *HighlightNodeArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HighlightNodeArgs) Validate() error {
+	return nil
+}
+
 // HighlightQuadArgs represents the arguments for HighlightQuad in the Overlay domain.
 type HighlightQuadArgs struct {
 	Quad         dom.Quad  `json:"quad"`                   // Quad to highlight
@@ -193,6 +233,15 @@ func (a *HighlightQuadArgs) SetOutlineColor(outlineColor dom.RGBA) *HighlightQua
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HighlightQuadArgs) Validate() error {
+	if a.Quad == nil {
+		return errors.New("overlay.HighlightQuadArgs: Quad is required")
+	}
+	return nil
+}
+
 // HighlightRectArgs represents the arguments for HighlightRect in the Overlay domain.
 type HighlightRectArgs struct {
 	X            int       `json:"x"`                      // X coordinate
@@ -227,6 +276,12 @@ func (a *HighlightRectArgs) SetOutlineColor(outlineColor dom.RGBA) *HighlightRec
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HighlightRectArgs) Validate() error {
+	return nil
+}
+
 // HighlightSourceOrderArgs represents the arguments for HighlightSourceOrder in the Overlay domain.
 type HighlightSourceOrderArgs struct {
 	SourceOrderConfig SourceOrderConfig       `json:"sourceOrderConfig"`       // A descriptor for the appearance of the overlay drawing.
@@ -263,6 +318,12 @@ func (a *HighlightSourceOrderArgs) SetObjectID(objectID runtime.RemoteObjectID)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HighlightSourceOrderArgs) Validate() error {
+	return nil
+}
+
 // SetInspectModeArgs represents the arguments for SetInspectMode in the Overlay domain.
 type SetInspectModeArgs struct {
 	Mode            InspectMode      `json:"mode"`                      // Set an inspection mode.
@@ -284,6 +345,15 @@ func (a *SetInspectModeArgs) SetHighlightConfig(highlightConfig HighlightConfig)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInspectModeArgs) Validate() error {
+	if !a.Mode.Valid() {
+		return errors.New("overlay.SetInspectModeArgs: Mode: invalid value " + strconv.Quote(string(a.Mode)))
+	}
+	return nil
+}
+
 // SetShowAdHighlightsArgs represents the arguments for SetShowAdHighlights in the Overlay domain.
 type SetShowAdHighlightsArgs struct {
 	Show bool `json:"show"` // True for showing ad highlights
@@ -296,6 +366,12 @@ func NewSetShowAdHighlightsArgs(show bool) *SetShowAdHighlightsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowAdHighlightsArgs) Validate() error {
+	return nil
+}
+
 // SetPausedInDebuggerMessageArgs represents the arguments for SetPausedInDebuggerMessage in the Overlay domain.
 type SetPausedInDebuggerMessageArgs struct {
 	Message *string `json:"message,omitempty"` // The message to display, also triggers resume and step over controls.
@@ -315,6 +391,12 @@ func (a *SetPausedInDebuggerMessageArgs) SetMessage(message string) *SetPausedIn
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPausedInDebuggerMessageArgs) Validate() error {
+	return nil
+}
+
 // SetShowDebugBordersArgs represents the arguments for SetShowDebugBorders in the Overlay domain.
 type SetShowDebugBordersArgs struct {
 	Show bool `json:"show"` // True for showing debug borders
@@ -327,6 +409,12 @@ func NewSetShowDebugBordersArgs(show bool) *SetShowDebugBordersArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowDebugBordersArgs) Validate() error {
+	return nil
+}
+
 // SetShowFPSCounterArgs represents the arguments for SetShowFPSCounter in the Overlay domain.
 type SetShowFPSCounterArgs struct {
 	Show bool `json:"show"` // True for showing the FPS counter
@@ -339,6 +427,12 @@ func NewSetShowFPSCounterArgs(show bool) *SetShowFPSCounterArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowFPSCounterArgs) Validate() error {
+	return nil
+}
+
 // SetShowGridOverlaysArgs represents the arguments for SetShowGridOverlays in the Overlay domain.
 type SetShowGridOverlaysArgs struct {
 	GridNodeHighlightConfigs []GridNodeHighlightConfig `json:"gridNodeHighlightConfigs"` // An array of node identifiers and descriptors for the highlight appearance.
@@ -351,6 +445,15 @@ func NewSetShowGridOverlaysArgs(gridNodeHighlightConfigs []GridNodeHighlightConf
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowGridOverlaysArgs) Validate() error {
+	if a.GridNodeHighlightConfigs == nil {
+		return errors.New("overlay.SetShowGridOverlaysArgs: GridNodeHighlightConfigs is required")
+	}
+	return nil
+}
+
 // SetShowFlexOverlaysArgs represents the arguments for SetShowFlexOverlays in the Overlay domain.
 type SetShowFlexOverlaysArgs struct {
 	FlexNodeHighlightConfigs []FlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"` // An array of node identifiers and descriptors for the highlight appearance.
@@ -363,6 +466,15 @@ func NewSetShowFlexOverlaysArgs(flexNodeHighlightConfigs []FlexNodeHighlightConf
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowFlexOverlaysArgs) Validate() error {
+	if a.FlexNodeHighlightConfigs == nil {
+		return errors.New("overlay.SetShowFlexOverlaysArgs: FlexNodeHighlightConfigs is required")
+	}
+	return nil
+}
+
 // SetShowScrollSnapOverlaysArgs represents the arguments for SetShowScrollSnapOverlays in the Overlay domain.
 type SetShowScrollSnapOverlaysArgs struct {
 	ScrollSnapHighlightConfigs []ScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"` // An array of node identifiers and descriptors for the highlight appearance.
@@ -375,6 +487,15 @@ func NewSetShowScrollSnapOverlaysArgs(scrollSnapHighlightConfigs []ScrollSnapHig
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowScrollSnapOverlaysArgs) Validate() error {
+	if a.ScrollSnapHighlightConfigs == nil {
+		return errors.New("overlay.SetShowScrollSnapOverlaysArgs: ScrollSnapHighlightConfigs is required")
+	}
+	return nil
+}
+
 // SetShowContainerQueryOverlaysArgs represents the arguments for SetShowContainerQueryOverlays in the Overlay domain.
 type SetShowContainerQueryOverlaysArgs struct {
 	ContainerQueryHighlightConfigs []ContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"` // An array of node identifiers and descriptors for the highlight appearance.
@@ -387,6 +508,15 @@ func NewSetShowContainerQueryOverlaysArgs(containerQueryHighlightConfigs []Conta
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowContainerQueryOverlaysArgs) Validate() error {
+	if a.ContainerQueryHighlightConfigs == nil {
+		return errors.New("overlay.SetShowContainerQueryOverlaysArgs: ContainerQueryHighlightConfigs is required")
+	}
+	return nil
+}
+
 // SetShowPaintRectsArgs represents the arguments for SetShowPaintRects in the Overlay domain.
 type SetShowPaintRectsArgs struct {
 	Result bool `json:"result"` // True for showing paint rectangles
@@ -399,6 +529,12 @@ func NewSetShowPaintRectsArgs(result bool) *SetShowPaintRectsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowPaintRectsArgs) Validate() error {
+	return nil
+}
+
 // SetShowLayoutShiftRegionsArgs represents the arguments for SetShowLayoutShiftRegions in the Overlay domain.
 type SetShowLayoutShiftRegionsArgs struct {
 	Result bool `json:"result"` // True for showing layout shift regions
@@ -411,6 +547,12 @@ func NewSetShowLayoutShiftRegionsArgs(result bool) *SetShowLayoutShiftRegionsArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowLayoutShiftRegionsArgs) Validate() error {
+	return nil
+}
+
 // SetShowScrollBottleneckRectsArgs represents the arguments for SetShowScrollBottleneckRects in the Overlay domain.
 type SetShowScrollBottleneckRectsArgs struct {
 	Show bool `json:"show"` // True for showing scroll bottleneck rects
@@ -423,6 +565,12 @@ func NewSetShowScrollBottleneckRectsArgs(show bool) *SetShowScrollBottleneckRect
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowScrollBottleneckRectsArgs) Validate() error {
+	return nil
+}
+
 // SetShowHitTestBordersArgs represents the arguments for SetShowHitTestBorders in the Overlay domain.
 type SetShowHitTestBordersArgs struct {
 	Show bool `json:"show"` // True for showing hit-test borders
@@ -435,6 +583,12 @@ func NewSetShowHitTestBordersArgs(show bool) *SetShowHitTestBordersArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowHitTestBordersArgs) Validate() error {
+	return nil
+}
+
 // SetShowWebVitalsArgs represents the arguments for SetShowWebVitals in the Overlay domain.
 type SetShowWebVitalsArgs struct {
 	Show bool `json:"show"` // No description.
@@ -447,6 +601,12 @@ func NewSetShowWebVitalsArgs(show bool) *SetShowWebVitalsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowWebVitalsArgs) Validate() error {
+	return nil
+}
+
 // SetShowViewportSizeOnResizeArgs represents the arguments for SetShowViewportSizeOnResize in the Overlay domain.
 type SetShowViewportSizeOnResizeArgs struct {
 	Show bool `json:"show"` // Whether to paint size or not.
@@ -459,6 +619,12 @@ func NewSetShowViewportSizeOnResizeArgs(show bool) *SetShowViewportSizeOnResizeA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowViewportSizeOnResizeArgs) Validate() error {
+	return nil
+}
+
 // SetShowHingeArgs represents the arguments for SetShowHinge in the Overlay domain.
 type SetShowHingeArgs struct {
 	HingeConfig *HingeConfig `json:"hingeConfig,omitempty"` // hinge data, null means hideHinge
@@ -478,6 +644,12 @@ func (a *SetShowHingeArgs) SetHingeConfig(hingeConfig HingeConfig) *SetShowHinge
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowHingeArgs) Validate() error {
+	return nil
+}
+
 // SetShowIsolatedElementsArgs represents the arguments for SetShowIsolatedElements in the Overlay domain.
 type SetShowIsolatedElementsArgs struct {
 	IsolatedElementHighlightConfigs []IsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"` // An array of node identifiers and descriptors for the highlight appearance.
@@ -490,6 +662,15 @@ func NewSetShowIsolatedElementsArgs(isolatedElementHighlightConfigs []IsolatedEl
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowIsolatedElementsArgs) Validate() error {
+	if a.IsolatedElementHighlightConfigs == nil {
+		return errors.New("overlay.SetShowIsolatedElementsArgs: IsolatedElementHighlightConfigs is required")
+	}
+	return nil
+}
+
 // SetShowWindowControlsOverlayArgs represents the arguments for SetShowWindowControlsOverlay in the Overlay domain.
 type SetShowWindowControlsOverlayArgs struct {
 	WindowControlsOverlayConfig *WindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"` // Window Controls Overlay data, null means hide Window Controls Overlay
@@ -509,3 +690,9 @@ func (a *SetShowWindowControlsOverlayArgs) SetWindowControlsOverlayConfig(window
 	a.WindowControlsOverlayConfig = &windowControlsOverlayConfig
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowWindowControlsOverlayArgs) Validate() error {
+	return nil
+}
@@ -3,6 +3,9 @@
 package page
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/debugger"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/io"
@@ -22,6 +25,12 @@ func NewAddScriptToEvaluateOnLoadArgs(scriptSource string) *AddScriptToEvaluateO
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddScriptToEvaluateOnLoadArgs) Validate() error {
+	return nil
+}
+
 // AddScriptToEvaluateOnLoadReply represents the return values for AddScriptToEvaluateOnLoad in the Page domain.
 type AddScriptToEvaluateOnLoadReply struct {
 	Identifier ScriptIdentifier `json:"identifier"` // Identifier of the added script.
@@ -88,6 +97,12 @@ func (a *AddScriptToEvaluateOnNewDocumentArgs) SetRunImmediately(runImmediately
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddScriptToEvaluateOnNewDocumentArgs) Validate() error {
+	return nil
+}
+
 // AddScriptToEvaluateOnNewDocumentReply represents the return values for AddScriptToEvaluateOnNewDocument in the Page domain.
 type AddScriptToEvaluateOnNewDocumentReply struct {
 	Identifier ScriptIdentifier `json:"identifier"` // Identifier of the added script.
@@ -176,6 +191,19 @@ func (a *CaptureScreenshotArgs) SetOptimizeForSpeed(optimizeForSpeed bool) *Capt
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CaptureScreenshotArgs) Validate() error {
+	if a.Format != nil {
+		switch *a.Format {
+		case "jpeg", "png", "webp":
+		default:
+			return errors.New("page.CaptureScreenshotArgs: Format: invalid value " + strconv.Quote(*a.Format))
+		}
+	}
+	return nil
+}
+
 // CaptureScreenshotReply represents the return values for CaptureScreenshot in the Page domain.
 type CaptureScreenshotReply struct {
 	Data []byte `json:"data"` // Base64-encoded image data. (Encoded as a base64 string when passed over JSON)
@@ -205,6 +233,19 @@ func (a *CaptureSnapshotArgs) SetFormat(format string) *CaptureSnapshotArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CaptureSnapshotArgs) Validate() error {
+	if a.Format != nil {
+		switch *a.Format {
+		case "mhtml":
+		default:
+			return errors.New("page.CaptureSnapshotArgs: Format: invalid value " + strconv.Quote(*a.Format))
+		}
+	}
+	return nil
+}
+
 // CaptureSnapshotReply represents the return values for CaptureSnapshot in the Page domain.
 type CaptureSnapshotReply struct {
 	Data string `json:"data"` // Serialized page data.
@@ -239,6 +280,12 @@ func (a *CreateIsolatedWorldArgs) SetGrantUniveralAccess(grantUniveralAccess boo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CreateIsolatedWorldArgs) Validate() error {
+	return nil
+}
+
 // CreateIsolatedWorldReply represents the return values for CreateIsolatedWorld in the Page domain.
 type CreateIsolatedWorldReply struct {
 	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"` // Execution context of the isolated world.
@@ -272,6 +319,12 @@ func (a *EnableArgs) SetEnableFileChooserOpenedEvent(enableFileChooserOpenedEven
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // GetAppManifestArgs represents the arguments for GetAppManifest in the Page domain.
 type GetAppManifestArgs struct {
 	ManifestID *string `json:"manifestId,omitempty"` // No description.
@@ -290,6 +343,12 @@ func (a *GetAppManifestArgs) SetManifestID(manifestID string) *GetAppManifestArg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAppManifestArgs) Validate() error {
+	return nil
+}
+
 // GetAppManifestReply represents the return values for GetAppManifest in the Page domain.
 type GetAppManifestReply struct {
 	URL    string             `json:"url"`            // Manifest location.
@@ -336,6 +395,12 @@ func NewGetAdScriptAncestryArgs(frameID FrameID) *GetAdScriptAncestryArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAdScriptAncestryArgs) Validate() error {
+	return nil
+}
+
 // GetAdScriptAncestryReply represents the return values for GetAdScriptAncestry in the Page domain.
 type GetAdScriptAncestryReply struct {
 	AdScriptAncestry *AdScriptAncestry `json:"adScriptAncestry,omitempty"` // The ancestry chain of ad script identifiers leading to this frame's creation, along with the root script's filterlist rule. The ancestry chain is ordered from the most immediate script (in the frame creation stack) to more distant ancestors (that created the immediately preceding script). Only sent if frame is labeled as an ad and ids are available.
@@ -388,6 +453,12 @@ func NewGetResourceContentArgs(frameID FrameID, url string) *GetResourceContentA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetResourceContentArgs) Validate() error {
+	return nil
+}
+
 // GetResourceContentReply represents the return values for GetResourceContent in the Page domain.
 type GetResourceContentReply struct {
 	Content       string `json:"content"`       // Resource content.
@@ -420,6 +491,12 @@ func (a *HandleJavaScriptDialogArgs) SetPromptText(promptText string) *HandleJav
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HandleJavaScriptDialogArgs) Validate() error {
+	return nil
+}
+
 // NavigateArgs represents the arguments for Navigate in the Page domain.
 type NavigateArgs struct {
 	URL            string         `json:"url"`                      // URL to navigate the page to.
@@ -468,6 +545,22 @@ func (a *NavigateArgs) SetReferrerPolicy(referrerPolicy ReferrerPolicy) *Navigat
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *NavigateArgs) Validate() error {
+	if a.TransitionType != "" {
+		if !a.TransitionType.Valid() {
+			return errors.New("page.NavigateArgs: TransitionType: invalid value " + strconv.Quote(string(a.TransitionType)))
+		}
+	}
+	if a.ReferrerPolicy != "" {
+		if !a.ReferrerPolicy.Valid() {
+			return errors.New("page.NavigateArgs: ReferrerPolicy: invalid value " + strconv.Quote(string(a.ReferrerPolicy)))
+		}
+	}
+	return nil
+}
+
 // NavigateReply represents the return values for Navigate in the Page domain.
 type NavigateReply struct {
 	FrameID   FrameID           `json:"frameId"`             // Frame id that has navigated (or failed to navigate)
@@ -491,6 +584,12 @@ func NewNavigateToHistoryEntryArgs(entryID int) *NavigateToHistoryEntryArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *NavigateToHistoryEntryArgs) Validate() error {
+	return nil
+}
+
 // PrintToPDFArgs represents the arguments for PrintToPDF in the Page domain.
 type PrintToPDFArgs struct {
 	Landscape           *bool    `json:"landscape,omitempty"`           // Paper orientation. Defaults to false.
@@ -676,6 +775,19 @@ func (a *PrintToPDFArgs) SetGenerateDocumentOutline(generateDocumentOutline bool
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *PrintToPDFArgs) Validate() error {
+	if a.TransferMode != nil {
+		switch *a.TransferMode {
+		case "ReturnAsBase64", "ReturnAsStream":
+		default:
+			return errors.New("page.PrintToPDFArgs: TransferMode: invalid value " + strconv.Quote(*a.TransferMode))
+		}
+	}
+	return nil
+}
+
 // PrintToPDFReply represents the return values for PrintToPDF in the Page domain.
 type PrintToPDFReply struct {
 	Data []byte `json:"data"` // Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON)
@@ -732,6 +844,12 @@ func (a *ReloadArgs) SetLoaderID(loaderID network.LoaderID) *ReloadArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReloadArgs) Validate() error {
+	return nil
+}
+
 // RemoveScriptToEvaluateOnLoadArgs represents the arguments for RemoveScriptToEvaluateOnLoad in the Page domain.
 type RemoveScriptToEvaluateOnLoadArgs struct {
 	Identifier ScriptIdentifier `json:"identifier"` // No description.
@@ -744,6 +862,12 @@ func NewRemoveScriptToEvaluateOnLoadArgs(identifier ScriptIdentifier) *RemoveScr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveScriptToEvaluateOnLoadArgs) Validate() error {
+	return nil
+}
+
 // RemoveScriptToEvaluateOnNewDocumentArgs represents the arguments for RemoveScriptToEvaluateOnNewDocument in the Page domain.
 type RemoveScriptToEvaluateOnNewDocumentArgs struct {
 	Identifier ScriptIdentifier `json:"identifier"` // No description.
@@ -756,6 +880,12 @@ func NewRemoveScriptToEvaluateOnNewDocumentArgs(identifier ScriptIdentifier) *Re
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveScriptToEvaluateOnNewDocumentArgs) Validate() error {
+	return nil
+}
+
 // ScreencastFrameAckArgs represents the arguments for ScreencastFrameAck in the Page domain.
 type ScreencastFrameAckArgs struct {
 	SessionID int `json:"sessionId"` // Frame number.
@@ -768,6 +898,12 @@ func NewScreencastFrameAckArgs(sessionID int) *ScreencastFrameAckArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ScreencastFrameAckArgs) Validate() error {
+	return nil
+}
+
 // SearchInResourceArgs represents the arguments for SearchInResource in the Page domain.
 type SearchInResourceArgs struct {
 	FrameID       FrameID `json:"frameId"`                 // Frame id for resource to search in.
@@ -800,6 +936,12 @@ func (a *SearchInResourceArgs) SetIsRegex(isRegex bool) *SearchInResourceArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SearchInResourceArgs) Validate() error {
+	return nil
+}
+
 // SearchInResourceReply represents the return values for SearchInResource in the Page domain.
 type SearchInResourceReply struct {
 	Result []debugger.SearchMatch `json:"result"` // List of search matches.
@@ -817,6 +959,12 @@ func NewSetAdBlockingEnabledArgs(enabled bool) *SetAdBlockingEnabledArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAdBlockingEnabledArgs) Validate() error {
+	return nil
+}
+
 // SetBypassCSPArgs represents the arguments for SetBypassCSP in the Page domain.
 type SetBypassCSPArgs struct {
 	Enabled bool `json:"enabled"` // Whether to bypass page CSP.
@@ -829,6 +977,12 @@ func NewSetBypassCSPArgs(enabled bool) *SetBypassCSPArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetBypassCSPArgs) Validate() error {
+	return nil
+}
+
 // GetPermissionsPolicyStateArgs represents the arguments for GetPermissionsPolicyState in the Page domain.
 type GetPermissionsPolicyStateArgs struct {
 	FrameID FrameID `json:"frameId"` // No description.
@@ -841,6 +995,12 @@ func NewGetPermissionsPolicyStateArgs(frameID FrameID) *GetPermissionsPolicyStat
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetPermissionsPolicyStateArgs) Validate() error {
+	return nil
+}
+
 // GetPermissionsPolicyStateReply represents the return values for GetPermissionsPolicyState in the Page domain.
 type GetPermissionsPolicyStateReply struct {
 	States []PermissionsPolicyFeatureState `json:"states"` // No description.
@@ -858,6 +1018,12 @@ func NewGetOriginTrialsArgs(frameID FrameID) *GetOriginTrialsArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetOriginTrialsArgs) Validate() error {
+	return nil
+}
+
 // GetOriginTrialsReply represents the return values for GetOriginTrials in the Page domain.
 type GetOriginTrialsReply struct {
 	OriginTrials []OriginTrial `json:"originTrials"` // No description.
@@ -883,6 +1049,12 @@ func (a *SetFontFamiliesArgs) SetForScripts(forScripts []ScriptFontFamilies) *Se
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetFontFamiliesArgs) Validate() error {
+	return nil
+}
+
 // SetFontSizesArgs represents the arguments for SetFontSizes in the Page domain.
 type SetFontSizesArgs struct {
 	FontSizes FontSizes `json:"fontSizes"` // Specifies font sizes to set. If a font size is not specified, it won't be changed.
@@ -895,6 +1067,12 @@ func NewSetFontSizesArgs(fontSizes FontSizes) *SetFontSizesArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetFontSizesArgs) Validate() error {
+	return nil
+}
+
 // SetDocumentContentArgs represents the arguments for SetDocumentContent in the Page domain.
 type SetDocumentContentArgs struct {
 	FrameID FrameID `json:"frameId"` // Frame id to set HTML for.
@@ -909,6 +1087,12 @@ func NewSetDocumentContentArgs(frameID FrameID, html string) *SetDocumentContent
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDocumentContentArgs) Validate() error {
+	return nil
+}
+
 // SetDownloadBehaviorArgs represents the arguments for SetDownloadBehavior in the Page domain.
 type SetDownloadBehaviorArgs struct {
 	// Behavior Whether to allow all or deny all download requests, or use
@@ -934,6 +1118,17 @@ func (a *SetDownloadBehaviorArgs) SetDownloadPath(downloadPath string) *SetDownl
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDownloadBehaviorArgs) Validate() error {
+	switch a.Behavior {
+	case "deny", "allow", "default":
+	default:
+		return errors.New("page.SetDownloadBehaviorArgs: Behavior: invalid value " + strconv.Quote(a.Behavior))
+	}
+	return nil
+}
+
 // SetLifecycleEventsEnabledArgs represents the arguments for SetLifecycleEventsEnabled in the Page domain.
 type SetLifecycleEventsEnabledArgs struct {
 	Enabled bool `json:"enabled"` // If true, starts emitting lifecycle events.
@@ -946,6 +1141,12 @@ func NewSetLifecycleEventsEnabledArgs(enabled bool) *SetLifecycleEventsEnabledAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetLifecycleEventsEnabledArgs) Validate() error {
+	return nil
+}
+
 // StartScreencastArgs represents the arguments for StartScreencast in the Page domain.
 type StartScreencastArgs struct {
 	// Format Image compression format.
@@ -1002,6 +1203,19 @@ func (a *StartScreencastArgs) SetEveryNthFrame(everyNthFrame int) *StartScreenca
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartScreencastArgs) Validate() error {
+	if a.Format != nil {
+		switch *a.Format {
+		case "jpeg", "png":
+		default:
+			return errors.New("page.StartScreencastArgs: Format: invalid value " + strconv.Quote(*a.Format))
+		}
+	}
+	return nil
+}
+
 // SetWebLifecycleStateArgs represents the arguments for SetWebLifecycleState in the Page domain.
 type SetWebLifecycleStateArgs struct {
 	// State Target lifecycle state
@@ -1017,6 +1231,17 @@ func NewSetWebLifecycleStateArgs(state string) *SetWebLifecycleStateArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetWebLifecycleStateArgs) Validate() error {
+	switch a.State {
+	case "frozen", "active":
+	default:
+		return errors.New("page.SetWebLifecycleStateArgs: State: invalid value " + strconv.Quote(a.State))
+	}
+	return nil
+}
+
 // ProduceCompilationCacheArgs represents the arguments for ProduceCompilationCache in the Page domain.
 type ProduceCompilationCacheArgs struct {
 	Scripts []CompilationCacheParams `json:"scripts"` // No description.
@@ -1029,6 +1254,15 @@ func NewProduceCompilationCacheArgs(scripts []CompilationCacheParams) *ProduceCo
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ProduceCompilationCacheArgs) Validate() error {
+	if a.Scripts == nil {
+		return errors.New("page.ProduceCompilationCacheArgs: Scripts is required")
+	}
+	return nil
+}
+
 // AddCompilationCacheArgs represents the arguments for AddCompilationCache in the Page domain.
 type AddCompilationCacheArgs struct {
 	URL  string `json:"url"`  // No description.
@@ -1043,6 +1277,15 @@ func NewAddCompilationCacheArgs(url string, data []byte) *AddCompilationCacheArg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddCompilationCacheArgs) Validate() error {
+	if a.Data == nil {
+		return errors.New("page.AddCompilationCacheArgs: Data is required")
+	}
+	return nil
+}
+
 // SetSPCTransactionModeArgs represents the arguments for SetSPCTransactionMode in the Page domain.
 type SetSPCTransactionModeArgs struct {
 	// Mode
@@ -1058,6 +1301,17 @@ func NewSetSPCTransactionModeArgs(mode string) *SetSPCTransactionModeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSPCTransactionModeArgs) Validate() error {
+	switch a.Mode {
+	case "none", "autoAccept", "autoChooseToAuthAnotherWay", "autoReject", "autoOptOut":
+	default:
+		return errors.New("page.SetSPCTransactionModeArgs: Mode: invalid value " + strconv.Quote(a.Mode))
+	}
+	return nil
+}
+
 // SetRPHRegistrationModeArgs represents the arguments for SetRPHRegistrationMode in the Page domain.
 type SetRPHRegistrationModeArgs struct {
 	// Mode
@@ -1073,6 +1327,17 @@ func NewSetRPHRegistrationModeArgs(mode string) *SetRPHRegistrationModeArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRPHRegistrationModeArgs) Validate() error {
+	switch a.Mode {
+	case "none", "autoAccept", "autoReject":
+	default:
+		return errors.New("page.SetRPHRegistrationModeArgs: Mode: invalid value " + strconv.Quote(a.Mode))
+	}
+	return nil
+}
+
 // GenerateTestReportArgs represents the arguments for GenerateTestReport in the Page domain.
 type GenerateTestReportArgs struct {
 	Message string  `json:"message"`         // Message to be displayed in the report.
@@ -1093,6 +1358,12 @@ func (a *GenerateTestReportArgs) SetGroup(group string) *GenerateTestReportArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GenerateTestReportArgs) Validate() error {
+	return nil
+}
+
 // SetInterceptFileChooserDialogArgs represents the arguments for SetInterceptFileChooserDialog in the Page domain.
 type SetInterceptFileChooserDialogArgs struct {
 	Enabled bool `json:"enabled"` // No description.
@@ -1121,6 +1392,12 @@ func (a *SetInterceptFileChooserDialogArgs) SetCancel(cancel bool) *SetIntercept
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInterceptFileChooserDialogArgs) Validate() error {
+	return nil
+}
+
 // SetPrerenderingAllowedArgs represents the arguments for SetPrerenderingAllowed in the Page domain.
 type SetPrerenderingAllowedArgs struct {
 	IsAllowed bool `json:"isAllowed"` // No description.
@@ -1133,6 +1410,12 @@ func NewSetPrerenderingAllowedArgs(isAllowed bool) *SetPrerenderingAllowedArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPrerenderingAllowedArgs) Validate() error {
+	return nil
+}
+
 // GetAnnotatedPageContentArgs represents the arguments for GetAnnotatedPageContent in the Page domain.
 type GetAnnotatedPageContentArgs struct {
 	IncludeActionableInformation *bool `json:"includeActionableInformation,omitempty"` // Whether to include actionable information. Defaults to true.
@@ -1152,6 +1435,12 @@ func (a *GetAnnotatedPageContentArgs) SetIncludeActionableInformation(includeAct
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAnnotatedPageContentArgs) Validate() error {
+	return nil
+}
+
 // GetAnnotatedPageContentReply represents the return values for GetAnnotatedPageContent in the Page domain.
 type GetAnnotatedPageContentReply struct {
 	Content []byte `json:"content"` // The annotated page content as a base64 encoded protobuf. The format is defined by the `AnnotatedPageContent` message in components/optimization_guide/proto/features/common_quality_data.proto (Encoded as a base64 string when passed over JSON)
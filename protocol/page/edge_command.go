@@ -0,0 +1,36 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package page
+
+// CanScreencastReply represents the return values for CanScreencast in the Page domain.
+type CanScreencastReply struct {
+	Result bool `json:"result"` // True if screencast is supported.
+}
+
+// SetShowViewportSizeOnResizeArgs represents the arguments for SetShowViewportSizeOnResize in the Page domain.
+type SetShowViewportSizeOnResizeArgs struct {
+	Show     bool  `json:"show"`               // Whether to paint size or not.
+	ShowGrid *bool `json:"showGrid,omitempty"` // Whether to paint grid as well.
+}
+
+// NewSetShowViewportSizeOnResizeArgs initializes SetShowViewportSizeOnResizeArgs with the required arguments.
+func NewSetShowViewportSizeOnResizeArgs(show bool) *SetShowViewportSizeOnResizeArgs {
+	args := new(SetShowViewportSizeOnResizeArgs)
+	args.Show = show
+	return args
+}
+
+// SetShowGrid sets the ShowGrid optional argument. Whether to paint
+// grid as well.
+func (a *SetShowViewportSizeOnResizeArgs) SetShowGrid(showGrid bool) *SetShowViewportSizeOnResizeArgs {
+	a.ShowGrid = &showGrid
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetShowViewportSizeOnResizeArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,37 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package page
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// CanScreencast invokes the Page method. Tells whether screencast is
+// supported.
+func (d *domainClient) CanScreencast(ctx context.Context) (reply *CanScreencastReply, err error) {
+	reply = new(CanScreencastReply)
+	err = rpcc.Invoke(ctx, "Page.canScreencast", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "CanScreencast", Err: err}
+	}
+	return
+}
+
+// SetShowViewportSizeOnResize invokes the Page method. Paints viewport size
+// upon main frame resize.
+func (d *domainClient) SetShowViewportSizeOnResize(ctx context.Context, args *SetShowViewportSizeOnResizeArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Page.setShowViewportSizeOnResize", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Page.setShowViewportSizeOnResize", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "SetShowViewportSizeOnResize", Err: err}
+	}
+	return
+}
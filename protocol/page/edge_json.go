@@ -0,0 +1,66 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package page
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanScreencastReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanScreencastReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowViewportSizeOnResizeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	if v.ShowGrid != nil {
+		w.Key("showGrid")
+		w.Bool(*v.ShowGrid)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowViewportSizeOnResizeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		case "showGrid":
+			if r.Null() {
+				v.ShowGrid = nil
+			} else {
+				p := r.Bool()
+				v.ShowGrid = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
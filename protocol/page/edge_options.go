@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package page
+
+// SetShowViewportSizeOnResizeOption sets an optional argument of SetShowViewportSizeOnResizeArgs.
+type SetShowViewportSizeOnResizeOption func(*SetShowViewportSizeOnResizeArgs)
+
+// NewSetShowViewportSizeOnResizeArgsWith initializes SetShowViewportSizeOnResizeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetShowViewportSizeOnResizeArgsWith(show bool, opts ...SetShowViewportSizeOnResizeOption) *SetShowViewportSizeOnResizeArgs {
+	args := NewSetShowViewportSizeOnResizeArgs(show)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetShowViewportSizeOnResizeWithShowGrid sets the ShowGrid optional argument, see
+// SetShowViewportSizeOnResizeArgs.SetShowGrid.
+func SetShowViewportSizeOnResizeWithShowGrid(v bool) SetShowViewportSizeOnResizeOption {
+	return func(a *SetShowViewportSizeOnResizeArgs) { a.SetShowGrid(v) }
+}
@@ -0,0 +1,38 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package page
+
+// ResourceType Resource type as it was perceived by the rendering engine.
+type ResourceType string
+
+// ResourceType as enums.
+const (
+	ResourceTypeNotSet      ResourceType = ""
+	ResourceTypeDocument    ResourceType = "Document"
+	ResourceTypeStylesheet  ResourceType = "Stylesheet"
+	ResourceTypeImage       ResourceType = "Image"
+	ResourceTypeMedia       ResourceType = "Media"
+	ResourceTypeFont        ResourceType = "Font"
+	ResourceTypeScript      ResourceType = "Script"
+	ResourceTypeTextTrack   ResourceType = "TextTrack"
+	ResourceTypeXHR         ResourceType = "XHR"
+	ResourceTypeFetch       ResourceType = "Fetch"
+	ResourceTypeEventSource ResourceType = "EventSource"
+	ResourceTypeWebSocket   ResourceType = "WebSocket"
+	ResourceTypeOther       ResourceType = "Other"
+)
+
+func (e ResourceType) Valid() bool {
+	switch e {
+	case "Document", "Stylesheet", "Image", "Media", "Font", "Script", "TextTrack", "XHR", "Fetch", "EventSource", "WebSocket", "Other":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e ResourceType) String() string {
+	return string(e)
+}
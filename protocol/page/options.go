@@ -0,0 +1,594 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+// AddScriptToEvaluateOnNewDocumentOption sets an optional argument of AddScriptToEvaluateOnNewDocumentArgs.
+type AddScriptToEvaluateOnNewDocumentOption func(*AddScriptToEvaluateOnNewDocumentArgs)
+
+// NewAddScriptToEvaluateOnNewDocumentArgsWith initializes AddScriptToEvaluateOnNewDocumentArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAddScriptToEvaluateOnNewDocumentArgsWith(source string, opts ...AddScriptToEvaluateOnNewDocumentOption) *AddScriptToEvaluateOnNewDocumentArgs {
+	args := NewAddScriptToEvaluateOnNewDocumentArgs(source)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AddScriptToEvaluateOnNewDocumentWithWorldName sets the WorldName optional argument, see
+// AddScriptToEvaluateOnNewDocumentArgs.SetWorldName.
+//
+// Note: This argument is experimental.
+func AddScriptToEvaluateOnNewDocumentWithWorldName(v string) AddScriptToEvaluateOnNewDocumentOption {
+	return func(a *AddScriptToEvaluateOnNewDocumentArgs) { a.SetWorldName(v) }
+}
+
+// AddScriptToEvaluateOnNewDocumentWithIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument, see
+// AddScriptToEvaluateOnNewDocumentArgs.SetIncludeCommandLineAPI.
+//
+// Note: This argument is experimental.
+func AddScriptToEvaluateOnNewDocumentWithIncludeCommandLineAPI(v bool) AddScriptToEvaluateOnNewDocumentOption {
+	return func(a *AddScriptToEvaluateOnNewDocumentArgs) { a.SetIncludeCommandLineAPI(v) }
+}
+
+// AddScriptToEvaluateOnNewDocumentWithRunImmediately sets the RunImmediately optional argument, see
+// AddScriptToEvaluateOnNewDocumentArgs.SetRunImmediately.
+//
+// Note: This argument is experimental.
+func AddScriptToEvaluateOnNewDocumentWithRunImmediately(v bool) AddScriptToEvaluateOnNewDocumentOption {
+	return func(a *AddScriptToEvaluateOnNewDocumentArgs) { a.SetRunImmediately(v) }
+}
+
+// CaptureScreenshotOption sets an optional argument of CaptureScreenshotArgs.
+type CaptureScreenshotOption func(*CaptureScreenshotArgs)
+
+// NewCaptureScreenshotArgsWith initializes CaptureScreenshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCaptureScreenshotArgsWith(opts ...CaptureScreenshotOption) *CaptureScreenshotArgs {
+	args := NewCaptureScreenshotArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CaptureScreenshotWithFormat sets the Format optional argument, see
+// CaptureScreenshotArgs.SetFormat.
+func CaptureScreenshotWithFormat(v string) CaptureScreenshotOption {
+	return func(a *CaptureScreenshotArgs) { a.SetFormat(v) }
+}
+
+// CaptureScreenshotWithQuality sets the Quality optional argument, see
+// CaptureScreenshotArgs.SetQuality.
+func CaptureScreenshotWithQuality(v int) CaptureScreenshotOption {
+	return func(a *CaptureScreenshotArgs) { a.SetQuality(v) }
+}
+
+// CaptureScreenshotWithClip sets the Clip optional argument, see
+// CaptureScreenshotArgs.SetClip.
+func CaptureScreenshotWithClip(v Viewport) CaptureScreenshotOption {
+	return func(a *CaptureScreenshotArgs) { a.SetClip(v) }
+}
+
+// CaptureScreenshotWithFromSurface sets the FromSurface optional argument, see
+// CaptureScreenshotArgs.SetFromSurface.
+//
+// Note: This argument is experimental.
+func CaptureScreenshotWithFromSurface(v bool) CaptureScreenshotOption {
+	return func(a *CaptureScreenshotArgs) { a.SetFromSurface(v) }
+}
+
+// CaptureScreenshotWithCaptureBeyondViewport sets the CaptureBeyondViewport optional argument, see
+// CaptureScreenshotArgs.SetCaptureBeyondViewport.
+//
+// Note: This argument is experimental.
+func CaptureScreenshotWithCaptureBeyondViewport(v bool) CaptureScreenshotOption {
+	return func(a *CaptureScreenshotArgs) { a.SetCaptureBeyondViewport(v) }
+}
+
+// CaptureScreenshotWithOptimizeForSpeed sets the OptimizeForSpeed optional argument, see
+// CaptureScreenshotArgs.SetOptimizeForSpeed.
+//
+// Note: This argument is experimental.
+func CaptureScreenshotWithOptimizeForSpeed(v bool) CaptureScreenshotOption {
+	return func(a *CaptureScreenshotArgs) { a.SetOptimizeForSpeed(v) }
+}
+
+// CaptureSnapshotOption sets an optional argument of CaptureSnapshotArgs.
+type CaptureSnapshotOption func(*CaptureSnapshotArgs)
+
+// NewCaptureSnapshotArgsWith initializes CaptureSnapshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCaptureSnapshotArgsWith(opts ...CaptureSnapshotOption) *CaptureSnapshotArgs {
+	args := NewCaptureSnapshotArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CaptureSnapshotWithFormat sets the Format optional argument, see
+// CaptureSnapshotArgs.SetFormat.
+func CaptureSnapshotWithFormat(v string) CaptureSnapshotOption {
+	return func(a *CaptureSnapshotArgs) { a.SetFormat(v) }
+}
+
+// CreateIsolatedWorldOption sets an optional argument of CreateIsolatedWorldArgs.
+type CreateIsolatedWorldOption func(*CreateIsolatedWorldArgs)
+
+// NewCreateIsolatedWorldArgsWith initializes CreateIsolatedWorldArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCreateIsolatedWorldArgsWith(frameID FrameID, opts ...CreateIsolatedWorldOption) *CreateIsolatedWorldArgs {
+	args := NewCreateIsolatedWorldArgs(frameID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CreateIsolatedWorldWithWorldName sets the WorldName optional argument, see
+// CreateIsolatedWorldArgs.SetWorldName.
+func CreateIsolatedWorldWithWorldName(v string) CreateIsolatedWorldOption {
+	return func(a *CreateIsolatedWorldArgs) { a.SetWorldName(v) }
+}
+
+// CreateIsolatedWorldWithGrantUniveralAccess sets the GrantUniveralAccess optional argument, see
+// CreateIsolatedWorldArgs.SetGrantUniveralAccess.
+func CreateIsolatedWorldWithGrantUniveralAccess(v bool) CreateIsolatedWorldOption {
+	return func(a *CreateIsolatedWorldArgs) { a.SetGrantUniveralAccess(v) }
+}
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithEnableFileChooserOpenedEvent sets the EnableFileChooserOpenedEvent optional argument, see
+// EnableArgs.SetEnableFileChooserOpenedEvent.
+//
+// Note: This argument is experimental.
+func EnableWithEnableFileChooserOpenedEvent(v bool) EnableOption {
+	return func(a *EnableArgs) { a.SetEnableFileChooserOpenedEvent(v) }
+}
+
+// GetAppManifestOption sets an optional argument of GetAppManifestArgs.
+type GetAppManifestOption func(*GetAppManifestArgs)
+
+// NewGetAppManifestArgsWith initializes GetAppManifestArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetAppManifestArgsWith(opts ...GetAppManifestOption) *GetAppManifestArgs {
+	args := NewGetAppManifestArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetAppManifestWithManifestID sets the ManifestID optional argument, see
+// GetAppManifestArgs.SetManifestID.
+func GetAppManifestWithManifestID(v string) GetAppManifestOption {
+	return func(a *GetAppManifestArgs) { a.SetManifestID(v) }
+}
+
+// HandleJavaScriptDialogOption sets an optional argument of HandleJavaScriptDialogArgs.
+type HandleJavaScriptDialogOption func(*HandleJavaScriptDialogArgs)
+
+// NewHandleJavaScriptDialogArgsWith initializes HandleJavaScriptDialogArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewHandleJavaScriptDialogArgsWith(accept bool, opts ...HandleJavaScriptDialogOption) *HandleJavaScriptDialogArgs {
+	args := NewHandleJavaScriptDialogArgs(accept)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// HandleJavaScriptDialogWithPromptText sets the PromptText optional argument, see
+// HandleJavaScriptDialogArgs.SetPromptText.
+func HandleJavaScriptDialogWithPromptText(v string) HandleJavaScriptDialogOption {
+	return func(a *HandleJavaScriptDialogArgs) { a.SetPromptText(v) }
+}
+
+// NavigateOption sets an optional argument of NavigateArgs.
+type NavigateOption func(*NavigateArgs)
+
+// NewNavigateArgsWith initializes NavigateArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewNavigateArgsWith(url string, opts ...NavigateOption) *NavigateArgs {
+	args := NewNavigateArgs(url)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// NavigateWithReferrer sets the Referrer optional argument, see
+// NavigateArgs.SetReferrer.
+func NavigateWithReferrer(v string) NavigateOption {
+	return func(a *NavigateArgs) { a.SetReferrer(v) }
+}
+
+// NavigateWithTransitionType sets the TransitionType optional argument, see
+// NavigateArgs.SetTransitionType.
+func NavigateWithTransitionType(v TransitionType) NavigateOption {
+	return func(a *NavigateArgs) { a.SetTransitionType(v) }
+}
+
+// NavigateWithFrameID sets the FrameID optional argument, see
+// NavigateArgs.SetFrameID.
+func NavigateWithFrameID(v FrameID) NavigateOption {
+	return func(a *NavigateArgs) { a.SetFrameID(v) }
+}
+
+// NavigateWithReferrerPolicy sets the ReferrerPolicy optional argument, see
+// NavigateArgs.SetReferrerPolicy.
+//
+// Note: This argument is experimental.
+func NavigateWithReferrerPolicy(v ReferrerPolicy) NavigateOption {
+	return func(a *NavigateArgs) { a.SetReferrerPolicy(v) }
+}
+
+// PrintToPDFOption sets an optional argument of PrintToPDFArgs.
+type PrintToPDFOption func(*PrintToPDFArgs)
+
+// NewPrintToPDFArgsWith initializes PrintToPDFArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewPrintToPDFArgsWith(opts ...PrintToPDFOption) *PrintToPDFArgs {
+	args := NewPrintToPDFArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// PrintToPDFWithLandscape sets the Landscape optional argument, see
+// PrintToPDFArgs.SetLandscape.
+func PrintToPDFWithLandscape(v bool) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetLandscape(v) }
+}
+
+// PrintToPDFWithDisplayHeaderFooter sets the DisplayHeaderFooter optional argument, see
+// PrintToPDFArgs.SetDisplayHeaderFooter.
+func PrintToPDFWithDisplayHeaderFooter(v bool) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetDisplayHeaderFooter(v) }
+}
+
+// PrintToPDFWithPrintBackground sets the PrintBackground optional argument, see
+// PrintToPDFArgs.SetPrintBackground.
+func PrintToPDFWithPrintBackground(v bool) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetPrintBackground(v) }
+}
+
+// PrintToPDFWithScale sets the Scale optional argument, see
+// PrintToPDFArgs.SetScale.
+func PrintToPDFWithScale(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetScale(v) }
+}
+
+// PrintToPDFWithPaperWidth sets the PaperWidth optional argument, see
+// PrintToPDFArgs.SetPaperWidth.
+func PrintToPDFWithPaperWidth(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetPaperWidth(v) }
+}
+
+// PrintToPDFWithPaperHeight sets the PaperHeight optional argument, see
+// PrintToPDFArgs.SetPaperHeight.
+func PrintToPDFWithPaperHeight(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetPaperHeight(v) }
+}
+
+// PrintToPDFWithMarginTop sets the MarginTop optional argument, see
+// PrintToPDFArgs.SetMarginTop.
+func PrintToPDFWithMarginTop(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetMarginTop(v) }
+}
+
+// PrintToPDFWithMarginBottom sets the MarginBottom optional argument, see
+// PrintToPDFArgs.SetMarginBottom.
+func PrintToPDFWithMarginBottom(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetMarginBottom(v) }
+}
+
+// PrintToPDFWithMarginLeft sets the MarginLeft optional argument, see
+// PrintToPDFArgs.SetMarginLeft.
+func PrintToPDFWithMarginLeft(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetMarginLeft(v) }
+}
+
+// PrintToPDFWithMarginRight sets the MarginRight optional argument, see
+// PrintToPDFArgs.SetMarginRight.
+func PrintToPDFWithMarginRight(v float64) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetMarginRight(v) }
+}
+
+// PrintToPDFWithPageRanges sets the PageRanges optional argument, see
+// PrintToPDFArgs.SetPageRanges.
+func PrintToPDFWithPageRanges(v string) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetPageRanges(v) }
+}
+
+// PrintToPDFWithHeaderTemplate sets the HeaderTemplate optional argument, see
+// PrintToPDFArgs.SetHeaderTemplate.
+func PrintToPDFWithHeaderTemplate(v string) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetHeaderTemplate(v) }
+}
+
+// PrintToPDFWithFooterTemplate sets the FooterTemplate optional argument, see
+// PrintToPDFArgs.SetFooterTemplate.
+func PrintToPDFWithFooterTemplate(v string) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetFooterTemplate(v) }
+}
+
+// PrintToPDFWithPreferCSSPageSize sets the PreferCSSPageSize optional argument, see
+// PrintToPDFArgs.SetPreferCSSPageSize.
+func PrintToPDFWithPreferCSSPageSize(v bool) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetPreferCSSPageSize(v) }
+}
+
+// PrintToPDFWithTransferMode sets the TransferMode optional argument, see
+// PrintToPDFArgs.SetTransferMode.
+//
+// Note: This argument is experimental.
+func PrintToPDFWithTransferMode(v string) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetTransferMode(v) }
+}
+
+// PrintToPDFWithGenerateTaggedPDF sets the GenerateTaggedPDF optional argument, see
+// PrintToPDFArgs.SetGenerateTaggedPDF.
+//
+// Note: This argument is experimental.
+func PrintToPDFWithGenerateTaggedPDF(v bool) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetGenerateTaggedPDF(v) }
+}
+
+// PrintToPDFWithGenerateDocumentOutline sets the GenerateDocumentOutline optional argument, see
+// PrintToPDFArgs.SetGenerateDocumentOutline.
+//
+// Note: This argument is experimental.
+func PrintToPDFWithGenerateDocumentOutline(v bool) PrintToPDFOption {
+	return func(a *PrintToPDFArgs) { a.SetGenerateDocumentOutline(v) }
+}
+
+// ReloadOption sets an optional argument of ReloadArgs.
+type ReloadOption func(*ReloadArgs)
+
+// NewReloadArgsWith initializes ReloadArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewReloadArgsWith(opts ...ReloadOption) *ReloadArgs {
+	args := NewReloadArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ReloadWithIgnoreCache sets the IgnoreCache optional argument, see
+// ReloadArgs.SetIgnoreCache.
+func ReloadWithIgnoreCache(v bool) ReloadOption {
+	return func(a *ReloadArgs) { a.SetIgnoreCache(v) }
+}
+
+// ReloadWithScriptToEvaluateOnLoad sets the ScriptToEvaluateOnLoad optional argument, see
+// ReloadArgs.SetScriptToEvaluateOnLoad.
+func ReloadWithScriptToEvaluateOnLoad(v string) ReloadOption {
+	return func(a *ReloadArgs) { a.SetScriptToEvaluateOnLoad(v) }
+}
+
+// ReloadWithLoaderID sets the LoaderID optional argument, see
+// ReloadArgs.SetLoaderID.
+//
+// Note: This argument is experimental.
+func ReloadWithLoaderID(v network.LoaderID) ReloadOption {
+	return func(a *ReloadArgs) { a.SetLoaderID(v) }
+}
+
+// SearchInResourceOption sets an optional argument of SearchInResourceArgs.
+type SearchInResourceOption func(*SearchInResourceArgs)
+
+// NewSearchInResourceArgsWith initializes SearchInResourceArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSearchInResourceArgsWith(frameID FrameID, url string, query string, opts ...SearchInResourceOption) *SearchInResourceArgs {
+	args := NewSearchInResourceArgs(frameID, url, query)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SearchInResourceWithCaseSensitive sets the CaseSensitive optional argument, see
+// SearchInResourceArgs.SetCaseSensitive.
+func SearchInResourceWithCaseSensitive(v bool) SearchInResourceOption {
+	return func(a *SearchInResourceArgs) { a.SetCaseSensitive(v) }
+}
+
+// SearchInResourceWithIsRegex sets the IsRegex optional argument, see
+// SearchInResourceArgs.SetIsRegex.
+func SearchInResourceWithIsRegex(v bool) SearchInResourceOption {
+	return func(a *SearchInResourceArgs) { a.SetIsRegex(v) }
+}
+
+// SetFontFamiliesOption sets an optional argument of SetFontFamiliesArgs.
+type SetFontFamiliesOption func(*SetFontFamiliesArgs)
+
+// NewSetFontFamiliesArgsWith initializes SetFontFamiliesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetFontFamiliesArgsWith(fontFamilies FontFamilies, opts ...SetFontFamiliesOption) *SetFontFamiliesArgs {
+	args := NewSetFontFamiliesArgs(fontFamilies)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetFontFamiliesWithForScripts sets the ForScripts optional argument, see
+// SetFontFamiliesArgs.SetForScripts.
+func SetFontFamiliesWithForScripts(v []ScriptFontFamilies) SetFontFamiliesOption {
+	return func(a *SetFontFamiliesArgs) { a.SetForScripts(v) }
+}
+
+// SetDownloadBehaviorOption sets an optional argument of SetDownloadBehaviorArgs.
+type SetDownloadBehaviorOption func(*SetDownloadBehaviorArgs)
+
+// NewSetDownloadBehaviorArgsWith initializes SetDownloadBehaviorArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDownloadBehaviorArgsWith(behavior string, opts ...SetDownloadBehaviorOption) *SetDownloadBehaviorArgs {
+	args := NewSetDownloadBehaviorArgs(behavior)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDownloadBehaviorWithDownloadPath sets the DownloadPath optional argument, see
+// SetDownloadBehaviorArgs.SetDownloadPath.
+func SetDownloadBehaviorWithDownloadPath(v string) SetDownloadBehaviorOption {
+	return func(a *SetDownloadBehaviorArgs) { a.SetDownloadPath(v) }
+}
+
+// StartScreencastOption sets an optional argument of StartScreencastArgs.
+type StartScreencastOption func(*StartScreencastArgs)
+
+// NewStartScreencastArgsWith initializes StartScreencastArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStartScreencastArgsWith(opts ...StartScreencastOption) *StartScreencastArgs {
+	args := NewStartScreencastArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StartScreencastWithFormat sets the Format optional argument, see
+// StartScreencastArgs.SetFormat.
+func StartScreencastWithFormat(v string) StartScreencastOption {
+	return func(a *StartScreencastArgs) { a.SetFormat(v) }
+}
+
+// StartScreencastWithQuality sets the Quality optional argument, see
+// StartScreencastArgs.SetQuality.
+func StartScreencastWithQuality(v int) StartScreencastOption {
+	return func(a *StartScreencastArgs) { a.SetQuality(v) }
+}
+
+// StartScreencastWithMaxWidth sets the MaxWidth optional argument, see
+// StartScreencastArgs.SetMaxWidth.
+func StartScreencastWithMaxWidth(v int) StartScreencastOption {
+	return func(a *StartScreencastArgs) { a.SetMaxWidth(v) }
+}
+
+// StartScreencastWithMaxHeight sets the MaxHeight optional argument, see
+// StartScreencastArgs.SetMaxHeight.
+func StartScreencastWithMaxHeight(v int) StartScreencastOption {
+	return func(a *StartScreencastArgs) { a.SetMaxHeight(v) }
+}
+
+// StartScreencastWithEveryNthFrame sets the EveryNthFrame optional argument, see
+// StartScreencastArgs.SetEveryNthFrame.
+func StartScreencastWithEveryNthFrame(v int) StartScreencastOption {
+	return func(a *StartScreencastArgs) { a.SetEveryNthFrame(v) }
+}
+
+// GenerateTestReportOption sets an optional argument of GenerateTestReportArgs.
+type GenerateTestReportOption func(*GenerateTestReportArgs)
+
+// NewGenerateTestReportArgsWith initializes GenerateTestReportArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGenerateTestReportArgsWith(message string, opts ...GenerateTestReportOption) *GenerateTestReportArgs {
+	args := NewGenerateTestReportArgs(message)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GenerateTestReportWithGroup sets the Group optional argument, see
+// GenerateTestReportArgs.SetGroup.
+func GenerateTestReportWithGroup(v string) GenerateTestReportOption {
+	return func(a *GenerateTestReportArgs) { a.SetGroup(v) }
+}
+
+// SetInterceptFileChooserDialogOption sets an optional argument of SetInterceptFileChooserDialogArgs.
+type SetInterceptFileChooserDialogOption func(*SetInterceptFileChooserDialogArgs)
+
+// NewSetInterceptFileChooserDialogArgsWith initializes SetInterceptFileChooserDialogArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetInterceptFileChooserDialogArgsWith(enabled bool, opts ...SetInterceptFileChooserDialogOption) *SetInterceptFileChooserDialogArgs {
+	args := NewSetInterceptFileChooserDialogArgs(enabled)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetInterceptFileChooserDialogWithCancel sets the Cancel optional argument, see
+// SetInterceptFileChooserDialogArgs.SetCancel.
+//
+// Note: This argument is experimental.
+func SetInterceptFileChooserDialogWithCancel(v bool) SetInterceptFileChooserDialogOption {
+	return func(a *SetInterceptFileChooserDialogArgs) { a.SetCancel(v) }
+}
+
+// GetAnnotatedPageContentOption sets an optional argument of GetAnnotatedPageContentArgs.
+type GetAnnotatedPageContentOption func(*GetAnnotatedPageContentArgs)
+
+// NewGetAnnotatedPageContentArgsWith initializes GetAnnotatedPageContentArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetAnnotatedPageContentArgsWith(opts ...GetAnnotatedPageContentOption) *GetAnnotatedPageContentArgs {
+	args := NewGetAnnotatedPageContentArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetAnnotatedPageContentWithIncludeActionableInformation sets the IncludeActionableInformation optional argument, see
+// GetAnnotatedPageContentArgs.SetIncludeActionableInformation.
+func GetAnnotatedPageContentWithIncludeActionableInformation(v bool) GetAnnotatedPageContentOption {
+	return func(a *GetAnnotatedPageContentArgs) { a.SetIncludeActionableInformation(v) }
+}
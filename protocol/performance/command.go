@@ -2,6 +2,11 @@
 
 package performance
 
+import (
+	"errors"
+	"strconv"
+)
+
 // EnableArgs represents the arguments for Enable in the Performance domain.
 type EnableArgs struct {
 	// TimeDomain Time domain to use for collecting and reporting duration
@@ -27,6 +32,19 @@ func (a *EnableArgs) SetTimeDomain(timeDomain string) *EnableArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	if a.TimeDomain != nil {
+		switch *a.TimeDomain {
+		case "timeTicks", "threadTicks":
+		default:
+			return errors.New("performance.EnableArgs: TimeDomain: invalid value " + strconv.Quote(*a.TimeDomain))
+		}
+	}
+	return nil
+}
+
 // SetTimeDomainArgs represents the arguments for SetTimeDomain in the Performance domain.
 type SetTimeDomainArgs struct {
 	// TimeDomain Time domain
@@ -42,6 +60,17 @@ func NewSetTimeDomainArgs(timeDomain string) *SetTimeDomainArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTimeDomainArgs) Validate() error {
+	switch a.TimeDomain {
+	case "timeTicks", "threadTicks":
+	default:
+		return errors.New("performance.SetTimeDomainArgs: TimeDomain: invalid value " + strconv.Quote(a.TimeDomain))
+	}
+	return nil
+}
+
 // GetMetricsReply represents the return values for GetMetrics in the Performance domain.
 type GetMetricsReply struct {
 	Metrics []Metric `json:"metrics"` // Current values for run-time metrics.
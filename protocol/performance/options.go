@@ -0,0 +1,24 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performance
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithTimeDomain sets the TimeDomain optional argument, see
+// EnableArgs.SetTimeDomain.
+func EnableWithTimeDomain(v string) EnableOption {
+	return func(a *EnableArgs) { a.SetTimeDomain(v) }
+}
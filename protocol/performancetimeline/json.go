@@ -0,0 +1,295 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performancetimeline
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LargestContentfulPaint) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("renderTime")
+	w.Marshal(v.RenderTime)
+	w.Key("loadTime")
+	w.Marshal(v.LoadTime)
+	w.Key("size")
+	w.Float(v.Size)
+	if v.ElementID != nil {
+		w.Key("elementId")
+		w.String(*v.ElementID)
+	}
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LargestContentfulPaint) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "renderTime":
+			r.Decode(&v.RenderTime)
+		case "loadTime":
+			r.Decode(&v.LoadTime)
+		case "size":
+			v.Size = r.Float()
+		case "elementId":
+			if r.Null() {
+				v.ElementID = nil
+			} else {
+				p := r.String()
+				v.ElementID = &p
+			}
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.NodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayoutShiftAttribution) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("previousRect")
+	v.PreviousRect.EncodeJSON(w)
+	w.Key("currentRect")
+	v.CurrentRect.EncodeJSON(w)
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayoutShiftAttribution) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "previousRect":
+			v.PreviousRect.DecodeJSON(r)
+		case "currentRect":
+			v.CurrentRect.DecodeJSON(r)
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.NodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayoutShift) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	w.Float(v.Value)
+	w.Key("hadRecentInput")
+	w.Bool(v.HadRecentInput)
+	w.Key("lastInputTime")
+	w.Marshal(v.LastInputTime)
+	w.Key("sources")
+	if v.Sources == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Sources {
+			v.Sources[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayoutShift) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = r.Float()
+		case "hadRecentInput":
+			v.HadRecentInput = r.Bool()
+		case "lastInputTime":
+			r.Decode(&v.LastInputTime)
+		case "sources":
+			if r.ArrayStart() {
+				v.Sources = make([]LayoutShiftAttribution, 0, 4)
+				for r.ArrayNext() {
+					var e0 LayoutShiftAttribution
+					e0.DecodeJSON(r)
+					v.Sources = append(v.Sources, e0)
+				}
+			} else {
+				v.Sources = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TimelineEvent) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("time")
+	w.Marshal(v.Time)
+	if v.Duration != nil {
+		w.Key("duration")
+		w.Float(*v.Duration)
+	}
+	if v.LcpDetails != nil {
+		w.Key("lcpDetails")
+		v.LcpDetails.EncodeJSON(w)
+	}
+	if v.LayoutShiftDetails != nil {
+		w.Key("layoutShiftDetails")
+		v.LayoutShiftDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TimelineEvent) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "type":
+			v.Type = r.String()
+		case "name":
+			v.Name = r.String()
+		case "time":
+			r.Decode(&v.Time)
+		case "duration":
+			if r.Null() {
+				v.Duration = nil
+			} else {
+				p := r.Float()
+				v.Duration = &p
+			}
+		case "lcpDetails":
+			if r.Null() {
+				v.LcpDetails = nil
+			} else {
+				var p LargestContentfulPaint
+				p.DecodeJSON(r)
+				v.LcpDetails = &p
+			}
+		case "layoutShiftDetails":
+			if r.Null() {
+				v.LayoutShiftDetails = nil
+			} else {
+				var p LayoutShift
+				p.DecodeJSON(r)
+				v.LayoutShiftDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventTypes")
+	if v.EventTypes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.EventTypes {
+			w.String(v.EventTypes[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventTypes":
+			if r.ArrayStart() {
+				v.EventTypes = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.EventTypes = append(v.EventTypes, e0)
+				}
+			} else {
+				v.EventTypes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TimelineEventAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("event")
+	v.Event.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TimelineEventAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "event":
+			v.Event.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -19,6 +19,12 @@ func NewSetSamplingIntervalArgs(interval int) *SetSamplingIntervalArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSamplingIntervalArgs) Validate() error {
+	return nil
+}
+
 // StartPreciseCoverageArgs represents the arguments for StartPreciseCoverage in the Profiler domain.
 type StartPreciseCoverageArgs struct {
 	CallCount             *bool `json:"callCount,omitempty"`             // Collect accurate call counts beyond simple 'covered' or 'not covered'.
@@ -54,6 +60,12 @@ func (a *StartPreciseCoverageArgs) SetAllowTriggeredUpdates(allowTriggeredUpdate
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartPreciseCoverageArgs) Validate() error {
+	return nil
+}
+
 // StartPreciseCoverageReply represents the return values for StartPreciseCoverage in the Profiler domain.
 type StartPreciseCoverageReply struct {
 	Timestamp float64 `json:"timestamp"` // Monotonically increasing time (in seconds) when the coverage update was taken in the backend.
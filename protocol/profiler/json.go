@@ -0,0 +1,665 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package profiler
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ProfileNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.Int(v.ID)
+	w.Key("callFrame")
+	v.CallFrame.EncodeJSON(w)
+	if v.HitCount != nil {
+		w.Key("hitCount")
+		w.Int(*v.HitCount)
+	}
+	if len(v.Children) > 0 {
+		w.Key("children")
+		w.ArrayStart()
+		for i0 := range v.Children {
+			w.Int(v.Children[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.DeoptReason != nil {
+		w.Key("deoptReason")
+		w.String(*v.DeoptReason)
+	}
+	if len(v.PositionTicks) > 0 {
+		w.Key("positionTicks")
+		w.ArrayStart()
+		for i0 := range v.PositionTicks {
+			v.PositionTicks[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ProfileNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.Int()
+		case "callFrame":
+			v.CallFrame.DecodeJSON(r)
+		case "hitCount":
+			if r.Null() {
+				v.HitCount = nil
+			} else {
+				p := r.Int()
+				v.HitCount = &p
+			}
+		case "children":
+			if r.ArrayStart() {
+				v.Children = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Children = append(v.Children, e0)
+				}
+			} else {
+				v.Children = nil
+			}
+		case "deoptReason":
+			if r.Null() {
+				v.DeoptReason = nil
+			} else {
+				p := r.String()
+				v.DeoptReason = &p
+			}
+		case "positionTicks":
+			if r.ArrayStart() {
+				v.PositionTicks = make([]PositionTickInfo, 0, 4)
+				for r.ArrayNext() {
+					var e0 PositionTickInfo
+					e0.DecodeJSON(r)
+					v.PositionTicks = append(v.PositionTicks, e0)
+				}
+			} else {
+				v.PositionTicks = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Profile) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("startTime")
+	w.Float(v.StartTime)
+	w.Key("endTime")
+	w.Float(v.EndTime)
+	if len(v.Samples) > 0 {
+		w.Key("samples")
+		w.ArrayStart()
+		for i0 := range v.Samples {
+			w.Int(v.Samples[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.TimeDeltas) > 0 {
+		w.Key("timeDeltas")
+		w.ArrayStart()
+		for i0 := range v.TimeDeltas {
+			w.Int(v.TimeDeltas[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Profile) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]ProfileNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 ProfileNode
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		case "startTime":
+			v.StartTime = r.Float()
+		case "endTime":
+			v.EndTime = r.Float()
+		case "samples":
+			if r.ArrayStart() {
+				v.Samples = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Samples = append(v.Samples, e0)
+				}
+			} else {
+				v.Samples = nil
+			}
+		case "timeDeltas":
+			if r.ArrayStart() {
+				v.TimeDeltas = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.TimeDeltas = append(v.TimeDeltas, e0)
+				}
+			} else {
+				v.TimeDeltas = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PositionTickInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("line")
+	w.Int(v.Line)
+	w.Key("ticks")
+	w.Int(v.Ticks)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PositionTickInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "line":
+			v.Line = r.Int()
+		case "ticks":
+			v.Ticks = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CoverageRange) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("startOffset")
+	w.Int(v.StartOffset)
+	w.Key("endOffset")
+	w.Int(v.EndOffset)
+	w.Key("count")
+	w.Int(v.Count)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CoverageRange) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "startOffset":
+			v.StartOffset = r.Int()
+		case "endOffset":
+			v.EndOffset = r.Int()
+		case "count":
+			v.Count = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FunctionCoverage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("functionName")
+	w.String(v.FunctionName)
+	w.Key("ranges")
+	if v.Ranges == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Ranges {
+			v.Ranges[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("isBlockCoverage")
+	w.Bool(v.IsBlockCoverage)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FunctionCoverage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "functionName":
+			v.FunctionName = r.String()
+		case "ranges":
+			if r.ArrayStart() {
+				v.Ranges = make([]CoverageRange, 0, 4)
+				for r.ArrayNext() {
+					var e0 CoverageRange
+					e0.DecodeJSON(r)
+					v.Ranges = append(v.Ranges, e0)
+				}
+			} else {
+				v.Ranges = nil
+			}
+		case "isBlockCoverage":
+			v.IsBlockCoverage = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScriptCoverage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("functions")
+	if v.Functions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Functions {
+			v.Functions[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScriptCoverage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "functions":
+			if r.ArrayStart() {
+				v.Functions = make([]FunctionCoverage, 0, 4)
+				for r.ArrayNext() {
+					var e0 FunctionCoverage
+					e0.DecodeJSON(r)
+					v.Functions = append(v.Functions, e0)
+				}
+			} else {
+				v.Functions = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBestEffortCoverageReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBestEffortCoverageReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]ScriptCoverage, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScriptCoverage
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSamplingIntervalArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("interval")
+	w.Int(v.Interval)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSamplingIntervalArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "interval":
+			v.Interval = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartPreciseCoverageArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.CallCount != nil {
+		w.Key("callCount")
+		w.Bool(*v.CallCount)
+	}
+	if v.Detailed != nil {
+		w.Key("detailed")
+		w.Bool(*v.Detailed)
+	}
+	if v.AllowTriggeredUpdates != nil {
+		w.Key("allowTriggeredUpdates")
+		w.Bool(*v.AllowTriggeredUpdates)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartPreciseCoverageArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callCount":
+			if r.Null() {
+				v.CallCount = nil
+			} else {
+				p := r.Bool()
+				v.CallCount = &p
+			}
+		case "detailed":
+			if r.Null() {
+				v.Detailed = nil
+			} else {
+				p := r.Bool()
+				v.Detailed = &p
+			}
+		case "allowTriggeredUpdates":
+			if r.Null() {
+				v.AllowTriggeredUpdates = nil
+			} else {
+				p := r.Bool()
+				v.AllowTriggeredUpdates = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartPreciseCoverageReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Float(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartPreciseCoverageReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			v.Timestamp = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakePreciseCoverageReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("timestamp")
+	w.Float(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakePreciseCoverageReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]ScriptCoverage, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScriptCoverage
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		case "timestamp":
+			v.Timestamp = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ConsoleProfileFinishedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	if v.Title != nil {
+		w.Key("title")
+		w.String(*v.Title)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ConsoleProfileFinishedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "location":
+			v.Location.DecodeJSON(r)
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		case "title":
+			if r.Null() {
+				v.Title = nil
+			} else {
+				p := r.String()
+				v.Title = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ConsoleProfileStartedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("location")
+	v.Location.EncodeJSON(w)
+	if v.Title != nil {
+		w.Key("title")
+		w.String(*v.Title)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ConsoleProfileStartedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "location":
+			v.Location.DecodeJSON(r)
+		case "title":
+			if r.Null() {
+				v.Title = nil
+			} else {
+				p := r.String()
+				v.Title = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PreciseCoverageDeltaUpdateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Float(v.Timestamp)
+	w.Key("occasion")
+	w.String(v.Occasion)
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PreciseCoverageDeltaUpdateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			v.Timestamp = r.Float()
+		case "occasion":
+			v.Occasion = r.String()
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]ScriptCoverage, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScriptCoverage
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -3,6 +3,9 @@
 package pwa
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/target"
 )
 
@@ -18,6 +21,12 @@ func NewGetOSAppStateArgs(manifestID string) *GetOSAppStateArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetOSAppStateArgs) Validate() error {
+	return nil
+}
+
 // GetOSAppStateReply represents the return values for GetOSAppState in the PWA domain.
 type GetOSAppStateReply struct {
 	BadgeCount   int           `json:"badgeCount"`   // No description.
@@ -45,6 +54,12 @@ func (a *InstallArgs) SetInstallURLOrBundleURL(installURLOrBundleURL string) *In
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *InstallArgs) Validate() error {
+	return nil
+}
+
 // UninstallArgs represents the arguments for Uninstall in the PWA domain.
 type UninstallArgs struct {
 	ManifestID string `json:"manifestId"` // No description.
@@ -57,6 +72,12 @@ func NewUninstallArgs(manifestID string) *UninstallArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UninstallArgs) Validate() error {
+	return nil
+}
+
 // LaunchArgs represents the arguments for Launch in the PWA domain.
 type LaunchArgs struct {
 	ManifestID string  `json:"manifestId"`    // No description.
@@ -76,6 +97,12 @@ func (a *LaunchArgs) SetURL(url string) *LaunchArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *LaunchArgs) Validate() error {
+	return nil
+}
+
 // LaunchReply represents the return values for Launch in the PWA domain.
 type LaunchReply struct {
 	TargetID target.ID `json:"targetId"` // ID of the tab target created as a result.
@@ -95,6 +122,15 @@ func NewLaunchFilesInAppArgs(manifestID string, files []string) *LaunchFilesInAp
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *LaunchFilesInAppArgs) Validate() error {
+	if a.Files == nil {
+		return errors.New("pwa.LaunchFilesInAppArgs: Files is required")
+	}
+	return nil
+}
+
 // LaunchFilesInAppReply represents the return values for LaunchFilesInApp in the PWA domain.
 type LaunchFilesInAppReply struct {
 	TargetIDs []target.ID `json:"targetIds"` // IDs of the tab targets created as the result.
@@ -112,6 +148,12 @@ func NewOpenCurrentPageInAppArgs(manifestID string) *OpenCurrentPageInAppArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *OpenCurrentPageInAppArgs) Validate() error {
+	return nil
+}
+
 // ChangeAppUserSettingsArgs represents the arguments for ChangeAppUserSettings in the PWA domain.
 type ChangeAppUserSettingsArgs struct {
 	ManifestID    string      `json:"manifestId"`              // No description.
@@ -148,3 +190,14 @@ func (a *ChangeAppUserSettingsArgs) SetDisplayMode(displayMode DisplayMode) *Cha
 	a.DisplayMode = displayMode
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ChangeAppUserSettingsArgs) Validate() error {
+	if a.DisplayMode != "" {
+		if !a.DisplayMode.Valid() {
+			return errors.New("pwa.ChangeAppUserSettingsArgs: DisplayMode: invalid value " + strconv.Quote(string(a.DisplayMode)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package pwa
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/target"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FileHandlerAccept) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("mediaType")
+	w.String(v.MediaType)
+	w.Key("fileExtensions")
+	if v.FileExtensions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FileExtensions {
+			w.String(v.FileExtensions[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FileHandlerAccept) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "mediaType":
+			v.MediaType = r.String()
+		case "fileExtensions":
+			if r.ArrayStart() {
+				v.FileExtensions = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.FileExtensions = append(v.FileExtensions, e0)
+				}
+			} else {
+				v.FileExtensions = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FileHandler) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("action")
+	w.String(v.Action)
+	w.Key("accepts")
+	if v.Accepts == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Accepts {
+			v.Accepts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("displayName")
+	w.String(v.DisplayName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FileHandler) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "action":
+			v.Action = r.String()
+		case "accepts":
+			if r.ArrayStart() {
+				v.Accepts = make([]FileHandlerAccept, 0, 4)
+				for r.ArrayNext() {
+					var e0 FileHandlerAccept
+					e0.DecodeJSON(r)
+					v.Accepts = append(v.Accepts, e0)
+				}
+			} else {
+				v.Accepts = nil
+			}
+		case "displayName":
+			v.DisplayName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOSAppStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOSAppStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOSAppStateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("badgeCount")
+	w.Int(v.BadgeCount)
+	w.Key("fileHandlers")
+	if v.FileHandlers == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FileHandlers {
+			v.FileHandlers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOSAppStateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "badgeCount":
+			v.BadgeCount = r.Int()
+		case "fileHandlers":
+			if r.ArrayStart() {
+				v.FileHandlers = make([]FileHandler, 0, 4)
+				for r.ArrayNext() {
+					var e0 FileHandler
+					e0.DecodeJSON(r)
+					v.FileHandlers = append(v.FileHandlers, e0)
+				}
+			} else {
+				v.FileHandlers = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InstallArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	if v.InstallURLOrBundleURL != nil {
+		w.Key("installUrlOrBundleUrl")
+		w.String(*v.InstallURLOrBundleURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InstallArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		case "installUrlOrBundleUrl":
+			if r.Null() {
+				v.InstallURLOrBundleURL = nil
+			} else {
+				p := r.String()
+				v.InstallURLOrBundleURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UninstallArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UninstallArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LaunchArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LaunchArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LaunchReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LaunchReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = target.ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LaunchFilesInAppArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	w.Key("files")
+	if v.Files == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Files {
+			w.String(v.Files[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LaunchFilesInAppArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		case "files":
+			if r.ArrayStart() {
+				v.Files = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Files = append(v.Files, e0)
+				}
+			} else {
+				v.Files = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LaunchFilesInAppReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetIds")
+	if v.TargetIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TargetIDs {
+			w.String(string(v.TargetIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LaunchFilesInAppReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetIds":
+			if r.ArrayStart() {
+				v.TargetIDs = make([]target.ID, 0, 4)
+				for r.ArrayNext() {
+					e0 := target.ID(r.String())
+					v.TargetIDs = append(v.TargetIDs, e0)
+				}
+			} else {
+				v.TargetIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OpenCurrentPageInAppArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OpenCurrentPageInAppArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ChangeAppUserSettingsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("manifestId")
+	w.String(v.ManifestID)
+	if v.LinkCapturing != nil {
+		w.Key("linkCapturing")
+		w.Bool(*v.LinkCapturing)
+	}
+	if v.DisplayMode != "" {
+		w.Key("displayMode")
+		w.String(string(v.DisplayMode))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ChangeAppUserSettingsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			v.ManifestID = r.String()
+		case "linkCapturing":
+			if r.Null() {
+				v.LinkCapturing = nil
+			} else {
+				p := r.Bool()
+				v.LinkCapturing = &p
+			}
+		case "displayMode":
+			v.DisplayMode = DisplayMode(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
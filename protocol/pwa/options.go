@@ -0,0 +1,72 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package pwa
+
+// InstallOption sets an optional argument of InstallArgs.
+type InstallOption func(*InstallArgs)
+
+// NewInstallArgsWith initializes InstallArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewInstallArgsWith(manifestID string, opts ...InstallOption) *InstallArgs {
+	args := NewInstallArgs(manifestID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// InstallWithInstallURLOrBundleURL sets the InstallURLOrBundleURL optional argument, see
+// InstallArgs.SetInstallURLOrBundleURL.
+func InstallWithInstallURLOrBundleURL(v string) InstallOption {
+	return func(a *InstallArgs) { a.SetInstallURLOrBundleURL(v) }
+}
+
+// LaunchOption sets an optional argument of LaunchArgs.
+type LaunchOption func(*LaunchArgs)
+
+// NewLaunchArgsWith initializes LaunchArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewLaunchArgsWith(manifestID string, opts ...LaunchOption) *LaunchArgs {
+	args := NewLaunchArgs(manifestID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// LaunchWithURL sets the URL optional argument, see
+// LaunchArgs.SetURL.
+func LaunchWithURL(v string) LaunchOption {
+	return func(a *LaunchArgs) { a.SetURL(v) }
+}
+
+// ChangeAppUserSettingsOption sets an optional argument of ChangeAppUserSettingsArgs.
+type ChangeAppUserSettingsOption func(*ChangeAppUserSettingsArgs)
+
+// NewChangeAppUserSettingsArgsWith initializes ChangeAppUserSettingsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewChangeAppUserSettingsArgsWith(manifestID string, opts ...ChangeAppUserSettingsOption) *ChangeAppUserSettingsArgs {
+	args := NewChangeAppUserSettingsArgs(manifestID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ChangeAppUserSettingsWithLinkCapturing sets the LinkCapturing optional argument, see
+// ChangeAppUserSettingsArgs.SetLinkCapturing.
+func ChangeAppUserSettingsWithLinkCapturing(v bool) ChangeAppUserSettingsOption {
+	return func(a *ChangeAppUserSettingsArgs) { a.SetLinkCapturing(v) }
+}
+
+// ChangeAppUserSettingsWithDisplayMode sets the DisplayMode optional argument, see
+// ChangeAppUserSettingsArgs.SetDisplayMode.
+func ChangeAppUserSettingsWithDisplayMode(v DisplayMode) ChangeAppUserSettingsOption {
+	return func(a *ChangeAppUserSettingsArgs) { a.SetDisplayMode(v) }
+}
@@ -2,6 +2,8 @@
 
 package runtime
 
+import "errors"
+
 // AwaitPromiseArgs represents the arguments for AwaitPromise in the Runtime domain.
 type AwaitPromiseArgs struct {
 	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`           // Identifier of the promise.
@@ -31,6 +33,12 @@ func (a *AwaitPromiseArgs) SetGeneratePreview(generatePreview bool) *AwaitPromis
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AwaitPromiseArgs) Validate() error {
+	return nil
+}
+
 // AwaitPromiseReply represents the return values for AwaitPromise in the Runtime domain.
 type AwaitPromiseReply struct {
 	Result           RemoteObject      `json:"result"`                     // Promise result. Will contain rejected value if promise was rejected.
@@ -188,6 +196,15 @@ func (a *CallFunctionOnArgs) SetSerializationOptions(serializationOptions Serial
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CallFunctionOnArgs) Validate() error {
+	if a.UniqueContextID != nil && a.ExecutionContextID != nil {
+		return errors.New("runtime.CallFunctionOnArgs: UniqueContextID and ExecutionContextID are mutually exclusive")
+	}
+	return nil
+}
+
 // CallFunctionOnReply represents the return values for CallFunctionOn in the Runtime domain.
 type CallFunctionOnReply struct {
 	Result           RemoteObject      `json:"result"`                     // Call result.
@@ -220,6 +237,12 @@ func (a *CompileScriptArgs) SetExecutionContextID(executionContextID ExecutionCo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CompileScriptArgs) Validate() error {
+	return nil
+}
+
 // CompileScriptReply represents the return values for CompileScript in the Runtime domain.
 type CompileScriptReply struct {
 	ScriptID         *ScriptID         `json:"scriptId,omitempty"`         // Id of the script.
@@ -434,6 +457,15 @@ func (a *EvaluateArgs) SetSerializationOptions(serializationOptions Serializatio
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EvaluateArgs) Validate() error {
+	if a.ContextID != nil && a.UniqueContextID != nil {
+		return errors.New("runtime.EvaluateArgs: ContextID and UniqueContextID are mutually exclusive")
+	}
+	return nil
+}
+
 // EvaluateReply represents the return values for Evaluate in the Runtime domain.
 type EvaluateReply struct {
 	Result           RemoteObject      `json:"result"`                     // Evaluation result.
@@ -517,6 +549,12 @@ func (a *GetPropertiesArgs) SetNonIndexedPropertiesOnly(nonIndexedPropertiesOnly
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetPropertiesArgs) Validate() error {
+	return nil
+}
+
 // GetPropertiesReply represents the return values for GetProperties in the Runtime domain.
 type GetPropertiesReply struct {
 	Result             []PropertyDescriptor         `json:"result"`                       // Object properties.
@@ -548,6 +586,12 @@ func (a *GlobalLexicalScopeNamesArgs) SetExecutionContextID(executionContextID E
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GlobalLexicalScopeNamesArgs) Validate() error {
+	return nil
+}
+
 // GlobalLexicalScopeNamesReply represents the return values for GlobalLexicalScopeNames in the Runtime domain.
 type GlobalLexicalScopeNamesReply struct {
 	Names []string `json:"names"` // No description.
@@ -573,6 +617,12 @@ func (a *QueryObjectsArgs) SetObjectGroup(objectGroup string) *QueryObjectsArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *QueryObjectsArgs) Validate() error {
+	return nil
+}
+
 // QueryObjectsReply represents the return values for QueryObjects in the Runtime domain.
 type QueryObjectsReply struct {
 	Objects RemoteObject `json:"objects"` // Array with objects.
@@ -590,6 +640,12 @@ func NewReleaseObjectArgs(objectID RemoteObjectID) *ReleaseObjectArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReleaseObjectArgs) Validate() error {
+	return nil
+}
+
 // ReleaseObjectGroupArgs represents the arguments for ReleaseObjectGroup in the Runtime domain.
 type ReleaseObjectGroupArgs struct {
 	ObjectGroup string `json:"objectGroup"` // Symbolic object group name.
@@ -602,6 +658,12 @@ func NewReleaseObjectGroupArgs(objectGroup string) *ReleaseObjectGroupArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReleaseObjectGroupArgs) Validate() error {
+	return nil
+}
+
 // RunScriptArgs represents the arguments for RunScript in the Runtime domain.
 type RunScriptArgs struct {
 	ScriptID              ScriptID            `json:"scriptId"`                        // Id of the script to run.
@@ -676,6 +738,12 @@ func (a *RunScriptArgs) SetAwaitPromise(awaitPromise bool) *RunScriptArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RunScriptArgs) Validate() error {
+	return nil
+}
+
 // RunScriptReply represents the return values for RunScript in the Runtime domain.
 type RunScriptReply struct {
 	Result           RemoteObject      `json:"result"`                     // Run result.
@@ -694,6 +762,12 @@ func NewSetCustomObjectFormatterEnabledArgs(enabled bool) *SetCustomObjectFormat
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCustomObjectFormatterEnabledArgs) Validate() error {
+	return nil
+}
+
 // SetMaxCallStackSizeToCaptureArgs represents the arguments for SetMaxCallStackSizeToCapture in the Runtime domain.
 type SetMaxCallStackSizeToCaptureArgs struct {
 	Size int `json:"size"` // No description.
@@ -706,6 +780,12 @@ func NewSetMaxCallStackSizeToCaptureArgs(size int) *SetMaxCallStackSizeToCapture
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetMaxCallStackSizeToCaptureArgs) Validate() error {
+	return nil
+}
+
 // AddBindingArgs represents the arguments for AddBinding in the Runtime domain.
 type AddBindingArgs struct {
 	Name string `json:"name"` // No description.
@@ -760,6 +840,15 @@ func (a *AddBindingArgs) SetExecutionContextName(executionContextName string) *A
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddBindingArgs) Validate() error {
+	if a.ExecutionContextID != nil && a.ExecutionContextName != nil {
+		return errors.New("runtime.AddBindingArgs: ExecutionContextID and ExecutionContextName are mutually exclusive")
+	}
+	return nil
+}
+
 // RemoveBindingArgs represents the arguments for RemoveBinding in the Runtime domain.
 type RemoveBindingArgs struct {
 	Name string `json:"name"` // No description.
@@ -772,6 +861,12 @@ func NewRemoveBindingArgs(name string) *RemoveBindingArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveBindingArgs) Validate() error {
+	return nil
+}
+
 // GetExceptionDetailsArgs represents the arguments for GetExceptionDetails in the Runtime domain.
 type GetExceptionDetailsArgs struct {
 	ErrorObjectID RemoteObjectID `json:"errorObjectId"` // The error object for which to resolve the exception details.
@@ -784,6 +879,12 @@ func NewGetExceptionDetailsArgs(errorObjectID RemoteObjectID) *GetExceptionDetai
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetExceptionDetailsArgs) Validate() error {
+	return nil
+}
+
 // GetExceptionDetailsReply represents the return values for GetExceptionDetails in the Runtime domain.
 type GetExceptionDetailsReply struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // No description.
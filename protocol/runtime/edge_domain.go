@@ -0,0 +1,22 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// Run invokes the Runtime method. Tells inspected instance(worker or page)
+// that it can run in case it was started paused.
+func (d *domainClient) Run(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Runtime.run", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "Run", Err: err}
+	}
+	return
+}
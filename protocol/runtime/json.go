@@ -0,0 +1,2521 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SerializationOptions) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("serialization")
+	w.String(v.Serialization)
+	if v.MaxDepth != nil {
+		w.Key("maxDepth")
+		w.Int(*v.MaxDepth)
+	}
+	if len(v.AdditionalParameters) > 0 {
+		w.Key("additionalParameters")
+		w.Raw(v.AdditionalParameters)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SerializationOptions) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "serialization":
+			v.Serialization = r.String()
+		case "maxDepth":
+			if r.Null() {
+				v.MaxDepth = nil
+			} else {
+				p := r.Int()
+				v.MaxDepth = &p
+			}
+		case "additionalParameters":
+			v.AdditionalParameters = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeepSerializedValue) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if len(v.Value) > 0 {
+		w.Key("value")
+		w.Raw(v.Value)
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(*v.ObjectID)
+	}
+	if v.WeakLocalObjectReference != nil {
+		w.Key("weakLocalObjectReference")
+		w.Int(*v.WeakLocalObjectReference)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeepSerializedValue) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "value":
+			v.Value = r.Raw()
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := r.String()
+				v.ObjectID = &p
+			}
+		case "weakLocalObjectReference":
+			if r.Null() {
+				v.WeakLocalObjectReference = nil
+			} else {
+				p := r.Int()
+				v.WeakLocalObjectReference = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoteObject) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.Subtype != nil {
+		w.Key("subtype")
+		w.String(*v.Subtype)
+	}
+	if v.ClassName != nil {
+		w.Key("className")
+		w.String(*v.ClassName)
+	}
+	if len(v.Value) > 0 {
+		w.Key("value")
+		w.Raw(v.Value)
+	}
+	if v.UnserializableValue != nil {
+		w.Key("unserializableValue")
+		w.String(string(*v.UnserializableValue))
+	}
+	if v.Description != nil {
+		w.Key("description")
+		w.String(*v.Description)
+	}
+	if v.DeepSerializedValue != nil {
+		w.Key("deepSerializedValue")
+		v.DeepSerializedValue.EncodeJSON(w)
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.Preview != nil {
+		w.Key("preview")
+		v.Preview.EncodeJSON(w)
+	}
+	if v.CustomPreview != nil {
+		w.Key("customPreview")
+		v.CustomPreview.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoteObject) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "subtype":
+			if r.Null() {
+				v.Subtype = nil
+			} else {
+				p := r.String()
+				v.Subtype = &p
+			}
+		case "className":
+			if r.Null() {
+				v.ClassName = nil
+			} else {
+				p := r.String()
+				v.ClassName = &p
+			}
+		case "value":
+			v.Value = r.Raw()
+		case "unserializableValue":
+			if r.Null() {
+				v.UnserializableValue = nil
+			} else {
+				p := UnserializableValue(r.String())
+				v.UnserializableValue = &p
+			}
+		case "description":
+			if r.Null() {
+				v.Description = nil
+			} else {
+				p := r.String()
+				v.Description = &p
+			}
+		case "deepSerializedValue":
+			if r.Null() {
+				v.DeepSerializedValue = nil
+			} else {
+				var p DeepSerializedValue
+				p.DecodeJSON(r)
+				v.DeepSerializedValue = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "preview":
+			if r.Null() {
+				v.Preview = nil
+			} else {
+				var p ObjectPreview
+				p.DecodeJSON(r)
+				v.Preview = &p
+			}
+		case "customPreview":
+			if r.Null() {
+				v.CustomPreview = nil
+			} else {
+				var p CustomPreview
+				p.DecodeJSON(r)
+				v.CustomPreview = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CustomPreview) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("header")
+	w.String(v.Header)
+	if v.BodyGetterID != nil {
+		w.Key("bodyGetterId")
+		w.String(string(*v.BodyGetterID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CustomPreview) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "header":
+			v.Header = r.String()
+		case "bodyGetterId":
+			if r.Null() {
+				v.BodyGetterID = nil
+			} else {
+				p := RemoteObjectID(r.String())
+				v.BodyGetterID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ObjectPreview) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.Subtype != nil {
+		w.Key("subtype")
+		w.String(*v.Subtype)
+	}
+	if v.Description != nil {
+		w.Key("description")
+		w.String(*v.Description)
+	}
+	w.Key("overflow")
+	w.Bool(v.Overflow)
+	w.Key("properties")
+	if v.Properties == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Properties {
+			v.Properties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Entries) > 0 {
+		w.Key("entries")
+		w.ArrayStart()
+		for i0 := range v.Entries {
+			v.Entries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ObjectPreview) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "subtype":
+			if r.Null() {
+				v.Subtype = nil
+			} else {
+				p := r.String()
+				v.Subtype = &p
+			}
+		case "description":
+			if r.Null() {
+				v.Description = nil
+			} else {
+				p := r.String()
+				v.Description = &p
+			}
+		case "overflow":
+			v.Overflow = r.Bool()
+		case "properties":
+			if r.ArrayStart() {
+				v.Properties = make([]PropertyPreview, 0, 4)
+				for r.ArrayNext() {
+					var e0 PropertyPreview
+					e0.DecodeJSON(r)
+					v.Properties = append(v.Properties, e0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		case "entries":
+			if r.ArrayStart() {
+				v.Entries = make([]EntryPreview, 0, 4)
+				for r.ArrayNext() {
+					var e0 EntryPreview
+					e0.DecodeJSON(r)
+					v.Entries = append(v.Entries, e0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PropertyPreview) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("type")
+	w.String(v.Type)
+	if v.Value != nil {
+		w.Key("value")
+		w.String(*v.Value)
+	}
+	if v.ValuePreview != nil {
+		w.Key("valuePreview")
+		v.ValuePreview.EncodeJSON(w)
+	}
+	if v.Subtype != nil {
+		w.Key("subtype")
+		w.String(*v.Subtype)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PropertyPreview) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "type":
+			v.Type = r.String()
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				p := r.String()
+				v.Value = &p
+			}
+		case "valuePreview":
+			if r.Null() {
+				v.ValuePreview = nil
+			} else {
+				var p ObjectPreview
+				p.DecodeJSON(r)
+				v.ValuePreview = &p
+			}
+		case "subtype":
+			if r.Null() {
+				v.Subtype = nil
+			} else {
+				p := r.String()
+				v.Subtype = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EntryPreview) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Key != nil {
+		w.Key("key")
+		v.Key.EncodeJSON(w)
+	}
+	w.Key("value")
+	v.Value.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EntryPreview) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			if r.Null() {
+				v.Key = nil
+			} else {
+				var p ObjectPreview
+				p.DecodeJSON(r)
+				v.Key = &p
+			}
+		case "value":
+			v.Value.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PropertyDescriptor) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.Value != nil {
+		w.Key("value")
+		v.Value.EncodeJSON(w)
+	}
+	if v.Writable != nil {
+		w.Key("writable")
+		w.Bool(*v.Writable)
+	}
+	if v.Get != nil {
+		w.Key("get")
+		v.Get.EncodeJSON(w)
+	}
+	if v.Set != nil {
+		w.Key("set")
+		v.Set.EncodeJSON(w)
+	}
+	w.Key("configurable")
+	w.Bool(v.Configurable)
+	w.Key("enumerable")
+	w.Bool(v.Enumerable)
+	if v.WasThrown != nil {
+		w.Key("wasThrown")
+		w.Bool(*v.WasThrown)
+	}
+	if v.IsOwn != nil {
+		w.Key("isOwn")
+		w.Bool(*v.IsOwn)
+	}
+	if v.Symbol != nil {
+		w.Key("symbol")
+		v.Symbol.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PropertyDescriptor) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Value = &p
+			}
+		case "writable":
+			if r.Null() {
+				v.Writable = nil
+			} else {
+				p := r.Bool()
+				v.Writable = &p
+			}
+		case "get":
+			if r.Null() {
+				v.Get = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Get = &p
+			}
+		case "set":
+			if r.Null() {
+				v.Set = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Set = &p
+			}
+		case "configurable":
+			v.Configurable = r.Bool()
+		case "enumerable":
+			v.Enumerable = r.Bool()
+		case "wasThrown":
+			if r.Null() {
+				v.WasThrown = nil
+			} else {
+				p := r.Bool()
+				v.WasThrown = &p
+			}
+		case "isOwn":
+			if r.Null() {
+				v.IsOwn = nil
+			} else {
+				p := r.Bool()
+				v.IsOwn = &p
+			}
+		case "symbol":
+			if r.Null() {
+				v.Symbol = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Symbol = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InternalPropertyDescriptor) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.Value != nil {
+		w.Key("value")
+		v.Value.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InternalPropertyDescriptor) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Value = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PrivatePropertyDescriptor) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.Value != nil {
+		w.Key("value")
+		v.Value.EncodeJSON(w)
+	}
+	if v.Get != nil {
+		w.Key("get")
+		v.Get.EncodeJSON(w)
+	}
+	if v.Set != nil {
+		w.Key("set")
+		v.Set.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PrivatePropertyDescriptor) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Value = &p
+			}
+		case "get":
+			if r.Null() {
+				v.Get = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Get = &p
+			}
+		case "set":
+			if r.Null() {
+				v.Set = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Set = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CallArgument) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.Value) > 0 {
+		w.Key("value")
+		w.Raw(v.Value)
+	}
+	if v.UnserializableValue != nil {
+		w.Key("unserializableValue")
+		w.String(string(*v.UnserializableValue))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CallArgument) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			v.Value = r.Raw()
+		case "unserializableValue":
+			if r.Null() {
+				v.UnserializableValue = nil
+			} else {
+				p := UnserializableValue(r.String())
+				v.UnserializableValue = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExecutionContextDescription) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.Int(int(v.ID))
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("uniqueId")
+	w.String(v.UniqueID)
+	if len(v.AuxData) > 0 {
+		w.Key("auxData")
+		w.Raw(v.AuxData)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExecutionContextDescription) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = ExecutionContextID(r.Int())
+		case "origin":
+			v.Origin = r.String()
+		case "name":
+			v.Name = r.String()
+		case "uniqueId":
+			v.UniqueID = r.String()
+		case "auxData":
+			v.AuxData = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExceptionDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("exceptionId")
+	w.Int(v.ExceptionID)
+	w.Key("text")
+	w.String(v.Text)
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	if v.ScriptID != nil {
+		w.Key("scriptId")
+		w.String(string(*v.ScriptID))
+	}
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.StackTrace != nil {
+		w.Key("stackTrace")
+		v.StackTrace.EncodeJSON(w)
+	}
+	if v.Exception != nil {
+		w.Key("exception")
+		v.Exception.EncodeJSON(w)
+	}
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	if len(v.ExceptionMetaData) > 0 {
+		w.Key("exceptionMetaData")
+		w.Raw(v.ExceptionMetaData)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExceptionDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "exceptionId":
+			v.ExceptionID = r.Int()
+		case "text":
+			v.Text = r.String()
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		case "scriptId":
+			if r.Null() {
+				v.ScriptID = nil
+			} else {
+				p := ScriptID(r.String())
+				v.ScriptID = &p
+			}
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "stackTrace":
+			if r.Null() {
+				v.StackTrace = nil
+			} else {
+				var p StackTrace
+				p.DecodeJSON(r)
+				v.StackTrace = &p
+			}
+		case "exception":
+			if r.Null() {
+				v.Exception = nil
+			} else {
+				var p RemoteObject
+				p.DecodeJSON(r)
+				v.Exception = &p
+			}
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		case "exceptionMetaData":
+			v.ExceptionMetaData = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CallFrame) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("functionName")
+	w.String(v.FunctionName)
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CallFrame) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "functionName":
+			v.FunctionName = r.String()
+		case "scriptId":
+			v.ScriptID = ScriptID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StackTrace) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Description != nil {
+		w.Key("description")
+		w.String(*v.Description)
+	}
+	w.Key("callFrames")
+	if v.CallFrames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CallFrames {
+			v.CallFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Parent != nil {
+		w.Key("parent")
+		v.Parent.EncodeJSON(w)
+	}
+	if v.ParentID != nil {
+		w.Key("parentId")
+		v.ParentID.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StackTrace) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "description":
+			if r.Null() {
+				v.Description = nil
+			} else {
+				p := r.String()
+				v.Description = &p
+			}
+		case "callFrames":
+			if r.ArrayStart() {
+				v.CallFrames = make([]CallFrame, 0, 4)
+				for r.ArrayNext() {
+					var e0 CallFrame
+					e0.DecodeJSON(r)
+					v.CallFrames = append(v.CallFrames, e0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "parent":
+			if r.Null() {
+				v.Parent = nil
+			} else {
+				var p StackTrace
+				p.DecodeJSON(r)
+				v.Parent = &p
+			}
+		case "parentId":
+			if r.Null() {
+				v.ParentID = nil
+			} else {
+				var p StackTraceID
+				p.DecodeJSON(r)
+				v.ParentID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StackTraceID) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	if v.DebuggerID != nil {
+		w.Key("debuggerId")
+		w.String(string(*v.DebuggerID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StackTraceID) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "debuggerId":
+			if r.Null() {
+				v.DebuggerID = nil
+			} else {
+				p := UniqueDebuggerID(r.String())
+				v.DebuggerID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AwaitPromiseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("promiseObjectId")
+	w.String(string(v.PromiseObjectID))
+	if v.ReturnByValue != nil {
+		w.Key("returnByValue")
+		w.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		w.Key("generatePreview")
+		w.Bool(*v.GeneratePreview)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AwaitPromiseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "promiseObjectId":
+			v.PromiseObjectID = RemoteObjectID(r.String())
+		case "returnByValue":
+			if r.Null() {
+				v.ReturnByValue = nil
+			} else {
+				p := r.Bool()
+				v.ReturnByValue = &p
+			}
+		case "generatePreview":
+			if r.Null() {
+				v.GeneratePreview = nil
+			} else {
+				p := r.Bool()
+				v.GeneratePreview = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AwaitPromiseReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	v.Result.EncodeJSON(w)
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AwaitPromiseReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result.DecodeJSON(r)
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CallFunctionOnArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("functionDeclaration")
+	w.String(v.FunctionDeclaration)
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if len(v.Arguments) > 0 {
+		w.Key("arguments")
+		w.ArrayStart()
+		for i0 := range v.Arguments {
+			v.Arguments[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Silent != nil {
+		w.Key("silent")
+		w.Bool(*v.Silent)
+	}
+	if v.ReturnByValue != nil {
+		w.Key("returnByValue")
+		w.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		w.Key("generatePreview")
+		w.Bool(*v.GeneratePreview)
+	}
+	if v.UserGesture != nil {
+		w.Key("userGesture")
+		w.Bool(*v.UserGesture)
+	}
+	if v.AwaitPromise != nil {
+		w.Key("awaitPromise")
+		w.Bool(*v.AwaitPromise)
+	}
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	if v.ThrowOnSideEffect != nil {
+		w.Key("throwOnSideEffect")
+		w.Bool(*v.ThrowOnSideEffect)
+	}
+	if v.UniqueContextID != nil {
+		w.Key("uniqueContextId")
+		w.String(*v.UniqueContextID)
+	}
+	if v.SerializationOptions != nil {
+		w.Key("serializationOptions")
+		v.SerializationOptions.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CallFunctionOnArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "functionDeclaration":
+			v.FunctionDeclaration = r.String()
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "arguments":
+			if r.ArrayStart() {
+				v.Arguments = make([]CallArgument, 0, 4)
+				for r.ArrayNext() {
+					var e0 CallArgument
+					e0.DecodeJSON(r)
+					v.Arguments = append(v.Arguments, e0)
+				}
+			} else {
+				v.Arguments = nil
+			}
+		case "silent":
+			if r.Null() {
+				v.Silent = nil
+			} else {
+				p := r.Bool()
+				v.Silent = &p
+			}
+		case "returnByValue":
+			if r.Null() {
+				v.ReturnByValue = nil
+			} else {
+				p := r.Bool()
+				v.ReturnByValue = &p
+			}
+		case "generatePreview":
+			if r.Null() {
+				v.GeneratePreview = nil
+			} else {
+				p := r.Bool()
+				v.GeneratePreview = &p
+			}
+		case "userGesture":
+			if r.Null() {
+				v.UserGesture = nil
+			} else {
+				p := r.Bool()
+				v.UserGesture = &p
+			}
+		case "awaitPromise":
+			if r.Null() {
+				v.AwaitPromise = nil
+			} else {
+				p := r.Bool()
+				v.AwaitPromise = &p
+			}
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		case "throwOnSideEffect":
+			if r.Null() {
+				v.ThrowOnSideEffect = nil
+			} else {
+				p := r.Bool()
+				v.ThrowOnSideEffect = &p
+			}
+		case "uniqueContextId":
+			if r.Null() {
+				v.UniqueContextID = nil
+			} else {
+				p := r.String()
+				v.UniqueContextID = &p
+			}
+		case "serializationOptions":
+			if r.Null() {
+				v.SerializationOptions = nil
+			} else {
+				var p SerializationOptions
+				p.DecodeJSON(r)
+				v.SerializationOptions = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CallFunctionOnReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	v.Result.EncodeJSON(w)
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CallFunctionOnReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result.DecodeJSON(r)
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompileScriptArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("expression")
+	w.String(v.Expression)
+	w.Key("sourceURL")
+	w.String(v.SourceURL)
+	w.Key("persistScript")
+	w.Bool(v.PersistScript)
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompileScriptArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "expression":
+			v.Expression = r.String()
+		case "sourceURL":
+			v.SourceURL = r.String()
+		case "persistScript":
+			v.PersistScript = r.Bool()
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompileScriptReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ScriptID != nil {
+		w.Key("scriptId")
+		w.String(string(*v.ScriptID))
+	}
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompileScriptReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			if r.Null() {
+				v.ScriptID = nil
+			} else {
+				p := ScriptID(r.String())
+				v.ScriptID = &p
+			}
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EvaluateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("expression")
+	w.String(v.Expression)
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		w.Key("includeCommandLineAPI")
+		w.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.Silent != nil {
+		w.Key("silent")
+		w.Bool(*v.Silent)
+	}
+	if v.ContextID != nil {
+		w.Key("contextId")
+		w.Int(int(*v.ContextID))
+	}
+	if v.ReturnByValue != nil {
+		w.Key("returnByValue")
+		w.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		w.Key("generatePreview")
+		w.Bool(*v.GeneratePreview)
+	}
+	if v.UserGesture != nil {
+		w.Key("userGesture")
+		w.Bool(*v.UserGesture)
+	}
+	if v.AwaitPromise != nil {
+		w.Key("awaitPromise")
+		w.Bool(*v.AwaitPromise)
+	}
+	if v.ThrowOnSideEffect != nil {
+		w.Key("throwOnSideEffect")
+		w.Bool(*v.ThrowOnSideEffect)
+	}
+	if v.Timeout != nil {
+		w.Key("timeout")
+		w.Float(float64(*v.Timeout))
+	}
+	if v.DisableBreaks != nil {
+		w.Key("disableBreaks")
+		w.Bool(*v.DisableBreaks)
+	}
+	if v.ReplMode != nil {
+		w.Key("replMode")
+		w.Bool(*v.ReplMode)
+	}
+	if v.AllowUnsafeEvalBlockedByCSP != nil {
+		w.Key("allowUnsafeEvalBlockedByCSP")
+		w.Bool(*v.AllowUnsafeEvalBlockedByCSP)
+	}
+	if v.UniqueContextID != nil {
+		w.Key("uniqueContextId")
+		w.String(*v.UniqueContextID)
+	}
+	if v.SerializationOptions != nil {
+		w.Key("serializationOptions")
+		v.SerializationOptions.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EvaluateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "expression":
+			v.Expression = r.String()
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		case "includeCommandLineAPI":
+			if r.Null() {
+				v.IncludeCommandLineAPI = nil
+			} else {
+				p := r.Bool()
+				v.IncludeCommandLineAPI = &p
+			}
+		case "silent":
+			if r.Null() {
+				v.Silent = nil
+			} else {
+				p := r.Bool()
+				v.Silent = &p
+			}
+		case "contextId":
+			if r.Null() {
+				v.ContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ContextID = &p
+			}
+		case "returnByValue":
+			if r.Null() {
+				v.ReturnByValue = nil
+			} else {
+				p := r.Bool()
+				v.ReturnByValue = &p
+			}
+		case "generatePreview":
+			if r.Null() {
+				v.GeneratePreview = nil
+			} else {
+				p := r.Bool()
+				v.GeneratePreview = &p
+			}
+		case "userGesture":
+			if r.Null() {
+				v.UserGesture = nil
+			} else {
+				p := r.Bool()
+				v.UserGesture = &p
+			}
+		case "awaitPromise":
+			if r.Null() {
+				v.AwaitPromise = nil
+			} else {
+				p := r.Bool()
+				v.AwaitPromise = &p
+			}
+		case "throwOnSideEffect":
+			if r.Null() {
+				v.ThrowOnSideEffect = nil
+			} else {
+				p := r.Bool()
+				v.ThrowOnSideEffect = &p
+			}
+		case "timeout":
+			if r.Null() {
+				v.Timeout = nil
+			} else {
+				p := TimeDelta(r.Float())
+				v.Timeout = &p
+			}
+		case "disableBreaks":
+			if r.Null() {
+				v.DisableBreaks = nil
+			} else {
+				p := r.Bool()
+				v.DisableBreaks = &p
+			}
+		case "replMode":
+			if r.Null() {
+				v.ReplMode = nil
+			} else {
+				p := r.Bool()
+				v.ReplMode = &p
+			}
+		case "allowUnsafeEvalBlockedByCSP":
+			if r.Null() {
+				v.AllowUnsafeEvalBlockedByCSP = nil
+			} else {
+				p := r.Bool()
+				v.AllowUnsafeEvalBlockedByCSP = &p
+			}
+		case "uniqueContextId":
+			if r.Null() {
+				v.UniqueContextID = nil
+			} else {
+				p := r.String()
+				v.UniqueContextID = &p
+			}
+		case "serializationOptions":
+			if r.Null() {
+				v.SerializationOptions = nil
+			} else {
+				var p SerializationOptions
+				p.DecodeJSON(r)
+				v.SerializationOptions = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EvaluateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	v.Result.EncodeJSON(w)
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EvaluateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result.DecodeJSON(r)
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetIsolateIDReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetIsolateIDReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHeapUsageReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("usedSize")
+	w.Float(v.UsedSize)
+	w.Key("totalSize")
+	w.Float(v.TotalSize)
+	w.Key("embedderHeapUsedSize")
+	w.Float(v.EmbedderHeapUsedSize)
+	w.Key("backingStorageSize")
+	w.Float(v.BackingStorageSize)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHeapUsageReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "usedSize":
+			v.UsedSize = r.Float()
+		case "totalSize":
+			v.TotalSize = r.Float()
+		case "embedderHeapUsedSize":
+			v.EmbedderHeapUsedSize = r.Float()
+		case "backingStorageSize":
+			v.BackingStorageSize = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPropertiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	if v.OwnProperties != nil {
+		w.Key("ownProperties")
+		w.Bool(*v.OwnProperties)
+	}
+	if v.AccessorPropertiesOnly != nil {
+		w.Key("accessorPropertiesOnly")
+		w.Bool(*v.AccessorPropertiesOnly)
+	}
+	if v.GeneratePreview != nil {
+		w.Key("generatePreview")
+		w.Bool(*v.GeneratePreview)
+	}
+	if v.NonIndexedPropertiesOnly != nil {
+		w.Key("nonIndexedPropertiesOnly")
+		w.Bool(*v.NonIndexedPropertiesOnly)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPropertiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = RemoteObjectID(r.String())
+		case "ownProperties":
+			if r.Null() {
+				v.OwnProperties = nil
+			} else {
+				p := r.Bool()
+				v.OwnProperties = &p
+			}
+		case "accessorPropertiesOnly":
+			if r.Null() {
+				v.AccessorPropertiesOnly = nil
+			} else {
+				p := r.Bool()
+				v.AccessorPropertiesOnly = &p
+			}
+		case "generatePreview":
+			if r.Null() {
+				v.GeneratePreview = nil
+			} else {
+				p := r.Bool()
+				v.GeneratePreview = &p
+			}
+		case "nonIndexedPropertiesOnly":
+			if r.Null() {
+				v.NonIndexedPropertiesOnly = nil
+			} else {
+				p := r.Bool()
+				v.NonIndexedPropertiesOnly = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPropertiesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.InternalProperties) > 0 {
+		w.Key("internalProperties")
+		w.ArrayStart()
+		for i0 := range v.InternalProperties {
+			v.InternalProperties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.PrivateProperties) > 0 {
+		w.Key("privateProperties")
+		w.ArrayStart()
+		for i0 := range v.PrivateProperties {
+			v.PrivateProperties[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPropertiesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]PropertyDescriptor, 0, 4)
+				for r.ArrayNext() {
+					var e0 PropertyDescriptor
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		case "internalProperties":
+			if r.ArrayStart() {
+				v.InternalProperties = make([]InternalPropertyDescriptor, 0, 4)
+				for r.ArrayNext() {
+					var e0 InternalPropertyDescriptor
+					e0.DecodeJSON(r)
+					v.InternalProperties = append(v.InternalProperties, e0)
+				}
+			} else {
+				v.InternalProperties = nil
+			}
+		case "privateProperties":
+			if r.ArrayStart() {
+				v.PrivateProperties = make([]PrivatePropertyDescriptor, 0, 4)
+				for r.ArrayNext() {
+					var e0 PrivatePropertyDescriptor
+					e0.DecodeJSON(r)
+					v.PrivateProperties = append(v.PrivateProperties, e0)
+				}
+			} else {
+				v.PrivateProperties = nil
+			}
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GlobalLexicalScopeNamesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GlobalLexicalScopeNamesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GlobalLexicalScopeNamesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("names")
+	if v.Names == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Names {
+			w.String(v.Names[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GlobalLexicalScopeNamesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "names":
+			if r.ArrayStart() {
+				v.Names = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Names = append(v.Names, e0)
+				}
+			} else {
+				v.Names = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QueryObjectsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("prototypeObjectId")
+	w.String(string(v.PrototypeObjectID))
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QueryObjectsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "prototypeObjectId":
+			v.PrototypeObjectID = RemoteObjectID(r.String())
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QueryObjectsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objects")
+	v.Objects.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QueryObjectsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objects":
+			v.Objects.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReleaseObjectArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReleaseObjectArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = RemoteObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReleaseObjectGroupArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectGroup")
+	w.String(v.ObjectGroup)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReleaseObjectGroupArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectGroup":
+			v.ObjectGroup = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RunScriptArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	if v.Silent != nil {
+		w.Key("silent")
+		w.Bool(*v.Silent)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		w.Key("includeCommandLineAPI")
+		w.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.ReturnByValue != nil {
+		w.Key("returnByValue")
+		w.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		w.Key("generatePreview")
+		w.Bool(*v.GeneratePreview)
+	}
+	if v.AwaitPromise != nil {
+		w.Key("awaitPromise")
+		w.Bool(*v.AwaitPromise)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RunScriptArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = ScriptID(r.String())
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		case "silent":
+			if r.Null() {
+				v.Silent = nil
+			} else {
+				p := r.Bool()
+				v.Silent = &p
+			}
+		case "includeCommandLineAPI":
+			if r.Null() {
+				v.IncludeCommandLineAPI = nil
+			} else {
+				p := r.Bool()
+				v.IncludeCommandLineAPI = &p
+			}
+		case "returnByValue":
+			if r.Null() {
+				v.ReturnByValue = nil
+			} else {
+				p := r.Bool()
+				v.ReturnByValue = &p
+			}
+		case "generatePreview":
+			if r.Null() {
+				v.GeneratePreview = nil
+			} else {
+				p := r.Bool()
+				v.GeneratePreview = &p
+			}
+		case "awaitPromise":
+			if r.Null() {
+				v.AwaitPromise = nil
+			} else {
+				p := r.Bool()
+				v.AwaitPromise = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RunScriptReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	v.Result.EncodeJSON(w)
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RunScriptReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result.DecodeJSON(r)
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCustomObjectFormatterEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCustomObjectFormatterEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetMaxCallStackSizeToCaptureArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("size")
+	w.Int(v.Size)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetMaxCallStackSizeToCaptureArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "size":
+			v.Size = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddBindingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	if v.ExecutionContextName != nil {
+		w.Key("executionContextName")
+		w.String(*v.ExecutionContextName)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddBindingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		case "executionContextName":
+			if r.Null() {
+				v.ExecutionContextName = nil
+			} else {
+				p := r.String()
+				v.ExecutionContextName = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveBindingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveBindingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetExceptionDetailsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("errorObjectId")
+	w.String(string(v.ErrorObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetExceptionDetailsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "errorObjectId":
+			v.ErrorObjectID = RemoteObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetExceptionDetailsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ExceptionDetails != nil {
+		w.Key("exceptionDetails")
+		v.ExceptionDetails.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetExceptionDetailsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "exceptionDetails":
+			if r.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				var p ExceptionDetails
+				p.DecodeJSON(r)
+				v.ExceptionDetails = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BindingCalledReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("payload")
+	w.String(v.Payload)
+	w.Key("executionContextId")
+	w.Int(int(v.ExecutionContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BindingCalledReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "payload":
+			v.Payload = r.String()
+		case "executionContextId":
+			v.ExecutionContextID = ExecutionContextID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ConsoleAPICalledReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("args")
+	if v.Args == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Args {
+			v.Args[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("executionContextId")
+	w.Int(int(v.ExecutionContextID))
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	if v.StackTrace != nil {
+		w.Key("stackTrace")
+		v.StackTrace.EncodeJSON(w)
+	}
+	if v.Context != nil {
+		w.Key("context")
+		w.String(*v.Context)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ConsoleAPICalledReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "args":
+			if r.ArrayStart() {
+				v.Args = make([]RemoteObject, 0, 4)
+				for r.ArrayNext() {
+					var e0 RemoteObject
+					e0.DecodeJSON(r)
+					v.Args = append(v.Args, e0)
+				}
+			} else {
+				v.Args = nil
+			}
+		case "executionContextId":
+			v.ExecutionContextID = ExecutionContextID(r.Int())
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "stackTrace":
+			if r.Null() {
+				v.StackTrace = nil
+			} else {
+				var p StackTrace
+				p.DecodeJSON(r)
+				v.StackTrace = &p
+			}
+		case "context":
+			if r.Null() {
+				v.Context = nil
+			} else {
+				p := r.String()
+				v.Context = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExceptionRevokedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("reason")
+	w.String(v.Reason)
+	w.Key("exceptionId")
+	w.Int(v.ExceptionID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExceptionRevokedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "reason":
+			v.Reason = r.String()
+		case "exceptionId":
+			v.ExceptionID = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExceptionThrownReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("exceptionDetails")
+	v.ExceptionDetails.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExceptionThrownReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "exceptionDetails":
+			v.ExceptionDetails.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExecutionContextCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("context")
+	v.Context.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExecutionContextCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "context":
+			v.Context.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExecutionContextDestroyedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("executionContextId")
+	w.Int(int(v.ExecutionContextID))
+	w.Key("executionContextUniqueId")
+	w.String(v.ExecutionContextUniqueID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExecutionContextDestroyedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "executionContextId":
+			v.ExecutionContextID = ExecutionContextID(r.Int())
+		case "executionContextUniqueId":
+			v.ExecutionContextUniqueID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExecutionContextsClearedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExecutionContextsClearedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InspectRequestedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("object")
+	v.Object.EncodeJSON(w)
+	w.Key("hints")
+	w.Raw(v.Hints)
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InspectRequestedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "object":
+			v.Object.DecodeJSON(r)
+		case "hints":
+			v.Hints = r.Raw()
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -2,6 +2,11 @@
 
 package security
 
+import (
+	"errors"
+	"strconv"
+)
+
 // SetIgnoreCertificateErrorsArgs represents the arguments for SetIgnoreCertificateErrors in the Security domain.
 type SetIgnoreCertificateErrorsArgs struct {
 	Ignore bool `json:"ignore"` // If true, all certificate errors will be ignored.
@@ -14,6 +19,12 @@ func NewSetIgnoreCertificateErrorsArgs(ignore bool) *SetIgnoreCertificateErrorsA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetIgnoreCertificateErrorsArgs) Validate() error {
+	return nil
+}
+
 // HandleCertificateErrorArgs represents the arguments for HandleCertificateError in the Security domain.
 type HandleCertificateErrorArgs struct {
 	EventID int                    `json:"eventId"` // The ID of the event.
@@ -28,6 +39,15 @@ func NewHandleCertificateErrorArgs(eventID int, action CertificateErrorAction) *
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HandleCertificateErrorArgs) Validate() error {
+	if !a.Action.Valid() {
+		return errors.New("security.HandleCertificateErrorArgs: Action: invalid value " + strconv.Quote(string(a.Action)))
+	}
+	return nil
+}
+
 // SetOverrideCertificateErrorsArgs represents the arguments for SetOverrideCertificateErrors in the Security domain.
 type SetOverrideCertificateErrorsArgs struct {
 	Override bool `json:"override"` // If true, certificate errors will be overridden.
@@ -39,3 +59,9 @@ func NewSetOverrideCertificateErrorsArgs(override bool) *SetOverrideCertificateE
 	args.Override = override
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetOverrideCertificateErrorsArgs) Validate() error {
+	return nil
+}
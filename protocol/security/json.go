@@ -0,0 +1,550 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CertificateSecurityState) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("protocol")
+	w.String(v.Protocol)
+	w.Key("keyExchange")
+	w.String(v.KeyExchange)
+	if v.KeyExchangeGroup != nil {
+		w.Key("keyExchangeGroup")
+		w.String(*v.KeyExchangeGroup)
+	}
+	w.Key("cipher")
+	w.String(v.Cipher)
+	if v.MAC != nil {
+		w.Key("mac")
+		w.String(*v.MAC)
+	}
+	w.Key("certificate")
+	if v.Certificate == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Certificate {
+			w.String(v.Certificate[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("subjectName")
+	w.String(v.SubjectName)
+	w.Key("issuer")
+	w.String(v.Issuer)
+	w.Key("validFrom")
+	w.Marshal(v.ValidFrom)
+	w.Key("validTo")
+	w.Marshal(v.ValidTo)
+	if v.CertificateNetworkError != nil {
+		w.Key("certificateNetworkError")
+		w.String(*v.CertificateNetworkError)
+	}
+	w.Key("certificateHasWeakSignature")
+	w.Bool(v.CertificateHasWeakSignature)
+	w.Key("certificateHasSha1Signature")
+	w.Bool(v.CertificateHasSha1Signature)
+	w.Key("modernSSL")
+	w.Bool(v.ModernSSL)
+	w.Key("obsoleteSslProtocol")
+	w.Bool(v.ObsoleteSSLProtocol)
+	w.Key("obsoleteSslKeyExchange")
+	w.Bool(v.ObsoleteSSLKeyExchange)
+	w.Key("obsoleteSslCipher")
+	w.Bool(v.ObsoleteSSLCipher)
+	w.Key("obsoleteSslSignature")
+	w.Bool(v.ObsoleteSSLSignature)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CertificateSecurityState) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "protocol":
+			v.Protocol = r.String()
+		case "keyExchange":
+			v.KeyExchange = r.String()
+		case "keyExchangeGroup":
+			if r.Null() {
+				v.KeyExchangeGroup = nil
+			} else {
+				p := r.String()
+				v.KeyExchangeGroup = &p
+			}
+		case "cipher":
+			v.Cipher = r.String()
+		case "mac":
+			if r.Null() {
+				v.MAC = nil
+			} else {
+				p := r.String()
+				v.MAC = &p
+			}
+		case "certificate":
+			if r.ArrayStart() {
+				v.Certificate = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Certificate = append(v.Certificate, e0)
+				}
+			} else {
+				v.Certificate = nil
+			}
+		case "subjectName":
+			v.SubjectName = r.String()
+		case "issuer":
+			v.Issuer = r.String()
+		case "validFrom":
+			r.Decode(&v.ValidFrom)
+		case "validTo":
+			r.Decode(&v.ValidTo)
+		case "certificateNetworkError":
+			if r.Null() {
+				v.CertificateNetworkError = nil
+			} else {
+				p := r.String()
+				v.CertificateNetworkError = &p
+			}
+		case "certificateHasWeakSignature":
+			v.CertificateHasWeakSignature = r.Bool()
+		case "certificateHasSha1Signature":
+			v.CertificateHasSha1Signature = r.Bool()
+		case "modernSSL":
+			v.ModernSSL = r.Bool()
+		case "obsoleteSslProtocol":
+			v.ObsoleteSSLProtocol = r.Bool()
+		case "obsoleteSslKeyExchange":
+			v.ObsoleteSSLKeyExchange = r.Bool()
+		case "obsoleteSslCipher":
+			v.ObsoleteSSLCipher = r.Bool()
+		case "obsoleteSslSignature":
+			v.ObsoleteSSLSignature = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SafetyTipInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("safetyTipStatus")
+	w.String(string(v.SafetyTipStatus))
+	if v.SafeURL != nil {
+		w.Key("safeUrl")
+		w.String(*v.SafeURL)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SafetyTipInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "safetyTipStatus":
+			v.SafetyTipStatus = SafetyTipStatus(r.String())
+		case "safeUrl":
+			if r.Null() {
+				v.SafeURL = nil
+			} else {
+				p := r.String()
+				v.SafeURL = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VisibleSecurityState) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("securityState")
+	w.String(string(v.SecurityState))
+	if v.CertificateSecurityState != nil {
+		w.Key("certificateSecurityState")
+		v.CertificateSecurityState.EncodeJSON(w)
+	}
+	if v.SafetyTipInfo != nil {
+		w.Key("safetyTipInfo")
+		v.SafetyTipInfo.EncodeJSON(w)
+	}
+	w.Key("securityStateIssueIds")
+	if v.SecurityStateIssueIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.SecurityStateIssueIDs {
+			w.String(v.SecurityStateIssueIDs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VisibleSecurityState) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityState":
+			v.SecurityState = State(r.String())
+		case "certificateSecurityState":
+			if r.Null() {
+				v.CertificateSecurityState = nil
+			} else {
+				var p CertificateSecurityState
+				p.DecodeJSON(r)
+				v.CertificateSecurityState = &p
+			}
+		case "safetyTipInfo":
+			if r.Null() {
+				v.SafetyTipInfo = nil
+			} else {
+				var p SafetyTipInfo
+				p.DecodeJSON(r)
+				v.SafetyTipInfo = &p
+			}
+		case "securityStateIssueIds":
+			if r.ArrayStart() {
+				v.SecurityStateIssueIDs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.SecurityStateIssueIDs = append(v.SecurityStateIssueIDs, e0)
+				}
+			} else {
+				v.SecurityStateIssueIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StateExplanation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("securityState")
+	w.String(string(v.SecurityState))
+	w.Key("title")
+	w.String(v.Title)
+	w.Key("summary")
+	w.String(v.Summary)
+	w.Key("description")
+	w.String(v.Description)
+	w.Key("mixedContentType")
+	w.String(string(v.MixedContentType))
+	w.Key("certificate")
+	if v.Certificate == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Certificate {
+			w.String(v.Certificate[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Recommendations) > 0 {
+		w.Key("recommendations")
+		w.ArrayStart()
+		for i0 := range v.Recommendations {
+			w.String(v.Recommendations[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StateExplanation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityState":
+			v.SecurityState = State(r.String())
+		case "title":
+			v.Title = r.String()
+		case "summary":
+			v.Summary = r.String()
+		case "description":
+			v.Description = r.String()
+		case "mixedContentType":
+			v.MixedContentType = MixedContentType(r.String())
+		case "certificate":
+			if r.ArrayStart() {
+				v.Certificate = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Certificate = append(v.Certificate, e0)
+				}
+			} else {
+				v.Certificate = nil
+			}
+		case "recommendations":
+			if r.ArrayStart() {
+				v.Recommendations = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Recommendations = append(v.Recommendations, e0)
+				}
+			} else {
+				v.Recommendations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InsecureContentStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ranMixedContent")
+	w.Bool(v.RanMixedContent)
+	w.Key("displayedMixedContent")
+	w.Bool(v.DisplayedMixedContent)
+	w.Key("containedMixedForm")
+	w.Bool(v.ContainedMixedForm)
+	w.Key("ranContentWithCertErrors")
+	w.Bool(v.RanContentWithCertErrors)
+	w.Key("displayedContentWithCertErrors")
+	w.Bool(v.DisplayedContentWithCertErrors)
+	w.Key("ranInsecureContentStyle")
+	w.String(string(v.RanInsecureContentStyle))
+	w.Key("displayedInsecureContentStyle")
+	w.String(string(v.DisplayedInsecureContentStyle))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InsecureContentStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ranMixedContent":
+			v.RanMixedContent = r.Bool()
+		case "displayedMixedContent":
+			v.DisplayedMixedContent = r.Bool()
+		case "containedMixedForm":
+			v.ContainedMixedForm = r.Bool()
+		case "ranContentWithCertErrors":
+			v.RanContentWithCertErrors = r.Bool()
+		case "displayedContentWithCertErrors":
+			v.DisplayedContentWithCertErrors = r.Bool()
+		case "ranInsecureContentStyle":
+			v.RanInsecureContentStyle = State(r.String())
+		case "displayedInsecureContentStyle":
+			v.DisplayedInsecureContentStyle = State(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetIgnoreCertificateErrorsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ignore")
+	w.Bool(v.Ignore)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetIgnoreCertificateErrorsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ignore":
+			v.Ignore = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HandleCertificateErrorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventId")
+	w.Int(v.EventID)
+	w.Key("action")
+	w.String(string(v.Action))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HandleCertificateErrorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventId":
+			v.EventID = r.Int()
+		case "action":
+			v.Action = CertificateErrorAction(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetOverrideCertificateErrorsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("override")
+	w.Bool(v.Override)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetOverrideCertificateErrorsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "override":
+			v.Override = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CertificateErrorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventId")
+	w.Int(v.EventID)
+	w.Key("errorType")
+	w.String(v.ErrorType)
+	w.Key("requestURL")
+	w.String(v.RequestURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CertificateErrorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventId":
+			v.EventID = r.Int()
+		case "errorType":
+			v.ErrorType = r.String()
+		case "requestURL":
+			v.RequestURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VisibleSecurityStateChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("visibleSecurityState")
+	v.VisibleSecurityState.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VisibleSecurityStateChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "visibleSecurityState":
+			v.VisibleSecurityState.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StateChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("securityState")
+	w.String(string(v.SecurityState))
+	w.Key("schemeIsCryptographic")
+	w.Bool(v.SchemeIsCryptographic)
+	w.Key("explanations")
+	if v.Explanations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Explanations {
+			v.Explanations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("insecureContentStatus")
+	v.InsecureContentStatus.EncodeJSON(w)
+	if v.Summary != nil {
+		w.Key("summary")
+		w.String(*v.Summary)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StateChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityState":
+			v.SecurityState = State(r.String())
+		case "schemeIsCryptographic":
+			v.SchemeIsCryptographic = r.Bool()
+		case "explanations":
+			if r.ArrayStart() {
+				v.Explanations = make([]StateExplanation, 0, 4)
+				for r.ArrayNext() {
+					var e0 StateExplanation
+					e0.DecodeJSON(r)
+					v.Explanations = append(v.Explanations, e0)
+				}
+			} else {
+				v.Explanations = nil
+			}
+		case "insecureContentStatus":
+			v.InsecureContentStatus.DecodeJSON(r)
+		case "summary":
+			if r.Null() {
+				v.Summary = nil
+			} else {
+				p := r.String()
+				v.Summary = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -18,6 +18,12 @@ func NewDeliverPushMessageArgs(origin string, registrationID RegistrationID, dat
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeliverPushMessageArgs) Validate() error {
+	return nil
+}
+
 // DispatchSyncEventArgs represents the arguments for DispatchSyncEvent in the ServiceWorker domain.
 type DispatchSyncEventArgs struct {
 	Origin         string         `json:"origin"`         // No description.
@@ -36,6 +42,12 @@ func NewDispatchSyncEventArgs(origin string, registrationID RegistrationID, tag
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchSyncEventArgs) Validate() error {
+	return nil
+}
+
 // DispatchPeriodicSyncEventArgs represents the arguments for DispatchPeriodicSyncEvent in the ServiceWorker domain.
 type DispatchPeriodicSyncEventArgs struct {
 	Origin         string         `json:"origin"`         // No description.
@@ -52,6 +64,12 @@ func NewDispatchPeriodicSyncEventArgs(origin string, registrationID Registration
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchPeriodicSyncEventArgs) Validate() error {
+	return nil
+}
+
 // SetForceUpdateOnPageLoadArgs represents the arguments for SetForceUpdateOnPageLoad in the ServiceWorker domain.
 type SetForceUpdateOnPageLoadArgs struct {
 	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"` // No description.
@@ -64,6 +82,12 @@ func NewSetForceUpdateOnPageLoadArgs(forceUpdateOnPageLoad bool) *SetForceUpdate
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetForceUpdateOnPageLoadArgs) Validate() error {
+	return nil
+}
+
 // SkipWaitingArgs represents the arguments for SkipWaiting in the ServiceWorker domain.
 type SkipWaitingArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -76,6 +100,12 @@ func NewSkipWaitingArgs(scopeURL string) *SkipWaitingArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SkipWaitingArgs) Validate() error {
+	return nil
+}
+
 // StartWorkerArgs represents the arguments for StartWorker in the ServiceWorker domain.
 type StartWorkerArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -88,6 +118,12 @@ func NewStartWorkerArgs(scopeURL string) *StartWorkerArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartWorkerArgs) Validate() error {
+	return nil
+}
+
 // StopWorkerArgs represents the arguments for StopWorker in the ServiceWorker domain.
 type StopWorkerArgs struct {
 	VersionID string `json:"versionId"` // No description.
@@ -100,6 +136,12 @@ func NewStopWorkerArgs(versionID string) *StopWorkerArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopWorkerArgs) Validate() error {
+	return nil
+}
+
 // UnregisterArgs represents the arguments for Unregister in the ServiceWorker domain.
 type UnregisterArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -112,6 +154,12 @@ func NewUnregisterArgs(scopeURL string) *UnregisterArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UnregisterArgs) Validate() error {
+	return nil
+}
+
 // UpdateRegistrationArgs represents the arguments for UpdateRegistration in the ServiceWorker domain.
 type UpdateRegistrationArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -123,3 +171,9 @@ func NewUpdateRegistrationArgs(scopeURL string) *UpdateRegistrationArgs {
 	args.ScopeURL = scopeURL
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UpdateRegistrationArgs) Validate() error {
+	return nil
+}
@@ -4,6 +4,7 @@ package storage
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/mafredri/cdp/protocol/browser"
 	"github.com/mafredri/cdp/protocol/network"
@@ -22,6 +23,12 @@ func NewGetStorageKeyForFrameArgs(frameID page.FrameID) *GetStorageKeyForFrameAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetStorageKeyForFrameArgs) Validate() error {
+	return nil
+}
+
 // GetStorageKeyForFrameReply represents the return values for GetStorageKeyForFrame in the Storage domain.
 type GetStorageKeyForFrameReply struct {
 	StorageKey SerializedStorageKey `json:"storageKey"` // No description.
@@ -45,6 +52,12 @@ func (a *GetStorageKeyArgs) SetFrameID(frameID page.FrameID) *GetStorageKeyArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetStorageKeyArgs) Validate() error {
+	return nil
+}
+
 // GetStorageKeyReply represents the return values for GetStorageKey in the Storage domain.
 type GetStorageKeyReply struct {
 	StorageKey SerializedStorageKey `json:"storageKey"` // No description.
@@ -64,6 +77,12 @@ func NewClearDataForOriginArgs(origin string, storageTypes string) *ClearDataFor
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearDataForOriginArgs) Validate() error {
+	return nil
+}
+
 // ClearDataForStorageKeyArgs represents the arguments for ClearDataForStorageKey in the Storage domain.
 type ClearDataForStorageKeyArgs struct {
 	StorageKey   string `json:"storageKey"`   // Storage key.
@@ -78,6 +97,12 @@ func NewClearDataForStorageKeyArgs(storageKey string, storageTypes string) *Clea
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearDataForStorageKeyArgs) Validate() error {
+	return nil
+}
+
 // GetCookiesArgs represents the arguments for GetCookies in the Storage domain.
 type GetCookiesArgs struct {
 	BrowserContextID *browser.ContextID `json:"browserContextId,omitempty"` // Browser context to use when called on the browser endpoint.
@@ -97,6 +122,12 @@ func (a *GetCookiesArgs) SetBrowserContextID(browserContextID browser.ContextID)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetCookiesArgs) Validate() error {
+	return nil
+}
+
 // GetCookiesReply represents the return values for GetCookies in the Storage domain.
 type GetCookiesReply struct {
 	Cookies []network.Cookie `json:"cookies"` // Array of cookie objects.
@@ -122,6 +153,15 @@ func (a *SetCookiesArgs) SetBrowserContextID(browserContextID browser.ContextID)
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCookiesArgs) Validate() error {
+	if a.Cookies == nil {
+		return errors.New("storage.SetCookiesArgs: Cookies is required")
+	}
+	return nil
+}
+
 // ClearCookiesArgs represents the arguments for ClearCookies in the Storage domain.
 type ClearCookiesArgs struct {
 	BrowserContextID *browser.ContextID `json:"browserContextId,omitempty"` // Browser context to use when called on the browser endpoint.
@@ -141,6 +181,12 @@ func (a *ClearCookiesArgs) SetBrowserContextID(browserContextID browser.ContextI
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearCookiesArgs) Validate() error {
+	return nil
+}
+
 // GetUsageAndQuotaArgs represents the arguments for GetUsageAndQuota in the Storage domain.
 type GetUsageAndQuotaArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -153,6 +199,12 @@ func NewGetUsageAndQuotaArgs(origin string) *GetUsageAndQuotaArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetUsageAndQuotaArgs) Validate() error {
+	return nil
+}
+
 // GetUsageAndQuotaReply represents the return values for GetUsageAndQuota in the Storage domain.
 type GetUsageAndQuotaReply struct {
 	Usage          float64        `json:"usage"`          // Storage usage (bytes).
@@ -187,6 +239,12 @@ func (a *OverrideQuotaForOriginArgs) SetQuotaSize(quotaSize float64) *OverrideQu
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *OverrideQuotaForOriginArgs) Validate() error {
+	return nil
+}
+
 // TrackCacheStorageForOriginArgs represents the arguments for TrackCacheStorageForOrigin in the Storage domain.
 type TrackCacheStorageForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -199,6 +257,12 @@ func NewTrackCacheStorageForOriginArgs(origin string) *TrackCacheStorageForOrigi
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TrackCacheStorageForOriginArgs) Validate() error {
+	return nil
+}
+
 // TrackCacheStorageForStorageKeyArgs represents the arguments for TrackCacheStorageForStorageKey in the Storage domain.
 type TrackCacheStorageForStorageKeyArgs struct {
 	StorageKey string `json:"storageKey"` // Storage key.
@@ -211,6 +275,12 @@ func NewTrackCacheStorageForStorageKeyArgs(storageKey string) *TrackCacheStorage
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TrackCacheStorageForStorageKeyArgs) Validate() error {
+	return nil
+}
+
 // TrackIndexedDBForOriginArgs represents the arguments for TrackIndexedDBForOrigin in the Storage domain.
 type TrackIndexedDBForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -223,6 +293,12 @@ func NewTrackIndexedDBForOriginArgs(origin string) *TrackIndexedDBForOriginArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TrackIndexedDBForOriginArgs) Validate() error {
+	return nil
+}
+
 // TrackIndexedDBForStorageKeyArgs represents the arguments for TrackIndexedDBForStorageKey in the Storage domain.
 type TrackIndexedDBForStorageKeyArgs struct {
 	StorageKey string `json:"storageKey"` // Storage key.
@@ -235,6 +311,12 @@ func NewTrackIndexedDBForStorageKeyArgs(storageKey string) *TrackIndexedDBForSto
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *TrackIndexedDBForStorageKeyArgs) Validate() error {
+	return nil
+}
+
 // UntrackCacheStorageForOriginArgs represents the arguments for UntrackCacheStorageForOrigin in the Storage domain.
 type UntrackCacheStorageForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -247,6 +329,12 @@ func NewUntrackCacheStorageForOriginArgs(origin string) *UntrackCacheStorageForO
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UntrackCacheStorageForOriginArgs) Validate() error {
+	return nil
+}
+
 // UntrackCacheStorageForStorageKeyArgs represents the arguments for UntrackCacheStorageForStorageKey in the Storage domain.
 type UntrackCacheStorageForStorageKeyArgs struct {
 	StorageKey string `json:"storageKey"` // Storage key.
@@ -259,6 +347,12 @@ func NewUntrackCacheStorageForStorageKeyArgs(storageKey string) *UntrackCacheSto
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UntrackCacheStorageForStorageKeyArgs) Validate() error {
+	return nil
+}
+
 // UntrackIndexedDBForOriginArgs represents the arguments for UntrackIndexedDBForOrigin in the Storage domain.
 type UntrackIndexedDBForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -271,6 +365,12 @@ func NewUntrackIndexedDBForOriginArgs(origin string) *UntrackIndexedDBForOriginA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UntrackIndexedDBForOriginArgs) Validate() error {
+	return nil
+}
+
 // UntrackIndexedDBForStorageKeyArgs represents the arguments for UntrackIndexedDBForStorageKey in the Storage domain.
 type UntrackIndexedDBForStorageKeyArgs struct {
 	StorageKey string `json:"storageKey"` // Storage key.
@@ -283,6 +383,12 @@ func NewUntrackIndexedDBForStorageKeyArgs(storageKey string) *UntrackIndexedDBFo
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UntrackIndexedDBForStorageKeyArgs) Validate() error {
+	return nil
+}
+
 // GetTrustTokensReply represents the return values for GetTrustTokens in the Storage domain.
 type GetTrustTokensReply struct {
 	Tokens []TrustTokens `json:"tokens"` // No description.
@@ -300,6 +406,12 @@ func NewClearTrustTokensArgs(issuerOrigin string) *ClearTrustTokensArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearTrustTokensArgs) Validate() error {
+	return nil
+}
+
 // ClearTrustTokensReply represents the return values for ClearTrustTokens in the Storage domain.
 type ClearTrustTokensReply struct {
 	DidDeleteTokens bool `json:"didDeleteTokens"` // True if any tokens were deleted, false otherwise.
@@ -319,6 +431,12 @@ func NewGetInterestGroupDetailsArgs(ownerOrigin string, name string) *GetInteres
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetInterestGroupDetailsArgs) Validate() error {
+	return nil
+}
+
 // GetInterestGroupDetailsReply represents the return values for GetInterestGroupDetails in the Storage domain.
 type GetInterestGroupDetailsReply struct {
 	Details json.RawMessage `json:"details"` // This largely corresponds to: https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup but has absolute expirationTime instead of relative lifetimeMs and also adds joiningOrigin.
@@ -336,6 +454,12 @@ func NewSetInterestGroupTrackingArgs(enable bool) *SetInterestGroupTrackingArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInterestGroupTrackingArgs) Validate() error {
+	return nil
+}
+
 // SetInterestGroupAuctionTrackingArgs represents the arguments for SetInterestGroupAuctionTracking in the Storage domain.
 type SetInterestGroupAuctionTrackingArgs struct {
 	Enable bool `json:"enable"` // No description.
@@ -348,6 +472,12 @@ func NewSetInterestGroupAuctionTrackingArgs(enable bool) *SetInterestGroupAuctio
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInterestGroupAuctionTrackingArgs) Validate() error {
+	return nil
+}
+
 // GetSharedStorageMetadataArgs represents the arguments for GetSharedStorageMetadata in the Storage domain.
 type GetSharedStorageMetadataArgs struct {
 	OwnerOrigin string `json:"ownerOrigin"` // No description.
@@ -360,6 +490,12 @@ func NewGetSharedStorageMetadataArgs(ownerOrigin string) *GetSharedStorageMetada
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetSharedStorageMetadataArgs) Validate() error {
+	return nil
+}
+
 // GetSharedStorageMetadataReply represents the return values for GetSharedStorageMetadata in the Storage domain.
 type GetSharedStorageMetadataReply struct {
 	Metadata SharedStorageMetadata `json:"metadata"` // No description.
@@ -377,6 +513,12 @@ func NewGetSharedStorageEntriesArgs(ownerOrigin string) *GetSharedStorageEntries
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetSharedStorageEntriesArgs) Validate() error {
+	return nil
+}
+
 // GetSharedStorageEntriesReply represents the return values for GetSharedStorageEntries in the Storage domain.
 type GetSharedStorageEntriesReply struct {
 	Entries []SharedStorageEntry `json:"entries"` // No description.
@@ -407,6 +549,12 @@ func (a *SetSharedStorageEntryArgs) SetIgnoreIfPresent(ignoreIfPresent bool) *Se
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSharedStorageEntryArgs) Validate() error {
+	return nil
+}
+
 // DeleteSharedStorageEntryArgs represents the arguments for DeleteSharedStorageEntry in the Storage domain.
 type DeleteSharedStorageEntryArgs struct {
 	OwnerOrigin string `json:"ownerOrigin"` // No description.
@@ -421,6 +569,12 @@ func NewDeleteSharedStorageEntryArgs(ownerOrigin string, key string) *DeleteShar
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteSharedStorageEntryArgs) Validate() error {
+	return nil
+}
+
 // ClearSharedStorageEntriesArgs represents the arguments for ClearSharedStorageEntries in the Storage domain.
 type ClearSharedStorageEntriesArgs struct {
 	OwnerOrigin string `json:"ownerOrigin"` // No description.
@@ -433,6 +587,12 @@ func NewClearSharedStorageEntriesArgs(ownerOrigin string) *ClearSharedStorageEnt
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearSharedStorageEntriesArgs) Validate() error {
+	return nil
+}
+
 // ResetSharedStorageBudgetArgs represents the arguments for ResetSharedStorageBudget in the Storage domain.
 type ResetSharedStorageBudgetArgs struct {
 	OwnerOrigin string `json:"ownerOrigin"` // No description.
@@ -445,6 +605,12 @@ func NewResetSharedStorageBudgetArgs(ownerOrigin string) *ResetSharedStorageBudg
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ResetSharedStorageBudgetArgs) Validate() error {
+	return nil
+}
+
 // SetSharedStorageTrackingArgs represents the arguments for SetSharedStorageTracking in the Storage domain.
 type SetSharedStorageTrackingArgs struct {
 	Enable bool `json:"enable"` // No description.
@@ -457,6 +623,12 @@ func NewSetSharedStorageTrackingArgs(enable bool) *SetSharedStorageTrackingArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSharedStorageTrackingArgs) Validate() error {
+	return nil
+}
+
 // SetStorageBucketTrackingArgs represents the arguments for SetStorageBucketTracking in the Storage domain.
 type SetStorageBucketTrackingArgs struct {
 	StorageKey string `json:"storageKey"` // No description.
@@ -471,6 +643,12 @@ func NewSetStorageBucketTrackingArgs(storageKey string, enable bool) *SetStorage
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetStorageBucketTrackingArgs) Validate() error {
+	return nil
+}
+
 // DeleteStorageBucketArgs represents the arguments for DeleteStorageBucket in the Storage domain.
 type DeleteStorageBucketArgs struct {
 	Bucket Bucket `json:"bucket"` // No description.
@@ -483,6 +661,12 @@ func NewDeleteStorageBucketArgs(bucket Bucket) *DeleteStorageBucketArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteStorageBucketArgs) Validate() error {
+	return nil
+}
+
 // RunBounceTrackingMitigationsReply represents the return values for RunBounceTrackingMitigations in the Storage domain.
 type RunBounceTrackingMitigationsReply struct {
 	DeletedSites []string `json:"deletedSites"` // No description.
@@ -500,6 +684,12 @@ func NewSetAttributionReportingLocalTestingModeArgs(enabled bool) *SetAttributio
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAttributionReportingLocalTestingModeArgs) Validate() error {
+	return nil
+}
+
 // SetAttributionReportingTrackingArgs represents the arguments for SetAttributionReportingTracking in the Storage domain.
 type SetAttributionReportingTrackingArgs struct {
 	Enable bool `json:"enable"` // No description.
@@ -512,6 +702,12 @@ func NewSetAttributionReportingTrackingArgs(enable bool) *SetAttributionReportin
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAttributionReportingTrackingArgs) Validate() error {
+	return nil
+}
+
 // SendPendingAttributionReportsReply represents the return values for SendPendingAttributionReports in the Storage domain.
 type SendPendingAttributionReportsReply struct {
 	NumSent int `json:"numSent"` // The number of reports that were sent.
@@ -536,6 +732,15 @@ func NewGetAffectedURLsForThirdPartyCookieMetadataArgs(firstPartyURL string, thi
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetAffectedURLsForThirdPartyCookieMetadataArgs) Validate() error {
+	if a.ThirdPartyURLs == nil {
+		return errors.New("storage.GetAffectedURLsForThirdPartyCookieMetadataArgs: ThirdPartyURLs is required")
+	}
+	return nil
+}
+
 // GetAffectedURLsForThirdPartyCookieMetadataReply represents the return values for GetAffectedURLsForThirdPartyCookieMetadata in the Storage domain.
 type GetAffectedURLsForThirdPartyCookieMetadataReply struct {
 	MatchedURLs []string `json:"matchedUrls"` // Array of matching URLs. If there is a primary pattern match for the first- party URL, only the first-party URL is returned in the array.
@@ -556,3 +761,12 @@ func NewSetProtectedAudienceKAnonymityArgs(owner string, name string, hashes []s
 	args.Hashes = hashes
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetProtectedAudienceKAnonymityArgs) Validate() error {
+	if a.Hashes == nil {
+		return errors.New("storage.SetProtectedAudienceKAnonymityArgs: Hashes is required")
+	}
+	return nil
+}
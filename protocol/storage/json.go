@@ -0,0 +1,3672 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/target"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UsageForType) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageType")
+	w.String(string(v.StorageType))
+	w.Key("usage")
+	w.Float(v.Usage)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UsageForType) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageType":
+			v.StorageType = Type(r.String())
+		case "usage":
+			v.Usage = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrustTokens) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("issuerOrigin")
+	w.String(v.IssuerOrigin)
+	w.Key("count")
+	w.Float(v.Count)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrustTokens) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "issuerOrigin":
+			v.IssuerOrigin = r.String()
+		case "count":
+			v.Count = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("creationTime")
+	w.Marshal(v.CreationTime)
+	w.Key("length")
+	w.Int(v.Length)
+	w.Key("remainingBudget")
+	w.Float(v.RemainingBudget)
+	w.Key("bytesUsed")
+	w.Int(v.BytesUsed)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "creationTime":
+			r.Decode(&v.CreationTime)
+		case "length":
+			v.Length = r.Int()
+		case "remainingBudget":
+			v.RemainingBudget = r.Float()
+		case "bytesUsed":
+			v.BytesUsed = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStoragePrivateAggregationConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.AggregationCoordinatorOrigin != nil {
+		w.Key("aggregationCoordinatorOrigin")
+		w.String(*v.AggregationCoordinatorOrigin)
+	}
+	if v.ContextID != nil {
+		w.Key("contextId")
+		w.String(*v.ContextID)
+	}
+	w.Key("filteringIdMaxBytes")
+	w.Int(v.FilteringIDMaxBytes)
+	if v.MaxContributions != nil {
+		w.Key("maxContributions")
+		w.Int(*v.MaxContributions)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStoragePrivateAggregationConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "aggregationCoordinatorOrigin":
+			if r.Null() {
+				v.AggregationCoordinatorOrigin = nil
+			} else {
+				p := r.String()
+				v.AggregationCoordinatorOrigin = &p
+			}
+		case "contextId":
+			if r.Null() {
+				v.ContextID = nil
+			} else {
+				p := r.String()
+				v.ContextID = &p
+			}
+		case "filteringIdMaxBytes":
+			v.FilteringIDMaxBytes = r.Int()
+		case "maxContributions":
+			if r.Null() {
+				v.MaxContributions = nil
+			} else {
+				p := r.Int()
+				v.MaxContributions = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageReportingMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventType")
+	w.String(v.EventType)
+	w.Key("reportingUrl")
+	w.String(v.ReportingURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageReportingMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventType":
+			v.EventType = r.String()
+		case "reportingUrl":
+			v.ReportingURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageURLWithMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("reportingMetadata")
+	if v.ReportingMetadata == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ReportingMetadata {
+			v.ReportingMetadata[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageURLWithMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "reportingMetadata":
+			if r.ArrayStart() {
+				v.ReportingMetadata = make([]SharedStorageReportingMetadata, 0, 4)
+				for r.ArrayNext() {
+					var e0 SharedStorageReportingMetadata
+					e0.DecodeJSON(r)
+					v.ReportingMetadata = append(v.ReportingMetadata, e0)
+				}
+			} else {
+				v.ReportingMetadata = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageAccessParams) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ScriptSourceURL != nil {
+		w.Key("scriptSourceUrl")
+		w.String(*v.ScriptSourceURL)
+	}
+	if v.DataOrigin != nil {
+		w.Key("dataOrigin")
+		w.String(*v.DataOrigin)
+	}
+	if v.OperationName != nil {
+		w.Key("operationName")
+		w.String(*v.OperationName)
+	}
+	if v.OperationID != nil {
+		w.Key("operationId")
+		w.String(*v.OperationID)
+	}
+	if v.KeepAlive != nil {
+		w.Key("keepAlive")
+		w.Bool(*v.KeepAlive)
+	}
+	if v.PrivateAggregationConfig != nil {
+		w.Key("privateAggregationConfig")
+		v.PrivateAggregationConfig.EncodeJSON(w)
+	}
+	if v.SerializedData != nil {
+		w.Key("serializedData")
+		w.String(*v.SerializedData)
+	}
+	if len(v.URLsWithMetadata) > 0 {
+		w.Key("urlsWithMetadata")
+		w.ArrayStart()
+		for i0 := range v.URLsWithMetadata {
+			v.URLsWithMetadata[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.UrnUUID != nil {
+		w.Key("urnUuid")
+		w.String(*v.UrnUUID)
+	}
+	if v.Key != nil {
+		w.Key("key")
+		w.String(*v.Key)
+	}
+	if v.Value != nil {
+		w.Key("value")
+		w.String(*v.Value)
+	}
+	if v.IgnoreIfPresent != nil {
+		w.Key("ignoreIfPresent")
+		w.Bool(*v.IgnoreIfPresent)
+	}
+	if v.WorkletOrdinal != nil {
+		w.Key("workletOrdinal")
+		w.Int(*v.WorkletOrdinal)
+	}
+	if v.WorkletTargetID != nil {
+		w.Key("workletTargetId")
+		w.String(string(*v.WorkletTargetID))
+	}
+	if v.WithLock != nil {
+		w.Key("withLock")
+		w.String(*v.WithLock)
+	}
+	if v.BatchUpdateID != nil {
+		w.Key("batchUpdateId")
+		w.String(*v.BatchUpdateID)
+	}
+	if v.BatchSize != nil {
+		w.Key("batchSize")
+		w.Int(*v.BatchSize)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageAccessParams) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptSourceUrl":
+			if r.Null() {
+				v.ScriptSourceURL = nil
+			} else {
+				p := r.String()
+				v.ScriptSourceURL = &p
+			}
+		case "dataOrigin":
+			if r.Null() {
+				v.DataOrigin = nil
+			} else {
+				p := r.String()
+				v.DataOrigin = &p
+			}
+		case "operationName":
+			if r.Null() {
+				v.OperationName = nil
+			} else {
+				p := r.String()
+				v.OperationName = &p
+			}
+		case "operationId":
+			if r.Null() {
+				v.OperationID = nil
+			} else {
+				p := r.String()
+				v.OperationID = &p
+			}
+		case "keepAlive":
+			if r.Null() {
+				v.KeepAlive = nil
+			} else {
+				p := r.Bool()
+				v.KeepAlive = &p
+			}
+		case "privateAggregationConfig":
+			if r.Null() {
+				v.PrivateAggregationConfig = nil
+			} else {
+				var p SharedStoragePrivateAggregationConfig
+				p.DecodeJSON(r)
+				v.PrivateAggregationConfig = &p
+			}
+		case "serializedData":
+			if r.Null() {
+				v.SerializedData = nil
+			} else {
+				p := r.String()
+				v.SerializedData = &p
+			}
+		case "urlsWithMetadata":
+			if r.ArrayStart() {
+				v.URLsWithMetadata = make([]SharedStorageURLWithMetadata, 0, 4)
+				for r.ArrayNext() {
+					var e0 SharedStorageURLWithMetadata
+					e0.DecodeJSON(r)
+					v.URLsWithMetadata = append(v.URLsWithMetadata, e0)
+				}
+			} else {
+				v.URLsWithMetadata = nil
+			}
+		case "urnUuid":
+			if r.Null() {
+				v.UrnUUID = nil
+			} else {
+				p := r.String()
+				v.UrnUUID = &p
+			}
+		case "key":
+			if r.Null() {
+				v.Key = nil
+			} else {
+				p := r.String()
+				v.Key = &p
+			}
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				p := r.String()
+				v.Value = &p
+			}
+		case "ignoreIfPresent":
+			if r.Null() {
+				v.IgnoreIfPresent = nil
+			} else {
+				p := r.Bool()
+				v.IgnoreIfPresent = &p
+			}
+		case "workletOrdinal":
+			if r.Null() {
+				v.WorkletOrdinal = nil
+			} else {
+				p := r.Int()
+				v.WorkletOrdinal = &p
+			}
+		case "workletTargetId":
+			if r.Null() {
+				v.WorkletTargetID = nil
+			} else {
+				p := target.ID(r.String())
+				v.WorkletTargetID = &p
+			}
+		case "withLock":
+			if r.Null() {
+				v.WithLock = nil
+			} else {
+				p := r.String()
+				v.WithLock = &p
+			}
+		case "batchUpdateId":
+			if r.Null() {
+				v.BatchUpdateID = nil
+			} else {
+				p := r.String()
+				v.BatchUpdateID = &p
+			}
+		case "batchSize":
+			if r.Null() {
+				v.BatchSize = nil
+			} else {
+				p := r.Int()
+				v.BatchSize = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Bucket) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(string(v.StorageKey))
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Bucket) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = SerializedStorageKey(r.String())
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BucketInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bucket")
+	v.Bucket.EncodeJSON(w)
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("expiration")
+	w.Marshal(v.Expiration)
+	w.Key("quota")
+	w.Float(v.Quota)
+	w.Key("persistent")
+	w.Bool(v.Persistent)
+	w.Key("durability")
+	w.String(string(v.Durability))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BucketInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bucket":
+			v.Bucket.DecodeJSON(r)
+		case "id":
+			v.ID = r.String()
+		case "expiration":
+			r.Decode(&v.Expiration)
+		case "quota":
+			v.Quota = r.Float()
+		case "persistent":
+			v.Persistent = r.Bool()
+		case "durability":
+			v.Durability = BucketsDurability(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingFilterDataEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("values")
+	if v.Values == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Values {
+			w.String(v.Values[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingFilterDataEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key = r.String()
+		case "values":
+			if r.ArrayStart() {
+				v.Values = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Values = append(v.Values, e0)
+				}
+			} else {
+				v.Values = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingFilterConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("filterValues")
+	if v.FilterValues == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FilterValues {
+			v.FilterValues[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.LookbackWindow != nil {
+		w.Key("lookbackWindow")
+		w.Int(*v.LookbackWindow)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingFilterConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "filterValues":
+			if r.ArrayStart() {
+				v.FilterValues = make([]AttributionReportingFilterDataEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingFilterDataEntry
+					e0.DecodeJSON(r)
+					v.FilterValues = append(v.FilterValues, e0)
+				}
+			} else {
+				v.FilterValues = nil
+			}
+		case "lookbackWindow":
+			if r.Null() {
+				v.LookbackWindow = nil
+			} else {
+				p := r.Int()
+				v.LookbackWindow = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingFilterPair) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("filters")
+	if v.Filters == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Filters {
+			v.Filters[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("notFilters")
+	if v.NotFilters == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NotFilters {
+			v.NotFilters[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingFilterPair) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "filters":
+			if r.ArrayStart() {
+				v.Filters = make([]AttributionReportingFilterConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingFilterConfig
+					e0.DecodeJSON(r)
+					v.Filters = append(v.Filters, e0)
+				}
+			} else {
+				v.Filters = nil
+			}
+		case "notFilters":
+			if r.ArrayStart() {
+				v.NotFilters = make([]AttributionReportingFilterConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingFilterConfig
+					e0.DecodeJSON(r)
+					v.NotFilters = append(v.NotFilters, e0)
+				}
+			} else {
+				v.NotFilters = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregationKeysEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("value")
+	w.String(string(v.Value))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregationKeysEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key = r.String()
+		case "value":
+			v.Value = UnsignedInt128AsBase16(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingEventReportWindows) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("start")
+	w.Int(v.Start)
+	w.Key("ends")
+	if v.Ends == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Ends {
+			w.Int(v.Ends[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingEventReportWindows) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "start":
+			v.Start = r.Int()
+		case "ends":
+			if r.ArrayStart() {
+				v.Ends = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.Ends = append(v.Ends, e0)
+				}
+			} else {
+				v.Ends = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregatableDebugReportingData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("keyPiece")
+	w.String(string(v.KeyPiece))
+	w.Key("value")
+	w.Float(v.Value)
+	w.Key("types")
+	if v.Types == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Types {
+			w.String(v.Types[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregatableDebugReportingData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "keyPiece":
+			v.KeyPiece = UnsignedInt128AsBase16(r.String())
+		case "value":
+			v.Value = r.Float()
+		case "types":
+			if r.ArrayStart() {
+				v.Types = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Types = append(v.Types, e0)
+				}
+			} else {
+				v.Types = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregatableDebugReportingConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Budget != nil {
+		w.Key("budget")
+		w.Float(*v.Budget)
+	}
+	w.Key("keyPiece")
+	w.String(string(v.KeyPiece))
+	w.Key("debugData")
+	if v.DebugData == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DebugData {
+			v.DebugData[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.AggregationCoordinatorOrigin != nil {
+		w.Key("aggregationCoordinatorOrigin")
+		w.String(*v.AggregationCoordinatorOrigin)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregatableDebugReportingConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "budget":
+			if r.Null() {
+				v.Budget = nil
+			} else {
+				p := r.Float()
+				v.Budget = &p
+			}
+		case "keyPiece":
+			v.KeyPiece = UnsignedInt128AsBase16(r.String())
+		case "debugData":
+			if r.ArrayStart() {
+				v.DebugData = make([]AttributionReportingAggregatableDebugReportingData, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingAggregatableDebugReportingData
+					e0.DecodeJSON(r)
+					v.DebugData = append(v.DebugData, e0)
+				}
+			} else {
+				v.DebugData = nil
+			}
+		case "aggregationCoordinatorOrigin":
+			if r.Null() {
+				v.AggregationCoordinatorOrigin = nil
+			} else {
+				p := r.String()
+				v.AggregationCoordinatorOrigin = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionScopesData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("values")
+	if v.Values == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Values {
+			w.String(v.Values[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("limit")
+	w.Float(v.Limit)
+	w.Key("maxEventStates")
+	w.Float(v.MaxEventStates)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionScopesData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "values":
+			if r.ArrayStart() {
+				v.Values = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Values = append(v.Values, e0)
+				}
+			} else {
+				v.Values = nil
+			}
+		case "limit":
+			v.Limit = r.Float()
+		case "maxEventStates":
+			v.MaxEventStates = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingNamedBudgetDef) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("budget")
+	w.Int(v.Budget)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingNamedBudgetDef) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "budget":
+			v.Budget = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingSourceRegistration) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("time")
+	w.Marshal(v.Time)
+	w.Key("expiry")
+	w.Int(v.Expiry)
+	w.Key("triggerData")
+	if v.TriggerData == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TriggerData {
+			w.Float(v.TriggerData[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("eventReportWindows")
+	v.EventReportWindows.EncodeJSON(w)
+	w.Key("aggregatableReportWindow")
+	w.Int(v.AggregatableReportWindow)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("sourceOrigin")
+	w.String(v.SourceOrigin)
+	w.Key("reportingOrigin")
+	w.String(v.ReportingOrigin)
+	w.Key("destinationSites")
+	if v.DestinationSites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DestinationSites {
+			w.String(v.DestinationSites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("eventId")
+	w.String(string(v.EventID))
+	w.Key("priority")
+	w.String(string(v.Priority))
+	w.Key("filterData")
+	if v.FilterData == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FilterData {
+			v.FilterData[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("aggregationKeys")
+	if v.AggregationKeys == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AggregationKeys {
+			v.AggregationKeys[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.DebugKey != nil {
+		w.Key("debugKey")
+		w.String(string(*v.DebugKey))
+	}
+	w.Key("triggerDataMatching")
+	w.String(string(v.TriggerDataMatching))
+	w.Key("destinationLimitPriority")
+	w.String(string(v.DestinationLimitPriority))
+	w.Key("aggregatableDebugReportingConfig")
+	v.AggregatableDebugReportingConfig.EncodeJSON(w)
+	if v.ScopesData != nil {
+		w.Key("scopesData")
+		v.ScopesData.EncodeJSON(w)
+	}
+	w.Key("maxEventLevelReports")
+	w.Int(v.MaxEventLevelReports)
+	w.Key("namedBudgets")
+	if v.NamedBudgets == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NamedBudgets {
+			v.NamedBudgets[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("debugReporting")
+	w.Bool(v.DebugReporting)
+	w.Key("eventLevelEpsilon")
+	w.Float(v.EventLevelEpsilon)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingSourceRegistration) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "time":
+			r.Decode(&v.Time)
+		case "expiry":
+			v.Expiry = r.Int()
+		case "triggerData":
+			if r.ArrayStart() {
+				v.TriggerData = make([]float64, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.TriggerData = append(v.TriggerData, e0)
+				}
+			} else {
+				v.TriggerData = nil
+			}
+		case "eventReportWindows":
+			v.EventReportWindows.DecodeJSON(r)
+		case "aggregatableReportWindow":
+			v.AggregatableReportWindow = r.Int()
+		case "type":
+			v.Type = AttributionReportingSourceType(r.String())
+		case "sourceOrigin":
+			v.SourceOrigin = r.String()
+		case "reportingOrigin":
+			v.ReportingOrigin = r.String()
+		case "destinationSites":
+			if r.ArrayStart() {
+				v.DestinationSites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.DestinationSites = append(v.DestinationSites, e0)
+				}
+			} else {
+				v.DestinationSites = nil
+			}
+		case "eventId":
+			v.EventID = UnsignedInt64AsBase10(r.String())
+		case "priority":
+			v.Priority = SignedInt64AsBase10(r.String())
+		case "filterData":
+			if r.ArrayStart() {
+				v.FilterData = make([]AttributionReportingFilterDataEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingFilterDataEntry
+					e0.DecodeJSON(r)
+					v.FilterData = append(v.FilterData, e0)
+				}
+			} else {
+				v.FilterData = nil
+			}
+		case "aggregationKeys":
+			if r.ArrayStart() {
+				v.AggregationKeys = make([]AttributionReportingAggregationKeysEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingAggregationKeysEntry
+					e0.DecodeJSON(r)
+					v.AggregationKeys = append(v.AggregationKeys, e0)
+				}
+			} else {
+				v.AggregationKeys = nil
+			}
+		case "debugKey":
+			if r.Null() {
+				v.DebugKey = nil
+			} else {
+				p := UnsignedInt64AsBase10(r.String())
+				v.DebugKey = &p
+			}
+		case "triggerDataMatching":
+			v.TriggerDataMatching = AttributionReportingTriggerDataMatching(r.String())
+		case "destinationLimitPriority":
+			v.DestinationLimitPriority = SignedInt64AsBase10(r.String())
+		case "aggregatableDebugReportingConfig":
+			v.AggregatableDebugReportingConfig.DecodeJSON(r)
+		case "scopesData":
+			if r.Null() {
+				v.ScopesData = nil
+			} else {
+				var p AttributionScopesData
+				p.DecodeJSON(r)
+				v.ScopesData = &p
+			}
+		case "maxEventLevelReports":
+			v.MaxEventLevelReports = r.Int()
+		case "namedBudgets":
+			if r.ArrayStart() {
+				v.NamedBudgets = make([]AttributionReportingNamedBudgetDef, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingNamedBudgetDef
+					e0.DecodeJSON(r)
+					v.NamedBudgets = append(v.NamedBudgets, e0)
+				}
+			} else {
+				v.NamedBudgets = nil
+			}
+		case "debugReporting":
+			v.DebugReporting = r.Bool()
+		case "eventLevelEpsilon":
+			v.EventLevelEpsilon = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregatableValueDictEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("value")
+	w.Float(v.Value)
+	w.Key("filteringId")
+	w.String(string(v.FilteringID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregatableValueDictEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key = r.String()
+		case "value":
+			v.Value = r.Float()
+		case "filteringId":
+			v.FilteringID = UnsignedInt64AsBase10(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregatableValueEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("values")
+	if v.Values == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Values {
+			v.Values[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("filters")
+	v.Filters.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregatableValueEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "values":
+			if r.ArrayStart() {
+				v.Values = make([]AttributionReportingAggregatableValueDictEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingAggregatableValueDictEntry
+					e0.DecodeJSON(r)
+					v.Values = append(v.Values, e0)
+				}
+			} else {
+				v.Values = nil
+			}
+		case "filters":
+			v.Filters.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingEventTriggerData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.String(string(v.Data))
+	w.Key("priority")
+	w.String(string(v.Priority))
+	if v.DedupKey != nil {
+		w.Key("dedupKey")
+		w.String(string(*v.DedupKey))
+	}
+	w.Key("filters")
+	v.Filters.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingEventTriggerData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = UnsignedInt64AsBase10(r.String())
+		case "priority":
+			v.Priority = SignedInt64AsBase10(r.String())
+		case "dedupKey":
+			if r.Null() {
+				v.DedupKey = nil
+			} else {
+				p := UnsignedInt64AsBase10(r.String())
+				v.DedupKey = &p
+			}
+		case "filters":
+			v.Filters.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregatableTriggerData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("keyPiece")
+	w.String(string(v.KeyPiece))
+	w.Key("sourceKeys")
+	if v.SourceKeys == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.SourceKeys {
+			w.String(v.SourceKeys[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("filters")
+	v.Filters.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregatableTriggerData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "keyPiece":
+			v.KeyPiece = UnsignedInt128AsBase16(r.String())
+		case "sourceKeys":
+			if r.ArrayStart() {
+				v.SourceKeys = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.SourceKeys = append(v.SourceKeys, e0)
+				}
+			} else {
+				v.SourceKeys = nil
+			}
+		case "filters":
+			v.Filters.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingAggregatableDedupKey) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.DedupKey != nil {
+		w.Key("dedupKey")
+		w.String(string(*v.DedupKey))
+	}
+	w.Key("filters")
+	v.Filters.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingAggregatableDedupKey) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dedupKey":
+			if r.Null() {
+				v.DedupKey = nil
+			} else {
+				p := UnsignedInt64AsBase10(r.String())
+				v.DedupKey = &p
+			}
+		case "filters":
+			v.Filters.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingNamedBudgetCandidate) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	w.Key("filters")
+	v.Filters.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingNamedBudgetCandidate) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "filters":
+			v.Filters.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingTriggerRegistration) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("filters")
+	v.Filters.EncodeJSON(w)
+	if v.DebugKey != nil {
+		w.Key("debugKey")
+		w.String(string(*v.DebugKey))
+	}
+	w.Key("aggregatableDedupKeys")
+	if v.AggregatableDedupKeys == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AggregatableDedupKeys {
+			v.AggregatableDedupKeys[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("eventTriggerData")
+	if v.EventTriggerData == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.EventTriggerData {
+			v.EventTriggerData[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("aggregatableTriggerData")
+	if v.AggregatableTriggerData == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AggregatableTriggerData {
+			v.AggregatableTriggerData[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("aggregatableValues")
+	if v.AggregatableValues == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AggregatableValues {
+			v.AggregatableValues[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("aggregatableFilteringIdMaxBytes")
+	w.Int(v.AggregatableFilteringIDMaxBytes)
+	w.Key("debugReporting")
+	w.Bool(v.DebugReporting)
+	if v.AggregationCoordinatorOrigin != nil {
+		w.Key("aggregationCoordinatorOrigin")
+		w.String(*v.AggregationCoordinatorOrigin)
+	}
+	w.Key("sourceRegistrationTimeConfig")
+	w.String(string(v.SourceRegistrationTimeConfig))
+	if v.TriggerContextID != nil {
+		w.Key("triggerContextId")
+		w.String(*v.TriggerContextID)
+	}
+	w.Key("aggregatableDebugReportingConfig")
+	v.AggregatableDebugReportingConfig.EncodeJSON(w)
+	w.Key("scopes")
+	if v.Scopes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Scopes {
+			w.String(v.Scopes[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("namedBudgets")
+	if v.NamedBudgets == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NamedBudgets {
+			v.NamedBudgets[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingTriggerRegistration) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "filters":
+			v.Filters.DecodeJSON(r)
+		case "debugKey":
+			if r.Null() {
+				v.DebugKey = nil
+			} else {
+				p := UnsignedInt64AsBase10(r.String())
+				v.DebugKey = &p
+			}
+		case "aggregatableDedupKeys":
+			if r.ArrayStart() {
+				v.AggregatableDedupKeys = make([]AttributionReportingAggregatableDedupKey, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingAggregatableDedupKey
+					e0.DecodeJSON(r)
+					v.AggregatableDedupKeys = append(v.AggregatableDedupKeys, e0)
+				}
+			} else {
+				v.AggregatableDedupKeys = nil
+			}
+		case "eventTriggerData":
+			if r.ArrayStart() {
+				v.EventTriggerData = make([]AttributionReportingEventTriggerData, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingEventTriggerData
+					e0.DecodeJSON(r)
+					v.EventTriggerData = append(v.EventTriggerData, e0)
+				}
+			} else {
+				v.EventTriggerData = nil
+			}
+		case "aggregatableTriggerData":
+			if r.ArrayStart() {
+				v.AggregatableTriggerData = make([]AttributionReportingAggregatableTriggerData, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingAggregatableTriggerData
+					e0.DecodeJSON(r)
+					v.AggregatableTriggerData = append(v.AggregatableTriggerData, e0)
+				}
+			} else {
+				v.AggregatableTriggerData = nil
+			}
+		case "aggregatableValues":
+			if r.ArrayStart() {
+				v.AggregatableValues = make([]AttributionReportingAggregatableValueEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingAggregatableValueEntry
+					e0.DecodeJSON(r)
+					v.AggregatableValues = append(v.AggregatableValues, e0)
+				}
+			} else {
+				v.AggregatableValues = nil
+			}
+		case "aggregatableFilteringIdMaxBytes":
+			v.AggregatableFilteringIDMaxBytes = r.Int()
+		case "debugReporting":
+			v.DebugReporting = r.Bool()
+		case "aggregationCoordinatorOrigin":
+			if r.Null() {
+				v.AggregationCoordinatorOrigin = nil
+			} else {
+				p := r.String()
+				v.AggregationCoordinatorOrigin = &p
+			}
+		case "sourceRegistrationTimeConfig":
+			v.SourceRegistrationTimeConfig = AttributionReportingSourceRegistrationTimeConfig(r.String())
+		case "triggerContextId":
+			if r.Null() {
+				v.TriggerContextID = nil
+			} else {
+				p := r.String()
+				v.TriggerContextID = &p
+			}
+		case "aggregatableDebugReportingConfig":
+			v.AggregatableDebugReportingConfig.DecodeJSON(r)
+		case "scopes":
+			if r.ArrayStart() {
+				v.Scopes = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Scopes = append(v.Scopes, e0)
+				}
+			} else {
+				v.Scopes = nil
+			}
+		case "namedBudgets":
+			if r.ArrayStart() {
+				v.NamedBudgets = make([]AttributionReportingNamedBudgetCandidate, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttributionReportingNamedBudgetCandidate
+					e0.DecodeJSON(r)
+					v.NamedBudgets = append(v.NamedBudgets, e0)
+				}
+			} else {
+				v.NamedBudgets = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RelatedWebsiteSet) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("primarySites")
+	if v.PrimarySites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.PrimarySites {
+			w.String(v.PrimarySites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("associatedSites")
+	if v.AssociatedSites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AssociatedSites {
+			w.String(v.AssociatedSites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("serviceSites")
+	if v.ServiceSites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ServiceSites {
+			w.String(v.ServiceSites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RelatedWebsiteSet) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "primarySites":
+			if r.ArrayStart() {
+				v.PrimarySites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.PrimarySites = append(v.PrimarySites, e0)
+				}
+			} else {
+				v.PrimarySites = nil
+			}
+		case "associatedSites":
+			if r.ArrayStart() {
+				v.AssociatedSites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.AssociatedSites = append(v.AssociatedSites, e0)
+				}
+			} else {
+				v.AssociatedSites = nil
+			}
+		case "serviceSites":
+			if r.ArrayStart() {
+				v.ServiceSites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ServiceSites = append(v.ServiceSites, e0)
+				}
+			} else {
+				v.ServiceSites = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStorageKeyForFrameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStorageKeyForFrameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStorageKeyForFrameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(string(v.StorageKey))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStorageKeyForFrameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = SerializedStorageKey(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStorageKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStorageKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.FrameID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetStorageKeyReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(string(v.StorageKey))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetStorageKeyReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = SerializedStorageKey(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearDataForOriginArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("storageTypes")
+	w.String(v.StorageTypes)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearDataForOriginArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "storageTypes":
+			v.StorageTypes = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearDataForStorageKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.Key("storageTypes")
+	w.String(v.StorageTypes)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearDataForStorageKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "storageTypes":
+			v.StorageTypes = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCookiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCookiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := browser.ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCookiesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cookies")
+	if v.Cookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Cookies {
+			v.Cookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCookiesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookies":
+			if r.ArrayStart() {
+				v.Cookies = make([]network.Cookie, 0, 4)
+				for r.ArrayNext() {
+					var e0 network.Cookie
+					e0.DecodeJSON(r)
+					v.Cookies = append(v.Cookies, e0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCookiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("cookies")
+	if v.Cookies == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Cookies {
+			v.Cookies[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCookiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "cookies":
+			if r.ArrayStart() {
+				v.Cookies = make([]network.CookieParam, 0, 4)
+				for r.ArrayNext() {
+					var e0 network.CookieParam
+					e0.DecodeJSON(r)
+					v.Cookies = append(v.Cookies, e0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := browser.ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearCookiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearCookiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := browser.ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetUsageAndQuotaArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetUsageAndQuotaArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetUsageAndQuotaReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("usage")
+	w.Float(v.Usage)
+	w.Key("quota")
+	w.Float(v.Quota)
+	w.Key("overrideActive")
+	w.Bool(v.OverrideActive)
+	w.Key("usageBreakdown")
+	if v.UsageBreakdown == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.UsageBreakdown {
+			v.UsageBreakdown[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetUsageAndQuotaReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "usage":
+			v.Usage = r.Float()
+		case "quota":
+			v.Quota = r.Float()
+		case "overrideActive":
+			v.OverrideActive = r.Bool()
+		case "usageBreakdown":
+			if r.ArrayStart() {
+				v.UsageBreakdown = make([]UsageForType, 0, 4)
+				for r.ArrayNext() {
+					var e0 UsageForType
+					e0.DecodeJSON(r)
+					v.UsageBreakdown = append(v.UsageBreakdown, e0)
+				}
+			} else {
+				v.UsageBreakdown = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OverrideQuotaForOriginArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	if v.QuotaSize != nil {
+		w.Key("quotaSize")
+		w.Float(*v.QuotaSize)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OverrideQuotaForOriginArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "quotaSize":
+			if r.Null() {
+				v.QuotaSize = nil
+			} else {
+				p := r.Float()
+				v.QuotaSize = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrackCacheStorageForOriginArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrackCacheStorageForOriginArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrackCacheStorageForStorageKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrackCacheStorageForStorageKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrackIndexedDBForOriginArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrackIndexedDBForOriginArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TrackIndexedDBForStorageKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TrackIndexedDBForStorageKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UntrackCacheStorageForOriginArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UntrackCacheStorageForOriginArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UntrackCacheStorageForStorageKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UntrackCacheStorageForStorageKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UntrackIndexedDBForOriginArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UntrackIndexedDBForOriginArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UntrackIndexedDBForStorageKeyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UntrackIndexedDBForStorageKeyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetTrustTokensReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("tokens")
+	if v.Tokens == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Tokens {
+			v.Tokens[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetTrustTokensReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "tokens":
+			if r.ArrayStart() {
+				v.Tokens = make([]TrustTokens, 0, 4)
+				for r.ArrayNext() {
+					var e0 TrustTokens
+					e0.DecodeJSON(r)
+					v.Tokens = append(v.Tokens, e0)
+				}
+			} else {
+				v.Tokens = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearTrustTokensArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("issuerOrigin")
+	w.String(v.IssuerOrigin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearTrustTokensArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "issuerOrigin":
+			v.IssuerOrigin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearTrustTokensReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("didDeleteTokens")
+	w.Bool(v.DidDeleteTokens)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearTrustTokensReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "didDeleteTokens":
+			v.DidDeleteTokens = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetInterestGroupDetailsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.Key("name")
+	w.String(v.Name)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetInterestGroupDetailsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		case "name":
+			v.Name = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetInterestGroupDetailsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("details")
+	w.Raw(v.Details)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetInterestGroupDetailsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "details":
+			v.Details = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInterestGroupTrackingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInterestGroupTrackingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInterestGroupAuctionTrackingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInterestGroupAuctionTrackingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSharedStorageMetadataArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSharedStorageMetadataArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSharedStorageMetadataReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("metadata")
+	v.Metadata.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSharedStorageMetadataReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "metadata":
+			v.Metadata.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSharedStorageEntriesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSharedStorageEntriesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSharedStorageEntriesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("entries")
+	if v.Entries == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Entries {
+			v.Entries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSharedStorageEntriesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "entries":
+			if r.ArrayStart() {
+				v.Entries = make([]SharedStorageEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 SharedStorageEntry
+					e0.DecodeJSON(r)
+					v.Entries = append(v.Entries, e0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSharedStorageEntryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("value")
+	w.String(v.Value)
+	if v.IgnoreIfPresent != nil {
+		w.Key("ignoreIfPresent")
+		w.Bool(*v.IgnoreIfPresent)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSharedStorageEntryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		case "key":
+			v.Key = r.String()
+		case "value":
+			v.Value = r.String()
+		case "ignoreIfPresent":
+			if r.Null() {
+				v.IgnoreIfPresent = nil
+			} else {
+				p := r.Bool()
+				v.IgnoreIfPresent = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteSharedStorageEntryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.Key("key")
+	w.String(v.Key)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteSharedStorageEntryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		case "key":
+			v.Key = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearSharedStorageEntriesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearSharedStorageEntriesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResetSharedStorageBudgetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResetSharedStorageBudgetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSharedStorageTrackingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSharedStorageTrackingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetStorageBucketTrackingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetStorageBucketTrackingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteStorageBucketArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bucket")
+	v.Bucket.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteStorageBucketArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bucket":
+			v.Bucket.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RunBounceTrackingMitigationsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("deletedSites")
+	if v.DeletedSites == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DeletedSites {
+			w.String(v.DeletedSites[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RunBounceTrackingMitigationsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "deletedSites":
+			if r.ArrayStart() {
+				v.DeletedSites = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.DeletedSites = append(v.DeletedSites, e0)
+				}
+			} else {
+				v.DeletedSites = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAttributionReportingLocalTestingModeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAttributionReportingLocalTestingModeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAttributionReportingTrackingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAttributionReportingTrackingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SendPendingAttributionReportsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("numSent")
+	w.Int(v.NumSent)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SendPendingAttributionReportsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "numSent":
+			v.NumSent = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRelatedWebsiteSetsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sets")
+	if v.Sets == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Sets {
+			v.Sets[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRelatedWebsiteSetsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sets":
+			if r.ArrayStart() {
+				v.Sets = make([]RelatedWebsiteSet, 0, 4)
+				for r.ArrayNext() {
+					var e0 RelatedWebsiteSet
+					e0.DecodeJSON(r)
+					v.Sets = append(v.Sets, e0)
+				}
+			} else {
+				v.Sets = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAffectedURLsForThirdPartyCookieMetadataArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("firstPartyUrl")
+	w.String(v.FirstPartyURL)
+	w.Key("thirdPartyUrls")
+	if v.ThirdPartyURLs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ThirdPartyURLs {
+			w.String(v.ThirdPartyURLs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAffectedURLsForThirdPartyCookieMetadataArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "firstPartyUrl":
+			v.FirstPartyURL = r.String()
+		case "thirdPartyUrls":
+			if r.ArrayStart() {
+				v.ThirdPartyURLs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ThirdPartyURLs = append(v.ThirdPartyURLs, e0)
+				}
+			} else {
+				v.ThirdPartyURLs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAffectedURLsForThirdPartyCookieMetadataReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("matchedUrls")
+	if v.MatchedURLs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.MatchedURLs {
+			w.String(v.MatchedURLs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAffectedURLsForThirdPartyCookieMetadataReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "matchedUrls":
+			if r.ArrayStart() {
+				v.MatchedURLs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.MatchedURLs = append(v.MatchedURLs, e0)
+				}
+			} else {
+				v.MatchedURLs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetProtectedAudienceKAnonymityArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("owner")
+	w.String(v.Owner)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("hashes")
+	if v.Hashes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Hashes {
+			w.String(v.Hashes[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetProtectedAudienceKAnonymityArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "owner":
+			v.Owner = r.String()
+		case "name":
+			v.Name = r.String()
+		case "hashes":
+			if r.ArrayStart() {
+				v.Hashes = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Hashes = append(v.Hashes, e0)
+				}
+			} else {
+				v.Hashes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CacheStorageContentUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.Key("bucketId")
+	w.String(v.BucketID)
+	w.Key("cacheName")
+	w.String(v.CacheName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CacheStorageContentUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "bucketId":
+			v.BucketID = r.String()
+		case "cacheName":
+			v.CacheName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CacheStorageListUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.Key("bucketId")
+	w.String(v.BucketID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CacheStorageListUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "bucketId":
+			v.BucketID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IndexedDBContentUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.Key("bucketId")
+	w.String(v.BucketID)
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.Key("objectStoreName")
+	w.String(v.ObjectStoreName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IndexedDBContentUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "bucketId":
+			v.BucketID = r.String()
+		case "databaseName":
+			v.DatabaseName = r.String()
+		case "objectStoreName":
+			v.ObjectStoreName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IndexedDBListUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.Key("bucketId")
+	w.String(v.BucketID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IndexedDBListUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "storageKey":
+			v.StorageKey = r.String()
+		case "bucketId":
+			v.BucketID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InterestGroupAccessedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("accessTime")
+	w.Marshal(v.AccessTime)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.Key("name")
+	w.String(v.Name)
+	if v.ComponentSellerOrigin != nil {
+		w.Key("componentSellerOrigin")
+		w.String(*v.ComponentSellerOrigin)
+	}
+	if v.Bid != nil {
+		w.Key("bid")
+		w.Float(*v.Bid)
+	}
+	if v.BidCurrency != nil {
+		w.Key("bidCurrency")
+		w.String(*v.BidCurrency)
+	}
+	if v.UniqueAuctionID != nil {
+		w.Key("uniqueAuctionId")
+		w.String(string(*v.UniqueAuctionID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InterestGroupAccessedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "accessTime":
+			r.Decode(&v.AccessTime)
+		case "type":
+			v.Type = InterestGroupAccessType(r.String())
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		case "name":
+			v.Name = r.String()
+		case "componentSellerOrigin":
+			if r.Null() {
+				v.ComponentSellerOrigin = nil
+			} else {
+				p := r.String()
+				v.ComponentSellerOrigin = &p
+			}
+		case "bid":
+			if r.Null() {
+				v.Bid = nil
+			} else {
+				p := r.Float()
+				v.Bid = &p
+			}
+		case "bidCurrency":
+			if r.Null() {
+				v.BidCurrency = nil
+			} else {
+				p := r.String()
+				v.BidCurrency = &p
+			}
+		case "uniqueAuctionId":
+			if r.Null() {
+				v.UniqueAuctionID = nil
+			} else {
+				p := InterestGroupAuctionID(r.String())
+				v.UniqueAuctionID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InterestGroupAuctionEventOccurredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("eventTime")
+	w.Marshal(v.EventTime)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("uniqueAuctionId")
+	w.String(string(v.UniqueAuctionID))
+	if v.ParentAuctionID != nil {
+		w.Key("parentAuctionId")
+		w.String(string(*v.ParentAuctionID))
+	}
+	if len(v.AuctionConfig) > 0 {
+		w.Key("auctionConfig")
+		w.Raw(v.AuctionConfig)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InterestGroupAuctionEventOccurredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "eventTime":
+			r.Decode(&v.EventTime)
+		case "type":
+			v.Type = InterestGroupAuctionEventType(r.String())
+		case "uniqueAuctionId":
+			v.UniqueAuctionID = InterestGroupAuctionID(r.String())
+		case "parentAuctionId":
+			if r.Null() {
+				v.ParentAuctionID = nil
+			} else {
+				p := InterestGroupAuctionID(r.String())
+				v.ParentAuctionID = &p
+			}
+		case "auctionConfig":
+			v.AuctionConfig = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InterestGroupAuctionNetworkRequestCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("auctions")
+	if v.Auctions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Auctions {
+			w.String(string(v.Auctions[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InterestGroupAuctionNetworkRequestCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = InterestGroupAuctionFetchType(r.String())
+		case "requestId":
+			v.RequestID = network.RequestID(r.String())
+		case "auctions":
+			if r.ArrayStart() {
+				v.Auctions = make([]InterestGroupAuctionID, 0, 4)
+				for r.ArrayNext() {
+					e0 := InterestGroupAuctionID(r.String())
+					v.Auctions = append(v.Auctions, e0)
+				}
+			} else {
+				v.Auctions = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageAccessedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("accessTime")
+	w.Marshal(v.AccessTime)
+	w.Key("scope")
+	w.String(string(v.Scope))
+	w.Key("method")
+	w.String(string(v.Method))
+	w.Key("mainFrameId")
+	w.String(string(v.MainFrameID))
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.Key("ownerSite")
+	w.String(v.OwnerSite)
+	w.Key("params")
+	v.Params.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageAccessedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "accessTime":
+			r.Decode(&v.AccessTime)
+		case "scope":
+			v.Scope = SharedStorageAccessScope(r.String())
+		case "method":
+			v.Method = SharedStorageAccessMethod(r.String())
+		case "mainFrameId":
+			v.MainFrameID = page.FrameID(r.String())
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		case "ownerSite":
+			v.OwnerSite = r.String()
+		case "params":
+			v.Params.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SharedStorageWorkletOperationExecutionFinishedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("finishedTime")
+	w.Marshal(v.FinishedTime)
+	w.Key("executionTime")
+	w.Int(v.ExecutionTime)
+	w.Key("method")
+	w.String(string(v.Method))
+	w.Key("operationId")
+	w.String(v.OperationID)
+	w.Key("workletTargetId")
+	w.String(string(v.WorkletTargetID))
+	w.Key("mainFrameId")
+	w.String(string(v.MainFrameID))
+	w.Key("ownerOrigin")
+	w.String(v.OwnerOrigin)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SharedStorageWorkletOperationExecutionFinishedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "finishedTime":
+			r.Decode(&v.FinishedTime)
+		case "executionTime":
+			v.ExecutionTime = r.Int()
+		case "method":
+			v.Method = SharedStorageAccessMethod(r.String())
+		case "operationId":
+			v.OperationID = r.String()
+		case "workletTargetId":
+			v.WorkletTargetID = target.ID(r.String())
+		case "mainFrameId":
+			v.MainFrameID = page.FrameID(r.String())
+		case "ownerOrigin":
+			v.OwnerOrigin = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BucketCreatedOrUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bucketInfo")
+	v.BucketInfo.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BucketCreatedOrUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bucketInfo":
+			v.BucketInfo.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BucketDeletedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bucketId")
+	w.String(v.BucketID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BucketDeletedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bucketId":
+			v.BucketID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingSourceRegisteredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("registration")
+	v.Registration.EncodeJSON(w)
+	w.Key("result")
+	w.String(string(v.Result))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingSourceRegisteredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "registration":
+			v.Registration.DecodeJSON(r)
+		case "result":
+			v.Result = AttributionReportingSourceRegistrationResult(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingTriggerRegisteredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("registration")
+	v.Registration.EncodeJSON(w)
+	w.Key("eventLevel")
+	w.String(string(v.EventLevel))
+	w.Key("aggregatable")
+	w.String(string(v.Aggregatable))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingTriggerRegisteredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "registration":
+			v.Registration.DecodeJSON(r)
+		case "eventLevel":
+			v.EventLevel = AttributionReportingEventLevelResult(r.String())
+		case "aggregatable":
+			v.Aggregatable = AttributionReportingAggregatableResult(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingReportSentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("body")
+	w.Raw(v.Body)
+	w.Key("result")
+	w.String(string(v.Result))
+	if v.NetError != nil {
+		w.Key("netError")
+		w.Int(*v.NetError)
+	}
+	if v.NetErrorName != nil {
+		w.Key("netErrorName")
+		w.String(*v.NetErrorName)
+	}
+	if v.HTTPStatusCode != nil {
+		w.Key("httpStatusCode")
+		w.Int(*v.HTTPStatusCode)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingReportSentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "body":
+			v.Body = r.Raw()
+		case "result":
+			v.Result = AttributionReportingReportResult(r.String())
+		case "netError":
+			if r.Null() {
+				v.NetError = nil
+			} else {
+				p := r.Int()
+				v.NetError = &p
+			}
+		case "netErrorName":
+			if r.Null() {
+				v.NetErrorName = nil
+			} else {
+				p := r.String()
+				v.NetErrorName = &p
+			}
+		case "httpStatusCode":
+			if r.Null() {
+				v.HTTPStatusCode = nil
+			} else {
+				p := r.Int()
+				v.HTTPStatusCode = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributionReportingVerboseDebugReportSentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if len(v.Body) > 0 {
+		w.Key("body")
+		w.ArrayStart()
+		for i0 := range v.Body {
+			w.Raw(v.Body[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.NetError != nil {
+		w.Key("netError")
+		w.Int(*v.NetError)
+	}
+	if v.NetErrorName != nil {
+		w.Key("netErrorName")
+		w.String(*v.NetErrorName)
+	}
+	if v.HTTPStatusCode != nil {
+		w.Key("httpStatusCode")
+		w.Int(*v.HTTPStatusCode)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributionReportingVerboseDebugReportSentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "body":
+			if r.ArrayStart() {
+				v.Body = make([]json.RawMessage, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Raw()
+					v.Body = append(v.Body, e0)
+				}
+			} else {
+				v.Body = nil
+			}
+		case "netError":
+			if r.Null() {
+				v.NetError = nil
+			} else {
+				p := r.Int()
+				v.NetError = &p
+			}
+		case "netErrorName":
+			if r.Null() {
+				v.NetErrorName = nil
+			} else {
+				p := r.String()
+				v.NetErrorName = &p
+			}
+		case "httpStatusCode":
+			if r.Null() {
+				v.HTTPStatusCode = nil
+			} else {
+				p := r.Int()
+				v.HTTPStatusCode = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
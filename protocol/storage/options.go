@@ -0,0 +1,135 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// GetStorageKeyOption sets an optional argument of GetStorageKeyArgs.
+type GetStorageKeyOption func(*GetStorageKeyArgs)
+
+// NewGetStorageKeyArgsWith initializes GetStorageKeyArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetStorageKeyArgsWith(opts ...GetStorageKeyOption) *GetStorageKeyArgs {
+	args := NewGetStorageKeyArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetStorageKeyWithFrameID sets the FrameID optional argument, see
+// GetStorageKeyArgs.SetFrameID.
+func GetStorageKeyWithFrameID(v page.FrameID) GetStorageKeyOption {
+	return func(a *GetStorageKeyArgs) { a.SetFrameID(v) }
+}
+
+// GetCookiesOption sets an optional argument of GetCookiesArgs.
+type GetCookiesOption func(*GetCookiesArgs)
+
+// NewGetCookiesArgsWith initializes GetCookiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetCookiesArgsWith(opts ...GetCookiesOption) *GetCookiesArgs {
+	args := NewGetCookiesArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetCookiesWithBrowserContextID sets the BrowserContextID optional argument, see
+// GetCookiesArgs.SetBrowserContextID.
+func GetCookiesWithBrowserContextID(v browser.ContextID) GetCookiesOption {
+	return func(a *GetCookiesArgs) { a.SetBrowserContextID(v) }
+}
+
+// SetCookiesOption sets an optional argument of SetCookiesArgs.
+type SetCookiesOption func(*SetCookiesArgs)
+
+// NewSetCookiesArgsWith initializes SetCookiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetCookiesArgsWith(cookies []network.CookieParam, opts ...SetCookiesOption) *SetCookiesArgs {
+	args := NewSetCookiesArgs(cookies)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetCookiesWithBrowserContextID sets the BrowserContextID optional argument, see
+// SetCookiesArgs.SetBrowserContextID.
+func SetCookiesWithBrowserContextID(v browser.ContextID) SetCookiesOption {
+	return func(a *SetCookiesArgs) { a.SetBrowserContextID(v) }
+}
+
+// ClearCookiesOption sets an optional argument of ClearCookiesArgs.
+type ClearCookiesOption func(*ClearCookiesArgs)
+
+// NewClearCookiesArgsWith initializes ClearCookiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewClearCookiesArgsWith(opts ...ClearCookiesOption) *ClearCookiesArgs {
+	args := NewClearCookiesArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ClearCookiesWithBrowserContextID sets the BrowserContextID optional argument, see
+// ClearCookiesArgs.SetBrowserContextID.
+func ClearCookiesWithBrowserContextID(v browser.ContextID) ClearCookiesOption {
+	return func(a *ClearCookiesArgs) { a.SetBrowserContextID(v) }
+}
+
+// OverrideQuotaForOriginOption sets an optional argument of OverrideQuotaForOriginArgs.
+type OverrideQuotaForOriginOption func(*OverrideQuotaForOriginArgs)
+
+// NewOverrideQuotaForOriginArgsWith initializes OverrideQuotaForOriginArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewOverrideQuotaForOriginArgsWith(origin string, opts ...OverrideQuotaForOriginOption) *OverrideQuotaForOriginArgs {
+	args := NewOverrideQuotaForOriginArgs(origin)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// OverrideQuotaForOriginWithQuotaSize sets the QuotaSize optional argument, see
+// OverrideQuotaForOriginArgs.SetQuotaSize.
+func OverrideQuotaForOriginWithQuotaSize(v float64) OverrideQuotaForOriginOption {
+	return func(a *OverrideQuotaForOriginArgs) { a.SetQuotaSize(v) }
+}
+
+// SetSharedStorageEntryOption sets an optional argument of SetSharedStorageEntryArgs.
+type SetSharedStorageEntryOption func(*SetSharedStorageEntryArgs)
+
+// NewSetSharedStorageEntryArgsWith initializes SetSharedStorageEntryArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetSharedStorageEntryArgsWith(ownerOrigin string, key string, value string, opts ...SetSharedStorageEntryOption) *SetSharedStorageEntryArgs {
+	args := NewSetSharedStorageEntryArgs(ownerOrigin, key, value)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetSharedStorageEntryWithIgnoreIfPresent sets the IgnoreIfPresent optional argument, see
+// SetSharedStorageEntryArgs.SetIgnoreIfPresent.
+func SetSharedStorageEntryWithIgnoreIfPresent(v bool) SetSharedStorageEntryOption {
+	return func(a *SetSharedStorageEntryArgs) { a.SetIgnoreIfPresent(v) }
+}
@@ -22,6 +22,12 @@ func NewGetFeatureStateArgs(featureState string) *GetFeatureStateArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetFeatureStateArgs) Validate() error {
+	return nil
+}
+
 // GetFeatureStateReply represents the return values for GetFeatureState in the SystemInfo domain.
 type GetFeatureStateReply struct {
 	FeatureEnabled bool `json:"featureEnabled"` // No description.
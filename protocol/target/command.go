@@ -3,6 +3,9 @@
 package target
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/mafredri/cdp/protocol/internal"
 )
 
@@ -18,6 +21,12 @@ func NewActivateTargetArgs(targetID ID) *ActivateTargetArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ActivateTargetArgs) Validate() error {
+	return nil
+}
+
 // AttachToTargetArgs represents the arguments for AttachToTarget in the Target domain.
 type AttachToTargetArgs struct {
 	TargetID ID    `json:"targetId"`          // No description.
@@ -40,6 +49,12 @@ func (a *AttachToTargetArgs) SetFlatten(flatten bool) *AttachToTargetArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AttachToTargetArgs) Validate() error {
+	return nil
+}
+
 // AttachToTargetReply represents the return values for AttachToTarget in the Target domain.
 type AttachToTargetReply struct {
 	SessionID SessionID `json:"sessionId"` // Id assigned to the session.
@@ -62,6 +77,12 @@ func NewCloseTargetArgs(targetID ID) *CloseTargetArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CloseTargetArgs) Validate() error {
+	return nil
+}
+
 // CloseTargetReply represents the return values for CloseTarget in the Target domain.
 type CloseTargetReply struct {
 	// Success is deprecated.
@@ -100,6 +121,12 @@ func (a *ExposeDevToolsProtocolArgs) SetInheritPermissions(inheritPermissions bo
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ExposeDevToolsProtocolArgs) Validate() error {
+	return nil
+}
+
 // CreateBrowserContextArgs represents the arguments for CreateBrowserContext in the Target domain.
 type CreateBrowserContextArgs struct {
 	// DisposeOnDetach If specified, disposes this context when debugging
@@ -170,6 +197,12 @@ func (a *CreateBrowserContextArgs) SetOriginsWithUniversalNetworkAccess(originsW
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CreateBrowserContextArgs) Validate() error {
+	return nil
+}
+
 // CreateBrowserContextReply represents the return values for CreateBrowserContext in the Target domain.
 type CreateBrowserContextReply struct {
 	BrowserContextID internal.BrowserContextID `json:"browserContextId"` // The id of the context created.
@@ -325,6 +358,17 @@ func (a *CreateTargetArgs) SetHidden(hidden bool) *CreateTargetArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CreateTargetArgs) Validate() error {
+	if a.WindowState != "" {
+		if !a.WindowState.Valid() {
+			return errors.New("target.CreateTargetArgs: WindowState: invalid value " + strconv.Quote(string(a.WindowState)))
+		}
+	}
+	return nil
+}
+
 // CreateTargetReply represents the return values for CreateTarget in the Target domain.
 type CreateTargetReply struct {
 	TargetID ID `json:"targetId"` // The id of the page opened.
@@ -361,6 +405,12 @@ func (a *DetachFromTargetArgs) SetTargetID(targetID ID) *DetachFromTargetArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DetachFromTargetArgs) Validate() error {
+	return nil
+}
+
 // DisposeBrowserContextArgs represents the arguments for DisposeBrowserContext in the Target domain.
 type DisposeBrowserContextArgs struct {
 	BrowserContextID internal.BrowserContextID `json:"browserContextId"` // No description.
@@ -373,6 +423,12 @@ func NewDisposeBrowserContextArgs(browserContextID internal.BrowserContextID) *D
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DisposeBrowserContextArgs) Validate() error {
+	return nil
+}
+
 // GetTargetInfoArgs represents the arguments for GetTargetInfo in the Target domain.
 type GetTargetInfoArgs struct {
 	TargetID *ID `json:"targetId,omitempty"` // No description.
@@ -391,6 +447,12 @@ func (a *GetTargetInfoArgs) SetTargetID(targetID ID) *GetTargetInfoArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetTargetInfoArgs) Validate() error {
+	return nil
+}
+
 // GetTargetInfoReply represents the return values for GetTargetInfo in the Target domain.
 type GetTargetInfoReply struct {
 	TargetInfo Info `json:"targetInfo"` // No description.
@@ -424,6 +486,12 @@ func (a *GetTargetsArgs) SetFilter(filter Filter) *GetTargetsArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetTargetsArgs) Validate() error {
+	return nil
+}
+
 // GetTargetsReply represents the return values for GetTargets in the Target domain.
 type GetTargetsReply struct {
 	TargetInfos []Info `json:"targetInfos"` // The list of targets.
@@ -461,6 +529,12 @@ func (a *SendMessageToTargetArgs) SetTargetID(targetID ID) *SendMessageToTargetA
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SendMessageToTargetArgs) Validate() error {
+	return nil
+}
+
 // SetAutoAttachArgs represents the arguments for SetAutoAttach in the Target domain.
 type SetAutoAttachArgs struct {
 	AutoAttach             bool `json:"autoAttach"`             // Whether to auto-attach to related targets.
@@ -506,6 +580,12 @@ func (a *SetAutoAttachArgs) SetFilter(filter Filter) *SetAutoAttachArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutoAttachArgs) Validate() error {
+	return nil
+}
+
 // AutoAttachRelatedArgs represents the arguments for AutoAttachRelated in the Target domain.
 type AutoAttachRelatedArgs struct {
 	TargetID               ID   `json:"targetId"`               // No description.
@@ -533,6 +613,12 @@ func (a *AutoAttachRelatedArgs) SetFilter(filter Filter) *AutoAttachRelatedArgs
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AutoAttachRelatedArgs) Validate() error {
+	return nil
+}
+
 // SetDiscoverTargetsArgs represents the arguments for SetDiscoverTargets in the Target domain.
 type SetDiscoverTargetsArgs struct {
 	Discover bool `json:"discover"` // Whether to discover available targets.
@@ -560,6 +646,12 @@ func (a *SetDiscoverTargetsArgs) SetFilter(filter Filter) *SetDiscoverTargetsArg
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDiscoverTargetsArgs) Validate() error {
+	return nil
+}
+
 // SetRemoteLocationsArgs represents the arguments for SetRemoteLocations in the Target domain.
 type SetRemoteLocationsArgs struct {
 	Locations []RemoteLocation `json:"locations"` // List of remote locations.
@@ -572,6 +664,15 @@ func NewSetRemoteLocationsArgs(locations []RemoteLocation) *SetRemoteLocationsAr
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRemoteLocationsArgs) Validate() error {
+	if a.Locations == nil {
+		return errors.New("target.SetRemoteLocationsArgs: Locations is required")
+	}
+	return nil
+}
+
 // GetDevToolsTargetArgs represents the arguments for GetDevToolsTarget in the Target domain.
 type GetDevToolsTargetArgs struct {
 	TargetID ID `json:"targetId"` // Page or tab target ID.
@@ -584,6 +685,12 @@ func NewGetDevToolsTargetArgs(targetID ID) *GetDevToolsTargetArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetDevToolsTargetArgs) Validate() error {
+	return nil
+}
+
 // GetDevToolsTargetReply represents the return values for GetDevToolsTarget in the Target domain.
 type GetDevToolsTargetReply struct {
 	TargetID *ID `json:"targetId,omitempty"` // The targetId of DevTools page target if exists.
@@ -610,6 +717,12 @@ func (a *OpenDevToolsArgs) SetPanelID(panelID string) *OpenDevToolsArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *OpenDevToolsArgs) Validate() error {
+	return nil
+}
+
 // OpenDevToolsReply represents the return values for OpenDevTools in the Target domain.
 type OpenDevToolsReply struct {
 	TargetID ID `json:"targetId"` // The targetId of DevTools page target.
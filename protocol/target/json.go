@@ -0,0 +1,1401 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package target
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/internal"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Info) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("title")
+	w.String(v.Title)
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("attached")
+	w.Bool(v.Attached)
+	if v.OpenerID != nil {
+		w.Key("openerId")
+		w.String(string(*v.OpenerID))
+	}
+	w.Key("canAccessOpener")
+	w.Bool(v.CanAccessOpener)
+	if v.OpenerFrameID != nil {
+		w.Key("openerFrameId")
+		w.String(string(*v.OpenerFrameID))
+	}
+	if v.ParentFrameID != nil {
+		w.Key("parentFrameId")
+		w.String(string(*v.ParentFrameID))
+	}
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	if v.Subtype != nil {
+		w.Key("subtype")
+		w.String(*v.Subtype)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Info) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		case "type":
+			v.Type = r.String()
+		case "title":
+			v.Title = r.String()
+		case "url":
+			v.URL = r.String()
+		case "attached":
+			v.Attached = r.Bool()
+		case "openerId":
+			if r.Null() {
+				v.OpenerID = nil
+			} else {
+				p := ID(r.String())
+				v.OpenerID = &p
+			}
+		case "canAccessOpener":
+			v.CanAccessOpener = r.Bool()
+		case "openerFrameId":
+			if r.Null() {
+				v.OpenerFrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.OpenerFrameID = &p
+			}
+		case "parentFrameId":
+			if r.Null() {
+				v.ParentFrameID = nil
+			} else {
+				p := page.FrameID(r.String())
+				v.ParentFrameID = &p
+			}
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := internal.BrowserContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		case "subtype":
+			if r.Null() {
+				v.Subtype = nil
+			} else {
+				p := r.String()
+				v.Subtype = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FilterEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Exclude != nil {
+		w.Key("exclude")
+		w.Bool(*v.Exclude)
+	}
+	if v.Type != nil {
+		w.Key("type")
+		w.String(*v.Type)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FilterEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "exclude":
+			if r.Null() {
+				v.Exclude = nil
+			} else {
+				p := r.Bool()
+				v.Exclude = &p
+			}
+		case "type":
+			if r.Null() {
+				v.Type = nil
+			} else {
+				p := r.String()
+				v.Type = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoteLocation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("host")
+	w.String(v.Host)
+	w.Key("port")
+	w.Int(v.Port)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoteLocation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "host":
+			v.Host = r.String()
+		case "port":
+			v.Port = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ActivateTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ActivateTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttachToTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	if v.Flatten != nil {
+		w.Key("flatten")
+		w.Bool(*v.Flatten)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttachToTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		case "flatten":
+			if r.Null() {
+				v.Flatten = nil
+			} else {
+				p := r.Bool()
+				v.Flatten = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttachToTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttachToTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttachToBrowserTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttachToBrowserTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CloseTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CloseTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CloseTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("success")
+	w.Bool(v.Success)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CloseTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "success":
+			v.Success = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExposeDevToolsProtocolArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	if v.BindingName != nil {
+		w.Key("bindingName")
+		w.String(*v.BindingName)
+	}
+	if v.InheritPermissions != nil {
+		w.Key("inheritPermissions")
+		w.Bool(*v.InheritPermissions)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExposeDevToolsProtocolArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		case "bindingName":
+			if r.Null() {
+				v.BindingName = nil
+			} else {
+				p := r.String()
+				v.BindingName = &p
+			}
+		case "inheritPermissions":
+			if r.Null() {
+				v.InheritPermissions = nil
+			} else {
+				p := r.Bool()
+				v.InheritPermissions = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateBrowserContextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.DisposeOnDetach != nil {
+		w.Key("disposeOnDetach")
+		w.Bool(*v.DisposeOnDetach)
+	}
+	if v.ProxyServer != nil {
+		w.Key("proxyServer")
+		w.String(*v.ProxyServer)
+	}
+	if v.ProxyBypassList != nil {
+		w.Key("proxyBypassList")
+		w.String(*v.ProxyBypassList)
+	}
+	if len(v.OriginsWithUniversalNetworkAccess) > 0 {
+		w.Key("originsWithUniversalNetworkAccess")
+		w.ArrayStart()
+		for i0 := range v.OriginsWithUniversalNetworkAccess {
+			w.String(v.OriginsWithUniversalNetworkAccess[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateBrowserContextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "disposeOnDetach":
+			if r.Null() {
+				v.DisposeOnDetach = nil
+			} else {
+				p := r.Bool()
+				v.DisposeOnDetach = &p
+			}
+		case "proxyServer":
+			if r.Null() {
+				v.ProxyServer = nil
+			} else {
+				p := r.String()
+				v.ProxyServer = &p
+			}
+		case "proxyBypassList":
+			if r.Null() {
+				v.ProxyBypassList = nil
+			} else {
+				p := r.String()
+				v.ProxyBypassList = &p
+			}
+		case "originsWithUniversalNetworkAccess":
+			if r.ArrayStart() {
+				v.OriginsWithUniversalNetworkAccess = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.OriginsWithUniversalNetworkAccess = append(v.OriginsWithUniversalNetworkAccess, e0)
+				}
+			} else {
+				v.OriginsWithUniversalNetworkAccess = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateBrowserContextReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("browserContextId")
+	w.String(string(v.BrowserContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateBrowserContextReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "browserContextId":
+			v.BrowserContextID = internal.BrowserContextID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBrowserContextsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("browserContextIds")
+	if v.BrowserContextIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.BrowserContextIDs {
+			w.String(string(v.BrowserContextIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBrowserContextsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "browserContextIds":
+			if r.ArrayStart() {
+				v.BrowserContextIDs = make([]internal.BrowserContextID, 0, 4)
+				for r.ArrayNext() {
+					e0 := internal.BrowserContextID(r.String())
+					v.BrowserContextIDs = append(v.BrowserContextIDs, e0)
+				}
+			} else {
+				v.BrowserContextIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if v.Left != nil {
+		w.Key("left")
+		w.Int(*v.Left)
+	}
+	if v.Top != nil {
+		w.Key("top")
+		w.Int(*v.Top)
+	}
+	if v.Width != nil {
+		w.Key("width")
+		w.Int(*v.Width)
+	}
+	if v.Height != nil {
+		w.Key("height")
+		w.Int(*v.Height)
+	}
+	if v.WindowState != "" {
+		w.Key("windowState")
+		w.String(string(v.WindowState))
+	}
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	if v.EnableBeginFrameControl != nil {
+		w.Key("enableBeginFrameControl")
+		w.Bool(*v.EnableBeginFrameControl)
+	}
+	if v.NewWindow != nil {
+		w.Key("newWindow")
+		w.Bool(*v.NewWindow)
+	}
+	if v.Background != nil {
+		w.Key("background")
+		w.Bool(*v.Background)
+	}
+	if v.ForTab != nil {
+		w.Key("forTab")
+		w.Bool(*v.ForTab)
+	}
+	if v.Hidden != nil {
+		w.Key("hidden")
+		w.Bool(*v.Hidden)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "left":
+			if r.Null() {
+				v.Left = nil
+			} else {
+				p := r.Int()
+				v.Left = &p
+			}
+		case "top":
+			if r.Null() {
+				v.Top = nil
+			} else {
+				p := r.Int()
+				v.Top = &p
+			}
+		case "width":
+			if r.Null() {
+				v.Width = nil
+			} else {
+				p := r.Int()
+				v.Width = &p
+			}
+		case "height":
+			if r.Null() {
+				v.Height = nil
+			} else {
+				p := r.Int()
+				v.Height = &p
+			}
+		case "windowState":
+			v.WindowState = WindowState(r.String())
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := internal.BrowserContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		case "enableBeginFrameControl":
+			if r.Null() {
+				v.EnableBeginFrameControl = nil
+			} else {
+				p := r.Bool()
+				v.EnableBeginFrameControl = &p
+			}
+		case "newWindow":
+			if r.Null() {
+				v.NewWindow = nil
+			} else {
+				p := r.Bool()
+				v.NewWindow = &p
+			}
+		case "background":
+			if r.Null() {
+				v.Background = nil
+			} else {
+				p := r.Bool()
+				v.Background = &p
+			}
+		case "forTab":
+			if r.Null() {
+				v.ForTab = nil
+			} else {
+				p := r.Bool()
+				v.ForTab = &p
+			}
+		case "hidden":
+			if r.Null() {
+				v.Hidden = nil
+			} else {
+				p := r.Bool()
+				v.Hidden = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DetachFromTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SessionID != nil {
+		w.Key("sessionId")
+		w.String(string(*v.SessionID))
+	}
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DetachFromTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			if r.Null() {
+				v.SessionID = nil
+			} else {
+				p := SessionID(r.String())
+				v.SessionID = &p
+			}
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DisposeBrowserContextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("browserContextId")
+	w.String(string(v.BrowserContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DisposeBrowserContextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "browserContextId":
+			v.BrowserContextID = internal.BrowserContextID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetTargetInfoArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetTargetInfoArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetTargetInfoReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetInfo")
+	v.TargetInfo.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetTargetInfoReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetInfo":
+			v.TargetInfo.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetTargetsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.Filter) > 0 {
+		w.Key("filter")
+		w.ArrayStart()
+		for i0 := range v.Filter {
+			v.Filter[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetTargetsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "filter":
+			if r.ArrayStart() {
+				v.Filter = make(Filter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FilterEntry
+					e0.DecodeJSON(r)
+					v.Filter = append(v.Filter, e0)
+				}
+			} else {
+				v.Filter = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetTargetsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetInfos")
+	if v.TargetInfos == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TargetInfos {
+			v.TargetInfos[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetTargetsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetInfos":
+			if r.ArrayStart() {
+				v.TargetInfos = make([]Info, 0, 4)
+				for r.ArrayNext() {
+					var e0 Info
+					e0.DecodeJSON(r)
+					v.TargetInfos = append(v.TargetInfos, e0)
+				}
+			} else {
+				v.TargetInfos = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SendMessageToTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("message")
+	w.String(v.Message)
+	if v.SessionID != nil {
+		w.Key("sessionId")
+		w.String(string(*v.SessionID))
+	}
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SendMessageToTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "message":
+			v.Message = r.String()
+		case "sessionId":
+			if r.Null() {
+				v.SessionID = nil
+			} else {
+				p := SessionID(r.String())
+				v.SessionID = &p
+			}
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAutoAttachArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("autoAttach")
+	w.Bool(v.AutoAttach)
+	w.Key("waitForDebuggerOnStart")
+	w.Bool(v.WaitForDebuggerOnStart)
+	if v.Flatten != nil {
+		w.Key("flatten")
+		w.Bool(*v.Flatten)
+	}
+	if len(v.Filter) > 0 {
+		w.Key("filter")
+		w.ArrayStart()
+		for i0 := range v.Filter {
+			v.Filter[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAutoAttachArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "autoAttach":
+			v.AutoAttach = r.Bool()
+		case "waitForDebuggerOnStart":
+			v.WaitForDebuggerOnStart = r.Bool()
+		case "flatten":
+			if r.Null() {
+				v.Flatten = nil
+			} else {
+				p := r.Bool()
+				v.Flatten = &p
+			}
+		case "filter":
+			if r.ArrayStart() {
+				v.Filter = make(Filter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FilterEntry
+					e0.DecodeJSON(r)
+					v.Filter = append(v.Filter, e0)
+				}
+			} else {
+				v.Filter = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AutoAttachRelatedArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.Key("waitForDebuggerOnStart")
+	w.Bool(v.WaitForDebuggerOnStart)
+	if len(v.Filter) > 0 {
+		w.Key("filter")
+		w.ArrayStart()
+		for i0 := range v.Filter {
+			v.Filter[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AutoAttachRelatedArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		case "waitForDebuggerOnStart":
+			v.WaitForDebuggerOnStart = r.Bool()
+		case "filter":
+			if r.ArrayStart() {
+				v.Filter = make(Filter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FilterEntry
+					e0.DecodeJSON(r)
+					v.Filter = append(v.Filter, e0)
+				}
+			} else {
+				v.Filter = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDiscoverTargetsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("discover")
+	w.Bool(v.Discover)
+	if len(v.Filter) > 0 {
+		w.Key("filter")
+		w.ArrayStart()
+		for i0 := range v.Filter {
+			v.Filter[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDiscoverTargetsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "discover":
+			v.Discover = r.Bool()
+		case "filter":
+			if r.ArrayStart() {
+				v.Filter = make(Filter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FilterEntry
+					e0.DecodeJSON(r)
+					v.Filter = append(v.Filter, e0)
+				}
+			} else {
+				v.Filter = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetRemoteLocationsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("locations")
+	if v.Locations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Locations {
+			v.Locations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetRemoteLocationsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "locations":
+			if r.ArrayStart() {
+				v.Locations = make([]RemoteLocation, 0, 4)
+				for r.ArrayNext() {
+					var e0 RemoteLocation
+					e0.DecodeJSON(r)
+					v.Locations = append(v.Locations, e0)
+				}
+			} else {
+				v.Locations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDevToolsTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDevToolsTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDevToolsTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDevToolsTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OpenDevToolsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	if v.PanelID != nil {
+		w.Key("panelId")
+		w.String(*v.PanelID)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OpenDevToolsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		case "panelId":
+			if r.Null() {
+				v.PanelID = nil
+			} else {
+				p := r.String()
+				v.PanelID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OpenDevToolsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OpenDevToolsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttachedToTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.Key("targetInfo")
+	v.TargetInfo.EncodeJSON(w)
+	w.Key("waitingForDebugger")
+	w.Bool(v.WaitingForDebugger)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttachedToTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		case "targetInfo":
+			v.TargetInfo.DecodeJSON(r)
+		case "waitingForDebugger":
+			v.WaitingForDebugger = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DetachedFromTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DetachedFromTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReceivedMessageFromTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.String(string(v.SessionID))
+	w.Key("message")
+	w.String(v.Message)
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReceivedMessageFromTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = SessionID(r.String())
+		case "message":
+			v.Message = r.String()
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetInfo")
+	v.TargetInfo.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetInfo":
+			v.TargetInfo.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DestroyedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DestroyedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CrashedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetId")
+	w.String(string(v.TargetID))
+	w.Key("status")
+	w.String(v.Status)
+	w.Key("errorCode")
+	w.Int(v.ErrorCode)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CrashedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			v.TargetID = ID(r.String())
+		case "status":
+			v.Status = r.String()
+		case "errorCode":
+			v.ErrorCode = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InfoChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("targetInfo")
+	v.TargetInfo.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InfoChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetInfo":
+			v.TargetInfo.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,395 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package target
+
+import (
+	"github.com/mafredri/cdp/protocol/internal"
+)
+
+// AttachToTargetOption sets an optional argument of AttachToTargetArgs.
+type AttachToTargetOption func(*AttachToTargetArgs)
+
+// NewAttachToTargetArgsWith initializes AttachToTargetArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAttachToTargetArgsWith(targetID ID, opts ...AttachToTargetOption) *AttachToTargetArgs {
+	args := NewAttachToTargetArgs(targetID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AttachToTargetWithFlatten sets the Flatten optional argument, see
+// AttachToTargetArgs.SetFlatten.
+func AttachToTargetWithFlatten(v bool) AttachToTargetOption {
+	return func(a *AttachToTargetArgs) { a.SetFlatten(v) }
+}
+
+// ExposeDevToolsProtocolOption sets an optional argument of ExposeDevToolsProtocolArgs.
+type ExposeDevToolsProtocolOption func(*ExposeDevToolsProtocolArgs)
+
+// NewExposeDevToolsProtocolArgsWith initializes ExposeDevToolsProtocolArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewExposeDevToolsProtocolArgsWith(targetID ID, opts ...ExposeDevToolsProtocolOption) *ExposeDevToolsProtocolArgs {
+	args := NewExposeDevToolsProtocolArgs(targetID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ExposeDevToolsProtocolWithBindingName sets the BindingName optional argument, see
+// ExposeDevToolsProtocolArgs.SetBindingName.
+func ExposeDevToolsProtocolWithBindingName(v string) ExposeDevToolsProtocolOption {
+	return func(a *ExposeDevToolsProtocolArgs) { a.SetBindingName(v) }
+}
+
+// ExposeDevToolsProtocolWithInheritPermissions sets the InheritPermissions optional argument, see
+// ExposeDevToolsProtocolArgs.SetInheritPermissions.
+func ExposeDevToolsProtocolWithInheritPermissions(v bool) ExposeDevToolsProtocolOption {
+	return func(a *ExposeDevToolsProtocolArgs) { a.SetInheritPermissions(v) }
+}
+
+// CreateBrowserContextOption sets an optional argument of CreateBrowserContextArgs.
+type CreateBrowserContextOption func(*CreateBrowserContextArgs)
+
+// NewCreateBrowserContextArgsWith initializes CreateBrowserContextArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCreateBrowserContextArgsWith(opts ...CreateBrowserContextOption) *CreateBrowserContextArgs {
+	args := NewCreateBrowserContextArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CreateBrowserContextWithDisposeOnDetach sets the DisposeOnDetach optional argument, see
+// CreateBrowserContextArgs.SetDisposeOnDetach.
+//
+// Note: This argument is experimental.
+func CreateBrowserContextWithDisposeOnDetach(v bool) CreateBrowserContextOption {
+	return func(a *CreateBrowserContextArgs) { a.SetDisposeOnDetach(v) }
+}
+
+// CreateBrowserContextWithProxyServer sets the ProxyServer optional argument, see
+// CreateBrowserContextArgs.SetProxyServer.
+//
+// Note: This argument is experimental.
+func CreateBrowserContextWithProxyServer(v string) CreateBrowserContextOption {
+	return func(a *CreateBrowserContextArgs) { a.SetProxyServer(v) }
+}
+
+// CreateBrowserContextWithProxyBypassList sets the ProxyBypassList optional argument, see
+// CreateBrowserContextArgs.SetProxyBypassList.
+//
+// Note: This argument is experimental.
+func CreateBrowserContextWithProxyBypassList(v string) CreateBrowserContextOption {
+	return func(a *CreateBrowserContextArgs) { a.SetProxyBypassList(v) }
+}
+
+// CreateBrowserContextWithOriginsWithUniversalNetworkAccess sets the OriginsWithUniversalNetworkAccess optional argument, see
+// CreateBrowserContextArgs.SetOriginsWithUniversalNetworkAccess.
+//
+// Note: This argument is experimental.
+func CreateBrowserContextWithOriginsWithUniversalNetworkAccess(v []string) CreateBrowserContextOption {
+	return func(a *CreateBrowserContextArgs) { a.SetOriginsWithUniversalNetworkAccess(v) }
+}
+
+// CreateTargetOption sets an optional argument of CreateTargetArgs.
+type CreateTargetOption func(*CreateTargetArgs)
+
+// NewCreateTargetArgsWith initializes CreateTargetArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCreateTargetArgsWith(url string, opts ...CreateTargetOption) *CreateTargetArgs {
+	args := NewCreateTargetArgs(url)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CreateTargetWithLeft sets the Left optional argument, see
+// CreateTargetArgs.SetLeft.
+//
+// Note: This argument is experimental.
+func CreateTargetWithLeft(v int) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetLeft(v) }
+}
+
+// CreateTargetWithTop sets the Top optional argument, see
+// CreateTargetArgs.SetTop.
+//
+// Note: This argument is experimental.
+func CreateTargetWithTop(v int) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetTop(v) }
+}
+
+// CreateTargetWithWidth sets the Width optional argument, see
+// CreateTargetArgs.SetWidth.
+func CreateTargetWithWidth(v int) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetWidth(v) }
+}
+
+// CreateTargetWithHeight sets the Height optional argument, see
+// CreateTargetArgs.SetHeight.
+func CreateTargetWithHeight(v int) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetHeight(v) }
+}
+
+// CreateTargetWithWindowState sets the WindowState optional argument, see
+// CreateTargetArgs.SetWindowState.
+func CreateTargetWithWindowState(v WindowState) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetWindowState(v) }
+}
+
+// CreateTargetWithBrowserContextID sets the BrowserContextID optional argument, see
+// CreateTargetArgs.SetBrowserContextID.
+//
+// Note: This argument is experimental.
+func CreateTargetWithBrowserContextID(v internal.BrowserContextID) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetBrowserContextID(v) }
+}
+
+// CreateTargetWithEnableBeginFrameControl sets the EnableBeginFrameControl optional argument, see
+// CreateTargetArgs.SetEnableBeginFrameControl.
+//
+// Note: This argument is experimental.
+func CreateTargetWithEnableBeginFrameControl(v bool) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetEnableBeginFrameControl(v) }
+}
+
+// CreateTargetWithNewWindow sets the NewWindow optional argument, see
+// CreateTargetArgs.SetNewWindow.
+func CreateTargetWithNewWindow(v bool) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetNewWindow(v) }
+}
+
+// CreateTargetWithBackground sets the Background optional argument, see
+// CreateTargetArgs.SetBackground.
+func CreateTargetWithBackground(v bool) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetBackground(v) }
+}
+
+// CreateTargetWithForTab sets the ForTab optional argument, see
+// CreateTargetArgs.SetForTab.
+//
+// Note: This argument is experimental.
+func CreateTargetWithForTab(v bool) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetForTab(v) }
+}
+
+// CreateTargetWithHidden sets the Hidden optional argument, see
+// CreateTargetArgs.SetHidden.
+//
+// Note: This argument is experimental.
+func CreateTargetWithHidden(v bool) CreateTargetOption {
+	return func(a *CreateTargetArgs) { a.SetHidden(v) }
+}
+
+// DetachFromTargetOption sets an optional argument of DetachFromTargetArgs.
+type DetachFromTargetOption func(*DetachFromTargetArgs)
+
+// NewDetachFromTargetArgsWith initializes DetachFromTargetArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDetachFromTargetArgsWith(opts ...DetachFromTargetOption) *DetachFromTargetArgs {
+	args := NewDetachFromTargetArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DetachFromTargetWithSessionID sets the SessionID optional argument, see
+// DetachFromTargetArgs.SetSessionID.
+func DetachFromTargetWithSessionID(v SessionID) DetachFromTargetOption {
+	return func(a *DetachFromTargetArgs) { a.SetSessionID(v) }
+}
+
+// DetachFromTargetWithTargetID sets the TargetID optional argument, see
+// DetachFromTargetArgs.SetTargetID.
+//
+// Deprecated: This argument should not be used.
+func DetachFromTargetWithTargetID(v ID) DetachFromTargetOption {
+	return func(a *DetachFromTargetArgs) { a.SetTargetID(v) }
+}
+
+// GetTargetInfoOption sets an optional argument of GetTargetInfoArgs.
+type GetTargetInfoOption func(*GetTargetInfoArgs)
+
+// NewGetTargetInfoArgsWith initializes GetTargetInfoArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetTargetInfoArgsWith(opts ...GetTargetInfoOption) *GetTargetInfoArgs {
+	args := NewGetTargetInfoArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetTargetInfoWithTargetID sets the TargetID optional argument, see
+// GetTargetInfoArgs.SetTargetID.
+func GetTargetInfoWithTargetID(v ID) GetTargetInfoOption {
+	return func(a *GetTargetInfoArgs) { a.SetTargetID(v) }
+}
+
+// GetTargetsOption sets an optional argument of GetTargetsArgs.
+type GetTargetsOption func(*GetTargetsArgs)
+
+// NewGetTargetsArgsWith initializes GetTargetsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetTargetsArgsWith(opts ...GetTargetsOption) *GetTargetsArgs {
+	args := NewGetTargetsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetTargetsWithFilter sets the Filter optional argument, see
+// GetTargetsArgs.SetFilter.
+//
+// Note: This argument is experimental.
+func GetTargetsWithFilter(v Filter) GetTargetsOption {
+	return func(a *GetTargetsArgs) { a.SetFilter(v) }
+}
+
+// SendMessageToTargetOption sets an optional argument of SendMessageToTargetArgs.
+type SendMessageToTargetOption func(*SendMessageToTargetArgs)
+
+// NewSendMessageToTargetArgsWith initializes SendMessageToTargetArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSendMessageToTargetArgsWith(message string, opts ...SendMessageToTargetOption) *SendMessageToTargetArgs {
+	args := NewSendMessageToTargetArgs(message)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SendMessageToTargetWithSessionID sets the SessionID optional argument, see
+// SendMessageToTargetArgs.SetSessionID.
+func SendMessageToTargetWithSessionID(v SessionID) SendMessageToTargetOption {
+	return func(a *SendMessageToTargetArgs) { a.SetSessionID(v) }
+}
+
+// SendMessageToTargetWithTargetID sets the TargetID optional argument, see
+// SendMessageToTargetArgs.SetTargetID.
+//
+// Deprecated: This argument should not be used.
+func SendMessageToTargetWithTargetID(v ID) SendMessageToTargetOption {
+	return func(a *SendMessageToTargetArgs) { a.SetTargetID(v) }
+}
+
+// SetAutoAttachOption sets an optional argument of SetAutoAttachArgs.
+type SetAutoAttachOption func(*SetAutoAttachArgs)
+
+// NewSetAutoAttachArgsWith initializes SetAutoAttachArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetAutoAttachArgsWith(autoAttach bool, waitForDebuggerOnStart bool, opts ...SetAutoAttachOption) *SetAutoAttachArgs {
+	args := NewSetAutoAttachArgs(autoAttach, waitForDebuggerOnStart)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetAutoAttachWithFlatten sets the Flatten optional argument, see
+// SetAutoAttachArgs.SetFlatten.
+//
+// Note: This argument is experimental.
+func SetAutoAttachWithFlatten(v bool) SetAutoAttachOption {
+	return func(a *SetAutoAttachArgs) { a.SetFlatten(v) }
+}
+
+// SetAutoAttachWithFilter sets the Filter optional argument, see
+// SetAutoAttachArgs.SetFilter.
+//
+// Note: This argument is experimental.
+func SetAutoAttachWithFilter(v Filter) SetAutoAttachOption {
+	return func(a *SetAutoAttachArgs) { a.SetFilter(v) }
+}
+
+// AutoAttachRelatedOption sets an optional argument of AutoAttachRelatedArgs.
+type AutoAttachRelatedOption func(*AutoAttachRelatedArgs)
+
+// NewAutoAttachRelatedArgsWith initializes AutoAttachRelatedArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAutoAttachRelatedArgsWith(targetID ID, waitForDebuggerOnStart bool, opts ...AutoAttachRelatedOption) *AutoAttachRelatedArgs {
+	args := NewAutoAttachRelatedArgs(targetID, waitForDebuggerOnStart)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AutoAttachRelatedWithFilter sets the Filter optional argument, see
+// AutoAttachRelatedArgs.SetFilter.
+//
+// Note: This argument is experimental.
+func AutoAttachRelatedWithFilter(v Filter) AutoAttachRelatedOption {
+	return func(a *AutoAttachRelatedArgs) { a.SetFilter(v) }
+}
+
+// SetDiscoverTargetsOption sets an optional argument of SetDiscoverTargetsArgs.
+type SetDiscoverTargetsOption func(*SetDiscoverTargetsArgs)
+
+// NewSetDiscoverTargetsArgsWith initializes SetDiscoverTargetsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDiscoverTargetsArgsWith(discover bool, opts ...SetDiscoverTargetsOption) *SetDiscoverTargetsArgs {
+	args := NewSetDiscoverTargetsArgs(discover)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDiscoverTargetsWithFilter sets the Filter optional argument, see
+// SetDiscoverTargetsArgs.SetFilter.
+//
+// Note: This argument is experimental.
+func SetDiscoverTargetsWithFilter(v Filter) SetDiscoverTargetsOption {
+	return func(a *SetDiscoverTargetsArgs) { a.SetFilter(v) }
+}
+
+// OpenDevToolsOption sets an optional argument of OpenDevToolsArgs.
+type OpenDevToolsOption func(*OpenDevToolsArgs)
+
+// NewOpenDevToolsArgsWith initializes OpenDevToolsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewOpenDevToolsArgsWith(targetID ID, opts ...OpenDevToolsOption) *OpenDevToolsArgs {
+	args := NewOpenDevToolsArgs(targetID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// OpenDevToolsWithPanelID sets the PanelID optional argument, see
+// OpenDevToolsArgs.SetPanelID.
+func OpenDevToolsWithPanelID(v string) OpenDevToolsOption {
+	return func(a *OpenDevToolsArgs) { a.SetPanelID(v) }
+}
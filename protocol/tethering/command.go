@@ -14,6 +14,12 @@ func NewBindArgs(port int) *BindArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *BindArgs) Validate() error {
+	return nil
+}
+
 // UnbindArgs represents the arguments for Unbind in the Tethering domain.
 type UnbindArgs struct {
 	Port int `json:"port"` // Port number to unbind.
@@ -25,3 +31,9 @@ func NewUnbindArgs(port int) *UnbindArgs {
 	args.Port = port
 	return args
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UnbindArgs) Validate() error {
+	return nil
+}
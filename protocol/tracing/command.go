@@ -2,6 +2,11 @@
 
 package tracing
 
+import (
+	"errors"
+	"strconv"
+)
+
 // GetCategoriesReply represents the return values for GetCategories in the Tracing domain.
 type GetCategoriesReply struct {
 	Categories []string `json:"categories"` // A list of supported tracing categories.
@@ -19,6 +24,12 @@ func NewRecordClockSyncMarkerArgs(syncID string) *RecordClockSyncMarkerArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RecordClockSyncMarkerArgs) Validate() error {
+	return nil
+}
+
 // RequestMemoryDumpArgs represents the arguments for RequestMemoryDump in the Tracing domain.
 type RequestMemoryDumpArgs struct {
 	Deterministic *bool                   `json:"deterministic,omitempty"` // Enables more deterministic results by forcing garbage collection
@@ -46,6 +57,17 @@ func (a *RequestMemoryDumpArgs) SetLevelOfDetail(levelOfDetail MemoryDumpLevelOf
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestMemoryDumpArgs) Validate() error {
+	if a.LevelOfDetail != "" {
+		if !a.LevelOfDetail.Valid() {
+			return errors.New("tracing.RequestMemoryDumpArgs: LevelOfDetail: invalid value " + strconv.Quote(string(a.LevelOfDetail)))
+		}
+	}
+	return nil
+}
+
 // RequestMemoryDumpReply represents the return values for RequestMemoryDump in the Tracing domain.
 type RequestMemoryDumpReply struct {
 	DumpGUID string `json:"dumpGuid"` // GUID of the resulting global memory dump.
@@ -189,3 +211,31 @@ func (a *StartArgs) SetTracingBackend(tracingBackend Backend) *StartArgs {
 	a.TracingBackend = tracingBackend
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartArgs) Validate() error {
+	if a.TransferMode != nil {
+		switch *a.TransferMode {
+		case "ReportEvents", "ReturnAsStream":
+		default:
+			return errors.New("tracing.StartArgs: TransferMode: invalid value " + strconv.Quote(*a.TransferMode))
+		}
+	}
+	if a.StreamFormat != "" {
+		if !a.StreamFormat.Valid() {
+			return errors.New("tracing.StartArgs: StreamFormat: invalid value " + strconv.Quote(string(a.StreamFormat)))
+		}
+	}
+	if a.StreamCompression != "" {
+		if !a.StreamCompression.Valid() {
+			return errors.New("tracing.StartArgs: StreamCompression: invalid value " + strconv.Quote(string(a.StreamCompression)))
+		}
+	}
+	if a.TracingBackend != "" {
+		if !a.TracingBackend.Valid() {
+			return errors.New("tracing.StartArgs: TracingBackend: invalid value " + strconv.Quote(string(a.TracingBackend)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,505 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/protocol/io"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TraceConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.RecordMode != nil {
+		w.Key("recordMode")
+		w.String(*v.RecordMode)
+	}
+	if v.TraceBufferSizeInKb != nil {
+		w.Key("traceBufferSizeInKb")
+		w.Float(*v.TraceBufferSizeInKb)
+	}
+	if v.EnableSampling != nil {
+		w.Key("enableSampling")
+		w.Bool(*v.EnableSampling)
+	}
+	if v.EnableSystrace != nil {
+		w.Key("enableSystrace")
+		w.Bool(*v.EnableSystrace)
+	}
+	if v.EnableArgumentFilter != nil {
+		w.Key("enableArgumentFilter")
+		w.Bool(*v.EnableArgumentFilter)
+	}
+	if len(v.IncludedCategories) > 0 {
+		w.Key("includedCategories")
+		w.ArrayStart()
+		for i0 := range v.IncludedCategories {
+			w.String(v.IncludedCategories[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.ExcludedCategories) > 0 {
+		w.Key("excludedCategories")
+		w.ArrayStart()
+		for i0 := range v.ExcludedCategories {
+			w.String(v.ExcludedCategories[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.SyntheticDelays) > 0 {
+		w.Key("syntheticDelays")
+		w.ArrayStart()
+		for i0 := range v.SyntheticDelays {
+			w.String(v.SyntheticDelays[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.MemoryDumpConfig) > 0 {
+		w.Key("memoryDumpConfig")
+		w.Marshal(v.MemoryDumpConfig)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TraceConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "recordMode":
+			if r.Null() {
+				v.RecordMode = nil
+			} else {
+				p := r.String()
+				v.RecordMode = &p
+			}
+		case "traceBufferSizeInKb":
+			if r.Null() {
+				v.TraceBufferSizeInKb = nil
+			} else {
+				p := r.Float()
+				v.TraceBufferSizeInKb = &p
+			}
+		case "enableSampling":
+			if r.Null() {
+				v.EnableSampling = nil
+			} else {
+				p := r.Bool()
+				v.EnableSampling = &p
+			}
+		case "enableSystrace":
+			if r.Null() {
+				v.EnableSystrace = nil
+			} else {
+				p := r.Bool()
+				v.EnableSystrace = &p
+			}
+		case "enableArgumentFilter":
+			if r.Null() {
+				v.EnableArgumentFilter = nil
+			} else {
+				p := r.Bool()
+				v.EnableArgumentFilter = &p
+			}
+		case "includedCategories":
+			if r.ArrayStart() {
+				v.IncludedCategories = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.IncludedCategories = append(v.IncludedCategories, e0)
+				}
+			} else {
+				v.IncludedCategories = nil
+			}
+		case "excludedCategories":
+			if r.ArrayStart() {
+				v.ExcludedCategories = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ExcludedCategories = append(v.ExcludedCategories, e0)
+				}
+			} else {
+				v.ExcludedCategories = nil
+			}
+		case "syntheticDelays":
+			if r.ArrayStart() {
+				v.SyntheticDelays = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.SyntheticDelays = append(v.SyntheticDelays, e0)
+				}
+			} else {
+				v.SyntheticDelays = nil
+			}
+		case "memoryDumpConfig":
+			r.Decode(&v.MemoryDumpConfig)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCategoriesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("categories")
+	if v.Categories == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Categories {
+			w.String(v.Categories[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCategoriesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "categories":
+			if r.ArrayStart() {
+				v.Categories = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Categories = append(v.Categories, e0)
+				}
+			} else {
+				v.Categories = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RecordClockSyncMarkerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("syncId")
+	w.String(v.SyncID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RecordClockSyncMarkerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "syncId":
+			v.SyncID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestMemoryDumpArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Deterministic != nil {
+		w.Key("deterministic")
+		w.Bool(*v.Deterministic)
+	}
+	if v.LevelOfDetail != "" {
+		w.Key("levelOfDetail")
+		w.String(string(v.LevelOfDetail))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestMemoryDumpArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "deterministic":
+			if r.Null() {
+				v.Deterministic = nil
+			} else {
+				p := r.Bool()
+				v.Deterministic = &p
+			}
+		case "levelOfDetail":
+			v.LevelOfDetail = MemoryDumpLevelOfDetail(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestMemoryDumpReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dumpGuid")
+	w.String(v.DumpGUID)
+	w.Key("success")
+	w.Bool(v.Success)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestMemoryDumpReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dumpGuid":
+			v.DumpGUID = r.String()
+		case "success":
+			v.Success = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Categories != nil {
+		w.Key("categories")
+		w.String(*v.Categories)
+	}
+	if v.Options != nil {
+		w.Key("options")
+		w.String(*v.Options)
+	}
+	if v.BufferUsageReportingInterval != nil {
+		w.Key("bufferUsageReportingInterval")
+		w.Float(*v.BufferUsageReportingInterval)
+	}
+	if v.TransferMode != nil {
+		w.Key("transferMode")
+		w.String(*v.TransferMode)
+	}
+	if v.StreamFormat != "" {
+		w.Key("streamFormat")
+		w.String(string(v.StreamFormat))
+	}
+	if v.StreamCompression != "" {
+		w.Key("streamCompression")
+		w.String(string(v.StreamCompression))
+	}
+	if v.TraceConfig != nil {
+		w.Key("traceConfig")
+		v.TraceConfig.EncodeJSON(w)
+	}
+	if len(v.PerfettoConfig) > 0 {
+		w.Key("perfettoConfig")
+		w.Base64(v.PerfettoConfig)
+	}
+	if v.TracingBackend != "" {
+		w.Key("tracingBackend")
+		w.String(string(v.TracingBackend))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "categories":
+			if r.Null() {
+				v.Categories = nil
+			} else {
+				p := r.String()
+				v.Categories = &p
+			}
+		case "options":
+			if r.Null() {
+				v.Options = nil
+			} else {
+				p := r.String()
+				v.Options = &p
+			}
+		case "bufferUsageReportingInterval":
+			if r.Null() {
+				v.BufferUsageReportingInterval = nil
+			} else {
+				p := r.Float()
+				v.BufferUsageReportingInterval = &p
+			}
+		case "transferMode":
+			if r.Null() {
+				v.TransferMode = nil
+			} else {
+				p := r.String()
+				v.TransferMode = &p
+			}
+		case "streamFormat":
+			v.StreamFormat = StreamFormat(r.String())
+		case "streamCompression":
+			v.StreamCompression = StreamCompression(r.String())
+		case "traceConfig":
+			if r.Null() {
+				v.TraceConfig = nil
+			} else {
+				var p TraceConfig
+				p.DecodeJSON(r)
+				v.TraceConfig = &p
+			}
+		case "perfettoConfig":
+			v.PerfettoConfig = r.Base64()
+		case "tracingBackend":
+			v.TracingBackend = Backend(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BufferUsageReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.PercentFull != nil {
+		w.Key("percentFull")
+		w.Float(*v.PercentFull)
+	}
+	if v.EventCount != nil {
+		w.Key("eventCount")
+		w.Float(*v.EventCount)
+	}
+	if v.Value != nil {
+		w.Key("value")
+		w.Float(*v.Value)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BufferUsageReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "percentFull":
+			if r.Null() {
+				v.PercentFull = nil
+			} else {
+				p := r.Float()
+				v.PercentFull = &p
+			}
+		case "eventCount":
+			if r.Null() {
+				v.EventCount = nil
+			} else {
+				p := r.Float()
+				v.EventCount = &p
+			}
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				p := r.Float()
+				v.Value = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DataCollectedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("value")
+	if v.Value == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Value {
+			w.Raw(v.Value[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DataCollectedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "value":
+			if r.ArrayStart() {
+				v.Value = make([]json.RawMessage, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Raw()
+					v.Value = append(v.Value, e0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompleteReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dataLossOccurred")
+	w.Bool(v.DataLossOccurred)
+	if v.Stream != nil {
+		w.Key("stream")
+		w.String(string(*v.Stream))
+	}
+	if v.TraceFormat != "" {
+		w.Key("traceFormat")
+		w.String(string(v.TraceFormat))
+	}
+	if v.StreamCompression != "" {
+		w.Key("streamCompression")
+		w.String(string(v.StreamCompression))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompleteReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dataLossOccurred":
+			v.DataLossOccurred = r.Bool()
+		case "stream":
+			if r.Null() {
+				v.Stream = nil
+			} else {
+				p := io.StreamHandle(r.String())
+				v.Stream = &p
+			}
+		case "traceFormat":
+			v.TraceFormat = StreamFormat(r.String())
+		case "streamCompression":
+			v.StreamCompression = StreamCompression(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package tracing
+
+// RequestMemoryDumpOption sets an optional argument of RequestMemoryDumpArgs.
+type RequestMemoryDumpOption func(*RequestMemoryDumpArgs)
+
+// NewRequestMemoryDumpArgsWith initializes RequestMemoryDumpArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestMemoryDumpArgsWith(opts ...RequestMemoryDumpOption) *RequestMemoryDumpArgs {
+	args := NewRequestMemoryDumpArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestMemoryDumpWithDeterministic sets the Deterministic optional argument, see
+// RequestMemoryDumpArgs.SetDeterministic.
+func RequestMemoryDumpWithDeterministic(v bool) RequestMemoryDumpOption {
+	return func(a *RequestMemoryDumpArgs) { a.SetDeterministic(v) }
+}
+
+// RequestMemoryDumpWithLevelOfDetail sets the LevelOfDetail optional argument, see
+// RequestMemoryDumpArgs.SetLevelOfDetail.
+func RequestMemoryDumpWithLevelOfDetail(v MemoryDumpLevelOfDetail) RequestMemoryDumpOption {
+	return func(a *RequestMemoryDumpArgs) { a.SetLevelOfDetail(v) }
+}
+
+// StartOption sets an optional argument of StartArgs.
+type StartOption func(*StartArgs)
+
+// NewStartArgsWith initializes StartArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStartArgsWith(opts ...StartOption) *StartArgs {
+	args := NewStartArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StartWithCategories sets the Categories optional argument, see
+// StartArgs.SetCategories.
+//
+// Deprecated: This argument should not be used.
+//
+// Note: This argument is experimental.
+func StartWithCategories(v string) StartOption {
+	return func(a *StartArgs) { a.SetCategories(v) }
+}
+
+// StartWithOptions sets the Options optional argument, see
+// StartArgs.SetOptions.
+//
+// Deprecated: This argument should not be used.
+//
+// Note: This argument is experimental.
+func StartWithOptions(v string) StartOption {
+	return func(a *StartArgs) { a.SetOptions(v) }
+}
+
+// StartWithBufferUsageReportingInterval sets the BufferUsageReportingInterval optional argument, see
+// StartArgs.SetBufferUsageReportingInterval.
+//
+// Note: This argument is experimental.
+func StartWithBufferUsageReportingInterval(v float64) StartOption {
+	return func(a *StartArgs) { a.SetBufferUsageReportingInterval(v) }
+}
+
+// StartWithTransferMode sets the TransferMode optional argument, see
+// StartArgs.SetTransferMode.
+func StartWithTransferMode(v string) StartOption {
+	return func(a *StartArgs) { a.SetTransferMode(v) }
+}
+
+// StartWithStreamFormat sets the StreamFormat optional argument, see
+// StartArgs.SetStreamFormat.
+func StartWithStreamFormat(v StreamFormat) StartOption {
+	return func(a *StartArgs) { a.SetStreamFormat(v) }
+}
+
+// StartWithStreamCompression sets the StreamCompression optional argument, see
+// StartArgs.SetStreamCompression.
+//
+// Note: This argument is experimental.
+func StartWithStreamCompression(v StreamCompression) StartOption {
+	return func(a *StartArgs) { a.SetStreamCompression(v) }
+}
+
+// StartWithTraceConfig sets the TraceConfig optional argument, see
+// StartArgs.SetTraceConfig.
+func StartWithTraceConfig(v TraceConfig) StartOption {
+	return func(a *StartArgs) { a.SetTraceConfig(v) }
+}
+
+// StartWithPerfettoConfig sets the PerfettoConfig optional argument, see
+// StartArgs.SetPerfettoConfig.
+//
+// Note: This argument is experimental.
+func StartWithPerfettoConfig(v []byte) StartOption {
+	return func(a *StartArgs) { a.SetPerfettoConfig(v) }
+}
+
+// StartWithTracingBackend sets the TracingBackend optional argument, see
+// StartArgs.SetTracingBackend.
+//
+// Note: This argument is experimental.
+func StartWithTracingBackend(v Backend) StartOption {
+	return func(a *StartArgs) { a.SetTracingBackend(v) }
+}
@@ -0,0 +1,54 @@
+package protocol_test
+
+import (
+	"reflect"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+var encoderType = reflect.TypeOf((*jsonx.Encoder)(nil)).Elem()
+
+// protocolTypes returns all argument, reply and event reply types used
+// by cdp.Client and cdp.NodeClient, and the types they contain.
+func protocolTypes() []reflect.Type {
+	seen := make(map[reflect.Type]bool)
+	var types []reflect.Type
+	var add func(t reflect.Type)
+	add = func(t reflect.Type) {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || seen[t] || !reflect.PointerTo(t).Implements(encoderType) {
+			return
+		}
+		seen[t] = true
+		types = append(types, t)
+		for i := 0; i < t.NumField(); i++ {
+			add(t.Field(i).Type)
+		}
+	}
+
+	for _, c := range []reflect.Type{reflect.TypeOf(cdp.Client{}), reflect.TypeOf(cdp.NodeClient{})} {
+		for i := 0; i < c.NumField(); i++ {
+			d := c.Field(i).Type
+			if d.Kind() != reflect.Interface {
+				continue
+			}
+			for j := 0; j < d.NumMethod(); j++ {
+				m := d.Method(j).Type
+				for k := 0; k < m.NumIn(); k++ {
+					add(m.In(k))
+				}
+				for k := 0; k < m.NumOut(); k++ {
+					out := m.Out(k)
+					if recv, ok := out.MethodByName("Recv"); ok && out.Kind() == reflect.Interface {
+						out = recv.Type.Out(0) // Event client.
+					}
+					add(out)
+				}
+			}
+		}
+	}
+	return types
+}
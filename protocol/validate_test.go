@@ -0,0 +1,135 @@
+package protocol_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/animation"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/indexeddb"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+type validator interface {
+	Validate() error
+}
+
+func TestArgsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    validator
+		wantErr string
+	}{
+		{
+			name: "Valid",
+			args: page.NewNavigateArgs("about:blank").SetTransitionType(page.TransitionTypeLink),
+		},
+		{
+			name:    "InvalidEnum",
+			args:    page.NewNavigateArgs("about:blank").SetTransitionType("invalid"),
+			wantErr: `page.NavigateArgs: TransitionType: invalid value "invalid"`,
+		},
+		{
+			name: "ValidLocalEnum",
+			args: page.NewCaptureScreenshotArgs().SetFormat("png"),
+		},
+		{
+			name:    "InvalidLocalEnum",
+			args:    page.NewCaptureScreenshotArgs().SetFormat("gif"),
+			wantErr: `page.CaptureScreenshotArgs: Format: invalid value "gif"`,
+		},
+		{
+			name:    "InvalidEnumInArray",
+			args:    browser.NewGrantPermissionsArgs([]browser.PermissionType{"geolocation", "invalid"}),
+			wantErr: `browser.GrantPermissionsArgs: Permissions[1]: invalid value "invalid"`,
+		},
+		{
+			name:    "RequiredArray",
+			args:    animation.NewSetPausedArgs(nil, true),
+			wantErr: "animation.SetPausedArgs: Animations is required",
+		},
+		{
+			name: "EmptyRequiredArray",
+			args: animation.NewSetPausedArgs([]string{}, true),
+		},
+		{
+			name:    "MutuallyExclusive",
+			args:    runtime.NewEvaluateArgs("1").SetContextID(1).SetUniqueContextID("a"),
+			wantErr: "runtime.EvaluateArgs: ContextID and UniqueContextID are mutually exclusive",
+		},
+		{
+			name: "MutuallyExclusiveOne",
+			args: runtime.NewEvaluateArgs("1").SetUniqueContextID("a"),
+		},
+		{
+			name:    "ExactlyOneMissing",
+			args:    indexeddb.NewRequestDatabaseNamesArgs(),
+			wantErr: "indexeddb.RequestDatabaseNamesArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set",
+		},
+		{
+			name:    "ExactlyOneTooMany",
+			args:    indexeddb.NewRequestDatabaseNamesArgs().SetSecurityOrigin("a").SetStorageKey("b"),
+			wantErr: "indexeddb.RequestDatabaseNamesArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set",
+		},
+		{
+			name: "ExactlyOne",
+			args: indexeddb.NewRequestDatabaseNamesArgs().SetStorageKey("b"),
+		},
+		{
+			name:    "EitherMissing",
+			args:    debugger.NewSetBreakpointByURLArgs(1),
+			wantErr: "debugger.SetBreakpointByURLArgs: exactly one of URL, URLRegex or ScriptHash must be set",
+		},
+		{
+			name:    "EitherBoth",
+			args:    debugger.NewSetBreakpointByURLArgs(1).SetURL("a.js").SetURLRegex("a"),
+			wantErr: "debugger.SetBreakpointByURLArgs: exactly one of URL, URLRegex or ScriptHash must be set",
+		},
+		{
+			name: "Either",
+			args: debugger.NewSetBreakpointByURLArgs(1).SetURLRegex("a"),
+		},
+		{
+			name: "EitherNotDescribed",
+			args: debugger.NewSetBreakpointByURLArgs(1).SetScriptHash("h"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestArgsValidateAll(t *testing.T) {
+	validatorType := reflect.TypeOf((*validator)(nil)).Elem()
+
+	var n int
+	for _, typ := range protocolTypes() {
+		if !strings.HasSuffix(typ.Name(), "Args") {
+			continue
+		}
+		n++
+		if !reflect.PointerTo(typ).Implements(validatorType) {
+			t.Errorf("%v: missing Validate method", typ)
+			continue
+		}
+		// The zero value must not panic.
+		reflect.New(typ).Interface().(validator).Validate()
+	}
+	if n < 300 {
+		t.Errorf("found %d Args types, want at least 300", n)
+	}
+}
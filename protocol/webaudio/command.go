@@ -14,6 +14,12 @@ func NewGetRealtimeDataArgs(contextID GraphObjectID) *GetRealtimeDataArgs {
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetRealtimeDataArgs) Validate() error {
+	return nil
+}
+
 // GetRealtimeDataReply represents the return values for GetRealtimeData in the WebAudio domain.
 type GetRealtimeDataReply struct {
 	RealtimeData ContextRealtimeData `json:"realtimeData"` // No description.
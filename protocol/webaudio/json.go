@@ -0,0 +1,685 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package webaudio
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContextRealtimeData) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("currentTime")
+	w.Float(v.CurrentTime)
+	w.Key("renderCapacity")
+	w.Float(v.RenderCapacity)
+	w.Key("callbackIntervalMean")
+	w.Float(v.CallbackIntervalMean)
+	w.Key("callbackIntervalVariance")
+	w.Float(v.CallbackIntervalVariance)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContextRealtimeData) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "currentTime":
+			v.CurrentTime = r.Float()
+		case "renderCapacity":
+			v.RenderCapacity = r.Float()
+		case "callbackIntervalMean":
+			v.CallbackIntervalMean = r.Float()
+		case "callbackIntervalVariance":
+			v.CallbackIntervalVariance = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BaseAudioContext) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("contextType")
+	w.String(string(v.ContextType))
+	w.Key("contextState")
+	w.String(string(v.ContextState))
+	if v.RealtimeData != nil {
+		w.Key("realtimeData")
+		v.RealtimeData.EncodeJSON(w)
+	}
+	w.Key("callbackBufferSize")
+	w.Float(v.CallbackBufferSize)
+	w.Key("maxOutputChannelCount")
+	w.Float(v.MaxOutputChannelCount)
+	w.Key("sampleRate")
+	w.Float(v.SampleRate)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BaseAudioContext) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "contextType":
+			v.ContextType = ContextType(r.String())
+		case "contextState":
+			v.ContextState = ContextState(r.String())
+		case "realtimeData":
+			if r.Null() {
+				v.RealtimeData = nil
+			} else {
+				var p ContextRealtimeData
+				p.DecodeJSON(r)
+				v.RealtimeData = &p
+			}
+		case "callbackBufferSize":
+			v.CallbackBufferSize = r.Float()
+		case "maxOutputChannelCount":
+			v.MaxOutputChannelCount = r.Float()
+		case "sampleRate":
+			v.SampleRate = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioListener) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("listenerId")
+	w.String(string(v.ListenerID))
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioListener) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "listenerId":
+			v.ListenerID = GraphObjectID(r.String())
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.String(string(v.NodeID))
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("nodeType")
+	w.String(string(v.NodeType))
+	w.Key("numberOfInputs")
+	w.Float(v.NumberOfInputs)
+	w.Key("numberOfOutputs")
+	w.Float(v.NumberOfOutputs)
+	w.Key("channelCount")
+	w.Float(v.ChannelCount)
+	w.Key("channelCountMode")
+	w.String(string(v.ChannelCountMode))
+	w.Key("channelInterpretation")
+	w.String(string(v.ChannelInterpretation))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = GraphObjectID(r.String())
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "nodeType":
+			v.NodeType = NodeType(r.String())
+		case "numberOfInputs":
+			v.NumberOfInputs = r.Float()
+		case "numberOfOutputs":
+			v.NumberOfOutputs = r.Float()
+		case "channelCount":
+			v.ChannelCount = r.Float()
+		case "channelCountMode":
+			v.ChannelCountMode = ChannelCountMode(r.String())
+		case "channelInterpretation":
+			v.ChannelInterpretation = ChannelInterpretation(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioParam) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("paramId")
+	w.String(string(v.ParamID))
+	w.Key("nodeId")
+	w.String(string(v.NodeID))
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("paramType")
+	w.String(string(v.ParamType))
+	w.Key("rate")
+	w.String(string(v.Rate))
+	w.Key("defaultValue")
+	w.Float(v.DefaultValue)
+	w.Key("minValue")
+	w.Float(v.MinValue)
+	w.Key("maxValue")
+	w.Float(v.MaxValue)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioParam) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "paramId":
+			v.ParamID = GraphObjectID(r.String())
+		case "nodeId":
+			v.NodeID = GraphObjectID(r.String())
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "paramType":
+			v.ParamType = ParamType(r.String())
+		case "rate":
+			v.Rate = AutomationRate(r.String())
+		case "defaultValue":
+			v.DefaultValue = r.Float()
+		case "minValue":
+			v.MinValue = r.Float()
+		case "maxValue":
+			v.MaxValue = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRealtimeDataArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRealtimeDataArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRealtimeDataReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("realtimeData")
+	v.RealtimeData.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRealtimeDataReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "realtimeData":
+			v.RealtimeData.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContextCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("context")
+	v.Context.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContextCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "context":
+			v.Context.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContextWillBeDestroyedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContextWillBeDestroyedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContextChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("context")
+	v.Context.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContextChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "context":
+			v.Context.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioListenerCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("listener")
+	v.Listener.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioListenerCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "listener":
+			v.Listener.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioListenerWillBeDestroyedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("listenerId")
+	w.String(string(v.ListenerID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioListenerWillBeDestroyedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "listenerId":
+			v.ListenerID = GraphObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioNodeCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("node")
+	v.Node.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioNodeCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "node":
+			v.Node.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioNodeWillBeDestroyedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("nodeId")
+	w.String(string(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioNodeWillBeDestroyedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "nodeId":
+			v.NodeID = GraphObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioParamCreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("param")
+	v.Param.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioParamCreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "param":
+			v.Param.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AudioParamWillBeDestroyedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("nodeId")
+	w.String(string(v.NodeID))
+	w.Key("paramId")
+	w.String(string(v.ParamID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AudioParamWillBeDestroyedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "nodeId":
+			v.NodeID = GraphObjectID(r.String())
+		case "paramId":
+			v.ParamID = GraphObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodesConnectedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("sourceId")
+	w.String(string(v.SourceID))
+	w.Key("destinationId")
+	w.String(string(v.DestinationID))
+	if v.SourceOutputIndex != nil {
+		w.Key("sourceOutputIndex")
+		w.Float(*v.SourceOutputIndex)
+	}
+	if v.DestinationInputIndex != nil {
+		w.Key("destinationInputIndex")
+		w.Float(*v.DestinationInputIndex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodesConnectedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "sourceId":
+			v.SourceID = GraphObjectID(r.String())
+		case "destinationId":
+			v.DestinationID = GraphObjectID(r.String())
+		case "sourceOutputIndex":
+			if r.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				p := r.Float()
+				v.SourceOutputIndex = &p
+			}
+		case "destinationInputIndex":
+			if r.Null() {
+				v.DestinationInputIndex = nil
+			} else {
+				p := r.Float()
+				v.DestinationInputIndex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodesDisconnectedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("sourceId")
+	w.String(string(v.SourceID))
+	w.Key("destinationId")
+	w.String(string(v.DestinationID))
+	if v.SourceOutputIndex != nil {
+		w.Key("sourceOutputIndex")
+		w.Float(*v.SourceOutputIndex)
+	}
+	if v.DestinationInputIndex != nil {
+		w.Key("destinationInputIndex")
+		w.Float(*v.DestinationInputIndex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodesDisconnectedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "sourceId":
+			v.SourceID = GraphObjectID(r.String())
+		case "destinationId":
+			v.DestinationID = GraphObjectID(r.String())
+		case "sourceOutputIndex":
+			if r.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				p := r.Float()
+				v.SourceOutputIndex = &p
+			}
+		case "destinationInputIndex":
+			if r.Null() {
+				v.DestinationInputIndex = nil
+			} else {
+				p := r.Float()
+				v.DestinationInputIndex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodeParamConnectedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("sourceId")
+	w.String(string(v.SourceID))
+	w.Key("destinationId")
+	w.String(string(v.DestinationID))
+	if v.SourceOutputIndex != nil {
+		w.Key("sourceOutputIndex")
+		w.Float(*v.SourceOutputIndex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodeParamConnectedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "sourceId":
+			v.SourceID = GraphObjectID(r.String())
+		case "destinationId":
+			v.DestinationID = GraphObjectID(r.String())
+		case "sourceOutputIndex":
+			if r.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				p := r.Float()
+				v.SourceOutputIndex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodeParamDisconnectedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("contextId")
+	w.String(string(v.ContextID))
+	w.Key("sourceId")
+	w.String(string(v.SourceID))
+	w.Key("destinationId")
+	w.String(string(v.DestinationID))
+	if v.SourceOutputIndex != nil {
+		w.Key("sourceOutputIndex")
+		w.Float(*v.SourceOutputIndex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodeParamDisconnectedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "contextId":
+			v.ContextID = GraphObjectID(r.String())
+		case "sourceId":
+			v.SourceID = GraphObjectID(r.String())
+		case "destinationId":
+			v.DestinationID = GraphObjectID(r.String())
+		case "sourceOutputIndex":
+			if r.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				p := r.Float()
+				v.SourceOutputIndex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -24,6 +24,12 @@ func (a *EnableArgs) SetEnableUI(enableUI bool) *EnableArgs {
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
 // AddVirtualAuthenticatorArgs represents the arguments for AddVirtualAuthenticator in the WebAuthn domain.
 type AddVirtualAuthenticatorArgs struct {
 	Options VirtualAuthenticatorOptions `json:"options"` // No description.
@@ -36,6 +42,12 @@ func NewAddVirtualAuthenticatorArgs(options VirtualAuthenticatorOptions) *AddVir
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddVirtualAuthenticatorArgs) Validate() error {
+	return nil
+}
+
 // AddVirtualAuthenticatorReply represents the return values for AddVirtualAuthenticator in the WebAuthn domain.
 type AddVirtualAuthenticatorReply struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -80,6 +92,12 @@ func (a *SetResponseOverrideBitsArgs) SetIsBadUP(isBadUP bool) *SetResponseOverr
 	return a
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetResponseOverrideBitsArgs) Validate() error {
+	return nil
+}
+
 // RemoveVirtualAuthenticatorArgs represents the arguments for RemoveVirtualAuthenticator in the WebAuthn domain.
 type RemoveVirtualAuthenticatorArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -92,6 +110,12 @@ func NewRemoveVirtualAuthenticatorArgs(authenticatorID AuthenticatorID) *RemoveV
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveVirtualAuthenticatorArgs) Validate() error {
+	return nil
+}
+
 // AddCredentialArgs represents the arguments for AddCredential in the WebAuthn domain.
 type AddCredentialArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -106,6 +130,12 @@ func NewAddCredentialArgs(authenticatorID AuthenticatorID, credential Credential
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddCredentialArgs) Validate() error {
+	return nil
+}
+
 // GetCredentialArgs represents the arguments for GetCredential in the WebAuthn domain.
 type GetCredentialArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -120,6 +150,12 @@ func NewGetCredentialArgs(authenticatorID AuthenticatorID, credentialID string)
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetCredentialArgs) Validate() error {
+	return nil
+}
+
 // GetCredentialReply represents the return values for GetCredential in the WebAuthn domain.
 type GetCredentialReply struct {
 	Credential Credential `json:"credential"` // No description.
@@ -137,6 +173,12 @@ func NewGetCredentialsArgs(authenticatorID AuthenticatorID) *GetCredentialsArgs
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetCredentialsArgs) Validate() error {
+	return nil
+}
+
 // GetCredentialsReply represents the return values for GetCredentials in the WebAuthn domain.
 type GetCredentialsReply struct {
 	Credentials []Credential `json:"credentials"` // No description.
@@ -156,6 +198,12 @@ func NewRemoveCredentialArgs(authenticatorID AuthenticatorID, credentialID strin
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveCredentialArgs) Validate() error {
+	return nil
+}
+
 // ClearCredentialsArgs represents the arguments for ClearCredentials in the WebAuthn domain.
 type ClearCredentialsArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -168,6 +216,12 @@ func NewClearCredentialsArgs(authenticatorID AuthenticatorID) *ClearCredentialsA
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearCredentialsArgs) Validate() error {
+	return nil
+}
+
 // SetUserVerifiedArgs represents the arguments for SetUserVerified in the WebAuthn domain.
 type SetUserVerifiedArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -182,6 +236,12 @@ func NewSetUserVerifiedArgs(authenticatorID AuthenticatorID, isUserVerified bool
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetUserVerifiedArgs) Validate() error {
+	return nil
+}
+
 // SetAutomaticPresenceSimulationArgs represents the arguments for SetAutomaticPresenceSimulation in the WebAuthn domain.
 type SetAutomaticPresenceSimulationArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -196,6 +256,12 @@ func NewSetAutomaticPresenceSimulationArgs(authenticatorID AuthenticatorID, enab
 	return args
 }
 
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutomaticPresenceSimulationArgs) Validate() error {
+	return nil
+}
+
 // SetCredentialPropertiesArgs represents the arguments for SetCredentialProperties in the WebAuthn domain.
 type SetCredentialPropertiesArgs struct {
 	AuthenticatorID   AuthenticatorID `json:"authenticatorId"`             // No description.
@@ -223,3 +289,9 @@ func (a *SetCredentialPropertiesArgs) SetBackupState(backupState bool) *SetCrede
 	a.BackupState = &backupState
 	return a
 }
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCredentialPropertiesArgs) Validate() error {
+	return nil
+}
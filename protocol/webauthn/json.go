@@ -0,0 +1,808 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package webauthn
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VirtualAuthenticatorOptions) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("protocol")
+	w.String(string(v.Protocol))
+	if v.CTAP2Version != "" {
+		w.Key("ctap2Version")
+		w.String(string(v.CTAP2Version))
+	}
+	w.Key("transport")
+	w.String(string(v.Transport))
+	if v.HasResidentKey != nil {
+		w.Key("hasResidentKey")
+		w.Bool(*v.HasResidentKey)
+	}
+	if v.HasUserVerification != nil {
+		w.Key("hasUserVerification")
+		w.Bool(*v.HasUserVerification)
+	}
+	if v.HasLargeBlob != nil {
+		w.Key("hasLargeBlob")
+		w.Bool(*v.HasLargeBlob)
+	}
+	if v.HasCredBlob != nil {
+		w.Key("hasCredBlob")
+		w.Bool(*v.HasCredBlob)
+	}
+	if v.HasMinPinLength != nil {
+		w.Key("hasMinPinLength")
+		w.Bool(*v.HasMinPinLength)
+	}
+	if v.HasPrf != nil {
+		w.Key("hasPrf")
+		w.Bool(*v.HasPrf)
+	}
+	if v.AutomaticPresenceSimulation != nil {
+		w.Key("automaticPresenceSimulation")
+		w.Bool(*v.AutomaticPresenceSimulation)
+	}
+	if v.IsUserVerified != nil {
+		w.Key("isUserVerified")
+		w.Bool(*v.IsUserVerified)
+	}
+	if v.DefaultBackupEligibility != nil {
+		w.Key("defaultBackupEligibility")
+		w.Bool(*v.DefaultBackupEligibility)
+	}
+	if v.DefaultBackupState != nil {
+		w.Key("defaultBackupState")
+		w.Bool(*v.DefaultBackupState)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VirtualAuthenticatorOptions) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "protocol":
+			v.Protocol = AuthenticatorProtocol(r.String())
+		case "ctap2Version":
+			v.CTAP2Version = CTAP2Version(r.String())
+		case "transport":
+			v.Transport = AuthenticatorTransport(r.String())
+		case "hasResidentKey":
+			if r.Null() {
+				v.HasResidentKey = nil
+			} else {
+				p := r.Bool()
+				v.HasResidentKey = &p
+			}
+		case "hasUserVerification":
+			if r.Null() {
+				v.HasUserVerification = nil
+			} else {
+				p := r.Bool()
+				v.HasUserVerification = &p
+			}
+		case "hasLargeBlob":
+			if r.Null() {
+				v.HasLargeBlob = nil
+			} else {
+				p := r.Bool()
+				v.HasLargeBlob = &p
+			}
+		case "hasCredBlob":
+			if r.Null() {
+				v.HasCredBlob = nil
+			} else {
+				p := r.Bool()
+				v.HasCredBlob = &p
+			}
+		case "hasMinPinLength":
+			if r.Null() {
+				v.HasMinPinLength = nil
+			} else {
+				p := r.Bool()
+				v.HasMinPinLength = &p
+			}
+		case "hasPrf":
+			if r.Null() {
+				v.HasPrf = nil
+			} else {
+				p := r.Bool()
+				v.HasPrf = &p
+			}
+		case "automaticPresenceSimulation":
+			if r.Null() {
+				v.AutomaticPresenceSimulation = nil
+			} else {
+				p := r.Bool()
+				v.AutomaticPresenceSimulation = &p
+			}
+		case "isUserVerified":
+			if r.Null() {
+				v.IsUserVerified = nil
+			} else {
+				p := r.Bool()
+				v.IsUserVerified = &p
+			}
+		case "defaultBackupEligibility":
+			if r.Null() {
+				v.DefaultBackupEligibility = nil
+			} else {
+				p := r.Bool()
+				v.DefaultBackupEligibility = &p
+			}
+		case "defaultBackupState":
+			if r.Null() {
+				v.DefaultBackupState = nil
+			} else {
+				p := r.Bool()
+				v.DefaultBackupState = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Credential) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("credentialId")
+	w.String(v.CredentialID)
+	w.Key("isResidentCredential")
+	w.Bool(v.IsResidentCredential)
+	if v.RPID != nil {
+		w.Key("rpId")
+		w.String(*v.RPID)
+	}
+	w.Key("privateKey")
+	w.Base64(v.PrivateKey)
+	if len(v.UserHandle) > 0 {
+		w.Key("userHandle")
+		w.Base64(v.UserHandle)
+	}
+	w.Key("signCount")
+	w.Int(v.SignCount)
+	if len(v.LargeBlob) > 0 {
+		w.Key("largeBlob")
+		w.Base64(v.LargeBlob)
+	}
+	if v.BackupEligibility != nil {
+		w.Key("backupEligibility")
+		w.Bool(*v.BackupEligibility)
+	}
+	if v.BackupState != nil {
+		w.Key("backupState")
+		w.Bool(*v.BackupState)
+	}
+	if v.UserName != nil {
+		w.Key("userName")
+		w.String(*v.UserName)
+	}
+	if v.UserDisplayName != nil {
+		w.Key("userDisplayName")
+		w.String(*v.UserDisplayName)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Credential) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "credentialId":
+			v.CredentialID = r.String()
+		case "isResidentCredential":
+			v.IsResidentCredential = r.Bool()
+		case "rpId":
+			if r.Null() {
+				v.RPID = nil
+			} else {
+				p := r.String()
+				v.RPID = &p
+			}
+		case "privateKey":
+			v.PrivateKey = r.Base64()
+		case "userHandle":
+			v.UserHandle = r.Base64()
+		case "signCount":
+			v.SignCount = r.Int()
+		case "largeBlob":
+			v.LargeBlob = r.Base64()
+		case "backupEligibility":
+			if r.Null() {
+				v.BackupEligibility = nil
+			} else {
+				p := r.Bool()
+				v.BackupEligibility = &p
+			}
+		case "backupState":
+			if r.Null() {
+				v.BackupState = nil
+			} else {
+				p := r.Bool()
+				v.BackupState = &p
+			}
+		case "userName":
+			if r.Null() {
+				v.UserName = nil
+			} else {
+				p := r.String()
+				v.UserName = &p
+			}
+		case "userDisplayName":
+			if r.Null() {
+				v.UserDisplayName = nil
+			} else {
+				p := r.String()
+				v.UserDisplayName = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.EnableUI != nil {
+		w.Key("enableUI")
+		w.Bool(*v.EnableUI)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enableUI":
+			if r.Null() {
+				v.EnableUI = nil
+			} else {
+				p := r.Bool()
+				v.EnableUI = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddVirtualAuthenticatorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("options")
+	v.Options.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddVirtualAuthenticatorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "options":
+			v.Options.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddVirtualAuthenticatorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddVirtualAuthenticatorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetResponseOverrideBitsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	if v.IsBogusSignature != nil {
+		w.Key("isBogusSignature")
+		w.Bool(*v.IsBogusSignature)
+	}
+	if v.IsBadUV != nil {
+		w.Key("isBadUV")
+		w.Bool(*v.IsBadUV)
+	}
+	if v.IsBadUP != nil {
+		w.Key("isBadUP")
+		w.Bool(*v.IsBadUP)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetResponseOverrideBitsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "isBogusSignature":
+			if r.Null() {
+				v.IsBogusSignature = nil
+			} else {
+				p := r.Bool()
+				v.IsBogusSignature = &p
+			}
+		case "isBadUV":
+			if r.Null() {
+				v.IsBadUV = nil
+			} else {
+				p := r.Bool()
+				v.IsBadUV = &p
+			}
+		case "isBadUP":
+			if r.Null() {
+				v.IsBadUP = nil
+			} else {
+				p := r.Bool()
+				v.IsBadUP = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveVirtualAuthenticatorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveVirtualAuthenticatorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddCredentialArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credential")
+	v.Credential.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddCredentialArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credential":
+			v.Credential.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCredentialArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credentialId")
+	w.String(v.CredentialID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCredentialArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credentialId":
+			v.CredentialID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCredentialReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("credential")
+	v.Credential.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCredentialReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "credential":
+			v.Credential.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCredentialsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCredentialsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCredentialsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("credentials")
+	if v.Credentials == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Credentials {
+			v.Credentials[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCredentialsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "credentials":
+			if r.ArrayStart() {
+				v.Credentials = make([]Credential, 0, 4)
+				for r.ArrayNext() {
+					var e0 Credential
+					e0.DecodeJSON(r)
+					v.Credentials = append(v.Credentials, e0)
+				}
+			} else {
+				v.Credentials = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveCredentialArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credentialId")
+	w.String(v.CredentialID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveCredentialArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credentialId":
+			v.CredentialID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearCredentialsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearCredentialsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetUserVerifiedArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("isUserVerified")
+	w.Bool(v.IsUserVerified)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetUserVerifiedArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "isUserVerified":
+			v.IsUserVerified = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAutomaticPresenceSimulationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAutomaticPresenceSimulationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetCredentialPropertiesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credentialId")
+	w.String(v.CredentialID)
+	if v.BackupEligibility != nil {
+		w.Key("backupEligibility")
+		w.Bool(*v.BackupEligibility)
+	}
+	if v.BackupState != nil {
+		w.Key("backupState")
+		w.Bool(*v.BackupState)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetCredentialPropertiesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credentialId":
+			v.CredentialID = r.String()
+		case "backupEligibility":
+			if r.Null() {
+				v.BackupEligibility = nil
+			} else {
+				p := r.Bool()
+				v.BackupEligibility = &p
+			}
+		case "backupState":
+			if r.Null() {
+				v.BackupState = nil
+			} else {
+				p := r.Bool()
+				v.BackupState = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CredentialAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credential")
+	v.Credential.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CredentialAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credential":
+			v.Credential.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CredentialDeletedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credentialId")
+	w.String(v.CredentialID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CredentialDeletedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credentialId":
+			v.CredentialID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CredentialUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credential")
+	v.Credential.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CredentialUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credential":
+			v.Credential.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CredentialAssertedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("authenticatorId")
+	w.String(string(v.AuthenticatorID))
+	w.Key("credential")
+	v.Credential.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CredentialAssertedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorID = AuthenticatorID(r.String())
+		case "credential":
+			v.Credential.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
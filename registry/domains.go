@@ -0,0 +1,6817 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package registry
+
+import (
+	"reflect"
+
+	"github.com/mafredri/cdp/protocol/accessibility"
+	"github.com/mafredri/cdp/protocol/animation"
+	"github.com/mafredri/cdp/protocol/audits"
+	"github.com/mafredri/cdp/protocol/autofill"
+	"github.com/mafredri/cdp/protocol/backgroundservice"
+	"github.com/mafredri/cdp/protocol/bluetoothemulation"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/cachestorage"
+	"github.com/mafredri/cdp/protocol/cast"
+	"github.com/mafredri/cdp/protocol/console"
+	"github.com/mafredri/cdp/protocol/css"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/deviceaccess"
+	"github.com/mafredri/cdp/protocol/deviceorientation"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/domdebugger"
+	"github.com/mafredri/cdp/protocol/domsnapshot"
+	"github.com/mafredri/cdp/protocol/domstorage"
+	"github.com/mafredri/cdp/protocol/emulation"
+	"github.com/mafredri/cdp/protocol/eventbreakpoints"
+	"github.com/mafredri/cdp/protocol/extensions"
+	"github.com/mafredri/cdp/protocol/fedcm"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/filesystem"
+	"github.com/mafredri/cdp/protocol/headlessexperimental"
+	"github.com/mafredri/cdp/protocol/heapprofiler"
+	"github.com/mafredri/cdp/protocol/indexeddb"
+	"github.com/mafredri/cdp/protocol/input"
+	"github.com/mafredri/cdp/protocol/inspector"
+	"github.com/mafredri/cdp/protocol/io"
+	"github.com/mafredri/cdp/protocol/layertree"
+	"github.com/mafredri/cdp/protocol/log"
+	"github.com/mafredri/cdp/protocol/media"
+	"github.com/mafredri/cdp/protocol/memory"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/noderuntime"
+	"github.com/mafredri/cdp/protocol/nodetracing"
+	"github.com/mafredri/cdp/protocol/nodeworker"
+	"github.com/mafredri/cdp/protocol/overlay"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/performance"
+	"github.com/mafredri/cdp/protocol/performancetimeline"
+	"github.com/mafredri/cdp/protocol/preload"
+	"github.com/mafredri/cdp/protocol/profiler"
+	"github.com/mafredri/cdp/protocol/pwa"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/schema"
+	"github.com/mafredri/cdp/protocol/security"
+	"github.com/mafredri/cdp/protocol/serviceworker"
+	"github.com/mafredri/cdp/protocol/storage"
+	"github.com/mafredri/cdp/protocol/systeminfo"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/protocol/tethering"
+	"github.com/mafredri/cdp/protocol/tracing"
+	"github.com/mafredri/cdp/protocol/webaudio"
+	"github.com/mafredri/cdp/protocol/webauthn"
+)
+
+func init() {
+	methods = append(methods, []*Method{
+		{
+			Name:         "Accessibility.disable",
+			Domain:       "Accessibility",
+			Description:  "Disables the accessibility domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "Accessibility.enable",
+			Domain:       "Accessibility",
+			Description:  "Enables the accessibility domain which causes `AXNodeId`s to remain consistent between method calls.\nThis turns on accessibility for the page, which can impact performance until accessibility is disabled.",
+			Experimental: true,
+		},
+		{
+			Name:         "Accessibility.getPartialAXTree",
+			Domain:       "Accessibility",
+			Description:  "Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.",
+			Experimental: true,
+			Args:         reflect.TypeOf(accessibility.GetPartialAXTreeArgs{}),
+			NewArgs:      func() interface{} { return new(accessibility.GetPartialAXTreeArgs) },
+			Reply:        reflect.TypeOf(accessibility.GetPartialAXTreeReply{}),
+			NewReply:     func() interface{} { return new(accessibility.GetPartialAXTreeReply) },
+		},
+		{
+			Name:         "Accessibility.getFullAXTree",
+			Domain:       "Accessibility",
+			Description:  "Fetches the entire accessibility tree for the root Document",
+			Experimental: true,
+			Args:         reflect.TypeOf(accessibility.GetFullAXTreeArgs{}),
+			NewArgs:      func() interface{} { return new(accessibility.GetFullAXTreeArgs) },
+			Reply:        reflect.TypeOf(accessibility.GetFullAXTreeReply{}),
+			NewReply:     func() interface{} { return new(accessibility.GetFullAXTreeReply) },
+		},
+		{
+			Name:         "Accessibility.getRootAXNode",
+			Domain:       "Accessibility",
+			Description:  "Fetches the root node.\nRequires `enable()` to have been called previously.",
+			Experimental: true,
+			Args:         reflect.TypeOf(accessibility.GetRootAXNodeArgs{}),
+			NewArgs:      func() interface{} { return new(accessibility.GetRootAXNodeArgs) },
+			Reply:        reflect.TypeOf(accessibility.GetRootAXNodeReply{}),
+			NewReply:     func() interface{} { return new(accessibility.GetRootAXNodeReply) },
+		},
+		{
+			Name:         "Accessibility.getAXNodeAndAncestors",
+			Domain:       "Accessibility",
+			Description:  "Fetches a node and all ancestors up to and including the root.\nRequires `enable()` to have been called previously.",
+			Experimental: true,
+			Args:         reflect.TypeOf(accessibility.GetAXNodeAndAncestorsArgs{}),
+			NewArgs:      func() interface{} { return new(accessibility.GetAXNodeAndAncestorsArgs) },
+			Reply:        reflect.TypeOf(accessibility.GetAXNodeAndAncestorsReply{}),
+			NewReply:     func() interface{} { return new(accessibility.GetAXNodeAndAncestorsReply) },
+		},
+		{
+			Name:         "Accessibility.getChildAXNodes",
+			Domain:       "Accessibility",
+			Description:  "Fetches a particular accessibility node by AXNodeId.\nRequires `enable()` to have been called previously.",
+			Experimental: true,
+			Args:         reflect.TypeOf(accessibility.GetChildAXNodesArgs{}),
+			NewArgs:      func() interface{} { return new(accessibility.GetChildAXNodesArgs) },
+			Reply:        reflect.TypeOf(accessibility.GetChildAXNodesReply{}),
+			NewReply:     func() interface{} { return new(accessibility.GetChildAXNodesReply) },
+		},
+		{
+			Name:         "Accessibility.queryAXTree",
+			Domain:       "Accessibility",
+			Description:  "Query a DOM node's accessibility subtree for accessible name and role.\nThis command computes the name and role for all nodes in the subtree, including those that are\nignored for accessibility, and returns those that match the specified name and role. If no DOM\nnode is specified, or the DOM node does not exist, the command returns an error. If neither\n`accessibleName` or `role` is specified, it returns all the accessibility nodes in the subtree.",
+			Experimental: true,
+			Args:         reflect.TypeOf(accessibility.QueryAXTreeArgs{}),
+			NewArgs:      func() interface{} { return new(accessibility.QueryAXTreeArgs) },
+			Reply:        reflect.TypeOf(accessibility.QueryAXTreeReply{}),
+			NewReply:     func() interface{} { return new(accessibility.QueryAXTreeReply) },
+		},
+		{
+			Name:         "Animation.disable",
+			Domain:       "Animation",
+			Description:  "Disables animation domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "Animation.enable",
+			Domain:       "Animation",
+			Description:  "Enables animation domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "Animation.getCurrentTime",
+			Domain:       "Animation",
+			Description:  "Returns the current time of the an animation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.GetCurrentTimeArgs{}),
+			NewArgs:      func() interface{} { return new(animation.GetCurrentTimeArgs) },
+			Reply:        reflect.TypeOf(animation.GetCurrentTimeReply{}),
+			NewReply:     func() interface{} { return new(animation.GetCurrentTimeReply) },
+		},
+		{
+			Name:         "Animation.getPlaybackRate",
+			Domain:       "Animation",
+			Description:  "Gets the playback rate of the document timeline.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(animation.GetPlaybackRateReply{}),
+			NewReply:     func() interface{} { return new(animation.GetPlaybackRateReply) },
+		},
+		{
+			Name:         "Animation.releaseAnimations",
+			Domain:       "Animation",
+			Description:  "Releases a set of animations to no longer be manipulated.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.ReleaseAnimationsArgs{}),
+			NewArgs:      func() interface{} { return new(animation.ReleaseAnimationsArgs) },
+		},
+		{
+			Name:         "Animation.resolveAnimation",
+			Domain:       "Animation",
+			Description:  "Gets the remote object of the Animation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.ResolveAnimationArgs{}),
+			NewArgs:      func() interface{} { return new(animation.ResolveAnimationArgs) },
+			Reply:        reflect.TypeOf(animation.ResolveAnimationReply{}),
+			NewReply:     func() interface{} { return new(animation.ResolveAnimationReply) },
+		},
+		{
+			Name:         "Animation.seekAnimations",
+			Domain:       "Animation",
+			Description:  "Seek a set of animations to a particular time within each animation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.SeekAnimationsArgs{}),
+			NewArgs:      func() interface{} { return new(animation.SeekAnimationsArgs) },
+		},
+		{
+			Name:         "Animation.setPaused",
+			Domain:       "Animation",
+			Description:  "Sets the paused state of a set of animations.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.SetPausedArgs{}),
+			NewArgs:      func() interface{} { return new(animation.SetPausedArgs) },
+		},
+		{
+			Name:         "Animation.setPlaybackRate",
+			Domain:       "Animation",
+			Description:  "Sets the playback rate of the document timeline.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.SetPlaybackRateArgs{}),
+			NewArgs:      func() interface{} { return new(animation.SetPlaybackRateArgs) },
+		},
+		{
+			Name:         "Animation.setTiming",
+			Domain:       "Animation",
+			Description:  "Sets the timing of an animation node.",
+			Experimental: true,
+			Args:         reflect.TypeOf(animation.SetTimingArgs{}),
+			NewArgs:      func() interface{} { return new(animation.SetTimingArgs) },
+		},
+		{
+			Name:         "Audits.getEncodedResponse",
+			Domain:       "Audits",
+			Description:  "Returns the response body and size if it were re-encoded with the specified settings. Only\napplies to images.",
+			Experimental: true,
+			Args:         reflect.TypeOf(audits.GetEncodedResponseArgs{}),
+			NewArgs:      func() interface{} { return new(audits.GetEncodedResponseArgs) },
+			Reply:        reflect.TypeOf(audits.GetEncodedResponseReply{}),
+			NewReply:     func() interface{} { return new(audits.GetEncodedResponseReply) },
+		},
+		{
+			Name:         "Audits.disable",
+			Domain:       "Audits",
+			Description:  "Disables issues domain, prevents further issues from being reported to the client.",
+			Experimental: true,
+		},
+		{
+			Name:         "Audits.enable",
+			Domain:       "Audits",
+			Description:  "Enables issues domain, sends the issues collected so far to the client by means of the\n`issueAdded` event.",
+			Experimental: true,
+		},
+		{
+			Name:         "Audits.checkContrast",
+			Domain:       "Audits",
+			Description:  "Runs the contrast check for the target page. Found issues are reported\nusing Audits.issueAdded event.",
+			Experimental: true,
+			Args:         reflect.TypeOf(audits.CheckContrastArgs{}),
+			NewArgs:      func() interface{} { return new(audits.CheckContrastArgs) },
+		},
+		{
+			Name:         "Audits.checkFormsIssues",
+			Domain:       "Audits",
+			Description:  "Runs the form issues check for the target page. Found issues are reported\nusing Audits.issueAdded event.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(audits.CheckFormsIssuesReply{}),
+			NewReply:     func() interface{} { return new(audits.CheckFormsIssuesReply) },
+		},
+		{
+			Name:         "Autofill.trigger",
+			Domain:       "Autofill",
+			Description:  "Trigger autofill on a form identified by the fieldId.\nIf the field and related form cannot be autofilled, returns an error.",
+			Experimental: true,
+			Args:         reflect.TypeOf(autofill.TriggerArgs{}),
+			NewArgs:      func() interface{} { return new(autofill.TriggerArgs) },
+		},
+		{
+			Name:         "Autofill.setAddresses",
+			Domain:       "Autofill",
+			Description:  "Set addresses so that developers can verify their forms implementation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(autofill.SetAddressesArgs{}),
+			NewArgs:      func() interface{} { return new(autofill.SetAddressesArgs) },
+		},
+		{
+			Name:         "Autofill.disable",
+			Domain:       "Autofill",
+			Description:  "Disables autofill domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "Autofill.enable",
+			Domain:       "Autofill",
+			Description:  "Enables autofill domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "BackgroundService.startObserving",
+			Domain:       "BackgroundService",
+			Description:  "Enables event updates for the service.",
+			Experimental: true,
+			Args:         reflect.TypeOf(backgroundservice.StartObservingArgs{}),
+			NewArgs:      func() interface{} { return new(backgroundservice.StartObservingArgs) },
+		},
+		{
+			Name:         "BackgroundService.stopObserving",
+			Domain:       "BackgroundService",
+			Description:  "Disables event updates for the service.",
+			Experimental: true,
+			Args:         reflect.TypeOf(backgroundservice.StopObservingArgs{}),
+			NewArgs:      func() interface{} { return new(backgroundservice.StopObservingArgs) },
+		},
+		{
+			Name:         "BackgroundService.setRecording",
+			Domain:       "BackgroundService",
+			Description:  "Set the recording state for the service.",
+			Experimental: true,
+			Args:         reflect.TypeOf(backgroundservice.SetRecordingArgs{}),
+			NewArgs:      func() interface{} { return new(backgroundservice.SetRecordingArgs) },
+		},
+		{
+			Name:         "BackgroundService.clearEvents",
+			Domain:       "BackgroundService",
+			Description:  "Clears all stored data for the service.",
+			Experimental: true,
+			Args:         reflect.TypeOf(backgroundservice.ClearEventsArgs{}),
+			NewArgs:      func() interface{} { return new(backgroundservice.ClearEventsArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.enable",
+			Domain:       "BluetoothEmulation",
+			Description:  "Enable the BluetoothEmulation domain.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.EnableArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.EnableArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.setSimulatedCentralState",
+			Domain:       "BluetoothEmulation",
+			Description:  "Set the state of the simulated central.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SetSimulatedCentralStateArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SetSimulatedCentralStateArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.disable",
+			Domain:       "BluetoothEmulation",
+			Description:  "Disable the BluetoothEmulation domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "BluetoothEmulation.simulatePreconnectedPeripheral",
+			Domain:       "BluetoothEmulation",
+			Description:  "Simulates a peripheral with |address|, |name| and |knownServiceUuids|\nthat has already been connected to the system.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SimulatePreconnectedPeripheralArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SimulatePreconnectedPeripheralArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.simulateAdvertisement",
+			Domain:       "BluetoothEmulation",
+			Description:  "Simulates an advertisement packet described in |entry| being received by\nthe central.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SimulateAdvertisementArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SimulateAdvertisementArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.simulateGATTOperationResponse",
+			Domain:       "BluetoothEmulation",
+			Description:  "Simulates the response code from the peripheral with |address| for a\nGATT operation of |type|. The |code| value follows the HCI Error Codes from\nBluetooth Core Specification Vol 2 Part D 1.3 List Of Error Codes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SimulateGATTOperationResponseArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SimulateGATTOperationResponseArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.simulateCharacteristicOperationResponse",
+			Domain:       "BluetoothEmulation",
+			Description:  "Simulates the response from the characteristic with |characteristicId| for a\ncharacteristic operation of |type|. The |code| value follows the Error\nCodes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response.\nThe |data| is expected to exist when simulating a successful read operation\nresponse.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SimulateCharacteristicOperationResponseArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SimulateCharacteristicOperationResponseArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.simulateDescriptorOperationResponse",
+			Domain:       "BluetoothEmulation",
+			Description:  "Simulates the response from the descriptor with |descriptorId| for a\ndescriptor operation of |type|. The |code| value follows the Error\nCodes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response.\nThe |data| is expected to exist when simulating a successful read operation\nresponse.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SimulateDescriptorOperationResponseArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SimulateDescriptorOperationResponseArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.addService",
+			Domain:       "BluetoothEmulation",
+			Description:  "Adds a service with |serviceUuid| to the peripheral with |address|.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.AddServiceArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.AddServiceArgs) },
+			Reply:        reflect.TypeOf(bluetoothemulation.AddServiceReply{}),
+			NewReply:     func() interface{} { return new(bluetoothemulation.AddServiceReply) },
+		},
+		{
+			Name:         "BluetoothEmulation.removeService",
+			Domain:       "BluetoothEmulation",
+			Description:  "Removes the service respresented by |serviceId| from the simulated central.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.RemoveServiceArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.RemoveServiceArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.addCharacteristic",
+			Domain:       "BluetoothEmulation",
+			Description:  "Adds a characteristic with |characteristicUuid| and |properties| to the\nservice represented by |serviceId|.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.AddCharacteristicArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.AddCharacteristicArgs) },
+			Reply:        reflect.TypeOf(bluetoothemulation.AddCharacteristicReply{}),
+			NewReply:     func() interface{} { return new(bluetoothemulation.AddCharacteristicReply) },
+		},
+		{
+			Name:         "BluetoothEmulation.removeCharacteristic",
+			Domain:       "BluetoothEmulation",
+			Description:  "Removes the characteristic respresented by |characteristicId| from the\nsimulated central.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.RemoveCharacteristicArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.RemoveCharacteristicArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.addDescriptor",
+			Domain:       "BluetoothEmulation",
+			Description:  "Adds a descriptor with |descriptorUuid| to the characteristic respresented\nby |characteristicId|.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.AddDescriptorArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.AddDescriptorArgs) },
+			Reply:        reflect.TypeOf(bluetoothemulation.AddDescriptorReply{}),
+			NewReply:     func() interface{} { return new(bluetoothemulation.AddDescriptorReply) },
+		},
+		{
+			Name:         "BluetoothEmulation.removeDescriptor",
+			Domain:       "BluetoothEmulation",
+			Description:  "Removes the descriptor with |descriptorId| from the simulated central.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.RemoveDescriptorArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.RemoveDescriptorArgs) },
+		},
+		{
+			Name:         "BluetoothEmulation.simulateGATTDisconnection",
+			Domain:       "BluetoothEmulation",
+			Description:  "Simulates a GATT disconnection from the peripheral with |address|.",
+			Experimental: true,
+			Args:         reflect.TypeOf(bluetoothemulation.SimulateGATTDisconnectionArgs{}),
+			NewArgs:      func() interface{} { return new(bluetoothemulation.SimulateGATTDisconnectionArgs) },
+		},
+		{
+			Name:         "Browser.setPermission",
+			Domain:       "Browser",
+			Description:  "Set permission settings for given embedding and embedded origins.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.SetPermissionArgs{}),
+			NewArgs:      func() interface{} { return new(browser.SetPermissionArgs) },
+		},
+		{
+			Name:         "Browser.grantPermissions",
+			Domain:       "Browser",
+			Description:  "Grant specific permissions to the given origin and reject all others. Deprecated. Use\nsetPermission instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(browser.GrantPermissionsArgs{}),
+			NewArgs:      func() interface{} { return new(browser.GrantPermissionsArgs) },
+		},
+		{
+			Name:        "Browser.resetPermissions",
+			Domain:      "Browser",
+			Description: "Reset all permission management for all origins.",
+			Args:        reflect.TypeOf(browser.ResetPermissionsArgs{}),
+			NewArgs:     func() interface{} { return new(browser.ResetPermissionsArgs) },
+		},
+		{
+			Name:         "Browser.setDownloadBehavior",
+			Domain:       "Browser",
+			Description:  "Set the behavior when downloading a file.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.SetDownloadBehaviorArgs{}),
+			NewArgs:      func() interface{} { return new(browser.SetDownloadBehaviorArgs) },
+		},
+		{
+			Name:         "Browser.cancelDownload",
+			Domain:       "Browser",
+			Description:  "Cancel a download if in progress",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.CancelDownloadArgs{}),
+			NewArgs:      func() interface{} { return new(browser.CancelDownloadArgs) },
+		},
+		{
+			Name:        "Browser.close",
+			Domain:      "Browser",
+			Description: "Close browser gracefully.",
+		},
+		{
+			Name:         "Browser.crash",
+			Domain:       "Browser",
+			Description:  "Crashes browser on the main thread.",
+			Experimental: true,
+		},
+		{
+			Name:         "Browser.crashGpuProcess",
+			Domain:       "Browser",
+			Description:  "Crashes GPU process.",
+			Experimental: true,
+		},
+		{
+			Name:        "Browser.getVersion",
+			Domain:      "Browser",
+			Description: "Returns version information.",
+			Reply:       reflect.TypeOf(browser.GetVersionReply{}),
+			NewReply:    func() interface{} { return new(browser.GetVersionReply) },
+		},
+		{
+			Name:         "Browser.getBrowserCommandLine",
+			Domain:       "Browser",
+			Description:  "Returns the command line switches for the browser process if, and only if\n--enable-automation is on the commandline.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(browser.GetBrowserCommandLineReply{}),
+			NewReply:     func() interface{} { return new(browser.GetBrowserCommandLineReply) },
+		},
+		{
+			Name:         "Browser.getHistograms",
+			Domain:       "Browser",
+			Description:  "Get Chrome histograms.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.GetHistogramsArgs{}),
+			NewArgs:      func() interface{} { return new(browser.GetHistogramsArgs) },
+			Reply:        reflect.TypeOf(browser.GetHistogramsReply{}),
+			NewReply:     func() interface{} { return new(browser.GetHistogramsReply) },
+		},
+		{
+			Name:         "Browser.getHistogram",
+			Domain:       "Browser",
+			Description:  "Get a Chrome histogram by name.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.GetHistogramArgs{}),
+			NewArgs:      func() interface{} { return new(browser.GetHistogramArgs) },
+			Reply:        reflect.TypeOf(browser.GetHistogramReply{}),
+			NewReply:     func() interface{} { return new(browser.GetHistogramReply) },
+		},
+		{
+			Name:         "Browser.getWindowBounds",
+			Domain:       "Browser",
+			Description:  "Get position and size of the browser window.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.GetWindowBoundsArgs{}),
+			NewArgs:      func() interface{} { return new(browser.GetWindowBoundsArgs) },
+			Reply:        reflect.TypeOf(browser.GetWindowBoundsReply{}),
+			NewReply:     func() interface{} { return new(browser.GetWindowBoundsReply) },
+		},
+		{
+			Name:         "Browser.getWindowForTarget",
+			Domain:       "Browser",
+			Description:  "Get the browser window that contains the devtools target.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.GetWindowForTargetArgs{}),
+			NewArgs:      func() interface{} { return new(browser.GetWindowForTargetArgs) },
+			Reply:        reflect.TypeOf(browser.GetWindowForTargetReply{}),
+			NewReply:     func() interface{} { return new(browser.GetWindowForTargetReply) },
+		},
+		{
+			Name:         "Browser.setWindowBounds",
+			Domain:       "Browser",
+			Description:  "Set position and/or size of the browser window.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.SetWindowBoundsArgs{}),
+			NewArgs:      func() interface{} { return new(browser.SetWindowBoundsArgs) },
+		},
+		{
+			Name:         "Browser.setContentsSize",
+			Domain:       "Browser",
+			Description:  "Set size of the browser contents resizing browser window as necessary.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.SetContentsSizeArgs{}),
+			NewArgs:      func() interface{} { return new(browser.SetContentsSizeArgs) },
+		},
+		{
+			Name:         "Browser.setDockTile",
+			Domain:       "Browser",
+			Description:  "Set dock tile details, platform-specific.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.SetDockTileArgs{}),
+			NewArgs:      func() interface{} { return new(browser.SetDockTileArgs) },
+		},
+		{
+			Name:         "Browser.executeBrowserCommand",
+			Domain:       "Browser",
+			Description:  "Invoke custom browser commands used by telemetry.",
+			Experimental: true,
+			Args:         reflect.TypeOf(browser.ExecuteBrowserCommandArgs{}),
+			NewArgs:      func() interface{} { return new(browser.ExecuteBrowserCommandArgs) },
+		},
+		{
+			Name:        "Browser.addPrivacySandboxEnrollmentOverride",
+			Domain:      "Browser",
+			Description: "Allows a site to use privacy sandbox features that require enrollment\nwithout the site actually being enrolled. Only supported on page targets.",
+			Args:        reflect.TypeOf(browser.AddPrivacySandboxEnrollmentOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(browser.AddPrivacySandboxEnrollmentOverrideArgs) },
+		},
+		{
+			Name:        "Browser.addPrivacySandboxCoordinatorKeyConfig",
+			Domain:      "Browser",
+			Description: "Configures encryption keys used with a given privacy sandbox API to talk\nto a trusted coordinator.  Since this is intended for test automation only,\ncoordinatorOrigin must be a .test domain. No existing coordinator\nconfiguration for the origin may exist.",
+			Args:        reflect.TypeOf(browser.AddPrivacySandboxCoordinatorKeyConfigArgs{}),
+			NewArgs:     func() interface{} { return new(browser.AddPrivacySandboxCoordinatorKeyConfigArgs) },
+		},
+		{
+			Name:         "CSS.addRule",
+			Domain:       "CSS",
+			Description:  "Inserts a new rule with the given `ruleText` in a stylesheet with given `styleSheetId`, at the\nposition specified by `location`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.AddRuleArgs{}),
+			NewArgs:      func() interface{} { return new(css.AddRuleArgs) },
+			Reply:        reflect.TypeOf(css.AddRuleReply{}),
+			NewReply:     func() interface{} { return new(css.AddRuleReply) },
+		},
+		{
+			Name:         "CSS.collectClassNames",
+			Domain:       "CSS",
+			Description:  "Returns all class names from specified stylesheet.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.CollectClassNamesArgs{}),
+			NewArgs:      func() interface{} { return new(css.CollectClassNamesArgs) },
+			Reply:        reflect.TypeOf(css.CollectClassNamesReply{}),
+			NewReply:     func() interface{} { return new(css.CollectClassNamesReply) },
+		},
+		{
+			Name:         "CSS.createStyleSheet",
+			Domain:       "CSS",
+			Description:  "Creates a new special \"via-inspector\" stylesheet in the frame with given `frameId`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.CreateStyleSheetArgs{}),
+			NewArgs:      func() interface{} { return new(css.CreateStyleSheetArgs) },
+			Reply:        reflect.TypeOf(css.CreateStyleSheetReply{}),
+			NewReply:     func() interface{} { return new(css.CreateStyleSheetReply) },
+		},
+		{
+			Name:         "CSS.disable",
+			Domain:       "CSS",
+			Description:  "Disables the CSS agent for the given page.",
+			Experimental: true,
+		},
+		{
+			Name:         "CSS.enable",
+			Domain:       "CSS",
+			Description:  "Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been\nenabled until the result of this command is received.",
+			Experimental: true,
+		},
+		{
+			Name:         "CSS.forcePseudoState",
+			Domain:       "CSS",
+			Description:  "Ensures that the given node will have specified pseudo-classes whenever its style is computed by\nthe browser.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.ForcePseudoStateArgs{}),
+			NewArgs:      func() interface{} { return new(css.ForcePseudoStateArgs) },
+		},
+		{
+			Name:         "CSS.forceStartingStyle",
+			Domain:       "CSS",
+			Description:  "Ensures that the given node is in its starting-style state.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.ForceStartingStyleArgs{}),
+			NewArgs:      func() interface{} { return new(css.ForceStartingStyleArgs) },
+		},
+		{
+			Name:         "CSS.getBackgroundColors",
+			Domain:       "CSS",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetBackgroundColorsArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetBackgroundColorsArgs) },
+			Reply:        reflect.TypeOf(css.GetBackgroundColorsReply{}),
+			NewReply:     func() interface{} { return new(css.GetBackgroundColorsReply) },
+		},
+		{
+			Name:         "CSS.getComputedStyleForNode",
+			Domain:       "CSS",
+			Description:  "Returns the computed style for a DOM node identified by `nodeId`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetComputedStyleForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetComputedStyleForNodeArgs) },
+			Reply:        reflect.TypeOf(css.GetComputedStyleForNodeReply{}),
+			NewReply:     func() interface{} { return new(css.GetComputedStyleForNodeReply) },
+		},
+		{
+			Name:         "CSS.resolveValues",
+			Domain:       "CSS",
+			Description:  "Resolve the specified values in the context of the provided element.\nFor example, a value of '1em' is evaluated according to the computed\n'font-size' of the element and a value 'calc(1px + 2px)' will be\nresolved to '3px'.\nIf the `propertyName` was specified the `values` are resolved as if\nthey were property's declaration. If a value cannot be parsed according\nto the provided property syntax, the value is parsed using combined\nsyntax as if null `propertyName` was provided. If the value cannot be\nresolved even then, return the provided value without any changes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.ResolveValuesArgs{}),
+			NewArgs:      func() interface{} { return new(css.ResolveValuesArgs) },
+			Reply:        reflect.TypeOf(css.ResolveValuesReply{}),
+			NewReply:     func() interface{} { return new(css.ResolveValuesReply) },
+		},
+		{
+			Name:         "CSS.getLonghandProperties",
+			Domain:       "CSS",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetLonghandPropertiesArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetLonghandPropertiesArgs) },
+			Reply:        reflect.TypeOf(css.GetLonghandPropertiesReply{}),
+			NewReply:     func() interface{} { return new(css.GetLonghandPropertiesReply) },
+		},
+		{
+			Name:         "CSS.getInlineStylesForNode",
+			Domain:       "CSS",
+			Description:  "Returns the styles defined inline (explicitly in the \"style\" attribute and implicitly, using DOM\nattributes) for a DOM node identified by `nodeId`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetInlineStylesForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetInlineStylesForNodeArgs) },
+			Reply:        reflect.TypeOf(css.GetInlineStylesForNodeReply{}),
+			NewReply:     func() interface{} { return new(css.GetInlineStylesForNodeReply) },
+		},
+		{
+			Name:         "CSS.getAnimatedStylesForNode",
+			Domain:       "CSS",
+			Description:  "Returns the styles coming from animations & transitions\nincluding the animation & transition styles coming from inheritance chain.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetAnimatedStylesForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetAnimatedStylesForNodeArgs) },
+			Reply:        reflect.TypeOf(css.GetAnimatedStylesForNodeReply{}),
+			NewReply:     func() interface{} { return new(css.GetAnimatedStylesForNodeReply) },
+		},
+		{
+			Name:         "CSS.getMatchedStylesForNode",
+			Domain:       "CSS",
+			Description:  "Returns requested styles for a DOM node identified by `nodeId`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetMatchedStylesForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetMatchedStylesForNodeArgs) },
+			Reply:        reflect.TypeOf(css.GetMatchedStylesForNodeReply{}),
+			NewReply:     func() interface{} { return new(css.GetMatchedStylesForNodeReply) },
+		},
+		{
+			Name:         "CSS.getEnvironmentVariables",
+			Domain:       "CSS",
+			Description:  "Returns the values of the default UA-defined environment variables used in env()",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.GetEnvironmentVariablesReply{}),
+			NewReply:     func() interface{} { return new(css.GetEnvironmentVariablesReply) },
+		},
+		{
+			Name:         "CSS.getMediaQueries",
+			Domain:       "CSS",
+			Description:  "Returns all media queries parsed by the rendering engine.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.GetMediaQueriesReply{}),
+			NewReply:     func() interface{} { return new(css.GetMediaQueriesReply) },
+		},
+		{
+			Name:         "CSS.getPlatformFontsForNode",
+			Domain:       "CSS",
+			Description:  "Requests information about platform fonts which we used to render child TextNodes in the given\nnode.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetPlatformFontsForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetPlatformFontsForNodeArgs) },
+			Reply:        reflect.TypeOf(css.GetPlatformFontsForNodeReply{}),
+			NewReply:     func() interface{} { return new(css.GetPlatformFontsForNodeReply) },
+		},
+		{
+			Name:         "CSS.getStyleSheetText",
+			Domain:       "CSS",
+			Description:  "Returns the current textual content for a stylesheet.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetStyleSheetTextArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetStyleSheetTextArgs) },
+			Reply:        reflect.TypeOf(css.GetStyleSheetTextReply{}),
+			NewReply:     func() interface{} { return new(css.GetStyleSheetTextReply) },
+		},
+		{
+			Name:         "CSS.getLayersForNode",
+			Domain:       "CSS",
+			Description:  "Returns all layers parsed by the rendering engine for the tree scope of a node.\nGiven a DOM element identified by nodeId, getLayersForNode returns the root\nlayer for the nearest ancestor document or shadow root. The layer root contains\nthe full layer tree for the tree scope and their ordering.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetLayersForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetLayersForNodeArgs) },
+			Reply:        reflect.TypeOf(css.GetLayersForNodeReply{}),
+			NewReply:     func() interface{} { return new(css.GetLayersForNodeReply) },
+		},
+		{
+			Name:         "CSS.getLocationForSelector",
+			Domain:       "CSS",
+			Description:  "Given a CSS selector text and a style sheet ID, getLocationForSelector\nreturns an array of locations of the CSS selector in the style sheet.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.GetLocationForSelectorArgs{}),
+			NewArgs:      func() interface{} { return new(css.GetLocationForSelectorArgs) },
+			Reply:        reflect.TypeOf(css.GetLocationForSelectorReply{}),
+			NewReply:     func() interface{} { return new(css.GetLocationForSelectorReply) },
+		},
+		{
+			Name:         "CSS.trackComputedStyleUpdatesForNode",
+			Domain:       "CSS",
+			Description:  "Starts tracking the given node for the computed style updates\nand whenever the computed style is updated for node, it queues\na `computedStyleUpdated` event with throttling.\nThere can only be 1 node tracked for computed style updates\nso passing a new node id removes tracking from the previous node.\nPass `undefined` to disable tracking.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.TrackComputedStyleUpdatesForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.TrackComputedStyleUpdatesForNodeArgs) },
+		},
+		{
+			Name:         "CSS.trackComputedStyleUpdates",
+			Domain:       "CSS",
+			Description:  "Starts tracking the given computed styles for updates. The specified array of properties\nreplaces the one previously specified. Pass empty array to disable tracking.\nUse takeComputedStyleUpdates to retrieve the list of nodes that had properties modified.\nThe changes to computed style properties are only tracked for nodes pushed to the front-end\nby the DOM agent. If no changes to the tracked properties occur after the node has been pushed\nto the front-end, no updates will be issued for the node.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.TrackComputedStyleUpdatesArgs{}),
+			NewArgs:      func() interface{} { return new(css.TrackComputedStyleUpdatesArgs) },
+		},
+		{
+			Name:         "CSS.takeComputedStyleUpdates",
+			Domain:       "CSS",
+			Description:  "Polls the next batch of computed style updates.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.TakeComputedStyleUpdatesReply{}),
+			NewReply:     func() interface{} { return new(css.TakeComputedStyleUpdatesReply) },
+		},
+		{
+			Name:         "CSS.setEffectivePropertyValueForNode",
+			Domain:       "CSS",
+			Description:  "Find a rule with the given active property for the given node and set the new value for this\nproperty",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetEffectivePropertyValueForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetEffectivePropertyValueForNodeArgs) },
+		},
+		{
+			Name:         "CSS.setPropertyRulePropertyName",
+			Domain:       "CSS",
+			Description:  "Modifies the property rule property name.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetPropertyRulePropertyNameArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetPropertyRulePropertyNameArgs) },
+			Reply:        reflect.TypeOf(css.SetPropertyRulePropertyNameReply{}),
+			NewReply:     func() interface{} { return new(css.SetPropertyRulePropertyNameReply) },
+		},
+		{
+			Name:         "CSS.setKeyframeKey",
+			Domain:       "CSS",
+			Description:  "Modifies the keyframe rule key text.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetKeyframeKeyArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetKeyframeKeyArgs) },
+			Reply:        reflect.TypeOf(css.SetKeyframeKeyReply{}),
+			NewReply:     func() interface{} { return new(css.SetKeyframeKeyReply) },
+		},
+		{
+			Name:         "CSS.setMediaText",
+			Domain:       "CSS",
+			Description:  "Modifies the rule selector.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetMediaTextArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetMediaTextArgs) },
+			Reply:        reflect.TypeOf(css.SetMediaTextReply{}),
+			NewReply:     func() interface{} { return new(css.SetMediaTextReply) },
+		},
+		{
+			Name:         "CSS.setContainerQueryText",
+			Domain:       "CSS",
+			Description:  "Modifies the expression of a container query.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetContainerQueryTextArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetContainerQueryTextArgs) },
+			Reply:        reflect.TypeOf(css.SetContainerQueryTextReply{}),
+			NewReply:     func() interface{} { return new(css.SetContainerQueryTextReply) },
+		},
+		{
+			Name:         "CSS.setSupportsText",
+			Domain:       "CSS",
+			Description:  "Modifies the expression of a supports at-rule.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetSupportsTextArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetSupportsTextArgs) },
+			Reply:        reflect.TypeOf(css.SetSupportsTextReply{}),
+			NewReply:     func() interface{} { return new(css.SetSupportsTextReply) },
+		},
+		{
+			Name:         "CSS.setScopeText",
+			Domain:       "CSS",
+			Description:  "Modifies the expression of a scope at-rule.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetScopeTextArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetScopeTextArgs) },
+			Reply:        reflect.TypeOf(css.SetScopeTextReply{}),
+			NewReply:     func() interface{} { return new(css.SetScopeTextReply) },
+		},
+		{
+			Name:         "CSS.setRuleSelector",
+			Domain:       "CSS",
+			Description:  "Modifies the rule selector.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetRuleSelectorArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetRuleSelectorArgs) },
+			Reply:        reflect.TypeOf(css.SetRuleSelectorReply{}),
+			NewReply:     func() interface{} { return new(css.SetRuleSelectorReply) },
+		},
+		{
+			Name:         "CSS.setStyleSheetText",
+			Domain:       "CSS",
+			Description:  "Sets the new stylesheet text.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetStyleSheetTextArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetStyleSheetTextArgs) },
+			Reply:        reflect.TypeOf(css.SetStyleSheetTextReply{}),
+			NewReply:     func() interface{} { return new(css.SetStyleSheetTextReply) },
+		},
+		{
+			Name:         "CSS.setStyleTexts",
+			Domain:       "CSS",
+			Description:  "Applies specified style edits one after another in the given order.",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetStyleTextsArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetStyleTextsArgs) },
+			Reply:        reflect.TypeOf(css.SetStyleTextsReply{}),
+			NewReply:     func() interface{} { return new(css.SetStyleTextsReply) },
+		},
+		{
+			Name:         "CSS.startRuleUsageTracking",
+			Domain:       "CSS",
+			Description:  "Enables the selector recording.",
+			Experimental: true,
+		},
+		{
+			Name:         "CSS.stopRuleUsageTracking",
+			Domain:       "CSS",
+			Description:  "Stop tracking rule usage and return the list of rules that were used since last call to\n`takeCoverageDelta` (or since start of coverage instrumentation).",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.StopRuleUsageTrackingReply{}),
+			NewReply:     func() interface{} { return new(css.StopRuleUsageTrackingReply) },
+		},
+		{
+			Name:         "CSS.takeCoverageDelta",
+			Domain:       "CSS",
+			Description:  "Obtain list of rules that became used since last call to this method (or since start of coverage\ninstrumentation).",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.TakeCoverageDeltaReply{}),
+			NewReply:     func() interface{} { return new(css.TakeCoverageDeltaReply) },
+		},
+		{
+			Name:         "CSS.setLocalFontsEnabled",
+			Domain:       "CSS",
+			Description:  "Enables/disables rendering of local CSS fonts (enabled by default).",
+			Experimental: true,
+			Args:         reflect.TypeOf(css.SetLocalFontsEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(css.SetLocalFontsEnabledArgs) },
+		},
+		{
+			Name:         "CacheStorage.deleteCache",
+			Domain:       "CacheStorage",
+			Description:  "Deletes a cache.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cachestorage.DeleteCacheArgs{}),
+			NewArgs:      func() interface{} { return new(cachestorage.DeleteCacheArgs) },
+		},
+		{
+			Name:         "CacheStorage.deleteEntry",
+			Domain:       "CacheStorage",
+			Description:  "Deletes a cache entry.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cachestorage.DeleteEntryArgs{}),
+			NewArgs:      func() interface{} { return new(cachestorage.DeleteEntryArgs) },
+		},
+		{
+			Name:         "CacheStorage.requestCacheNames",
+			Domain:       "CacheStorage",
+			Description:  "Requests cache names.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cachestorage.RequestCacheNamesArgs{}),
+			NewArgs:      func() interface{} { return new(cachestorage.RequestCacheNamesArgs) },
+			Reply:        reflect.TypeOf(cachestorage.RequestCacheNamesReply{}),
+			NewReply:     func() interface{} { return new(cachestorage.RequestCacheNamesReply) },
+		},
+		{
+			Name:         "CacheStorage.requestCachedResponse",
+			Domain:       "CacheStorage",
+			Description:  "Fetches cache entry.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cachestorage.RequestCachedResponseArgs{}),
+			NewArgs:      func() interface{} { return new(cachestorage.RequestCachedResponseArgs) },
+			Reply:        reflect.TypeOf(cachestorage.RequestCachedResponseReply{}),
+			NewReply:     func() interface{} { return new(cachestorage.RequestCachedResponseReply) },
+		},
+		{
+			Name:         "CacheStorage.requestEntries",
+			Domain:       "CacheStorage",
+			Description:  "Requests data from cache.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cachestorage.RequestEntriesArgs{}),
+			NewArgs:      func() interface{} { return new(cachestorage.RequestEntriesArgs) },
+			Reply:        reflect.TypeOf(cachestorage.RequestEntriesReply{}),
+			NewReply:     func() interface{} { return new(cachestorage.RequestEntriesReply) },
+		},
+		{
+			Name:         "Cast.enable",
+			Domain:       "Cast",
+			Description:  "Starts observing for sinks that can be used for tab mirroring, and if set,\nsinks compatible with |presentationUrl| as well. When sinks are found, a\n|sinksUpdated| event is fired.\nAlso starts observing for issue messages. When an issue is added or removed,\nan |issueUpdated| event is fired.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cast.EnableArgs{}),
+			NewArgs:      func() interface{} { return new(cast.EnableArgs) },
+		},
+		{
+			Name:         "Cast.disable",
+			Domain:       "Cast",
+			Description:  "Stops observing for sinks and issues.",
+			Experimental: true,
+		},
+		{
+			Name:         "Cast.setSinkToUse",
+			Domain:       "Cast",
+			Description:  "Sets a sink to be used when the web page requests the browser to choose a\nsink via Presentation API, Remote Playback API, or Cast SDK.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cast.SetSinkToUseArgs{}),
+			NewArgs:      func() interface{} { return new(cast.SetSinkToUseArgs) },
+		},
+		{
+			Name:         "Cast.startDesktopMirroring",
+			Domain:       "Cast",
+			Description:  "Starts mirroring the desktop to the sink.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cast.StartDesktopMirroringArgs{}),
+			NewArgs:      func() interface{} { return new(cast.StartDesktopMirroringArgs) },
+		},
+		{
+			Name:         "Cast.startTabMirroring",
+			Domain:       "Cast",
+			Description:  "Starts mirroring the tab to the sink.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cast.StartTabMirroringArgs{}),
+			NewArgs:      func() interface{} { return new(cast.StartTabMirroringArgs) },
+		},
+		{
+			Name:         "Cast.stopCasting",
+			Domain:       "Cast",
+			Description:  "Stops the active Cast session on the sink.",
+			Experimental: true,
+			Args:         reflect.TypeOf(cast.StopCastingArgs{}),
+			NewArgs:      func() interface{} { return new(cast.StopCastingArgs) },
+		},
+		{
+			Name:        "Console.clearMessages",
+			Domain:      "Console",
+			Description: "Does nothing.",
+			Deprecated:  true,
+		},
+		{
+			Name:        "Console.disable",
+			Domain:      "Console",
+			Description: "Disables console domain, prevents further console messages from being reported to the client.",
+			Deprecated:  true,
+		},
+		{
+			Name:        "Console.enable",
+			Domain:      "Console",
+			Description: "Enables console domain, sends the messages collected so far to the client by means of the\n`messageAdded` notification.",
+			Deprecated:  true,
+		},
+		{
+			Name:         "DOM.collectClassNamesFromSubtree",
+			Domain:       "DOM",
+			Description:  "Collects class names for the node with given id and all of it's child nodes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.CollectClassNamesFromSubtreeArgs{}),
+			NewArgs:      func() interface{} { return new(dom.CollectClassNamesFromSubtreeArgs) },
+			Reply:        reflect.TypeOf(dom.CollectClassNamesFromSubtreeReply{}),
+			NewReply:     func() interface{} { return new(dom.CollectClassNamesFromSubtreeReply) },
+		},
+		{
+			Name:         "DOM.copyTo",
+			Domain:       "DOM",
+			Description:  "Creates a deep copy of the specified node and places it into the target container before the\ngiven anchor.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.CopyToArgs{}),
+			NewArgs:      func() interface{} { return new(dom.CopyToArgs) },
+			Reply:        reflect.TypeOf(dom.CopyToReply{}),
+			NewReply:     func() interface{} { return new(dom.CopyToReply) },
+		},
+		{
+			Name:        "DOM.describeNode",
+			Domain:      "DOM",
+			Description: "Describes node given its id, does not require domain to be enabled. Does not start tracking any\nobjects, can be used for automation.",
+			Args:        reflect.TypeOf(dom.DescribeNodeArgs{}),
+			NewArgs:     func() interface{} { return new(dom.DescribeNodeArgs) },
+			Reply:       reflect.TypeOf(dom.DescribeNodeReply{}),
+			NewReply:    func() interface{} { return new(dom.DescribeNodeReply) },
+		},
+		{
+			Name:        "DOM.scrollIntoViewIfNeeded",
+			Domain:      "DOM",
+			Description: "Scrolls the specified rect of the given node into view if not already visible.\nNote: exactly one between nodeId, backendNodeId and objectId should be passed\nto identify the node.",
+			Args:        reflect.TypeOf(dom.ScrollIntoViewIfNeededArgs{}),
+			NewArgs:     func() interface{} { return new(dom.ScrollIntoViewIfNeededArgs) },
+		},
+		{
+			Name:        "DOM.disable",
+			Domain:      "DOM",
+			Description: "Disables DOM agent for the given page.",
+		},
+		{
+			Name:         "DOM.discardSearchResults",
+			Domain:       "DOM",
+			Description:  "Discards search results from the session with the given id. `getSearchResults` should no longer\nbe called for that search.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.DiscardSearchResultsArgs{}),
+			NewArgs:      func() interface{} { return new(dom.DiscardSearchResultsArgs) },
+		},
+		{
+			Name:        "DOM.enable",
+			Domain:      "DOM",
+			Description: "Enables DOM agent for the given page.",
+			Args:        reflect.TypeOf(dom.EnableArgs{}),
+			NewArgs:     func() interface{} { return new(dom.EnableArgs) },
+		},
+		{
+			Name:        "DOM.focus",
+			Domain:      "DOM",
+			Description: "Focuses the given element.",
+			Args:        reflect.TypeOf(dom.FocusArgs{}),
+			NewArgs:     func() interface{} { return new(dom.FocusArgs) },
+		},
+		{
+			Name:        "DOM.getAttributes",
+			Domain:      "DOM",
+			Description: "Returns attributes for the specified node.",
+			Args:        reflect.TypeOf(dom.GetAttributesArgs{}),
+			NewArgs:     func() interface{} { return new(dom.GetAttributesArgs) },
+			Reply:       reflect.TypeOf(dom.GetAttributesReply{}),
+			NewReply:    func() interface{} { return new(dom.GetAttributesReply) },
+		},
+		{
+			Name:        "DOM.getBoxModel",
+			Domain:      "DOM",
+			Description: "Returns boxes for the given node.",
+			Args:        reflect.TypeOf(dom.GetBoxModelArgs{}),
+			NewArgs:     func() interface{} { return new(dom.GetBoxModelArgs) },
+			Reply:       reflect.TypeOf(dom.GetBoxModelReply{}),
+			NewReply:    func() interface{} { return new(dom.GetBoxModelReply) },
+		},
+		{
+			Name:         "DOM.getContentQuads",
+			Domain:       "DOM",
+			Description:  "Returns quads that describe node position on the page. This method\nmight return multiple quads for inline nodes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetContentQuadsArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetContentQuadsArgs) },
+			Reply:        reflect.TypeOf(dom.GetContentQuadsReply{}),
+			NewReply:     func() interface{} { return new(dom.GetContentQuadsReply) },
+		},
+		{
+			Name:        "DOM.getDocument",
+			Domain:      "DOM",
+			Description: "Returns the root DOM node (and optionally the subtree) to the caller.\nImplicitly enables the DOM domain events for the current target.",
+			Args:        reflect.TypeOf(dom.GetDocumentArgs{}),
+			NewArgs:     func() interface{} { return new(dom.GetDocumentArgs) },
+			Reply:       reflect.TypeOf(dom.GetDocumentReply{}),
+			NewReply:    func() interface{} { return new(dom.GetDocumentReply) },
+		},
+		{
+			Name:        "DOM.getFlattenedDocument",
+			Domain:      "DOM",
+			Description: "Returns the root DOM node (and optionally the subtree) to the caller.\nDeprecated, as it is not designed to work well with the rest of the DOM agent.\nUse DOMSnapshot.captureSnapshot instead.",
+			Deprecated:  true,
+			Args:        reflect.TypeOf(dom.GetFlattenedDocumentArgs{}),
+			NewArgs:     func() interface{} { return new(dom.GetFlattenedDocumentArgs) },
+			Reply:       reflect.TypeOf(dom.GetFlattenedDocumentReply{}),
+			NewReply:    func() interface{} { return new(dom.GetFlattenedDocumentReply) },
+		},
+		{
+			Name:         "DOM.getNodesForSubtreeByStyle",
+			Domain:       "DOM",
+			Description:  "Finds nodes with a given computed style in a subtree.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetNodesForSubtreeByStyleArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetNodesForSubtreeByStyleArgs) },
+			Reply:        reflect.TypeOf(dom.GetNodesForSubtreeByStyleReply{}),
+			NewReply:     func() interface{} { return new(dom.GetNodesForSubtreeByStyleReply) },
+		},
+		{
+			Name:        "DOM.getNodeForLocation",
+			Domain:      "DOM",
+			Description: "Returns node id at given location. Depending on whether DOM domain is enabled, nodeId is\neither returned or not.",
+			Args:        reflect.TypeOf(dom.GetNodeForLocationArgs{}),
+			NewArgs:     func() interface{} { return new(dom.GetNodeForLocationArgs) },
+			Reply:       reflect.TypeOf(dom.GetNodeForLocationReply{}),
+			NewReply:    func() interface{} { return new(dom.GetNodeForLocationReply) },
+		},
+		{
+			Name:        "DOM.getOuterHTML",
+			Domain:      "DOM",
+			Description: "Returns node's HTML markup.",
+			Args:        reflect.TypeOf(dom.GetOuterHTMLArgs{}),
+			NewArgs:     func() interface{} { return new(dom.GetOuterHTMLArgs) },
+			Reply:       reflect.TypeOf(dom.GetOuterHTMLReply{}),
+			NewReply:    func() interface{} { return new(dom.GetOuterHTMLReply) },
+		},
+		{
+			Name:         "DOM.getRelayoutBoundary",
+			Domain:       "DOM",
+			Description:  "Returns the id of the nearest ancestor that is a relayout boundary.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetRelayoutBoundaryArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetRelayoutBoundaryArgs) },
+			Reply:        reflect.TypeOf(dom.GetRelayoutBoundaryReply{}),
+			NewReply:     func() interface{} { return new(dom.GetRelayoutBoundaryReply) },
+		},
+		{
+			Name:         "DOM.getSearchResults",
+			Domain:       "DOM",
+			Description:  "Returns search results from given `fromIndex` to given `toIndex` from the search with the given\nidentifier.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetSearchResultsArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetSearchResultsArgs) },
+			Reply:        reflect.TypeOf(dom.GetSearchResultsReply{}),
+			NewReply:     func() interface{} { return new(dom.GetSearchResultsReply) },
+		},
+		{
+			Name:         "DOM.markUndoableState",
+			Domain:       "DOM",
+			Description:  "Marks last undoable state.",
+			Experimental: true,
+		},
+		{
+			Name:        "DOM.moveTo",
+			Domain:      "DOM",
+			Description: "Moves node into the new container, places it before the given anchor.",
+			Args:        reflect.TypeOf(dom.MoveToArgs{}),
+			NewArgs:     func() interface{} { return new(dom.MoveToArgs) },
+			Reply:       reflect.TypeOf(dom.MoveToReply{}),
+			NewReply:    func() interface{} { return new(dom.MoveToReply) },
+		},
+		{
+			Name:         "DOM.performSearch",
+			Domain:       "DOM",
+			Description:  "Searches for a given string in the DOM tree. Use `getSearchResults` to access search results or\n`cancelSearch` to end this search session.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.PerformSearchArgs{}),
+			NewArgs:      func() interface{} { return new(dom.PerformSearchArgs) },
+			Reply:        reflect.TypeOf(dom.PerformSearchReply{}),
+			NewReply:     func() interface{} { return new(dom.PerformSearchReply) },
+		},
+		{
+			Name:         "DOM.pushNodeByPathToFrontend",
+			Domain:       "DOM",
+			Description:  "Requests that the node is sent to the caller given its path. // FIXME, use XPath",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.PushNodeByPathToFrontendArgs{}),
+			NewArgs:      func() interface{} { return new(dom.PushNodeByPathToFrontendArgs) },
+			Reply:        reflect.TypeOf(dom.PushNodeByPathToFrontendReply{}),
+			NewReply:     func() interface{} { return new(dom.PushNodeByPathToFrontendReply) },
+		},
+		{
+			Name:         "DOM.pushNodesByBackendIdsToFrontend",
+			Domain:       "DOM",
+			Description:  "Requests that a batch of nodes is sent to the caller given their backend node ids.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.PushNodesByBackendIDsToFrontendArgs{}),
+			NewArgs:      func() interface{} { return new(dom.PushNodesByBackendIDsToFrontendArgs) },
+			Reply:        reflect.TypeOf(dom.PushNodesByBackendIDsToFrontendReply{}),
+			NewReply:     func() interface{} { return new(dom.PushNodesByBackendIDsToFrontendReply) },
+		},
+		{
+			Name:        "DOM.querySelector",
+			Domain:      "DOM",
+			Description: "Executes `querySelector` on a given node.",
+			Args:        reflect.TypeOf(dom.QuerySelectorArgs{}),
+			NewArgs:     func() interface{} { return new(dom.QuerySelectorArgs) },
+			Reply:       reflect.TypeOf(dom.QuerySelectorReply{}),
+			NewReply:    func() interface{} { return new(dom.QuerySelectorReply) },
+		},
+		{
+			Name:        "DOM.querySelectorAll",
+			Domain:      "DOM",
+			Description: "Executes `querySelectorAll` on a given node.",
+			Args:        reflect.TypeOf(dom.QuerySelectorAllArgs{}),
+			NewArgs:     func() interface{} { return new(dom.QuerySelectorAllArgs) },
+			Reply:       reflect.TypeOf(dom.QuerySelectorAllReply{}),
+			NewReply:    func() interface{} { return new(dom.QuerySelectorAllReply) },
+		},
+		{
+			Name:         "DOM.getTopLayerElements",
+			Domain:       "DOM",
+			Description:  "Returns NodeIds of current top layer elements.\nTop layer is rendered closest to the user within a viewport, therefore its elements always\nappear on top of all other content.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.GetTopLayerElementsReply{}),
+			NewReply:     func() interface{} { return new(dom.GetTopLayerElementsReply) },
+		},
+		{
+			Name:         "DOM.getElementByRelation",
+			Domain:       "DOM",
+			Description:  "Returns the NodeId of the matched element according to certain relations.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetElementByRelationArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetElementByRelationArgs) },
+			Reply:        reflect.TypeOf(dom.GetElementByRelationReply{}),
+			NewReply:     func() interface{} { return new(dom.GetElementByRelationReply) },
+		},
+		{
+			Name:         "DOM.redo",
+			Domain:       "DOM",
+			Description:  "Re-does the last undone action.",
+			Experimental: true,
+		},
+		{
+			Name:        "DOM.removeAttribute",
+			Domain:      "DOM",
+			Description: "Removes attribute with given name from an element with given id.",
+			Args:        reflect.TypeOf(dom.RemoveAttributeArgs{}),
+			NewArgs:     func() interface{} { return new(dom.RemoveAttributeArgs) },
+		},
+		{
+			Name:        "DOM.removeNode",
+			Domain:      "DOM",
+			Description: "Removes node with given id.",
+			Args:        reflect.TypeOf(dom.RemoveNodeArgs{}),
+			NewArgs:     func() interface{} { return new(dom.RemoveNodeArgs) },
+		},
+		{
+			Name:        "DOM.requestChildNodes",
+			Domain:      "DOM",
+			Description: "Requests that children of the node with given id are returned to the caller in form of\n`setChildNodes` events where not only immediate children are retrieved, but all children down to\nthe specified depth.",
+			Args:        reflect.TypeOf(dom.RequestChildNodesArgs{}),
+			NewArgs:     func() interface{} { return new(dom.RequestChildNodesArgs) },
+		},
+		{
+			Name:        "DOM.requestNode",
+			Domain:      "DOM",
+			Description: "Requests that the node is sent to the caller given the JavaScript node object reference. All\nnodes that form the path from the node to the root are also sent to the client as a series of\n`setChildNodes` notifications.",
+			Args:        reflect.TypeOf(dom.RequestNodeArgs{}),
+			NewArgs:     func() interface{} { return new(dom.RequestNodeArgs) },
+			Reply:       reflect.TypeOf(dom.RequestNodeReply{}),
+			NewReply:    func() interface{} { return new(dom.RequestNodeReply) },
+		},
+		{
+			Name:        "DOM.resolveNode",
+			Domain:      "DOM",
+			Description: "Resolves the JavaScript node object for a given NodeId or BackendNodeId.",
+			Args:        reflect.TypeOf(dom.ResolveNodeArgs{}),
+			NewArgs:     func() interface{} { return new(dom.ResolveNodeArgs) },
+			Reply:       reflect.TypeOf(dom.ResolveNodeReply{}),
+			NewReply:    func() interface{} { return new(dom.ResolveNodeReply) },
+		},
+		{
+			Name:        "DOM.setAttributeValue",
+			Domain:      "DOM",
+			Description: "Sets attribute for an element with given id.",
+			Args:        reflect.TypeOf(dom.SetAttributeValueArgs{}),
+			NewArgs:     func() interface{} { return new(dom.SetAttributeValueArgs) },
+		},
+		{
+			Name:        "DOM.setAttributesAsText",
+			Domain:      "DOM",
+			Description: "Sets attributes on element with given id. This method is useful when user edits some existing\nattribute value and types in several attribute name/value pairs.",
+			Args:        reflect.TypeOf(dom.SetAttributesAsTextArgs{}),
+			NewArgs:     func() interface{} { return new(dom.SetAttributesAsTextArgs) },
+		},
+		{
+			Name:        "DOM.setFileInputFiles",
+			Domain:      "DOM",
+			Description: "Sets files for the given file input element.",
+			Args:        reflect.TypeOf(dom.SetFileInputFilesArgs{}),
+			NewArgs:     func() interface{} { return new(dom.SetFileInputFilesArgs) },
+		},
+		{
+			Name:         "DOM.setNodeStackTracesEnabled",
+			Domain:       "DOM",
+			Description:  "Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.SetNodeStackTracesEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(dom.SetNodeStackTracesEnabledArgs) },
+		},
+		{
+			Name:         "DOM.getNodeStackTraces",
+			Domain:       "DOM",
+			Description:  "Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetNodeStackTracesArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetNodeStackTracesArgs) },
+			Reply:        reflect.TypeOf(dom.GetNodeStackTracesReply{}),
+			NewReply:     func() interface{} { return new(dom.GetNodeStackTracesReply) },
+		},
+		{
+			Name:         "DOM.getFileInfo",
+			Domain:       "DOM",
+			Description:  "Returns file information for the given\nFile wrapper.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetFileInfoArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetFileInfoArgs) },
+			Reply:        reflect.TypeOf(dom.GetFileInfoReply{}),
+			NewReply:     func() interface{} { return new(dom.GetFileInfoReply) },
+		},
+		{
+			Name:         "DOM.getDetachedDomNodes",
+			Domain:       "DOM",
+			Description:  "Returns list of detached nodes",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.GetDetachedDOMNodesReply{}),
+			NewReply:     func() interface{} { return new(dom.GetDetachedDOMNodesReply) },
+		},
+		{
+			Name:         "DOM.setInspectedNode",
+			Domain:       "DOM",
+			Description:  "Enables console to refer to the node with given id via $x (see Command Line API for more details\n$x functions).",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.SetInspectedNodeArgs{}),
+			NewArgs:      func() interface{} { return new(dom.SetInspectedNodeArgs) },
+		},
+		{
+			Name:        "DOM.setNodeName",
+			Domain:      "DOM",
+			Description: "Sets node name for a node with given id.",
+			Args:        reflect.TypeOf(dom.SetNodeNameArgs{}),
+			NewArgs:     func() interface{} { return new(dom.SetNodeNameArgs) },
+			Reply:       reflect.TypeOf(dom.SetNodeNameReply{}),
+			NewReply:    func() interface{} { return new(dom.SetNodeNameReply) },
+		},
+		{
+			Name:        "DOM.setNodeValue",
+			Domain:      "DOM",
+			Description: "Sets node value for a node with given id.",
+			Args:        reflect.TypeOf(dom.SetNodeValueArgs{}),
+			NewArgs:     func() interface{} { return new(dom.SetNodeValueArgs) },
+		},
+		{
+			Name:        "DOM.setOuterHTML",
+			Domain:      "DOM",
+			Description: "Sets node HTML markup, returns new node id.",
+			Args:        reflect.TypeOf(dom.SetOuterHTMLArgs{}),
+			NewArgs:     func() interface{} { return new(dom.SetOuterHTMLArgs) },
+		},
+		{
+			Name:         "DOM.undo",
+			Domain:       "DOM",
+			Description:  "Undoes the last performed action.",
+			Experimental: true,
+		},
+		{
+			Name:         "DOM.getFrameOwner",
+			Domain:       "DOM",
+			Description:  "Returns iframe node that owns iframe with the given domain.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetFrameOwnerArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetFrameOwnerArgs) },
+			Reply:        reflect.TypeOf(dom.GetFrameOwnerReply{}),
+			NewReply:     func() interface{} { return new(dom.GetFrameOwnerReply) },
+		},
+		{
+			Name:         "DOM.getContainerForNode",
+			Domain:       "DOM",
+			Description:  "Returns the query container of the given node based on container query\nconditions: containerName, physical and logical axes, and whether it queries\nscroll-state or anchored elements. If no axes are provided and\nqueriesScrollState is false, the style container is returned, which is the\ndirect parent or the closest element with a matching container-name.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetContainerForNodeArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetContainerForNodeArgs) },
+			Reply:        reflect.TypeOf(dom.GetContainerForNodeReply{}),
+			NewReply:     func() interface{} { return new(dom.GetContainerForNodeReply) },
+		},
+		{
+			Name:         "DOM.getQueryingDescendantsForContainer",
+			Domain:       "DOM",
+			Description:  "Returns the descendants of a container query container that have\ncontainer queries against this container.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetQueryingDescendantsForContainerArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetQueryingDescendantsForContainerArgs) },
+			Reply:        reflect.TypeOf(dom.GetQueryingDescendantsForContainerReply{}),
+			NewReply:     func() interface{} { return new(dom.GetQueryingDescendantsForContainerReply) },
+		},
+		{
+			Name:         "DOM.getAnchorElement",
+			Domain:       "DOM",
+			Description:  "Returns the target anchor element of the given anchor query according to\nhttps://www.w3.org/TR/css-anchor-position-1/#target.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.GetAnchorElementArgs{}),
+			NewArgs:      func() interface{} { return new(dom.GetAnchorElementArgs) },
+			Reply:        reflect.TypeOf(dom.GetAnchorElementReply{}),
+			NewReply:     func() interface{} { return new(dom.GetAnchorElementReply) },
+		},
+		{
+			Name:         "DOM.forceShowPopover",
+			Domain:       "DOM",
+			Description:  "When enabling, this API force-opens the popover identified by nodeId\nand keeps it open until disabled.",
+			Experimental: true,
+			Args:         reflect.TypeOf(dom.ForceShowPopoverArgs{}),
+			NewArgs:      func() interface{} { return new(dom.ForceShowPopoverArgs) },
+			Reply:        reflect.TypeOf(dom.ForceShowPopoverReply{}),
+			NewReply:     func() interface{} { return new(dom.ForceShowPopoverReply) },
+		},
+		{
+			Name:        "DOMDebugger.getEventListeners",
+			Domain:      "DOMDebugger",
+			Description: "Returns event listeners of the given object.",
+			Args:        reflect.TypeOf(domdebugger.GetEventListenersArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.GetEventListenersArgs) },
+			Reply:       reflect.TypeOf(domdebugger.GetEventListenersReply{}),
+			NewReply:    func() interface{} { return new(domdebugger.GetEventListenersReply) },
+		},
+		{
+			Name:        "DOMDebugger.removeDOMBreakpoint",
+			Domain:      "DOMDebugger",
+			Description: "Removes DOM breakpoint that was set using `setDOMBreakpoint`.",
+			Args:        reflect.TypeOf(domdebugger.RemoveDOMBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.RemoveDOMBreakpointArgs) },
+		},
+		{
+			Name:        "DOMDebugger.removeEventListenerBreakpoint",
+			Domain:      "DOMDebugger",
+			Description: "Removes breakpoint on particular DOM event.",
+			Args:        reflect.TypeOf(domdebugger.RemoveEventListenerBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.RemoveEventListenerBreakpointArgs) },
+		},
+		{
+			Name:        "DOMDebugger.removeXHRBreakpoint",
+			Domain:      "DOMDebugger",
+			Description: "Removes breakpoint from XMLHttpRequest.",
+			Args:        reflect.TypeOf(domdebugger.RemoveXHRBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.RemoveXHRBreakpointArgs) },
+		},
+		{
+			Name:         "DOMDebugger.setBreakOnCSPViolation",
+			Domain:       "DOMDebugger",
+			Description:  "Sets breakpoint on particular CSP violations.",
+			Experimental: true,
+			Args:         reflect.TypeOf(domdebugger.SetBreakOnCSPViolationArgs{}),
+			NewArgs:      func() interface{} { return new(domdebugger.SetBreakOnCSPViolationArgs) },
+		},
+		{
+			Name:        "DOMDebugger.setDOMBreakpoint",
+			Domain:      "DOMDebugger",
+			Description: "Sets breakpoint on particular operation with DOM.",
+			Args:        reflect.TypeOf(domdebugger.SetDOMBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.SetDOMBreakpointArgs) },
+		},
+		{
+			Name:        "DOMDebugger.setEventListenerBreakpoint",
+			Domain:      "DOMDebugger",
+			Description: "Sets breakpoint on particular DOM event.",
+			Args:        reflect.TypeOf(domdebugger.SetEventListenerBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.SetEventListenerBreakpointArgs) },
+		},
+		{
+			Name:        "DOMDebugger.setXHRBreakpoint",
+			Domain:      "DOMDebugger",
+			Description: "Sets breakpoint on XMLHttpRequest.",
+			Args:        reflect.TypeOf(domdebugger.SetXHRBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(domdebugger.SetXHRBreakpointArgs) },
+		},
+		{
+			Name:         "DOMSnapshot.disable",
+			Domain:       "DOMSnapshot",
+			Description:  "Disables DOM snapshot agent for the given page.",
+			Experimental: true,
+		},
+		{
+			Name:         "DOMSnapshot.enable",
+			Domain:       "DOMSnapshot",
+			Description:  "Enables DOM snapshot agent for the given page.",
+			Experimental: true,
+		},
+		{
+			Name:         "DOMSnapshot.getSnapshot",
+			Domain:       "DOMSnapshot",
+			Description:  "Returns a document snapshot, including the full DOM tree of the root node (including iframes,\ntemplate contents, and imported documents) in a flattened array, as well as layout and\nwhite-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is\nflattened.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(domsnapshot.GetSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(domsnapshot.GetSnapshotArgs) },
+			Reply:        reflect.TypeOf(domsnapshot.GetSnapshotReply{}),
+			NewReply:     func() interface{} { return new(domsnapshot.GetSnapshotReply) },
+		},
+		{
+			Name:         "DOMSnapshot.captureSnapshot",
+			Domain:       "DOMSnapshot",
+			Description:  "Returns a document snapshot, including the full DOM tree of the root node (including iframes,\ntemplate contents, and imported documents) in a flattened array, as well as layout and\nwhite-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is\nflattened.",
+			Experimental: true,
+			Args:         reflect.TypeOf(domsnapshot.CaptureSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(domsnapshot.CaptureSnapshotArgs) },
+			Reply:        reflect.TypeOf(domsnapshot.CaptureSnapshotReply{}),
+			NewReply:     func() interface{} { return new(domsnapshot.CaptureSnapshotReply) },
+		},
+		{
+			Name:         "DOMStorage.clear",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Args:         reflect.TypeOf(domstorage.ClearArgs{}),
+			NewArgs:      func() interface{} { return new(domstorage.ClearArgs) },
+		},
+		{
+			Name:         "DOMStorage.disable",
+			Domain:       "DOMStorage",
+			Description:  "Disables storage tracking, prevents storage events from being sent to the client.",
+			Experimental: true,
+		},
+		{
+			Name:         "DOMStorage.enable",
+			Domain:       "DOMStorage",
+			Description:  "Enables storage tracking, storage events will now be delivered to the client.",
+			Experimental: true,
+		},
+		{
+			Name:         "DOMStorage.getDOMStorageItems",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Args:         reflect.TypeOf(domstorage.GetDOMStorageItemsArgs{}),
+			NewArgs:      func() interface{} { return new(domstorage.GetDOMStorageItemsArgs) },
+			Reply:        reflect.TypeOf(domstorage.GetDOMStorageItemsReply{}),
+			NewReply:     func() interface{} { return new(domstorage.GetDOMStorageItemsReply) },
+		},
+		{
+			Name:         "DOMStorage.removeDOMStorageItem",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Args:         reflect.TypeOf(domstorage.RemoveDOMStorageItemArgs{}),
+			NewArgs:      func() interface{} { return new(domstorage.RemoveDOMStorageItemArgs) },
+		},
+		{
+			Name:         "DOMStorage.setDOMStorageItem",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Args:         reflect.TypeOf(domstorage.SetDOMStorageItemArgs{}),
+			NewArgs:      func() interface{} { return new(domstorage.SetDOMStorageItemArgs) },
+		},
+		{
+			Name:        "Debugger.continueToLocation",
+			Domain:      "Debugger",
+			Description: "Continues execution until specific location is reached.",
+			Args:        reflect.TypeOf(debugger.ContinueToLocationArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.ContinueToLocationArgs) },
+		},
+		{
+			Name:        "Debugger.disable",
+			Domain:      "Debugger",
+			Description: "Disables debugger for given page.",
+		},
+		{
+			Name:        "Debugger.enable",
+			Domain:      "Debugger",
+			Description: "Enables debugger for the given page. Clients should not assume that the debugging has been\nenabled until the result for this command is received.",
+			Args:        reflect.TypeOf(debugger.EnableArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.EnableArgs) },
+			Reply:       reflect.TypeOf(debugger.EnableReply{}),
+			NewReply:    func() interface{} { return new(debugger.EnableReply) },
+		},
+		{
+			Name:        "Debugger.evaluateOnCallFrame",
+			Domain:      "Debugger",
+			Description: "Evaluates expression on a given call frame.",
+			Args:        reflect.TypeOf(debugger.EvaluateOnCallFrameArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.EvaluateOnCallFrameArgs) },
+			Reply:       reflect.TypeOf(debugger.EvaluateOnCallFrameReply{}),
+			NewReply:    func() interface{} { return new(debugger.EvaluateOnCallFrameReply) },
+		},
+		{
+			Name:        "Debugger.getPossibleBreakpoints",
+			Domain:      "Debugger",
+			Description: "Returns possible locations for breakpoint. scriptId in start and end range locations should be\nthe same.",
+			Args:        reflect.TypeOf(debugger.GetPossibleBreakpointsArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.GetPossibleBreakpointsArgs) },
+			Reply:       reflect.TypeOf(debugger.GetPossibleBreakpointsReply{}),
+			NewReply:    func() interface{} { return new(debugger.GetPossibleBreakpointsReply) },
+		},
+		{
+			Name:        "Debugger.getScriptSource",
+			Domain:      "Debugger",
+			Description: "Returns source for the script with given id.",
+			Args:        reflect.TypeOf(debugger.GetScriptSourceArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.GetScriptSourceArgs) },
+			Reply:       reflect.TypeOf(debugger.GetScriptSourceReply{}),
+			NewReply:    func() interface{} { return new(debugger.GetScriptSourceReply) },
+		},
+		{
+			Name:         "Debugger.disassembleWasmModule",
+			Domain:       "Debugger",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.DisassembleWASMModuleArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.DisassembleWASMModuleArgs) },
+			Reply:        reflect.TypeOf(debugger.DisassembleWASMModuleReply{}),
+			NewReply:     func() interface{} { return new(debugger.DisassembleWASMModuleReply) },
+		},
+		{
+			Name:         "Debugger.nextWasmDisassemblyChunk",
+			Domain:       "Debugger",
+			Description:  "Disassemble the next chunk of lines for the module corresponding to the\nstream. If disassembly is complete, this API will invalidate the streamId\nand return an empty chunk. Any subsequent calls for the now invalid stream\nwill return errors.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.NextWASMDisassemblyChunkArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.NextWASMDisassemblyChunkArgs) },
+			Reply:        reflect.TypeOf(debugger.NextWASMDisassemblyChunkReply{}),
+			NewReply:     func() interface{} { return new(debugger.NextWASMDisassemblyChunkReply) },
+		},
+		{
+			Name:        "Debugger.getWasmBytecode",
+			Domain:      "Debugger",
+			Description: "This command is deprecated. Use getScriptSource instead.",
+			Deprecated:  true,
+			Args:        reflect.TypeOf(debugger.GetWASMBytecodeArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.GetWASMBytecodeArgs) },
+			Reply:       reflect.TypeOf(debugger.GetWASMBytecodeReply{}),
+			NewReply:    func() interface{} { return new(debugger.GetWASMBytecodeReply) },
+		},
+		{
+			Name:         "Debugger.getStackTrace",
+			Domain:       "Debugger",
+			Description:  "Returns stack trace with given `stackTraceId`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.GetStackTraceArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.GetStackTraceArgs) },
+			Reply:        reflect.TypeOf(debugger.GetStackTraceReply{}),
+			NewReply:     func() interface{} { return new(debugger.GetStackTraceReply) },
+		},
+		{
+			Name:        "Debugger.pause",
+			Domain:      "Debugger",
+			Description: "Stops on the next JavaScript statement.",
+		},
+		{
+			Name:         "Debugger.pauseOnAsyncCall",
+			Domain:       "Debugger",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(debugger.PauseOnAsyncCallArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.PauseOnAsyncCallArgs) },
+		},
+		{
+			Name:        "Debugger.removeBreakpoint",
+			Domain:      "Debugger",
+			Description: "Removes JavaScript breakpoint.",
+			Args:        reflect.TypeOf(debugger.RemoveBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.RemoveBreakpointArgs) },
+		},
+		{
+			Name:        "Debugger.restartFrame",
+			Domain:      "Debugger",
+			Description: "Restarts particular call frame from the beginning. The old, deprecated\nbehavior of `restartFrame` is to stay paused and allow further CDP commands\nafter a restart was scheduled. This can cause problems with restarting, so\nwe now continue execution immediatly after it has been scheduled until we\nreach the beginning of the restarted frame.\n\nTo stay back-wards compatible, `restartFrame` now expects a `mode`\nparameter to be present. If the `mode` parameter is missing, `restartFrame`\nerrors out.\n\nThe various return values are deprecated and `callFrames` is always empty.\nUse the call frames from the `Debugger#paused` events instead, that fires\nonce V8 pauses at the beginning of the restarted function.",
+			Args:        reflect.TypeOf(debugger.RestartFrameArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.RestartFrameArgs) },
+			Reply:       reflect.TypeOf(debugger.RestartFrameReply{}),
+			NewReply:    func() interface{} { return new(debugger.RestartFrameReply) },
+		},
+		{
+			Name:        "Debugger.resume",
+			Domain:      "Debugger",
+			Description: "Resumes JavaScript execution.",
+			Args:        reflect.TypeOf(debugger.ResumeArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.ResumeArgs) },
+		},
+		{
+			Name:        "Debugger.searchInContent",
+			Domain:      "Debugger",
+			Description: "Searches for given string in script content.",
+			Args:        reflect.TypeOf(debugger.SearchInContentArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SearchInContentArgs) },
+			Reply:       reflect.TypeOf(debugger.SearchInContentReply{}),
+			NewReply:    func() interface{} { return new(debugger.SearchInContentReply) },
+		},
+		{
+			Name:        "Debugger.setAsyncCallStackDepth",
+			Domain:      "Debugger",
+			Description: "Enables or disables async call stacks tracking.",
+			Args:        reflect.TypeOf(debugger.SetAsyncCallStackDepthArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetAsyncCallStackDepthArgs) },
+		},
+		{
+			Name:         "Debugger.setBlackboxExecutionContexts",
+			Domain:       "Debugger",
+			Description:  "Replace previous blackbox execution contexts with passed ones. Forces backend to skip\nstepping/pausing in scripts in these execution contexts. VM will try to leave blackboxed script by\nperforming 'step in' several times, finally resorting to 'step out' if unsuccessful.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.SetBlackboxExecutionContextsArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.SetBlackboxExecutionContextsArgs) },
+		},
+		{
+			Name:         "Debugger.setBlackboxPatterns",
+			Domain:       "Debugger",
+			Description:  "Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in\nscripts with url matching one of the patterns. VM will try to leave blackboxed script by\nperforming 'step in' several times, finally resorting to 'step out' if unsuccessful.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.SetBlackboxPatternsArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.SetBlackboxPatternsArgs) },
+		},
+		{
+			Name:         "Debugger.setBlackboxedRanges",
+			Domain:       "Debugger",
+			Description:  "Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted\nscripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.\nPositions array contains positions where blackbox state is changed. First interval isn't\nblackboxed. Array should be sorted.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.SetBlackboxedRangesArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.SetBlackboxedRangesArgs) },
+		},
+		{
+			Name:        "Debugger.setBreakpoint",
+			Domain:      "Debugger",
+			Description: "Sets JavaScript breakpoint at a given location.",
+			Args:        reflect.TypeOf(debugger.SetBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetBreakpointArgs) },
+			Reply:       reflect.TypeOf(debugger.SetBreakpointReply{}),
+			NewReply:    func() interface{} { return new(debugger.SetBreakpointReply) },
+		},
+		{
+			Name:        "Debugger.setInstrumentationBreakpoint",
+			Domain:      "Debugger",
+			Description: "Sets instrumentation breakpoint.",
+			Args:        reflect.TypeOf(debugger.SetInstrumentationBreakpointArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetInstrumentationBreakpointArgs) },
+			Reply:       reflect.TypeOf(debugger.SetInstrumentationBreakpointReply{}),
+			NewReply:    func() interface{} { return new(debugger.SetInstrumentationBreakpointReply) },
+		},
+		{
+			Name:        "Debugger.setBreakpointByUrl",
+			Domain:      "Debugger",
+			Description: "Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this\ncommand is issued, all existing parsed scripts will have breakpoints resolved and returned in\n`locations` property. Further matching script parsing will result in subsequent\n`breakpointResolved` events issued. This logical breakpoint will survive page reloads.",
+			Args:        reflect.TypeOf(debugger.SetBreakpointByURLArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetBreakpointByURLArgs) },
+			Reply:       reflect.TypeOf(debugger.SetBreakpointByURLReply{}),
+			NewReply:    func() interface{} { return new(debugger.SetBreakpointByURLReply) },
+		},
+		{
+			Name:         "Debugger.setBreakpointOnFunctionCall",
+			Domain:       "Debugger",
+			Description:  "Sets JavaScript breakpoint before each call to the given function.\nIf another function was created from the same source as a given one,\ncalling it will also trigger the breakpoint.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.SetBreakpointOnFunctionCallArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.SetBreakpointOnFunctionCallArgs) },
+			Reply:        reflect.TypeOf(debugger.SetBreakpointOnFunctionCallReply{}),
+			NewReply:     func() interface{} { return new(debugger.SetBreakpointOnFunctionCallReply) },
+		},
+		{
+			Name:        "Debugger.setBreakpointsActive",
+			Domain:      "Debugger",
+			Description: "Activates / deactivates all breakpoints on the page.",
+			Args:        reflect.TypeOf(debugger.SetBreakpointsActiveArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetBreakpointsActiveArgs) },
+		},
+		{
+			Name:        "Debugger.setPauseOnExceptions",
+			Domain:      "Debugger",
+			Description: "Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions,\nor caught exceptions, no exceptions. Initial pause on exceptions state is `none`.",
+			Args:        reflect.TypeOf(debugger.SetPauseOnExceptionsArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetPauseOnExceptionsArgs) },
+		},
+		{
+			Name:         "Debugger.setReturnValue",
+			Domain:       "Debugger",
+			Description:  "Changes return value in top frame. Available only at return break position.",
+			Experimental: true,
+			Args:         reflect.TypeOf(debugger.SetReturnValueArgs{}),
+			NewArgs:      func() interface{} { return new(debugger.SetReturnValueArgs) },
+		},
+		{
+			Name:        "Debugger.setScriptSource",
+			Domain:      "Debugger",
+			Description: "Edits JavaScript source live.\n\nIn general, functions that are currently on the stack can not be edited with\na single exception: If the edited function is the top-most stack frame and\nthat is the only activation of that function on the stack. In this case\nthe live edit will be successful and a `Debugger.restartFrame` for the\ntop-most function is automatically triggered.",
+			Args:        reflect.TypeOf(debugger.SetScriptSourceArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetScriptSourceArgs) },
+			Reply:       reflect.TypeOf(debugger.SetScriptSourceReply{}),
+			NewReply:    func() interface{} { return new(debugger.SetScriptSourceReply) },
+		},
+		{
+			Name:        "Debugger.setSkipAllPauses",
+			Domain:      "Debugger",
+			Description: "Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).",
+			Args:        reflect.TypeOf(debugger.SetSkipAllPausesArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetSkipAllPausesArgs) },
+		},
+		{
+			Name:        "Debugger.setVariableValue",
+			Domain:      "Debugger",
+			Description: "Changes value of variable in a callframe. Object-based scopes are not supported and must be\nmutated manually.",
+			Args:        reflect.TypeOf(debugger.SetVariableValueArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.SetVariableValueArgs) },
+		},
+		{
+			Name:        "Debugger.stepInto",
+			Domain:      "Debugger",
+			Description: "Steps into the function call.",
+			Args:        reflect.TypeOf(debugger.StepIntoArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.StepIntoArgs) },
+		},
+		{
+			Name:        "Debugger.stepOut",
+			Domain:      "Debugger",
+			Description: "Steps out of the function call.",
+		},
+		{
+			Name:        "Debugger.stepOver",
+			Domain:      "Debugger",
+			Description: "Steps over the statement.",
+			Args:        reflect.TypeOf(debugger.StepOverArgs{}),
+			NewArgs:     func() interface{} { return new(debugger.StepOverArgs) },
+		},
+		{
+			Name:         "DeviceAccess.enable",
+			Domain:       "DeviceAccess",
+			Description:  "Enable events in this domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "DeviceAccess.disable",
+			Domain:       "DeviceAccess",
+			Description:  "Disable events in this domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "DeviceAccess.selectPrompt",
+			Domain:       "DeviceAccess",
+			Description:  "Select a device in response to a DeviceAccess.deviceRequestPrompted event.",
+			Experimental: true,
+			Args:         reflect.TypeOf(deviceaccess.SelectPromptArgs{}),
+			NewArgs:      func() interface{} { return new(deviceaccess.SelectPromptArgs) },
+		},
+		{
+			Name:         "DeviceAccess.cancelPrompt",
+			Domain:       "DeviceAccess",
+			Description:  "Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.",
+			Experimental: true,
+			Args:         reflect.TypeOf(deviceaccess.CancelPromptArgs{}),
+			NewArgs:      func() interface{} { return new(deviceaccess.CancelPromptArgs) },
+		},
+		{
+			Name:         "DeviceOrientation.clearDeviceOrientationOverride",
+			Domain:       "DeviceOrientation",
+			Description:  "Clears the overridden Device Orientation.",
+			Experimental: true,
+		},
+		{
+			Name:         "DeviceOrientation.setDeviceOrientationOverride",
+			Domain:       "DeviceOrientation",
+			Description:  "Overrides the Device Orientation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(deviceorientation.SetDeviceOrientationOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(deviceorientation.SetDeviceOrientationOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.canEmulate",
+			Domain:      "Emulation",
+			Description: "Tells whether emulation is supported.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(emulation.CanEmulateReply{}),
+			NewReply:    func() interface{} { return new(emulation.CanEmulateReply) },
+		},
+		{
+			Name:        "Emulation.clearDeviceMetricsOverride",
+			Domain:      "Emulation",
+			Description: "Clears the overridden device metrics.",
+		},
+		{
+			Name:        "Emulation.clearGeolocationOverride",
+			Domain:      "Emulation",
+			Description: "Clears the overridden Geolocation Position and Error.",
+		},
+		{
+			Name:         "Emulation.resetPageScaleFactor",
+			Domain:       "Emulation",
+			Description:  "Requests that page scale factor is reset to initial values.",
+			Experimental: true,
+		},
+		{
+			Name:         "Emulation.setFocusEmulationEnabled",
+			Domain:       "Emulation",
+			Description:  "Enables or disables simulating a focused and active page.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetFocusEmulationEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetFocusEmulationEnabledArgs) },
+		},
+		{
+			Name:         "Emulation.setAutoDarkModeOverride",
+			Domain:       "Emulation",
+			Description:  "Automatically render all web contents using a dark theme.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetAutoDarkModeOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetAutoDarkModeOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.setCPUThrottlingRate",
+			Domain:      "Emulation",
+			Description: "Enables CPU throttling to emulate slow CPUs.",
+			Args:        reflect.TypeOf(emulation.SetCPUThrottlingRateArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetCPUThrottlingRateArgs) },
+		},
+		{
+			Name:        "Emulation.setDefaultBackgroundColorOverride",
+			Domain:      "Emulation",
+			Description: "Sets or clears an override of the default background color of the frame. This override is used\nif the content does not specify one.",
+			Args:        reflect.TypeOf(emulation.SetDefaultBackgroundColorOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetDefaultBackgroundColorOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setSafeAreaInsetsOverride",
+			Domain:       "Emulation",
+			Description:  "Overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*). Unset values will cause the\nrespective variables to be undefined, even if previously overridden.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetSafeAreaInsetsOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetSafeAreaInsetsOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.setDeviceMetricsOverride",
+			Domain:      "Emulation",
+			Description: "Overrides the values of device screen dimensions (window.screen.width, window.screen.height,\nwindow.innerWidth, window.innerHeight, and \"device-width\"/\"device-height\"-related CSS media\nquery results).",
+			Args:        reflect.TypeOf(emulation.SetDeviceMetricsOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetDeviceMetricsOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setDevicePostureOverride",
+			Domain:       "Emulation",
+			Description:  "Start reporting the given posture value to the Device Posture API.\nThis override can also be set in setDeviceMetricsOverride().",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetDevicePostureOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetDevicePostureOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.clearDevicePostureOverride",
+			Domain:       "Emulation",
+			Description:  "Clears a device posture override set with either setDeviceMetricsOverride()\nor setDevicePostureOverride() and starts using posture information from the\nplatform again.\nDoes nothing if no override is set.",
+			Experimental: true,
+		},
+		{
+			Name:         "Emulation.setDisplayFeaturesOverride",
+			Domain:       "Emulation",
+			Description:  "Start using the given display features to pupulate the Viewport Segments API.\nThis override can also be set in setDeviceMetricsOverride().",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetDisplayFeaturesOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetDisplayFeaturesOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.clearDisplayFeaturesOverride",
+			Domain:       "Emulation",
+			Description:  "Clears the display features override set with either setDeviceMetricsOverride()\nor setDisplayFeaturesOverride() and starts using display features from the\nplatform again.\nDoes nothing if no override is set.",
+			Experimental: true,
+		},
+		{
+			Name:         "Emulation.setScrollbarsHidden",
+			Domain:       "Emulation",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetScrollbarsHiddenArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetScrollbarsHiddenArgs) },
+		},
+		{
+			Name:         "Emulation.setDocumentCookieDisabled",
+			Domain:       "Emulation",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetDocumentCookieDisabledArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetDocumentCookieDisabledArgs) },
+		},
+		{
+			Name:         "Emulation.setEmitTouchEventsForMouse",
+			Domain:       "Emulation",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetEmitTouchEventsForMouseArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetEmitTouchEventsForMouseArgs) },
+		},
+		{
+			Name:        "Emulation.setEmulatedMedia",
+			Domain:      "Emulation",
+			Description: "Emulates the given media type or media feature for CSS media queries.",
+			Args:        reflect.TypeOf(emulation.SetEmulatedMediaArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetEmulatedMediaArgs) },
+		},
+		{
+			Name:        "Emulation.setEmulatedVisionDeficiency",
+			Domain:      "Emulation",
+			Description: "Emulates the given vision deficiency.",
+			Args:        reflect.TypeOf(emulation.SetEmulatedVisionDeficiencyArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetEmulatedVisionDeficiencyArgs) },
+		},
+		{
+			Name:        "Emulation.setEmulatedOSTextScale",
+			Domain:      "Emulation",
+			Description: "Emulates the given OS text scale.",
+			Args:        reflect.TypeOf(emulation.SetEmulatedOSTextScaleArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetEmulatedOSTextScaleArgs) },
+		},
+		{
+			Name:        "Emulation.setGeolocationOverride",
+			Domain:      "Emulation",
+			Description: "Overrides the Geolocation Position or Error. Omitting latitude, longitude or\naccuracy emulates position unavailable.",
+			Args:        reflect.TypeOf(emulation.SetGeolocationOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetGeolocationOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.getOverriddenSensorInformation",
+			Domain:       "Emulation",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.GetOverriddenSensorInformationArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.GetOverriddenSensorInformationArgs) },
+			Reply:        reflect.TypeOf(emulation.GetOverriddenSensorInformationReply{}),
+			NewReply:     func() interface{} { return new(emulation.GetOverriddenSensorInformationReply) },
+		},
+		{
+			Name:         "Emulation.setSensorOverrideEnabled",
+			Domain:       "Emulation",
+			Description:  "Overrides a platform sensor of a given type. If |enabled| is true, calls to\nSensor.start() will use a virtual sensor as backend rather than fetching\ndata from a real hardware sensor. Otherwise, existing virtual\nsensor-backend Sensor objects will fire an error event and new calls to\nSensor.start() will attempt to use a real sensor instead.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetSensorOverrideEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetSensorOverrideEnabledArgs) },
+		},
+		{
+			Name:         "Emulation.setSensorOverrideReadings",
+			Domain:       "Emulation",
+			Description:  "Updates the sensor readings reported by a sensor type previously overridden\nby setSensorOverrideEnabled.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetSensorOverrideReadingsArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetSensorOverrideReadingsArgs) },
+		},
+		{
+			Name:         "Emulation.setPressureSourceOverrideEnabled",
+			Domain:       "Emulation",
+			Description:  "Overrides a pressure source of a given type, as used by the Compute\nPressure API, so that updates to PressureObserver.observe() are provided\nvia setPressureStateOverride instead of being retrieved from\nplatform-provided telemetry data.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetPressureSourceOverrideEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetPressureSourceOverrideEnabledArgs) },
+		},
+		{
+			Name:         "Emulation.setPressureStateOverride",
+			Domain:       "Emulation",
+			Description:  "TODO: OBSOLETE: To remove when setPressureDataOverride is merged.\nProvides a given pressure state that will be processed and eventually be\ndelivered to PressureObserver users. |source| must have been previously\noverridden by setPressureSourceOverrideEnabled.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetPressureStateOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetPressureStateOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setPressureDataOverride",
+			Domain:       "Emulation",
+			Description:  "Provides a given pressure data set that will be processed and eventually be\ndelivered to PressureObserver users. |source| must have been previously\noverridden by setPressureSourceOverrideEnabled.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetPressureDataOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetPressureDataOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.setIdleOverride",
+			Domain:      "Emulation",
+			Description: "Overrides the Idle state.",
+			Args:        reflect.TypeOf(emulation.SetIdleOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetIdleOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.clearIdleOverride",
+			Domain:      "Emulation",
+			Description: "Clears Idle state overrides.",
+		},
+		{
+			Name:         "Emulation.setNavigatorOverrides",
+			Domain:       "Emulation",
+			Description:  "Overrides value returned by the javascript navigator object.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(emulation.SetNavigatorOverridesArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetNavigatorOverridesArgs) },
+		},
+		{
+			Name:         "Emulation.setPageScaleFactor",
+			Domain:       "Emulation",
+			Description:  "Sets a specified page scale factor.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetPageScaleFactorArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetPageScaleFactorArgs) },
+		},
+		{
+			Name:        "Emulation.setScriptExecutionDisabled",
+			Domain:      "Emulation",
+			Description: "Switches script execution in the page.",
+			Args:        reflect.TypeOf(emulation.SetScriptExecutionDisabledArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetScriptExecutionDisabledArgs) },
+		},
+		{
+			Name:        "Emulation.setTouchEmulationEnabled",
+			Domain:      "Emulation",
+			Description: "Enables touch on platforms which do not support them.",
+			Args:        reflect.TypeOf(emulation.SetTouchEmulationEnabledArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetTouchEmulationEnabledArgs) },
+		},
+		{
+			Name:         "Emulation.setVirtualTimePolicy",
+			Domain:       "Emulation",
+			Description:  "Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets\nthe current virtual time policy.  Note this supersedes any previous time budget.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetVirtualTimePolicyArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetVirtualTimePolicyArgs) },
+			Reply:        reflect.TypeOf(emulation.SetVirtualTimePolicyReply{}),
+			NewReply:     func() interface{} { return new(emulation.SetVirtualTimePolicyReply) },
+		},
+		{
+			Name:         "Emulation.setLocaleOverride",
+			Domain:       "Emulation",
+			Description:  "Overrides default host system locale with the specified one.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetLocaleOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetLocaleOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.setTimezoneOverride",
+			Domain:      "Emulation",
+			Description: "Overrides default host system timezone with the specified one.",
+			Args:        reflect.TypeOf(emulation.SetTimezoneOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetTimezoneOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setVisibleSize",
+			Domain:       "Emulation",
+			Description:  "Resizes the frame/viewport of the page. Note that this does not affect the frame's container\n(e.g. browser window). Can be used to produce screenshots of the specified size. Not supported\non Android.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(emulation.SetVisibleSizeArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetVisibleSizeArgs) },
+		},
+		{
+			Name:         "Emulation.setDisabledImageTypes",
+			Domain:       "Emulation",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetDisabledImageTypesArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetDisabledImageTypesArgs) },
+		},
+		{
+			Name:         "Emulation.setDataSaverOverride",
+			Domain:       "Emulation",
+			Description:  "Override the value of navigator.connection.saveData",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetDataSaverOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetDataSaverOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setHardwareConcurrencyOverride",
+			Domain:       "Emulation",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetHardwareConcurrencyOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetHardwareConcurrencyOverrideArgs) },
+		},
+		{
+			Name:        "Emulation.setUserAgentOverride",
+			Domain:      "Emulation",
+			Description: "Allows overriding user agent with the given string.\n`userAgentMetadata` must be set for Client Hint headers to be sent.",
+			Args:        reflect.TypeOf(emulation.SetUserAgentOverrideArgs{}),
+			NewArgs:     func() interface{} { return new(emulation.SetUserAgentOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setAutomationOverride",
+			Domain:       "Emulation",
+			Description:  "Allows overriding the automation flag.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetAutomationOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetAutomationOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.setSmallViewportHeightDifferenceOverride",
+			Domain:       "Emulation",
+			Description:  "Allows overriding the difference between the small and large viewport sizes, which determine the\nvalue of the `svh` and `lvh` unit, respectively. Only supported for top-level frames.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.SetSmallViewportHeightDifferenceOverrideArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.SetSmallViewportHeightDifferenceOverrideArgs) },
+		},
+		{
+			Name:         "Emulation.getScreenInfos",
+			Domain:       "Emulation",
+			Description:  "Returns device's screen configuration.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(emulation.GetScreenInfosReply{}),
+			NewReply:     func() interface{} { return new(emulation.GetScreenInfosReply) },
+		},
+		{
+			Name:         "Emulation.addScreen",
+			Domain:       "Emulation",
+			Description:  "Add a new screen to the device. Only supported in headless mode.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.AddScreenArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.AddScreenArgs) },
+			Reply:        reflect.TypeOf(emulation.AddScreenReply{}),
+			NewReply:     func() interface{} { return new(emulation.AddScreenReply) },
+		},
+		{
+			Name:         "Emulation.removeScreen",
+			Domain:       "Emulation",
+			Description:  "Remove screen from the device. Only supported in headless mode.",
+			Experimental: true,
+			Args:         reflect.TypeOf(emulation.RemoveScreenArgs{}),
+			NewArgs:      func() interface{} { return new(emulation.RemoveScreenArgs) },
+		},
+		{
+			Name:         "EventBreakpoints.setInstrumentationBreakpoint",
+			Domain:       "EventBreakpoints",
+			Description:  "Sets breakpoint on particular native event.",
+			Experimental: true,
+			Args:         reflect.TypeOf(eventbreakpoints.SetInstrumentationBreakpointArgs{}),
+			NewArgs:      func() interface{} { return new(eventbreakpoints.SetInstrumentationBreakpointArgs) },
+		},
+		{
+			Name:         "EventBreakpoints.removeInstrumentationBreakpoint",
+			Domain:       "EventBreakpoints",
+			Description:  "Removes breakpoint on particular native event.",
+			Experimental: true,
+			Args:         reflect.TypeOf(eventbreakpoints.RemoveInstrumentationBreakpointArgs{}),
+			NewArgs:      func() interface{} { return new(eventbreakpoints.RemoveInstrumentationBreakpointArgs) },
+		},
+		{
+			Name:         "EventBreakpoints.disable",
+			Domain:       "EventBreakpoints",
+			Description:  "Removes all breakpoints",
+			Experimental: true,
+		},
+		{
+			Name:         "Extensions.loadUnpacked",
+			Domain:       "Extensions",
+			Description:  "Installs an unpacked extension from the filesystem similar to\n--load-extension CLI flags. Returns extension ID once the extension\nhas been installed. Available if the client is connected using the\n--remote-debugging-pipe flag and the --enable-unsafe-extension-debugging\nflag is set.",
+			Experimental: true,
+			Args:         reflect.TypeOf(extensions.LoadUnpackedArgs{}),
+			NewArgs:      func() interface{} { return new(extensions.LoadUnpackedArgs) },
+			Reply:        reflect.TypeOf(extensions.LoadUnpackedReply{}),
+			NewReply:     func() interface{} { return new(extensions.LoadUnpackedReply) },
+		},
+		{
+			Name:         "Extensions.uninstall",
+			Domain:       "Extensions",
+			Description:  "Uninstalls an unpacked extension (others not supported) from the profile.\nAvailable if the client is connected using the --remote-debugging-pipe flag\nand the --enable-unsafe-extension-debugging.",
+			Experimental: true,
+			Args:         reflect.TypeOf(extensions.UninstallArgs{}),
+			NewArgs:      func() interface{} { return new(extensions.UninstallArgs) },
+		},
+		{
+			Name:         "Extensions.getStorageItems",
+			Domain:       "Extensions",
+			Description:  "Gets data from extension storage in the given `storageArea`. If `keys` is\nspecified, these are used to filter the result.",
+			Experimental: true,
+			Args:         reflect.TypeOf(extensions.GetStorageItemsArgs{}),
+			NewArgs:      func() interface{} { return new(extensions.GetStorageItemsArgs) },
+			Reply:        reflect.TypeOf(extensions.GetStorageItemsReply{}),
+			NewReply:     func() interface{} { return new(extensions.GetStorageItemsReply) },
+		},
+		{
+			Name:         "Extensions.removeStorageItems",
+			Domain:       "Extensions",
+			Description:  "Removes `keys` from extension storage in the given `storageArea`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(extensions.RemoveStorageItemsArgs{}),
+			NewArgs:      func() interface{} { return new(extensions.RemoveStorageItemsArgs) },
+		},
+		{
+			Name:         "Extensions.clearStorageItems",
+			Domain:       "Extensions",
+			Description:  "Clears extension storage in the given `storageArea`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(extensions.ClearStorageItemsArgs{}),
+			NewArgs:      func() interface{} { return new(extensions.ClearStorageItemsArgs) },
+		},
+		{
+			Name:         "Extensions.setStorageItems",
+			Domain:       "Extensions",
+			Description:  "Sets `values` in extension storage in the given `storageArea`. The provided `values`\nwill be merged with existing values in the storage area.",
+			Experimental: true,
+			Args:         reflect.TypeOf(extensions.SetStorageItemsArgs{}),
+			NewArgs:      func() interface{} { return new(extensions.SetStorageItemsArgs) },
+		},
+		{
+			Name:         "FedCm.enable",
+			Domain:       "FedCm",
+			Experimental: true,
+			Args:         reflect.TypeOf(fedcm.EnableArgs{}),
+			NewArgs:      func() interface{} { return new(fedcm.EnableArgs) },
+		},
+		{
+			Name:         "FedCm.disable",
+			Domain:       "FedCm",
+			Experimental: true,
+		},
+		{
+			Name:         "FedCm.selectAccount",
+			Domain:       "FedCm",
+			Experimental: true,
+			Args:         reflect.TypeOf(fedcm.SelectAccountArgs{}),
+			NewArgs:      func() interface{} { return new(fedcm.SelectAccountArgs) },
+		},
+		{
+			Name:         "FedCm.clickDialogButton",
+			Domain:       "FedCm",
+			Experimental: true,
+			Args:         reflect.TypeOf(fedcm.ClickDialogButtonArgs{}),
+			NewArgs:      func() interface{} { return new(fedcm.ClickDialogButtonArgs) },
+		},
+		{
+			Name:         "FedCm.openUrl",
+			Domain:       "FedCm",
+			Experimental: true,
+			Args:         reflect.TypeOf(fedcm.OpenURLArgs{}),
+			NewArgs:      func() interface{} { return new(fedcm.OpenURLArgs) },
+		},
+		{
+			Name:         "FedCm.dismissDialog",
+			Domain:       "FedCm",
+			Experimental: true,
+			Args:         reflect.TypeOf(fedcm.DismissDialogArgs{}),
+			NewArgs:      func() interface{} { return new(fedcm.DismissDialogArgs) },
+		},
+		{
+			Name:         "FedCm.resetCooldown",
+			Domain:       "FedCm",
+			Description:  "Resets the cooldown time, if any, to allow the next FedCM call to show\na dialog even if one was recently dismissed by the user.",
+			Experimental: true,
+		},
+		{
+			Name:        "Fetch.disable",
+			Domain:      "Fetch",
+			Description: "Disables the fetch domain.",
+		},
+		{
+			Name:        "Fetch.enable",
+			Domain:      "Fetch",
+			Description: "Enables issuing of requestPaused events. A request will be paused until client\ncalls one of failRequest, fulfillRequest or continueRequest/continueWithAuth.",
+			Args:        reflect.TypeOf(fetch.EnableArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.EnableArgs) },
+		},
+		{
+			Name:        "Fetch.failRequest",
+			Domain:      "Fetch",
+			Description: "Causes the request to fail with specified reason.",
+			Args:        reflect.TypeOf(fetch.FailRequestArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.FailRequestArgs) },
+		},
+		{
+			Name:        "Fetch.fulfillRequest",
+			Domain:      "Fetch",
+			Description: "Provides response to the request.",
+			Args:        reflect.TypeOf(fetch.FulfillRequestArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.FulfillRequestArgs) },
+		},
+		{
+			Name:        "Fetch.continueRequest",
+			Domain:      "Fetch",
+			Description: "Continues the request, optionally modifying some of its parameters.",
+			Args:        reflect.TypeOf(fetch.ContinueRequestArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.ContinueRequestArgs) },
+		},
+		{
+			Name:        "Fetch.continueWithAuth",
+			Domain:      "Fetch",
+			Description: "Continues a request supplying authChallengeResponse following authRequired event.",
+			Args:        reflect.TypeOf(fetch.ContinueWithAuthArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.ContinueWithAuthArgs) },
+		},
+		{
+			Name:         "Fetch.continueResponse",
+			Domain:       "Fetch",
+			Description:  "Continues loading of the paused response, optionally modifying the\nresponse headers. If either responseCode or headers are modified, all of them\nmust be present.",
+			Experimental: true,
+			Args:         reflect.TypeOf(fetch.ContinueResponseArgs{}),
+			NewArgs:      func() interface{} { return new(fetch.ContinueResponseArgs) },
+		},
+		{
+			Name:        "Fetch.getResponseBody",
+			Domain:      "Fetch",
+			Description: "Causes the body of the response to be received from the server and\nreturned as a single string. May only be issued for a request that\nis paused in the Response stage and is mutually exclusive with\ntakeResponseBodyForInterceptionAsStream. Calling other methods that\naffect the request or disabling fetch domain before body is received\nresults in an undefined behavior.\nNote that the response body is not available for redirects. Requests\npaused in the _redirect received_ state may be differentiated by\n`responseCode` and presence of `location` response header, see\ncomments to `requestPaused` for details.",
+			Args:        reflect.TypeOf(fetch.GetResponseBodyArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.GetResponseBodyArgs) },
+			Reply:       reflect.TypeOf(fetch.GetResponseBodyReply{}),
+			NewReply:    func() interface{} { return new(fetch.GetResponseBodyReply) },
+		},
+		{
+			Name:        "Fetch.takeResponseBodyAsStream",
+			Domain:      "Fetch",
+			Description: "Returns a handle to the stream representing the response body.\nThe request must be paused in the HeadersReceived stage.\nNote that after this command the request can't be continued\nas is -- client either needs to cancel it or to provide the\nresponse body.\nThe stream only supports sequential read, IO.read will fail if the position\nis specified.\nThis method is mutually exclusive with getResponseBody.\nCalling other methods that affect the request or disabling fetch\ndomain before body is received results in an undefined behavior.",
+			Args:        reflect.TypeOf(fetch.TakeResponseBodyAsStreamArgs{}),
+			NewArgs:     func() interface{} { return new(fetch.TakeResponseBodyAsStreamArgs) },
+			Reply:       reflect.TypeOf(fetch.TakeResponseBodyAsStreamReply{}),
+			NewReply:    func() interface{} { return new(fetch.TakeResponseBodyAsStreamReply) },
+		},
+		{
+			Name:         "FileSystem.getDirectory",
+			Domain:       "FileSystem",
+			Experimental: true,
+			Args:         reflect.TypeOf(filesystem.GetDirectoryArgs{}),
+			NewArgs:      func() interface{} { return new(filesystem.GetDirectoryArgs) },
+			Reply:        reflect.TypeOf(filesystem.GetDirectoryReply{}),
+			NewReply:     func() interface{} { return new(filesystem.GetDirectoryReply) },
+		},
+		{
+			Name:         "HeadlessExperimental.beginFrame",
+			Domain:       "HeadlessExperimental",
+			Description:  "Sends a BeginFrame to the target and returns when the frame was completed. Optionally captures a\nscreenshot from the resulting frame. Requires that the target was created with enabled\nBeginFrameControl. Designed for use with --run-all-compositor-stages-before-draw, see also\nhttps://goo.gle/chrome-headless-rendering for more background.",
+			Experimental: true,
+			Args:         reflect.TypeOf(headlessexperimental.BeginFrameArgs{}),
+			NewArgs:      func() interface{} { return new(headlessexperimental.BeginFrameArgs) },
+			Reply:        reflect.TypeOf(headlessexperimental.BeginFrameReply{}),
+			NewReply:     func() interface{} { return new(headlessexperimental.BeginFrameReply) },
+		},
+		{
+			Name:         "HeadlessExperimental.disable",
+			Domain:       "HeadlessExperimental",
+			Description:  "Disables headless events for the target.",
+			Experimental: true,
+			Deprecated:   true,
+		},
+		{
+			Name:         "HeadlessExperimental.enable",
+			Domain:       "HeadlessExperimental",
+			Description:  "Enables headless events for the target.",
+			Experimental: true,
+			Deprecated:   true,
+		},
+		{
+			Name:         "HeapProfiler.addInspectedHeapObject",
+			Domain:       "HeapProfiler",
+			Description:  "Enables console to refer to the node with given id via $x (see Command Line API for more details\n$x functions).",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.AddInspectedHeapObjectArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.AddInspectedHeapObjectArgs) },
+		},
+		{
+			Name:         "HeapProfiler.collectGarbage",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+		},
+		{
+			Name:         "HeapProfiler.disable",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+		},
+		{
+			Name:         "HeapProfiler.enable",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+		},
+		{
+			Name:         "HeapProfiler.getHeapObjectId",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.GetHeapObjectIDArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.GetHeapObjectIDArgs) },
+			Reply:        reflect.TypeOf(heapprofiler.GetHeapObjectIDReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.GetHeapObjectIDReply) },
+		},
+		{
+			Name:         "HeapProfiler.getObjectByHeapObjectId",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.GetObjectByHeapObjectIDArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.GetObjectByHeapObjectIDArgs) },
+			Reply:        reflect.TypeOf(heapprofiler.GetObjectByHeapObjectIDReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.GetObjectByHeapObjectIDReply) },
+		},
+		{
+			Name:         "HeapProfiler.getSamplingProfile",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.GetSamplingProfileReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.GetSamplingProfileReply) },
+		},
+		{
+			Name:         "HeapProfiler.startSampling",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.StartSamplingArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.StartSamplingArgs) },
+		},
+		{
+			Name:         "HeapProfiler.startTrackingHeapObjects",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.StartTrackingHeapObjectsArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.StartTrackingHeapObjectsArgs) },
+		},
+		{
+			Name:         "HeapProfiler.stopSampling",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.StopSamplingReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.StopSamplingReply) },
+		},
+		{
+			Name:         "HeapProfiler.stopTrackingHeapObjects",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.StopTrackingHeapObjectsArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.StopTrackingHeapObjectsArgs) },
+		},
+		{
+			Name:         "HeapProfiler.takeHeapSnapshot",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Args:         reflect.TypeOf(heapprofiler.TakeHeapSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(heapprofiler.TakeHeapSnapshotArgs) },
+		},
+		{
+			Name:        "IO.close",
+			Domain:      "IO",
+			Description: "Close the stream, discard any temporary backing storage.",
+			Args:        reflect.TypeOf(io.CloseArgs{}),
+			NewArgs:     func() interface{} { return new(io.CloseArgs) },
+		},
+		{
+			Name:        "IO.read",
+			Domain:      "IO",
+			Description: "Read a chunk of the stream",
+			Args:        reflect.TypeOf(io.ReadArgs{}),
+			NewArgs:     func() interface{} { return new(io.ReadArgs) },
+			Reply:       reflect.TypeOf(io.ReadReply{}),
+			NewReply:    func() interface{} { return new(io.ReadReply) },
+		},
+		{
+			Name:        "IO.resolveBlob",
+			Domain:      "IO",
+			Description: "Return UUID of Blob object specified by a remote object id.",
+			Args:        reflect.TypeOf(io.ResolveBlobArgs{}),
+			NewArgs:     func() interface{} { return new(io.ResolveBlobArgs) },
+			Reply:       reflect.TypeOf(io.ResolveBlobReply{}),
+			NewReply:    func() interface{} { return new(io.ResolveBlobReply) },
+		},
+		{
+			Name:         "IndexedDB.clearObjectStore",
+			Domain:       "IndexedDB",
+			Description:  "Clears all entries from an object store.",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.ClearObjectStoreArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.ClearObjectStoreArgs) },
+		},
+		{
+			Name:         "IndexedDB.deleteDatabase",
+			Domain:       "IndexedDB",
+			Description:  "Deletes a database.",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.DeleteDatabaseArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.DeleteDatabaseArgs) },
+		},
+		{
+			Name:         "IndexedDB.deleteObjectStoreEntries",
+			Domain:       "IndexedDB",
+			Description:  "Delete a range of entries from an object store",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.DeleteObjectStoreEntriesArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.DeleteObjectStoreEntriesArgs) },
+		},
+		{
+			Name:         "IndexedDB.disable",
+			Domain:       "IndexedDB",
+			Description:  "Disables events from backend.",
+			Experimental: true,
+		},
+		{
+			Name:         "IndexedDB.enable",
+			Domain:       "IndexedDB",
+			Description:  "Enables events from backend.",
+			Experimental: true,
+		},
+		{
+			Name:         "IndexedDB.requestData",
+			Domain:       "IndexedDB",
+			Description:  "Requests data from object store or index.",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.RequestDataArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.RequestDataArgs) },
+			Reply:        reflect.TypeOf(indexeddb.RequestDataReply{}),
+			NewReply:     func() interface{} { return new(indexeddb.RequestDataReply) },
+		},
+		{
+			Name:         "IndexedDB.getMetadata",
+			Domain:       "IndexedDB",
+			Description:  "Gets metadata of an object store.",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.GetMetadataArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.GetMetadataArgs) },
+			Reply:        reflect.TypeOf(indexeddb.GetMetadataReply{}),
+			NewReply:     func() interface{} { return new(indexeddb.GetMetadataReply) },
+		},
+		{
+			Name:         "IndexedDB.requestDatabase",
+			Domain:       "IndexedDB",
+			Description:  "Requests database with given name in given frame.",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.RequestDatabaseArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.RequestDatabaseArgs) },
+			Reply:        reflect.TypeOf(indexeddb.RequestDatabaseReply{}),
+			NewReply:     func() interface{} { return new(indexeddb.RequestDatabaseReply) },
+		},
+		{
+			Name:         "IndexedDB.requestDatabaseNames",
+			Domain:       "IndexedDB",
+			Description:  "Requests database names for given security origin.",
+			Experimental: true,
+			Args:         reflect.TypeOf(indexeddb.RequestDatabaseNamesArgs{}),
+			NewArgs:      func() interface{} { return new(indexeddb.RequestDatabaseNamesArgs) },
+			Reply:        reflect.TypeOf(indexeddb.RequestDatabaseNamesReply{}),
+			NewReply:     func() interface{} { return new(indexeddb.RequestDatabaseNamesReply) },
+		},
+		{
+			Name:         "Input.dispatchDragEvent",
+			Domain:       "Input",
+			Description:  "Dispatches a drag event into the page.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.DispatchDragEventArgs{}),
+			NewArgs:      func() interface{} { return new(input.DispatchDragEventArgs) },
+		},
+		{
+			Name:        "Input.dispatchKeyEvent",
+			Domain:      "Input",
+			Description: "Dispatches a key event to the page.",
+			Args:        reflect.TypeOf(input.DispatchKeyEventArgs{}),
+			NewArgs:     func() interface{} { return new(input.DispatchKeyEventArgs) },
+		},
+		{
+			Name:         "Input.insertText",
+			Domain:       "Input",
+			Description:  "This method emulates inserting text that doesn't come from a key press,\nfor example an emoji keyboard or an IME.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.InsertTextArgs{}),
+			NewArgs:      func() interface{} { return new(input.InsertTextArgs) },
+		},
+		{
+			Name:         "Input.imeSetComposition",
+			Domain:       "Input",
+			Description:  "This method sets the current candidate text for IME.\nUse imeCommitComposition to commit the final text.\nUse imeSetComposition with empty string as text to cancel composition.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.IMESetCompositionArgs{}),
+			NewArgs:      func() interface{} { return new(input.IMESetCompositionArgs) },
+		},
+		{
+			Name:        "Input.dispatchMouseEvent",
+			Domain:      "Input",
+			Description: "Dispatches a mouse event to the page.",
+			Args:        reflect.TypeOf(input.DispatchMouseEventArgs{}),
+			NewArgs:     func() interface{} { return new(input.DispatchMouseEventArgs) },
+		},
+		{
+			Name:        "Input.dispatchTouchEvent",
+			Domain:      "Input",
+			Description: "Dispatches a touch event to the page.",
+			Args:        reflect.TypeOf(input.DispatchTouchEventArgs{}),
+			NewArgs:     func() interface{} { return new(input.DispatchTouchEventArgs) },
+		},
+		{
+			Name:        "Input.cancelDragging",
+			Domain:      "Input",
+			Description: "Cancels any active dragging in the page.",
+		},
+		{
+			Name:         "Input.emulateTouchFromMouseEvent",
+			Domain:       "Input",
+			Description:  "Emulates touch event from the mouse event parameters.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.EmulateTouchFromMouseEventArgs{}),
+			NewArgs:      func() interface{} { return new(input.EmulateTouchFromMouseEventArgs) },
+		},
+		{
+			Name:        "Input.setIgnoreInputEvents",
+			Domain:      "Input",
+			Description: "Ignores input events (useful while auditing page).",
+			Args:        reflect.TypeOf(input.SetIgnoreInputEventsArgs{}),
+			NewArgs:     func() interface{} { return new(input.SetIgnoreInputEventsArgs) },
+		},
+		{
+			Name:         "Input.setInterceptDrags",
+			Domain:       "Input",
+			Description:  "Prevents default drag and drop behavior and instead emits `Input.dragIntercepted` events.\nDrag and drop behavior can be directly controlled via `Input.dispatchDragEvent`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.SetInterceptDragsArgs{}),
+			NewArgs:      func() interface{} { return new(input.SetInterceptDragsArgs) },
+		},
+		{
+			Name:         "Input.synthesizePinchGesture",
+			Domain:       "Input",
+			Description:  "Synthesizes a pinch gesture over a time period by issuing appropriate touch events.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.SynthesizePinchGestureArgs{}),
+			NewArgs:      func() interface{} { return new(input.SynthesizePinchGestureArgs) },
+		},
+		{
+			Name:         "Input.synthesizeScrollGesture",
+			Domain:       "Input",
+			Description:  "Synthesizes a scroll gesture over a time period by issuing appropriate touch events.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.SynthesizeScrollGestureArgs{}),
+			NewArgs:      func() interface{} { return new(input.SynthesizeScrollGestureArgs) },
+		},
+		{
+			Name:         "Input.synthesizeTapGesture",
+			Domain:       "Input",
+			Description:  "Synthesizes a tap gesture over a time period by issuing appropriate touch events.",
+			Experimental: true,
+			Args:         reflect.TypeOf(input.SynthesizeTapGestureArgs{}),
+			NewArgs:      func() interface{} { return new(input.SynthesizeTapGestureArgs) },
+		},
+		{
+			Name:         "Inspector.disable",
+			Domain:       "Inspector",
+			Description:  "Disables inspector domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "Inspector.enable",
+			Domain:       "Inspector",
+			Description:  "Enables inspector domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "LayerTree.compositingReasons",
+			Domain:       "LayerTree",
+			Description:  "Provides the reasons why the given layer was composited.",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.CompositingReasonsArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.CompositingReasonsArgs) },
+			Reply:        reflect.TypeOf(layertree.CompositingReasonsReply{}),
+			NewReply:     func() interface{} { return new(layertree.CompositingReasonsReply) },
+		},
+		{
+			Name:         "LayerTree.disable",
+			Domain:       "LayerTree",
+			Description:  "Disables compositing tree inspection.",
+			Experimental: true,
+		},
+		{
+			Name:         "LayerTree.enable",
+			Domain:       "LayerTree",
+			Description:  "Enables compositing tree inspection.",
+			Experimental: true,
+		},
+		{
+			Name:         "LayerTree.loadSnapshot",
+			Domain:       "LayerTree",
+			Description:  "Returns the snapshot identifier.",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.LoadSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.LoadSnapshotArgs) },
+			Reply:        reflect.TypeOf(layertree.LoadSnapshotReply{}),
+			NewReply:     func() interface{} { return new(layertree.LoadSnapshotReply) },
+		},
+		{
+			Name:         "LayerTree.makeSnapshot",
+			Domain:       "LayerTree",
+			Description:  "Returns the layer snapshot identifier.",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.MakeSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.MakeSnapshotArgs) },
+			Reply:        reflect.TypeOf(layertree.MakeSnapshotReply{}),
+			NewReply:     func() interface{} { return new(layertree.MakeSnapshotReply) },
+		},
+		{
+			Name:         "LayerTree.profileSnapshot",
+			Domain:       "LayerTree",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.ProfileSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.ProfileSnapshotArgs) },
+			Reply:        reflect.TypeOf(layertree.ProfileSnapshotReply{}),
+			NewReply:     func() interface{} { return new(layertree.ProfileSnapshotReply) },
+		},
+		{
+			Name:         "LayerTree.releaseSnapshot",
+			Domain:       "LayerTree",
+			Description:  "Releases layer snapshot captured by the back-end.",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.ReleaseSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.ReleaseSnapshotArgs) },
+		},
+		{
+			Name:         "LayerTree.replaySnapshot",
+			Domain:       "LayerTree",
+			Description:  "Replays the layer snapshot and returns the resulting bitmap.",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.ReplaySnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.ReplaySnapshotArgs) },
+			Reply:        reflect.TypeOf(layertree.ReplaySnapshotReply{}),
+			NewReply:     func() interface{} { return new(layertree.ReplaySnapshotReply) },
+		},
+		{
+			Name:         "LayerTree.snapshotCommandLog",
+			Domain:       "LayerTree",
+			Description:  "Replays the layer snapshot and returns canvas log.",
+			Experimental: true,
+			Args:         reflect.TypeOf(layertree.SnapshotCommandLogArgs{}),
+			NewArgs:      func() interface{} { return new(layertree.SnapshotCommandLogArgs) },
+			Reply:        reflect.TypeOf(layertree.SnapshotCommandLogReply{}),
+			NewReply:     func() interface{} { return new(layertree.SnapshotCommandLogReply) },
+		},
+		{
+			Name:        "Log.clear",
+			Domain:      "Log",
+			Description: "Clears the log.",
+		},
+		{
+			Name:        "Log.disable",
+			Domain:      "Log",
+			Description: "Disables log domain, prevents further log entries from being reported to the client.",
+		},
+		{
+			Name:        "Log.enable",
+			Domain:      "Log",
+			Description: "Enables log domain, sends the entries collected so far to the client by means of the\n`entryAdded` notification.",
+		},
+		{
+			Name:        "Log.startViolationsReport",
+			Domain:      "Log",
+			Description: "start violation reporting.",
+			Args:        reflect.TypeOf(log.StartViolationsReportArgs{}),
+			NewArgs:     func() interface{} { return new(log.StartViolationsReportArgs) },
+		},
+		{
+			Name:        "Log.stopViolationsReport",
+			Domain:      "Log",
+			Description: "Stop violation reporting.",
+		},
+		{
+			Name:         "Media.enable",
+			Domain:       "Media",
+			Description:  "Enables the Media domain",
+			Experimental: true,
+		},
+		{
+			Name:         "Media.disable",
+			Domain:       "Media",
+			Description:  "Disables the Media domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "Memory.getDOMCounters",
+			Domain:       "Memory",
+			Description:  "Retruns current DOM object counters.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(memory.GetDOMCountersReply{}),
+			NewReply:     func() interface{} { return new(memory.GetDOMCountersReply) },
+		},
+		{
+			Name:         "Memory.getDOMCountersForLeakDetection",
+			Domain:       "Memory",
+			Description:  "Retruns DOM object counters after preparing renderer for leak detection.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(memory.GetDOMCountersForLeakDetectionReply{}),
+			NewReply:     func() interface{} { return new(memory.GetDOMCountersForLeakDetectionReply) },
+		},
+		{
+			Name:         "Memory.prepareForLeakDetection",
+			Domain:       "Memory",
+			Description:  "Prepares for leak detection by terminating workers, stopping spellcheckers,\ndropping non-essential internal caches, running garbage collections, etc.",
+			Experimental: true,
+		},
+		{
+			Name:         "Memory.forciblyPurgeJavaScriptMemory",
+			Domain:       "Memory",
+			Description:  "Simulate OomIntervention by purging V8 memory.",
+			Experimental: true,
+		},
+		{
+			Name:         "Memory.setPressureNotificationsSuppressed",
+			Domain:       "Memory",
+			Description:  "Enable/disable suppressing memory pressure notifications in all processes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(memory.SetPressureNotificationsSuppressedArgs{}),
+			NewArgs:      func() interface{} { return new(memory.SetPressureNotificationsSuppressedArgs) },
+		},
+		{
+			Name:         "Memory.simulatePressureNotification",
+			Domain:       "Memory",
+			Description:  "Simulate a memory pressure notification in all processes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(memory.SimulatePressureNotificationArgs{}),
+			NewArgs:      func() interface{} { return new(memory.SimulatePressureNotificationArgs) },
+		},
+		{
+			Name:         "Memory.startSampling",
+			Domain:       "Memory",
+			Description:  "Start collecting native memory profile.",
+			Experimental: true,
+			Args:         reflect.TypeOf(memory.StartSamplingArgs{}),
+			NewArgs:      func() interface{} { return new(memory.StartSamplingArgs) },
+		},
+		{
+			Name:         "Memory.stopSampling",
+			Domain:       "Memory",
+			Description:  "Stop collecting native memory profile.",
+			Experimental: true,
+		},
+		{
+			Name:         "Memory.getAllTimeSamplingProfile",
+			Domain:       "Memory",
+			Description:  "Retrieve native memory allocations profile\ncollected since renderer process startup.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(memory.GetAllTimeSamplingProfileReply{}),
+			NewReply:     func() interface{} { return new(memory.GetAllTimeSamplingProfileReply) },
+		},
+		{
+			Name:         "Memory.getBrowserSamplingProfile",
+			Domain:       "Memory",
+			Description:  "Retrieve native memory allocations profile\ncollected since browser process startup.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(memory.GetBrowserSamplingProfileReply{}),
+			NewReply:     func() interface{} { return new(memory.GetBrowserSamplingProfileReply) },
+		},
+		{
+			Name:         "Memory.getSamplingProfile",
+			Domain:       "Memory",
+			Description:  "Retrieve native memory allocations profile collected since last\n`startSampling` call.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(memory.GetSamplingProfileReply{}),
+			NewReply:     func() interface{} { return new(memory.GetSamplingProfileReply) },
+		},
+		{
+			Name:         "Network.setAcceptedEncodings",
+			Domain:       "Network",
+			Description:  "Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.SetAcceptedEncodingsArgs{}),
+			NewArgs:      func() interface{} { return new(network.SetAcceptedEncodingsArgs) },
+		},
+		{
+			Name:         "Network.clearAcceptedEncodingsOverride",
+			Domain:       "Network",
+			Description:  "Clears accepted encodings set by setAcceptedEncodings",
+			Experimental: true,
+		},
+		{
+			Name:        "Network.canClearBrowserCache",
+			Domain:      "Network",
+			Description: "Tells whether clearing browser cache is supported.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(network.CanClearBrowserCacheReply{}),
+			NewReply:    func() interface{} { return new(network.CanClearBrowserCacheReply) },
+		},
+		{
+			Name:        "Network.canClearBrowserCookies",
+			Domain:      "Network",
+			Description: "Tells whether clearing browser cookies is supported.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(network.CanClearBrowserCookiesReply{}),
+			NewReply:    func() interface{} { return new(network.CanClearBrowserCookiesReply) },
+		},
+		{
+			Name:        "Network.canEmulateNetworkConditions",
+			Domain:      "Network",
+			Description: "Tells whether emulation of network conditions is supported.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(network.CanEmulateNetworkConditionsReply{}),
+			NewReply:    func() interface{} { return new(network.CanEmulateNetworkConditionsReply) },
+		},
+		{
+			Name:        "Network.clearBrowserCache",
+			Domain:      "Network",
+			Description: "Clears browser cache.",
+		},
+		{
+			Name:        "Network.clearBrowserCookies",
+			Domain:      "Network",
+			Description: "Clears browser cookies.",
+		},
+		{
+			Name:         "Network.continueInterceptedRequest",
+			Domain:       "Network",
+			Description:  "Response to Network.requestIntercepted which either modifies the request to continue with any\nmodifications, or blocks it, or completes it with the provided response bytes. If a network\nfetch occurs as a result which encounters a redirect an additional Network.requestIntercepted\nevent will be sent with the same InterceptionId.\nDeprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(network.ContinueInterceptedRequestArgs{}),
+			NewArgs:      func() interface{} { return new(network.ContinueInterceptedRequestArgs) },
+		},
+		{
+			Name:        "Network.deleteCookies",
+			Domain:      "Network",
+			Description: "Deletes browser cookies with matching name and url or domain/path/partitionKey pair.",
+			Args:        reflect.TypeOf(network.DeleteCookiesArgs{}),
+			NewArgs:     func() interface{} { return new(network.DeleteCookiesArgs) },
+		},
+		{
+			Name:        "Network.disable",
+			Domain:      "Network",
+			Description: "Disables network tracking, prevents network events from being sent to the client.",
+		},
+		{
+			Name:        "Network.emulateNetworkConditions",
+			Domain:      "Network",
+			Description: "Activates emulation of network conditions. This command is deprecated in favor of the emulateNetworkConditionsByRule\nand overrideNetworkState commands, which can be used together to the same effect.",
+			Deprecated:  true,
+			Args:        reflect.TypeOf(network.EmulateNetworkConditionsArgs{}),
+			NewArgs:     func() interface{} { return new(network.EmulateNetworkConditionsArgs) },
+		},
+		{
+			Name:         "Network.emulateNetworkConditionsByRule",
+			Domain:       "Network",
+			Description:  "Activates emulation of network conditions for individual requests using URL match patterns. Unlike the deprecated\nNetwork.emulateNetworkConditions this method does not affect `navigator` state. Use Network.overrideNetworkState to\nexplicitly modify `navigator` behavior.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.EmulateNetworkConditionsByRuleArgs{}),
+			NewArgs:      func() interface{} { return new(network.EmulateNetworkConditionsByRuleArgs) },
+			Reply:        reflect.TypeOf(network.EmulateNetworkConditionsByRuleReply{}),
+			NewReply:     func() interface{} { return new(network.EmulateNetworkConditionsByRuleReply) },
+		},
+		{
+			Name:         "Network.overrideNetworkState",
+			Domain:       "Network",
+			Description:  "Override the state of navigator.onLine and navigator.connection.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.OverrideNetworkStateArgs{}),
+			NewArgs:      func() interface{} { return new(network.OverrideNetworkStateArgs) },
+		},
+		{
+			Name:        "Network.enable",
+			Domain:      "Network",
+			Description: "Enables network tracking, network events will now be delivered to the client.",
+			Args:        reflect.TypeOf(network.EnableArgs{}),
+			NewArgs:     func() interface{} { return new(network.EnableArgs) },
+		},
+		{
+			Name:        "Network.getAllCookies",
+			Domain:      "Network",
+			Description: "Returns all browser cookies. Depending on the backend support, will return detailed cookie\ninformation in the `cookies` field.\nDeprecated. Use Storage.getCookies instead.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(network.GetAllCookiesReply{}),
+			NewReply:    func() interface{} { return new(network.GetAllCookiesReply) },
+		},
+		{
+			Name:         "Network.getCertificate",
+			Domain:       "Network",
+			Description:  "Returns the DER-encoded certificate.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.GetCertificateArgs{}),
+			NewArgs:      func() interface{} { return new(network.GetCertificateArgs) },
+			Reply:        reflect.TypeOf(network.GetCertificateReply{}),
+			NewReply:     func() interface{} { return new(network.GetCertificateReply) },
+		},
+		{
+			Name:        "Network.getCookies",
+			Domain:      "Network",
+			Description: "Returns all browser cookies for the current URL. Depending on the backend support, will return\ndetailed cookie information in the `cookies` field.",
+			Args:        reflect.TypeOf(network.GetCookiesArgs{}),
+			NewArgs:     func() interface{} { return new(network.GetCookiesArgs) },
+			Reply:       reflect.TypeOf(network.GetCookiesReply{}),
+			NewReply:    func() interface{} { return new(network.GetCookiesReply) },
+		},
+		{
+			Name:        "Network.getResponseBody",
+			Domain:      "Network",
+			Description: "Returns content served for the given request.",
+			Args:        reflect.TypeOf(network.GetResponseBodyArgs{}),
+			NewArgs:     func() interface{} { return new(network.GetResponseBodyArgs) },
+			Reply:       reflect.TypeOf(network.GetResponseBodyReply{}),
+			NewReply:    func() interface{} { return new(network.GetResponseBodyReply) },
+		},
+		{
+			Name:        "Network.getRequestPostData",
+			Domain:      "Network",
+			Description: "Returns post data sent with the request. Returns an error when no data was sent with the request.",
+			Args:        reflect.TypeOf(network.GetRequestPostDataArgs{}),
+			NewArgs:     func() interface{} { return new(network.GetRequestPostDataArgs) },
+			Reply:       reflect.TypeOf(network.GetRequestPostDataReply{}),
+			NewReply:    func() interface{} { return new(network.GetRequestPostDataReply) },
+		},
+		{
+			Name:         "Network.getResponseBodyForInterception",
+			Domain:       "Network",
+			Description:  "Returns content served for the given currently intercepted request.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.GetResponseBodyForInterceptionArgs{}),
+			NewArgs:      func() interface{} { return new(network.GetResponseBodyForInterceptionArgs) },
+			Reply:        reflect.TypeOf(network.GetResponseBodyForInterceptionReply{}),
+			NewReply:     func() interface{} { return new(network.GetResponseBodyForInterceptionReply) },
+		},
+		{
+			Name:         "Network.takeResponseBodyForInterceptionAsStream",
+			Domain:       "Network",
+			Description:  "Returns a handle to the stream representing the response body. Note that after this command,\nthe intercepted request can't be continued as is -- you either need to cancel it or to provide\nthe response body. The stream only supports sequential read, IO.read will fail if the position\nis specified.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.TakeResponseBodyForInterceptionAsStreamArgs{}),
+			NewArgs:      func() interface{} { return new(network.TakeResponseBodyForInterceptionAsStreamArgs) },
+			Reply:        reflect.TypeOf(network.TakeResponseBodyForInterceptionAsStreamReply{}),
+			NewReply:     func() interface{} { return new(network.TakeResponseBodyForInterceptionAsStreamReply) },
+		},
+		{
+			Name:         "Network.replayXHR",
+			Domain:       "Network",
+			Description:  "This method sends a new XMLHttpRequest which is identical to the original one. The following\nparameters should be identical: method, url, async, request body, extra headers, withCredentials\nattribute, user, password.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.ReplayXHRArgs{}),
+			NewArgs:      func() interface{} { return new(network.ReplayXHRArgs) },
+		},
+		{
+			Name:         "Network.searchInResponseBody",
+			Domain:       "Network",
+			Description:  "Searches for given string in response content.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.SearchInResponseBodyArgs{}),
+			NewArgs:      func() interface{} { return new(network.SearchInResponseBodyArgs) },
+			Reply:        reflect.TypeOf(network.SearchInResponseBodyReply{}),
+			NewReply:     func() interface{} { return new(network.SearchInResponseBodyReply) },
+		},
+		{
+			Name:         "Network.setBlockedURLs",
+			Domain:       "Network",
+			Description:  "Blocks URLs from loading.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.SetBlockedURLsArgs{}),
+			NewArgs:      func() interface{} { return new(network.SetBlockedURLsArgs) },
+		},
+		{
+			Name:        "Network.setBypassServiceWorker",
+			Domain:      "Network",
+			Description: "Toggles ignoring of service worker for each request.",
+			Args:        reflect.TypeOf(network.SetBypassServiceWorkerArgs{}),
+			NewArgs:     func() interface{} { return new(network.SetBypassServiceWorkerArgs) },
+		},
+		{
+			Name:        "Network.setCacheDisabled",
+			Domain:      "Network",
+			Description: "Toggles ignoring cache for each request. If `true`, cache will not be used.",
+			Args:        reflect.TypeOf(network.SetCacheDisabledArgs{}),
+			NewArgs:     func() interface{} { return new(network.SetCacheDisabledArgs) },
+		},
+		{
+			Name:        "Network.setCookie",
+			Domain:      "Network",
+			Description: "Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.",
+			Args:        reflect.TypeOf(network.SetCookieArgs{}),
+			NewArgs:     func() interface{} { return new(network.SetCookieArgs) },
+			Reply:       reflect.TypeOf(network.SetCookieReply{}),
+			NewReply:    func() interface{} { return new(network.SetCookieReply) },
+		},
+		{
+			Name:        "Network.setCookies",
+			Domain:      "Network",
+			Description: "Sets given cookies.",
+			Args:        reflect.TypeOf(network.SetCookiesArgs{}),
+			NewArgs:     func() interface{} { return new(network.SetCookiesArgs) },
+		},
+		{
+			Name:        "Network.setExtraHTTPHeaders",
+			Domain:      "Network",
+			Description: "Specifies whether to always send extra HTTP headers with the requests from this page.",
+			Args:        reflect.TypeOf(network.SetExtraHTTPHeadersArgs{}),
+			NewArgs:     func() interface{} { return new(network.SetExtraHTTPHeadersArgs) },
+		},
+		{
+			Name:         "Network.setAttachDebugStack",
+			Domain:       "Network",
+			Description:  "Specifies whether to attach a page script stack id in requests",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.SetAttachDebugStackArgs{}),
+			NewArgs:      func() interface{} { return new(network.SetAttachDebugStackArgs) },
+		},
+		{
+			Name:         "Network.setRequestInterception",
+			Domain:       "Network",
+			Description:  "Sets the requests to intercept that match the provided patterns and optionally resource types.\nDeprecated, please use Fetch.enable instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(network.SetRequestInterceptionArgs{}),
+			NewArgs:      func() interface{} { return new(network.SetRequestInterceptionArgs) },
+		},
+		{
+			Name:         "Network.streamResourceContent",
+			Domain:       "Network",
+			Description:  "Enables streaming of the response for the given requestId.\nIf enabled, the dataReceived event contains the data that was received during streaming.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.StreamResourceContentArgs{}),
+			NewArgs:      func() interface{} { return new(network.StreamResourceContentArgs) },
+			Reply:        reflect.TypeOf(network.StreamResourceContentReply{}),
+			NewReply:     func() interface{} { return new(network.StreamResourceContentReply) },
+		},
+		{
+			Name:         "Network.getSecurityIsolationStatus",
+			Domain:       "Network",
+			Description:  "Returns information about the COEP/COOP isolation status.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.GetSecurityIsolationStatusArgs{}),
+			NewArgs:      func() interface{} { return new(network.GetSecurityIsolationStatusArgs) },
+			Reply:        reflect.TypeOf(network.GetSecurityIsolationStatusReply{}),
+			NewReply:     func() interface{} { return new(network.GetSecurityIsolationStatusReply) },
+		},
+		{
+			Name:         "Network.enableReportingApi",
+			Domain:       "Network",
+			Description:  "Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client.\nEnabling triggers 'reportingApiReportAdded' for all existing reports.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.EnableReportingAPIArgs{}),
+			NewArgs:      func() interface{} { return new(network.EnableReportingAPIArgs) },
+		},
+		{
+			Name:         "Network.loadNetworkResource",
+			Domain:       "Network",
+			Description:  "Fetches the resource and returns the content.",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.LoadNetworkResourceArgs{}),
+			NewArgs:      func() interface{} { return new(network.LoadNetworkResourceArgs) },
+			Reply:        reflect.TypeOf(network.LoadNetworkResourceReply{}),
+			NewReply:     func() interface{} { return new(network.LoadNetworkResourceReply) },
+		},
+		{
+			Name:         "Network.setCookieControls",
+			Domain:       "Network",
+			Description:  "Sets Controls for third-party cookie access\nPage reload is required before the new cookie behavior will be observed",
+			Experimental: true,
+			Args:         reflect.TypeOf(network.SetCookieControlsArgs{}),
+			NewArgs:      func() interface{} { return new(network.SetCookieControlsArgs) },
+		},
+		{
+			Name:         "NodeRuntime.enable",
+			Domain:       "NodeRuntime",
+			Description:  "Enable the NodeRuntime events except by `NodeRuntime.waitingForDisconnect`.",
+			Experimental: true,
+		},
+		{
+			Name:         "NodeRuntime.disable",
+			Domain:       "NodeRuntime",
+			Description:  "Disable NodeRuntime events",
+			Experimental: true,
+		},
+		{
+			Name:         "NodeRuntime.notifyWhenWaitingForDisconnect",
+			Domain:       "NodeRuntime",
+			Description:  "Enable the `NodeRuntime.waitingForDisconnect`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(noderuntime.NotifyWhenWaitingForDisconnectArgs{}),
+			NewArgs:      func() interface{} { return new(noderuntime.NotifyWhenWaitingForDisconnectArgs) },
+		},
+		{
+			Name:         "NodeTracing.getCategories",
+			Domain:       "NodeTracing",
+			Description:  "Gets supported tracing categories.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(nodetracing.GetCategoriesReply{}),
+			NewReply:     func() interface{} { return new(nodetracing.GetCategoriesReply) },
+		},
+		{
+			Name:         "NodeTracing.start",
+			Domain:       "NodeTracing",
+			Description:  "Start trace events collection.",
+			Experimental: true,
+			Args:         reflect.TypeOf(nodetracing.StartArgs{}),
+			NewArgs:      func() interface{} { return new(nodetracing.StartArgs) },
+		},
+		{
+			Name:         "NodeTracing.stop",
+			Domain:       "NodeTracing",
+			Description:  "Stop trace events collection. Remaining collected events will be sent as a sequence of\ndataCollected events followed by tracingComplete event.",
+			Experimental: true,
+		},
+		{
+			Name:         "NodeWorker.sendMessageToWorker",
+			Domain:       "NodeWorker",
+			Description:  "Sends protocol message over session with given id.",
+			Experimental: true,
+			Args:         reflect.TypeOf(nodeworker.SendMessageToWorkerArgs{}),
+			NewArgs:      func() interface{} { return new(nodeworker.SendMessageToWorkerArgs) },
+		},
+		{
+			Name:         "NodeWorker.enable",
+			Domain:       "NodeWorker",
+			Description:  "Instructs the inspector to attach to running workers. Will also attach to new workers\nas they start",
+			Experimental: true,
+			Args:         reflect.TypeOf(nodeworker.EnableArgs{}),
+			NewArgs:      func() interface{} { return new(nodeworker.EnableArgs) },
+		},
+		{
+			Name:         "NodeWorker.disable",
+			Domain:       "NodeWorker",
+			Description:  "Detaches from all running workers and disables attaching to new workers as they are started.",
+			Experimental: true,
+		},
+		{
+			Name:         "NodeWorker.detach",
+			Domain:       "NodeWorker",
+			Description:  "Detached from the worker with given sessionId.",
+			Experimental: true,
+			Args:         reflect.TypeOf(nodeworker.DetachArgs{}),
+			NewArgs:      func() interface{} { return new(nodeworker.DetachArgs) },
+		},
+		{
+			Name:         "Overlay.disable",
+			Domain:       "Overlay",
+			Description:  "Disables domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "Overlay.enable",
+			Domain:       "Overlay",
+			Description:  "Enables domain notifications.",
+			Experimental: true,
+		},
+		{
+			Name:         "Overlay.getHighlightObjectForTest",
+			Domain:       "Overlay",
+			Description:  "For testing.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.GetHighlightObjectForTestArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.GetHighlightObjectForTestArgs) },
+			Reply:        reflect.TypeOf(overlay.GetHighlightObjectForTestReply{}),
+			NewReply:     func() interface{} { return new(overlay.GetHighlightObjectForTestReply) },
+		},
+		{
+			Name:         "Overlay.getGridHighlightObjectsForTest",
+			Domain:       "Overlay",
+			Description:  "For Persistent Grid testing.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.GetGridHighlightObjectsForTestArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.GetGridHighlightObjectsForTestArgs) },
+			Reply:        reflect.TypeOf(overlay.GetGridHighlightObjectsForTestReply{}),
+			NewReply:     func() interface{} { return new(overlay.GetGridHighlightObjectsForTestReply) },
+		},
+		{
+			Name:         "Overlay.getSourceOrderHighlightObjectForTest",
+			Domain:       "Overlay",
+			Description:  "For Source Order Viewer testing.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.GetSourceOrderHighlightObjectForTestArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.GetSourceOrderHighlightObjectForTestArgs) },
+			Reply:        reflect.TypeOf(overlay.GetSourceOrderHighlightObjectForTestReply{}),
+			NewReply:     func() interface{} { return new(overlay.GetSourceOrderHighlightObjectForTestReply) },
+		},
+		{
+			Name:         "Overlay.hideHighlight",
+			Domain:       "Overlay",
+			Description:  "Hides any highlight.",
+			Experimental: true,
+		},
+		{
+			Name:         "Overlay.highlightFrame",
+			Domain:       "Overlay",
+			Description:  "Highlights owner element of the frame with given id.\nDeprecated: Doesn't work reliably and cannot be fixed due to process\nseparation (the owner node might be in a different process). Determine\nthe owner node in the client and use highlightNode.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(overlay.HighlightFrameArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.HighlightFrameArgs) },
+		},
+		{
+			Name:         "Overlay.highlightNode",
+			Domain:       "Overlay",
+			Description:  "Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or\nobjectId must be specified.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.HighlightNodeArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.HighlightNodeArgs) },
+		},
+		{
+			Name:         "Overlay.highlightQuad",
+			Domain:       "Overlay",
+			Description:  "Highlights given quad. Coordinates are absolute with respect to the main frame viewport.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.HighlightQuadArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.HighlightQuadArgs) },
+		},
+		{
+			Name:         "Overlay.highlightRect",
+			Domain:       "Overlay",
+			Description:  "Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.\nIssue: the method does not handle device pixel ratio (DPR) correctly.\nThe coordinates currently have to be adjusted by the client\nif DPR is not 1 (see crbug.com/437807128).",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.HighlightRectArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.HighlightRectArgs) },
+		},
+		{
+			Name:         "Overlay.highlightSourceOrder",
+			Domain:       "Overlay",
+			Description:  "Highlights the source order of the children of the DOM node with given id or with the given\nJavaScript object wrapper. Either nodeId or objectId must be specified.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.HighlightSourceOrderArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.HighlightSourceOrderArgs) },
+		},
+		{
+			Name:         "Overlay.setInspectMode",
+			Domain:       "Overlay",
+			Description:  "Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted.\nBackend then generates 'inspectNodeRequested' event upon element selection.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetInspectModeArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetInspectModeArgs) },
+		},
+		{
+			Name:         "Overlay.setShowAdHighlights",
+			Domain:       "Overlay",
+			Description:  "Highlights owner element of all frames detected to be ads.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowAdHighlightsArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowAdHighlightsArgs) },
+		},
+		{
+			Name:         "Overlay.setPausedInDebuggerMessage",
+			Domain:       "Overlay",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetPausedInDebuggerMessageArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetPausedInDebuggerMessageArgs) },
+		},
+		{
+			Name:         "Overlay.setShowDebugBorders",
+			Domain:       "Overlay",
+			Description:  "Requests that backend shows debug borders on layers",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowDebugBordersArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowDebugBordersArgs) },
+		},
+		{
+			Name:         "Overlay.setShowFPSCounter",
+			Domain:       "Overlay",
+			Description:  "Requests that backend shows the FPS counter",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowFPSCounterArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowFPSCounterArgs) },
+		},
+		{
+			Name:         "Overlay.setShowGridOverlays",
+			Domain:       "Overlay",
+			Description:  "Highlight multiple elements with the CSS Grid overlay.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowGridOverlaysArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowGridOverlaysArgs) },
+		},
+		{
+			Name:         "Overlay.setShowFlexOverlays",
+			Domain:       "Overlay",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowFlexOverlaysArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowFlexOverlaysArgs) },
+		},
+		{
+			Name:         "Overlay.setShowScrollSnapOverlays",
+			Domain:       "Overlay",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowScrollSnapOverlaysArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowScrollSnapOverlaysArgs) },
+		},
+		{
+			Name:         "Overlay.setShowContainerQueryOverlays",
+			Domain:       "Overlay",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowContainerQueryOverlaysArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowContainerQueryOverlaysArgs) },
+		},
+		{
+			Name:         "Overlay.setShowPaintRects",
+			Domain:       "Overlay",
+			Description:  "Requests that backend shows paint rectangles",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowPaintRectsArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowPaintRectsArgs) },
+		},
+		{
+			Name:         "Overlay.setShowLayoutShiftRegions",
+			Domain:       "Overlay",
+			Description:  "Requests that backend shows layout shift regions",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowLayoutShiftRegionsArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowLayoutShiftRegionsArgs) },
+		},
+		{
+			Name:         "Overlay.setShowScrollBottleneckRects",
+			Domain:       "Overlay",
+			Description:  "Requests that backend shows scroll bottleneck rects",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowScrollBottleneckRectsArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowScrollBottleneckRectsArgs) },
+		},
+		{
+			Name:         "Overlay.setShowHitTestBorders",
+			Domain:       "Overlay",
+			Description:  "Deprecated, no longer has any effect.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(overlay.SetShowHitTestBordersArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowHitTestBordersArgs) },
+		},
+		{
+			Name:         "Overlay.setShowWebVitals",
+			Domain:       "Overlay",
+			Description:  "Deprecated, no longer has any effect.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(overlay.SetShowWebVitalsArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowWebVitalsArgs) },
+		},
+		{
+			Name:         "Overlay.setShowViewportSizeOnResize",
+			Domain:       "Overlay",
+			Description:  "Paints viewport size upon main frame resize.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowViewportSizeOnResizeArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowViewportSizeOnResizeArgs) },
+		},
+		{
+			Name:         "Overlay.setShowHinge",
+			Domain:       "Overlay",
+			Description:  "Add a dual screen device hinge",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowHingeArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowHingeArgs) },
+		},
+		{
+			Name:         "Overlay.setShowIsolatedElements",
+			Domain:       "Overlay",
+			Description:  "Show elements in isolation mode with overlays.",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowIsolatedElementsArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowIsolatedElementsArgs) },
+		},
+		{
+			Name:         "Overlay.setShowWindowControlsOverlay",
+			Domain:       "Overlay",
+			Description:  "Show Window Controls Overlay for PWA",
+			Experimental: true,
+			Args:         reflect.TypeOf(overlay.SetShowWindowControlsOverlayArgs{}),
+			NewArgs:      func() interface{} { return new(overlay.SetShowWindowControlsOverlayArgs) },
+		},
+		{
+			Name:         "PWA.getOsAppState",
+			Domain:       "PWA",
+			Description:  "Returns the following OS state for the given manifest id.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.GetOSAppStateArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.GetOSAppStateArgs) },
+			Reply:        reflect.TypeOf(pwa.GetOSAppStateReply{}),
+			NewReply:     func() interface{} { return new(pwa.GetOSAppStateReply) },
+		},
+		{
+			Name:         "PWA.install",
+			Domain:       "PWA",
+			Description:  "Installs the given manifest identity, optionally using the given installUrlOrBundleUrl\n\nIWA-specific install description:\nmanifestId corresponds to isolated-app:// + web_package::SignedWebBundleId\n\nFile installation mode:\nThe installUrlOrBundleUrl can be either file:// or http(s):// pointing\nto a signed web bundle (.swbn). In this case SignedWebBundleId must correspond to\nThe .swbn file's signing key.\n\nDev proxy installation mode:\ninstallUrlOrBundleUrl must be http(s):// that serves dev mode IWA.\nweb_package::SignedWebBundleId must be of type dev proxy.\n\nThe advantage of dev proxy mode is that all changes to IWA\nautomatically will be reflected in the running app without\nreinstallation.\n\nTo generate bundle id for proxy mode:\n1. Generate 32 random bytes.\n2. Add a specific suffix at the end following the documentation\n   https://github.com/WICG/isolated-web-apps/blob/main/Scheme.md#suffix\n3. Encode the entire sequence using Base32 without padding.\n\nIf Chrome is not in IWA dev\nmode, the installation will fail, regardless of the state of the allowlist.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.InstallArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.InstallArgs) },
+		},
+		{
+			Name:         "PWA.uninstall",
+			Domain:       "PWA",
+			Description:  "Uninstalls the given manifest_id and closes any opened app windows.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.UninstallArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.UninstallArgs) },
+		},
+		{
+			Name:         "PWA.launch",
+			Domain:       "PWA",
+			Description:  "Launches the installed web app, or an url in the same web app instead of the\ndefault start url if it is provided. Returns a page Target.TargetID which\ncan be used to attach to via Target.attachToTarget or similar APIs.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.LaunchArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.LaunchArgs) },
+			Reply:        reflect.TypeOf(pwa.LaunchReply{}),
+			NewReply:     func() interface{} { return new(pwa.LaunchReply) },
+		},
+		{
+			Name:         "PWA.launchFilesInApp",
+			Domain:       "PWA",
+			Description:  "Opens one or more local files from an installed web app identified by its\nmanifestId. The web app needs to have file handlers registered to process\nthe files. The API returns one or more page Target.TargetIDs which can be\nused to attach to via Target.attachToTarget or similar APIs.\nIf some files in the parameters cannot be handled by the web app, they will\nbe ignored. If none of the files can be handled, this API returns an error.\nIf no files are provided as the parameter, this API also returns an error.\n\nAccording to the definition of the file handlers in the manifest file, one\nTarget.TargetID may represent a page handling one or more files. The order\nof the returned Target.TargetIDs is not guaranteed.\n\nTODO(crbug.com/339454034): Check the existences of the input files.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.LaunchFilesInAppArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.LaunchFilesInAppArgs) },
+			Reply:        reflect.TypeOf(pwa.LaunchFilesInAppReply{}),
+			NewReply:     func() interface{} { return new(pwa.LaunchFilesInAppReply) },
+		},
+		{
+			Name:         "PWA.openCurrentPageInApp",
+			Domain:       "PWA",
+			Description:  "Opens the current page in its web app identified by the manifest id, needs\nto be called on a page target. This function returns immediately without\nwaiting for the app to finish loading.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.OpenCurrentPageInAppArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.OpenCurrentPageInAppArgs) },
+		},
+		{
+			Name:         "PWA.changeAppUserSettings",
+			Domain:       "PWA",
+			Description:  "Changes user settings of the web app identified by its manifestId. If the\napp was not installed, this command returns an error. Unset parameters will\nbe ignored; unrecognized values will cause an error.\n\nUnlike the ones defined in the manifest files of the web apps, these\nsettings are provided by the browser and controlled by the users, they\nimpact the way the browser handling the web apps.\n\nSee the comment of each parameter.",
+			Experimental: true,
+			Args:         reflect.TypeOf(pwa.ChangeAppUserSettingsArgs{}),
+			NewArgs:      func() interface{} { return new(pwa.ChangeAppUserSettingsArgs) },
+		},
+		{
+			Name:         "Page.addScriptToEvaluateOnLoad",
+			Domain:       "Page",
+			Description:  "Deprecated, please use addScriptToEvaluateOnNewDocument instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(page.AddScriptToEvaluateOnLoadArgs{}),
+			NewArgs:      func() interface{} { return new(page.AddScriptToEvaluateOnLoadArgs) },
+			Reply:        reflect.TypeOf(page.AddScriptToEvaluateOnLoadReply{}),
+			NewReply:     func() interface{} { return new(page.AddScriptToEvaluateOnLoadReply) },
+		},
+		{
+			Name:        "Page.addScriptToEvaluateOnNewDocument",
+			Domain:      "Page",
+			Description: "Evaluates given script in every frame upon creation (before loading frame's scripts).",
+			Args:        reflect.TypeOf(page.AddScriptToEvaluateOnNewDocumentArgs{}),
+			NewArgs:     func() interface{} { return new(page.AddScriptToEvaluateOnNewDocumentArgs) },
+			Reply:       reflect.TypeOf(page.AddScriptToEvaluateOnNewDocumentReply{}),
+			NewReply:    func() interface{} { return new(page.AddScriptToEvaluateOnNewDocumentReply) },
+		},
+		{
+			Name:        "Page.bringToFront",
+			Domain:      "Page",
+			Description: "Brings page to front (activates tab).",
+		},
+		{
+			Name:        "Page.captureScreenshot",
+			Domain:      "Page",
+			Description: "Capture page screenshot.",
+			Args:        reflect.TypeOf(page.CaptureScreenshotArgs{}),
+			NewArgs:     func() interface{} { return new(page.CaptureScreenshotArgs) },
+			Reply:       reflect.TypeOf(page.CaptureScreenshotReply{}),
+			NewReply:    func() interface{} { return new(page.CaptureScreenshotReply) },
+		},
+		{
+			Name:         "Page.captureSnapshot",
+			Domain:       "Page",
+			Description:  "Returns a snapshot of the page as a string. For MHTML format, the serialization includes\niframes, shadow DOM, external resources, and element-inline styles.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.CaptureSnapshotArgs{}),
+			NewArgs:      func() interface{} { return new(page.CaptureSnapshotArgs) },
+			Reply:        reflect.TypeOf(page.CaptureSnapshotReply{}),
+			NewReply:     func() interface{} { return new(page.CaptureSnapshotReply) },
+		},
+		{
+			Name:        "Page.createIsolatedWorld",
+			Domain:      "Page",
+			Description: "Creates an isolated world for the given frame.",
+			Args:        reflect.TypeOf(page.CreateIsolatedWorldArgs{}),
+			NewArgs:     func() interface{} { return new(page.CreateIsolatedWorldArgs) },
+			Reply:       reflect.TypeOf(page.CreateIsolatedWorldReply{}),
+			NewReply:    func() interface{} { return new(page.CreateIsolatedWorldReply) },
+		},
+		{
+			Name:        "Page.disable",
+			Domain:      "Page",
+			Description: "Disables page domain notifications.",
+		},
+		{
+			Name:        "Page.enable",
+			Domain:      "Page",
+			Description: "Enables page domain notifications.",
+			Args:        reflect.TypeOf(page.EnableArgs{}),
+			NewArgs:     func() interface{} { return new(page.EnableArgs) },
+		},
+		{
+			Name:        "Page.getAppManifest",
+			Domain:      "Page",
+			Description: "Gets the processed manifest for this current document.\n  This API always waits for the manifest to be loaded.\n  If manifestId is provided, and it does not match the manifest of the\n    current document, this API errors out.\n  If there is not a loaded page, this API errors out immediately.",
+			Args:        reflect.TypeOf(page.GetAppManifestArgs{}),
+			NewArgs:     func() interface{} { return new(page.GetAppManifestArgs) },
+			Reply:       reflect.TypeOf(page.GetAppManifestReply{}),
+			NewReply:    func() interface{} { return new(page.GetAppManifestReply) },
+		},
+		{
+			Name:         "Page.getInstallabilityErrors",
+			Domain:       "Page",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.GetInstallabilityErrorsReply{}),
+			NewReply:     func() interface{} { return new(page.GetInstallabilityErrorsReply) },
+		},
+		{
+			Name:         "Page.getManifestIcons",
+			Domain:       "Page",
+			Description:  "Deprecated because it's not guaranteed that the returned icon is in fact the one used for PWA installation.",
+			Experimental: true,
+			Deprecated:   true,
+			Reply:        reflect.TypeOf(page.GetManifestIconsReply{}),
+			NewReply:     func() interface{} { return new(page.GetManifestIconsReply) },
+		},
+		{
+			Name:         "Page.getAppId",
+			Domain:       "Page",
+			Description:  "Returns the unique (PWA) app id.\nOnly returns values if the feature flag 'WebAppEnableManifestId' is enabled",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.GetAppIDReply{}),
+			NewReply:     func() interface{} { return new(page.GetAppIDReply) },
+		},
+		{
+			Name:         "Page.getAdScriptAncestry",
+			Domain:       "Page",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.GetAdScriptAncestryArgs{}),
+			NewArgs:      func() interface{} { return new(page.GetAdScriptAncestryArgs) },
+			Reply:        reflect.TypeOf(page.GetAdScriptAncestryReply{}),
+			NewReply:     func() interface{} { return new(page.GetAdScriptAncestryReply) },
+		},
+		{
+			Name:        "Page.getFrameTree",
+			Domain:      "Page",
+			Description: "Returns present frame tree structure.",
+			Reply:       reflect.TypeOf(page.GetFrameTreeReply{}),
+			NewReply:    func() interface{} { return new(page.GetFrameTreeReply) },
+		},
+		{
+			Name:        "Page.getLayoutMetrics",
+			Domain:      "Page",
+			Description: "Returns metrics relating to the layouting of the page, such as viewport bounds/scale.",
+			Reply:       reflect.TypeOf(page.GetLayoutMetricsReply{}),
+			NewReply:    func() interface{} { return new(page.GetLayoutMetricsReply) },
+		},
+		{
+			Name:        "Page.getNavigationHistory",
+			Domain:      "Page",
+			Description: "Returns navigation history for the current page.",
+			Reply:       reflect.TypeOf(page.GetNavigationHistoryReply{}),
+			NewReply:    func() interface{} { return new(page.GetNavigationHistoryReply) },
+		},
+		{
+			Name:        "Page.resetNavigationHistory",
+			Domain:      "Page",
+			Description: "Resets navigation history for the current page.",
+		},
+		{
+			Name:         "Page.getResourceContent",
+			Domain:       "Page",
+			Description:  "Returns content of the given resource.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.GetResourceContentArgs{}),
+			NewArgs:      func() interface{} { return new(page.GetResourceContentArgs) },
+			Reply:        reflect.TypeOf(page.GetResourceContentReply{}),
+			NewReply:     func() interface{} { return new(page.GetResourceContentReply) },
+		},
+		{
+			Name:         "Page.getResourceTree",
+			Domain:       "Page",
+			Description:  "Returns present frame / resource tree structure.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.GetResourceTreeReply{}),
+			NewReply:     func() interface{} { return new(page.GetResourceTreeReply) },
+		},
+		{
+			Name:        "Page.handleJavaScriptDialog",
+			Domain:      "Page",
+			Description: "Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).",
+			Args:        reflect.TypeOf(page.HandleJavaScriptDialogArgs{}),
+			NewArgs:     func() interface{} { return new(page.HandleJavaScriptDialogArgs) },
+		},
+		{
+			Name:        "Page.navigate",
+			Domain:      "Page",
+			Description: "Navigates current page to the given URL.",
+			Args:        reflect.TypeOf(page.NavigateArgs{}),
+			NewArgs:     func() interface{} { return new(page.NavigateArgs) },
+			Reply:       reflect.TypeOf(page.NavigateReply{}),
+			NewReply:    func() interface{} { return new(page.NavigateReply) },
+		},
+		{
+			Name:        "Page.navigateToHistoryEntry",
+			Domain:      "Page",
+			Description: "Navigates current page to the given history entry.",
+			Args:        reflect.TypeOf(page.NavigateToHistoryEntryArgs{}),
+			NewArgs:     func() interface{} { return new(page.NavigateToHistoryEntryArgs) },
+		},
+		{
+			Name:        "Page.printToPDF",
+			Domain:      "Page",
+			Description: "Print page as PDF.",
+			Args:        reflect.TypeOf(page.PrintToPDFArgs{}),
+			NewArgs:     func() interface{} { return new(page.PrintToPDFArgs) },
+			Reply:       reflect.TypeOf(page.PrintToPDFReply{}),
+			NewReply:    func() interface{} { return new(page.PrintToPDFReply) },
+		},
+		{
+			Name:        "Page.reload",
+			Domain:      "Page",
+			Description: "Reloads given page optionally ignoring the cache.",
+			Args:        reflect.TypeOf(page.ReloadArgs{}),
+			NewArgs:     func() interface{} { return new(page.ReloadArgs) },
+		},
+		{
+			Name:         "Page.removeScriptToEvaluateOnLoad",
+			Domain:       "Page",
+			Description:  "Deprecated, please use removeScriptToEvaluateOnNewDocument instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(page.RemoveScriptToEvaluateOnLoadArgs{}),
+			NewArgs:      func() interface{} { return new(page.RemoveScriptToEvaluateOnLoadArgs) },
+		},
+		{
+			Name:        "Page.removeScriptToEvaluateOnNewDocument",
+			Domain:      "Page",
+			Description: "Removes given script from the list.",
+			Args:        reflect.TypeOf(page.RemoveScriptToEvaluateOnNewDocumentArgs{}),
+			NewArgs:     func() interface{} { return new(page.RemoveScriptToEvaluateOnNewDocumentArgs) },
+		},
+		{
+			Name:         "Page.screencastFrameAck",
+			Domain:       "Page",
+			Description:  "Acknowledges that a screencast frame has been received by the frontend.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.ScreencastFrameAckArgs{}),
+			NewArgs:      func() interface{} { return new(page.ScreencastFrameAckArgs) },
+		},
+		{
+			Name:         "Page.searchInResource",
+			Domain:       "Page",
+			Description:  "Searches for given string in resource content.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SearchInResourceArgs{}),
+			NewArgs:      func() interface{} { return new(page.SearchInResourceArgs) },
+			Reply:        reflect.TypeOf(page.SearchInResourceReply{}),
+			NewReply:     func() interface{} { return new(page.SearchInResourceReply) },
+		},
+		{
+			Name:         "Page.setAdBlockingEnabled",
+			Domain:       "Page",
+			Description:  "Enable Chrome's experimental ad filter on all sites.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetAdBlockingEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetAdBlockingEnabledArgs) },
+		},
+		{
+			Name:        "Page.setBypassCSP",
+			Domain:      "Page",
+			Description: "Enable page Content Security Policy by-passing.",
+			Args:        reflect.TypeOf(page.SetBypassCSPArgs{}),
+			NewArgs:     func() interface{} { return new(page.SetBypassCSPArgs) },
+		},
+		{
+			Name:         "Page.getPermissionsPolicyState",
+			Domain:       "Page",
+			Description:  "Get Permissions Policy state on given frame.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.GetPermissionsPolicyStateArgs{}),
+			NewArgs:      func() interface{} { return new(page.GetPermissionsPolicyStateArgs) },
+			Reply:        reflect.TypeOf(page.GetPermissionsPolicyStateReply{}),
+			NewReply:     func() interface{} { return new(page.GetPermissionsPolicyStateReply) },
+		},
+		{
+			Name:         "Page.getOriginTrials",
+			Domain:       "Page",
+			Description:  "Get Origin Trials on given frame.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.GetOriginTrialsArgs{}),
+			NewArgs:      func() interface{} { return new(page.GetOriginTrialsArgs) },
+			Reply:        reflect.TypeOf(page.GetOriginTrialsReply{}),
+			NewReply:     func() interface{} { return new(page.GetOriginTrialsReply) },
+		},
+		{
+			Name:         "Page.setFontFamilies",
+			Domain:       "Page",
+			Description:  "Set generic font families.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetFontFamiliesArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetFontFamiliesArgs) },
+		},
+		{
+			Name:         "Page.setFontSizes",
+			Domain:       "Page",
+			Description:  "Set default font sizes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetFontSizesArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetFontSizesArgs) },
+		},
+		{
+			Name:        "Page.setDocumentContent",
+			Domain:      "Page",
+			Description: "Sets given markup as the document's HTML.",
+			Args:        reflect.TypeOf(page.SetDocumentContentArgs{}),
+			NewArgs:     func() interface{} { return new(page.SetDocumentContentArgs) },
+		},
+		{
+			Name:         "Page.setDownloadBehavior",
+			Domain:       "Page",
+			Description:  "Set the behavior when downloading a file.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(page.SetDownloadBehaviorArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetDownloadBehaviorArgs) },
+		},
+		{
+			Name:        "Page.setLifecycleEventsEnabled",
+			Domain:      "Page",
+			Description: "Controls whether page will emit lifecycle events.",
+			Args:        reflect.TypeOf(page.SetLifecycleEventsEnabledArgs{}),
+			NewArgs:     func() interface{} { return new(page.SetLifecycleEventsEnabledArgs) },
+		},
+		{
+			Name:         "Page.startScreencast",
+			Domain:       "Page",
+			Description:  "Starts sending each frame using the `screencastFrame` event.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.StartScreencastArgs{}),
+			NewArgs:      func() interface{} { return new(page.StartScreencastArgs) },
+		},
+		{
+			Name:        "Page.stopLoading",
+			Domain:      "Page",
+			Description: "Force the page stop all navigations and pending resource fetches.",
+		},
+		{
+			Name:         "Page.crash",
+			Domain:       "Page",
+			Description:  "Crashes renderer on the IO thread, generates minidumps.",
+			Experimental: true,
+		},
+		{
+			Name:        "Page.close",
+			Domain:      "Page",
+			Description: "Tries to close page, running its beforeunload hooks, if any.",
+		},
+		{
+			Name:         "Page.setWebLifecycleState",
+			Domain:       "Page",
+			Description:  "Tries to update the web lifecycle state of the page.\nIt will transition the page to the given state according to:\nhttps://github.com/WICG/web-lifecycle/",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetWebLifecycleStateArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetWebLifecycleStateArgs) },
+		},
+		{
+			Name:         "Page.stopScreencast",
+			Domain:       "Page",
+			Description:  "Stops sending each frame in the `screencastFrame`.",
+			Experimental: true,
+		},
+		{
+			Name:         "Page.produceCompilationCache",
+			Domain:       "Page",
+			Description:  "Requests backend to produce compilation cache for the specified scripts.\n`scripts` are appended to the list of scripts for which the cache\nwould be produced. The list may be reset during page navigation.\nWhen script with a matching URL is encountered, the cache is optionally\nproduced upon backend discretion, based on internal heuristics.\nSee also: `Page.compilationCacheProduced`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.ProduceCompilationCacheArgs{}),
+			NewArgs:      func() interface{} { return new(page.ProduceCompilationCacheArgs) },
+		},
+		{
+			Name:         "Page.addCompilationCache",
+			Domain:       "Page",
+			Description:  "Seeds compilation cache for given url. Compilation cache does not survive\ncross-process navigation.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.AddCompilationCacheArgs{}),
+			NewArgs:      func() interface{} { return new(page.AddCompilationCacheArgs) },
+		},
+		{
+			Name:         "Page.clearCompilationCache",
+			Domain:       "Page",
+			Description:  "Clears seeded compilation cache.",
+			Experimental: true,
+		},
+		{
+			Name:         "Page.setSPCTransactionMode",
+			Domain:       "Page",
+			Description:  "Sets the Secure Payment Confirmation transaction mode.\nhttps://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetSPCTransactionModeArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetSPCTransactionModeArgs) },
+		},
+		{
+			Name:         "Page.setRPHRegistrationMode",
+			Domain:       "Page",
+			Description:  "Extensions for Custom Handlers API:\nhttps://html.spec.whatwg.org/multipage/system-state.html#rph-automation",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetRPHRegistrationModeArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetRPHRegistrationModeArgs) },
+		},
+		{
+			Name:         "Page.generateTestReport",
+			Domain:       "Page",
+			Description:  "Generates a report for testing.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.GenerateTestReportArgs{}),
+			NewArgs:      func() interface{} { return new(page.GenerateTestReportArgs) },
+		},
+		{
+			Name:         "Page.waitForDebugger",
+			Domain:       "Page",
+			Description:  "Pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.",
+			Experimental: true,
+		},
+		{
+			Name:        "Page.setInterceptFileChooserDialog",
+			Domain:      "Page",
+			Description: "Intercept file chooser requests and transfer control to protocol clients.\nWhen file chooser interception is enabled, native file chooser dialog is not shown.\nInstead, a protocol event `Page.fileChooserOpened` is emitted.",
+			Args:        reflect.TypeOf(page.SetInterceptFileChooserDialogArgs{}),
+			NewArgs:     func() interface{} { return new(page.SetInterceptFileChooserDialogArgs) },
+		},
+		{
+			Name:         "Page.setPrerenderingAllowed",
+			Domain:       "Page",
+			Description:  "Enable/disable prerendering manually.\n\nThis command is a short-term solution for https://crbug.com/1440085.\nSee https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA\nfor more details.\n\nTODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.SetPrerenderingAllowedArgs{}),
+			NewArgs:      func() interface{} { return new(page.SetPrerenderingAllowedArgs) },
+		},
+		{
+			Name:         "Page.getAnnotatedPageContent",
+			Domain:       "Page",
+			Description:  "Get the annotated page content for the main frame.\nThis is an experimental command that is subject to change.",
+			Experimental: true,
+			Args:         reflect.TypeOf(page.GetAnnotatedPageContentArgs{}),
+			NewArgs:      func() interface{} { return new(page.GetAnnotatedPageContentArgs) },
+			Reply:        reflect.TypeOf(page.GetAnnotatedPageContentReply{}),
+			NewReply:     func() interface{} { return new(page.GetAnnotatedPageContentReply) },
+		},
+		{
+			Name:        "Performance.disable",
+			Domain:      "Performance",
+			Description: "Disable collecting and reporting metrics.",
+		},
+		{
+			Name:        "Performance.enable",
+			Domain:      "Performance",
+			Description: "Enable collecting and reporting metrics.",
+			Args:        reflect.TypeOf(performance.EnableArgs{}),
+			NewArgs:     func() interface{} { return new(performance.EnableArgs) },
+		},
+		{
+			Name:         "Performance.setTimeDomain",
+			Domain:       "Performance",
+			Description:  "Sets time domain to use for collecting and reporting duration metrics.\nNote that this must be called before enabling metrics collection. Calling\nthis method while metrics collection is enabled returns an error.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(performance.SetTimeDomainArgs{}),
+			NewArgs:      func() interface{} { return new(performance.SetTimeDomainArgs) },
+		},
+		{
+			Name:        "Performance.getMetrics",
+			Domain:      "Performance",
+			Description: "Retrieve current values of run-time metrics.",
+			Reply:       reflect.TypeOf(performance.GetMetricsReply{}),
+			NewReply:    func() interface{} { return new(performance.GetMetricsReply) },
+		},
+		{
+			Name:         "PerformanceTimeline.enable",
+			Domain:       "PerformanceTimeline",
+			Description:  "Previously buffered events would be reported before method returns.\nSee also: timelineEventAdded",
+			Experimental: true,
+			Args:         reflect.TypeOf(performancetimeline.EnableArgs{}),
+			NewArgs:      func() interface{} { return new(performancetimeline.EnableArgs) },
+		},
+		{
+			Name:         "Preload.enable",
+			Domain:       "Preload",
+			Experimental: true,
+		},
+		{
+			Name:         "Preload.disable",
+			Domain:       "Preload",
+			Experimental: true,
+		},
+		{
+			Name:   "Profiler.disable",
+			Domain: "Profiler",
+		},
+		{
+			Name:   "Profiler.enable",
+			Domain: "Profiler",
+		},
+		{
+			Name:        "Profiler.getBestEffortCoverage",
+			Domain:      "Profiler",
+			Description: "Collect coverage data for the current isolate. The coverage data may be incomplete due to\ngarbage collection.",
+			Reply:       reflect.TypeOf(profiler.GetBestEffortCoverageReply{}),
+			NewReply:    func() interface{} { return new(profiler.GetBestEffortCoverageReply) },
+		},
+		{
+			Name:        "Profiler.setSamplingInterval",
+			Domain:      "Profiler",
+			Description: "Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.",
+			Args:        reflect.TypeOf(profiler.SetSamplingIntervalArgs{}),
+			NewArgs:     func() interface{} { return new(profiler.SetSamplingIntervalArgs) },
+		},
+		{
+			Name:   "Profiler.start",
+			Domain: "Profiler",
+		},
+		{
+			Name:        "Profiler.startPreciseCoverage",
+			Domain:      "Profiler",
+			Description: "Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code\ncoverage may be incomplete. Enabling prevents running optimized code and resets execution\ncounters.",
+			Args:        reflect.TypeOf(profiler.StartPreciseCoverageArgs{}),
+			NewArgs:     func() interface{} { return new(profiler.StartPreciseCoverageArgs) },
+			Reply:       reflect.TypeOf(profiler.StartPreciseCoverageReply{}),
+			NewReply:    func() interface{} { return new(profiler.StartPreciseCoverageReply) },
+		},
+		{
+			Name:     "Profiler.stop",
+			Domain:   "Profiler",
+			Reply:    reflect.TypeOf(profiler.StopReply{}),
+			NewReply: func() interface{} { return new(profiler.StopReply) },
+		},
+		{
+			Name:        "Profiler.stopPreciseCoverage",
+			Domain:      "Profiler",
+			Description: "Disable precise code coverage. Disabling releases unnecessary execution count records and allows\nexecuting optimized code.",
+		},
+		{
+			Name:        "Profiler.takePreciseCoverage",
+			Domain:      "Profiler",
+			Description: "Collect coverage data for the current isolate, and resets execution counters. Precise code\ncoverage needs to have started.",
+			Reply:       reflect.TypeOf(profiler.TakePreciseCoverageReply{}),
+			NewReply:    func() interface{} { return new(profiler.TakePreciseCoverageReply) },
+		},
+		{
+			Name:        "Runtime.awaitPromise",
+			Domain:      "Runtime",
+			Description: "Add handler to promise with given promise object id.",
+			Args:        reflect.TypeOf(runtime.AwaitPromiseArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.AwaitPromiseArgs) },
+			Reply:       reflect.TypeOf(runtime.AwaitPromiseReply{}),
+			NewReply:    func() interface{} { return new(runtime.AwaitPromiseReply) },
+		},
+		{
+			Name:        "Runtime.callFunctionOn",
+			Domain:      "Runtime",
+			Description: "Calls function with given declaration on the given object. Object group of the result is\ninherited from the target object.",
+			Args:        reflect.TypeOf(runtime.CallFunctionOnArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.CallFunctionOnArgs) },
+			Reply:       reflect.TypeOf(runtime.CallFunctionOnReply{}),
+			NewReply:    func() interface{} { return new(runtime.CallFunctionOnReply) },
+		},
+		{
+			Name:        "Runtime.compileScript",
+			Domain:      "Runtime",
+			Description: "Compiles expression.",
+			Args:        reflect.TypeOf(runtime.CompileScriptArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.CompileScriptArgs) },
+			Reply:       reflect.TypeOf(runtime.CompileScriptReply{}),
+			NewReply:    func() interface{} { return new(runtime.CompileScriptReply) },
+		},
+		{
+			Name:        "Runtime.disable",
+			Domain:      "Runtime",
+			Description: "Disables reporting of execution contexts creation.",
+		},
+		{
+			Name:        "Runtime.discardConsoleEntries",
+			Domain:      "Runtime",
+			Description: "Discards collected exceptions and console API calls.",
+		},
+		{
+			Name:        "Runtime.enable",
+			Domain:      "Runtime",
+			Description: "Enables reporting of execution contexts creation by means of `executionContextCreated` event.\nWhen the reporting gets enabled the event will be sent immediately for each existing execution\ncontext.",
+		},
+		{
+			Name:        "Runtime.evaluate",
+			Domain:      "Runtime",
+			Description: "Evaluates expression on global object.",
+			Args:        reflect.TypeOf(runtime.EvaluateArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.EvaluateArgs) },
+			Reply:       reflect.TypeOf(runtime.EvaluateReply{}),
+			NewReply:    func() interface{} { return new(runtime.EvaluateReply) },
+		},
+		{
+			Name:         "Runtime.getIsolateId",
+			Domain:       "Runtime",
+			Description:  "Returns the isolate id.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(runtime.GetIsolateIDReply{}),
+			NewReply:     func() interface{} { return new(runtime.GetIsolateIDReply) },
+		},
+		{
+			Name:         "Runtime.getHeapUsage",
+			Domain:       "Runtime",
+			Description:  "Returns the JavaScript heap usage.\nIt is the total usage of the corresponding isolate not scoped to a particular Runtime.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(runtime.GetHeapUsageReply{}),
+			NewReply:     func() interface{} { return new(runtime.GetHeapUsageReply) },
+		},
+		{
+			Name:        "Runtime.getProperties",
+			Domain:      "Runtime",
+			Description: "Returns properties of a given object. Object group of the result is inherited from the target\nobject.",
+			Args:        reflect.TypeOf(runtime.GetPropertiesArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.GetPropertiesArgs) },
+			Reply:       reflect.TypeOf(runtime.GetPropertiesReply{}),
+			NewReply:    func() interface{} { return new(runtime.GetPropertiesReply) },
+		},
+		{
+			Name:        "Runtime.globalLexicalScopeNames",
+			Domain:      "Runtime",
+			Description: "Returns all let, const and class variables from global scope.",
+			Args:        reflect.TypeOf(runtime.GlobalLexicalScopeNamesArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.GlobalLexicalScopeNamesArgs) },
+			Reply:       reflect.TypeOf(runtime.GlobalLexicalScopeNamesReply{}),
+			NewReply:    func() interface{} { return new(runtime.GlobalLexicalScopeNamesReply) },
+		},
+		{
+			Name:     "Runtime.queryObjects",
+			Domain:   "Runtime",
+			Args:     reflect.TypeOf(runtime.QueryObjectsArgs{}),
+			NewArgs:  func() interface{} { return new(runtime.QueryObjectsArgs) },
+			Reply:    reflect.TypeOf(runtime.QueryObjectsReply{}),
+			NewReply: func() interface{} { return new(runtime.QueryObjectsReply) },
+		},
+		{
+			Name:        "Runtime.releaseObject",
+			Domain:      "Runtime",
+			Description: "Releases remote object with given id.",
+			Args:        reflect.TypeOf(runtime.ReleaseObjectArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.ReleaseObjectArgs) },
+		},
+		{
+			Name:        "Runtime.releaseObjectGroup",
+			Domain:      "Runtime",
+			Description: "Releases all remote objects that belong to a given group.",
+			Args:        reflect.TypeOf(runtime.ReleaseObjectGroupArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.ReleaseObjectGroupArgs) },
+		},
+		{
+			Name:        "Runtime.runIfWaitingForDebugger",
+			Domain:      "Runtime",
+			Description: "Tells inspected instance to run if it was waiting for debugger to attach.",
+		},
+		{
+			Name:        "Runtime.runScript",
+			Domain:      "Runtime",
+			Description: "Runs script with given id in a given context.",
+			Args:        reflect.TypeOf(runtime.RunScriptArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.RunScriptArgs) },
+			Reply:       reflect.TypeOf(runtime.RunScriptReply{}),
+			NewReply:    func() interface{} { return new(runtime.RunScriptReply) },
+		},
+		{
+			Name:         "Runtime.setCustomObjectFormatterEnabled",
+			Domain:       "Runtime",
+			Experimental: true,
+			Args:         reflect.TypeOf(runtime.SetCustomObjectFormatterEnabledArgs{}),
+			NewArgs:      func() interface{} { return new(runtime.SetCustomObjectFormatterEnabledArgs) },
+		},
+		{
+			Name:         "Runtime.setMaxCallStackSizeToCapture",
+			Domain:       "Runtime",
+			Experimental: true,
+			Args:         reflect.TypeOf(runtime.SetMaxCallStackSizeToCaptureArgs{}),
+			NewArgs:      func() interface{} { return new(runtime.SetMaxCallStackSizeToCaptureArgs) },
+		},
+		{
+			Name:         "Runtime.terminateExecution",
+			Domain:       "Runtime",
+			Description:  "Terminate current or next JavaScript execution.\nWill cancel the termination when the outer-most script execution ends.",
+			Experimental: true,
+		},
+		{
+			Name:        "Runtime.addBinding",
+			Domain:      "Runtime",
+			Description: "If executionContextId is empty, adds binding with the given name on the\nglobal objects of all inspected contexts, including those created later,\nbindings survive reloads.\nBinding function takes exactly one argument, this argument should be string,\nin case of any other input, function throws an exception.\nEach binding function call produces Runtime.bindingCalled notification.",
+			Args:        reflect.TypeOf(runtime.AddBindingArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.AddBindingArgs) },
+		},
+		{
+			Name:        "Runtime.removeBinding",
+			Domain:      "Runtime",
+			Description: "This method does not remove binding function from global object but\nunsubscribes current runtime agent from Runtime.bindingCalled notifications.",
+			Args:        reflect.TypeOf(runtime.RemoveBindingArgs{}),
+			NewArgs:     func() interface{} { return new(runtime.RemoveBindingArgs) },
+		},
+		{
+			Name:         "Runtime.getExceptionDetails",
+			Domain:       "Runtime",
+			Description:  "This method tries to lookup and populate exception details for a\nJavaScript Error object.\nNote that the stackTrace portion of the resulting exceptionDetails will\nonly be populated if the Runtime domain was enabled at the time when the\nError was thrown.",
+			Experimental: true,
+			Args:         reflect.TypeOf(runtime.GetExceptionDetailsArgs{}),
+			NewArgs:      func() interface{} { return new(runtime.GetExceptionDetailsArgs) },
+			Reply:        reflect.TypeOf(runtime.GetExceptionDetailsReply{}),
+			NewReply:     func() interface{} { return new(runtime.GetExceptionDetailsReply) },
+		},
+		{
+			Name:        "Schema.getDomains",
+			Domain:      "Schema",
+			Description: "Returns supported domains.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(schema.GetDomainsReply{}),
+			NewReply:    func() interface{} { return new(schema.GetDomainsReply) },
+		},
+		{
+			Name:        "Security.disable",
+			Domain:      "Security",
+			Description: "Disables tracking security state changes.",
+		},
+		{
+			Name:        "Security.enable",
+			Domain:      "Security",
+			Description: "Enables tracking security state changes.",
+		},
+		{
+			Name:        "Security.setIgnoreCertificateErrors",
+			Domain:      "Security",
+			Description: "Enable/disable whether all certificate errors should be ignored.",
+			Args:        reflect.TypeOf(security.SetIgnoreCertificateErrorsArgs{}),
+			NewArgs:     func() interface{} { return new(security.SetIgnoreCertificateErrorsArgs) },
+		},
+		{
+			Name:        "Security.handleCertificateError",
+			Domain:      "Security",
+			Description: "Handles a certificate error that fired a certificateError event.",
+			Deprecated:  true,
+			Args:        reflect.TypeOf(security.HandleCertificateErrorArgs{}),
+			NewArgs:     func() interface{} { return new(security.HandleCertificateErrorArgs) },
+		},
+		{
+			Name:        "Security.setOverrideCertificateErrors",
+			Domain:      "Security",
+			Description: "Enable/disable overriding certificate errors. If enabled, all certificate error events need to\nbe handled by the DevTools client and should be answered with `handleCertificateError` commands.",
+			Deprecated:  true,
+			Args:        reflect.TypeOf(security.SetOverrideCertificateErrorsArgs{}),
+			NewArgs:     func() interface{} { return new(security.SetOverrideCertificateErrorsArgs) },
+		},
+		{
+			Name:         "ServiceWorker.deliverPushMessage",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.DeliverPushMessageArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.DeliverPushMessageArgs) },
+		},
+		{
+			Name:         "ServiceWorker.disable",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+		},
+		{
+			Name:         "ServiceWorker.dispatchSyncEvent",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.DispatchSyncEventArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.DispatchSyncEventArgs) },
+		},
+		{
+			Name:         "ServiceWorker.dispatchPeriodicSyncEvent",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.DispatchPeriodicSyncEventArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.DispatchPeriodicSyncEventArgs) },
+		},
+		{
+			Name:         "ServiceWorker.enable",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+		},
+		{
+			Name:         "ServiceWorker.setForceUpdateOnPageLoad",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.SetForceUpdateOnPageLoadArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.SetForceUpdateOnPageLoadArgs) },
+		},
+		{
+			Name:         "ServiceWorker.skipWaiting",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.SkipWaitingArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.SkipWaitingArgs) },
+		},
+		{
+			Name:         "ServiceWorker.startWorker",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.StartWorkerArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.StartWorkerArgs) },
+		},
+		{
+			Name:         "ServiceWorker.stopAllWorkers",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+		},
+		{
+			Name:         "ServiceWorker.stopWorker",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.StopWorkerArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.StopWorkerArgs) },
+		},
+		{
+			Name:         "ServiceWorker.unregister",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.UnregisterArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.UnregisterArgs) },
+		},
+		{
+			Name:         "ServiceWorker.updateRegistration",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Args:         reflect.TypeOf(serviceworker.UpdateRegistrationArgs{}),
+			NewArgs:      func() interface{} { return new(serviceworker.UpdateRegistrationArgs) },
+		},
+		{
+			Name:         "Storage.getStorageKeyForFrame",
+			Domain:       "Storage",
+			Description:  "Returns a storage key given a frame id.\nDeprecated. Please use Storage.getStorageKey instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Args:         reflect.TypeOf(storage.GetStorageKeyForFrameArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetStorageKeyForFrameArgs) },
+			Reply:        reflect.TypeOf(storage.GetStorageKeyForFrameReply{}),
+			NewReply:     func() interface{} { return new(storage.GetStorageKeyForFrameReply) },
+		},
+		{
+			Name:         "Storage.getStorageKey",
+			Domain:       "Storage",
+			Description:  "Returns storage key for the given frame. If no frame ID is provided,\nthe storage key of the target executing this command is returned.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetStorageKeyArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetStorageKeyArgs) },
+			Reply:        reflect.TypeOf(storage.GetStorageKeyReply{}),
+			NewReply:     func() interface{} { return new(storage.GetStorageKeyReply) },
+		},
+		{
+			Name:         "Storage.clearDataForOrigin",
+			Domain:       "Storage",
+			Description:  "Clears storage for origin.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.ClearDataForOriginArgs{}),
+			NewArgs:      func() interface{} { return new(storage.ClearDataForOriginArgs) },
+		},
+		{
+			Name:         "Storage.clearDataForStorageKey",
+			Domain:       "Storage",
+			Description:  "Clears storage for storage key.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.ClearDataForStorageKeyArgs{}),
+			NewArgs:      func() interface{} { return new(storage.ClearDataForStorageKeyArgs) },
+		},
+		{
+			Name:         "Storage.getCookies",
+			Domain:       "Storage",
+			Description:  "Returns all browser cookies.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetCookiesArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetCookiesArgs) },
+			Reply:        reflect.TypeOf(storage.GetCookiesReply{}),
+			NewReply:     func() interface{} { return new(storage.GetCookiesReply) },
+		},
+		{
+			Name:         "Storage.setCookies",
+			Domain:       "Storage",
+			Description:  "Sets given cookies.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetCookiesArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetCookiesArgs) },
+		},
+		{
+			Name:         "Storage.clearCookies",
+			Domain:       "Storage",
+			Description:  "Clears cookies.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.ClearCookiesArgs{}),
+			NewArgs:      func() interface{} { return new(storage.ClearCookiesArgs) },
+		},
+		{
+			Name:         "Storage.getUsageAndQuota",
+			Domain:       "Storage",
+			Description:  "Returns usage and quota in bytes.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetUsageAndQuotaArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetUsageAndQuotaArgs) },
+			Reply:        reflect.TypeOf(storage.GetUsageAndQuotaReply{}),
+			NewReply:     func() interface{} { return new(storage.GetUsageAndQuotaReply) },
+		},
+		{
+			Name:         "Storage.overrideQuotaForOrigin",
+			Domain:       "Storage",
+			Description:  "Override quota for the specified origin",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.OverrideQuotaForOriginArgs{}),
+			NewArgs:      func() interface{} { return new(storage.OverrideQuotaForOriginArgs) },
+		},
+		{
+			Name:         "Storage.trackCacheStorageForOrigin",
+			Domain:       "Storage",
+			Description:  "Registers origin to be notified when an update occurs to its cache storage list.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.TrackCacheStorageForOriginArgs{}),
+			NewArgs:      func() interface{} { return new(storage.TrackCacheStorageForOriginArgs) },
+		},
+		{
+			Name:         "Storage.trackCacheStorageForStorageKey",
+			Domain:       "Storage",
+			Description:  "Registers storage key to be notified when an update occurs to its cache storage list.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.TrackCacheStorageForStorageKeyArgs{}),
+			NewArgs:      func() interface{} { return new(storage.TrackCacheStorageForStorageKeyArgs) },
+		},
+		{
+			Name:         "Storage.trackIndexedDBForOrigin",
+			Domain:       "Storage",
+			Description:  "Registers origin to be notified when an update occurs to its IndexedDB.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.TrackIndexedDBForOriginArgs{}),
+			NewArgs:      func() interface{} { return new(storage.TrackIndexedDBForOriginArgs) },
+		},
+		{
+			Name:         "Storage.trackIndexedDBForStorageKey",
+			Domain:       "Storage",
+			Description:  "Registers storage key to be notified when an update occurs to its IndexedDB.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.TrackIndexedDBForStorageKeyArgs{}),
+			NewArgs:      func() interface{} { return new(storage.TrackIndexedDBForStorageKeyArgs) },
+		},
+		{
+			Name:         "Storage.untrackCacheStorageForOrigin",
+			Domain:       "Storage",
+			Description:  "Unregisters origin from receiving notifications for cache storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.UntrackCacheStorageForOriginArgs{}),
+			NewArgs:      func() interface{} { return new(storage.UntrackCacheStorageForOriginArgs) },
+		},
+		{
+			Name:         "Storage.untrackCacheStorageForStorageKey",
+			Domain:       "Storage",
+			Description:  "Unregisters storage key from receiving notifications for cache storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.UntrackCacheStorageForStorageKeyArgs{}),
+			NewArgs:      func() interface{} { return new(storage.UntrackCacheStorageForStorageKeyArgs) },
+		},
+		{
+			Name:         "Storage.untrackIndexedDBForOrigin",
+			Domain:       "Storage",
+			Description:  "Unregisters origin from receiving notifications for IndexedDB.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.UntrackIndexedDBForOriginArgs{}),
+			NewArgs:      func() interface{} { return new(storage.UntrackIndexedDBForOriginArgs) },
+		},
+		{
+			Name:         "Storage.untrackIndexedDBForStorageKey",
+			Domain:       "Storage",
+			Description:  "Unregisters storage key from receiving notifications for IndexedDB.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.UntrackIndexedDBForStorageKeyArgs{}),
+			NewArgs:      func() interface{} { return new(storage.UntrackIndexedDBForStorageKeyArgs) },
+		},
+		{
+			Name:         "Storage.getTrustTokens",
+			Domain:       "Storage",
+			Description:  "Returns the number of stored Trust Tokens per issuer for the\ncurrent browsing context.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.GetTrustTokensReply{}),
+			NewReply:     func() interface{} { return new(storage.GetTrustTokensReply) },
+		},
+		{
+			Name:         "Storage.clearTrustTokens",
+			Domain:       "Storage",
+			Description:  "Removes all Trust Tokens issued by the provided issuerOrigin.\nLeaves other stored data, including the issuer's Redemption Records, intact.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.ClearTrustTokensArgs{}),
+			NewArgs:      func() interface{} { return new(storage.ClearTrustTokensArgs) },
+			Reply:        reflect.TypeOf(storage.ClearTrustTokensReply{}),
+			NewReply:     func() interface{} { return new(storage.ClearTrustTokensReply) },
+		},
+		{
+			Name:         "Storage.getInterestGroupDetails",
+			Domain:       "Storage",
+			Description:  "Gets details for a named interest group.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetInterestGroupDetailsArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetInterestGroupDetailsArgs) },
+			Reply:        reflect.TypeOf(storage.GetInterestGroupDetailsReply{}),
+			NewReply:     func() interface{} { return new(storage.GetInterestGroupDetailsReply) },
+		},
+		{
+			Name:         "Storage.setInterestGroupTracking",
+			Domain:       "Storage",
+			Description:  "Enables/Disables issuing of interestGroupAccessed events.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetInterestGroupTrackingArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetInterestGroupTrackingArgs) },
+		},
+		{
+			Name:         "Storage.setInterestGroupAuctionTracking",
+			Domain:       "Storage",
+			Description:  "Enables/Disables issuing of interestGroupAuctionEventOccurred and\ninterestGroupAuctionNetworkRequestCreated.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetInterestGroupAuctionTrackingArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetInterestGroupAuctionTrackingArgs) },
+		},
+		{
+			Name:         "Storage.getSharedStorageMetadata",
+			Domain:       "Storage",
+			Description:  "Gets metadata for an origin's shared storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetSharedStorageMetadataArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetSharedStorageMetadataArgs) },
+			Reply:        reflect.TypeOf(storage.GetSharedStorageMetadataReply{}),
+			NewReply:     func() interface{} { return new(storage.GetSharedStorageMetadataReply) },
+		},
+		{
+			Name:         "Storage.getSharedStorageEntries",
+			Domain:       "Storage",
+			Description:  "Gets the entries in an given origin's shared storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetSharedStorageEntriesArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetSharedStorageEntriesArgs) },
+			Reply:        reflect.TypeOf(storage.GetSharedStorageEntriesReply{}),
+			NewReply:     func() interface{} { return new(storage.GetSharedStorageEntriesReply) },
+		},
+		{
+			Name:         "Storage.setSharedStorageEntry",
+			Domain:       "Storage",
+			Description:  "Sets entry with `key` and `value` for a given origin's shared storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetSharedStorageEntryArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetSharedStorageEntryArgs) },
+		},
+		{
+			Name:         "Storage.deleteSharedStorageEntry",
+			Domain:       "Storage",
+			Description:  "Deletes entry for `key` (if it exists) for a given origin's shared storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.DeleteSharedStorageEntryArgs{}),
+			NewArgs:      func() interface{} { return new(storage.DeleteSharedStorageEntryArgs) },
+		},
+		{
+			Name:         "Storage.clearSharedStorageEntries",
+			Domain:       "Storage",
+			Description:  "Clears all entries for a given origin's shared storage.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.ClearSharedStorageEntriesArgs{}),
+			NewArgs:      func() interface{} { return new(storage.ClearSharedStorageEntriesArgs) },
+		},
+		{
+			Name:         "Storage.resetSharedStorageBudget",
+			Domain:       "Storage",
+			Description:  "Resets the budget for `ownerOrigin` by clearing all budget withdrawals.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.ResetSharedStorageBudgetArgs{}),
+			NewArgs:      func() interface{} { return new(storage.ResetSharedStorageBudgetArgs) },
+		},
+		{
+			Name:         "Storage.setSharedStorageTracking",
+			Domain:       "Storage",
+			Description:  "Enables/disables issuing of sharedStorageAccessed events.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetSharedStorageTrackingArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetSharedStorageTrackingArgs) },
+		},
+		{
+			Name:         "Storage.setStorageBucketTracking",
+			Domain:       "Storage",
+			Description:  "Set tracking for a storage key's buckets.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetStorageBucketTrackingArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetStorageBucketTrackingArgs) },
+		},
+		{
+			Name:         "Storage.deleteStorageBucket",
+			Domain:       "Storage",
+			Description:  "Deletes the Storage Bucket with the given storage key and bucket name.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.DeleteStorageBucketArgs{}),
+			NewArgs:      func() interface{} { return new(storage.DeleteStorageBucketArgs) },
+		},
+		{
+			Name:         "Storage.runBounceTrackingMitigations",
+			Domain:       "Storage",
+			Description:  "Deletes state for sites identified as potential bounce trackers, immediately.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.RunBounceTrackingMitigationsReply{}),
+			NewReply:     func() interface{} { return new(storage.RunBounceTrackingMitigationsReply) },
+		},
+		{
+			Name:         "Storage.setAttributionReportingLocalTestingMode",
+			Domain:       "Storage",
+			Description:  "https://wicg.github.io/attribution-reporting-api/",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetAttributionReportingLocalTestingModeArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetAttributionReportingLocalTestingModeArgs) },
+		},
+		{
+			Name:         "Storage.setAttributionReportingTracking",
+			Domain:       "Storage",
+			Description:  "Enables/disables issuing of Attribution Reporting events.",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetAttributionReportingTrackingArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetAttributionReportingTrackingArgs) },
+		},
+		{
+			Name:         "Storage.sendPendingAttributionReports",
+			Domain:       "Storage",
+			Description:  "Sends all pending Attribution Reports immediately, regardless of their\nscheduled report time.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.SendPendingAttributionReportsReply{}),
+			NewReply:     func() interface{} { return new(storage.SendPendingAttributionReportsReply) },
+		},
+		{
+			Name:         "Storage.getRelatedWebsiteSets",
+			Domain:       "Storage",
+			Description:  "Returns the effective Related Website Sets in use by this profile for the browser\nsession. The effective Related Website Sets will not change during a browser session.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.GetRelatedWebsiteSetsReply{}),
+			NewReply:     func() interface{} { return new(storage.GetRelatedWebsiteSetsReply) },
+		},
+		{
+			Name:         "Storage.getAffectedUrlsForThirdPartyCookieMetadata",
+			Domain:       "Storage",
+			Description:  "Returns the list of URLs from a page and its embedded resources that match\nexisting grace period URL pattern rules.\nhttps://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.GetAffectedURLsForThirdPartyCookieMetadataArgs{}),
+			NewArgs:      func() interface{} { return new(storage.GetAffectedURLsForThirdPartyCookieMetadataArgs) },
+			Reply:        reflect.TypeOf(storage.GetAffectedURLsForThirdPartyCookieMetadataReply{}),
+			NewReply:     func() interface{} { return new(storage.GetAffectedURLsForThirdPartyCookieMetadataReply) },
+		},
+		{
+			Name:         "Storage.setProtectedAudienceKAnonymity",
+			Domain:       "Storage",
+			Experimental: true,
+			Args:         reflect.TypeOf(storage.SetProtectedAudienceKAnonymityArgs{}),
+			NewArgs:      func() interface{} { return new(storage.SetProtectedAudienceKAnonymityArgs) },
+		},
+		{
+			Name:         "SystemInfo.getInfo",
+			Domain:       "SystemInfo",
+			Description:  "Returns information about the system.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(systeminfo.GetInfoReply{}),
+			NewReply:     func() interface{} { return new(systeminfo.GetInfoReply) },
+		},
+		{
+			Name:         "SystemInfo.getFeatureState",
+			Domain:       "SystemInfo",
+			Description:  "Returns information about the feature state.",
+			Experimental: true,
+			Args:         reflect.TypeOf(systeminfo.GetFeatureStateArgs{}),
+			NewArgs:      func() interface{} { return new(systeminfo.GetFeatureStateArgs) },
+			Reply:        reflect.TypeOf(systeminfo.GetFeatureStateReply{}),
+			NewReply:     func() interface{} { return new(systeminfo.GetFeatureStateReply) },
+		},
+		{
+			Name:         "SystemInfo.getProcessInfo",
+			Domain:       "SystemInfo",
+			Description:  "Returns information about all running processes.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(systeminfo.GetProcessInfoReply{}),
+			NewReply:     func() interface{} { return new(systeminfo.GetProcessInfoReply) },
+		},
+		{
+			Name:        "Target.activateTarget",
+			Domain:      "Target",
+			Description: "Activates (focuses) the target.",
+			Args:        reflect.TypeOf(target.ActivateTargetArgs{}),
+			NewArgs:     func() interface{} { return new(target.ActivateTargetArgs) },
+		},
+		{
+			Name:        "Target.attachToTarget",
+			Domain:      "Target",
+			Description: "Attaches to the target with given id.",
+			Args:        reflect.TypeOf(target.AttachToTargetArgs{}),
+			NewArgs:     func() interface{} { return new(target.AttachToTargetArgs) },
+			Reply:       reflect.TypeOf(target.AttachToTargetReply{}),
+			NewReply:    func() interface{} { return new(target.AttachToTargetReply) },
+		},
+		{
+			Name:         "Target.attachToBrowserTarget",
+			Domain:       "Target",
+			Description:  "Attaches to the browser target, only uses flat sessionId mode.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(target.AttachToBrowserTargetReply{}),
+			NewReply:     func() interface{} { return new(target.AttachToBrowserTargetReply) },
+		},
+		{
+			Name:        "Target.closeTarget",
+			Domain:      "Target",
+			Description: "Closes the target. If the target is a page that gets closed too.",
+			Args:        reflect.TypeOf(target.CloseTargetArgs{}),
+			NewArgs:     func() interface{} { return new(target.CloseTargetArgs) },
+			Reply:       reflect.TypeOf(target.CloseTargetReply{}),
+			NewReply:    func() interface{} { return new(target.CloseTargetReply) },
+		},
+		{
+			Name:         "Target.exposeDevToolsProtocol",
+			Domain:       "Target",
+			Description:  "Inject object to the target's main frame that provides a communication\nchannel with browser target.\n\nInjected object will be available as `window[bindingName]`.\n\nThe object has the following API:\n- `binding.send(json)` - a method to send messages over the remote debugging protocol\n- `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.",
+			Experimental: true,
+			Args:         reflect.TypeOf(target.ExposeDevToolsProtocolArgs{}),
+			NewArgs:      func() interface{} { return new(target.ExposeDevToolsProtocolArgs) },
+		},
+		{
+			Name:        "Target.createBrowserContext",
+			Domain:      "Target",
+			Description: "Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than\none.",
+			Args:        reflect.TypeOf(target.CreateBrowserContextArgs{}),
+			NewArgs:     func() interface{} { return new(target.CreateBrowserContextArgs) },
+			Reply:       reflect.TypeOf(target.CreateBrowserContextReply{}),
+			NewReply:    func() interface{} { return new(target.CreateBrowserContextReply) },
+		},
+		{
+			Name:        "Target.getBrowserContexts",
+			Domain:      "Target",
+			Description: "Returns all browser contexts created with `Target.createBrowserContext` method.",
+			Reply:       reflect.TypeOf(target.GetBrowserContextsReply{}),
+			NewReply:    func() interface{} { return new(target.GetBrowserContextsReply) },
+		},
+		{
+			Name:        "Target.createTarget",
+			Domain:      "Target",
+			Description: "Creates a new page.",
+			Args:        reflect.TypeOf(target.CreateTargetArgs{}),
+			NewArgs:     func() interface{} { return new(target.CreateTargetArgs) },
+			Reply:       reflect.TypeOf(target.CreateTargetReply{}),
+			NewReply:    func() interface{} { return new(target.CreateTargetReply) },
+		},
+		{
+			Name:        "Target.detachFromTarget",
+			Domain:      "Target",
+			Description: "Detaches session with given id.",
+			Args:        reflect.TypeOf(target.DetachFromTargetArgs{}),
+			NewArgs:     func() interface{} { return new(target.DetachFromTargetArgs) },
+		},
+		{
+			Name:        "Target.disposeBrowserContext",
+			Domain:      "Target",
+			Description: "Deletes a BrowserContext. All the belonging pages will be closed without calling their\nbeforeunload hooks.",
+			Args:        reflect.TypeOf(target.DisposeBrowserContextArgs{}),
+			NewArgs:     func() interface{} { return new(target.DisposeBrowserContextArgs) },
+		},
+		{
+			Name:         "Target.getTargetInfo",
+			Domain:       "Target",
+			Description:  "Returns information about a target.",
+			Experimental: true,
+			Args:         reflect.TypeOf(target.GetTargetInfoArgs{}),
+			NewArgs:      func() interface{} { return new(target.GetTargetInfoArgs) },
+			Reply:        reflect.TypeOf(target.GetTargetInfoReply{}),
+			NewReply:     func() interface{} { return new(target.GetTargetInfoReply) },
+		},
+		{
+			Name:        "Target.getTargets",
+			Domain:      "Target",
+			Description: "Retrieves a list of available targets.",
+			Args:        reflect.TypeOf(target.GetTargetsArgs{}),
+			NewArgs:     func() interface{} { return new(target.GetTargetsArgs) },
+			Reply:       reflect.TypeOf(target.GetTargetsReply{}),
+			NewReply:    func() interface{} { return new(target.GetTargetsReply) },
+		},
+		{
+			Name:        "Target.sendMessageToTarget",
+			Domain:      "Target",
+			Description: "Sends protocol message over session with given id.\nConsider using flat mode instead; see commands attachToTarget, setAutoAttach,\nand crbug.com/991325.",
+			Deprecated:  true,
+			Args:        reflect.TypeOf(target.SendMessageToTargetArgs{}),
+			NewArgs:     func() interface{} { return new(target.SendMessageToTargetArgs) },
+		},
+		{
+			Name:        "Target.setAutoAttach",
+			Domain:      "Target",
+			Description: "Controls whether to automatically attach to new targets which are considered\nto be directly related to this one (for example, iframes or workers).\nWhen turned on, attaches to all existing related targets as well. When turned off,\nautomatically detaches from all currently attached targets.\nThis also clears all targets added by `autoAttachRelated` from the list of targets to watch\nfor creation of related targets.\nYou might want to call this recursively for auto-attached targets to attach\nto all available targets.",
+			Args:        reflect.TypeOf(target.SetAutoAttachArgs{}),
+			NewArgs:     func() interface{} { return new(target.SetAutoAttachArgs) },
+		},
+		{
+			Name:         "Target.autoAttachRelated",
+			Domain:       "Target",
+			Description:  "Adds the specified target to the list of targets that will be monitored for any related target\ncreation (such as child frames, child workers and new versions of service worker) and reported\nthrough `attachedToTarget`. The specified target is also auto-attached.\nThis cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent\n`setAutoAttach`. Only available at the Browser target.",
+			Experimental: true,
+			Args:         reflect.TypeOf(target.AutoAttachRelatedArgs{}),
+			NewArgs:      func() interface{} { return new(target.AutoAttachRelatedArgs) },
+		},
+		{
+			Name:        "Target.setDiscoverTargets",
+			Domain:      "Target",
+			Description: "Controls whether to discover available targets and notify via\n`targetCreated/targetInfoChanged/targetDestroyed` events.",
+			Args:        reflect.TypeOf(target.SetDiscoverTargetsArgs{}),
+			NewArgs:     func() interface{} { return new(target.SetDiscoverTargetsArgs) },
+		},
+		{
+			Name:         "Target.setRemoteLocations",
+			Domain:       "Target",
+			Description:  "Enables target discovery for the specified locations, when `setDiscoverTargets` was set to\n`true`.",
+			Experimental: true,
+			Args:         reflect.TypeOf(target.SetRemoteLocationsArgs{}),
+			NewArgs:      func() interface{} { return new(target.SetRemoteLocationsArgs) },
+		},
+		{
+			Name:         "Target.getDevToolsTarget",
+			Domain:       "Target",
+			Description:  "Gets the targetId of the DevTools page target opened for the given target\n(if any).",
+			Experimental: true,
+			Args:         reflect.TypeOf(target.GetDevToolsTargetArgs{}),
+			NewArgs:      func() interface{} { return new(target.GetDevToolsTargetArgs) },
+			Reply:        reflect.TypeOf(target.GetDevToolsTargetReply{}),
+			NewReply:     func() interface{} { return new(target.GetDevToolsTargetReply) },
+		},
+		{
+			Name:         "Target.openDevTools",
+			Domain:       "Target",
+			Description:  "Opens a DevTools window for the target.",
+			Experimental: true,
+			Args:         reflect.TypeOf(target.OpenDevToolsArgs{}),
+			NewArgs:      func() interface{} { return new(target.OpenDevToolsArgs) },
+			Reply:        reflect.TypeOf(target.OpenDevToolsReply{}),
+			NewReply:     func() interface{} { return new(target.OpenDevToolsReply) },
+		},
+		{
+			Name:         "Tethering.bind",
+			Domain:       "Tethering",
+			Description:  "Request browser port binding.",
+			Experimental: true,
+			Args:         reflect.TypeOf(tethering.BindArgs{}),
+			NewArgs:      func() interface{} { return new(tethering.BindArgs) },
+		},
+		{
+			Name:         "Tethering.unbind",
+			Domain:       "Tethering",
+			Description:  "Request browser port unbinding.",
+			Experimental: true,
+			Args:         reflect.TypeOf(tethering.UnbindArgs{}),
+			NewArgs:      func() interface{} { return new(tethering.UnbindArgs) },
+		},
+		{
+			Name:        "Tracing.end",
+			Domain:      "Tracing",
+			Description: "Stop trace events collection.",
+		},
+		{
+			Name:         "Tracing.getCategories",
+			Domain:       "Tracing",
+			Description:  "Gets supported tracing categories.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(tracing.GetCategoriesReply{}),
+			NewReply:     func() interface{} { return new(tracing.GetCategoriesReply) },
+		},
+		{
+			Name:         "Tracing.recordClockSyncMarker",
+			Domain:       "Tracing",
+			Description:  "Record a clock sync marker in the trace.",
+			Experimental: true,
+			Args:         reflect.TypeOf(tracing.RecordClockSyncMarkerArgs{}),
+			NewArgs:      func() interface{} { return new(tracing.RecordClockSyncMarkerArgs) },
+		},
+		{
+			Name:         "Tracing.requestMemoryDump",
+			Domain:       "Tracing",
+			Description:  "Request a global memory dump.",
+			Experimental: true,
+			Args:         reflect.TypeOf(tracing.RequestMemoryDumpArgs{}),
+			NewArgs:      func() interface{} { return new(tracing.RequestMemoryDumpArgs) },
+			Reply:        reflect.TypeOf(tracing.RequestMemoryDumpReply{}),
+			NewReply:     func() interface{} { return new(tracing.RequestMemoryDumpReply) },
+		},
+		{
+			Name:        "Tracing.start",
+			Domain:      "Tracing",
+			Description: "Start trace events collection.",
+			Args:        reflect.TypeOf(tracing.StartArgs{}),
+			NewArgs:     func() interface{} { return new(tracing.StartArgs) },
+		},
+		{
+			Name:         "WebAudio.enable",
+			Domain:       "WebAudio",
+			Description:  "Enables the WebAudio domain and starts sending context lifetime events.",
+			Experimental: true,
+		},
+		{
+			Name:         "WebAudio.disable",
+			Domain:       "WebAudio",
+			Description:  "Disables the WebAudio domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "WebAudio.getRealtimeData",
+			Domain:       "WebAudio",
+			Description:  "Fetch the realtime data from the registered contexts.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webaudio.GetRealtimeDataArgs{}),
+			NewArgs:      func() interface{} { return new(webaudio.GetRealtimeDataArgs) },
+			Reply:        reflect.TypeOf(webaudio.GetRealtimeDataReply{}),
+			NewReply:     func() interface{} { return new(webaudio.GetRealtimeDataReply) },
+		},
+		{
+			Name:         "WebAuthn.enable",
+			Domain:       "WebAuthn",
+			Description:  "Enable the WebAuthn domain and start intercepting credential storage and\nretrieval with a virtual authenticator.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.EnableArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.EnableArgs) },
+		},
+		{
+			Name:         "WebAuthn.disable",
+			Domain:       "WebAuthn",
+			Description:  "Disable the WebAuthn domain.",
+			Experimental: true,
+		},
+		{
+			Name:         "WebAuthn.addVirtualAuthenticator",
+			Domain:       "WebAuthn",
+			Description:  "Creates and adds a virtual authenticator.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.AddVirtualAuthenticatorArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.AddVirtualAuthenticatorArgs) },
+			Reply:        reflect.TypeOf(webauthn.AddVirtualAuthenticatorReply{}),
+			NewReply:     func() interface{} { return new(webauthn.AddVirtualAuthenticatorReply) },
+		},
+		{
+			Name:         "WebAuthn.setResponseOverrideBits",
+			Domain:       "WebAuthn",
+			Description:  "Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not present.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.SetResponseOverrideBitsArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.SetResponseOverrideBitsArgs) },
+		},
+		{
+			Name:         "WebAuthn.removeVirtualAuthenticator",
+			Domain:       "WebAuthn",
+			Description:  "Removes the given authenticator.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.RemoveVirtualAuthenticatorArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.RemoveVirtualAuthenticatorArgs) },
+		},
+		{
+			Name:         "WebAuthn.addCredential",
+			Domain:       "WebAuthn",
+			Description:  "Adds the credential to the specified authenticator.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.AddCredentialArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.AddCredentialArgs) },
+		},
+		{
+			Name:         "WebAuthn.getCredential",
+			Domain:       "WebAuthn",
+			Description:  "Returns a single credential stored in the given virtual authenticator that\nmatches the credential ID.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.GetCredentialArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.GetCredentialArgs) },
+			Reply:        reflect.TypeOf(webauthn.GetCredentialReply{}),
+			NewReply:     func() interface{} { return new(webauthn.GetCredentialReply) },
+		},
+		{
+			Name:         "WebAuthn.getCredentials",
+			Domain:       "WebAuthn",
+			Description:  "Returns all the credentials stored in the given virtual authenticator.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.GetCredentialsArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.GetCredentialsArgs) },
+			Reply:        reflect.TypeOf(webauthn.GetCredentialsReply{}),
+			NewReply:     func() interface{} { return new(webauthn.GetCredentialsReply) },
+		},
+		{
+			Name:         "WebAuthn.removeCredential",
+			Domain:       "WebAuthn",
+			Description:  "Removes a credential from the authenticator.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.RemoveCredentialArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.RemoveCredentialArgs) },
+		},
+		{
+			Name:         "WebAuthn.clearCredentials",
+			Domain:       "WebAuthn",
+			Description:  "Clears all the credentials from the specified device.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.ClearCredentialsArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.ClearCredentialsArgs) },
+		},
+		{
+			Name:         "WebAuthn.setUserVerified",
+			Domain:       "WebAuthn",
+			Description:  "Sets whether User Verification succeeds or fails for an authenticator.\nThe default is true.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.SetUserVerifiedArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.SetUserVerifiedArgs) },
+		},
+		{
+			Name:         "WebAuthn.setAutomaticPresenceSimulation",
+			Domain:       "WebAuthn",
+			Description:  "Sets whether tests of user presence will succeed immediately (if true) or fail to resolve (if false) for an authenticator.\nThe default is true.",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.SetAutomaticPresenceSimulationArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.SetAutomaticPresenceSimulationArgs) },
+		},
+		{
+			Name:         "WebAuthn.setCredentialProperties",
+			Domain:       "WebAuthn",
+			Description:  "Allows setting credential properties.\nhttps://w3c.github.io/webauthn/#sctn-automation-set-credential-properties",
+			Experimental: true,
+			Args:         reflect.TypeOf(webauthn.SetCredentialPropertiesArgs{}),
+			NewArgs:      func() interface{} { return new(webauthn.SetCredentialPropertiesArgs) },
+		},
+	}...)
+	events = append(events, []*Event{
+		{
+			Name:         "Accessibility.loadComplete",
+			Domain:       "Accessibility",
+			Description:  "The loadComplete event mirrors the load complete event sent by the browser to assistive\ntechnology when the web page has finished loading.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(accessibility.LoadCompleteReply{}),
+			NewReply:     func() interface{} { return new(accessibility.LoadCompleteReply) },
+		},
+		{
+			Name:         "Accessibility.nodesUpdated",
+			Domain:       "Accessibility",
+			Description:  "The nodesUpdated event is sent every time a previously requested node has changed the in tree.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(accessibility.NodesUpdatedReply{}),
+			NewReply:     func() interface{} { return new(accessibility.NodesUpdatedReply) },
+		},
+		{
+			Name:         "Animation.animationCanceled",
+			Domain:       "Animation",
+			Description:  "Event for when an animation has been cancelled.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(animation.CanceledReply{}),
+			NewReply:     func() interface{} { return new(animation.CanceledReply) },
+		},
+		{
+			Name:         "Animation.animationCreated",
+			Domain:       "Animation",
+			Description:  "Event for each animation that has been created.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(animation.CreatedReply{}),
+			NewReply:     func() interface{} { return new(animation.CreatedReply) },
+		},
+		{
+			Name:         "Animation.animationStarted",
+			Domain:       "Animation",
+			Description:  "Event for animation that has been started.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(animation.StartedReply{}),
+			NewReply:     func() interface{} { return new(animation.StartedReply) },
+		},
+		{
+			Name:         "Animation.animationUpdated",
+			Domain:       "Animation",
+			Description:  "Event for animation that has been updated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(animation.UpdatedReply{}),
+			NewReply:     func() interface{} { return new(animation.UpdatedReply) },
+		},
+		{
+			Name:         "Audits.issueAdded",
+			Domain:       "Audits",
+			Experimental: true,
+			Reply:        reflect.TypeOf(audits.IssueAddedReply{}),
+			NewReply:     func() interface{} { return new(audits.IssueAddedReply) },
+		},
+		{
+			Name:         "Autofill.addressFormFilled",
+			Domain:       "Autofill",
+			Description:  "Emitted when an address form is filled.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(autofill.AddressFormFilledReply{}),
+			NewReply:     func() interface{} { return new(autofill.AddressFormFilledReply) },
+		},
+		{
+			Name:         "BackgroundService.recordingStateChanged",
+			Domain:       "BackgroundService",
+			Description:  "Called when the recording state for the service has been updated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(backgroundservice.RecordingStateChangedReply{}),
+			NewReply:     func() interface{} { return new(backgroundservice.RecordingStateChangedReply) },
+		},
+		{
+			Name:         "BackgroundService.backgroundServiceEventReceived",
+			Domain:       "BackgroundService",
+			Description:  "Called with all existing backgroundServiceEvents when enabled, and all new\nevents afterwards if enabled and recording.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(backgroundservice.EventReceivedReply{}),
+			NewReply:     func() interface{} { return new(backgroundservice.EventReceivedReply) },
+		},
+		{
+			Name:         "BluetoothEmulation.gattOperationReceived",
+			Domain:       "BluetoothEmulation",
+			Description:  "Event for when a GATT operation of |type| to the peripheral with |address|\nhappened.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(bluetoothemulation.GattOperationReceivedReply{}),
+			NewReply:     func() interface{} { return new(bluetoothemulation.GattOperationReceivedReply) },
+		},
+		{
+			Name:         "BluetoothEmulation.characteristicOperationReceived",
+			Domain:       "BluetoothEmulation",
+			Description:  "Event for when a characteristic operation of |type| to the characteristic\nrespresented by |characteristicId| happened. |data| and |writeType| is\nexpected to exist when |type| is write.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(bluetoothemulation.CharacteristicOperationReceivedReply{}),
+			NewReply:     func() interface{} { return new(bluetoothemulation.CharacteristicOperationReceivedReply) },
+		},
+		{
+			Name:         "BluetoothEmulation.descriptorOperationReceived",
+			Domain:       "BluetoothEmulation",
+			Description:  "Event for when a descriptor operation of |type| to the descriptor\nrespresented by |descriptorId| happened. |data| is expected to exist when\n|type| is write.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(bluetoothemulation.DescriptorOperationReceivedReply{}),
+			NewReply:     func() interface{} { return new(bluetoothemulation.DescriptorOperationReceivedReply) },
+		},
+		{
+			Name:         "Browser.downloadWillBegin",
+			Domain:       "Browser",
+			Description:  "Fired when page is about to start a download.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(browser.DownloadWillBeginReply{}),
+			NewReply:     func() interface{} { return new(browser.DownloadWillBeginReply) },
+		},
+		{
+			Name:         "Browser.downloadProgress",
+			Domain:       "Browser",
+			Description:  "Fired when download makes progress. Last call has |done| == true.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(browser.DownloadProgressReply{}),
+			NewReply:     func() interface{} { return new(browser.DownloadProgressReply) },
+		},
+		{
+			Name:         "CSS.fontsUpdated",
+			Domain:       "CSS",
+			Description:  "Fires whenever a web font is updated.  A non-empty font parameter indicates a successfully loaded\nweb font.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.FontsUpdatedReply{}),
+			NewReply:     func() interface{} { return new(css.FontsUpdatedReply) },
+		},
+		{
+			Name:         "CSS.mediaQueryResultChanged",
+			Domain:       "CSS",
+			Description:  "Fires whenever a MediaQuery result changes (for example, after a browser window has been\nresized.) The current implementation considers only viewport-dependent media features.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.MediaQueryResultChangedReply{}),
+			NewReply:     func() interface{} { return new(css.MediaQueryResultChangedReply) },
+		},
+		{
+			Name:         "CSS.styleSheetAdded",
+			Domain:       "CSS",
+			Description:  "Fired whenever an active document stylesheet is added.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.StyleSheetAddedReply{}),
+			NewReply:     func() interface{} { return new(css.StyleSheetAddedReply) },
+		},
+		{
+			Name:         "CSS.styleSheetChanged",
+			Domain:       "CSS",
+			Description:  "Fired whenever a stylesheet is changed as a result of the client operation.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.StyleSheetChangedReply{}),
+			NewReply:     func() interface{} { return new(css.StyleSheetChangedReply) },
+		},
+		{
+			Name:         "CSS.styleSheetRemoved",
+			Domain:       "CSS",
+			Description:  "Fired whenever an active document stylesheet is removed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.StyleSheetRemovedReply{}),
+			NewReply:     func() interface{} { return new(css.StyleSheetRemovedReply) },
+		},
+		{
+			Name:         "CSS.computedStyleUpdated",
+			Domain:       "CSS",
+			Experimental: true,
+			Reply:        reflect.TypeOf(css.ComputedStyleUpdatedReply{}),
+			NewReply:     func() interface{} { return new(css.ComputedStyleUpdatedReply) },
+		},
+		{
+			Name:         "Cast.sinksUpdated",
+			Domain:       "Cast",
+			Description:  "This is fired whenever the list of available sinks changes. A sink is a\ndevice or a software surface that you can cast to.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(cast.SinksUpdatedReply{}),
+			NewReply:     func() interface{} { return new(cast.SinksUpdatedReply) },
+		},
+		{
+			Name:         "Cast.issueUpdated",
+			Domain:       "Cast",
+			Description:  "This is fired whenever the outstanding issue/error message changes.\n|issueMessage| is empty if there is no issue.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(cast.IssueUpdatedReply{}),
+			NewReply:     func() interface{} { return new(cast.IssueUpdatedReply) },
+		},
+		{
+			Name:        "Console.messageAdded",
+			Domain:      "Console",
+			Description: "Issued when new console message is added.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(console.MessageAddedReply{}),
+			NewReply:    func() interface{} { return new(console.MessageAddedReply) },
+		},
+		{
+			Name:        "DOM.attributeModified",
+			Domain:      "DOM",
+			Description: "Fired when `Element`'s attribute is modified.",
+			Reply:       reflect.TypeOf(dom.AttributeModifiedReply{}),
+			NewReply:    func() interface{} { return new(dom.AttributeModifiedReply) },
+		},
+		{
+			Name:         "DOM.adoptedStyleSheetsModified",
+			Domain:       "DOM",
+			Description:  "Fired when `Element`'s adoptedStyleSheets are modified.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.AdoptedStyleSheetsModifiedReply{}),
+			NewReply:     func() interface{} { return new(dom.AdoptedStyleSheetsModifiedReply) },
+		},
+		{
+			Name:        "DOM.attributeRemoved",
+			Domain:      "DOM",
+			Description: "Fired when `Element`'s attribute is removed.",
+			Reply:       reflect.TypeOf(dom.AttributeRemovedReply{}),
+			NewReply:    func() interface{} { return new(dom.AttributeRemovedReply) },
+		},
+		{
+			Name:        "DOM.characterDataModified",
+			Domain:      "DOM",
+			Description: "Mirrors `DOMCharacterDataModified` event.",
+			Reply:       reflect.TypeOf(dom.CharacterDataModifiedReply{}),
+			NewReply:    func() interface{} { return new(dom.CharacterDataModifiedReply) },
+		},
+		{
+			Name:        "DOM.childNodeCountUpdated",
+			Domain:      "DOM",
+			Description: "Fired when `Container`'s child node count has changed.",
+			Reply:       reflect.TypeOf(dom.ChildNodeCountUpdatedReply{}),
+			NewReply:    func() interface{} { return new(dom.ChildNodeCountUpdatedReply) },
+		},
+		{
+			Name:        "DOM.childNodeInserted",
+			Domain:      "DOM",
+			Description: "Mirrors `DOMNodeInserted` event.",
+			Reply:       reflect.TypeOf(dom.ChildNodeInsertedReply{}),
+			NewReply:    func() interface{} { return new(dom.ChildNodeInsertedReply) },
+		},
+		{
+			Name:        "DOM.childNodeRemoved",
+			Domain:      "DOM",
+			Description: "Mirrors `DOMNodeRemoved` event.",
+			Reply:       reflect.TypeOf(dom.ChildNodeRemovedReply{}),
+			NewReply:    func() interface{} { return new(dom.ChildNodeRemovedReply) },
+		},
+		{
+			Name:         "DOM.distributedNodesUpdated",
+			Domain:       "DOM",
+			Description:  "Called when distribution is changed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.DistributedNodesUpdatedReply{}),
+			NewReply:     func() interface{} { return new(dom.DistributedNodesUpdatedReply) },
+		},
+		{
+			Name:        "DOM.documentUpdated",
+			Domain:      "DOM",
+			Description: "Fired when `Document` has been totally updated. Node ids are no longer valid.",
+			Reply:       reflect.TypeOf(dom.DocumentUpdatedReply{}),
+			NewReply:    func() interface{} { return new(dom.DocumentUpdatedReply) },
+		},
+		{
+			Name:         "DOM.inlineStyleInvalidated",
+			Domain:       "DOM",
+			Description:  "Fired when `Element`'s inline style is modified via a CSS property modification.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.InlineStyleInvalidatedReply{}),
+			NewReply:     func() interface{} { return new(dom.InlineStyleInvalidatedReply) },
+		},
+		{
+			Name:         "DOM.pseudoElementAdded",
+			Domain:       "DOM",
+			Description:  "Called when a pseudo element is added to an element.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.PseudoElementAddedReply{}),
+			NewReply:     func() interface{} { return new(dom.PseudoElementAddedReply) },
+		},
+		{
+			Name:         "DOM.topLayerElementsUpdated",
+			Domain:       "DOM",
+			Description:  "Called when top layer elements are changed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.TopLayerElementsUpdatedReply{}),
+			NewReply:     func() interface{} { return new(dom.TopLayerElementsUpdatedReply) },
+		},
+		{
+			Name:         "DOM.scrollableFlagUpdated",
+			Domain:       "DOM",
+			Description:  "Fired when a node's scrollability state changes.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.ScrollableFlagUpdatedReply{}),
+			NewReply:     func() interface{} { return new(dom.ScrollableFlagUpdatedReply) },
+		},
+		{
+			Name:         "DOM.affectedByStartingStylesFlagUpdated",
+			Domain:       "DOM",
+			Description:  "Fired when a node's starting styles changes.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.AffectedByStartingStylesFlagUpdatedReply{}),
+			NewReply:     func() interface{} { return new(dom.AffectedByStartingStylesFlagUpdatedReply) },
+		},
+		{
+			Name:         "DOM.pseudoElementRemoved",
+			Domain:       "DOM",
+			Description:  "Called when a pseudo element is removed from an element.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.PseudoElementRemovedReply{}),
+			NewReply:     func() interface{} { return new(dom.PseudoElementRemovedReply) },
+		},
+		{
+			Name:        "DOM.setChildNodes",
+			Domain:      "DOM",
+			Description: "Fired when backend wants to provide client with the missing DOM structure. This happens upon\nmost of the calls requesting node ids.",
+			Reply:       reflect.TypeOf(dom.SetChildNodesReply{}),
+			NewReply:    func() interface{} { return new(dom.SetChildNodesReply) },
+		},
+		{
+			Name:         "DOM.shadowRootPopped",
+			Domain:       "DOM",
+			Description:  "Called when shadow root is popped from the element.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.ShadowRootPoppedReply{}),
+			NewReply:     func() interface{} { return new(dom.ShadowRootPoppedReply) },
+		},
+		{
+			Name:         "DOM.shadowRootPushed",
+			Domain:       "DOM",
+			Description:  "Called when shadow root is pushed into the element.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(dom.ShadowRootPushedReply{}),
+			NewReply:     func() interface{} { return new(dom.ShadowRootPushedReply) },
+		},
+		{
+			Name:         "DOMStorage.domStorageItemAdded",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(domstorage.ItemAddedReply{}),
+			NewReply:     func() interface{} { return new(domstorage.ItemAddedReply) },
+		},
+		{
+			Name:         "DOMStorage.domStorageItemRemoved",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(domstorage.ItemRemovedReply{}),
+			NewReply:     func() interface{} { return new(domstorage.ItemRemovedReply) },
+		},
+		{
+			Name:         "DOMStorage.domStorageItemUpdated",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(domstorage.ItemUpdatedReply{}),
+			NewReply:     func() interface{} { return new(domstorage.ItemUpdatedReply) },
+		},
+		{
+			Name:         "DOMStorage.domStorageItemsCleared",
+			Domain:       "DOMStorage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(domstorage.ItemsClearedReply{}),
+			NewReply:     func() interface{} { return new(domstorage.ItemsClearedReply) },
+		},
+		{
+			Name:        "Debugger.breakpointResolved",
+			Domain:      "Debugger",
+			Description: "Fired when breakpoint is resolved to an actual script and location.\nDeprecated in favor of `resolvedBreakpoints` in the `scriptParsed` event.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(debugger.BreakpointResolvedReply{}),
+			NewReply:    func() interface{} { return new(debugger.BreakpointResolvedReply) },
+		},
+		{
+			Name:        "Debugger.paused",
+			Domain:      "Debugger",
+			Description: "Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.",
+			Reply:       reflect.TypeOf(debugger.PausedReply{}),
+			NewReply:    func() interface{} { return new(debugger.PausedReply) },
+		},
+		{
+			Name:        "Debugger.resumed",
+			Domain:      "Debugger",
+			Description: "Fired when the virtual machine resumed execution.",
+			Reply:       reflect.TypeOf(debugger.ResumedReply{}),
+			NewReply:    func() interface{} { return new(debugger.ResumedReply) },
+		},
+		{
+			Name:        "Debugger.scriptFailedToParse",
+			Domain:      "Debugger",
+			Description: "Fired when virtual machine fails to parse the script.",
+			Reply:       reflect.TypeOf(debugger.ScriptFailedToParseReply{}),
+			NewReply:    func() interface{} { return new(debugger.ScriptFailedToParseReply) },
+		},
+		{
+			Name:        "Debugger.scriptParsed",
+			Domain:      "Debugger",
+			Description: "Fired when virtual machine parses script. This event is also fired for all known and uncollected\nscripts upon enabling debugger.",
+			Reply:       reflect.TypeOf(debugger.ScriptParsedReply{}),
+			NewReply:    func() interface{} { return new(debugger.ScriptParsedReply) },
+		},
+		{
+			Name:         "DeviceAccess.deviceRequestPrompted",
+			Domain:       "DeviceAccess",
+			Description:  "A device request opened a user prompt to select a device. Respond with the\nselectPrompt or cancelPrompt command.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(deviceaccess.DeviceRequestPromptedReply{}),
+			NewReply:     func() interface{} { return new(deviceaccess.DeviceRequestPromptedReply) },
+		},
+		{
+			Name:         "Emulation.virtualTimeBudgetExpired",
+			Domain:       "Emulation",
+			Description:  "Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(emulation.VirtualTimeBudgetExpiredReply{}),
+			NewReply:     func() interface{} { return new(emulation.VirtualTimeBudgetExpiredReply) },
+		},
+		{
+			Name:         "FedCm.dialogShown",
+			Domain:       "FedCm",
+			Experimental: true,
+			Reply:        reflect.TypeOf(fedcm.DialogShownReply{}),
+			NewReply:     func() interface{} { return new(fedcm.DialogShownReply) },
+		},
+		{
+			Name:         "FedCm.dialogClosed",
+			Domain:       "FedCm",
+			Description:  "Triggered when a dialog is closed, either by user action, JS abort,\nor a command below.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(fedcm.DialogClosedReply{}),
+			NewReply:     func() interface{} { return new(fedcm.DialogClosedReply) },
+		},
+		{
+			Name:        "Fetch.requestPaused",
+			Domain:      "Fetch",
+			Description: "Issued when the domain is enabled and the request URL matches the\nspecified filter. The request is paused until the client responds\nwith one of continueRequest, failRequest or fulfillRequest.\nThe stage of the request can be determined by presence of responseErrorReason\nand responseStatusCode -- the request is at the response stage if either\nof these fields is present and in the request stage otherwise.\nRedirect responses and subsequent requests are reported similarly to regular\nresponses and requests. Redirect responses may be distinguished by the value\nof `responseStatusCode` (which is one of 301, 302, 303, 307, 308) along with\npresence of the `location` header. Requests resulting from a redirect will\nhave `redirectedRequestId` field set.",
+			Reply:       reflect.TypeOf(fetch.RequestPausedReply{}),
+			NewReply:    func() interface{} { return new(fetch.RequestPausedReply) },
+		},
+		{
+			Name:        "Fetch.authRequired",
+			Domain:      "Fetch",
+			Description: "Issued when the domain is enabled with handleAuthRequests set to true.\nThe request is paused until client responds with continueWithAuth.",
+			Reply:       reflect.TypeOf(fetch.AuthRequiredReply{}),
+			NewReply:    func() interface{} { return new(fetch.AuthRequiredReply) },
+		},
+		{
+			Name:         "HeapProfiler.addHeapSnapshotChunk",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.AddHeapSnapshotChunkReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.AddHeapSnapshotChunkReply) },
+		},
+		{
+			Name:         "HeapProfiler.heapStatsUpdate",
+			Domain:       "HeapProfiler",
+			Description:  "If heap objects tracking has been started then backend may send update for one or more fragments",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.HeapStatsUpdateReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.HeapStatsUpdateReply) },
+		},
+		{
+			Name:         "HeapProfiler.lastSeenObjectId",
+			Domain:       "HeapProfiler",
+			Description:  "If heap objects tracking has been started then backend regularly sends a current value for last\nseen object id and corresponding timestamp. If the were changes in the heap since last event\nthen one or more heapStatsUpdate events will be sent before a new lastSeenObjectId event.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.LastSeenObjectIDReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.LastSeenObjectIDReply) },
+		},
+		{
+			Name:         "HeapProfiler.reportHeapSnapshotProgress",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.ReportHeapSnapshotProgressReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.ReportHeapSnapshotProgressReply) },
+		},
+		{
+			Name:         "HeapProfiler.resetProfiles",
+			Domain:       "HeapProfiler",
+			Experimental: true,
+			Reply:        reflect.TypeOf(heapprofiler.ResetProfilesReply{}),
+			NewReply:     func() interface{} { return new(heapprofiler.ResetProfilesReply) },
+		},
+		{
+			Name:         "Input.dragIntercepted",
+			Domain:       "Input",
+			Description:  "Emitted only when `Input.setInterceptDrags` is enabled. Use this data with `Input.dispatchDragEvent` to\nrestore normal drag and drop behavior.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(input.DragInterceptedReply{}),
+			NewReply:     func() interface{} { return new(input.DragInterceptedReply) },
+		},
+		{
+			Name:         "Inspector.detached",
+			Domain:       "Inspector",
+			Description:  "Fired when remote debugging connection is about to be terminated. Contains detach reason.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(inspector.DetachedReply{}),
+			NewReply:     func() interface{} { return new(inspector.DetachedReply) },
+		},
+		{
+			Name:         "Inspector.targetCrashed",
+			Domain:       "Inspector",
+			Description:  "Fired when debugging target has crashed",
+			Experimental: true,
+			Reply:        reflect.TypeOf(inspector.TargetCrashedReply{}),
+			NewReply:     func() interface{} { return new(inspector.TargetCrashedReply) },
+		},
+		{
+			Name:         "Inspector.targetReloadedAfterCrash",
+			Domain:       "Inspector",
+			Description:  "Fired when debugging target has reloaded after crash",
+			Experimental: true,
+			Reply:        reflect.TypeOf(inspector.TargetReloadedAfterCrashReply{}),
+			NewReply:     func() interface{} { return new(inspector.TargetReloadedAfterCrashReply) },
+		},
+		{
+			Name:         "Inspector.workerScriptLoaded",
+			Domain:       "Inspector",
+			Description:  "Fired on worker targets when main worker script and any imported scripts have been evaluated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(inspector.WorkerScriptLoadedReply{}),
+			NewReply:     func() interface{} { return new(inspector.WorkerScriptLoadedReply) },
+		},
+		{
+			Name:         "LayerTree.layerPainted",
+			Domain:       "LayerTree",
+			Experimental: true,
+			Reply:        reflect.TypeOf(layertree.LayerPaintedReply{}),
+			NewReply:     func() interface{} { return new(layertree.LayerPaintedReply) },
+		},
+		{
+			Name:         "LayerTree.layerTreeDidChange",
+			Domain:       "LayerTree",
+			Experimental: true,
+			Reply:        reflect.TypeOf(layertree.DidChangeReply{}),
+			NewReply:     func() interface{} { return new(layertree.DidChangeReply) },
+		},
+		{
+			Name:        "Log.entryAdded",
+			Domain:      "Log",
+			Description: "Issued when new message was logged.",
+			Reply:       reflect.TypeOf(log.EntryAddedReply{}),
+			NewReply:    func() interface{} { return new(log.EntryAddedReply) },
+		},
+		{
+			Name:         "Media.playerPropertiesChanged",
+			Domain:       "Media",
+			Description:  "This can be called multiple times, and can be used to set / override /\nremove player properties. A null propValue indicates removal.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(media.PlayerPropertiesChangedReply{}),
+			NewReply:     func() interface{} { return new(media.PlayerPropertiesChangedReply) },
+		},
+		{
+			Name:         "Media.playerEventsAdded",
+			Domain:       "Media",
+			Description:  "Send events as a list, allowing them to be batched on the browser for less\ncongestion. If batched, events must ALWAYS be in chronological order.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(media.PlayerEventsAddedReply{}),
+			NewReply:     func() interface{} { return new(media.PlayerEventsAddedReply) },
+		},
+		{
+			Name:         "Media.playerMessagesLogged",
+			Domain:       "Media",
+			Description:  "Send a list of any messages that need to be delivered.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(media.PlayerMessagesLoggedReply{}),
+			NewReply:     func() interface{} { return new(media.PlayerMessagesLoggedReply) },
+		},
+		{
+			Name:         "Media.playerErrorsRaised",
+			Domain:       "Media",
+			Description:  "Send a list of any errors that need to be delivered.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(media.PlayerErrorsRaisedReply{}),
+			NewReply:     func() interface{} { return new(media.PlayerErrorsRaisedReply) },
+		},
+		{
+			Name:         "Media.playerCreated",
+			Domain:       "Media",
+			Description:  "Called whenever a player is created, or when a new agent joins and receives\na list of active players. If an agent is restored, it will receive one\nevent for each active player.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(media.PlayerCreatedReply{}),
+			NewReply:     func() interface{} { return new(media.PlayerCreatedReply) },
+		},
+		{
+			Name:        "Network.dataReceived",
+			Domain:      "Network",
+			Description: "Fired when data chunk was received over the network.",
+			Reply:       reflect.TypeOf(network.DataReceivedReply{}),
+			NewReply:    func() interface{} { return new(network.DataReceivedReply) },
+		},
+		{
+			Name:        "Network.eventSourceMessageReceived",
+			Domain:      "Network",
+			Description: "Fired when EventSource message is received.",
+			Reply:       reflect.TypeOf(network.EventSourceMessageReceivedReply{}),
+			NewReply:    func() interface{} { return new(network.EventSourceMessageReceivedReply) },
+		},
+		{
+			Name:        "Network.loadingFailed",
+			Domain:      "Network",
+			Description: "Fired when HTTP request has failed to load.",
+			Reply:       reflect.TypeOf(network.LoadingFailedReply{}),
+			NewReply:    func() interface{} { return new(network.LoadingFailedReply) },
+		},
+		{
+			Name:        "Network.loadingFinished",
+			Domain:      "Network",
+			Description: "Fired when HTTP request has finished loading.",
+			Reply:       reflect.TypeOf(network.LoadingFinishedReply{}),
+			NewReply:    func() interface{} { return new(network.LoadingFinishedReply) },
+		},
+		{
+			Name:         "Network.requestIntercepted",
+			Domain:       "Network",
+			Description:  "Details of an intercepted HTTP request, which must be either allowed, blocked, modified or\nmocked.\nDeprecated, use Fetch.requestPaused instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Reply:        reflect.TypeOf(network.RequestInterceptedReply{}),
+			NewReply:     func() interface{} { return new(network.RequestInterceptedReply) },
+		},
+		{
+			Name:        "Network.requestServedFromCache",
+			Domain:      "Network",
+			Description: "Fired if request ended up loading from cache.",
+			Reply:       reflect.TypeOf(network.RequestServedFromCacheReply{}),
+			NewReply:    func() interface{} { return new(network.RequestServedFromCacheReply) },
+		},
+		{
+			Name:        "Network.requestWillBeSent",
+			Domain:      "Network",
+			Description: "Fired when page is about to send HTTP request.",
+			Reply:       reflect.TypeOf(network.RequestWillBeSentReply{}),
+			NewReply:    func() interface{} { return new(network.RequestWillBeSentReply) },
+		},
+		{
+			Name:         "Network.resourceChangedPriority",
+			Domain:       "Network",
+			Description:  "Fired when resource loading priority is changed",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.ResourceChangedPriorityReply{}),
+			NewReply:     func() interface{} { return new(network.ResourceChangedPriorityReply) },
+		},
+		{
+			Name:         "Network.signedExchangeReceived",
+			Domain:       "Network",
+			Description:  "Fired when a signed exchange was received over the network",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.SignedExchangeReceivedReply{}),
+			NewReply:     func() interface{} { return new(network.SignedExchangeReceivedReply) },
+		},
+		{
+			Name:        "Network.responseReceived",
+			Domain:      "Network",
+			Description: "Fired when HTTP response is available.",
+			Reply:       reflect.TypeOf(network.ResponseReceivedReply{}),
+			NewReply:    func() interface{} { return new(network.ResponseReceivedReply) },
+		},
+		{
+			Name:        "Network.webSocketClosed",
+			Domain:      "Network",
+			Description: "Fired when WebSocket is closed.",
+			Reply:       reflect.TypeOf(network.WebSocketClosedReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketClosedReply) },
+		},
+		{
+			Name:        "Network.webSocketCreated",
+			Domain:      "Network",
+			Description: "Fired upon WebSocket creation.",
+			Reply:       reflect.TypeOf(network.WebSocketCreatedReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketCreatedReply) },
+		},
+		{
+			Name:        "Network.webSocketFrameError",
+			Domain:      "Network",
+			Description: "Fired when WebSocket message error occurs.",
+			Reply:       reflect.TypeOf(network.WebSocketFrameErrorReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketFrameErrorReply) },
+		},
+		{
+			Name:        "Network.webSocketFrameReceived",
+			Domain:      "Network",
+			Description: "Fired when WebSocket message is received.",
+			Reply:       reflect.TypeOf(network.WebSocketFrameReceivedReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketFrameReceivedReply) },
+		},
+		{
+			Name:        "Network.webSocketFrameSent",
+			Domain:      "Network",
+			Description: "Fired when WebSocket message is sent.",
+			Reply:       reflect.TypeOf(network.WebSocketFrameSentReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketFrameSentReply) },
+		},
+		{
+			Name:        "Network.webSocketHandshakeResponseReceived",
+			Domain:      "Network",
+			Description: "Fired when WebSocket handshake response becomes available.",
+			Reply:       reflect.TypeOf(network.WebSocketHandshakeResponseReceivedReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketHandshakeResponseReceivedReply) },
+		},
+		{
+			Name:        "Network.webSocketWillSendHandshakeRequest",
+			Domain:      "Network",
+			Description: "Fired when WebSocket is about to initiate handshake.",
+			Reply:       reflect.TypeOf(network.WebSocketWillSendHandshakeRequestReply{}),
+			NewReply:    func() interface{} { return new(network.WebSocketWillSendHandshakeRequestReply) },
+		},
+		{
+			Name:        "Network.webTransportCreated",
+			Domain:      "Network",
+			Description: "Fired upon WebTransport creation.",
+			Reply:       reflect.TypeOf(network.WebTransportCreatedReply{}),
+			NewReply:    func() interface{} { return new(network.WebTransportCreatedReply) },
+		},
+		{
+			Name:        "Network.webTransportConnectionEstablished",
+			Domain:      "Network",
+			Description: "Fired when WebTransport handshake is finished.",
+			Reply:       reflect.TypeOf(network.WebTransportConnectionEstablishedReply{}),
+			NewReply:    func() interface{} { return new(network.WebTransportConnectionEstablishedReply) },
+		},
+		{
+			Name:        "Network.webTransportClosed",
+			Domain:      "Network",
+			Description: "Fired when WebTransport is disposed.",
+			Reply:       reflect.TypeOf(network.WebTransportClosedReply{}),
+			NewReply:    func() interface{} { return new(network.WebTransportClosedReply) },
+		},
+		{
+			Name:         "Network.directTCPSocketCreated",
+			Domain:       "Network",
+			Description:  "Fired upon direct_socket.TCPSocket creation.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectTCPSocketCreatedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectTCPSocketCreatedReply) },
+		},
+		{
+			Name:         "Network.directTCPSocketOpened",
+			Domain:       "Network",
+			Description:  "Fired when direct_socket.TCPSocket connection is opened.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectTCPSocketOpenedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectTCPSocketOpenedReply) },
+		},
+		{
+			Name:         "Network.directTCPSocketAborted",
+			Domain:       "Network",
+			Description:  "Fired when direct_socket.TCPSocket is aborted.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectTCPSocketAbortedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectTCPSocketAbortedReply) },
+		},
+		{
+			Name:         "Network.directTCPSocketClosed",
+			Domain:       "Network",
+			Description:  "Fired when direct_socket.TCPSocket is closed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectTCPSocketClosedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectTCPSocketClosedReply) },
+		},
+		{
+			Name:         "Network.directTCPSocketChunkSent",
+			Domain:       "Network",
+			Description:  "Fired when data is sent to tcp direct socket stream.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectTCPSocketChunkSentReply{}),
+			NewReply:     func() interface{} { return new(network.DirectTCPSocketChunkSentReply) },
+		},
+		{
+			Name:         "Network.directTCPSocketChunkReceived",
+			Domain:       "Network",
+			Description:  "Fired when data is received from tcp direct socket stream.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectTCPSocketChunkReceivedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectTCPSocketChunkReceivedReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketJoinedMulticastGroup",
+			Domain:       "Network",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketJoinedMulticastGroupReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketJoinedMulticastGroupReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketLeftMulticastGroup",
+			Domain:       "Network",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketLeftMulticastGroupReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketLeftMulticastGroupReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketCreated",
+			Domain:       "Network",
+			Description:  "Fired upon direct_socket.UDPSocket creation.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketCreatedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketCreatedReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketOpened",
+			Domain:       "Network",
+			Description:  "Fired when direct_socket.UDPSocket connection is opened.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketOpenedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketOpenedReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketAborted",
+			Domain:       "Network",
+			Description:  "Fired when direct_socket.UDPSocket is aborted.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketAbortedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketAbortedReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketClosed",
+			Domain:       "Network",
+			Description:  "Fired when direct_socket.UDPSocket is closed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketClosedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketClosedReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketChunkSent",
+			Domain:       "Network",
+			Description:  "Fired when message is sent to udp direct socket stream.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketChunkSentReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketChunkSentReply) },
+		},
+		{
+			Name:         "Network.directUDPSocketChunkReceived",
+			Domain:       "Network",
+			Description:  "Fired when message is received from udp direct socket stream.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.DirectUDPSocketChunkReceivedReply{}),
+			NewReply:     func() interface{} { return new(network.DirectUDPSocketChunkReceivedReply) },
+		},
+		{
+			Name:         "Network.requestWillBeSentExtraInfo",
+			Domain:       "Network",
+			Description:  "Fired when additional information about a requestWillBeSent event is available from the\nnetwork stack. Not every requestWillBeSent event will have an additional\nrequestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent\nor requestWillBeSentExtraInfo will be fired first for the same request.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.RequestWillBeSentExtraInfoReply{}),
+			NewReply:     func() interface{} { return new(network.RequestWillBeSentExtraInfoReply) },
+		},
+		{
+			Name:         "Network.responseReceivedExtraInfo",
+			Domain:       "Network",
+			Description:  "Fired when additional information about a responseReceived event is available from the network\nstack. Not every responseReceived event will have an additional responseReceivedExtraInfo for\nit, and responseReceivedExtraInfo may be fired before or after responseReceived.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.ResponseReceivedExtraInfoReply{}),
+			NewReply:     func() interface{} { return new(network.ResponseReceivedExtraInfoReply) },
+		},
+		{
+			Name:         "Network.responseReceivedEarlyHints",
+			Domain:       "Network",
+			Description:  "Fired when 103 Early Hints headers is received in addition to the common response.\nNot every responseReceived event will have an responseReceivedEarlyHints fired.\nOnly one responseReceivedEarlyHints may be fired for eached responseReceived event.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.ResponseReceivedEarlyHintsReply{}),
+			NewReply:     func() interface{} { return new(network.ResponseReceivedEarlyHintsReply) },
+		},
+		{
+			Name:         "Network.trustTokenOperationDone",
+			Domain:       "Network",
+			Description:  "Fired exactly once for each Trust Token operation. Depending on\nthe type of the operation and whether the operation succeeded or\nfailed, the event is fired before the corresponding request was sent\nor after the response was received.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.TrustTokenOperationDoneReply{}),
+			NewReply:     func() interface{} { return new(network.TrustTokenOperationDoneReply) },
+		},
+		{
+			Name:         "Network.policyUpdated",
+			Domain:       "Network",
+			Description:  "Fired once security policy has been updated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.PolicyUpdatedReply{}),
+			NewReply:     func() interface{} { return new(network.PolicyUpdatedReply) },
+		},
+		{
+			Name:         "Network.reportingApiReportAdded",
+			Domain:       "Network",
+			Description:  "Is sent whenever a new report is added.\nAnd after 'enableReportingApi' for all existing reports.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.ReportingAPIReportAddedReply{}),
+			NewReply:     func() interface{} { return new(network.ReportingAPIReportAddedReply) },
+		},
+		{
+			Name:         "Network.reportingApiReportUpdated",
+			Domain:       "Network",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.ReportingAPIReportUpdatedReply{}),
+			NewReply:     func() interface{} { return new(network.ReportingAPIReportUpdatedReply) },
+		},
+		{
+			Name:         "Network.reportingApiEndpointsChangedForOrigin",
+			Domain:       "Network",
+			Experimental: true,
+			Reply:        reflect.TypeOf(network.ReportingAPIEndpointsChangedForOriginReply{}),
+			NewReply:     func() interface{} { return new(network.ReportingAPIEndpointsChangedForOriginReply) },
+		},
+		{
+			Name:         "NodeRuntime.waitingForDisconnect",
+			Domain:       "NodeRuntime",
+			Description:  "This event is fired instead of `Runtime.executionContextDestroyed` when\nenabled.\nIt is fired when the Node process finished all code execution and is\nwaiting for all frontends to disconnect.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(noderuntime.WaitingForDisconnectReply{}),
+			NewReply:     func() interface{} { return new(noderuntime.WaitingForDisconnectReply) },
+		},
+		{
+			Name:         "NodeRuntime.waitingForDebugger",
+			Domain:       "NodeRuntime",
+			Description:  "This event is fired when the runtime is waiting for the debugger. For\nexample, when inspector.waitingForDebugger is called",
+			Experimental: true,
+			Reply:        reflect.TypeOf(noderuntime.WaitingForDebuggerReply{}),
+			NewReply:     func() interface{} { return new(noderuntime.WaitingForDebuggerReply) },
+		},
+		{
+			Name:         "NodeTracing.dataCollected",
+			Domain:       "NodeTracing",
+			Description:  "Contains an bucket of collected trace events.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(nodetracing.DataCollectedReply{}),
+			NewReply:     func() interface{} { return new(nodetracing.DataCollectedReply) },
+		},
+		{
+			Name:         "NodeTracing.tracingComplete",
+			Domain:       "NodeTracing",
+			Description:  "Signals that tracing is stopped and there is no trace buffers pending flush, all data were\ndelivered via dataCollected events.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(nodetracing.TracingCompleteReply{}),
+			NewReply:     func() interface{} { return new(nodetracing.TracingCompleteReply) },
+		},
+		{
+			Name:         "NodeWorker.attachedToWorker",
+			Domain:       "NodeWorker",
+			Description:  "Issued when attached to a worker.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(nodeworker.AttachedToWorkerReply{}),
+			NewReply:     func() interface{} { return new(nodeworker.AttachedToWorkerReply) },
+		},
+		{
+			Name:         "NodeWorker.detachedFromWorker",
+			Domain:       "NodeWorker",
+			Description:  "Issued when detached from the worker.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(nodeworker.DetachedFromWorkerReply{}),
+			NewReply:     func() interface{} { return new(nodeworker.DetachedFromWorkerReply) },
+		},
+		{
+			Name:         "NodeWorker.receivedMessageFromWorker",
+			Domain:       "NodeWorker",
+			Description:  "Notifies about a new protocol message received from the session\n(session ID is provided in attachedToWorker notification).",
+			Experimental: true,
+			Reply:        reflect.TypeOf(nodeworker.ReceivedMessageFromWorkerReply{}),
+			NewReply:     func() interface{} { return new(nodeworker.ReceivedMessageFromWorkerReply) },
+		},
+		{
+			Name:         "Overlay.inspectNodeRequested",
+			Domain:       "Overlay",
+			Description:  "Fired when the node should be inspected. This happens after call to `setInspectMode` or when\nuser manually inspects an element.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(overlay.InspectNodeRequestedReply{}),
+			NewReply:     func() interface{} { return new(overlay.InspectNodeRequestedReply) },
+		},
+		{
+			Name:         "Overlay.nodeHighlightRequested",
+			Domain:       "Overlay",
+			Description:  "Fired when the node should be highlighted. This happens after call to `setInspectMode`.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(overlay.NodeHighlightRequestedReply{}),
+			NewReply:     func() interface{} { return new(overlay.NodeHighlightRequestedReply) },
+		},
+		{
+			Name:         "Overlay.screenshotRequested",
+			Domain:       "Overlay",
+			Description:  "Fired when user asks to capture screenshot of some area on the page.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(overlay.ScreenshotRequestedReply{}),
+			NewReply:     func() interface{} { return new(overlay.ScreenshotRequestedReply) },
+		},
+		{
+			Name:         "Overlay.inspectModeCanceled",
+			Domain:       "Overlay",
+			Description:  "Fired when user cancels the inspect mode.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(overlay.InspectModeCanceledReply{}),
+			NewReply:     func() interface{} { return new(overlay.InspectModeCanceledReply) },
+		},
+		{
+			Name:     "Page.domContentEventFired",
+			Domain:   "Page",
+			Reply:    reflect.TypeOf(page.DOMContentEventFiredReply{}),
+			NewReply: func() interface{} { return new(page.DOMContentEventFiredReply) },
+		},
+		{
+			Name:        "Page.fileChooserOpened",
+			Domain:      "Page",
+			Description: "Emitted only when `page.interceptFileChooser` is enabled.",
+			Reply:       reflect.TypeOf(page.FileChooserOpenedReply{}),
+			NewReply:    func() interface{} { return new(page.FileChooserOpenedReply) },
+		},
+		{
+			Name:        "Page.frameAttached",
+			Domain:      "Page",
+			Description: "Fired when frame has been attached to its parent.",
+			Reply:       reflect.TypeOf(page.FrameAttachedReply{}),
+			NewReply:    func() interface{} { return new(page.FrameAttachedReply) },
+		},
+		{
+			Name:        "Page.frameClearedScheduledNavigation",
+			Domain:      "Page",
+			Description: "Fired when frame no longer has a scheduled navigation.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(page.FrameClearedScheduledNavigationReply{}),
+			NewReply:    func() interface{} { return new(page.FrameClearedScheduledNavigationReply) },
+		},
+		{
+			Name:        "Page.frameDetached",
+			Domain:      "Page",
+			Description: "Fired when frame has been detached from its parent.",
+			Reply:       reflect.TypeOf(page.FrameDetachedReply{}),
+			NewReply:    func() interface{} { return new(page.FrameDetachedReply) },
+		},
+		{
+			Name:         "Page.frameSubtreeWillBeDetached",
+			Domain:       "Page",
+			Description:  "Fired before frame subtree is detached. Emitted before any frame of the\nsubtree is actually detached.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.FrameSubtreeWillBeDetachedReply{}),
+			NewReply:     func() interface{} { return new(page.FrameSubtreeWillBeDetachedReply) },
+		},
+		{
+			Name:        "Page.frameNavigated",
+			Domain:      "Page",
+			Description: "Fired once navigation of the frame has completed. Frame is now associated with the new loader.",
+			Reply:       reflect.TypeOf(page.FrameNavigatedReply{}),
+			NewReply:    func() interface{} { return new(page.FrameNavigatedReply) },
+		},
+		{
+			Name:         "Page.documentOpened",
+			Domain:       "Page",
+			Description:  "Fired when opening document to write to.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.DocumentOpenedReply{}),
+			NewReply:     func() interface{} { return new(page.DocumentOpenedReply) },
+		},
+		{
+			Name:         "Page.frameResized",
+			Domain:       "Page",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.FrameResizedReply{}),
+			NewReply:     func() interface{} { return new(page.FrameResizedReply) },
+		},
+		{
+			Name:         "Page.frameStartedNavigating",
+			Domain:       "Page",
+			Description:  "Fired when a navigation starts. This event is fired for both\nrenderer-initiated and browser-initiated navigations. For renderer-initiated\nnavigations, the event is fired after `frameRequestedNavigation`.\nNavigation may still be cancelled after the event is issued. Multiple events\ncan be fired for a single navigation, for example, when a same-document\nnavigation becomes a cross-document navigation (such as in the case of a\nframeset).",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.FrameStartedNavigatingReply{}),
+			NewReply:     func() interface{} { return new(page.FrameStartedNavigatingReply) },
+		},
+		{
+			Name:         "Page.frameRequestedNavigation",
+			Domain:       "Page",
+			Description:  "Fired when a renderer-initiated navigation is requested.\nNavigation may still be cancelled after the event is issued.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.FrameRequestedNavigationReply{}),
+			NewReply:     func() interface{} { return new(page.FrameRequestedNavigationReply) },
+		},
+		{
+			Name:        "Page.frameScheduledNavigation",
+			Domain:      "Page",
+			Description: "Fired when frame schedules a potential navigation.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(page.FrameScheduledNavigationReply{}),
+			NewReply:    func() interface{} { return new(page.FrameScheduledNavigationReply) },
+		},
+		{
+			Name:         "Page.frameStartedLoading",
+			Domain:       "Page",
+			Description:  "Fired when frame has started loading.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.FrameStartedLoadingReply{}),
+			NewReply:     func() interface{} { return new(page.FrameStartedLoadingReply) },
+		},
+		{
+			Name:         "Page.frameStoppedLoading",
+			Domain:       "Page",
+			Description:  "Fired when frame has stopped loading.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.FrameStoppedLoadingReply{}),
+			NewReply:     func() interface{} { return new(page.FrameStoppedLoadingReply) },
+		},
+		{
+			Name:         "Page.downloadWillBegin",
+			Domain:       "Page",
+			Description:  "Fired when page is about to start a download.\nDeprecated. Use Browser.downloadWillBegin instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Reply:        reflect.TypeOf(page.DownloadWillBeginReply{}),
+			NewReply:     func() interface{} { return new(page.DownloadWillBeginReply) },
+		},
+		{
+			Name:         "Page.downloadProgress",
+			Domain:       "Page",
+			Description:  "Fired when download makes progress. Last call has |done| == true.\nDeprecated. Use Browser.downloadProgress instead.",
+			Experimental: true,
+			Deprecated:   true,
+			Reply:        reflect.TypeOf(page.DownloadProgressReply{}),
+			NewReply:     func() interface{} { return new(page.DownloadProgressReply) },
+		},
+		{
+			Name:        "Page.interstitialHidden",
+			Domain:      "Page",
+			Description: "Fired when interstitial page was hidden",
+			Reply:       reflect.TypeOf(page.InterstitialHiddenReply{}),
+			NewReply:    func() interface{} { return new(page.InterstitialHiddenReply) },
+		},
+		{
+			Name:        "Page.interstitialShown",
+			Domain:      "Page",
+			Description: "Fired when interstitial page was shown",
+			Reply:       reflect.TypeOf(page.InterstitialShownReply{}),
+			NewReply:    func() interface{} { return new(page.InterstitialShownReply) },
+		},
+		{
+			Name:        "Page.javascriptDialogClosed",
+			Domain:      "Page",
+			Description: "Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been\nclosed.",
+			Reply:       reflect.TypeOf(page.JavascriptDialogClosedReply{}),
+			NewReply:    func() interface{} { return new(page.JavascriptDialogClosedReply) },
+		},
+		{
+			Name:        "Page.javascriptDialogOpening",
+			Domain:      "Page",
+			Description: "Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to\nopen.",
+			Reply:       reflect.TypeOf(page.JavascriptDialogOpeningReply{}),
+			NewReply:    func() interface{} { return new(page.JavascriptDialogOpeningReply) },
+		},
+		{
+			Name:        "Page.lifecycleEvent",
+			Domain:      "Page",
+			Description: "Fired for lifecycle events (navigation, load, paint, etc) in the current\ntarget (including local frames).",
+			Reply:       reflect.TypeOf(page.LifecycleEventReply{}),
+			NewReply:    func() interface{} { return new(page.LifecycleEventReply) },
+		},
+		{
+			Name:         "Page.backForwardCacheNotUsed",
+			Domain:       "Page",
+			Description:  "Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do\nnot assume any ordering with the Page.frameNavigated event. This event is fired only for\nmain-frame history navigation where the document changes (non-same-document navigations),\nwhen bfcache navigation fails.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.BackForwardCacheNotUsedReply{}),
+			NewReply:     func() interface{} { return new(page.BackForwardCacheNotUsedReply) },
+		},
+		{
+			Name:     "Page.loadEventFired",
+			Domain:   "Page",
+			Reply:    reflect.TypeOf(page.LoadEventFiredReply{}),
+			NewReply: func() interface{} { return new(page.LoadEventFiredReply) },
+		},
+		{
+			Name:         "Page.navigatedWithinDocument",
+			Domain:       "Page",
+			Description:  "Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.NavigatedWithinDocumentReply{}),
+			NewReply:     func() interface{} { return new(page.NavigatedWithinDocumentReply) },
+		},
+		{
+			Name:         "Page.screencastFrame",
+			Domain:       "Page",
+			Description:  "Compressed image data requested by the `startScreencast`.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.ScreencastFrameReply{}),
+			NewReply:     func() interface{} { return new(page.ScreencastFrameReply) },
+		},
+		{
+			Name:         "Page.screencastVisibilityChanged",
+			Domain:       "Page",
+			Description:  "Fired when the page with currently enabled screencast was shown or hidden `.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.ScreencastVisibilityChangedReply{}),
+			NewReply:     func() interface{} { return new(page.ScreencastVisibilityChangedReply) },
+		},
+		{
+			Name:        "Page.windowOpen",
+			Domain:      "Page",
+			Description: "Fired when a new window is going to be opened, via window.open(), link click, form submission,\netc.",
+			Reply:       reflect.TypeOf(page.WindowOpenReply{}),
+			NewReply:    func() interface{} { return new(page.WindowOpenReply) },
+		},
+		{
+			Name:         "Page.compilationCacheProduced",
+			Domain:       "Page",
+			Description:  "Issued for every compilation cache generated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(page.CompilationCacheProducedReply{}),
+			NewReply:     func() interface{} { return new(page.CompilationCacheProducedReply) },
+		},
+		{
+			Name:        "Performance.metrics",
+			Domain:      "Performance",
+			Description: "Current values of the metrics.",
+			Reply:       reflect.TypeOf(performance.MetricsReply{}),
+			NewReply:    func() interface{} { return new(performance.MetricsReply) },
+		},
+		{
+			Name:         "PerformanceTimeline.timelineEventAdded",
+			Domain:       "PerformanceTimeline",
+			Description:  "Sent when a performance timeline event is added. See reportPerformanceTimeline method.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(performancetimeline.TimelineEventAddedReply{}),
+			NewReply:     func() interface{} { return new(performancetimeline.TimelineEventAddedReply) },
+		},
+		{
+			Name:         "Preload.ruleSetUpdated",
+			Domain:       "Preload",
+			Description:  "Upsert. Currently, it is only emitted when a rule set added.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(preload.RuleSetUpdatedReply{}),
+			NewReply:     func() interface{} { return new(preload.RuleSetUpdatedReply) },
+		},
+		{
+			Name:         "Preload.ruleSetRemoved",
+			Domain:       "Preload",
+			Experimental: true,
+			Reply:        reflect.TypeOf(preload.RuleSetRemovedReply{}),
+			NewReply:     func() interface{} { return new(preload.RuleSetRemovedReply) },
+		},
+		{
+			Name:         "Preload.preloadEnabledStateUpdated",
+			Domain:       "Preload",
+			Description:  "Fired when a preload enabled state is updated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(preload.EnabledStateUpdatedReply{}),
+			NewReply:     func() interface{} { return new(preload.EnabledStateUpdatedReply) },
+		},
+		{
+			Name:         "Preload.prefetchStatusUpdated",
+			Domain:       "Preload",
+			Description:  "Fired when a prefetch attempt is updated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(preload.PrefetchStatusUpdatedReply{}),
+			NewReply:     func() interface{} { return new(preload.PrefetchStatusUpdatedReply) },
+		},
+		{
+			Name:         "Preload.prerenderStatusUpdated",
+			Domain:       "Preload",
+			Description:  "Fired when a prerender attempt is updated.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(preload.PrerenderStatusUpdatedReply{}),
+			NewReply:     func() interface{} { return new(preload.PrerenderStatusUpdatedReply) },
+		},
+		{
+			Name:         "Preload.preloadingAttemptSourcesUpdated",
+			Domain:       "Preload",
+			Description:  "Send a list of sources for all preloading attempts in a document.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(preload.AttemptSourcesUpdatedReply{}),
+			NewReply:     func() interface{} { return new(preload.AttemptSourcesUpdatedReply) },
+		},
+		{
+			Name:     "Profiler.consoleProfileFinished",
+			Domain:   "Profiler",
+			Reply:    reflect.TypeOf(profiler.ConsoleProfileFinishedReply{}),
+			NewReply: func() interface{} { return new(profiler.ConsoleProfileFinishedReply) },
+		},
+		{
+			Name:        "Profiler.consoleProfileStarted",
+			Domain:      "Profiler",
+			Description: "Sent when new profile recording is started using console.profile() call.",
+			Reply:       reflect.TypeOf(profiler.ConsoleProfileStartedReply{}),
+			NewReply:    func() interface{} { return new(profiler.ConsoleProfileStartedReply) },
+		},
+		{
+			Name:         "Profiler.preciseCoverageDeltaUpdate",
+			Domain:       "Profiler",
+			Description:  "Reports coverage delta since the last poll (either from an event like this, or from\n`takePreciseCoverage` for the current isolate. May only be sent if precise code\ncoverage has been started. This event can be trigged by the embedder to, for example,\ntrigger collection of coverage data immediately at a certain point in time.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(profiler.PreciseCoverageDeltaUpdateReply{}),
+			NewReply:     func() interface{} { return new(profiler.PreciseCoverageDeltaUpdateReply) },
+		},
+		{
+			Name:         "Runtime.bindingCalled",
+			Domain:       "Runtime",
+			Description:  "Notification is issued every time when binding is called.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(runtime.BindingCalledReply{}),
+			NewReply:     func() interface{} { return new(runtime.BindingCalledReply) },
+		},
+		{
+			Name:        "Runtime.consoleAPICalled",
+			Domain:      "Runtime",
+			Description: "Issued when console API was called.",
+			Reply:       reflect.TypeOf(runtime.ConsoleAPICalledReply{}),
+			NewReply:    func() interface{} { return new(runtime.ConsoleAPICalledReply) },
+		},
+		{
+			Name:        "Runtime.exceptionRevoked",
+			Domain:      "Runtime",
+			Description: "Issued when unhandled exception was revoked.",
+			Reply:       reflect.TypeOf(runtime.ExceptionRevokedReply{}),
+			NewReply:    func() interface{} { return new(runtime.ExceptionRevokedReply) },
+		},
+		{
+			Name:        "Runtime.exceptionThrown",
+			Domain:      "Runtime",
+			Description: "Issued when exception was thrown and unhandled.",
+			Reply:       reflect.TypeOf(runtime.ExceptionThrownReply{}),
+			NewReply:    func() interface{} { return new(runtime.ExceptionThrownReply) },
+		},
+		{
+			Name:        "Runtime.executionContextCreated",
+			Domain:      "Runtime",
+			Description: "Issued when new execution context is created.",
+			Reply:       reflect.TypeOf(runtime.ExecutionContextCreatedReply{}),
+			NewReply:    func() interface{} { return new(runtime.ExecutionContextCreatedReply) },
+		},
+		{
+			Name:        "Runtime.executionContextDestroyed",
+			Domain:      "Runtime",
+			Description: "Issued when execution context is destroyed.",
+			Reply:       reflect.TypeOf(runtime.ExecutionContextDestroyedReply{}),
+			NewReply:    func() interface{} { return new(runtime.ExecutionContextDestroyedReply) },
+		},
+		{
+			Name:        "Runtime.executionContextsCleared",
+			Domain:      "Runtime",
+			Description: "Issued when all executionContexts were cleared in browser",
+			Reply:       reflect.TypeOf(runtime.ExecutionContextsClearedReply{}),
+			NewReply:    func() interface{} { return new(runtime.ExecutionContextsClearedReply) },
+		},
+		{
+			Name:        "Runtime.inspectRequested",
+			Domain:      "Runtime",
+			Description: "Issued when object should be inspected (for example, as a result of inspect() command line API\ncall).",
+			Reply:       reflect.TypeOf(runtime.InspectRequestedReply{}),
+			NewReply:    func() interface{} { return new(runtime.InspectRequestedReply) },
+		},
+		{
+			Name:        "Security.certificateError",
+			Domain:      "Security",
+			Description: "There is a certificate error. If overriding certificate errors is enabled, then it should be\nhandled with the `handleCertificateError` command. Note: this event does not fire if the\ncertificate error has been allowed internally. Only one client per target should override\ncertificate errors at the same time.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(security.CertificateErrorReply{}),
+			NewReply:    func() interface{} { return new(security.CertificateErrorReply) },
+		},
+		{
+			Name:         "Security.visibleSecurityStateChanged",
+			Domain:       "Security",
+			Description:  "The security state of the page changed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(security.VisibleSecurityStateChangedReply{}),
+			NewReply:     func() interface{} { return new(security.VisibleSecurityStateChangedReply) },
+		},
+		{
+			Name:        "Security.securityStateChanged",
+			Domain:      "Security",
+			Description: "The security state of the page changed. No longer being sent.",
+			Deprecated:  true,
+			Reply:       reflect.TypeOf(security.StateChangedReply{}),
+			NewReply:    func() interface{} { return new(security.StateChangedReply) },
+		},
+		{
+			Name:         "ServiceWorker.workerErrorReported",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Reply:        reflect.TypeOf(serviceworker.WorkerErrorReportedReply{}),
+			NewReply:     func() interface{} { return new(serviceworker.WorkerErrorReportedReply) },
+		},
+		{
+			Name:         "ServiceWorker.workerRegistrationUpdated",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Reply:        reflect.TypeOf(serviceworker.WorkerRegistrationUpdatedReply{}),
+			NewReply:     func() interface{} { return new(serviceworker.WorkerRegistrationUpdatedReply) },
+		},
+		{
+			Name:         "ServiceWorker.workerVersionUpdated",
+			Domain:       "ServiceWorker",
+			Experimental: true,
+			Reply:        reflect.TypeOf(serviceworker.WorkerVersionUpdatedReply{}),
+			NewReply:     func() interface{} { return new(serviceworker.WorkerVersionUpdatedReply) },
+		},
+		{
+			Name:         "Storage.cacheStorageContentUpdated",
+			Domain:       "Storage",
+			Description:  "A cache's contents have been modified.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.CacheStorageContentUpdatedReply{}),
+			NewReply:     func() interface{} { return new(storage.CacheStorageContentUpdatedReply) },
+		},
+		{
+			Name:         "Storage.cacheStorageListUpdated",
+			Domain:       "Storage",
+			Description:  "A cache has been added/deleted.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.CacheStorageListUpdatedReply{}),
+			NewReply:     func() interface{} { return new(storage.CacheStorageListUpdatedReply) },
+		},
+		{
+			Name:         "Storage.indexedDBContentUpdated",
+			Domain:       "Storage",
+			Description:  "The origin's IndexedDB object store has been modified.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.IndexedDBContentUpdatedReply{}),
+			NewReply:     func() interface{} { return new(storage.IndexedDBContentUpdatedReply) },
+		},
+		{
+			Name:         "Storage.indexedDBListUpdated",
+			Domain:       "Storage",
+			Description:  "The origin's IndexedDB database list has been modified.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.IndexedDBListUpdatedReply{}),
+			NewReply:     func() interface{} { return new(storage.IndexedDBListUpdatedReply) },
+		},
+		{
+			Name:         "Storage.interestGroupAccessed",
+			Domain:       "Storage",
+			Description:  "One of the interest groups was accessed. Note that these events are global\nto all targets sharing an interest group store.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.InterestGroupAccessedReply{}),
+			NewReply:     func() interface{} { return new(storage.InterestGroupAccessedReply) },
+		},
+		{
+			Name:         "Storage.interestGroupAuctionEventOccurred",
+			Domain:       "Storage",
+			Description:  "An auction involving interest groups is taking place. These events are\ntarget-specific.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.InterestGroupAuctionEventOccurredReply{}),
+			NewReply:     func() interface{} { return new(storage.InterestGroupAuctionEventOccurredReply) },
+		},
+		{
+			Name:         "Storage.interestGroupAuctionNetworkRequestCreated",
+			Domain:       "Storage",
+			Description:  "Specifies which auctions a particular network fetch may be related to, and\nin what role. Note that it is not ordered with respect to\nNetwork.requestWillBeSent (but will happen before loadingFinished\nloadingFailed).",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.InterestGroupAuctionNetworkRequestCreatedReply{}),
+			NewReply:     func() interface{} { return new(storage.InterestGroupAuctionNetworkRequestCreatedReply) },
+		},
+		{
+			Name:         "Storage.sharedStorageAccessed",
+			Domain:       "Storage",
+			Description:  "Shared storage was accessed by the associated page.\nThe following parameters are included in all events.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.SharedStorageAccessedReply{}),
+			NewReply:     func() interface{} { return new(storage.SharedStorageAccessedReply) },
+		},
+		{
+			Name:         "Storage.sharedStorageWorkletOperationExecutionFinished",
+			Domain:       "Storage",
+			Description:  "A shared storage run or selectURL operation finished its execution.\nThe following parameters are included in all events.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.SharedStorageWorkletOperationExecutionFinishedReply{}),
+			NewReply:     func() interface{} { return new(storage.SharedStorageWorkletOperationExecutionFinishedReply) },
+		},
+		{
+			Name:         "Storage.storageBucketCreatedOrUpdated",
+			Domain:       "Storage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.BucketCreatedOrUpdatedReply{}),
+			NewReply:     func() interface{} { return new(storage.BucketCreatedOrUpdatedReply) },
+		},
+		{
+			Name:         "Storage.storageBucketDeleted",
+			Domain:       "Storage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.BucketDeletedReply{}),
+			NewReply:     func() interface{} { return new(storage.BucketDeletedReply) },
+		},
+		{
+			Name:         "Storage.attributionReportingSourceRegistered",
+			Domain:       "Storage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.AttributionReportingSourceRegisteredReply{}),
+			NewReply:     func() interface{} { return new(storage.AttributionReportingSourceRegisteredReply) },
+		},
+		{
+			Name:         "Storage.attributionReportingTriggerRegistered",
+			Domain:       "Storage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.AttributionReportingTriggerRegisteredReply{}),
+			NewReply:     func() interface{} { return new(storage.AttributionReportingTriggerRegisteredReply) },
+		},
+		{
+			Name:         "Storage.attributionReportingReportSent",
+			Domain:       "Storage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.AttributionReportingReportSentReply{}),
+			NewReply:     func() interface{} { return new(storage.AttributionReportingReportSentReply) },
+		},
+		{
+			Name:         "Storage.attributionReportingVerboseDebugReportSent",
+			Domain:       "Storage",
+			Experimental: true,
+			Reply:        reflect.TypeOf(storage.AttributionReportingVerboseDebugReportSentReply{}),
+			NewReply:     func() interface{} { return new(storage.AttributionReportingVerboseDebugReportSentReply) },
+		},
+		{
+			Name:         "Target.attachedToTarget",
+			Domain:       "Target",
+			Description:  "Issued when attached to target because of auto-attach or `attachToTarget` command.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(target.AttachedToTargetReply{}),
+			NewReply:     func() interface{} { return new(target.AttachedToTargetReply) },
+		},
+		{
+			Name:         "Target.detachedFromTarget",
+			Domain:       "Target",
+			Description:  "Issued when detached from target for any reason (including `detachFromTarget` command). Can be\nissued multiple times per target if multiple sessions have been attached to it.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(target.DetachedFromTargetReply{}),
+			NewReply:     func() interface{} { return new(target.DetachedFromTargetReply) },
+		},
+		{
+			Name:        "Target.receivedMessageFromTarget",
+			Domain:      "Target",
+			Description: "Notifies about a new protocol message received from the session (as reported in\n`attachedToTarget` event).",
+			Reply:       reflect.TypeOf(target.ReceivedMessageFromTargetReply{}),
+			NewReply:    func() interface{} { return new(target.ReceivedMessageFromTargetReply) },
+		},
+		{
+			Name:        "Target.targetCreated",
+			Domain:      "Target",
+			Description: "Issued when a possible inspection target is created.",
+			Reply:       reflect.TypeOf(target.CreatedReply{}),
+			NewReply:    func() interface{} { return new(target.CreatedReply) },
+		},
+		{
+			Name:        "Target.targetDestroyed",
+			Domain:      "Target",
+			Description: "Issued when a target is destroyed.",
+			Reply:       reflect.TypeOf(target.DestroyedReply{}),
+			NewReply:    func() interface{} { return new(target.DestroyedReply) },
+		},
+		{
+			Name:        "Target.targetCrashed",
+			Domain:      "Target",
+			Description: "Issued when a target has crashed.",
+			Reply:       reflect.TypeOf(target.CrashedReply{}),
+			NewReply:    func() interface{} { return new(target.CrashedReply) },
+		},
+		{
+			Name:        "Target.targetInfoChanged",
+			Domain:      "Target",
+			Description: "Issued when some information about a target has changed. This only happens between\n`targetCreated` and `targetDestroyed`.",
+			Reply:       reflect.TypeOf(target.InfoChangedReply{}),
+			NewReply:    func() interface{} { return new(target.InfoChangedReply) },
+		},
+		{
+			Name:         "Tethering.accepted",
+			Domain:       "Tethering",
+			Description:  "Informs that port was successfully bound and got a specified connection id.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(tethering.AcceptedReply{}),
+			NewReply:     func() interface{} { return new(tethering.AcceptedReply) },
+		},
+		{
+			Name:         "Tracing.bufferUsage",
+			Domain:       "Tracing",
+			Experimental: true,
+			Reply:        reflect.TypeOf(tracing.BufferUsageReply{}),
+			NewReply:     func() interface{} { return new(tracing.BufferUsageReply) },
+		},
+		{
+			Name:         "Tracing.dataCollected",
+			Domain:       "Tracing",
+			Description:  "Contains a bucket of collected trace events. When tracing is stopped collected events will be\nsent as a sequence of dataCollected events followed by tracingComplete event.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(tracing.DataCollectedReply{}),
+			NewReply:     func() interface{} { return new(tracing.DataCollectedReply) },
+		},
+		{
+			Name:        "Tracing.tracingComplete",
+			Domain:      "Tracing",
+			Description: "Signals that tracing is stopped and there is no trace buffers pending flush, all data were\ndelivered via dataCollected events.",
+			Reply:       reflect.TypeOf(tracing.CompleteReply{}),
+			NewReply:    func() interface{} { return new(tracing.CompleteReply) },
+		},
+		{
+			Name:         "WebAudio.contextCreated",
+			Domain:       "WebAudio",
+			Description:  "Notifies that a new BaseAudioContext has been created.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.ContextCreatedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.ContextCreatedReply) },
+		},
+		{
+			Name:         "WebAudio.contextWillBeDestroyed",
+			Domain:       "WebAudio",
+			Description:  "Notifies that an existing BaseAudioContext will be destroyed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.ContextWillBeDestroyedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.ContextWillBeDestroyedReply) },
+		},
+		{
+			Name:         "WebAudio.contextChanged",
+			Domain:       "WebAudio",
+			Description:  "Notifies that existing BaseAudioContext has changed some properties (id stays the same)..",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.ContextChangedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.ContextChangedReply) },
+		},
+		{
+			Name:         "WebAudio.audioListenerCreated",
+			Domain:       "WebAudio",
+			Description:  "Notifies that the construction of an AudioListener has finished.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.AudioListenerCreatedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.AudioListenerCreatedReply) },
+		},
+		{
+			Name:         "WebAudio.audioListenerWillBeDestroyed",
+			Domain:       "WebAudio",
+			Description:  "Notifies that a new AudioListener has been created.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.AudioListenerWillBeDestroyedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.AudioListenerWillBeDestroyedReply) },
+		},
+		{
+			Name:         "WebAudio.audioNodeCreated",
+			Domain:       "WebAudio",
+			Description:  "Notifies that a new AudioNode has been created.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.AudioNodeCreatedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.AudioNodeCreatedReply) },
+		},
+		{
+			Name:         "WebAudio.audioNodeWillBeDestroyed",
+			Domain:       "WebAudio",
+			Description:  "Notifies that an existing AudioNode has been destroyed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.AudioNodeWillBeDestroyedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.AudioNodeWillBeDestroyedReply) },
+		},
+		{
+			Name:         "WebAudio.audioParamCreated",
+			Domain:       "WebAudio",
+			Description:  "Notifies that a new AudioParam has been created.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.AudioParamCreatedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.AudioParamCreatedReply) },
+		},
+		{
+			Name:         "WebAudio.audioParamWillBeDestroyed",
+			Domain:       "WebAudio",
+			Description:  "Notifies that an existing AudioParam has been destroyed.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.AudioParamWillBeDestroyedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.AudioParamWillBeDestroyedReply) },
+		},
+		{
+			Name:         "WebAudio.nodesConnected",
+			Domain:       "WebAudio",
+			Description:  "Notifies that two AudioNodes are connected.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.NodesConnectedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.NodesConnectedReply) },
+		},
+		{
+			Name:         "WebAudio.nodesDisconnected",
+			Domain:       "WebAudio",
+			Description:  "Notifies that AudioNodes are disconnected. The destination can be null, and it means all the outgoing connections from the source are disconnected.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.NodesDisconnectedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.NodesDisconnectedReply) },
+		},
+		{
+			Name:         "WebAudio.nodeParamConnected",
+			Domain:       "WebAudio",
+			Description:  "Notifies that an AudioNode is connected to an AudioParam.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.NodeParamConnectedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.NodeParamConnectedReply) },
+		},
+		{
+			Name:         "WebAudio.nodeParamDisconnected",
+			Domain:       "WebAudio",
+			Description:  "Notifies that an AudioNode is disconnected to an AudioParam.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webaudio.NodeParamDisconnectedReply{}),
+			NewReply:     func() interface{} { return new(webaudio.NodeParamDisconnectedReply) },
+		},
+		{
+			Name:         "WebAuthn.credentialAdded",
+			Domain:       "WebAuthn",
+			Description:  "Triggered when a credential is added to an authenticator.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webauthn.CredentialAddedReply{}),
+			NewReply:     func() interface{} { return new(webauthn.CredentialAddedReply) },
+		},
+		{
+			Name:         "WebAuthn.credentialDeleted",
+			Domain:       "WebAuthn",
+			Description:  "Triggered when a credential is deleted, e.g. through\nPublicKeyCredential.signalUnknownCredential().",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webauthn.CredentialDeletedReply{}),
+			NewReply:     func() interface{} { return new(webauthn.CredentialDeletedReply) },
+		},
+		{
+			Name:         "WebAuthn.credentialUpdated",
+			Domain:       "WebAuthn",
+			Description:  "Triggered when a credential is updated, e.g. through\nPublicKeyCredential.signalCurrentUserDetails().",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webauthn.CredentialUpdatedReply{}),
+			NewReply:     func() interface{} { return new(webauthn.CredentialUpdatedReply) },
+		},
+		{
+			Name:         "WebAuthn.credentialAsserted",
+			Domain:       "WebAuthn",
+			Description:  "Triggered when a credential is used in a webauthn assertion.",
+			Experimental: true,
+			Reply:        reflect.TypeOf(webauthn.CredentialAssertedReply{}),
+			NewReply:     func() interface{} { return new(webauthn.CredentialAssertedReply) },
+		},
+	}...)
+}
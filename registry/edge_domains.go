@@ -0,0 +1,74 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+//go:build edge
+
+package registry
+
+import (
+	"reflect"
+
+	"github.com/mafredri/cdp/protocol/css"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+func init() {
+	methods = append(methods, []*Method{
+		{
+			Name:        "Debugger.canSetScriptSource",
+			Domain:      "Debugger",
+			Description: "Always returns true.",
+			Reply:       reflect.TypeOf(debugger.CanSetScriptSourceReply{}),
+			NewReply:    func() interface{} { return new(debugger.CanSetScriptSourceReply) },
+		},
+		{
+			Name:        "Page.canScreencast",
+			Domain:      "Page",
+			Description: "Tells whether screencast is supported.",
+			Reply:       reflect.TypeOf(page.CanScreencastReply{}),
+			NewReply:    func() interface{} { return new(page.CanScreencastReply) },
+		},
+		{
+			Name:        "Page.setShowViewportSizeOnResize",
+			Domain:      "Page",
+			Description: "Paints viewport size upon main frame resize.",
+			Args:        reflect.TypeOf(page.SetShowViewportSizeOnResizeArgs{}),
+			NewArgs:     func() interface{} { return new(page.SetShowViewportSizeOnResizeArgs) },
+		},
+		{
+			Name:        "Runtime.run",
+			Domain:      "Runtime",
+			Description: "Tells inspected instance(worker or page) that it can run in case it was started paused.",
+		},
+	}...)
+	events = append(events, []*Event{
+		{
+			Name:     "CSS.layoutEditorChange",
+			Domain:   "CSS",
+			Reply:    reflect.TypeOf(css.LayoutEditorChangeReply{}),
+			NewReply: func() interface{} { return new(css.LayoutEditorChangeReply) },
+		},
+		{
+			Name:        "DOM.inspectNodeRequested",
+			Domain:      "DOM",
+			Description: "Fired when the node should be inspected. This happens after call to <code>setInspectMode</code>.",
+			Reply:       reflect.TypeOf(dom.InspectNodeRequestedReply{}),
+			NewReply:    func() interface{} { return new(dom.InspectNodeRequestedReply) },
+		},
+		{
+			Name:        "Debugger.globalObjectCleared",
+			Domain:      "Debugger",
+			Description: "Called when global has been cleared and debugger client should reset its state. Happens upon navigation or reload.",
+			Reply:       reflect.TypeOf(debugger.GlobalObjectClearedReply{}),
+			NewReply:    func() interface{} { return new(debugger.GlobalObjectClearedReply) },
+		},
+		{
+			Name:        "Debugger.asyncOperationCompleted",
+			Domain:      "Debugger",
+			Description: "Fired when an async operation is completed (while in a debugger stepping session).",
+			Reply:       reflect.TypeOf(debugger.AsyncOperationCompletedReply{}),
+			NewReply:    func() interface{} { return new(debugger.AsyncOperationCompletedReply) },
+		},
+	}...)
+}
@@ -0,0 +1,158 @@
+/*
+Package registry describes the methods (commands) and events of the
+protocol at runtime. It maps names such as "Page.navigate" or
+"Network.requestWillBeSent" to their arguments and reply types, for
+decoding recorded traffic, building generic tools or implementing fake
+servers.
+
+Decode the parameters of a recorded request:
+
+	m, ok := registry.LookupMethod(req.Method)
+	if !ok {
+		// Unknown method.
+	}
+	args, err := m.DecodeArgs(req.Params)
+	if err != nil {
+		// Handle error.
+	}
+	fmt.Printf("%s: %#v\n", m.Name, args) // Page.navigate: &page.NavigateArgs{...}
+
+The registry contains the domains of cdp.Client and cdp.NodeClient, and
+vendor extensions (e.g. cdp.EdgeClient) when built with their tag. The
+registry is generated by cdpgen.
+*/
+package registry
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/mafredri/cdp/internal/errors"
+)
+
+// Method describes a protocol method (command).
+type Method struct {
+	Name         string // Full name, e.g. "Page.navigate".
+	Domain       string // E.g. "Page".
+	Description  string
+	Experimental bool // Also set when the domain is experimental.
+	Deprecated   bool // Also set when the domain is deprecated.
+
+	// Args is the arguments type (e.g. page.NavigateArgs) and Reply the
+	// return values type (e.g. page.NavigateReply), nil for methods
+	// without arguments or return values.
+	Args  reflect.Type
+	Reply reflect.Type
+
+	// NewArgs and NewReply return a pointer to a new value of Args and
+	// Reply, e.g. new(page.NavigateArgs), nil if the type is nil.
+	NewArgs  func() interface{}
+	NewReply func() interface{}
+}
+
+// DecodeArgs decodes the JSON encoded arguments (params) of a request
+// for m. Returns nil if m has no arguments.
+func (m *Method) DecodeArgs(data []byte) (interface{}, error) {
+	return decode(m.Name, "arguments", m.NewArgs, data)
+}
+
+// DecodeReply decodes the JSON encoded result of a response for m.
+// Returns nil if m has no return values.
+func (m *Method) DecodeReply(data []byte) (interface{}, error) {
+	return decode(m.Name, "reply", m.NewReply, data)
+}
+
+// Event describes a protocol event.
+type Event struct {
+	Name         string // Full name, e.g. "Network.requestWillBeSent".
+	Domain       string // E.g. "Network".
+	Description  string
+	Experimental bool // Also set when the domain is experimental.
+	Deprecated   bool // Also set when the domain is deprecated.
+
+	// Reply is the event type, e.g. network.RequestWillBeSentReply.
+	Reply reflect.Type
+	// NewReply returns a pointer to a new value of Reply, e.g.
+	// new(network.RequestWillBeSentReply).
+	NewReply func() interface{}
+}
+
+// DecodeReply decodes the JSON encoded params of a notification for e.
+func (e *Event) DecodeReply(data []byte) (interface{}, error) {
+	return decode(e.Name, "reply", e.NewReply, data)
+}
+
+func decode(name, what string, newV func() interface{}, data []byte) (interface{}, error) {
+	if newV == nil {
+		return nil, nil
+	}
+	v := newV()
+	if len(data) == 0 {
+		return v, nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, errors.Wrapf(err, "registry: %s: decode %s failed", name, what)
+	}
+	return v, nil
+}
+
+// methods and events are written by cdpgen, vendor extensions append
+// to them in init.
+var (
+	methods []*Method
+	events  []*Event
+)
+
+var (
+	indexOnce sync.Once
+	methodIdx map[string]*Method
+	eventIdx  map[string]*Event
+)
+
+func index() {
+	indexOnce.Do(func() {
+		sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+		sort.Slice(events, func(i, j int) bool { return events[i].Name < events[j].Name })
+
+		methodIdx = make(map[string]*Method, len(methods))
+		for _, m := range methods {
+			methodIdx[m.Name] = m
+		}
+		eventIdx = make(map[string]*Event, len(events))
+		for _, e := range events {
+			eventIdx[e.Name] = e
+		}
+	})
+}
+
+// LookupMethod returns the method with the full name, e.g.
+// "Page.navigate". The returned value must not be modified.
+func LookupMethod(name string) (*Method, bool) {
+	index()
+	m, ok := methodIdx[name]
+	return m, ok
+}
+
+// LookupEvent returns the event with the full name, e.g.
+// "Network.requestWillBeSent". The returned value must not be modified.
+func LookupEvent(name string) (*Event, bool) {
+	index()
+	e, ok := eventIdx[name]
+	return e, ok
+}
+
+// Methods returns all methods sorted by name. The returned slice must
+// not be modified.
+func Methods() []*Method {
+	index()
+	return methods
+}
+
+// Events returns all events sorted by name. The returned slice must not
+// be modified.
+func Events() []*Event {
+	index()
+	return events
+}
@@ -0,0 +1,126 @@
+package registry
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+func TestLookupMethod(t *testing.T) {
+	m, ok := LookupMethod("Page.navigate")
+	if !ok {
+		t.Fatal("LookupMethod(Page.navigate): not found")
+	}
+	if m.Domain != "Page" || m.Description == "" {
+		t.Errorf("LookupMethod(Page.navigate) = %+v, want domain Page and description", m)
+	}
+	if m.Args != reflect.TypeOf(page.NavigateArgs{}) || m.Reply != reflect.TypeOf(page.NavigateReply{}) {
+		t.Errorf("LookupMethod(Page.navigate) types = %v, %v", m.Args, m.Reply)
+	}
+
+	args, err := m.DecodeArgs([]byte(`{"url":"about:blank","transitionType":"link"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := page.NewNavigateArgs("about:blank").SetTransitionType(page.TransitionTypeLink)
+	if diff := cmp.Diff(want, args); diff != "" {
+		t.Errorf("DecodeArgs() (-want +got):\n%s", diff)
+	}
+	if _, err = m.DecodeArgs([]byte(`{"url":1}`)); err == nil {
+		t.Error("DecodeArgs(invalid): want error, got nil")
+	}
+
+	m, ok = LookupMethod("Page.disable")
+	if !ok {
+		t.Fatal("LookupMethod(Page.disable): not found")
+	}
+	if m.Args != nil || m.NewArgs != nil {
+		t.Errorf("Page.disable: got args %v, want none", m.Args)
+	}
+	if v, err := m.DecodeReply([]byte(`{}`)); v != nil || err != nil {
+		t.Errorf("Page.disable: DecodeReply() = %v, %v, want nil, nil", v, err)
+	}
+
+	if _, ok = LookupMethod("Page.notExist"); ok {
+		t.Error("LookupMethod(Page.notExist): found")
+	}
+}
+
+func TestLookupEvent(t *testing.T) {
+	e, ok := LookupEvent("Network.dataReceived")
+	if !ok {
+		t.Fatal("LookupEvent(Network.dataReceived): not found")
+	}
+	v, err := e.DecodeReply([]byte(`{"requestId":"1","timestamp":1.5,"dataLength":2,"encodedDataLength":3}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &network.DataReceivedReply{RequestID: "1", Timestamp: 1.5, DataLength: 2, EncodedDataLength: 3}
+	if diff := cmp.Diff(want, v); diff != "" {
+		t.Errorf("DecodeReply() (-want +got):\n%s", diff)
+	}
+
+	if _, ok = LookupMethod("Network.dataReceived"); ok {
+		t.Error("LookupMethod(Network.dataReceived): found event")
+	}
+}
+
+func TestFlags(t *testing.T) {
+	for name, want := range map[string][2]bool{
+		"Page.navigate":            {false, false},
+		"Page.getResourceTree":     {true, false},
+		"DOM.getFlattenedDocument": {false, true},
+		"Animation.enable":         {true, false}, // Experimental domain.
+	} {
+		m, ok := LookupMethod(name)
+		if !ok {
+			t.Errorf("LookupMethod(%s): not found", name)
+			continue
+		}
+		if got := [2]bool{m.Experimental, m.Deprecated}; got != want {
+			t.Errorf("%s: experimental, deprecated = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	methods := Methods()
+	if len(methods) < 500 {
+		t.Errorf("Methods() = %d, want at least 500", len(methods))
+	}
+	if !sort.SliceIsSorted(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name }) {
+		t.Error("Methods() not sorted")
+	}
+	seen := make(map[string]bool)
+	for _, m := range methods {
+		if seen[m.Name] {
+			t.Errorf("%s: duplicate", m.Name)
+		}
+		seen[m.Name] = true
+		if (m.Args == nil) != (m.NewArgs == nil) || (m.Reply == nil) != (m.NewReply == nil) {
+			t.Errorf("%s: types and constructors differ", m.Name)
+			continue
+		}
+		if m.NewArgs != nil && reflect.TypeOf(m.NewArgs()).Elem() != m.Args {
+			t.Errorf("%s: NewArgs() returns %T, want *%v", m.Name, m.NewArgs(), m.Args)
+		}
+		if m.NewReply != nil && reflect.TypeOf(m.NewReply()).Elem() != m.Reply {
+			t.Errorf("%s: NewReply() returns %T, want *%v", m.Name, m.NewReply(), m.Reply)
+		}
+	}
+
+	events := Events()
+	if len(events) < 150 {
+		t.Errorf("Events() = %d, want at least 150", len(events))
+	}
+	for _, e := range events {
+		if reflect.TypeOf(e.NewReply()).Elem() != e.Reply {
+			t.Errorf("%s: NewReply() returns %T, want *%v", e.Name, e.NewReply(), e.Reply)
+		}
+	}
+}
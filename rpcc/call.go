@@ -7,12 +7,20 @@ type rpcCall struct {
 	Args   interface{}
 	Reply  interface{}
 	Error  chan error
+
+	session *Conn // Set for calls made on a flat session.
 }
 
 func (c *rpcCall) done(err error) {
 	c.Error <- err
 }
 
+// validator is implemented by arguments that can be validated, see
+// WithValidation.
+type validator interface {
+	Validate() error
+}
+
 // Invoke sends an RPC request and blocks until the response is received.
 // This function is called by generated code but can be used to issue
 // requests manually.
@@ -21,6 +29,12 @@ func Invoke(ctx context.Context, method string, args, reply interface{}, conn *C
 		ctx = context.Background()
 	}
 
+	if v, ok := args.(validator); ok && conn.root().dialOpts.validate {
+		if err := v.Validate(); err != nil {
+			return &ArgsError{Method: method, Err: err}
+		}
+	}
+
 	call := &rpcCall{
 		Method: method,
 		Args:   args,
@@ -10,13 +10,15 @@ import (
 	"log"
 	"net"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gorilla/websocket"
 )
 
 type closeError struct {
-	msg string
-	err error
+	msg    string
+	reason error // Sentinel matched by errors.Is, if any.
+	err    error
 }
 
 func (e *closeError) Error() string {
@@ -28,11 +30,57 @@ func (e *closeError) Error() string {
 func (e *closeError) Closed() bool  { return true }
 func (e *closeError) Cause() error  { return e.err }
 func (e *closeError) Unwrap() error { return e.err }
+func (e *closeError) Is(target error) bool {
+	return e.reason != nil && e.reason == target
+}
 
 // ErrConnClosing indicates that the operation is illegal because
 // the connection is closing.
 var ErrConnClosing = &closeError{msg: "rpcc: the connection is closing"}
 
+// ErrRemoteClosed is the reason reported by Err when the connection
+// was closed by the remote, or the underlying transport failed.
+var ErrRemoteClosed = errors.New("rpcc: the remote closed the connection")
+
+// ErrTargetCrashed is the reason reported by Err when the remote closed
+// the connection after sending an Inspector.targetCrashed notification.
+var ErrTargetCrashed = errors.New("rpcc: the target crashed")
+
+// DetachedError is the reason reported by Err when the connection was
+// closed after the remote sent an Inspector.detached notification. A
+// Codec may return a DetachedError (or ErrTargetCrashed) from
+// ReadResponse to close the connection with that reason.
+type DetachedError struct {
+	Reason string // The reason given by Inspector.detached.
+}
+
+func (e *DetachedError) Error() string {
+	return "rpcc: inspector detached: " + e.Reason
+}
+
+// DecodeError is the reason reported by Err when the connection was
+// closed because a message from the remote could not be decoded. A
+// Codec may return a DecodeError from ReadResponse to indicate that
+// the failure was caused by malformed data rather than the transport.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string { return "rpcc: decode failed: " + e.Err.Error() }
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+// ArgsError is returned by Invoke when the arguments fail validation,
+// see WithValidation.
+type ArgsError struct {
+	Method string
+	Err    error
+}
+
+func (e *ArgsError) Error() string {
+	return "rpcc: invalid arguments for " + e.Method + ": " + e.Err.Error()
+}
+func (e *ArgsError) Unwrap() error { return e.Err }
+
 const (
 	defaultWriteBufferSize = 4096
 )
@@ -83,6 +131,19 @@ func WithCompression() DialOption {
 	}
 }
 
+// WithValidation returns a DialOption that validates the arguments of
+// each request before it is sent, the arguments are validated if they
+// implement Validate() error (e.g. the generated arguments of the
+// protocol commands). An invalid request is not sent and Invoke returns
+// an *ArgsError.
+//
+// Connections created by DialSession use the option of conn.
+func WithValidation() DialOption {
+	return func(o *dialOptions) {
+		o.validate = true
+	}
+}
+
 // WithTLSClientConfig specifies the TLS configuration to use with tls.Client.
 func WithTLSClientConfig(c *tls.Config) DialOption {
 	return func(o *dialOptions) {
@@ -94,6 +155,7 @@ type dialOptions struct {
 	codec    func(io.ReadWriter) Codec
 	dialer   func(context.Context, string) (io.ReadWriteCloser, error)
 	wsDialer websocket.Dialer
+	validate bool
 }
 
 // Dial connects to target and returns an active connection. The target
@@ -186,11 +248,11 @@ func DialContext(ctx context.Context, target string, opts ...DialOption) (conn *
 
 	recvDone := func(err error) {
 		// When we receive Inspector.detached the remote will close
-		// the connection afterwards and recvDone will return. Maybe
-		// we could give the user time to react to the event before
-		// closing?
-		// TODO(mafredri): Do we want to close here, like this?
-		c.close(err)
+		// the connection afterwards and recvDone will return. The
+		// notification is still delivered to active streams (they
+		// drain pending messages after close) and the reason is
+		// reported by Err, giving the user a chance to react.
+		c.close(c.remoteCloseError(err))
 	}
 	go c.recv(c.notify, recvDone)
 
@@ -259,6 +321,18 @@ type Conn struct {
 	closed  bool
 	err     error // Protected by mu and closed until context is cancelled.
 
+	detached *DetachedError // Set by recv on Inspector.detached.
+	crashed  bool           // Set by recv on Inspector.targetCrashed.
+
+	// Flat sessions (DialSession) are multiplexed over the root
+	// connection, parent is the root connection for a session and
+	// owner the connection passed to DialSession.
+	parent         *Conn
+	owner          *Conn
+	sessionID      string
+	sessions       map[string]*Conn // Protected by mu, only used by root.
+	remoteDetached atomic.Bool
+
 	reqMu sync.Mutex // Protects following.
 	req   Request
 	// Encodes and decodes JSON onto conn. Encoding is
@@ -276,6 +350,9 @@ type Response struct {
 	// RPC notification from remote.
 	Method string          `json:"method"` // Method invokation requested by remote.
 	Args   json.RawMessage `json:"params"` // Method parameters, if any.
+
+	// SessionID is set for messages belonging to a flat session.
+	SessionID string `json:"sessionId"`
 }
 
 func (r *Response) reset() {
@@ -284,6 +361,7 @@ func (r *Response) reset() {
 	r.Error = nil
 	r.Method = ""
 	r.Args = nil
+	r.SessionID = ""
 }
 
 func (r *Response) String() string {
@@ -318,6 +396,62 @@ func (c *Conn) Context() context.Context {
 	return c.ctx
 }
 
+// Done returns a channel that is closed when the connection is closed,
+// either by calling Close or by the remote.
+func (c *Conn) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+// Err returns nil if Done is not yet closed. After Done is closed, Err
+// returns the error that closed the connection. The reason can be
+// inspected with errors.Is and errors.As:
+//
+//	ErrConnClosing   the connection was closed via Close.
+//	ErrRemoteClosed  the remote closed the connection.
+//	ErrTargetCrashed the target crashed (Inspector.targetCrashed).
+//	*DetachedError   the remote detached (Inspector.detached).
+//	*DecodeError     a message from the remote could not be decoded.
+func (c *Conn) Err() error {
+	select {
+	case <-c.ctx.Done():
+	default:
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// remoteCloseError classifies the error returned by the codec when
+// reading from the connection.
+func (c *Conn) remoteCloseError(err error) error {
+	var decodeErr *DecodeError
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &decodeErr):
+		return &closeError{msg: ErrConnClosing.msg, err: err}
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return &closeError{msg: ErrConnClosing.msg, err: &DecodeError{Err: err}}
+	}
+
+	c.mu.Lock()
+	detached, crashed := c.detached, c.crashed
+	c.mu.Unlock()
+
+	var detachedErr *DetachedError
+	switch {
+	case detached != nil:
+		return &closeError{msg: ErrConnClosing.msg, err: detached}
+	case errors.As(err, &detachedErr), errors.Is(err, ErrTargetCrashed):
+		// Reason provided by the Codec, e.g. a session.
+		return &closeError{msg: ErrConnClosing.msg, err: err}
+	case crashed:
+		return &closeError{msg: ErrConnClosing.msg, reason: ErrTargetCrashed, err: err}
+	}
+	return &closeError{msg: ErrConnClosing.msg, reason: ErrRemoteClosed, err: err}
+}
+
 // recv decodes and handles RPC responses. Responses to RPC requests
 // are forwarded to the pending call, if any. RPC Notifications are
 // forwarded by calling notify, synchronously.
@@ -333,6 +467,16 @@ func (c *Conn) recv(notify func(string, []byte), done func(error)) {
 
 		// Check if this is an RPC notification from the server.
 		if resp.Method != "" {
+			if resp.Method == "Target.detachedFromTarget" {
+				// Close the flat session, if any, before
+				// notifying listeners.
+				c.detachSession(resp.Args)
+			}
+			if resp.SessionID != "" {
+				c.handleSessionMessage(&resp)
+				continue
+			}
+			c.handleNotification(resp.Method, resp.Args)
 			// Method represents the event that was triggered over the
 			// Chrome DevTools Protocol. We do not expect to receive
 			// RPC requests, if this was one, the ID field would be set.
@@ -366,11 +510,39 @@ func (c *Conn) recv(notify func(string, []byte), done func(error)) {
 	}
 }
 
+// handleNotification records the state changes signaled by Inspector
+// notifications.
+func (c *Conn) handleNotification(method string, args []byte) {
+	switch method {
+	case "Inspector.detached":
+		c.setDetached(args)
+	case "Inspector.targetCrashed":
+		c.mu.Lock()
+		c.crashed = true
+		c.mu.Unlock()
+	}
+}
+
+// setDetached records the reason from an Inspector.detached
+// notification, the remote will close the connection shortly after.
+func (c *Conn) setDetached(args []byte) {
+	var ev struct {
+		Reason string `json:"reason"`
+	}
+	// Ignore errors, an undecodable reason is still a detach.
+	_ = json.Unmarshal(args, &ev)
+
+	c.mu.Lock()
+	c.detached = &DetachedError{Reason: ev.Reason}
+	c.mu.Unlock()
+}
+
 // Request represents an RPC request to be sent to the server.
 type Request struct {
-	ID     uint64      `json:"id"`               // ID chosen by client.
-	Method string      `json:"method"`           // Method invoked on remote.
-	Args   interface{} `json:"params,omitempty"` // Method parameters, if any.
+	ID        uint64      `json:"id"`                  // ID chosen by client.
+	Method    string      `json:"method"`              // Method invoked on remote.
+	Args      interface{} `json:"params,omitempty"`    // Method parameters, if any.
+	SessionID string      `json:"sessionId,omitempty"` // Flat session, if any.
 }
 
 // send returns after the call has successfully been dispatched over
@@ -385,27 +557,40 @@ func (c *Conn) send(ctx context.Context, call *rpcCall) (err error) {
 		}
 	}()
 
-	c.mu.Lock()
-	if c.closed {
+	// Flat sessions send requests via the root connection.
+	root := c.root()
+	if root != c {
+		c.mu.Lock()
+		closed, err := c.closed, c.err
 		c.mu.Unlock()
-		return c.err
+		if closed {
+			return err
+		}
+		call.session = c
 	}
-	c.reqSeq++
-	reqID := c.reqSeq
-	c.pending[reqID] = call
-	c.mu.Unlock()
+
+	root.mu.Lock()
+	if root.closed {
+		root.mu.Unlock()
+		return root.err
+	}
+	root.reqSeq++
+	reqID := root.reqSeq
+	root.pending[reqID] = call
+	root.mu.Unlock()
 
 	done := make(chan error, 1)
 	go func() {
-		c.reqMu.Lock()
-		c.req.ID = reqID
-		c.req.Method = call.Method
-		c.req.Args = call.Args
+		root.reqMu.Lock()
+		root.req.ID = reqID
+		root.req.Method = call.Method
+		root.req.Args = call.Args
+		root.req.SessionID = c.sessionID
 
-		err := c.codec.WriteRequest(&c.req)
+		err := root.codec.WriteRequest(&root.req)
 
-		c.req.Args = nil
-		c.reqMu.Unlock()
+		root.req.Args = nil
+		root.reqMu.Unlock()
 		done <- err
 	}()
 
@@ -417,20 +602,20 @@ func (c *Conn) send(ctx context.Context, call *rpcCall) (err error) {
 	}
 
 	if err != nil {
-		c.mu.Lock()
-		if c.closed {
+		root.mu.Lock()
+		if root.closed {
 			// There is a chance that WriteRequest is executed in
 			// parallel with the closing of Conn. If it happens,
 			// err will be a "use of closed network connection"
 			// error, but we want to return the error that closed
 			// Conn.
-			err = c.err
+			err = root.err
 		} else {
 			// Remove reference on error, avoid
 			// unnecessary work in recv.
-			delete(c.pending, reqID)
+			delete(root.pending, reqID)
 		}
-		c.mu.Unlock()
+		root.mu.Unlock()
 		return err
 	}
 
@@ -476,32 +661,38 @@ func (c *Conn) listen(method string, w streamWriter) (func(), error) {
 // that closed the connection.
 func (c *Conn) close(err error) error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
 	if c.closed {
+		defer c.mu.Unlock()
 		return c.err
 	}
-	c.closed = true
-	if err == nil {
-		err = ErrConnClosing
-	} else {
-		err = &closeError{msg: ErrConnClosing.msg, err: err}
+	var sessions []*Conn
+	for _, s := range c.sessions {
+		sessions = append(sessions, s)
 	}
-	c.err = err
-	for id, call := range c.pending {
-		delete(c.pending, id)
-		call.done(err)
+	c.sessions = nil
+	err = c.closeLocked(err)
+	c.mu.Unlock()
+
+	if c.parent != nil {
+		// Sessions dialed from this session are closed with it, the
+		// session is removed from the root connection before closing
+		// the transport so that Target.detachedFromTarget for it is
+		// ignored.
+		sessions = append(sessions, c.parent.sessionsOf(c)...)
+		c.parent.closeSession(c, err)
 	}
-	// Stop sending on all streams by signaling
-	// that the connection is closed.
-	c.streams = nil
 
+	// Close the transport without holding the lock, for a flat
+	// session this calls onClose which waits for the reply via the
+	// root connection.
 	// Conn can be nil if DialContext did not complete.
 	if c.conn != nil {
 		wserr := c.conn.Close()
 		if wserr != nil && err == ErrConnClosing {
 			err = wserr
+			c.mu.Lock()
 			c.err = &closeError{msg: ErrConnClosing.msg, err: err}
+			c.mu.Unlock()
 		}
 	}
 
@@ -509,12 +700,52 @@ func (c *Conn) close(err error) error {
 	// streams will be closed.
 	c.cancel()
 
+	// Flat sessions are closed with the same error as the root
+	// connection, this is done after releasing the lock since
+	// closing a session requires locking the root connection.
+	closeErr := c.Err()
+	for _, s := range sessions {
+		s.close(closeErr)
+	}
+
 	if err == ErrConnClosing {
 		return nil
 	}
 	return err
 }
 
+// closeLocked marks the connection as closed and fails all pending
+// calls, the transport is closed by close after releasing the lock.
+func (c *Conn) closeLocked(err error) error {
+	c.closed = true
+	var ce *closeError
+	switch {
+	case err == nil, err == ErrConnClosing:
+		err = ErrConnClosing
+	case errors.As(err, &ce):
+		// Already classified by remoteCloseError.
+	default:
+		err = &closeError{msg: ErrConnClosing.msg, err: err}
+	}
+	c.err = err
+	for id, call := range c.pending {
+		delete(c.pending, id)
+		call.done(err)
+	}
+	// Stop sending on all streams by signaling
+	// that the connection is closed.
+	c.streams = nil
+
+	return err
+}
+
+// isClosed reports whether close has been called.
+func (c *Conn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
 // SetCompressionLevel sets the flate compressions level for writes. Valid level
 // range is [-2, 9]. Returns error if compression is not enabled for Conn. See
 // package compress/flate for a description of compression levels.
@@ -27,7 +27,7 @@ func (ts *testServer) Close() error {
 	return ts.conn.Close()
 }
 
-func newTestServer(t testing.TB, respond func(*websocket.Conn, *Request) error) *testServer {
+func newTestServer(t testing.TB, respond func(*websocket.Conn, *Request) error, opts ...DialOption) *testServer {
 	// Timeouts to prevent tests from running forever.
 	timeout := 5 * time.Second
 
@@ -71,7 +71,7 @@ func newTestServer(t testing.TB, respond func(*websocket.Conn, *Request) error)
 		}
 	}))
 
-	ts.conn, err = Dial("ws"+strings.TrimPrefix(ts.srv.URL, "http"), WithCompression())
+	ts.conn, err = Dial("ws"+strings.TrimPrefix(ts.srv.URL, "http"), append([]DialOption{WithCompression()}, opts...)...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -146,6 +146,61 @@ func TestConn_InvokeError(t *testing.T) {
 	}
 }
 
+type testArgs struct {
+	Name string `json:"name"`
+}
+
+func (a *testArgs) Validate() error {
+	if a.Name == "" {
+		return errors.New("Name is required")
+	}
+	return nil
+}
+
+func TestConn_InvokeValidation(t *testing.T) {
+	for _, validate := range []bool{false, true} {
+		var requests int
+		var opts []DialOption
+		if validate {
+			opts = append(opts, WithValidation())
+		}
+		srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+			requests++
+			return conn.WriteJSON(&Response{ID: req.ID, Result: []byte("{}")})
+		}, opts...)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		err := Invoke(ctx, "test.Hello", &testArgs{}, nil, srv.conn)
+		var argsErr *ArgsError
+		switch {
+		case validate && !errors.As(err, &argsErr):
+			t.Errorf("Invoke: want *ArgsError, got %#v", err)
+		case validate && argsErr.Method != "test.Hello":
+			t.Errorf("Invoke: got ArgsError.Method %q, want %q", argsErr.Method, "test.Hello")
+		case !validate && err != nil:
+			t.Errorf("Invoke: got %v, want no validation", err)
+		}
+
+		// Valid arguments are sent.
+		err = Invoke(ctx, "test.Hello", &testArgs{Name: "a"}, nil, srv.conn)
+		if err != nil {
+			t.Error(err)
+		}
+
+		cancel()
+		srv.Close()
+
+		want := 1
+		if !validate {
+			want = 2
+		}
+		if requests != want {
+			t.Errorf("validate = %t: got %d requests, want %d", validate, requests, want)
+		}
+	}
+}
+
 func TestConn_InvokeRemoteDisconnected(t *testing.T) {
 	srv := newTestServer(t, nil)
 	defer srv.Close()
@@ -410,6 +465,90 @@ func TestConn_Context(t *testing.T) {
 	}
 }
 
+func TestConn_DoneAndErr(t *testing.T) {
+	tests := []struct {
+		name  string
+		close func(*testServer)
+		check func(error) bool
+	}{
+		{
+			name:  "Local close",
+			close: func(srv *testServer) { srv.conn.Close() },
+			check: func(err error) bool { return err == ErrConnClosing },
+		},
+		{
+			name:  "Remote closed",
+			close: func(srv *testServer) { srv.wsConn.Close() },
+			check: func(err error) bool { return errors.Is(err, ErrRemoteClosed) },
+		},
+		{
+			name: "Inspector detached",
+			close: func(srv *testServer) {
+				srv.wsConn.WriteJSON(&Response{
+					Method: "Inspector.detached",
+					Args:   []byte(`{"reason":"target_closed"}`),
+				})
+				srv.wsConn.Close()
+			},
+			check: func(err error) bool {
+				var detached *DetachedError
+				return errors.As(err, &detached) && detached.Reason == "target_closed"
+			},
+		},
+		{
+			name: "Target crashed",
+			close: func(srv *testServer) {
+				srv.wsConn.WriteJSON(&Response{
+					Method: "Inspector.targetCrashed",
+					Args:   []byte(`{}`),
+				})
+				srv.wsConn.Close()
+			},
+			check: func(err error) bool { return errors.Is(err, ErrTargetCrashed) },
+		},
+		{
+			name: "Decode failure",
+			close: func(srv *testServer) {
+				w, err := srv.wsConn.NextWriter(websocket.TextMessage)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write([]byte(`{"id": 1, "result": nope}`))
+				w.Close()
+			},
+			check: func(err error) bool {
+				var decodeErr *DecodeError
+				return errors.As(err, &decodeErr)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t, nil)
+			defer srv.Close()
+
+			if err := srv.conn.Err(); err != nil {
+				t.Errorf("Err() before close: got %v, want nil", err)
+			}
+
+			tt.close(srv)
+			select {
+			case <-srv.conn.Done():
+			case <-time.After(time.Second):
+				t.Fatal("Timeout waiting for Done")
+			}
+
+			err := srv.conn.Err()
+			if err == nil || !tt.check(err) {
+				t.Errorf("Err() got %v, want %s reason", err, tt.name)
+			}
+			if _, ok := err.(interface{ Closed() bool }); !ok {
+				t.Errorf("Err() got %T, want error with Closed()", err)
+			}
+		})
+	}
+}
+
 func TestDialContext_PanicOnNilContext(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -68,5 +68,30 @@ When order is important, two streams can be synchronized with Sync:
 	if err != nil {
 		// Handle error.
 	}
+
+# Connection closure
+
+Done is closed when the connection is closed, Err reports why:
+
+	<-conn.Done()
+	var detached *rpcc.DetachedError
+	switch err := conn.Err(); {
+	case errors.Is(err, rpcc.ErrRemoteClosed):
+		// The browser went away, restart it.
+	case errors.As(err, &detached):
+		// The target was detached, e.g. closed.
+	}
+
+# Flat sessions
+
+Sessions attached with flatten enabled (Target.attachToTarget) are
+multiplexed over the same connection, DialSession returns a connection for
+the session:
+
+	pageConn, err := rpcc.DialSession(conn, sessionID, detach)
+	if err != nil {
+		// Handle error.
+	}
+	defer pageConn.Close() // Calls detach.
 */
 package rpcc
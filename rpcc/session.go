@@ -0,0 +1,162 @@
+package rpcc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+)
+
+// DialSession returns a connection for the flat session identified by
+// sessionID (see Target.attachToTarget with flatten enabled). Requests
+// and notifications for the session are multiplexed over conn by
+// setting the sessionId field of each message.
+//
+// Sessions can be nested, e.g. a session attached from within a page
+// session, in which case conn can be either the page session or the
+// browser connection; all flat sessions share the underlying
+// connection.
+//
+// The session connection is closed when conn is closed, or when the
+// remote sends Target.detachedFromTarget for the session. Close on the
+// session connection calls onClose, if not nil, unless the session was
+// already detached by the remote or conn is closed. Typically onClose
+// invokes Target.detachFromTarget.
+func DialSession(conn *Conn, sessionID string, onClose func() error) (*Conn, error) {
+	root := conn.root()
+
+	c := &Conn{
+		parent:    root,
+		owner:     conn,
+		sessionID: sessionID,
+		streams:   make(map[string]*streamClients),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.conn = &sessionCloser{c: c, close: onClose}
+
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	if root.closed {
+		c.cancel()
+		return nil, root.err
+	}
+	if _, ok := root.sessions[sessionID]; ok {
+		c.cancel()
+		return nil, errors.New("rpcc: DialSession: session already exists: " + sessionID)
+	}
+	if root.sessions == nil {
+		root.sessions = make(map[string]*Conn)
+	}
+	root.sessions[sessionID] = c
+
+	return c, nil
+}
+
+// SessionID returns the session ID for connections created by
+// DialSession, otherwise the empty string.
+func (c *Conn) SessionID() string {
+	return c.sessionID
+}
+
+// root returns the connection that owns the transport.
+func (c *Conn) root() *Conn {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
+}
+
+// session returns the flat session connection for id, if any.
+func (c *Conn) session(id string) *Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sessions[id]
+}
+
+// sessionsOf returns the flat sessions dialed from the session s.
+func (c *Conn) sessionsOf(s *Conn) []*Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var sessions []*Conn
+	for _, ss := range c.sessions {
+		if ss.owner == s {
+			sessions = append(sessions, ss)
+		}
+	}
+	return sessions
+}
+
+// detachSession closes the flat session connection when the remote
+// sends Target.detachedFromTarget. Sessions closed locally have
+// already been removed from c.
+func (c *Conn) detachSession(args []byte) {
+	var ev struct {
+		SessionID string `json:"sessionId"`
+	}
+	if err := json.Unmarshal(args, &ev); err != nil || ev.SessionID == "" {
+		return
+	}
+	s := c.session(ev.SessionID)
+	if s == nil {
+		return
+	}
+	s.remoteDetached.Store(true)
+	s.close(s.remoteCloseError(&DetachedError{Reason: "Target.detachedFromTarget"}))
+}
+
+// closeSession removes the flat session s from c and fails all its
+// pending calls with err.
+func (c *Conn) closeSession(s *Conn, err error) {
+	c.mu.Lock()
+	if c.sessions[s.sessionID] == s {
+		delete(c.sessions, s.sessionID)
+	}
+	var calls []*rpcCall
+	for id, call := range c.pending {
+		if call.session == s {
+			delete(c.pending, id)
+			calls = append(calls, call)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, call := range calls {
+		call.done(err)
+	}
+}
+
+// handleSessionMessage routes a message with a sessionId to the flat
+// session connection.
+func (c *Conn) handleSessionMessage(resp *Response) {
+	s := c.session(resp.SessionID)
+	if s == nil {
+		if enableDebug {
+			log.Println("rpcc: no session: " + resp.SessionID + ": " + resp.String())
+		}
+		return
+	}
+	s.handleNotification(resp.Method, resp.Args)
+	s.notify(resp.Method, resp.Args)
+}
+
+// sessionCloser implements io.ReadWriteCloser for a flat session, only
+// Close is used, the transport is provided by the root connection.
+type sessionCloser struct {
+	c     *Conn
+	close func() error
+}
+
+func (s *sessionCloser) Read(p []byte) (int, error)  { return 0, errors.New("rpcc: read not allowed") }
+func (s *sessionCloser) Write(p []byte) (int, error) { return 0, errors.New("rpcc: write not allowed") }
+func (s *sessionCloser) Close() error {
+	if s.close == nil || s.c.remoteDetached.Load() {
+		return nil
+	}
+	// The session is gone along with the connection it was dialed
+	// from, there is nothing to detach from.
+	if s.c.owner.isClosed() || s.c.parent.isClosed() {
+		return nil
+	}
+	return s.close()
+}
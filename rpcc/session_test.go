@@ -0,0 +1,223 @@
+package rpcc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestDialSession(t *testing.T) {
+	// Reply with the session ID of the request. The messages passed to
+	// test.Send are written first, the websocket allows only one writer
+	// so all writes happen here.
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		if req.Method == "test.Send" {
+			b, err := json.Marshal(req.Args)
+			if err != nil {
+				return err
+			}
+			var msgs []Response
+			if err = json.Unmarshal(b, &msgs); err != nil {
+				return err
+			}
+			for _, m := range msgs {
+				if err = conn.WriteJSON(&m); err != nil {
+					return err
+				}
+			}
+		}
+		return conn.WriteJSON(&Response{
+			ID:     req.ID,
+			Result: []byte(fmt.Sprintf("%q", req.SessionID)),
+		})
+	})
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	detached := make(chan struct{}, 1)
+	s1, err := DialSession(srv.conn, "s1", func() error {
+		detached <- struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = DialSession(srv.conn, "s1", nil); err == nil {
+		t.Error("DialSession: want error for duplicate session, got nil")
+	}
+	s2, err := DialSession(s1, "s2", nil) // Nested session.
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2.SessionID() != "s2" {
+		t.Errorf("SessionID: got %q, want %q", s2.SessionID(), "s2")
+	}
+
+	for _, c := range []*Conn{srv.conn, s1, s2} {
+		var reply string
+		if err = Invoke(ctx, "test.Session", nil, &reply, c); err != nil {
+			t.Fatal(err)
+		}
+		if reply != c.SessionID() {
+			t.Errorf("test.Session: got session %q, want %q", reply, c.SessionID())
+		}
+	}
+
+	rootStream, err := NewStream(ctx, "test.Notify", srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rootStream.Close()
+	s1Stream, err := NewStream(ctx, "test.Notify", s1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s1Stream.Close()
+
+	err = Invoke(ctx, "test.Send", []Response{
+		{Method: "test.Notify", Args: []byte(`"s1"`), SessionID: "s1"},
+		{Method: "test.Notify", Args: []byte(`"root"`)},
+	}, nil, srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		s    Stream
+		want string
+	}{
+		{s1Stream, "s1"},
+		{rootStream, "root"},
+	} {
+		var reply string
+		if err = tt.s.RecvMsg(&reply); err != nil {
+			t.Fatal(err)
+		}
+		if reply != tt.want {
+			t.Errorf("test.Notify: got %q, want %q", reply, tt.want)
+		}
+	}
+
+	// Detached by remote, onClose must not be called.
+	err = Invoke(ctx, "test.Send", []Response{{
+		Method: "Target.detachedFromTarget",
+		Args:   []byte(`{"sessionId":"s2"}`),
+	}}, nil, srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-s2.Done()
+	var detachedErr *DetachedError
+	if err = s2.Err(); !errors.As(err, &detachedErr) {
+		t.Errorf("s2.Err: got %v, want *DetachedError", err)
+	}
+	if err = Invoke(ctx, "test.Session", nil, nil, s2); err == nil {
+		t.Error("Invoke on detached session: want error, got nil")
+	}
+
+	if err = s1.Close(); err != nil {
+		t.Error(err)
+	}
+	select {
+	case <-detached:
+	default:
+		t.Error("s1.Close: onClose was not called")
+	}
+	if err = srv.conn.Err(); err != nil {
+		t.Errorf("root closed by session: %v", err)
+	}
+
+	// Closing the root closes all sessions.
+	s3, err := DialSession(srv.conn, "s3", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.conn.Close()
+	<-s3.Done()
+	if err = s3.Err(); err != ErrConnClosing {
+		t.Errorf("s3.Err: got %v, want %v", err, ErrConnClosing)
+	}
+	if _, err = DialSession(srv.conn, "s4", nil); err == nil {
+		t.Error("DialSession on closed connection: want error, got nil")
+	}
+}
+
+func TestSessionClose(t *testing.T) {
+	// Like Chrome, send Target.detachedFromTarget before the reply to
+	// Target.detachFromTarget.
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		if req.Method == "Target.detachFromTarget" {
+			args := req.Args.(map[string]interface{})
+			err := conn.WriteJSON(&Response{
+				Method: "Target.detachedFromTarget",
+				Args:   []byte(fmt.Sprintf(`{"sessionId":%q}`, args["sessionId"])),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return conn.WriteJSON(&Response{ID: req.ID, Result: []byte(`{}`)})
+	})
+	defer srv.Close()
+
+	var detached []string
+	dial := func(conn *Conn, id string) *Conn {
+		s, err := DialSession(conn, id, func() error {
+			detached = append(detached, id)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			args := map[string]string{"sessionId": id}
+			return Invoke(ctx, "Target.detachFromTarget", args, nil, srv.conn)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	s1 := dial(srv.conn, "s1")
+	s2 := dial(s1, "s2") // Nested session.
+	s3 := dial(s2, "s3")
+
+	start := time.Now()
+	if err := s1.Close(); err != nil {
+		t.Errorf("s1.Close: %v", err)
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("s1.Close: took %v, want detached before timeout", d)
+	}
+	for _, s := range []*Conn{s2, s3} {
+		select {
+		case <-s.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("%s: not closed with parent session", s.SessionID())
+		}
+		if err := s.Err(); err != ErrConnClosing {
+			t.Errorf("%s.Err: got %v, want %v", s.SessionID(), err, ErrConnClosing)
+		}
+	}
+	if len(detached) != 1 || detached[0] != "s1" {
+		t.Errorf("onClose: got %v, want [s1]", detached)
+	}
+
+	// The remote is gone when the root is closed, onClose must not
+	// be called.
+	detached = nil
+	s4 := dial(srv.conn, "s4")
+	if err := srv.conn.Close(); err != nil {
+		t.Error(err)
+	}
+	<-s4.Done()
+	if err := s4.Err(); err != ErrConnClosing {
+		t.Errorf("s4.Err: got %v, want %v", err, ErrConnClosing)
+	}
+	if len(detached) != 0 {
+		t.Errorf("onClose: got %v, want none", detached)
+	}
+}
@@ -0,0 +1,210 @@
+package session
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// BrowserContextOption represents an option passed to NewBrowserContext.
+type BrowserContextOption func(*browserContextOptions)
+
+type browserContextOptions struct {
+	create   *target.CreateBrowserContextArgs
+	download *browser.SetDownloadBehaviorArgs
+}
+
+// WithProxy returns a BrowserContextOption that sets the proxy server
+// for the browser context, similar to the --proxy-server and
+// --proxy-bypass-list command line flags. An empty bypassList is
+// ignored.
+func WithProxy(server, bypassList string) BrowserContextOption {
+	return func(o *browserContextOptions) {
+		o.create.ProxyServer = &server
+		if bypassList != "" {
+			o.create.ProxyBypassList = &bypassList
+		}
+	}
+}
+
+// WithDownloadBehavior returns a BrowserContextOption that sets the
+// download behavior ("deny", "allow", "allowAndName" or "default") for
+// the browser context. An empty path uses the default download path.
+func WithDownloadBehavior(behavior, path string) BrowserContextOption {
+	return func(o *browserContextOptions) {
+		o.download = browser.NewSetDownloadBehaviorArgs(behavior)
+		if path != "" {
+			o.download.SetDownloadPath(path)
+		}
+	}
+}
+
+// BrowserContext is an isolated browser context, similar to an
+// incognito window. Pages opened in the browser context share cookies
+// and cache with each other, but not with other browser contexts.
+type BrowserContext struct {
+	ID browser.ContextID
+
+	m *Manager
+
+	mu       sync.Mutex // Protects following.
+	sessions []*session
+	closed   bool
+}
+
+// NewBrowserContext creates a new browser context. The Manager must
+// have been created for a browser connection (see devtool.Version), it
+// is used to invoke commands on the Target domain and to establish
+// sessions to pages in the browser context.
+func NewBrowserContext(ctx context.Context, m *Manager, opts ...BrowserContextOption) (*BrowserContext, error) {
+	o := browserContextOptions{
+		create: target.NewCreateBrowserContextArgs(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	reply, err := m.c.Target.CreateBrowserContext(ctx, o.create)
+	if err != nil {
+		return nil, errors.Wrapf(err, "session: create browser context failed")
+	}
+	bc := &BrowserContext{ID: reply.BrowserContextID, m: m}
+
+	if o.download != nil {
+		o.download.SetBrowserContextID(bc.ID)
+		if err = m.c.Browser.SetDownloadBehavior(ctx, o.download); err != nil {
+			err = errors.Wrapf(err, "session: set download behavior failed")
+			return nil, errors.Merge(err, bc.Close())
+		}
+	}
+
+	return bc, nil
+}
+
+// NewPage opens url in a new page belonging to the browser context and
+// establishes a session to it. The session connection is closed by
+// Close, or when the page is closed.
+func (bc *BrowserContext) NewPage(ctx context.Context, url string) (SessionInfo, error) {
+	reply, err := bc.m.c.Target.CreateTarget(ctx,
+		target.NewCreateTargetArgs(url).SetBrowserContextID(bc.ID))
+	if err != nil {
+		return SessionInfo{}, errors.Wrapf(err, "session: create target failed")
+	}
+
+	s, err := bc.dial(ctx, reply.TargetID)
+	if err != nil {
+		// Do not leave the page behind, ctx may be done.
+		cctx, cancel := context.WithTimeout(context.Background(), bc.m.opts.detachTimeout)
+		defer cancel()
+		_, _ = bc.m.c.Target.CloseTarget(cctx, target.NewCloseTargetArgs(reply.TargetID))
+		return SessionInfo{}, err
+	}
+
+	return SessionInfo{
+		ID:       s.ID,
+		TargetID: s.TargetID,
+		Type:     "page",
+		URL:      url,
+		Created:  s.created,
+		Conn:     s.Conn(),
+	}, nil
+}
+
+// Dial establishes a session to a target in the browser context, e.g.
+// a popup opened by one of its pages (see Targets). The session
+// connection is closed by Close.
+func (bc *BrowserContext) Dial(ctx context.Context, id target.ID) (*rpcc.Conn, error) {
+	s, err := bc.dial(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.Conn(), nil
+}
+
+func (bc *BrowserContext) dial(ctx context.Context, id target.ID) (*session, error) {
+	bc.mu.Lock()
+	closed := bc.closed
+	bc.mu.Unlock()
+	if closed {
+		return nil, errors.New("session: BrowserContext is closed")
+	}
+
+	s, err := bc.m.dial(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	if bc.closed {
+		s.Close()
+		return nil, errors.New("session: BrowserContext is closed")
+	}
+	// Forget about connections that have been closed by the user.
+	sessions := bc.sessions[:0]
+	for _, ss := range bc.sessions {
+		if ss.Conn().Err() == nil {
+			sessions = append(sessions, ss)
+		}
+	}
+	bc.sessions = append(sessions, s)
+
+	return s, nil
+}
+
+// Targets returns all targets (pages, popups, workers, etc.) that
+// belong to the browser context.
+func (bc *BrowserContext) Targets(ctx context.Context) ([]target.Info, error) {
+	reply, err := bc.m.c.Target.GetTargets(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "session: get targets failed")
+	}
+	var infos []target.Info
+	for _, t := range reply.TargetInfos {
+		if t.BrowserContextID != nil && *t.BrowserContextID == bc.ID {
+			infos = append(infos, t)
+		}
+	}
+	return infos, nil
+}
+
+// Close closes all session connections established via the browser
+// context and disposes of it, which closes all of its targets.
+// Sessions are closed concurrently, like Manager.Close (see
+// WithCloseConcurrency). Subsequent calls to Close return nil.
+func (bc *BrowserContext) Close() error {
+	bc.mu.Lock()
+	if bc.closed {
+		bc.mu.Unlock()
+		return nil
+	}
+	bc.closed = true
+	sessions := make(map[target.SessionID]*session, len(bc.sessions))
+	for _, s := range bc.sessions {
+		if s.Conn().Err() == nil {
+			sessions[s.ID] = s
+		}
+	}
+	bc.sessions = nil
+	bc.mu.Unlock()
+
+	var errs []error
+	if err := closeSessions(sessions, bc.m.opts.closeConcurrency); err != nil {
+		errs = append(errs, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), bc.m.opts.detachTimeout)
+	defer cancel()
+
+	err := bc.m.c.Target.DisposeBrowserContext(ctx,
+		target.NewDisposeBrowserContextArgs(bc.ID))
+	if err != nil {
+		errs = append(errs, errors.Wrapf(err, "session: dispose browser context failed"))
+	}
+
+	return errors.Merge(errs...)
+}
@@ -14,6 +14,11 @@ Initialize a new session Manager.
 	}
 	defer m.Close() // Cleanup.
 
+Options can be passed to NewManager, e.g. to change how long closing a
+session waits for DetachFromTarget.
+
+	m, err := session.NewManager(c, session.WithDetachTimeout(time.Second))
+
 Establish a new session connection to targetID.
 
 	pageConn, err := m.Dial(context.TODO(), targetID)
@@ -28,8 +33,76 @@ Use the session connection.
 	err = pageClient.Page.Enable(context.TODO())
 	// ...
 
+A Manager can also be created on top of a session connection, e.g. to
+attach to workers and out-of-process iframes discovered by the Target domain
+of a page. Such targets are typically auto-attached by the remote and
+adopted by the Manager.
+
+	pm, err := session.NewManager(pageClient)
+	// ...
+	attached, err := pageClient.Target.AttachedToTarget(ctx)
+	// ...
+	err = pageClient.Target.SetAutoAttach(ctx,
+		target.NewSetAutoAttachArgs(true, false).SetFlatten(false))
+	// ...
+	ev, err := attached.Recv()
+	// ...
+	workerConn, err := pm.Adopt(ctx, ev)
+
+With WithFlatSessions, session messages are routed over the websocket
+connection by session ID (see rpcc.DialSession) instead of via
+SendMessageToTarget, nested sessions share the same connection.
+
+	m, err := session.NewManager(c, session.WithFlatSessions())
+
+Create an isolated browser context (similar to an incognito window) and open
+pages in it. Close closes all sessions and pages of the browser context.
+
+	bc, err := session.NewBrowserContext(ctx, m,
+		session.WithProxy("localhost:8080", ""),
+		session.WithDownloadBehavior("allow", "/tmp/downloads"))
+	if err != nil {
+		// Handle error.
+	}
+	defer bc.Close()
+
+	p, err := bc.NewPage(ctx, "https://github.com/mafredri/cdp")
+	if err != nil {
+		// Handle error.
+	}
+	pageClient := cdp.NewClient(p.Conn)
+
+List the active sessions, or look up the session for a target.
+
+	sessions, err := m.Sessions(ctx)
+	// ...
+	info, err := m.Lookup(ctx, targetID)
+	if err == session.ErrSessionNotFound {
+		// Not attached to targetID.
+	}
+
+Subscribe to be notified when a session is attached, detached (e.g. the
+target was closed) or its target has crashed.
+
+	for ev := range m.Subscribe(ctx) {
+		switch ev.Type {
+		case session.Attached:
+			// A new session was established via Dial.
+		case session.Detached:
+			// The session connection is closed, ev.Err is the reason.
+		case session.Crashed:
+			// The target crashed, it can be reloaded or closed.
+		}
+	}
+
 If session connections are behaving unexpectedly, you can debug the session
-Manager by checking the error channel.
+Manager by setting an error handler.
+
+	m, err := session.NewManager(c, session.WithErrorHandler(func(err error) {
+		log.Println(err)
+	}))
+
+Or by checking the error channel (only the latest error is kept).
 
 	go func() {
 		for err := range m.Err() {
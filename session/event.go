@@ -0,0 +1,155 @@
+package session
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/mafredri/cdp/protocol/target"
+)
+
+// EventType is the type of a session Event.
+type EventType int
+
+// EventType enums.
+const (
+	// Attached is sent when a session has been established by Dial
+	// or Adopt.
+	Attached EventType = iota + 1
+	// Detached is sent when a session has been detached from its
+	// target, e.g. because the target or the session connection was
+	// closed. The session connection is closed before the event is
+	// sent.
+	Detached
+	// Crashed is sent when the target of a session has crashed. The
+	// session connection is not closed, the target can be reloaded.
+	Crashed
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Attached:
+		return "Attached"
+	case Detached:
+		return "Detached"
+	case Crashed:
+		return "Crashed"
+	default:
+		return "EventType(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// Event represents a change in the state of a session.
+type Event struct {
+	Type      EventType
+	SessionID target.SessionID
+	TargetID  target.ID
+	// Err is the reason for the event, nil for Attached. For
+	// Detached it is the error
+	// that closed the session connection (see rpcc.Conn.Err) and for
+	// Crashed it wraps rpcc.ErrTargetCrashed.
+	Err error
+}
+
+// subscriber receives events until its context is done. Events are
+// queued so that a slow subscriber never blocks the Manager.
+type subscriber struct {
+	ctx context.Context
+	in  chan Event
+	out chan Event
+}
+
+func newSubscriber(ctx context.Context) *subscriber {
+	s := &subscriber{
+		ctx: ctx,
+		in:  make(chan Event),
+		out: make(chan Event),
+	}
+	go s.run()
+	return s
+}
+
+func (s *subscriber) run() {
+	defer close(s.out)
+
+	in := s.in
+	var queue []Event
+	for in != nil || len(queue) > 0 {
+		var out chan Event
+		var next Event
+		if len(queue) > 0 {
+			out = s.out
+			next = queue[0]
+		}
+		select {
+		case ev, ok := <-in:
+			if !ok {
+				// Deliver the remaining queue before closing.
+				in = nil
+				continue
+			}
+			queue = append(queue, ev)
+		case out <- next:
+			queue[0] = Event{}
+			queue = queue[1:]
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// send queues the event, it only blocks until the event is queued.
+func (s *subscriber) send(ev Event) bool {
+	select {
+	case s.in <- ev:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// notifier keeps track of subscribers.
+type notifier struct {
+	mu     sync.Mutex // Protects following.
+	subs   map[*subscriber]struct{}
+	closed bool
+}
+
+func (n *notifier) subscribe(ctx context.Context) <-chan Event {
+	s := newSubscriber(ctx)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		close(s.in)
+		return s.out
+	}
+	if n.subs == nil {
+		n.subs = make(map[*subscriber]struct{})
+	}
+	n.subs[s] = struct{}{}
+	return s.out
+}
+
+func (n *notifier) notify(ev Event) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for s := range n.subs {
+		if !s.send(ev) {
+			delete(n.subs, s)
+		}
+	}
+}
+
+func (n *notifier) close() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return
+	}
+	n.closed = true
+	for s := range n.subs {
+		close(s.in)
+		delete(n.subs, s)
+	}
+}
@@ -2,6 +2,10 @@ package session
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/mafredri/cdp"
@@ -15,10 +19,16 @@ type Manager struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	opts managerOptions
 	c    *cdp.Client
 	sC   chan *session
 	done chan error
 	errC chan error
+
+	notifier notifier
+
+	mu       sync.Mutex // Protects following.
+	sessions map[target.SessionID]*session
 }
 
 const (
@@ -31,70 +41,367 @@ const (
 	// The default timeout is a compromise between not blocking for
 	// extended periods of time and allowing slow connections to
 	// deliver the message.
-	//
-	// TODO(mafredri): Should we allow configuring the timeout?
 	defaultDetachTimeout = 5 * time.Second
+
+	// The default number of sessions that are closed concurrently
+	// when the Manager is closed.
+	defaultCloseConcurrency = 16
+
+	// The default number of messages buffered for a session before
+	// the Manager blocks waiting for the session to read them.
+	defaultSessionBufferSize = 1
 )
 
+// ManagerOption represents an option passed to NewManager.
+type ManagerOption func(*managerOptions)
+
+type managerOptions struct {
+	ctx               context.Context
+	detachTimeout     time.Duration
+	closeConcurrency  int
+	sessionBufferSize int
+	errorHandler      func(error)
+	flat              bool
+}
+
+func (o managerOptions) session() sessionOptions {
+	return sessionOptions{
+		flat:          o.flat,
+		detachTimeout: o.detachTimeout,
+		bufSize:       o.sessionBufferSize,
+	}
+}
+
+// WithContext returns a ManagerOption that sets the parent context for
+// the Manager. The Manager is closed when ctx is done. The Manager is
+// always closed when the underlying rpcc.Conn of the cdp.Client is
+// closed.
+func WithContext(ctx context.Context) ManagerOption {
+	return func(o *managerOptions) {
+		o.ctx = ctx
+	}
+}
+
+// WithErrorHandler returns a ManagerOption that sets a function that
+// is called for every error encountered by the Manager. When set,
+// errors are no longer sent on the Err channel, which only holds the
+// oldest undelivered error (later errors are discarded). The handler is called from the Manager goroutine and
+// must not block.
+func WithErrorHandler(h func(error)) ManagerOption {
+	return func(o *managerOptions) {
+		o.errorHandler = h
+	}
+}
+
+// WithSessionBufferSize returns a ManagerOption that sets the number of
+// messages buffered for each session. A larger buffer prevents a busy
+// session from delaying messages to other sessions. The default is 1.
+func WithSessionBufferSize(n int) ManagerOption {
+	return func(o *managerOptions) {
+		if n < 0 {
+			n = 0
+		}
+		o.sessionBufferSize = n
+	}
+}
+
+// WithFlatSessions returns a ManagerOption that enables flat sessions
+// (see rpcc.DialSession). Instead of SendMessageToTarget and
+// ReceivedMessageFromTarget, messages for the session are sent over the
+// connection of the cdp.Client with the session ID set. The cdp.Client
+// must have been created by cdp.NewClient.
+func WithFlatSessions() ManagerOption {
+	return func(o *managerOptions) {
+		o.flat = true
+	}
+}
+
+// WithDetachTimeout returns a ManagerOption that sets the timeout for
+// invoking DetachFromTarget when a session connection is closed. The
+// default timeout is 5 seconds.
+func WithDetachTimeout(d time.Duration) ManagerOption {
+	return func(o *managerOptions) {
+		o.detachTimeout = d
+	}
+}
+
+// WithCloseConcurrency returns a ManagerOption that limits the number
+// of sessions that are closed concurrently when the Manager is closed.
+// The default is 16.
+func WithCloseConcurrency(n int) ManagerOption {
+	return func(o *managerOptions) {
+		o.closeConcurrency = n
+	}
+}
+
+// SessionError represents an error that occurred for a session.
+type SessionError struct {
+	SessionID target.SessionID
+	TargetID  target.ID
+	Err       error
+}
+
+func (e *SessionError) Error() string {
+	return fmt.Sprintf("session %s (target %s): %v", e.SessionID, e.TargetID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *SessionError) Unwrap() error { return e.Err }
+
+// CloseError is returned by Close when one or more sessions could not
+// be closed cleanly, e.g. DetachFromTarget timed out.
+type CloseError struct {
+	Errors []*SessionError // Sorted by session ID.
+}
+
+func (e *CloseError) Error() string {
+	var s strings.Builder
+	s.WriteString("session.Manager: close failed")
+	for _, err := range e.Errors {
+		s.WriteString(": ")
+		s.WriteString(err.Error())
+	}
+	return s.String()
+}
+
+// Unwrap returns the session errors.
+func (e *CloseError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
 // Dial establishes a target session and creates a lightweight rpcc.Conn
 // that uses SendMessageToTarget and ReceivedMessageFromTarget from the
-// Target domain instead of a new websocket connection.
+// Target domain instead of a new websocket connection (or the session
+// ID, see WithFlatSessions).
 //
 // Dial will invoke AttachToTarget. Close (rpcc.Conn) will invoke
 // DetachFromTarget.
 func (m *Manager) Dial(ctx context.Context, id target.ID) (*rpcc.Conn, error) {
-	s, err := dial(ctx, id, m.c, defaultDetachTimeout)
+	s, err := m.dial(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	return s.Conn(), nil
+}
+
+func (m *Manager) dial(ctx context.Context, id target.ID) (*session, error) {
+	s, err := dial(ctx, id, m.c, m.opts.session())
+	if err != nil {
+		return nil, err
+	}
+	return s, m.register(s, "Dial")
+}
+
+// Adopt creates a session connection for a session that was attached
+// by the remote, e.g. targets that are auto-attached (SetAutoAttach)
+// from within a page session such as workers and out-of-process
+// iframes. The flatten argument of SetAutoAttach must match
+// WithFlatSessions.
+//
+// Adopt does not invoke AttachToTarget. Close (rpcc.Conn) will invoke
+// DetachFromTarget.
+func (m *Manager) Adopt(ctx context.Context, ev *target.AttachedToTargetReply) (*rpcc.Conn, error) {
+	s, err := newSession(ctx, ev.SessionID, ev.TargetInfo.TargetID, m.c, m.opts.session())
+	if err != nil {
+		return nil, err
+	}
+	s.targetType = ev.TargetInfo.Type
+	s.url = ev.TargetInfo.URL
+	if err = m.register(s, "Adopt"); err != nil {
+		return nil, err
+	}
+	return s.Conn(), nil
+}
+
+// register hands the session over to the Manager goroutine.
+func (m *Manager) register(s *session, op string) error {
 	select {
 	case m.sC <- s:
+		return nil
 	case <-m.ctx.Done():
 		s.Close()
-		return nil, errors.New("session.Manager: Dial failed: Manager is closed")
+		return errors.New("session.Manager: " + op + " failed: Manager is closed")
 	}
-	return s.Conn(), nil
 }
 
 // Close closes the Manager and all active sessions. All rpcc.Conn
-// created by Dial will be closed.
+// created by Dial will be closed. Sessions are closed concurrently (see
+// WithCloseConcurrency) and a *CloseError is returned if any of them
+// failed to close.
 func (m *Manager) Close() error {
 	m.cancel()
 	if m.done != nil {
-		return errors.Wrapf(<-m.done, "session.Manager: close failed")
+		return <-m.done
 	}
 	return nil
 }
 
+// Subscribe returns a channel that receives session events (Attached,
+// Detached or Crashed) until ctx is done or the Manager is closed, at
+// which point the channel is closed. Events are queued, a slow
+// subscriber does not block the Manager.
+func (m *Manager) Subscribe(ctx context.Context) <-chan Event {
+	return m.notifier.subscribe(ctx)
+}
+
+// ErrSessionNotFound is returned by Lookup when there is no active
+// session for the target.
+var ErrSessionNotFound = errors.New("session.Manager: session not found")
+
+// SessionInfo describes an active session established by Dial or Adopt.
+type SessionInfo struct {
+	ID       target.SessionID
+	TargetID target.ID
+	Type     string    // Target type, e.g. "page" or "iframe".
+	URL      string    // Target URL.
+	Created  time.Time // When the session was established.
+	Conn     *rpcc.Conn
+}
+
+// Sessions returns all active sessions, ordered by creation time. The
+// target type and URL are queried via GetTargets, they are left empty
+// for targets that no longer exist. When GetTargets is not supported
+// (e.g. by a Manager on a page session), the target type and URL of
+// adopted sessions are used.
+func (m *Manager) Sessions(ctx context.Context) ([]SessionInfo, error) {
+	infos := m.activeSessions(func(*session) bool { return true })
+	if len(infos) == 0 {
+		return infos, nil
+	}
+	return infos, m.fillTargetInfo(ctx, infos)
+}
+
+// Lookup returns the oldest active session for the target, or
+// ErrSessionNotFound if there is none.
+func (m *Manager) Lookup(ctx context.Context, id target.ID) (SessionInfo, error) {
+	infos := m.activeSessions(func(s *session) bool { return s.TargetID == id })
+	if len(infos) == 0 {
+		return SessionInfo{}, ErrSessionNotFound
+	}
+	infos = infos[:1]
+	return infos[0], m.fillTargetInfo(ctx, infos)
+}
+
+func (m *Manager) activeSessions(match func(*session) bool) []SessionInfo {
+	m.mu.Lock()
+	infos := make([]SessionInfo, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		if !match(s) || s.Conn().Err() != nil {
+			continue
+		}
+		infos = append(infos, SessionInfo{
+			ID:       s.ID,
+			TargetID: s.TargetID,
+			Type:     s.targetType,
+			URL:      s.url,
+			Created:  s.created,
+			Conn:     s.Conn(),
+		})
+	}
+	m.mu.Unlock()
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Created.Equal(infos[j].Created) {
+			return infos[i].ID < infos[j].ID
+		}
+		return infos[i].Created.Before(infos[j].Created)
+	})
+	return infos
+}
+
+func (m *Manager) fillTargetInfo(ctx context.Context, infos []SessionInfo) error {
+	targets, err := m.c.Target.GetTargets(ctx, nil)
+	if err != nil {
+		var respErr *rpcc.ResponseError
+		if errors.As(err, &respErr) {
+			// Not supported by the target, e.g. a page session.
+			return nil
+		}
+		return errors.Wrapf(err, "session.Manager: get targets failed")
+	}
+	byID := make(map[target.ID]target.Info, len(targets.TargetInfos))
+	for _, t := range targets.TargetInfos {
+		byID[t.TargetID] = t
+	}
+	for i := range infos {
+		if t, ok := byID[infos[i].TargetID]; ok {
+			infos[i].Type = t.Type
+			infos[i].URL = t.URL
+		}
+	}
+	return nil
+}
+
+func (m *Manager) session(id target.SessionID) (*session, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[id]
+	return s, ok
+}
+
+// addSession adds s to the active sessions until its connection is
+// closed, either locally or by the remote.
+func (m *Manager) addSession(s *session) {
+	m.mu.Lock()
+	if m.sessions == nil {
+		m.sessions = make(map[target.SessionID]*session)
+	}
+	m.sessions[s.ID] = s
+	m.mu.Unlock()
+
+	context.AfterFunc(s.Conn().Context(), func() { m.detached(s) })
+}
+
+// removeSession removes s and reports whether it was active.
+func (m *Manager) removeSession(s *session) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sessions[s.ID] != s {
+		return false
+	}
+	delete(m.sessions, s.ID)
+	return true
+}
+
+// detachedReason is the rpcc.DetachedError reason used for sessions
+// closed due to Target.detachedFromTarget.
+const detachedReason = "Target.detachedFromTarget"
+
 func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC chan<- error) {
 	defer ev.Close()
+	defer m.notifier.close()
 
 	isClosing := func(err error) bool {
 		// Test if this is an rpcc.closeError.
 		var e interface{ Closed() bool }
 		if ok := errors.As(err, &e); ok && e.Closed() {
 			// Cleanup, the underlying connection was closed
-			// before the Manager and its context does not
-			// inherit from rpcc.Conn.
+			// before the context cancellation propagated.
 			m.cancel()
 			return true
 		}
 
-		if errors.Is(err, context.Canceled) {
-			// Manager was closed.
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			// Manager was closed or parent context done.
 			return true
 		}
 		return false
 	}
 
-	sessions := make(map[target.SessionID]*session)
 	defer func() {
-		var err []error
-		for _, ss := range sessions {
-			// TODO(mafredri): Speed up by closing sessions concurrently.
-			err = append(err, ss.Close())
+		m.mu.Lock()
+		sessions := m.sessions
+		m.sessions = nil
+		m.mu.Unlock()
+
+		err := closeSessions(sessions, m.opts.closeConcurrency)
+		if err != nil {
+			done <- err
 		}
-		done <- errors.Merge(err...)
 		close(done)
 		close(errC)
 	}()
@@ -102,7 +409,12 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 	for {
 		select {
 		case s := <-created:
-			sessions[s.ID] = s
+			m.addSession(s)
+			m.notifier.notify(Event{
+				Type:      Attached,
+				SessionID: s.ID,
+				TargetID:  s.TargetID,
+			})
 
 		// Checking detached should be sufficient for monitoring the
 		// session. A DetachedFromTarget event is always sent before
@@ -114,13 +426,44 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 					return
 				}
 				err = errors.Wrapf(err, "Manager.watch: error receiving detached event")
-				sendOrDiscardErr(errC, err)
+				m.handleErr(errC, err)
 				continue
 			}
 
-			if s, ok := sessions[ev.SessionID]; ok {
-				delete(sessions, s.ID)
-				s.Close()
+			if s, ok := m.session(ev.SessionID); ok {
+				s.closeRemote(&rpcc.DetachedError{Reason: detachedReason})
+				m.detached(s)
+			}
+
+		case <-ev.crashed.Ready():
+			ev, err := ev.crashed.Recv()
+			if err != nil {
+				if isClosing(err) {
+					return
+				}
+				err = errors.Wrapf(err, "Manager.watch: error receiving crashed event")
+				m.handleErr(errC, err)
+				continue
+			}
+
+			crashErr := fmt.Errorf("session: target %s crashed (status = %s, code = %d): %w",
+				ev.TargetID, ev.Status, ev.ErrorCode, rpcc.ErrTargetCrashed)
+			m.mu.Lock()
+			var crashed []*session
+			for _, s := range m.sessions {
+				if s.TargetID == ev.TargetID {
+					crashed = append(crashed, s)
+				}
+			}
+			m.mu.Unlock()
+
+			for _, s := range crashed {
+				m.notifier.notify(Event{
+					Type:      Crashed,
+					SessionID: s.ID,
+					TargetID:  s.TargetID,
+					Err:       crashErr,
+				})
 			}
 
 		case <-ev.message.Ready():
@@ -130,11 +473,11 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 					return
 				}
 				err = errors.Wrapf(err, "Manager.watch: error receiving message event")
-				sendOrDiscardErr(errC, err)
+				m.handleErr(errC, err)
 				continue
 			}
 
-			if s, ok := sessions[ev.SessionID]; ok {
+			if s, ok := m.session(ev.SessionID); ok {
 				// We rely on the implementation of *rpcc.Conn
 				// to read this message in a reasonably short
 				// amount of time. Blocking here can potentially
@@ -142,13 +485,78 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 				// not happen.
 				err = s.Write([]byte(ev.Message))
 				if err != nil {
-					delete(sessions, s.ID)
+					// The session connection was closed.
+					m.detached(s)
 				}
 			}
 		}
 	}
 }
 
+// detached removes the (closed) session and notifies subscribers, once
+// per session.
+func (m *Manager) detached(s *session) {
+	if !m.removeSession(s) {
+		return
+	}
+	m.notifier.notify(Event{
+		Type:      Detached,
+		SessionID: s.ID,
+		TargetID:  s.TargetID,
+		Err:       s.Conn().Err(),
+	})
+}
+
+// closeSessions closes all sessions using at most n goroutines.
+func closeSessions(sessions map[target.SessionID]*session, n int) error {
+	if n < 1 {
+		n = 1
+	}
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, n)
+		mu   sync.Mutex // Protects errs.
+		errs []*SessionError
+	)
+	for _, s := range sessions {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(s *session) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := s.Close(); err != nil {
+				mu.Lock()
+				errs = append(errs, &SessionError{
+					SessionID: s.ID,
+					TargetID:  s.TargetID,
+					Err:       err,
+				})
+				mu.Unlock()
+			}
+		}(s)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].SessionID < errs[j].SessionID
+	})
+	return &CloseError{Errors: errs}
+}
+
+// handleErr passes err to the error handler, if set, or sends it on
+// errC (discarding it if errC is full).
+func (m *Manager) handleErr(errC chan<- error, err error) {
+	if m.opts.errorHandler != nil {
+		m.opts.errorHandler(err)
+		return
+	}
+	sendOrDiscardErr(errC, err)
+}
+
 func sendOrDiscardErr(errC chan<- error, err error) {
 	select {
 	case errC <- err:
@@ -160,7 +568,8 @@ func sendOrDiscardErr(errC chan<- error, err error) {
 // The channel is closed if Manager is closed.
 //
 // Errors could happen if the debug target sends events that cannot be
-// decoded from JSON.
+// decoded from JSON. No errors are sent when an error handler has been
+// set via WithErrorHandler.
 func (m *Manager) Err() <-chan error {
 	return m.errC
 }
@@ -170,31 +579,49 @@ func (m *Manager) Err() <-chan error {
 // The cdp.Client will be used to listen to events and invoke commands
 // on the Target domain. It will also be used by all rpcc.Conn created
 // by Dial.
-func NewManager(c *cdp.Client) (*Manager, error) {
+func NewManager(c *cdp.Client, opts ...ManagerOption) (*Manager, error) {
 	m := &Manager{
+		opts: managerOptions{
+			ctx:               context.Background(),
+			detachTimeout:     defaultDetachTimeout,
+			closeConcurrency:  defaultCloseConcurrency,
+			sessionBufferSize: defaultSessionBufferSize,
+		},
 		c:    c,
 		sC:   make(chan *session),
 		errC: make(chan error, 1),
 	}
+	for _, o := range opts {
+		o(&m.opts)
+	}
 
-	// TODO(mafredri): Inherit the context from rpcc.Conn in cdp.Client.
-	// cdp.Client does not yet expose the context, nor rpcc.Conn.
-	m.ctx, m.cancel = context.WithCancel(context.TODO())
+	// The Manager is closed when either the parent context is done
+	// or the connection is closed.
+	stop := func() bool { return false }
+	m.ctx, m.cancel = context.WithCancel(m.opts.ctx)
+	if conn := c.Conn(); conn != nil {
+		stop = context.AfterFunc(conn.Context(), m.cancel)
+	}
 
 	ev, err := newSessionEvents(m.ctx, c)
 	if err != nil {
+		stop()
 		close(m.errC)
 		m.Close()
 		return nil, err
 	}
 
 	m.done = make(chan error, 1)
-	go m.watch(ev, m.sC, m.done, m.errC)
+	go func() {
+		defer stop()
+		m.watch(ev, m.sC, m.done, m.errC)
+	}()
 	return m, nil
 }
 
 type sessionEvents struct {
 	detached target.DetachedFromTargetClient
+	crashed  target.CrashedClient
 	message  target.ReceivedMessageFromTargetClient
 }
 
@@ -210,12 +637,16 @@ func newSessionEvents(ctx context.Context, c *cdp.Client) (events *sessionEvents
 	if err != nil {
 		return nil, err
 	}
+	ev.crashed, err = c.Target.TargetCrashed(ctx)
+	if err != nil {
+		return nil, err
+	}
 	ev.message, err = c.Target.ReceivedMessageFromTarget(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = cdp.Sync(ev.detached, ev.message)
+	err = cdp.Sync(ev.detached, ev.crashed, ev.message)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +659,7 @@ func (ev *sessionEvents) Close() (err error) {
 		Close() error
 	}{
 		ev.detached,
+		ev.crashed,
 		ev.message,
 	} {
 		if c != nil {
@@ -1,8 +1,14 @@
 package session
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/mafredri/cdp"
 	"github.com/mafredri/cdp/internal/errors"
 	"github.com/mafredri/cdp/protocol/target"
 	"github.com/mafredri/cdp/rpcc"
@@ -31,11 +37,22 @@ var _ rpcc.Stream = (*testEventClient)(nil)
 
 type testDetacher struct {
 	*testEventClient
-	err error
+	reply *target.DetachedFromTargetReply
+	err   error
 }
 
 func (ev *testDetacher) Recv() (*target.DetachedFromTargetReply, error) {
-	return nil, ev.err
+	return ev.reply, ev.err
+}
+
+type testCrasher struct {
+	*testEventClient
+	reply *target.CrashedReply
+	err   error
+}
+
+func (ev *testCrasher) Recv() (*target.CrashedReply, error) {
+	return ev.reply, ev.err
 }
 
 type testMessenger struct {
@@ -49,9 +66,11 @@ func (ev *testMessenger) Recv() (*target.ReceivedMessageFromTargetReply, error)
 
 func TestManager_ErrorsAreSentOnErrChan(t *testing.T) {
 	detached := &testDetacher{testEventClient: newTestEventClient()}
+	crashed := &testCrasher{testEventClient: newTestEventClient()}
 	message := &testMessenger{testEventClient: newTestEventClient()}
 	ev := &sessionEvents{
 		detached: detached,
+		crashed:  crashed,
 		message:  message,
 	}
 
@@ -59,6 +78,7 @@ func TestManager_ErrorsAreSentOnErrChan(t *testing.T) {
 	go m.watch(ev, nil, make(chan error, 1), m.errC)
 
 	message.next()
+	crashed.next()
 
 	detached.next()
 	detached.err = errors.New("detach nope")
@@ -68,6 +88,7 @@ func TestManager_ErrorsAreSentOnErrChan(t *testing.T) {
 		t.Errorf("got error: %v; want: %v", err, detached.err)
 	}
 	detached.next()
+	crashed.next()
 
 	message.next()
 	message.err = errors.New("message nope")
@@ -77,8 +98,369 @@ func TestManager_ErrorsAreSentOnErrChan(t *testing.T) {
 		t.Errorf("got error: %v; want: %v", err, message.err)
 	}
 	message.next()
+	detached.next()
+
+	crashed.next()
+	crashed.err = errors.New("crashed nope")
+	crashed.markReady()
+	err = <-m.Err()
+	if !errors.Is(err, crashed.err) {
+		t.Errorf("got error: %v; want: %v", err, crashed.err)
+	}
+	crashed.next()
+	message.next()
+
+	// Close the watcher goroutine.
+	detached.next()
+	detached.err = rpcc.ErrConnClosing
+	detached.markReady()
+}
+
+// newTestSession returns a session that invokes detach on close. When
+// detach is nil, the session must be closed by the remote.
+func newTestSession(t *testing.T, id target.SessionID, targetID target.ID, detach func() error) *session {
+	s := &session{
+		ID:           id,
+		TargetID:     targetID,
+		recvC:        make(chan []byte, 1),
+		init:         make(chan struct{}),
+		remoteClosed: make(chan struct{}),
+		send:         func([]byte) error { return nil },
+	}
+	if detach == nil {
+		detach = func() error {
+			if !s.isRemoteClosed() {
+				t.Error("detach: session was not closed by remote")
+			}
+			return nil
+		}
+	}
+	var err error
+	s.conn, err = rpcc.DialContext(context.Background(), "", sessionDetachConn(detach), sessionCodec(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(s.init)
+	return s
+}
+
+func TestManager_SubscribeCrashedAndDetached(t *testing.T) {
+	detached := &testDetacher{testEventClient: newTestEventClient()}
+	crashed := &testCrasher{testEventClient: newTestEventClient()}
+	message := &testMessenger{testEventClient: newTestEventClient()}
+	ev := &sessionEvents{
+		detached: detached,
+		crashed:  crashed,
+		message:  message,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	created := make(chan *session)
+	m := Manager{cancel: func() {}, errC: make(chan error, 1)}
+	events := m.Subscribe(ctx)
+	go m.watch(ev, created, make(chan error, 1), m.errC)
+
+	next := func() {
+		detached.next()
+		crashed.next()
+		message.next()
+	}
+
+	s := newTestSession(t, "session", "target", nil)
+	next()
+	created <- s
+	got := <-events
+	if got.Type != Attached || got.SessionID != s.ID || got.Err != nil {
+		t.Errorf("got event %v; want Attached for %s", got, s.ID)
+	}
+	active := m.activeSessions(func(s *session) bool { return s.TargetID == "target" })
+	if len(active) != 1 || active[0].ID != s.ID || active[0].Conn != s.Conn() {
+		t.Errorf("got active sessions %v; want %s", active, s.ID)
+	}
+
+	next()
+	crashed.reply = &target.CrashedReply{TargetID: "target", Status: "crashed", ErrorCode: 1}
+	crashed.markReady()
+	got = <-events
+	if got.Type != Crashed || got.SessionID != s.ID || got.TargetID != s.TargetID {
+		t.Errorf("got event %v; want Crashed for %s", got, s.ID)
+	}
+	if !errors.Is(got.Err, rpcc.ErrTargetCrashed) {
+		t.Errorf("got error: %v; want: %v", got.Err, rpcc.ErrTargetCrashed)
+	}
+	if err := s.Conn().Err(); err != nil {
+		t.Errorf("session closed after crash: %v", err)
+	}
+
+	next()
+	detached.reply = &target.DetachedFromTargetReply{SessionID: "session"}
+	detached.markReady()
+	got = <-events
+	var detachedErr *rpcc.DetachedError
+	if got.Type != Detached || got.SessionID != s.ID || !errors.As(got.Err, &detachedErr) {
+		t.Errorf("got event %v; want Detached for %s", got, s.ID)
+	}
+	if err := s.Conn().Err(); !errors.As(err, &detachedErr) {
+		t.Errorf("got session error: %v; want rpcc.DetachedError", err)
+	}
+	if active := m.activeSessions(func(*session) bool { return true }); len(active) != 0 {
+		t.Errorf("got active sessions %v; want none", active)
+	}
 
 	// Close the watcher goroutine.
+	next()
+	detached.reply = nil
 	detached.err = rpcc.ErrConnClosing
 	detached.markReady()
+	if _, ok := <-events; ok {
+		t.Error("events channel should have been closed")
+	}
+}
+
+func TestManager_RemoveClosedSession(t *testing.T) {
+	detached := &testDetacher{testEventClient: newTestEventClient()}
+	crashed := &testCrasher{testEventClient: newTestEventClient()}
+	message := &testMessenger{testEventClient: newTestEventClient()}
+	ev := &sessionEvents{
+		detached: detached,
+		crashed:  crashed,
+		message:  message,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	created := make(chan *session)
+	m := Manager{cancel: func() {}, errC: make(chan error, 1)}
+	events := m.Subscribe(ctx)
+	go m.watch(ev, created, make(chan error, 1), m.errC)
+
+	next := func() {
+		detached.next()
+		crashed.next()
+		message.next()
+	}
+
+	s := newTestSession(t, "session", "target", func() error { return nil })
+	next()
+	created <- s
+	if got := <-events; got.Type != Attached {
+		t.Errorf("got event %v; want Attached for %s", got, s.ID)
+	}
+
+	// Closed locally, the remote has not (yet) sent DetachedFromTarget.
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := <-events
+	if got.Type != Detached || got.SessionID != s.ID || got.Err != rpcc.ErrConnClosing {
+		t.Errorf("got event %v; want Detached for %s", got, s.ID)
+	}
+	if _, ok := m.session(s.ID); ok {
+		t.Error("closed session was not removed")
+	}
+
+	// Close the watcher goroutine.
+	next()
+	detached.err = rpcc.ErrConnClosing
+	detached.markReady()
+	if _, ok := <-events; ok {
+		t.Error("events channel should have been closed")
+	}
+}
+
+func TestCloseSessions(t *testing.T) {
+	const limit = 3
+	var active, maxActive int32
+	errDetach := errors.New("detach nope")
+
+	sessions := make(map[target.SessionID]*session)
+	for i := 0; i < 10; i++ {
+		id := target.SessionID(fmt.Sprintf("session%d", i))
+		sessions[id] = newTestSession(t, id, "target", func() error {
+			n := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			if id == "session3" || id == "session7" {
+				return errDetach
+			}
+			return nil
+		})
+	}
+
+	err := closeSessions(sessions, limit)
+	if n := atomic.LoadInt32(&maxActive); n > limit || n < 2 {
+		t.Errorf("got %d concurrent closes; want between 2 and %d", n, limit)
+	}
+
+	var closeErr *CloseError
+	if !errors.As(err, &closeErr) {
+		t.Fatalf("got error: %v; want *CloseError", err)
+	}
+	if len(closeErr.Errors) != 2 ||
+		closeErr.Errors[0].SessionID != "session3" ||
+		closeErr.Errors[1].SessionID != "session7" {
+		t.Errorf("got errors: %v; want session3 and session7", closeErr.Errors)
+	}
+	if !errors.Is(err, errDetach) {
+		t.Errorf("got error: %v; want: %v", err, errDetach)
+	}
+	for _, s := range sessions {
+		if s.Conn().Err() == nil {
+			t.Errorf("session %s was not closed", s.ID)
+		}
+	}
+}
+
+func TestManager_ErrorHandler(t *testing.T) {
+	detached := &testDetacher{testEventClient: newTestEventClient()}
+	crashed := &testCrasher{testEventClient: newTestEventClient()}
+	message := &testMessenger{testEventClient: newTestEventClient()}
+	ev := &sessionEvents{
+		detached: detached,
+		crashed:  crashed,
+		message:  message,
+	}
+
+	errs := make(chan error, 1)
+	m := Manager{
+		cancel: func() {},
+		errC:   make(chan error, 1),
+		opts: managerOptions{
+			errorHandler: func(err error) { errs <- err },
+		},
+	}
+	go m.watch(ev, nil, make(chan error, 1), m.errC)
+
+	message.next()
+	crashed.next()
+	detached.next()
+	detached.err = errors.New("detach nope")
+	detached.markReady()
+	if err := <-errs; !errors.Is(err, detached.err) {
+		t.Errorf("got error: %v; want: %v", err, detached.err)
+	}
+
+	// Close the watcher goroutine.
+	message.next()
+	crashed.next()
+	detached.next()
+	detached.err = rpcc.ErrConnClosing
+	detached.markReady()
+
+	if err, ok := <-m.Err(); ok {
+		t.Errorf("got error on Err channel: %v; want closed", err)
+	}
+}
+
+// blockingCodec blocks reads until done is closed.
+type blockingCodec struct{ done chan struct{} }
+
+func (c *blockingCodec) WriteRequest(*rpcc.Request) error  { return errors.New("not allowed") }
+func (c *blockingCodec) ReadResponse(*rpcc.Response) error { <-c.done; return io.EOF }
+
+func newTestConn(t *testing.T) *rpcc.Conn {
+	codec := &blockingCodec{done: make(chan struct{})}
+	conn, err := rpcc.DialContext(context.Background(), "",
+		rpcc.WithDialer(func(context.Context, string) (io.ReadWriteCloser, error) {
+			return &closeConn{close: func() error {
+				close(codec.done)
+				return nil
+			}}, nil
+		}),
+		rpcc.WithCodec(func(io.ReadWriter) rpcc.Codec { return codec }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func TestNewManager_Lifetime(t *testing.T) {
+	tests := []struct {
+		name  string
+		close func(cancel context.CancelFunc, conn *rpcc.Conn)
+	}{
+		{"Parent context", func(cancel context.CancelFunc, _ *rpcc.Conn) { cancel() }},
+		{"Connection", func(_ context.CancelFunc, conn *rpcc.Conn) { conn.Close() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conn := newTestConn(t)
+			defer conn.Close()
+
+			m, err := NewManager(cdp.NewClient(conn), WithContext(ctx))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer m.Close()
+
+			tt.close(cancel, conn)
+			select {
+			case <-m.ctx.Done():
+			case <-time.After(time.Second):
+				t.Fatal("Manager was not closed")
+			}
+			if _, ok := <-m.Err(); ok {
+				t.Error("Err channel was not closed")
+			}
+		})
+	}
+}
+
+func TestManager_AdoptFlat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn := newTestConn(t)
+	defer conn.Close()
+
+	m, err := NewManager(cdp.NewClient(conn), WithFlatSessions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	events := m.Subscribe(ctx)
+
+	sessionConn, err := m.Adopt(ctx, &target.AttachedToTargetReply{
+		SessionID: "session",
+		TargetInfo: target.Info{
+			TargetID: "worker",
+			Type:     "service_worker",
+			URL:      "https://example.com/sw.js",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sessionConn.SessionID() != "session" {
+		t.Errorf("got session ID %q; want %q", sessionConn.SessionID(), "session")
+	}
+
+	got := <-events
+	if got.Type != Attached || got.SessionID != "session" || got.TargetID != "worker" {
+		t.Errorf("got event %v; want Attached for session", got)
+	}
+	active := m.activeSessions(func(*session) bool { return true })
+	if len(active) != 1 || active[0].Type != "service_worker" || active[0].URL != "https://example.com/sw.js" {
+		t.Errorf("got active sessions %v; want adopted service_worker", active)
+	}
+
+	conn.Close()
+	<-sessionConn.Done()
+	if err := sessionConn.Err(); err == nil {
+		t.Error("session connection was not closed with the connection")
+	}
 }
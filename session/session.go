@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/mafredri/cdp"
@@ -17,11 +18,22 @@ import (
 type session struct {
 	ID       target.SessionID
 	TargetID target.ID
-	recvC    chan []byte
-	send     func([]byte) error
+	created  time.Time
+	flat     bool // Flat session, see rpcc.DialSession.
+
+	// Target type and URL, when known (see Manager.Adopt).
+	targetType string
+	url        string
+
+	recvC chan []byte
+	send  func([]byte) error
 
 	init chan struct{} // Protect conn from early read.
 	conn *rpcc.Conn
+
+	remoteOnce   sync.Once
+	remoteClosed chan struct{} // Closed when detached by remote.
+	remoteErr    error         // Reason returned by ReadResponse.
 }
 
 // Ensure that session implements rpcc.Codec.
@@ -43,6 +55,15 @@ func (s *session) ReadResponse(r *rpcc.Response) error {
 	select {
 	case m := <-s.recvC:
 		return json.Unmarshal(m, r)
+	case <-s.remoteClosed:
+		// Deliver any message that was written before the
+		// session was detached.
+		select {
+		case m := <-s.recvC:
+			return json.Unmarshal(m, r)
+		default:
+		}
+		return s.remoteErr
 	case <-s.conn.Context().Done():
 		return s.conn.Context().Err()
 	}
@@ -67,6 +88,27 @@ func (s *session) Close() error {
 	return s.conn.Close()
 }
 
+// closeRemote closes the underlying *rpcc.Conn with err as the reason
+// (see rpcc.Conn.Err) without invoking DetachFromTarget, the session
+// is already detached. Blocks until the connection is closed.
+func (s *session) closeRemote(err error) {
+	s.remoteOnce.Do(func() {
+		s.remoteErr = err
+		close(s.remoteClosed)
+	})
+	<-s.conn.Context().Done()
+}
+
+// isRemoteClosed returns true if the session was closed by the remote.
+func (s *session) isRemoteClosed() bool {
+	select {
+	case <-s.remoteClosed:
+		return true
+	default:
+		return false
+	}
+}
+
 var (
 	// We only handle Close on conn to detach the session. The codec
 	// handles the actual transport (Read / Write) in this case.
@@ -84,25 +126,50 @@ var (
 	}
 )
 
+// sessionOptions configure how sessions are created by dial and adopt.
+type sessionOptions struct {
+	flat          bool
+	detachTimeout time.Duration
+	bufSize       int
+}
+
 // dial attaches to the target via the provided *cdp.Client and creates
 // a lightweight RPC connection to the target. Communication is done via
 // the underlying *rpcc.Conn for the provided *cdp.Client.
-func dial(ctx context.Context, id target.ID, tc *cdp.Client, detachTimeout time.Duration) (s *session, err error) {
+func dial(ctx context.Context, id target.ID, tc *cdp.Client, opts sessionOptions) (*session, error) {
 	args := target.NewAttachToTargetArgs(id)
 	// The default of this flag will change to true, so until CDP
-	// uses flat session mode, we set this to false explicitly.
+	// uses flat session mode by default, we set it explicitly.
 	// See https://bugs.chromium.org/p/chromium/issues/detail?id=991325.
-	args.SetFlatten(false)
+	args.SetFlatten(opts.flat)
 	reply, err := tc.Target.AttachToTarget(ctx, args)
 	if err != nil {
 		return nil, err
 	}
 
+	s, err := newSession(ctx, reply.SessionID, id, tc, opts)
+	if err != nil {
+		// Avoid leaking the session on the remote.
+		dctx, cancel := context.WithTimeout(context.Background(), opts.detachTimeout)
+		defer cancel()
+		_ = tc.Target.DetachFromTarget(dctx,
+			target.NewDetachFromTargetArgs().SetSessionID(reply.SessionID))
+		return nil, err
+	}
+	return s, nil
+}
+
+// newSession creates the RPC connection for a session that has already
+// been attached, either by dial or by the remote (auto-attach).
+func newSession(ctx context.Context, id target.SessionID, targetID target.ID, tc *cdp.Client, opts sessionOptions) (s *session, err error) {
 	s = &session{
-		TargetID: id,
-		ID:       reply.SessionID,
-		recvC:    make(chan []byte, 1),
-		init:     make(chan struct{}),
+		TargetID:     targetID,
+		ID:           id,
+		created:      time.Now(),
+		flat:         opts.flat,
+		recvC:        make(chan []byte, opts.bufSize),
+		init:         make(chan struct{}),
+		remoteClosed: make(chan struct{}),
 		send: func(data []byte) error {
 			<-s.init
 			// TODO(maf): Use async invocation.
@@ -113,7 +180,10 @@ func dial(ctx context.Context, id target.ID, tc *cdp.Client, detachTimeout time.
 	}
 
 	detach := func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), detachTimeout)
+		if s.isRemoteClosed() {
+			return nil // Already detached.
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), opts.detachTimeout)
 		defer cancel()
 
 		err := tc.Target.DetachFromTarget(ctx,
@@ -124,7 +194,15 @@ func dial(ctx context.Context, id target.ID, tc *cdp.Client, detachTimeout time.
 		return errors.Wrapf(err, "session: detach failed for session %s", s.ID)
 	}
 
-	s.conn, err = rpcc.DialContext(ctx, "", sessionDetachConn(detach), sessionCodec(s))
+	if opts.flat {
+		conn := tc.Conn()
+		if conn == nil {
+			return nil, errors.New("session: flat sessions require a cdp.Client created by cdp.NewClient")
+		}
+		s.conn, err = rpcc.DialSession(conn, string(id), detach)
+	} else {
+		s.conn, err = rpcc.DialContext(ctx, "", sessionDetachConn(detach), sessionCodec(s))
+	}
 	if err != nil {
 		return nil, err
 	}
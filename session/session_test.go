@@ -99,6 +99,115 @@ func TestManager(t *testing.T) {
 	}
 }
 
+func TestManager_Sessions(t *testing.T) {
+	checkBrowser(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	c := testutil.NewClient(ctx, t)
+
+	m, err := session.NewManager(c.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	events := m.Subscribe(ctx)
+
+	newPage := c.NewPage(ctx)
+	pageConn, err := m.Dial(ctx, newPage.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := <-events
+	if ev.Type != session.Attached || ev.TargetID != newPage.ID() {
+		t.Errorf("got event %v, want Attached for %s", ev, newPage.ID())
+	}
+
+	sessions, err := m.Sessions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].Conn != pageConn || sessions[0].Type != "page" {
+		t.Errorf("Sessions: got %v, want one page session", sessions)
+	}
+
+	info, err := m.Lookup(ctx, newPage.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Conn != pageConn || info.URL != "about:blank" {
+		t.Errorf("Lookup: got %v, want session for %s", info, newPage.ID())
+	}
+
+	newPage.Close()
+
+	ev = <-events
+	if ev.Type != session.Detached || ev.TargetID != newPage.ID() {
+		t.Errorf("got event %v, want Detached for %s", ev, newPage.ID())
+	}
+	_, err = m.Lookup(ctx, newPage.ID())
+	if err != session.ErrSessionNotFound {
+		t.Errorf("Lookup: got %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestBrowserContext(t *testing.T) {
+	checkBrowser(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	c := testutil.NewClient(ctx, t)
+
+	m, err := session.NewManager(c.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	bc, err := session.NewBrowserContext(ctx, m,
+		session.WithDownloadBehavior("deny", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bc.Close()
+
+	p, err := bc.NewPage(ctx, "about:blank")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eval, err := cdp.NewClient(p.Conn).Runtime.Evaluate(ctx,
+		runtime.NewEvaluateArgs(`location.href`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var href string
+	if err = json.Unmarshal(eval.Result.Value, &href); err != nil || href != "about:blank" {
+		t.Errorf("got href %q (%v), want about:blank", href, err)
+	}
+
+	targets, err := bc.Targets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 || targets[0].TargetID != p.TargetID {
+		t.Errorf("Targets: got %v, want %s", targets, p.TargetID)
+	}
+
+	if err = bc.Close(); err != nil {
+		t.Error(err)
+	}
+	if p.Conn.Err() == nil {
+		t.Error("page session was not closed")
+	}
+	if _, err = bc.NewPage(ctx, "about:blank"); err == nil {
+		t.Error("NewPage: expected error after Close, got nil")
+	}
+}
+
 func TestManager_Close(t *testing.T) {
 	checkBrowser(t)
 
@@ -0,0 +1,107 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package stable
+
+import (
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/domdebugger"
+	"github.com/mafredri/cdp/protocol/emulation"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/input"
+	"github.com/mafredri/cdp/protocol/io"
+	"github.com/mafredri/cdp/protocol/log"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/performance"
+	"github.com/mafredri/cdp/protocol/profiler"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/security"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/protocol/tracing"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// Client represents a Chrome DevTools Protocol client that can be used to
+// invoke methods or listen to events in every CDP domain. The Client consumes
+// a rpcc connection, used to invoke the methods.
+type Client struct {
+	Browser     Browser
+	DOM         DOM
+	DOMDebugger DOMDebugger
+	Debugger    Debugger
+	Emulation   Emulation
+	Fetch       Fetch
+	IO          IO
+	Input       Input
+	Log         Log
+	Network     Network
+	Page        Page
+	Performance Performance
+	Profiler    Profiler
+	Runtime     Runtime
+	Security    Security
+	Target      Target
+	Tracing     Tracing
+
+	conn *rpcc.Conn
+}
+
+// NewClient returns a new Client that uses conn
+// for communication with the debugging target.
+func NewClient(conn *rpcc.Conn) *Client {
+	return &Client{
+		Browser:     browser.NewClient(conn),
+		DOM:         dom.NewClient(conn),
+		DOMDebugger: domdebugger.NewClient(conn),
+		Debugger:    debugger.NewClient(conn),
+		Emulation:   emulation.NewClient(conn),
+		Fetch:       fetch.NewClient(conn),
+		IO:          io.NewClient(conn),
+		Input:       input.NewClient(conn),
+		Log:         log.NewClient(conn),
+		Network:     network.NewClient(conn),
+		Page:        page.NewClient(conn),
+		Performance: performance.NewClient(conn),
+		Profiler:    profiler.NewClient(conn),
+		Runtime:     runtime.NewClient(conn),
+		Security:    security.NewClient(conn),
+		Target:      target.NewClient(conn),
+		Tracing:     tracing.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the Client. Conn returns nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn {
+	return c.conn
+}
+
+// FromClient returns a Client that uses the domains of c.
+func FromClient(c *cdp.Client) *Client {
+	return &Client{
+		Browser:     c.Browser,
+		DOM:         c.DOM,
+		DOMDebugger: c.DOMDebugger,
+		Debugger:    c.Debugger,
+		Emulation:   c.Emulation,
+		Fetch:       c.Fetch,
+		IO:          c.IO,
+		Input:       c.Input,
+		Log:         c.Log,
+		Network:     c.Network,
+		Page:        c.Page,
+		Performance: c.Performance,
+		Profiler:    c.Profiler,
+		Runtime:     c.Runtime,
+		Security:    c.Security,
+		Target:      c.Target,
+		Tracing:     c.Tracing,
+
+		conn: c.Conn(),
+	}
+}
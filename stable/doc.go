@@ -0,0 +1,24 @@
+/*
+Package stable provides the stable subset of the bindings in package cdp,
+experimental and deprecated domains, commands and events are omitted.
+Using an API that is not stable results in a compile error, instead of a
+runtime error when the browser drops it.
+
+The Client is used like cdp.Client:
+
+	c := stable.NewClient(conn) // conn created via rpcc.Dial.
+	err := c.Page.Enable(ctx)
+	// ...
+
+	// c.Page.StartScreencast(ctx, nil) // Does not compile, experimental.
+
+Packages that use the cdp.Client (e.g. session) can share the connection,
+a cdp.Client is converted with FromClient:
+
+	c := stable.FromClient(cdpClient)
+
+The types (e.g. page.NavigateArgs) are shared with package cdp, the
+arguments of stable commands may still contain experimental fields, they
+are marked in their documentation.
+*/
+package stable
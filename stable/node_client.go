@@ -0,0 +1,157 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package stable
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/io"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/profiler"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// NodeClient represents a client for the Node.js inspector protocol, it
+// can be used to invoke methods or listen to events in the domains
+// supported by Node.js. Domains that Node.js only partially supports
+// (e.g. Network) have Node specific interfaces with the commands and
+// events of the Node.js protocol. The NodeClient consumes a rpcc
+// connection, used to invoke the methods.
+type NodeClient struct {
+	Debugger Debugger
+	IO       NodeIO
+	Network  NodeNetwork
+	Profiler Profiler
+	Runtime  Runtime
+	Target   NodeTarget
+
+	conn *rpcc.Conn
+}
+
+// NewNodeClient returns a new NodeClient that uses conn
+// for communication with the Node.js process.
+func NewNodeClient(conn *rpcc.Conn) *NodeClient {
+	return &NodeClient{
+		Debugger: debugger.NewClient(conn),
+		IO:       io.NewClient(conn),
+		Network:  network.NewClient(conn),
+		Profiler: profiler.NewClient(conn),
+		Runtime:  runtime.NewClient(conn),
+		Target:   target.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the NodeClient. Conn returns
+// nil if the NodeClient was not created by NewNodeClient.
+func (c *NodeClient) Conn() *rpcc.Conn {
+	return c.conn
+}
+
+// FromNodeClient returns a NodeClient that uses the domains of c.
+func FromNodeClient(c *cdp.NodeClient) *NodeClient {
+	return &NodeClient{
+		Debugger: c.Debugger,
+		IO:       c.IO,
+		Network:  c.Network,
+		Profiler: c.Profiler,
+		Runtime:  c.Runtime,
+		Target:   c.Target,
+
+		conn: c.Conn(),
+	}
+}
+
+// NodeIO is the subset of the IO domain supported by Node.js. Input/Output
+// operations for streams produced by DevTools.
+type NodeIO interface {
+	// Command Close
+	//
+	// Close the stream, discard any temporary backing storage.
+	Close(context.Context, *io.CloseArgs) error
+
+	// Command Read
+	//
+	// Read a chunk of the stream
+	Read(context.Context, *io.ReadArgs) (*io.ReadReply, error)
+}
+
+// NodeNetwork is the subset of the Network domain supported by Node.js.
+// Network domain allows tracking network activities of the page. It exposes
+// information about http, file, data and other requests and responses, their
+// headers, bodies, timing, etc.
+type NodeNetwork interface {
+	// Command Disable
+	//
+	// Disables network tracking, prevents network events from being sent
+	// to the client.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables network tracking, network events will now be delivered to
+	// the client.
+	Enable(context.Context, *network.EnableArgs) error
+
+	// Command GetResponseBody
+	//
+	// Returns content served for the given request.
+	GetResponseBody(context.Context, *network.GetResponseBodyArgs) (*network.GetResponseBodyReply, error)
+
+	// Command GetRequestPostData
+	//
+	// Returns post data sent with the request. Returns an error when no
+	// data was sent with the request.
+	GetRequestPostData(context.Context, *network.GetRequestPostDataArgs) (*network.GetRequestPostDataReply, error)
+
+	// Event DataReceived
+	//
+	// Fired when data chunk was received over the network.
+	DataReceived(context.Context) (network.DataReceivedClient, error)
+
+	// Event LoadingFailed
+	//
+	// Fired when HTTP request has failed to load.
+	LoadingFailed(context.Context) (network.LoadingFailedClient, error)
+
+	// Event LoadingFinished
+	//
+	// Fired when HTTP request has finished loading.
+	LoadingFinished(context.Context) (network.LoadingFinishedClient, error)
+
+	// Event RequestWillBeSent
+	//
+	// Fired when page is about to send HTTP request.
+	RequestWillBeSent(context.Context) (network.RequestWillBeSentClient, error)
+
+	// Event ResponseReceived
+	//
+	// Fired when HTTP response is available.
+	ResponseReceived(context.Context) (network.ResponseReceivedClient, error)
+}
+
+// NodeTarget is the subset of the Target domain supported by Node.js.
+// Supports additional targets discovery and allows to attach to them.
+type NodeTarget interface {
+	// Command SetAutoAttach
+	//
+	// Controls whether to automatically attach to new targets which are
+	// considered to be directly related to this one (for example, iframes
+	// or workers). When turned on, attaches to all existing related
+	// targets as well. When turned off, automatically detaches from all
+	// currently attached targets. This also clears all targets added by
+	// `autoAttachRelated` from the list of targets to watch for creation
+	// of related targets. You might want to call this recursively for
+	// auto-attached targets to attach to all available targets.
+	SetAutoAttach(context.Context, *target.SetAutoAttachArgs) error
+
+	// Event TargetCreated
+	//
+	// Issued when a possible inspection target is created.
+	TargetCreated(context.Context) (target.CreatedClient, error)
+}
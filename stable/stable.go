@@ -0,0 +1,1416 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package stable
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/debugger"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/domdebugger"
+	"github.com/mafredri/cdp/protocol/emulation"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/input"
+	"github.com/mafredri/cdp/protocol/io"
+	"github.com/mafredri/cdp/protocol/log"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/performance"
+	"github.com/mafredri/cdp/protocol/profiler"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/security"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/protocol/tracing"
+)
+
+// The Browser domain. The Browser domain defines methods and events for
+// browser managing.
+type Browser interface {
+	// Command ResetPermissions
+	//
+	// Reset all permission management for all origins.
+	ResetPermissions(context.Context, *browser.ResetPermissionsArgs) error
+
+	// Command Close
+	//
+	// Close browser gracefully.
+	Close(context.Context) error
+
+	// Command GetVersion
+	//
+	// Returns version information.
+	GetVersion(context.Context) (*browser.GetVersionReply, error)
+
+	// Command AddPrivacySandboxEnrollmentOverride
+	//
+	// Allows a site to use privacy sandbox features that require
+	// enrollment without the site actually being enrolled. Only supported
+	// on page targets.
+	AddPrivacySandboxEnrollmentOverride(context.Context, *browser.AddPrivacySandboxEnrollmentOverrideArgs) error
+
+	// Command AddPrivacySandboxCoordinatorKeyConfig
+	//
+	// Configures encryption keys used with a given privacy sandbox API to
+	// talk to a trusted coordinator. Since this is intended for test
+	// automation only, coordinatorOrigin must be a .test domain. No
+	// existing coordinator configuration for the origin may exist.
+	AddPrivacySandboxCoordinatorKeyConfig(context.Context, *browser.AddPrivacySandboxCoordinatorKeyConfigArgs) error
+}
+
+// The DOM domain. This domain exposes DOM read/write operations. Each DOM
+// Node is represented with its mirror object that has an `id`. This `id` can
+// be used to get additional information on the Node, resolve it into the
+// JavaScript object wrapper, etc. It is important that client receives DOM
+// events only for the nodes that are known to the client. Backend keeps track
+// of the nodes that were sent to the client and never sends the same node
+// twice. It is client's responsibility to collect information about the nodes
+// that were sent to the client. Note that `iframe` owner elements will return
+// corresponding document elements as their child nodes.
+type DOM interface {
+	// Command DescribeNode
+	//
+	// Describes node given its id, does not require domain to be enabled.
+	// Does not start tracking any objects, can be used for automation.
+	DescribeNode(context.Context, *dom.DescribeNodeArgs) (*dom.DescribeNodeReply, error)
+
+	// Command ScrollIntoViewIfNeeded
+	//
+	// Scrolls the specified rect of the given node into view if not
+	// already visible. Note: exactly one between nodeId, backendNodeId and
+	// objectId should be passed to identify the node.
+	ScrollIntoViewIfNeeded(context.Context, *dom.ScrollIntoViewIfNeededArgs) error
+
+	// Command Disable
+	//
+	// Disables DOM agent for the given page.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables DOM agent for the given page.
+	Enable(context.Context, *dom.EnableArgs) error
+
+	// Command Focus
+	//
+	// Focuses the given element.
+	Focus(context.Context, *dom.FocusArgs) error
+
+	// Command GetAttributes
+	//
+	// Returns attributes for the specified node.
+	GetAttributes(context.Context, *dom.GetAttributesArgs) (*dom.GetAttributesReply, error)
+
+	// Command GetBoxModel
+	//
+	// Returns boxes for the given node.
+	GetBoxModel(context.Context, *dom.GetBoxModelArgs) (*dom.GetBoxModelReply, error)
+
+	// Command GetDocument
+	//
+	// Returns the root DOM node (and optionally the subtree) to the
+	// caller. Implicitly enables the DOM domain events for the current
+	// target.
+	GetDocument(context.Context, *dom.GetDocumentArgs) (*dom.GetDocumentReply, error)
+
+	// Command GetNodeForLocation
+	//
+	// Returns node id at given location. Depending on whether DOM domain
+	// is enabled, nodeId is either returned or not.
+	GetNodeForLocation(context.Context, *dom.GetNodeForLocationArgs) (*dom.GetNodeForLocationReply, error)
+
+	// Command GetOuterHTML
+	//
+	// Returns node's HTML markup.
+	GetOuterHTML(context.Context, *dom.GetOuterHTMLArgs) (*dom.GetOuterHTMLReply, error)
+
+	// Command MoveTo
+	//
+	// Moves node into the new container, places it before the given
+	// anchor.
+	MoveTo(context.Context, *dom.MoveToArgs) (*dom.MoveToReply, error)
+
+	// Command QuerySelector
+	//
+	// Executes `querySelector` on a given node.
+	QuerySelector(context.Context, *dom.QuerySelectorArgs) (*dom.QuerySelectorReply, error)
+
+	// Command QuerySelectorAll
+	//
+	// Executes `querySelectorAll` on a given node.
+	QuerySelectorAll(context.Context, *dom.QuerySelectorAllArgs) (*dom.QuerySelectorAllReply, error)
+
+	// Command RemoveAttribute
+	//
+	// Removes attribute with given name from an element with given id.
+	RemoveAttribute(context.Context, *dom.RemoveAttributeArgs) error
+
+	// Command RemoveNode
+	//
+	// Removes node with given id.
+	RemoveNode(context.Context, *dom.RemoveNodeArgs) error
+
+	// Command RequestChildNodes
+	//
+	// Requests that children of the node with given id are returned to
+	// the caller in form of `setChildNodes` events where not only
+	// immediate children are retrieved, but all children down to the
+	// specified depth.
+	RequestChildNodes(context.Context, *dom.RequestChildNodesArgs) error
+
+	// Command RequestNode
+	//
+	// Requests that the node is sent to the caller given the JavaScript
+	// node object reference. All nodes that form the path from the node to
+	// the root are also sent to the client as a series of `setChildNodes`
+	// notifications.
+	RequestNode(context.Context, *dom.RequestNodeArgs) (*dom.RequestNodeReply, error)
+
+	// Command ResolveNode
+	//
+	// Resolves the JavaScript node object for a given NodeId or
+	// BackendNodeId.
+	ResolveNode(context.Context, *dom.ResolveNodeArgs) (*dom.ResolveNodeReply, error)
+
+	// Command SetAttributeValue
+	//
+	// Sets attribute for an element with given id.
+	SetAttributeValue(context.Context, *dom.SetAttributeValueArgs) error
+
+	// Command SetAttributesAsText
+	//
+	// Sets attributes on element with given id. This method is useful
+	// when user edits some existing attribute value and types in several
+	// attribute name/value pairs.
+	SetAttributesAsText(context.Context, *dom.SetAttributesAsTextArgs) error
+
+	// Command SetFileInputFiles
+	//
+	// Sets files for the given file input element.
+	SetFileInputFiles(context.Context, *dom.SetFileInputFilesArgs) error
+
+	// Command SetNodeName
+	//
+	// Sets node name for a node with given id.
+	SetNodeName(context.Context, *dom.SetNodeNameArgs) (*dom.SetNodeNameReply, error)
+
+	// Command SetNodeValue
+	//
+	// Sets node value for a node with given id.
+	SetNodeValue(context.Context, *dom.SetNodeValueArgs) error
+
+	// Command SetOuterHTML
+	//
+	// Sets node HTML markup, returns new node id.
+	SetOuterHTML(context.Context, *dom.SetOuterHTMLArgs) error
+
+	// Event AttributeModified
+	//
+	// Fired when `Element`'s attribute is modified.
+	AttributeModified(context.Context) (dom.AttributeModifiedClient, error)
+
+	// Event AttributeRemoved
+	//
+	// Fired when `Element`'s attribute is removed.
+	AttributeRemoved(context.Context) (dom.AttributeRemovedClient, error)
+
+	// Event CharacterDataModified
+	//
+	// Mirrors `DOMCharacterDataModified` event.
+	CharacterDataModified(context.Context) (dom.CharacterDataModifiedClient, error)
+
+	// Event ChildNodeCountUpdated
+	//
+	// Fired when `Container`'s child node count has changed.
+	ChildNodeCountUpdated(context.Context) (dom.ChildNodeCountUpdatedClient, error)
+
+	// Event ChildNodeInserted
+	//
+	// Mirrors `DOMNodeInserted` event.
+	ChildNodeInserted(context.Context) (dom.ChildNodeInsertedClient, error)
+
+	// Event ChildNodeRemoved
+	//
+	// Mirrors `DOMNodeRemoved` event.
+	ChildNodeRemoved(context.Context) (dom.ChildNodeRemovedClient, error)
+
+	// Event DocumentUpdated
+	//
+	// Fired when `Document` has been totally updated. Node ids are no
+	// longer valid.
+	DocumentUpdated(context.Context) (dom.DocumentUpdatedClient, error)
+
+	// Event SetChildNodes
+	//
+	// Fired when backend wants to provide client with the missing DOM
+	// structure. This happens upon most of the calls requesting node ids.
+	SetChildNodes(context.Context) (dom.SetChildNodesClient, error)
+}
+
+// The DOMDebugger domain. DOM debugging allows setting breakpoints on
+// particular DOM operations and events. JavaScript execution will stop on
+// these operations as if there was a regular breakpoint set.
+type DOMDebugger interface {
+	// Command GetEventListeners
+	//
+	// Returns event listeners of the given object.
+	GetEventListeners(context.Context, *domdebugger.GetEventListenersArgs) (*domdebugger.GetEventListenersReply, error)
+
+	// Command RemoveDOMBreakpoint
+	//
+	// Removes DOM breakpoint that was set using `setDOMBreakpoint`.
+	RemoveDOMBreakpoint(context.Context, *domdebugger.RemoveDOMBreakpointArgs) error
+
+	// Command RemoveEventListenerBreakpoint
+	//
+	// Removes breakpoint on particular DOM event.
+	RemoveEventListenerBreakpoint(context.Context, *domdebugger.RemoveEventListenerBreakpointArgs) error
+
+	// Command RemoveXHRBreakpoint
+	//
+	// Removes breakpoint from XMLHttpRequest.
+	RemoveXHRBreakpoint(context.Context, *domdebugger.RemoveXHRBreakpointArgs) error
+
+	// Command SetDOMBreakpoint
+	//
+	// Sets breakpoint on particular operation with DOM.
+	SetDOMBreakpoint(context.Context, *domdebugger.SetDOMBreakpointArgs) error
+
+	// Command SetEventListenerBreakpoint
+	//
+	// Sets breakpoint on particular DOM event.
+	SetEventListenerBreakpoint(context.Context, *domdebugger.SetEventListenerBreakpointArgs) error
+
+	// Command SetXHRBreakpoint
+	//
+	// Sets breakpoint on XMLHttpRequest.
+	SetXHRBreakpoint(context.Context, *domdebugger.SetXHRBreakpointArgs) error
+}
+
+// The Debugger domain. Debugger domain exposes JavaScript debugging
+// capabilities. It allows setting and removing breakpoints, stepping through
+// execution, exploring stack traces, etc.
+type Debugger interface {
+	// Command ContinueToLocation
+	//
+	// Continues execution until specific location is reached.
+	ContinueToLocation(context.Context, *debugger.ContinueToLocationArgs) error
+
+	// Command Disable
+	//
+	// Disables debugger for given page.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables debugger for the given page. Clients should not assume that
+	// the debugging has been enabled until the result for this command is
+	// received.
+	Enable(context.Context, *debugger.EnableArgs) (*debugger.EnableReply, error)
+
+	// Command EvaluateOnCallFrame
+	//
+	// Evaluates expression on a given call frame.
+	EvaluateOnCallFrame(context.Context, *debugger.EvaluateOnCallFrameArgs) (*debugger.EvaluateOnCallFrameReply, error)
+
+	// Command GetPossibleBreakpoints
+	//
+	// Returns possible locations for breakpoint. scriptId in start and
+	// end range locations should be the same.
+	GetPossibleBreakpoints(context.Context, *debugger.GetPossibleBreakpointsArgs) (*debugger.GetPossibleBreakpointsReply, error)
+
+	// Command GetScriptSource
+	//
+	// Returns source for the script with given id.
+	GetScriptSource(context.Context, *debugger.GetScriptSourceArgs) (*debugger.GetScriptSourceReply, error)
+
+	// Command Pause
+	//
+	// Stops on the next JavaScript statement.
+	Pause(context.Context) error
+
+	// Command RemoveBreakpoint
+	//
+	// Removes JavaScript breakpoint.
+	RemoveBreakpoint(context.Context, *debugger.RemoveBreakpointArgs) error
+
+	// Command RestartFrame
+	//
+	// Restarts particular call frame from the beginning. The old,
+	// deprecated behavior of `restartFrame` is to stay paused and allow
+	// further CDP commands after a restart was scheduled. This can cause
+	// problems with restarting, so we now continue execution immediately
+	// after it has been scheduled until we reach the beginning of the
+	// restarted frame.
+	//
+	// To stay back-wards compatible, `restartFrame` now expects a `mode`
+	// parameter to be present. If the `mode` parameter is missing,
+	// `restartFrame` errors out.
+	//
+	// The various return values are deprecated and `callFrames` is always
+	// empty. Use the call frames from the `Debugger#paused` events
+	// instead, that fires once V8 pauses at the beginning of the restarted
+	// function.
+	RestartFrame(context.Context, *debugger.RestartFrameArgs) (*debugger.RestartFrameReply, error)
+
+	// Command Resume
+	//
+	// Resumes JavaScript execution.
+	Resume(context.Context, *debugger.ResumeArgs) error
+
+	// Command SearchInContent
+	//
+	// Searches for given string in script content.
+	SearchInContent(context.Context, *debugger.SearchInContentArgs) (*debugger.SearchInContentReply, error)
+
+	// Command SetAsyncCallStackDepth
+	//
+	// Enables or disables async call stacks tracking.
+	SetAsyncCallStackDepth(context.Context, *debugger.SetAsyncCallStackDepthArgs) error
+
+	// Command SetBreakpoint
+	//
+	// Sets JavaScript breakpoint at a given location.
+	SetBreakpoint(context.Context, *debugger.SetBreakpointArgs) (*debugger.SetBreakpointReply, error)
+
+	// Command SetInstrumentationBreakpoint
+	//
+	// Sets instrumentation breakpoint.
+	SetInstrumentationBreakpoint(context.Context, *debugger.SetInstrumentationBreakpointArgs) (*debugger.SetInstrumentationBreakpointReply, error)
+
+	// Command SetBreakpointByURL
+	//
+	// Sets JavaScript breakpoint at given location specified either by
+	// URL or URL regex. Once this command is issued, all existing parsed
+	// scripts will have breakpoints resolved and returned in `locations`
+	// property. Further matching script parsing will result in subsequent
+	// `breakpointResolved` events issued. This logical breakpoint will
+	// survive page reloads.
+	SetBreakpointByURL(context.Context, *debugger.SetBreakpointByURLArgs) (*debugger.SetBreakpointByURLReply, error)
+
+	// Command SetBreakpointsActive
+	//
+	// Activates / deactivates all breakpoints on the page.
+	SetBreakpointsActive(context.Context, *debugger.SetBreakpointsActiveArgs) error
+
+	// Command SetPauseOnExceptions
+	//
+	// Defines pause on exceptions state. Can be set to stop on all
+	// exceptions, uncaught exceptions, or caught exceptions, no
+	// exceptions. Initial pause on exceptions state is `none`.
+	SetPauseOnExceptions(context.Context, *debugger.SetPauseOnExceptionsArgs) error
+
+	// Command SetScriptSource
+	//
+	// Edits JavaScript source live.
+	//
+	// In general, functions that are currently on the stack can not be
+	// edited with a single exception: If the edited function is the
+	// top-most stack frame and that is the only activation of that
+	// function on the stack. In this case the live edit will be successful
+	// and a `Debugger.restartFrame` for the top-most function is
+	// automatically triggered.
+	SetScriptSource(context.Context, *debugger.SetScriptSourceArgs) (*debugger.SetScriptSourceReply, error)
+
+	// Command SetSkipAllPauses
+	//
+	// Makes page not interrupt on any pauses (breakpoint, exception, dom
+	// exception etc).
+	SetSkipAllPauses(context.Context, *debugger.SetSkipAllPausesArgs) error
+
+	// Command SetVariableValue
+	//
+	// Changes value of variable in a callframe. Object-based scopes are
+	// not supported and must be mutated manually.
+	SetVariableValue(context.Context, *debugger.SetVariableValueArgs) error
+
+	// Command StepInto
+	//
+	// Steps into the function call.
+	StepInto(context.Context, *debugger.StepIntoArgs) error
+
+	// Command StepOut
+	//
+	// Steps out of the function call.
+	StepOut(context.Context) error
+
+	// Command StepOver
+	//
+	// Steps over the statement.
+	StepOver(context.Context, *debugger.StepOverArgs) error
+
+	// Event Paused
+	//
+	// Fired when the virtual machine stopped on breakpoint or exception
+	// or any other stop criteria.
+	Paused(context.Context) (debugger.PausedClient, error)
+
+	// Event Resumed
+	//
+	// Fired when the virtual machine resumed execution.
+	Resumed(context.Context) (debugger.ResumedClient, error)
+
+	// Event ScriptFailedToParse
+	//
+	// Fired when virtual machine fails to parse the script.
+	ScriptFailedToParse(context.Context) (debugger.ScriptFailedToParseClient, error)
+
+	// Event ScriptParsed
+	//
+	// Fired when virtual machine parses script. This event is also fired
+	// for all known and uncollected scripts upon enabling debugger.
+	ScriptParsed(context.Context) (debugger.ScriptParsedClient, error)
+}
+
+// The Emulation domain. This domain emulates different environments for the
+// page.
+type Emulation interface {
+	// Command ClearDeviceMetricsOverride
+	//
+	// Clears the overridden device metrics.
+	ClearDeviceMetricsOverride(context.Context) error
+
+	// Command ClearGeolocationOverride
+	//
+	// Clears the overridden Geolocation Position and Error.
+	ClearGeolocationOverride(context.Context) error
+
+	// Command SetCPUThrottlingRate
+	//
+	// Enables CPU throttling to emulate slow CPUs.
+	SetCPUThrottlingRate(context.Context, *emulation.SetCPUThrottlingRateArgs) error
+
+	// Command SetDefaultBackgroundColorOverride
+	//
+	// Sets or clears an override of the default background color of the
+	// frame. This override is used if the content does not specify one.
+	SetDefaultBackgroundColorOverride(context.Context, *emulation.SetDefaultBackgroundColorOverrideArgs) error
+
+	// Command SetDeviceMetricsOverride
+	//
+	// Overrides the values of device screen dimensions
+	// (window.screen.width, window.screen.height, window.innerWidth,
+	// window.innerHeight, and "device-width"/"device-height"-related CSS
+	// media query results).
+	SetDeviceMetricsOverride(context.Context, *emulation.SetDeviceMetricsOverrideArgs) error
+
+	// Command SetEmulatedMedia
+	//
+	// Emulates the given media type or media feature for CSS media
+	// queries.
+	SetEmulatedMedia(context.Context, *emulation.SetEmulatedMediaArgs) error
+
+	// Command SetEmulatedVisionDeficiency
+	//
+	// Emulates the given vision deficiency.
+	SetEmulatedVisionDeficiency(context.Context, *emulation.SetEmulatedVisionDeficiencyArgs) error
+
+	// Command SetEmulatedOSTextScale
+	//
+	// Emulates the given OS text scale.
+	SetEmulatedOSTextScale(context.Context, *emulation.SetEmulatedOSTextScaleArgs) error
+
+	// Command SetGeolocationOverride
+	//
+	// Overrides the Geolocation Position or Error. Omitting latitude,
+	// longitude or accuracy emulates position unavailable.
+	SetGeolocationOverride(context.Context, *emulation.SetGeolocationOverrideArgs) error
+
+	// Command SetIdleOverride
+	//
+	// Overrides the Idle state.
+	SetIdleOverride(context.Context, *emulation.SetIdleOverrideArgs) error
+
+	// Command ClearIdleOverride
+	//
+	// Clears Idle state overrides.
+	ClearIdleOverride(context.Context) error
+
+	// Command SetScriptExecutionDisabled
+	//
+	// Switches script execution in the page.
+	SetScriptExecutionDisabled(context.Context, *emulation.SetScriptExecutionDisabledArgs) error
+
+	// Command SetTouchEmulationEnabled
+	//
+	// Enables touch on platforms which do not support them.
+	SetTouchEmulationEnabled(context.Context, *emulation.SetTouchEmulationEnabledArgs) error
+
+	// Command SetTimezoneOverride
+	//
+	// Overrides default host system timezone with the specified one.
+	SetTimezoneOverride(context.Context, *emulation.SetTimezoneOverrideArgs) error
+
+	// Command SetUserAgentOverride
+	//
+	// Allows overriding user agent with the given string.
+	// `userAgentMetadata` must be set for Client Hint headers to be sent.
+	SetUserAgentOverride(context.Context, *emulation.SetUserAgentOverrideArgs) error
+}
+
+// The Fetch domain. A domain for letting clients substitute browser's network
+// layer with client code.
+type Fetch interface {
+	// Command Disable
+	//
+	// Disables the fetch domain.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables issuing of requestPaused events. A request will be paused
+	// until client calls one of failRequest, fulfillRequest or
+	// continueRequest/continueWithAuth.
+	Enable(context.Context, *fetch.EnableArgs) error
+
+	// Command FailRequest
+	//
+	// Causes the request to fail with specified reason.
+	FailRequest(context.Context, *fetch.FailRequestArgs) error
+
+	// Command FulfillRequest
+	//
+	// Provides response to the request.
+	FulfillRequest(context.Context, *fetch.FulfillRequestArgs) error
+
+	// Command ContinueRequest
+	//
+	// Continues the request, optionally modifying some of its parameters.
+	ContinueRequest(context.Context, *fetch.ContinueRequestArgs) error
+
+	// Command ContinueWithAuth
+	//
+	// Continues a request supplying authChallengeResponse following
+	// authRequired event.
+	ContinueWithAuth(context.Context, *fetch.ContinueWithAuthArgs) error
+
+	// Command GetResponseBody
+	//
+	// Causes the body of the response to be received from the server and
+	// returned as a single string. May only be issued for a request that
+	// is paused in the Response stage and is mutually exclusive with
+	// takeResponseBodyForInterceptionAsStream. Calling other methods that
+	// affect the request or disabling fetch domain before body is received
+	// results in an undefined behavior. Note that the response body is not
+	// available for redirects. Requests paused in the _redirect received_
+	// state may be differentiated by `responseCode` and presence of
+	// `location` response header, see comments to `requestPaused` for
+	// details.
+	GetResponseBody(context.Context, *fetch.GetResponseBodyArgs) (*fetch.GetResponseBodyReply, error)
+
+	// Command TakeResponseBodyAsStream
+	//
+	// Returns a handle to the stream representing the response body. The
+	// request must be paused in the HeadersReceived stage. Note that after
+	// this command the request can't be continued as is -- client either
+	// needs to cancel it or to provide the response body. The stream only
+	// supports sequential read, IO.read will fail if the position is
+	// specified. This method is mutually exclusive with getResponseBody.
+	// Calling other methods that affect the request or disabling fetch
+	// domain before body is received results in an undefined behavior.
+	TakeResponseBodyAsStream(context.Context, *fetch.TakeResponseBodyAsStreamArgs) (*fetch.TakeResponseBodyAsStreamReply, error)
+
+	// Event RequestPaused
+	//
+	// Issued when the domain is enabled and the request URL matches the
+	// specified filter. The request is paused until the client responds
+	// with one of continueRequest, failRequest or fulfillRequest. The
+	// stage of the request can be determined by presence of
+	// responseErrorReason and responseStatusCode -- the request is at the
+	// response stage if either of these fields is present and in the
+	// request stage otherwise. Redirect responses and subsequent requests
+	// are reported similarly to regular responses and requests. Redirect
+	// responses may be distinguished by the value of `responseStatusCode`
+	// (which is one of 301, 302, 303, 307, 308) along with presence of the
+	// `location` header. Requests resulting from a redirect will have
+	// `redirectedRequestId` field set.
+	RequestPaused(context.Context) (fetch.RequestPausedClient, error)
+
+	// Event AuthRequired
+	//
+	// Issued when the domain is enabled with handleAuthRequests set to
+	// true. The request is paused until client responds with
+	// continueWithAuth.
+	AuthRequired(context.Context) (fetch.AuthRequiredClient, error)
+}
+
+// The IO domain. Input/Output operations for streams produced by DevTools.
+type IO interface {
+	// Command Close
+	//
+	// Close the stream, discard any temporary backing storage.
+	Close(context.Context, *io.CloseArgs) error
+
+	// Command Read
+	//
+	// Read a chunk of the stream
+	Read(context.Context, *io.ReadArgs) (*io.ReadReply, error)
+
+	// Command ResolveBlob
+	//
+	// Return UUID of Blob object specified by a remote object id.
+	ResolveBlob(context.Context, *io.ResolveBlobArgs) (*io.ResolveBlobReply, error)
+}
+
+// The Input domain.
+type Input interface {
+	// Command DispatchKeyEvent
+	//
+	// Dispatches a key event to the page.
+	DispatchKeyEvent(context.Context, *input.DispatchKeyEventArgs) error
+
+	// Command DispatchMouseEvent
+	//
+	// Dispatches a mouse event to the page.
+	DispatchMouseEvent(context.Context, *input.DispatchMouseEventArgs) error
+
+	// Command DispatchTouchEvent
+	//
+	// Dispatches a touch event to the page.
+	DispatchTouchEvent(context.Context, *input.DispatchTouchEventArgs) error
+
+	// Command CancelDragging
+	//
+	// Cancels any active dragging in the page.
+	CancelDragging(context.Context) error
+
+	// Command SetIgnoreInputEvents
+	//
+	// Ignores input events (useful while auditing page).
+	SetIgnoreInputEvents(context.Context, *input.SetIgnoreInputEventsArgs) error
+}
+
+// The Log domain. Provides access to log entries.
+type Log interface {
+	// Command Clear
+	//
+	// Clears the log.
+	Clear(context.Context) error
+
+	// Command Disable
+	//
+	// Disables log domain, prevents further log entries from being
+	// reported to the client.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables log domain, sends the entries collected so far to the
+	// client by means of the `entryAdded` notification.
+	Enable(context.Context) error
+
+	// Command StartViolationsReport
+	//
+	// start violation reporting.
+	StartViolationsReport(context.Context, *log.StartViolationsReportArgs) error
+
+	// Command StopViolationsReport
+	//
+	// Stop violation reporting.
+	StopViolationsReport(context.Context) error
+
+	// Event EntryAdded
+	//
+	// Issued when new message was logged.
+	EntryAdded(context.Context) (log.EntryAddedClient, error)
+}
+
+// The Network domain. Network domain allows tracking network activities of
+// the page. It exposes information about http, file, data and other requests
+// and responses, their headers, bodies, timing, etc.
+type Network interface {
+	// Command ClearBrowserCache
+	//
+	// Clears browser cache.
+	ClearBrowserCache(context.Context) error
+
+	// Command ClearBrowserCookies
+	//
+	// Clears browser cookies.
+	ClearBrowserCookies(context.Context) error
+
+	// Command DeleteCookies
+	//
+	// Deletes browser cookies with matching name and url or
+	// domain/path/partitionKey pair.
+	DeleteCookies(context.Context, *network.DeleteCookiesArgs) error
+
+	// Command Disable
+	//
+	// Disables network tracking, prevents network events from being sent
+	// to the client.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables network tracking, network events will now be delivered to
+	// the client.
+	Enable(context.Context, *network.EnableArgs) error
+
+	// Command GetCookies
+	//
+	// Returns all browser cookies for the current URL. Depending on the
+	// backend support, will return detailed cookie information in the
+	// `cookies` field.
+	GetCookies(context.Context, *network.GetCookiesArgs) (*network.GetCookiesReply, error)
+
+	// Command GetResponseBody
+	//
+	// Returns content served for the given request.
+	GetResponseBody(context.Context, *network.GetResponseBodyArgs) (*network.GetResponseBodyReply, error)
+
+	// Command GetRequestPostData
+	//
+	// Returns post data sent with the request. Returns an error when no
+	// data was sent with the request.
+	GetRequestPostData(context.Context, *network.GetRequestPostDataArgs) (*network.GetRequestPostDataReply, error)
+
+	// Command SetBypassServiceWorker
+	//
+	// Toggles ignoring of service worker for each request.
+	SetBypassServiceWorker(context.Context, *network.SetBypassServiceWorkerArgs) error
+
+	// Command SetCacheDisabled
+	//
+	// Toggles ignoring cache for each request. If `true`, cache will not
+	// be used.
+	SetCacheDisabled(context.Context, *network.SetCacheDisabledArgs) error
+
+	// Command SetCookie
+	//
+	// Sets a cookie with the given cookie data; may overwrite equivalent
+	// cookies if they exist.
+	SetCookie(context.Context, *network.SetCookieArgs) (*network.SetCookieReply, error)
+
+	// Command SetCookies
+	//
+	// Sets given cookies.
+	SetCookies(context.Context, *network.SetCookiesArgs) error
+
+	// Command SetExtraHTTPHeaders
+	//
+	// Specifies whether to always send extra HTTP headers with the
+	// requests from this page.
+	SetExtraHTTPHeaders(context.Context, *network.SetExtraHTTPHeadersArgs) error
+
+	// Event DataReceived
+	//
+	// Fired when data chunk was received over the network.
+	DataReceived(context.Context) (network.DataReceivedClient, error)
+
+	// Event EventSourceMessageReceived
+	//
+	// Fired when EventSource message is received.
+	EventSourceMessageReceived(context.Context) (network.EventSourceMessageReceivedClient, error)
+
+	// Event LoadingFailed
+	//
+	// Fired when HTTP request has failed to load.
+	LoadingFailed(context.Context) (network.LoadingFailedClient, error)
+
+	// Event LoadingFinished
+	//
+	// Fired when HTTP request has finished loading.
+	LoadingFinished(context.Context) (network.LoadingFinishedClient, error)
+
+	// Event RequestServedFromCache
+	//
+	// Fired if request ended up loading from cache.
+	RequestServedFromCache(context.Context) (network.RequestServedFromCacheClient, error)
+
+	// Event RequestWillBeSent
+	//
+	// Fired when page is about to send HTTP request.
+	RequestWillBeSent(context.Context) (network.RequestWillBeSentClient, error)
+
+	// Event ResponseReceived
+	//
+	// Fired when HTTP response is available.
+	ResponseReceived(context.Context) (network.ResponseReceivedClient, error)
+
+	// Event WebSocketClosed
+	//
+	// Fired when WebSocket is closed.
+	WebSocketClosed(context.Context) (network.WebSocketClosedClient, error)
+
+	// Event WebSocketCreated
+	//
+	// Fired upon WebSocket creation.
+	WebSocketCreated(context.Context) (network.WebSocketCreatedClient, error)
+
+	// Event WebSocketFrameError
+	//
+	// Fired when WebSocket message error occurs.
+	WebSocketFrameError(context.Context) (network.WebSocketFrameErrorClient, error)
+
+	// Event WebSocketFrameReceived
+	//
+	// Fired when WebSocket message is received.
+	WebSocketFrameReceived(context.Context) (network.WebSocketFrameReceivedClient, error)
+
+	// Event WebSocketFrameSent
+	//
+	// Fired when WebSocket message is sent.
+	WebSocketFrameSent(context.Context) (network.WebSocketFrameSentClient, error)
+
+	// Event WebSocketHandshakeResponseReceived
+	//
+	// Fired when WebSocket handshake response becomes available.
+	WebSocketHandshakeResponseReceived(context.Context) (network.WebSocketHandshakeResponseReceivedClient, error)
+
+	// Event WebSocketWillSendHandshakeRequest
+	//
+	// Fired when WebSocket is about to initiate handshake.
+	WebSocketWillSendHandshakeRequest(context.Context) (network.WebSocketWillSendHandshakeRequestClient, error)
+
+	// Event WebTransportCreated
+	//
+	// Fired upon WebTransport creation.
+	WebTransportCreated(context.Context) (network.WebTransportCreatedClient, error)
+
+	// Event WebTransportConnectionEstablished
+	//
+	// Fired when WebTransport handshake is finished.
+	WebTransportConnectionEstablished(context.Context) (network.WebTransportConnectionEstablishedClient, error)
+
+	// Event WebTransportClosed
+	//
+	// Fired when WebTransport is disposed.
+	WebTransportClosed(context.Context) (network.WebTransportClosedClient, error)
+}
+
+// The Page domain. Actions and events related to the inspected page belong to
+// the page domain.
+type Page interface {
+	// Command AddScriptToEvaluateOnNewDocument
+	//
+	// Evaluates given script in every frame upon creation (before loading
+	// frame's scripts).
+	AddScriptToEvaluateOnNewDocument(context.Context, *page.AddScriptToEvaluateOnNewDocumentArgs) (*page.AddScriptToEvaluateOnNewDocumentReply, error)
+
+	// Command BringToFront
+	//
+	// Brings page to front (activates tab).
+	BringToFront(context.Context) error
+
+	// Command CaptureScreenshot
+	//
+	// Capture page screenshot.
+	CaptureScreenshot(context.Context, *page.CaptureScreenshotArgs) (*page.CaptureScreenshotReply, error)
+
+	// Command CreateIsolatedWorld
+	//
+	// Creates an isolated world for the given frame.
+	CreateIsolatedWorld(context.Context, *page.CreateIsolatedWorldArgs) (*page.CreateIsolatedWorldReply, error)
+
+	// Command Disable
+	//
+	// Disables page domain notifications.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables page domain notifications.
+	Enable(context.Context, *page.EnableArgs) error
+
+	// Command GetAppManifest
+	//
+	// Gets the processed manifest for this current document. This API
+	// always waits for the manifest to be loaded. If manifestId is
+	// provided, and it does not match the manifest of the current
+	// document, this API errors out. If there is not a loaded page, this
+	// API errors out immediately.
+	GetAppManifest(context.Context, *page.GetAppManifestArgs) (*page.GetAppManifestReply, error)
+
+	// Command GetFrameTree
+	//
+	// Returns present frame tree structure.
+	GetFrameTree(context.Context) (*page.GetFrameTreeReply, error)
+
+	// Command GetLayoutMetrics
+	//
+	// Returns metrics relating to the layouting of the page, such as
+	// viewport bounds/scale.
+	GetLayoutMetrics(context.Context) (*page.GetLayoutMetricsReply, error)
+
+	// Command GetNavigationHistory
+	//
+	// Returns navigation history for the current page.
+	GetNavigationHistory(context.Context) (*page.GetNavigationHistoryReply, error)
+
+	// Command ResetNavigationHistory
+	//
+	// Resets navigation history for the current page.
+	ResetNavigationHistory(context.Context) error
+
+	// Command HandleJavaScriptDialog
+	//
+	// Accepts or dismisses a JavaScript initiated dialog (alert, confirm,
+	// prompt, or onbeforeunload).
+	HandleJavaScriptDialog(context.Context, *page.HandleJavaScriptDialogArgs) error
+
+	// Command Navigate
+	//
+	// Navigates current page to the given URL.
+	Navigate(context.Context, *page.NavigateArgs) (*page.NavigateReply, error)
+
+	// Command NavigateToHistoryEntry
+	//
+	// Navigates current page to the given history entry.
+	NavigateToHistoryEntry(context.Context, *page.NavigateToHistoryEntryArgs) error
+
+	// Command PrintToPDF
+	//
+	// Print page as PDF.
+	PrintToPDF(context.Context, *page.PrintToPDFArgs) (*page.PrintToPDFReply, error)
+
+	// Command Reload
+	//
+	// Reloads given page optionally ignoring the cache.
+	Reload(context.Context, *page.ReloadArgs) error
+
+	// Command RemoveScriptToEvaluateOnNewDocument
+	//
+	// Removes given script from the list.
+	RemoveScriptToEvaluateOnNewDocument(context.Context, *page.RemoveScriptToEvaluateOnNewDocumentArgs) error
+
+	// Command SetBypassCSP
+	//
+	// Enable page Content Security Policy by-passing.
+	SetBypassCSP(context.Context, *page.SetBypassCSPArgs) error
+
+	// Command SetDocumentContent
+	//
+	// Sets given markup as the document's HTML.
+	SetDocumentContent(context.Context, *page.SetDocumentContentArgs) error
+
+	// Command SetLifecycleEventsEnabled
+	//
+	// Controls whether page will emit lifecycle events.
+	SetLifecycleEventsEnabled(context.Context, *page.SetLifecycleEventsEnabledArgs) error
+
+	// Command StopLoading
+	//
+	// Force the page stop all navigations and pending resource fetches.
+	StopLoading(context.Context) error
+
+	// Command Close
+	//
+	// Tries to close page, running its beforeunload hooks, if any.
+	Close(context.Context) error
+
+	// Command SetInterceptFileChooserDialog
+	//
+	// Intercept file chooser requests and transfer control to protocol
+	// clients. When file chooser interception is enabled, native file
+	// chooser dialog is not shown. Instead, a protocol event
+	// `Page.fileChooserOpened` is emitted.
+	SetInterceptFileChooserDialog(context.Context, *page.SetInterceptFileChooserDialogArgs) error
+
+	// Event DOMContentEventFired
+	DOMContentEventFired(context.Context) (page.DOMContentEventFiredClient, error)
+
+	// Event FileChooserOpened
+	//
+	// Emitted only when `page.interceptFileChooser` is enabled.
+	FileChooserOpened(context.Context) (page.FileChooserOpenedClient, error)
+
+	// Event FrameAttached
+	//
+	// Fired when frame has been attached to its parent.
+	FrameAttached(context.Context) (page.FrameAttachedClient, error)
+
+	// Event FrameDetached
+	//
+	// Fired when frame has been detached from its parent.
+	FrameDetached(context.Context) (page.FrameDetachedClient, error)
+
+	// Event FrameNavigated
+	//
+	// Fired once navigation of the frame has completed. Frame is now
+	// associated with the new loader.
+	FrameNavigated(context.Context) (page.FrameNavigatedClient, error)
+
+	// Event InterstitialHidden
+	//
+	// Fired when interstitial page was hidden
+	InterstitialHidden(context.Context) (page.InterstitialHiddenClient, error)
+
+	// Event InterstitialShown
+	//
+	// Fired when interstitial page was shown
+	InterstitialShown(context.Context) (page.InterstitialShownClient, error)
+
+	// Event JavascriptDialogClosed
+	//
+	// Fired when a JavaScript initiated dialog (alert, confirm, prompt,
+	// or onbeforeunload) has been closed.
+	JavascriptDialogClosed(context.Context) (page.JavascriptDialogClosedClient, error)
+
+	// Event JavascriptDialogOpening
+	//
+	// Fired when a JavaScript initiated dialog (alert, confirm, prompt,
+	// or onbeforeunload) is about to open.
+	JavascriptDialogOpening(context.Context) (page.JavascriptDialogOpeningClient, error)
+
+	// Event LifecycleEvent
+	//
+	// Fired for lifecycle events (navigation, load, paint, etc) in the
+	// current target (including local frames).
+	LifecycleEvent(context.Context) (page.LifecycleEventClient, error)
+
+	// Event LoadEventFired
+	LoadEventFired(context.Context) (page.LoadEventFiredClient, error)
+
+	// Event WindowOpen
+	//
+	// Fired when a new window is going to be opened, via window.open(),
+	// link click, form submission, etc.
+	WindowOpen(context.Context) (page.WindowOpenClient, error)
+}
+
+// The Performance domain.
+type Performance interface {
+	// Command Disable
+	//
+	// Disable collecting and reporting metrics.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enable collecting and reporting metrics.
+	Enable(context.Context, *performance.EnableArgs) error
+
+	// Command GetMetrics
+	//
+	// Retrieve current values of run-time metrics.
+	GetMetrics(context.Context) (*performance.GetMetricsReply, error)
+
+	// Event Metrics
+	//
+	// Current values of the metrics.
+	Metrics(context.Context) (performance.MetricsClient, error)
+}
+
+// The Profiler domain.
+type Profiler interface {
+	// Command Disable
+	Disable(context.Context) error
+
+	// Command Enable
+	Enable(context.Context) error
+
+	// Command GetBestEffortCoverage
+	//
+	// Collect coverage data for the current isolate. The coverage data
+	// may be incomplete due to garbage collection.
+	GetBestEffortCoverage(context.Context) (*profiler.GetBestEffortCoverageReply, error)
+
+	// Command SetSamplingInterval
+	//
+	// Changes CPU profiler sampling interval. Must be called before CPU
+	// profiles recording started.
+	SetSamplingInterval(context.Context, *profiler.SetSamplingIntervalArgs) error
+
+	// Command Start
+	Start(context.Context) error
+
+	// Command StartPreciseCoverage
+	//
+	// Enable precise code coverage. Coverage data for JavaScript executed
+	// before enabling precise code coverage may be incomplete. Enabling
+	// prevents running optimized code and resets execution counters.
+	StartPreciseCoverage(context.Context, *profiler.StartPreciseCoverageArgs) (*profiler.StartPreciseCoverageReply, error)
+
+	// Command Stop
+	Stop(context.Context) (*profiler.StopReply, error)
+
+	// Command StopPreciseCoverage
+	//
+	// Disable precise code coverage. Disabling releases unnecessary
+	// execution count records and allows executing optimized code.
+	StopPreciseCoverage(context.Context) error
+
+	// Command TakePreciseCoverage
+	//
+	// Collect coverage data for the current isolate, and resets execution
+	// counters. Precise code coverage needs to have started.
+	TakePreciseCoverage(context.Context) (*profiler.TakePreciseCoverageReply, error)
+
+	// Event ConsoleProfileFinished
+	ConsoleProfileFinished(context.Context) (profiler.ConsoleProfileFinishedClient, error)
+
+	// Event ConsoleProfileStarted
+	//
+	// Sent when new profile recording is started using console.profile()
+	// call.
+	ConsoleProfileStarted(context.Context) (profiler.ConsoleProfileStartedClient, error)
+}
+
+// The Runtime domain. Runtime domain exposes JavaScript runtime by means of
+// remote evaluation and mirror objects. Evaluation results are returned as
+// mirror object that expose object type, string representation and unique
+// identifier that can be used for further object reference. Original objects
+// are maintained in memory unless they are either explicitly released or are
+// released along with the other objects in their object group.
+type Runtime interface {
+	// Command AwaitPromise
+	//
+	// Add handler to promise with given promise object id.
+	AwaitPromise(context.Context, *runtime.AwaitPromiseArgs) (*runtime.AwaitPromiseReply, error)
+
+	// Command CallFunctionOn
+	//
+	// Calls function with given declaration on the given object. Object
+	// group of the result is inherited from the target object.
+	CallFunctionOn(context.Context, *runtime.CallFunctionOnArgs) (*runtime.CallFunctionOnReply, error)
+
+	// Command CompileScript
+	//
+	// Compiles expression.
+	CompileScript(context.Context, *runtime.CompileScriptArgs) (*runtime.CompileScriptReply, error)
+
+	// Command Disable
+	//
+	// Disables reporting of execution contexts creation.
+	Disable(context.Context) error
+
+	// Command DiscardConsoleEntries
+	//
+	// Discards collected exceptions and console API calls.
+	DiscardConsoleEntries(context.Context) error
+
+	// Command Enable
+	//
+	// Enables reporting of execution contexts creation by means of
+	// `executionContextCreated` event. When the reporting gets enabled the
+	// event will be sent immediately for each existing execution context.
+	Enable(context.Context) error
+
+	// Command Evaluate
+	//
+	// Evaluates expression on global object.
+	Evaluate(context.Context, *runtime.EvaluateArgs) (*runtime.EvaluateReply, error)
+
+	// Command GetProperties
+	//
+	// Returns properties of a given object. Object group of the result is
+	// inherited from the target object.
+	GetProperties(context.Context, *runtime.GetPropertiesArgs) (*runtime.GetPropertiesReply, error)
+
+	// Command GlobalLexicalScopeNames
+	//
+	// Returns all let, const and class variables from global scope.
+	GlobalLexicalScopeNames(context.Context, *runtime.GlobalLexicalScopeNamesArgs) (*runtime.GlobalLexicalScopeNamesReply, error)
+
+	// Command QueryObjects
+	QueryObjects(context.Context, *runtime.QueryObjectsArgs) (*runtime.QueryObjectsReply, error)
+
+	// Command ReleaseObject
+	//
+	// Releases remote object with given id.
+	ReleaseObject(context.Context, *runtime.ReleaseObjectArgs) error
+
+	// Command ReleaseObjectGroup
+	//
+	// Releases all remote objects that belong to a given group.
+	ReleaseObjectGroup(context.Context, *runtime.ReleaseObjectGroupArgs) error
+
+	// Command RunIfWaitingForDebugger
+	//
+	// Tells inspected instance to run if it was waiting for debugger to
+	// attach.
+	RunIfWaitingForDebugger(context.Context) error
+
+	// Command RunScript
+	//
+	// Runs script with given id in a given context.
+	RunScript(context.Context, *runtime.RunScriptArgs) (*runtime.RunScriptReply, error)
+
+	// Command AddBinding
+	//
+	// If executionContextId is empty, adds binding with the given name on
+	// the global objects of all inspected contexts, including those
+	// created later, bindings survive reloads. Binding function takes
+	// exactly one argument, this argument should be string, in case of any
+	// other input, function throws an exception. Each binding function
+	// call produces Runtime.bindingCalled notification.
+	AddBinding(context.Context, *runtime.AddBindingArgs) error
+
+	// Command RemoveBinding
+	//
+	// This method does not remove binding function from global object but
+	// unsubscribes current runtime agent from Runtime.bindingCalled
+	// notifications.
+	RemoveBinding(context.Context, *runtime.RemoveBindingArgs) error
+
+	// Event ConsoleAPICalled
+	//
+	// Issued when console API was called.
+	ConsoleAPICalled(context.Context) (runtime.ConsoleAPICalledClient, error)
+
+	// Event ExceptionRevoked
+	//
+	// Issued when unhandled exception was revoked.
+	ExceptionRevoked(context.Context) (runtime.ExceptionRevokedClient, error)
+
+	// Event ExceptionThrown
+	//
+	// Issued when exception was thrown and unhandled.
+	ExceptionThrown(context.Context) (runtime.ExceptionThrownClient, error)
+
+	// Event ExecutionContextCreated
+	//
+	// Issued when new execution context is created.
+	ExecutionContextCreated(context.Context) (runtime.ExecutionContextCreatedClient, error)
+
+	// Event ExecutionContextDestroyed
+	//
+	// Issued when execution context is destroyed.
+	ExecutionContextDestroyed(context.Context) (runtime.ExecutionContextDestroyedClient, error)
+
+	// Event ExecutionContextsCleared
+	//
+	// Issued when all executionContexts were cleared in browser
+	ExecutionContextsCleared(context.Context) (runtime.ExecutionContextsClearedClient, error)
+
+	// Event InspectRequested
+	//
+	// Issued when object should be inspected (for example, as a result of
+	// inspect() command line API call).
+	InspectRequested(context.Context) (runtime.InspectRequestedClient, error)
+}
+
+// The Security domain.
+type Security interface {
+	// Command Disable
+	//
+	// Disables tracking security state changes.
+	Disable(context.Context) error
+
+	// Command Enable
+	//
+	// Enables tracking security state changes.
+	Enable(context.Context) error
+
+	// Command SetIgnoreCertificateErrors
+	//
+	// Enable/disable whether all certificate errors should be ignored.
+	SetIgnoreCertificateErrors(context.Context, *security.SetIgnoreCertificateErrorsArgs) error
+}
+
+// The Target domain. Supports additional targets discovery and allows to
+// attach to them.
+type Target interface {
+	// Command ActivateTarget
+	//
+	// Activates (focuses) the target.
+	ActivateTarget(context.Context, *target.ActivateTargetArgs) error
+
+	// Command AttachToTarget
+	//
+	// Attaches to the target with given id.
+	AttachToTarget(context.Context, *target.AttachToTargetArgs) (*target.AttachToTargetReply, error)
+
+	// Command CloseTarget
+	//
+	// Closes the target. If the target is a page that gets closed too.
+	CloseTarget(context.Context, *target.CloseTargetArgs) (*target.CloseTargetReply, error)
+
+	// Command CreateBrowserContext
+	//
+	// Creates a new empty BrowserContext. Similar to an incognito profile
+	// but you can have more than one.
+	CreateBrowserContext(context.Context, *target.CreateBrowserContextArgs) (*target.CreateBrowserContextReply, error)
+
+	// Command GetBrowserContexts
+	//
+	// Returns all browser contexts created with
+	// `Target.createBrowserContext` method.
+	GetBrowserContexts(context.Context) (*target.GetBrowserContextsReply, error)
+
+	// Command CreateTarget
+	//
+	// Creates a new page.
+	CreateTarget(context.Context, *target.CreateTargetArgs) (*target.CreateTargetReply, error)
+
+	// Command DetachFromTarget
+	//
+	// Detaches session with given id.
+	DetachFromTarget(context.Context, *target.DetachFromTargetArgs) error
+
+	// Command DisposeBrowserContext
+	//
+	// Deletes a BrowserContext. All the belonging pages will be closed
+	// without calling their beforeunload hooks.
+	DisposeBrowserContext(context.Context, *target.DisposeBrowserContextArgs) error
+
+	// Command GetTargets
+	//
+	// Retrieves a list of available targets.
+	GetTargets(context.Context, *target.GetTargetsArgs) (*target.GetTargetsReply, error)
+
+	// Command SetAutoAttach
+	//
+	// Controls whether to automatically attach to new targets which are
+	// considered to be directly related to this one (for example, iframes
+	// or workers). When turned on, attaches to all existing related
+	// targets as well. When turned off, automatically detaches from all
+	// currently attached targets. This also clears all targets added by
+	// `autoAttachRelated` from the list of targets to watch for creation
+	// of related targets. You might want to call this recursively for
+	// auto-attached targets to attach to all available targets.
+	SetAutoAttach(context.Context, *target.SetAutoAttachArgs) error
+
+	// Command SetDiscoverTargets
+	//
+	// Controls whether to discover available targets and notify via
+	// `targetCreated/targetInfoChanged/targetDestroyed` events.
+	SetDiscoverTargets(context.Context, *target.SetDiscoverTargetsArgs) error
+
+	// Event ReceivedMessageFromTarget
+	//
+	// Notifies about a new protocol message received from the session (as
+	// reported in `attachedToTarget` event).
+	ReceivedMessageFromTarget(context.Context) (target.ReceivedMessageFromTargetClient, error)
+
+	// Event TargetCreated
+	//
+	// Issued when a possible inspection target is created.
+	TargetCreated(context.Context) (target.CreatedClient, error)
+
+	// Event TargetDestroyed
+	//
+	// Issued when a target is destroyed.
+	TargetDestroyed(context.Context) (target.DestroyedClient, error)
+
+	// Event TargetCrashed
+	//
+	// Issued when a target has crashed.
+	TargetCrashed(context.Context) (target.CrashedClient, error)
+
+	// Event TargetInfoChanged
+	//
+	// Issued when some information about a target has changed. This only
+	// happens between `targetCreated` and `targetDestroyed`.
+	TargetInfoChanged(context.Context) (target.InfoChangedClient, error)
+}
+
+// The Tracing domain.
+type Tracing interface {
+	// Command End
+	//
+	// Stop trace events collection.
+	End(context.Context) error
+
+	// Command Start
+	//
+	// Start trace events collection.
+	Start(context.Context, *tracing.StartArgs) error
+
+	// Event TracingComplete
+	//
+	// Signals that tracing is stopped and there is no trace buffers
+	// pending flush, all data were delivered via dataCollected events.
+	TracingComplete(context.Context) (tracing.CompleteClient, error)
+}
@@ -0,0 +1,57 @@
+package stable
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mafredri/cdp"
+)
+
+func TestStableSubset(t *testing.T) {
+	tests := []struct {
+		stable, cdp reflect.Type
+		omitted     []string
+	}{
+		{
+			stable:  reflect.TypeOf((*Page)(nil)).Elem(),
+			cdp:     reflect.TypeOf((*cdp.Page)(nil)).Elem(),
+			omitted: []string{"StartScreencast", "ScreencastFrame"}, // Experimental.
+		},
+		{
+			stable:  reflect.TypeOf((*Target)(nil)).Elem(),
+			cdp:     reflect.TypeOf((*cdp.Target)(nil)).Elem(),
+			omitted: []string{"SendMessageToTarget"}, // Deprecated.
+		},
+	}
+	for _, tt := range tests {
+		if !tt.cdp.Implements(tt.stable) {
+			t.Errorf("%v does not implement %v", tt.cdp, tt.stable)
+		}
+		if _, ok := tt.stable.MethodByName("Navigate"); tt.stable.Name() == "Page" && !ok {
+			t.Errorf("%v: missing stable method Navigate", tt.stable)
+		}
+		for _, m := range tt.omitted {
+			if _, ok := tt.stable.MethodByName(m); ok {
+				t.Errorf("%v: got method %s, want omitted", tt.stable, m)
+			}
+			if _, ok := tt.cdp.MethodByName(m); !ok {
+				t.Errorf("%v: missing method %s", tt.cdp, m)
+			}
+		}
+	}
+
+	if _, ok := reflect.TypeOf(Client{}).FieldByName("Animation"); ok {
+		t.Error("Client: got experimental domain Animation, want omitted")
+	}
+}
+
+func TestFromClient(t *testing.T) {
+	c := cdp.NewClient(nil)
+	sc := FromClient(c)
+	if sc.Page != c.Page || sc.Target != c.Target {
+		t.Error("FromClient: domains do not match")
+	}
+	if sc.Conn() != c.Conn() {
+		t.Error("FromClient: conn does not match")
+	}
+}
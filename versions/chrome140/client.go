@@ -0,0 +1,189 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package chrome140
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/accessibility"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/animation"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/audits"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/autofill"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/backgroundservice"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/bluetoothemulation"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/browser"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/cachestorage"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/cast"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/console"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/css"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/debugger"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/deviceaccess"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/deviceorientation"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/domdebugger"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/domsnapshot"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/domstorage"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/emulation"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/eventbreakpoints"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/extensions"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/fedcm"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/fetch"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/filesystem"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/headlessexperimental"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/heapprofiler"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/indexeddb"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/input"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/inspector"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/io"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/layertree"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/log"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/media"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/memory"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/overlay"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/performance"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/performancetimeline"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/preload"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/profiler"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/pwa"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/schema"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/security"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/serviceworker"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/storage"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/systeminfo"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/target"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/tethering"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/tracing"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/webaudio"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/webauthn"
+)
+
+// Client represents a Chrome DevTools Protocol client that can be used to
+// invoke methods or listen to events in every CDP domain. The Client consumes
+// a rpcc connection, used to invoke the methods.
+type Client struct {
+	Accessibility        Accessibility
+	Animation            Animation
+	Audits               Audits
+	Autofill             Autofill
+	BackgroundService    BackgroundService
+	BluetoothEmulation   BluetoothEmulation
+	Browser              Browser
+	CSS                  CSS
+	CacheStorage         CacheStorage
+	Cast                 Cast
+	Console              Console
+	DOM                  DOM
+	DOMDebugger          DOMDebugger
+	DOMSnapshot          DOMSnapshot
+	DOMStorage           DOMStorage
+	Debugger             Debugger
+	DeviceAccess         DeviceAccess
+	DeviceOrientation    DeviceOrientation
+	Emulation            Emulation
+	EventBreakpoints     EventBreakpoints
+	Extensions           Extensions
+	FedCM                FedCM
+	Fetch                Fetch
+	FileSystem           FileSystem
+	HeadlessExperimental HeadlessExperimental
+	HeapProfiler         HeapProfiler
+	IO                   IO
+	IndexedDB            IndexedDB
+	Input                Input
+	Inspector            Inspector
+	LayerTree            LayerTree
+	Log                  Log
+	Media                Media
+	Memory               Memory
+	Network              Network
+	Overlay              Overlay
+	PWA                  PWA
+	Page                 Page
+	Performance          Performance
+	PerformanceTimeline  PerformanceTimeline
+	Preload              Preload
+	Profiler             Profiler
+	Runtime              Runtime
+	Schema               Schema
+	Security             Security
+	ServiceWorker        ServiceWorker
+	Storage              Storage
+	SystemInfo           SystemInfo
+	Target               Target
+	Tethering            Tethering
+	Tracing              Tracing
+	WebAudio             WebAudio
+	WebAuthn             WebAuthn
+
+	conn *rpcc.Conn
+}
+
+// NewClient returns a new Client that uses conn
+// for communication with the debugging target.
+func NewClient(conn *rpcc.Conn) *Client {
+	return &Client{
+		Accessibility:        accessibility.NewClient(conn),
+		Animation:            animation.NewClient(conn),
+		Audits:               audits.NewClient(conn),
+		Autofill:             autofill.NewClient(conn),
+		BackgroundService:    backgroundservice.NewClient(conn),
+		BluetoothEmulation:   bluetoothemulation.NewClient(conn),
+		Browser:              browser.NewClient(conn),
+		CSS:                  css.NewClient(conn),
+		CacheStorage:         cachestorage.NewClient(conn),
+		Cast:                 cast.NewClient(conn),
+		Console:              console.NewClient(conn),
+		DOM:                  dom.NewClient(conn),
+		DOMDebugger:          domdebugger.NewClient(conn),
+		DOMSnapshot:          domsnapshot.NewClient(conn),
+		DOMStorage:           domstorage.NewClient(conn),
+		Debugger:             debugger.NewClient(conn),
+		DeviceAccess:         deviceaccess.NewClient(conn),
+		DeviceOrientation:    deviceorientation.NewClient(conn),
+		Emulation:            emulation.NewClient(conn),
+		EventBreakpoints:     eventbreakpoints.NewClient(conn),
+		Extensions:           extensions.NewClient(conn),
+		FedCM:                fedcm.NewClient(conn),
+		Fetch:                fetch.NewClient(conn),
+		FileSystem:           filesystem.NewClient(conn),
+		HeadlessExperimental: headlessexperimental.NewClient(conn),
+		HeapProfiler:         heapprofiler.NewClient(conn),
+		IO:                   io.NewClient(conn),
+		IndexedDB:            indexeddb.NewClient(conn),
+		Input:                input.NewClient(conn),
+		Inspector:            inspector.NewClient(conn),
+		LayerTree:            layertree.NewClient(conn),
+		Log:                  log.NewClient(conn),
+		Media:                media.NewClient(conn),
+		Memory:               memory.NewClient(conn),
+		Network:              network.NewClient(conn),
+		Overlay:              overlay.NewClient(conn),
+		PWA:                  pwa.NewClient(conn),
+		Page:                 page.NewClient(conn),
+		Performance:          performance.NewClient(conn),
+		PerformanceTimeline:  performancetimeline.NewClient(conn),
+		Preload:              preload.NewClient(conn),
+		Profiler:             profiler.NewClient(conn),
+		Runtime:              runtime.NewClient(conn),
+		Schema:               schema.NewClient(conn),
+		Security:             security.NewClient(conn),
+		ServiceWorker:        serviceworker.NewClient(conn),
+		Storage:              storage.NewClient(conn),
+		SystemInfo:           systeminfo.NewClient(conn),
+		Target:               target.NewClient(conn),
+		Tethering:            tethering.NewClient(conn),
+		Tracing:              tracing.NewClient(conn),
+		WebAudio:             webaudio.NewClient(conn),
+		WebAuthn:             webauthn.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the rpcc connection used by the Client. Conn returns nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn {
+	return c.conn
+}
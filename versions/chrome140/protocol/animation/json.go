@@ -0,0 +1,699 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package animation
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Animation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("pausedState")
+	w.Bool(v.PausedState)
+	w.Key("playState")
+	w.String(v.PlayState)
+	w.Key("playbackRate")
+	w.Float(v.PlaybackRate)
+	w.Key("startTime")
+	w.Float(v.StartTime)
+	w.Key("currentTime")
+	w.Float(v.CurrentTime)
+	w.Key("type")
+	w.String(v.Type)
+	if v.Source != nil {
+		w.Key("source")
+		v.Source.EncodeJSON(w)
+	}
+	if v.CSSID != nil {
+		w.Key("cssId")
+		w.String(*v.CSSID)
+	}
+	if v.ViewOrScrollTimeline != nil {
+		w.Key("viewOrScrollTimeline")
+		v.ViewOrScrollTimeline.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Animation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		case "name":
+			v.Name = r.String()
+		case "pausedState":
+			v.PausedState = r.Bool()
+		case "playState":
+			v.PlayState = r.String()
+		case "playbackRate":
+			v.PlaybackRate = r.Float()
+		case "startTime":
+			v.StartTime = r.Float()
+		case "currentTime":
+			v.CurrentTime = r.Float()
+		case "type":
+			v.Type = r.String()
+		case "source":
+			if r.Null() {
+				v.Source = nil
+			} else {
+				var p Effect
+				p.DecodeJSON(r)
+				v.Source = &p
+			}
+		case "cssId":
+			if r.Null() {
+				v.CSSID = nil
+			} else {
+				p := r.String()
+				v.CSSID = &p
+			}
+		case "viewOrScrollTimeline":
+			if r.Null() {
+				v.ViewOrScrollTimeline = nil
+			} else {
+				var p ViewOrScrollTimeline
+				p.DecodeJSON(r)
+				v.ViewOrScrollTimeline = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ViewOrScrollTimeline) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SourceNodeID != nil {
+		w.Key("sourceNodeId")
+		w.Int(int(*v.SourceNodeID))
+	}
+	if v.StartOffset != nil {
+		w.Key("startOffset")
+		w.Float(*v.StartOffset)
+	}
+	if v.EndOffset != nil {
+		w.Key("endOffset")
+		w.Float(*v.EndOffset)
+	}
+	if v.SubjectNodeID != nil {
+		w.Key("subjectNodeId")
+		w.Int(int(*v.SubjectNodeID))
+	}
+	w.Key("axis")
+	w.String(string(v.Axis))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ViewOrScrollTimeline) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceNodeId":
+			if r.Null() {
+				v.SourceNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.SourceNodeID = &p
+			}
+		case "startOffset":
+			if r.Null() {
+				v.StartOffset = nil
+			} else {
+				p := r.Float()
+				v.StartOffset = &p
+			}
+		case "endOffset":
+			if r.Null() {
+				v.EndOffset = nil
+			} else {
+				p := r.Float()
+				v.EndOffset = &p
+			}
+		case "subjectNodeId":
+			if r.Null() {
+				v.SubjectNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.SubjectNodeID = &p
+			}
+		case "axis":
+			v.Axis = dom.ScrollOrientation(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Effect) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("delay")
+	w.Float(v.Delay)
+	w.Key("endDelay")
+	w.Float(v.EndDelay)
+	w.Key("iterationStart")
+	w.Float(v.IterationStart)
+	w.Key("iterations")
+	w.Float(v.Iterations)
+	w.Key("duration")
+	w.Float(v.Duration)
+	w.Key("direction")
+	w.String(v.Direction)
+	w.Key("fill")
+	w.String(v.Fill)
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.KeyframesRule != nil {
+		w.Key("keyframesRule")
+		v.KeyframesRule.EncodeJSON(w)
+	}
+	w.Key("easing")
+	w.String(v.Easing)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Effect) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "delay":
+			v.Delay = r.Float()
+		case "endDelay":
+			v.EndDelay = r.Float()
+		case "iterationStart":
+			v.IterationStart = r.Float()
+		case "iterations":
+			v.Iterations = r.Float()
+		case "duration":
+			v.Duration = r.Float()
+		case "direction":
+			v.Direction = r.String()
+		case "fill":
+			v.Fill = r.String()
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "keyframesRule":
+			if r.Null() {
+				v.KeyframesRule = nil
+			} else {
+				var p KeyframesRule
+				p.DecodeJSON(r)
+				v.KeyframesRule = &p
+			}
+		case "easing":
+			v.Easing = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *KeyframesRule) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	w.Key("keyframes")
+	if v.Keyframes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Keyframes {
+			v.Keyframes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *KeyframesRule) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "keyframes":
+			if r.ArrayStart() {
+				v.Keyframes = make([]KeyframeStyle, 0, 4)
+				for r.ArrayNext() {
+					var e0 KeyframeStyle
+					e0.DecodeJSON(r)
+					v.Keyframes = append(v.Keyframes, e0)
+				}
+			} else {
+				v.Keyframes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *KeyframeStyle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("offset")
+	w.String(v.Offset)
+	w.Key("easing")
+	w.String(v.Easing)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *KeyframeStyle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "offset":
+			v.Offset = r.String()
+		case "easing":
+			v.Easing = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCurrentTimeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCurrentTimeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetCurrentTimeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("currentTime")
+	w.Float(v.CurrentTime)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetCurrentTimeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "currentTime":
+			v.CurrentTime = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPlaybackRateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playbackRate")
+	w.Float(v.PlaybackRate)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPlaybackRateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playbackRate":
+			v.PlaybackRate = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReleaseAnimationsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animations")
+	if v.Animations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Animations {
+			w.String(v.Animations[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReleaseAnimationsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animations":
+			if r.ArrayStart() {
+				v.Animations = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Animations = append(v.Animations, e0)
+				}
+			} else {
+				v.Animations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveAnimationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animationId")
+	w.String(v.AnimationID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveAnimationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animationId":
+			v.AnimationID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveAnimationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("remoteObject")
+	v.RemoteObject.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveAnimationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "remoteObject":
+			v.RemoteObject.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SeekAnimationsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animations")
+	if v.Animations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Animations {
+			w.String(v.Animations[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("currentTime")
+	w.Float(v.CurrentTime)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SeekAnimationsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animations":
+			if r.ArrayStart() {
+				v.Animations = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Animations = append(v.Animations, e0)
+				}
+			} else {
+				v.Animations = nil
+			}
+		case "currentTime":
+			v.CurrentTime = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPausedArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animations")
+	if v.Animations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Animations {
+			w.String(v.Animations[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("paused")
+	w.Bool(v.Paused)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPausedArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animations":
+			if r.ArrayStart() {
+				v.Animations = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Animations = append(v.Animations, e0)
+				}
+			} else {
+				v.Animations = nil
+			}
+		case "paused":
+			v.Paused = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPlaybackRateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("playbackRate")
+	w.Float(v.PlaybackRate)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPlaybackRateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "playbackRate":
+			v.PlaybackRate = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetTimingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animationId")
+	w.String(v.AnimationID)
+	w.Key("duration")
+	w.Float(v.Duration)
+	w.Key("delay")
+	w.Float(v.Delay)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetTimingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animationId":
+			v.AnimationID = r.String()
+		case "duration":
+			v.Duration = r.Float()
+		case "delay":
+			v.Delay = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CanceledReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CanceledReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(v.ID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animation")
+	v.Animation.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animation":
+			v.Animation.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("animation")
+	v.Animation.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "animation":
+			v.Animation.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
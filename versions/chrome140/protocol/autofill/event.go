@@ -0,0 +1,22 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// AddressFormFilledClient is a client for AddressFormFilled events. Emitted
+// when an address form is filled.
+type AddressFormFilledClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*AddressFormFilledReply, error)
+	rpcc.Stream
+}
+
+// AddressFormFilledReply is the reply for AddressFormFilled events.
+type AddressFormFilledReply struct {
+	FilledFields []FilledField `json:"filledFields"` // Information about the fields that were filled
+	AddressUI    AddressUI     `json:"addressUi"`    // An UI representation of the address used to fill the form. Consists of a 2D array where each child represents an address/profile line.
+}
@@ -0,0 +1,29 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+)
+
+// TriggerOption sets an optional argument of TriggerArgs.
+type TriggerOption func(*TriggerArgs)
+
+// NewTriggerArgsWith initializes TriggerArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewTriggerArgsWith(fieldID dom.BackendNodeID, card CreditCard, opts ...TriggerOption) *TriggerArgs {
+	args := NewTriggerArgs(fieldID, card)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// TriggerWithFrameID sets the FrameID optional argument, see
+// TriggerArgs.SetFrameID.
+func TriggerWithFrameID(v page.FrameID) TriggerOption {
+	return func(a *TriggerArgs) { a.SetFrameID(v) }
+}
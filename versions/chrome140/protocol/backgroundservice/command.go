@@ -0,0 +1,94 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package backgroundservice
+
+import (
+	"errors"
+	"strconv"
+)
+
+// StartObservingArgs represents the arguments for StartObserving in the BackgroundService domain.
+type StartObservingArgs struct {
+	Service ServiceName `json:"service"` // No description.
+}
+
+// NewStartObservingArgs initializes StartObservingArgs with the required arguments.
+func NewStartObservingArgs(service ServiceName) *StartObservingArgs {
+	args := new(StartObservingArgs)
+	args.Service = service
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartObservingArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.StartObservingArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
+
+// StopObservingArgs represents the arguments for StopObserving in the BackgroundService domain.
+type StopObservingArgs struct {
+	Service ServiceName `json:"service"` // No description.
+}
+
+// NewStopObservingArgs initializes StopObservingArgs with the required arguments.
+func NewStopObservingArgs(service ServiceName) *StopObservingArgs {
+	args := new(StopObservingArgs)
+	args.Service = service
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopObservingArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.StopObservingArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
+
+// SetRecordingArgs represents the arguments for SetRecording in the BackgroundService domain.
+type SetRecordingArgs struct {
+	ShouldRecord bool        `json:"shouldRecord"` // No description.
+	Service      ServiceName `json:"service"`      // No description.
+}
+
+// NewSetRecordingArgs initializes SetRecordingArgs with the required arguments.
+func NewSetRecordingArgs(shouldRecord bool, service ServiceName) *SetRecordingArgs {
+	args := new(SetRecordingArgs)
+	args.ShouldRecord = shouldRecord
+	args.Service = service
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRecordingArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.SetRecordingArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
+
+// ClearEventsArgs represents the arguments for ClearEvents in the BackgroundService domain.
+type ClearEventsArgs struct {
+	Service ServiceName `json:"service"` // No description.
+}
+
+// NewClearEventsArgs initializes ClearEventsArgs with the required arguments.
+func NewClearEventsArgs(service ServiceName) *ClearEventsArgs {
+	args := new(ClearEventsArgs)
+	args.Service = service
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ClearEventsArgs) Validate() error {
+	if !a.Service.Valid() {
+		return errors.New("backgroundservice.ClearEventsArgs: Service: invalid value " + strconv.Quote(string(a.Service)))
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package backgroundservice
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/serviceworker"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EventMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EventMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Event) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("serviceWorkerRegistrationId")
+	w.String(string(v.ServiceWorkerRegistrationID))
+	w.Key("service")
+	w.String(string(v.Service))
+	w.Key("eventName")
+	w.String(v.EventName)
+	w.Key("instanceId")
+	w.String(v.InstanceID)
+	w.Key("eventMetadata")
+	if v.EventMetadata == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.EventMetadata {
+			v.EventMetadata[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("storageKey")
+	w.String(v.StorageKey)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Event) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		case "origin":
+			v.Origin = r.String()
+		case "serviceWorkerRegistrationId":
+			v.ServiceWorkerRegistrationID = serviceworker.RegistrationID(r.String())
+		case "service":
+			v.Service = ServiceName(r.String())
+		case "eventName":
+			v.EventName = r.String()
+		case "instanceId":
+			v.InstanceID = r.String()
+		case "eventMetadata":
+			if r.ArrayStart() {
+				v.EventMetadata = make([]EventMetadata, 0, 4)
+				for r.ArrayNext() {
+					var e0 EventMetadata
+					e0.DecodeJSON(r)
+					v.EventMetadata = append(v.EventMetadata, e0)
+				}
+			} else {
+				v.EventMetadata = nil
+			}
+		case "storageKey":
+			v.StorageKey = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartObservingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("service")
+	w.String(string(v.Service))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartObservingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "service":
+			v.Service = ServiceName(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopObservingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("service")
+	w.String(string(v.Service))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopObservingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "service":
+			v.Service = ServiceName(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetRecordingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("shouldRecord")
+	w.Bool(v.ShouldRecord)
+	w.Key("service")
+	w.String(string(v.Service))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetRecordingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "shouldRecord":
+			v.ShouldRecord = r.Bool()
+		case "service":
+			v.Service = ServiceName(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearEventsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("service")
+	w.String(string(v.Service))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearEventsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "service":
+			v.Service = ServiceName(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RecordingStateChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isRecording")
+	w.Bool(v.IsRecording)
+	w.Key("service")
+	w.String(string(v.Service))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RecordingStateChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isRecording":
+			v.IsRecording = r.Bool()
+		case "service":
+			v.Service = ServiceName(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EventReceivedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backgroundServiceEvent")
+	v.BackgroundServiceEvent.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EventReceivedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backgroundServiceEvent":
+			v.BackgroundServiceEvent.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
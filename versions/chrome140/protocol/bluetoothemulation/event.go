@@ -0,0 +1,60 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package bluetoothemulation
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// GattOperationReceivedClient is a client for GattOperationReceived events.
+// Event for when a GATT operation of |type| to the peripheral with |address|
+// happened.
+type GattOperationReceivedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*GattOperationReceivedReply, error)
+	rpcc.Stream
+}
+
+// GattOperationReceivedReply is the reply for GattOperationReceived events.
+type GattOperationReceivedReply struct {
+	Address string            `json:"address"` // No description.
+	Type    GATTOperationType `json:"type"`    // No description.
+}
+
+// CharacteristicOperationReceivedClient is a client for CharacteristicOperationReceived events.
+// Event for when a characteristic operation of |type| to the characteristic
+// respresented by |characteristicId| happened. |data| and |writeType| is
+// expected to exist when |type| is write.
+type CharacteristicOperationReceivedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*CharacteristicOperationReceivedReply, error)
+	rpcc.Stream
+}
+
+// CharacteristicOperationReceivedReply is the reply for CharacteristicOperationReceived events.
+type CharacteristicOperationReceivedReply struct {
+	CharacteristicID string                      `json:"characteristicId"`    // No description.
+	Type             CharacteristicOperationType `json:"type"`                // No description.
+	Data             *string                     `json:"data,omitempty"`      // No description.
+	WriteType        CharacteristicWriteType     `json:"writeType,omitempty"` // No description.
+}
+
+// DescriptorOperationReceivedClient is a client for DescriptorOperationReceived events.
+// Event for when a descriptor operation of |type| to the descriptor
+// respresented by |descriptorId| happened. |data| is expected to exist when
+// |type| is write.
+type DescriptorOperationReceivedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DescriptorOperationReceivedReply, error)
+	rpcc.Stream
+}
+
+// DescriptorOperationReceivedReply is the reply for DescriptorOperationReceived events.
+type DescriptorOperationReceivedReply struct {
+	DescriptorID string                  `json:"descriptorId"`   // No description.
+	Type         DescriptorOperationType `json:"type"`           // No description.
+	Data         *string                 `json:"data,omitempty"` // No description.
+}
@@ -0,0 +1,45 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package bluetoothemulation
+
+// SimulateCharacteristicOperationResponseOption sets an optional argument of SimulateCharacteristicOperationResponseArgs.
+type SimulateCharacteristicOperationResponseOption func(*SimulateCharacteristicOperationResponseArgs)
+
+// NewSimulateCharacteristicOperationResponseArgsWith initializes SimulateCharacteristicOperationResponseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSimulateCharacteristicOperationResponseArgsWith(characteristicID string, typ CharacteristicOperationType, code int, opts ...SimulateCharacteristicOperationResponseOption) *SimulateCharacteristicOperationResponseArgs {
+	args := NewSimulateCharacteristicOperationResponseArgs(characteristicID, typ, code)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SimulateCharacteristicOperationResponseWithData sets the Data optional argument, see
+// SimulateCharacteristicOperationResponseArgs.SetData.
+func SimulateCharacteristicOperationResponseWithData(v string) SimulateCharacteristicOperationResponseOption {
+	return func(a *SimulateCharacteristicOperationResponseArgs) { a.SetData(v) }
+}
+
+// SimulateDescriptorOperationResponseOption sets an optional argument of SimulateDescriptorOperationResponseArgs.
+type SimulateDescriptorOperationResponseOption func(*SimulateDescriptorOperationResponseArgs)
+
+// NewSimulateDescriptorOperationResponseArgsWith initializes SimulateDescriptorOperationResponseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSimulateDescriptorOperationResponseArgsWith(descriptorID string, typ DescriptorOperationType, code int, opts ...SimulateDescriptorOperationResponseOption) *SimulateDescriptorOperationResponseArgs {
+	args := NewSimulateDescriptorOperationResponseArgs(descriptorID, typ, code)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SimulateDescriptorOperationResponseWithData sets the Data optional argument, see
+// SimulateDescriptorOperationResponseArgs.SetData.
+func SimulateDescriptorOperationResponseWithData(v string) SimulateDescriptorOperationResponseOption {
+	return func(a *SimulateDescriptorOperationResponseArgs) { a.SetData(v) }
+}
@@ -0,0 +1,1065 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/target"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Bounds) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Left != nil {
+		w.Key("left")
+		w.Int(*v.Left)
+	}
+	if v.Top != nil {
+		w.Key("top")
+		w.Int(*v.Top)
+	}
+	if v.Width != nil {
+		w.Key("width")
+		w.Int(*v.Width)
+	}
+	if v.Height != nil {
+		w.Key("height")
+		w.Int(*v.Height)
+	}
+	if v.WindowState != "" {
+		w.Key("windowState")
+		w.String(string(v.WindowState))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Bounds) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "left":
+			if r.Null() {
+				v.Left = nil
+			} else {
+				p := r.Int()
+				v.Left = &p
+			}
+		case "top":
+			if r.Null() {
+				v.Top = nil
+			} else {
+				p := r.Int()
+				v.Top = &p
+			}
+		case "width":
+			if r.Null() {
+				v.Width = nil
+			} else {
+				p := r.Int()
+				v.Width = &p
+			}
+		case "height":
+			if r.Null() {
+				v.Height = nil
+			} else {
+				p := r.Int()
+				v.Height = &p
+			}
+		case "windowState":
+			v.WindowState = WindowState(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PermissionDescriptor) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.Sysex != nil {
+		w.Key("sysex")
+		w.Bool(*v.Sysex)
+	}
+	if v.UserVisibleOnly != nil {
+		w.Key("userVisibleOnly")
+		w.Bool(*v.UserVisibleOnly)
+	}
+	if v.AllowWithoutSanitization != nil {
+		w.Key("allowWithoutSanitization")
+		w.Bool(*v.AllowWithoutSanitization)
+	}
+	if v.AllowWithoutGesture != nil {
+		w.Key("allowWithoutGesture")
+		w.Bool(*v.AllowWithoutGesture)
+	}
+	if v.PanTiltZoom != nil {
+		w.Key("panTiltZoom")
+		w.Bool(*v.PanTiltZoom)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PermissionDescriptor) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "sysex":
+			if r.Null() {
+				v.Sysex = nil
+			} else {
+				p := r.Bool()
+				v.Sysex = &p
+			}
+		case "userVisibleOnly":
+			if r.Null() {
+				v.UserVisibleOnly = nil
+			} else {
+				p := r.Bool()
+				v.UserVisibleOnly = &p
+			}
+		case "allowWithoutSanitization":
+			if r.Null() {
+				v.AllowWithoutSanitization = nil
+			} else {
+				p := r.Bool()
+				v.AllowWithoutSanitization = &p
+			}
+		case "allowWithoutGesture":
+			if r.Null() {
+				v.AllowWithoutGesture = nil
+			} else {
+				p := r.Bool()
+				v.AllowWithoutGesture = &p
+			}
+		case "panTiltZoom":
+			if r.Null() {
+				v.PanTiltZoom = nil
+			} else {
+				p := r.Bool()
+				v.PanTiltZoom = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Bucket) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("low")
+	w.Int(v.Low)
+	w.Key("high")
+	w.Int(v.High)
+	w.Key("count")
+	w.Int(v.Count)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Bucket) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "low":
+			v.Low = r.Int()
+		case "high":
+			v.High = r.Int()
+		case "count":
+			v.Count = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Histogram) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("sum")
+	w.Int(v.Sum)
+	w.Key("count")
+	w.Int(v.Count)
+	w.Key("buckets")
+	if v.Buckets == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Buckets {
+			v.Buckets[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Histogram) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "sum":
+			v.Sum = r.Int()
+		case "count":
+			v.Count = r.Int()
+		case "buckets":
+			if r.ArrayStart() {
+				v.Buckets = make([]Bucket, 0, 4)
+				for r.ArrayNext() {
+					var e0 Bucket
+					e0.DecodeJSON(r)
+					v.Buckets = append(v.Buckets, e0)
+				}
+			} else {
+				v.Buckets = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPermissionArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("permission")
+	v.Permission.EncodeJSON(w)
+	w.Key("setting")
+	w.String(string(v.Setting))
+	if v.Origin != nil {
+		w.Key("origin")
+		w.String(*v.Origin)
+	}
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPermissionArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "permission":
+			v.Permission.DecodeJSON(r)
+		case "setting":
+			v.Setting = PermissionSetting(r.String())
+		case "origin":
+			if r.Null() {
+				v.Origin = nil
+			} else {
+				p := r.String()
+				v.Origin = &p
+			}
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GrantPermissionsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("permissions")
+	if v.Permissions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Permissions {
+			w.String(string(v.Permissions[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if v.Origin != nil {
+		w.Key("origin")
+		w.String(*v.Origin)
+	}
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GrantPermissionsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "permissions":
+			if r.ArrayStart() {
+				v.Permissions = make([]PermissionType, 0, 4)
+				for r.ArrayNext() {
+					e0 := PermissionType(r.String())
+					v.Permissions = append(v.Permissions, e0)
+				}
+			} else {
+				v.Permissions = nil
+			}
+		case "origin":
+			if r.Null() {
+				v.Origin = nil
+			} else {
+				p := r.String()
+				v.Origin = &p
+			}
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResetPermissionsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResetPermissionsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDownloadBehaviorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("behavior")
+	w.String(v.Behavior)
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	if v.DownloadPath != nil {
+		w.Key("downloadPath")
+		w.String(*v.DownloadPath)
+	}
+	if v.EventsEnabled != nil {
+		w.Key("eventsEnabled")
+		w.Bool(*v.EventsEnabled)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDownloadBehaviorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "behavior":
+			v.Behavior = r.String()
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		case "downloadPath":
+			if r.Null() {
+				v.DownloadPath = nil
+			} else {
+				p := r.String()
+				v.DownloadPath = &p
+			}
+		case "eventsEnabled":
+			if r.Null() {
+				v.EventsEnabled = nil
+			} else {
+				p := r.Bool()
+				v.EventsEnabled = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CancelDownloadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("guid")
+	w.String(v.GUID)
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CancelDownloadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "guid":
+			v.GUID = r.String()
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetVersionReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("protocolVersion")
+	w.String(v.ProtocolVersion)
+	w.Key("product")
+	w.String(v.Product)
+	w.Key("revision")
+	w.String(v.Revision)
+	w.Key("userAgent")
+	w.String(v.UserAgent)
+	w.Key("jsVersion")
+	w.String(v.JSVersion)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetVersionReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "protocolVersion":
+			v.ProtocolVersion = r.String()
+		case "product":
+			v.Product = r.String()
+		case "revision":
+			v.Revision = r.String()
+		case "userAgent":
+			v.UserAgent = r.String()
+		case "jsVersion":
+			v.JSVersion = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBrowserCommandLineReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("arguments")
+	if v.Arguments == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Arguments {
+			w.String(v.Arguments[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBrowserCommandLineReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "arguments":
+			if r.ArrayStart() {
+				v.Arguments = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Arguments = append(v.Arguments, e0)
+				}
+			} else {
+				v.Arguments = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHistogramsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Query != nil {
+		w.Key("query")
+		w.String(*v.Query)
+	}
+	if v.Delta != nil {
+		w.Key("delta")
+		w.Bool(*v.Delta)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHistogramsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "query":
+			if r.Null() {
+				v.Query = nil
+			} else {
+				p := r.String()
+				v.Query = &p
+			}
+		case "delta":
+			if r.Null() {
+				v.Delta = nil
+			} else {
+				p := r.Bool()
+				v.Delta = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHistogramsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("histograms")
+	if v.Histograms == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Histograms {
+			v.Histograms[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHistogramsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "histograms":
+			if r.ArrayStart() {
+				v.Histograms = make([]Histogram, 0, 4)
+				for r.ArrayNext() {
+					var e0 Histogram
+					e0.DecodeJSON(r)
+					v.Histograms = append(v.Histograms, e0)
+				}
+			} else {
+				v.Histograms = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHistogramArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	if v.Delta != nil {
+		w.Key("delta")
+		w.Bool(*v.Delta)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHistogramArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "delta":
+			if r.Null() {
+				v.Delta = nil
+			} else {
+				p := r.Bool()
+				v.Delta = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHistogramReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("histogram")
+	v.Histogram.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHistogramReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "histogram":
+			v.Histogram.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetWindowBoundsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("windowId")
+	w.Int(int(v.WindowID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetWindowBoundsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "windowId":
+			v.WindowID = WindowID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetWindowBoundsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bounds")
+	v.Bounds.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetWindowBoundsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bounds":
+			v.Bounds.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetWindowForTargetArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetWindowForTargetArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := target.ID(r.String())
+				v.TargetID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetWindowForTargetReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("windowId")
+	w.Int(int(v.WindowID))
+	w.Key("bounds")
+	v.Bounds.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetWindowForTargetReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "windowId":
+			v.WindowID = WindowID(r.Int())
+		case "bounds":
+			v.Bounds.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetWindowBoundsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("windowId")
+	w.Int(int(v.WindowID))
+	w.Key("bounds")
+	v.Bounds.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetWindowBoundsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "windowId":
+			v.WindowID = WindowID(r.Int())
+		case "bounds":
+			v.Bounds.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetContentsSizeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("windowId")
+	w.Int(int(v.WindowID))
+	if v.Width != nil {
+		w.Key("width")
+		w.Int(*v.Width)
+	}
+	if v.Height != nil {
+		w.Key("height")
+		w.Int(*v.Height)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetContentsSizeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "windowId":
+			v.WindowID = WindowID(r.Int())
+		case "width":
+			if r.Null() {
+				v.Width = nil
+			} else {
+				p := r.Int()
+				v.Width = &p
+			}
+		case "height":
+			if r.Null() {
+				v.Height = nil
+			} else {
+				p := r.Int()
+				v.Height = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDockTileArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BadgeLabel != nil {
+		w.Key("badgeLabel")
+		w.String(*v.BadgeLabel)
+	}
+	if len(v.Image) > 0 {
+		w.Key("image")
+		w.Base64(v.Image)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDockTileArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "badgeLabel":
+			if r.Null() {
+				v.BadgeLabel = nil
+			} else {
+				p := r.String()
+				v.BadgeLabel = &p
+			}
+		case "image":
+			v.Image = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ExecuteBrowserCommandArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("commandId")
+	w.String(string(v.CommandID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ExecuteBrowserCommandArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "commandId":
+			v.CommandID = CommandID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddPrivacySandboxEnrollmentOverrideArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddPrivacySandboxEnrollmentOverrideArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddPrivacySandboxCoordinatorKeyConfigArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("api")
+	w.String(string(v.API))
+	w.Key("coordinatorOrigin")
+	w.String(v.CoordinatorOrigin)
+	w.Key("keyConfig")
+	w.String(v.KeyConfig)
+	if v.BrowserContextID != nil {
+		w.Key("browserContextId")
+		w.String(string(*v.BrowserContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddPrivacySandboxCoordinatorKeyConfigArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "api":
+			v.API = PrivacySandboxAPI(r.String())
+		case "coordinatorOrigin":
+			v.CoordinatorOrigin = r.String()
+		case "keyConfig":
+			v.KeyConfig = r.String()
+		case "browserContextId":
+			if r.Null() {
+				v.BrowserContextID = nil
+			} else {
+				p := ContextID(r.String())
+				v.BrowserContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DownloadWillBeginReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("guid")
+	w.String(v.GUID)
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("suggestedFilename")
+	w.String(v.SuggestedFilename)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DownloadWillBeginReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "guid":
+			v.GUID = r.String()
+		case "url":
+			v.URL = r.String()
+		case "suggestedFilename":
+			v.SuggestedFilename = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DownloadProgressReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("guid")
+	w.String(v.GUID)
+	w.Key("totalBytes")
+	w.Float(v.TotalBytes)
+	w.Key("receivedBytes")
+	w.Float(v.ReceivedBytes)
+	w.Key("state")
+	w.String(v.State)
+	if v.FilePath != nil {
+		w.Key("filePath")
+		w.String(*v.FilePath)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DownloadProgressReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "guid":
+			v.GUID = r.String()
+		case "totalBytes":
+			v.TotalBytes = r.Float()
+		case "receivedBytes":
+			v.ReceivedBytes = r.Float()
+		case "state":
+			v.State = r.String()
+		case "filePath":
+			if r.Null() {
+				v.FilePath = nil
+			} else {
+				p := r.String()
+				v.FilePath = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
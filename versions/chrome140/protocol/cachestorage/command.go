@@ -0,0 +1,184 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cachestorage
+
+import (
+	"errors"
+
+	"github.com/mafredri/cdp/versions/chrome140/protocol/storage"
+)
+
+// DeleteCacheArgs represents the arguments for DeleteCache in the CacheStorage domain.
+type DeleteCacheArgs struct {
+	CacheID CacheID `json:"cacheId"` // Id of cache for deletion.
+}
+
+// NewDeleteCacheArgs initializes DeleteCacheArgs with the required arguments.
+func NewDeleteCacheArgs(cacheID CacheID) *DeleteCacheArgs {
+	args := new(DeleteCacheArgs)
+	args.CacheID = cacheID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteCacheArgs) Validate() error {
+	return nil
+}
+
+// DeleteEntryArgs represents the arguments for DeleteEntry in the CacheStorage domain.
+type DeleteEntryArgs struct {
+	CacheID CacheID `json:"cacheId"` // Id of cache where the entry will be deleted.
+	Request string  `json:"request"` // URL spec of the request.
+}
+
+// NewDeleteEntryArgs initializes DeleteEntryArgs with the required arguments.
+func NewDeleteEntryArgs(cacheID CacheID, request string) *DeleteEntryArgs {
+	args := new(DeleteEntryArgs)
+	args.CacheID = cacheID
+	args.Request = request
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeleteEntryArgs) Validate() error {
+	return nil
+}
+
+// RequestCacheNamesArgs represents the arguments for RequestCacheNames in the CacheStorage domain.
+type RequestCacheNamesArgs struct {
+	SecurityOrigin *string         `json:"securityOrigin,omitempty"` // At least and at most one of securityOrigin, storageKey, storageBucket must be specified. Security origin.
+	StorageKey     *string         `json:"storageKey,omitempty"`     // Storage key.
+	StorageBucket  *storage.Bucket `json:"storageBucket,omitempty"`  // Storage bucket. If not specified, it uses the default bucket.
+}
+
+// NewRequestCacheNamesArgs initializes RequestCacheNamesArgs with the required arguments.
+func NewRequestCacheNamesArgs() *RequestCacheNamesArgs {
+	args := new(RequestCacheNamesArgs)
+
+	return args
+}
+
+// SetSecurityOrigin sets the SecurityOrigin optional argument. At
+// least and at most one of securityOrigin, storageKey, storageBucket
+// must be specified. Security origin.
+func (a *RequestCacheNamesArgs) SetSecurityOrigin(securityOrigin string) *RequestCacheNamesArgs {
+	a.SecurityOrigin = &securityOrigin
+	return a
+}
+
+// SetStorageKey sets the StorageKey optional argument. Storage key.
+func (a *RequestCacheNamesArgs) SetStorageKey(storageKey string) *RequestCacheNamesArgs {
+	a.StorageKey = &storageKey
+	return a
+}
+
+// SetStorageBucket sets the StorageBucket optional argument. Storage
+// bucket. If not specified, it uses the default bucket.
+func (a *RequestCacheNamesArgs) SetStorageBucket(storageBucket storage.Bucket) *RequestCacheNamesArgs {
+	a.StorageBucket = &storageBucket
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestCacheNamesArgs) Validate() error {
+	n := 0
+	if a.SecurityOrigin != nil {
+		n++
+	}
+	if a.StorageKey != nil {
+		n++
+	}
+	if a.StorageBucket != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New("cachestorage.RequestCacheNamesArgs: exactly one of SecurityOrigin, StorageKey or StorageBucket must be set")
+	}
+	return nil
+}
+
+// RequestCacheNamesReply represents the return values for RequestCacheNames in the CacheStorage domain.
+type RequestCacheNamesReply struct {
+	Caches []Cache `json:"caches"` // Caches for the security origin.
+}
+
+// RequestCachedResponseArgs represents the arguments for RequestCachedResponse in the CacheStorage domain.
+type RequestCachedResponseArgs struct {
+	CacheID        CacheID  `json:"cacheId"`        // Id of cache that contains the entry.
+	RequestURL     string   `json:"requestURL"`     // URL spec of the request.
+	RequestHeaders []Header `json:"requestHeaders"` // headers of the request.
+}
+
+// NewRequestCachedResponseArgs initializes RequestCachedResponseArgs with the required arguments.
+func NewRequestCachedResponseArgs(cacheID CacheID, requestURL string, requestHeaders []Header) *RequestCachedResponseArgs {
+	args := new(RequestCachedResponseArgs)
+	args.CacheID = cacheID
+	args.RequestURL = requestURL
+	args.RequestHeaders = requestHeaders
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestCachedResponseArgs) Validate() error {
+	if a.RequestHeaders == nil {
+		return errors.New("cachestorage.RequestCachedResponseArgs: RequestHeaders is required")
+	}
+	return nil
+}
+
+// RequestCachedResponseReply represents the return values for RequestCachedResponse in the CacheStorage domain.
+type RequestCachedResponseReply struct {
+	Response CachedResponse `json:"response"` // Response read from the cache.
+}
+
+// RequestEntriesArgs represents the arguments for RequestEntries in the CacheStorage domain.
+type RequestEntriesArgs struct {
+	CacheID    CacheID `json:"cacheId"`              // ID of cache to get entries from.
+	SkipCount  *int    `json:"skipCount,omitempty"`  // Number of records to skip.
+	PageSize   *int    `json:"pageSize,omitempty"`   // Number of records to fetch.
+	PathFilter *string `json:"pathFilter,omitempty"` // If present, only return the entries containing this substring in the path
+}
+
+// NewRequestEntriesArgs initializes RequestEntriesArgs with the required arguments.
+func NewRequestEntriesArgs(cacheID CacheID) *RequestEntriesArgs {
+	args := new(RequestEntriesArgs)
+	args.CacheID = cacheID
+	return args
+}
+
+// SetSkipCount sets the SkipCount optional argument. Number of
+// records to skip.
+func (a *RequestEntriesArgs) SetSkipCount(skipCount int) *RequestEntriesArgs {
+	a.SkipCount = &skipCount
+	return a
+}
+
+// SetPageSize sets the PageSize optional argument. Number of records
+// to fetch.
+func (a *RequestEntriesArgs) SetPageSize(pageSize int) *RequestEntriesArgs {
+	a.PageSize = &pageSize
+	return a
+}
+
+// SetPathFilter sets the PathFilter optional argument. If present,
+// only return the entries containing this substring in the path
+func (a *RequestEntriesArgs) SetPathFilter(pathFilter string) *RequestEntriesArgs {
+	a.PathFilter = &pathFilter
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RequestEntriesArgs) Validate() error {
+	return nil
+}
+
+// RequestEntriesReply represents the return values for RequestEntries in the CacheStorage domain.
+type RequestEntriesReply struct {
+	CacheDataEntries []DataEntry `json:"cacheDataEntries"` // Array of object store data entries.
+	ReturnCount      float64     `json:"returnCount"`      // Count of returned entries from this storage. If pathFilter is empty, it is the count of all entries from this storage.
+}
@@ -0,0 +1,73 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cachestorage
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/storage"
+)
+
+// RequestCacheNamesOption sets an optional argument of RequestCacheNamesArgs.
+type RequestCacheNamesOption func(*RequestCacheNamesArgs)
+
+// NewRequestCacheNamesArgsWith initializes RequestCacheNamesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestCacheNamesArgsWith(opts ...RequestCacheNamesOption) *RequestCacheNamesArgs {
+	args := NewRequestCacheNamesArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestCacheNamesWithSecurityOrigin sets the SecurityOrigin optional argument, see
+// RequestCacheNamesArgs.SetSecurityOrigin.
+func RequestCacheNamesWithSecurityOrigin(v string) RequestCacheNamesOption {
+	return func(a *RequestCacheNamesArgs) { a.SetSecurityOrigin(v) }
+}
+
+// RequestCacheNamesWithStorageKey sets the StorageKey optional argument, see
+// RequestCacheNamesArgs.SetStorageKey.
+func RequestCacheNamesWithStorageKey(v string) RequestCacheNamesOption {
+	return func(a *RequestCacheNamesArgs) { a.SetStorageKey(v) }
+}
+
+// RequestCacheNamesWithStorageBucket sets the StorageBucket optional argument, see
+// RequestCacheNamesArgs.SetStorageBucket.
+func RequestCacheNamesWithStorageBucket(v storage.Bucket) RequestCacheNamesOption {
+	return func(a *RequestCacheNamesArgs) { a.SetStorageBucket(v) }
+}
+
+// RequestEntriesOption sets an optional argument of RequestEntriesArgs.
+type RequestEntriesOption func(*RequestEntriesArgs)
+
+// NewRequestEntriesArgsWith initializes RequestEntriesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestEntriesArgsWith(cacheID CacheID, opts ...RequestEntriesOption) *RequestEntriesArgs {
+	args := NewRequestEntriesArgs(cacheID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestEntriesWithSkipCount sets the SkipCount optional argument, see
+// RequestEntriesArgs.SetSkipCount.
+func RequestEntriesWithSkipCount(v int) RequestEntriesOption {
+	return func(a *RequestEntriesArgs) { a.SetSkipCount(v) }
+}
+
+// RequestEntriesWithPageSize sets the PageSize optional argument, see
+// RequestEntriesArgs.SetPageSize.
+func RequestEntriesWithPageSize(v int) RequestEntriesOption {
+	return func(a *RequestEntriesArgs) { a.SetPageSize(v) }
+}
+
+// RequestEntriesWithPathFilter sets the PathFilter optional argument, see
+// RequestEntriesArgs.SetPathFilter.
+func RequestEntriesWithPathFilter(v string) RequestEntriesOption {
+	return func(a *RequestEntriesArgs) { a.SetPathFilter(v) }
+}
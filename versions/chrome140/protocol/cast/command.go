@@ -0,0 +1,99 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cast
+
+// EnableArgs represents the arguments for Enable in the Cast domain.
+type EnableArgs struct {
+	PresentationURL *string `json:"presentationUrl,omitempty"` // No description.
+}
+
+// NewEnableArgs initializes EnableArgs with the required arguments.
+func NewEnableArgs() *EnableArgs {
+	args := new(EnableArgs)
+
+	return args
+}
+
+// SetPresentationURL sets the PresentationURL optional argument.
+func (a *EnableArgs) SetPresentationURL(presentationURL string) *EnableArgs {
+	a.PresentationURL = &presentationURL
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	return nil
+}
+
+// SetSinkToUseArgs represents the arguments for SetSinkToUse in the Cast domain.
+type SetSinkToUseArgs struct {
+	SinkName string `json:"sinkName"` // No description.
+}
+
+// NewSetSinkToUseArgs initializes SetSinkToUseArgs with the required arguments.
+func NewSetSinkToUseArgs(sinkName string) *SetSinkToUseArgs {
+	args := new(SetSinkToUseArgs)
+	args.SinkName = sinkName
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSinkToUseArgs) Validate() error {
+	return nil
+}
+
+// StartDesktopMirroringArgs represents the arguments for StartDesktopMirroring in the Cast domain.
+type StartDesktopMirroringArgs struct {
+	SinkName string `json:"sinkName"` // No description.
+}
+
+// NewStartDesktopMirroringArgs initializes StartDesktopMirroringArgs with the required arguments.
+func NewStartDesktopMirroringArgs(sinkName string) *StartDesktopMirroringArgs {
+	args := new(StartDesktopMirroringArgs)
+	args.SinkName = sinkName
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartDesktopMirroringArgs) Validate() error {
+	return nil
+}
+
+// StartTabMirroringArgs represents the arguments for StartTabMirroring in the Cast domain.
+type StartTabMirroringArgs struct {
+	SinkName string `json:"sinkName"` // No description.
+}
+
+// NewStartTabMirroringArgs initializes StartTabMirroringArgs with the required arguments.
+func NewStartTabMirroringArgs(sinkName string) *StartTabMirroringArgs {
+	args := new(StartTabMirroringArgs)
+	args.SinkName = sinkName
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartTabMirroringArgs) Validate() error {
+	return nil
+}
+
+// StopCastingArgs represents the arguments for StopCasting in the Cast domain.
+type StopCastingArgs struct {
+	SinkName string `json:"sinkName"` // No description.
+}
+
+// NewStopCastingArgs initializes StopCastingArgs with the required arguments.
+func NewStopCastingArgs(sinkName string) *StopCastingArgs {
+	args := new(StopCastingArgs)
+	args.SinkName = sinkName
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopCastingArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,37 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cast
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// SinksUpdatedClient is a client for SinksUpdated events. This is fired
+// whenever the list of available sinks changes. A sink is a device or a
+// software surface that you can cast to.
+type SinksUpdatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*SinksUpdatedReply, error)
+	rpcc.Stream
+}
+
+// SinksUpdatedReply is the reply for SinksUpdated events.
+type SinksUpdatedReply struct {
+	Sinks []Sink `json:"sinks"` // No description.
+}
+
+// IssueUpdatedClient is a client for IssueUpdated events. This is fired
+// whenever the outstanding issue/error message changes. |issueMessage| is
+// empty if there is no issue.
+type IssueUpdatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*IssueUpdatedReply, error)
+	rpcc.Stream
+}
+
+// IssueUpdatedReply is the reply for IssueUpdated events.
+type IssueUpdatedReply struct {
+	IssueMessage string `json:"issueMessage"` // No description.
+}
@@ -0,0 +1,24 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cast
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithPresentationURL sets the PresentationURL optional argument, see
+// EnableArgs.SetPresentationURL.
+func EnableWithPresentationURL(v string) EnableOption {
+	return func(a *EnableArgs) { a.SetPresentationURL(v) }
+}
@@ -0,0 +1,10 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cast
+
+// Sink
+type Sink struct {
+	Name    string  `json:"name"`              // No description.
+	ID      string  `json:"id"`                // No description.
+	Session *string `json:"session,omitempty"` // Text describing the current session. Present only if there is an active session on the sink.
+}
@@ -0,0 +1,129 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+)
+
+// AddRuleOption sets an optional argument of AddRuleArgs.
+type AddRuleOption func(*AddRuleArgs)
+
+// NewAddRuleArgsWith initializes AddRuleArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAddRuleArgsWith(styleSheetID StyleSheetID, ruleText string, location SourceRange, opts ...AddRuleOption) *AddRuleArgs {
+	args := NewAddRuleArgs(styleSheetID, ruleText, location)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AddRuleWithNodeForPropertySyntaxValidation sets the NodeForPropertySyntaxValidation optional argument, see
+// AddRuleArgs.SetNodeForPropertySyntaxValidation.
+//
+// Note: This argument is experimental.
+func AddRuleWithNodeForPropertySyntaxValidation(v dom.NodeID) AddRuleOption {
+	return func(a *AddRuleArgs) { a.SetNodeForPropertySyntaxValidation(v) }
+}
+
+// CreateStyleSheetOption sets an optional argument of CreateStyleSheetArgs.
+type CreateStyleSheetOption func(*CreateStyleSheetArgs)
+
+// NewCreateStyleSheetArgsWith initializes CreateStyleSheetArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCreateStyleSheetArgsWith(frameID page.FrameID, opts ...CreateStyleSheetOption) *CreateStyleSheetArgs {
+	args := NewCreateStyleSheetArgs(frameID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CreateStyleSheetWithForce sets the Force optional argument, see
+// CreateStyleSheetArgs.SetForce.
+func CreateStyleSheetWithForce(v bool) CreateStyleSheetOption {
+	return func(a *CreateStyleSheetArgs) { a.SetForce(v) }
+}
+
+// ResolveValuesOption sets an optional argument of ResolveValuesArgs.
+type ResolveValuesOption func(*ResolveValuesArgs)
+
+// NewResolveValuesArgsWith initializes ResolveValuesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewResolveValuesArgsWith(values []string, nodeID dom.NodeID, opts ...ResolveValuesOption) *ResolveValuesArgs {
+	args := NewResolveValuesArgs(values, nodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ResolveValuesWithPropertyName sets the PropertyName optional argument, see
+// ResolveValuesArgs.SetPropertyName.
+func ResolveValuesWithPropertyName(v string) ResolveValuesOption {
+	return func(a *ResolveValuesArgs) { a.SetPropertyName(v) }
+}
+
+// ResolveValuesWithPseudoType sets the PseudoType optional argument, see
+// ResolveValuesArgs.SetPseudoType.
+func ResolveValuesWithPseudoType(v dom.PseudoType) ResolveValuesOption {
+	return func(a *ResolveValuesArgs) { a.SetPseudoType(v) }
+}
+
+// ResolveValuesWithPseudoIdentifier sets the PseudoIdentifier optional argument, see
+// ResolveValuesArgs.SetPseudoIdentifier.
+func ResolveValuesWithPseudoIdentifier(v string) ResolveValuesOption {
+	return func(a *ResolveValuesArgs) { a.SetPseudoIdentifier(v) }
+}
+
+// TrackComputedStyleUpdatesForNodeOption sets an optional argument of TrackComputedStyleUpdatesForNodeArgs.
+type TrackComputedStyleUpdatesForNodeOption func(*TrackComputedStyleUpdatesForNodeArgs)
+
+// NewTrackComputedStyleUpdatesForNodeArgsWith initializes TrackComputedStyleUpdatesForNodeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewTrackComputedStyleUpdatesForNodeArgsWith(opts ...TrackComputedStyleUpdatesForNodeOption) *TrackComputedStyleUpdatesForNodeArgs {
+	args := NewTrackComputedStyleUpdatesForNodeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// TrackComputedStyleUpdatesForNodeWithNodeID sets the NodeID optional argument, see
+// TrackComputedStyleUpdatesForNodeArgs.SetNodeID.
+func TrackComputedStyleUpdatesForNodeWithNodeID(v dom.NodeID) TrackComputedStyleUpdatesForNodeOption {
+	return func(a *TrackComputedStyleUpdatesForNodeArgs) { a.SetNodeID(v) }
+}
+
+// SetStyleTextsOption sets an optional argument of SetStyleTextsArgs.
+type SetStyleTextsOption func(*SetStyleTextsArgs)
+
+// NewSetStyleTextsArgsWith initializes SetStyleTextsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetStyleTextsArgsWith(edits []StyleDeclarationEdit, opts ...SetStyleTextsOption) *SetStyleTextsArgs {
+	args := NewSetStyleTextsArgs(edits)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetStyleTextsWithNodeForPropertySyntaxValidation sets the NodeForPropertySyntaxValidation optional argument, see
+// SetStyleTextsArgs.SetNodeForPropertySyntaxValidation.
+//
+// Note: This argument is experimental.
+func SetStyleTextsWithNodeForPropertySyntaxValidation(v dom.NodeID) SetStyleTextsOption {
+	return func(a *SetStyleTextsArgs) { a.SetNodeForPropertySyntaxValidation(v) }
+}
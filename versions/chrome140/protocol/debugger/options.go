@@ -0,0 +1,401 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// ContinueToLocationOption sets an optional argument of ContinueToLocationArgs.
+type ContinueToLocationOption func(*ContinueToLocationArgs)
+
+// NewContinueToLocationArgsWith initializes ContinueToLocationArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewContinueToLocationArgsWith(location Location, opts ...ContinueToLocationOption) *ContinueToLocationArgs {
+	args := NewContinueToLocationArgs(location)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ContinueToLocationWithTargetCallFrames sets the TargetCallFrames optional argument, see
+// ContinueToLocationArgs.SetTargetCallFrames.
+func ContinueToLocationWithTargetCallFrames(v string) ContinueToLocationOption {
+	return func(a *ContinueToLocationArgs) { a.SetTargetCallFrames(v) }
+}
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithMaxScriptsCacheSize sets the MaxScriptsCacheSize optional argument, see
+// EnableArgs.SetMaxScriptsCacheSize.
+//
+// Note: This argument is experimental.
+func EnableWithMaxScriptsCacheSize(v float64) EnableOption {
+	return func(a *EnableArgs) { a.SetMaxScriptsCacheSize(v) }
+}
+
+// EvaluateOnCallFrameOption sets an optional argument of EvaluateOnCallFrameArgs.
+type EvaluateOnCallFrameOption func(*EvaluateOnCallFrameArgs)
+
+// NewEvaluateOnCallFrameArgsWith initializes EvaluateOnCallFrameArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEvaluateOnCallFrameArgsWith(callFrameID CallFrameID, expression string, opts ...EvaluateOnCallFrameOption) *EvaluateOnCallFrameArgs {
+	args := NewEvaluateOnCallFrameArgs(callFrameID, expression)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EvaluateOnCallFrameWithObjectGroup sets the ObjectGroup optional argument, see
+// EvaluateOnCallFrameArgs.SetObjectGroup.
+func EvaluateOnCallFrameWithObjectGroup(v string) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetObjectGroup(v) }
+}
+
+// EvaluateOnCallFrameWithIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument, see
+// EvaluateOnCallFrameArgs.SetIncludeCommandLineAPI.
+func EvaluateOnCallFrameWithIncludeCommandLineAPI(v bool) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetIncludeCommandLineAPI(v) }
+}
+
+// EvaluateOnCallFrameWithSilent sets the Silent optional argument, see
+// EvaluateOnCallFrameArgs.SetSilent.
+func EvaluateOnCallFrameWithSilent(v bool) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetSilent(v) }
+}
+
+// EvaluateOnCallFrameWithReturnByValue sets the ReturnByValue optional argument, see
+// EvaluateOnCallFrameArgs.SetReturnByValue.
+func EvaluateOnCallFrameWithReturnByValue(v bool) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetReturnByValue(v) }
+}
+
+// EvaluateOnCallFrameWithGeneratePreview sets the GeneratePreview optional argument, see
+// EvaluateOnCallFrameArgs.SetGeneratePreview.
+//
+// Note: This argument is experimental.
+func EvaluateOnCallFrameWithGeneratePreview(v bool) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetGeneratePreview(v) }
+}
+
+// EvaluateOnCallFrameWithThrowOnSideEffect sets the ThrowOnSideEffect optional argument, see
+// EvaluateOnCallFrameArgs.SetThrowOnSideEffect.
+func EvaluateOnCallFrameWithThrowOnSideEffect(v bool) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetThrowOnSideEffect(v) }
+}
+
+// EvaluateOnCallFrameWithTimeout sets the Timeout optional argument, see
+// EvaluateOnCallFrameArgs.SetTimeout.
+//
+// Note: This argument is experimental.
+func EvaluateOnCallFrameWithTimeout(v runtime.TimeDelta) EvaluateOnCallFrameOption {
+	return func(a *EvaluateOnCallFrameArgs) { a.SetTimeout(v) }
+}
+
+// GetPossibleBreakpointsOption sets an optional argument of GetPossibleBreakpointsArgs.
+type GetPossibleBreakpointsOption func(*GetPossibleBreakpointsArgs)
+
+// NewGetPossibleBreakpointsArgsWith initializes GetPossibleBreakpointsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetPossibleBreakpointsArgsWith(start Location, opts ...GetPossibleBreakpointsOption) *GetPossibleBreakpointsArgs {
+	args := NewGetPossibleBreakpointsArgs(start)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetPossibleBreakpointsWithEnd sets the End optional argument, see
+// GetPossibleBreakpointsArgs.SetEnd.
+func GetPossibleBreakpointsWithEnd(v Location) GetPossibleBreakpointsOption {
+	return func(a *GetPossibleBreakpointsArgs) { a.SetEnd(v) }
+}
+
+// GetPossibleBreakpointsWithRestrictToFunction sets the RestrictToFunction optional argument, see
+// GetPossibleBreakpointsArgs.SetRestrictToFunction.
+func GetPossibleBreakpointsWithRestrictToFunction(v bool) GetPossibleBreakpointsOption {
+	return func(a *GetPossibleBreakpointsArgs) { a.SetRestrictToFunction(v) }
+}
+
+// RestartFrameOption sets an optional argument of RestartFrameArgs.
+type RestartFrameOption func(*RestartFrameArgs)
+
+// NewRestartFrameArgsWith initializes RestartFrameArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRestartFrameArgsWith(callFrameID CallFrameID, opts ...RestartFrameOption) *RestartFrameArgs {
+	args := NewRestartFrameArgs(callFrameID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RestartFrameWithMode sets the Mode optional argument, see
+// RestartFrameArgs.SetMode.
+//
+// Note: This argument is experimental.
+func RestartFrameWithMode(v string) RestartFrameOption {
+	return func(a *RestartFrameArgs) { a.SetMode(v) }
+}
+
+// ResumeOption sets an optional argument of ResumeArgs.
+type ResumeOption func(*ResumeArgs)
+
+// NewResumeArgsWith initializes ResumeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewResumeArgsWith(opts ...ResumeOption) *ResumeArgs {
+	args := NewResumeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ResumeWithTerminateOnResume sets the TerminateOnResume optional argument, see
+// ResumeArgs.SetTerminateOnResume.
+func ResumeWithTerminateOnResume(v bool) ResumeOption {
+	return func(a *ResumeArgs) { a.SetTerminateOnResume(v) }
+}
+
+// SearchInContentOption sets an optional argument of SearchInContentArgs.
+type SearchInContentOption func(*SearchInContentArgs)
+
+// NewSearchInContentArgsWith initializes SearchInContentArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSearchInContentArgsWith(scriptID runtime.ScriptID, query string, opts ...SearchInContentOption) *SearchInContentArgs {
+	args := NewSearchInContentArgs(scriptID, query)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SearchInContentWithCaseSensitive sets the CaseSensitive optional argument, see
+// SearchInContentArgs.SetCaseSensitive.
+func SearchInContentWithCaseSensitive(v bool) SearchInContentOption {
+	return func(a *SearchInContentArgs) { a.SetCaseSensitive(v) }
+}
+
+// SearchInContentWithIsRegex sets the IsRegex optional argument, see
+// SearchInContentArgs.SetIsRegex.
+func SearchInContentWithIsRegex(v bool) SearchInContentOption {
+	return func(a *SearchInContentArgs) { a.SetIsRegex(v) }
+}
+
+// SetBlackboxPatternsOption sets an optional argument of SetBlackboxPatternsArgs.
+type SetBlackboxPatternsOption func(*SetBlackboxPatternsArgs)
+
+// NewSetBlackboxPatternsArgsWith initializes SetBlackboxPatternsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetBlackboxPatternsArgsWith(patterns []string, opts ...SetBlackboxPatternsOption) *SetBlackboxPatternsArgs {
+	args := NewSetBlackboxPatternsArgs(patterns)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetBlackboxPatternsWithSkipAnonymous sets the SkipAnonymous optional argument, see
+// SetBlackboxPatternsArgs.SetSkipAnonymous.
+func SetBlackboxPatternsWithSkipAnonymous(v bool) SetBlackboxPatternsOption {
+	return func(a *SetBlackboxPatternsArgs) { a.SetSkipAnonymous(v) }
+}
+
+// SetBreakpointOption sets an optional argument of SetBreakpointArgs.
+type SetBreakpointOption func(*SetBreakpointArgs)
+
+// NewSetBreakpointArgsWith initializes SetBreakpointArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetBreakpointArgsWith(location Location, opts ...SetBreakpointOption) *SetBreakpointArgs {
+	args := NewSetBreakpointArgs(location)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetBreakpointWithCondition sets the Condition optional argument, see
+// SetBreakpointArgs.SetCondition.
+func SetBreakpointWithCondition(v string) SetBreakpointOption {
+	return func(a *SetBreakpointArgs) { a.SetCondition(v) }
+}
+
+// SetBreakpointByURLOption sets an optional argument of SetBreakpointByURLArgs.
+type SetBreakpointByURLOption func(*SetBreakpointByURLArgs)
+
+// NewSetBreakpointByURLArgsWith initializes SetBreakpointByURLArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetBreakpointByURLArgsWith(lineNumber int, opts ...SetBreakpointByURLOption) *SetBreakpointByURLArgs {
+	args := NewSetBreakpointByURLArgs(lineNumber)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetBreakpointByURLWithURL sets the URL optional argument, see
+// SetBreakpointByURLArgs.SetURL.
+func SetBreakpointByURLWithURL(v string) SetBreakpointByURLOption {
+	return func(a *SetBreakpointByURLArgs) { a.SetURL(v) }
+}
+
+// SetBreakpointByURLWithURLRegex sets the URLRegex optional argument, see
+// SetBreakpointByURLArgs.SetURLRegex.
+func SetBreakpointByURLWithURLRegex(v string) SetBreakpointByURLOption {
+	return func(a *SetBreakpointByURLArgs) { a.SetURLRegex(v) }
+}
+
+// SetBreakpointByURLWithScriptHash sets the ScriptHash optional argument, see
+// SetBreakpointByURLArgs.SetScriptHash.
+func SetBreakpointByURLWithScriptHash(v string) SetBreakpointByURLOption {
+	return func(a *SetBreakpointByURLArgs) { a.SetScriptHash(v) }
+}
+
+// SetBreakpointByURLWithColumnNumber sets the ColumnNumber optional argument, see
+// SetBreakpointByURLArgs.SetColumnNumber.
+func SetBreakpointByURLWithColumnNumber(v int) SetBreakpointByURLOption {
+	return func(a *SetBreakpointByURLArgs) { a.SetColumnNumber(v) }
+}
+
+// SetBreakpointByURLWithCondition sets the Condition optional argument, see
+// SetBreakpointByURLArgs.SetCondition.
+func SetBreakpointByURLWithCondition(v string) SetBreakpointByURLOption {
+	return func(a *SetBreakpointByURLArgs) { a.SetCondition(v) }
+}
+
+// SetBreakpointOnFunctionCallOption sets an optional argument of SetBreakpointOnFunctionCallArgs.
+type SetBreakpointOnFunctionCallOption func(*SetBreakpointOnFunctionCallArgs)
+
+// NewSetBreakpointOnFunctionCallArgsWith initializes SetBreakpointOnFunctionCallArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetBreakpointOnFunctionCallArgsWith(objectID runtime.RemoteObjectID, opts ...SetBreakpointOnFunctionCallOption) *SetBreakpointOnFunctionCallArgs {
+	args := NewSetBreakpointOnFunctionCallArgs(objectID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetBreakpointOnFunctionCallWithCondition sets the Condition optional argument, see
+// SetBreakpointOnFunctionCallArgs.SetCondition.
+func SetBreakpointOnFunctionCallWithCondition(v string) SetBreakpointOnFunctionCallOption {
+	return func(a *SetBreakpointOnFunctionCallArgs) { a.SetCondition(v) }
+}
+
+// SetScriptSourceOption sets an optional argument of SetScriptSourceArgs.
+type SetScriptSourceOption func(*SetScriptSourceArgs)
+
+// NewSetScriptSourceArgsWith initializes SetScriptSourceArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetScriptSourceArgsWith(scriptID runtime.ScriptID, scriptSource string, opts ...SetScriptSourceOption) *SetScriptSourceArgs {
+	args := NewSetScriptSourceArgs(scriptID, scriptSource)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetScriptSourceWithDryRun sets the DryRun optional argument, see
+// SetScriptSourceArgs.SetDryRun.
+func SetScriptSourceWithDryRun(v bool) SetScriptSourceOption {
+	return func(a *SetScriptSourceArgs) { a.SetDryRun(v) }
+}
+
+// SetScriptSourceWithAllowTopFrameEditing sets the AllowTopFrameEditing optional argument, see
+// SetScriptSourceArgs.SetAllowTopFrameEditing.
+//
+// Note: This argument is experimental.
+func SetScriptSourceWithAllowTopFrameEditing(v bool) SetScriptSourceOption {
+	return func(a *SetScriptSourceArgs) { a.SetAllowTopFrameEditing(v) }
+}
+
+// StepIntoOption sets an optional argument of StepIntoArgs.
+type StepIntoOption func(*StepIntoArgs)
+
+// NewStepIntoArgsWith initializes StepIntoArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStepIntoArgsWith(opts ...StepIntoOption) *StepIntoArgs {
+	args := NewStepIntoArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StepIntoWithBreakOnAsyncCall sets the BreakOnAsyncCall optional argument, see
+// StepIntoArgs.SetBreakOnAsyncCall.
+//
+// Note: This argument is experimental.
+func StepIntoWithBreakOnAsyncCall(v bool) StepIntoOption {
+	return func(a *StepIntoArgs) { a.SetBreakOnAsyncCall(v) }
+}
+
+// StepIntoWithSkipList sets the SkipList optional argument, see
+// StepIntoArgs.SetSkipList.
+//
+// Note: This argument is experimental.
+func StepIntoWithSkipList(v []LocationRange) StepIntoOption {
+	return func(a *StepIntoArgs) { a.SetSkipList(v) }
+}
+
+// StepOverOption sets an optional argument of StepOverArgs.
+type StepOverOption func(*StepOverArgs)
+
+// NewStepOverArgsWith initializes StepOverArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStepOverArgsWith(opts ...StepOverOption) *StepOverArgs {
+	args := NewStepOverArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StepOverWithSkipList sets the SkipList optional argument, see
+// StepOverArgs.SetSkipList.
+//
+// Note: This argument is experimental.
+func StepOverWithSkipList(v []LocationRange) StepOverOption {
+	return func(a *StepOverArgs) { a.SetSkipList(v) }
+}
@@ -0,0 +1,41 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package deviceaccess
+
+// SelectPromptArgs represents the arguments for SelectPrompt in the DeviceAccess domain.
+type SelectPromptArgs struct {
+	ID       RequestID `json:"id"`       // No description.
+	DeviceID DeviceID  `json:"deviceId"` // No description.
+}
+
+// NewSelectPromptArgs initializes SelectPromptArgs with the required arguments.
+func NewSelectPromptArgs(id RequestID, deviceID DeviceID) *SelectPromptArgs {
+	args := new(SelectPromptArgs)
+	args.ID = id
+	args.DeviceID = deviceID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SelectPromptArgs) Validate() error {
+	return nil
+}
+
+// CancelPromptArgs represents the arguments for CancelPrompt in the DeviceAccess domain.
+type CancelPromptArgs struct {
+	ID RequestID `json:"id"` // No description.
+}
+
+// NewCancelPromptArgs initializes CancelPromptArgs with the required arguments.
+func NewCancelPromptArgs(id RequestID) *CancelPromptArgs {
+	args := new(CancelPromptArgs)
+	args.ID = id
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CancelPromptArgs) Validate() error {
+	return nil
+}
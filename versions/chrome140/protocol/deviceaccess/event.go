@@ -0,0 +1,23 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package deviceaccess
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// DeviceRequestPromptedClient is a client for DeviceRequestPrompted events. A
+// device request opened a user prompt to select a device. Respond with the
+// selectPrompt or cancelPrompt command.
+type DeviceRequestPromptedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DeviceRequestPromptedReply, error)
+	rpcc.Stream
+}
+
+// DeviceRequestPromptedReply is the reply for DeviceRequestPrompted events.
+type DeviceRequestPromptedReply struct {
+	ID      RequestID      `json:"id"`      // No description.
+	Devices []PromptDevice `json:"devices"` // No description.
+}
@@ -0,0 +1,16 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package deviceaccess
+
+// RequestID Device request id.
+type RequestID string
+
+// DeviceID A device id.
+type DeviceID string
+
+// PromptDevice Device information displayed in a user prompt to select a
+// device.
+type PromptDevice struct {
+	ID   DeviceID `json:"id"`   // No description.
+	Name string   `json:"name"` // Display name as it appears in a device request user prompt.
+}
@@ -0,0 +1,25 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package deviceorientation
+
+// SetDeviceOrientationOverrideArgs represents the arguments for SetDeviceOrientationOverride in the DeviceOrientation domain.
+type SetDeviceOrientationOverrideArgs struct {
+	Alpha float64 `json:"alpha"` // Mock alpha
+	Beta  float64 `json:"beta"`  // Mock beta
+	Gamma float64 `json:"gamma"` // Mock gamma
+}
+
+// NewSetDeviceOrientationOverrideArgs initializes SetDeviceOrientationOverrideArgs with the required arguments.
+func NewSetDeviceOrientationOverrideArgs(alpha float64, beta float64, gamma float64) *SetDeviceOrientationOverrideArgs {
+	args := new(SetDeviceOrientationOverrideArgs)
+	args.Alpha = alpha
+	args.Beta = beta
+	args.Gamma = gamma
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDeviceOrientationOverrideArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,3825 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/internal"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BackendNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeType")
+	w.Int(v.NodeType)
+	w.Key("nodeName")
+	w.String(v.NodeName)
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BackendNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeType":
+			v.NodeType = r.Int()
+		case "nodeName":
+			v.NodeName = r.String()
+		case "backendNodeId":
+			v.BackendNodeID = BackendNodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Node) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	if v.ParentID != nil {
+		w.Key("parentId")
+		w.Int(int(*v.ParentID))
+	}
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	w.Key("nodeType")
+	w.Int(v.NodeType)
+	w.Key("nodeName")
+	w.String(v.NodeName)
+	w.Key("localName")
+	w.String(v.LocalName)
+	w.Key("nodeValue")
+	w.String(v.NodeValue)
+	if v.ChildNodeCount != nil {
+		w.Key("childNodeCount")
+		w.Int(*v.ChildNodeCount)
+	}
+	if len(v.Children) > 0 {
+		w.Key("children")
+		w.ArrayStart()
+		for i0 := range v.Children {
+			v.Children[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Attributes) > 0 {
+		w.Key("attributes")
+		w.ArrayStart()
+		for i0 := range v.Attributes {
+			w.String(v.Attributes[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.DocumentURL != nil {
+		w.Key("documentURL")
+		w.String(*v.DocumentURL)
+	}
+	if v.BaseURL != nil {
+		w.Key("baseURL")
+		w.String(*v.BaseURL)
+	}
+	if v.PublicID != nil {
+		w.Key("publicId")
+		w.String(*v.PublicID)
+	}
+	if v.SystemID != nil {
+		w.Key("systemId")
+		w.String(*v.SystemID)
+	}
+	if v.InternalSubset != nil {
+		w.Key("internalSubset")
+		w.String(*v.InternalSubset)
+	}
+	if v.XMLVersion != nil {
+		w.Key("xmlVersion")
+		w.String(*v.XMLVersion)
+	}
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	if v.Value != nil {
+		w.Key("value")
+		w.String(*v.Value)
+	}
+	if v.PseudoType != "" {
+		w.Key("pseudoType")
+		w.String(string(v.PseudoType))
+	}
+	if v.PseudoIdentifier != nil {
+		w.Key("pseudoIdentifier")
+		w.String(*v.PseudoIdentifier)
+	}
+	if v.ShadowRootType != "" {
+		w.Key("shadowRootType")
+		w.String(string(v.ShadowRootType))
+	}
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	if v.ContentDocument != nil {
+		w.Key("contentDocument")
+		v.ContentDocument.EncodeJSON(w)
+	}
+	if len(v.ShadowRoots) > 0 {
+		w.Key("shadowRoots")
+		w.ArrayStart()
+		for i0 := range v.ShadowRoots {
+			v.ShadowRoots[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.TemplateContent != nil {
+		w.Key("templateContent")
+		v.TemplateContent.EncodeJSON(w)
+	}
+	if len(v.PseudoElements) > 0 {
+		w.Key("pseudoElements")
+		w.ArrayStart()
+		for i0 := range v.PseudoElements {
+			v.PseudoElements[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ImportedDocument != nil {
+		w.Key("importedDocument")
+		v.ImportedDocument.EncodeJSON(w)
+	}
+	if len(v.DistributedNodes) > 0 {
+		w.Key("distributedNodes")
+		w.ArrayStart()
+		for i0 := range v.DistributedNodes {
+			v.DistributedNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.IsSVG != nil {
+		w.Key("isSVG")
+		w.Bool(*v.IsSVG)
+	}
+	if v.CompatibilityMode != "" {
+		w.Key("compatibilityMode")
+		w.String(string(v.CompatibilityMode))
+	}
+	if v.AssignedSlot != nil {
+		w.Key("assignedSlot")
+		v.AssignedSlot.EncodeJSON(w)
+	}
+	if v.IsScrollable != nil {
+		w.Key("isScrollable")
+		w.Bool(*v.IsScrollable)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Node) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "parentId":
+			if r.Null() {
+				v.ParentID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.ParentID = &p
+			}
+		case "backendNodeId":
+			v.BackendNodeID = BackendNodeID(r.Int())
+		case "nodeType":
+			v.NodeType = r.Int()
+		case "nodeName":
+			v.NodeName = r.String()
+		case "localName":
+			v.LocalName = r.String()
+		case "nodeValue":
+			v.NodeValue = r.String()
+		case "childNodeCount":
+			if r.Null() {
+				v.ChildNodeCount = nil
+			} else {
+				p := r.Int()
+				v.ChildNodeCount = &p
+			}
+		case "children":
+			if r.ArrayStart() {
+				v.Children = make([]Node, 0, 4)
+				for r.ArrayNext() {
+					var e0 Node
+					e0.DecodeJSON(r)
+					v.Children = append(v.Children, e0)
+				}
+			} else {
+				v.Children = nil
+			}
+		case "attributes":
+			if r.ArrayStart() {
+				v.Attributes = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Attributes = append(v.Attributes, e0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		case "documentURL":
+			if r.Null() {
+				v.DocumentURL = nil
+			} else {
+				p := r.String()
+				v.DocumentURL = &p
+			}
+		case "baseURL":
+			if r.Null() {
+				v.BaseURL = nil
+			} else {
+				p := r.String()
+				v.BaseURL = &p
+			}
+		case "publicId":
+			if r.Null() {
+				v.PublicID = nil
+			} else {
+				p := r.String()
+				v.PublicID = &p
+			}
+		case "systemId":
+			if r.Null() {
+				v.SystemID = nil
+			} else {
+				p := r.String()
+				v.SystemID = &p
+			}
+		case "internalSubset":
+			if r.Null() {
+				v.InternalSubset = nil
+			} else {
+				p := r.String()
+				v.InternalSubset = &p
+			}
+		case "xmlVersion":
+			if r.Null() {
+				v.XMLVersion = nil
+			} else {
+				p := r.String()
+				v.XMLVersion = &p
+			}
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "value":
+			if r.Null() {
+				v.Value = nil
+			} else {
+				p := r.String()
+				v.Value = &p
+			}
+		case "pseudoType":
+			v.PseudoType = PseudoType(r.String())
+		case "pseudoIdentifier":
+			if r.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				p := r.String()
+				v.PseudoIdentifier = &p
+			}
+		case "shadowRootType":
+			v.ShadowRootType = ShadowRootType(r.String())
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := internal.PageFrameID(r.String())
+				v.FrameID = &p
+			}
+		case "contentDocument":
+			if r.Null() {
+				v.ContentDocument = nil
+			} else {
+				var p Node
+				p.DecodeJSON(r)
+				v.ContentDocument = &p
+			}
+		case "shadowRoots":
+			if r.ArrayStart() {
+				v.ShadowRoots = make([]Node, 0, 4)
+				for r.ArrayNext() {
+					var e0 Node
+					e0.DecodeJSON(r)
+					v.ShadowRoots = append(v.ShadowRoots, e0)
+				}
+			} else {
+				v.ShadowRoots = nil
+			}
+		case "templateContent":
+			if r.Null() {
+				v.TemplateContent = nil
+			} else {
+				var p Node
+				p.DecodeJSON(r)
+				v.TemplateContent = &p
+			}
+		case "pseudoElements":
+			if r.ArrayStart() {
+				v.PseudoElements = make([]Node, 0, 4)
+				for r.ArrayNext() {
+					var e0 Node
+					e0.DecodeJSON(r)
+					v.PseudoElements = append(v.PseudoElements, e0)
+				}
+			} else {
+				v.PseudoElements = nil
+			}
+		case "importedDocument":
+			if r.Null() {
+				v.ImportedDocument = nil
+			} else {
+				var p Node
+				p.DecodeJSON(r)
+				v.ImportedDocument = &p
+			}
+		case "distributedNodes":
+			if r.ArrayStart() {
+				v.DistributedNodes = make([]BackendNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 BackendNode
+					e0.DecodeJSON(r)
+					v.DistributedNodes = append(v.DistributedNodes, e0)
+				}
+			} else {
+				v.DistributedNodes = nil
+			}
+		case "isSVG":
+			if r.Null() {
+				v.IsSVG = nil
+			} else {
+				p := r.Bool()
+				v.IsSVG = &p
+			}
+		case "compatibilityMode":
+			v.CompatibilityMode = CompatibilityMode(r.String())
+		case "assignedSlot":
+			if r.Null() {
+				v.AssignedSlot = nil
+			} else {
+				var p BackendNode
+				p.DecodeJSON(r)
+				v.AssignedSlot = &p
+			}
+		case "isScrollable":
+			if r.Null() {
+				v.IsScrollable = nil
+			} else {
+				p := r.Bool()
+				v.IsScrollable = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DetachedElementInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("treeNode")
+	v.TreeNode.EncodeJSON(w)
+	w.Key("retainedNodeIds")
+	if v.RetainedNodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.RetainedNodeIDs {
+			w.Int(int(v.RetainedNodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DetachedElementInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "treeNode":
+			v.TreeNode.DecodeJSON(r)
+		case "retainedNodeIds":
+			if r.ArrayStart() {
+				v.RetainedNodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.RetainedNodeIDs = append(v.RetainedNodeIDs, e0)
+				}
+			} else {
+				v.RetainedNodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RGBA) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("r")
+	w.Int(v.R)
+	w.Key("g")
+	w.Int(v.G)
+	w.Key("b")
+	w.Int(v.B)
+	if v.A != nil {
+		w.Key("a")
+		w.Float(*v.A)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RGBA) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "r":
+			v.R = r.Int()
+		case "g":
+			v.G = r.Int()
+		case "b":
+			v.B = r.Int()
+		case "a":
+			if r.Null() {
+				v.A = nil
+			} else {
+				p := r.Float()
+				v.A = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BoxModel) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("content")
+	if v.Content == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Content {
+			w.Float(v.Content[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("padding")
+	if v.Padding == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Padding {
+			w.Float(v.Padding[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("border")
+	if v.Border == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Border {
+			w.Float(v.Border[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("margin")
+	if v.Margin == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Margin {
+			w.Float(v.Margin[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	if v.ShapeOutside != nil {
+		w.Key("shapeOutside")
+		v.ShapeOutside.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BoxModel) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "content":
+			if r.ArrayStart() {
+				v.Content = make(Quad, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Content = append(v.Content, e0)
+				}
+			} else {
+				v.Content = nil
+			}
+		case "padding":
+			if r.ArrayStart() {
+				v.Padding = make(Quad, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Padding = append(v.Padding, e0)
+				}
+			} else {
+				v.Padding = nil
+			}
+		case "border":
+			if r.ArrayStart() {
+				v.Border = make(Quad, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Border = append(v.Border, e0)
+				}
+			} else {
+				v.Border = nil
+			}
+		case "margin":
+			if r.ArrayStart() {
+				v.Margin = make(Quad, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Margin = append(v.Margin, e0)
+				}
+			} else {
+				v.Margin = nil
+			}
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		case "shapeOutside":
+			if r.Null() {
+				v.ShapeOutside = nil
+			} else {
+				var p ShapeOutsideInfo
+				p.DecodeJSON(r)
+				v.ShapeOutside = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ShapeOutsideInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("bounds")
+	if v.Bounds == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Bounds {
+			w.Float(v.Bounds[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("shape")
+	if v.Shape == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Shape {
+			w.Raw(v.Shape[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("marginShape")
+	if v.MarginShape == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.MarginShape {
+			w.Raw(v.MarginShape[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ShapeOutsideInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "bounds":
+			if r.ArrayStart() {
+				v.Bounds = make(Quad, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Bounds = append(v.Bounds, e0)
+				}
+			} else {
+				v.Bounds = nil
+			}
+		case "shape":
+			if r.ArrayStart() {
+				v.Shape = make([]json.RawMessage, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Raw()
+					v.Shape = append(v.Shape, e0)
+				}
+			} else {
+				v.Shape = nil
+			}
+		case "marginShape":
+			if r.ArrayStart() {
+				v.MarginShape = make([]json.RawMessage, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Raw()
+					v.MarginShape = append(v.MarginShape, e0)
+				}
+			} else {
+				v.MarginShape = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Rect) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("width")
+	w.Float(v.Width)
+	w.Key("height")
+	w.Float(v.Height)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Rect) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "width":
+			v.Width = r.Float()
+		case "height":
+			v.Height = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CSSComputedStyleProperty) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CSSComputedStyleProperty) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CollectClassNamesFromSubtreeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CollectClassNamesFromSubtreeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CollectClassNamesFromSubtreeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("classNames")
+	if v.ClassNames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ClassNames {
+			w.String(v.ClassNames[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CollectClassNamesFromSubtreeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "classNames":
+			if r.ArrayStart() {
+				v.ClassNames = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.ClassNames = append(v.ClassNames, e0)
+				}
+			} else {
+				v.ClassNames = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CopyToArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("targetNodeId")
+	w.Int(int(v.TargetNodeID))
+	if v.InsertBeforeNodeID != nil {
+		w.Key("insertBeforeNodeId")
+		w.Int(int(*v.InsertBeforeNodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CopyToArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "targetNodeId":
+			v.TargetNodeID = NodeID(r.Int())
+		case "insertBeforeNodeId":
+			if r.Null() {
+				v.InsertBeforeNodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.InsertBeforeNodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CopyToReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CopyToReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DescribeNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.Depth != nil {
+		w.Key("depth")
+		w.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		w.Key("pierce")
+		w.Bool(*v.Pierce)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DescribeNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "depth":
+			if r.Null() {
+				v.Depth = nil
+			} else {
+				p := r.Int()
+				v.Depth = &p
+			}
+		case "pierce":
+			if r.Null() {
+				v.Pierce = nil
+			} else {
+				p := r.Bool()
+				v.Pierce = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DescribeNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("node")
+	v.Node.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DescribeNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "node":
+			v.Node.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScrollIntoViewIfNeededArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.Rect != nil {
+		w.Key("rect")
+		v.Rect.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScrollIntoViewIfNeededArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "rect":
+			if r.Null() {
+				v.Rect = nil
+			} else {
+				var p Rect
+				p.DecodeJSON(r)
+				v.Rect = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DiscardSearchResultsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("searchId")
+	w.String(v.SearchID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DiscardSearchResultsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "searchId":
+			v.SearchID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.IncludeWhitespace != nil {
+		w.Key("includeWhitespace")
+		w.String(*v.IncludeWhitespace)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "includeWhitespace":
+			if r.Null() {
+				v.IncludeWhitespace = nil
+			} else {
+				p := r.String()
+				v.IncludeWhitespace = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FocusArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FocusArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAttributesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAttributesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAttributesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("attributes")
+	if v.Attributes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Attributes {
+			w.String(v.Attributes[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAttributesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "attributes":
+			if r.ArrayStart() {
+				v.Attributes = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Attributes = append(v.Attributes, e0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBoxModelArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBoxModelArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBoxModelReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("model")
+	v.Model.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBoxModelReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "model":
+			v.Model.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetContentQuadsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetContentQuadsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetContentQuadsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("quads")
+	if v.Quads == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Quads {
+			if v.Quads[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Quads[i0] {
+					w.Float(v.Quads[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetContentQuadsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "quads":
+			if r.ArrayStart() {
+				v.Quads = make([]Quad, 0, 4)
+				for r.ArrayNext() {
+					var e0 Quad
+					if r.ArrayStart() {
+						e0 = make(Quad, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Quads = append(v.Quads, e0)
+				}
+			} else {
+				v.Quads = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDocumentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Depth != nil {
+		w.Key("depth")
+		w.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		w.Key("pierce")
+		w.Bool(*v.Pierce)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDocumentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "depth":
+			if r.Null() {
+				v.Depth = nil
+			} else {
+				p := r.Int()
+				v.Depth = &p
+			}
+		case "pierce":
+			if r.Null() {
+				v.Pierce = nil
+			} else {
+				p := r.Bool()
+				v.Pierce = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDocumentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("root")
+	v.Root.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDocumentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "root":
+			v.Root.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFlattenedDocumentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Depth != nil {
+		w.Key("depth")
+		w.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		w.Key("pierce")
+		w.Bool(*v.Pierce)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFlattenedDocumentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "depth":
+			if r.Null() {
+				v.Depth = nil
+			} else {
+				p := r.Int()
+				v.Depth = &p
+			}
+		case "pierce":
+			if r.Null() {
+				v.Pierce = nil
+			} else {
+				p := r.Bool()
+				v.Pierce = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFlattenedDocumentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFlattenedDocumentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]Node, 0, 4)
+				for r.ArrayNext() {
+					var e0 Node
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNodesForSubtreeByStyleArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("computedStyles")
+	if v.ComputedStyles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ComputedStyles {
+			v.ComputedStyles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Pierce != nil {
+		w.Key("pierce")
+		w.Bool(*v.Pierce)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNodesForSubtreeByStyleArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "computedStyles":
+			if r.ArrayStart() {
+				v.ComputedStyles = make([]CSSComputedStyleProperty, 0, 4)
+				for r.ArrayNext() {
+					var e0 CSSComputedStyleProperty
+					e0.DecodeJSON(r)
+					v.ComputedStyles = append(v.ComputedStyles, e0)
+				}
+			} else {
+				v.ComputedStyles = nil
+			}
+		case "pierce":
+			if r.Null() {
+				v.Pierce = nil
+			} else {
+				p := r.Bool()
+				v.Pierce = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNodesForSubtreeByStyleReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNodesForSubtreeByStyleReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNodeForLocationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Int(v.X)
+	w.Key("y")
+	w.Int(v.Y)
+	if v.IncludeUserAgentShadowDOM != nil {
+		w.Key("includeUserAgentShadowDOM")
+		w.Bool(*v.IncludeUserAgentShadowDOM)
+	}
+	if v.IgnorePointerEventsNone != nil {
+		w.Key("ignorePointerEventsNone")
+		w.Bool(*v.IgnorePointerEventsNone)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNodeForLocationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Int()
+		case "y":
+			v.Y = r.Int()
+		case "includeUserAgentShadowDOM":
+			if r.Null() {
+				v.IncludeUserAgentShadowDOM = nil
+			} else {
+				p := r.Bool()
+				v.IncludeUserAgentShadowDOM = &p
+			}
+		case "ignorePointerEventsNone":
+			if r.Null() {
+				v.IgnorePointerEventsNone = nil
+			} else {
+				p := r.Bool()
+				v.IgnorePointerEventsNone = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNodeForLocationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNodeForLocationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backendNodeId":
+			v.BackendNodeID = BackendNodeID(r.Int())
+		case "frameId":
+			v.FrameID = internal.PageFrameID(r.String())
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOuterHTMLArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.IncludeShadowDOM != nil {
+		w.Key("includeShadowDOM")
+		w.Bool(*v.IncludeShadowDOM)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOuterHTMLArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "includeShadowDOM":
+			if r.Null() {
+				v.IncludeShadowDOM = nil
+			} else {
+				p := r.Bool()
+				v.IncludeShadowDOM = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOuterHTMLReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("outerHTML")
+	w.String(v.OuterHTML)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOuterHTMLReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "outerHTML":
+			v.OuterHTML = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRelayoutBoundaryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRelayoutBoundaryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetRelayoutBoundaryReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetRelayoutBoundaryReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSearchResultsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("searchId")
+	w.String(v.SearchID)
+	w.Key("fromIndex")
+	w.Int(v.FromIndex)
+	w.Key("toIndex")
+	w.Int(v.ToIndex)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSearchResultsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "searchId":
+			v.SearchID = r.String()
+		case "fromIndex":
+			v.FromIndex = r.Int()
+		case "toIndex":
+			v.ToIndex = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSearchResultsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSearchResultsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MoveToArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("targetNodeId")
+	w.Int(int(v.TargetNodeID))
+	if v.InsertBeforeNodeID != nil {
+		w.Key("insertBeforeNodeId")
+		w.Int(int(*v.InsertBeforeNodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MoveToArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "targetNodeId":
+			v.TargetNodeID = NodeID(r.Int())
+		case "insertBeforeNodeId":
+			if r.Null() {
+				v.InsertBeforeNodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.InsertBeforeNodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MoveToReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MoveToReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PerformSearchArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("query")
+	w.String(v.Query)
+	if v.IncludeUserAgentShadowDOM != nil {
+		w.Key("includeUserAgentShadowDOM")
+		w.Bool(*v.IncludeUserAgentShadowDOM)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PerformSearchArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "query":
+			v.Query = r.String()
+		case "includeUserAgentShadowDOM":
+			if r.Null() {
+				v.IncludeUserAgentShadowDOM = nil
+			} else {
+				p := r.Bool()
+				v.IncludeUserAgentShadowDOM = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PerformSearchReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("searchId")
+	w.String(v.SearchID)
+	w.Key("resultCount")
+	w.Int(v.ResultCount)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PerformSearchReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "searchId":
+			v.SearchID = r.String()
+		case "resultCount":
+			v.ResultCount = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PushNodeByPathToFrontendArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("path")
+	w.String(v.Path)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PushNodeByPathToFrontendArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "path":
+			v.Path = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PushNodeByPathToFrontendReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PushNodeByPathToFrontendReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PushNodesByBackendIDsToFrontendArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backendNodeIds")
+	if v.BackendNodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.BackendNodeIDs {
+			w.Int(int(v.BackendNodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PushNodesByBackendIDsToFrontendArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backendNodeIds":
+			if r.ArrayStart() {
+				v.BackendNodeIDs = make([]BackendNodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := BackendNodeID(r.Int())
+					v.BackendNodeIDs = append(v.BackendNodeIDs, e0)
+				}
+			} else {
+				v.BackendNodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PushNodesByBackendIDsToFrontendReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PushNodesByBackendIDsToFrontendReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QuerySelectorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("selector")
+	w.String(v.Selector)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QuerySelectorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "selector":
+			v.Selector = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QuerySelectorReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QuerySelectorReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QuerySelectorAllArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("selector")
+	w.String(v.Selector)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QuerySelectorAllArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "selector":
+			v.Selector = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *QuerySelectorAllReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *QuerySelectorAllReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetTopLayerElementsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetTopLayerElementsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetElementByRelationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("relation")
+	w.String(v.Relation)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetElementByRelationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "relation":
+			v.Relation = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetElementByRelationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetElementByRelationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveAttributeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("name")
+	w.String(v.Name)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveAttributeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "name":
+			v.Name = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestChildNodesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	if v.Depth != nil {
+		w.Key("depth")
+		w.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		w.Key("pierce")
+		w.Bool(*v.Pierce)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestChildNodesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "depth":
+			if r.Null() {
+				v.Depth = nil
+			} else {
+				p := r.Int()
+				v.Depth = &p
+			}
+		case "pierce":
+			if r.Null() {
+				v.Pierce = nil
+			} else {
+				p := r.Bool()
+				v.Pierce = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = runtime.RemoteObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	if v.ExecutionContextID != nil {
+		w.Key("executionContextId")
+		w.Int(int(*v.ExecutionContextID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		case "executionContextId":
+			if r.Null() {
+				v.ExecutionContextID = nil
+			} else {
+				p := runtime.ExecutionContextID(r.Int())
+				v.ExecutionContextID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResolveNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("object")
+	v.Object.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResolveNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "object":
+			v.Object.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAttributeValueArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAttributeValueArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAttributesAsTextArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("text")
+	w.String(v.Text)
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAttributesAsTextArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "text":
+			v.Text = r.String()
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetFileInputFilesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("files")
+	if v.Files == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Files {
+			w.String(v.Files[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetFileInputFilesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "files":
+			if r.ArrayStart() {
+				v.Files = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Files = append(v.Files, e0)
+				}
+			} else {
+				v.Files = nil
+			}
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetNodeStackTracesEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetNodeStackTracesEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNodeStackTracesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNodeStackTracesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNodeStackTracesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Creation != nil {
+		w.Key("creation")
+		v.Creation.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNodeStackTracesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "creation":
+			if r.Null() {
+				v.Creation = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.Creation = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFileInfoArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFileInfoArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = runtime.RemoteObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFileInfoReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("path")
+	w.String(v.Path)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFileInfoReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "path":
+			v.Path = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDetachedDOMNodesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("detachedNodes")
+	if v.DetachedNodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DetachedNodes {
+			v.DetachedNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDetachedDOMNodesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "detachedNodes":
+			if r.ArrayStart() {
+				v.DetachedNodes = make([]DetachedElementInfo, 0, 4)
+				for r.ArrayNext() {
+					var e0 DetachedElementInfo
+					e0.DecodeJSON(r)
+					v.DetachedNodes = append(v.DetachedNodes, e0)
+				}
+			} else {
+				v.DetachedNodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInspectedNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInspectedNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetNodeNameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("name")
+	w.String(v.Name)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetNodeNameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "name":
+			v.Name = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetNodeNameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetNodeNameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetNodeValueArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetNodeValueArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetOuterHTMLArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("outerHTML")
+	w.String(v.OuterHTML)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetOuterHTMLArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "outerHTML":
+			v.OuterHTML = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFrameOwnerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFrameOwnerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = internal.PageFrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFrameOwnerReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFrameOwnerReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backendNodeId":
+			v.BackendNodeID = BackendNodeID(r.Int())
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetContainerForNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	if v.ContainerName != nil {
+		w.Key("containerName")
+		w.String(*v.ContainerName)
+	}
+	if v.PhysicalAxes != "" {
+		w.Key("physicalAxes")
+		w.String(string(v.PhysicalAxes))
+	}
+	if v.LogicalAxes != "" {
+		w.Key("logicalAxes")
+		w.String(string(v.LogicalAxes))
+	}
+	if v.QueriesScrollState != nil {
+		w.Key("queriesScrollState")
+		w.Bool(*v.QueriesScrollState)
+	}
+	if v.QueriesAnchored != nil {
+		w.Key("queriesAnchored")
+		w.Bool(*v.QueriesAnchored)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetContainerForNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "containerName":
+			if r.Null() {
+				v.ContainerName = nil
+			} else {
+				p := r.String()
+				v.ContainerName = &p
+			}
+		case "physicalAxes":
+			v.PhysicalAxes = PhysicalAxes(r.String())
+		case "logicalAxes":
+			v.LogicalAxes = LogicalAxes(r.String())
+		case "queriesScrollState":
+			if r.Null() {
+				v.QueriesScrollState = nil
+			} else {
+				p := r.Bool()
+				v.QueriesScrollState = &p
+			}
+		case "queriesAnchored":
+			if r.Null() {
+				v.QueriesAnchored = nil
+			} else {
+				p := r.Bool()
+				v.QueriesAnchored = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetContainerForNodeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetContainerForNodeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := NodeID(r.Int())
+				v.NodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetQueryingDescendantsForContainerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetQueryingDescendantsForContainerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetQueryingDescendantsForContainerReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetQueryingDescendantsForContainerReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAnchorElementArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	if v.AnchorSpecifier != nil {
+		w.Key("anchorSpecifier")
+		w.String(*v.AnchorSpecifier)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAnchorElementArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "anchorSpecifier":
+			if r.Null() {
+				v.AnchorSpecifier = nil
+			} else {
+				p := r.String()
+				v.AnchorSpecifier = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAnchorElementReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAnchorElementReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ForceShowPopoverArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("enable")
+	w.Bool(v.Enable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ForceShowPopoverArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "enable":
+			v.Enable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ForceShowPopoverReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ForceShowPopoverReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributeModifiedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributeModifiedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttributeRemovedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("name")
+	w.String(v.Name)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttributeRemovedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "name":
+			v.Name = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CharacterDataModifiedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("characterData")
+	w.String(v.CharacterData)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CharacterDataModifiedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "characterData":
+			v.CharacterData = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ChildNodeCountUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("childNodeCount")
+	w.Int(v.ChildNodeCount)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ChildNodeCountUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "childNodeCount":
+			v.ChildNodeCount = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ChildNodeInsertedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentNodeId")
+	w.Int(int(v.ParentNodeID))
+	w.Key("previousNodeId")
+	w.Int(int(v.PreviousNodeID))
+	w.Key("node")
+	v.Node.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ChildNodeInsertedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentNodeId":
+			v.ParentNodeID = NodeID(r.Int())
+		case "previousNodeId":
+			v.PreviousNodeID = NodeID(r.Int())
+		case "node":
+			v.Node.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ChildNodeRemovedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentNodeId")
+	w.Int(int(v.ParentNodeID))
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ChildNodeRemovedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentNodeId":
+			v.ParentNodeID = NodeID(r.Int())
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DistributedNodesUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("insertionPointId")
+	w.Int(int(v.InsertionPointID))
+	w.Key("distributedNodes")
+	if v.DistributedNodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DistributedNodes {
+			v.DistributedNodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DistributedNodesUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "insertionPointId":
+			v.InsertionPointID = NodeID(r.Int())
+		case "distributedNodes":
+			if r.ArrayStart() {
+				v.DistributedNodes = make([]BackendNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 BackendNode
+					e0.DecodeJSON(r)
+					v.DistributedNodes = append(v.DistributedNodes, e0)
+				}
+			} else {
+				v.DistributedNodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DocumentUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DocumentUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InlineStyleInvalidatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InlineStyleInvalidatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PseudoElementAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentId")
+	w.Int(int(v.ParentID))
+	w.Key("pseudoElement")
+	v.PseudoElement.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PseudoElementAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentId":
+			v.ParentID = NodeID(r.Int())
+		case "pseudoElement":
+			v.PseudoElement.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TopLayerElementsUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TopLayerElementsUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScrollableFlagUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.Key("isScrollable")
+	w.Bool(v.IsScrollable)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScrollableFlagUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = NodeID(r.Int())
+		case "isScrollable":
+			v.IsScrollable = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PseudoElementRemovedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentId")
+	w.Int(int(v.ParentID))
+	w.Key("pseudoElementId")
+	w.Int(int(v.PseudoElementID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PseudoElementRemovedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentId":
+			v.ParentID = NodeID(r.Int())
+		case "pseudoElementId":
+			v.PseudoElementID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetChildNodesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentId")
+	w.Int(int(v.ParentID))
+	w.Key("nodes")
+	if v.Nodes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Nodes {
+			v.Nodes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetChildNodesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentId":
+			v.ParentID = NodeID(r.Int())
+		case "nodes":
+			if r.ArrayStart() {
+				v.Nodes = make([]Node, 0, 4)
+				for r.ArrayNext() {
+					var e0 Node
+					e0.DecodeJSON(r)
+					v.Nodes = append(v.Nodes, e0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ShadowRootPoppedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("hostId")
+	w.Int(int(v.HostID))
+	w.Key("rootId")
+	w.Int(int(v.RootID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ShadowRootPoppedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "hostId":
+			v.HostID = NodeID(r.Int())
+		case "rootId":
+			v.RootID = NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ShadowRootPushedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("hostId")
+	w.Int(int(v.HostID))
+	w.Key("root")
+	v.Root.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ShadowRootPushedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "hostId":
+			v.HostID = NodeID(r.Int())
+		case "root":
+			v.Root.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
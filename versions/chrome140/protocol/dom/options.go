@@ -0,0 +1,605 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// CopyToOption sets an optional argument of CopyToArgs.
+type CopyToOption func(*CopyToArgs)
+
+// NewCopyToArgsWith initializes CopyToArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCopyToArgsWith(nodeID NodeID, targetNodeID NodeID, opts ...CopyToOption) *CopyToArgs {
+	args := NewCopyToArgs(nodeID, targetNodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CopyToWithInsertBeforeNodeID sets the InsertBeforeNodeID optional argument, see
+// CopyToArgs.SetInsertBeforeNodeID.
+func CopyToWithInsertBeforeNodeID(v NodeID) CopyToOption {
+	return func(a *CopyToArgs) { a.SetInsertBeforeNodeID(v) }
+}
+
+// DescribeNodeOption sets an optional argument of DescribeNodeArgs.
+type DescribeNodeOption func(*DescribeNodeArgs)
+
+// NewDescribeNodeArgsWith initializes DescribeNodeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDescribeNodeArgsWith(opts ...DescribeNodeOption) *DescribeNodeArgs {
+	args := NewDescribeNodeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DescribeNodeWithNodeID sets the NodeID optional argument, see
+// DescribeNodeArgs.SetNodeID.
+func DescribeNodeWithNodeID(v NodeID) DescribeNodeOption {
+	return func(a *DescribeNodeArgs) { a.SetNodeID(v) }
+}
+
+// DescribeNodeWithBackendNodeID sets the BackendNodeID optional argument, see
+// DescribeNodeArgs.SetBackendNodeID.
+func DescribeNodeWithBackendNodeID(v BackendNodeID) DescribeNodeOption {
+	return func(a *DescribeNodeArgs) { a.SetBackendNodeID(v) }
+}
+
+// DescribeNodeWithObjectID sets the ObjectID optional argument, see
+// DescribeNodeArgs.SetObjectID.
+func DescribeNodeWithObjectID(v runtime.RemoteObjectID) DescribeNodeOption {
+	return func(a *DescribeNodeArgs) { a.SetObjectID(v) }
+}
+
+// DescribeNodeWithDepth sets the Depth optional argument, see
+// DescribeNodeArgs.SetDepth.
+func DescribeNodeWithDepth(v int) DescribeNodeOption {
+	return func(a *DescribeNodeArgs) { a.SetDepth(v) }
+}
+
+// DescribeNodeWithPierce sets the Pierce optional argument, see
+// DescribeNodeArgs.SetPierce.
+func DescribeNodeWithPierce(v bool) DescribeNodeOption {
+	return func(a *DescribeNodeArgs) { a.SetPierce(v) }
+}
+
+// ScrollIntoViewIfNeededOption sets an optional argument of ScrollIntoViewIfNeededArgs.
+type ScrollIntoViewIfNeededOption func(*ScrollIntoViewIfNeededArgs)
+
+// NewScrollIntoViewIfNeededArgsWith initializes ScrollIntoViewIfNeededArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewScrollIntoViewIfNeededArgsWith(opts ...ScrollIntoViewIfNeededOption) *ScrollIntoViewIfNeededArgs {
+	args := NewScrollIntoViewIfNeededArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ScrollIntoViewIfNeededWithNodeID sets the NodeID optional argument, see
+// ScrollIntoViewIfNeededArgs.SetNodeID.
+func ScrollIntoViewIfNeededWithNodeID(v NodeID) ScrollIntoViewIfNeededOption {
+	return func(a *ScrollIntoViewIfNeededArgs) { a.SetNodeID(v) }
+}
+
+// ScrollIntoViewIfNeededWithBackendNodeID sets the BackendNodeID optional argument, see
+// ScrollIntoViewIfNeededArgs.SetBackendNodeID.
+func ScrollIntoViewIfNeededWithBackendNodeID(v BackendNodeID) ScrollIntoViewIfNeededOption {
+	return func(a *ScrollIntoViewIfNeededArgs) { a.SetBackendNodeID(v) }
+}
+
+// ScrollIntoViewIfNeededWithObjectID sets the ObjectID optional argument, see
+// ScrollIntoViewIfNeededArgs.SetObjectID.
+func ScrollIntoViewIfNeededWithObjectID(v runtime.RemoteObjectID) ScrollIntoViewIfNeededOption {
+	return func(a *ScrollIntoViewIfNeededArgs) { a.SetObjectID(v) }
+}
+
+// ScrollIntoViewIfNeededWithRect sets the Rect optional argument, see
+// ScrollIntoViewIfNeededArgs.SetRect.
+func ScrollIntoViewIfNeededWithRect(v Rect) ScrollIntoViewIfNeededOption {
+	return func(a *ScrollIntoViewIfNeededArgs) { a.SetRect(v) }
+}
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithIncludeWhitespace sets the IncludeWhitespace optional argument, see
+// EnableArgs.SetIncludeWhitespace.
+//
+// Note: This argument is experimental.
+func EnableWithIncludeWhitespace(v string) EnableOption {
+	return func(a *EnableArgs) { a.SetIncludeWhitespace(v) }
+}
+
+// FocusOption sets an optional argument of FocusArgs.
+type FocusOption func(*FocusArgs)
+
+// NewFocusArgsWith initializes FocusArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewFocusArgsWith(opts ...FocusOption) *FocusArgs {
+	args := NewFocusArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// FocusWithNodeID sets the NodeID optional argument, see
+// FocusArgs.SetNodeID.
+func FocusWithNodeID(v NodeID) FocusOption {
+	return func(a *FocusArgs) { a.SetNodeID(v) }
+}
+
+// FocusWithBackendNodeID sets the BackendNodeID optional argument, see
+// FocusArgs.SetBackendNodeID.
+func FocusWithBackendNodeID(v BackendNodeID) FocusOption {
+	return func(a *FocusArgs) { a.SetBackendNodeID(v) }
+}
+
+// FocusWithObjectID sets the ObjectID optional argument, see
+// FocusArgs.SetObjectID.
+func FocusWithObjectID(v runtime.RemoteObjectID) FocusOption {
+	return func(a *FocusArgs) { a.SetObjectID(v) }
+}
+
+// GetBoxModelOption sets an optional argument of GetBoxModelArgs.
+type GetBoxModelOption func(*GetBoxModelArgs)
+
+// NewGetBoxModelArgsWith initializes GetBoxModelArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetBoxModelArgsWith(opts ...GetBoxModelOption) *GetBoxModelArgs {
+	args := NewGetBoxModelArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetBoxModelWithNodeID sets the NodeID optional argument, see
+// GetBoxModelArgs.SetNodeID.
+func GetBoxModelWithNodeID(v NodeID) GetBoxModelOption {
+	return func(a *GetBoxModelArgs) { a.SetNodeID(v) }
+}
+
+// GetBoxModelWithBackendNodeID sets the BackendNodeID optional argument, see
+// GetBoxModelArgs.SetBackendNodeID.
+func GetBoxModelWithBackendNodeID(v BackendNodeID) GetBoxModelOption {
+	return func(a *GetBoxModelArgs) { a.SetBackendNodeID(v) }
+}
+
+// GetBoxModelWithObjectID sets the ObjectID optional argument, see
+// GetBoxModelArgs.SetObjectID.
+func GetBoxModelWithObjectID(v runtime.RemoteObjectID) GetBoxModelOption {
+	return func(a *GetBoxModelArgs) { a.SetObjectID(v) }
+}
+
+// GetContentQuadsOption sets an optional argument of GetContentQuadsArgs.
+type GetContentQuadsOption func(*GetContentQuadsArgs)
+
+// NewGetContentQuadsArgsWith initializes GetContentQuadsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetContentQuadsArgsWith(opts ...GetContentQuadsOption) *GetContentQuadsArgs {
+	args := NewGetContentQuadsArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetContentQuadsWithNodeID sets the NodeID optional argument, see
+// GetContentQuadsArgs.SetNodeID.
+func GetContentQuadsWithNodeID(v NodeID) GetContentQuadsOption {
+	return func(a *GetContentQuadsArgs) { a.SetNodeID(v) }
+}
+
+// GetContentQuadsWithBackendNodeID sets the BackendNodeID optional argument, see
+// GetContentQuadsArgs.SetBackendNodeID.
+func GetContentQuadsWithBackendNodeID(v BackendNodeID) GetContentQuadsOption {
+	return func(a *GetContentQuadsArgs) { a.SetBackendNodeID(v) }
+}
+
+// GetContentQuadsWithObjectID sets the ObjectID optional argument, see
+// GetContentQuadsArgs.SetObjectID.
+func GetContentQuadsWithObjectID(v runtime.RemoteObjectID) GetContentQuadsOption {
+	return func(a *GetContentQuadsArgs) { a.SetObjectID(v) }
+}
+
+// GetDocumentOption sets an optional argument of GetDocumentArgs.
+type GetDocumentOption func(*GetDocumentArgs)
+
+// NewGetDocumentArgsWith initializes GetDocumentArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetDocumentArgsWith(opts ...GetDocumentOption) *GetDocumentArgs {
+	args := NewGetDocumentArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetDocumentWithDepth sets the Depth optional argument, see
+// GetDocumentArgs.SetDepth.
+func GetDocumentWithDepth(v int) GetDocumentOption {
+	return func(a *GetDocumentArgs) { a.SetDepth(v) }
+}
+
+// GetDocumentWithPierce sets the Pierce optional argument, see
+// GetDocumentArgs.SetPierce.
+func GetDocumentWithPierce(v bool) GetDocumentOption {
+	return func(a *GetDocumentArgs) { a.SetPierce(v) }
+}
+
+// GetFlattenedDocumentOption sets an optional argument of GetFlattenedDocumentArgs.
+type GetFlattenedDocumentOption func(*GetFlattenedDocumentArgs)
+
+// NewGetFlattenedDocumentArgsWith initializes GetFlattenedDocumentArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetFlattenedDocumentArgsWith(opts ...GetFlattenedDocumentOption) *GetFlattenedDocumentArgs {
+	args := NewGetFlattenedDocumentArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetFlattenedDocumentWithDepth sets the Depth optional argument, see
+// GetFlattenedDocumentArgs.SetDepth.
+func GetFlattenedDocumentWithDepth(v int) GetFlattenedDocumentOption {
+	return func(a *GetFlattenedDocumentArgs) { a.SetDepth(v) }
+}
+
+// GetFlattenedDocumentWithPierce sets the Pierce optional argument, see
+// GetFlattenedDocumentArgs.SetPierce.
+func GetFlattenedDocumentWithPierce(v bool) GetFlattenedDocumentOption {
+	return func(a *GetFlattenedDocumentArgs) { a.SetPierce(v) }
+}
+
+// GetNodesForSubtreeByStyleOption sets an optional argument of GetNodesForSubtreeByStyleArgs.
+type GetNodesForSubtreeByStyleOption func(*GetNodesForSubtreeByStyleArgs)
+
+// NewGetNodesForSubtreeByStyleArgsWith initializes GetNodesForSubtreeByStyleArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetNodesForSubtreeByStyleArgsWith(nodeID NodeID, computedStyles []CSSComputedStyleProperty, opts ...GetNodesForSubtreeByStyleOption) *GetNodesForSubtreeByStyleArgs {
+	args := NewGetNodesForSubtreeByStyleArgs(nodeID, computedStyles)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetNodesForSubtreeByStyleWithPierce sets the Pierce optional argument, see
+// GetNodesForSubtreeByStyleArgs.SetPierce.
+func GetNodesForSubtreeByStyleWithPierce(v bool) GetNodesForSubtreeByStyleOption {
+	return func(a *GetNodesForSubtreeByStyleArgs) { a.SetPierce(v) }
+}
+
+// GetNodeForLocationOption sets an optional argument of GetNodeForLocationArgs.
+type GetNodeForLocationOption func(*GetNodeForLocationArgs)
+
+// NewGetNodeForLocationArgsWith initializes GetNodeForLocationArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetNodeForLocationArgsWith(x int, y int, opts ...GetNodeForLocationOption) *GetNodeForLocationArgs {
+	args := NewGetNodeForLocationArgs(x, y)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetNodeForLocationWithIncludeUserAgentShadowDOM sets the IncludeUserAgentShadowDOM optional argument, see
+// GetNodeForLocationArgs.SetIncludeUserAgentShadowDOM.
+func GetNodeForLocationWithIncludeUserAgentShadowDOM(v bool) GetNodeForLocationOption {
+	return func(a *GetNodeForLocationArgs) { a.SetIncludeUserAgentShadowDOM(v) }
+}
+
+// GetNodeForLocationWithIgnorePointerEventsNone sets the IgnorePointerEventsNone optional argument, see
+// GetNodeForLocationArgs.SetIgnorePointerEventsNone.
+func GetNodeForLocationWithIgnorePointerEventsNone(v bool) GetNodeForLocationOption {
+	return func(a *GetNodeForLocationArgs) { a.SetIgnorePointerEventsNone(v) }
+}
+
+// GetOuterHTMLOption sets an optional argument of GetOuterHTMLArgs.
+type GetOuterHTMLOption func(*GetOuterHTMLArgs)
+
+// NewGetOuterHTMLArgsWith initializes GetOuterHTMLArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetOuterHTMLArgsWith(opts ...GetOuterHTMLOption) *GetOuterHTMLArgs {
+	args := NewGetOuterHTMLArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetOuterHTMLWithNodeID sets the NodeID optional argument, see
+// GetOuterHTMLArgs.SetNodeID.
+func GetOuterHTMLWithNodeID(v NodeID) GetOuterHTMLOption {
+	return func(a *GetOuterHTMLArgs) { a.SetNodeID(v) }
+}
+
+// GetOuterHTMLWithBackendNodeID sets the BackendNodeID optional argument, see
+// GetOuterHTMLArgs.SetBackendNodeID.
+func GetOuterHTMLWithBackendNodeID(v BackendNodeID) GetOuterHTMLOption {
+	return func(a *GetOuterHTMLArgs) { a.SetBackendNodeID(v) }
+}
+
+// GetOuterHTMLWithObjectID sets the ObjectID optional argument, see
+// GetOuterHTMLArgs.SetObjectID.
+func GetOuterHTMLWithObjectID(v runtime.RemoteObjectID) GetOuterHTMLOption {
+	return func(a *GetOuterHTMLArgs) { a.SetObjectID(v) }
+}
+
+// GetOuterHTMLWithIncludeShadowDOM sets the IncludeShadowDOM optional argument, see
+// GetOuterHTMLArgs.SetIncludeShadowDOM.
+//
+// Note: This argument is experimental.
+func GetOuterHTMLWithIncludeShadowDOM(v bool) GetOuterHTMLOption {
+	return func(a *GetOuterHTMLArgs) { a.SetIncludeShadowDOM(v) }
+}
+
+// MoveToOption sets an optional argument of MoveToArgs.
+type MoveToOption func(*MoveToArgs)
+
+// NewMoveToArgsWith initializes MoveToArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewMoveToArgsWith(nodeID NodeID, targetNodeID NodeID, opts ...MoveToOption) *MoveToArgs {
+	args := NewMoveToArgs(nodeID, targetNodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// MoveToWithInsertBeforeNodeID sets the InsertBeforeNodeID optional argument, see
+// MoveToArgs.SetInsertBeforeNodeID.
+func MoveToWithInsertBeforeNodeID(v NodeID) MoveToOption {
+	return func(a *MoveToArgs) { a.SetInsertBeforeNodeID(v) }
+}
+
+// PerformSearchOption sets an optional argument of PerformSearchArgs.
+type PerformSearchOption func(*PerformSearchArgs)
+
+// NewPerformSearchArgsWith initializes PerformSearchArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewPerformSearchArgsWith(query string, opts ...PerformSearchOption) *PerformSearchArgs {
+	args := NewPerformSearchArgs(query)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// PerformSearchWithIncludeUserAgentShadowDOM sets the IncludeUserAgentShadowDOM optional argument, see
+// PerformSearchArgs.SetIncludeUserAgentShadowDOM.
+func PerformSearchWithIncludeUserAgentShadowDOM(v bool) PerformSearchOption {
+	return func(a *PerformSearchArgs) { a.SetIncludeUserAgentShadowDOM(v) }
+}
+
+// RequestChildNodesOption sets an optional argument of RequestChildNodesArgs.
+type RequestChildNodesOption func(*RequestChildNodesArgs)
+
+// NewRequestChildNodesArgsWith initializes RequestChildNodesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRequestChildNodesArgsWith(nodeID NodeID, opts ...RequestChildNodesOption) *RequestChildNodesArgs {
+	args := NewRequestChildNodesArgs(nodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RequestChildNodesWithDepth sets the Depth optional argument, see
+// RequestChildNodesArgs.SetDepth.
+func RequestChildNodesWithDepth(v int) RequestChildNodesOption {
+	return func(a *RequestChildNodesArgs) { a.SetDepth(v) }
+}
+
+// RequestChildNodesWithPierce sets the Pierce optional argument, see
+// RequestChildNodesArgs.SetPierce.
+func RequestChildNodesWithPierce(v bool) RequestChildNodesOption {
+	return func(a *RequestChildNodesArgs) { a.SetPierce(v) }
+}
+
+// ResolveNodeOption sets an optional argument of ResolveNodeArgs.
+type ResolveNodeOption func(*ResolveNodeArgs)
+
+// NewResolveNodeArgsWith initializes ResolveNodeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewResolveNodeArgsWith(opts ...ResolveNodeOption) *ResolveNodeArgs {
+	args := NewResolveNodeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ResolveNodeWithNodeID sets the NodeID optional argument, see
+// ResolveNodeArgs.SetNodeID.
+func ResolveNodeWithNodeID(v NodeID) ResolveNodeOption {
+	return func(a *ResolveNodeArgs) { a.SetNodeID(v) }
+}
+
+// ResolveNodeWithBackendNodeID sets the BackendNodeID optional argument, see
+// ResolveNodeArgs.SetBackendNodeID.
+func ResolveNodeWithBackendNodeID(v BackendNodeID) ResolveNodeOption {
+	return func(a *ResolveNodeArgs) { a.SetBackendNodeID(v) }
+}
+
+// ResolveNodeWithObjectGroup sets the ObjectGroup optional argument, see
+// ResolveNodeArgs.SetObjectGroup.
+func ResolveNodeWithObjectGroup(v string) ResolveNodeOption {
+	return func(a *ResolveNodeArgs) { a.SetObjectGroup(v) }
+}
+
+// ResolveNodeWithExecutionContextID sets the ExecutionContextID optional argument, see
+// ResolveNodeArgs.SetExecutionContextID.
+func ResolveNodeWithExecutionContextID(v runtime.ExecutionContextID) ResolveNodeOption {
+	return func(a *ResolveNodeArgs) { a.SetExecutionContextID(v) }
+}
+
+// SetAttributesAsTextOption sets an optional argument of SetAttributesAsTextArgs.
+type SetAttributesAsTextOption func(*SetAttributesAsTextArgs)
+
+// NewSetAttributesAsTextArgsWith initializes SetAttributesAsTextArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetAttributesAsTextArgsWith(nodeID NodeID, text string, opts ...SetAttributesAsTextOption) *SetAttributesAsTextArgs {
+	args := NewSetAttributesAsTextArgs(nodeID, text)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetAttributesAsTextWithName sets the Name optional argument, see
+// SetAttributesAsTextArgs.SetName.
+func SetAttributesAsTextWithName(v string) SetAttributesAsTextOption {
+	return func(a *SetAttributesAsTextArgs) { a.SetName(v) }
+}
+
+// SetFileInputFilesOption sets an optional argument of SetFileInputFilesArgs.
+type SetFileInputFilesOption func(*SetFileInputFilesArgs)
+
+// NewSetFileInputFilesArgsWith initializes SetFileInputFilesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetFileInputFilesArgsWith(files []string, opts ...SetFileInputFilesOption) *SetFileInputFilesArgs {
+	args := NewSetFileInputFilesArgs(files)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetFileInputFilesWithNodeID sets the NodeID optional argument, see
+// SetFileInputFilesArgs.SetNodeID.
+func SetFileInputFilesWithNodeID(v NodeID) SetFileInputFilesOption {
+	return func(a *SetFileInputFilesArgs) { a.SetNodeID(v) }
+}
+
+// SetFileInputFilesWithBackendNodeID sets the BackendNodeID optional argument, see
+// SetFileInputFilesArgs.SetBackendNodeID.
+func SetFileInputFilesWithBackendNodeID(v BackendNodeID) SetFileInputFilesOption {
+	return func(a *SetFileInputFilesArgs) { a.SetBackendNodeID(v) }
+}
+
+// SetFileInputFilesWithObjectID sets the ObjectID optional argument, see
+// SetFileInputFilesArgs.SetObjectID.
+func SetFileInputFilesWithObjectID(v runtime.RemoteObjectID) SetFileInputFilesOption {
+	return func(a *SetFileInputFilesArgs) { a.SetObjectID(v) }
+}
+
+// GetContainerForNodeOption sets an optional argument of GetContainerForNodeArgs.
+type GetContainerForNodeOption func(*GetContainerForNodeArgs)
+
+// NewGetContainerForNodeArgsWith initializes GetContainerForNodeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetContainerForNodeArgsWith(nodeID NodeID, opts ...GetContainerForNodeOption) *GetContainerForNodeArgs {
+	args := NewGetContainerForNodeArgs(nodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetContainerForNodeWithContainerName sets the ContainerName optional argument, see
+// GetContainerForNodeArgs.SetContainerName.
+func GetContainerForNodeWithContainerName(v string) GetContainerForNodeOption {
+	return func(a *GetContainerForNodeArgs) { a.SetContainerName(v) }
+}
+
+// GetContainerForNodeWithPhysicalAxes sets the PhysicalAxes optional argument, see
+// GetContainerForNodeArgs.SetPhysicalAxes.
+func GetContainerForNodeWithPhysicalAxes(v PhysicalAxes) GetContainerForNodeOption {
+	return func(a *GetContainerForNodeArgs) { a.SetPhysicalAxes(v) }
+}
+
+// GetContainerForNodeWithLogicalAxes sets the LogicalAxes optional argument, see
+// GetContainerForNodeArgs.SetLogicalAxes.
+func GetContainerForNodeWithLogicalAxes(v LogicalAxes) GetContainerForNodeOption {
+	return func(a *GetContainerForNodeArgs) { a.SetLogicalAxes(v) }
+}
+
+// GetContainerForNodeWithQueriesScrollState sets the QueriesScrollState optional argument, see
+// GetContainerForNodeArgs.SetQueriesScrollState.
+func GetContainerForNodeWithQueriesScrollState(v bool) GetContainerForNodeOption {
+	return func(a *GetContainerForNodeArgs) { a.SetQueriesScrollState(v) }
+}
+
+// GetContainerForNodeWithQueriesAnchored sets the QueriesAnchored optional argument, see
+// GetContainerForNodeArgs.SetQueriesAnchored.
+func GetContainerForNodeWithQueriesAnchored(v bool) GetContainerForNodeOption {
+	return func(a *GetContainerForNodeArgs) { a.SetQueriesAnchored(v) }
+}
+
+// GetAnchorElementOption sets an optional argument of GetAnchorElementArgs.
+type GetAnchorElementOption func(*GetAnchorElementArgs)
+
+// NewGetAnchorElementArgsWith initializes GetAnchorElementArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetAnchorElementArgsWith(nodeID NodeID, opts ...GetAnchorElementOption) *GetAnchorElementArgs {
+	args := NewGetAnchorElementArgs(nodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetAnchorElementWithAnchorSpecifier sets the AnchorSpecifier optional argument, see
+// GetAnchorElementArgs.SetAnchorSpecifier.
+func GetAnchorElementWithAnchorSpecifier(v string) GetAnchorElementOption {
+	return func(a *GetAnchorElementArgs) { a.SetAnchorSpecifier(v) }
+}
@@ -0,0 +1,79 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package domsnapshot
+
+// GetSnapshotOption sets an optional argument of GetSnapshotArgs.
+type GetSnapshotOption func(*GetSnapshotArgs)
+
+// NewGetSnapshotArgsWith initializes GetSnapshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetSnapshotArgsWith(computedStyleWhitelist []string, opts ...GetSnapshotOption) *GetSnapshotArgs {
+	args := NewGetSnapshotArgs(computedStyleWhitelist)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetSnapshotWithIncludeEventListeners sets the IncludeEventListeners optional argument, see
+// GetSnapshotArgs.SetIncludeEventListeners.
+func GetSnapshotWithIncludeEventListeners(v bool) GetSnapshotOption {
+	return func(a *GetSnapshotArgs) { a.SetIncludeEventListeners(v) }
+}
+
+// GetSnapshotWithIncludePaintOrder sets the IncludePaintOrder optional argument, see
+// GetSnapshotArgs.SetIncludePaintOrder.
+func GetSnapshotWithIncludePaintOrder(v bool) GetSnapshotOption {
+	return func(a *GetSnapshotArgs) { a.SetIncludePaintOrder(v) }
+}
+
+// GetSnapshotWithIncludeUserAgentShadowTree sets the IncludeUserAgentShadowTree optional argument, see
+// GetSnapshotArgs.SetIncludeUserAgentShadowTree.
+func GetSnapshotWithIncludeUserAgentShadowTree(v bool) GetSnapshotOption {
+	return func(a *GetSnapshotArgs) { a.SetIncludeUserAgentShadowTree(v) }
+}
+
+// CaptureSnapshotOption sets an optional argument of CaptureSnapshotArgs.
+type CaptureSnapshotOption func(*CaptureSnapshotArgs)
+
+// NewCaptureSnapshotArgsWith initializes CaptureSnapshotArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCaptureSnapshotArgsWith(computedStyles []string, opts ...CaptureSnapshotOption) *CaptureSnapshotArgs {
+	args := NewCaptureSnapshotArgs(computedStyles)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CaptureSnapshotWithIncludePaintOrder sets the IncludePaintOrder optional argument, see
+// CaptureSnapshotArgs.SetIncludePaintOrder.
+func CaptureSnapshotWithIncludePaintOrder(v bool) CaptureSnapshotOption {
+	return func(a *CaptureSnapshotArgs) { a.SetIncludePaintOrder(v) }
+}
+
+// CaptureSnapshotWithIncludeDOMRects sets the IncludeDOMRects optional argument, see
+// CaptureSnapshotArgs.SetIncludeDOMRects.
+func CaptureSnapshotWithIncludeDOMRects(v bool) CaptureSnapshotOption {
+	return func(a *CaptureSnapshotArgs) { a.SetIncludeDOMRects(v) }
+}
+
+// CaptureSnapshotWithIncludeBlendedBackgroundColors sets the IncludeBlendedBackgroundColors optional argument, see
+// CaptureSnapshotArgs.SetIncludeBlendedBackgroundColors.
+//
+// Note: This argument is experimental.
+func CaptureSnapshotWithIncludeBlendedBackgroundColors(v bool) CaptureSnapshotOption {
+	return func(a *CaptureSnapshotArgs) { a.SetIncludeBlendedBackgroundColors(v) }
+}
+
+// CaptureSnapshotWithIncludeTextColorOpacities sets the IncludeTextColorOpacities optional argument, see
+// CaptureSnapshotArgs.SetIncludeTextColorOpacities.
+//
+// Note: This argument is experimental.
+func CaptureSnapshotWithIncludeTextColorOpacities(v bool) CaptureSnapshotOption {
+	return func(a *CaptureSnapshotArgs) { a.SetIncludeTextColorOpacities(v) }
+}
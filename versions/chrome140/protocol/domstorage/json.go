@@ -0,0 +1,328 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package domstorage
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StorageID) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(string(*v.StorageKey))
+	}
+	w.Key("isLocalStorage")
+	w.Bool(v.IsLocalStorage)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StorageID) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := SerializedStorageKey(r.String())
+				v.StorageKey = &p
+			}
+		case "isLocalStorage":
+			v.IsLocalStorage = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDOMStorageItemsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDOMStorageItemsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDOMStorageItemsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("entries")
+	if v.Entries == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Entries {
+			if v.Entries[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Entries[i0] {
+					w.String(v.Entries[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDOMStorageItemsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "entries":
+			if r.ArrayStart() {
+				v.Entries = make([]Item, 0, 4)
+				for r.ArrayNext() {
+					var e0 Item
+					if r.ArrayStart() {
+						e0 = make(Item, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.String()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Entries = append(v.Entries, e0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveDOMStorageItemArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.Key("key")
+	w.String(v.Key)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveDOMStorageItemArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		case "key":
+			v.Key = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDOMStorageItemArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDOMStorageItemArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		case "key":
+			v.Key = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ItemAddedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("newValue")
+	w.String(v.NewValue)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ItemAddedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		case "key":
+			v.Key = r.String()
+		case "newValue":
+			v.NewValue = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ItemRemovedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.Key("key")
+	w.String(v.Key)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ItemRemovedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		case "key":
+			v.Key = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ItemUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.Key("key")
+	w.String(v.Key)
+	w.Key("oldValue")
+	w.String(v.OldValue)
+	w.Key("newValue")
+	w.String(v.NewValue)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ItemUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		case "key":
+			v.Key = r.String()
+		case "oldValue":
+			v.OldValue = r.String()
+		case "newValue":
+			v.NewValue = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ItemsClearedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("storageId")
+	v.StorageID.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ItemsClearedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "storageId":
+			v.StorageID.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,1102 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+)
+
+// CanEmulateReply represents the return values for CanEmulate in the Emulation domain.
+type CanEmulateReply struct {
+	Result bool `json:"result"` // True if emulation is supported.
+}
+
+// SetFocusEmulationEnabledArgs represents the arguments for SetFocusEmulationEnabled in the Emulation domain.
+type SetFocusEmulationEnabledArgs struct {
+	Enabled bool `json:"enabled"` // Whether to enable to disable focus emulation.
+}
+
+// NewSetFocusEmulationEnabledArgs initializes SetFocusEmulationEnabledArgs with the required arguments.
+func NewSetFocusEmulationEnabledArgs(enabled bool) *SetFocusEmulationEnabledArgs {
+	args := new(SetFocusEmulationEnabledArgs)
+	args.Enabled = enabled
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetFocusEmulationEnabledArgs) Validate() error {
+	return nil
+}
+
+// SetAutoDarkModeOverrideArgs represents the arguments for SetAutoDarkModeOverride in the Emulation domain.
+type SetAutoDarkModeOverrideArgs struct {
+	Enabled *bool `json:"enabled,omitempty"` // Whether to enable or disable automatic dark mode. If not specified, any existing override will be cleared.
+}
+
+// NewSetAutoDarkModeOverrideArgs initializes SetAutoDarkModeOverrideArgs with the required arguments.
+func NewSetAutoDarkModeOverrideArgs() *SetAutoDarkModeOverrideArgs {
+	args := new(SetAutoDarkModeOverrideArgs)
+
+	return args
+}
+
+// SetEnabled sets the Enabled optional argument. Whether to enable or
+// disable automatic dark mode. If not specified, any existing override
+// will be cleared.
+func (a *SetAutoDarkModeOverrideArgs) SetEnabled(enabled bool) *SetAutoDarkModeOverrideArgs {
+	a.Enabled = &enabled
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutoDarkModeOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetCPUThrottlingRateArgs represents the arguments for SetCPUThrottlingRate in the Emulation domain.
+type SetCPUThrottlingRateArgs struct {
+	Rate float64 `json:"rate"` // Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
+}
+
+// NewSetCPUThrottlingRateArgs initializes SetCPUThrottlingRateArgs with the required arguments.
+func NewSetCPUThrottlingRateArgs(rate float64) *SetCPUThrottlingRateArgs {
+	args := new(SetCPUThrottlingRateArgs)
+	args.Rate = rate
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCPUThrottlingRateArgs) Validate() error {
+	return nil
+}
+
+// SetDefaultBackgroundColorOverrideArgs represents the arguments for SetDefaultBackgroundColorOverride in the Emulation domain.
+type SetDefaultBackgroundColorOverrideArgs struct {
+	Color *dom.RGBA `json:"color,omitempty"` // RGBA of the default background color. If not specified, any existing override will be cleared.
+}
+
+// NewSetDefaultBackgroundColorOverrideArgs initializes SetDefaultBackgroundColorOverrideArgs with the required arguments.
+func NewSetDefaultBackgroundColorOverrideArgs() *SetDefaultBackgroundColorOverrideArgs {
+	args := new(SetDefaultBackgroundColorOverrideArgs)
+
+	return args
+}
+
+// SetColor sets the Color optional argument. RGBA of the default
+// background color. If not specified, any existing override will be
+// cleared.
+func (a *SetDefaultBackgroundColorOverrideArgs) SetColor(color dom.RGBA) *SetDefaultBackgroundColorOverrideArgs {
+	a.Color = &color
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDefaultBackgroundColorOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetSafeAreaInsetsOverrideArgs represents the arguments for SetSafeAreaInsetsOverride in the Emulation domain.
+type SetSafeAreaInsetsOverrideArgs struct {
+	Insets SafeAreaInsets `json:"insets"` // No description.
+}
+
+// NewSetSafeAreaInsetsOverrideArgs initializes SetSafeAreaInsetsOverrideArgs with the required arguments.
+func NewSetSafeAreaInsetsOverrideArgs(insets SafeAreaInsets) *SetSafeAreaInsetsOverrideArgs {
+	args := new(SetSafeAreaInsetsOverrideArgs)
+	args.Insets = insets
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSafeAreaInsetsOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetDeviceMetricsOverrideArgs represents the arguments for SetDeviceMetricsOverride in the Emulation domain.
+type SetDeviceMetricsOverrideArgs struct {
+	Width             int     `json:"width"`             // Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height            int     `json:"height"`            // Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"` // Overriding device scale factor value. 0 disables the override.
+	Mobile            bool    `json:"mobile"`            // Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
+	// Scale Scale to apply to resulting view image.
+	//
+	// Note: This property is experimental.
+	Scale *float64 `json:"scale,omitempty"`
+	// ScreenWidth Overriding screen width value in pixels (minimum 0,
+	// maximum 10000000).
+	//
+	// Note: This property is experimental.
+	ScreenWidth *int `json:"screenWidth,omitempty"`
+	// ScreenHeight Overriding screen height value in pixels (minimum 0,
+	// maximum 10000000).
+	//
+	// Note: This property is experimental.
+	ScreenHeight *int `json:"screenHeight,omitempty"`
+	// PositionX Overriding view X position on screen in pixels (minimum
+	// 0, maximum 10000000).
+	//
+	// Note: This property is experimental.
+	PositionX *int `json:"positionX,omitempty"`
+	// PositionY Overriding view Y position on screen in pixels (minimum
+	// 0, maximum 10000000).
+	//
+	// Note: This property is experimental.
+	PositionY *int `json:"positionY,omitempty"`
+	// DontSetVisibleSize Do not set visible view size, rely upon explicit
+	// setVisibleSize call.
+	//
+	// Note: This property is experimental.
+	DontSetVisibleSize *bool              `json:"dontSetVisibleSize,omitempty"`
+	ScreenOrientation  *ScreenOrientation `json:"screenOrientation,omitempty"` // Screen orientation override.
+	// Viewport If set, the visible area of the page will be overridden to
+	// this viewport. This viewport change is not observed by the page,
+	// e.g. viewport-relative elements do not change positions.
+	//
+	// Note: This property is experimental.
+	Viewport *page.Viewport `json:"viewport,omitempty"`
+	// DisplayFeature is deprecated.
+	//
+	// Deprecated: If set, the display feature of a multi-segment screen.
+	// If not set, multi-segment support is turned-off. Deprecated, use
+	// Emulation.setDisplayFeaturesOverride.
+	//
+	// Note: This property is experimental.
+	DisplayFeature *DisplayFeature `json:"displayFeature,omitempty"`
+	// DevicePosture is deprecated.
+	//
+	// Deprecated: If set, the posture of a foldable device. If not set
+	// the posture is set to continuous. Deprecated, use
+	// Emulation.setDevicePostureOverride.
+	//
+	// Note: This property is experimental.
+	DevicePosture *DevicePosture `json:"devicePosture,omitempty"`
+}
+
+// NewSetDeviceMetricsOverrideArgs initializes SetDeviceMetricsOverrideArgs with the required arguments.
+func NewSetDeviceMetricsOverrideArgs(width int, height int, deviceScaleFactor float64, mobile bool) *SetDeviceMetricsOverrideArgs {
+	args := new(SetDeviceMetricsOverrideArgs)
+	args.Width = width
+	args.Height = height
+	args.DeviceScaleFactor = deviceScaleFactor
+	args.Mobile = mobile
+	return args
+}
+
+// SetScale sets the Scale optional argument. Scale to apply to
+// resulting view image.
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetScale(scale float64) *SetDeviceMetricsOverrideArgs {
+	a.Scale = &scale
+	return a
+}
+
+// SetScreenWidth sets the ScreenWidth optional argument. Overriding
+// screen width value in pixels (minimum 0, maximum 10000000).
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetScreenWidth(screenWidth int) *SetDeviceMetricsOverrideArgs {
+	a.ScreenWidth = &screenWidth
+	return a
+}
+
+// SetScreenHeight sets the ScreenHeight optional argument. Overriding
+// screen height value in pixels (minimum 0, maximum 10000000).
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetScreenHeight(screenHeight int) *SetDeviceMetricsOverrideArgs {
+	a.ScreenHeight = &screenHeight
+	return a
+}
+
+// SetPositionX sets the PositionX optional argument. Overriding view
+// X position on screen in pixels (minimum 0, maximum 10000000).
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetPositionX(positionX int) *SetDeviceMetricsOverrideArgs {
+	a.PositionX = &positionX
+	return a
+}
+
+// SetPositionY sets the PositionY optional argument. Overriding view
+// Y position on screen in pixels (minimum 0, maximum 10000000).
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetPositionY(positionY int) *SetDeviceMetricsOverrideArgs {
+	a.PositionY = &positionY
+	return a
+}
+
+// SetDontSetVisibleSize sets the DontSetVisibleSize optional argument.
+// Do not set visible view size, rely upon explicit setVisibleSize
+// call.
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetDontSetVisibleSize(dontSetVisibleSize bool) *SetDeviceMetricsOverrideArgs {
+	a.DontSetVisibleSize = &dontSetVisibleSize
+	return a
+}
+
+// SetScreenOrientation sets the ScreenOrientation optional argument.
+// Screen orientation override.
+func (a *SetDeviceMetricsOverrideArgs) SetScreenOrientation(screenOrientation ScreenOrientation) *SetDeviceMetricsOverrideArgs {
+	a.ScreenOrientation = &screenOrientation
+	return a
+}
+
+// SetViewport sets the Viewport optional argument. If set, the
+// visible area of the page will be overridden to this viewport. This
+// viewport change is not observed by the page, e.g. viewport-relative
+// elements do not change positions.
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetViewport(viewport page.Viewport) *SetDeviceMetricsOverrideArgs {
+	a.Viewport = &viewport
+	return a
+}
+
+// SetDisplayFeature sets the DisplayFeature optional argument.
+//
+// Deprecated: If
+// set, the display feature of a multi-segment screen. If not set,
+// multi-segment support is turned-off. Deprecated, use
+// Emulation.setDisplayFeaturesOverride.
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetDisplayFeature(displayFeature DisplayFeature) *SetDeviceMetricsOverrideArgs {
+	a.DisplayFeature = &displayFeature
+	return a
+}
+
+// SetDevicePosture sets the DevicePosture optional argument.
+//
+// Deprecated: If set,
+// the posture of a foldable device. If not set the posture is set to
+// continuous. Deprecated, use Emulation.setDevicePostureOverride.
+//
+// Note: This property is experimental.
+func (a *SetDeviceMetricsOverrideArgs) SetDevicePosture(devicePosture DevicePosture) *SetDeviceMetricsOverrideArgs {
+	a.DevicePosture = &devicePosture
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDeviceMetricsOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetDevicePostureOverrideArgs represents the arguments for SetDevicePostureOverride in the Emulation domain.
+type SetDevicePostureOverrideArgs struct {
+	Posture DevicePosture `json:"posture"` // No description.
+}
+
+// NewSetDevicePostureOverrideArgs initializes SetDevicePostureOverrideArgs with the required arguments.
+func NewSetDevicePostureOverrideArgs(posture DevicePosture) *SetDevicePostureOverrideArgs {
+	args := new(SetDevicePostureOverrideArgs)
+	args.Posture = posture
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDevicePostureOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetDisplayFeaturesOverrideArgs represents the arguments for SetDisplayFeaturesOverride in the Emulation domain.
+type SetDisplayFeaturesOverrideArgs struct {
+	Features []DisplayFeature `json:"features"` // No description.
+}
+
+// NewSetDisplayFeaturesOverrideArgs initializes SetDisplayFeaturesOverrideArgs with the required arguments.
+func NewSetDisplayFeaturesOverrideArgs(features []DisplayFeature) *SetDisplayFeaturesOverrideArgs {
+	args := new(SetDisplayFeaturesOverrideArgs)
+	args.Features = features
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDisplayFeaturesOverrideArgs) Validate() error {
+	if a.Features == nil {
+		return errors.New("emulation.SetDisplayFeaturesOverrideArgs: Features is required")
+	}
+	return nil
+}
+
+// SetScrollbarsHiddenArgs represents the arguments for SetScrollbarsHidden in the Emulation domain.
+type SetScrollbarsHiddenArgs struct {
+	Hidden bool `json:"hidden"` // Whether scrollbars should be always hidden.
+}
+
+// NewSetScrollbarsHiddenArgs initializes SetScrollbarsHiddenArgs with the required arguments.
+func NewSetScrollbarsHiddenArgs(hidden bool) *SetScrollbarsHiddenArgs {
+	args := new(SetScrollbarsHiddenArgs)
+	args.Hidden = hidden
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetScrollbarsHiddenArgs) Validate() error {
+	return nil
+}
+
+// SetDocumentCookieDisabledArgs represents the arguments for SetDocumentCookieDisabled in the Emulation domain.
+type SetDocumentCookieDisabledArgs struct {
+	Disabled bool `json:"disabled"` // Whether document.coookie API should be disabled.
+}
+
+// NewSetDocumentCookieDisabledArgs initializes SetDocumentCookieDisabledArgs with the required arguments.
+func NewSetDocumentCookieDisabledArgs(disabled bool) *SetDocumentCookieDisabledArgs {
+	args := new(SetDocumentCookieDisabledArgs)
+	args.Disabled = disabled
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDocumentCookieDisabledArgs) Validate() error {
+	return nil
+}
+
+// SetEmitTouchEventsForMouseArgs represents the arguments for SetEmitTouchEventsForMouse in the Emulation domain.
+type SetEmitTouchEventsForMouseArgs struct {
+	Enabled bool `json:"enabled"` // Whether touch emulation based on mouse input should be enabled.
+	// Configuration Touch/gesture events configuration. Default: current
+	// platform.
+	//
+	// Values: "mobile", "desktop".
+	Configuration *string `json:"configuration,omitempty"`
+}
+
+// NewSetEmitTouchEventsForMouseArgs initializes SetEmitTouchEventsForMouseArgs with the required arguments.
+func NewSetEmitTouchEventsForMouseArgs(enabled bool) *SetEmitTouchEventsForMouseArgs {
+	args := new(SetEmitTouchEventsForMouseArgs)
+	args.Enabled = enabled
+	return args
+}
+
+// SetConfiguration sets the Configuration optional argument.
+// Touch/gesture events configuration. Default: current platform.
+//
+// Values: "mobile", "desktop".
+func (a *SetEmitTouchEventsForMouseArgs) SetConfiguration(configuration string) *SetEmitTouchEventsForMouseArgs {
+	a.Configuration = &configuration
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmitTouchEventsForMouseArgs) Validate() error {
+	if a.Configuration != nil {
+		switch *a.Configuration {
+		case "mobile", "desktop":
+		default:
+			return errors.New("emulation.SetEmitTouchEventsForMouseArgs: Configuration: invalid value " + strconv.Quote(*a.Configuration))
+		}
+	}
+	return nil
+}
+
+// SetEmulatedMediaArgs represents the arguments for SetEmulatedMedia in the Emulation domain.
+type SetEmulatedMediaArgs struct {
+	Media    *string        `json:"media,omitempty"`    // Media type to emulate. Empty string disables the override.
+	Features []MediaFeature `json:"features,omitempty"` // Media features to emulate.
+}
+
+// NewSetEmulatedMediaArgs initializes SetEmulatedMediaArgs with the required arguments.
+func NewSetEmulatedMediaArgs() *SetEmulatedMediaArgs {
+	args := new(SetEmulatedMediaArgs)
+
+	return args
+}
+
+// SetMedia sets the Media optional argument. Media type to emulate.
+// Empty string disables the override.
+func (a *SetEmulatedMediaArgs) SetMedia(media string) *SetEmulatedMediaArgs {
+	a.Media = &media
+	return a
+}
+
+// SetFeatures sets the Features optional argument. Media features to
+// emulate.
+func (a *SetEmulatedMediaArgs) SetFeatures(features []MediaFeature) *SetEmulatedMediaArgs {
+	a.Features = features
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmulatedMediaArgs) Validate() error {
+	return nil
+}
+
+// SetEmulatedVisionDeficiencyArgs represents the arguments for SetEmulatedVisionDeficiency in the Emulation domain.
+type SetEmulatedVisionDeficiencyArgs struct {
+	// Type Vision deficiency to emulate. Order: best-effort emulations
+	// come first, followed by any physiologically accurate emulations for
+	// medically recognized color vision deficiencies.
+	//
+	// Values: "none", "blurredVision", "reducedContrast", "achromatopsia", "deuteranopia", "protanopia", "tritanopia".
+	Type string `json:"type"`
+}
+
+// NewSetEmulatedVisionDeficiencyArgs initializes SetEmulatedVisionDeficiencyArgs with the required arguments.
+func NewSetEmulatedVisionDeficiencyArgs(typ string) *SetEmulatedVisionDeficiencyArgs {
+	args := new(SetEmulatedVisionDeficiencyArgs)
+	args.Type = typ
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmulatedVisionDeficiencyArgs) Validate() error {
+	switch a.Type {
+	case "none", "blurredVision", "reducedContrast", "achromatopsia", "deuteranopia", "protanopia", "tritanopia":
+	default:
+		return errors.New("emulation.SetEmulatedVisionDeficiencyArgs: Type: invalid value " + strconv.Quote(a.Type))
+	}
+	return nil
+}
+
+// SetEmulatedOSTextScaleArgs represents the arguments for SetEmulatedOSTextScale in the Emulation domain.
+type SetEmulatedOSTextScaleArgs struct {
+	Scale *float64 `json:"scale,omitempty"` // No description.
+}
+
+// NewSetEmulatedOSTextScaleArgs initializes SetEmulatedOSTextScaleArgs with the required arguments.
+func NewSetEmulatedOSTextScaleArgs() *SetEmulatedOSTextScaleArgs {
+	args := new(SetEmulatedOSTextScaleArgs)
+
+	return args
+}
+
+// SetScale sets the Scale optional argument.
+func (a *SetEmulatedOSTextScaleArgs) SetScale(scale float64) *SetEmulatedOSTextScaleArgs {
+	a.Scale = &scale
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetEmulatedOSTextScaleArgs) Validate() error {
+	return nil
+}
+
+// SetGeolocationOverrideArgs represents the arguments for SetGeolocationOverride in the Emulation domain.
+type SetGeolocationOverrideArgs struct {
+	Latitude         *float64 `json:"latitude,omitempty"`         // Mock latitude
+	Longitude        *float64 `json:"longitude,omitempty"`        // Mock longitude
+	Accuracy         *float64 `json:"accuracy,omitempty"`         // Mock accuracy
+	Altitude         *float64 `json:"altitude,omitempty"`         // Mock altitude
+	AltitudeAccuracy *float64 `json:"altitudeAccuracy,omitempty"` // Mock altitudeAccuracy
+	Heading          *float64 `json:"heading,omitempty"`          // Mock heading
+	Speed            *float64 `json:"speed,omitempty"`            // Mock speed
+}
+
+// NewSetGeolocationOverrideArgs initializes SetGeolocationOverrideArgs with the required arguments.
+func NewSetGeolocationOverrideArgs() *SetGeolocationOverrideArgs {
+	args := new(SetGeolocationOverrideArgs)
+
+	return args
+}
+
+// SetLatitude sets the Latitude optional argument. Mock latitude
+func (a *SetGeolocationOverrideArgs) SetLatitude(latitude float64) *SetGeolocationOverrideArgs {
+	a.Latitude = &latitude
+	return a
+}
+
+// SetLongitude sets the Longitude optional argument. Mock longitude
+func (a *SetGeolocationOverrideArgs) SetLongitude(longitude float64) *SetGeolocationOverrideArgs {
+	a.Longitude = &longitude
+	return a
+}
+
+// SetAccuracy sets the Accuracy optional argument. Mock accuracy
+func (a *SetGeolocationOverrideArgs) SetAccuracy(accuracy float64) *SetGeolocationOverrideArgs {
+	a.Accuracy = &accuracy
+	return a
+}
+
+// SetAltitude sets the Altitude optional argument. Mock altitude
+func (a *SetGeolocationOverrideArgs) SetAltitude(altitude float64) *SetGeolocationOverrideArgs {
+	a.Altitude = &altitude
+	return a
+}
+
+// SetAltitudeAccuracy sets the AltitudeAccuracy optional argument.
+// Mock altitudeAccuracy
+func (a *SetGeolocationOverrideArgs) SetAltitudeAccuracy(altitudeAccuracy float64) *SetGeolocationOverrideArgs {
+	a.AltitudeAccuracy = &altitudeAccuracy
+	return a
+}
+
+// SetHeading sets the Heading optional argument. Mock heading
+func (a *SetGeolocationOverrideArgs) SetHeading(heading float64) *SetGeolocationOverrideArgs {
+	a.Heading = &heading
+	return a
+}
+
+// SetSpeed sets the Speed optional argument. Mock speed
+func (a *SetGeolocationOverrideArgs) SetSpeed(speed float64) *SetGeolocationOverrideArgs {
+	a.Speed = &speed
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetGeolocationOverrideArgs) Validate() error {
+	return nil
+}
+
+// GetOverriddenSensorInformationArgs represents the arguments for GetOverriddenSensorInformation in the Emulation domain.
+type GetOverriddenSensorInformationArgs struct {
+	Type SensorType `json:"type"` // No description.
+}
+
+// NewGetOverriddenSensorInformationArgs initializes GetOverriddenSensorInformationArgs with the required arguments.
+func NewGetOverriddenSensorInformationArgs(typ SensorType) *GetOverriddenSensorInformationArgs {
+	args := new(GetOverriddenSensorInformationArgs)
+	args.Type = typ
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetOverriddenSensorInformationArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("emulation.GetOverriddenSensorInformationArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
+// GetOverriddenSensorInformationReply represents the return values for GetOverriddenSensorInformation in the Emulation domain.
+type GetOverriddenSensorInformationReply struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"` // No description.
+}
+
+// SetSensorOverrideEnabledArgs represents the arguments for SetSensorOverrideEnabled in the Emulation domain.
+type SetSensorOverrideEnabledArgs struct {
+	Enabled  bool            `json:"enabled"`            // No description.
+	Type     SensorType      `json:"type"`               // No description.
+	Metadata *SensorMetadata `json:"metadata,omitempty"` // No description.
+}
+
+// NewSetSensorOverrideEnabledArgs initializes SetSensorOverrideEnabledArgs with the required arguments.
+func NewSetSensorOverrideEnabledArgs(enabled bool, typ SensorType) *SetSensorOverrideEnabledArgs {
+	args := new(SetSensorOverrideEnabledArgs)
+	args.Enabled = enabled
+	args.Type = typ
+	return args
+}
+
+// SetMetadata sets the Metadata optional argument.
+func (a *SetSensorOverrideEnabledArgs) SetMetadata(metadata SensorMetadata) *SetSensorOverrideEnabledArgs {
+	a.Metadata = &metadata
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSensorOverrideEnabledArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("emulation.SetSensorOverrideEnabledArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
+// SetSensorOverrideReadingsArgs represents the arguments for SetSensorOverrideReadings in the Emulation domain.
+type SetSensorOverrideReadingsArgs struct {
+	Type    SensorType    `json:"type"`    // No description.
+	Reading SensorReading `json:"reading"` // No description.
+}
+
+// NewSetSensorOverrideReadingsArgs initializes SetSensorOverrideReadingsArgs with the required arguments.
+func NewSetSensorOverrideReadingsArgs(typ SensorType, reading SensorReading) *SetSensorOverrideReadingsArgs {
+	args := new(SetSensorOverrideReadingsArgs)
+	args.Type = typ
+	args.Reading = reading
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSensorOverrideReadingsArgs) Validate() error {
+	if !a.Type.Valid() {
+		return errors.New("emulation.SetSensorOverrideReadingsArgs: Type: invalid value " + strconv.Quote(string(a.Type)))
+	}
+	return nil
+}
+
+// SetPressureSourceOverrideEnabledArgs represents the arguments for SetPressureSourceOverrideEnabled in the Emulation domain.
+type SetPressureSourceOverrideEnabledArgs struct {
+	Enabled  bool              `json:"enabled"`            // No description.
+	Source   PressureSource    `json:"source"`             // No description.
+	Metadata *PressureMetadata `json:"metadata,omitempty"` // No description.
+}
+
+// NewSetPressureSourceOverrideEnabledArgs initializes SetPressureSourceOverrideEnabledArgs with the required arguments.
+func NewSetPressureSourceOverrideEnabledArgs(enabled bool, source PressureSource) *SetPressureSourceOverrideEnabledArgs {
+	args := new(SetPressureSourceOverrideEnabledArgs)
+	args.Enabled = enabled
+	args.Source = source
+	return args
+}
+
+// SetMetadata sets the Metadata optional argument.
+func (a *SetPressureSourceOverrideEnabledArgs) SetMetadata(metadata PressureMetadata) *SetPressureSourceOverrideEnabledArgs {
+	a.Metadata = &metadata
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureSourceOverrideEnabledArgs) Validate() error {
+	if !a.Source.Valid() {
+		return errors.New("emulation.SetPressureSourceOverrideEnabledArgs: Source: invalid value " + strconv.Quote(string(a.Source)))
+	}
+	return nil
+}
+
+// SetPressureStateOverrideArgs represents the arguments for SetPressureStateOverride in the Emulation domain.
+type SetPressureStateOverrideArgs struct {
+	Source PressureSource `json:"source"` // No description.
+	State  PressureState  `json:"state"`  // No description.
+}
+
+// NewSetPressureStateOverrideArgs initializes SetPressureStateOverrideArgs with the required arguments.
+func NewSetPressureStateOverrideArgs(source PressureSource, state PressureState) *SetPressureStateOverrideArgs {
+	args := new(SetPressureStateOverrideArgs)
+	args.Source = source
+	args.State = state
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureStateOverrideArgs) Validate() error {
+	if !a.Source.Valid() {
+		return errors.New("emulation.SetPressureStateOverrideArgs: Source: invalid value " + strconv.Quote(string(a.Source)))
+	}
+	if !a.State.Valid() {
+		return errors.New("emulation.SetPressureStateOverrideArgs: State: invalid value " + strconv.Quote(string(a.State)))
+	}
+	return nil
+}
+
+// SetPressureDataOverrideArgs represents the arguments for SetPressureDataOverride in the Emulation domain.
+type SetPressureDataOverrideArgs struct {
+	Source                  PressureSource `json:"source"`                            // No description.
+	State                   PressureState  `json:"state"`                             // No description.
+	OwnContributionEstimate *float64       `json:"ownContributionEstimate,omitempty"` // No description.
+}
+
+// NewSetPressureDataOverrideArgs initializes SetPressureDataOverrideArgs with the required arguments.
+func NewSetPressureDataOverrideArgs(source PressureSource, state PressureState) *SetPressureDataOverrideArgs {
+	args := new(SetPressureDataOverrideArgs)
+	args.Source = source
+	args.State = state
+	return args
+}
+
+// SetOwnContributionEstimate sets the OwnContributionEstimate optional argument.
+func (a *SetPressureDataOverrideArgs) SetOwnContributionEstimate(ownContributionEstimate float64) *SetPressureDataOverrideArgs {
+	a.OwnContributionEstimate = &ownContributionEstimate
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPressureDataOverrideArgs) Validate() error {
+	if !a.Source.Valid() {
+		return errors.New("emulation.SetPressureDataOverrideArgs: Source: invalid value " + strconv.Quote(string(a.Source)))
+	}
+	if !a.State.Valid() {
+		return errors.New("emulation.SetPressureDataOverrideArgs: State: invalid value " + strconv.Quote(string(a.State)))
+	}
+	return nil
+}
+
+// SetIdleOverrideArgs represents the arguments for SetIdleOverride in the Emulation domain.
+type SetIdleOverrideArgs struct {
+	IsUserActive     bool `json:"isUserActive"`     // Mock isUserActive
+	IsScreenUnlocked bool `json:"isScreenUnlocked"` // Mock isScreenUnlocked
+}
+
+// NewSetIdleOverrideArgs initializes SetIdleOverrideArgs with the required arguments.
+func NewSetIdleOverrideArgs(isUserActive bool, isScreenUnlocked bool) *SetIdleOverrideArgs {
+	args := new(SetIdleOverrideArgs)
+	args.IsUserActive = isUserActive
+	args.IsScreenUnlocked = isScreenUnlocked
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetIdleOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetNavigatorOverridesArgs represents the arguments for SetNavigatorOverrides in the Emulation domain.
+type SetNavigatorOverridesArgs struct {
+	Platform string `json:"platform"` // The platform navigator.platform should return.
+}
+
+// NewSetNavigatorOverridesArgs initializes SetNavigatorOverridesArgs with the required arguments.
+func NewSetNavigatorOverridesArgs(platform string) *SetNavigatorOverridesArgs {
+	args := new(SetNavigatorOverridesArgs)
+	args.Platform = platform
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetNavigatorOverridesArgs) Validate() error {
+	return nil
+}
+
+// SetPageScaleFactorArgs represents the arguments for SetPageScaleFactor in the Emulation domain.
+type SetPageScaleFactorArgs struct {
+	PageScaleFactor float64 `json:"pageScaleFactor"` // Page scale factor.
+}
+
+// NewSetPageScaleFactorArgs initializes SetPageScaleFactorArgs with the required arguments.
+func NewSetPageScaleFactorArgs(pageScaleFactor float64) *SetPageScaleFactorArgs {
+	args := new(SetPageScaleFactorArgs)
+	args.PageScaleFactor = pageScaleFactor
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetPageScaleFactorArgs) Validate() error {
+	return nil
+}
+
+// SetScriptExecutionDisabledArgs represents the arguments for SetScriptExecutionDisabled in the Emulation domain.
+type SetScriptExecutionDisabledArgs struct {
+	Value bool `json:"value"` // Whether script execution should be disabled in the page.
+}
+
+// NewSetScriptExecutionDisabledArgs initializes SetScriptExecutionDisabledArgs with the required arguments.
+func NewSetScriptExecutionDisabledArgs(value bool) *SetScriptExecutionDisabledArgs {
+	args := new(SetScriptExecutionDisabledArgs)
+	args.Value = value
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetScriptExecutionDisabledArgs) Validate() error {
+	return nil
+}
+
+// SetTouchEmulationEnabledArgs represents the arguments for SetTouchEmulationEnabled in the Emulation domain.
+type SetTouchEmulationEnabledArgs struct {
+	Enabled        bool `json:"enabled"`                  // Whether the touch event emulation should be enabled.
+	MaxTouchPoints *int `json:"maxTouchPoints,omitempty"` // Maximum touch points supported. Defaults to one.
+}
+
+// NewSetTouchEmulationEnabledArgs initializes SetTouchEmulationEnabledArgs with the required arguments.
+func NewSetTouchEmulationEnabledArgs(enabled bool) *SetTouchEmulationEnabledArgs {
+	args := new(SetTouchEmulationEnabledArgs)
+	args.Enabled = enabled
+	return args
+}
+
+// SetMaxTouchPoints sets the MaxTouchPoints optional argument.
+// Maximum touch points supported. Defaults to one.
+func (a *SetTouchEmulationEnabledArgs) SetMaxTouchPoints(maxTouchPoints int) *SetTouchEmulationEnabledArgs {
+	a.MaxTouchPoints = &maxTouchPoints
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTouchEmulationEnabledArgs) Validate() error {
+	return nil
+}
+
+// SetVirtualTimePolicyArgs represents the arguments for SetVirtualTimePolicy in the Emulation domain.
+type SetVirtualTimePolicyArgs struct {
+	Policy                            VirtualTimePolicy      `json:"policy"`                                      // No description.
+	Budget                            *float64               `json:"budget,omitempty"`                            // If set, after this many virtual milliseconds have elapsed virtual time will be paused and a virtualTimeBudgetExpired event is sent.
+	MaxVirtualTimeTaskStarvationCount *int                   `json:"maxVirtualTimeTaskStarvationCount,omitempty"` // If set this specifies the maximum number of tasks that can be run before virtual is forced forwards to prevent deadlock.
+	InitialVirtualTime                network.TimeSinceEpoch `json:"initialVirtualTime,omitempty"`                // If set, base::Time::Now will be overridden to initially return this value.
+}
+
+// NewSetVirtualTimePolicyArgs initializes SetVirtualTimePolicyArgs with the required arguments.
+func NewSetVirtualTimePolicyArgs(policy VirtualTimePolicy) *SetVirtualTimePolicyArgs {
+	args := new(SetVirtualTimePolicyArgs)
+	args.Policy = policy
+	return args
+}
+
+// SetBudget sets the Budget optional argument. If set, after this
+// many virtual milliseconds have elapsed virtual time will be paused
+// and a virtualTimeBudgetExpired event is sent.
+func (a *SetVirtualTimePolicyArgs) SetBudget(budget float64) *SetVirtualTimePolicyArgs {
+	a.Budget = &budget
+	return a
+}
+
+// SetMaxVirtualTimeTaskStarvationCount sets the MaxVirtualTimeTaskStarvationCount optional argument.
+// If set this specifies the maximum number of tasks that can be run
+// before virtual is forced forwards to prevent deadlock.
+func (a *SetVirtualTimePolicyArgs) SetMaxVirtualTimeTaskStarvationCount(maxVirtualTimeTaskStarvationCount int) *SetVirtualTimePolicyArgs {
+	a.MaxVirtualTimeTaskStarvationCount = &maxVirtualTimeTaskStarvationCount
+	return a
+}
+
+// SetInitialVirtualTime sets the InitialVirtualTime optional argument.
+// If set, base::Time::Now will be overridden to initially return this
+// value.
+func (a *SetVirtualTimePolicyArgs) SetInitialVirtualTime(initialVirtualTime network.TimeSinceEpoch) *SetVirtualTimePolicyArgs {
+	a.InitialVirtualTime = initialVirtualTime
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetVirtualTimePolicyArgs) Validate() error {
+	if !a.Policy.Valid() {
+		return errors.New("emulation.SetVirtualTimePolicyArgs: Policy: invalid value " + strconv.Quote(string(a.Policy)))
+	}
+	return nil
+}
+
+// SetVirtualTimePolicyReply represents the return values for SetVirtualTimePolicy in the Emulation domain.
+type SetVirtualTimePolicyReply struct {
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"` // Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
+}
+
+// SetLocaleOverrideArgs represents the arguments for SetLocaleOverride in the Emulation domain.
+type SetLocaleOverrideArgs struct {
+	Locale *string `json:"locale,omitempty"` // ICU style C locale (e.g. "en_US"). If not specified or empty, disables the override and restores default host system locale.
+}
+
+// NewSetLocaleOverrideArgs initializes SetLocaleOverrideArgs with the required arguments.
+func NewSetLocaleOverrideArgs() *SetLocaleOverrideArgs {
+	args := new(SetLocaleOverrideArgs)
+
+	return args
+}
+
+// SetLocale sets the Locale optional argument. ICU style C locale
+// (e.g. "en_US"). If not specified or empty, disables the override and
+// restores default host system locale.
+func (a *SetLocaleOverrideArgs) SetLocale(locale string) *SetLocaleOverrideArgs {
+	a.Locale = &locale
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetLocaleOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetTimezoneOverrideArgs represents the arguments for SetTimezoneOverride in the Emulation domain.
+type SetTimezoneOverrideArgs struct {
+	TimezoneID string `json:"timezoneId"` // The timezone identifier. List of supported timezones: https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt If empty, disables the override and restores default host system timezone.
+}
+
+// NewSetTimezoneOverrideArgs initializes SetTimezoneOverrideArgs with the required arguments.
+func NewSetTimezoneOverrideArgs(timezoneID string) *SetTimezoneOverrideArgs {
+	args := new(SetTimezoneOverrideArgs)
+	args.TimezoneID = timezoneID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTimezoneOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetVisibleSizeArgs represents the arguments for SetVisibleSize in the Emulation domain.
+type SetVisibleSizeArgs struct {
+	Width  int `json:"width"`  // Frame width (DIP).
+	Height int `json:"height"` // Frame height (DIP).
+}
+
+// NewSetVisibleSizeArgs initializes SetVisibleSizeArgs with the required arguments.
+func NewSetVisibleSizeArgs(width int, height int) *SetVisibleSizeArgs {
+	args := new(SetVisibleSizeArgs)
+	args.Width = width
+	args.Height = height
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetVisibleSizeArgs) Validate() error {
+	return nil
+}
+
+// SetDisabledImageTypesArgs represents the arguments for SetDisabledImageTypes in the Emulation domain.
+type SetDisabledImageTypesArgs struct {
+	ImageTypes []DisabledImageType `json:"imageTypes"` // Image types to disable.
+}
+
+// NewSetDisabledImageTypesArgs initializes SetDisabledImageTypesArgs with the required arguments.
+func NewSetDisabledImageTypesArgs(imageTypes []DisabledImageType) *SetDisabledImageTypesArgs {
+	args := new(SetDisabledImageTypesArgs)
+	args.ImageTypes = imageTypes
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDisabledImageTypesArgs) Validate() error {
+	if a.ImageTypes == nil {
+		return errors.New("emulation.SetDisabledImageTypesArgs: ImageTypes is required")
+	}
+	for i0 := range a.ImageTypes {
+		if !a.ImageTypes[i0].Valid() {
+			return errors.New("emulation.SetDisabledImageTypesArgs: ImageTypes" + "[" + strconv.Itoa(i0) + "]: invalid value " + strconv.Quote(string(a.ImageTypes[i0])))
+		}
+	}
+	return nil
+}
+
+// SetDataSaverOverrideArgs represents the arguments for SetDataSaverOverride in the Emulation domain.
+type SetDataSaverOverrideArgs struct {
+	DataSaverEnabled *bool `json:"dataSaverEnabled,omitempty"` // Override value. Omitting the parameter disables the override.
+}
+
+// NewSetDataSaverOverrideArgs initializes SetDataSaverOverrideArgs with the required arguments.
+func NewSetDataSaverOverrideArgs() *SetDataSaverOverrideArgs {
+	args := new(SetDataSaverOverrideArgs)
+
+	return args
+}
+
+// SetDataSaverEnabled sets the DataSaverEnabled optional argument.
+// Override value. Omitting the parameter disables the override.
+func (a *SetDataSaverOverrideArgs) SetDataSaverEnabled(dataSaverEnabled bool) *SetDataSaverOverrideArgs {
+	a.DataSaverEnabled = &dataSaverEnabled
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDataSaverOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetHardwareConcurrencyOverrideArgs represents the arguments for SetHardwareConcurrencyOverride in the Emulation domain.
+type SetHardwareConcurrencyOverrideArgs struct {
+	HardwareConcurrency int `json:"hardwareConcurrency"` // Hardware concurrency to report
+}
+
+// NewSetHardwareConcurrencyOverrideArgs initializes SetHardwareConcurrencyOverrideArgs with the required arguments.
+func NewSetHardwareConcurrencyOverrideArgs(hardwareConcurrency int) *SetHardwareConcurrencyOverrideArgs {
+	args := new(SetHardwareConcurrencyOverrideArgs)
+	args.HardwareConcurrency = hardwareConcurrency
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetHardwareConcurrencyOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetUserAgentOverrideArgs represents the arguments for SetUserAgentOverride in the Emulation domain.
+type SetUserAgentOverrideArgs struct {
+	UserAgent      string  `json:"userAgent"`                // User agent to use.
+	AcceptLanguage *string `json:"acceptLanguage,omitempty"` // Browser language to emulate.
+	Platform       *string `json:"platform,omitempty"`       // The platform navigator.platform should return.
+	// UserAgentMetadata To be sent in Sec-CH-UA-* headers and returned in
+	// navigator.userAgentData
+	//
+	// Note: This property is experimental.
+	UserAgentMetadata *UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// NewSetUserAgentOverrideArgs initializes SetUserAgentOverrideArgs with the required arguments.
+func NewSetUserAgentOverrideArgs(userAgent string) *SetUserAgentOverrideArgs {
+	args := new(SetUserAgentOverrideArgs)
+	args.UserAgent = userAgent
+	return args
+}
+
+// SetAcceptLanguage sets the AcceptLanguage optional argument.
+// Browser language to emulate.
+func (a *SetUserAgentOverrideArgs) SetAcceptLanguage(acceptLanguage string) *SetUserAgentOverrideArgs {
+	a.AcceptLanguage = &acceptLanguage
+	return a
+}
+
+// SetPlatform sets the Platform optional argument. The platform
+// navigator.platform should return.
+func (a *SetUserAgentOverrideArgs) SetPlatform(platform string) *SetUserAgentOverrideArgs {
+	a.Platform = &platform
+	return a
+}
+
+// SetUserAgentMetadata sets the UserAgentMetadata optional argument.
+// To be sent in Sec-CH-UA-* headers and returned in
+// navigator.userAgentData
+//
+// Note: This property is experimental.
+func (a *SetUserAgentOverrideArgs) SetUserAgentMetadata(userAgentMetadata UserAgentMetadata) *SetUserAgentOverrideArgs {
+	a.UserAgentMetadata = &userAgentMetadata
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetUserAgentOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetAutomationOverrideArgs represents the arguments for SetAutomationOverride in the Emulation domain.
+type SetAutomationOverrideArgs struct {
+	Enabled bool `json:"enabled"` // Whether the override should be enabled.
+}
+
+// NewSetAutomationOverrideArgs initializes SetAutomationOverrideArgs with the required arguments.
+func NewSetAutomationOverrideArgs(enabled bool) *SetAutomationOverrideArgs {
+	args := new(SetAutomationOverrideArgs)
+	args.Enabled = enabled
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutomationOverrideArgs) Validate() error {
+	return nil
+}
+
+// SetSmallViewportHeightDifferenceOverrideArgs represents the arguments for SetSmallViewportHeightDifferenceOverride in the Emulation domain.
+type SetSmallViewportHeightDifferenceOverrideArgs struct {
+	Difference int `json:"difference"` // This will cause an element of size 100svh to be `difference` pixels smaller than an element of size 100lvh.
+}
+
+// NewSetSmallViewportHeightDifferenceOverrideArgs initializes SetSmallViewportHeightDifferenceOverrideArgs with the required arguments.
+func NewSetSmallViewportHeightDifferenceOverrideArgs(difference int) *SetSmallViewportHeightDifferenceOverrideArgs {
+	args := new(SetSmallViewportHeightDifferenceOverrideArgs)
+	args.Difference = difference
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetSmallViewportHeightDifferenceOverrideArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,21 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// VirtualTimeBudgetExpiredClient is a client for VirtualTimeBudgetExpired events.
+// Notification sent after the virtual time budget for the current
+// VirtualTimePolicy has run out.
+type VirtualTimeBudgetExpiredClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*VirtualTimeBudgetExpiredReply, error)
+	rpcc.Stream
+}
+
+// VirtualTimeBudgetExpiredReply is the reply for VirtualTimeBudgetExpired events.
+type VirtualTimeBudgetExpiredReply struct {
+}
@@ -0,0 +1,468 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+)
+
+// SetAutoDarkModeOverrideOption sets an optional argument of SetAutoDarkModeOverrideArgs.
+type SetAutoDarkModeOverrideOption func(*SetAutoDarkModeOverrideArgs)
+
+// NewSetAutoDarkModeOverrideArgsWith initializes SetAutoDarkModeOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetAutoDarkModeOverrideArgsWith(opts ...SetAutoDarkModeOverrideOption) *SetAutoDarkModeOverrideArgs {
+	args := NewSetAutoDarkModeOverrideArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetAutoDarkModeOverrideWithEnabled sets the Enabled optional argument, see
+// SetAutoDarkModeOverrideArgs.SetEnabled.
+func SetAutoDarkModeOverrideWithEnabled(v bool) SetAutoDarkModeOverrideOption {
+	return func(a *SetAutoDarkModeOverrideArgs) { a.SetEnabled(v) }
+}
+
+// SetDefaultBackgroundColorOverrideOption sets an optional argument of SetDefaultBackgroundColorOverrideArgs.
+type SetDefaultBackgroundColorOverrideOption func(*SetDefaultBackgroundColorOverrideArgs)
+
+// NewSetDefaultBackgroundColorOverrideArgsWith initializes SetDefaultBackgroundColorOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDefaultBackgroundColorOverrideArgsWith(opts ...SetDefaultBackgroundColorOverrideOption) *SetDefaultBackgroundColorOverrideArgs {
+	args := NewSetDefaultBackgroundColorOverrideArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDefaultBackgroundColorOverrideWithColor sets the Color optional argument, see
+// SetDefaultBackgroundColorOverrideArgs.SetColor.
+func SetDefaultBackgroundColorOverrideWithColor(v dom.RGBA) SetDefaultBackgroundColorOverrideOption {
+	return func(a *SetDefaultBackgroundColorOverrideArgs) { a.SetColor(v) }
+}
+
+// SetDeviceMetricsOverrideOption sets an optional argument of SetDeviceMetricsOverrideArgs.
+type SetDeviceMetricsOverrideOption func(*SetDeviceMetricsOverrideArgs)
+
+// NewSetDeviceMetricsOverrideArgsWith initializes SetDeviceMetricsOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDeviceMetricsOverrideArgsWith(width int, height int, deviceScaleFactor float64, mobile bool, opts ...SetDeviceMetricsOverrideOption) *SetDeviceMetricsOverrideArgs {
+	args := NewSetDeviceMetricsOverrideArgs(width, height, deviceScaleFactor, mobile)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDeviceMetricsOverrideWithScale sets the Scale optional argument, see
+// SetDeviceMetricsOverrideArgs.SetScale.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithScale(v float64) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetScale(v) }
+}
+
+// SetDeviceMetricsOverrideWithScreenWidth sets the ScreenWidth optional argument, see
+// SetDeviceMetricsOverrideArgs.SetScreenWidth.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithScreenWidth(v int) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetScreenWidth(v) }
+}
+
+// SetDeviceMetricsOverrideWithScreenHeight sets the ScreenHeight optional argument, see
+// SetDeviceMetricsOverrideArgs.SetScreenHeight.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithScreenHeight(v int) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetScreenHeight(v) }
+}
+
+// SetDeviceMetricsOverrideWithPositionX sets the PositionX optional argument, see
+// SetDeviceMetricsOverrideArgs.SetPositionX.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithPositionX(v int) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetPositionX(v) }
+}
+
+// SetDeviceMetricsOverrideWithPositionY sets the PositionY optional argument, see
+// SetDeviceMetricsOverrideArgs.SetPositionY.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithPositionY(v int) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetPositionY(v) }
+}
+
+// SetDeviceMetricsOverrideWithDontSetVisibleSize sets the DontSetVisibleSize optional argument, see
+// SetDeviceMetricsOverrideArgs.SetDontSetVisibleSize.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithDontSetVisibleSize(v bool) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetDontSetVisibleSize(v) }
+}
+
+// SetDeviceMetricsOverrideWithScreenOrientation sets the ScreenOrientation optional argument, see
+// SetDeviceMetricsOverrideArgs.SetScreenOrientation.
+func SetDeviceMetricsOverrideWithScreenOrientation(v ScreenOrientation) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetScreenOrientation(v) }
+}
+
+// SetDeviceMetricsOverrideWithViewport sets the Viewport optional argument, see
+// SetDeviceMetricsOverrideArgs.SetViewport.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithViewport(v page.Viewport) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetViewport(v) }
+}
+
+// SetDeviceMetricsOverrideWithDisplayFeature sets the DisplayFeature optional argument, see
+// SetDeviceMetricsOverrideArgs.SetDisplayFeature.
+//
+// Deprecated: This argument should not be used.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithDisplayFeature(v DisplayFeature) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetDisplayFeature(v) }
+}
+
+// SetDeviceMetricsOverrideWithDevicePosture sets the DevicePosture optional argument, see
+// SetDeviceMetricsOverrideArgs.SetDevicePosture.
+//
+// Deprecated: This argument should not be used.
+//
+// Note: This argument is experimental.
+func SetDeviceMetricsOverrideWithDevicePosture(v DevicePosture) SetDeviceMetricsOverrideOption {
+	return func(a *SetDeviceMetricsOverrideArgs) { a.SetDevicePosture(v) }
+}
+
+// SetEmitTouchEventsForMouseOption sets an optional argument of SetEmitTouchEventsForMouseArgs.
+type SetEmitTouchEventsForMouseOption func(*SetEmitTouchEventsForMouseArgs)
+
+// NewSetEmitTouchEventsForMouseArgsWith initializes SetEmitTouchEventsForMouseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetEmitTouchEventsForMouseArgsWith(enabled bool, opts ...SetEmitTouchEventsForMouseOption) *SetEmitTouchEventsForMouseArgs {
+	args := NewSetEmitTouchEventsForMouseArgs(enabled)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetEmitTouchEventsForMouseWithConfiguration sets the Configuration optional argument, see
+// SetEmitTouchEventsForMouseArgs.SetConfiguration.
+func SetEmitTouchEventsForMouseWithConfiguration(v string) SetEmitTouchEventsForMouseOption {
+	return func(a *SetEmitTouchEventsForMouseArgs) { a.SetConfiguration(v) }
+}
+
+// SetEmulatedMediaOption sets an optional argument of SetEmulatedMediaArgs.
+type SetEmulatedMediaOption func(*SetEmulatedMediaArgs)
+
+// NewSetEmulatedMediaArgsWith initializes SetEmulatedMediaArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetEmulatedMediaArgsWith(opts ...SetEmulatedMediaOption) *SetEmulatedMediaArgs {
+	args := NewSetEmulatedMediaArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetEmulatedMediaWithMedia sets the Media optional argument, see
+// SetEmulatedMediaArgs.SetMedia.
+func SetEmulatedMediaWithMedia(v string) SetEmulatedMediaOption {
+	return func(a *SetEmulatedMediaArgs) { a.SetMedia(v) }
+}
+
+// SetEmulatedMediaWithFeatures sets the Features optional argument, see
+// SetEmulatedMediaArgs.SetFeatures.
+func SetEmulatedMediaWithFeatures(v []MediaFeature) SetEmulatedMediaOption {
+	return func(a *SetEmulatedMediaArgs) { a.SetFeatures(v) }
+}
+
+// SetEmulatedOSTextScaleOption sets an optional argument of SetEmulatedOSTextScaleArgs.
+type SetEmulatedOSTextScaleOption func(*SetEmulatedOSTextScaleArgs)
+
+// NewSetEmulatedOSTextScaleArgsWith initializes SetEmulatedOSTextScaleArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetEmulatedOSTextScaleArgsWith(opts ...SetEmulatedOSTextScaleOption) *SetEmulatedOSTextScaleArgs {
+	args := NewSetEmulatedOSTextScaleArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetEmulatedOSTextScaleWithScale sets the Scale optional argument, see
+// SetEmulatedOSTextScaleArgs.SetScale.
+func SetEmulatedOSTextScaleWithScale(v float64) SetEmulatedOSTextScaleOption {
+	return func(a *SetEmulatedOSTextScaleArgs) { a.SetScale(v) }
+}
+
+// SetGeolocationOverrideOption sets an optional argument of SetGeolocationOverrideArgs.
+type SetGeolocationOverrideOption func(*SetGeolocationOverrideArgs)
+
+// NewSetGeolocationOverrideArgsWith initializes SetGeolocationOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetGeolocationOverrideArgsWith(opts ...SetGeolocationOverrideOption) *SetGeolocationOverrideArgs {
+	args := NewSetGeolocationOverrideArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetGeolocationOverrideWithLatitude sets the Latitude optional argument, see
+// SetGeolocationOverrideArgs.SetLatitude.
+func SetGeolocationOverrideWithLatitude(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetLatitude(v) }
+}
+
+// SetGeolocationOverrideWithLongitude sets the Longitude optional argument, see
+// SetGeolocationOverrideArgs.SetLongitude.
+func SetGeolocationOverrideWithLongitude(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetLongitude(v) }
+}
+
+// SetGeolocationOverrideWithAccuracy sets the Accuracy optional argument, see
+// SetGeolocationOverrideArgs.SetAccuracy.
+func SetGeolocationOverrideWithAccuracy(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetAccuracy(v) }
+}
+
+// SetGeolocationOverrideWithAltitude sets the Altitude optional argument, see
+// SetGeolocationOverrideArgs.SetAltitude.
+func SetGeolocationOverrideWithAltitude(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetAltitude(v) }
+}
+
+// SetGeolocationOverrideWithAltitudeAccuracy sets the AltitudeAccuracy optional argument, see
+// SetGeolocationOverrideArgs.SetAltitudeAccuracy.
+func SetGeolocationOverrideWithAltitudeAccuracy(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetAltitudeAccuracy(v) }
+}
+
+// SetGeolocationOverrideWithHeading sets the Heading optional argument, see
+// SetGeolocationOverrideArgs.SetHeading.
+func SetGeolocationOverrideWithHeading(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetHeading(v) }
+}
+
+// SetGeolocationOverrideWithSpeed sets the Speed optional argument, see
+// SetGeolocationOverrideArgs.SetSpeed.
+func SetGeolocationOverrideWithSpeed(v float64) SetGeolocationOverrideOption {
+	return func(a *SetGeolocationOverrideArgs) { a.SetSpeed(v) }
+}
+
+// SetSensorOverrideEnabledOption sets an optional argument of SetSensorOverrideEnabledArgs.
+type SetSensorOverrideEnabledOption func(*SetSensorOverrideEnabledArgs)
+
+// NewSetSensorOverrideEnabledArgsWith initializes SetSensorOverrideEnabledArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetSensorOverrideEnabledArgsWith(enabled bool, typ SensorType, opts ...SetSensorOverrideEnabledOption) *SetSensorOverrideEnabledArgs {
+	args := NewSetSensorOverrideEnabledArgs(enabled, typ)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetSensorOverrideEnabledWithMetadata sets the Metadata optional argument, see
+// SetSensorOverrideEnabledArgs.SetMetadata.
+func SetSensorOverrideEnabledWithMetadata(v SensorMetadata) SetSensorOverrideEnabledOption {
+	return func(a *SetSensorOverrideEnabledArgs) { a.SetMetadata(v) }
+}
+
+// SetPressureSourceOverrideEnabledOption sets an optional argument of SetPressureSourceOverrideEnabledArgs.
+type SetPressureSourceOverrideEnabledOption func(*SetPressureSourceOverrideEnabledArgs)
+
+// NewSetPressureSourceOverrideEnabledArgsWith initializes SetPressureSourceOverrideEnabledArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetPressureSourceOverrideEnabledArgsWith(enabled bool, source PressureSource, opts ...SetPressureSourceOverrideEnabledOption) *SetPressureSourceOverrideEnabledArgs {
+	args := NewSetPressureSourceOverrideEnabledArgs(enabled, source)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetPressureSourceOverrideEnabledWithMetadata sets the Metadata optional argument, see
+// SetPressureSourceOverrideEnabledArgs.SetMetadata.
+func SetPressureSourceOverrideEnabledWithMetadata(v PressureMetadata) SetPressureSourceOverrideEnabledOption {
+	return func(a *SetPressureSourceOverrideEnabledArgs) { a.SetMetadata(v) }
+}
+
+// SetPressureDataOverrideOption sets an optional argument of SetPressureDataOverrideArgs.
+type SetPressureDataOverrideOption func(*SetPressureDataOverrideArgs)
+
+// NewSetPressureDataOverrideArgsWith initializes SetPressureDataOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetPressureDataOverrideArgsWith(source PressureSource, state PressureState, opts ...SetPressureDataOverrideOption) *SetPressureDataOverrideArgs {
+	args := NewSetPressureDataOverrideArgs(source, state)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetPressureDataOverrideWithOwnContributionEstimate sets the OwnContributionEstimate optional argument, see
+// SetPressureDataOverrideArgs.SetOwnContributionEstimate.
+func SetPressureDataOverrideWithOwnContributionEstimate(v float64) SetPressureDataOverrideOption {
+	return func(a *SetPressureDataOverrideArgs) { a.SetOwnContributionEstimate(v) }
+}
+
+// SetTouchEmulationEnabledOption sets an optional argument of SetTouchEmulationEnabledArgs.
+type SetTouchEmulationEnabledOption func(*SetTouchEmulationEnabledArgs)
+
+// NewSetTouchEmulationEnabledArgsWith initializes SetTouchEmulationEnabledArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetTouchEmulationEnabledArgsWith(enabled bool, opts ...SetTouchEmulationEnabledOption) *SetTouchEmulationEnabledArgs {
+	args := NewSetTouchEmulationEnabledArgs(enabled)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetTouchEmulationEnabledWithMaxTouchPoints sets the MaxTouchPoints optional argument, see
+// SetTouchEmulationEnabledArgs.SetMaxTouchPoints.
+func SetTouchEmulationEnabledWithMaxTouchPoints(v int) SetTouchEmulationEnabledOption {
+	return func(a *SetTouchEmulationEnabledArgs) { a.SetMaxTouchPoints(v) }
+}
+
+// SetVirtualTimePolicyOption sets an optional argument of SetVirtualTimePolicyArgs.
+type SetVirtualTimePolicyOption func(*SetVirtualTimePolicyArgs)
+
+// NewSetVirtualTimePolicyArgsWith initializes SetVirtualTimePolicyArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetVirtualTimePolicyArgsWith(policy VirtualTimePolicy, opts ...SetVirtualTimePolicyOption) *SetVirtualTimePolicyArgs {
+	args := NewSetVirtualTimePolicyArgs(policy)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetVirtualTimePolicyWithBudget sets the Budget optional argument, see
+// SetVirtualTimePolicyArgs.SetBudget.
+func SetVirtualTimePolicyWithBudget(v float64) SetVirtualTimePolicyOption {
+	return func(a *SetVirtualTimePolicyArgs) { a.SetBudget(v) }
+}
+
+// SetVirtualTimePolicyWithMaxVirtualTimeTaskStarvationCount sets the MaxVirtualTimeTaskStarvationCount optional argument, see
+// SetVirtualTimePolicyArgs.SetMaxVirtualTimeTaskStarvationCount.
+func SetVirtualTimePolicyWithMaxVirtualTimeTaskStarvationCount(v int) SetVirtualTimePolicyOption {
+	return func(a *SetVirtualTimePolicyArgs) { a.SetMaxVirtualTimeTaskStarvationCount(v) }
+}
+
+// SetVirtualTimePolicyWithInitialVirtualTime sets the InitialVirtualTime optional argument, see
+// SetVirtualTimePolicyArgs.SetInitialVirtualTime.
+func SetVirtualTimePolicyWithInitialVirtualTime(v network.TimeSinceEpoch) SetVirtualTimePolicyOption {
+	return func(a *SetVirtualTimePolicyArgs) { a.SetInitialVirtualTime(v) }
+}
+
+// SetLocaleOverrideOption sets an optional argument of SetLocaleOverrideArgs.
+type SetLocaleOverrideOption func(*SetLocaleOverrideArgs)
+
+// NewSetLocaleOverrideArgsWith initializes SetLocaleOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetLocaleOverrideArgsWith(opts ...SetLocaleOverrideOption) *SetLocaleOverrideArgs {
+	args := NewSetLocaleOverrideArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetLocaleOverrideWithLocale sets the Locale optional argument, see
+// SetLocaleOverrideArgs.SetLocale.
+func SetLocaleOverrideWithLocale(v string) SetLocaleOverrideOption {
+	return func(a *SetLocaleOverrideArgs) { a.SetLocale(v) }
+}
+
+// SetDataSaverOverrideOption sets an optional argument of SetDataSaverOverrideArgs.
+type SetDataSaverOverrideOption func(*SetDataSaverOverrideArgs)
+
+// NewSetDataSaverOverrideArgsWith initializes SetDataSaverOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetDataSaverOverrideArgsWith(opts ...SetDataSaverOverrideOption) *SetDataSaverOverrideArgs {
+	args := NewSetDataSaverOverrideArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetDataSaverOverrideWithDataSaverEnabled sets the DataSaverEnabled optional argument, see
+// SetDataSaverOverrideArgs.SetDataSaverEnabled.
+func SetDataSaverOverrideWithDataSaverEnabled(v bool) SetDataSaverOverrideOption {
+	return func(a *SetDataSaverOverrideArgs) { a.SetDataSaverEnabled(v) }
+}
+
+// SetUserAgentOverrideOption sets an optional argument of SetUserAgentOverrideArgs.
+type SetUserAgentOverrideOption func(*SetUserAgentOverrideArgs)
+
+// NewSetUserAgentOverrideArgsWith initializes SetUserAgentOverrideArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetUserAgentOverrideArgsWith(userAgent string, opts ...SetUserAgentOverrideOption) *SetUserAgentOverrideArgs {
+	args := NewSetUserAgentOverrideArgs(userAgent)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetUserAgentOverrideWithAcceptLanguage sets the AcceptLanguage optional argument, see
+// SetUserAgentOverrideArgs.SetAcceptLanguage.
+func SetUserAgentOverrideWithAcceptLanguage(v string) SetUserAgentOverrideOption {
+	return func(a *SetUserAgentOverrideArgs) { a.SetAcceptLanguage(v) }
+}
+
+// SetUserAgentOverrideWithPlatform sets the Platform optional argument, see
+// SetUserAgentOverrideArgs.SetPlatform.
+func SetUserAgentOverrideWithPlatform(v string) SetUserAgentOverrideOption {
+	return func(a *SetUserAgentOverrideArgs) { a.SetPlatform(v) }
+}
+
+// SetUserAgentOverrideWithUserAgentMetadata sets the UserAgentMetadata optional argument, see
+// SetUserAgentOverrideArgs.SetUserAgentMetadata.
+//
+// Note: This argument is experimental.
+func SetUserAgentOverrideWithUserAgentMetadata(v UserAgentMetadata) SetUserAgentOverrideOption {
+	return func(a *SetUserAgentOverrideArgs) { a.SetUserAgentMetadata(v) }
+}
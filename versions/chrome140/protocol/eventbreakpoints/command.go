@@ -0,0 +1,39 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package eventbreakpoints
+
+// SetInstrumentationBreakpointArgs represents the arguments for SetInstrumentationBreakpoint in the EventBreakpoints domain.
+type SetInstrumentationBreakpointArgs struct {
+	EventName string `json:"eventName"` // Instrumentation name to stop on.
+}
+
+// NewSetInstrumentationBreakpointArgs initializes SetInstrumentationBreakpointArgs with the required arguments.
+func NewSetInstrumentationBreakpointArgs(eventName string) *SetInstrumentationBreakpointArgs {
+	args := new(SetInstrumentationBreakpointArgs)
+	args.EventName = eventName
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetInstrumentationBreakpointArgs) Validate() error {
+	return nil
+}
+
+// RemoveInstrumentationBreakpointArgs represents the arguments for RemoveInstrumentationBreakpoint in the EventBreakpoints domain.
+type RemoveInstrumentationBreakpointArgs struct {
+	EventName string `json:"eventName"` // Instrumentation name to stop on.
+}
+
+// NewRemoveInstrumentationBreakpointArgs initializes RemoveInstrumentationBreakpointArgs with the required arguments.
+func NewRemoveInstrumentationBreakpointArgs(eventName string) *RemoveInstrumentationBreakpointArgs {
+	args := new(RemoveInstrumentationBreakpointArgs)
+	args.EventName = eventName
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveInstrumentationBreakpointArgs) Validate() error {
+	return nil
+}
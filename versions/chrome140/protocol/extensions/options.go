@@ -0,0 +1,24 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package extensions
+
+// GetStorageItemsOption sets an optional argument of GetStorageItemsArgs.
+type GetStorageItemsOption func(*GetStorageItemsArgs)
+
+// NewGetStorageItemsArgsWith initializes GetStorageItemsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetStorageItemsArgsWith(id string, storageArea StorageArea, opts ...GetStorageItemsOption) *GetStorageItemsArgs {
+	args := NewGetStorageItemsArgs(id, storageArea)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetStorageItemsWithKeys sets the Keys optional argument, see
+// GetStorageItemsArgs.SetKeys.
+func GetStorageItemsWithKeys(v []string) GetStorageItemsOption {
+	return func(a *GetStorageItemsArgs) { a.SetKeys(v) }
+}
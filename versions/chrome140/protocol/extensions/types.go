@@ -0,0 +1,28 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package extensions
+
+// StorageArea Storage areas.
+type StorageArea string
+
+// StorageArea as enums.
+const (
+	StorageAreaNotSet  StorageArea = ""
+	StorageAreaSession StorageArea = "session"
+	StorageAreaLocal   StorageArea = "local"
+	StorageAreaSync    StorageArea = "sync"
+	StorageAreaManaged StorageArea = "managed"
+)
+
+func (e StorageArea) Valid() bool {
+	switch e {
+	case "session", "local", "sync", "managed":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e StorageArea) String() string {
+	return string(e)
+}
@@ -0,0 +1,45 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package fedcm
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithDisableRejectionDelay sets the DisableRejectionDelay optional argument, see
+// EnableArgs.SetDisableRejectionDelay.
+func EnableWithDisableRejectionDelay(v bool) EnableOption {
+	return func(a *EnableArgs) { a.SetDisableRejectionDelay(v) }
+}
+
+// DismissDialogOption sets an optional argument of DismissDialogArgs.
+type DismissDialogOption func(*DismissDialogArgs)
+
+// NewDismissDialogArgsWith initializes DismissDialogArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewDismissDialogArgsWith(dialogID string, opts ...DismissDialogOption) *DismissDialogArgs {
+	args := NewDismissDialogArgs(dialogID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// DismissDialogWithTriggerCooldown sets the TriggerCooldown optional argument, see
+// DismissDialogArgs.SetTriggerCooldown.
+func DismissDialogWithTriggerCooldown(v bool) DismissDialogOption {
+	return func(a *DismissDialogArgs) { a.SetTriggerCooldown(v) }
+}
@@ -0,0 +1,113 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package fedcm
+
+// LoginState Whether this is a sign-up or sign-in action for this account,
+// i.e. whether this account has ever been used to sign in to this RP before.
+type LoginState string
+
+// LoginState as enums.
+const (
+	LoginStateNotSet LoginState = ""
+	LoginStateSignIn LoginState = "SignIn"
+	LoginStateSignUp LoginState = "SignUp"
+)
+
+func (e LoginState) Valid() bool {
+	switch e {
+	case "SignIn", "SignUp":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e LoginState) String() string {
+	return string(e)
+}
+
+// DialogType The types of FedCM dialogs.
+type DialogType string
+
+// DialogType as enums.
+const (
+	DialogTypeNotSet          DialogType = ""
+	DialogTypeAccountChooser  DialogType = "AccountChooser"
+	DialogTypeAutoReauthn     DialogType = "AutoReauthn"
+	DialogTypeConfirmIDPLogin DialogType = "ConfirmIdpLogin"
+	DialogTypeError           DialogType = "Error"
+)
+
+func (e DialogType) Valid() bool {
+	switch e {
+	case "AccountChooser", "AutoReauthn", "ConfirmIdpLogin", "Error":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e DialogType) String() string {
+	return string(e)
+}
+
+// DialogButton The buttons on the FedCM dialog.
+type DialogButton string
+
+// DialogButton as enums.
+const (
+	DialogButtonNotSet                  DialogButton = ""
+	DialogButtonConfirmIDPLoginContinue DialogButton = "ConfirmIdpLoginContinue"
+	DialogButtonErrorGotIt              DialogButton = "ErrorGotIt"
+	DialogButtonErrorMoreDetails        DialogButton = "ErrorMoreDetails"
+)
+
+func (e DialogButton) Valid() bool {
+	switch e {
+	case "ConfirmIdpLoginContinue", "ErrorGotIt", "ErrorMoreDetails":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e DialogButton) String() string {
+	return string(e)
+}
+
+// AccountURLType The URLs that each account has
+type AccountURLType string
+
+// AccountURLType as enums.
+const (
+	AccountURLTypeNotSet         AccountURLType = ""
+	AccountURLTypeTermsOfService AccountURLType = "TermsOfService"
+	AccountURLTypePrivacyPolicy  AccountURLType = "PrivacyPolicy"
+)
+
+func (e AccountURLType) Valid() bool {
+	switch e {
+	case "TermsOfService", "PrivacyPolicy":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e AccountURLType) String() string {
+	return string(e)
+}
+
+// Account Corresponds to IdentityRequestAccount
+type Account struct {
+	AccountID         string     `json:"accountId"`                   // No description.
+	Email             string     `json:"email"`                       // No description.
+	Name              string     `json:"name"`                        // No description.
+	GivenName         string     `json:"givenName"`                   // No description.
+	PictureURL        string     `json:"pictureUrl"`                  // No description.
+	IDPConfigURL      string     `json:"idpConfigUrl"`                // No description.
+	IDPLoginURL       string     `json:"idpLoginUrl"`                 // No description.
+	LoginState        LoginState `json:"loginState"`                  // No description.
+	TermsOfServiceURL *string    `json:"termsOfServiceUrl,omitempty"` // These two are only set if the loginState is signUp
+	PrivacyPolicyURL  *string    `json:"privacyPolicyUrl,omitempty"`  // No description.
+}
@@ -0,0 +1,738 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/io"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestPattern) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.URLPattern != nil {
+		w.Key("urlPattern")
+		w.String(*v.URLPattern)
+	}
+	if v.ResourceType != nil {
+		w.Key("resourceType")
+		w.String(string(*v.ResourceType))
+	}
+	if v.RequestStage != "" {
+		w.Key("requestStage")
+		w.String(string(v.RequestStage))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestPattern) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "urlPattern":
+			if r.Null() {
+				v.URLPattern = nil
+			} else {
+				p := r.String()
+				v.URLPattern = &p
+			}
+		case "resourceType":
+			if r.Null() {
+				v.ResourceType = nil
+			} else {
+				p := network.ResourceType(r.String())
+				v.ResourceType = &p
+			}
+		case "requestStage":
+			v.RequestStage = RequestStage(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HeaderEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HeaderEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AuthChallenge) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Source != nil {
+		w.Key("source")
+		w.String(*v.Source)
+	}
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("scheme")
+	w.String(v.Scheme)
+	w.Key("realm")
+	w.String(v.Realm)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AuthChallenge) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "source":
+			if r.Null() {
+				v.Source = nil
+			} else {
+				p := r.String()
+				v.Source = &p
+			}
+		case "origin":
+			v.Origin = r.String()
+		case "scheme":
+			v.Scheme = r.String()
+		case "realm":
+			v.Realm = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AuthChallengeResponse) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("response")
+	w.String(v.Response)
+	if v.Username != nil {
+		w.Key("username")
+		w.String(*v.Username)
+	}
+	if v.Password != nil {
+		w.Key("password")
+		w.String(*v.Password)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AuthChallengeResponse) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "response":
+			v.Response = r.String()
+		case "username":
+			if r.Null() {
+				v.Username = nil
+			} else {
+				p := r.String()
+				v.Username = &p
+			}
+		case "password":
+			if r.Null() {
+				v.Password = nil
+			} else {
+				p := r.String()
+				v.Password = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.Patterns) > 0 {
+		w.Key("patterns")
+		w.ArrayStart()
+		for i0 := range v.Patterns {
+			v.Patterns[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.HandleAuthRequests != nil {
+		w.Key("handleAuthRequests")
+		w.Bool(*v.HandleAuthRequests)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "patterns":
+			if r.ArrayStart() {
+				v.Patterns = make([]RequestPattern, 0, 4)
+				for r.ArrayNext() {
+					var e0 RequestPattern
+					e0.DecodeJSON(r)
+					v.Patterns = append(v.Patterns, e0)
+				}
+			} else {
+				v.Patterns = nil
+			}
+		case "handleAuthRequests":
+			if r.Null() {
+				v.HandleAuthRequests = nil
+			} else {
+				p := r.Bool()
+				v.HandleAuthRequests = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FailRequestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("errorReason")
+	w.String(string(v.ErrorReason))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FailRequestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "errorReason":
+			v.ErrorReason = network.ErrorReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FulfillRequestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("responseCode")
+	w.Int(v.ResponseCode)
+	if len(v.ResponseHeaders) > 0 {
+		w.Key("responseHeaders")
+		w.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			v.ResponseHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.BinaryResponseHeaders) > 0 {
+		w.Key("binaryResponseHeaders")
+		w.Base64(v.BinaryResponseHeaders)
+	}
+	if len(v.Body) > 0 {
+		w.Key("body")
+		w.Base64(v.Body)
+	}
+	if v.ResponsePhrase != nil {
+		w.Key("responsePhrase")
+		w.String(*v.ResponsePhrase)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FulfillRequestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "responseCode":
+			v.ResponseCode = r.Int()
+		case "responseHeaders":
+			if r.ArrayStart() {
+				v.ResponseHeaders = make([]HeaderEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 HeaderEntry
+					e0.DecodeJSON(r)
+					v.ResponseHeaders = append(v.ResponseHeaders, e0)
+				}
+			} else {
+				v.ResponseHeaders = nil
+			}
+		case "binaryResponseHeaders":
+			v.BinaryResponseHeaders = r.Base64()
+		case "body":
+			v.Body = r.Base64()
+		case "responsePhrase":
+			if r.Null() {
+				v.ResponsePhrase = nil
+			} else {
+				p := r.String()
+				v.ResponsePhrase = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContinueRequestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.Method != nil {
+		w.Key("method")
+		w.String(*v.Method)
+	}
+	if len(v.PostData) > 0 {
+		w.Key("postData")
+		w.Base64(v.PostData)
+	}
+	if len(v.Headers) > 0 {
+		w.Key("headers")
+		w.ArrayStart()
+		for i0 := range v.Headers {
+			v.Headers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.InterceptResponse != nil {
+		w.Key("interceptResponse")
+		w.Bool(*v.InterceptResponse)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContinueRequestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "method":
+			if r.Null() {
+				v.Method = nil
+			} else {
+				p := r.String()
+				v.Method = &p
+			}
+		case "postData":
+			v.PostData = r.Base64()
+		case "headers":
+			if r.ArrayStart() {
+				v.Headers = make([]HeaderEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 HeaderEntry
+					e0.DecodeJSON(r)
+					v.Headers = append(v.Headers, e0)
+				}
+			} else {
+				v.Headers = nil
+			}
+		case "interceptResponse":
+			if r.Null() {
+				v.InterceptResponse = nil
+			} else {
+				p := r.Bool()
+				v.InterceptResponse = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContinueWithAuthArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("authChallengeResponse")
+	v.AuthChallengeResponse.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContinueWithAuthArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "authChallengeResponse":
+			v.AuthChallengeResponse.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContinueResponseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	if v.ResponseCode != nil {
+		w.Key("responseCode")
+		w.Int(*v.ResponseCode)
+	}
+	if v.ResponsePhrase != nil {
+		w.Key("responsePhrase")
+		w.String(*v.ResponsePhrase)
+	}
+	if len(v.ResponseHeaders) > 0 {
+		w.Key("responseHeaders")
+		w.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			v.ResponseHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.BinaryResponseHeaders) > 0 {
+		w.Key("binaryResponseHeaders")
+		w.Base64(v.BinaryResponseHeaders)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContinueResponseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "responseCode":
+			if r.Null() {
+				v.ResponseCode = nil
+			} else {
+				p := r.Int()
+				v.ResponseCode = &p
+			}
+		case "responsePhrase":
+			if r.Null() {
+				v.ResponsePhrase = nil
+			} else {
+				p := r.String()
+				v.ResponsePhrase = &p
+			}
+		case "responseHeaders":
+			if r.ArrayStart() {
+				v.ResponseHeaders = make([]HeaderEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 HeaderEntry
+					e0.DecodeJSON(r)
+					v.ResponseHeaders = append(v.ResponseHeaders, e0)
+				}
+			} else {
+				v.ResponseHeaders = nil
+			}
+		case "binaryResponseHeaders":
+			v.BinaryResponseHeaders = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResponseBodyArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResponseBodyArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResponseBodyReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("body")
+	w.String(v.Body)
+	w.Key("base64Encoded")
+	w.Bool(v.Base64Encoded)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResponseBodyReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "body":
+			v.Body = r.String()
+		case "base64Encoded":
+			v.Base64Encoded = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeResponseBodyAsStreamArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeResponseBodyAsStreamArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeResponseBodyAsStreamReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("stream")
+	w.String(string(v.Stream))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeResponseBodyAsStreamReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "stream":
+			v.Stream = io.StreamHandle(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestPausedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("resourceType")
+	w.String(string(v.ResourceType))
+	if v.ResponseErrorReason != nil {
+		w.Key("responseErrorReason")
+		w.String(string(*v.ResponseErrorReason))
+	}
+	if v.ResponseStatusCode != nil {
+		w.Key("responseStatusCode")
+		w.Int(*v.ResponseStatusCode)
+	}
+	if v.ResponseStatusText != nil {
+		w.Key("responseStatusText")
+		w.String(*v.ResponseStatusText)
+	}
+	if len(v.ResponseHeaders) > 0 {
+		w.Key("responseHeaders")
+		w.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			v.ResponseHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.NetworkID != nil {
+		w.Key("networkId")
+		w.String(string(*v.NetworkID))
+	}
+	if v.RedirectedRequestID != nil {
+		w.Key("redirectedRequestId")
+		w.String(string(*v.RedirectedRequestID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestPausedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "request":
+			v.Request.DecodeJSON(r)
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "resourceType":
+			v.ResourceType = network.ResourceType(r.String())
+		case "responseErrorReason":
+			if r.Null() {
+				v.ResponseErrorReason = nil
+			} else {
+				p := network.ErrorReason(r.String())
+				v.ResponseErrorReason = &p
+			}
+		case "responseStatusCode":
+			if r.Null() {
+				v.ResponseStatusCode = nil
+			} else {
+				p := r.Int()
+				v.ResponseStatusCode = &p
+			}
+		case "responseStatusText":
+			if r.Null() {
+				v.ResponseStatusText = nil
+			} else {
+				p := r.String()
+				v.ResponseStatusText = &p
+			}
+		case "responseHeaders":
+			if r.ArrayStart() {
+				v.ResponseHeaders = make([]HeaderEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 HeaderEntry
+					e0.DecodeJSON(r)
+					v.ResponseHeaders = append(v.ResponseHeaders, e0)
+				}
+			} else {
+				v.ResponseHeaders = nil
+			}
+		case "networkId":
+			if r.Null() {
+				v.NetworkID = nil
+			} else {
+				p := network.RequestID(r.String())
+				v.NetworkID = &p
+			}
+		case "redirectedRequestId":
+			if r.Null() {
+				v.RedirectedRequestID = nil
+			} else {
+				p := RequestID(r.String())
+				v.RedirectedRequestID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AuthRequiredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.Key("request")
+	v.Request.EncodeJSON(w)
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("resourceType")
+	w.String(string(v.ResourceType))
+	w.Key("authChallenge")
+	v.AuthChallenge.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AuthRequiredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "requestId":
+			v.RequestID = RequestID(r.String())
+		case "request":
+			v.Request.DecodeJSON(r)
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "resourceType":
+			v.ResourceType = network.ResourceType(r.String())
+		case "authChallenge":
+			v.AuthChallenge.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
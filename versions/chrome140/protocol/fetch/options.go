@@ -0,0 +1,155 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package fetch
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithPatterns sets the Patterns optional argument, see
+// EnableArgs.SetPatterns.
+func EnableWithPatterns(v []RequestPattern) EnableOption {
+	return func(a *EnableArgs) { a.SetPatterns(v) }
+}
+
+// EnableWithHandleAuthRequests sets the HandleAuthRequests optional argument, see
+// EnableArgs.SetHandleAuthRequests.
+func EnableWithHandleAuthRequests(v bool) EnableOption {
+	return func(a *EnableArgs) { a.SetHandleAuthRequests(v) }
+}
+
+// FulfillRequestOption sets an optional argument of FulfillRequestArgs.
+type FulfillRequestOption func(*FulfillRequestArgs)
+
+// NewFulfillRequestArgsWith initializes FulfillRequestArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewFulfillRequestArgsWith(requestID RequestID, responseCode int, opts ...FulfillRequestOption) *FulfillRequestArgs {
+	args := NewFulfillRequestArgs(requestID, responseCode)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// FulfillRequestWithResponseHeaders sets the ResponseHeaders optional argument, see
+// FulfillRequestArgs.SetResponseHeaders.
+func FulfillRequestWithResponseHeaders(v []HeaderEntry) FulfillRequestOption {
+	return func(a *FulfillRequestArgs) { a.SetResponseHeaders(v) }
+}
+
+// FulfillRequestWithBinaryResponseHeaders sets the BinaryResponseHeaders optional argument, see
+// FulfillRequestArgs.SetBinaryResponseHeaders.
+func FulfillRequestWithBinaryResponseHeaders(v []byte) FulfillRequestOption {
+	return func(a *FulfillRequestArgs) { a.SetBinaryResponseHeaders(v) }
+}
+
+// FulfillRequestWithBody sets the Body optional argument, see
+// FulfillRequestArgs.SetBody.
+func FulfillRequestWithBody(v []byte) FulfillRequestOption {
+	return func(a *FulfillRequestArgs) { a.SetBody(v) }
+}
+
+// FulfillRequestWithResponsePhrase sets the ResponsePhrase optional argument, see
+// FulfillRequestArgs.SetResponsePhrase.
+func FulfillRequestWithResponsePhrase(v string) FulfillRequestOption {
+	return func(a *FulfillRequestArgs) { a.SetResponsePhrase(v) }
+}
+
+// ContinueRequestOption sets an optional argument of ContinueRequestArgs.
+type ContinueRequestOption func(*ContinueRequestArgs)
+
+// NewContinueRequestArgsWith initializes ContinueRequestArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewContinueRequestArgsWith(requestID RequestID, opts ...ContinueRequestOption) *ContinueRequestArgs {
+	args := NewContinueRequestArgs(requestID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ContinueRequestWithURL sets the URL optional argument, see
+// ContinueRequestArgs.SetURL.
+func ContinueRequestWithURL(v string) ContinueRequestOption {
+	return func(a *ContinueRequestArgs) { a.SetURL(v) }
+}
+
+// ContinueRequestWithMethod sets the Method optional argument, see
+// ContinueRequestArgs.SetMethod.
+func ContinueRequestWithMethod(v string) ContinueRequestOption {
+	return func(a *ContinueRequestArgs) { a.SetMethod(v) }
+}
+
+// ContinueRequestWithPostData sets the PostData optional argument, see
+// ContinueRequestArgs.SetPostData.
+func ContinueRequestWithPostData(v []byte) ContinueRequestOption {
+	return func(a *ContinueRequestArgs) { a.SetPostData(v) }
+}
+
+// ContinueRequestWithHeaders sets the Headers optional argument, see
+// ContinueRequestArgs.SetHeaders.
+func ContinueRequestWithHeaders(v []HeaderEntry) ContinueRequestOption {
+	return func(a *ContinueRequestArgs) { a.SetHeaders(v) }
+}
+
+// ContinueRequestWithInterceptResponse sets the InterceptResponse optional argument, see
+// ContinueRequestArgs.SetInterceptResponse.
+//
+// Note: This argument is experimental.
+func ContinueRequestWithInterceptResponse(v bool) ContinueRequestOption {
+	return func(a *ContinueRequestArgs) { a.SetInterceptResponse(v) }
+}
+
+// ContinueResponseOption sets an optional argument of ContinueResponseArgs.
+type ContinueResponseOption func(*ContinueResponseArgs)
+
+// NewContinueResponseArgsWith initializes ContinueResponseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewContinueResponseArgsWith(requestID RequestID, opts ...ContinueResponseOption) *ContinueResponseArgs {
+	args := NewContinueResponseArgs(requestID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// ContinueResponseWithResponseCode sets the ResponseCode optional argument, see
+// ContinueResponseArgs.SetResponseCode.
+func ContinueResponseWithResponseCode(v int) ContinueResponseOption {
+	return func(a *ContinueResponseArgs) { a.SetResponseCode(v) }
+}
+
+// ContinueResponseWithResponsePhrase sets the ResponsePhrase optional argument, see
+// ContinueResponseArgs.SetResponsePhrase.
+func ContinueResponseWithResponsePhrase(v string) ContinueResponseOption {
+	return func(a *ContinueResponseArgs) { a.SetResponsePhrase(v) }
+}
+
+// ContinueResponseWithResponseHeaders sets the ResponseHeaders optional argument, see
+// ContinueResponseArgs.SetResponseHeaders.
+func ContinueResponseWithResponseHeaders(v []HeaderEntry) ContinueResponseOption {
+	return func(a *ContinueResponseArgs) { a.SetResponseHeaders(v) }
+}
+
+// ContinueResponseWithBinaryResponseHeaders sets the BinaryResponseHeaders optional argument, see
+// ContinueResponseArgs.SetBinaryResponseHeaders.
+func ContinueResponseWithBinaryResponseHeaders(v []byte) ContinueResponseOption {
+	return func(a *ContinueResponseArgs) { a.SetBinaryResponseHeaders(v) }
+}
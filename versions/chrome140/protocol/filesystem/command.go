@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package filesystem
+
+// GetDirectoryArgs represents the arguments for GetDirectory in the FileSystem domain.
+type GetDirectoryArgs struct {
+	BucketFileSystemLocator BucketFileSystemLocator `json:"bucketFileSystemLocator"` // No description.
+}
+
+// NewGetDirectoryArgs initializes GetDirectoryArgs with the required arguments.
+func NewGetDirectoryArgs(bucketFileSystemLocator BucketFileSystemLocator) *GetDirectoryArgs {
+	args := new(GetDirectoryArgs)
+	args.BucketFileSystemLocator = bucketFileSystemLocator
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetDirectoryArgs) Validate() error {
+	return nil
+}
+
+// GetDirectoryReply represents the return values for GetDirectory in the FileSystem domain.
+type GetDirectoryReply struct {
+	Directory Directory `json:"directory"` // Returns the directory object at the path.
+}
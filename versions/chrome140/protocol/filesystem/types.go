@@ -0,0 +1,30 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package filesystem
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/storage"
+)
+
+// File
+type File struct {
+	Name         string                 `json:"name"`         // No description.
+	LastModified network.TimeSinceEpoch `json:"lastModified"` // Timestamp
+	Size         float64                `json:"size"`         // Size in bytes
+	Type         string                 `json:"type"`         // No description.
+}
+
+// Directory
+type Directory struct {
+	Name              string   `json:"name"`              // No description.
+	NestedDirectories []string `json:"nestedDirectories"` // No description.
+	NestedFiles       []File   `json:"nestedFiles"`       // Files that are directly nested under this directory.
+}
+
+// BucketFileSystemLocator
+type BucketFileSystemLocator struct {
+	StorageKey     storage.SerializedStorageKey `json:"storageKey"`           // Storage key
+	BucketName     *string                      `json:"bucketName,omitempty"` // Bucket name. Not passing a `bucketName` will retrieve the default Bucket. (https://developer.mozilla.org/en-US/docs/Web/API/Storage_API#storage_buckets)
+	PathComponents []string                     `json:"pathComponents"`       // Path to the directory using each path component as an array item.
+}
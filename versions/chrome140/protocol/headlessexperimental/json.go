@@ -0,0 +1,152 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package headlessexperimental
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreenshotParams) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Format != nil {
+		w.Key("format")
+		w.String(*v.Format)
+	}
+	if v.Quality != nil {
+		w.Key("quality")
+		w.Int(*v.Quality)
+	}
+	if v.OptimizeForSpeed != nil {
+		w.Key("optimizeForSpeed")
+		w.Bool(*v.OptimizeForSpeed)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreenshotParams) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "format":
+			if r.Null() {
+				v.Format = nil
+			} else {
+				p := r.String()
+				v.Format = &p
+			}
+		case "quality":
+			if r.Null() {
+				v.Quality = nil
+			} else {
+				p := r.Int()
+				v.Quality = &p
+			}
+		case "optimizeForSpeed":
+			if r.Null() {
+				v.OptimizeForSpeed = nil
+			} else {
+				p := r.Bool()
+				v.OptimizeForSpeed = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BeginFrameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.FrameTimeTicks != nil {
+		w.Key("frameTimeTicks")
+		w.Float(*v.FrameTimeTicks)
+	}
+	if v.Interval != nil {
+		w.Key("interval")
+		w.Float(*v.Interval)
+	}
+	if v.NoDisplayUpdates != nil {
+		w.Key("noDisplayUpdates")
+		w.Bool(*v.NoDisplayUpdates)
+	}
+	if v.Screenshot != nil {
+		w.Key("screenshot")
+		v.Screenshot.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BeginFrameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameTimeTicks":
+			if r.Null() {
+				v.FrameTimeTicks = nil
+			} else {
+				p := r.Float()
+				v.FrameTimeTicks = &p
+			}
+		case "interval":
+			if r.Null() {
+				v.Interval = nil
+			} else {
+				p := r.Float()
+				v.Interval = &p
+			}
+		case "noDisplayUpdates":
+			if r.Null() {
+				v.NoDisplayUpdates = nil
+			} else {
+				p := r.Bool()
+				v.NoDisplayUpdates = &p
+			}
+		case "screenshot":
+			if r.Null() {
+				v.Screenshot = nil
+			} else {
+				var p ScreenshotParams
+				p.DecodeJSON(r)
+				v.Screenshot = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BeginFrameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("hasDamage")
+	w.Bool(v.HasDamage)
+	if len(v.ScreenshotData) > 0 {
+		w.Key("screenshotData")
+		w.Base64(v.ScreenshotData)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BeginFrameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "hasDamage":
+			v.HasDamage = r.Bool()
+		case "screenshotData":
+			v.ScreenshotData = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,661 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package heapprofiler
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SamplingHeapProfileNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("callFrame")
+	v.CallFrame.EncodeJSON(w)
+	w.Key("selfSize")
+	w.Float(v.SelfSize)
+	w.Key("id")
+	w.Int(v.ID)
+	w.Key("children")
+	if v.Children == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Children {
+			v.Children[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SamplingHeapProfileNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "callFrame":
+			v.CallFrame.DecodeJSON(r)
+		case "selfSize":
+			v.SelfSize = r.Float()
+		case "id":
+			v.ID = r.Int()
+		case "children":
+			if r.ArrayStart() {
+				v.Children = make([]SamplingHeapProfileNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 SamplingHeapProfileNode
+					e0.DecodeJSON(r)
+					v.Children = append(v.Children, e0)
+				}
+			} else {
+				v.Children = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SamplingHeapProfileSample) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("size")
+	w.Float(v.Size)
+	w.Key("nodeId")
+	w.Int(v.NodeID)
+	w.Key("ordinal")
+	w.Float(v.Ordinal)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SamplingHeapProfileSample) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "size":
+			v.Size = r.Float()
+		case "nodeId":
+			v.NodeID = r.Int()
+		case "ordinal":
+			v.Ordinal = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SamplingHeapProfile) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("head")
+	v.Head.EncodeJSON(w)
+	w.Key("samples")
+	if v.Samples == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Samples {
+			v.Samples[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SamplingHeapProfile) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "head":
+			v.Head.DecodeJSON(r)
+		case "samples":
+			if r.ArrayStart() {
+				v.Samples = make([]SamplingHeapProfileSample, 0, 4)
+				for r.ArrayNext() {
+					var e0 SamplingHeapProfileSample
+					e0.DecodeJSON(r)
+					v.Samples = append(v.Samples, e0)
+				}
+			} else {
+				v.Samples = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddInspectedHeapObjectArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("heapObjectId")
+	w.String(string(v.HeapObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddInspectedHeapObjectArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "heapObjectId":
+			v.HeapObjectID = HeapSnapshotObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHeapObjectIDArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHeapObjectIDArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = runtime.RemoteObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHeapObjectIDReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("heapSnapshotObjectId")
+	w.String(string(v.HeapSnapshotObjectID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHeapObjectIDReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "heapSnapshotObjectId":
+			v.HeapSnapshotObjectID = HeapSnapshotObjectID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetObjectByHeapObjectIDArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectId")
+	w.String(string(v.ObjectID))
+	if v.ObjectGroup != nil {
+		w.Key("objectGroup")
+		w.String(*v.ObjectGroup)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetObjectByHeapObjectIDArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectId":
+			v.ObjectID = HeapSnapshotObjectID(r.String())
+		case "objectGroup":
+			if r.Null() {
+				v.ObjectGroup = nil
+			} else {
+				p := r.String()
+				v.ObjectGroup = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetObjectByHeapObjectIDReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	v.Result.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetObjectByHeapObjectIDReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSamplingProfileReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSamplingProfileReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartSamplingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SamplingInterval != nil {
+		w.Key("samplingInterval")
+		w.Float(*v.SamplingInterval)
+	}
+	if v.IncludeObjectsCollectedByMajorGC != nil {
+		w.Key("includeObjectsCollectedByMajorGC")
+		w.Bool(*v.IncludeObjectsCollectedByMajorGC)
+	}
+	if v.IncludeObjectsCollectedByMinorGC != nil {
+		w.Key("includeObjectsCollectedByMinorGC")
+		w.Bool(*v.IncludeObjectsCollectedByMinorGC)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartSamplingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "samplingInterval":
+			if r.Null() {
+				v.SamplingInterval = nil
+			} else {
+				p := r.Float()
+				v.SamplingInterval = &p
+			}
+		case "includeObjectsCollectedByMajorGC":
+			if r.Null() {
+				v.IncludeObjectsCollectedByMajorGC = nil
+			} else {
+				p := r.Bool()
+				v.IncludeObjectsCollectedByMajorGC = &p
+			}
+		case "includeObjectsCollectedByMinorGC":
+			if r.Null() {
+				v.IncludeObjectsCollectedByMinorGC = nil
+			} else {
+				p := r.Bool()
+				v.IncludeObjectsCollectedByMinorGC = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartTrackingHeapObjectsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.TrackAllocations != nil {
+		w.Key("trackAllocations")
+		w.Bool(*v.TrackAllocations)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartTrackingHeapObjectsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "trackAllocations":
+			if r.Null() {
+				v.TrackAllocations = nil
+			} else {
+				p := r.Bool()
+				v.TrackAllocations = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopSamplingReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopSamplingReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopTrackingHeapObjectsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ReportProgress != nil {
+		w.Key("reportProgress")
+		w.Bool(*v.ReportProgress)
+	}
+	if v.TreatGlobalObjectsAsRoots != nil {
+		w.Key("treatGlobalObjectsAsRoots")
+		w.Bool(*v.TreatGlobalObjectsAsRoots)
+	}
+	if v.CaptureNumericValue != nil {
+		w.Key("captureNumericValue")
+		w.Bool(*v.CaptureNumericValue)
+	}
+	if v.ExposeInternals != nil {
+		w.Key("exposeInternals")
+		w.Bool(*v.ExposeInternals)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopTrackingHeapObjectsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "reportProgress":
+			if r.Null() {
+				v.ReportProgress = nil
+			} else {
+				p := r.Bool()
+				v.ReportProgress = &p
+			}
+		case "treatGlobalObjectsAsRoots":
+			if r.Null() {
+				v.TreatGlobalObjectsAsRoots = nil
+			} else {
+				p := r.Bool()
+				v.TreatGlobalObjectsAsRoots = &p
+			}
+		case "captureNumericValue":
+			if r.Null() {
+				v.CaptureNumericValue = nil
+			} else {
+				p := r.Bool()
+				v.CaptureNumericValue = &p
+			}
+		case "exposeInternals":
+			if r.Null() {
+				v.ExposeInternals = nil
+			} else {
+				p := r.Bool()
+				v.ExposeInternals = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *TakeHeapSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ReportProgress != nil {
+		w.Key("reportProgress")
+		w.Bool(*v.ReportProgress)
+	}
+	if v.TreatGlobalObjectsAsRoots != nil {
+		w.Key("treatGlobalObjectsAsRoots")
+		w.Bool(*v.TreatGlobalObjectsAsRoots)
+	}
+	if v.CaptureNumericValue != nil {
+		w.Key("captureNumericValue")
+		w.Bool(*v.CaptureNumericValue)
+	}
+	if v.ExposeInternals != nil {
+		w.Key("exposeInternals")
+		w.Bool(*v.ExposeInternals)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *TakeHeapSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "reportProgress":
+			if r.Null() {
+				v.ReportProgress = nil
+			} else {
+				p := r.Bool()
+				v.ReportProgress = &p
+			}
+		case "treatGlobalObjectsAsRoots":
+			if r.Null() {
+				v.TreatGlobalObjectsAsRoots = nil
+			} else {
+				p := r.Bool()
+				v.TreatGlobalObjectsAsRoots = &p
+			}
+		case "captureNumericValue":
+			if r.Null() {
+				v.CaptureNumericValue = nil
+			} else {
+				p := r.Bool()
+				v.CaptureNumericValue = &p
+			}
+		case "exposeInternals":
+			if r.Null() {
+				v.ExposeInternals = nil
+			} else {
+				p := r.Bool()
+				v.ExposeInternals = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddHeapSnapshotChunkReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("chunk")
+	w.String(v.Chunk)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddHeapSnapshotChunkReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "chunk":
+			v.Chunk = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HeapStatsUpdateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("statsUpdate")
+	if v.StatsUpdate == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.StatsUpdate {
+			w.Int(v.StatsUpdate[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HeapStatsUpdateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "statsUpdate":
+			if r.ArrayStart() {
+				v.StatsUpdate = make([]int, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Int()
+					v.StatsUpdate = append(v.StatsUpdate, e0)
+				}
+			} else {
+				v.StatsUpdate = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LastSeenObjectIDReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("lastSeenObjectId")
+	w.Int(v.LastSeenObjectID)
+	w.Key("timestamp")
+	w.Float(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LastSeenObjectIDReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "lastSeenObjectId":
+			v.LastSeenObjectID = r.Int()
+		case "timestamp":
+			v.Timestamp = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReportHeapSnapshotProgressReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("done")
+	w.Int(v.Done)
+	w.Key("total")
+	w.Int(v.Total)
+	if v.Finished != nil {
+		w.Key("finished")
+		w.Bool(*v.Finished)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReportHeapSnapshotProgressReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "done":
+			v.Done = r.Int()
+		case "total":
+			v.Total = r.Int()
+		case "finished":
+			if r.Null() {
+				v.Finished = nil
+			} else {
+				p := r.Bool()
+				v.Finished = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ResetProfilesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ResetProfilesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,924 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package indexeddb
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/storage"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DatabaseWithObjectStores) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("version")
+	w.Float(v.Version)
+	w.Key("objectStores")
+	if v.ObjectStores == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ObjectStores {
+			v.ObjectStores[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DatabaseWithObjectStores) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "version":
+			v.Version = r.Float()
+		case "objectStores":
+			if r.ArrayStart() {
+				v.ObjectStores = make([]ObjectStore, 0, 4)
+				for r.ArrayNext() {
+					var e0 ObjectStore
+					e0.DecodeJSON(r)
+					v.ObjectStores = append(v.ObjectStores, e0)
+				}
+			} else {
+				v.ObjectStores = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ObjectStore) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("keyPath")
+	v.KeyPath.EncodeJSON(w)
+	w.Key("autoIncrement")
+	w.Bool(v.AutoIncrement)
+	w.Key("indexes")
+	if v.Indexes == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Indexes {
+			v.Indexes[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ObjectStore) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "keyPath":
+			v.KeyPath.DecodeJSON(r)
+		case "autoIncrement":
+			v.AutoIncrement = r.Bool()
+		case "indexes":
+			if r.ArrayStart() {
+				v.Indexes = make([]ObjectStoreIndex, 0, 4)
+				for r.ArrayNext() {
+					var e0 ObjectStoreIndex
+					e0.DecodeJSON(r)
+					v.Indexes = append(v.Indexes, e0)
+				}
+			} else {
+				v.Indexes = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ObjectStoreIndex) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("keyPath")
+	v.KeyPath.EncodeJSON(w)
+	w.Key("unique")
+	w.Bool(v.Unique)
+	w.Key("multiEntry")
+	w.Bool(v.MultiEntry)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ObjectStoreIndex) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "keyPath":
+			v.KeyPath.DecodeJSON(r)
+		case "unique":
+			v.Unique = r.Bool()
+		case "multiEntry":
+			v.MultiEntry = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Key) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.Number != nil {
+		w.Key("number")
+		w.Float(*v.Number)
+	}
+	if v.String != nil {
+		w.Key("string")
+		w.String(*v.String)
+	}
+	if v.Date != nil {
+		w.Key("date")
+		w.Float(*v.Date)
+	}
+	if len(v.Array) > 0 {
+		w.Key("array")
+		w.ArrayStart()
+		for i0 := range v.Array {
+			v.Array[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Key) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "number":
+			if r.Null() {
+				v.Number = nil
+			} else {
+				p := r.Float()
+				v.Number = &p
+			}
+		case "string":
+			if r.Null() {
+				v.String = nil
+			} else {
+				p := r.String()
+				v.String = &p
+			}
+		case "date":
+			if r.Null() {
+				v.Date = nil
+			} else {
+				p := r.Float()
+				v.Date = &p
+			}
+		case "array":
+			if r.ArrayStart() {
+				v.Array = make([]Key, 0, 4)
+				for r.ArrayNext() {
+					var e0 Key
+					e0.DecodeJSON(r)
+					v.Array = append(v.Array, e0)
+				}
+			} else {
+				v.Array = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *KeyRange) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Lower != nil {
+		w.Key("lower")
+		v.Lower.EncodeJSON(w)
+	}
+	if v.Upper != nil {
+		w.Key("upper")
+		v.Upper.EncodeJSON(w)
+	}
+	w.Key("lowerOpen")
+	w.Bool(v.LowerOpen)
+	w.Key("upperOpen")
+	w.Bool(v.UpperOpen)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *KeyRange) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "lower":
+			if r.Null() {
+				v.Lower = nil
+			} else {
+				var p Key
+				p.DecodeJSON(r)
+				v.Lower = &p
+			}
+		case "upper":
+			if r.Null() {
+				v.Upper = nil
+			} else {
+				var p Key
+				p.DecodeJSON(r)
+				v.Upper = &p
+			}
+		case "lowerOpen":
+			v.LowerOpen = r.Bool()
+		case "upperOpen":
+			v.UpperOpen = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DataEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	v.Key.EncodeJSON(w)
+	w.Key("primaryKey")
+	v.PrimaryKey.EncodeJSON(w)
+	w.Key("value")
+	v.Value.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DataEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key.DecodeJSON(r)
+		case "primaryKey":
+			v.PrimaryKey.DecodeJSON(r)
+		case "value":
+			v.Value.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *KeyPath) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	if v.String != nil {
+		w.Key("string")
+		w.String(*v.String)
+	}
+	if len(v.Array) > 0 {
+		w.Key("array")
+		w.ArrayStart()
+		for i0 := range v.Array {
+			w.String(v.Array[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *KeyPath) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "string":
+			if r.Null() {
+				v.String = nil
+			} else {
+				p := r.String()
+				v.String = &p
+			}
+		case "array":
+			if r.ArrayStart() {
+				v.Array = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Array = append(v.Array, e0)
+				}
+			} else {
+				v.Array = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ClearObjectStoreArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.Key("objectStoreName")
+	w.String(v.ObjectStoreName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ClearObjectStoreArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "databaseName":
+			v.DatabaseName = r.String()
+		case "objectStoreName":
+			v.ObjectStoreName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteDatabaseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteDatabaseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "databaseName":
+			v.DatabaseName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeleteObjectStoreEntriesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.Key("objectStoreName")
+	w.String(v.ObjectStoreName)
+	w.Key("keyRange")
+	v.KeyRange.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeleteObjectStoreEntriesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "databaseName":
+			v.DatabaseName = r.String()
+		case "objectStoreName":
+			v.ObjectStoreName = r.String()
+		case "keyRange":
+			v.KeyRange.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestDataArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.Key("objectStoreName")
+	w.String(v.ObjectStoreName)
+	w.Key("indexName")
+	w.String(v.IndexName)
+	w.Key("skipCount")
+	w.Int(v.SkipCount)
+	w.Key("pageSize")
+	w.Int(v.PageSize)
+	if v.KeyRange != nil {
+		w.Key("keyRange")
+		v.KeyRange.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestDataArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "databaseName":
+			v.DatabaseName = r.String()
+		case "objectStoreName":
+			v.ObjectStoreName = r.String()
+		case "indexName":
+			v.IndexName = r.String()
+		case "skipCount":
+			v.SkipCount = r.Int()
+		case "pageSize":
+			v.PageSize = r.Int()
+		case "keyRange":
+			if r.Null() {
+				v.KeyRange = nil
+			} else {
+				var p KeyRange
+				p.DecodeJSON(r)
+				v.KeyRange = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestDataReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("objectStoreDataEntries")
+	if v.ObjectStoreDataEntries == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ObjectStoreDataEntries {
+			v.ObjectStoreDataEntries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("hasMore")
+	w.Bool(v.HasMore)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestDataReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "objectStoreDataEntries":
+			if r.ArrayStart() {
+				v.ObjectStoreDataEntries = make([]DataEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 DataEntry
+					e0.DecodeJSON(r)
+					v.ObjectStoreDataEntries = append(v.ObjectStoreDataEntries, e0)
+				}
+			} else {
+				v.ObjectStoreDataEntries = nil
+			}
+		case "hasMore":
+			v.HasMore = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetMetadataArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.Key("objectStoreName")
+	w.String(v.ObjectStoreName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetMetadataArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "databaseName":
+			v.DatabaseName = r.String()
+		case "objectStoreName":
+			v.ObjectStoreName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetMetadataReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("entriesCount")
+	w.Float(v.EntriesCount)
+	w.Key("keyGeneratorValue")
+	w.Float(v.KeyGeneratorValue)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetMetadataReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "entriesCount":
+			v.EntriesCount = r.Float()
+		case "keyGeneratorValue":
+			v.KeyGeneratorValue = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestDatabaseArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.Key("databaseName")
+	w.String(v.DatabaseName)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestDatabaseArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		case "databaseName":
+			v.DatabaseName = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestDatabaseReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("databaseWithObjectStores")
+	v.DatabaseWithObjectStores.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestDatabaseReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "databaseWithObjectStores":
+			v.DatabaseWithObjectStores.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestDatabaseNamesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SecurityOrigin != nil {
+		w.Key("securityOrigin")
+		w.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		w.Key("storageKey")
+		w.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		w.Key("storageBucket")
+		v.StorageBucket.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestDatabaseNamesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "securityOrigin":
+			if r.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				p := r.String()
+				v.SecurityOrigin = &p
+			}
+		case "storageKey":
+			if r.Null() {
+				v.StorageKey = nil
+			} else {
+				p := r.String()
+				v.StorageKey = &p
+			}
+		case "storageBucket":
+			if r.Null() {
+				v.StorageBucket = nil
+			} else {
+				var p storage.Bucket
+				p.DecodeJSON(r)
+				v.StorageBucket = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RequestDatabaseNamesReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("databaseNames")
+	if v.DatabaseNames == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DatabaseNames {
+			w.String(v.DatabaseNames[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RequestDatabaseNamesReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "databaseNames":
+			if r.ArrayStart() {
+				v.DatabaseNames = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.DatabaseNames = append(v.DatabaseNames, e0)
+				}
+			} else {
+				v.DatabaseNames = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
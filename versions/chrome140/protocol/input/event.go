@@ -0,0 +1,22 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// DragInterceptedClient is a client for DragIntercepted events. Emitted only
+// when `Input.setInterceptDrags` is enabled. Use this data with
+// `Input.dispatchDragEvent` to restore normal drag and drop behavior.
+type DragInterceptedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DragInterceptedReply, error)
+	rpcc.Stream
+}
+
+// DragInterceptedReply is the reply for DragIntercepted events.
+type DragInterceptedReply struct {
+	Data DragData `json:"data"` // No description.
+}
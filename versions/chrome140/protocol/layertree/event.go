@@ -0,0 +1,35 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package layertree
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+)
+
+// LayerPaintedClient is a client for LayerPainted events.
+type LayerPaintedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*LayerPaintedReply, error)
+	rpcc.Stream
+}
+
+// LayerPaintedReply is the reply for LayerPainted events.
+type LayerPaintedReply struct {
+	LayerID LayerID  `json:"layerId"` // The id of the painted layer.
+	Clip    dom.Rect `json:"clip"`    // Clip rectangle.
+}
+
+// DidChangeClient is a client for LayerTreeDidChange events.
+type DidChangeClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DidChangeReply, error)
+	rpcc.Stream
+}
+
+// DidChangeReply is the reply for LayerTreeDidChange events.
+type DidChangeReply struct {
+	Layers []Layer `json:"layers,omitempty"` // Layer tree, absent if not in the compositing mode.
+}
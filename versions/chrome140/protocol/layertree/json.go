@@ -0,0 +1,812 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package layertree
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScrollRect) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rect")
+	v.Rect.EncodeJSON(w)
+	w.Key("type")
+	w.String(v.Type)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScrollRect) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rect":
+			v.Rect.DecodeJSON(r)
+		case "type":
+			v.Type = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StickyPositionConstraint) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("stickyBoxRect")
+	v.StickyBoxRect.EncodeJSON(w)
+	w.Key("containingBlockRect")
+	v.ContainingBlockRect.EncodeJSON(w)
+	if v.NearestLayerShiftingStickyBox != nil {
+		w.Key("nearestLayerShiftingStickyBox")
+		w.String(string(*v.NearestLayerShiftingStickyBox))
+	}
+	if v.NearestLayerShiftingContainingBlock != nil {
+		w.Key("nearestLayerShiftingContainingBlock")
+		w.String(string(*v.NearestLayerShiftingContainingBlock))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StickyPositionConstraint) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "stickyBoxRect":
+			v.StickyBoxRect.DecodeJSON(r)
+		case "containingBlockRect":
+			v.ContainingBlockRect.DecodeJSON(r)
+		case "nearestLayerShiftingStickyBox":
+			if r.Null() {
+				v.NearestLayerShiftingStickyBox = nil
+			} else {
+				p := LayerID(r.String())
+				v.NearestLayerShiftingStickyBox = &p
+			}
+		case "nearestLayerShiftingContainingBlock":
+			if r.Null() {
+				v.NearestLayerShiftingContainingBlock = nil
+			} else {
+				p := LayerID(r.String())
+				v.NearestLayerShiftingContainingBlock = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PictureTile) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("picture")
+	w.Base64(v.Picture)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PictureTile) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "picture":
+			v.Picture = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Layer) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("layerId")
+	w.String(string(v.LayerID))
+	if v.ParentLayerID != nil {
+		w.Key("parentLayerId")
+		w.String(string(*v.ParentLayerID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	w.Key("offsetX")
+	w.Float(v.OffsetX)
+	w.Key("offsetY")
+	w.Float(v.OffsetY)
+	w.Key("width")
+	w.Float(v.Width)
+	w.Key("height")
+	w.Float(v.Height)
+	if len(v.Transform) > 0 {
+		w.Key("transform")
+		w.ArrayStart()
+		for i0 := range v.Transform {
+			w.Float(v.Transform[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.AnchorX != nil {
+		w.Key("anchorX")
+		w.Float(*v.AnchorX)
+	}
+	if v.AnchorY != nil {
+		w.Key("anchorY")
+		w.Float(*v.AnchorY)
+	}
+	if v.AnchorZ != nil {
+		w.Key("anchorZ")
+		w.Float(*v.AnchorZ)
+	}
+	w.Key("paintCount")
+	w.Int(v.PaintCount)
+	w.Key("drawsContent")
+	w.Bool(v.DrawsContent)
+	if v.Invisible != nil {
+		w.Key("invisible")
+		w.Bool(*v.Invisible)
+	}
+	if len(v.ScrollRects) > 0 {
+		w.Key("scrollRects")
+		w.ArrayStart()
+		for i0 := range v.ScrollRects {
+			v.ScrollRects[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.StickyPositionConstraint != nil {
+		w.Key("stickyPositionConstraint")
+		v.StickyPositionConstraint.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Layer) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layerId":
+			v.LayerID = LayerID(r.String())
+		case "parentLayerId":
+			if r.Null() {
+				v.ParentLayerID = nil
+			} else {
+				p := LayerID(r.String())
+				v.ParentLayerID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "offsetX":
+			v.OffsetX = r.Float()
+		case "offsetY":
+			v.OffsetY = r.Float()
+		case "width":
+			v.Width = r.Float()
+		case "height":
+			v.Height = r.Float()
+		case "transform":
+			if r.ArrayStart() {
+				v.Transform = make([]float64, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Transform = append(v.Transform, e0)
+				}
+			} else {
+				v.Transform = nil
+			}
+		case "anchorX":
+			if r.Null() {
+				v.AnchorX = nil
+			} else {
+				p := r.Float()
+				v.AnchorX = &p
+			}
+		case "anchorY":
+			if r.Null() {
+				v.AnchorY = nil
+			} else {
+				p := r.Float()
+				v.AnchorY = &p
+			}
+		case "anchorZ":
+			if r.Null() {
+				v.AnchorZ = nil
+			} else {
+				p := r.Float()
+				v.AnchorZ = &p
+			}
+		case "paintCount":
+			v.PaintCount = r.Int()
+		case "drawsContent":
+			v.DrawsContent = r.Bool()
+		case "invisible":
+			if r.Null() {
+				v.Invisible = nil
+			} else {
+				p := r.Bool()
+				v.Invisible = &p
+			}
+		case "scrollRects":
+			if r.ArrayStart() {
+				v.ScrollRects = make([]ScrollRect, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScrollRect
+					e0.DecodeJSON(r)
+					v.ScrollRects = append(v.ScrollRects, e0)
+				}
+			} else {
+				v.ScrollRects = nil
+			}
+		case "stickyPositionConstraint":
+			if r.Null() {
+				v.StickyPositionConstraint = nil
+			} else {
+				var p StickyPositionConstraint
+				p.DecodeJSON(r)
+				v.StickyPositionConstraint = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompositingReasonsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("layerId")
+	w.String(string(v.LayerID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompositingReasonsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layerId":
+			v.LayerID = LayerID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompositingReasonsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("compositingReasons")
+	if v.CompositingReasons == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CompositingReasons {
+			w.String(v.CompositingReasons[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("compositingReasonIds")
+	if v.CompositingReasonIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CompositingReasonIDs {
+			w.String(v.CompositingReasonIDs[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompositingReasonsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "compositingReasons":
+			if r.ArrayStart() {
+				v.CompositingReasons = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.CompositingReasons = append(v.CompositingReasons, e0)
+				}
+			} else {
+				v.CompositingReasons = nil
+			}
+		case "compositingReasonIds":
+			if r.ArrayStart() {
+				v.CompositingReasonIDs = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.CompositingReasonIDs = append(v.CompositingReasonIDs, e0)
+				}
+			} else {
+				v.CompositingReasonIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("tiles")
+	if v.Tiles == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Tiles {
+			v.Tiles[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "tiles":
+			if r.ArrayStart() {
+				v.Tiles = make([]PictureTile, 0, 4)
+				for r.ArrayNext() {
+					var e0 PictureTile
+					e0.DecodeJSON(r)
+					v.Tiles = append(v.Tiles, e0)
+				}
+			} else {
+				v.Tiles = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadSnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("snapshotId")
+	w.String(string(v.SnapshotID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadSnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapshotId":
+			v.SnapshotID = SnapshotID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MakeSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("layerId")
+	w.String(string(v.LayerID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MakeSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layerId":
+			v.LayerID = LayerID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MakeSnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("snapshotId")
+	w.String(string(v.SnapshotID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MakeSnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapshotId":
+			v.SnapshotID = SnapshotID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ProfileSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("snapshotId")
+	w.String(string(v.SnapshotID))
+	if v.MinRepeatCount != nil {
+		w.Key("minRepeatCount")
+		w.Int(*v.MinRepeatCount)
+	}
+	if v.MinDuration != nil {
+		w.Key("minDuration")
+		w.Float(*v.MinDuration)
+	}
+	if v.ClipRect != nil {
+		w.Key("clipRect")
+		v.ClipRect.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ProfileSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapshotId":
+			v.SnapshotID = SnapshotID(r.String())
+		case "minRepeatCount":
+			if r.Null() {
+				v.MinRepeatCount = nil
+			} else {
+				p := r.Int()
+				v.MinRepeatCount = &p
+			}
+		case "minDuration":
+			if r.Null() {
+				v.MinDuration = nil
+			} else {
+				p := r.Float()
+				v.MinDuration = &p
+			}
+		case "clipRect":
+			if r.Null() {
+				v.ClipRect = nil
+			} else {
+				var p dom.Rect
+				p.DecodeJSON(r)
+				v.ClipRect = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ProfileSnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timings")
+	if v.Timings == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Timings {
+			if v.Timings[i0] == nil {
+				w.Null()
+			} else {
+				w.ArrayStart()
+				for i1 := range v.Timings[i0] {
+					w.Float(v.Timings[i0][i1])
+				}
+				w.ArrayEnd()
+			}
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ProfileSnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timings":
+			if r.ArrayStart() {
+				v.Timings = make([]PaintProfile, 0, 4)
+				for r.ArrayNext() {
+					var e0 PaintProfile
+					if r.ArrayStart() {
+						e0 = make(PaintProfile, 0, 4)
+						for r.ArrayNext() {
+							e1 := r.Float()
+							e0 = append(e0, e1)
+						}
+					} else {
+						e0 = nil
+					}
+					v.Timings = append(v.Timings, e0)
+				}
+			} else {
+				v.Timings = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReleaseSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("snapshotId")
+	w.String(string(v.SnapshotID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReleaseSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapshotId":
+			v.SnapshotID = SnapshotID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReplaySnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("snapshotId")
+	w.String(string(v.SnapshotID))
+	if v.FromStep != nil {
+		w.Key("fromStep")
+		w.Int(*v.FromStep)
+	}
+	if v.ToStep != nil {
+		w.Key("toStep")
+		w.Int(*v.ToStep)
+	}
+	if v.Scale != nil {
+		w.Key("scale")
+		w.Float(*v.Scale)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReplaySnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapshotId":
+			v.SnapshotID = SnapshotID(r.String())
+		case "fromStep":
+			if r.Null() {
+				v.FromStep = nil
+			} else {
+				p := r.Int()
+				v.FromStep = &p
+			}
+		case "toStep":
+			if r.Null() {
+				v.ToStep = nil
+			} else {
+				p := r.Int()
+				v.ToStep = &p
+			}
+		case "scale":
+			if r.Null() {
+				v.Scale = nil
+			} else {
+				p := r.Float()
+				v.Scale = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReplaySnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("dataURL")
+	w.String(v.DataURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReplaySnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "dataURL":
+			v.DataURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SnapshotCommandLogArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("snapshotId")
+	w.String(string(v.SnapshotID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SnapshotCommandLogArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapshotId":
+			v.SnapshotID = SnapshotID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SnapshotCommandLogReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("commandLog")
+	if v.CommandLog == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.CommandLog {
+			w.Raw(v.CommandLog[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SnapshotCommandLogReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "commandLog":
+			if r.ArrayStart() {
+				v.CommandLog = make([]json.RawMessage, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Raw()
+					v.CommandLog = append(v.CommandLog, e0)
+				}
+			} else {
+				v.CommandLog = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayerPaintedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("layerId")
+	w.String(string(v.LayerID))
+	w.Key("clip")
+	v.Clip.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayerPaintedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layerId":
+			v.LayerID = LayerID(r.String())
+		case "clip":
+			v.Clip.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DidChangeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if len(v.Layers) > 0 {
+		w.Key("layers")
+		w.ArrayStart()
+		for i0 := range v.Layers {
+			v.Layers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DidChangeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layers":
+			if r.ArrayStart() {
+				v.Layers = make([]Layer, 0, 4)
+				for r.ArrayNext() {
+					var e0 Layer
+					e0.DecodeJSON(r)
+					v.Layers = append(v.Layers, e0)
+				}
+			} else {
+				v.Layers = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package log
+
+import "errors"
+
+// StartViolationsReportArgs represents the arguments for StartViolationsReport in the Log domain.
+type StartViolationsReportArgs struct {
+	Config []ViolationSetting `json:"config"` // Configuration for violations.
+}
+
+// NewStartViolationsReportArgs initializes StartViolationsReportArgs with the required arguments.
+func NewStartViolationsReportArgs(config []ViolationSetting) *StartViolationsReportArgs {
+	args := new(StartViolationsReportArgs)
+	args.Config = config
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartViolationsReportArgs) Validate() error {
+	if a.Config == nil {
+		return errors.New("log.StartViolationsReportArgs: Config is required")
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package memory
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SamplingProfileNode) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("size")
+	w.Float(v.Size)
+	w.Key("total")
+	w.Float(v.Total)
+	w.Key("stack")
+	if v.Stack == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Stack {
+			w.String(v.Stack[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SamplingProfileNode) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "size":
+			v.Size = r.Float()
+		case "total":
+			v.Total = r.Float()
+		case "stack":
+			if r.ArrayStart() {
+				v.Stack = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Stack = append(v.Stack, e0)
+				}
+			} else {
+				v.Stack = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SamplingProfile) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("samples")
+	if v.Samples == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Samples {
+			v.Samples[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("modules")
+	if v.Modules == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Modules {
+			v.Modules[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SamplingProfile) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "samples":
+			if r.ArrayStart() {
+				v.Samples = make([]SamplingProfileNode, 0, 4)
+				for r.ArrayNext() {
+					var e0 SamplingProfileNode
+					e0.DecodeJSON(r)
+					v.Samples = append(v.Samples, e0)
+				}
+			} else {
+				v.Samples = nil
+			}
+		case "modules":
+			if r.ArrayStart() {
+				v.Modules = make([]Module, 0, 4)
+				for r.ArrayNext() {
+					var e0 Module
+					e0.DecodeJSON(r)
+					v.Modules = append(v.Modules, e0)
+				}
+			} else {
+				v.Modules = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Module) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("uuid")
+	w.String(v.UUID)
+	w.Key("baseAddress")
+	w.String(v.BaseAddress)
+	w.Key("size")
+	w.Float(v.Size)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Module) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "uuid":
+			v.UUID = r.String()
+		case "baseAddress":
+			v.BaseAddress = r.String()
+		case "size":
+			v.Size = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DOMCounter) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("count")
+	w.Int(v.Count)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DOMCounter) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "count":
+			v.Count = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDOMCountersReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("documents")
+	w.Int(v.Documents)
+	w.Key("nodes")
+	w.Int(v.Nodes)
+	w.Key("jsEventListeners")
+	w.Int(v.JSEventListeners)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDOMCountersReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "documents":
+			v.Documents = r.Int()
+		case "nodes":
+			v.Nodes = r.Int()
+		case "jsEventListeners":
+			v.JSEventListeners = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDOMCountersForLeakDetectionReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("counters")
+	if v.Counters == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Counters {
+			v.Counters[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDOMCountersForLeakDetectionReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "counters":
+			if r.ArrayStart() {
+				v.Counters = make([]DOMCounter, 0, 4)
+				for r.ArrayNext() {
+					var e0 DOMCounter
+					e0.DecodeJSON(r)
+					v.Counters = append(v.Counters, e0)
+				}
+			} else {
+				v.Counters = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPressureNotificationsSuppressedArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("suppressed")
+	w.Bool(v.Suppressed)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPressureNotificationsSuppressedArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "suppressed":
+			v.Suppressed = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SimulatePressureNotificationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("level")
+	w.String(string(v.Level))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SimulatePressureNotificationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "level":
+			v.Level = PressureLevel(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartSamplingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SamplingInterval != nil {
+		w.Key("samplingInterval")
+		w.Int(*v.SamplingInterval)
+	}
+	if v.SuppressRandomness != nil {
+		w.Key("suppressRandomness")
+		w.Bool(*v.SuppressRandomness)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartSamplingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "samplingInterval":
+			if r.Null() {
+				v.SamplingInterval = nil
+			} else {
+				p := r.Int()
+				v.SamplingInterval = &p
+			}
+		case "suppressRandomness":
+			if r.Null() {
+				v.SuppressRandomness = nil
+			} else {
+				p := r.Bool()
+				v.SuppressRandomness = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAllTimeSamplingProfileReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAllTimeSamplingProfileReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetBrowserSamplingProfileReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetBrowserSamplingProfileReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSamplingProfileReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	v.Profile.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSamplingProfileReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
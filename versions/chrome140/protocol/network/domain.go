@@ -0,0 +1,1421 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package network implements the Network domain. Network domain allows
+// tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing,
+// etc.
+package network
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/internal"
+)
+
+// domainClient is a client for the Network domain. Network domain allows
+// tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing,
+// etc.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the Network domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// SetAcceptedEncodings invokes the Network method. Sets a list of content
+// encodings that will be accepted. Empty list means no encoding is accepted.
+func (d *domainClient) SetAcceptedEncodings(ctx context.Context, args *SetAcceptedEncodingsArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setAcceptedEncodings", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setAcceptedEncodings", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetAcceptedEncodings", Err: err}
+	}
+	return
+}
+
+// ClearAcceptedEncodingsOverride invokes the Network method. Clears accepted
+// encodings set by setAcceptedEncodings
+func (d *domainClient) ClearAcceptedEncodingsOverride(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Network.clearAcceptedEncodingsOverride", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "ClearAcceptedEncodingsOverride", Err: err}
+	}
+	return
+}
+
+// CanClearBrowserCache invokes the Network method. Tells whether clearing
+// browser cache is supported.
+func (d *domainClient) CanClearBrowserCache(ctx context.Context) (reply *CanClearBrowserCacheReply, err error) {
+	reply = new(CanClearBrowserCacheReply)
+	err = rpcc.Invoke(ctx, "Network.canClearBrowserCache", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "CanClearBrowserCache", Err: err}
+	}
+	return
+}
+
+// CanClearBrowserCookies invokes the Network method. Tells whether clearing
+// browser cookies is supported.
+func (d *domainClient) CanClearBrowserCookies(ctx context.Context) (reply *CanClearBrowserCookiesReply, err error) {
+	reply = new(CanClearBrowserCookiesReply)
+	err = rpcc.Invoke(ctx, "Network.canClearBrowserCookies", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "CanClearBrowserCookies", Err: err}
+	}
+	return
+}
+
+// CanEmulateNetworkConditions invokes the Network method. Tells whether
+// emulation of network conditions is supported.
+func (d *domainClient) CanEmulateNetworkConditions(ctx context.Context) (reply *CanEmulateNetworkConditionsReply, err error) {
+	reply = new(CanEmulateNetworkConditionsReply)
+	err = rpcc.Invoke(ctx, "Network.canEmulateNetworkConditions", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "CanEmulateNetworkConditions", Err: err}
+	}
+	return
+}
+
+// ClearBrowserCache invokes the Network method. Clears browser cache.
+func (d *domainClient) ClearBrowserCache(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Network.clearBrowserCache", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "ClearBrowserCache", Err: err}
+	}
+	return
+}
+
+// ClearBrowserCookies invokes the Network method. Clears browser cookies.
+func (d *domainClient) ClearBrowserCookies(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Network.clearBrowserCookies", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "ClearBrowserCookies", Err: err}
+	}
+	return
+}
+
+// ContinueInterceptedRequest invokes the Network method. Response to
+// Network.requestIntercepted which either modifies the request to continue
+// with any modifications, or blocks it, or completes it with the provided
+// response bytes. If a network fetch occurs as a result which encounters a
+// redirect an additional Network.requestIntercepted event will be sent with
+// the same InterceptionId. Deprecated, use Fetch.continueRequest,
+// Fetch.fulfillRequest and Fetch.failRequest instead.
+func (d *domainClient) ContinueInterceptedRequest(ctx context.Context, args *ContinueInterceptedRequestArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.continueInterceptedRequest", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.continueInterceptedRequest", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "ContinueInterceptedRequest", Err: err}
+	}
+	return
+}
+
+// DeleteCookies invokes the Network method. Deletes browser cookies with
+// matching name and url or domain/path/partitionKey pair.
+func (d *domainClient) DeleteCookies(ctx context.Context, args *DeleteCookiesArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.deleteCookies", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.deleteCookies", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "DeleteCookies", Err: err}
+	}
+	return
+}
+
+// Disable invokes the Network method. Disables network tracking, prevents
+// network events from being sent to the client.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Network.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// EmulateNetworkConditions invokes the Network method. Activates emulation of
+// network conditions.
+func (d *domainClient) EmulateNetworkConditions(ctx context.Context, args *EmulateNetworkConditionsArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.emulateNetworkConditions", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.emulateNetworkConditions", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "EmulateNetworkConditions", Err: err}
+	}
+	return
+}
+
+// Enable invokes the Network method. Enables network tracking, network events
+// will now be delivered to the client.
+func (d *domainClient) Enable(ctx context.Context, args *EnableArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.enable", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.enable", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// GetAllCookies invokes the Network method. Returns all browser cookies.
+// Depending on the backend support, will return detailed cookie information in
+// the `cookies` field. Deprecated. Use Storage.getCookies instead.
+func (d *domainClient) GetAllCookies(ctx context.Context) (reply *GetAllCookiesReply, err error) {
+	reply = new(GetAllCookiesReply)
+	err = rpcc.Invoke(ctx, "Network.getAllCookies", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetAllCookies", Err: err}
+	}
+	return
+}
+
+// GetCertificate invokes the Network method. Returns the DER-encoded
+// certificate.
+func (d *domainClient) GetCertificate(ctx context.Context, args *GetCertificateArgs) (reply *GetCertificateReply, err error) {
+	reply = new(GetCertificateReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.getCertificate", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.getCertificate", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetCertificate", Err: err}
+	}
+	return
+}
+
+// GetCookies invokes the Network method. Returns all browser cookies for the
+// current URL. Depending on the backend support, will return detailed cookie
+// information in the `cookies` field.
+func (d *domainClient) GetCookies(ctx context.Context, args *GetCookiesArgs) (reply *GetCookiesReply, err error) {
+	reply = new(GetCookiesReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.getCookies", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.getCookies", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetCookies", Err: err}
+	}
+	return
+}
+
+// GetResponseBody invokes the Network method. Returns content served for the
+// given request.
+func (d *domainClient) GetResponseBody(ctx context.Context, args *GetResponseBodyArgs) (reply *GetResponseBodyReply, err error) {
+	reply = new(GetResponseBodyReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.getResponseBody", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.getResponseBody", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetResponseBody", Err: err}
+	}
+	return
+}
+
+// GetRequestPostData invokes the Network method. Returns post data sent with
+// the request. Returns an error when no data was sent with the request.
+func (d *domainClient) GetRequestPostData(ctx context.Context, args *GetRequestPostDataArgs) (reply *GetRequestPostDataReply, err error) {
+	reply = new(GetRequestPostDataReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.getRequestPostData", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.getRequestPostData", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetRequestPostData", Err: err}
+	}
+	return
+}
+
+// GetResponseBodyForInterception invokes the Network method. Returns content
+// served for the given currently intercepted request.
+func (d *domainClient) GetResponseBodyForInterception(ctx context.Context, args *GetResponseBodyForInterceptionArgs) (reply *GetResponseBodyForInterceptionReply, err error) {
+	reply = new(GetResponseBodyForInterceptionReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.getResponseBodyForInterception", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.getResponseBodyForInterception", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetResponseBodyForInterception", Err: err}
+	}
+	return
+}
+
+// TakeResponseBodyForInterceptionAsStream invokes the Network method. Returns
+// a handle to the stream representing the response body. Note that after this
+// command, the intercepted request can't be continued as is -- you either need
+// to cancel it or to provide the response body. The stream only supports
+// sequential read, IO.read will fail if the position is specified.
+func (d *domainClient) TakeResponseBodyForInterceptionAsStream(ctx context.Context, args *TakeResponseBodyForInterceptionAsStreamArgs) (reply *TakeResponseBodyForInterceptionAsStreamReply, err error) {
+	reply = new(TakeResponseBodyForInterceptionAsStreamReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.takeResponseBodyForInterceptionAsStream", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.takeResponseBodyForInterceptionAsStream", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "TakeResponseBodyForInterceptionAsStream", Err: err}
+	}
+	return
+}
+
+// ReplayXHR invokes the Network method. This method sends a new
+// XMLHttpRequest which is identical to the original one. The following
+// parameters should be identical: method, url, async, request body, extra
+// headers, withCredentials attribute, user, password.
+func (d *domainClient) ReplayXHR(ctx context.Context, args *ReplayXHRArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.replayXHR", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.replayXHR", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "ReplayXHR", Err: err}
+	}
+	return
+}
+
+// SearchInResponseBody invokes the Network method. Searches for given string
+// in response content.
+func (d *domainClient) SearchInResponseBody(ctx context.Context, args *SearchInResponseBodyArgs) (reply *SearchInResponseBodyReply, err error) {
+	reply = new(SearchInResponseBodyReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.searchInResponseBody", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.searchInResponseBody", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SearchInResponseBody", Err: err}
+	}
+	return
+}
+
+// SetBlockedURLs invokes the Network method. Blocks URLs from loading.
+func (d *domainClient) SetBlockedURLs(ctx context.Context, args *SetBlockedURLsArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setBlockedURLs", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setBlockedURLs", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetBlockedURLs", Err: err}
+	}
+	return
+}
+
+// SetBypassServiceWorker invokes the Network method. Toggles ignoring of
+// service worker for each request.
+func (d *domainClient) SetBypassServiceWorker(ctx context.Context, args *SetBypassServiceWorkerArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setBypassServiceWorker", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setBypassServiceWorker", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetBypassServiceWorker", Err: err}
+	}
+	return
+}
+
+// SetCacheDisabled invokes the Network method. Toggles ignoring cache for
+// each request. If `true`, cache will not be used.
+func (d *domainClient) SetCacheDisabled(ctx context.Context, args *SetCacheDisabledArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setCacheDisabled", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setCacheDisabled", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetCacheDisabled", Err: err}
+	}
+	return
+}
+
+// SetCookie invokes the Network method. Sets a cookie with the given cookie
+// data; may overwrite equivalent cookies if they exist.
+func (d *domainClient) SetCookie(ctx context.Context, args *SetCookieArgs) (reply *SetCookieReply, err error) {
+	reply = new(SetCookieReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setCookie", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setCookie", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetCookie", Err: err}
+	}
+	return
+}
+
+// SetCookies invokes the Network method. Sets given cookies.
+func (d *domainClient) SetCookies(ctx context.Context, args *SetCookiesArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setCookies", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setCookies", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetCookies", Err: err}
+	}
+	return
+}
+
+// SetExtraHTTPHeaders invokes the Network method. Specifies whether to always
+// send extra HTTP headers with the requests from this page.
+func (d *domainClient) SetExtraHTTPHeaders(ctx context.Context, args *SetExtraHTTPHeadersArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setExtraHTTPHeaders", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setExtraHTTPHeaders", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetExtraHTTPHeaders", Err: err}
+	}
+	return
+}
+
+// SetAttachDebugStack invokes the Network method. Specifies whether to attach
+// a page script stack id in requests
+func (d *domainClient) SetAttachDebugStack(ctx context.Context, args *SetAttachDebugStackArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setAttachDebugStack", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setAttachDebugStack", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetAttachDebugStack", Err: err}
+	}
+	return
+}
+
+// SetRequestInterception invokes the Network method. Sets the requests to
+// intercept that match the provided patterns and optionally resource types.
+// Deprecated, please use Fetch.enable instead.
+func (d *domainClient) SetRequestInterception(ctx context.Context, args *SetRequestInterceptionArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setRequestInterception", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setRequestInterception", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetRequestInterception", Err: err}
+	}
+	return
+}
+
+// StreamResourceContent invokes the Network method. Enables streaming of the
+// response for the given requestId. If enabled, the dataReceived event
+// contains the data that was received during streaming.
+func (d *domainClient) StreamResourceContent(ctx context.Context, args *StreamResourceContentArgs) (reply *StreamResourceContentReply, err error) {
+	reply = new(StreamResourceContentReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.streamResourceContent", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.streamResourceContent", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "StreamResourceContent", Err: err}
+	}
+	return
+}
+
+// GetSecurityIsolationStatus invokes the Network method. Returns information
+// about the COEP/COOP isolation status.
+func (d *domainClient) GetSecurityIsolationStatus(ctx context.Context, args *GetSecurityIsolationStatusArgs) (reply *GetSecurityIsolationStatusReply, err error) {
+	reply = new(GetSecurityIsolationStatusReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.getSecurityIsolationStatus", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.getSecurityIsolationStatus", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "GetSecurityIsolationStatus", Err: err}
+	}
+	return
+}
+
+// EnableReportingAPI invokes the Network method. Enables tracking for the
+// Reporting API, events generated by the Reporting API will now be delivered
+// to the client. Enabling triggers 'reportingApiReportAdded' for all existing
+// reports.
+func (d *domainClient) EnableReportingAPI(ctx context.Context, args *EnableReportingAPIArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.enableReportingApi", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.enableReportingApi", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "EnableReportingAPI", Err: err}
+	}
+	return
+}
+
+// LoadNetworkResource invokes the Network method. Fetches the resource and
+// returns the content.
+func (d *domainClient) LoadNetworkResource(ctx context.Context, args *LoadNetworkResourceArgs) (reply *LoadNetworkResourceReply, err error) {
+	reply = new(LoadNetworkResourceReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.loadNetworkResource", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.loadNetworkResource", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "LoadNetworkResource", Err: err}
+	}
+	return
+}
+
+// SetCookieControls invokes the Network method. Sets Controls for third-party
+// cookie access Page reload is required before the new cookie behavior will be
+// observed
+func (d *domainClient) SetCookieControls(ctx context.Context, args *SetCookieControlsArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Network.setCookieControls", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Network.setCookieControls", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Network", Op: "SetCookieControls", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) DataReceived(ctx context.Context) (DataReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.dataReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &dataReceivedClient{Stream: s}, nil
+}
+
+type dataReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *dataReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *dataReceivedClient) Recv() (*DataReceivedReply, error) {
+	event := new(DataReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DataReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) EventSourceMessageReceived(ctx context.Context) (EventSourceMessageReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.eventSourceMessageReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &eventSourceMessageReceivedClient{Stream: s}, nil
+}
+
+type eventSourceMessageReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *eventSourceMessageReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *eventSourceMessageReceivedClient) Recv() (*EventSourceMessageReceivedReply, error) {
+	event := new(EventSourceMessageReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "EventSourceMessageReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) LoadingFailed(ctx context.Context) (LoadingFailedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.loadingFailed", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &loadingFailedClient{Stream: s}, nil
+}
+
+type loadingFailedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *loadingFailedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *loadingFailedClient) Recv() (*LoadingFailedReply, error) {
+	event := new(LoadingFailedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "LoadingFailed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) LoadingFinished(ctx context.Context) (LoadingFinishedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.loadingFinished", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &loadingFinishedClient{Stream: s}, nil
+}
+
+type loadingFinishedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *loadingFinishedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *loadingFinishedClient) Recv() (*LoadingFinishedReply, error) {
+	event := new(LoadingFinishedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "LoadingFinished Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) RequestIntercepted(ctx context.Context) (RequestInterceptedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestIntercepted", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &requestInterceptedClient{Stream: s}, nil
+}
+
+type requestInterceptedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *requestInterceptedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *requestInterceptedClient) Recv() (*RequestInterceptedReply, error) {
+	event := new(RequestInterceptedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "RequestIntercepted Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) RequestServedFromCache(ctx context.Context) (RequestServedFromCacheClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestServedFromCache", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &requestServedFromCacheClient{Stream: s}, nil
+}
+
+type requestServedFromCacheClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *requestServedFromCacheClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *requestServedFromCacheClient) Recv() (*RequestServedFromCacheReply, error) {
+	event := new(RequestServedFromCacheReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "RequestServedFromCache Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) RequestWillBeSent(ctx context.Context) (RequestWillBeSentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestWillBeSent", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &requestWillBeSentClient{Stream: s}, nil
+}
+
+type requestWillBeSentClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *requestWillBeSentClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *requestWillBeSentClient) Recv() (*RequestWillBeSentReply, error) {
+	event := new(RequestWillBeSentReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "RequestWillBeSent Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ResourceChangedPriority(ctx context.Context) (ResourceChangedPriorityClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.resourceChangedPriority", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceChangedPriorityClient{Stream: s}, nil
+}
+
+type resourceChangedPriorityClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *resourceChangedPriorityClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *resourceChangedPriorityClient) Recv() (*ResourceChangedPriorityReply, error) {
+	event := new(ResourceChangedPriorityReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ResourceChangedPriority Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) SignedExchangeReceived(ctx context.Context) (SignedExchangeReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.signedExchangeReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &signedExchangeReceivedClient{Stream: s}, nil
+}
+
+type signedExchangeReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *signedExchangeReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *signedExchangeReceivedClient) Recv() (*SignedExchangeReceivedReply, error) {
+	event := new(SignedExchangeReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "SignedExchangeReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ResponseReceived(ctx context.Context) (ResponseReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.responseReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &responseReceivedClient{Stream: s}, nil
+}
+
+type responseReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *responseReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *responseReceivedClient) Recv() (*ResponseReceivedReply, error) {
+	event := new(ResponseReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ResponseReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketClosed(ctx context.Context) (WebSocketClosedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketClosed", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketClosedClient{Stream: s}, nil
+}
+
+type webSocketClosedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketClosedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketClosedClient) Recv() (*WebSocketClosedReply, error) {
+	event := new(WebSocketClosedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketClosed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketCreated(ctx context.Context) (WebSocketCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketCreated", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketCreatedClient{Stream: s}, nil
+}
+
+type webSocketCreatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketCreatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketCreatedClient) Recv() (*WebSocketCreatedReply, error) {
+	event := new(WebSocketCreatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketCreated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketFrameError(ctx context.Context) (WebSocketFrameErrorClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameError", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketFrameErrorClient{Stream: s}, nil
+}
+
+type webSocketFrameErrorClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketFrameErrorClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketFrameErrorClient) Recv() (*WebSocketFrameErrorReply, error) {
+	event := new(WebSocketFrameErrorReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketFrameError Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketFrameReceived(ctx context.Context) (WebSocketFrameReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketFrameReceivedClient{Stream: s}, nil
+}
+
+type webSocketFrameReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketFrameReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketFrameReceivedClient) Recv() (*WebSocketFrameReceivedReply, error) {
+	event := new(WebSocketFrameReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketFrameReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketFrameSent(ctx context.Context) (WebSocketFrameSentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameSent", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketFrameSentClient{Stream: s}, nil
+}
+
+type webSocketFrameSentClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketFrameSentClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketFrameSentClient) Recv() (*WebSocketFrameSentReply, error) {
+	event := new(WebSocketFrameSentReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketFrameSent Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketHandshakeResponseReceived(ctx context.Context) (WebSocketHandshakeResponseReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketHandshakeResponseReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketHandshakeResponseReceivedClient{Stream: s}, nil
+}
+
+type webSocketHandshakeResponseReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketHandshakeResponseReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketHandshakeResponseReceivedClient) Recv() (*WebSocketHandshakeResponseReceivedReply, error) {
+	event := new(WebSocketHandshakeResponseReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketHandshakeResponseReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebSocketWillSendHandshakeRequest(ctx context.Context) (WebSocketWillSendHandshakeRequestClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketWillSendHandshakeRequest", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webSocketWillSendHandshakeRequestClient{Stream: s}, nil
+}
+
+type webSocketWillSendHandshakeRequestClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webSocketWillSendHandshakeRequestClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webSocketWillSendHandshakeRequestClient) Recv() (*WebSocketWillSendHandshakeRequestReply, error) {
+	event := new(WebSocketWillSendHandshakeRequestReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebSocketWillSendHandshakeRequest Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebTransportCreated(ctx context.Context) (WebTransportCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webTransportCreated", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webTransportCreatedClient{Stream: s}, nil
+}
+
+type webTransportCreatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webTransportCreatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webTransportCreatedClient) Recv() (*WebTransportCreatedReply, error) {
+	event := new(WebTransportCreatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebTransportCreated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebTransportConnectionEstablished(ctx context.Context) (WebTransportConnectionEstablishedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webTransportConnectionEstablished", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webTransportConnectionEstablishedClient{Stream: s}, nil
+}
+
+type webTransportConnectionEstablishedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webTransportConnectionEstablishedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webTransportConnectionEstablishedClient) Recv() (*WebTransportConnectionEstablishedReply, error) {
+	event := new(WebTransportConnectionEstablishedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebTransportConnectionEstablished Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) WebTransportClosed(ctx context.Context) (WebTransportClosedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webTransportClosed", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &webTransportClosedClient{Stream: s}, nil
+}
+
+type webTransportClosedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *webTransportClosedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *webTransportClosedClient) Recv() (*WebTransportClosedReply, error) {
+	event := new(WebTransportClosedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "WebTransportClosed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectTCPSocketCreated(ctx context.Context) (DirectTCPSocketCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directTCPSocketCreated", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directTCPSocketCreatedClient{Stream: s}, nil
+}
+
+type directTCPSocketCreatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directTCPSocketCreatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directTCPSocketCreatedClient) Recv() (*DirectTCPSocketCreatedReply, error) {
+	event := new(DirectTCPSocketCreatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectTCPSocketCreated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectTCPSocketOpened(ctx context.Context) (DirectTCPSocketOpenedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directTCPSocketOpened", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directTCPSocketOpenedClient{Stream: s}, nil
+}
+
+type directTCPSocketOpenedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directTCPSocketOpenedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directTCPSocketOpenedClient) Recv() (*DirectTCPSocketOpenedReply, error) {
+	event := new(DirectTCPSocketOpenedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectTCPSocketOpened Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectTCPSocketAborted(ctx context.Context) (DirectTCPSocketAbortedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directTCPSocketAborted", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directTCPSocketAbortedClient{Stream: s}, nil
+}
+
+type directTCPSocketAbortedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directTCPSocketAbortedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directTCPSocketAbortedClient) Recv() (*DirectTCPSocketAbortedReply, error) {
+	event := new(DirectTCPSocketAbortedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectTCPSocketAborted Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectTCPSocketClosed(ctx context.Context) (DirectTCPSocketClosedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directTCPSocketClosed", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directTCPSocketClosedClient{Stream: s}, nil
+}
+
+type directTCPSocketClosedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directTCPSocketClosedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directTCPSocketClosedClient) Recv() (*DirectTCPSocketClosedReply, error) {
+	event := new(DirectTCPSocketClosedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectTCPSocketClosed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectTCPSocketChunkSent(ctx context.Context) (DirectTCPSocketChunkSentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directTCPSocketChunkSent", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directTCPSocketChunkSentClient{Stream: s}, nil
+}
+
+type directTCPSocketChunkSentClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directTCPSocketChunkSentClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directTCPSocketChunkSentClient) Recv() (*DirectTCPSocketChunkSentReply, error) {
+	event := new(DirectTCPSocketChunkSentReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectTCPSocketChunkSent Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectTCPSocketChunkReceived(ctx context.Context) (DirectTCPSocketChunkReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directTCPSocketChunkReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directTCPSocketChunkReceivedClient{Stream: s}, nil
+}
+
+type directTCPSocketChunkReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directTCPSocketChunkReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directTCPSocketChunkReceivedClient) Recv() (*DirectTCPSocketChunkReceivedReply, error) {
+	event := new(DirectTCPSocketChunkReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectTCPSocketChunkReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectUDPSocketCreated(ctx context.Context) (DirectUDPSocketCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directUDPSocketCreated", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directUDPSocketCreatedClient{Stream: s}, nil
+}
+
+type directUDPSocketCreatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directUDPSocketCreatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directUDPSocketCreatedClient) Recv() (*DirectUDPSocketCreatedReply, error) {
+	event := new(DirectUDPSocketCreatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectUDPSocketCreated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectUDPSocketOpened(ctx context.Context) (DirectUDPSocketOpenedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directUDPSocketOpened", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directUDPSocketOpenedClient{Stream: s}, nil
+}
+
+type directUDPSocketOpenedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directUDPSocketOpenedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directUDPSocketOpenedClient) Recv() (*DirectUDPSocketOpenedReply, error) {
+	event := new(DirectUDPSocketOpenedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectUDPSocketOpened Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectUDPSocketAborted(ctx context.Context) (DirectUDPSocketAbortedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directUDPSocketAborted", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directUDPSocketAbortedClient{Stream: s}, nil
+}
+
+type directUDPSocketAbortedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directUDPSocketAbortedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directUDPSocketAbortedClient) Recv() (*DirectUDPSocketAbortedReply, error) {
+	event := new(DirectUDPSocketAbortedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectUDPSocketAborted Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectUDPSocketClosed(ctx context.Context) (DirectUDPSocketClosedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directUDPSocketClosed", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directUDPSocketClosedClient{Stream: s}, nil
+}
+
+type directUDPSocketClosedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directUDPSocketClosedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directUDPSocketClosedClient) Recv() (*DirectUDPSocketClosedReply, error) {
+	event := new(DirectUDPSocketClosedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectUDPSocketClosed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectUDPSocketChunkSent(ctx context.Context) (DirectUDPSocketChunkSentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directUDPSocketChunkSent", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directUDPSocketChunkSentClient{Stream: s}, nil
+}
+
+type directUDPSocketChunkSentClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directUDPSocketChunkSentClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directUDPSocketChunkSentClient) Recv() (*DirectUDPSocketChunkSentReply, error) {
+	event := new(DirectUDPSocketChunkSentReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectUDPSocketChunkSent Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DirectUDPSocketChunkReceived(ctx context.Context) (DirectUDPSocketChunkReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.directUDPSocketChunkReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &directUDPSocketChunkReceivedClient{Stream: s}, nil
+}
+
+type directUDPSocketChunkReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *directUDPSocketChunkReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *directUDPSocketChunkReceivedClient) Recv() (*DirectUDPSocketChunkReceivedReply, error) {
+	event := new(DirectUDPSocketChunkReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "DirectUDPSocketChunkReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) RequestWillBeSentExtraInfo(ctx context.Context) (RequestWillBeSentExtraInfoClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestWillBeSentExtraInfo", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &requestWillBeSentExtraInfoClient{Stream: s}, nil
+}
+
+type requestWillBeSentExtraInfoClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *requestWillBeSentExtraInfoClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *requestWillBeSentExtraInfoClient) Recv() (*RequestWillBeSentExtraInfoReply, error) {
+	event := new(RequestWillBeSentExtraInfoReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "RequestWillBeSentExtraInfo Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ResponseReceivedExtraInfo(ctx context.Context) (ResponseReceivedExtraInfoClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.responseReceivedExtraInfo", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &responseReceivedExtraInfoClient{Stream: s}, nil
+}
+
+type responseReceivedExtraInfoClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *responseReceivedExtraInfoClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *responseReceivedExtraInfoClient) Recv() (*ResponseReceivedExtraInfoReply, error) {
+	event := new(ResponseReceivedExtraInfoReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ResponseReceivedExtraInfo Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ResponseReceivedEarlyHints(ctx context.Context) (ResponseReceivedEarlyHintsClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.responseReceivedEarlyHints", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &responseReceivedEarlyHintsClient{Stream: s}, nil
+}
+
+type responseReceivedEarlyHintsClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *responseReceivedEarlyHintsClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *responseReceivedEarlyHintsClient) Recv() (*ResponseReceivedEarlyHintsReply, error) {
+	event := new(ResponseReceivedEarlyHintsReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ResponseReceivedEarlyHints Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) TrustTokenOperationDone(ctx context.Context) (TrustTokenOperationDoneClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.trustTokenOperationDone", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &trustTokenOperationDoneClient{Stream: s}, nil
+}
+
+type trustTokenOperationDoneClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *trustTokenOperationDoneClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *trustTokenOperationDoneClient) Recv() (*TrustTokenOperationDoneReply, error) {
+	event := new(TrustTokenOperationDoneReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "TrustTokenOperationDone Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) PolicyUpdated(ctx context.Context) (PolicyUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.policyUpdated", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &policyUpdatedClient{Stream: s}, nil
+}
+
+type policyUpdatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *policyUpdatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *policyUpdatedClient) Recv() (*PolicyUpdatedReply, error) {
+	event := new(PolicyUpdatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "PolicyUpdated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) SubresourceWebBundleMetadataReceived(ctx context.Context) (SubresourceWebBundleMetadataReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.subresourceWebBundleMetadataReceived", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &subresourceWebBundleMetadataReceivedClient{Stream: s}, nil
+}
+
+type subresourceWebBundleMetadataReceivedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *subresourceWebBundleMetadataReceivedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *subresourceWebBundleMetadataReceivedClient) Recv() (*SubresourceWebBundleMetadataReceivedReply, error) {
+	event := new(SubresourceWebBundleMetadataReceivedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "SubresourceWebBundleMetadataReceived Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) SubresourceWebBundleMetadataError(ctx context.Context) (SubresourceWebBundleMetadataErrorClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.subresourceWebBundleMetadataError", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &subresourceWebBundleMetadataErrorClient{Stream: s}, nil
+}
+
+type subresourceWebBundleMetadataErrorClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *subresourceWebBundleMetadataErrorClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *subresourceWebBundleMetadataErrorClient) Recv() (*SubresourceWebBundleMetadataErrorReply, error) {
+	event := new(SubresourceWebBundleMetadataErrorReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "SubresourceWebBundleMetadataError Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) SubresourceWebBundleInnerResponseParsed(ctx context.Context) (SubresourceWebBundleInnerResponseParsedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.subresourceWebBundleInnerResponseParsed", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &subresourceWebBundleInnerResponseParsedClient{Stream: s}, nil
+}
+
+type subresourceWebBundleInnerResponseParsedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *subresourceWebBundleInnerResponseParsedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *subresourceWebBundleInnerResponseParsedClient) Recv() (*SubresourceWebBundleInnerResponseParsedReply, error) {
+	event := new(SubresourceWebBundleInnerResponseParsedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "SubresourceWebBundleInnerResponseParsed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) SubresourceWebBundleInnerResponseError(ctx context.Context) (SubresourceWebBundleInnerResponseErrorClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.subresourceWebBundleInnerResponseError", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &subresourceWebBundleInnerResponseErrorClient{Stream: s}, nil
+}
+
+type subresourceWebBundleInnerResponseErrorClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *subresourceWebBundleInnerResponseErrorClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *subresourceWebBundleInnerResponseErrorClient) Recv() (*SubresourceWebBundleInnerResponseErrorReply, error) {
+	event := new(SubresourceWebBundleInnerResponseErrorReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "SubresourceWebBundleInnerResponseError Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ReportingAPIReportAdded(ctx context.Context) (ReportingAPIReportAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.reportingApiReportAdded", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &reportingAPIReportAddedClient{Stream: s}, nil
+}
+
+type reportingAPIReportAddedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *reportingAPIReportAddedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *reportingAPIReportAddedClient) Recv() (*ReportingAPIReportAddedReply, error) {
+	event := new(ReportingAPIReportAddedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ReportingAPIReportAdded Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ReportingAPIReportUpdated(ctx context.Context) (ReportingAPIReportUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.reportingApiReportUpdated", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &reportingAPIReportUpdatedClient{Stream: s}, nil
+}
+
+type reportingAPIReportUpdatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *reportingAPIReportUpdatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *reportingAPIReportUpdatedClient) Recv() (*ReportingAPIReportUpdatedReply, error) {
+	event := new(ReportingAPIReportUpdatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ReportingAPIReportUpdated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ReportingAPIEndpointsChangedForOrigin(ctx context.Context) (ReportingAPIEndpointsChangedForOriginClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.reportingApiEndpointsChangedForOrigin", d.conn)
+	if err != nil {
+		return nil, err
+	}
+	return &reportingAPIEndpointsChangedForOriginClient{Stream: s}, nil
+}
+
+type reportingAPIEndpointsChangedForOriginClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *reportingAPIEndpointsChangedForOriginClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *reportingAPIEndpointsChangedForOriginClient) Recv() (*ReportingAPIEndpointsChangedForOriginReply, error) {
+	event := new(ReportingAPIEndpointsChangedForOriginReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Network", Op: "ReportingAPIEndpointsChangedForOrigin Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,2256 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SourceOrderConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("parentOutlineColor")
+	v.ParentOutlineColor.EncodeJSON(w)
+	w.Key("childOutlineColor")
+	v.ChildOutlineColor.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SourceOrderConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "parentOutlineColor":
+			v.ParentOutlineColor.DecodeJSON(r)
+		case "childOutlineColor":
+			v.ChildOutlineColor.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GridHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ShowGridExtensionLines != nil {
+		w.Key("showGridExtensionLines")
+		w.Bool(*v.ShowGridExtensionLines)
+	}
+	if v.ShowPositiveLineNumbers != nil {
+		w.Key("showPositiveLineNumbers")
+		w.Bool(*v.ShowPositiveLineNumbers)
+	}
+	if v.ShowNegativeLineNumbers != nil {
+		w.Key("showNegativeLineNumbers")
+		w.Bool(*v.ShowNegativeLineNumbers)
+	}
+	if v.ShowAreaNames != nil {
+		w.Key("showAreaNames")
+		w.Bool(*v.ShowAreaNames)
+	}
+	if v.ShowLineNames != nil {
+		w.Key("showLineNames")
+		w.Bool(*v.ShowLineNames)
+	}
+	if v.ShowTrackSizes != nil {
+		w.Key("showTrackSizes")
+		w.Bool(*v.ShowTrackSizes)
+	}
+	if v.GridBorderColor != nil {
+		w.Key("gridBorderColor")
+		v.GridBorderColor.EncodeJSON(w)
+	}
+	if v.CellBorderColor != nil {
+		w.Key("cellBorderColor")
+		v.CellBorderColor.EncodeJSON(w)
+	}
+	if v.RowLineColor != nil {
+		w.Key("rowLineColor")
+		v.RowLineColor.EncodeJSON(w)
+	}
+	if v.ColumnLineColor != nil {
+		w.Key("columnLineColor")
+		v.ColumnLineColor.EncodeJSON(w)
+	}
+	if v.GridBorderDash != nil {
+		w.Key("gridBorderDash")
+		w.Bool(*v.GridBorderDash)
+	}
+	if v.CellBorderDash != nil {
+		w.Key("cellBorderDash")
+		w.Bool(*v.CellBorderDash)
+	}
+	if v.RowLineDash != nil {
+		w.Key("rowLineDash")
+		w.Bool(*v.RowLineDash)
+	}
+	if v.ColumnLineDash != nil {
+		w.Key("columnLineDash")
+		w.Bool(*v.ColumnLineDash)
+	}
+	if v.RowGapColor != nil {
+		w.Key("rowGapColor")
+		v.RowGapColor.EncodeJSON(w)
+	}
+	if v.RowHatchColor != nil {
+		w.Key("rowHatchColor")
+		v.RowHatchColor.EncodeJSON(w)
+	}
+	if v.ColumnGapColor != nil {
+		w.Key("columnGapColor")
+		v.ColumnGapColor.EncodeJSON(w)
+	}
+	if v.ColumnHatchColor != nil {
+		w.Key("columnHatchColor")
+		v.ColumnHatchColor.EncodeJSON(w)
+	}
+	if v.AreaBorderColor != nil {
+		w.Key("areaBorderColor")
+		v.AreaBorderColor.EncodeJSON(w)
+	}
+	if v.GridBackgroundColor != nil {
+		w.Key("gridBackgroundColor")
+		v.GridBackgroundColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GridHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "showGridExtensionLines":
+			if r.Null() {
+				v.ShowGridExtensionLines = nil
+			} else {
+				p := r.Bool()
+				v.ShowGridExtensionLines = &p
+			}
+		case "showPositiveLineNumbers":
+			if r.Null() {
+				v.ShowPositiveLineNumbers = nil
+			} else {
+				p := r.Bool()
+				v.ShowPositiveLineNumbers = &p
+			}
+		case "showNegativeLineNumbers":
+			if r.Null() {
+				v.ShowNegativeLineNumbers = nil
+			} else {
+				p := r.Bool()
+				v.ShowNegativeLineNumbers = &p
+			}
+		case "showAreaNames":
+			if r.Null() {
+				v.ShowAreaNames = nil
+			} else {
+				p := r.Bool()
+				v.ShowAreaNames = &p
+			}
+		case "showLineNames":
+			if r.Null() {
+				v.ShowLineNames = nil
+			} else {
+				p := r.Bool()
+				v.ShowLineNames = &p
+			}
+		case "showTrackSizes":
+			if r.Null() {
+				v.ShowTrackSizes = nil
+			} else {
+				p := r.Bool()
+				v.ShowTrackSizes = &p
+			}
+		case "gridBorderColor":
+			if r.Null() {
+				v.GridBorderColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.GridBorderColor = &p
+			}
+		case "cellBorderColor":
+			if r.Null() {
+				v.CellBorderColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.CellBorderColor = &p
+			}
+		case "rowLineColor":
+			if r.Null() {
+				v.RowLineColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.RowLineColor = &p
+			}
+		case "columnLineColor":
+			if r.Null() {
+				v.ColumnLineColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ColumnLineColor = &p
+			}
+		case "gridBorderDash":
+			if r.Null() {
+				v.GridBorderDash = nil
+			} else {
+				p := r.Bool()
+				v.GridBorderDash = &p
+			}
+		case "cellBorderDash":
+			if r.Null() {
+				v.CellBorderDash = nil
+			} else {
+				p := r.Bool()
+				v.CellBorderDash = &p
+			}
+		case "rowLineDash":
+			if r.Null() {
+				v.RowLineDash = nil
+			} else {
+				p := r.Bool()
+				v.RowLineDash = &p
+			}
+		case "columnLineDash":
+			if r.Null() {
+				v.ColumnLineDash = nil
+			} else {
+				p := r.Bool()
+				v.ColumnLineDash = &p
+			}
+		case "rowGapColor":
+			if r.Null() {
+				v.RowGapColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.RowGapColor = &p
+			}
+		case "rowHatchColor":
+			if r.Null() {
+				v.RowHatchColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.RowHatchColor = &p
+			}
+		case "columnGapColor":
+			if r.Null() {
+				v.ColumnGapColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ColumnGapColor = &p
+			}
+		case "columnHatchColor":
+			if r.Null() {
+				v.ColumnHatchColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ColumnHatchColor = &p
+			}
+		case "areaBorderColor":
+			if r.Null() {
+				v.AreaBorderColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.AreaBorderColor = &p
+			}
+		case "gridBackgroundColor":
+			if r.Null() {
+				v.GridBackgroundColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.GridBackgroundColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FlexContainerHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ContainerBorder != nil {
+		w.Key("containerBorder")
+		v.ContainerBorder.EncodeJSON(w)
+	}
+	if v.LineSeparator != nil {
+		w.Key("lineSeparator")
+		v.LineSeparator.EncodeJSON(w)
+	}
+	if v.ItemSeparator != nil {
+		w.Key("itemSeparator")
+		v.ItemSeparator.EncodeJSON(w)
+	}
+	if v.MainDistributedSpace != nil {
+		w.Key("mainDistributedSpace")
+		v.MainDistributedSpace.EncodeJSON(w)
+	}
+	if v.CrossDistributedSpace != nil {
+		w.Key("crossDistributedSpace")
+		v.CrossDistributedSpace.EncodeJSON(w)
+	}
+	if v.RowGapSpace != nil {
+		w.Key("rowGapSpace")
+		v.RowGapSpace.EncodeJSON(w)
+	}
+	if v.ColumnGapSpace != nil {
+		w.Key("columnGapSpace")
+		v.ColumnGapSpace.EncodeJSON(w)
+	}
+	if v.CrossAlignment != nil {
+		w.Key("crossAlignment")
+		v.CrossAlignment.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FlexContainerHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "containerBorder":
+			if r.Null() {
+				v.ContainerBorder = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.ContainerBorder = &p
+			}
+		case "lineSeparator":
+			if r.Null() {
+				v.LineSeparator = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.LineSeparator = &p
+			}
+		case "itemSeparator":
+			if r.Null() {
+				v.ItemSeparator = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.ItemSeparator = &p
+			}
+		case "mainDistributedSpace":
+			if r.Null() {
+				v.MainDistributedSpace = nil
+			} else {
+				var p BoxStyle
+				p.DecodeJSON(r)
+				v.MainDistributedSpace = &p
+			}
+		case "crossDistributedSpace":
+			if r.Null() {
+				v.CrossDistributedSpace = nil
+			} else {
+				var p BoxStyle
+				p.DecodeJSON(r)
+				v.CrossDistributedSpace = &p
+			}
+		case "rowGapSpace":
+			if r.Null() {
+				v.RowGapSpace = nil
+			} else {
+				var p BoxStyle
+				p.DecodeJSON(r)
+				v.RowGapSpace = &p
+			}
+		case "columnGapSpace":
+			if r.Null() {
+				v.ColumnGapSpace = nil
+			} else {
+				var p BoxStyle
+				p.DecodeJSON(r)
+				v.ColumnGapSpace = &p
+			}
+		case "crossAlignment":
+			if r.Null() {
+				v.CrossAlignment = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.CrossAlignment = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FlexItemHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BaseSizeBox != nil {
+		w.Key("baseSizeBox")
+		v.BaseSizeBox.EncodeJSON(w)
+	}
+	if v.BaseSizeBorder != nil {
+		w.Key("baseSizeBorder")
+		v.BaseSizeBorder.EncodeJSON(w)
+	}
+	if v.FlexibilityArrow != nil {
+		w.Key("flexibilityArrow")
+		v.FlexibilityArrow.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FlexItemHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "baseSizeBox":
+			if r.Null() {
+				v.BaseSizeBox = nil
+			} else {
+				var p BoxStyle
+				p.DecodeJSON(r)
+				v.BaseSizeBox = &p
+			}
+		case "baseSizeBorder":
+			if r.Null() {
+				v.BaseSizeBorder = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.BaseSizeBorder = &p
+			}
+		case "flexibilityArrow":
+			if r.Null() {
+				v.FlexibilityArrow = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.FlexibilityArrow = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LineStyle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Color != nil {
+		w.Key("color")
+		v.Color.EncodeJSON(w)
+	}
+	if v.Pattern != nil {
+		w.Key("pattern")
+		w.String(*v.Pattern)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LineStyle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "color":
+			if r.Null() {
+				v.Color = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.Color = &p
+			}
+		case "pattern":
+			if r.Null() {
+				v.Pattern = nil
+			} else {
+				p := r.String()
+				v.Pattern = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BoxStyle) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.FillColor != nil {
+		w.Key("fillColor")
+		v.FillColor.EncodeJSON(w)
+	}
+	if v.HatchColor != nil {
+		w.Key("hatchColor")
+		v.HatchColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BoxStyle) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fillColor":
+			if r.Null() {
+				v.FillColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.FillColor = &p
+			}
+		case "hatchColor":
+			if r.Null() {
+				v.HatchColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.HatchColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ShowInfo != nil {
+		w.Key("showInfo")
+		w.Bool(*v.ShowInfo)
+	}
+	if v.ShowStyles != nil {
+		w.Key("showStyles")
+		w.Bool(*v.ShowStyles)
+	}
+	if v.ShowRulers != nil {
+		w.Key("showRulers")
+		w.Bool(*v.ShowRulers)
+	}
+	if v.ShowAccessibilityInfo != nil {
+		w.Key("showAccessibilityInfo")
+		w.Bool(*v.ShowAccessibilityInfo)
+	}
+	if v.ShowExtensionLines != nil {
+		w.Key("showExtensionLines")
+		w.Bool(*v.ShowExtensionLines)
+	}
+	if v.ContentColor != nil {
+		w.Key("contentColor")
+		v.ContentColor.EncodeJSON(w)
+	}
+	if v.PaddingColor != nil {
+		w.Key("paddingColor")
+		v.PaddingColor.EncodeJSON(w)
+	}
+	if v.BorderColor != nil {
+		w.Key("borderColor")
+		v.BorderColor.EncodeJSON(w)
+	}
+	if v.MarginColor != nil {
+		w.Key("marginColor")
+		v.MarginColor.EncodeJSON(w)
+	}
+	if v.EventTargetColor != nil {
+		w.Key("eventTargetColor")
+		v.EventTargetColor.EncodeJSON(w)
+	}
+	if v.ShapeColor != nil {
+		w.Key("shapeColor")
+		v.ShapeColor.EncodeJSON(w)
+	}
+	if v.ShapeMarginColor != nil {
+		w.Key("shapeMarginColor")
+		v.ShapeMarginColor.EncodeJSON(w)
+	}
+	if v.CSSGridColor != nil {
+		w.Key("cssGridColor")
+		v.CSSGridColor.EncodeJSON(w)
+	}
+	if v.ColorFormat != "" {
+		w.Key("colorFormat")
+		w.String(string(v.ColorFormat))
+	}
+	if v.GridHighlightConfig != nil {
+		w.Key("gridHighlightConfig")
+		v.GridHighlightConfig.EncodeJSON(w)
+	}
+	if v.FlexContainerHighlightConfig != nil {
+		w.Key("flexContainerHighlightConfig")
+		v.FlexContainerHighlightConfig.EncodeJSON(w)
+	}
+	if v.FlexItemHighlightConfig != nil {
+		w.Key("flexItemHighlightConfig")
+		v.FlexItemHighlightConfig.EncodeJSON(w)
+	}
+	if v.ContrastAlgorithm != "" {
+		w.Key("contrastAlgorithm")
+		w.String(string(v.ContrastAlgorithm))
+	}
+	if v.ContainerQueryContainerHighlightConfig != nil {
+		w.Key("containerQueryContainerHighlightConfig")
+		v.ContainerQueryContainerHighlightConfig.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "showInfo":
+			if r.Null() {
+				v.ShowInfo = nil
+			} else {
+				p := r.Bool()
+				v.ShowInfo = &p
+			}
+		case "showStyles":
+			if r.Null() {
+				v.ShowStyles = nil
+			} else {
+				p := r.Bool()
+				v.ShowStyles = &p
+			}
+		case "showRulers":
+			if r.Null() {
+				v.ShowRulers = nil
+			} else {
+				p := r.Bool()
+				v.ShowRulers = &p
+			}
+		case "showAccessibilityInfo":
+			if r.Null() {
+				v.ShowAccessibilityInfo = nil
+			} else {
+				p := r.Bool()
+				v.ShowAccessibilityInfo = &p
+			}
+		case "showExtensionLines":
+			if r.Null() {
+				v.ShowExtensionLines = nil
+			} else {
+				p := r.Bool()
+				v.ShowExtensionLines = &p
+			}
+		case "contentColor":
+			if r.Null() {
+				v.ContentColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ContentColor = &p
+			}
+		case "paddingColor":
+			if r.Null() {
+				v.PaddingColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.PaddingColor = &p
+			}
+		case "borderColor":
+			if r.Null() {
+				v.BorderColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.BorderColor = &p
+			}
+		case "marginColor":
+			if r.Null() {
+				v.MarginColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.MarginColor = &p
+			}
+		case "eventTargetColor":
+			if r.Null() {
+				v.EventTargetColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.EventTargetColor = &p
+			}
+		case "shapeColor":
+			if r.Null() {
+				v.ShapeColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ShapeColor = &p
+			}
+		case "shapeMarginColor":
+			if r.Null() {
+				v.ShapeMarginColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ShapeMarginColor = &p
+			}
+		case "cssGridColor":
+			if r.Null() {
+				v.CSSGridColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.CSSGridColor = &p
+			}
+		case "colorFormat":
+			v.ColorFormat = ColorFormat(r.String())
+		case "gridHighlightConfig":
+			if r.Null() {
+				v.GridHighlightConfig = nil
+			} else {
+				var p GridHighlightConfig
+				p.DecodeJSON(r)
+				v.GridHighlightConfig = &p
+			}
+		case "flexContainerHighlightConfig":
+			if r.Null() {
+				v.FlexContainerHighlightConfig = nil
+			} else {
+				var p FlexContainerHighlightConfig
+				p.DecodeJSON(r)
+				v.FlexContainerHighlightConfig = &p
+			}
+		case "flexItemHighlightConfig":
+			if r.Null() {
+				v.FlexItemHighlightConfig = nil
+			} else {
+				var p FlexItemHighlightConfig
+				p.DecodeJSON(r)
+				v.FlexItemHighlightConfig = &p
+			}
+		case "contrastAlgorithm":
+			v.ContrastAlgorithm = ContrastAlgorithm(r.String())
+		case "containerQueryContainerHighlightConfig":
+			if r.Null() {
+				v.ContainerQueryContainerHighlightConfig = nil
+			} else {
+				var p ContainerQueryContainerHighlightConfig
+				p.DecodeJSON(r)
+				v.ContainerQueryContainerHighlightConfig = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GridNodeHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("gridHighlightConfig")
+	v.GridHighlightConfig.EncodeJSON(w)
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GridNodeHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "gridHighlightConfig":
+			v.GridHighlightConfig.DecodeJSON(r)
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FlexNodeHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("flexContainerHighlightConfig")
+	v.FlexContainerHighlightConfig.EncodeJSON(w)
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FlexNodeHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "flexContainerHighlightConfig":
+			v.FlexContainerHighlightConfig.DecodeJSON(r)
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScrollSnapContainerHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.SnapportBorder != nil {
+		w.Key("snapportBorder")
+		v.SnapportBorder.EncodeJSON(w)
+	}
+	if v.SnapAreaBorder != nil {
+		w.Key("snapAreaBorder")
+		v.SnapAreaBorder.EncodeJSON(w)
+	}
+	if v.ScrollMarginColor != nil {
+		w.Key("scrollMarginColor")
+		v.ScrollMarginColor.EncodeJSON(w)
+	}
+	if v.ScrollPaddingColor != nil {
+		w.Key("scrollPaddingColor")
+		v.ScrollPaddingColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScrollSnapContainerHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "snapportBorder":
+			if r.Null() {
+				v.SnapportBorder = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.SnapportBorder = &p
+			}
+		case "snapAreaBorder":
+			if r.Null() {
+				v.SnapAreaBorder = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.SnapAreaBorder = &p
+			}
+		case "scrollMarginColor":
+			if r.Null() {
+				v.ScrollMarginColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ScrollMarginColor = &p
+			}
+		case "scrollPaddingColor":
+			if r.Null() {
+				v.ScrollPaddingColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ScrollPaddingColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScrollSnapHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scrollSnapContainerHighlightConfig")
+	v.ScrollSnapContainerHighlightConfig.EncodeJSON(w)
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScrollSnapHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scrollSnapContainerHighlightConfig":
+			v.ScrollSnapContainerHighlightConfig.DecodeJSON(r)
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HingeConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rect")
+	v.Rect.EncodeJSON(w)
+	if v.ContentColor != nil {
+		w.Key("contentColor")
+		v.ContentColor.EncodeJSON(w)
+	}
+	if v.OutlineColor != nil {
+		w.Key("outlineColor")
+		v.OutlineColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HingeConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rect":
+			v.Rect.DecodeJSON(r)
+		case "contentColor":
+			if r.Null() {
+				v.ContentColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ContentColor = &p
+			}
+		case "outlineColor":
+			if r.Null() {
+				v.OutlineColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.OutlineColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WindowControlsOverlayConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("showCSS")
+	w.Bool(v.ShowCSS)
+	w.Key("selectedPlatform")
+	w.String(v.SelectedPlatform)
+	w.Key("themeColor")
+	w.String(v.ThemeColor)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WindowControlsOverlayConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "showCSS":
+			v.ShowCSS = r.Bool()
+		case "selectedPlatform":
+			v.SelectedPlatform = r.String()
+		case "themeColor":
+			v.ThemeColor = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContainerQueryHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("containerQueryContainerHighlightConfig")
+	v.ContainerQueryContainerHighlightConfig.EncodeJSON(w)
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContainerQueryHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "containerQueryContainerHighlightConfig":
+			v.ContainerQueryContainerHighlightConfig.DecodeJSON(r)
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ContainerQueryContainerHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ContainerBorder != nil {
+		w.Key("containerBorder")
+		v.ContainerBorder.EncodeJSON(w)
+	}
+	if v.DescendantBorder != nil {
+		w.Key("descendantBorder")
+		v.DescendantBorder.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ContainerQueryContainerHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "containerBorder":
+			if r.Null() {
+				v.ContainerBorder = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.ContainerBorder = &p
+			}
+		case "descendantBorder":
+			if r.Null() {
+				v.DescendantBorder = nil
+			} else {
+				var p LineStyle
+				p.DecodeJSON(r)
+				v.DescendantBorder = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IsolatedElementHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isolationModeHighlightConfig")
+	v.IsolationModeHighlightConfig.EncodeJSON(w)
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IsolatedElementHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isolationModeHighlightConfig":
+			v.IsolationModeHighlightConfig.DecodeJSON(r)
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *IsolationModeHighlightConfig) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ResizerColor != nil {
+		w.Key("resizerColor")
+		v.ResizerColor.EncodeJSON(w)
+	}
+	if v.ResizerHandleColor != nil {
+		w.Key("resizerHandleColor")
+		v.ResizerHandleColor.EncodeJSON(w)
+	}
+	if v.MaskColor != nil {
+		w.Key("maskColor")
+		v.MaskColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *IsolationModeHighlightConfig) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "resizerColor":
+			if r.Null() {
+				v.ResizerColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ResizerColor = &p
+			}
+		case "resizerHandleColor":
+			if r.Null() {
+				v.ResizerHandleColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ResizerHandleColor = &p
+			}
+		case "maskColor":
+			if r.Null() {
+				v.MaskColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.MaskColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHighlightObjectForTestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	if v.IncludeDistance != nil {
+		w.Key("includeDistance")
+		w.Bool(*v.IncludeDistance)
+	}
+	if v.IncludeStyle != nil {
+		w.Key("includeStyle")
+		w.Bool(*v.IncludeStyle)
+	}
+	if v.ColorFormat != "" {
+		w.Key("colorFormat")
+		w.String(string(v.ColorFormat))
+	}
+	if v.ShowAccessibilityInfo != nil {
+		w.Key("showAccessibilityInfo")
+		w.Bool(*v.ShowAccessibilityInfo)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHighlightObjectForTestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		case "includeDistance":
+			if r.Null() {
+				v.IncludeDistance = nil
+			} else {
+				p := r.Bool()
+				v.IncludeDistance = &p
+			}
+		case "includeStyle":
+			if r.Null() {
+				v.IncludeStyle = nil
+			} else {
+				p := r.Bool()
+				v.IncludeStyle = &p
+			}
+		case "colorFormat":
+			v.ColorFormat = ColorFormat(r.String())
+		case "showAccessibilityInfo":
+			if r.Null() {
+				v.ShowAccessibilityInfo = nil
+			} else {
+				p := r.Bool()
+				v.ShowAccessibilityInfo = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetHighlightObjectForTestReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("highlight")
+	w.Raw(v.Highlight)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetHighlightObjectForTestReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "highlight":
+			v.Highlight = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetGridHighlightObjectsForTestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetGridHighlightObjectsForTestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]dom.NodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := dom.NodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetGridHighlightObjectsForTestReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("highlights")
+	w.Raw(v.Highlights)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetGridHighlightObjectsForTestReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "highlights":
+			v.Highlights = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSourceOrderHighlightObjectForTestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSourceOrderHighlightObjectForTestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetSourceOrderHighlightObjectForTestReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("highlight")
+	w.Raw(v.Highlight)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetSourceOrderHighlightObjectForTestReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "highlight":
+			v.Highlight = r.Raw()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightFrameArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	if v.ContentColor != nil {
+		w.Key("contentColor")
+		v.ContentColor.EncodeJSON(w)
+	}
+	if v.ContentOutlineColor != nil {
+		w.Key("contentOutlineColor")
+		v.ContentOutlineColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightFrameArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = page.FrameID(r.String())
+		case "contentColor":
+			if r.Null() {
+				v.ContentColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ContentColor = &p
+			}
+		case "contentOutlineColor":
+			if r.Null() {
+				v.ContentOutlineColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.ContentOutlineColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightNodeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("highlightConfig")
+	v.HighlightConfig.EncodeJSON(w)
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	if v.Selector != nil {
+		w.Key("selector")
+		w.String(*v.Selector)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightNodeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "highlightConfig":
+			v.HighlightConfig.DecodeJSON(r)
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		case "selector":
+			if r.Null() {
+				v.Selector = nil
+			} else {
+				p := r.String()
+				v.Selector = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightQuadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("quad")
+	if v.Quad == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Quad {
+			w.Float(v.Quad[i0])
+		}
+		w.ArrayEnd()
+	}
+	if v.Color != nil {
+		w.Key("color")
+		v.Color.EncodeJSON(w)
+	}
+	if v.OutlineColor != nil {
+		w.Key("outlineColor")
+		v.OutlineColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightQuadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "quad":
+			if r.ArrayStart() {
+				v.Quad = make(dom.Quad, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.Float()
+					v.Quad = append(v.Quad, e0)
+				}
+			} else {
+				v.Quad = nil
+			}
+		case "color":
+			if r.Null() {
+				v.Color = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.Color = &p
+			}
+		case "outlineColor":
+			if r.Null() {
+				v.OutlineColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.OutlineColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightRectArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Int(v.X)
+	w.Key("y")
+	w.Int(v.Y)
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	if v.Color != nil {
+		w.Key("color")
+		v.Color.EncodeJSON(w)
+	}
+	if v.OutlineColor != nil {
+		w.Key("outlineColor")
+		v.OutlineColor.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightRectArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Int()
+		case "y":
+			v.Y = r.Int()
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		case "color":
+			if r.Null() {
+				v.Color = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.Color = &p
+			}
+		case "outlineColor":
+			if r.Null() {
+				v.OutlineColor = nil
+			} else {
+				var p dom.RGBA
+				p.DecodeJSON(r)
+				v.OutlineColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HighlightSourceOrderArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sourceOrderConfig")
+	v.SourceOrderConfig.EncodeJSON(w)
+	if v.NodeID != nil {
+		w.Key("nodeId")
+		w.Int(int(*v.NodeID))
+	}
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.ObjectID != nil {
+		w.Key("objectId")
+		w.String(string(*v.ObjectID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HighlightSourceOrderArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sourceOrderConfig":
+			v.SourceOrderConfig.DecodeJSON(r)
+		case "nodeId":
+			if r.Null() {
+				v.NodeID = nil
+			} else {
+				p := dom.NodeID(r.Int())
+				v.NodeID = &p
+			}
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "objectId":
+			if r.Null() {
+				v.ObjectID = nil
+			} else {
+				p := runtime.RemoteObjectID(r.String())
+				v.ObjectID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInspectModeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("mode")
+	w.String(string(v.Mode))
+	if v.HighlightConfig != nil {
+		w.Key("highlightConfig")
+		v.HighlightConfig.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInspectModeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "mode":
+			v.Mode = InspectMode(r.String())
+		case "highlightConfig":
+			if r.Null() {
+				v.HighlightConfig = nil
+			} else {
+				var p HighlightConfig
+				p.DecodeJSON(r)
+				v.HighlightConfig = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowAdHighlightsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowAdHighlightsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPausedInDebuggerMessageArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Message != nil {
+		w.Key("message")
+		w.String(*v.Message)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPausedInDebuggerMessageArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "message":
+			if r.Null() {
+				v.Message = nil
+			} else {
+				p := r.String()
+				v.Message = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowDebugBordersArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowDebugBordersArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowFPSCounterArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowFPSCounterArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowGridOverlaysArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("gridNodeHighlightConfigs")
+	if v.GridNodeHighlightConfigs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.GridNodeHighlightConfigs {
+			v.GridNodeHighlightConfigs[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowGridOverlaysArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "gridNodeHighlightConfigs":
+			if r.ArrayStart() {
+				v.GridNodeHighlightConfigs = make([]GridNodeHighlightConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 GridNodeHighlightConfig
+					e0.DecodeJSON(r)
+					v.GridNodeHighlightConfigs = append(v.GridNodeHighlightConfigs, e0)
+				}
+			} else {
+				v.GridNodeHighlightConfigs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowFlexOverlaysArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("flexNodeHighlightConfigs")
+	if v.FlexNodeHighlightConfigs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.FlexNodeHighlightConfigs {
+			v.FlexNodeHighlightConfigs[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowFlexOverlaysArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "flexNodeHighlightConfigs":
+			if r.ArrayStart() {
+				v.FlexNodeHighlightConfigs = make([]FlexNodeHighlightConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 FlexNodeHighlightConfig
+					e0.DecodeJSON(r)
+					v.FlexNodeHighlightConfigs = append(v.FlexNodeHighlightConfigs, e0)
+				}
+			} else {
+				v.FlexNodeHighlightConfigs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowScrollSnapOverlaysArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scrollSnapHighlightConfigs")
+	if v.ScrollSnapHighlightConfigs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ScrollSnapHighlightConfigs {
+			v.ScrollSnapHighlightConfigs[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowScrollSnapOverlaysArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scrollSnapHighlightConfigs":
+			if r.ArrayStart() {
+				v.ScrollSnapHighlightConfigs = make([]ScrollSnapHighlightConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScrollSnapHighlightConfig
+					e0.DecodeJSON(r)
+					v.ScrollSnapHighlightConfigs = append(v.ScrollSnapHighlightConfigs, e0)
+				}
+			} else {
+				v.ScrollSnapHighlightConfigs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowContainerQueryOverlaysArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("containerQueryHighlightConfigs")
+	if v.ContainerQueryHighlightConfigs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ContainerQueryHighlightConfigs {
+			v.ContainerQueryHighlightConfigs[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowContainerQueryOverlaysArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "containerQueryHighlightConfigs":
+			if r.ArrayStart() {
+				v.ContainerQueryHighlightConfigs = make([]ContainerQueryHighlightConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 ContainerQueryHighlightConfig
+					e0.DecodeJSON(r)
+					v.ContainerQueryHighlightConfigs = append(v.ContainerQueryHighlightConfigs, e0)
+				}
+			} else {
+				v.ContainerQueryHighlightConfigs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowPaintRectsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowPaintRectsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowLayoutShiftRegionsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	w.Bool(v.Result)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowLayoutShiftRegionsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			v.Result = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowScrollBottleneckRectsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowScrollBottleneckRectsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowHitTestBordersArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowHitTestBordersArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowWebVitalsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowWebVitalsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowViewportSizeOnResizeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("show")
+	w.Bool(v.Show)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowViewportSizeOnResizeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "show":
+			v.Show = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowHingeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.HingeConfig != nil {
+		w.Key("hingeConfig")
+		v.HingeConfig.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowHingeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "hingeConfig":
+			if r.Null() {
+				v.HingeConfig = nil
+			} else {
+				var p HingeConfig
+				p.DecodeJSON(r)
+				v.HingeConfig = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowIsolatedElementsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isolatedElementHighlightConfigs")
+	if v.IsolatedElementHighlightConfigs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.IsolatedElementHighlightConfigs {
+			v.IsolatedElementHighlightConfigs[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowIsolatedElementsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isolatedElementHighlightConfigs":
+			if r.ArrayStart() {
+				v.IsolatedElementHighlightConfigs = make([]IsolatedElementHighlightConfig, 0, 4)
+				for r.ArrayNext() {
+					var e0 IsolatedElementHighlightConfig
+					e0.DecodeJSON(r)
+					v.IsolatedElementHighlightConfigs = append(v.IsolatedElementHighlightConfigs, e0)
+				}
+			} else {
+				v.IsolatedElementHighlightConfigs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetShowWindowControlsOverlayArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.WindowControlsOverlayConfig != nil {
+		w.Key("windowControlsOverlayConfig")
+		v.WindowControlsOverlayConfig.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetShowWindowControlsOverlayArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "windowControlsOverlayConfig":
+			if r.Null() {
+				v.WindowControlsOverlayConfig = nil
+			} else {
+				var p WindowControlsOverlayConfig
+				p.DecodeJSON(r)
+				v.WindowControlsOverlayConfig = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InspectNodeRequestedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("backendNodeId")
+	w.Int(int(v.BackendNodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InspectNodeRequestedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backendNodeId":
+			v.BackendNodeID = dom.BackendNodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NodeHighlightRequestedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("nodeId")
+	w.Int(int(v.NodeID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NodeHighlightRequestedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "nodeId":
+			v.NodeID = dom.NodeID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreenshotRequestedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("viewport")
+	v.Viewport.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreenshotRequestedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "viewport":
+			v.Viewport.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InspectModeCanceledReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InspectModeCanceledReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
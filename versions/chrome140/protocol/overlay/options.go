@@ -0,0 +1,285 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// GetHighlightObjectForTestOption sets an optional argument of GetHighlightObjectForTestArgs.
+type GetHighlightObjectForTestOption func(*GetHighlightObjectForTestArgs)
+
+// NewGetHighlightObjectForTestArgsWith initializes GetHighlightObjectForTestArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetHighlightObjectForTestArgsWith(nodeID dom.NodeID, opts ...GetHighlightObjectForTestOption) *GetHighlightObjectForTestArgs {
+	args := NewGetHighlightObjectForTestArgs(nodeID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetHighlightObjectForTestWithIncludeDistance sets the IncludeDistance optional argument, see
+// GetHighlightObjectForTestArgs.SetIncludeDistance.
+func GetHighlightObjectForTestWithIncludeDistance(v bool) GetHighlightObjectForTestOption {
+	return func(a *GetHighlightObjectForTestArgs) { a.SetIncludeDistance(v) }
+}
+
+// GetHighlightObjectForTestWithIncludeStyle sets the IncludeStyle optional argument, see
+// GetHighlightObjectForTestArgs.SetIncludeStyle.
+func GetHighlightObjectForTestWithIncludeStyle(v bool) GetHighlightObjectForTestOption {
+	return func(a *GetHighlightObjectForTestArgs) { a.SetIncludeStyle(v) }
+}
+
+// GetHighlightObjectForTestWithColorFormat sets the ColorFormat optional argument, see
+// GetHighlightObjectForTestArgs.SetColorFormat.
+func GetHighlightObjectForTestWithColorFormat(v ColorFormat) GetHighlightObjectForTestOption {
+	return func(a *GetHighlightObjectForTestArgs) { a.SetColorFormat(v) }
+}
+
+// GetHighlightObjectForTestWithShowAccessibilityInfo sets the ShowAccessibilityInfo optional argument, see
+// GetHighlightObjectForTestArgs.SetShowAccessibilityInfo.
+func GetHighlightObjectForTestWithShowAccessibilityInfo(v bool) GetHighlightObjectForTestOption {
+	return func(a *GetHighlightObjectForTestArgs) { a.SetShowAccessibilityInfo(v) }
+}
+
+// HighlightFrameOption sets an optional argument of HighlightFrameArgs.
+type HighlightFrameOption func(*HighlightFrameArgs)
+
+// NewHighlightFrameArgsWith initializes HighlightFrameArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewHighlightFrameArgsWith(frameID page.FrameID, opts ...HighlightFrameOption) *HighlightFrameArgs {
+	args := NewHighlightFrameArgs(frameID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// HighlightFrameWithContentColor sets the ContentColor optional argument, see
+// HighlightFrameArgs.SetContentColor.
+func HighlightFrameWithContentColor(v dom.RGBA) HighlightFrameOption {
+	return func(a *HighlightFrameArgs) { a.SetContentColor(v) }
+}
+
+// HighlightFrameWithContentOutlineColor sets the ContentOutlineColor optional argument, see
+// HighlightFrameArgs.SetContentOutlineColor.
+func HighlightFrameWithContentOutlineColor(v dom.RGBA) HighlightFrameOption {
+	return func(a *HighlightFrameArgs) { a.SetContentOutlineColor(v) }
+}
+
+// HighlightNodeOption sets an optional argument of HighlightNodeArgs.
+type HighlightNodeOption func(*HighlightNodeArgs)
+
+// NewHighlightNodeArgsWith initializes HighlightNodeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewHighlightNodeArgsWith(highlightConfig HighlightConfig, opts ...HighlightNodeOption) *HighlightNodeArgs {
+	args := NewHighlightNodeArgs(highlightConfig)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// HighlightNodeWithNodeID sets the NodeID optional argument, see
+// HighlightNodeArgs.SetNodeID.
+func HighlightNodeWithNodeID(v dom.NodeID) HighlightNodeOption {
+	return func(a *HighlightNodeArgs) { a.SetNodeID(v) }
+}
+
+// HighlightNodeWithBackendNodeID sets the BackendNodeID optional argument, see
+// HighlightNodeArgs.SetBackendNodeID.
+func HighlightNodeWithBackendNodeID(v dom.BackendNodeID) HighlightNodeOption {
+	return func(a *HighlightNodeArgs) { a.SetBackendNodeID(v) }
+}
+
+// HighlightNodeWithObjectID sets the ObjectID optional argument, see
+// HighlightNodeArgs.SetObjectID.
+func HighlightNodeWithObjectID(v runtime.RemoteObjectID) HighlightNodeOption {
+	return func(a *HighlightNodeArgs) { a.SetObjectID(v) }
+}
+
+// HighlightNodeWithSelector sets the Selector optional argument, see
+// HighlightNodeArgs.SetSelector.
+func HighlightNodeWithSelector(v string) HighlightNodeOption {
+	return func(a *HighlightNodeArgs) { a.SetSelector(v) }
+}
+
+// HighlightQuadOption sets an optional argument of HighlightQuadArgs.
+type HighlightQuadOption func(*HighlightQuadArgs)
+
+// NewHighlightQuadArgsWith initializes HighlightQuadArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewHighlightQuadArgsWith(quad dom.Quad, opts ...HighlightQuadOption) *HighlightQuadArgs {
+	args := NewHighlightQuadArgs(quad)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// HighlightQuadWithColor sets the Color optional argument, see
+// HighlightQuadArgs.SetColor.
+func HighlightQuadWithColor(v dom.RGBA) HighlightQuadOption {
+	return func(a *HighlightQuadArgs) { a.SetColor(v) }
+}
+
+// HighlightQuadWithOutlineColor sets the OutlineColor optional argument, see
+// HighlightQuadArgs.SetOutlineColor.
+func HighlightQuadWithOutlineColor(v dom.RGBA) HighlightQuadOption {
+	return func(a *HighlightQuadArgs) { a.SetOutlineColor(v) }
+}
+
+// HighlightRectOption sets an optional argument of HighlightRectArgs.
+type HighlightRectOption func(*HighlightRectArgs)
+
+// NewHighlightRectArgsWith initializes HighlightRectArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewHighlightRectArgsWith(x int, y int, width int, height int, opts ...HighlightRectOption) *HighlightRectArgs {
+	args := NewHighlightRectArgs(x, y, width, height)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// HighlightRectWithColor sets the Color optional argument, see
+// HighlightRectArgs.SetColor.
+func HighlightRectWithColor(v dom.RGBA) HighlightRectOption {
+	return func(a *HighlightRectArgs) { a.SetColor(v) }
+}
+
+// HighlightRectWithOutlineColor sets the OutlineColor optional argument, see
+// HighlightRectArgs.SetOutlineColor.
+func HighlightRectWithOutlineColor(v dom.RGBA) HighlightRectOption {
+	return func(a *HighlightRectArgs) { a.SetOutlineColor(v) }
+}
+
+// HighlightSourceOrderOption sets an optional argument of HighlightSourceOrderArgs.
+type HighlightSourceOrderOption func(*HighlightSourceOrderArgs)
+
+// NewHighlightSourceOrderArgsWith initializes HighlightSourceOrderArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewHighlightSourceOrderArgsWith(sourceOrderConfig SourceOrderConfig, opts ...HighlightSourceOrderOption) *HighlightSourceOrderArgs {
+	args := NewHighlightSourceOrderArgs(sourceOrderConfig)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// HighlightSourceOrderWithNodeID sets the NodeID optional argument, see
+// HighlightSourceOrderArgs.SetNodeID.
+func HighlightSourceOrderWithNodeID(v dom.NodeID) HighlightSourceOrderOption {
+	return func(a *HighlightSourceOrderArgs) { a.SetNodeID(v) }
+}
+
+// HighlightSourceOrderWithBackendNodeID sets the BackendNodeID optional argument, see
+// HighlightSourceOrderArgs.SetBackendNodeID.
+func HighlightSourceOrderWithBackendNodeID(v dom.BackendNodeID) HighlightSourceOrderOption {
+	return func(a *HighlightSourceOrderArgs) { a.SetBackendNodeID(v) }
+}
+
+// HighlightSourceOrderWithObjectID sets the ObjectID optional argument, see
+// HighlightSourceOrderArgs.SetObjectID.
+func HighlightSourceOrderWithObjectID(v runtime.RemoteObjectID) HighlightSourceOrderOption {
+	return func(a *HighlightSourceOrderArgs) { a.SetObjectID(v) }
+}
+
+// SetInspectModeOption sets an optional argument of SetInspectModeArgs.
+type SetInspectModeOption func(*SetInspectModeArgs)
+
+// NewSetInspectModeArgsWith initializes SetInspectModeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetInspectModeArgsWith(mode InspectMode, opts ...SetInspectModeOption) *SetInspectModeArgs {
+	args := NewSetInspectModeArgs(mode)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetInspectModeWithHighlightConfig sets the HighlightConfig optional argument, see
+// SetInspectModeArgs.SetHighlightConfig.
+func SetInspectModeWithHighlightConfig(v HighlightConfig) SetInspectModeOption {
+	return func(a *SetInspectModeArgs) { a.SetHighlightConfig(v) }
+}
+
+// SetPausedInDebuggerMessageOption sets an optional argument of SetPausedInDebuggerMessageArgs.
+type SetPausedInDebuggerMessageOption func(*SetPausedInDebuggerMessageArgs)
+
+// NewSetPausedInDebuggerMessageArgsWith initializes SetPausedInDebuggerMessageArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetPausedInDebuggerMessageArgsWith(opts ...SetPausedInDebuggerMessageOption) *SetPausedInDebuggerMessageArgs {
+	args := NewSetPausedInDebuggerMessageArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetPausedInDebuggerMessageWithMessage sets the Message optional argument, see
+// SetPausedInDebuggerMessageArgs.SetMessage.
+func SetPausedInDebuggerMessageWithMessage(v string) SetPausedInDebuggerMessageOption {
+	return func(a *SetPausedInDebuggerMessageArgs) { a.SetMessage(v) }
+}
+
+// SetShowHingeOption sets an optional argument of SetShowHingeArgs.
+type SetShowHingeOption func(*SetShowHingeArgs)
+
+// NewSetShowHingeArgsWith initializes SetShowHingeArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetShowHingeArgsWith(opts ...SetShowHingeOption) *SetShowHingeArgs {
+	args := NewSetShowHingeArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetShowHingeWithHingeConfig sets the HingeConfig optional argument, see
+// SetShowHingeArgs.SetHingeConfig.
+func SetShowHingeWithHingeConfig(v HingeConfig) SetShowHingeOption {
+	return func(a *SetShowHingeArgs) { a.SetHingeConfig(v) }
+}
+
+// SetShowWindowControlsOverlayOption sets an optional argument of SetShowWindowControlsOverlayArgs.
+type SetShowWindowControlsOverlayOption func(*SetShowWindowControlsOverlayArgs)
+
+// NewSetShowWindowControlsOverlayArgsWith initializes SetShowWindowControlsOverlayArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetShowWindowControlsOverlayArgsWith(opts ...SetShowWindowControlsOverlayOption) *SetShowWindowControlsOverlayArgs {
+	args := NewSetShowWindowControlsOverlayArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetShowWindowControlsOverlayWithWindowControlsOverlayConfig sets the WindowControlsOverlayConfig optional argument, see
+// SetShowWindowControlsOverlayArgs.SetWindowControlsOverlayConfig.
+func SetShowWindowControlsOverlayWithWindowControlsOverlayConfig(v WindowControlsOverlayConfig) SetShowWindowControlsOverlayOption {
+	return func(a *SetShowWindowControlsOverlayArgs) { a.SetWindowControlsOverlayConfig(v) }
+}
@@ -0,0 +1,5087 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/debugger"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/io"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/runtime"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AdFrameStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("adFrameType")
+	w.String(string(v.AdFrameType))
+	if len(v.Explanations) > 0 {
+		w.Key("explanations")
+		w.ArrayStart()
+		for i0 := range v.Explanations {
+			w.String(string(v.Explanations[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AdFrameStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "adFrameType":
+			v.AdFrameType = AdFrameType(r.String())
+		case "explanations":
+			if r.ArrayStart() {
+				v.Explanations = make([]AdFrameExplanation, 0, 4)
+				for r.ArrayNext() {
+					e0 := AdFrameExplanation(r.String())
+					v.Explanations = append(v.Explanations, e0)
+				}
+			} else {
+				v.Explanations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AdScriptID) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptId")
+	w.String(string(v.ScriptID))
+	w.Key("debuggerId")
+	w.String(string(v.DebuggerID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AdScriptID) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptId":
+			v.ScriptID = runtime.ScriptID(r.String())
+		case "debuggerId":
+			v.DebuggerID = runtime.UniqueDebuggerID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AdScriptAncestry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ancestryChain")
+	if v.AncestryChain == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.AncestryChain {
+			v.AncestryChain[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.RootScriptFilterlistRule != nil {
+		w.Key("rootScriptFilterlistRule")
+		w.String(*v.RootScriptFilterlistRule)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AdScriptAncestry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ancestryChain":
+			if r.ArrayStart() {
+				v.AncestryChain = make([]AdScriptID, 0, 4)
+				for r.ArrayNext() {
+					var e0 AdScriptID
+					e0.DecodeJSON(r)
+					v.AncestryChain = append(v.AncestryChain, e0)
+				}
+			} else {
+				v.AncestryChain = nil
+			}
+		case "rootScriptFilterlistRule":
+			if r.Null() {
+				v.RootScriptFilterlistRule = nil
+			} else {
+				p := r.String()
+				v.RootScriptFilterlistRule = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PermissionsPolicyBlockLocator) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("blockReason")
+	w.String(string(v.BlockReason))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PermissionsPolicyBlockLocator) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "blockReason":
+			v.BlockReason = PermissionsPolicyBlockReason(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PermissionsPolicyFeatureState) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("feature")
+	w.String(string(v.Feature))
+	w.Key("allowed")
+	w.Bool(v.Allowed)
+	if v.Locator != nil {
+		w.Key("locator")
+		v.Locator.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PermissionsPolicyFeatureState) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "feature":
+			v.Feature = PermissionsPolicyFeature(r.String())
+		case "allowed":
+			v.Allowed = r.Bool()
+		case "locator":
+			if r.Null() {
+				v.Locator = nil
+			} else {
+				var p PermissionsPolicyBlockLocator
+				p.DecodeJSON(r)
+				v.Locator = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OriginTrialToken) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("matchSubDomains")
+	w.Bool(v.MatchSubDomains)
+	w.Key("trialName")
+	w.String(v.TrialName)
+	w.Key("expiryTime")
+	w.Marshal(v.ExpiryTime)
+	w.Key("isThirdParty")
+	w.Bool(v.IsThirdParty)
+	w.Key("usageRestriction")
+	w.String(string(v.UsageRestriction))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OriginTrialToken) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "matchSubDomains":
+			v.MatchSubDomains = r.Bool()
+		case "trialName":
+			v.TrialName = r.String()
+		case "expiryTime":
+			r.Decode(&v.ExpiryTime)
+		case "isThirdParty":
+			v.IsThirdParty = r.Bool()
+		case "usageRestriction":
+			v.UsageRestriction = OriginTrialUsageRestriction(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OriginTrialTokenWithStatus) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("rawTokenText")
+	w.String(v.RawTokenText)
+	if v.ParsedToken != nil {
+		w.Key("parsedToken")
+		v.ParsedToken.EncodeJSON(w)
+	}
+	w.Key("status")
+	w.String(string(v.Status))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OriginTrialTokenWithStatus) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "rawTokenText":
+			v.RawTokenText = r.String()
+		case "parsedToken":
+			if r.Null() {
+				v.ParsedToken = nil
+			} else {
+				var p OriginTrialToken
+				p.DecodeJSON(r)
+				v.ParsedToken = &p
+			}
+		case "status":
+			v.Status = OriginTrialTokenStatus(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *OriginTrial) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("trialName")
+	w.String(v.TrialName)
+	w.Key("status")
+	w.String(string(v.Status))
+	w.Key("tokensWithStatus")
+	if v.TokensWithStatus == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.TokensWithStatus {
+			v.TokensWithStatus[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *OriginTrial) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "trialName":
+			v.TrialName = r.String()
+		case "status":
+			v.Status = OriginTrialStatus(r.String())
+		case "tokensWithStatus":
+			if r.ArrayStart() {
+				v.TokensWithStatus = make([]OriginTrialTokenWithStatus, 0, 4)
+				for r.ArrayNext() {
+					var e0 OriginTrialTokenWithStatus
+					e0.DecodeJSON(r)
+					v.TokensWithStatus = append(v.TokensWithStatus, e0)
+				}
+			} else {
+				v.TokensWithStatus = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SecurityOriginDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isLocalhost")
+	w.Bool(v.IsLocalhost)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SecurityOriginDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isLocalhost":
+			v.IsLocalhost = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Frame) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(string(v.ID))
+	if v.ParentID != nil {
+		w.Key("parentId")
+		w.String(string(*v.ParentID))
+	}
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	w.Key("url")
+	w.String(v.URL)
+	if v.URLFragment != nil {
+		w.Key("urlFragment")
+		w.String(*v.URLFragment)
+	}
+	w.Key("domainAndRegistry")
+	w.String(v.DomainAndRegistry)
+	w.Key("securityOrigin")
+	w.String(v.SecurityOrigin)
+	if v.SecurityOriginDetails != nil {
+		w.Key("securityOriginDetails")
+		v.SecurityOriginDetails.EncodeJSON(w)
+	}
+	w.Key("mimeType")
+	w.String(v.MimeType)
+	if v.UnreachableURL != nil {
+		w.Key("unreachableUrl")
+		w.String(*v.UnreachableURL)
+	}
+	if v.AdFrameStatus != nil {
+		w.Key("adFrameStatus")
+		v.AdFrameStatus.EncodeJSON(w)
+	}
+	w.Key("secureContextType")
+	w.String(string(v.SecureContextType))
+	w.Key("crossOriginIsolatedContextType")
+	w.String(string(v.CrossOriginIsolatedContextType))
+	w.Key("gatedAPIFeatures")
+	if v.GatedAPIFeatures == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.GatedAPIFeatures {
+			w.String(string(v.GatedAPIFeatures[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Frame) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = FrameID(r.String())
+		case "parentId":
+			if r.Null() {
+				v.ParentID = nil
+			} else {
+				p := FrameID(r.String())
+				v.ParentID = &p
+			}
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "url":
+			v.URL = r.String()
+		case "urlFragment":
+			if r.Null() {
+				v.URLFragment = nil
+			} else {
+				p := r.String()
+				v.URLFragment = &p
+			}
+		case "domainAndRegistry":
+			v.DomainAndRegistry = r.String()
+		case "securityOrigin":
+			v.SecurityOrigin = r.String()
+		case "securityOriginDetails":
+			if r.Null() {
+				v.SecurityOriginDetails = nil
+			} else {
+				var p SecurityOriginDetails
+				p.DecodeJSON(r)
+				v.SecurityOriginDetails = &p
+			}
+		case "mimeType":
+			v.MimeType = r.String()
+		case "unreachableUrl":
+			if r.Null() {
+				v.UnreachableURL = nil
+			} else {
+				p := r.String()
+				v.UnreachableURL = &p
+			}
+		case "adFrameStatus":
+			if r.Null() {
+				v.AdFrameStatus = nil
+			} else {
+				var p AdFrameStatus
+				p.DecodeJSON(r)
+				v.AdFrameStatus = &p
+			}
+		case "secureContextType":
+			v.SecureContextType = SecureContextType(r.String())
+		case "crossOriginIsolatedContextType":
+			v.CrossOriginIsolatedContextType = CrossOriginIsolatedContextType(r.String())
+		case "gatedAPIFeatures":
+			if r.ArrayStart() {
+				v.GatedAPIFeatures = make([]GatedAPIFeatures, 0, 4)
+				for r.ArrayNext() {
+					e0 := GatedAPIFeatures(r.String())
+					v.GatedAPIFeatures = append(v.GatedAPIFeatures, e0)
+				}
+			} else {
+				v.GatedAPIFeatures = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameResource) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("mimeType")
+	w.String(v.MimeType)
+	if v.LastModified != 0 {
+		w.Key("lastModified")
+		w.Marshal(v.LastModified)
+	}
+	if v.ContentSize != nil {
+		w.Key("contentSize")
+		w.Float(*v.ContentSize)
+	}
+	if v.Failed != nil {
+		w.Key("failed")
+		w.Bool(*v.Failed)
+	}
+	if v.Canceled != nil {
+		w.Key("canceled")
+		w.Bool(*v.Canceled)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameResource) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "type":
+			v.Type = network.ResourceType(r.String())
+		case "mimeType":
+			v.MimeType = r.String()
+		case "lastModified":
+			r.Decode(&v.LastModified)
+		case "contentSize":
+			if r.Null() {
+				v.ContentSize = nil
+			} else {
+				p := r.Float()
+				v.ContentSize = &p
+			}
+		case "failed":
+			if r.Null() {
+				v.Failed = nil
+			} else {
+				p := r.Bool()
+				v.Failed = &p
+			}
+		case "canceled":
+			if r.Null() {
+				v.Canceled = nil
+			} else {
+				p := r.Bool()
+				v.Canceled = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameResourceTree) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frame")
+	v.Frame.EncodeJSON(w)
+	if len(v.ChildFrames) > 0 {
+		w.Key("childFrames")
+		w.ArrayStart()
+		for i0 := range v.ChildFrames {
+			v.ChildFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("resources")
+	if v.Resources == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Resources {
+			v.Resources[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameResourceTree) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frame":
+			v.Frame.DecodeJSON(r)
+		case "childFrames":
+			if r.ArrayStart() {
+				v.ChildFrames = make([]FrameResourceTree, 0, 4)
+				for r.ArrayNext() {
+					var e0 FrameResourceTree
+					e0.DecodeJSON(r)
+					v.ChildFrames = append(v.ChildFrames, e0)
+				}
+			} else {
+				v.ChildFrames = nil
+			}
+		case "resources":
+			if r.ArrayStart() {
+				v.Resources = make([]FrameResource, 0, 4)
+				for r.ArrayNext() {
+					var e0 FrameResource
+					e0.DecodeJSON(r)
+					v.Resources = append(v.Resources, e0)
+				}
+			} else {
+				v.Resources = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameTree) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frame")
+	v.Frame.EncodeJSON(w)
+	if len(v.ChildFrames) > 0 {
+		w.Key("childFrames")
+		w.ArrayStart()
+		for i0 := range v.ChildFrames {
+			v.ChildFrames[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameTree) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frame":
+			v.Frame.DecodeJSON(r)
+		case "childFrames":
+			if r.ArrayStart() {
+				v.ChildFrames = make([]FrameTree, 0, 4)
+				for r.ArrayNext() {
+					var e0 FrameTree
+					e0.DecodeJSON(r)
+					v.ChildFrames = append(v.ChildFrames, e0)
+				}
+			} else {
+				v.ChildFrames = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NavigationEntry) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.Int(v.ID)
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("userTypedURL")
+	w.String(v.UserTypedURL)
+	w.Key("title")
+	w.String(v.Title)
+	w.Key("transitionType")
+	w.String(string(v.TransitionType))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NavigationEntry) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = r.Int()
+		case "url":
+			v.URL = r.String()
+		case "userTypedURL":
+			v.UserTypedURL = r.String()
+		case "title":
+			v.Title = r.String()
+		case "transitionType":
+			v.TransitionType = TransitionType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreencastFrameMetadata) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("offsetTop")
+	w.Float(v.OffsetTop)
+	w.Key("pageScaleFactor")
+	w.Float(v.PageScaleFactor)
+	w.Key("deviceWidth")
+	w.Float(v.DeviceWidth)
+	w.Key("deviceHeight")
+	w.Float(v.DeviceHeight)
+	w.Key("scrollOffsetX")
+	w.Float(v.ScrollOffsetX)
+	w.Key("scrollOffsetY")
+	w.Float(v.ScrollOffsetY)
+	if v.Timestamp != 0 {
+		w.Key("timestamp")
+		w.Marshal(v.Timestamp)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreencastFrameMetadata) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "offsetTop":
+			v.OffsetTop = r.Float()
+		case "pageScaleFactor":
+			v.PageScaleFactor = r.Float()
+		case "deviceWidth":
+			v.DeviceWidth = r.Float()
+		case "deviceHeight":
+			v.DeviceHeight = r.Float()
+		case "scrollOffsetX":
+			v.ScrollOffsetX = r.Float()
+		case "scrollOffsetY":
+			v.ScrollOffsetY = r.Float()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AppManifestError) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("message")
+	w.String(v.Message)
+	w.Key("critical")
+	w.Int(v.Critical)
+	w.Key("line")
+	w.Int(v.Line)
+	w.Key("column")
+	w.Int(v.Column)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AppManifestError) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "message":
+			v.Message = r.String()
+		case "critical":
+			v.Critical = r.Int()
+		case "line":
+			v.Line = r.Int()
+		case "column":
+			v.Column = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AppManifestParsedProperties) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scope")
+	w.String(v.Scope)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AppManifestParsedProperties) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scope":
+			v.Scope = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LayoutViewport) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("pageX")
+	w.Int(v.PageX)
+	w.Key("pageY")
+	w.Int(v.PageY)
+	w.Key("clientWidth")
+	w.Int(v.ClientWidth)
+	w.Key("clientHeight")
+	w.Int(v.ClientHeight)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LayoutViewport) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "pageX":
+			v.PageX = r.Int()
+		case "pageY":
+			v.PageY = r.Int()
+		case "clientWidth":
+			v.ClientWidth = r.Int()
+		case "clientHeight":
+			v.ClientHeight = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VisualViewport) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("offsetX")
+	w.Float(v.OffsetX)
+	w.Key("offsetY")
+	w.Float(v.OffsetY)
+	w.Key("pageX")
+	w.Float(v.PageX)
+	w.Key("pageY")
+	w.Float(v.PageY)
+	w.Key("clientWidth")
+	w.Float(v.ClientWidth)
+	w.Key("clientHeight")
+	w.Float(v.ClientHeight)
+	w.Key("scale")
+	w.Float(v.Scale)
+	if v.Zoom != nil {
+		w.Key("zoom")
+		w.Float(*v.Zoom)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VisualViewport) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "offsetX":
+			v.OffsetX = r.Float()
+		case "offsetY":
+			v.OffsetY = r.Float()
+		case "pageX":
+			v.PageX = r.Float()
+		case "pageY":
+			v.PageY = r.Float()
+		case "clientWidth":
+			v.ClientWidth = r.Float()
+		case "clientHeight":
+			v.ClientHeight = r.Float()
+		case "scale":
+			v.Scale = r.Float()
+		case "zoom":
+			if r.Null() {
+				v.Zoom = nil
+			} else {
+				p := r.Float()
+				v.Zoom = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Viewport) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("x")
+	w.Float(v.X)
+	w.Key("y")
+	w.Float(v.Y)
+	w.Key("width")
+	w.Float(v.Width)
+	w.Key("height")
+	w.Float(v.Height)
+	w.Key("scale")
+	w.Float(v.Scale)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Viewport) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "x":
+			v.X = r.Float()
+		case "y":
+			v.Y = r.Float()
+		case "width":
+			v.Width = r.Float()
+		case "height":
+			v.Height = r.Float()
+		case "scale":
+			v.Scale = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FontFamilies) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Standard != nil {
+		w.Key("standard")
+		w.String(*v.Standard)
+	}
+	if v.Fixed != nil {
+		w.Key("fixed")
+		w.String(*v.Fixed)
+	}
+	if v.Serif != nil {
+		w.Key("serif")
+		w.String(*v.Serif)
+	}
+	if v.SansSerif != nil {
+		w.Key("sansSerif")
+		w.String(*v.SansSerif)
+	}
+	if v.Cursive != nil {
+		w.Key("cursive")
+		w.String(*v.Cursive)
+	}
+	if v.Fantasy != nil {
+		w.Key("fantasy")
+		w.String(*v.Fantasy)
+	}
+	if v.Math != nil {
+		w.Key("math")
+		w.String(*v.Math)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FontFamilies) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "standard":
+			if r.Null() {
+				v.Standard = nil
+			} else {
+				p := r.String()
+				v.Standard = &p
+			}
+		case "fixed":
+			if r.Null() {
+				v.Fixed = nil
+			} else {
+				p := r.String()
+				v.Fixed = &p
+			}
+		case "serif":
+			if r.Null() {
+				v.Serif = nil
+			} else {
+				p := r.String()
+				v.Serif = &p
+			}
+		case "sansSerif":
+			if r.Null() {
+				v.SansSerif = nil
+			} else {
+				p := r.String()
+				v.SansSerif = &p
+			}
+		case "cursive":
+			if r.Null() {
+				v.Cursive = nil
+			} else {
+				p := r.String()
+				v.Cursive = &p
+			}
+		case "fantasy":
+			if r.Null() {
+				v.Fantasy = nil
+			} else {
+				p := r.String()
+				v.Fantasy = &p
+			}
+		case "math":
+			if r.Null() {
+				v.Math = nil
+			} else {
+				p := r.String()
+				v.Math = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScriptFontFamilies) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("script")
+	w.String(v.Script)
+	w.Key("fontFamilies")
+	v.FontFamilies.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScriptFontFamilies) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "script":
+			v.Script = r.String()
+		case "fontFamilies":
+			v.FontFamilies.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FontSizes) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Standard != nil {
+		w.Key("standard")
+		w.Int(*v.Standard)
+	}
+	if v.Fixed != nil {
+		w.Key("fixed")
+		w.Int(*v.Fixed)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FontSizes) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "standard":
+			if r.Null() {
+				v.Standard = nil
+			} else {
+				p := r.Int()
+				v.Standard = &p
+			}
+		case "fixed":
+			if r.Null() {
+				v.Fixed = nil
+			} else {
+				p := r.Int()
+				v.Fixed = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InstallabilityErrorArgument) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.String(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InstallabilityErrorArgument) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InstallabilityError) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("errorId")
+	w.String(v.ErrorID)
+	w.Key("errorArguments")
+	if v.ErrorArguments == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ErrorArguments {
+			v.ErrorArguments[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InstallabilityError) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "errorId":
+			v.ErrorID = r.String()
+		case "errorArguments":
+			if r.ArrayStart() {
+				v.ErrorArguments = make([]InstallabilityErrorArgument, 0, 4)
+				for r.ArrayNext() {
+					var e0 InstallabilityErrorArgument
+					e0.DecodeJSON(r)
+					v.ErrorArguments = append(v.ErrorArguments, e0)
+				}
+			} else {
+				v.ErrorArguments = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompilationCacheParams) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if v.Eager != nil {
+		w.Key("eager")
+		w.Bool(*v.Eager)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompilationCacheParams) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "eager":
+			if r.Null() {
+				v.Eager = nil
+			} else {
+				p := r.Bool()
+				v.Eager = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FileFilter) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	if len(v.Accepts) > 0 {
+		w.Key("accepts")
+		w.ArrayStart()
+		for i0 := range v.Accepts {
+			w.String(v.Accepts[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FileFilter) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "accepts":
+			if r.ArrayStart() {
+				v.Accepts = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.Accepts = append(v.Accepts, e0)
+				}
+			} else {
+				v.Accepts = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FileHandler) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("action")
+	w.String(v.Action)
+	w.Key("name")
+	w.String(v.Name)
+	if len(v.Icons) > 0 {
+		w.Key("icons")
+		w.ArrayStart()
+		for i0 := range v.Icons {
+			v.Icons[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Accepts) > 0 {
+		w.Key("accepts")
+		w.ArrayStart()
+		for i0 := range v.Accepts {
+			v.Accepts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("launchType")
+	w.String(v.LaunchType)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FileHandler) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "action":
+			v.Action = r.String()
+		case "name":
+			v.Name = r.String()
+		case "icons":
+			if r.ArrayStart() {
+				v.Icons = make([]ImageResource, 0, 4)
+				for r.ArrayNext() {
+					var e0 ImageResource
+					e0.DecodeJSON(r)
+					v.Icons = append(v.Icons, e0)
+				}
+			} else {
+				v.Icons = nil
+			}
+		case "accepts":
+			if r.ArrayStart() {
+				v.Accepts = make([]FileFilter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FileFilter
+					e0.DecodeJSON(r)
+					v.Accepts = append(v.Accepts, e0)
+				}
+			} else {
+				v.Accepts = nil
+			}
+		case "launchType":
+			v.LaunchType = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ImageResource) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if v.Sizes != nil {
+		w.Key("sizes")
+		w.String(*v.Sizes)
+	}
+	if v.Type != nil {
+		w.Key("type")
+		w.String(*v.Type)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ImageResource) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "sizes":
+			if r.Null() {
+				v.Sizes = nil
+			} else {
+				p := r.String()
+				v.Sizes = &p
+			}
+		case "type":
+			if r.Null() {
+				v.Type = nil
+			} else {
+				p := r.String()
+				v.Type = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LaunchHandler) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("clientMode")
+	w.String(v.ClientMode)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LaunchHandler) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "clientMode":
+			v.ClientMode = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ProtocolHandler) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("protocol")
+	w.String(v.Protocol)
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ProtocolHandler) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "protocol":
+			v.Protocol = r.String()
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RelatedApplication) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ID != nil {
+		w.Key("id")
+		w.String(*v.ID)
+	}
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RelatedApplication) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			if r.Null() {
+				v.ID = nil
+			} else {
+				p := r.String()
+				v.ID = &p
+			}
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScopeExtension) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("hasOriginWildcard")
+	w.Bool(v.HasOriginWildcard)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScopeExtension) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "hasOriginWildcard":
+			v.HasOriginWildcard = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Screenshot) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("image")
+	v.Image.EncodeJSON(w)
+	w.Key("formFactor")
+	w.String(v.FormFactor)
+	if v.Label != nil {
+		w.Key("label")
+		w.String(*v.Label)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Screenshot) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "image":
+			v.Image.DecodeJSON(r)
+		case "formFactor":
+			v.FormFactor = r.String()
+		case "label":
+			if r.Null() {
+				v.Label = nil
+			} else {
+				p := r.String()
+				v.Label = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ShareTarget) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("action")
+	w.String(v.Action)
+	w.Key("method")
+	w.String(v.Method)
+	w.Key("enctype")
+	w.String(v.Enctype)
+	if v.Title != nil {
+		w.Key("title")
+		w.String(*v.Title)
+	}
+	if v.Text != nil {
+		w.Key("text")
+		w.String(*v.Text)
+	}
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if len(v.Files) > 0 {
+		w.Key("files")
+		w.ArrayStart()
+		for i0 := range v.Files {
+			v.Files[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ShareTarget) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "action":
+			v.Action = r.String()
+		case "method":
+			v.Method = r.String()
+		case "enctype":
+			v.Enctype = r.String()
+		case "title":
+			if r.Null() {
+				v.Title = nil
+			} else {
+				p := r.String()
+				v.Title = &p
+			}
+		case "text":
+			if r.Null() {
+				v.Text = nil
+			} else {
+				p := r.String()
+				v.Text = &p
+			}
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "files":
+			if r.ArrayStart() {
+				v.Files = make([]FileFilter, 0, 4)
+				for r.ArrayNext() {
+					var e0 FileFilter
+					e0.DecodeJSON(r)
+					v.Files = append(v.Files, e0)
+				}
+			} else {
+				v.Files = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Shortcut) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Shortcut) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WebAppManifest) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.BackgroundColor != nil {
+		w.Key("backgroundColor")
+		w.String(*v.BackgroundColor)
+	}
+	if v.Description != nil {
+		w.Key("description")
+		w.String(*v.Description)
+	}
+	if v.Dir != nil {
+		w.Key("dir")
+		w.String(*v.Dir)
+	}
+	if v.Display != nil {
+		w.Key("display")
+		w.String(*v.Display)
+	}
+	if len(v.DisplayOverrides) > 0 {
+		w.Key("displayOverrides")
+		w.ArrayStart()
+		for i0 := range v.DisplayOverrides {
+			w.String(v.DisplayOverrides[i0])
+		}
+		w.ArrayEnd()
+	}
+	if len(v.FileHandlers) > 0 {
+		w.Key("fileHandlers")
+		w.ArrayStart()
+		for i0 := range v.FileHandlers {
+			v.FileHandlers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Icons) > 0 {
+		w.Key("icons")
+		w.ArrayStart()
+		for i0 := range v.Icons {
+			v.Icons[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ID != nil {
+		w.Key("id")
+		w.String(*v.ID)
+	}
+	if v.Lang != nil {
+		w.Key("lang")
+		w.String(*v.Lang)
+	}
+	if v.LaunchHandler != nil {
+		w.Key("launchHandler")
+		v.LaunchHandler.EncodeJSON(w)
+	}
+	if v.Name != nil {
+		w.Key("name")
+		w.String(*v.Name)
+	}
+	if v.Orientation != nil {
+		w.Key("orientation")
+		w.String(*v.Orientation)
+	}
+	if v.PreferRelatedApplications != nil {
+		w.Key("preferRelatedApplications")
+		w.Bool(*v.PreferRelatedApplications)
+	}
+	if len(v.ProtocolHandlers) > 0 {
+		w.Key("protocolHandlers")
+		w.ArrayStart()
+		for i0 := range v.ProtocolHandlers {
+			v.ProtocolHandlers[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.RelatedApplications) > 0 {
+		w.Key("relatedApplications")
+		w.ArrayStart()
+		for i0 := range v.RelatedApplications {
+			v.RelatedApplications[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Scope != nil {
+		w.Key("scope")
+		w.String(*v.Scope)
+	}
+	if len(v.ScopeExtensions) > 0 {
+		w.Key("scopeExtensions")
+		w.ArrayStart()
+		for i0 := range v.ScopeExtensions {
+			v.ScopeExtensions[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.Screenshots) > 0 {
+		w.Key("screenshots")
+		w.ArrayStart()
+		for i0 := range v.Screenshots {
+			v.Screenshots[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.ShareTarget != nil {
+		w.Key("shareTarget")
+		v.ShareTarget.EncodeJSON(w)
+	}
+	if v.ShortName != nil {
+		w.Key("shortName")
+		w.String(*v.ShortName)
+	}
+	if len(v.Shortcuts) > 0 {
+		w.Key("shortcuts")
+		w.ArrayStart()
+		for i0 := range v.Shortcuts {
+			v.Shortcuts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.StartURL != nil {
+		w.Key("startUrl")
+		w.String(*v.StartURL)
+	}
+	if v.ThemeColor != nil {
+		w.Key("themeColor")
+		w.String(*v.ThemeColor)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WebAppManifest) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "backgroundColor":
+			if r.Null() {
+				v.BackgroundColor = nil
+			} else {
+				p := r.String()
+				v.BackgroundColor = &p
+			}
+		case "description":
+			if r.Null() {
+				v.Description = nil
+			} else {
+				p := r.String()
+				v.Description = &p
+			}
+		case "dir":
+			if r.Null() {
+				v.Dir = nil
+			} else {
+				p := r.String()
+				v.Dir = &p
+			}
+		case "display":
+			if r.Null() {
+				v.Display = nil
+			} else {
+				p := r.String()
+				v.Display = &p
+			}
+		case "displayOverrides":
+			if r.ArrayStart() {
+				v.DisplayOverrides = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.DisplayOverrides = append(v.DisplayOverrides, e0)
+				}
+			} else {
+				v.DisplayOverrides = nil
+			}
+		case "fileHandlers":
+			if r.ArrayStart() {
+				v.FileHandlers = make([]FileHandler, 0, 4)
+				for r.ArrayNext() {
+					var e0 FileHandler
+					e0.DecodeJSON(r)
+					v.FileHandlers = append(v.FileHandlers, e0)
+				}
+			} else {
+				v.FileHandlers = nil
+			}
+		case "icons":
+			if r.ArrayStart() {
+				v.Icons = make([]ImageResource, 0, 4)
+				for r.ArrayNext() {
+					var e0 ImageResource
+					e0.DecodeJSON(r)
+					v.Icons = append(v.Icons, e0)
+				}
+			} else {
+				v.Icons = nil
+			}
+		case "id":
+			if r.Null() {
+				v.ID = nil
+			} else {
+				p := r.String()
+				v.ID = &p
+			}
+		case "lang":
+			if r.Null() {
+				v.Lang = nil
+			} else {
+				p := r.String()
+				v.Lang = &p
+			}
+		case "launchHandler":
+			if r.Null() {
+				v.LaunchHandler = nil
+			} else {
+				var p LaunchHandler
+				p.DecodeJSON(r)
+				v.LaunchHandler = &p
+			}
+		case "name":
+			if r.Null() {
+				v.Name = nil
+			} else {
+				p := r.String()
+				v.Name = &p
+			}
+		case "orientation":
+			if r.Null() {
+				v.Orientation = nil
+			} else {
+				p := r.String()
+				v.Orientation = &p
+			}
+		case "preferRelatedApplications":
+			if r.Null() {
+				v.PreferRelatedApplications = nil
+			} else {
+				p := r.Bool()
+				v.PreferRelatedApplications = &p
+			}
+		case "protocolHandlers":
+			if r.ArrayStart() {
+				v.ProtocolHandlers = make([]ProtocolHandler, 0, 4)
+				for r.ArrayNext() {
+					var e0 ProtocolHandler
+					e0.DecodeJSON(r)
+					v.ProtocolHandlers = append(v.ProtocolHandlers, e0)
+				}
+			} else {
+				v.ProtocolHandlers = nil
+			}
+		case "relatedApplications":
+			if r.ArrayStart() {
+				v.RelatedApplications = make([]RelatedApplication, 0, 4)
+				for r.ArrayNext() {
+					var e0 RelatedApplication
+					e0.DecodeJSON(r)
+					v.RelatedApplications = append(v.RelatedApplications, e0)
+				}
+			} else {
+				v.RelatedApplications = nil
+			}
+		case "scope":
+			if r.Null() {
+				v.Scope = nil
+			} else {
+				p := r.String()
+				v.Scope = &p
+			}
+		case "scopeExtensions":
+			if r.ArrayStart() {
+				v.ScopeExtensions = make([]ScopeExtension, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScopeExtension
+					e0.DecodeJSON(r)
+					v.ScopeExtensions = append(v.ScopeExtensions, e0)
+				}
+			} else {
+				v.ScopeExtensions = nil
+			}
+		case "screenshots":
+			if r.ArrayStart() {
+				v.Screenshots = make([]Screenshot, 0, 4)
+				for r.ArrayNext() {
+					var e0 Screenshot
+					e0.DecodeJSON(r)
+					v.Screenshots = append(v.Screenshots, e0)
+				}
+			} else {
+				v.Screenshots = nil
+			}
+		case "shareTarget":
+			if r.Null() {
+				v.ShareTarget = nil
+			} else {
+				var p ShareTarget
+				p.DecodeJSON(r)
+				v.ShareTarget = &p
+			}
+		case "shortName":
+			if r.Null() {
+				v.ShortName = nil
+			} else {
+				p := r.String()
+				v.ShortName = &p
+			}
+		case "shortcuts":
+			if r.ArrayStart() {
+				v.Shortcuts = make([]Shortcut, 0, 4)
+				for r.ArrayNext() {
+					var e0 Shortcut
+					e0.DecodeJSON(r)
+					v.Shortcuts = append(v.Shortcuts, e0)
+				}
+			} else {
+				v.Shortcuts = nil
+			}
+		case "startUrl":
+			if r.Null() {
+				v.StartURL = nil
+			} else {
+				p := r.String()
+				v.StartURL = &p
+			}
+		case "themeColor":
+			if r.Null() {
+				v.ThemeColor = nil
+			} else {
+				p := r.String()
+				v.ThemeColor = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BackForwardCacheBlockingDetails) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.Function != nil {
+		w.Key("function")
+		w.String(*v.Function)
+	}
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BackForwardCacheBlockingDetails) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "function":
+			if r.Null() {
+				v.Function = nil
+			} else {
+				p := r.String()
+				v.Function = &p
+			}
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BackForwardCacheNotRestoredExplanation) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("reason")
+	w.String(string(v.Reason))
+	if v.Context != nil {
+		w.Key("context")
+		w.String(*v.Context)
+	}
+	if len(v.Details) > 0 {
+		w.Key("details")
+		w.ArrayStart()
+		for i0 := range v.Details {
+			v.Details[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BackForwardCacheNotRestoredExplanation) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = BackForwardCacheNotRestoredReasonType(r.String())
+		case "reason":
+			v.Reason = BackForwardCacheNotRestoredReason(r.String())
+		case "context":
+			if r.Null() {
+				v.Context = nil
+			} else {
+				p := r.String()
+				v.Context = &p
+			}
+		case "details":
+			if r.ArrayStart() {
+				v.Details = make([]BackForwardCacheBlockingDetails, 0, 4)
+				for r.ArrayNext() {
+					var e0 BackForwardCacheBlockingDetails
+					e0.DecodeJSON(r)
+					v.Details = append(v.Details, e0)
+				}
+			} else {
+				v.Details = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BackForwardCacheNotRestoredExplanationTree) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("explanations")
+	if v.Explanations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Explanations {
+			v.Explanations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("children")
+	if v.Children == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Children {
+			v.Children[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BackForwardCacheNotRestoredExplanationTree) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "explanations":
+			if r.ArrayStart() {
+				v.Explanations = make([]BackForwardCacheNotRestoredExplanation, 0, 4)
+				for r.ArrayNext() {
+					var e0 BackForwardCacheNotRestoredExplanation
+					e0.DecodeJSON(r)
+					v.Explanations = append(v.Explanations, e0)
+				}
+			} else {
+				v.Explanations = nil
+			}
+		case "children":
+			if r.ArrayStart() {
+				v.Children = make([]BackForwardCacheNotRestoredExplanationTree, 0, 4)
+				for r.ArrayNext() {
+					var e0 BackForwardCacheNotRestoredExplanationTree
+					e0.DecodeJSON(r)
+					v.Children = append(v.Children, e0)
+				}
+			} else {
+				v.Children = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddScriptToEvaluateOnLoadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scriptSource")
+	w.String(v.ScriptSource)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddScriptToEvaluateOnLoadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scriptSource":
+			v.ScriptSource = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddScriptToEvaluateOnLoadReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddScriptToEvaluateOnLoadReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = ScriptIdentifier(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddScriptToEvaluateOnNewDocumentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("source")
+	w.String(v.Source)
+	if v.WorldName != nil {
+		w.Key("worldName")
+		w.String(*v.WorldName)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		w.Key("includeCommandLineAPI")
+		w.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.RunImmediately != nil {
+		w.Key("runImmediately")
+		w.Bool(*v.RunImmediately)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddScriptToEvaluateOnNewDocumentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "source":
+			v.Source = r.String()
+		case "worldName":
+			if r.Null() {
+				v.WorldName = nil
+			} else {
+				p := r.String()
+				v.WorldName = &p
+			}
+		case "includeCommandLineAPI":
+			if r.Null() {
+				v.IncludeCommandLineAPI = nil
+			} else {
+				p := r.Bool()
+				v.IncludeCommandLineAPI = &p
+			}
+		case "runImmediately":
+			if r.Null() {
+				v.RunImmediately = nil
+			} else {
+				p := r.Bool()
+				v.RunImmediately = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddScriptToEvaluateOnNewDocumentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddScriptToEvaluateOnNewDocumentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = ScriptIdentifier(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CaptureScreenshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Format != nil {
+		w.Key("format")
+		w.String(*v.Format)
+	}
+	if v.Quality != nil {
+		w.Key("quality")
+		w.Int(*v.Quality)
+	}
+	if v.Clip != nil {
+		w.Key("clip")
+		v.Clip.EncodeJSON(w)
+	}
+	if v.FromSurface != nil {
+		w.Key("fromSurface")
+		w.Bool(*v.FromSurface)
+	}
+	if v.CaptureBeyondViewport != nil {
+		w.Key("captureBeyondViewport")
+		w.Bool(*v.CaptureBeyondViewport)
+	}
+	if v.OptimizeForSpeed != nil {
+		w.Key("optimizeForSpeed")
+		w.Bool(*v.OptimizeForSpeed)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CaptureScreenshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "format":
+			if r.Null() {
+				v.Format = nil
+			} else {
+				p := r.String()
+				v.Format = &p
+			}
+		case "quality":
+			if r.Null() {
+				v.Quality = nil
+			} else {
+				p := r.Int()
+				v.Quality = &p
+			}
+		case "clip":
+			if r.Null() {
+				v.Clip = nil
+			} else {
+				var p Viewport
+				p.DecodeJSON(r)
+				v.Clip = &p
+			}
+		case "fromSurface":
+			if r.Null() {
+				v.FromSurface = nil
+			} else {
+				p := r.Bool()
+				v.FromSurface = &p
+			}
+		case "captureBeyondViewport":
+			if r.Null() {
+				v.CaptureBeyondViewport = nil
+			} else {
+				p := r.Bool()
+				v.CaptureBeyondViewport = &p
+			}
+		case "optimizeForSpeed":
+			if r.Null() {
+				v.OptimizeForSpeed = nil
+			} else {
+				p := r.Bool()
+				v.OptimizeForSpeed = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CaptureScreenshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.Base64(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CaptureScreenshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CaptureSnapshotArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Format != nil {
+		w.Key("format")
+		w.String(*v.Format)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CaptureSnapshotArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "format":
+			if r.Null() {
+				v.Format = nil
+			} else {
+				p := r.String()
+				v.Format = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CaptureSnapshotReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.String(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CaptureSnapshotReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateIsolatedWorldArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	if v.WorldName != nil {
+		w.Key("worldName")
+		w.String(*v.WorldName)
+	}
+	if v.GrantUniveralAccess != nil {
+		w.Key("grantUniveralAccess")
+		w.Bool(*v.GrantUniveralAccess)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateIsolatedWorldArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "worldName":
+			if r.Null() {
+				v.WorldName = nil
+			} else {
+				p := r.String()
+				v.WorldName = &p
+			}
+		case "grantUniveralAccess":
+			if r.Null() {
+				v.GrantUniveralAccess = nil
+			} else {
+				p := r.Bool()
+				v.GrantUniveralAccess = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CreateIsolatedWorldReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("executionContextId")
+	w.Int(int(v.ExecutionContextID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CreateIsolatedWorldReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "executionContextId":
+			v.ExecutionContextID = runtime.ExecutionContextID(r.Int())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.EnableFileChooserOpenedEvent != nil {
+		w.Key("enableFileChooserOpenedEvent")
+		w.Bool(*v.EnableFileChooserOpenedEvent)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enableFileChooserOpenedEvent":
+			if r.Null() {
+				v.EnableFileChooserOpenedEvent = nil
+			} else {
+				p := r.Bool()
+				v.EnableFileChooserOpenedEvent = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAppManifestArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.ManifestID != nil {
+		w.Key("manifestId")
+		w.String(*v.ManifestID)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAppManifestArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "manifestId":
+			if r.Null() {
+				v.ManifestID = nil
+			} else {
+				p := r.String()
+				v.ManifestID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAppManifestReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("errors")
+	if v.Errors == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Errors {
+			v.Errors[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.Data != nil {
+		w.Key("data")
+		w.String(*v.Data)
+	}
+	if v.Parsed != nil {
+		w.Key("parsed")
+		v.Parsed.EncodeJSON(w)
+	}
+	w.Key("manifest")
+	v.Manifest.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAppManifestReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "errors":
+			if r.ArrayStart() {
+				v.Errors = make([]AppManifestError, 0, 4)
+				for r.ArrayNext() {
+					var e0 AppManifestError
+					e0.DecodeJSON(r)
+					v.Errors = append(v.Errors, e0)
+				}
+			} else {
+				v.Errors = nil
+			}
+		case "data":
+			if r.Null() {
+				v.Data = nil
+			} else {
+				p := r.String()
+				v.Data = &p
+			}
+		case "parsed":
+			if r.Null() {
+				v.Parsed = nil
+			} else {
+				var p AppManifestParsedProperties
+				p.DecodeJSON(r)
+				v.Parsed = &p
+			}
+		case "manifest":
+			v.Manifest.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetInstallabilityErrorsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("installabilityErrors")
+	if v.InstallabilityErrors == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.InstallabilityErrors {
+			v.InstallabilityErrors[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetInstallabilityErrorsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "installabilityErrors":
+			if r.ArrayStart() {
+				v.InstallabilityErrors = make([]InstallabilityError, 0, 4)
+				for r.ArrayNext() {
+					var e0 InstallabilityError
+					e0.DecodeJSON(r)
+					v.InstallabilityErrors = append(v.InstallabilityErrors, e0)
+				}
+			} else {
+				v.InstallabilityErrors = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetManifestIconsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.PrimaryIcon != nil {
+		w.Key("primaryIcon")
+		w.String(*v.PrimaryIcon)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetManifestIconsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "primaryIcon":
+			if r.Null() {
+				v.PrimaryIcon = nil
+			} else {
+				p := r.String()
+				v.PrimaryIcon = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAppIDReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.AppID != nil {
+		w.Key("appId")
+		w.String(*v.AppID)
+	}
+	if v.RecommendedID != nil {
+		w.Key("recommendedId")
+		w.String(*v.RecommendedID)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAppIDReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "appId":
+			if r.Null() {
+				v.AppID = nil
+			} else {
+				p := r.String()
+				v.AppID = &p
+			}
+		case "recommendedId":
+			if r.Null() {
+				v.RecommendedID = nil
+			} else {
+				p := r.String()
+				v.RecommendedID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAdScriptAncestryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAdScriptAncestryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetAdScriptAncestryReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.AdScriptAncestry != nil {
+		w.Key("adScriptAncestry")
+		v.AdScriptAncestry.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetAdScriptAncestryReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "adScriptAncestry":
+			if r.Null() {
+				v.AdScriptAncestry = nil
+			} else {
+				var p AdScriptAncestry
+				p.DecodeJSON(r)
+				v.AdScriptAncestry = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFrameTreeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameTree")
+	v.FrameTree.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFrameTreeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameTree":
+			v.FrameTree.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetLayoutMetricsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("layoutViewport")
+	v.LayoutViewport.EncodeJSON(w)
+	w.Key("visualViewport")
+	v.VisualViewport.EncodeJSON(w)
+	w.Key("contentSize")
+	v.ContentSize.EncodeJSON(w)
+	w.Key("cssLayoutViewport")
+	v.CSSLayoutViewport.EncodeJSON(w)
+	w.Key("cssVisualViewport")
+	v.CSSVisualViewport.EncodeJSON(w)
+	w.Key("cssContentSize")
+	v.CSSContentSize.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetLayoutMetricsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "layoutViewport":
+			v.LayoutViewport.DecodeJSON(r)
+		case "visualViewport":
+			v.VisualViewport.DecodeJSON(r)
+		case "contentSize":
+			v.ContentSize.DecodeJSON(r)
+		case "cssLayoutViewport":
+			v.CSSLayoutViewport.DecodeJSON(r)
+		case "cssVisualViewport":
+			v.CSSVisualViewport.DecodeJSON(r)
+		case "cssContentSize":
+			v.CSSContentSize.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetNavigationHistoryReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("currentIndex")
+	w.Int(v.CurrentIndex)
+	w.Key("entries")
+	if v.Entries == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Entries {
+			v.Entries[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetNavigationHistoryReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "currentIndex":
+			v.CurrentIndex = r.Int()
+		case "entries":
+			if r.ArrayStart() {
+				v.Entries = make([]NavigationEntry, 0, 4)
+				for r.ArrayNext() {
+					var e0 NavigationEntry
+					e0.DecodeJSON(r)
+					v.Entries = append(v.Entries, e0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResourceContentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResourceContentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResourceContentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("content")
+	w.String(v.Content)
+	w.Key("base64Encoded")
+	w.Bool(v.Base64Encoded)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResourceContentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "content":
+			v.Content = r.String()
+		case "base64Encoded":
+			v.Base64Encoded = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetResourceTreeReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameTree")
+	v.FrameTree.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetResourceTreeReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameTree":
+			v.FrameTree.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *HandleJavaScriptDialogArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("accept")
+	w.Bool(v.Accept)
+	if v.PromptText != nil {
+		w.Key("promptText")
+		w.String(*v.PromptText)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *HandleJavaScriptDialogArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "accept":
+			v.Accept = r.Bool()
+		case "promptText":
+			if r.Null() {
+				v.PromptText = nil
+			} else {
+				p := r.String()
+				v.PromptText = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NavigateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	if v.Referrer != nil {
+		w.Key("referrer")
+		w.String(*v.Referrer)
+	}
+	if v.TransitionType != "" {
+		w.Key("transitionType")
+		w.String(string(v.TransitionType))
+	}
+	if v.FrameID != nil {
+		w.Key("frameId")
+		w.String(string(*v.FrameID))
+	}
+	if v.ReferrerPolicy != "" {
+		w.Key("referrerPolicy")
+		w.String(string(v.ReferrerPolicy))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NavigateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "referrer":
+			if r.Null() {
+				v.Referrer = nil
+			} else {
+				p := r.String()
+				v.Referrer = &p
+			}
+		case "transitionType":
+			v.TransitionType = TransitionType(r.String())
+		case "frameId":
+			if r.Null() {
+				v.FrameID = nil
+			} else {
+				p := FrameID(r.String())
+				v.FrameID = &p
+			}
+		case "referrerPolicy":
+			v.ReferrerPolicy = ReferrerPolicy(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NavigateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	if v.LoaderID != nil {
+		w.Key("loaderId")
+		w.String(string(*v.LoaderID))
+	}
+	if v.ErrorText != nil {
+		w.Key("errorText")
+		w.String(*v.ErrorText)
+	}
+	if v.IsDownload != nil {
+		w.Key("isDownload")
+		w.Bool(*v.IsDownload)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NavigateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "loaderId":
+			if r.Null() {
+				v.LoaderID = nil
+			} else {
+				p := network.LoaderID(r.String())
+				v.LoaderID = &p
+			}
+		case "errorText":
+			if r.Null() {
+				v.ErrorText = nil
+			} else {
+				p := r.String()
+				v.ErrorText = &p
+			}
+		case "isDownload":
+			if r.Null() {
+				v.IsDownload = nil
+			} else {
+				p := r.Bool()
+				v.IsDownload = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NavigateToHistoryEntryArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("entryId")
+	w.Int(v.EntryID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NavigateToHistoryEntryArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "entryId":
+			v.EntryID = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PrintToPDFArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Landscape != nil {
+		w.Key("landscape")
+		w.Bool(*v.Landscape)
+	}
+	if v.DisplayHeaderFooter != nil {
+		w.Key("displayHeaderFooter")
+		w.Bool(*v.DisplayHeaderFooter)
+	}
+	if v.PrintBackground != nil {
+		w.Key("printBackground")
+		w.Bool(*v.PrintBackground)
+	}
+	if v.Scale != nil {
+		w.Key("scale")
+		w.Float(*v.Scale)
+	}
+	if v.PaperWidth != nil {
+		w.Key("paperWidth")
+		w.Float(*v.PaperWidth)
+	}
+	if v.PaperHeight != nil {
+		w.Key("paperHeight")
+		w.Float(*v.PaperHeight)
+	}
+	if v.MarginTop != nil {
+		w.Key("marginTop")
+		w.Float(*v.MarginTop)
+	}
+	if v.MarginBottom != nil {
+		w.Key("marginBottom")
+		w.Float(*v.MarginBottom)
+	}
+	if v.MarginLeft != nil {
+		w.Key("marginLeft")
+		w.Float(*v.MarginLeft)
+	}
+	if v.MarginRight != nil {
+		w.Key("marginRight")
+		w.Float(*v.MarginRight)
+	}
+	if v.PageRanges != nil {
+		w.Key("pageRanges")
+		w.String(*v.PageRanges)
+	}
+	if v.HeaderTemplate != nil {
+		w.Key("headerTemplate")
+		w.String(*v.HeaderTemplate)
+	}
+	if v.FooterTemplate != nil {
+		w.Key("footerTemplate")
+		w.String(*v.FooterTemplate)
+	}
+	if v.PreferCSSPageSize != nil {
+		w.Key("preferCSSPageSize")
+		w.Bool(*v.PreferCSSPageSize)
+	}
+	if v.TransferMode != nil {
+		w.Key("transferMode")
+		w.String(*v.TransferMode)
+	}
+	if v.GenerateTaggedPDF != nil {
+		w.Key("generateTaggedPDF")
+		w.Bool(*v.GenerateTaggedPDF)
+	}
+	if v.GenerateDocumentOutline != nil {
+		w.Key("generateDocumentOutline")
+		w.Bool(*v.GenerateDocumentOutline)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PrintToPDFArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "landscape":
+			if r.Null() {
+				v.Landscape = nil
+			} else {
+				p := r.Bool()
+				v.Landscape = &p
+			}
+		case "displayHeaderFooter":
+			if r.Null() {
+				v.DisplayHeaderFooter = nil
+			} else {
+				p := r.Bool()
+				v.DisplayHeaderFooter = &p
+			}
+		case "printBackground":
+			if r.Null() {
+				v.PrintBackground = nil
+			} else {
+				p := r.Bool()
+				v.PrintBackground = &p
+			}
+		case "scale":
+			if r.Null() {
+				v.Scale = nil
+			} else {
+				p := r.Float()
+				v.Scale = &p
+			}
+		case "paperWidth":
+			if r.Null() {
+				v.PaperWidth = nil
+			} else {
+				p := r.Float()
+				v.PaperWidth = &p
+			}
+		case "paperHeight":
+			if r.Null() {
+				v.PaperHeight = nil
+			} else {
+				p := r.Float()
+				v.PaperHeight = &p
+			}
+		case "marginTop":
+			if r.Null() {
+				v.MarginTop = nil
+			} else {
+				p := r.Float()
+				v.MarginTop = &p
+			}
+		case "marginBottom":
+			if r.Null() {
+				v.MarginBottom = nil
+			} else {
+				p := r.Float()
+				v.MarginBottom = &p
+			}
+		case "marginLeft":
+			if r.Null() {
+				v.MarginLeft = nil
+			} else {
+				p := r.Float()
+				v.MarginLeft = &p
+			}
+		case "marginRight":
+			if r.Null() {
+				v.MarginRight = nil
+			} else {
+				p := r.Float()
+				v.MarginRight = &p
+			}
+		case "pageRanges":
+			if r.Null() {
+				v.PageRanges = nil
+			} else {
+				p := r.String()
+				v.PageRanges = &p
+			}
+		case "headerTemplate":
+			if r.Null() {
+				v.HeaderTemplate = nil
+			} else {
+				p := r.String()
+				v.HeaderTemplate = &p
+			}
+		case "footerTemplate":
+			if r.Null() {
+				v.FooterTemplate = nil
+			} else {
+				p := r.String()
+				v.FooterTemplate = &p
+			}
+		case "preferCSSPageSize":
+			if r.Null() {
+				v.PreferCSSPageSize = nil
+			} else {
+				p := r.Bool()
+				v.PreferCSSPageSize = &p
+			}
+		case "transferMode":
+			if r.Null() {
+				v.TransferMode = nil
+			} else {
+				p := r.String()
+				v.TransferMode = &p
+			}
+		case "generateTaggedPDF":
+			if r.Null() {
+				v.GenerateTaggedPDF = nil
+			} else {
+				p := r.Bool()
+				v.GenerateTaggedPDF = &p
+			}
+		case "generateDocumentOutline":
+			if r.Null() {
+				v.GenerateDocumentOutline = nil
+			} else {
+				p := r.Bool()
+				v.GenerateDocumentOutline = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PrintToPDFReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.Base64(v.Data)
+	if v.Stream != nil {
+		w.Key("stream")
+		w.String(string(*v.Stream))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PrintToPDFReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = r.Base64()
+		case "stream":
+			if r.Null() {
+				v.Stream = nil
+			} else {
+				p := io.StreamHandle(r.String())
+				v.Stream = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ReloadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.IgnoreCache != nil {
+		w.Key("ignoreCache")
+		w.Bool(*v.IgnoreCache)
+	}
+	if v.ScriptToEvaluateOnLoad != nil {
+		w.Key("scriptToEvaluateOnLoad")
+		w.String(*v.ScriptToEvaluateOnLoad)
+	}
+	if v.LoaderID != nil {
+		w.Key("loaderId")
+		w.String(string(*v.LoaderID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ReloadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ignoreCache":
+			if r.Null() {
+				v.IgnoreCache = nil
+			} else {
+				p := r.Bool()
+				v.IgnoreCache = &p
+			}
+		case "scriptToEvaluateOnLoad":
+			if r.Null() {
+				v.ScriptToEvaluateOnLoad = nil
+			} else {
+				p := r.String()
+				v.ScriptToEvaluateOnLoad = &p
+			}
+		case "loaderId":
+			if r.Null() {
+				v.LoaderID = nil
+			} else {
+				p := network.LoaderID(r.String())
+				v.LoaderID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveScriptToEvaluateOnLoadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveScriptToEvaluateOnLoadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = ScriptIdentifier(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RemoveScriptToEvaluateOnNewDocumentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("identifier")
+	w.String(string(v.Identifier))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RemoveScriptToEvaluateOnNewDocumentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "identifier":
+			v.Identifier = ScriptIdentifier(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreencastFrameAckArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("sessionId")
+	w.Int(v.SessionID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreencastFrameAckArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "sessionId":
+			v.SessionID = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchInResourceArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("query")
+	w.String(v.Query)
+	if v.CaseSensitive != nil {
+		w.Key("caseSensitive")
+		w.Bool(*v.CaseSensitive)
+	}
+	if v.IsRegex != nil {
+		w.Key("isRegex")
+		w.Bool(*v.IsRegex)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchInResourceArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "query":
+			v.Query = r.String()
+		case "caseSensitive":
+			if r.Null() {
+				v.CaseSensitive = nil
+			} else {
+				p := r.Bool()
+				v.CaseSensitive = &p
+			}
+		case "isRegex":
+			if r.Null() {
+				v.IsRegex = nil
+			} else {
+				p := r.Bool()
+				v.IsRegex = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SearchInResourceReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("result")
+	if v.Result == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Result {
+			v.Result[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SearchInResourceReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "result":
+			if r.ArrayStart() {
+				v.Result = make([]debugger.SearchMatch, 0, 4)
+				for r.ArrayNext() {
+					var e0 debugger.SearchMatch
+					e0.DecodeJSON(r)
+					v.Result = append(v.Result, e0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetAdBlockingEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetAdBlockingEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetBypassCSPArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetBypassCSPArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPermissionsPolicyStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPermissionsPolicyStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetPermissionsPolicyStateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("states")
+	if v.States == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.States {
+			v.States[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetPermissionsPolicyStateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "states":
+			if r.ArrayStart() {
+				v.States = make([]PermissionsPolicyFeatureState, 0, 4)
+				for r.ArrayNext() {
+					var e0 PermissionsPolicyFeatureState
+					e0.DecodeJSON(r)
+					v.States = append(v.States, e0)
+				}
+			} else {
+				v.States = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOriginTrialsArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOriginTrialsArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetOriginTrialsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("originTrials")
+	if v.OriginTrials == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.OriginTrials {
+			v.OriginTrials[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetOriginTrialsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "originTrials":
+			if r.ArrayStart() {
+				v.OriginTrials = make([]OriginTrial, 0, 4)
+				for r.ArrayNext() {
+					var e0 OriginTrial
+					e0.DecodeJSON(r)
+					v.OriginTrials = append(v.OriginTrials, e0)
+				}
+			} else {
+				v.OriginTrials = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetFontFamiliesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fontFamilies")
+	v.FontFamilies.EncodeJSON(w)
+	if len(v.ForScripts) > 0 {
+		w.Key("forScripts")
+		w.ArrayStart()
+		for i0 := range v.ForScripts {
+			v.ForScripts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetFontFamiliesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fontFamilies":
+			v.FontFamilies.DecodeJSON(r)
+		case "forScripts":
+			if r.ArrayStart() {
+				v.ForScripts = make([]ScriptFontFamilies, 0, 4)
+				for r.ArrayNext() {
+					var e0 ScriptFontFamilies
+					e0.DecodeJSON(r)
+					v.ForScripts = append(v.ForScripts, e0)
+				}
+			} else {
+				v.ForScripts = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetFontSizesArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("fontSizes")
+	v.FontSizes.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetFontSizesArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "fontSizes":
+			v.FontSizes.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDocumentContentArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("html")
+	w.String(v.HTML)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDocumentContentArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "html":
+			v.HTML = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetDownloadBehaviorArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("behavior")
+	w.String(v.Behavior)
+	if v.DownloadPath != nil {
+		w.Key("downloadPath")
+		w.String(*v.DownloadPath)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetDownloadBehaviorArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "behavior":
+			v.Behavior = r.String()
+		case "downloadPath":
+			if r.Null() {
+				v.DownloadPath = nil
+			} else {
+				p := r.String()
+				v.DownloadPath = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetLifecycleEventsEnabledArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetLifecycleEventsEnabledArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartScreencastArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.Format != nil {
+		w.Key("format")
+		w.String(*v.Format)
+	}
+	if v.Quality != nil {
+		w.Key("quality")
+		w.Int(*v.Quality)
+	}
+	if v.MaxWidth != nil {
+		w.Key("maxWidth")
+		w.Int(*v.MaxWidth)
+	}
+	if v.MaxHeight != nil {
+		w.Key("maxHeight")
+		w.Int(*v.MaxHeight)
+	}
+	if v.EveryNthFrame != nil {
+		w.Key("everyNthFrame")
+		w.Int(*v.EveryNthFrame)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartScreencastArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "format":
+			if r.Null() {
+				v.Format = nil
+			} else {
+				p := r.String()
+				v.Format = &p
+			}
+		case "quality":
+			if r.Null() {
+				v.Quality = nil
+			} else {
+				p := r.Int()
+				v.Quality = &p
+			}
+		case "maxWidth":
+			if r.Null() {
+				v.MaxWidth = nil
+			} else {
+				p := r.Int()
+				v.MaxWidth = &p
+			}
+		case "maxHeight":
+			if r.Null() {
+				v.MaxHeight = nil
+			} else {
+				p := r.Int()
+				v.MaxHeight = &p
+			}
+		case "everyNthFrame":
+			if r.Null() {
+				v.EveryNthFrame = nil
+			} else {
+				p := r.Int()
+				v.EveryNthFrame = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetWebLifecycleStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("state")
+	w.String(v.State)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetWebLifecycleStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "state":
+			v.State = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ProduceCompilationCacheArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scripts")
+	if v.Scripts == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Scripts {
+			v.Scripts[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ProduceCompilationCacheArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scripts":
+			if r.ArrayStart() {
+				v.Scripts = make([]CompilationCacheParams, 0, 4)
+				for r.ArrayNext() {
+					var e0 CompilationCacheParams
+					e0.DecodeJSON(r)
+					v.Scripts = append(v.Scripts, e0)
+				}
+			} else {
+				v.Scripts = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AddCompilationCacheArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("data")
+	w.Base64(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AddCompilationCacheArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "data":
+			v.Data = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetSPCTransactionModeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("mode")
+	w.String(v.Mode)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetSPCTransactionModeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "mode":
+			v.Mode = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetRPHRegistrationModeArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("mode")
+	w.String(v.Mode)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetRPHRegistrationModeArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "mode":
+			v.Mode = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GenerateTestReportArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("message")
+	w.String(v.Message)
+	if v.Group != nil {
+		w.Key("group")
+		w.String(*v.Group)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GenerateTestReportArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "message":
+			v.Message = r.String()
+		case "group":
+			if r.Null() {
+				v.Group = nil
+			} else {
+				p := r.String()
+				v.Group = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetInterceptFileChooserDialogArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("enabled")
+	w.Bool(v.Enabled)
+	if v.Cancel != nil {
+		w.Key("cancel")
+		w.Bool(*v.Cancel)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetInterceptFileChooserDialogArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "enabled":
+			v.Enabled = r.Bool()
+		case "cancel":
+			if r.Null() {
+				v.Cancel = nil
+			} else {
+				p := r.Bool()
+				v.Cancel = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetPrerenderingAllowedArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("isAllowed")
+	w.Bool(v.IsAllowed)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetPrerenderingAllowedArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "isAllowed":
+			v.IsAllowed = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DOMContentEventFiredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DOMContentEventFiredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FileChooserOpenedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("mode")
+	w.String(v.Mode)
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FileChooserOpenedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "mode":
+			v.Mode = r.String()
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameAttachedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("parentFrameId")
+	w.String(string(v.ParentFrameID))
+	if v.Stack != nil {
+		w.Key("stack")
+		v.Stack.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameAttachedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "parentFrameId":
+			v.ParentFrameID = FrameID(r.String())
+		case "stack":
+			if r.Null() {
+				v.Stack = nil
+			} else {
+				var p runtime.StackTrace
+				p.DecodeJSON(r)
+				v.Stack = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameClearedScheduledNavigationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameClearedScheduledNavigationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameDetachedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("reason")
+	w.String(v.Reason)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameDetachedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "reason":
+			v.Reason = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameSubtreeWillBeDetachedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameSubtreeWillBeDetachedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameNavigatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frame")
+	v.Frame.EncodeJSON(w)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameNavigatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frame":
+			v.Frame.DecodeJSON(r)
+		case "type":
+			v.Type = NavigationType(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DocumentOpenedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frame")
+	v.Frame.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DocumentOpenedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frame":
+			v.Frame.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameResizedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameResizedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameStartedNavigatingReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("navigationType")
+	w.String(v.NavigationType)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameStartedNavigatingReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "navigationType":
+			v.NavigationType = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameRequestedNavigationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("reason")
+	w.String(string(v.Reason))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("disposition")
+	w.String(string(v.Disposition))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameRequestedNavigationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "reason":
+			v.Reason = ClientNavigationReason(r.String())
+		case "url":
+			v.URL = r.String()
+		case "disposition":
+			v.Disposition = ClientNavigationDisposition(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameScheduledNavigationReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("delay")
+	w.Float(v.Delay)
+	w.Key("reason")
+	w.String(string(v.Reason))
+	w.Key("url")
+	w.String(v.URL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameScheduledNavigationReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "delay":
+			v.Delay = r.Float()
+		case "reason":
+			v.Reason = ClientNavigationReason(r.String())
+		case "url":
+			v.URL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameStartedLoadingReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameStartedLoadingReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *FrameStoppedLoadingReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *FrameStoppedLoadingReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DownloadWillBeginReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("guid")
+	w.String(v.GUID)
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("suggestedFilename")
+	w.String(v.SuggestedFilename)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DownloadWillBeginReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "guid":
+			v.GUID = r.String()
+		case "url":
+			v.URL = r.String()
+		case "suggestedFilename":
+			v.SuggestedFilename = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DownloadProgressReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("guid")
+	w.String(v.GUID)
+	w.Key("totalBytes")
+	w.Float(v.TotalBytes)
+	w.Key("receivedBytes")
+	w.Float(v.ReceivedBytes)
+	w.Key("state")
+	w.String(v.State)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DownloadProgressReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "guid":
+			v.GUID = r.String()
+		case "totalBytes":
+			v.TotalBytes = r.Float()
+		case "receivedBytes":
+			v.ReceivedBytes = r.Float()
+		case "state":
+			v.State = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InterstitialHiddenReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InterstitialHiddenReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *InterstitialShownReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *InterstitialShownReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *JavascriptDialogClosedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("result")
+	w.Bool(v.Result)
+	w.Key("userInput")
+	w.String(v.UserInput)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *JavascriptDialogClosedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "result":
+			v.Result = r.Bool()
+		case "userInput":
+			v.UserInput = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *JavascriptDialogOpeningReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("message")
+	w.String(v.Message)
+	w.Key("type")
+	w.String(string(v.Type))
+	w.Key("hasBrowserHandler")
+	w.Bool(v.HasBrowserHandler)
+	if v.DefaultPrompt != nil {
+		w.Key("defaultPrompt")
+		w.String(*v.DefaultPrompt)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *JavascriptDialogOpeningReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "message":
+			v.Message = r.String()
+		case "type":
+			v.Type = DialogType(r.String())
+		case "hasBrowserHandler":
+			v.HasBrowserHandler = r.Bool()
+		case "defaultPrompt":
+			if r.Null() {
+				v.DefaultPrompt = nil
+			} else {
+				p := r.String()
+				v.DefaultPrompt = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LifecycleEventReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LifecycleEventReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "name":
+			v.Name = r.String()
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *BackForwardCacheNotUsedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("notRestoredExplanations")
+	if v.NotRestoredExplanations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NotRestoredExplanations {
+			v.NotRestoredExplanations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if v.NotRestoredExplanationsTree != nil {
+		w.Key("notRestoredExplanationsTree")
+		v.NotRestoredExplanationsTree.EncodeJSON(w)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *BackForwardCacheNotUsedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "notRestoredExplanations":
+			if r.ArrayStart() {
+				v.NotRestoredExplanations = make([]BackForwardCacheNotRestoredExplanation, 0, 4)
+				for r.ArrayNext() {
+					var e0 BackForwardCacheNotRestoredExplanation
+					e0.DecodeJSON(r)
+					v.NotRestoredExplanations = append(v.NotRestoredExplanations, e0)
+				}
+			} else {
+				v.NotRestoredExplanations = nil
+			}
+		case "notRestoredExplanationsTree":
+			if r.Null() {
+				v.NotRestoredExplanationsTree = nil
+			} else {
+				var p BackForwardCacheNotRestoredExplanationTree
+				p.DecodeJSON(r)
+				v.NotRestoredExplanationsTree = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *LoadEventFiredReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timestamp")
+	w.Marshal(v.Timestamp)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *LoadEventFiredReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timestamp":
+			r.Decode(&v.Timestamp)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *NavigatedWithinDocumentReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("frameId")
+	w.String(string(v.FrameID))
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("navigationType")
+	w.String(v.NavigationType)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *NavigatedWithinDocumentReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "frameId":
+			v.FrameID = FrameID(r.String())
+		case "url":
+			v.URL = r.String()
+		case "navigationType":
+			v.NavigationType = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreencastFrameReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("data")
+	w.Base64(v.Data)
+	w.Key("metadata")
+	v.Metadata.EncodeJSON(w)
+	w.Key("sessionId")
+	w.Int(v.SessionID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreencastFrameReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "data":
+			v.Data = r.Base64()
+		case "metadata":
+			v.Metadata.DecodeJSON(r)
+		case "sessionId":
+			v.SessionID = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ScreencastVisibilityChangedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("visible")
+	w.Bool(v.Visible)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ScreencastVisibilityChangedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "visible":
+			v.Visible = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WindowOpenReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("windowName")
+	w.String(v.WindowName)
+	w.Key("windowFeatures")
+	if v.WindowFeatures == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.WindowFeatures {
+			w.String(v.WindowFeatures[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("userGesture")
+	w.Bool(v.UserGesture)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WindowOpenReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "windowName":
+			v.WindowName = r.String()
+		case "windowFeatures":
+			if r.ArrayStart() {
+				v.WindowFeatures = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.WindowFeatures = append(v.WindowFeatures, e0)
+				}
+			} else {
+				v.WindowFeatures = nil
+			}
+		case "userGesture":
+			v.UserGesture = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *CompilationCacheProducedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("url")
+	w.String(v.URL)
+	w.Key("data")
+	w.Base64(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *CompilationCacheProducedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "url":
+			v.URL = r.String()
+		case "data":
+			v.Data = r.Base64()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performance
+
+import (
+	"errors"
+	"strconv"
+)
+
+// EnableArgs represents the arguments for Enable in the Performance domain.
+type EnableArgs struct {
+	// TimeDomain Time domain to use for collecting and reporting duration
+	// metrics.
+	//
+	// Values: "timeTicks", "threadTicks".
+	TimeDomain *string `json:"timeDomain,omitempty"`
+}
+
+// NewEnableArgs initializes EnableArgs with the required arguments.
+func NewEnableArgs() *EnableArgs {
+	args := new(EnableArgs)
+
+	return args
+}
+
+// SetTimeDomain sets the TimeDomain optional argument. Time domain to
+// use for collecting and reporting duration metrics.
+//
+// Values: "timeTicks", "threadTicks".
+func (a *EnableArgs) SetTimeDomain(timeDomain string) *EnableArgs {
+	a.TimeDomain = &timeDomain
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	if a.TimeDomain != nil {
+		switch *a.TimeDomain {
+		case "timeTicks", "threadTicks":
+		default:
+			return errors.New("performance.EnableArgs: TimeDomain: invalid value " + strconv.Quote(*a.TimeDomain))
+		}
+	}
+	return nil
+}
+
+// SetTimeDomainArgs represents the arguments for SetTimeDomain in the Performance domain.
+type SetTimeDomainArgs struct {
+	// TimeDomain Time domain
+	//
+	// Values: "timeTicks", "threadTicks".
+	TimeDomain string `json:"timeDomain"`
+}
+
+// NewSetTimeDomainArgs initializes SetTimeDomainArgs with the required arguments.
+func NewSetTimeDomainArgs(timeDomain string) *SetTimeDomainArgs {
+	args := new(SetTimeDomainArgs)
+	args.TimeDomain = timeDomain
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetTimeDomainArgs) Validate() error {
+	switch a.TimeDomain {
+	case "timeTicks", "threadTicks":
+	default:
+		return errors.New("performance.SetTimeDomainArgs: TimeDomain: invalid value " + strconv.Quote(a.TimeDomain))
+	}
+	return nil
+}
+
+// GetMetricsReply represents the return values for GetMetrics in the Performance domain.
+type GetMetricsReply struct {
+	Metrics []Metric `json:"metrics"` // Current values for run-time metrics.
+}
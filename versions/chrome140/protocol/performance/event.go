@@ -0,0 +1,22 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performance
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// MetricsClient is a client for Metrics events. Current values of the
+// metrics.
+type MetricsClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*MetricsReply, error)
+	rpcc.Stream
+}
+
+// MetricsReply is the reply for Metrics events.
+type MetricsReply struct {
+	Metrics []Metric `json:"metrics"` // Current values of the metrics.
+	Title   string   `json:"title"`   // Timestamp title.
+}
@@ -0,0 +1,171 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performance
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Metric) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("value")
+	w.Float(v.Value)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Metric) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "value":
+			v.Value = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnableArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	if v.TimeDomain != nil {
+		w.Key("timeDomain")
+		w.String(*v.TimeDomain)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnableArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timeDomain":
+			if r.Null() {
+				v.TimeDomain = nil
+			} else {
+				p := r.String()
+				v.TimeDomain = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetTimeDomainArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("timeDomain")
+	w.String(v.TimeDomain)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetTimeDomainArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "timeDomain":
+			v.TimeDomain = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetMetricsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("metrics")
+	if v.Metrics == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Metrics {
+			v.Metrics[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetMetricsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "metrics":
+			if r.ArrayStart() {
+				v.Metrics = make([]Metric, 0, 4)
+				for r.ArrayNext() {
+					var e0 Metric
+					e0.DecodeJSON(r)
+					v.Metrics = append(v.Metrics, e0)
+				}
+			} else {
+				v.Metrics = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *MetricsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("metrics")
+	if v.Metrics == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Metrics {
+			v.Metrics[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("title")
+	w.String(v.Title)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *MetricsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "metrics":
+			if r.ArrayStart() {
+				v.Metrics = make([]Metric, 0, 4)
+				for r.ArrayNext() {
+					var e0 Metric
+					e0.DecodeJSON(r)
+					v.Metrics = append(v.Metrics, e0)
+				}
+			} else {
+				v.Metrics = nil
+			}
+		case "title":
+			v.Title = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performance
+
+// Metric Run-time execution metric.
+type Metric struct {
+	Name  string  `json:"name"`  // Metric name.
+	Value float64 `json:"value"` // Metric value.
+}
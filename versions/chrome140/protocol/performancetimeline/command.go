@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performancetimeline
+
+import "errors"
+
+// EnableArgs represents the arguments for Enable in the PerformanceTimeline domain.
+type EnableArgs struct {
+	EventTypes []string `json:"eventTypes"` // The types of event to report, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype The specified filter overrides any previous filters, passing empty filter disables recording. Note that not all types exposed to the web platform are currently supported.
+}
+
+// NewEnableArgs initializes EnableArgs with the required arguments.
+func NewEnableArgs(eventTypes []string) *EnableArgs {
+	args := new(EnableArgs)
+	args.EventTypes = eventTypes
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EnableArgs) Validate() error {
+	if a.EventTypes == nil {
+		return errors.New("performancetimeline.EnableArgs: EventTypes is required")
+	}
+	return nil
+}
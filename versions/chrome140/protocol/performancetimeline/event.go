@@ -0,0 +1,22 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package performancetimeline
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// TimelineEventAddedClient is a client for TimelineEventAdded events. Sent
+// when a performance timeline event is added. See reportPerformanceTimeline
+// method.
+type TimelineEventAddedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*TimelineEventAddedReply, error)
+	rpcc.Stream
+}
+
+// TimelineEventAddedReply is the reply for TimelineEventAdded events.
+type TimelineEventAddedReply struct {
+	Event TimelineEvent `json:"event"` // No description.
+}
@@ -0,0 +1,479 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/dom"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/network"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/page"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RuleSet) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(string(v.ID))
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("sourceText")
+	w.String(v.SourceText)
+	if v.BackendNodeID != nil {
+		w.Key("backendNodeId")
+		w.Int(int(*v.BackendNodeID))
+	}
+	if v.URL != nil {
+		w.Key("url")
+		w.String(*v.URL)
+	}
+	if v.RequestID != nil {
+		w.Key("requestId")
+		w.String(string(*v.RequestID))
+	}
+	if v.ErrorType != "" {
+		w.Key("errorType")
+		w.String(string(v.ErrorType))
+	}
+	if v.ErrorMessage != nil {
+		w.Key("errorMessage")
+		w.String(*v.ErrorMessage)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RuleSet) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = RuleSetID(r.String())
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "sourceText":
+			v.SourceText = r.String()
+		case "backendNodeId":
+			if r.Null() {
+				v.BackendNodeID = nil
+			} else {
+				p := dom.BackendNodeID(r.Int())
+				v.BackendNodeID = &p
+			}
+		case "url":
+			if r.Null() {
+				v.URL = nil
+			} else {
+				p := r.String()
+				v.URL = &p
+			}
+		case "requestId":
+			if r.Null() {
+				v.RequestID = nil
+			} else {
+				p := network.RequestID(r.String())
+				v.RequestID = &p
+			}
+		case "errorType":
+			v.ErrorType = RuleSetErrorType(r.String())
+		case "errorMessage":
+			if r.Null() {
+				v.ErrorMessage = nil
+			} else {
+				p := r.String()
+				v.ErrorMessage = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttemptKey) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("action")
+	w.String(string(v.Action))
+	w.Key("url")
+	w.String(v.URL)
+	if v.TargetHint != "" {
+		w.Key("targetHint")
+		w.String(string(v.TargetHint))
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttemptKey) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "action":
+			v.Action = SpeculationAction(r.String())
+		case "url":
+			v.URL = r.String()
+		case "targetHint":
+			v.TargetHint = SpeculationTargetHint(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttemptSource) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	v.Key.EncodeJSON(w)
+	w.Key("ruleSetIds")
+	if v.RuleSetIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.RuleSetIDs {
+			w.String(string(v.RuleSetIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.Key("nodeIds")
+	if v.NodeIDs == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.NodeIDs {
+			w.Int(int(v.NodeIDs[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttemptSource) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key.DecodeJSON(r)
+		case "ruleSetIds":
+			if r.ArrayStart() {
+				v.RuleSetIDs = make([]RuleSetID, 0, 4)
+				for r.ArrayNext() {
+					e0 := RuleSetID(r.String())
+					v.RuleSetIDs = append(v.RuleSetIDs, e0)
+				}
+			} else {
+				v.RuleSetIDs = nil
+			}
+		case "nodeIds":
+			if r.ArrayStart() {
+				v.NodeIDs = make([]dom.BackendNodeID, 0, 4)
+				for r.ArrayNext() {
+					e0 := dom.BackendNodeID(r.Int())
+					v.NodeIDs = append(v.NodeIDs, e0)
+				}
+			} else {
+				v.NodeIDs = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PrerenderMismatchedHeaders) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("headerName")
+	w.String(v.HeaderName)
+	if v.InitialValue != nil {
+		w.Key("initialValue")
+		w.String(*v.InitialValue)
+	}
+	if v.ActivationValue != nil {
+		w.Key("activationValue")
+		w.String(*v.ActivationValue)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PrerenderMismatchedHeaders) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "headerName":
+			v.HeaderName = r.String()
+		case "initialValue":
+			if r.Null() {
+				v.InitialValue = nil
+			} else {
+				p := r.String()
+				v.InitialValue = &p
+			}
+		case "activationValue":
+			if r.Null() {
+				v.ActivationValue = nil
+			} else {
+				p := r.String()
+				v.ActivationValue = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RuleSetUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("ruleSet")
+	v.RuleSet.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RuleSetUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "ruleSet":
+			v.RuleSet.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *RuleSetRemovedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("id")
+	w.String(string(v.ID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *RuleSetRemovedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "id":
+			v.ID = RuleSetID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *EnabledStateUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("disabledByPreference")
+	w.Bool(v.DisabledByPreference)
+	w.Key("disabledByDataSaver")
+	w.Bool(v.DisabledByDataSaver)
+	w.Key("disabledByBatterySaver")
+	w.Bool(v.DisabledByBatterySaver)
+	w.Key("disabledByHoldbackPrefetchSpeculationRules")
+	w.Bool(v.DisabledByHoldbackPrefetchSpeculationRules)
+	w.Key("disabledByHoldbackPrerenderSpeculationRules")
+	w.Bool(v.DisabledByHoldbackPrerenderSpeculationRules)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *EnabledStateUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "disabledByPreference":
+			v.DisabledByPreference = r.Bool()
+		case "disabledByDataSaver":
+			v.DisabledByDataSaver = r.Bool()
+		case "disabledByBatterySaver":
+			v.DisabledByBatterySaver = r.Bool()
+		case "disabledByHoldbackPrefetchSpeculationRules":
+			v.DisabledByHoldbackPrefetchSpeculationRules = r.Bool()
+		case "disabledByHoldbackPrerenderSpeculationRules":
+			v.DisabledByHoldbackPrerenderSpeculationRules = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PrefetchStatusUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	v.Key.EncodeJSON(w)
+	w.Key("pipelineId")
+	w.String(string(v.PipelineID))
+	w.Key("initiatingFrameId")
+	w.String(string(v.InitiatingFrameID))
+	w.Key("prefetchUrl")
+	w.String(v.PrefetchURL)
+	w.Key("status")
+	w.String(string(v.Status))
+	w.Key("prefetchStatus")
+	w.String(string(v.PrefetchStatus))
+	w.Key("requestId")
+	w.String(string(v.RequestID))
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PrefetchStatusUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key.DecodeJSON(r)
+		case "pipelineId":
+			v.PipelineID = PipelineID(r.String())
+		case "initiatingFrameId":
+			v.InitiatingFrameID = page.FrameID(r.String())
+		case "prefetchUrl":
+			v.PrefetchURL = r.String()
+		case "status":
+			v.Status = Status(r.String())
+		case "prefetchStatus":
+			v.PrefetchStatus = PrefetchStatus(r.String())
+		case "requestId":
+			v.RequestID = network.RequestID(r.String())
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *PrerenderStatusUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("key")
+	v.Key.EncodeJSON(w)
+	w.Key("pipelineId")
+	w.String(string(v.PipelineID))
+	w.Key("status")
+	w.String(string(v.Status))
+	if v.PrerenderStatus != "" {
+		w.Key("prerenderStatus")
+		w.String(string(v.PrerenderStatus))
+	}
+	if v.DisallowedMojoInterface != nil {
+		w.Key("disallowedMojoInterface")
+		w.String(*v.DisallowedMojoInterface)
+	}
+	if len(v.MismatchedHeaders) > 0 {
+		w.Key("mismatchedHeaders")
+		w.ArrayStart()
+		for i0 := range v.MismatchedHeaders {
+			v.MismatchedHeaders[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *PrerenderStatusUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "key":
+			v.Key.DecodeJSON(r)
+		case "pipelineId":
+			v.PipelineID = PipelineID(r.String())
+		case "status":
+			v.Status = Status(r.String())
+		case "prerenderStatus":
+			v.PrerenderStatus = PrerenderFinalStatus(r.String())
+		case "disallowedMojoInterface":
+			if r.Null() {
+				v.DisallowedMojoInterface = nil
+			} else {
+				p := r.String()
+				v.DisallowedMojoInterface = &p
+			}
+		case "mismatchedHeaders":
+			if r.ArrayStart() {
+				v.MismatchedHeaders = make([]PrerenderMismatchedHeaders, 0, 4)
+				for r.ArrayNext() {
+					var e0 PrerenderMismatchedHeaders
+					e0.DecodeJSON(r)
+					v.MismatchedHeaders = append(v.MismatchedHeaders, e0)
+				}
+			} else {
+				v.MismatchedHeaders = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *AttemptSourcesUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("loaderId")
+	w.String(string(v.LoaderID))
+	w.Key("preloadingAttemptSources")
+	if v.PreloadingAttemptSources == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.PreloadingAttemptSources {
+			v.PreloadingAttemptSources[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *AttemptSourcesUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "loaderId":
+			v.LoaderID = network.LoaderID(r.String())
+		case "preloadingAttemptSources":
+			if r.ArrayStart() {
+				v.PreloadingAttemptSources = make([]AttemptSource, 0, 4)
+				for r.ArrayNext() {
+					var e0 AttemptSource
+					e0.DecodeJSON(r)
+					v.PreloadingAttemptSources = append(v.PreloadingAttemptSources, e0)
+				}
+			} else {
+				v.PreloadingAttemptSources = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
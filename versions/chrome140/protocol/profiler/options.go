@@ -0,0 +1,36 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package profiler
+
+// StartPreciseCoverageOption sets an optional argument of StartPreciseCoverageArgs.
+type StartPreciseCoverageOption func(*StartPreciseCoverageArgs)
+
+// NewStartPreciseCoverageArgsWith initializes StartPreciseCoverageArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewStartPreciseCoverageArgsWith(opts ...StartPreciseCoverageOption) *StartPreciseCoverageArgs {
+	args := NewStartPreciseCoverageArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// StartPreciseCoverageWithCallCount sets the CallCount optional argument, see
+// StartPreciseCoverageArgs.SetCallCount.
+func StartPreciseCoverageWithCallCount(v bool) StartPreciseCoverageOption {
+	return func(a *StartPreciseCoverageArgs) { a.SetCallCount(v) }
+}
+
+// StartPreciseCoverageWithDetailed sets the Detailed optional argument, see
+// StartPreciseCoverageArgs.SetDetailed.
+func StartPreciseCoverageWithDetailed(v bool) StartPreciseCoverageOption {
+	return func(a *StartPreciseCoverageArgs) { a.SetDetailed(v) }
+}
+
+// StartPreciseCoverageWithAllowTriggeredUpdates sets the AllowTriggeredUpdates optional argument, see
+// StartPreciseCoverageArgs.SetAllowTriggeredUpdates.
+func StartPreciseCoverageWithAllowTriggeredUpdates(v bool) StartPreciseCoverageOption {
+	return func(a *StartPreciseCoverageArgs) { a.SetAllowTriggeredUpdates(v) }
+}
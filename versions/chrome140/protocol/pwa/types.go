@@ -0,0 +1,40 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package pwa
+
+// FileHandlerAccept The following types are the replica of
+// https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+type FileHandlerAccept struct {
+	MediaType      string   `json:"mediaType"`      // New name of the mimetype according to https://www.iana.org/assignments/media-types/media-types.xhtml
+	FileExtensions []string `json:"fileExtensions"` // No description.
+}
+
+// FileHandler
+type FileHandler struct {
+	Action      string              `json:"action"`      // No description.
+	Accepts     []FileHandlerAccept `json:"accepts"`     // No description.
+	DisplayName string              `json:"displayName"` // No description.
+}
+
+// DisplayMode If user prefers opening the app in browser or an app window.
+type DisplayMode string
+
+// DisplayMode as enums.
+const (
+	DisplayModeNotSet     DisplayMode = ""
+	DisplayModeStandalone DisplayMode = "standalone"
+	DisplayModeBrowser    DisplayMode = "browser"
+)
+
+func (e DisplayMode) Valid() bool {
+	switch e {
+	case "standalone", "browser":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e DisplayMode) String() string {
+	return string(e)
+}
@@ -0,0 +1,891 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package runtime
+
+import "errors"
+
+// AwaitPromiseArgs represents the arguments for AwaitPromise in the Runtime domain.
+type AwaitPromiseArgs struct {
+	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`           // Identifier of the promise.
+	ReturnByValue   *bool          `json:"returnByValue,omitempty"`   // Whether the result is expected to be a JSON object that should be sent by value.
+	GeneratePreview *bool          `json:"generatePreview,omitempty"` // Whether preview should be generated for the result.
+}
+
+// NewAwaitPromiseArgs initializes AwaitPromiseArgs with the required arguments.
+func NewAwaitPromiseArgs(promiseObjectID RemoteObjectID) *AwaitPromiseArgs {
+	args := new(AwaitPromiseArgs)
+	args.PromiseObjectID = promiseObjectID
+	return args
+}
+
+// SetReturnByValue sets the ReturnByValue optional argument. Whether
+// the result is expected to be a JSON object that should be sent by
+// value.
+func (a *AwaitPromiseArgs) SetReturnByValue(returnByValue bool) *AwaitPromiseArgs {
+	a.ReturnByValue = &returnByValue
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the result.
+func (a *AwaitPromiseArgs) SetGeneratePreview(generatePreview bool) *AwaitPromiseArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AwaitPromiseArgs) Validate() error {
+	return nil
+}
+
+// AwaitPromiseReply represents the return values for AwaitPromise in the Runtime domain.
+type AwaitPromiseReply struct {
+	Result           RemoteObject      `json:"result"`                     // Promise result. Will contain rejected value if promise was rejected.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details if stack strace is available.
+}
+
+// CallFunctionOnArgs represents the arguments for CallFunctionOn in the Runtime domain.
+type CallFunctionOnArgs struct {
+	FunctionDeclaration string          `json:"functionDeclaration"`     // Declaration of the function to call.
+	ObjectID            *RemoteObjectID `json:"objectId,omitempty"`      // Identifier of the object to call function on. Either objectId or executionContextId should be specified.
+	Arguments           []CallArgument  `json:"arguments,omitempty"`     // Call arguments. All call arguments must belong to the same JavaScript world as the target object.
+	Silent              *bool           `json:"silent,omitempty"`        // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	ReturnByValue       *bool           `json:"returnByValue,omitempty"` // Whether the result is expected to be a JSON object which should be sent by value. Can be overridden by `serializationOptions`.
+	// GeneratePreview Whether preview should be generated for the result.
+	//
+	// Note: This property is experimental.
+	GeneratePreview    *bool               `json:"generatePreview,omitempty"`
+	UserGesture        *bool               `json:"userGesture,omitempty"`        // Whether execution should be treated as initiated by user in the UI.
+	AwaitPromise       *bool               `json:"awaitPromise,omitempty"`       // Whether execution should `await` for resulting value and return once awaited promise is resolved.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"` // Specifies execution context which global object will be used to call function on. Either executionContextId or objectId should be specified.
+	ObjectGroup        *string             `json:"objectGroup,omitempty"`        // Symbolic group name that can be used to release multiple objects. If objectGroup is not specified and objectId is, objectGroup will be inherited from object.
+	// ThrowOnSideEffect Whether to throw an exception if side effect
+	// cannot be ruled out during evaluation.
+	//
+	// Note: This property is experimental.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// UniqueContextID An alternative way to specify the execution context
+	// to call function on. Compared to contextId that may be reused across
+	// processes, this is guaranteed to be system-unique, so it can be used
+	// to prevent accidental function call in context different than
+	// intended (e.g. as a result of navigation across process boundaries).
+	// This is mutually exclusive with `executionContextId`.
+	//
+	// Note: This property is experimental.
+	UniqueContextID *string `json:"uniqueContextId,omitempty"`
+	// SerializationOptions Specifies the result serialization. If
+	// provided, overrides `generatePreview` and `returnByValue`.
+	//
+	// Note: This property is experimental.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// NewCallFunctionOnArgs initializes CallFunctionOnArgs with the required arguments.
+func NewCallFunctionOnArgs(functionDeclaration string) *CallFunctionOnArgs {
+	args := new(CallFunctionOnArgs)
+	args.FunctionDeclaration = functionDeclaration
+	return args
+}
+
+// SetObjectID sets the ObjectID optional argument. Identifier of the
+// object to call function on. Either objectId or executionContextId
+// should be specified.
+func (a *CallFunctionOnArgs) SetObjectID(objectID RemoteObjectID) *CallFunctionOnArgs {
+	a.ObjectID = &objectID
+	return a
+}
+
+// SetArguments sets the Arguments optional argument. Call arguments.
+// All call arguments must belong to the same JavaScript world as the
+// target object.
+func (a *CallFunctionOnArgs) SetArguments(arguments []CallArgument) *CallFunctionOnArgs {
+	a.Arguments = arguments
+	return a
+}
+
+// SetSilent sets the Silent optional argument. In silent mode
+// exceptions thrown during evaluation are not reported and do not
+// pause execution. Overrides `setPauseOnException` state.
+func (a *CallFunctionOnArgs) SetSilent(silent bool) *CallFunctionOnArgs {
+	a.Silent = &silent
+	return a
+}
+
+// SetReturnByValue sets the ReturnByValue optional argument. Whether
+// the result is expected to be a JSON object which should be sent by
+// value. Can be overridden by `serializationOptions`.
+func (a *CallFunctionOnArgs) SetReturnByValue(returnByValue bool) *CallFunctionOnArgs {
+	a.ReturnByValue = &returnByValue
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the result.
+//
+// Note: This property is experimental.
+func (a *CallFunctionOnArgs) SetGeneratePreview(generatePreview bool) *CallFunctionOnArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// SetUserGesture sets the UserGesture optional argument. Whether
+// execution should be treated as initiated by user in the UI.
+func (a *CallFunctionOnArgs) SetUserGesture(userGesture bool) *CallFunctionOnArgs {
+	a.UserGesture = &userGesture
+	return a
+}
+
+// SetAwaitPromise sets the AwaitPromise optional argument. Whether
+// execution should `await` for resulting value and return once awaited
+// promise is resolved.
+func (a *CallFunctionOnArgs) SetAwaitPromise(awaitPromise bool) *CallFunctionOnArgs {
+	a.AwaitPromise = &awaitPromise
+	return a
+}
+
+// SetExecutionContextID sets the ExecutionContextID optional argument.
+// Specifies execution context which global object will be used to call
+// function on. Either executionContextId or objectId should be
+// specified.
+func (a *CallFunctionOnArgs) SetExecutionContextID(executionContextID ExecutionContextID) *CallFunctionOnArgs {
+	a.ExecutionContextID = &executionContextID
+	return a
+}
+
+// SetObjectGroup sets the ObjectGroup optional argument. Symbolic
+// group name that can be used to release multiple objects. If
+// objectGroup is not specified and objectId is, objectGroup will be
+// inherited from object.
+func (a *CallFunctionOnArgs) SetObjectGroup(objectGroup string) *CallFunctionOnArgs {
+	a.ObjectGroup = &objectGroup
+	return a
+}
+
+// SetThrowOnSideEffect sets the ThrowOnSideEffect optional argument.
+// Whether to throw an exception if side effect cannot be ruled out
+// during evaluation.
+//
+// Note: This property is experimental.
+func (a *CallFunctionOnArgs) SetThrowOnSideEffect(throwOnSideEffect bool) *CallFunctionOnArgs {
+	a.ThrowOnSideEffect = &throwOnSideEffect
+	return a
+}
+
+// SetUniqueContextID sets the UniqueContextID optional argument. An
+// alternative way to specify the execution context to call function
+// on. Compared to contextId that may be reused across processes, this
+// is guaranteed to be system-unique, so it can be used to prevent
+// accidental function call in context different than intended (e.g. as
+// a result of navigation across process boundaries). This is mutually
+// exclusive with `executionContextId`.
+//
+// Note: This property is experimental.
+func (a *CallFunctionOnArgs) SetUniqueContextID(uniqueContextID string) *CallFunctionOnArgs {
+	a.UniqueContextID = &uniqueContextID
+	return a
+}
+
+// SetSerializationOptions sets the SerializationOptions optional argument.
+// Specifies the result serialization. If provided, overrides
+// `generatePreview` and `returnByValue`.
+//
+// Note: This property is experimental.
+func (a *CallFunctionOnArgs) SetSerializationOptions(serializationOptions SerializationOptions) *CallFunctionOnArgs {
+	a.SerializationOptions = &serializationOptions
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CallFunctionOnArgs) Validate() error {
+	if a.UniqueContextID != nil && a.ExecutionContextID != nil {
+		return errors.New("runtime.CallFunctionOnArgs: UniqueContextID and ExecutionContextID are mutually exclusive")
+	}
+	return nil
+}
+
+// CallFunctionOnReply represents the return values for CallFunctionOn in the Runtime domain.
+type CallFunctionOnReply struct {
+	Result           RemoteObject      `json:"result"`                     // Call result.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
+}
+
+// CompileScriptArgs represents the arguments for CompileScript in the Runtime domain.
+type CompileScriptArgs struct {
+	Expression         string              `json:"expression"`                   // Expression to compile.
+	SourceURL          string              `json:"sourceURL"`                    // Source url to be set for the script.
+	PersistScript      bool                `json:"persistScript"`                // Specifies whether the compiled script should be persisted.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"` // Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page.
+}
+
+// NewCompileScriptArgs initializes CompileScriptArgs with the required arguments.
+func NewCompileScriptArgs(expression string, sourceURL string, persistScript bool) *CompileScriptArgs {
+	args := new(CompileScriptArgs)
+	args.Expression = expression
+	args.SourceURL = sourceURL
+	args.PersistScript = persistScript
+	return args
+}
+
+// SetExecutionContextID sets the ExecutionContextID optional argument.
+// Specifies in which execution context to perform script run. If the
+// parameter is omitted the evaluation will be performed in the context
+// of the inspected page.
+func (a *CompileScriptArgs) SetExecutionContextID(executionContextID ExecutionContextID) *CompileScriptArgs {
+	a.ExecutionContextID = &executionContextID
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CompileScriptArgs) Validate() error {
+	return nil
+}
+
+// CompileScriptReply represents the return values for CompileScript in the Runtime domain.
+type CompileScriptReply struct {
+	ScriptID         *ScriptID         `json:"scriptId,omitempty"`         // Id of the script.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
+}
+
+// EvaluateArgs represents the arguments for Evaluate in the Runtime domain.
+type EvaluateArgs struct {
+	Expression            string              `json:"expression"`                      // Expression to evaluate.
+	ObjectGroup           *string             `json:"objectGroup,omitempty"`           // Symbolic group name that can be used to release multiple objects.
+	IncludeCommandLineAPI *bool               `json:"includeCommandLineAPI,omitempty"` // Determines whether Command Line API should be available during the evaluation.
+	Silent                *bool               `json:"silent,omitempty"`                // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	ContextID             *ExecutionContextID `json:"contextId,omitempty"`             // Specifies in which execution context to perform evaluation. If the parameter is omitted the evaluation will be performed in the context of the inspected page. This is mutually exclusive with `uniqueContextId`, which offers an alternative way to identify the execution context that is more reliable in a multi-process environment.
+	ReturnByValue         *bool               `json:"returnByValue,omitempty"`         // Whether the result is expected to be a JSON object that should be sent by value.
+	// GeneratePreview Whether preview should be generated for the result.
+	//
+	// Note: This property is experimental.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	UserGesture     *bool `json:"userGesture,omitempty"`  // Whether execution should be treated as initiated by user in the UI.
+	AwaitPromise    *bool `json:"awaitPromise,omitempty"` // Whether execution should `await` for resulting value and return once awaited promise is resolved.
+	// ThrowOnSideEffect Whether to throw an exception if side effect
+	// cannot be ruled out during evaluation. This implies `disableBreaks`
+	// below.
+	//
+	// Note: This property is experimental.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// Timeout Terminate execution after timing out (number of
+	// milliseconds).
+	//
+	// Note: This property is experimental.
+	Timeout *TimeDelta `json:"timeout,omitempty"`
+	// DisableBreaks Disable breakpoints during execution.
+	//
+	// Note: This property is experimental.
+	DisableBreaks *bool `json:"disableBreaks,omitempty"`
+	// ReplMode Setting this flag to true enables `let` re-declaration and
+	// top-level `await`. Note that `let` variables can only be re-declared
+	// if they originate from `replMode` themselves.
+	//
+	// Note: This property is experimental.
+	ReplMode *bool `json:"replMode,omitempty"`
+	// AllowUnsafeEvalBlockedByCSP The Content Security Policy (CSP) for
+	// the target might block 'unsafe-eval' which includes eval(),
+	// Function(), setTimeout() and setInterval() when called with
+	// non-callable arguments. This flag bypasses CSP for this evaluation
+	// and allows unsafe-eval. Defaults to true.
+	//
+	// Note: This property is experimental.
+	AllowUnsafeEvalBlockedByCSP *bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	// UniqueContextID An alternative way to specify the execution context
+	// to evaluate in. Compared to contextId that may be reused across
+	// processes, this is guaranteed to be system-unique, so it can be used
+	// to prevent accidental evaluation of the expression in context
+	// different than intended (e.g. as a result of navigation across
+	// process boundaries). This is mutually exclusive with `contextId`.
+	//
+	// Note: This property is experimental.
+	UniqueContextID *string `json:"uniqueContextId,omitempty"`
+	// SerializationOptions Specifies the result serialization. If
+	// provided, overrides `generatePreview` and `returnByValue`.
+	//
+	// Note: This property is experimental.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// NewEvaluateArgs initializes EvaluateArgs with the required arguments.
+func NewEvaluateArgs(expression string) *EvaluateArgs {
+	args := new(EvaluateArgs)
+	args.Expression = expression
+	return args
+}
+
+// SetObjectGroup sets the ObjectGroup optional argument. Symbolic
+// group name that can be used to release multiple objects.
+func (a *EvaluateArgs) SetObjectGroup(objectGroup string) *EvaluateArgs {
+	a.ObjectGroup = &objectGroup
+	return a
+}
+
+// SetIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument.
+// Determines whether Command Line API should be available during the
+// evaluation.
+func (a *EvaluateArgs) SetIncludeCommandLineAPI(includeCommandLineAPI bool) *EvaluateArgs {
+	a.IncludeCommandLineAPI = &includeCommandLineAPI
+	return a
+}
+
+// SetSilent sets the Silent optional argument. In silent mode
+// exceptions thrown during evaluation are not reported and do not
+// pause execution. Overrides `setPauseOnException` state.
+func (a *EvaluateArgs) SetSilent(silent bool) *EvaluateArgs {
+	a.Silent = &silent
+	return a
+}
+
+// SetContextID sets the ContextID optional argument. Specifies in
+// which execution context to perform evaluation. If the parameter is
+// omitted the evaluation will be performed in the context of the
+// inspected page. This is mutually exclusive with `uniqueContextId`,
+// which offers an alternative way to identify the execution context
+// that is more reliable in a multi-process environment.
+func (a *EvaluateArgs) SetContextID(contextID ExecutionContextID) *EvaluateArgs {
+	a.ContextID = &contextID
+	return a
+}
+
+// SetReturnByValue sets the ReturnByValue optional argument. Whether
+// the result is expected to be a JSON object that should be sent by
+// value.
+func (a *EvaluateArgs) SetReturnByValue(returnByValue bool) *EvaluateArgs {
+	a.ReturnByValue = &returnByValue
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the result.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetGeneratePreview(generatePreview bool) *EvaluateArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// SetUserGesture sets the UserGesture optional argument. Whether
+// execution should be treated as initiated by user in the UI.
+func (a *EvaluateArgs) SetUserGesture(userGesture bool) *EvaluateArgs {
+	a.UserGesture = &userGesture
+	return a
+}
+
+// SetAwaitPromise sets the AwaitPromise optional argument. Whether
+// execution should `await` for resulting value and return once awaited
+// promise is resolved.
+func (a *EvaluateArgs) SetAwaitPromise(awaitPromise bool) *EvaluateArgs {
+	a.AwaitPromise = &awaitPromise
+	return a
+}
+
+// SetThrowOnSideEffect sets the ThrowOnSideEffect optional argument.
+// Whether to throw an exception if side effect cannot be ruled out
+// during evaluation. This implies `disableBreaks` below.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetThrowOnSideEffect(throwOnSideEffect bool) *EvaluateArgs {
+	a.ThrowOnSideEffect = &throwOnSideEffect
+	return a
+}
+
+// SetTimeout sets the Timeout optional argument. Terminate execution
+// after timing out (number of milliseconds).
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetTimeout(timeout TimeDelta) *EvaluateArgs {
+	a.Timeout = &timeout
+	return a
+}
+
+// SetDisableBreaks sets the DisableBreaks optional argument. Disable
+// breakpoints during execution.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetDisableBreaks(disableBreaks bool) *EvaluateArgs {
+	a.DisableBreaks = &disableBreaks
+	return a
+}
+
+// SetReplMode sets the ReplMode optional argument. Setting this flag
+// to true enables `let` re-declaration and top-level `await`. Note
+// that `let` variables can only be re-declared if they originate from
+// `replMode` themselves.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetReplMode(replMode bool) *EvaluateArgs {
+	a.ReplMode = &replMode
+	return a
+}
+
+// SetAllowUnsafeEvalBlockedByCSP sets the AllowUnsafeEvalBlockedByCSP optional argument.
+// The Content Security Policy (CSP) for the target might block
+// 'unsafe-eval' which includes eval(), Function(), setTimeout() and
+// setInterval() when called with non-callable arguments. This flag
+// bypasses CSP for this evaluation and allows unsafe-eval. Defaults to
+// true.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetAllowUnsafeEvalBlockedByCSP(allowUnsafeEvalBlockedByCSP bool) *EvaluateArgs {
+	a.AllowUnsafeEvalBlockedByCSP = &allowUnsafeEvalBlockedByCSP
+	return a
+}
+
+// SetUniqueContextID sets the UniqueContextID optional argument. An
+// alternative way to specify the execution context to evaluate in.
+// Compared to contextId that may be reused across processes, this is
+// guaranteed to be system-unique, so it can be used to prevent
+// accidental evaluation of the expression in context different than
+// intended (e.g. as a result of navigation across process boundaries).
+// This is mutually exclusive with `contextId`.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetUniqueContextID(uniqueContextID string) *EvaluateArgs {
+	a.UniqueContextID = &uniqueContextID
+	return a
+}
+
+// SetSerializationOptions sets the SerializationOptions optional argument.
+// Specifies the result serialization. If provided, overrides
+// `generatePreview` and `returnByValue`.
+//
+// Note: This property is experimental.
+func (a *EvaluateArgs) SetSerializationOptions(serializationOptions SerializationOptions) *EvaluateArgs {
+	a.SerializationOptions = &serializationOptions
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *EvaluateArgs) Validate() error {
+	if a.ContextID != nil && a.UniqueContextID != nil {
+		return errors.New("runtime.EvaluateArgs: ContextID and UniqueContextID are mutually exclusive")
+	}
+	return nil
+}
+
+// EvaluateReply represents the return values for Evaluate in the Runtime domain.
+type EvaluateReply struct {
+	Result           RemoteObject      `json:"result"`                     // Evaluation result.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
+}
+
+// GetIsolateIDReply represents the return values for GetIsolateID in the Runtime domain.
+type GetIsolateIDReply struct {
+	ID string `json:"id"` // The isolate id.
+}
+
+// GetHeapUsageReply represents the return values for GetHeapUsage in the Runtime domain.
+type GetHeapUsageReply struct {
+	UsedSize             float64 `json:"usedSize"`             // Used JavaScript heap size in bytes.
+	TotalSize            float64 `json:"totalSize"`            // Allocated JavaScript heap size in bytes.
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"` // Used size in bytes in the embedder's garbage-collected heap.
+	BackingStorageSize   float64 `json:"backingStorageSize"`   // Size in bytes of backing storage for array buffers and external strings.
+}
+
+// GetPropertiesArgs represents the arguments for GetProperties in the Runtime domain.
+type GetPropertiesArgs struct {
+	ObjectID      RemoteObjectID `json:"objectId"`                // Identifier of the object to return properties for.
+	OwnProperties *bool          `json:"ownProperties,omitempty"` // If true, returns properties belonging only to the element itself, not to its prototype chain.
+	// AccessorPropertiesOnly If true, returns accessor properties (with
+	// getter/setter) only; internal properties are not returned either.
+	//
+	// Note: This property is experimental.
+	AccessorPropertiesOnly *bool `json:"accessorPropertiesOnly,omitempty"`
+	// GeneratePreview Whether preview should be generated for the
+	// results.
+	//
+	// Note: This property is experimental.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// NonIndexedPropertiesOnly If true, returns non-indexed properties
+	// only.
+	//
+	// Note: This property is experimental.
+	NonIndexedPropertiesOnly *bool `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// NewGetPropertiesArgs initializes GetPropertiesArgs with the required arguments.
+func NewGetPropertiesArgs(objectID RemoteObjectID) *GetPropertiesArgs {
+	args := new(GetPropertiesArgs)
+	args.ObjectID = objectID
+	return args
+}
+
+// SetOwnProperties sets the OwnProperties optional argument. If true,
+// returns properties belonging only to the element itself, not to its
+// prototype chain.
+func (a *GetPropertiesArgs) SetOwnProperties(ownProperties bool) *GetPropertiesArgs {
+	a.OwnProperties = &ownProperties
+	return a
+}
+
+// SetAccessorPropertiesOnly sets the AccessorPropertiesOnly optional argument.
+// If true, returns accessor properties (with getter/setter) only;
+// internal properties are not returned either.
+//
+// Note: This property is experimental.
+func (a *GetPropertiesArgs) SetAccessorPropertiesOnly(accessorPropertiesOnly bool) *GetPropertiesArgs {
+	a.AccessorPropertiesOnly = &accessorPropertiesOnly
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the results.
+//
+// Note: This property is experimental.
+func (a *GetPropertiesArgs) SetGeneratePreview(generatePreview bool) *GetPropertiesArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// SetNonIndexedPropertiesOnly sets the NonIndexedPropertiesOnly optional argument.
+// If true, returns non-indexed properties only.
+//
+// Note: This property is experimental.
+func (a *GetPropertiesArgs) SetNonIndexedPropertiesOnly(nonIndexedPropertiesOnly bool) *GetPropertiesArgs {
+	a.NonIndexedPropertiesOnly = &nonIndexedPropertiesOnly
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetPropertiesArgs) Validate() error {
+	return nil
+}
+
+// GetPropertiesReply represents the return values for GetProperties in the Runtime domain.
+type GetPropertiesReply struct {
+	Result             []PropertyDescriptor         `json:"result"`                       // Object properties.
+	InternalProperties []InternalPropertyDescriptor `json:"internalProperties,omitempty"` // Internal object properties (only of the element itself).
+	// PrivateProperties Object private properties.
+	//
+	// Note: This property is experimental.
+	PrivateProperties []PrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+	ExceptionDetails  *ExceptionDetails           `json:"exceptionDetails,omitempty"` // Exception details.
+}
+
+// GlobalLexicalScopeNamesArgs represents the arguments for GlobalLexicalScopeNames in the Runtime domain.
+type GlobalLexicalScopeNamesArgs struct {
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"` // Specifies in which execution context to lookup global scope variables.
+}
+
+// NewGlobalLexicalScopeNamesArgs initializes GlobalLexicalScopeNamesArgs with the required arguments.
+func NewGlobalLexicalScopeNamesArgs() *GlobalLexicalScopeNamesArgs {
+	args := new(GlobalLexicalScopeNamesArgs)
+
+	return args
+}
+
+// SetExecutionContextID sets the ExecutionContextID optional argument.
+// Specifies in which execution context to lookup global scope
+// variables.
+func (a *GlobalLexicalScopeNamesArgs) SetExecutionContextID(executionContextID ExecutionContextID) *GlobalLexicalScopeNamesArgs {
+	a.ExecutionContextID = &executionContextID
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GlobalLexicalScopeNamesArgs) Validate() error {
+	return nil
+}
+
+// GlobalLexicalScopeNamesReply represents the return values for GlobalLexicalScopeNames in the Runtime domain.
+type GlobalLexicalScopeNamesReply struct {
+	Names []string `json:"names"` // No description.
+}
+
+// QueryObjectsArgs represents the arguments for QueryObjects in the Runtime domain.
+type QueryObjectsArgs struct {
+	PrototypeObjectID RemoteObjectID `json:"prototypeObjectId"`     // Identifier of the prototype to return objects for.
+	ObjectGroup       *string        `json:"objectGroup,omitempty"` // Symbolic group name that can be used to release the results.
+}
+
+// NewQueryObjectsArgs initializes QueryObjectsArgs with the required arguments.
+func NewQueryObjectsArgs(prototypeObjectID RemoteObjectID) *QueryObjectsArgs {
+	args := new(QueryObjectsArgs)
+	args.PrototypeObjectID = prototypeObjectID
+	return args
+}
+
+// SetObjectGroup sets the ObjectGroup optional argument. Symbolic
+// group name that can be used to release the results.
+func (a *QueryObjectsArgs) SetObjectGroup(objectGroup string) *QueryObjectsArgs {
+	a.ObjectGroup = &objectGroup
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *QueryObjectsArgs) Validate() error {
+	return nil
+}
+
+// QueryObjectsReply represents the return values for QueryObjects in the Runtime domain.
+type QueryObjectsReply struct {
+	Objects RemoteObject `json:"objects"` // Array with objects.
+}
+
+// ReleaseObjectArgs represents the arguments for ReleaseObject in the Runtime domain.
+type ReleaseObjectArgs struct {
+	ObjectID RemoteObjectID `json:"objectId"` // Identifier of the object to release.
+}
+
+// NewReleaseObjectArgs initializes ReleaseObjectArgs with the required arguments.
+func NewReleaseObjectArgs(objectID RemoteObjectID) *ReleaseObjectArgs {
+	args := new(ReleaseObjectArgs)
+	args.ObjectID = objectID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReleaseObjectArgs) Validate() error {
+	return nil
+}
+
+// ReleaseObjectGroupArgs represents the arguments for ReleaseObjectGroup in the Runtime domain.
+type ReleaseObjectGroupArgs struct {
+	ObjectGroup string `json:"objectGroup"` // Symbolic object group name.
+}
+
+// NewReleaseObjectGroupArgs initializes ReleaseObjectGroupArgs with the required arguments.
+func NewReleaseObjectGroupArgs(objectGroup string) *ReleaseObjectGroupArgs {
+	args := new(ReleaseObjectGroupArgs)
+	args.ObjectGroup = objectGroup
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ReleaseObjectGroupArgs) Validate() error {
+	return nil
+}
+
+// RunScriptArgs represents the arguments for RunScript in the Runtime domain.
+type RunScriptArgs struct {
+	ScriptID              ScriptID            `json:"scriptId"`                        // Id of the script to run.
+	ExecutionContextID    *ExecutionContextID `json:"executionContextId,omitempty"`    // Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page.
+	ObjectGroup           *string             `json:"objectGroup,omitempty"`           // Symbolic group name that can be used to release multiple objects.
+	Silent                *bool               `json:"silent,omitempty"`                // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	IncludeCommandLineAPI *bool               `json:"includeCommandLineAPI,omitempty"` // Determines whether Command Line API should be available during the evaluation.
+	ReturnByValue         *bool               `json:"returnByValue,omitempty"`         // Whether the result is expected to be a JSON object which should be sent by value.
+	GeneratePreview       *bool               `json:"generatePreview,omitempty"`       // Whether preview should be generated for the result.
+	AwaitPromise          *bool               `json:"awaitPromise,omitempty"`          // Whether execution should `await` for resulting value and return once awaited promise is resolved.
+}
+
+// NewRunScriptArgs initializes RunScriptArgs with the required arguments.
+func NewRunScriptArgs(scriptID ScriptID) *RunScriptArgs {
+	args := new(RunScriptArgs)
+	args.ScriptID = scriptID
+	return args
+}
+
+// SetExecutionContextID sets the ExecutionContextID optional argument.
+// Specifies in which execution context to perform script run. If the
+// parameter is omitted the evaluation will be performed in the context
+// of the inspected page.
+func (a *RunScriptArgs) SetExecutionContextID(executionContextID ExecutionContextID) *RunScriptArgs {
+	a.ExecutionContextID = &executionContextID
+	return a
+}
+
+// SetObjectGroup sets the ObjectGroup optional argument. Symbolic
+// group name that can be used to release multiple objects.
+func (a *RunScriptArgs) SetObjectGroup(objectGroup string) *RunScriptArgs {
+	a.ObjectGroup = &objectGroup
+	return a
+}
+
+// SetSilent sets the Silent optional argument. In silent mode
+// exceptions thrown during evaluation are not reported and do not
+// pause execution. Overrides `setPauseOnException` state.
+func (a *RunScriptArgs) SetSilent(silent bool) *RunScriptArgs {
+	a.Silent = &silent
+	return a
+}
+
+// SetIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument.
+// Determines whether Command Line API should be available during the
+// evaluation.
+func (a *RunScriptArgs) SetIncludeCommandLineAPI(includeCommandLineAPI bool) *RunScriptArgs {
+	a.IncludeCommandLineAPI = &includeCommandLineAPI
+	return a
+}
+
+// SetReturnByValue sets the ReturnByValue optional argument. Whether
+// the result is expected to be a JSON object which should be sent by
+// value.
+func (a *RunScriptArgs) SetReturnByValue(returnByValue bool) *RunScriptArgs {
+	a.ReturnByValue = &returnByValue
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the result.
+func (a *RunScriptArgs) SetGeneratePreview(generatePreview bool) *RunScriptArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// SetAwaitPromise sets the AwaitPromise optional argument. Whether
+// execution should `await` for resulting value and return once awaited
+// promise is resolved.
+func (a *RunScriptArgs) SetAwaitPromise(awaitPromise bool) *RunScriptArgs {
+	a.AwaitPromise = &awaitPromise
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RunScriptArgs) Validate() error {
+	return nil
+}
+
+// RunScriptReply represents the return values for RunScript in the Runtime domain.
+type RunScriptReply struct {
+	Result           RemoteObject      `json:"result"`                     // Run result.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
+}
+
+// SetCustomObjectFormatterEnabledArgs represents the arguments for SetCustomObjectFormatterEnabled in the Runtime domain.
+type SetCustomObjectFormatterEnabledArgs struct {
+	Enabled bool `json:"enabled"` // No description.
+}
+
+// NewSetCustomObjectFormatterEnabledArgs initializes SetCustomObjectFormatterEnabledArgs with the required arguments.
+func NewSetCustomObjectFormatterEnabledArgs(enabled bool) *SetCustomObjectFormatterEnabledArgs {
+	args := new(SetCustomObjectFormatterEnabledArgs)
+	args.Enabled = enabled
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetCustomObjectFormatterEnabledArgs) Validate() error {
+	return nil
+}
+
+// SetMaxCallStackSizeToCaptureArgs represents the arguments for SetMaxCallStackSizeToCapture in the Runtime domain.
+type SetMaxCallStackSizeToCaptureArgs struct {
+	Size int `json:"size"` // No description.
+}
+
+// NewSetMaxCallStackSizeToCaptureArgs initializes SetMaxCallStackSizeToCaptureArgs with the required arguments.
+func NewSetMaxCallStackSizeToCaptureArgs(size int) *SetMaxCallStackSizeToCaptureArgs {
+	args := new(SetMaxCallStackSizeToCaptureArgs)
+	args.Size = size
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetMaxCallStackSizeToCaptureArgs) Validate() error {
+	return nil
+}
+
+// AddBindingArgs represents the arguments for AddBinding in the Runtime domain.
+type AddBindingArgs struct {
+	Name string `json:"name"` // No description.
+	// ExecutionContextID is deprecated.
+	//
+	// Deprecated: If specified, the binding would only be exposed to the
+	// specified execution context. If omitted and `executionContextName`
+	// is not set, the binding is exposed to all execution contexts of the
+	// target. This parameter is mutually exclusive with
+	// `executionContextName`. Deprecated in favor of
+	// `executionContextName` due to an unclear use case and bugs in
+	// implementation (crbug.com/1169639). `executionContextId` will be
+	// removed in the future.
+	//
+	// Note: This property is experimental.
+	ExecutionContextID   *ExecutionContextID `json:"executionContextId,omitempty"`
+	ExecutionContextName *string             `json:"executionContextName,omitempty"` // If specified, the binding is exposed to the executionContext with matching name, even for contexts created after the binding is added. See also `ExecutionContext.name` and `worldName` parameter to `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually exclusive with `executionContextId`.
+}
+
+// NewAddBindingArgs initializes AddBindingArgs with the required arguments.
+func NewAddBindingArgs(name string) *AddBindingArgs {
+	args := new(AddBindingArgs)
+	args.Name = name
+	return args
+}
+
+// SetExecutionContextID sets the ExecutionContextID optional argument.
+//
+// Deprecated:
+// If specified, the binding would only be exposed to the specified
+// execution context. If omitted and `executionContextName` is not set,
+// the binding is exposed to all execution contexts of the target. This
+// parameter is mutually exclusive with `executionContextName`.
+// Deprecated in favor of `executionContextName` due to an unclear use
+// case and bugs in implementation (crbug.com/1169639).
+// `executionContextId` will be removed in the future.
+//
+// Note: This property is experimental.
+func (a *AddBindingArgs) SetExecutionContextID(executionContextID ExecutionContextID) *AddBindingArgs {
+	a.ExecutionContextID = &executionContextID
+	return a
+}
+
+// SetExecutionContextName sets the ExecutionContextName optional argument.
+// If specified, the binding is exposed to the executionContext with
+// matching name, even for contexts created after the binding is added.
+// See also `ExecutionContext.name` and `worldName` parameter to
+// `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually
+// exclusive with `executionContextId`.
+func (a *AddBindingArgs) SetExecutionContextName(executionContextName string) *AddBindingArgs {
+	a.ExecutionContextName = &executionContextName
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AddBindingArgs) Validate() error {
+	if a.ExecutionContextID != nil && a.ExecutionContextName != nil {
+		return errors.New("runtime.AddBindingArgs: ExecutionContextID and ExecutionContextName are mutually exclusive")
+	}
+	return nil
+}
+
+// RemoveBindingArgs represents the arguments for RemoveBinding in the Runtime domain.
+type RemoveBindingArgs struct {
+	Name string `json:"name"` // No description.
+}
+
+// NewRemoveBindingArgs initializes RemoveBindingArgs with the required arguments.
+func NewRemoveBindingArgs(name string) *RemoveBindingArgs {
+	args := new(RemoveBindingArgs)
+	args.Name = name
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *RemoveBindingArgs) Validate() error {
+	return nil
+}
+
+// GetExceptionDetailsArgs represents the arguments for GetExceptionDetails in the Runtime domain.
+type GetExceptionDetailsArgs struct {
+	ErrorObjectID RemoteObjectID `json:"errorObjectId"` // The error object for which to resolve the exception details.
+}
+
+// NewGetExceptionDetailsArgs initializes GetExceptionDetailsArgs with the required arguments.
+func NewGetExceptionDetailsArgs(errorObjectID RemoteObjectID) *GetExceptionDetailsArgs {
+	args := new(GetExceptionDetailsArgs)
+	args.ErrorObjectID = errorObjectID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetExceptionDetailsArgs) Validate() error {
+	return nil
+}
+
+// GetExceptionDetailsReply represents the return values for GetExceptionDetails in the Runtime domain.
+type GetExceptionDetailsReply struct {
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // No description.
+}
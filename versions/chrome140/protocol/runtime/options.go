@@ -0,0 +1,442 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package runtime
+
+// AwaitPromiseOption sets an optional argument of AwaitPromiseArgs.
+type AwaitPromiseOption func(*AwaitPromiseArgs)
+
+// NewAwaitPromiseArgsWith initializes AwaitPromiseArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAwaitPromiseArgsWith(promiseObjectID RemoteObjectID, opts ...AwaitPromiseOption) *AwaitPromiseArgs {
+	args := NewAwaitPromiseArgs(promiseObjectID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AwaitPromiseWithReturnByValue sets the ReturnByValue optional argument, see
+// AwaitPromiseArgs.SetReturnByValue.
+func AwaitPromiseWithReturnByValue(v bool) AwaitPromiseOption {
+	return func(a *AwaitPromiseArgs) { a.SetReturnByValue(v) }
+}
+
+// AwaitPromiseWithGeneratePreview sets the GeneratePreview optional argument, see
+// AwaitPromiseArgs.SetGeneratePreview.
+func AwaitPromiseWithGeneratePreview(v bool) AwaitPromiseOption {
+	return func(a *AwaitPromiseArgs) { a.SetGeneratePreview(v) }
+}
+
+// CallFunctionOnOption sets an optional argument of CallFunctionOnArgs.
+type CallFunctionOnOption func(*CallFunctionOnArgs)
+
+// NewCallFunctionOnArgsWith initializes CallFunctionOnArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCallFunctionOnArgsWith(functionDeclaration string, opts ...CallFunctionOnOption) *CallFunctionOnArgs {
+	args := NewCallFunctionOnArgs(functionDeclaration)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CallFunctionOnWithObjectID sets the ObjectID optional argument, see
+// CallFunctionOnArgs.SetObjectID.
+func CallFunctionOnWithObjectID(v RemoteObjectID) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetObjectID(v) }
+}
+
+// CallFunctionOnWithArguments sets the Arguments optional argument, see
+// CallFunctionOnArgs.SetArguments.
+func CallFunctionOnWithArguments(v []CallArgument) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetArguments(v) }
+}
+
+// CallFunctionOnWithSilent sets the Silent optional argument, see
+// CallFunctionOnArgs.SetSilent.
+func CallFunctionOnWithSilent(v bool) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetSilent(v) }
+}
+
+// CallFunctionOnWithReturnByValue sets the ReturnByValue optional argument, see
+// CallFunctionOnArgs.SetReturnByValue.
+func CallFunctionOnWithReturnByValue(v bool) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetReturnByValue(v) }
+}
+
+// CallFunctionOnWithGeneratePreview sets the GeneratePreview optional argument, see
+// CallFunctionOnArgs.SetGeneratePreview.
+//
+// Note: This argument is experimental.
+func CallFunctionOnWithGeneratePreview(v bool) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetGeneratePreview(v) }
+}
+
+// CallFunctionOnWithUserGesture sets the UserGesture optional argument, see
+// CallFunctionOnArgs.SetUserGesture.
+func CallFunctionOnWithUserGesture(v bool) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetUserGesture(v) }
+}
+
+// CallFunctionOnWithAwaitPromise sets the AwaitPromise optional argument, see
+// CallFunctionOnArgs.SetAwaitPromise.
+func CallFunctionOnWithAwaitPromise(v bool) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetAwaitPromise(v) }
+}
+
+// CallFunctionOnWithExecutionContextID sets the ExecutionContextID optional argument, see
+// CallFunctionOnArgs.SetExecutionContextID.
+func CallFunctionOnWithExecutionContextID(v ExecutionContextID) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetExecutionContextID(v) }
+}
+
+// CallFunctionOnWithObjectGroup sets the ObjectGroup optional argument, see
+// CallFunctionOnArgs.SetObjectGroup.
+func CallFunctionOnWithObjectGroup(v string) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetObjectGroup(v) }
+}
+
+// CallFunctionOnWithThrowOnSideEffect sets the ThrowOnSideEffect optional argument, see
+// CallFunctionOnArgs.SetThrowOnSideEffect.
+//
+// Note: This argument is experimental.
+func CallFunctionOnWithThrowOnSideEffect(v bool) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetThrowOnSideEffect(v) }
+}
+
+// CallFunctionOnWithUniqueContextID sets the UniqueContextID optional argument, see
+// CallFunctionOnArgs.SetUniqueContextID.
+//
+// Note: This argument is experimental.
+func CallFunctionOnWithUniqueContextID(v string) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetUniqueContextID(v) }
+}
+
+// CallFunctionOnWithSerializationOptions sets the SerializationOptions optional argument, see
+// CallFunctionOnArgs.SetSerializationOptions.
+//
+// Note: This argument is experimental.
+func CallFunctionOnWithSerializationOptions(v SerializationOptions) CallFunctionOnOption {
+	return func(a *CallFunctionOnArgs) { a.SetSerializationOptions(v) }
+}
+
+// CompileScriptOption sets an optional argument of CompileScriptArgs.
+type CompileScriptOption func(*CompileScriptArgs)
+
+// NewCompileScriptArgsWith initializes CompileScriptArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewCompileScriptArgsWith(expression string, sourceURL string, persistScript bool, opts ...CompileScriptOption) *CompileScriptArgs {
+	args := NewCompileScriptArgs(expression, sourceURL, persistScript)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// CompileScriptWithExecutionContextID sets the ExecutionContextID optional argument, see
+// CompileScriptArgs.SetExecutionContextID.
+func CompileScriptWithExecutionContextID(v ExecutionContextID) CompileScriptOption {
+	return func(a *CompileScriptArgs) { a.SetExecutionContextID(v) }
+}
+
+// EvaluateOption sets an optional argument of EvaluateArgs.
+type EvaluateOption func(*EvaluateArgs)
+
+// NewEvaluateArgsWith initializes EvaluateArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEvaluateArgsWith(expression string, opts ...EvaluateOption) *EvaluateArgs {
+	args := NewEvaluateArgs(expression)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EvaluateWithObjectGroup sets the ObjectGroup optional argument, see
+// EvaluateArgs.SetObjectGroup.
+func EvaluateWithObjectGroup(v string) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetObjectGroup(v) }
+}
+
+// EvaluateWithIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument, see
+// EvaluateArgs.SetIncludeCommandLineAPI.
+func EvaluateWithIncludeCommandLineAPI(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetIncludeCommandLineAPI(v) }
+}
+
+// EvaluateWithSilent sets the Silent optional argument, see
+// EvaluateArgs.SetSilent.
+func EvaluateWithSilent(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetSilent(v) }
+}
+
+// EvaluateWithContextID sets the ContextID optional argument, see
+// EvaluateArgs.SetContextID.
+func EvaluateWithContextID(v ExecutionContextID) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetContextID(v) }
+}
+
+// EvaluateWithReturnByValue sets the ReturnByValue optional argument, see
+// EvaluateArgs.SetReturnByValue.
+func EvaluateWithReturnByValue(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetReturnByValue(v) }
+}
+
+// EvaluateWithGeneratePreview sets the GeneratePreview optional argument, see
+// EvaluateArgs.SetGeneratePreview.
+//
+// Note: This argument is experimental.
+func EvaluateWithGeneratePreview(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetGeneratePreview(v) }
+}
+
+// EvaluateWithUserGesture sets the UserGesture optional argument, see
+// EvaluateArgs.SetUserGesture.
+func EvaluateWithUserGesture(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetUserGesture(v) }
+}
+
+// EvaluateWithAwaitPromise sets the AwaitPromise optional argument, see
+// EvaluateArgs.SetAwaitPromise.
+func EvaluateWithAwaitPromise(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetAwaitPromise(v) }
+}
+
+// EvaluateWithThrowOnSideEffect sets the ThrowOnSideEffect optional argument, see
+// EvaluateArgs.SetThrowOnSideEffect.
+//
+// Note: This argument is experimental.
+func EvaluateWithThrowOnSideEffect(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetThrowOnSideEffect(v) }
+}
+
+// EvaluateWithTimeout sets the Timeout optional argument, see
+// EvaluateArgs.SetTimeout.
+//
+// Note: This argument is experimental.
+func EvaluateWithTimeout(v TimeDelta) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetTimeout(v) }
+}
+
+// EvaluateWithDisableBreaks sets the DisableBreaks optional argument, see
+// EvaluateArgs.SetDisableBreaks.
+//
+// Note: This argument is experimental.
+func EvaluateWithDisableBreaks(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetDisableBreaks(v) }
+}
+
+// EvaluateWithReplMode sets the ReplMode optional argument, see
+// EvaluateArgs.SetReplMode.
+//
+// Note: This argument is experimental.
+func EvaluateWithReplMode(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetReplMode(v) }
+}
+
+// EvaluateWithAllowUnsafeEvalBlockedByCSP sets the AllowUnsafeEvalBlockedByCSP optional argument, see
+// EvaluateArgs.SetAllowUnsafeEvalBlockedByCSP.
+//
+// Note: This argument is experimental.
+func EvaluateWithAllowUnsafeEvalBlockedByCSP(v bool) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetAllowUnsafeEvalBlockedByCSP(v) }
+}
+
+// EvaluateWithUniqueContextID sets the UniqueContextID optional argument, see
+// EvaluateArgs.SetUniqueContextID.
+//
+// Note: This argument is experimental.
+func EvaluateWithUniqueContextID(v string) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetUniqueContextID(v) }
+}
+
+// EvaluateWithSerializationOptions sets the SerializationOptions optional argument, see
+// EvaluateArgs.SetSerializationOptions.
+//
+// Note: This argument is experimental.
+func EvaluateWithSerializationOptions(v SerializationOptions) EvaluateOption {
+	return func(a *EvaluateArgs) { a.SetSerializationOptions(v) }
+}
+
+// GetPropertiesOption sets an optional argument of GetPropertiesArgs.
+type GetPropertiesOption func(*GetPropertiesArgs)
+
+// NewGetPropertiesArgsWith initializes GetPropertiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGetPropertiesArgsWith(objectID RemoteObjectID, opts ...GetPropertiesOption) *GetPropertiesArgs {
+	args := NewGetPropertiesArgs(objectID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GetPropertiesWithOwnProperties sets the OwnProperties optional argument, see
+// GetPropertiesArgs.SetOwnProperties.
+func GetPropertiesWithOwnProperties(v bool) GetPropertiesOption {
+	return func(a *GetPropertiesArgs) { a.SetOwnProperties(v) }
+}
+
+// GetPropertiesWithAccessorPropertiesOnly sets the AccessorPropertiesOnly optional argument, see
+// GetPropertiesArgs.SetAccessorPropertiesOnly.
+//
+// Note: This argument is experimental.
+func GetPropertiesWithAccessorPropertiesOnly(v bool) GetPropertiesOption {
+	return func(a *GetPropertiesArgs) { a.SetAccessorPropertiesOnly(v) }
+}
+
+// GetPropertiesWithGeneratePreview sets the GeneratePreview optional argument, see
+// GetPropertiesArgs.SetGeneratePreview.
+//
+// Note: This argument is experimental.
+func GetPropertiesWithGeneratePreview(v bool) GetPropertiesOption {
+	return func(a *GetPropertiesArgs) { a.SetGeneratePreview(v) }
+}
+
+// GetPropertiesWithNonIndexedPropertiesOnly sets the NonIndexedPropertiesOnly optional argument, see
+// GetPropertiesArgs.SetNonIndexedPropertiesOnly.
+//
+// Note: This argument is experimental.
+func GetPropertiesWithNonIndexedPropertiesOnly(v bool) GetPropertiesOption {
+	return func(a *GetPropertiesArgs) { a.SetNonIndexedPropertiesOnly(v) }
+}
+
+// GlobalLexicalScopeNamesOption sets an optional argument of GlobalLexicalScopeNamesArgs.
+type GlobalLexicalScopeNamesOption func(*GlobalLexicalScopeNamesArgs)
+
+// NewGlobalLexicalScopeNamesArgsWith initializes GlobalLexicalScopeNamesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewGlobalLexicalScopeNamesArgsWith(opts ...GlobalLexicalScopeNamesOption) *GlobalLexicalScopeNamesArgs {
+	args := NewGlobalLexicalScopeNamesArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// GlobalLexicalScopeNamesWithExecutionContextID sets the ExecutionContextID optional argument, see
+// GlobalLexicalScopeNamesArgs.SetExecutionContextID.
+func GlobalLexicalScopeNamesWithExecutionContextID(v ExecutionContextID) GlobalLexicalScopeNamesOption {
+	return func(a *GlobalLexicalScopeNamesArgs) { a.SetExecutionContextID(v) }
+}
+
+// QueryObjectsOption sets an optional argument of QueryObjectsArgs.
+type QueryObjectsOption func(*QueryObjectsArgs)
+
+// NewQueryObjectsArgsWith initializes QueryObjectsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewQueryObjectsArgsWith(prototypeObjectID RemoteObjectID, opts ...QueryObjectsOption) *QueryObjectsArgs {
+	args := NewQueryObjectsArgs(prototypeObjectID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// QueryObjectsWithObjectGroup sets the ObjectGroup optional argument, see
+// QueryObjectsArgs.SetObjectGroup.
+func QueryObjectsWithObjectGroup(v string) QueryObjectsOption {
+	return func(a *QueryObjectsArgs) { a.SetObjectGroup(v) }
+}
+
+// RunScriptOption sets an optional argument of RunScriptArgs.
+type RunScriptOption func(*RunScriptArgs)
+
+// NewRunScriptArgsWith initializes RunScriptArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewRunScriptArgsWith(scriptID ScriptID, opts ...RunScriptOption) *RunScriptArgs {
+	args := NewRunScriptArgs(scriptID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// RunScriptWithExecutionContextID sets the ExecutionContextID optional argument, see
+// RunScriptArgs.SetExecutionContextID.
+func RunScriptWithExecutionContextID(v ExecutionContextID) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetExecutionContextID(v) }
+}
+
+// RunScriptWithObjectGroup sets the ObjectGroup optional argument, see
+// RunScriptArgs.SetObjectGroup.
+func RunScriptWithObjectGroup(v string) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetObjectGroup(v) }
+}
+
+// RunScriptWithSilent sets the Silent optional argument, see
+// RunScriptArgs.SetSilent.
+func RunScriptWithSilent(v bool) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetSilent(v) }
+}
+
+// RunScriptWithIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument, see
+// RunScriptArgs.SetIncludeCommandLineAPI.
+func RunScriptWithIncludeCommandLineAPI(v bool) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetIncludeCommandLineAPI(v) }
+}
+
+// RunScriptWithReturnByValue sets the ReturnByValue optional argument, see
+// RunScriptArgs.SetReturnByValue.
+func RunScriptWithReturnByValue(v bool) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetReturnByValue(v) }
+}
+
+// RunScriptWithGeneratePreview sets the GeneratePreview optional argument, see
+// RunScriptArgs.SetGeneratePreview.
+func RunScriptWithGeneratePreview(v bool) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetGeneratePreview(v) }
+}
+
+// RunScriptWithAwaitPromise sets the AwaitPromise optional argument, see
+// RunScriptArgs.SetAwaitPromise.
+func RunScriptWithAwaitPromise(v bool) RunScriptOption {
+	return func(a *RunScriptArgs) { a.SetAwaitPromise(v) }
+}
+
+// AddBindingOption sets an optional argument of AddBindingArgs.
+type AddBindingOption func(*AddBindingArgs)
+
+// NewAddBindingArgsWith initializes AddBindingArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewAddBindingArgsWith(name string, opts ...AddBindingOption) *AddBindingArgs {
+	args := NewAddBindingArgs(name)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// AddBindingWithExecutionContextID sets the ExecutionContextID optional argument, see
+// AddBindingArgs.SetExecutionContextID.
+//
+// Deprecated: This argument should not be used.
+//
+// Note: This argument is experimental.
+func AddBindingWithExecutionContextID(v ExecutionContextID) AddBindingOption {
+	return func(a *AddBindingArgs) { a.SetExecutionContextID(v) }
+}
+
+// AddBindingWithExecutionContextName sets the ExecutionContextName optional argument, see
+// AddBindingArgs.SetExecutionContextName.
+func AddBindingWithExecutionContextName(v string) AddBindingOption {
+	return func(a *AddBindingArgs) { a.SetExecutionContextName(v) }
+}
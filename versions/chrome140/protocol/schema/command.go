@@ -0,0 +1,8 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package schema
+
+// GetDomainsReply represents the return values for GetDomains in the Schema domain.
+type GetDomainsReply struct {
+	Domains []Domain `json:"domains"` // List of supported domains.
+}
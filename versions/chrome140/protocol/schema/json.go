@@ -0,0 +1,74 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package schema
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Domain) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("name")
+	w.String(v.Name)
+	w.Key("version")
+	w.String(v.Version)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Domain) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "name":
+			v.Name = r.String()
+		case "version":
+			v.Version = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetDomainsReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("domains")
+	if v.Domains == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Domains {
+			v.Domains[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetDomainsReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "domains":
+			if r.ArrayStart() {
+				v.Domains = make([]Domain, 0, 4)
+				for r.ArrayNext() {
+					var e0 Domain
+					e0.DecodeJSON(r)
+					v.Domains = append(v.Domains, e0)
+				}
+			} else {
+				v.Domains = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
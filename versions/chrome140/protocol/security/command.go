@@ -0,0 +1,67 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"errors"
+	"strconv"
+)
+
+// SetIgnoreCertificateErrorsArgs represents the arguments for SetIgnoreCertificateErrors in the Security domain.
+type SetIgnoreCertificateErrorsArgs struct {
+	Ignore bool `json:"ignore"` // If true, all certificate errors will be ignored.
+}
+
+// NewSetIgnoreCertificateErrorsArgs initializes SetIgnoreCertificateErrorsArgs with the required arguments.
+func NewSetIgnoreCertificateErrorsArgs(ignore bool) *SetIgnoreCertificateErrorsArgs {
+	args := new(SetIgnoreCertificateErrorsArgs)
+	args.Ignore = ignore
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetIgnoreCertificateErrorsArgs) Validate() error {
+	return nil
+}
+
+// HandleCertificateErrorArgs represents the arguments for HandleCertificateError in the Security domain.
+type HandleCertificateErrorArgs struct {
+	EventID int                    `json:"eventId"` // The ID of the event.
+	Action  CertificateErrorAction `json:"action"`  // The action to take on the certificate error.
+}
+
+// NewHandleCertificateErrorArgs initializes HandleCertificateErrorArgs with the required arguments.
+func NewHandleCertificateErrorArgs(eventID int, action CertificateErrorAction) *HandleCertificateErrorArgs {
+	args := new(HandleCertificateErrorArgs)
+	args.EventID = eventID
+	args.Action = action
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *HandleCertificateErrorArgs) Validate() error {
+	if !a.Action.Valid() {
+		return errors.New("security.HandleCertificateErrorArgs: Action: invalid value " + strconv.Quote(string(a.Action)))
+	}
+	return nil
+}
+
+// SetOverrideCertificateErrorsArgs represents the arguments for SetOverrideCertificateErrors in the Security domain.
+type SetOverrideCertificateErrorsArgs struct {
+	Override bool `json:"override"` // If true, certificate errors will be overridden.
+}
+
+// NewSetOverrideCertificateErrorsArgs initializes SetOverrideCertificateErrorsArgs with the required arguments.
+func NewSetOverrideCertificateErrorsArgs(override bool) *SetOverrideCertificateErrorsArgs {
+	args := new(SetOverrideCertificateErrorsArgs)
+	args.Override = override
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetOverrideCertificateErrorsArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package serviceworker
+
+// DeliverPushMessageArgs represents the arguments for DeliverPushMessage in the ServiceWorker domain.
+type DeliverPushMessageArgs struct {
+	Origin         string         `json:"origin"`         // No description.
+	RegistrationID RegistrationID `json:"registrationId"` // No description.
+	Data           string         `json:"data"`           // No description.
+}
+
+// NewDeliverPushMessageArgs initializes DeliverPushMessageArgs with the required arguments.
+func NewDeliverPushMessageArgs(origin string, registrationID RegistrationID, data string) *DeliverPushMessageArgs {
+	args := new(DeliverPushMessageArgs)
+	args.Origin = origin
+	args.RegistrationID = registrationID
+	args.Data = data
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DeliverPushMessageArgs) Validate() error {
+	return nil
+}
+
+// DispatchSyncEventArgs represents the arguments for DispatchSyncEvent in the ServiceWorker domain.
+type DispatchSyncEventArgs struct {
+	Origin         string         `json:"origin"`         // No description.
+	RegistrationID RegistrationID `json:"registrationId"` // No description.
+	Tag            string         `json:"tag"`            // No description.
+	LastChance     bool           `json:"lastChance"`     // No description.
+}
+
+// NewDispatchSyncEventArgs initializes DispatchSyncEventArgs with the required arguments.
+func NewDispatchSyncEventArgs(origin string, registrationID RegistrationID, tag string, lastChance bool) *DispatchSyncEventArgs {
+	args := new(DispatchSyncEventArgs)
+	args.Origin = origin
+	args.RegistrationID = registrationID
+	args.Tag = tag
+	args.LastChance = lastChance
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchSyncEventArgs) Validate() error {
+	return nil
+}
+
+// DispatchPeriodicSyncEventArgs represents the arguments for DispatchPeriodicSyncEvent in the ServiceWorker domain.
+type DispatchPeriodicSyncEventArgs struct {
+	Origin         string         `json:"origin"`         // No description.
+	RegistrationID RegistrationID `json:"registrationId"` // No description.
+	Tag            string         `json:"tag"`            // No description.
+}
+
+// NewDispatchPeriodicSyncEventArgs initializes DispatchPeriodicSyncEventArgs with the required arguments.
+func NewDispatchPeriodicSyncEventArgs(origin string, registrationID RegistrationID, tag string) *DispatchPeriodicSyncEventArgs {
+	args := new(DispatchPeriodicSyncEventArgs)
+	args.Origin = origin
+	args.RegistrationID = registrationID
+	args.Tag = tag
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DispatchPeriodicSyncEventArgs) Validate() error {
+	return nil
+}
+
+// SetForceUpdateOnPageLoadArgs represents the arguments for SetForceUpdateOnPageLoad in the ServiceWorker domain.
+type SetForceUpdateOnPageLoadArgs struct {
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"` // No description.
+}
+
+// NewSetForceUpdateOnPageLoadArgs initializes SetForceUpdateOnPageLoadArgs with the required arguments.
+func NewSetForceUpdateOnPageLoadArgs(forceUpdateOnPageLoad bool) *SetForceUpdateOnPageLoadArgs {
+	args := new(SetForceUpdateOnPageLoadArgs)
+	args.ForceUpdateOnPageLoad = forceUpdateOnPageLoad
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetForceUpdateOnPageLoadArgs) Validate() error {
+	return nil
+}
+
+// SkipWaitingArgs represents the arguments for SkipWaiting in the ServiceWorker domain.
+type SkipWaitingArgs struct {
+	ScopeURL string `json:"scopeURL"` // No description.
+}
+
+// NewSkipWaitingArgs initializes SkipWaitingArgs with the required arguments.
+func NewSkipWaitingArgs(scopeURL string) *SkipWaitingArgs {
+	args := new(SkipWaitingArgs)
+	args.ScopeURL = scopeURL
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SkipWaitingArgs) Validate() error {
+	return nil
+}
+
+// StartWorkerArgs represents the arguments for StartWorker in the ServiceWorker domain.
+type StartWorkerArgs struct {
+	ScopeURL string `json:"scopeURL"` // No description.
+}
+
+// NewStartWorkerArgs initializes StartWorkerArgs with the required arguments.
+func NewStartWorkerArgs(scopeURL string) *StartWorkerArgs {
+	args := new(StartWorkerArgs)
+	args.ScopeURL = scopeURL
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StartWorkerArgs) Validate() error {
+	return nil
+}
+
+// StopWorkerArgs represents the arguments for StopWorker in the ServiceWorker domain.
+type StopWorkerArgs struct {
+	VersionID string `json:"versionId"` // No description.
+}
+
+// NewStopWorkerArgs initializes StopWorkerArgs with the required arguments.
+func NewStopWorkerArgs(versionID string) *StopWorkerArgs {
+	args := new(StopWorkerArgs)
+	args.VersionID = versionID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *StopWorkerArgs) Validate() error {
+	return nil
+}
+
+// UnregisterArgs represents the arguments for Unregister in the ServiceWorker domain.
+type UnregisterArgs struct {
+	ScopeURL string `json:"scopeURL"` // No description.
+}
+
+// NewUnregisterArgs initializes UnregisterArgs with the required arguments.
+func NewUnregisterArgs(scopeURL string) *UnregisterArgs {
+	args := new(UnregisterArgs)
+	args.ScopeURL = scopeURL
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UnregisterArgs) Validate() error {
+	return nil
+}
+
+// UpdateRegistrationArgs represents the arguments for UpdateRegistration in the ServiceWorker domain.
+type UpdateRegistrationArgs struct {
+	ScopeURL string `json:"scopeURL"` // No description.
+}
+
+// NewUpdateRegistrationArgs initializes UpdateRegistrationArgs with the required arguments.
+func NewUpdateRegistrationArgs(scopeURL string) *UpdateRegistrationArgs {
+	args := new(UpdateRegistrationArgs)
+	args.ScopeURL = scopeURL
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UpdateRegistrationArgs) Validate() error {
+	return nil
+}
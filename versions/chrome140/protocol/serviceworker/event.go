@@ -0,0 +1,46 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package serviceworker
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// WorkerErrorReportedClient is a client for WorkerErrorReported events.
+type WorkerErrorReportedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*WorkerErrorReportedReply, error)
+	rpcc.Stream
+}
+
+// WorkerErrorReportedReply is the reply for WorkerErrorReported events.
+type WorkerErrorReportedReply struct {
+	ErrorMessage ErrorMessage `json:"errorMessage"` // No description.
+}
+
+// WorkerRegistrationUpdatedClient is a client for WorkerRegistrationUpdated events.
+type WorkerRegistrationUpdatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*WorkerRegistrationUpdatedReply, error)
+	rpcc.Stream
+}
+
+// WorkerRegistrationUpdatedReply is the reply for WorkerRegistrationUpdated events.
+type WorkerRegistrationUpdatedReply struct {
+	Registrations []Registration `json:"registrations"` // No description.
+}
+
+// WorkerVersionUpdatedClient is a client for WorkerVersionUpdated events.
+type WorkerVersionUpdatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*WorkerVersionUpdatedReply, error)
+	rpcc.Stream
+}
+
+// WorkerVersionUpdatedReply is the reply for WorkerVersionUpdated events.
+type WorkerVersionUpdatedReply struct {
+	Versions []Version `json:"versions"` // No description.
+}
@@ -0,0 +1,521 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package serviceworker
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+	"github.com/mafredri/cdp/versions/chrome140/protocol/target"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Registration) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("registrationId")
+	w.String(string(v.RegistrationID))
+	w.Key("scopeURL")
+	w.String(v.ScopeURL)
+	w.Key("isDeleted")
+	w.Bool(v.IsDeleted)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Registration) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "registrationId":
+			v.RegistrationID = RegistrationID(r.String())
+		case "scopeURL":
+			v.ScopeURL = r.String()
+		case "isDeleted":
+			v.IsDeleted = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Version) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("versionId")
+	w.String(v.VersionID)
+	w.Key("registrationId")
+	w.String(string(v.RegistrationID))
+	w.Key("scriptURL")
+	w.String(v.ScriptURL)
+	w.Key("runningStatus")
+	w.String(string(v.RunningStatus))
+	w.Key("status")
+	w.String(string(v.Status))
+	if v.ScriptLastModified != nil {
+		w.Key("scriptLastModified")
+		w.Float(*v.ScriptLastModified)
+	}
+	if v.ScriptResponseTime != nil {
+		w.Key("scriptResponseTime")
+		w.Float(*v.ScriptResponseTime)
+	}
+	if len(v.ControlledClients) > 0 {
+		w.Key("controlledClients")
+		w.ArrayStart()
+		for i0 := range v.ControlledClients {
+			w.String(string(v.ControlledClients[i0]))
+		}
+		w.ArrayEnd()
+	}
+	if v.TargetID != nil {
+		w.Key("targetId")
+		w.String(string(*v.TargetID))
+	}
+	if v.RouterRules != nil {
+		w.Key("routerRules")
+		w.String(*v.RouterRules)
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Version) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "versionId":
+			v.VersionID = r.String()
+		case "registrationId":
+			v.RegistrationID = RegistrationID(r.String())
+		case "scriptURL":
+			v.ScriptURL = r.String()
+		case "runningStatus":
+			v.RunningStatus = VersionRunningStatus(r.String())
+		case "status":
+			v.Status = VersionStatus(r.String())
+		case "scriptLastModified":
+			if r.Null() {
+				v.ScriptLastModified = nil
+			} else {
+				p := r.Float()
+				v.ScriptLastModified = &p
+			}
+		case "scriptResponseTime":
+			if r.Null() {
+				v.ScriptResponseTime = nil
+			} else {
+				p := r.Float()
+				v.ScriptResponseTime = &p
+			}
+		case "controlledClients":
+			if r.ArrayStart() {
+				v.ControlledClients = make([]target.ID, 0, 4)
+				for r.ArrayNext() {
+					e0 := target.ID(r.String())
+					v.ControlledClients = append(v.ControlledClients, e0)
+				}
+			} else {
+				v.ControlledClients = nil
+			}
+		case "targetId":
+			if r.Null() {
+				v.TargetID = nil
+			} else {
+				p := target.ID(r.String())
+				v.TargetID = &p
+			}
+		case "routerRules":
+			if r.Null() {
+				v.RouterRules = nil
+			} else {
+				p := r.String()
+				v.RouterRules = &p
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ErrorMessage) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("errorMessage")
+	w.String(v.ErrorMessage)
+	w.Key("registrationId")
+	w.String(string(v.RegistrationID))
+	w.Key("versionId")
+	w.String(v.VersionID)
+	w.Key("sourceURL")
+	w.String(v.SourceURL)
+	w.Key("lineNumber")
+	w.Int(v.LineNumber)
+	w.Key("columnNumber")
+	w.Int(v.ColumnNumber)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ErrorMessage) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "errorMessage":
+			v.ErrorMessage = r.String()
+		case "registrationId":
+			v.RegistrationID = RegistrationID(r.String())
+		case "versionId":
+			v.VersionID = r.String()
+		case "sourceURL":
+			v.SourceURL = r.String()
+		case "lineNumber":
+			v.LineNumber = r.Int()
+		case "columnNumber":
+			v.ColumnNumber = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DeliverPushMessageArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("registrationId")
+	w.String(string(v.RegistrationID))
+	w.Key("data")
+	w.String(v.Data)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DeliverPushMessageArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "registrationId":
+			v.RegistrationID = RegistrationID(r.String())
+		case "data":
+			v.Data = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DispatchSyncEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("registrationId")
+	w.String(string(v.RegistrationID))
+	w.Key("tag")
+	w.String(v.Tag)
+	w.Key("lastChance")
+	w.Bool(v.LastChance)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DispatchSyncEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "registrationId":
+			v.RegistrationID = RegistrationID(r.String())
+		case "tag":
+			v.Tag = r.String()
+		case "lastChance":
+			v.LastChance = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *DispatchPeriodicSyncEventArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("origin")
+	w.String(v.Origin)
+	w.Key("registrationId")
+	w.String(string(v.RegistrationID))
+	w.Key("tag")
+	w.String(v.Tag)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *DispatchPeriodicSyncEventArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "origin":
+			v.Origin = r.String()
+		case "registrationId":
+			v.RegistrationID = RegistrationID(r.String())
+		case "tag":
+			v.Tag = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SetForceUpdateOnPageLoadArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("forceUpdateOnPageLoad")
+	w.Bool(v.ForceUpdateOnPageLoad)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SetForceUpdateOnPageLoadArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "forceUpdateOnPageLoad":
+			v.ForceUpdateOnPageLoad = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *SkipWaitingArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scopeURL")
+	w.String(v.ScopeURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *SkipWaitingArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scopeURL":
+			v.ScopeURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StartWorkerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scopeURL")
+	w.String(v.ScopeURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StartWorkerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scopeURL":
+			v.ScopeURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *StopWorkerArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("versionId")
+	w.String(v.VersionID)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *StopWorkerArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "versionId":
+			v.VersionID = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UnregisterArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scopeURL")
+	w.String(v.ScopeURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UnregisterArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scopeURL":
+			v.ScopeURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *UpdateRegistrationArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("scopeURL")
+	w.String(v.ScopeURL)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *UpdateRegistrationArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "scopeURL":
+			v.ScopeURL = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WorkerErrorReportedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("errorMessage")
+	v.ErrorMessage.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WorkerErrorReportedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "errorMessage":
+			v.ErrorMessage.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WorkerRegistrationUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("registrations")
+	if v.Registrations == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Registrations {
+			v.Registrations[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WorkerRegistrationUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "registrations":
+			if r.ArrayStart() {
+				v.Registrations = make([]Registration, 0, 4)
+				for r.ArrayNext() {
+					var e0 Registration
+					e0.DecodeJSON(r)
+					v.Registrations = append(v.Registrations, e0)
+				}
+			} else {
+				v.Registrations = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *WorkerVersionUpdatedReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("versions")
+	if v.Versions == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Versions {
+			v.Versions[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *WorkerVersionUpdatedReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "versions":
+			if r.ArrayStart() {
+				v.Versions = make([]Version, 0, 4)
+				for r.ArrayNext() {
+					var e0 Version
+					e0.DecodeJSON(r)
+					v.Versions = append(v.Versions, e0)
+				}
+			} else {
+				v.Versions = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
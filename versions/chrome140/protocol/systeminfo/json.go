@@ -0,0 +1,503 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package systeminfo
+
+import (
+	"github.com/mafredri/cdp/internal/jsonx"
+)
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GPUDevice) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("vendorId")
+	w.Float(v.VendorID)
+	w.Key("deviceId")
+	w.Float(v.DeviceID)
+	if v.SubSysID != nil {
+		w.Key("subSysId")
+		w.Float(*v.SubSysID)
+	}
+	if v.Revision != nil {
+		w.Key("revision")
+		w.Float(*v.Revision)
+	}
+	w.Key("vendorString")
+	w.String(v.VendorString)
+	w.Key("deviceString")
+	w.String(v.DeviceString)
+	w.Key("driverVendor")
+	w.String(v.DriverVendor)
+	w.Key("driverVersion")
+	w.String(v.DriverVersion)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GPUDevice) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "vendorId":
+			v.VendorID = r.Float()
+		case "deviceId":
+			v.DeviceID = r.Float()
+		case "subSysId":
+			if r.Null() {
+				v.SubSysID = nil
+			} else {
+				p := r.Float()
+				v.SubSysID = &p
+			}
+		case "revision":
+			if r.Null() {
+				v.Revision = nil
+			} else {
+				p := r.Float()
+				v.Revision = &p
+			}
+		case "vendorString":
+			v.VendorString = r.String()
+		case "deviceString":
+			v.DeviceString = r.String()
+		case "driverVendor":
+			v.DriverVendor = r.String()
+		case "driverVersion":
+			v.DriverVersion = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *Size) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("width")
+	w.Int(v.Width)
+	w.Key("height")
+	w.Int(v.Height)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *Size) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "width":
+			v.Width = r.Int()
+		case "height":
+			v.Height = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VideoDecodeAcceleratorCapability) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	w.String(v.Profile)
+	w.Key("maxResolution")
+	v.MaxResolution.EncodeJSON(w)
+	w.Key("minResolution")
+	v.MinResolution.EncodeJSON(w)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VideoDecodeAcceleratorCapability) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile = r.String()
+		case "maxResolution":
+			v.MaxResolution.DecodeJSON(r)
+		case "minResolution":
+			v.MinResolution.DecodeJSON(r)
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *VideoEncodeAcceleratorCapability) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("profile")
+	w.String(v.Profile)
+	w.Key("maxResolution")
+	v.MaxResolution.EncodeJSON(w)
+	w.Key("maxFramerateNumerator")
+	w.Int(v.MaxFramerateNumerator)
+	w.Key("maxFramerateDenominator")
+	w.Int(v.MaxFramerateDenominator)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *VideoEncodeAcceleratorCapability) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "profile":
+			v.Profile = r.String()
+		case "maxResolution":
+			v.MaxResolution.DecodeJSON(r)
+		case "maxFramerateNumerator":
+			v.MaxFramerateNumerator = r.Int()
+		case "maxFramerateDenominator":
+			v.MaxFramerateDenominator = r.Int()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ImageDecodeAcceleratorCapability) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("imageType")
+	w.String(string(v.ImageType))
+	w.Key("maxDimensions")
+	v.MaxDimensions.EncodeJSON(w)
+	w.Key("minDimensions")
+	v.MinDimensions.EncodeJSON(w)
+	w.Key("subsamplings")
+	if v.Subsamplings == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Subsamplings {
+			w.String(string(v.Subsamplings[i0]))
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ImageDecodeAcceleratorCapability) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "imageType":
+			v.ImageType = ImageType(r.String())
+		case "maxDimensions":
+			v.MaxDimensions.DecodeJSON(r)
+		case "minDimensions":
+			v.MinDimensions.DecodeJSON(r)
+		case "subsamplings":
+			if r.ArrayStart() {
+				v.Subsamplings = make([]SubsamplingFormat, 0, 4)
+				for r.ArrayNext() {
+					e0 := SubsamplingFormat(r.String())
+					v.Subsamplings = append(v.Subsamplings, e0)
+				}
+			} else {
+				v.Subsamplings = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GPUInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("devices")
+	if v.Devices == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.Devices {
+			v.Devices[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	if len(v.AuxAttributes) > 0 {
+		w.Key("auxAttributes")
+		w.Raw(v.AuxAttributes)
+	}
+	if len(v.FeatureStatus) > 0 {
+		w.Key("featureStatus")
+		w.Raw(v.FeatureStatus)
+	}
+	w.Key("driverBugWorkarounds")
+	if v.DriverBugWorkarounds == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.DriverBugWorkarounds {
+			w.String(v.DriverBugWorkarounds[i0])
+		}
+		w.ArrayEnd()
+	}
+	w.Key("videoDecoding")
+	if v.VideoDecoding == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.VideoDecoding {
+			v.VideoDecoding[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("videoEncoding")
+	if v.VideoEncoding == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.VideoEncoding {
+			v.VideoEncoding[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.Key("imageDecoding")
+	if v.ImageDecoding == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ImageDecoding {
+			v.ImageDecoding[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GPUInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "devices":
+			if r.ArrayStart() {
+				v.Devices = make([]GPUDevice, 0, 4)
+				for r.ArrayNext() {
+					var e0 GPUDevice
+					e0.DecodeJSON(r)
+					v.Devices = append(v.Devices, e0)
+				}
+			} else {
+				v.Devices = nil
+			}
+		case "auxAttributes":
+			v.AuxAttributes = r.Raw()
+		case "featureStatus":
+			v.FeatureStatus = r.Raw()
+		case "driverBugWorkarounds":
+			if r.ArrayStart() {
+				v.DriverBugWorkarounds = make([]string, 0, 4)
+				for r.ArrayNext() {
+					e0 := r.String()
+					v.DriverBugWorkarounds = append(v.DriverBugWorkarounds, e0)
+				}
+			} else {
+				v.DriverBugWorkarounds = nil
+			}
+		case "videoDecoding":
+			if r.ArrayStart() {
+				v.VideoDecoding = make([]VideoDecodeAcceleratorCapability, 0, 4)
+				for r.ArrayNext() {
+					var e0 VideoDecodeAcceleratorCapability
+					e0.DecodeJSON(r)
+					v.VideoDecoding = append(v.VideoDecoding, e0)
+				}
+			} else {
+				v.VideoDecoding = nil
+			}
+		case "videoEncoding":
+			if r.ArrayStart() {
+				v.VideoEncoding = make([]VideoEncodeAcceleratorCapability, 0, 4)
+				for r.ArrayNext() {
+					var e0 VideoEncodeAcceleratorCapability
+					e0.DecodeJSON(r)
+					v.VideoEncoding = append(v.VideoEncoding, e0)
+				}
+			} else {
+				v.VideoEncoding = nil
+			}
+		case "imageDecoding":
+			if r.ArrayStart() {
+				v.ImageDecoding = make([]ImageDecodeAcceleratorCapability, 0, 4)
+				for r.ArrayNext() {
+					var e0 ImageDecodeAcceleratorCapability
+					e0.DecodeJSON(r)
+					v.ImageDecoding = append(v.ImageDecoding, e0)
+				}
+			} else {
+				v.ImageDecoding = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *ProcessInfo) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("type")
+	w.String(v.Type)
+	w.Key("id")
+	w.Int(v.ID)
+	w.Key("cpuTime")
+	w.Float(v.CPUTime)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *ProcessInfo) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "type":
+			v.Type = r.String()
+		case "id":
+			v.ID = r.Int()
+		case "cpuTime":
+			v.CPUTime = r.Float()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetInfoReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("gpu")
+	v.GPU.EncodeJSON(w)
+	w.Key("modelName")
+	w.String(v.ModelName)
+	w.Key("modelVersion")
+	w.String(v.ModelVersion)
+	w.Key("commandLine")
+	w.String(v.CommandLine)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetInfoReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "gpu":
+			v.GPU.DecodeJSON(r)
+		case "modelName":
+			v.ModelName = r.String()
+		case "modelVersion":
+			v.ModelVersion = r.String()
+		case "commandLine":
+			v.CommandLine = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFeatureStateArgs) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("featureState")
+	w.String(v.FeatureState)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFeatureStateArgs) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "featureState":
+			v.FeatureState = r.String()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetFeatureStateReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("featureEnabled")
+	w.Bool(v.FeatureEnabled)
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetFeatureStateReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "featureEnabled":
+			v.FeatureEnabled = r.Bool()
+		default:
+			r.Skip()
+		}
+	}
+}
+
+// EncodeJSON writes the JSON encoding of v to w.
+func (v *GetProcessInfoReply) EncodeJSON(w *jsonx.Writer) {
+	w.ObjectStart()
+	w.Key("processInfo")
+	if v.ProcessInfo == nil {
+		w.Null()
+	} else {
+		w.ArrayStart()
+		for i0 := range v.ProcessInfo {
+			v.ProcessInfo[i0].EncodeJSON(w)
+		}
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+}
+
+// DecodeJSON reads the JSON encoding of v from r.
+func (v *GetProcessInfoReply) DecodeJSON(r *jsonx.Reader) {
+	if !r.ObjectStart() {
+		return
+	}
+	for r.ObjectNext() {
+		switch string(r.Key()) {
+		case "processInfo":
+			if r.ArrayStart() {
+				v.ProcessInfo = make([]ProcessInfo, 0, 4)
+				for r.ArrayNext() {
+					var e0 ProcessInfo
+					e0.DecodeJSON(r)
+					v.ProcessInfo = append(v.ProcessInfo, e0)
+				}
+			} else {
+				v.ProcessInfo = nil
+			}
+		default:
+			r.Skip()
+		}
+	}
+}
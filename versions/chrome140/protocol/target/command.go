@@ -0,0 +1,697 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package target
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/mafredri/cdp/versions/chrome140/protocol/internal"
+)
+
+// ActivateTargetArgs represents the arguments for ActivateTarget in the Target domain.
+type ActivateTargetArgs struct {
+	TargetID ID `json:"targetId"` // No description.
+}
+
+// NewActivateTargetArgs initializes ActivateTargetArgs with the required arguments.
+func NewActivateTargetArgs(targetID ID) *ActivateTargetArgs {
+	args := new(ActivateTargetArgs)
+	args.TargetID = targetID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ActivateTargetArgs) Validate() error {
+	return nil
+}
+
+// AttachToTargetArgs represents the arguments for AttachToTarget in the Target domain.
+type AttachToTargetArgs struct {
+	TargetID ID    `json:"targetId"`          // No description.
+	Flatten  *bool `json:"flatten,omitempty"` // Enables "flat" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.
+}
+
+// NewAttachToTargetArgs initializes AttachToTargetArgs with the required arguments.
+func NewAttachToTargetArgs(targetID ID) *AttachToTargetArgs {
+	args := new(AttachToTargetArgs)
+	args.TargetID = targetID
+	return args
+}
+
+// SetFlatten sets the Flatten optional argument. Enables "flat"
+// access to the session via specifying sessionId attribute in the
+// commands. We plan to make this the default, deprecate non-flattened
+// mode, and eventually retire it. See crbug.com/991325.
+func (a *AttachToTargetArgs) SetFlatten(flatten bool) *AttachToTargetArgs {
+	a.Flatten = &flatten
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AttachToTargetArgs) Validate() error {
+	return nil
+}
+
+// AttachToTargetReply represents the return values for AttachToTarget in the Target domain.
+type AttachToTargetReply struct {
+	SessionID SessionID `json:"sessionId"` // Id assigned to the session.
+}
+
+// AttachToBrowserTargetReply represents the return values for AttachToBrowserTarget in the Target domain.
+type AttachToBrowserTargetReply struct {
+	SessionID SessionID `json:"sessionId"` // Id assigned to the session.
+}
+
+// CloseTargetArgs represents the arguments for CloseTarget in the Target domain.
+type CloseTargetArgs struct {
+	TargetID ID `json:"targetId"` // No description.
+}
+
+// NewCloseTargetArgs initializes CloseTargetArgs with the required arguments.
+func NewCloseTargetArgs(targetID ID) *CloseTargetArgs {
+	args := new(CloseTargetArgs)
+	args.TargetID = targetID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CloseTargetArgs) Validate() error {
+	return nil
+}
+
+// CloseTargetReply represents the return values for CloseTarget in the Target domain.
+type CloseTargetReply struct {
+	// Success is deprecated.
+	//
+	// Deprecated: Always set to true. If an error occurs, the response
+	// indicates protocol error.
+	Success bool `json:"success"`
+}
+
+// ExposeDevToolsProtocolArgs represents the arguments for ExposeDevToolsProtocol in the Target domain.
+type ExposeDevToolsProtocolArgs struct {
+	TargetID           ID      `json:"targetId"`                     // No description.
+	BindingName        *string `json:"bindingName,omitempty"`        // Binding name, 'cdp' if not specified.
+	InheritPermissions *bool   `json:"inheritPermissions,omitempty"` // If true, inherits the current root session's permissions (default: false).
+}
+
+// NewExposeDevToolsProtocolArgs initializes ExposeDevToolsProtocolArgs with the required arguments.
+func NewExposeDevToolsProtocolArgs(targetID ID) *ExposeDevToolsProtocolArgs {
+	args := new(ExposeDevToolsProtocolArgs)
+	args.TargetID = targetID
+	return args
+}
+
+// SetBindingName sets the BindingName optional argument. Binding
+// name, 'cdp' if not specified.
+func (a *ExposeDevToolsProtocolArgs) SetBindingName(bindingName string) *ExposeDevToolsProtocolArgs {
+	a.BindingName = &bindingName
+	return a
+}
+
+// SetInheritPermissions sets the InheritPermissions optional argument.
+// If true, inherits the current root session's permissions (default:
+// false).
+func (a *ExposeDevToolsProtocolArgs) SetInheritPermissions(inheritPermissions bool) *ExposeDevToolsProtocolArgs {
+	a.InheritPermissions = &inheritPermissions
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *ExposeDevToolsProtocolArgs) Validate() error {
+	return nil
+}
+
+// CreateBrowserContextArgs represents the arguments for CreateBrowserContext in the Target domain.
+type CreateBrowserContextArgs struct {
+	// DisposeOnDetach If specified, disposes this context when debugging
+	// session disconnects.
+	//
+	// Note: This property is experimental.
+	DisposeOnDetach *bool `json:"disposeOnDetach,omitempty"`
+	// ProxyServer Proxy server, similar to the one passed to
+	// --proxy-server
+	//
+	// Note: This property is experimental.
+	ProxyServer *string `json:"proxyServer,omitempty"`
+	// ProxyBypassList Proxy bypass list, similar to the one passed to
+	// --proxy-bypass-list
+	//
+	// Note: This property is experimental.
+	ProxyBypassList *string `json:"proxyBypassList,omitempty"`
+	// OriginsWithUniversalNetworkAccess An optional list of origins to
+	// grant unlimited cross-origin access to. Parts of the URL other than
+	// those constituting origin are ignored.
+	//
+	// Note: This property is experimental.
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
+// NewCreateBrowserContextArgs initializes CreateBrowserContextArgs with the required arguments.
+func NewCreateBrowserContextArgs() *CreateBrowserContextArgs {
+	args := new(CreateBrowserContextArgs)
+
+	return args
+}
+
+// SetDisposeOnDetach sets the DisposeOnDetach optional argument. If
+// specified, disposes this context when debugging session disconnects.
+//
+// Note: This property is experimental.
+func (a *CreateBrowserContextArgs) SetDisposeOnDetach(disposeOnDetach bool) *CreateBrowserContextArgs {
+	a.DisposeOnDetach = &disposeOnDetach
+	return a
+}
+
+// SetProxyServer sets the ProxyServer optional argument. Proxy
+// server, similar to the one passed to --proxy-server
+//
+// Note: This property is experimental.
+func (a *CreateBrowserContextArgs) SetProxyServer(proxyServer string) *CreateBrowserContextArgs {
+	a.ProxyServer = &proxyServer
+	return a
+}
+
+// SetProxyBypassList sets the ProxyBypassList optional argument.
+// Proxy bypass list, similar to the one passed to --proxy-bypass-list
+//
+// Note: This property is experimental.
+func (a *CreateBrowserContextArgs) SetProxyBypassList(proxyBypassList string) *CreateBrowserContextArgs {
+	a.ProxyBypassList = &proxyBypassList
+	return a
+}
+
+// SetOriginsWithUniversalNetworkAccess sets the OriginsWithUniversalNetworkAccess optional argument.
+// An optional list of origins to grant unlimited cross-origin access
+// to. Parts of the URL other than those constituting origin are
+// ignored.
+//
+// Note: This property is experimental.
+func (a *CreateBrowserContextArgs) SetOriginsWithUniversalNetworkAccess(originsWithUniversalNetworkAccess []string) *CreateBrowserContextArgs {
+	a.OriginsWithUniversalNetworkAccess = originsWithUniversalNetworkAccess
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CreateBrowserContextArgs) Validate() error {
+	return nil
+}
+
+// CreateBrowserContextReply represents the return values for CreateBrowserContext in the Target domain.
+type CreateBrowserContextReply struct {
+	BrowserContextID internal.BrowserContextID `json:"browserContextId"` // The id of the context created.
+}
+
+// GetBrowserContextsReply represents the return values for GetBrowserContexts in the Target domain.
+type GetBrowserContextsReply struct {
+	BrowserContextIDs []internal.BrowserContextID `json:"browserContextIds"` // An array of browser context ids.
+}
+
+// CreateTargetArgs represents the arguments for CreateTarget in the Target domain.
+type CreateTargetArgs struct {
+	URL string `json:"url"` // The initial URL the page will be navigated to. An empty string indicates about:blank.
+	// Left Frame left origin in DIP (requires newWindow to be true or
+	// headless shell).
+	//
+	// Note: This property is experimental.
+	Left *int `json:"left,omitempty"`
+	// Top Frame top origin in DIP (requires newWindow to be true or
+	// headless shell).
+	//
+	// Note: This property is experimental.
+	Top         *int        `json:"top,omitempty"`
+	Width       *int        `json:"width,omitempty"`       // Frame width in DIP (requires newWindow to be true or headless shell).
+	Height      *int        `json:"height,omitempty"`      // Frame height in DIP (requires newWindow to be true or headless shell).
+	WindowState WindowState `json:"windowState,omitempty"` // Frame window state (requires newWindow to be true or headless shell). Default is normal.
+	// BrowserContextID The browser context to create the page in.
+	//
+	// Note: This property is experimental.
+	BrowserContextID *internal.BrowserContextID `json:"browserContextId,omitempty"`
+	// EnableBeginFrameControl Whether BeginFrames for this target will be
+	// controlled via DevTools (headless shell only, not supported on MacOS
+	// yet, false by default).
+	//
+	// Note: This property is experimental.
+	EnableBeginFrameControl *bool `json:"enableBeginFrameControl,omitempty"`
+	NewWindow               *bool `json:"newWindow,omitempty"`  // Whether to create a new Window or Tab (false by default, not supported by headless shell).
+	Background              *bool `json:"background,omitempty"` // Whether to create the target in background or foreground (false by default, not supported by headless shell).
+	// ForTab Whether to create the target of type "tab".
+	//
+	// Note: This property is experimental.
+	ForTab *bool `json:"forTab,omitempty"`
+	// Hidden Whether to create a hidden target. The hidden target is
+	// observable via protocol, but not present in the tab UI strip. Cannot
+	// be created with `forTab: true`, `newWindow: true` or `background:
+	// false`. The life-time of the tab is limited to the life-time of the
+	// session.
+	//
+	// Note: This property is experimental.
+	Hidden *bool `json:"hidden,omitempty"`
+}
+
+// NewCreateTargetArgs initializes CreateTargetArgs with the required arguments.
+func NewCreateTargetArgs(url string) *CreateTargetArgs {
+	args := new(CreateTargetArgs)
+	args.URL = url
+	return args
+}
+
+// SetLeft sets the Left optional argument. Frame left origin in DIP
+// (requires newWindow to be true or headless shell).
+//
+// Note: This property is experimental.
+func (a *CreateTargetArgs) SetLeft(left int) *CreateTargetArgs {
+	a.Left = &left
+	return a
+}
+
+// SetTop sets the Top optional argument. Frame top origin in DIP
+// (requires newWindow to be true or headless shell).
+//
+// Note: This property is experimental.
+func (a *CreateTargetArgs) SetTop(top int) *CreateTargetArgs {
+	a.Top = &top
+	return a
+}
+
+// SetWidth sets the Width optional argument. Frame width in DIP
+// (requires newWindow to be true or headless shell).
+func (a *CreateTargetArgs) SetWidth(width int) *CreateTargetArgs {
+	a.Width = &width
+	return a
+}
+
+// SetHeight sets the Height optional argument. Frame height in DIP
+// (requires newWindow to be true or headless shell).
+func (a *CreateTargetArgs) SetHeight(height int) *CreateTargetArgs {
+	a.Height = &height
+	return a
+}
+
+// SetWindowState sets the WindowState optional argument. Frame window
+// state (requires newWindow to be true or headless shell). Default is
+// normal.
+func (a *CreateTargetArgs) SetWindowState(windowState WindowState) *CreateTargetArgs {
+	a.WindowState = windowState
+	return a
+}
+
+// SetBrowserContextID sets the BrowserContextID optional argument.
+// The browser context to create the page in.
+//
+// Note: This property is experimental.
+func (a *CreateTargetArgs) SetBrowserContextID(browserContextID internal.BrowserContextID) *CreateTargetArgs {
+	a.BrowserContextID = &browserContextID
+	return a
+}
+
+// SetEnableBeginFrameControl sets the EnableBeginFrameControl optional argument.
+// Whether BeginFrames for this target will be controlled via DevTools
+// (headless shell only, not supported on MacOS yet, false by default).
+//
+// Note: This property is experimental.
+func (a *CreateTargetArgs) SetEnableBeginFrameControl(enableBeginFrameControl bool) *CreateTargetArgs {
+	a.EnableBeginFrameControl = &enableBeginFrameControl
+	return a
+}
+
+// SetNewWindow sets the NewWindow optional argument. Whether to
+// create a new Window or Tab (false by default, not supported by
+// headless shell).
+func (a *CreateTargetArgs) SetNewWindow(newWindow bool) *CreateTargetArgs {
+	a.NewWindow = &newWindow
+	return a
+}
+
+// SetBackground sets the Background optional argument. Whether to
+// create the target in background or foreground (false by default, not
+// supported by headless shell).
+func (a *CreateTargetArgs) SetBackground(background bool) *CreateTargetArgs {
+	a.Background = &background
+	return a
+}
+
+// SetForTab sets the ForTab optional argument. Whether to create the
+// target of type "tab".
+//
+// Note: This property is experimental.
+func (a *CreateTargetArgs) SetForTab(forTab bool) *CreateTargetArgs {
+	a.ForTab = &forTab
+	return a
+}
+
+// SetHidden sets the Hidden optional argument. Whether to create a
+// hidden target. The hidden target is observable via protocol, but not
+// present in the tab UI strip. Cannot be created with `forTab: true`,
+// `newWindow: true` or `background: false`. The life-time of the tab
+// is limited to the life-time of the session.
+//
+// Note: This property is experimental.
+func (a *CreateTargetArgs) SetHidden(hidden bool) *CreateTargetArgs {
+	a.Hidden = &hidden
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *CreateTargetArgs) Validate() error {
+	if a.WindowState != "" {
+		if !a.WindowState.Valid() {
+			return errors.New("target.CreateTargetArgs: WindowState: invalid value " + strconv.Quote(string(a.WindowState)))
+		}
+	}
+	return nil
+}
+
+// CreateTargetReply represents the return values for CreateTarget in the Target domain.
+type CreateTargetReply struct {
+	TargetID ID `json:"targetId"` // The id of the page opened.
+}
+
+// DetachFromTargetArgs represents the arguments for DetachFromTarget in the Target domain.
+type DetachFromTargetArgs struct {
+	SessionID *SessionID `json:"sessionId,omitempty"` // Session to detach.
+	// TargetID is deprecated.
+	//
+	// Deprecated: Deprecated.
+	TargetID *ID `json:"targetId,omitempty"`
+}
+
+// NewDetachFromTargetArgs initializes DetachFromTargetArgs with the required arguments.
+func NewDetachFromTargetArgs() *DetachFromTargetArgs {
+	args := new(DetachFromTargetArgs)
+
+	return args
+}
+
+// SetSessionID sets the SessionID optional argument. Session to
+// detach.
+func (a *DetachFromTargetArgs) SetSessionID(sessionID SessionID) *DetachFromTargetArgs {
+	a.SessionID = &sessionID
+	return a
+}
+
+// SetTargetID sets the TargetID optional argument.
+//
+// Deprecated: Deprecated.
+func (a *DetachFromTargetArgs) SetTargetID(targetID ID) *DetachFromTargetArgs {
+	a.TargetID = &targetID
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DetachFromTargetArgs) Validate() error {
+	return nil
+}
+
+// DisposeBrowserContextArgs represents the arguments for DisposeBrowserContext in the Target domain.
+type DisposeBrowserContextArgs struct {
+	BrowserContextID internal.BrowserContextID `json:"browserContextId"` // No description.
+}
+
+// NewDisposeBrowserContextArgs initializes DisposeBrowserContextArgs with the required arguments.
+func NewDisposeBrowserContextArgs(browserContextID internal.BrowserContextID) *DisposeBrowserContextArgs {
+	args := new(DisposeBrowserContextArgs)
+	args.BrowserContextID = browserContextID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *DisposeBrowserContextArgs) Validate() error {
+	return nil
+}
+
+// GetTargetInfoArgs represents the arguments for GetTargetInfo in the Target domain.
+type GetTargetInfoArgs struct {
+	TargetID *ID `json:"targetId,omitempty"` // No description.
+}
+
+// NewGetTargetInfoArgs initializes GetTargetInfoArgs with the required arguments.
+func NewGetTargetInfoArgs() *GetTargetInfoArgs {
+	args := new(GetTargetInfoArgs)
+
+	return args
+}
+
+// SetTargetID sets the TargetID optional argument.
+func (a *GetTargetInfoArgs) SetTargetID(targetID ID) *GetTargetInfoArgs {
+	a.TargetID = &targetID
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetTargetInfoArgs) Validate() error {
+	return nil
+}
+
+// GetTargetInfoReply represents the return values for GetTargetInfo in the Target domain.
+type GetTargetInfoReply struct {
+	TargetInfo Info `json:"targetInfo"` // No description.
+}
+
+// GetTargetsArgs represents the arguments for GetTargets in the Target domain.
+type GetTargetsArgs struct {
+	// Filter Only targets matching filter will be reported. If filter is
+	// not specified and target discovery is currently enabled, a filter
+	// used for target discovery is used for consistency.
+	//
+	// Note: This property is experimental.
+	Filter Filter `json:"filter,omitempty"`
+}
+
+// NewGetTargetsArgs initializes GetTargetsArgs with the required arguments.
+func NewGetTargetsArgs() *GetTargetsArgs {
+	args := new(GetTargetsArgs)
+
+	return args
+}
+
+// SetFilter sets the Filter optional argument. Only targets matching
+// filter will be reported. If filter is not specified and target
+// discovery is currently enabled, a filter used for target discovery
+// is used for consistency.
+//
+// Note: This property is experimental.
+func (a *GetTargetsArgs) SetFilter(filter Filter) *GetTargetsArgs {
+	a.Filter = filter
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetTargetsArgs) Validate() error {
+	return nil
+}
+
+// GetTargetsReply represents the return values for GetTargets in the Target domain.
+type GetTargetsReply struct {
+	TargetInfos []Info `json:"targetInfos"` // The list of targets.
+}
+
+// SendMessageToTargetArgs represents the arguments for SendMessageToTarget in the Target domain.
+type SendMessageToTargetArgs struct {
+	Message   string     `json:"message"`             // No description.
+	SessionID *SessionID `json:"sessionId,omitempty"` // Identifier of the session.
+	// TargetID is deprecated.
+	//
+	// Deprecated: Deprecated.
+	TargetID *ID `json:"targetId,omitempty"`
+}
+
+// NewSendMessageToTargetArgs initializes SendMessageToTargetArgs with the required arguments.
+func NewSendMessageToTargetArgs(message string) *SendMessageToTargetArgs {
+	args := new(SendMessageToTargetArgs)
+	args.Message = message
+	return args
+}
+
+// SetSessionID sets the SessionID optional argument. Identifier of
+// the session.
+func (a *SendMessageToTargetArgs) SetSessionID(sessionID SessionID) *SendMessageToTargetArgs {
+	a.SessionID = &sessionID
+	return a
+}
+
+// SetTargetID sets the TargetID optional argument.
+//
+// Deprecated: Deprecated.
+func (a *SendMessageToTargetArgs) SetTargetID(targetID ID) *SendMessageToTargetArgs {
+	a.TargetID = &targetID
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SendMessageToTargetArgs) Validate() error {
+	return nil
+}
+
+// SetAutoAttachArgs represents the arguments for SetAutoAttach in the Target domain.
+type SetAutoAttachArgs struct {
+	AutoAttach             bool `json:"autoAttach"`             // Whether to auto-attach to related targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"` // Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
+	// Flatten Enables "flat" access to the session via specifying
+	// sessionId attribute in the commands. We plan to make this the
+	// default, deprecate non-flattened mode, and eventually retire it. See
+	// crbug.com/991325.
+	//
+	// Note: This property is experimental.
+	Flatten *bool `json:"flatten,omitempty"`
+	// Filter Only targets matching filter will be attached.
+	//
+	// Note: This property is experimental.
+	Filter Filter `json:"filter,omitempty"`
+}
+
+// NewSetAutoAttachArgs initializes SetAutoAttachArgs with the required arguments.
+func NewSetAutoAttachArgs(autoAttach bool, waitForDebuggerOnStart bool) *SetAutoAttachArgs {
+	args := new(SetAutoAttachArgs)
+	args.AutoAttach = autoAttach
+	args.WaitForDebuggerOnStart = waitForDebuggerOnStart
+	return args
+}
+
+// SetFlatten sets the Flatten optional argument. Enables "flat"
+// access to the session via specifying sessionId attribute in the
+// commands. We plan to make this the default, deprecate non-flattened
+// mode, and eventually retire it. See crbug.com/991325.
+//
+// Note: This property is experimental.
+func (a *SetAutoAttachArgs) SetFlatten(flatten bool) *SetAutoAttachArgs {
+	a.Flatten = &flatten
+	return a
+}
+
+// SetFilter sets the Filter optional argument. Only targets matching
+// filter will be attached.
+//
+// Note: This property is experimental.
+func (a *SetAutoAttachArgs) SetFilter(filter Filter) *SetAutoAttachArgs {
+	a.Filter = filter
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetAutoAttachArgs) Validate() error {
+	return nil
+}
+
+// AutoAttachRelatedArgs represents the arguments for AutoAttachRelated in the Target domain.
+type AutoAttachRelatedArgs struct {
+	TargetID               ID   `json:"targetId"`               // No description.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"` // Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
+	// Filter Only targets matching filter will be attached.
+	//
+	// Note: This property is experimental.
+	Filter Filter `json:"filter,omitempty"`
+}
+
+// NewAutoAttachRelatedArgs initializes AutoAttachRelatedArgs with the required arguments.
+func NewAutoAttachRelatedArgs(targetID ID, waitForDebuggerOnStart bool) *AutoAttachRelatedArgs {
+	args := new(AutoAttachRelatedArgs)
+	args.TargetID = targetID
+	args.WaitForDebuggerOnStart = waitForDebuggerOnStart
+	return args
+}
+
+// SetFilter sets the Filter optional argument. Only targets matching
+// filter will be attached.
+//
+// Note: This property is experimental.
+func (a *AutoAttachRelatedArgs) SetFilter(filter Filter) *AutoAttachRelatedArgs {
+	a.Filter = filter
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *AutoAttachRelatedArgs) Validate() error {
+	return nil
+}
+
+// SetDiscoverTargetsArgs represents the arguments for SetDiscoverTargets in the Target domain.
+type SetDiscoverTargetsArgs struct {
+	Discover bool `json:"discover"` // Whether to discover available targets.
+	// Filter Only targets matching filter will be attached. If `discover`
+	// is false, `filter` must be omitted or empty.
+	//
+	// Note: This property is experimental.
+	Filter Filter `json:"filter,omitempty"`
+}
+
+// NewSetDiscoverTargetsArgs initializes SetDiscoverTargetsArgs with the required arguments.
+func NewSetDiscoverTargetsArgs(discover bool) *SetDiscoverTargetsArgs {
+	args := new(SetDiscoverTargetsArgs)
+	args.Discover = discover
+	return args
+}
+
+// SetFilter sets the Filter optional argument. Only targets matching
+// filter will be attached. If `discover` is false, `filter` must be
+// omitted or empty.
+//
+// Note: This property is experimental.
+func (a *SetDiscoverTargetsArgs) SetFilter(filter Filter) *SetDiscoverTargetsArgs {
+	a.Filter = filter
+	return a
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetDiscoverTargetsArgs) Validate() error {
+	return nil
+}
+
+// SetRemoteLocationsArgs represents the arguments for SetRemoteLocations in the Target domain.
+type SetRemoteLocationsArgs struct {
+	Locations []RemoteLocation `json:"locations"` // List of remote locations.
+}
+
+// NewSetRemoteLocationsArgs initializes SetRemoteLocationsArgs with the required arguments.
+func NewSetRemoteLocationsArgs(locations []RemoteLocation) *SetRemoteLocationsArgs {
+	args := new(SetRemoteLocationsArgs)
+	args.Locations = locations
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *SetRemoteLocationsArgs) Validate() error {
+	if a.Locations == nil {
+		return errors.New("target.SetRemoteLocationsArgs: Locations is required")
+	}
+	return nil
+}
+
+// OpenDevToolsArgs represents the arguments for OpenDevTools in the Target domain.
+type OpenDevToolsArgs struct {
+	TargetID ID `json:"targetId"` // This can be the page or tab target ID.
+}
+
+// NewOpenDevToolsArgs initializes OpenDevToolsArgs with the required arguments.
+func NewOpenDevToolsArgs(targetID ID) *OpenDevToolsArgs {
+	args := new(OpenDevToolsArgs)
+	args.TargetID = targetID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *OpenDevToolsArgs) Validate() error {
+	return nil
+}
+
+// OpenDevToolsReply represents the return values for OpenDevTools in the Target domain.
+type OpenDevToolsReply struct {
+	TargetID ID `json:"targetId"` // The targetId of DevTools page target.
+}
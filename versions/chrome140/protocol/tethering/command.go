@@ -0,0 +1,39 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package tethering
+
+// BindArgs represents the arguments for Bind in the Tethering domain.
+type BindArgs struct {
+	Port int `json:"port"` // Port number to bind.
+}
+
+// NewBindArgs initializes BindArgs with the required arguments.
+func NewBindArgs(port int) *BindArgs {
+	args := new(BindArgs)
+	args.Port = port
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *BindArgs) Validate() error {
+	return nil
+}
+
+// UnbindArgs represents the arguments for Unbind in the Tethering domain.
+type UnbindArgs struct {
+	Port int `json:"port"` // Port number to unbind.
+}
+
+// NewUnbindArgs initializes UnbindArgs with the required arguments.
+func NewUnbindArgs(port int) *UnbindArgs {
+	args := new(UnbindArgs)
+	args.Port = port
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *UnbindArgs) Validate() error {
+	return nil
+}
@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package webaudio
+
+// GetRealtimeDataArgs represents the arguments for GetRealtimeData in the WebAudio domain.
+type GetRealtimeDataArgs struct {
+	ContextID GraphObjectID `json:"contextId"` // No description.
+}
+
+// NewGetRealtimeDataArgs initializes GetRealtimeDataArgs with the required arguments.
+func NewGetRealtimeDataArgs(contextID GraphObjectID) *GetRealtimeDataArgs {
+	args := new(GetRealtimeDataArgs)
+	args.ContextID = contextID
+	return args
+}
+
+// Validate returns an error if the arguments are invalid, e.g. a
+// required argument is missing or the value of an enum is unknown.
+func (a *GetRealtimeDataArgs) Validate() error {
+	return nil
+}
+
+// GetRealtimeDataReply represents the return values for GetRealtimeData in the WebAudio domain.
+type GetRealtimeDataReply struct {
+	RealtimeData ContextRealtimeData `json:"realtimeData"` // No description.
+}
@@ -0,0 +1,84 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package webauthn
+
+// EnableOption sets an optional argument of EnableArgs.
+type EnableOption func(*EnableArgs)
+
+// NewEnableArgsWith initializes EnableArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewEnableArgsWith(opts ...EnableOption) *EnableArgs {
+	args := NewEnableArgs()
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// EnableWithEnableUI sets the EnableUI optional argument, see
+// EnableArgs.SetEnableUI.
+func EnableWithEnableUI(v bool) EnableOption {
+	return func(a *EnableArgs) { a.SetEnableUI(v) }
+}
+
+// SetResponseOverrideBitsOption sets an optional argument of SetResponseOverrideBitsArgs.
+type SetResponseOverrideBitsOption func(*SetResponseOverrideBitsArgs)
+
+// NewSetResponseOverrideBitsArgsWith initializes SetResponseOverrideBitsArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetResponseOverrideBitsArgsWith(authenticatorID AuthenticatorID, opts ...SetResponseOverrideBitsOption) *SetResponseOverrideBitsArgs {
+	args := NewSetResponseOverrideBitsArgs(authenticatorID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetResponseOverrideBitsWithIsBogusSignature sets the IsBogusSignature optional argument, see
+// SetResponseOverrideBitsArgs.SetIsBogusSignature.
+func SetResponseOverrideBitsWithIsBogusSignature(v bool) SetResponseOverrideBitsOption {
+	return func(a *SetResponseOverrideBitsArgs) { a.SetIsBogusSignature(v) }
+}
+
+// SetResponseOverrideBitsWithIsBadUV sets the IsBadUV optional argument, see
+// SetResponseOverrideBitsArgs.SetIsBadUV.
+func SetResponseOverrideBitsWithIsBadUV(v bool) SetResponseOverrideBitsOption {
+	return func(a *SetResponseOverrideBitsArgs) { a.SetIsBadUV(v) }
+}
+
+// SetResponseOverrideBitsWithIsBadUP sets the IsBadUP optional argument, see
+// SetResponseOverrideBitsArgs.SetIsBadUP.
+func SetResponseOverrideBitsWithIsBadUP(v bool) SetResponseOverrideBitsOption {
+	return func(a *SetResponseOverrideBitsArgs) { a.SetIsBadUP(v) }
+}
+
+// SetCredentialPropertiesOption sets an optional argument of SetCredentialPropertiesArgs.
+type SetCredentialPropertiesOption func(*SetCredentialPropertiesArgs)
+
+// NewSetCredentialPropertiesArgsWith initializes SetCredentialPropertiesArgs with the required arguments
+// and applies the options, nil options are ignored.
+func NewSetCredentialPropertiesArgsWith(authenticatorID AuthenticatorID, credentialID string, opts ...SetCredentialPropertiesOption) *SetCredentialPropertiesArgs {
+	args := NewSetCredentialPropertiesArgs(authenticatorID, credentialID)
+	for _, o := range opts {
+		if o != nil {
+			o(args)
+		}
+	}
+	return args
+}
+
+// SetCredentialPropertiesWithBackupEligibility sets the BackupEligibility optional argument, see
+// SetCredentialPropertiesArgs.SetBackupEligibility.
+func SetCredentialPropertiesWithBackupEligibility(v bool) SetCredentialPropertiesOption {
+	return func(a *SetCredentialPropertiesArgs) { a.SetBackupEligibility(v) }
+}
+
+// SetCredentialPropertiesWithBackupState sets the BackupState optional argument, see
+// SetCredentialPropertiesArgs.SetBackupState.
+func SetCredentialPropertiesWithBackupState(v bool) SetCredentialPropertiesOption {
+	return func(a *SetCredentialPropertiesArgs) { a.SetBackupState(v) }
+}